@@ -0,0 +1,46 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"expvar"
+	"log/slog"
+	"net/http"
+	"net/http/pprof"
+	"time"
+)
+
+// adminHandler serves the Go runtime profiles under /debug/pprof/ and the
+// expvar counters, memory statistics included, at /debug/vars. It has no
+// authentication, so the admin address must only be reachable by operators.
+func adminHandler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /debug/pprof/", pprof.Index)
+	mux.HandleFunc("GET /debug/pprof/cmdline", pprof.Cmdline)
+	mux.HandleFunc("GET /debug/pprof/profile", pprof.Profile)
+	mux.HandleFunc("GET /debug/pprof/symbol", pprof.Symbol)
+	mux.HandleFunc("POST /debug/pprof/symbol", pprof.Symbol)
+	mux.HandleFunc("GET /debug/pprof/trace", pprof.Trace)
+	mux.Handle("GET /debug/vars", expvar.Handler())
+	return mux
+}
+
+// serveAdmin serves adminHandler on addr until ctx is done. Profiles are
+// streamed for as long as they were asked for, so shutdown does not wait for
+// them.
+func serveAdmin(ctx context.Context, addr string, logger *slog.Logger) {
+	server := &http.Server{
+		Addr:              addr,
+		Handler:           adminHandler(),
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+	go func() {
+		<-ctx.Done()
+		_ = server.Close()
+	}()
+
+	logger.Info("admin listening", "addr", addr)
+	if err := server.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		logger.Error("admin listener exited", "addr", addr, "error", err)
+	}
+}
@@ -0,0 +1,37 @@
+package main
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestAdminHandler(t *testing.T) {
+	handler := adminHandler()
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/debug/vars", nil))
+	var vars map[string]json.RawMessage
+	if err := json.Unmarshal(rec.Body.Bytes(), &vars); err != nil {
+		t.Fatalf("expected expvar JSON, got %d: %v", rec.Code, err)
+	}
+	for _, name := range []string{"memstats", "worker"} {
+		if _, ok := vars[name]; !ok {
+			t.Fatalf("expected %q in /debug/vars, got %s", name, rec.Body.String())
+		}
+	}
+
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/debug/pprof/heap?debug=1", nil))
+	if rec.Code != http.StatusOK || !strings.Contains(rec.Body.String(), "heap profile") {
+		t.Fatalf("expected a heap profile, got %d: %.200s", rec.Code, rec.Body.String())
+	}
+
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/v1/monitors", nil))
+	if rec.Code != http.StatusNotFound {
+		t.Fatalf("expected the admin listener not to serve the api, got %d", rec.Code)
+	}
+}
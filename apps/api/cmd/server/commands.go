@@ -0,0 +1,213 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"strconv"
+	"strings"
+
+	"goanna/apps/api/ent"
+	"goanna/apps/api/internal/migrations"
+	"goanna/apps/api/internal/server"
+	"goanna/apps/api/internal/worker"
+)
+
+// commandFlags returns a flag set with the config and database flags shared
+// by the commands that open the database, and the -config flag's value.
+func commandFlags(name string, usage string) (*flag.FlagSet, *string) {
+	flags := flag.NewFlagSet(name, flag.ContinueOnError)
+	configPath := flags.String("config", "", "YAML or TOML config file; environment variables and flags override it")
+	flags.String("db-driver", driverSQLite, "Database driver: sqlite3 or mysql (also MariaDB)")
+	flags.String("dsn", defaultDSN, "Database DSN")
+	flags.Usage = func() {
+		fmt.Fprintln(flags.Output(), "usage: goanna-api "+usage)
+		flags.PrintDefaults()
+	}
+	return flags, configPath
+}
+
+// parseCommand parses flags given before or after a command's single
+// positional argument, and returns that argument.
+func parseCommand(flags *flag.FlagSet, args []string) (string, error) {
+	if err := flags.Parse(args); err != nil {
+		return "", err
+	}
+	arg := flags.Arg(0)
+	if flags.NArg() > 0 {
+		if err := flags.Parse(flags.Args()[1:]); err != nil {
+			return "", err
+		}
+	}
+	if flags.NArg() > 0 {
+		return "", fmt.Errorf("unexpected argument %q", flags.Arg(0))
+	}
+	return arg, nil
+}
+
+// openCommandDatabase opens the configured database for a command, refusing
+// to work on a schema with pending migrations.
+func openCommandDatabase(ctx context.Context, configPath string, flags *flag.FlagSet, logger *slog.Logger) (serverConfig, *ent.Client, error) {
+	config, err := loadServerConfig(configPath, flags, logger)
+	if err != nil {
+		return config, nil, fmt.Errorf("invalid configuration: %w", err)
+	}
+	driver := config.Database.Driver
+
+	client, db, err := openDatabase(driver, config.Database.DSN, config.sqliteOptions())
+	if err != nil {
+		return config, nil, fmt.Errorf("open database: %w", err)
+	}
+	migrator, err := migrations.New(db, driver)
+	if err == nil {
+		var pending []migrations.Migration
+		pending, err = migrator.Pending(ctx)
+		if err == nil && len(pending) > 0 {
+			err = fmt.Errorf("%d schema migrations are pending; run \"goanna-api migrate up\" first", len(pending))
+		}
+	}
+	if err != nil {
+		_ = client.Close()
+		return config, nil, err
+	}
+	return config, client, nil
+}
+
+// runExport implements "goanna-api export [flags]", writing the monitors as
+// GET /v1/monitors/export does. It returns the process exit code.
+func runExport(args []string, stdout io.Writer, logger *slog.Logger) int {
+	flags, configPath := commandFlags("export", "export [flags]")
+	format := flags.String("format", "json", "Export format: json or yaml")
+	tags := flags.String("tag", "", "Comma-separated tags a monitor must all have to be exported")
+	stripSecrets := flags.Bool("strip-secrets", false, "Leave out auth credentials and credential headers")
+	output := flags.String("o", "", "File to write instead of stdout")
+	if _, err := parseCommand(flags, args); err != nil {
+		return 2
+	}
+
+	ctx := context.Background()
+	_, client, err := openCommandDatabase(ctx, *configPath, flags, logger)
+	if err != nil {
+		logger.Error("export failed", "error", err)
+		return 1
+	}
+	defer client.Close()
+
+	var tagFilter []string
+	if *tags != "" {
+		tagFilter = strings.Split(*tags, ",")
+	}
+	payload, err := server.New(client).ExportMonitors(ctx, strings.ToLower(*format), tagFilter, *stripSecrets)
+	if err != nil {
+		logger.Error("export failed", "error", err)
+		return 1
+	}
+
+	if *output != "" {
+		err = os.WriteFile(*output, payload, 0o600)
+	} else {
+		_, err = stdout.Write(payload)
+	}
+	if err != nil {
+		logger.Error("failed writing export", "error", err)
+		return 1
+	}
+	return 0
+}
+
+// runImport implements "goanna-api import [flags] <file|->", applying an
+// export document as POST /v1/monitors/import does. A running server picks
+// the monitors up on its next scheduling pass.
+func runImport(args []string, stdin io.Reader, stdout io.Writer, logger *slog.Logger) int {
+	flags, configPath := commandFlags("import", "import [flags] <file|->")
+	conflict := flags.String("conflict", "skip", "How to handle monitors that already exist: skip, update or create")
+	path, err := parseCommand(flags, args)
+	if err != nil {
+		return 2
+	}
+	if path == "" {
+		flags.Usage()
+		return 2
+	}
+
+	var payload []byte
+	if path == "-" {
+		payload, err = io.ReadAll(stdin)
+	} else {
+		payload, err = os.ReadFile(path)
+	}
+	if err != nil {
+		logger.Error("failed reading import", "error", err)
+		return 1
+	}
+
+	ctx := context.Background()
+	_, client, err := openCommandDatabase(ctx, *configPath, flags, logger)
+	if err != nil {
+		logger.Error("import failed", "error", err)
+		return 1
+	}
+	defer client.Close()
+
+	created, updated, skipped, err := server.New(client).ImportMonitors(ctx, payload, strings.ToLower(*conflict))
+	if err != nil {
+		logger.Error("import failed", "error", err)
+		return 1
+	}
+	fmt.Fprintf(stdout, "created %d, updated %d, skipped %d\n", created, updated, skipped)
+	return 0
+}
+
+// runCheck implements "goanna-api check [flags] <monitorId>": it runs one
+// check of a saved monitor without saving it and prints the outcome as
+// JSON. The exit code is 1 when the check fails.
+func runCheck(args []string, stdout io.Writer, logger *slog.Logger) int {
+	flags, configPath := commandFlags("check", "check [flags] <monitorId>")
+	arg, err := parseCommand(flags, args)
+	if err != nil {
+		return 2
+	}
+	monitorID, err := strconv.Atoi(arg)
+	if err != nil || monitorID <= 0 {
+		flags.Usage()
+		return 2
+	}
+
+	ctx := context.Background()
+	config, client, err := openCommandDatabase(ctx, *configPath, flags, logger)
+	if err != nil {
+		logger.Error("check failed", "error", err)
+		return 1
+	}
+	defer client.Close()
+
+	networkGuard, err := config.networkGuard()
+	if err != nil {
+		logger.Error("failed loading network policy", "error", err)
+		return 1
+	}
+	preview, err := worker.NewWithConfig(client, config.workerConfig(networkGuard)).PreviewMonitorCheck(ctx, monitorID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			err = errors.New("monitor not found")
+		}
+		logger.Error("check failed", "monitor", monitorID, "error", err)
+		return 1
+	}
+
+	encoder := json.NewEncoder(stdout)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(preview); err != nil {
+		logger.Error("failed writing check result", "error", err)
+		return 1
+	}
+	if preview.Status != "ok" {
+		return 1
+	}
+	return 0
+}
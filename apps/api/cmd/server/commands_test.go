@@ -0,0 +1,85 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"goanna/apps/api/internal/worker"
+)
+
+func TestExportImportAndCheckCommands(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	dsn := "file:" + filepath.Join(t.TempDir(), "goanna.db") + "?_fk=1"
+
+	target := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = io.WriteString(w, `{"price":{"amount":42}}`)
+	}))
+	defer target.Close()
+
+	if code := runImport([]string{"-dsn", dsn, "-"}, strings.NewReader("{}"), io.Discard, logger); code != 1 {
+		t.Fatalf("expected pending migrations to be refused, got %d", code)
+	}
+	if code := runMigrate([]string{"-dsn", dsn, "up"}, io.Discard, logger); code != 0 {
+		t.Fatalf("expected migrations to apply, got %d", code)
+	}
+
+	document := `
+version: 1
+monitors:
+  - label: Price
+    url: ` + target.URL + `
+    cron: "*/5 * * * *"
+    expectedType: json
+    selector: price.amount
+    tags: [shop]
+`
+	var out bytes.Buffer
+	if code := runImport([]string{"-", "-dsn", dsn}, strings.NewReader(document), &out, logger); code != 0 || out.String() != "created 1, updated 0, skipped 0\n" {
+		t.Fatalf("expected the monitor to be imported, got %d: %s", code, out.String())
+	}
+
+	path := filepath.Join(t.TempDir(), "monitors.yaml")
+	if code := runExport([]string{"-dsn", dsn, "-format", "yaml", "-tag", "Shop", "-o", path}, io.Discard, logger); code != 0 {
+		t.Fatalf("expected the export to succeed, got %d", code)
+	}
+	exported, err := os.ReadFile(path)
+	if err != nil || !strings.Contains(string(exported), "label: Price") {
+		t.Fatalf("expected the monitor to be exported, got %q (%v)", exported, err)
+	}
+
+	out.Reset()
+	if code := runImport([]string{"-dsn", dsn, path}, nil, &out, logger); code != 0 || out.String() != "created 0, updated 0, skipped 1\n" {
+		t.Fatalf("expected the existing monitor to be skipped, got %d: %s", code, out.String())
+	}
+
+	out.Reset()
+	if code := runCheck([]string{"-dsn", dsn, "1"}, &out, logger); code != 0 {
+		t.Fatalf("expected the check to pass, got %d: %s", code, out.String())
+	}
+	var preview worker.CheckPreview
+	if err := json.Unmarshal(out.Bytes(), &preview); err != nil {
+		t.Fatalf("expected check JSON: %v", err)
+	}
+	if preview.Status != "ok" || preview.SelectionValue == nil || *preview.SelectionValue != "42" {
+		t.Fatalf("expected the selected value, got %+v", preview)
+	}
+
+	if code := runCheck([]string{"-dsn", dsn, "99"}, io.Discard, logger); code != 1 {
+		t.Fatalf("expected an unknown monitor to fail, got %d", code)
+	}
+	if code := runCheck([]string{"-dsn", dsn}, io.Discard, logger); code != 2 {
+		t.Fatalf("expected a missing monitor id to be a usage error, got %d", code)
+	}
+	if code := run([]string{"sideways"}); code != 2 {
+		t.Fatalf("expected an unknown command to be a usage error, got %d", code)
+	}
+}
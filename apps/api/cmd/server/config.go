@@ -0,0 +1,616 @@
+package main
+
+import (
+	"bytes"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"log/slog"
+	"net"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"goanna/apps/api/internal/backup"
+	"goanna/apps/api/internal/server"
+	"goanna/apps/api/internal/worker"
+
+	"github.com/BurntSushi/toml"
+	"gopkg.in/yaml.v3"
+)
+
+// Environment variables overriding the config file.
+const (
+	configFileEnv           = "GOANNA_CONFIG"
+	addrEnv                 = "GOANNA_ADDR"
+	socketModeEnv           = "GOANNA_SOCKET_MODE"
+	basePathEnv             = "GOANNA_BASE_PATH"
+	adminAddrEnv            = "GOANNA_ADMIN_ADDR"
+	shutdownTimeoutEnv      = "GOANNA_SHUTDOWN_TIMEOUT_SECONDS"
+	dbDriverEnv             = "GOANNA_DB_DRIVER"
+	dsnEnv                  = "GOANNA_DSN"
+	autoMigrateEnv          = "GOANNA_AUTO_MIGRATE"
+	logLevelEnv             = "GOANNA_LOG_LEVEL"
+	logFormatEnv            = "GOANNA_LOG_FORMAT"
+	maxResponseBodyBytesEnv = "GOANNA_MAX_RESPONSE_BODY_BYTES"
+	renderingEnabledEnv     = "GOANNA_RENDERING_ENABLED"
+	chromiumPathEnv         = "GOANNA_CHROMIUM_PATH"
+	renderTimeoutEnv        = "GOANNA_RENDER_TIMEOUT_SECONDS"
+	maxConcurrentRendersEnv = "GOANNA_MAX_CONCURRENT_RENDERS"
+	maxConcurrentChecksEnv  = "GOANNA_MAX_CONCURRENT_CHECKS"
+	maxIdleConnsPerHostEnv  = "GOANNA_HTTP_MAX_IDLE_CONNS_PER_HOST"
+	idleConnTimeoutEnv      = "GOANNA_HTTP_IDLE_CONN_TIMEOUT_SECONDS"
+	disableKeepAlivesEnv    = "GOANNA_HTTP_DISABLE_KEEP_ALIVES"
+	forceHTTP2Env           = "GOANNA_HTTP_FORCE_HTTP2"
+	dnsServerEnv            = "GOANNA_DNS_SERVER"
+	ssrfProtectionEnv       = "GOANNA_SSRF_PROTECTION"
+	blockedNetworksEnv      = "GOANNA_BLOCKED_NETWORKS"
+	allowedNetworksEnv      = "GOANNA_ALLOWED_NETWORKS"
+	instanceIDEnv           = "GOANNA_INSTANCE_ID"
+	proxyURLEnv             = "GOANNA_PROXY_URL"
+	proxyBypassEnv          = "GOANNA_PROXY_BYPASS"
+	tlsCertFileEnv          = "GOANNA_TLS_CERT_FILE"
+	tlsKeyFileEnv           = "GOANNA_TLS_KEY_FILE"
+	acmeDomainsEnv          = "GOANNA_ACME_DOMAINS"
+	acmeEmailEnv            = "GOANNA_ACME_EMAIL"
+	acmeCacheDirEnv         = "GOANNA_ACME_CACHE_DIR"
+	acmeHTTPAddrEnv         = "GOANNA_ACME_HTTP_ADDR"
+	acmeDirectoryURLEnv     = "GOANNA_ACME_DIRECTORY_URL"
+	sessionTTLEnv           = "GOANNA_SESSION_TTL_HOURS"
+	sqliteJournalModeEnv    = "GOANNA_SQLITE_JOURNAL_MODE"
+	sqliteBusyTimeoutEnv    = "GOANNA_SQLITE_BUSY_TIMEOUT_MS"
+	sqliteSingleWriterEnv   = "GOANNA_SQLITE_SINGLE_WRITER"
+	backupDirEnv            = "GOANNA_BACKUP_DIR"
+	backupIntervalEnv       = "GOANNA_BACKUP_INTERVAL_HOURS"
+	backupKeepEnv           = "GOANNA_BACKUP_KEEP"
+	restoreFromEnv          = "GOANNA_RESTORE_FROM"
+	otelEnabledEnv          = "GOANNA_OTEL_ENABLED"
+	otelServiceNameEnv      = "GOANNA_OTEL_SERVICE_NAME"
+	sentryDSNEnv            = "GOANNA_SENTRY_DSN"
+	sentryEnvironmentEnv    = "GOANNA_SENTRY_ENVIRONMENT"
+)
+
+const defaultDSN = "file:./data/goanna.db?_fk=1"
+
+const (
+	logFormatText = "text"
+	logFormatJSON = "json"
+)
+
+// serverConfig is the startup configuration. Values come from, in increasing
+// precedence, built-in defaults, the -config file, GOANNA_* environment
+// variables and flags given on the command line.
+type serverConfig struct {
+	Addr                   string                 `yaml:"addr" toml:"addr"`
+	SocketMode             string                 `yaml:"socketMode" toml:"socketMode"`
+	BasePath               string                 `yaml:"basePath" toml:"basePath"`
+	AdminAddr              string                 `yaml:"adminAddr" toml:"adminAddr"`
+	ShutdownTimeoutSeconds int                    `yaml:"shutdownTimeoutSeconds" toml:"shutdownTimeoutSeconds"`
+	LogLevel               string                 `yaml:"logLevel" toml:"logLevel"`
+	LogFormat              string                 `yaml:"logFormat" toml:"logFormat"`
+	MaxResponseBodyBytes   int                    `yaml:"maxResponseBodyBytes" toml:"maxResponseBodyBytes"`
+	Database               databaseSettings       `yaml:"database" toml:"database"`
+	Worker                 workerSettings         `yaml:"worker" toml:"worker"`
+	Proxy                  proxySettings          `yaml:"proxy" toml:"proxy"`
+	Network                networkSettings        `yaml:"network" toml:"network"`
+	TLS                    tlsSettings            `yaml:"tls" toml:"tls"`
+	Auth                   authSettings           `yaml:"auth" toml:"auth"`
+	Backup                 backupSettings         `yaml:"backup" toml:"backup"`
+	Telemetry              telemetrySettings      `yaml:"telemetry" toml:"telemetry"`
+	ErrorReporting         errorReportingSettings `yaml:"errorReporting" toml:"errorReporting"`
+}
+
+type databaseSettings struct {
+	Driver      string         `yaml:"driver" toml:"driver"`
+	DSN         string         `yaml:"dsn" toml:"dsn"`
+	AutoMigrate bool           `yaml:"autoMigrate" toml:"autoMigrate"`
+	SQLite      sqliteSettings `yaml:"sqlite" toml:"sqlite"`
+}
+
+type sqliteSettings struct {
+	JournalMode   string `yaml:"journalMode" toml:"journalMode"`
+	BusyTimeoutMs int    `yaml:"busyTimeoutMs" toml:"busyTimeoutMs"`
+	SingleWriter  bool   `yaml:"singleWriter" toml:"singleWriter"`
+}
+
+type workerSettings struct {
+	MaxConcurrentChecks int               `yaml:"maxConcurrentChecks" toml:"maxConcurrentChecks"`
+	InstanceID          string            `yaml:"instanceId" toml:"instanceId"`
+	DNSServer           string            `yaml:"dnsServer" toml:"dnsServer"`
+	HTTP                httpSettings      `yaml:"http" toml:"http"`
+	Rendering           renderingSettings `yaml:"rendering" toml:"rendering"`
+}
+
+type httpSettings struct {
+	MaxIdleConnsPerHost    int  `yaml:"maxIdleConnsPerHost" toml:"maxIdleConnsPerHost"`
+	IdleConnTimeoutSeconds int  `yaml:"idleConnTimeoutSeconds" toml:"idleConnTimeoutSeconds"`
+	DisableKeepAlives      bool `yaml:"disableKeepAlives" toml:"disableKeepAlives"`
+	ForceHTTP2             bool `yaml:"forceHttp2" toml:"forceHttp2"`
+}
+
+type renderingSettings struct {
+	Enabled        bool   `yaml:"enabled" toml:"enabled"`
+	ChromiumPath   string `yaml:"chromiumPath" toml:"chromiumPath"`
+	TimeoutSeconds int    `yaml:"timeoutSeconds" toml:"timeoutSeconds"`
+	MaxConcurrent  int    `yaml:"maxConcurrent" toml:"maxConcurrent"`
+}
+
+// proxySettings is the outbound proxy used until one is set in the runtime
+// settings. Without a URL the HTTP_PROXY environment variables apply.
+type proxySettings struct {
+	URL    string `yaml:"url" toml:"url"`
+	Bypass string `yaml:"bypass" toml:"bypass"`
+}
+
+type networkSettings struct {
+	SSRFProtection  bool     `yaml:"ssrfProtection" toml:"ssrfProtection"`
+	BlockedNetworks []string `yaml:"blockedNetworks" toml:"blockedNetworks"`
+	AllowedNetworks []string `yaml:"allowedNetworks" toml:"allowedNetworks"`
+}
+
+// tlsSettings serve the API over HTTPS when both files are set, or with
+// certificates obtained through ACME when domains are.
+type tlsSettings struct {
+	CertFile string       `yaml:"certFile" toml:"certFile"`
+	KeyFile  string       `yaml:"keyFile" toml:"keyFile"`
+	ACME     acmeSettings `yaml:"acme" toml:"acme"`
+}
+
+// acmeSettings obtain and renew certificates for Domains from Let's Encrypt,
+// or the CA at DirectoryURL. Certificates are kept in CacheDir. HTTPAddr
+// serves HTTP-01 challenges and redirects other requests to HTTPS; without it
+// only TLS-ALPN-01 challenges on the HTTPS port are answered.
+type acmeSettings struct {
+	Domains      []string `yaml:"domains" toml:"domains"`
+	Email        string   `yaml:"email" toml:"email"`
+	CacheDir     string   `yaml:"cacheDir" toml:"cacheDir"`
+	HTTPAddr     string   `yaml:"httpAddr" toml:"httpAddr"`
+	DirectoryURL string   `yaml:"directoryUrl" toml:"directoryUrl"`
+}
+
+type authSettings struct {
+	SessionTTLHours int `yaml:"sessionTtlHours" toml:"sessionTtlHours"`
+}
+
+// backupSettings enable scheduled SQLite backups when Dir is set.
+type backupSettings struct {
+	Dir           string `yaml:"dir" toml:"dir"`
+	IntervalHours int    `yaml:"intervalHours" toml:"intervalHours"`
+	Keep          int    `yaml:"keep" toml:"keep"`
+}
+
+// telemetrySettings export OpenTelemetry traces and metrics over OTLP when
+// Enabled; the exporter endpoint comes from the OTEL_EXPORTER_OTLP_*
+// environment variables.
+type telemetrySettings struct {
+	Enabled     bool   `yaml:"enabled" toml:"enabled"`
+	ServiceName string `yaml:"serviceName" toml:"serviceName"`
+}
+
+// errorReportingSettings send panics and error logs to Sentry when
+// SentryDSN is set.
+type errorReportingSettings struct {
+	SentryDSN   string `yaml:"sentryDsn" toml:"sentryDsn"`
+	Environment string `yaml:"environment" toml:"environment"`
+}
+
+func defaultServerConfig() serverConfig {
+	return serverConfig{
+		Addr:                   ":8080",
+		SocketMode:             defaultSocketMode,
+		ShutdownTimeoutSeconds: int(defaultShutdownTimeout / time.Second),
+		LogLevel:               "info",
+		LogFormat:              logFormatText,
+		MaxResponseBodyBytes:   worker.DefaultMaxResponseBodyBytes,
+		Database: databaseSettings{
+			Driver:      driverSQLite,
+			DSN:         defaultDSN,
+			AutoMigrate: true,
+			SQLite: sqliteSettings{
+				JournalMode:   defaultSQLiteJournalMode,
+				BusyTimeoutMs: int(defaultSQLiteBusyTimeout / time.Millisecond),
+			},
+		},
+		Worker: workerSettings{
+			MaxConcurrentChecks: worker.DefaultMaxConcurrentChecks,
+			HTTP: httpSettings{
+				MaxIdleConnsPerHost:    worker.DefaultMaxIdleConnsPerHost,
+				IdleConnTimeoutSeconds: int(worker.DefaultIdleConnTimeout / time.Second),
+				ForceHTTP2:             true,
+			},
+			Rendering: renderingSettings{
+				ChromiumPath:   worker.DefaultBrowserPath,
+				TimeoutSeconds: int(worker.DefaultRenderTimeout / time.Second),
+				MaxConcurrent:  worker.DefaultMaxConcurrentRenders,
+			},
+		},
+		Network: networkSettings{
+			BlockedNetworks: worker.DefaultBlockedNetworks,
+		},
+		TLS: tlsSettings{
+			ACME: acmeSettings{
+				CacheDir: defaultACMECacheDir,
+				HTTPAddr: ":80",
+			},
+		},
+		Auth: authSettings{
+			SessionTTLHours: int(server.DefaultSessionTTL / time.Hour),
+		},
+		Backup: backupSettings{
+			IntervalHours: int(backup.DefaultInterval / time.Hour),
+			Keep:          backup.DefaultKeep,
+		},
+		Telemetry: telemetrySettings{
+			ServiceName: "goanna",
+		},
+	}
+}
+
+// loadServerConfig resolves the startup configuration from the config file at
+// path (GOANNA_CONFIG when empty, none when both are), the environment and
+// the flags set in flags.
+func loadServerConfig(path string, flags *flag.FlagSet, logger *slog.Logger) (serverConfig, error) {
+	config := defaultServerConfig()
+	if path == "" {
+		path = strings.TrimSpace(os.Getenv(configFileEnv))
+	}
+	if path != "" {
+		if err := readConfigFile(path, &config); err != nil {
+			return config, err
+		}
+	}
+	applyEnvironment(&config, logger)
+	applyFlags(&config, flags)
+
+	return config, config.validate()
+}
+
+// readConfigFile decodes a YAML (.yaml, .yml) or TOML (.toml) file over
+// config, leaving keys the file does not set unchanged.
+func readConfigFile(path string, config *serverConfig) error {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("read config file: %w", err)
+	}
+
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".yaml", ".yml":
+		decoder := yaml.NewDecoder(bytes.NewReader(content))
+		decoder.KnownFields(true)
+		if err := decoder.Decode(config); err != nil && !errors.Is(err, io.EOF) {
+			return fmt.Errorf("parse config file %s: %w", path, err)
+		}
+	case ".toml":
+		metadata, err := toml.Decode(string(content), config)
+		if err != nil {
+			return fmt.Errorf("parse config file %s: %w", path, err)
+		}
+		if undecoded := metadata.Undecoded(); len(undecoded) > 0 {
+			return fmt.Errorf("parse config file %s: unknown key %s", path, undecoded[0])
+		}
+	default:
+		return fmt.Errorf("config file %s must end in .yaml, .yml or .toml", path)
+	}
+	return nil
+}
+
+// applyEnvironment overrides config with the GOANNA_* variables that are set.
+// Invalid values are logged and ignored.
+func applyEnvironment(config *serverConfig, logger *slog.Logger) {
+	config.Addr = loadStringEnv(addrEnv, config.Addr)
+	config.SocketMode = loadStringEnv(socketModeEnv, config.SocketMode)
+	config.BasePath = loadStringEnv(basePathEnv, config.BasePath)
+	config.AdminAddr = loadStringEnv(adminAddrEnv, config.AdminAddr)
+	config.ShutdownTimeoutSeconds = loadPositiveIntEnv(shutdownTimeoutEnv, config.ShutdownTimeoutSeconds, logger)
+	config.LogLevel = loadStringEnv(logLevelEnv, config.LogLevel)
+	config.LogFormat = loadStringEnv(logFormatEnv, config.LogFormat)
+	config.Database.Driver = loadStringEnv(dbDriverEnv, config.Database.Driver)
+	config.Database.DSN = loadStringEnv(dsnEnv, config.Database.DSN)
+	config.Database.AutoMigrate = loadBoolEnv(autoMigrateEnv, config.Database.AutoMigrate, logger)
+	config.MaxResponseBodyBytes = loadPositiveIntEnv(maxResponseBodyBytesEnv, config.MaxResponseBodyBytes, logger)
+
+	sqlite := &config.Database.SQLite
+	if raw := strings.TrimSpace(os.Getenv(sqliteJournalModeEnv)); raw != "" {
+		sqlite.JournalMode = raw
+	}
+	sqlite.BusyTimeoutMs = loadPositiveIntEnv(sqliteBusyTimeoutEnv, sqlite.BusyTimeoutMs, logger)
+	sqlite.SingleWriter = loadBoolEnv(sqliteSingleWriterEnv, sqlite.SingleWriter, logger)
+
+	workerSettings := &config.Worker
+	workerSettings.MaxConcurrentChecks = loadPositiveIntEnv(maxConcurrentChecksEnv, workerSettings.MaxConcurrentChecks, logger)
+	workerSettings.InstanceID = loadStringEnv(instanceIDEnv, workerSettings.InstanceID)
+	workerSettings.DNSServer = loadDNSServerEnv(dnsServerEnv, workerSettings.DNSServer, logger)
+	workerSettings.HTTP.MaxIdleConnsPerHost = loadPositiveIntEnv(maxIdleConnsPerHostEnv, workerSettings.HTTP.MaxIdleConnsPerHost, logger)
+	workerSettings.HTTP.IdleConnTimeoutSeconds = loadPositiveIntEnv(idleConnTimeoutEnv, workerSettings.HTTP.IdleConnTimeoutSeconds, logger)
+	workerSettings.HTTP.DisableKeepAlives = loadBoolEnv(disableKeepAlivesEnv, workerSettings.HTTP.DisableKeepAlives, logger)
+	workerSettings.HTTP.ForceHTTP2 = loadBoolEnv(forceHTTP2Env, workerSettings.HTTP.ForceHTTP2, logger)
+	workerSettings.Rendering.Enabled = loadBoolEnv(renderingEnabledEnv, workerSettings.Rendering.Enabled, logger)
+	workerSettings.Rendering.ChromiumPath = loadStringEnv(chromiumPathEnv, workerSettings.Rendering.ChromiumPath)
+	workerSettings.Rendering.TimeoutSeconds = loadPositiveIntEnv(renderTimeoutEnv, workerSettings.Rendering.TimeoutSeconds, logger)
+	workerSettings.Rendering.MaxConcurrent = loadPositiveIntEnv(maxConcurrentRendersEnv, workerSettings.Rendering.MaxConcurrent, logger)
+
+	config.Proxy.URL = loadStringEnv(proxyURLEnv, config.Proxy.URL)
+	config.Proxy.Bypass = loadStringEnv(proxyBypassEnv, config.Proxy.Bypass)
+
+	config.Network.SSRFProtection = loadBoolEnv(ssrfProtectionEnv, config.Network.SSRFProtection, logger)
+	if raw := strings.TrimSpace(os.Getenv(blockedNetworksEnv)); raw != "" {
+		config.Network.BlockedNetworks = strings.Split(raw, ",")
+	}
+	if raw := strings.TrimSpace(os.Getenv(allowedNetworksEnv)); raw != "" {
+		config.Network.AllowedNetworks = strings.Split(raw, ",")
+	}
+
+	config.TLS.CertFile = loadStringEnv(tlsCertFileEnv, config.TLS.CertFile)
+	config.TLS.KeyFile = loadStringEnv(tlsKeyFileEnv, config.TLS.KeyFile)
+	if raw := strings.TrimSpace(os.Getenv(acmeDomainsEnv)); raw != "" {
+		config.TLS.ACME.Domains = strings.Split(raw, ",")
+	}
+	config.TLS.ACME.Email = loadStringEnv(acmeEmailEnv, config.TLS.ACME.Email)
+	config.TLS.ACME.CacheDir = loadStringEnv(acmeCacheDirEnv, config.TLS.ACME.CacheDir)
+	config.TLS.ACME.HTTPAddr = loadStringEnv(acmeHTTPAddrEnv, config.TLS.ACME.HTTPAddr)
+	config.TLS.ACME.DirectoryURL = loadStringEnv(acmeDirectoryURLEnv, config.TLS.ACME.DirectoryURL)
+	config.Auth.SessionTTLHours = loadPositiveIntEnv(sessionTTLEnv, config.Auth.SessionTTLHours, logger)
+
+	config.Backup.Dir = loadStringEnv(backupDirEnv, config.Backup.Dir)
+	config.Backup.IntervalHours = loadPositiveIntEnv(backupIntervalEnv, config.Backup.IntervalHours, logger)
+	config.Backup.Keep = loadPositiveIntEnv(backupKeepEnv, config.Backup.Keep, logger)
+
+	config.Telemetry.Enabled = loadBoolEnv(otelEnabledEnv, config.Telemetry.Enabled, logger)
+	config.Telemetry.ServiceName = loadStringEnv(otelServiceNameEnv, config.Telemetry.ServiceName)
+
+	config.ErrorReporting.SentryDSN = loadStringEnv(sentryDSNEnv, config.ErrorReporting.SentryDSN)
+	config.ErrorReporting.Environment = loadStringEnv(sentryEnvironmentEnv, config.ErrorReporting.Environment)
+}
+
+// applyFlags overrides config with the flags given on the command line, so
+// flag defaults do not mask the config file or environment.
+func applyFlags(config *serverConfig, flags *flag.FlagSet) {
+	if flags == nil {
+		return
+	}
+	flags.Visit(func(f *flag.Flag) {
+		value := f.Value.String()
+		switch f.Name {
+		case "addr":
+			config.Addr = value
+		case "socket-mode":
+			config.SocketMode = value
+		case "base-path":
+			config.BasePath = value
+		case "admin-addr":
+			config.AdminAddr = value
+		case "log-level":
+			config.LogLevel = value
+		case "log-format":
+			config.LogFormat = value
+		case "db-driver":
+			config.Database.Driver = value
+		case "dsn":
+			config.Database.DSN = value
+		case "auto-migrate":
+			config.Database.AutoMigrate, _ = strconv.ParseBool(value)
+		case "tls-cert":
+			config.TLS.CertFile = value
+		case "tls-key":
+			config.TLS.KeyFile = value
+		case "acme-domains":
+			config.TLS.ACME.Domains = strings.Split(value, ",")
+		}
+	})
+}
+
+func (c serverConfig) validate() error {
+	if _, err := c.logLevel(); err != nil {
+		return err
+	}
+	if format := strings.ToLower(strings.TrimSpace(c.LogFormat)); format != logFormatText && format != logFormatJSON {
+		return fmt.Errorf("logFormat must be %s or %s, got %q", logFormatText, logFormatJSON, c.LogFormat)
+	}
+	if c.Database.Driver != driverSQLite && c.Database.Driver != driverMySQL {
+		return fmt.Errorf("unsupported database driver %q (use %s or %s)", c.Database.Driver, driverSQLite, driverMySQL)
+	}
+
+	positive := []struct {
+		name  string
+		value int
+	}{
+		{"maxResponseBodyBytes", c.MaxResponseBodyBytes},
+		{"shutdownTimeoutSeconds", c.ShutdownTimeoutSeconds},
+		{"database.sqlite.busyTimeoutMs", c.Database.SQLite.BusyTimeoutMs},
+		{"worker.maxConcurrentChecks", c.Worker.MaxConcurrentChecks},
+		{"worker.http.maxIdleConnsPerHost", c.Worker.HTTP.MaxIdleConnsPerHost},
+		{"worker.http.idleConnTimeoutSeconds", c.Worker.HTTP.IdleConnTimeoutSeconds},
+		{"worker.rendering.timeoutSeconds", c.Worker.Rendering.TimeoutSeconds},
+		{"worker.rendering.maxConcurrent", c.Worker.Rendering.MaxConcurrent},
+		{"auth.sessionTtlHours", c.Auth.SessionTTLHours},
+		{"backup.intervalHours", c.Backup.IntervalHours},
+		{"backup.keep", c.Backup.Keep},
+	}
+	for _, setting := range positive {
+		if setting.value <= 0 {
+			return fmt.Errorf("%s must be a positive integer", setting.name)
+		}
+	}
+
+	if c.Worker.DNSServer != "" {
+		if _, err := worker.NormalizeDNSServer(c.Worker.DNSServer); err != nil {
+			return fmt.Errorf("worker.dnsServer: %w", err)
+		}
+	}
+	if c.Proxy.URL != "" {
+		if _, err := worker.ParseProxyURL(c.Proxy.URL); err != nil {
+			return fmt.Errorf("proxy.url: %w", err)
+		}
+	}
+	if (c.TLS.CertFile == "") != (c.TLS.KeyFile == "") {
+		return errors.New("tls.certFile and tls.keyFile must be set together")
+	}
+	if _, err := c.socketMode(); err != nil {
+		return err
+	}
+	if strings.ContainsAny(c.BasePath, "?#{} \t") {
+		return fmt.Errorf("basePath must be a plain URL path such as /goanna, got %q", c.BasePath)
+	}
+	if err := c.TLS.ACME.validate(); err != nil {
+		return err
+	}
+	if adminAddr := strings.TrimSpace(c.AdminAddr); adminAddr != "" {
+		if _, _, err := net.SplitHostPort(adminAddr); err != nil {
+			return fmt.Errorf("adminAddr must be a host:port such as 127.0.0.1:6060, got %q", c.AdminAddr)
+		}
+		if adminAddr == strings.TrimSpace(c.Addr) {
+			return errors.New("adminAddr must differ from addr")
+		}
+	}
+	if _, ok := unixSocketPath(c.Addr); ok && len(c.TLS.ACME.domains()) > 0 {
+		return errors.New("tls.acme.domains needs a TCP addr, not a unix socket")
+	}
+	if c.TLS.CertFile != "" && len(c.TLS.ACME.domains()) > 0 {
+		return errors.New("tls.certFile and tls.acme.domains cannot be used together")
+	}
+	return nil
+}
+
+// logLevel parses LogLevel: debug, info, warn or error.
+func (c serverConfig) logLevel() (slog.Level, error) {
+	var level slog.Level
+	if err := level.UnmarshalText([]byte(strings.TrimSpace(c.LogLevel))); err != nil {
+		return level, fmt.Errorf("logLevel must be debug, info, warn or error, got %q", c.LogLevel)
+	}
+	return level, nil
+}
+
+// logHandler returns a handler writing LogFormat records to w at level.
+func (c serverConfig) logHandler(w io.Writer, level slog.Leveler) slog.Handler {
+	options := &slog.HandlerOptions{Level: level}
+	if strings.EqualFold(strings.TrimSpace(c.LogFormat), logFormatJSON) {
+		return slog.NewJSONHandler(w, options)
+	}
+	return slog.NewTextHandler(w, options)
+}
+
+// shutdownTimeout is how long shutdown waits for requests and checks.
+func (c serverConfig) shutdownTimeout() time.Duration {
+	return time.Duration(c.ShutdownTimeoutSeconds) * time.Second
+}
+
+// sqliteOptions returns the SQLite tuning. It is ignored for other drivers.
+func (c serverConfig) sqliteOptions() sqliteOptions {
+	return sqliteOptions{
+		JournalMode:  strings.ToUpper(strings.TrimSpace(c.Database.SQLite.JournalMode)),
+		BusyTimeout:  time.Duration(c.Database.SQLite.BusyTimeoutMs) * time.Millisecond,
+		SingleWriter: c.Database.SQLite.SingleWriter,
+	}
+}
+
+// networkGuard builds the SSRF network policy. Protection is off unless
+// enabled; the blocklist defaults to private, loopback and link-local ranges
+// and the allowlist carves exceptions out of it.
+func (c serverConfig) networkGuard() (*worker.NetworkGuard, error) {
+	if !c.Network.SSRFProtection {
+		return nil, nil
+	}
+	return worker.NewNetworkGuard(c.Network.BlockedNetworks, c.Network.AllowedNetworks)
+}
+
+// workerConfig returns the worker settings; the shared Changes, Events and
+// Liveness are left for the caller.
+func (c serverConfig) workerConfig(guard *worker.NetworkGuard) worker.Config {
+	var dnsServer string
+	if c.Worker.DNSServer != "" {
+		dnsServer, _ = worker.NormalizeDNSServer(c.Worker.DNSServer)
+	}
+	return worker.Config{
+		MaxResponseBodyBytes: c.MaxResponseBodyBytes,
+		MaxConcurrentChecks:  c.Worker.MaxConcurrentChecks,
+		MaxIdleConnsPerHost:  c.Worker.HTTP.MaxIdleConnsPerHost,
+		IdleConnTimeout:      time.Duration(c.Worker.HTTP.IdleConnTimeoutSeconds) * time.Second,
+		DisableKeepAlives:    c.Worker.HTTP.DisableKeepAlives,
+		ForceHTTP2:           c.Worker.HTTP.ForceHTTP2,
+		DNSServer:            dnsServer,
+		NetworkGuard:         guard,
+		Proxy:                worker.ProxySettings{URL: c.Proxy.URL, Bypass: c.Proxy.Bypass},
+		InstanceID:           strings.TrimSpace(c.Worker.InstanceID),
+		RenderingEnabled:     c.Worker.Rendering.Enabled,
+		BrowserPath:          strings.TrimSpace(c.Worker.Rendering.ChromiumPath),
+		RenderTimeout:        time.Duration(c.Worker.Rendering.TimeoutSeconds) * time.Second,
+		MaxConcurrentRenders: c.Worker.Rendering.MaxConcurrent,
+	}
+}
+
+func loadStringEnv(key string, fallback string) string {
+	if raw := strings.TrimSpace(os.Getenv(key)); raw != "" {
+		return raw
+	}
+	return fallback
+}
+
+func loadPositiveIntEnv(key string, fallback int, logger *slog.Logger) int {
+	raw := strings.TrimSpace(os.Getenv(key))
+	if raw == "" {
+		return fallback
+	}
+
+	parsed, err := strconv.Atoi(raw)
+	if err != nil || parsed <= 0 {
+		logger.Warn(
+			"invalid environment override, using default",
+			"key",
+			key,
+			"value",
+			raw,
+			"default",
+			fallback,
+		)
+		return fallback
+	}
+
+	return parsed
+}
+
+func loadDNSServerEnv(key string, fallback string, logger *slog.Logger) string {
+	raw := strings.TrimSpace(os.Getenv(key))
+	if raw == "" {
+		return fallback
+	}
+
+	server, err := worker.NormalizeDNSServer(raw)
+	if err != nil {
+		logger.Warn(
+			"invalid environment override, using default",
+			"key",
+			key,
+			"value",
+			raw,
+		)
+		return fallback
+	}
+
+	return server
+}
+
+func loadBoolEnv(key string, fallback bool, logger *slog.Logger) bool {
+	raw := strings.TrimSpace(os.Getenv(key))
+	if raw == "" {
+		return fallback
+	}
+
+	parsed, err := strconv.ParseBool(raw)
+	if err != nil {
+		logger.Warn(
+			"invalid environment override, using default",
+			"key",
+			key,
+			"value",
+			raw,
+			"default",
+			fallback,
+		)
+		return fallback
+	}
+
+	return parsed
+}
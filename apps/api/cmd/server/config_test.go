@@ -0,0 +1,262 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"io"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+)
+
+func writeConfigFile(t *testing.T, name string, content string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), name)
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatalf("write config file: %v", err)
+	}
+	return path
+}
+
+func TestLoadServerConfigFiles(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+
+	yamlPath := writeConfigFile(t, "goanna.yaml", `
+addr: ":9090"
+database:
+  dsn: "file:/srv/goanna.db?_fk=1"
+worker:
+  maxConcurrentChecks: 8
+  http:
+    forceHttp2: false
+proxy:
+  url: "http://proxy.internal:3128"
+auth:
+  sessionTtlHours: 12
+`)
+	tomlPath := writeConfigFile(t, "goanna.toml", `
+addr = ":9090"
+
+[database]
+dsn = "file:/srv/goanna.db?_fk=1"
+
+[worker]
+maxConcurrentChecks = 8
+
+[worker.http]
+forceHttp2 = false
+
+[proxy]
+url = "http://proxy.internal:3128"
+
+[auth]
+sessionTtlHours = 12
+`)
+
+	for _, path := range []string{yamlPath, tomlPath} {
+		config, err := loadServerConfig(path, nil, logger)
+		if err != nil {
+			t.Fatalf("%s: %v", filepath.Base(path), err)
+		}
+		if config.Addr != ":9090" || config.Database.DSN != "file:/srv/goanna.db?_fk=1" || config.Worker.MaxConcurrentChecks != 8 {
+			t.Fatalf("%s: expected file values, got %+v", filepath.Base(path), config)
+		}
+		if config.Worker.HTTP.ForceHTTP2 || config.Proxy.URL != "http://proxy.internal:3128" || config.Auth.SessionTTLHours != 12 {
+			t.Fatalf("%s: expected nested file values, got %+v", filepath.Base(path), config)
+		}
+		// Keys the file leaves out keep their defaults.
+		if config.Database.Driver != driverSQLite || !config.Database.AutoMigrate || config.Worker.HTTP.MaxIdleConnsPerHost <= 0 {
+			t.Fatalf("%s: expected defaults for unset keys, got %+v", filepath.Base(path), config)
+		}
+	}
+}
+
+func TestLoadServerConfigPrecedence(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	path := writeConfigFile(t, "goanna.yaml", `
+addr: ":9090"
+maxResponseBodyBytes: 1024
+worker:
+  maxConcurrentChecks: 8
+`)
+	t.Setenv(maxConcurrentChecksEnv, "16")
+	t.Setenv(maxResponseBodyBytesEnv, "not-a-number")
+
+	flags := flag.NewFlagSet("test", flag.ContinueOnError)
+	flags.String("addr", ":8080", "")
+	flags.String("dsn", defaultDSN, "")
+	if err := flags.Parse([]string{"-addr", ":7070"}); err != nil {
+		t.Fatalf("parse flags: %v", err)
+	}
+
+	config, err := loadServerConfig(path, flags, logger)
+	if err != nil {
+		t.Fatalf("load config: %v", err)
+	}
+	if config.Addr != ":7070" {
+		t.Fatalf("expected the flag to override the file, got %q", config.Addr)
+	}
+	if config.Database.DSN != defaultDSN {
+		t.Fatalf("expected an unset flag to keep the default, got %q", config.Database.DSN)
+	}
+	if config.Worker.MaxConcurrentChecks != 16 {
+		t.Fatalf("expected the environment to override the file, got %d", config.Worker.MaxConcurrentChecks)
+	}
+	if config.MaxResponseBodyBytes != 1024 {
+		t.Fatalf("expected an invalid environment value to keep the file value, got %d", config.MaxResponseBodyBytes)
+	}
+	if got := config.workerConfig(nil).RenderTimeout; got != 30*time.Second {
+		t.Fatalf("expected the default render timeout, got %s", got)
+	}
+}
+
+func TestLoadServerConfigEnvironment(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	path := writeConfigFile(t, "goanna.toml", "addr = \":9090\"\nlogLevel = \"warn\"\n")
+	t.Setenv(configFileEnv, path)
+	t.Setenv(addrEnv, ":6060")
+	t.Setenv(dbDriverEnv, driverMySQL)
+	t.Setenv(dsnEnv, "goanna:secret@tcp(db:3306)/goanna")
+	t.Setenv(autoMigrateEnv, "false")
+	t.Setenv(otelEnabledEnv, "true")
+	t.Setenv(sentryDSNEnv, "https://key@sentry.example.com/1")
+
+	config, err := loadServerConfig("", nil, logger)
+	if err != nil {
+		t.Fatalf("load config: %v", err)
+	}
+	if config.Addr != ":6060" || config.Database.Driver != driverMySQL || config.Database.DSN != "goanna:secret@tcp(db:3306)/goanna" || config.Database.AutoMigrate {
+		t.Fatalf("expected the environment to set the startup flags, got %+v", config)
+	}
+	if level, err := config.logLevel(); err != nil || level != slog.LevelWarn {
+		t.Fatalf("expected GOANNA_CONFIG to be read, got level %v (%v)", level, err)
+	}
+	if !config.Telemetry.Enabled || config.Telemetry.ServiceName != "goanna" {
+		t.Fatalf("expected telemetry to be enabled under the default service name, got %+v", config.Telemetry)
+	}
+	if config.ErrorReporting.SentryDSN != "https://key@sentry.example.com/1" {
+		t.Fatalf("expected the sentry dsn from the environment, got %+v", config.ErrorReporting)
+	}
+
+	t.Setenv(logLevelEnv, "verbose")
+	if _, err := loadServerConfig("", nil, logger); err == nil || !strings.Contains(err.Error(), "logLevel") {
+		t.Fatalf("expected an invalid log level to be rejected, got %v", err)
+	}
+}
+
+func TestRestoreSource(t *testing.T) {
+	if from := restoreSource(""); from != "" {
+		t.Fatalf("expected no restore by default, got %q", from)
+	}
+	t.Setenv(restoreFromEnv, "/backups/env.db")
+	if from := restoreSource(""); from != "/backups/env.db" {
+		t.Fatalf("expected GOANNA_RESTORE_FROM to be used, got %q", from)
+	}
+	if from := restoreSource("/backups/flag.db"); from != "/backups/flag.db" {
+		t.Fatalf("expected the flag to override the environment, got %q", from)
+	}
+}
+
+func TestLogHandlerFormats(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	t.Setenv(logFormatEnv, "JSON")
+	config, err := loadServerConfig("", nil, logger)
+	if err != nil {
+		t.Fatalf("load config: %v", err)
+	}
+
+	var out bytes.Buffer
+	slog.New(config.logHandler(&out, slog.LevelInfo)).With("component", "worker").Info("check failed", "monitor_id", 4)
+	var record map[string]any
+	if err := json.Unmarshal(out.Bytes(), &record); err != nil {
+		t.Fatalf("expected a JSON log line, got %q (%v)", out.String(), err)
+	}
+	if record["msg"] != "check failed" || record["component"] != "worker" || record["monitor_id"] != float64(4) {
+		t.Fatalf("expected the record's attributes, got %v", record)
+	}
+
+	out.Reset()
+	config.LogFormat = logFormatText
+	slog.New(config.logHandler(&out, slog.LevelWarn)).Info("hidden")
+	slog.New(config.logHandler(&out, slog.LevelWarn)).Warn("shown")
+	if got := out.String(); strings.Contains(got, "hidden") || !strings.Contains(got, "level=WARN msg=shown") {
+		t.Fatalf("expected a text line at the configured level, got %q", got)
+	}
+}
+
+func TestLoadServerConfigACME(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	path := writeConfigFile(t, "goanna.yaml", `
+tls:
+  acme:
+    domains: [old.example.com]
+    email: ops@example.com
+`)
+	t.Setenv(acmeCacheDirEnv, "/var/lib/goanna/acme")
+
+	flags := flag.NewFlagSet("test", flag.ContinueOnError)
+	flags.String("acme-domains", "", "")
+	if err := flags.Parse([]string{"-acme-domains", "Goanna.example.com, status.example.com,"}); err != nil {
+		t.Fatalf("parse flags: %v", err)
+	}
+
+	config, err := loadServerConfig(path, flags, logger)
+	if err != nil {
+		t.Fatalf("load config: %v", err)
+	}
+	acme := config.TLS.ACME
+	if got := acme.domains(); !slices.Equal(got, []string{"goanna.example.com", "status.example.com"}) {
+		t.Fatalf("expected the flag to set the domains, got %v", got)
+	}
+	if acme.Email != "ops@example.com" || acme.CacheDir != "/var/lib/goanna/acme" || acme.HTTPAddr != ":80" {
+		t.Fatalf("expected the file, environment and defaults to apply, got %+v", acme)
+	}
+
+	policy := acme.manager().HostPolicy
+	if err := policy(t.Context(), "status.example.com"); err != nil {
+		t.Fatalf("expected a configured domain to be allowed, got %v", err)
+	}
+	if err := policy(t.Context(), "old.example.com"); err == nil {
+		t.Fatal("expected a domain outside the list to be refused")
+	}
+}
+
+func TestLoadServerConfigRejectsInvalidFiles(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	cases := map[string]struct {
+		name    string
+		content string
+		want    string
+	}{
+		"unknown yaml key":   {"goanna.yaml", "adress: \":9090\"\n", "adress"},
+		"unknown toml key":   {"goanna.toml", "[worker]\nmaxChecks = 2\n", "worker.maxChecks"},
+		"unsupported type":   {"goanna.json", "{}", "must end in"},
+		"unknown driver":     {"goanna.yaml", "database:\n  driver: postgres\n", "unsupported database driver"},
+		"non-positive":       {"goanna.yaml", "worker:\n  maxConcurrentChecks: 0\n", "worker.maxConcurrentChecks"},
+		"invalid proxy":      {"goanna.yaml", "proxy:\n  url: ftp://proxy\n", "proxy.url"},
+		"incomplete tls":     {"goanna.yaml", "tls:\n  certFile: cert.pem\n", "tls.certFile"},
+		"invalid dnsServer":  {"goanna.yaml", "worker:\n  dnsServer: \"1.1.1.1:\"\n", "worker.dnsServer"},
+		"acme and certFile":  {"goanna.yaml", "tls:\n  certFile: cert.pem\n  keyFile: key.pem\n  acme:\n    domains: [goanna.example.com]\n", "cannot be used together"},
+		"wildcard domain":    {"goanna.yaml", "tls:\n  acme:\n    domains: [\"*.example.com\"]\n", "tls.acme.domains"},
+		"base path":          {"goanna.yaml", "basePath: /goanna?x=1\n", "basePath"},
+		"socket mode":        {"goanna.yaml", "addr: unix:/run/goanna.sock\nsocketMode: \"0999\"\n", "socketMode"},
+		"acme on a socket":   {"goanna.yaml", "addr: unix:/run/goanna.sock\ntls:\n  acme:\n    domains: [goanna.example.com]\n", "unix socket"},
+		"log format":         {"goanna.yaml", "logFormat: logfmt\n", "logFormat"},
+		"admin without port": {"goanna.yaml", "adminAddr: localhost\n", "adminAddr"},
+		"admin on api addr":  {"goanna.yaml", "addr: \":8080\"\nadminAddr: \":8080\"\n", "adminAddr"},
+		"plain directoryUrl": {"goanna.yaml", "tls:\n  acme:\n    domains: [goanna.example.com]\n    directoryUrl: http://ca.internal/dir\n", "tls.acme.directoryUrl"},
+	}
+
+	for name, tc := range cases {
+		path := writeConfigFile(t, tc.name, tc.content)
+		_, err := loadServerConfig(path, nil, logger)
+		if err == nil || !strings.Contains(err.Error(), tc.want) {
+			t.Fatalf("%s: expected an error mentioning %q, got %v", name, tc.want, err)
+		}
+	}
+}
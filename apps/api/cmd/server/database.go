@@ -0,0 +1,131 @@
+package main
+
+import (
+	"database/sql"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"goanna/apps/api/ent"
+	"goanna/apps/api/internal/telemetry"
+
+	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
+	"github.com/go-sql-driver/mysql"
+	_ "github.com/mattn/go-sqlite3"
+)
+
+// Database drivers accepted by -db-driver.
+const (
+	driverSQLite = dialect.SQLite
+	driverMySQL  = dialect.MySQL
+)
+
+// SQLite defaults keeping API requests and the worker from failing with
+// "database is locked" when they write at the same time.
+const (
+	defaultSQLiteJournalMode = "WAL"
+	defaultSQLiteBusyTimeout = 5 * time.Second
+)
+
+// sqliteOptions tunes SQLite connections. Options already present in the DSN
+// win over these.
+type sqliteOptions struct {
+	// JournalMode is the journal_mode pragma; WAL lets reads continue while a
+	// write is in progress.
+	JournalMode string
+	// BusyTimeout is how long a statement waits for a lock before failing.
+	BusyTimeout time.Duration
+	// SingleWriter funnels every statement through one connection, for
+	// filesystems where SQLite locking is unreliable.
+	SingleWriter bool
+}
+
+// openDatabase opens an ent client for driver, adjusting dsn to what the
+// driver needs, and returns it with its connection pool. MySQL and MariaDB
+// share the mysql driver.
+func openDatabase(driver string, dsn string, sqlite sqliteOptions) (*ent.Client, *sql.DB, error) {
+	var drv *entsql.Driver
+	var err error
+	switch driver {
+	case driverSQLite:
+		drv, err = entsql.Open(dialect.SQLite, normalizeSQLiteDSN(dsn, sqlite))
+		if err != nil {
+			return nil, nil, err
+		}
+		if sqlite.SingleWriter {
+			drv.DB().SetMaxOpenConns(1)
+		}
+	case driverMySQL:
+		normalized, err := normalizeMySQLDSN(dsn)
+		if err != nil {
+			return nil, nil, err
+		}
+		drv, err = entsql.Open(dialect.MySQL, normalized)
+		if err != nil {
+			return nil, nil, err
+		}
+	default:
+		return nil, nil, fmt.Errorf("unsupported database driver %q, use %s or %s", driver, driverSQLite, driverMySQL)
+	}
+
+	return ent.NewClient(ent.Driver(telemetry.Driver(drv))), drv.DB(), nil
+}
+
+// sqliteFilePath returns the database file a SQLite DSN points at, failing
+// for in-memory databases.
+func sqliteFilePath(dsn string) (string, error) {
+	path, rawQuery, _ := strings.Cut(strings.TrimPrefix(dsn, "file:"), "?")
+	params, _ := url.ParseQuery(rawQuery)
+	if path == "" || path == ":memory:" || params.Get("mode") == "memory" {
+		return "", fmt.Errorf("dsn %q is not a SQLite database file", dsn)
+	}
+
+	return path, nil
+}
+
+// normalizeSQLiteDSN adds the journal mode and busy timeout from options, and
+// makes transactions take the write lock when they begin. A deferred
+// transaction that later tries to write fails at once if another connection
+// is writing, no matter the busy timeout.
+func normalizeSQLiteDSN(dsn string, options sqliteOptions) string {
+	base, rawQuery, _ := strings.Cut(dsn, "?")
+	params, err := url.ParseQuery(rawQuery)
+	if err != nil {
+		// Leave DSNs we cannot parse to the driver to report.
+		return dsn
+	}
+
+	setDefault := func(value string, keys ...string) {
+		if value == "" {
+			return
+		}
+		for _, key := range keys {
+			if params.Has(key) {
+				return
+			}
+		}
+		params.Set(keys[0], value)
+	}
+	setDefault(options.JournalMode, "_journal_mode", "_journal")
+	if options.BusyTimeout > 0 {
+		setDefault(strconv.FormatInt(options.BusyTimeout.Milliseconds(), 10), "_busy_timeout", "_timeout")
+	}
+	setDefault("immediate", "_txlock")
+
+	return base + "?" + params.Encode()
+}
+
+// normalizeMySQLDSN enables parseTime, without which time columns cannot be
+// scanned into ent's time.Time fields.
+func normalizeMySQLDSN(dsn string) (string, error) {
+	config, err := mysql.ParseDSN(dsn)
+	if err != nil {
+		return "", fmt.Errorf("invalid mysql dsn: %w", err)
+	}
+	config.ParseTime = true
+
+	return config.FormatDSN(), nil
+}
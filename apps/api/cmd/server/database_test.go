@@ -0,0 +1,200 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"goanna/apps/api/ent/monitor"
+	"goanna/apps/api/internal/migrations"
+)
+
+// mysqlTestDSNEnv names an empty MySQL or MariaDB database to run the
+// migration test against. CI sets it; locally the test is skipped.
+const mysqlTestDSNEnv = "GOANNA_TEST_MYSQL_DSN"
+
+func TestNormalizeSQLiteDSN(t *testing.T) {
+	options := sqliteOptions{JournalMode: "WAL", BusyTimeout: 2 * time.Second}
+
+	normalized := normalizeSQLiteDSN("file:./data/goanna.db?_fk=1", options)
+	base, rawQuery, _ := strings.Cut(normalized, "?")
+	params, err := url.ParseQuery(rawQuery)
+	if err != nil || base != "file:./data/goanna.db" {
+		t.Fatalf("expected the file path to be kept, got %q (%v)", normalized, err)
+	}
+	if params.Get("_fk") != "1" || params.Get("_journal_mode") != "WAL" || params.Get("_busy_timeout") != "2000" || params.Get("_txlock") != "immediate" {
+		t.Fatalf("expected tuning defaults to be added, got %q", normalized)
+	}
+
+	normalized = normalizeSQLiteDSN("file:goanna.db?_journal=DELETE&_timeout=100&_txlock=deferred", options)
+	if strings.Contains(normalized, "_journal_mode") || strings.Contains(normalized, "_busy_timeout") || !strings.Contains(normalized, "_txlock=deferred") {
+		t.Fatalf("expected options in the DSN to win, got %q", normalized)
+	}
+}
+
+func TestSQLiteFilePath(t *testing.T) {
+	if path, err := sqliteFilePath("file:./data/goanna.db?_fk=1"); err != nil || path != "./data/goanna.db" {
+		t.Fatalf("expected the database file, got %q (%v)", path, err)
+	}
+	for _, dsn := range []string{"file::memory:?cache=shared", "file:goanna?mode=memory", ""} {
+		if _, err := sqliteFilePath(dsn); err == nil {
+			t.Fatalf("%q: expected an error for a database without a file", dsn)
+		}
+	}
+}
+
+func TestSQLiteConcurrentWrites(t *testing.T) {
+	for _, singleWriter := range []bool{false, true} {
+		t.Run(fmt.Sprintf("singleWriter=%t", singleWriter), func(t *testing.T) {
+			dsn := "file:" + filepath.Join(t.TempDir(), "goanna.db") + "?_fk=1"
+			client, _, err := openDatabase(driverSQLite, dsn, sqliteOptions{
+				JournalMode:  defaultSQLiteJournalMode,
+				BusyTimeout:  defaultSQLiteBusyTimeout,
+				SingleWriter: singleWriter,
+			})
+			if err != nil {
+				t.Fatalf("expected database to open: %v", err)
+			}
+			defer client.Close()
+			if err := client.Schema.Create(t.Context()); err != nil {
+				t.Fatalf("expected migrations to apply: %v", err)
+			}
+
+			// Mix transactions, like API handlers use, with plain writes,
+			// like the worker's, which used to fail with "database is locked".
+			var wg sync.WaitGroup
+			errs := make(chan error, 200)
+			for i := range 200 {
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+					if i%2 == 0 {
+						_, err := client.Monitor.Create().SetURL("https://example.com").SetCron("* * * * *").Save(t.Context())
+						errs <- err
+						return
+					}
+					tx, err := client.Tx(t.Context())
+					if err != nil {
+						errs <- err
+						return
+					}
+					if _, err := tx.Monitor.Query().Count(t.Context()); err != nil {
+						_ = tx.Rollback()
+						errs <- err
+						return
+					}
+					if _, err := tx.Monitor.Create().SetURL("https://example.com").SetCron("* * * * *").Save(t.Context()); err != nil {
+						_ = tx.Rollback()
+						errs <- err
+						return
+					}
+					errs <- tx.Commit()
+				}()
+			}
+			wg.Wait()
+			close(errs)
+			for err := range errs {
+				if err != nil {
+					t.Fatalf("expected concurrent writes to succeed: %v", err)
+				}
+			}
+			if count, _ := client.Monitor.Query().Count(t.Context()); count != 200 {
+				t.Fatalf("expected 200 monitors, got %d", count)
+			}
+		})
+	}
+}
+
+func TestNormalizeMySQLDSN(t *testing.T) {
+	normalized, err := normalizeMySQLDSN("goanna:secret@tcp(db:3306)/goanna?charset=utf8mb4")
+	if err != nil {
+		t.Fatalf("expected DSN to parse: %v", err)
+	}
+	if !strings.Contains(normalized, "parseTime=true") || !strings.Contains(normalized, "charset=utf8mb4") {
+		t.Fatalf("expected parseTime added and charset kept, got %q", normalized)
+	}
+
+	if _, err := normalizeMySQLDSN("not a dsn"); err == nil {
+		t.Fatal("expected an invalid DSN to fail")
+	}
+}
+
+func TestOpenDatabaseRejectsUnknownDriver(t *testing.T) {
+	if _, _, err := openDatabase("postgres", "postgres://localhost/goanna", sqliteOptions{}); err == nil {
+		t.Fatal("expected an unsupported driver to fail")
+	}
+}
+
+func TestMySQLMigrations(t *testing.T) {
+	dsn := os.Getenv(mysqlTestDSNEnv)
+	if dsn == "" {
+		t.Skipf("%s is not set", mysqlTestDSNEnv)
+	}
+
+	client, db, err := openDatabase(driverMySQL, dsn, sqliteOptions{})
+	if err != nil {
+		t.Fatalf("expected database to open: %v", err)
+	}
+	defer client.Close()
+
+	migrator, err := migrations.New(db, driverMySQL)
+	if err != nil {
+		t.Fatalf("expected migrations to load: %v", err)
+	}
+	if _, err := migrator.Up(t.Context(), 0); err != nil {
+		t.Fatalf("expected migrations to apply: %v", err)
+	}
+
+	// The versioned migrations must produce the schema Ent expects as MySQL
+	// reports it back.
+	var diff bytes.Buffer
+	if err := client.Schema.WriteTo(t.Context(), &diff); err != nil {
+		t.Fatalf("expected schema diff: %v", err)
+	}
+	if changes := bytes.TrimSpace(diff.Bytes()); len(changes) > 0 {
+		t.Fatalf("expected migrations to produce the Ent schema, missing:\n%s", changes)
+	}
+
+	longURL := "https://example.com/?q=" + strings.Repeat("a", 2048)
+	created, err := client.Monitor.Create().
+		SetURL(longURL).
+		SetCron("*/5 * * * *").
+		SetHeaders(map[string]string{"Accept": "application/json"}).
+		Save(t.Context())
+	if err != nil {
+		t.Fatalf("expected monitor to save: %v", err)
+	}
+	defer client.Monitor.DeleteOneID(created.ID).ExecX(t.Context())
+
+	snapshot := []byte(strings.Repeat("b", 128*1024))
+	check, err := client.CheckResult.Create().
+		SetMonitor(created).
+		SetStatus("ok").
+		SetSelectionValue(strings.Repeat("c", 4096)).
+		SetBodySnapshot(snapshot).
+		Save(t.Context())
+	if err != nil {
+		t.Fatalf("expected check to save: %v", err)
+	}
+	defer client.CheckResult.DeleteOneID(check.ID).ExecX(t.Context())
+
+	loaded, err := client.Monitor.Query().
+		Where(monitor.IDEQ(created.ID)).
+		WithCheckResults().
+		Only(t.Context())
+	if err != nil {
+		t.Fatalf("expected monitor to load: %v", err)
+	}
+	if loaded.URL != longURL || loaded.CreatedAt.IsZero() || len(loaded.Edges.CheckResults) != 1 {
+		t.Fatalf("expected monitor to round-trip, got %+v", loaded)
+	}
+	if stored := loaded.Edges.CheckResults[0].BodySnapshot; stored == nil || len(*stored) != len(snapshot) {
+		t.Fatalf("expected %d snapshot bytes to round-trip", len(snapshot))
+	}
+}
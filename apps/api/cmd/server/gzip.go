@@ -0,0 +1,224 @@
+package main
+
+import (
+	"bufio"
+	"compress/gzip"
+	"errors"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// gzipMinBytes is the smallest response worth compressing; below it the gzip
+// header and CPU cost outweigh the savings.
+const gzipMinBytes = 1024
+
+var gzipWriters = sync.Pool{
+	New: func() any {
+		writer, _ := gzip.NewWriterLevel(nil, gzip.DefaultCompression)
+		return writer
+	},
+}
+
+// withGzip compresses responses of at least gzipMinBytes for clients that
+// accept gzip. Responses are buffered until that size is reached, so small
+// bodies go out unchanged. WebSocket upgrades and already encoded or
+// incompressible responses pass through.
+func withGzip(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodHead || r.Header.Get("Upgrade") != "" {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		w.Header().Add("Vary", "Accept-Encoding")
+		if !acceptsGzip(r.Header.Get("Accept-Encoding")) {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		responseWriter := &gzipResponseWriter{ResponseWriter: w}
+		defer responseWriter.Close()
+		next.ServeHTTP(responseWriter, r)
+	})
+}
+
+// acceptsGzip reports whether an Accept-Encoding header allows gzip.
+func acceptsGzip(header string) bool {
+	for _, part := range strings.Split(header, ",") {
+		name, params, _ := strings.Cut(strings.TrimSpace(part), ";")
+		name = strings.ToLower(strings.TrimSpace(name))
+		if name != "gzip" && name != "*" {
+			continue
+		}
+
+		quality := 1.0
+		for _, param := range strings.Split(params, ";") {
+			key, value, ok := strings.Cut(strings.TrimSpace(param), "=")
+			if ok && strings.EqualFold(strings.TrimSpace(key), "q") {
+				if parsed, err := strconv.ParseFloat(strings.TrimSpace(value), 64); err == nil {
+					quality = parsed
+				}
+			}
+		}
+		return quality > 0
+	}
+	return false
+}
+
+// gzipResponseWriter holds back the status and body until it knows whether
+// the response is large and compressible enough to gzip.
+type gzipResponseWriter struct {
+	http.ResponseWriter
+	statusCode  int
+	buffer      []byte
+	gzipWriter  *gzip.Writer
+	passthrough bool
+	hijacked    bool
+}
+
+func (w *gzipResponseWriter) WriteHeader(statusCode int) {
+	if w.statusCode != 0 || w.passthrough || w.gzipWriter != nil {
+		return
+	}
+	if statusCode >= 100 && statusCode < 200 {
+		w.ResponseWriter.WriteHeader(statusCode)
+		return
+	}
+
+	w.statusCode = statusCode
+	if !bodyAllowed(statusCode) || !compressible(w.Header()) {
+		w.startPassthrough()
+	}
+}
+
+func (w *gzipResponseWriter) Write(body []byte) (int, error) {
+	if w.statusCode == 0 {
+		w.WriteHeader(http.StatusOK)
+	}
+	if w.passthrough {
+		return w.ResponseWriter.Write(body)
+	}
+	if w.gzipWriter != nil {
+		return w.gzipWriter.Write(body)
+	}
+
+	w.buffer = append(w.buffer, body...)
+	if len(w.buffer) < gzipMinBytes {
+		return len(body), nil
+	}
+
+	// Sniff the type now, as net/http would otherwise sniff the
+	// compressed bytes.
+	if w.Header().Get("Content-Type") == "" {
+		w.Header().Set("Content-Type", http.DetectContentType(w.buffer))
+	}
+	if !compressible(w.Header()) {
+		w.startPassthrough()
+		return len(body), nil
+	}
+	if err := w.startGzip(); err != nil {
+		return 0, err
+	}
+	return len(body), nil
+}
+
+// Flush sends what has been buffered so far, compressing it if the response
+// has already reached gzipMinBytes.
+func (w *gzipResponseWriter) Flush() {
+	if w.statusCode == 0 {
+		w.WriteHeader(http.StatusOK)
+	}
+	if w.gzipWriter == nil && !w.passthrough {
+		w.startPassthrough()
+	}
+	if w.gzipWriter != nil {
+		_ = w.gzipWriter.Flush()
+	}
+	_ = http.NewResponseController(w.ResponseWriter).Flush()
+}
+
+func (w *gzipResponseWriter) Hijack() (net.Conn, *bufio.ReadWriter, error) {
+	hijacker, ok := w.ResponseWriter.(http.Hijacker)
+	if !ok {
+		return nil, nil, errors.New("response writer does not support hijacking")
+	}
+
+	w.hijacked = true
+	return hijacker.Hijack()
+}
+
+func (w *gzipResponseWriter) Unwrap() http.ResponseWriter {
+	return w.ResponseWriter
+}
+
+// Close finishes the response: it sends a short buffered body uncompressed or
+// completes the gzip stream.
+func (w *gzipResponseWriter) Close() {
+	if w.hijacked {
+		return
+	}
+	if w.gzipWriter != nil {
+		_ = w.gzipWriter.Close()
+		gzipWriters.Put(w.gzipWriter)
+		w.gzipWriter = nil
+		return
+	}
+	if !w.passthrough {
+		if w.statusCode == 0 {
+			w.statusCode = http.StatusOK
+		}
+		w.startPassthrough()
+	}
+}
+
+func (w *gzipResponseWriter) startPassthrough() {
+	w.passthrough = true
+	w.ResponseWriter.WriteHeader(w.statusCode)
+	if len(w.buffer) > 0 {
+		_, _ = w.ResponseWriter.Write(w.buffer)
+		w.buffer = nil
+	}
+}
+
+func (w *gzipResponseWriter) startGzip() error {
+	header := w.Header()
+	header.Set("Content-Encoding", "gzip")
+	header.Del("Content-Length")
+	w.ResponseWriter.WriteHeader(w.statusCode)
+
+	w.gzipWriter = gzipWriters.Get().(*gzip.Writer)
+	w.gzipWriter.Reset(w.ResponseWriter)
+	buffered := w.buffer
+	w.buffer = nil
+	_, err := w.gzipWriter.Write(buffered)
+	return err
+}
+
+func bodyAllowed(statusCode int) bool {
+	return statusCode != http.StatusNoContent && statusCode != http.StatusNotModified
+}
+
+// compressible reports whether a response with these headers should be
+// gzipped: it is not already encoded and its content type is text-like.
+func compressible(header http.Header) bool {
+	if header.Get("Content-Encoding") != "" {
+		return false
+	}
+
+	contentType := strings.ToLower(header.Get("Content-Type"))
+	if contentType == "" {
+		return true
+	}
+	mediaType, _, _ := strings.Cut(contentType, ";")
+	mediaType = strings.TrimSpace(mediaType)
+	return strings.HasPrefix(mediaType, "text/") ||
+		mediaType == "application/json" ||
+		mediaType == "application/yaml" ||
+		mediaType == "application/javascript" ||
+		mediaType == "image/svg+xml" ||
+		strings.HasSuffix(mediaType, "+json") ||
+		strings.HasSuffix(mediaType, "+xml")
+}
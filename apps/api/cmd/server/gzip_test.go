@@ -0,0 +1,105 @@
+package main
+
+import (
+	"compress/gzip"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestWithGzipCompressesLargeResponses(t *testing.T) {
+	body := `{"items":"` + strings.Repeat("a", 4*gzipMinBytes) + `"}`
+	handler := withGzip(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		_, _ = io.WriteString(w, body[:10])
+		_, _ = io.WriteString(w, body[10:])
+	}))
+
+	req := httptest.NewRequest(http.MethodGet, "/v1/monitors", nil)
+	req.Header.Set("Accept-Encoding", "br, gzip;q=0.8")
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusCreated || rec.Header().Get("Content-Encoding") != "gzip" {
+		t.Fatalf("expected gzipped 201, got %d with encoding %q", rec.Code, rec.Header().Get("Content-Encoding"))
+	}
+	if rec.Header().Get("Vary") != "Accept-Encoding" {
+		t.Fatalf("expected Vary header, got %q", rec.Header().Get("Vary"))
+	}
+
+	reader, err := gzip.NewReader(rec.Body)
+	if err != nil {
+		t.Fatalf("expected gzip body: %v", err)
+	}
+	decoded, err := io.ReadAll(reader)
+	if err != nil || string(decoded) != body {
+		t.Fatalf("expected body to round-trip, got %d bytes (%v)", len(decoded), err)
+	}
+}
+
+func TestWithGzipPassesThrough(t *testing.T) {
+	large := strings.Repeat("a", 2*gzipMinBytes)
+	cases := []struct {
+		name           string
+		acceptEncoding string
+		contentType    string
+		body           string
+	}{
+		{name: "small body", acceptEncoding: "gzip", contentType: "application/json", body: `{"ok":true}`},
+		{name: "gzip not accepted", acceptEncoding: "gzip;q=0, br", contentType: "application/json", body: large},
+		{name: "incompressible type", acceptEncoding: "gzip", contentType: "image/png", body: large},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			handler := withGzip(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", tc.contentType)
+				_, _ = io.WriteString(w, tc.body)
+			}))
+
+			req := httptest.NewRequest(http.MethodGet, "/v1/monitors", nil)
+			req.Header.Set("Accept-Encoding", tc.acceptEncoding)
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, req)
+
+			if rec.Code != http.StatusOK || rec.Header().Get("Content-Encoding") != "" || rec.Body.String() != tc.body {
+				t.Fatalf("expected unmodified response, got %d with encoding %q", rec.Code, rec.Header().Get("Content-Encoding"))
+			}
+		})
+	}
+}
+
+func TestWithGzipSkipsEmptyResponses(t *testing.T) {
+	handler := withGzip(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}))
+
+	req := httptest.NewRequest(http.MethodDelete, "/v1/monitors/1", nil)
+	req.Header.Set("Accept-Encoding", "gzip")
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusNoContent || rec.Header().Get("Content-Encoding") != "" || rec.Body.Len() != 0 {
+		t.Fatalf("expected bare 204, got %d with encoding %q", rec.Code, rec.Header().Get("Content-Encoding"))
+	}
+}
+
+func TestAcceptsGzip(t *testing.T) {
+	cases := map[string]bool{
+		"":                  false,
+		"gzip":              true,
+		"GZIP, deflate":     true,
+		"deflate, br":       false,
+		"*":                 true,
+		"gzip;q=0":          false,
+		"gzip; q=0.5":       true,
+		"identity, *;q=0.1": true,
+	}
+	for header, want := range cases {
+		if got := acceptsGzip(header); got != want {
+			t.Fatalf("acceptsGzip(%q) = %v, want %v", header, got, want)
+		}
+	}
+}
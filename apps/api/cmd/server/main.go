@@ -1,73 +1,252 @@
 package main
 
 import (
+	"bufio"
 	"context"
+	"errors"
 	"flag"
+	"fmt"
 	"log/slog"
+	"net"
 	"net/http"
 	"os"
-	"path/filepath"
-	"strconv"
+	"os/signal"
 	"strings"
+	"syscall"
 	"time"
 	_ "time/tzdata"
 
-	"goanna/apps/api/ent"
+	"goanna/apps/api/internal/backup"
+	"goanna/apps/api/internal/errorreport"
+	"goanna/apps/api/internal/migrations"
+	"goanna/apps/api/internal/requestid"
 	"goanna/apps/api/internal/server"
+	"goanna/apps/api/internal/telemetry"
+	"goanna/apps/api/internal/webui"
 	"goanna/apps/api/internal/worker"
-
-	_ "github.com/mattn/go-sqlite3"
 )
 
-const maxResponseBodyBytesEnv = "GOANNA_MAX_RESPONSE_BODY_BYTES"
-
 func main() {
-	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+	os.Exit(run(os.Args[1:]))
+}
+
+const usage = `usage: goanna-api [command] [flags]
+
+Commands:
+  serve    run the API server and background worker (default)
+  migrate  show, apply or revert schema migrations
+  export   write monitors as a JSON or YAML export document
+  import   create or update monitors from an export document
+  check    run one check of a saved monitor and print the result
+
+Run "goanna-api <command> -h" for the flags of a command.
+`
+
+// run dispatches to a subcommand and returns the process exit code. Without
+// a command, or with only flags, the server starts.
+func run(args []string) int {
+	command := "serve"
+	if len(args) > 0 && !strings.HasPrefix(args[0], "-") {
+		command, args = args[0], args[1:]
+	}
+
+	// Commands other than serve write their results to stdout, so their
+	// logs go to stderr.
+	logger := slog.New(slog.NewTextHandler(os.Stderr, nil))
+	switch command {
+	case "serve":
+		return runServe(args)
+	case "migrate":
+		return runMigrate(args, os.Stdout, logger)
+	case "export":
+		return runExport(args, os.Stdout, logger)
+	case "import":
+		return runImport(args, os.Stdin, os.Stdout, logger)
+	case "check":
+		return runCheck(args, os.Stdout, logger)
+	case "help":
+		fmt.Fprint(os.Stdout, usage)
+		return 0
+	default:
+		fmt.Fprintf(os.Stderr, "unknown command %q\n\n%s", command, usage)
+		return 2
+	}
+}
+
+// runServe implements "goanna-api [serve] [flags]": it runs the API server
+// and background worker until SIGINT or SIGTERM.
+func runServe(args []string) int {
+	logLevel := new(slog.LevelVar)
+	logger := slog.New(slog.NewTextHandler(os.Stdout, &slog.HandlerOptions{Level: logLevel}))
+
+	flags := flag.NewFlagSet("serve", flag.ContinueOnError)
+	configPath := flags.String("config", "", "YAML or TOML config file; environment variables and flags override it")
+	flags.String("addr", ":8080", "HTTP listen address, or unix:/path/to/socket")
+	flags.String("base-path", "", "URL path prefix for every route, e.g. /goanna behind a reverse proxy")
+	flags.String("admin-addr", "", "Separate address serving pprof profiles and expvar counters, e.g. 127.0.0.1:6060; off when empty")
+	flags.String("socket-mode", defaultSocketMode, "Octal permissions of the unix socket -addr listens on")
+	flags.String("db-driver", driverSQLite, "Database driver: sqlite3 or mysql (also MariaDB)")
+	flags.String("dsn", defaultDSN, "Database DSN, e.g. user:pass@tcp(host:3306)/goanna for mysql")
+	restoreFrom := flags.String("restore-from", "", "SQLite backup to restore over the database before starting")
+	flags.Bool("auto-migrate", true, "Apply pending schema migrations on startup; when false, refuse to start until \"migrate up\" has run")
+	flags.String("log-level", "info", "Log level: debug, info, warn or error")
+	flags.String("log-format", logFormatText, "Log format: text or json")
+	flags.String("tls-cert", "", "PEM certificate file to serve HTTPS with; requires -tls-key")
+	flags.String("tls-key", "", "PEM private key file for -tls-cert")
+	flags.String("acme-domains", "", "Comma-separated domains to serve HTTPS for with Let's Encrypt certificates")
+	if err := flags.Parse(args); err != nil {
+		return 2
+	}
 
-	addr := flag.String("addr", ":8080", "HTTP listen address")
-	dsn := flag.String("dsn", "file:./data/goanna.db?_fk=1", "SQLite DSN")
-	flag.Parse()
+	config, err := loadServerConfig(*configPath, flags, logger)
+	if err != nil {
+		logger.Error("invalid configuration", "error", err)
+		return 1
+	}
+	level, _ := config.logLevel()
+	logLevel.Set(level)
+	logger = slog.New(config.logHandler(os.Stdout, logLevel))
+	if dsn := strings.TrimSpace(config.ErrorReporting.SentryDSN); dsn != "" {
+		if err := errorreport.Init(dsn, config.ErrorReporting.Environment); err != nil {
+			logger.Error("invalid configuration", "error", fmt.Errorf("errorReporting.sentryDsn: %w", err))
+			return 1
+		}
+		defer errorreport.Flush(5 * time.Second)
+		logger = slog.New(errorreport.Handler(logger.Handler()))
+		logger.Info("error reporting enabled")
+	}
+	slog.SetDefault(logger)
 
-	if err := os.MkdirAll(filepath.Dir("./data/goanna.db"), 0o755); err != nil {
+	if config.Telemetry.Enabled {
+		shutdownTelemetry, err := telemetry.Setup(context.Background(), config.Telemetry.ServiceName)
+		if err != nil {
+			logger.Error("failed setting up opentelemetry", "error", err)
+			return 1
+		}
+		defer func() {
+			flushCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+			if err := shutdownTelemetry(flushCtx); err != nil {
+				logger.Warn("failed flushing opentelemetry", "error", err)
+			}
+		}()
+		logger.Info("opentelemetry export enabled", "service", config.Telemetry.ServiceName)
+	}
+
+	driver := config.Database.Driver
+	dsn := config.Database.DSN
+
+	if err := ensureDataDir(driver); err != nil {
 		logger.Error("failed creating data directory", "error", err)
-		os.Exit(1)
+		return 1
+	}
+
+	if from := restoreSource(*restoreFrom); from != "" {
+		if err := restoreDatabase(driver, dsn, from); err != nil {
+			logger.Error("failed restoring database backup", "from", from, "error", err)
+			return 1
+		}
+		logger.Info("restored database backup", "from", from)
 	}
 
-	client, err := ent.Open("sqlite3", *dsn)
+	client, db, err := openDatabase(driver, dsn, config.sqliteOptions())
 	if err != nil {
-		logger.Error("failed opening sqlite database", "error", err)
-		os.Exit(1)
+		logger.Error("failed opening database", "driver", driver, "error", err)
+		return 1
 	}
 	defer client.Close()
 
-	if err := client.Schema.Create(context.Background()); err != nil {
+	var backups *backup.Snapshotter
+	if driver == driverSQLite {
+		backups = backup.New(db)
+	}
+
+	migrator, err := migrations.New(db, driver)
+	if err != nil {
+		logger.Error("failed loading schema migrations", "error", err)
+		return 1
+	}
+	if err := migrateOnStartup(context.Background(), migrator, config.Database.AutoMigrate, logger); err != nil {
 		logger.Error("failed running schema migrations", "error", err)
-		os.Exit(1)
+		return 1
 	}
 
+	// SIGINT and SIGTERM drain requests, stop the worker and close the
+	// database instead of killing requests and writes mid-flight.
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
 	mux := http.NewServeMux()
-	maxResponseBodyBytes := loadPositiveIntEnv(
-		maxResponseBodyBytesEnv,
-		worker.DefaultMaxResponseBodyBytes,
-		logger,
-	)
+	networkGuard, err := config.networkGuard()
+	if err != nil {
+		logger.Error("failed loading network policy", "error", err)
+		return 1
+	}
+
+	workerConfig := config.workerConfig(networkGuard)
+	workerConfig.Changes = worker.NewScheduleChanges()
+	workerConfig.Events = worker.NewEvents()
+	workerConfig.Liveness = worker.NewLiveness()
+	workerConfig.Logger = logger.With("component", "worker")
+
 	api := server.NewWithConfig(client, server.Config{
-		MaxSelectorPayloadBytes: maxResponseBodyBytes,
+		MaxSelectorPayloadBytes: config.MaxResponseBodyBytes,
+		Worker:                  workerConfig,
+		Backups:                 backups,
+		SessionTTL:              time.Duration(config.Auth.SessionTTLHours) * time.Hour,
+		BasePath:                config.BasePath,
+		Migrations:              migrator,
 	})
 	api.RegisterRoutes(mux)
 
-	go worker.NewWithConfig(client, worker.Config{
-		MaxResponseBodyBytes: maxResponseBodyBytes,
-	}).Start(context.Background())
+	if backupDir := strings.TrimSpace(config.Backup.Dir); backupDir != "" {
+		if backups == nil {
+			logger.Warn("scheduled backups need a SQLite database, ignoring", "dir", backupDir)
+		} else {
+			interval := time.Duration(config.Backup.IntervalHours) * time.Hour
+			keep := config.Backup.Keep
+			go backups.RunScheduled(ctx, backupDir, interval, keep, logger.With("component", "backup"))
+			logger.Info("scheduled backups enabled", "dir", backupDir, "interval", interval, "keep", keep)
+		}
+	}
+
+	workerDone := make(chan struct{})
+	go func() {
+		defer close(workerDone)
+		defer errorreport.Recover(map[string]string{"component": "worker"})
+		worker.NewWithConfig(client, workerConfig).Start(ctx)
+	}()
 	logger.Info("background worker started")
 
-	handler := withRequestLogging(logger, withCORS(mux))
+	var routes http.Handler = mux
+	if files := webui.Files(); files != nil {
+		routes = withWebUI(mux, server.NormalizeBasePath(config.BasePath), files)
+		logger.Info("serving embedded web ui")
+	}
+	routes = errorreport.Middleware(routes)
+	if config.Telemetry.Enabled {
+		routes = telemetry.Handler(routes)
+	}
+	handler := requestid.Middleware(withRequestLogging(logger, withGzip(withCORS(routes))))
+
+	if adminAddr := strings.TrimSpace(config.AdminAddr); adminAddr != "" {
+		go serveAdmin(ctx, adminAddr, logger)
+	}
 
-	logger.Info("api listening", "addr", *addr)
-	if err := http.ListenAndServe(*addr, handler); err != nil {
+	if err := listenAndServe(ctx, config, handler, logger); err != nil {
 		logger.Error("server exited with error", "error", err)
-		os.Exit(1)
+		return 1
+	}
+
+	// A second signal kills the process without waiting for checks.
+	stop()
+	select {
+	case <-workerDone:
+		logger.Info("background worker stopped")
+	case <-time.After(config.shutdownTimeout()):
+		logger.Warn("checks still running at the shutdown timeout, closing the database anyway")
 	}
+	return 0
 }
 
 func withRequestLogging(logger *slog.Logger, next http.Handler) http.Handler {
@@ -83,6 +262,7 @@ func withRequestLogging(logger *slog.Logger, next http.Handler) http.Handler {
 			"status", responseWriter.StatusCode(),
 			"bytes", responseWriter.bytesWritten,
 			"duration_ms", time.Since(start).Milliseconds(),
+			"request_id", requestid.FromContext(r.Context()),
 		}
 		if r.Pattern != "" {
 			attrs = append(attrs, "pattern", r.Pattern)
@@ -96,7 +276,8 @@ func withCORS(next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		w.Header().Set("Access-Control-Allow-Origin", "*")
 		w.Header().Set("Access-Control-Allow-Methods", "GET,POST,PUT,PATCH,DELETE,OPTIONS")
-		w.Header().Set("Access-Control-Allow-Headers", "Content-Type,Authorization")
+		w.Header().Set("Access-Control-Allow-Headers", "Content-Type,Authorization,X-Request-ID")
+		w.Header().Set("Access-Control-Expose-Headers", "X-Next-Cursor,X-Request-ID")
 		if r.Method == http.MethodOptions {
 			w.WriteHeader(http.StatusNoContent)
 			return
@@ -126,6 +307,21 @@ func (w *loggingResponseWriter) Write(body []byte) (int, error) {
 	return bytesWritten, err
 }
 
+// Hijack lets WebSocket upgrades take over the connection.
+func (w *loggingResponseWriter) Hijack() (net.Conn, *bufio.ReadWriter, error) {
+	hijacker, ok := w.ResponseWriter.(http.Hijacker)
+	if !ok {
+		return nil, nil, errors.New("response writer does not support hijacking")
+	}
+
+	w.statusCode = http.StatusSwitchingProtocols
+	return hijacker.Hijack()
+}
+
+func (w *loggingResponseWriter) Unwrap() http.ResponseWriter {
+	return w.ResponseWriter
+}
+
 func (w *loggingResponseWriter) StatusCode() int {
 	if w.statusCode == 0 {
 		return http.StatusOK
@@ -134,25 +330,25 @@ func (w *loggingResponseWriter) StatusCode() int {
 	return w.statusCode
 }
 
-func loadPositiveIntEnv(key string, fallback int, logger *slog.Logger) int {
-	raw := strings.TrimSpace(os.Getenv(key))
-	if raw == "" {
-		return fallback
+// restoreSource returns the backup to restore on startup: the -restore-from
+// flag, or GOANNA_RESTORE_FROM when the flag is unset.
+func restoreSource(flagValue string) string {
+	if flagValue != "" {
+		return flagValue
 	}
+	return strings.TrimSpace(os.Getenv(restoreFromEnv))
+}
 
-	parsed, err := strconv.Atoi(raw)
-	if err != nil || parsed <= 0 {
-		logger.Warn(
-			"invalid environment override, using default",
-			"key",
-			key,
-			"value",
-			raw,
-			"default",
-			fallback,
-		)
-		return fallback
+// restoreDatabase copies a SQLite backup over the database file dsn points
+// at.
+func restoreDatabase(driver string, dsn string, from string) error {
+	if driver != driverSQLite {
+		return errors.New("restoring backups is only supported for SQLite")
+	}
+	path, err := sqliteFilePath(dsn)
+	if err != nil {
+		return err
 	}
 
-	return parsed
+	return backup.Restore(from, path)
 }
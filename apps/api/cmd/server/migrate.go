@@ -0,0 +1,124 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"text/tabwriter"
+
+	"goanna/apps/api/internal/migrations"
+)
+
+// runMigrate implements "goanna-api migrate [flags] status|up|down". It
+// returns the process exit code.
+func runMigrate(args []string, stdout io.Writer, logger *slog.Logger) int {
+	flags, configPath := commandFlags("migrate", "migrate [flags] status|up|down")
+	steps := flags.Int("steps", 0, "Migrations to apply (up, default all) or revert (down, default 1)")
+	command, err := parseCommand(flags, args)
+	if err != nil {
+		return 2
+	}
+
+	if command != "status" && command != "up" && command != "down" {
+		flags.Usage()
+		return 2
+	}
+
+	config, err := loadServerConfig(*configPath, flags, logger)
+	if err != nil {
+		logger.Error("invalid configuration", "error", err)
+		return 1
+	}
+	driver := config.Database.Driver
+
+	if err := ensureDataDir(driver); err != nil {
+		logger.Error("failed creating data directory", "error", err)
+		return 1
+	}
+	client, db, err := openDatabase(driver, config.Database.DSN, config.sqliteOptions())
+	if err != nil {
+		logger.Error("failed opening database", "driver", driver, "error", err)
+		return 1
+	}
+	defer client.Close()
+
+	migrator, err := migrations.New(db, driver)
+	if err != nil {
+		logger.Error("failed loading migrations", "error", err)
+		return 1
+	}
+
+	ctx := context.Background()
+	var changed []migrations.Migration
+	switch command {
+	case "status":
+		if err := writeMigrationStatus(ctx, stdout, migrator); err != nil {
+			logger.Error("failed reading migration status", "error", err)
+			return 1
+		}
+		return 0
+	case "up":
+		changed, err = migrator.Up(ctx, *steps)
+	case "down":
+		changed, err = migrator.Down(ctx, *steps)
+	}
+	for _, migration := range changed {
+		fmt.Fprintf(stdout, "%s %s_%s\n", command, migration.Version, migration.Name)
+	}
+	if err != nil {
+		logger.Error("migration failed", "command", command, "error", err)
+		return 1
+	}
+	if len(changed) == 0 {
+		fmt.Fprintln(stdout, "nothing to do")
+	}
+	return 0
+}
+
+func writeMigrationStatus(ctx context.Context, stdout io.Writer, migrator *migrations.Migrator) error {
+	states, err := migrator.Status(ctx)
+	if err != nil {
+		return err
+	}
+
+	table := tabwriter.NewWriter(stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(table, "VERSION\tNAME\tAPPLIED")
+	for _, state := range states {
+		applied := "pending"
+		if state.AppliedAt != nil {
+			applied = state.AppliedAt.UTC().Format("2006-01-02 15:04:05")
+		}
+		fmt.Fprintf(table, "%s\t%s\t%s\n", state.Version, state.Name, applied)
+	}
+	return table.Flush()
+}
+
+// migrateOnStartup applies pending migrations, or with auto disabled
+// refuses to start until they are applied with "migrate up".
+func migrateOnStartup(ctx context.Context, migrator *migrations.Migrator, auto bool, logger *slog.Logger) error {
+	if !auto {
+		pending, err := migrator.Pending(ctx)
+		if err != nil {
+			return err
+		}
+		if len(pending) > 0 {
+			return fmt.Errorf("%d schema migrations are pending; run \"goanna-api migrate up\" or start with -auto-migrate", len(pending))
+		}
+		return nil
+	}
+
+	applied, err := migrator.Up(ctx, 0)
+	for _, migration := range applied {
+		logger.Info("applied schema migration", "version", migration.Version, "name", migration.Name)
+	}
+	return err
+}
+
+func ensureDataDir(driver string) error {
+	if driver != driverSQLite {
+		return nil
+	}
+	return os.MkdirAll("./data", 0o755)
+}
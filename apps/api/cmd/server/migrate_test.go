@@ -0,0 +1,40 @@
+package main
+
+import (
+	"bytes"
+	"io"
+	"log/slog"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestRunMigrate(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	dsn := "file:" + filepath.Join(t.TempDir(), "goanna.db") + "?_fk=1"
+
+	run := func(args ...string) (int, string) {
+		var out bytes.Buffer
+		code := runMigrate(args, &out, logger)
+		return code, out.String()
+	}
+
+	if code, out := run("status", "-dsn", dsn); code != 0 || !strings.Contains(out, "pending") {
+		t.Fatalf("expected pending baseline, got %d:\n%s", code, out)
+	}
+	if code, out := run("-dsn", dsn, "up"); code != 0 || !strings.Contains(out, "up ") {
+		t.Fatalf("expected migrations to apply, got %d:\n%s", code, out)
+	}
+	if code, out := run("-dsn", dsn, "status"); code != 0 || strings.Contains(out, "pending") {
+		t.Fatalf("expected nothing pending, got %d:\n%s", code, out)
+	}
+	if code, out := run("-dsn", dsn, "up"); code != 0 || !strings.Contains(out, "nothing to do") {
+		t.Fatalf("expected a second up to be a no-op, got %d:\n%s", code, out)
+	}
+	if code, out := run("-dsn", dsn, "down"); code != 0 || !strings.Contains(out, "down ") {
+		t.Fatalf("expected the latest migration to revert, got %d:\n%s", code, out)
+	}
+	if code, _ := run("sideways"); code != 2 {
+		t.Fatalf("expected usage error, got %d", code)
+	}
+}
@@ -0,0 +1,84 @@
+package main
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// defaultShutdownTimeout bounds how long shutdown waits for in-flight
+// requests, and then for running checks, to finish.
+const defaultShutdownTimeout = 30 * time.Second
+
+// listenAndServe serves handler on the configured address or unix socket:
+// over HTTPS with ACME certificates or the certificate files when set, and
+// over plain HTTP otherwise. Once ctx is done it stops accepting connections
+// and waits up to the shutdown timeout for in-flight requests to finish.
+// Hijacked connections such as WebSockets are not waited for.
+func listenAndServe(ctx context.Context, config serverConfig, handler http.Handler, logger *slog.Logger) error {
+	listener, err := listen(config)
+	if err != nil {
+		return err
+	}
+
+	server := &http.Server{
+		Handler:           handler,
+		ReadHeaderTimeout: 10 * time.Second,
+		TLSConfig:         &tls.Config{MinVersion: tls.VersionTLS12},
+	}
+
+	serve := func() error { return server.Serve(listener) }
+	var challenges *http.Server
+	switch domains := config.TLS.ACME.domains(); {
+	case len(domains) > 0:
+		manager := config.TLS.ACME.manager()
+		server.TLSConfig = manager.TLSConfig()
+		server.TLSConfig.MinVersion = tls.VersionTLS12
+
+		if httpAddr := strings.TrimSpace(config.TLS.ACME.HTTPAddr); httpAddr != "" {
+			challenges = &http.Server{
+				Addr:              httpAddr,
+				Handler:           manager.HTTPHandler(nil),
+				ReadHeaderTimeout: 10 * time.Second,
+			}
+			go func() {
+				if err := challenges.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+					logger.Error("acme challenge listener exited", "addr", httpAddr, "error", err)
+				}
+			}()
+		}
+
+		logger.Info("api listening", "addr", config.Addr, "tls", true, "acme_domains", domains)
+		serve = func() error { return server.ServeTLS(listener, "", "") }
+	case config.TLS.CertFile != "":
+		logger.Info("api listening", "addr", config.Addr, "tls", true)
+		serve = func() error { return server.ServeTLS(listener, config.TLS.CertFile, config.TLS.KeyFile) }
+	default:
+		logger.Info("api listening", "addr", config.Addr)
+	}
+
+	served := make(chan error, 1)
+	go func() { served <- serve() }()
+	select {
+	case err := <-served:
+		return err
+	case <-ctx.Done():
+	}
+
+	timeout := config.shutdownTimeout()
+	logger.Info("draining http requests", "timeout", timeout)
+	drainCtx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	if challenges != nil {
+		_ = challenges.Shutdown(drainCtx)
+	}
+	if err := server.Shutdown(drainCtx); err != nil {
+		return fmt.Errorf("drain http requests: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,67 @@
+package main
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"net"
+	"net/http"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestListenAndServeDrainsRequests(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	path := filepath.Join(t.TempDir(), "goanna.sock")
+
+	config := defaultServerConfig()
+	config.Addr = unixSocketPrefix + path
+	config.ShutdownTimeoutSeconds = 5
+
+	started := make(chan struct{})
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		close(started)
+		time.Sleep(200 * time.Millisecond)
+		_, _ = io.WriteString(w, "done")
+	})
+
+	ctx, cancel := context.WithCancel(t.Context())
+	served := make(chan error, 1)
+	go func() { served <- listenAndServe(ctx, config, handler, logger) }()
+
+	client := &http.Client{Transport: &http.Transport{
+		DialContext: func(ctx context.Context, _ string, _ string) (net.Conn, error) {
+			return (&net.Dialer{}).DialContext(ctx, "unix", path)
+		},
+	}}
+	responses := make(chan string, 1)
+	go func() {
+		for {
+			resp, err := client.Get("http://goanna/slow")
+			if err != nil {
+				// The socket may not be listening yet.
+				time.Sleep(10 * time.Millisecond)
+				continue
+			}
+			body, _ := io.ReadAll(resp.Body)
+			resp.Body.Close()
+			responses <- string(body)
+			return
+		}
+	}()
+
+	select {
+	case <-started:
+	case <-time.After(5 * time.Second):
+		t.Fatal("expected the request to reach the handler")
+	}
+	cancel()
+
+	if err := <-served; err != nil {
+		t.Fatalf("expected a clean shutdown, got %v", err)
+	}
+	if body := <-responses; body != "done" {
+		t.Fatalf("expected the in-flight request to finish, got %q", body)
+	}
+}
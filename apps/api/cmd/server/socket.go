@@ -0,0 +1,68 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"net"
+	"os"
+	"strconv"
+	"strings"
+)
+
+const (
+	unixSocketPrefix  = "unix:"
+	defaultSocketMode = "0660"
+)
+
+// unixSocketPath returns the socket path of a unix:/path address.
+func unixSocketPath(addr string) (string, bool) {
+	path, ok := strings.CutPrefix(strings.TrimSpace(addr), unixSocketPrefix)
+	return path, ok
+}
+
+// socketMode parses SocketMode, the octal permissions of a unix socket.
+func (c serverConfig) socketMode() (fs.FileMode, error) {
+	mode, err := strconv.ParseUint(strings.TrimSpace(c.SocketMode), 8, 32)
+	if err != nil || mode > 0o777 {
+		return 0, fmt.Errorf("socketMode must be octal permissions such as 0660, got %q", c.SocketMode)
+	}
+	return fs.FileMode(mode), nil
+}
+
+// listen opens the listener for config.Addr: a unix socket with SocketMode
+// permissions for unix:/path addresses, and a TCP port otherwise.
+func listen(config serverConfig) (net.Listener, error) {
+	path, ok := unixSocketPath(config.Addr)
+	if !ok {
+		return net.Listen("tcp", config.Addr)
+	}
+	if path == "" {
+		return nil, errors.New("unix socket path is empty")
+	}
+
+	mode, err := config.socketMode()
+	if err != nil {
+		return nil, err
+	}
+
+	// A socket left behind by an unclean exit would fail the listen.
+	if info, err := os.Lstat(path); err == nil {
+		if info.Mode().Type() != fs.ModeSocket {
+			return nil, fmt.Errorf("%s exists and is not a socket", path)
+		}
+		if err := os.Remove(path); err != nil {
+			return nil, fmt.Errorf("remove stale socket: %w", err)
+		}
+	}
+
+	listener, err := net.Listen("unix", path)
+	if err != nil {
+		return nil, err
+	}
+	if err := os.Chmod(path, mode); err != nil {
+		_ = listener.Close()
+		return nil, fmt.Errorf("set socket permissions: %w", err)
+	}
+	return listener, nil
+}
@@ -0,0 +1,78 @@
+package main
+
+import (
+	"context"
+	"io"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestListenUnixSocket(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "goanna.sock")
+
+	// A socket left behind by an earlier run is replaced.
+	stale, err := net.Listen("unix", path)
+	if err != nil {
+		t.Fatalf("listen stale socket: %v", err)
+	}
+	stale.(*net.UnixListener).SetUnlinkOnClose(false)
+	_ = stale.Close()
+
+	config := defaultServerConfig()
+	config.Addr = unixSocketPrefix + path
+	config.SocketMode = "0600"
+	if err := config.validate(); err != nil {
+		t.Fatalf("expected a unix socket addr to be valid: %v", err)
+	}
+
+	listener, err := listen(config)
+	if err != nil {
+		t.Fatalf("listen: %v", err)
+	}
+	info, err := os.Stat(path)
+	if err != nil {
+		t.Fatalf("stat socket: %v", err)
+	}
+	if info.Mode().Perm() != 0o600 {
+		t.Fatalf("expected socket permissions 0600, got %o", info.Mode().Perm())
+	}
+
+	server := &http.Server{Handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = io.WriteString(w, "ok")
+	})}
+	go func() { _ = server.Serve(listener) }()
+	defer server.Close()
+
+	client := &http.Client{Transport: &http.Transport{
+		DialContext: func(ctx context.Context, _ string, _ string) (net.Conn, error) {
+			return (&net.Dialer{}).DialContext(ctx, "unix", path)
+		},
+	}}
+	resp, err := client.Get("http://goanna/healthz")
+	if err != nil {
+		t.Fatalf("request over the socket: %v", err)
+	}
+	defer resp.Body.Close()
+	if body, _ := io.ReadAll(resp.Body); string(body) != "ok" {
+		t.Fatalf("expected the handler to answer, got %q", body)
+	}
+}
+
+func TestListenRefusesToReplaceFiles(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "goanna.db")
+	if err := os.WriteFile(path, []byte("data"), 0o600); err != nil {
+		t.Fatalf("write file: %v", err)
+	}
+
+	config := defaultServerConfig()
+	config.Addr = unixSocketPrefix + path
+	if _, err := listen(config); err == nil {
+		t.Fatal("expected a regular file at the socket path to be refused")
+	}
+	if content, err := os.ReadFile(path); err != nil || string(content) != "data" {
+		t.Fatalf("expected the file to be kept, got %q (%v)", content, err)
+	}
+}
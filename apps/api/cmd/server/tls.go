@@ -0,0 +1,61 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"golang.org/x/crypto/acme"
+	"golang.org/x/crypto/acme/autocert"
+)
+
+const defaultACMECacheDir = "./data/acme"
+
+// domains returns the trimmed, non-empty ACME domains.
+func (a acmeSettings) domains() []string {
+	var domains []string
+	for _, domain := range a.Domains {
+		if domain = strings.ToLower(strings.TrimSpace(domain)); domain != "" {
+			domains = append(domains, domain)
+		}
+	}
+	return domains
+}
+
+func (a acmeSettings) validate() error {
+	domains := a.domains()
+	if len(domains) == 0 {
+		return nil
+	}
+	for _, domain := range domains {
+		if strings.ContainsAny(domain, "*/: ") {
+			return fmt.Errorf("tls.acme.domains: %q must be a plain host name", domain)
+		}
+	}
+	if strings.TrimSpace(a.CacheDir) == "" {
+		return errors.New("tls.acme.cacheDir is required with tls.acme.domains")
+	}
+	if a.DirectoryURL != "" {
+		parsed, err := url.Parse(a.DirectoryURL)
+		if err != nil || parsed.Scheme != "https" || parsed.Host == "" {
+			return errors.New("tls.acme.directoryUrl must be an https URL")
+		}
+	}
+	return nil
+}
+
+// manager returns the autocert manager for the domains, accepting the CA's
+// terms of service on the operator's behalf.
+func (a acmeSettings) manager() *autocert.Manager {
+	manager := &autocert.Manager{
+		Prompt:     autocert.AcceptTOS,
+		Cache:      autocert.DirCache(strings.TrimSpace(a.CacheDir)),
+		HostPolicy: autocert.HostWhitelist(a.domains()...),
+		Email:      strings.TrimSpace(a.Email),
+	}
+	if a.DirectoryURL != "" {
+		manager.Client = &acme.Client{DirectoryURL: a.DirectoryURL}
+	}
+	return manager
+}
@@ -0,0 +1,73 @@
+package main
+
+import (
+	"io/fs"
+	"net/http"
+	"path"
+	"strings"
+)
+
+// withWebUI serves the web UI from files for GET and HEAD requests no API
+// route matches. Paths without a file extension fall back to index.html so
+// client-side routes load the app; API paths and missing assets still 404.
+func withWebUI(mux *http.ServeMux, basePath string, files fs.FS) http.Handler {
+	ui := http.StripPrefix(basePath, spaHandler(files))
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet && r.Method != http.MethodHead {
+			mux.ServeHTTP(w, r)
+			return
+		}
+		if _, pattern := mux.Handler(r); pattern != "" {
+			mux.ServeHTTP(w, r)
+			return
+		}
+
+		rest, ok := strings.CutPrefix(r.URL.Path, basePath)
+		if !ok || isAPIPath(rest) {
+			mux.ServeHTTP(w, r)
+			return
+		}
+		ui.ServeHTTP(w, r)
+	})
+}
+
+// isAPIPath reports whether path, relative to the base path, belongs to the
+// API rather than the UI.
+func isAPIPath(path string) bool {
+	return path == "/v1" || strings.HasPrefix(path, "/v1/") || path == "/healthz" || path == "/readyz"
+}
+
+// spaHandler serves files, answering unknown client-side routes with
+// index.html. Built assets carry content hashes in their names and are
+// cached for good; index.html is revalidated so deploys show up at once.
+func spaHandler(files fs.FS) http.Handler {
+	fileServer := http.FileServerFS(files)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		name := strings.TrimPrefix(path.Clean("/"+r.URL.Path), "/")
+		if name != "" && name != "index.html" {
+			if info, err := fs.Stat(files, name); err == nil && !info.IsDir() {
+				if strings.HasPrefix(name, "assets/") {
+					w.Header().Set("Cache-Control", "public, max-age=31536000, immutable")
+				}
+				fileServer.ServeHTTP(w, r)
+				return
+			}
+			if path.Ext(name) != "" {
+				http.NotFound(w, r)
+				return
+			}
+		}
+
+		index, err := fs.ReadFile(files, "index.html")
+		if err != nil {
+			http.NotFound(w, r)
+			return
+		}
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		w.Header().Set("Cache-Control", "no-cache")
+		if r.Method == http.MethodHead {
+			return
+		}
+		_, _ = w.Write(index)
+	})
+}
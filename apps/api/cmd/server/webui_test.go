@@ -0,0 +1,53 @@
+package main
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"testing/fstest"
+)
+
+func TestWithWebUI(t *testing.T) {
+	files := fstest.MapFS{
+		"index.html":         {Data: []byte("<!doctype html><div id=app></div>")},
+		"favicon.ico":        {Data: []byte("icon")},
+		"assets/app-1a2b.js": {Data: []byte("console.log(1)")},
+	}
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /goanna/v1/monitors", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = io.WriteString(w, "[]")
+	})
+	handler := withWebUI(mux, "/goanna", files)
+
+	cases := []struct {
+		method string
+		path   string
+		status int
+		body   string
+		cache  string
+	}{
+		{http.MethodGet, "/goanna/v1/monitors", http.StatusOK, "[]", ""},
+		{http.MethodGet, "/goanna/", http.StatusOK, "<!doctype html><div id=app></div>", "no-cache"},
+		{http.MethodGet, "/goanna/monitors/4", http.StatusOK, "<!doctype html><div id=app></div>", "no-cache"},
+		{http.MethodGet, "/goanna/favicon.ico", http.StatusOK, "icon", ""},
+		{http.MethodGet, "/goanna/assets/app-1a2b.js", http.StatusOK, "console.log(1)", "public, max-age=31536000, immutable"},
+		{http.MethodGet, "/goanna/assets/app-old.js", http.StatusNotFound, "", ""},
+		{http.MethodGet, "/goanna/v1/unknown", http.StatusNotFound, "", ""},
+		{http.MethodPost, "/goanna/monitors/4", http.StatusNotFound, "", ""},
+		{http.MethodGet, "/monitors/4", http.StatusNotFound, "", ""},
+	}
+	for _, tc := range cases {
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, httptest.NewRequest(tc.method, tc.path, nil))
+		if rec.Code != tc.status {
+			t.Fatalf("%s %s: expected %d, got %d: %s", tc.method, tc.path, tc.status, rec.Code, rec.Body.String())
+		}
+		if tc.body != "" && rec.Body.String() != tc.body {
+			t.Fatalf("%s %s: expected body %q, got %q", tc.method, tc.path, tc.body, rec.Body.String())
+		}
+		if got := rec.Header().Get("Cache-Control"); got != tc.cache {
+			t.Fatalf("%s %s: expected Cache-Control %q, got %q", tc.method, tc.path, tc.cache, got)
+		}
+	}
+}
@@ -3,6 +3,7 @@
 package ent
 
 import (
+	"encoding/json"
 	"fmt"
 	"goanna/apps/api/ent/checkresult"
 	"goanna/apps/api/ent/monitor"
@@ -38,13 +39,34 @@ type CheckResult struct {
 	DiffSummary *string `json:"diff_summary,omitempty"`
 	// DiffDetails holds the value of the "diff_details" field.
 	DiffDetails *string `json:"diff_details,omitempty"`
+	// BodySnapshot holds the value of the "body_snapshot" field.
+	BodySnapshot *[]byte `json:"body_snapshot,omitempty"`
+	// BodySnapshotEncoding holds the value of the "body_snapshot_encoding" field.
+	BodySnapshotEncoding *string `json:"body_snapshot_encoding,omitempty"`
+	// BodySize holds the value of the "body_size" field.
+	BodySize *int `json:"body_size,omitempty"`
+	// BodySnapshotTruncated holds the value of the "body_snapshot_truncated" field.
+	BodySnapshotTruncated bool `json:"body_snapshot_truncated,omitempty"`
+	// BodyHash holds the value of the "body_hash" field.
+	BodyHash *string `json:"body_hash,omitempty"`
+	// TrackedHeaderValue holds the value of the "tracked_header_value" field.
+	TrackedHeaderValue *string `json:"tracked_header_value,omitempty"`
+	// RedirectChain holds the value of the "redirect_chain" field.
+	RedirectChain []string `json:"redirect_chain,omitempty"`
+	// BodyReadMs holds the value of the "body_read_ms" field.
+	BodyReadMs *float64 `json:"body_read_ms,omitempty"`
+	// SelectorMs holds the value of the "selector_ms" field.
+	SelectorMs *float64 `json:"selector_ms,omitempty"`
+	// DiffMs holds the value of the "diff_ms" field.
+	DiffMs *float64 `json:"diff_ms,omitempty"`
 	// CheckedAt holds the value of the "checked_at" field.
 	CheckedAt time.Time `json:"checked_at,omitempty"`
+	// MonitorID holds the value of the "monitor_id" field.
+	MonitorID int `json:"monitor_id,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the CheckResultQuery when eager-loading is set.
-	Edges                 CheckResultEdges `json:"edges"`
-	monitor_check_results *int
-	selectValues          sql.SelectValues
+	Edges        CheckResultEdges `json:"edges"`
+	selectValues sql.SelectValues
 }
 
 // CheckResultEdges holds the relations/edges for other nodes in the graph.
@@ -72,16 +94,18 @@ func (*CheckResult) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case checkresult.FieldDiffChanged:
+		case checkresult.FieldBodySnapshot, checkresult.FieldRedirectChain:
+			values[i] = new([]byte)
+		case checkresult.FieldDiffChanged, checkresult.FieldBodySnapshotTruncated:
 			values[i] = new(sql.NullBool)
-		case checkresult.FieldID, checkresult.FieldStatusCode, checkresult.FieldResponseTimeMs:
+		case checkresult.FieldBodyReadMs, checkresult.FieldSelectorMs, checkresult.FieldDiffMs:
+			values[i] = new(sql.NullFloat64)
+		case checkresult.FieldID, checkresult.FieldStatusCode, checkresult.FieldResponseTimeMs, checkresult.FieldBodySize, checkresult.FieldMonitorID:
 			values[i] = new(sql.NullInt64)
-		case checkresult.FieldStatus, checkresult.FieldErrorMessage, checkresult.FieldSelectionType, checkresult.FieldSelectionValue, checkresult.FieldDiffKind, checkresult.FieldDiffSummary, checkresult.FieldDiffDetails:
+		case checkresult.FieldStatus, checkresult.FieldErrorMessage, checkresult.FieldSelectionType, checkresult.FieldSelectionValue, checkresult.FieldDiffKind, checkresult.FieldDiffSummary, checkresult.FieldDiffDetails, checkresult.FieldBodySnapshotEncoding, checkresult.FieldBodyHash, checkresult.FieldTrackedHeaderValue:
 			values[i] = new(sql.NullString)
 		case checkresult.FieldCheckedAt:
 			values[i] = new(sql.NullTime)
-		case checkresult.ForeignKeys[0]: // monitor_check_results
-			values[i] = new(sql.NullInt64)
 		default:
 			values[i] = new(sql.UnknownType)
 		}
@@ -171,18 +195,86 @@ func (_m *CheckResult) assignValues(columns []string, values []any) error {
 				_m.DiffDetails = new(string)
 				*_m.DiffDetails = value.String
 			}
+		case checkresult.FieldBodySnapshot:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field body_snapshot", values[i])
+			} else if value != nil {
+				_m.BodySnapshot = value
+			}
+		case checkresult.FieldBodySnapshotEncoding:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field body_snapshot_encoding", values[i])
+			} else if value.Valid {
+				_m.BodySnapshotEncoding = new(string)
+				*_m.BodySnapshotEncoding = value.String
+			}
+		case checkresult.FieldBodySize:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field body_size", values[i])
+			} else if value.Valid {
+				_m.BodySize = new(int)
+				*_m.BodySize = int(value.Int64)
+			}
+		case checkresult.FieldBodySnapshotTruncated:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field body_snapshot_truncated", values[i])
+			} else if value.Valid {
+				_m.BodySnapshotTruncated = value.Bool
+			}
+		case checkresult.FieldBodyHash:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field body_hash", values[i])
+			} else if value.Valid {
+				_m.BodyHash = new(string)
+				*_m.BodyHash = value.String
+			}
+		case checkresult.FieldTrackedHeaderValue:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field tracked_header_value", values[i])
+			} else if value.Valid {
+				_m.TrackedHeaderValue = new(string)
+				*_m.TrackedHeaderValue = value.String
+			}
+		case checkresult.FieldRedirectChain:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field redirect_chain", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.RedirectChain); err != nil {
+					return fmt.Errorf("unmarshal field redirect_chain: %w", err)
+				}
+			}
+		case checkresult.FieldBodyReadMs:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field body_read_ms", values[i])
+			} else if value.Valid {
+				_m.BodyReadMs = new(float64)
+				*_m.BodyReadMs = value.Float64
+			}
+		case checkresult.FieldSelectorMs:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field selector_ms", values[i])
+			} else if value.Valid {
+				_m.SelectorMs = new(float64)
+				*_m.SelectorMs = value.Float64
+			}
+		case checkresult.FieldDiffMs:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field diff_ms", values[i])
+			} else if value.Valid {
+				_m.DiffMs = new(float64)
+				*_m.DiffMs = value.Float64
+			}
 		case checkresult.FieldCheckedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field checked_at", values[i])
 			} else if value.Valid {
 				_m.CheckedAt = value.Time
 			}
-		case checkresult.ForeignKeys[0]:
+		case checkresult.FieldMonitorID:
 			if value, ok := values[i].(*sql.NullInt64); !ok {
-				return fmt.Errorf("unexpected type %T for edge-field monitor_check_results", value)
+				return fmt.Errorf("unexpected type %T for field monitor_id", values[i])
 			} else if value.Valid {
-				_m.monitor_check_results = new(int)
-				*_m.monitor_check_results = int(value.Int64)
+				_m.MonitorID = int(value.Int64)
 			}
 		default:
 			_m.selectValues.Set(columns[i], values[i])
@@ -271,8 +363,57 @@ func (_m *CheckResult) String() string {
 		builder.WriteString(*v)
 	}
 	builder.WriteString(", ")
+	if v := _m.BodySnapshot; v != nil {
+		builder.WriteString("body_snapshot=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.BodySnapshotEncoding; v != nil {
+		builder.WriteString("body_snapshot_encoding=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := _m.BodySize; v != nil {
+		builder.WriteString("body_size=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("body_snapshot_truncated=")
+	builder.WriteString(fmt.Sprintf("%v", _m.BodySnapshotTruncated))
+	builder.WriteString(", ")
+	if v := _m.BodyHash; v != nil {
+		builder.WriteString("body_hash=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := _m.TrackedHeaderValue; v != nil {
+		builder.WriteString("tracked_header_value=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("redirect_chain=")
+	builder.WriteString(fmt.Sprintf("%v", _m.RedirectChain))
+	builder.WriteString(", ")
+	if v := _m.BodyReadMs; v != nil {
+		builder.WriteString("body_read_ms=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.SelectorMs; v != nil {
+		builder.WriteString("selector_ms=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.DiffMs; v != nil {
+		builder.WriteString("diff_ms=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
 	builder.WriteString("checked_at=")
 	builder.WriteString(_m.CheckedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("monitor_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.MonitorID))
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -34,8 +34,30 @@ const (
 	FieldDiffSummary = "diff_summary"
 	// FieldDiffDetails holds the string denoting the diff_details field in the database.
 	FieldDiffDetails = "diff_details"
+	// FieldBodySnapshot holds the string denoting the body_snapshot field in the database.
+	FieldBodySnapshot = "body_snapshot"
+	// FieldBodySnapshotEncoding holds the string denoting the body_snapshot_encoding field in the database.
+	FieldBodySnapshotEncoding = "body_snapshot_encoding"
+	// FieldBodySize holds the string denoting the body_size field in the database.
+	FieldBodySize = "body_size"
+	// FieldBodySnapshotTruncated holds the string denoting the body_snapshot_truncated field in the database.
+	FieldBodySnapshotTruncated = "body_snapshot_truncated"
+	// FieldBodyHash holds the string denoting the body_hash field in the database.
+	FieldBodyHash = "body_hash"
+	// FieldTrackedHeaderValue holds the string denoting the tracked_header_value field in the database.
+	FieldTrackedHeaderValue = "tracked_header_value"
+	// FieldRedirectChain holds the string denoting the redirect_chain field in the database.
+	FieldRedirectChain = "redirect_chain"
+	// FieldBodyReadMs holds the string denoting the body_read_ms field in the database.
+	FieldBodyReadMs = "body_read_ms"
+	// FieldSelectorMs holds the string denoting the selector_ms field in the database.
+	FieldSelectorMs = "selector_ms"
+	// FieldDiffMs holds the string denoting the diff_ms field in the database.
+	FieldDiffMs = "diff_ms"
 	// FieldCheckedAt holds the string denoting the checked_at field in the database.
 	FieldCheckedAt = "checked_at"
+	// FieldMonitorID holds the string denoting the monitor_id field in the database.
+	FieldMonitorID = "monitor_check_results"
 	// EdgeMonitor holds the string denoting the monitor edge name in mutations.
 	EdgeMonitor = "monitor"
 	// Table holds the table name of the checkresult in the database.
@@ -62,13 +84,18 @@ var Columns = []string{
 	FieldDiffKind,
 	FieldDiffSummary,
 	FieldDiffDetails,
+	FieldBodySnapshot,
+	FieldBodySnapshotEncoding,
+	FieldBodySize,
+	FieldBodySnapshotTruncated,
+	FieldBodyHash,
+	FieldTrackedHeaderValue,
+	FieldRedirectChain,
+	FieldBodyReadMs,
+	FieldSelectorMs,
+	FieldDiffMs,
 	FieldCheckedAt,
-}
-
-// ForeignKeys holds the SQL foreign-keys that are owned by the "check_results"
-// table and are not defined as standalone fields in the schema.
-var ForeignKeys = []string{
-	"monitor_check_results",
+	FieldMonitorID,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -78,11 +105,6 @@ func ValidColumn(column string) bool {
 			return true
 		}
 	}
-	for i := range ForeignKeys {
-		if column == ForeignKeys[i] {
-			return true
-		}
-	}
 	return false
 }
 
@@ -91,6 +113,8 @@ var (
 	DefaultStatus string
 	// DefaultDiffChanged holds the default value on creation for the "diff_changed" field.
 	DefaultDiffChanged bool
+	// DefaultBodySnapshotTruncated holds the default value on creation for the "body_snapshot_truncated" field.
+	DefaultBodySnapshotTruncated bool
 	// DefaultCheckedAt holds the default value on creation for the "checked_at" field.
 	DefaultCheckedAt func() time.Time
 )
@@ -153,11 +177,56 @@ func ByDiffDetails(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldDiffDetails, opts...).ToFunc()
 }
 
+// ByBodySnapshotEncoding orders the results by the body_snapshot_encoding field.
+func ByBodySnapshotEncoding(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBodySnapshotEncoding, opts...).ToFunc()
+}
+
+// ByBodySize orders the results by the body_size field.
+func ByBodySize(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBodySize, opts...).ToFunc()
+}
+
+// ByBodySnapshotTruncated orders the results by the body_snapshot_truncated field.
+func ByBodySnapshotTruncated(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBodySnapshotTruncated, opts...).ToFunc()
+}
+
+// ByBodyHash orders the results by the body_hash field.
+func ByBodyHash(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBodyHash, opts...).ToFunc()
+}
+
+// ByTrackedHeaderValue orders the results by the tracked_header_value field.
+func ByTrackedHeaderValue(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTrackedHeaderValue, opts...).ToFunc()
+}
+
+// ByBodyReadMs orders the results by the body_read_ms field.
+func ByBodyReadMs(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBodyReadMs, opts...).ToFunc()
+}
+
+// BySelectorMs orders the results by the selector_ms field.
+func BySelectorMs(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSelectorMs, opts...).ToFunc()
+}
+
+// ByDiffMs orders the results by the diff_ms field.
+func ByDiffMs(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDiffMs, opts...).ToFunc()
+}
+
 // ByCheckedAt orders the results by the checked_at field.
 func ByCheckedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCheckedAt, opts...).ToFunc()
 }
 
+// ByMonitorID orders the results by the monitor_id field.
+func ByMonitorID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMonitorID, opts...).ToFunc()
+}
+
 // ByMonitorField orders the results by monitor field.
 func ByMonitorField(field string, opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
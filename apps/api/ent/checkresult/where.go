@@ -105,11 +105,61 @@ func DiffDetails(v string) predicate.CheckResult {
 	return predicate.CheckResult(sql.FieldEQ(FieldDiffDetails, v))
 }
 
+// BodySnapshot applies equality check predicate on the "body_snapshot" field. It's identical to BodySnapshotEQ.
+func BodySnapshot(v []byte) predicate.CheckResult {
+	return predicate.CheckResult(sql.FieldEQ(FieldBodySnapshot, v))
+}
+
+// BodySnapshotEncoding applies equality check predicate on the "body_snapshot_encoding" field. It's identical to BodySnapshotEncodingEQ.
+func BodySnapshotEncoding(v string) predicate.CheckResult {
+	return predicate.CheckResult(sql.FieldEQ(FieldBodySnapshotEncoding, v))
+}
+
+// BodySize applies equality check predicate on the "body_size" field. It's identical to BodySizeEQ.
+func BodySize(v int) predicate.CheckResult {
+	return predicate.CheckResult(sql.FieldEQ(FieldBodySize, v))
+}
+
+// BodySnapshotTruncated applies equality check predicate on the "body_snapshot_truncated" field. It's identical to BodySnapshotTruncatedEQ.
+func BodySnapshotTruncated(v bool) predicate.CheckResult {
+	return predicate.CheckResult(sql.FieldEQ(FieldBodySnapshotTruncated, v))
+}
+
+// BodyHash applies equality check predicate on the "body_hash" field. It's identical to BodyHashEQ.
+func BodyHash(v string) predicate.CheckResult {
+	return predicate.CheckResult(sql.FieldEQ(FieldBodyHash, v))
+}
+
+// TrackedHeaderValue applies equality check predicate on the "tracked_header_value" field. It's identical to TrackedHeaderValueEQ.
+func TrackedHeaderValue(v string) predicate.CheckResult {
+	return predicate.CheckResult(sql.FieldEQ(FieldTrackedHeaderValue, v))
+}
+
+// BodyReadMs applies equality check predicate on the "body_read_ms" field. It's identical to BodyReadMsEQ.
+func BodyReadMs(v float64) predicate.CheckResult {
+	return predicate.CheckResult(sql.FieldEQ(FieldBodyReadMs, v))
+}
+
+// SelectorMs applies equality check predicate on the "selector_ms" field. It's identical to SelectorMsEQ.
+func SelectorMs(v float64) predicate.CheckResult {
+	return predicate.CheckResult(sql.FieldEQ(FieldSelectorMs, v))
+}
+
+// DiffMs applies equality check predicate on the "diff_ms" field. It's identical to DiffMsEQ.
+func DiffMs(v float64) predicate.CheckResult {
+	return predicate.CheckResult(sql.FieldEQ(FieldDiffMs, v))
+}
+
 // CheckedAt applies equality check predicate on the "checked_at" field. It's identical to CheckedAtEQ.
 func CheckedAt(v time.Time) predicate.CheckResult {
 	return predicate.CheckResult(sql.FieldEQ(FieldCheckedAt, v))
 }
 
+// MonitorID applies equality check predicate on the "monitor_id" field. It's identical to MonitorIDEQ.
+func MonitorID(v int) predicate.CheckResult {
+	return predicate.CheckResult(sql.FieldEQ(FieldMonitorID, v))
+}
+
 // StatusEQ applies the EQ predicate on the "status" field.
 func StatusEQ(v string) predicate.CheckResult {
 	return predicate.CheckResult(sql.FieldEQ(FieldStatus, v))
@@ -735,6 +785,501 @@ func DiffDetailsContainsFold(v string) predicate.CheckResult {
 	return predicate.CheckResult(sql.FieldContainsFold(FieldDiffDetails, v))
 }
 
+// BodySnapshotEQ applies the EQ predicate on the "body_snapshot" field.
+func BodySnapshotEQ(v []byte) predicate.CheckResult {
+	return predicate.CheckResult(sql.FieldEQ(FieldBodySnapshot, v))
+}
+
+// BodySnapshotNEQ applies the NEQ predicate on the "body_snapshot" field.
+func BodySnapshotNEQ(v []byte) predicate.CheckResult {
+	return predicate.CheckResult(sql.FieldNEQ(FieldBodySnapshot, v))
+}
+
+// BodySnapshotIn applies the In predicate on the "body_snapshot" field.
+func BodySnapshotIn(vs ...[]byte) predicate.CheckResult {
+	return predicate.CheckResult(sql.FieldIn(FieldBodySnapshot, vs...))
+}
+
+// BodySnapshotNotIn applies the NotIn predicate on the "body_snapshot" field.
+func BodySnapshotNotIn(vs ...[]byte) predicate.CheckResult {
+	return predicate.CheckResult(sql.FieldNotIn(FieldBodySnapshot, vs...))
+}
+
+// BodySnapshotGT applies the GT predicate on the "body_snapshot" field.
+func BodySnapshotGT(v []byte) predicate.CheckResult {
+	return predicate.CheckResult(sql.FieldGT(FieldBodySnapshot, v))
+}
+
+// BodySnapshotGTE applies the GTE predicate on the "body_snapshot" field.
+func BodySnapshotGTE(v []byte) predicate.CheckResult {
+	return predicate.CheckResult(sql.FieldGTE(FieldBodySnapshot, v))
+}
+
+// BodySnapshotLT applies the LT predicate on the "body_snapshot" field.
+func BodySnapshotLT(v []byte) predicate.CheckResult {
+	return predicate.CheckResult(sql.FieldLT(FieldBodySnapshot, v))
+}
+
+// BodySnapshotLTE applies the LTE predicate on the "body_snapshot" field.
+func BodySnapshotLTE(v []byte) predicate.CheckResult {
+	return predicate.CheckResult(sql.FieldLTE(FieldBodySnapshot, v))
+}
+
+// BodySnapshotIsNil applies the IsNil predicate on the "body_snapshot" field.
+func BodySnapshotIsNil() predicate.CheckResult {
+	return predicate.CheckResult(sql.FieldIsNull(FieldBodySnapshot))
+}
+
+// BodySnapshotNotNil applies the NotNil predicate on the "body_snapshot" field.
+func BodySnapshotNotNil() predicate.CheckResult {
+	return predicate.CheckResult(sql.FieldNotNull(FieldBodySnapshot))
+}
+
+// BodySnapshotEncodingEQ applies the EQ predicate on the "body_snapshot_encoding" field.
+func BodySnapshotEncodingEQ(v string) predicate.CheckResult {
+	return predicate.CheckResult(sql.FieldEQ(FieldBodySnapshotEncoding, v))
+}
+
+// BodySnapshotEncodingNEQ applies the NEQ predicate on the "body_snapshot_encoding" field.
+func BodySnapshotEncodingNEQ(v string) predicate.CheckResult {
+	return predicate.CheckResult(sql.FieldNEQ(FieldBodySnapshotEncoding, v))
+}
+
+// BodySnapshotEncodingIn applies the In predicate on the "body_snapshot_encoding" field.
+func BodySnapshotEncodingIn(vs ...string) predicate.CheckResult {
+	return predicate.CheckResult(sql.FieldIn(FieldBodySnapshotEncoding, vs...))
+}
+
+// BodySnapshotEncodingNotIn applies the NotIn predicate on the "body_snapshot_encoding" field.
+func BodySnapshotEncodingNotIn(vs ...string) predicate.CheckResult {
+	return predicate.CheckResult(sql.FieldNotIn(FieldBodySnapshotEncoding, vs...))
+}
+
+// BodySnapshotEncodingGT applies the GT predicate on the "body_snapshot_encoding" field.
+func BodySnapshotEncodingGT(v string) predicate.CheckResult {
+	return predicate.CheckResult(sql.FieldGT(FieldBodySnapshotEncoding, v))
+}
+
+// BodySnapshotEncodingGTE applies the GTE predicate on the "body_snapshot_encoding" field.
+func BodySnapshotEncodingGTE(v string) predicate.CheckResult {
+	return predicate.CheckResult(sql.FieldGTE(FieldBodySnapshotEncoding, v))
+}
+
+// BodySnapshotEncodingLT applies the LT predicate on the "body_snapshot_encoding" field.
+func BodySnapshotEncodingLT(v string) predicate.CheckResult {
+	return predicate.CheckResult(sql.FieldLT(FieldBodySnapshotEncoding, v))
+}
+
+// BodySnapshotEncodingLTE applies the LTE predicate on the "body_snapshot_encoding" field.
+func BodySnapshotEncodingLTE(v string) predicate.CheckResult {
+	return predicate.CheckResult(sql.FieldLTE(FieldBodySnapshotEncoding, v))
+}
+
+// BodySnapshotEncodingContains applies the Contains predicate on the "body_snapshot_encoding" field.
+func BodySnapshotEncodingContains(v string) predicate.CheckResult {
+	return predicate.CheckResult(sql.FieldContains(FieldBodySnapshotEncoding, v))
+}
+
+// BodySnapshotEncodingHasPrefix applies the HasPrefix predicate on the "body_snapshot_encoding" field.
+func BodySnapshotEncodingHasPrefix(v string) predicate.CheckResult {
+	return predicate.CheckResult(sql.FieldHasPrefix(FieldBodySnapshotEncoding, v))
+}
+
+// BodySnapshotEncodingHasSuffix applies the HasSuffix predicate on the "body_snapshot_encoding" field.
+func BodySnapshotEncodingHasSuffix(v string) predicate.CheckResult {
+	return predicate.CheckResult(sql.FieldHasSuffix(FieldBodySnapshotEncoding, v))
+}
+
+// BodySnapshotEncodingIsNil applies the IsNil predicate on the "body_snapshot_encoding" field.
+func BodySnapshotEncodingIsNil() predicate.CheckResult {
+	return predicate.CheckResult(sql.FieldIsNull(FieldBodySnapshotEncoding))
+}
+
+// BodySnapshotEncodingNotNil applies the NotNil predicate on the "body_snapshot_encoding" field.
+func BodySnapshotEncodingNotNil() predicate.CheckResult {
+	return predicate.CheckResult(sql.FieldNotNull(FieldBodySnapshotEncoding))
+}
+
+// BodySnapshotEncodingEqualFold applies the EqualFold predicate on the "body_snapshot_encoding" field.
+func BodySnapshotEncodingEqualFold(v string) predicate.CheckResult {
+	return predicate.CheckResult(sql.FieldEqualFold(FieldBodySnapshotEncoding, v))
+}
+
+// BodySnapshotEncodingContainsFold applies the ContainsFold predicate on the "body_snapshot_encoding" field.
+func BodySnapshotEncodingContainsFold(v string) predicate.CheckResult {
+	return predicate.CheckResult(sql.FieldContainsFold(FieldBodySnapshotEncoding, v))
+}
+
+// BodySizeEQ applies the EQ predicate on the "body_size" field.
+func BodySizeEQ(v int) predicate.CheckResult {
+	return predicate.CheckResult(sql.FieldEQ(FieldBodySize, v))
+}
+
+// BodySizeNEQ applies the NEQ predicate on the "body_size" field.
+func BodySizeNEQ(v int) predicate.CheckResult {
+	return predicate.CheckResult(sql.FieldNEQ(FieldBodySize, v))
+}
+
+// BodySizeIn applies the In predicate on the "body_size" field.
+func BodySizeIn(vs ...int) predicate.CheckResult {
+	return predicate.CheckResult(sql.FieldIn(FieldBodySize, vs...))
+}
+
+// BodySizeNotIn applies the NotIn predicate on the "body_size" field.
+func BodySizeNotIn(vs ...int) predicate.CheckResult {
+	return predicate.CheckResult(sql.FieldNotIn(FieldBodySize, vs...))
+}
+
+// BodySizeGT applies the GT predicate on the "body_size" field.
+func BodySizeGT(v int) predicate.CheckResult {
+	return predicate.CheckResult(sql.FieldGT(FieldBodySize, v))
+}
+
+// BodySizeGTE applies the GTE predicate on the "body_size" field.
+func BodySizeGTE(v int) predicate.CheckResult {
+	return predicate.CheckResult(sql.FieldGTE(FieldBodySize, v))
+}
+
+// BodySizeLT applies the LT predicate on the "body_size" field.
+func BodySizeLT(v int) predicate.CheckResult {
+	return predicate.CheckResult(sql.FieldLT(FieldBodySize, v))
+}
+
+// BodySizeLTE applies the LTE predicate on the "body_size" field.
+func BodySizeLTE(v int) predicate.CheckResult {
+	return predicate.CheckResult(sql.FieldLTE(FieldBodySize, v))
+}
+
+// BodySizeIsNil applies the IsNil predicate on the "body_size" field.
+func BodySizeIsNil() predicate.CheckResult {
+	return predicate.CheckResult(sql.FieldIsNull(FieldBodySize))
+}
+
+// BodySizeNotNil applies the NotNil predicate on the "body_size" field.
+func BodySizeNotNil() predicate.CheckResult {
+	return predicate.CheckResult(sql.FieldNotNull(FieldBodySize))
+}
+
+// BodySnapshotTruncatedEQ applies the EQ predicate on the "body_snapshot_truncated" field.
+func BodySnapshotTruncatedEQ(v bool) predicate.CheckResult {
+	return predicate.CheckResult(sql.FieldEQ(FieldBodySnapshotTruncated, v))
+}
+
+// BodySnapshotTruncatedNEQ applies the NEQ predicate on the "body_snapshot_truncated" field.
+func BodySnapshotTruncatedNEQ(v bool) predicate.CheckResult {
+	return predicate.CheckResult(sql.FieldNEQ(FieldBodySnapshotTruncated, v))
+}
+
+// BodyHashEQ applies the EQ predicate on the "body_hash" field.
+func BodyHashEQ(v string) predicate.CheckResult {
+	return predicate.CheckResult(sql.FieldEQ(FieldBodyHash, v))
+}
+
+// BodyHashNEQ applies the NEQ predicate on the "body_hash" field.
+func BodyHashNEQ(v string) predicate.CheckResult {
+	return predicate.CheckResult(sql.FieldNEQ(FieldBodyHash, v))
+}
+
+// BodyHashIn applies the In predicate on the "body_hash" field.
+func BodyHashIn(vs ...string) predicate.CheckResult {
+	return predicate.CheckResult(sql.FieldIn(FieldBodyHash, vs...))
+}
+
+// BodyHashNotIn applies the NotIn predicate on the "body_hash" field.
+func BodyHashNotIn(vs ...string) predicate.CheckResult {
+	return predicate.CheckResult(sql.FieldNotIn(FieldBodyHash, vs...))
+}
+
+// BodyHashGT applies the GT predicate on the "body_hash" field.
+func BodyHashGT(v string) predicate.CheckResult {
+	return predicate.CheckResult(sql.FieldGT(FieldBodyHash, v))
+}
+
+// BodyHashGTE applies the GTE predicate on the "body_hash" field.
+func BodyHashGTE(v string) predicate.CheckResult {
+	return predicate.CheckResult(sql.FieldGTE(FieldBodyHash, v))
+}
+
+// BodyHashLT applies the LT predicate on the "body_hash" field.
+func BodyHashLT(v string) predicate.CheckResult {
+	return predicate.CheckResult(sql.FieldLT(FieldBodyHash, v))
+}
+
+// BodyHashLTE applies the LTE predicate on the "body_hash" field.
+func BodyHashLTE(v string) predicate.CheckResult {
+	return predicate.CheckResult(sql.FieldLTE(FieldBodyHash, v))
+}
+
+// BodyHashContains applies the Contains predicate on the "body_hash" field.
+func BodyHashContains(v string) predicate.CheckResult {
+	return predicate.CheckResult(sql.FieldContains(FieldBodyHash, v))
+}
+
+// BodyHashHasPrefix applies the HasPrefix predicate on the "body_hash" field.
+func BodyHashHasPrefix(v string) predicate.CheckResult {
+	return predicate.CheckResult(sql.FieldHasPrefix(FieldBodyHash, v))
+}
+
+// BodyHashHasSuffix applies the HasSuffix predicate on the "body_hash" field.
+func BodyHashHasSuffix(v string) predicate.CheckResult {
+	return predicate.CheckResult(sql.FieldHasSuffix(FieldBodyHash, v))
+}
+
+// BodyHashIsNil applies the IsNil predicate on the "body_hash" field.
+func BodyHashIsNil() predicate.CheckResult {
+	return predicate.CheckResult(sql.FieldIsNull(FieldBodyHash))
+}
+
+// BodyHashNotNil applies the NotNil predicate on the "body_hash" field.
+func BodyHashNotNil() predicate.CheckResult {
+	return predicate.CheckResult(sql.FieldNotNull(FieldBodyHash))
+}
+
+// BodyHashEqualFold applies the EqualFold predicate on the "body_hash" field.
+func BodyHashEqualFold(v string) predicate.CheckResult {
+	return predicate.CheckResult(sql.FieldEqualFold(FieldBodyHash, v))
+}
+
+// BodyHashContainsFold applies the ContainsFold predicate on the "body_hash" field.
+func BodyHashContainsFold(v string) predicate.CheckResult {
+	return predicate.CheckResult(sql.FieldContainsFold(FieldBodyHash, v))
+}
+
+// TrackedHeaderValueEQ applies the EQ predicate on the "tracked_header_value" field.
+func TrackedHeaderValueEQ(v string) predicate.CheckResult {
+	return predicate.CheckResult(sql.FieldEQ(FieldTrackedHeaderValue, v))
+}
+
+// TrackedHeaderValueNEQ applies the NEQ predicate on the "tracked_header_value" field.
+func TrackedHeaderValueNEQ(v string) predicate.CheckResult {
+	return predicate.CheckResult(sql.FieldNEQ(FieldTrackedHeaderValue, v))
+}
+
+// TrackedHeaderValueIn applies the In predicate on the "tracked_header_value" field.
+func TrackedHeaderValueIn(vs ...string) predicate.CheckResult {
+	return predicate.CheckResult(sql.FieldIn(FieldTrackedHeaderValue, vs...))
+}
+
+// TrackedHeaderValueNotIn applies the NotIn predicate on the "tracked_header_value" field.
+func TrackedHeaderValueNotIn(vs ...string) predicate.CheckResult {
+	return predicate.CheckResult(sql.FieldNotIn(FieldTrackedHeaderValue, vs...))
+}
+
+// TrackedHeaderValueGT applies the GT predicate on the "tracked_header_value" field.
+func TrackedHeaderValueGT(v string) predicate.CheckResult {
+	return predicate.CheckResult(sql.FieldGT(FieldTrackedHeaderValue, v))
+}
+
+// TrackedHeaderValueGTE applies the GTE predicate on the "tracked_header_value" field.
+func TrackedHeaderValueGTE(v string) predicate.CheckResult {
+	return predicate.CheckResult(sql.FieldGTE(FieldTrackedHeaderValue, v))
+}
+
+// TrackedHeaderValueLT applies the LT predicate on the "tracked_header_value" field.
+func TrackedHeaderValueLT(v string) predicate.CheckResult {
+	return predicate.CheckResult(sql.FieldLT(FieldTrackedHeaderValue, v))
+}
+
+// TrackedHeaderValueLTE applies the LTE predicate on the "tracked_header_value" field.
+func TrackedHeaderValueLTE(v string) predicate.CheckResult {
+	return predicate.CheckResult(sql.FieldLTE(FieldTrackedHeaderValue, v))
+}
+
+// TrackedHeaderValueContains applies the Contains predicate on the "tracked_header_value" field.
+func TrackedHeaderValueContains(v string) predicate.CheckResult {
+	return predicate.CheckResult(sql.FieldContains(FieldTrackedHeaderValue, v))
+}
+
+// TrackedHeaderValueHasPrefix applies the HasPrefix predicate on the "tracked_header_value" field.
+func TrackedHeaderValueHasPrefix(v string) predicate.CheckResult {
+	return predicate.CheckResult(sql.FieldHasPrefix(FieldTrackedHeaderValue, v))
+}
+
+// TrackedHeaderValueHasSuffix applies the HasSuffix predicate on the "tracked_header_value" field.
+func TrackedHeaderValueHasSuffix(v string) predicate.CheckResult {
+	return predicate.CheckResult(sql.FieldHasSuffix(FieldTrackedHeaderValue, v))
+}
+
+// TrackedHeaderValueIsNil applies the IsNil predicate on the "tracked_header_value" field.
+func TrackedHeaderValueIsNil() predicate.CheckResult {
+	return predicate.CheckResult(sql.FieldIsNull(FieldTrackedHeaderValue))
+}
+
+// TrackedHeaderValueNotNil applies the NotNil predicate on the "tracked_header_value" field.
+func TrackedHeaderValueNotNil() predicate.CheckResult {
+	return predicate.CheckResult(sql.FieldNotNull(FieldTrackedHeaderValue))
+}
+
+// TrackedHeaderValueEqualFold applies the EqualFold predicate on the "tracked_header_value" field.
+func TrackedHeaderValueEqualFold(v string) predicate.CheckResult {
+	return predicate.CheckResult(sql.FieldEqualFold(FieldTrackedHeaderValue, v))
+}
+
+// TrackedHeaderValueContainsFold applies the ContainsFold predicate on the "tracked_header_value" field.
+func TrackedHeaderValueContainsFold(v string) predicate.CheckResult {
+	return predicate.CheckResult(sql.FieldContainsFold(FieldTrackedHeaderValue, v))
+}
+
+// RedirectChainIsNil applies the IsNil predicate on the "redirect_chain" field.
+func RedirectChainIsNil() predicate.CheckResult {
+	return predicate.CheckResult(sql.FieldIsNull(FieldRedirectChain))
+}
+
+// RedirectChainNotNil applies the NotNil predicate on the "redirect_chain" field.
+func RedirectChainNotNil() predicate.CheckResult {
+	return predicate.CheckResult(sql.FieldNotNull(FieldRedirectChain))
+}
+
+// BodyReadMsEQ applies the EQ predicate on the "body_read_ms" field.
+func BodyReadMsEQ(v float64) predicate.CheckResult {
+	return predicate.CheckResult(sql.FieldEQ(FieldBodyReadMs, v))
+}
+
+// BodyReadMsNEQ applies the NEQ predicate on the "body_read_ms" field.
+func BodyReadMsNEQ(v float64) predicate.CheckResult {
+	return predicate.CheckResult(sql.FieldNEQ(FieldBodyReadMs, v))
+}
+
+// BodyReadMsIn applies the In predicate on the "body_read_ms" field.
+func BodyReadMsIn(vs ...float64) predicate.CheckResult {
+	return predicate.CheckResult(sql.FieldIn(FieldBodyReadMs, vs...))
+}
+
+// BodyReadMsNotIn applies the NotIn predicate on the "body_read_ms" field.
+func BodyReadMsNotIn(vs ...float64) predicate.CheckResult {
+	return predicate.CheckResult(sql.FieldNotIn(FieldBodyReadMs, vs...))
+}
+
+// BodyReadMsGT applies the GT predicate on the "body_read_ms" field.
+func BodyReadMsGT(v float64) predicate.CheckResult {
+	return predicate.CheckResult(sql.FieldGT(FieldBodyReadMs, v))
+}
+
+// BodyReadMsGTE applies the GTE predicate on the "body_read_ms" field.
+func BodyReadMsGTE(v float64) predicate.CheckResult {
+	return predicate.CheckResult(sql.FieldGTE(FieldBodyReadMs, v))
+}
+
+// BodyReadMsLT applies the LT predicate on the "body_read_ms" field.
+func BodyReadMsLT(v float64) predicate.CheckResult {
+	return predicate.CheckResult(sql.FieldLT(FieldBodyReadMs, v))
+}
+
+// BodyReadMsLTE applies the LTE predicate on the "body_read_ms" field.
+func BodyReadMsLTE(v float64) predicate.CheckResult {
+	return predicate.CheckResult(sql.FieldLTE(FieldBodyReadMs, v))
+}
+
+// BodyReadMsIsNil applies the IsNil predicate on the "body_read_ms" field.
+func BodyReadMsIsNil() predicate.CheckResult {
+	return predicate.CheckResult(sql.FieldIsNull(FieldBodyReadMs))
+}
+
+// BodyReadMsNotNil applies the NotNil predicate on the "body_read_ms" field.
+func BodyReadMsNotNil() predicate.CheckResult {
+	return predicate.CheckResult(sql.FieldNotNull(FieldBodyReadMs))
+}
+
+// SelectorMsEQ applies the EQ predicate on the "selector_ms" field.
+func SelectorMsEQ(v float64) predicate.CheckResult {
+	return predicate.CheckResult(sql.FieldEQ(FieldSelectorMs, v))
+}
+
+// SelectorMsNEQ applies the NEQ predicate on the "selector_ms" field.
+func SelectorMsNEQ(v float64) predicate.CheckResult {
+	return predicate.CheckResult(sql.FieldNEQ(FieldSelectorMs, v))
+}
+
+// SelectorMsIn applies the In predicate on the "selector_ms" field.
+func SelectorMsIn(vs ...float64) predicate.CheckResult {
+	return predicate.CheckResult(sql.FieldIn(FieldSelectorMs, vs...))
+}
+
+// SelectorMsNotIn applies the NotIn predicate on the "selector_ms" field.
+func SelectorMsNotIn(vs ...float64) predicate.CheckResult {
+	return predicate.CheckResult(sql.FieldNotIn(FieldSelectorMs, vs...))
+}
+
+// SelectorMsGT applies the GT predicate on the "selector_ms" field.
+func SelectorMsGT(v float64) predicate.CheckResult {
+	return predicate.CheckResult(sql.FieldGT(FieldSelectorMs, v))
+}
+
+// SelectorMsGTE applies the GTE predicate on the "selector_ms" field.
+func SelectorMsGTE(v float64) predicate.CheckResult {
+	return predicate.CheckResult(sql.FieldGTE(FieldSelectorMs, v))
+}
+
+// SelectorMsLT applies the LT predicate on the "selector_ms" field.
+func SelectorMsLT(v float64) predicate.CheckResult {
+	return predicate.CheckResult(sql.FieldLT(FieldSelectorMs, v))
+}
+
+// SelectorMsLTE applies the LTE predicate on the "selector_ms" field.
+func SelectorMsLTE(v float64) predicate.CheckResult {
+	return predicate.CheckResult(sql.FieldLTE(FieldSelectorMs, v))
+}
+
+// SelectorMsIsNil applies the IsNil predicate on the "selector_ms" field.
+func SelectorMsIsNil() predicate.CheckResult {
+	return predicate.CheckResult(sql.FieldIsNull(FieldSelectorMs))
+}
+
+// SelectorMsNotNil applies the NotNil predicate on the "selector_ms" field.
+func SelectorMsNotNil() predicate.CheckResult {
+	return predicate.CheckResult(sql.FieldNotNull(FieldSelectorMs))
+}
+
+// DiffMsEQ applies the EQ predicate on the "diff_ms" field.
+func DiffMsEQ(v float64) predicate.CheckResult {
+	return predicate.CheckResult(sql.FieldEQ(FieldDiffMs, v))
+}
+
+// DiffMsNEQ applies the NEQ predicate on the "diff_ms" field.
+func DiffMsNEQ(v float64) predicate.CheckResult {
+	return predicate.CheckResult(sql.FieldNEQ(FieldDiffMs, v))
+}
+
+// DiffMsIn applies the In predicate on the "diff_ms" field.
+func DiffMsIn(vs ...float64) predicate.CheckResult {
+	return predicate.CheckResult(sql.FieldIn(FieldDiffMs, vs...))
+}
+
+// DiffMsNotIn applies the NotIn predicate on the "diff_ms" field.
+func DiffMsNotIn(vs ...float64) predicate.CheckResult {
+	return predicate.CheckResult(sql.FieldNotIn(FieldDiffMs, vs...))
+}
+
+// DiffMsGT applies the GT predicate on the "diff_ms" field.
+func DiffMsGT(v float64) predicate.CheckResult {
+	return predicate.CheckResult(sql.FieldGT(FieldDiffMs, v))
+}
+
+// DiffMsGTE applies the GTE predicate on the "diff_ms" field.
+func DiffMsGTE(v float64) predicate.CheckResult {
+	return predicate.CheckResult(sql.FieldGTE(FieldDiffMs, v))
+}
+
+// DiffMsLT applies the LT predicate on the "diff_ms" field.
+func DiffMsLT(v float64) predicate.CheckResult {
+	return predicate.CheckResult(sql.FieldLT(FieldDiffMs, v))
+}
+
+// DiffMsLTE applies the LTE predicate on the "diff_ms" field.
+func DiffMsLTE(v float64) predicate.CheckResult {
+	return predicate.CheckResult(sql.FieldLTE(FieldDiffMs, v))
+}
+
+// DiffMsIsNil applies the IsNil predicate on the "diff_ms" field.
+func DiffMsIsNil() predicate.CheckResult {
+	return predicate.CheckResult(sql.FieldIsNull(FieldDiffMs))
+}
+
+// DiffMsNotNil applies the NotNil predicate on the "diff_ms" field.
+func DiffMsNotNil() predicate.CheckResult {
+	return predicate.CheckResult(sql.FieldNotNull(FieldDiffMs))
+}
+
 // CheckedAtEQ applies the EQ predicate on the "checked_at" field.
 func CheckedAtEQ(v time.Time) predicate.CheckResult {
 	return predicate.CheckResult(sql.FieldEQ(FieldCheckedAt, v))
@@ -775,6 +1320,26 @@ func CheckedAtLTE(v time.Time) predicate.CheckResult {
 	return predicate.CheckResult(sql.FieldLTE(FieldCheckedAt, v))
 }
 
+// MonitorIDEQ applies the EQ predicate on the "monitor_id" field.
+func MonitorIDEQ(v int) predicate.CheckResult {
+	return predicate.CheckResult(sql.FieldEQ(FieldMonitorID, v))
+}
+
+// MonitorIDNEQ applies the NEQ predicate on the "monitor_id" field.
+func MonitorIDNEQ(v int) predicate.CheckResult {
+	return predicate.CheckResult(sql.FieldNEQ(FieldMonitorID, v))
+}
+
+// MonitorIDIn applies the In predicate on the "monitor_id" field.
+func MonitorIDIn(vs ...int) predicate.CheckResult {
+	return predicate.CheckResult(sql.FieldIn(FieldMonitorID, vs...))
+}
+
+// MonitorIDNotIn applies the NotIn predicate on the "monitor_id" field.
+func MonitorIDNotIn(vs ...int) predicate.CheckResult {
+	return predicate.CheckResult(sql.FieldNotIn(FieldMonitorID, vs...))
+}
+
 // HasMonitor applies the HasEdge predicate on the "monitor" edge.
 func HasMonitor() predicate.CheckResult {
 	return predicate.CheckResult(func(s *sql.Selector) {
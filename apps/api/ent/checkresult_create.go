@@ -161,6 +161,130 @@ func (_c *CheckResultCreate) SetNillableDiffDetails(v *string) *CheckResultCreat
 	return _c
 }
 
+// SetBodySnapshot sets the "body_snapshot" field.
+func (_c *CheckResultCreate) SetBodySnapshot(v []byte) *CheckResultCreate {
+	_c.mutation.SetBodySnapshot(v)
+	return _c
+}
+
+// SetBodySnapshotEncoding sets the "body_snapshot_encoding" field.
+func (_c *CheckResultCreate) SetBodySnapshotEncoding(v string) *CheckResultCreate {
+	_c.mutation.SetBodySnapshotEncoding(v)
+	return _c
+}
+
+// SetNillableBodySnapshotEncoding sets the "body_snapshot_encoding" field if the given value is not nil.
+func (_c *CheckResultCreate) SetNillableBodySnapshotEncoding(v *string) *CheckResultCreate {
+	if v != nil {
+		_c.SetBodySnapshotEncoding(*v)
+	}
+	return _c
+}
+
+// SetBodySize sets the "body_size" field.
+func (_c *CheckResultCreate) SetBodySize(v int) *CheckResultCreate {
+	_c.mutation.SetBodySize(v)
+	return _c
+}
+
+// SetNillableBodySize sets the "body_size" field if the given value is not nil.
+func (_c *CheckResultCreate) SetNillableBodySize(v *int) *CheckResultCreate {
+	if v != nil {
+		_c.SetBodySize(*v)
+	}
+	return _c
+}
+
+// SetBodySnapshotTruncated sets the "body_snapshot_truncated" field.
+func (_c *CheckResultCreate) SetBodySnapshotTruncated(v bool) *CheckResultCreate {
+	_c.mutation.SetBodySnapshotTruncated(v)
+	return _c
+}
+
+// SetNillableBodySnapshotTruncated sets the "body_snapshot_truncated" field if the given value is not nil.
+func (_c *CheckResultCreate) SetNillableBodySnapshotTruncated(v *bool) *CheckResultCreate {
+	if v != nil {
+		_c.SetBodySnapshotTruncated(*v)
+	}
+	return _c
+}
+
+// SetBodyHash sets the "body_hash" field.
+func (_c *CheckResultCreate) SetBodyHash(v string) *CheckResultCreate {
+	_c.mutation.SetBodyHash(v)
+	return _c
+}
+
+// SetNillableBodyHash sets the "body_hash" field if the given value is not nil.
+func (_c *CheckResultCreate) SetNillableBodyHash(v *string) *CheckResultCreate {
+	if v != nil {
+		_c.SetBodyHash(*v)
+	}
+	return _c
+}
+
+// SetTrackedHeaderValue sets the "tracked_header_value" field.
+func (_c *CheckResultCreate) SetTrackedHeaderValue(v string) *CheckResultCreate {
+	_c.mutation.SetTrackedHeaderValue(v)
+	return _c
+}
+
+// SetNillableTrackedHeaderValue sets the "tracked_header_value" field if the given value is not nil.
+func (_c *CheckResultCreate) SetNillableTrackedHeaderValue(v *string) *CheckResultCreate {
+	if v != nil {
+		_c.SetTrackedHeaderValue(*v)
+	}
+	return _c
+}
+
+// SetRedirectChain sets the "redirect_chain" field.
+func (_c *CheckResultCreate) SetRedirectChain(v []string) *CheckResultCreate {
+	_c.mutation.SetRedirectChain(v)
+	return _c
+}
+
+// SetBodyReadMs sets the "body_read_ms" field.
+func (_c *CheckResultCreate) SetBodyReadMs(v float64) *CheckResultCreate {
+	_c.mutation.SetBodyReadMs(v)
+	return _c
+}
+
+// SetNillableBodyReadMs sets the "body_read_ms" field if the given value is not nil.
+func (_c *CheckResultCreate) SetNillableBodyReadMs(v *float64) *CheckResultCreate {
+	if v != nil {
+		_c.SetBodyReadMs(*v)
+	}
+	return _c
+}
+
+// SetSelectorMs sets the "selector_ms" field.
+func (_c *CheckResultCreate) SetSelectorMs(v float64) *CheckResultCreate {
+	_c.mutation.SetSelectorMs(v)
+	return _c
+}
+
+// SetNillableSelectorMs sets the "selector_ms" field if the given value is not nil.
+func (_c *CheckResultCreate) SetNillableSelectorMs(v *float64) *CheckResultCreate {
+	if v != nil {
+		_c.SetSelectorMs(*v)
+	}
+	return _c
+}
+
+// SetDiffMs sets the "diff_ms" field.
+func (_c *CheckResultCreate) SetDiffMs(v float64) *CheckResultCreate {
+	_c.mutation.SetDiffMs(v)
+	return _c
+}
+
+// SetNillableDiffMs sets the "diff_ms" field if the given value is not nil.
+func (_c *CheckResultCreate) SetNillableDiffMs(v *float64) *CheckResultCreate {
+	if v != nil {
+		_c.SetDiffMs(*v)
+	}
+	return _c
+}
+
 // SetCheckedAt sets the "checked_at" field.
 func (_c *CheckResultCreate) SetCheckedAt(v time.Time) *CheckResultCreate {
 	_c.mutation.SetCheckedAt(v)
@@ -175,9 +299,9 @@ func (_c *CheckResultCreate) SetNillableCheckedAt(v *time.Time) *CheckResultCrea
 	return _c
 }
 
-// SetMonitorID sets the "monitor" edge to the Monitor entity by ID.
-func (_c *CheckResultCreate) SetMonitorID(id int) *CheckResultCreate {
-	_c.mutation.SetMonitorID(id)
+// SetMonitorID sets the "monitor_id" field.
+func (_c *CheckResultCreate) SetMonitorID(v int) *CheckResultCreate {
+	_c.mutation.SetMonitorID(v)
 	return _c
 }
 
@@ -229,6 +353,10 @@ func (_c *CheckResultCreate) defaults() {
 		v := checkresult.DefaultDiffChanged
 		_c.mutation.SetDiffChanged(v)
 	}
+	if _, ok := _c.mutation.BodySnapshotTruncated(); !ok {
+		v := checkresult.DefaultBodySnapshotTruncated
+		_c.mutation.SetBodySnapshotTruncated(v)
+	}
 	if _, ok := _c.mutation.CheckedAt(); !ok {
 		v := checkresult.DefaultCheckedAt()
 		_c.mutation.SetCheckedAt(v)
@@ -243,9 +371,15 @@ func (_c *CheckResultCreate) check() error {
 	if _, ok := _c.mutation.DiffChanged(); !ok {
 		return &ValidationError{Name: "diff_changed", err: errors.New(`ent: missing required field "CheckResult.diff_changed"`)}
 	}
+	if _, ok := _c.mutation.BodySnapshotTruncated(); !ok {
+		return &ValidationError{Name: "body_snapshot_truncated", err: errors.New(`ent: missing required field "CheckResult.body_snapshot_truncated"`)}
+	}
 	if _, ok := _c.mutation.CheckedAt(); !ok {
 		return &ValidationError{Name: "checked_at", err: errors.New(`ent: missing required field "CheckResult.checked_at"`)}
 	}
+	if _, ok := _c.mutation.MonitorID(); !ok {
+		return &ValidationError{Name: "monitor_id", err: errors.New(`ent: missing required field "CheckResult.monitor_id"`)}
+	}
 	if len(_c.mutation.MonitorIDs()) == 0 {
 		return &ValidationError{Name: "monitor", err: errors.New(`ent: missing required edge "CheckResult.monitor"`)}
 	}
@@ -315,6 +449,46 @@ func (_c *CheckResultCreate) createSpec() (*CheckResult, *sqlgraph.CreateSpec) {
 		_spec.SetField(checkresult.FieldDiffDetails, field.TypeString, value)
 		_node.DiffDetails = &value
 	}
+	if value, ok := _c.mutation.BodySnapshot(); ok {
+		_spec.SetField(checkresult.FieldBodySnapshot, field.TypeBytes, value)
+		_node.BodySnapshot = &value
+	}
+	if value, ok := _c.mutation.BodySnapshotEncoding(); ok {
+		_spec.SetField(checkresult.FieldBodySnapshotEncoding, field.TypeString, value)
+		_node.BodySnapshotEncoding = &value
+	}
+	if value, ok := _c.mutation.BodySize(); ok {
+		_spec.SetField(checkresult.FieldBodySize, field.TypeInt, value)
+		_node.BodySize = &value
+	}
+	if value, ok := _c.mutation.BodySnapshotTruncated(); ok {
+		_spec.SetField(checkresult.FieldBodySnapshotTruncated, field.TypeBool, value)
+		_node.BodySnapshotTruncated = value
+	}
+	if value, ok := _c.mutation.BodyHash(); ok {
+		_spec.SetField(checkresult.FieldBodyHash, field.TypeString, value)
+		_node.BodyHash = &value
+	}
+	if value, ok := _c.mutation.TrackedHeaderValue(); ok {
+		_spec.SetField(checkresult.FieldTrackedHeaderValue, field.TypeString, value)
+		_node.TrackedHeaderValue = &value
+	}
+	if value, ok := _c.mutation.RedirectChain(); ok {
+		_spec.SetField(checkresult.FieldRedirectChain, field.TypeJSON, value)
+		_node.RedirectChain = value
+	}
+	if value, ok := _c.mutation.BodyReadMs(); ok {
+		_spec.SetField(checkresult.FieldBodyReadMs, field.TypeFloat64, value)
+		_node.BodyReadMs = &value
+	}
+	if value, ok := _c.mutation.SelectorMs(); ok {
+		_spec.SetField(checkresult.FieldSelectorMs, field.TypeFloat64, value)
+		_node.SelectorMs = &value
+	}
+	if value, ok := _c.mutation.DiffMs(); ok {
+		_spec.SetField(checkresult.FieldDiffMs, field.TypeFloat64, value)
+		_node.DiffMs = &value
+	}
 	if value, ok := _c.mutation.CheckedAt(); ok {
 		_spec.SetField(checkresult.FieldCheckedAt, field.TypeTime, value)
 		_node.CheckedAt = value
@@ -333,7 +507,7 @@ func (_c *CheckResultCreate) createSpec() (*CheckResult, *sqlgraph.CreateSpec) {
 		for _, k := range nodes {
 			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
-		_node.monitor_check_results = &nodes[0]
+		_node.MonitorID = nodes[0]
 		_spec.Edges = append(_spec.Edges, edge)
 	}
 	return _node, _spec
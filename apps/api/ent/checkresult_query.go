@@ -24,7 +24,6 @@ type CheckResultQuery struct {
 	inters      []Interceptor
 	predicates  []predicate.CheckResult
 	withMonitor *MonitorQuery
-	withFKs     bool
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -370,18 +369,11 @@ func (_q *CheckResultQuery) prepareQuery(ctx context.Context) error {
 func (_q *CheckResultQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*CheckResult, error) {
 	var (
 		nodes       = []*CheckResult{}
-		withFKs     = _q.withFKs
 		_spec       = _q.querySpec()
 		loadedTypes = [1]bool{
 			_q.withMonitor != nil,
 		}
 	)
-	if _q.withMonitor != nil {
-		withFKs = true
-	}
-	if withFKs {
-		_spec.Node.Columns = append(_spec.Node.Columns, checkresult.ForeignKeys...)
-	}
 	_spec.ScanValues = func(columns []string) ([]any, error) {
 		return (*CheckResult).scanValues(nil, columns)
 	}
@@ -413,10 +405,7 @@ func (_q *CheckResultQuery) loadMonitor(ctx context.Context, query *MonitorQuery
 	ids := make([]int, 0, len(nodes))
 	nodeids := make(map[int][]*CheckResult)
 	for i := range nodes {
-		if nodes[i].monitor_check_results == nil {
-			continue
-		}
-		fk := *nodes[i].monitor_check_results
+		fk := nodes[i].MonitorID
 		if _, ok := nodeids[fk]; !ok {
 			ids = append(ids, fk)
 		}
@@ -433,7 +422,7 @@ func (_q *CheckResultQuery) loadMonitor(ctx context.Context, query *MonitorQuery
 	for _, n := range neighbors {
 		nodes, ok := nodeids[n.ID]
 		if !ok {
-			return fmt.Errorf(`unexpected foreign-key "monitor_check_results" returned %v`, n.ID)
+			return fmt.Errorf(`unexpected foreign-key "monitor_id" returned %v`, n.ID)
 		}
 		for i := range nodes {
 			assign(nodes[i], n)
@@ -467,6 +456,9 @@ func (_q *CheckResultQuery) querySpec() *sqlgraph.QuerySpec {
 				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
 			}
 		}
+		if _q.withMonitor != nil {
+			_spec.Node.AddColumnOnce(checkresult.FieldMonitorID)
+		}
 	}
 	if ps := _q.predicates; len(ps) > 0 {
 		_spec.Predicate = func(selector *sql.Selector) {
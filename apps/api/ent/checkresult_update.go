@@ -13,6 +13,7 @@ import (
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
 	"entgo.io/ent/schema/field"
 )
 
@@ -231,6 +232,218 @@ func (_u *CheckResultUpdate) ClearDiffDetails() *CheckResultUpdate {
 	return _u
 }
 
+// SetBodySnapshot sets the "body_snapshot" field.
+func (_u *CheckResultUpdate) SetBodySnapshot(v []byte) *CheckResultUpdate {
+	_u.mutation.SetBodySnapshot(v)
+	return _u
+}
+
+// ClearBodySnapshot clears the value of the "body_snapshot" field.
+func (_u *CheckResultUpdate) ClearBodySnapshot() *CheckResultUpdate {
+	_u.mutation.ClearBodySnapshot()
+	return _u
+}
+
+// SetBodySnapshotEncoding sets the "body_snapshot_encoding" field.
+func (_u *CheckResultUpdate) SetBodySnapshotEncoding(v string) *CheckResultUpdate {
+	_u.mutation.SetBodySnapshotEncoding(v)
+	return _u
+}
+
+// SetNillableBodySnapshotEncoding sets the "body_snapshot_encoding" field if the given value is not nil.
+func (_u *CheckResultUpdate) SetNillableBodySnapshotEncoding(v *string) *CheckResultUpdate {
+	if v != nil {
+		_u.SetBodySnapshotEncoding(*v)
+	}
+	return _u
+}
+
+// ClearBodySnapshotEncoding clears the value of the "body_snapshot_encoding" field.
+func (_u *CheckResultUpdate) ClearBodySnapshotEncoding() *CheckResultUpdate {
+	_u.mutation.ClearBodySnapshotEncoding()
+	return _u
+}
+
+// SetBodySize sets the "body_size" field.
+func (_u *CheckResultUpdate) SetBodySize(v int) *CheckResultUpdate {
+	_u.mutation.ResetBodySize()
+	_u.mutation.SetBodySize(v)
+	return _u
+}
+
+// SetNillableBodySize sets the "body_size" field if the given value is not nil.
+func (_u *CheckResultUpdate) SetNillableBodySize(v *int) *CheckResultUpdate {
+	if v != nil {
+		_u.SetBodySize(*v)
+	}
+	return _u
+}
+
+// AddBodySize adds value to the "body_size" field.
+func (_u *CheckResultUpdate) AddBodySize(v int) *CheckResultUpdate {
+	_u.mutation.AddBodySize(v)
+	return _u
+}
+
+// ClearBodySize clears the value of the "body_size" field.
+func (_u *CheckResultUpdate) ClearBodySize() *CheckResultUpdate {
+	_u.mutation.ClearBodySize()
+	return _u
+}
+
+// SetBodySnapshotTruncated sets the "body_snapshot_truncated" field.
+func (_u *CheckResultUpdate) SetBodySnapshotTruncated(v bool) *CheckResultUpdate {
+	_u.mutation.SetBodySnapshotTruncated(v)
+	return _u
+}
+
+// SetNillableBodySnapshotTruncated sets the "body_snapshot_truncated" field if the given value is not nil.
+func (_u *CheckResultUpdate) SetNillableBodySnapshotTruncated(v *bool) *CheckResultUpdate {
+	if v != nil {
+		_u.SetBodySnapshotTruncated(*v)
+	}
+	return _u
+}
+
+// SetBodyHash sets the "body_hash" field.
+func (_u *CheckResultUpdate) SetBodyHash(v string) *CheckResultUpdate {
+	_u.mutation.SetBodyHash(v)
+	return _u
+}
+
+// SetNillableBodyHash sets the "body_hash" field if the given value is not nil.
+func (_u *CheckResultUpdate) SetNillableBodyHash(v *string) *CheckResultUpdate {
+	if v != nil {
+		_u.SetBodyHash(*v)
+	}
+	return _u
+}
+
+// ClearBodyHash clears the value of the "body_hash" field.
+func (_u *CheckResultUpdate) ClearBodyHash() *CheckResultUpdate {
+	_u.mutation.ClearBodyHash()
+	return _u
+}
+
+// SetTrackedHeaderValue sets the "tracked_header_value" field.
+func (_u *CheckResultUpdate) SetTrackedHeaderValue(v string) *CheckResultUpdate {
+	_u.mutation.SetTrackedHeaderValue(v)
+	return _u
+}
+
+// SetNillableTrackedHeaderValue sets the "tracked_header_value" field if the given value is not nil.
+func (_u *CheckResultUpdate) SetNillableTrackedHeaderValue(v *string) *CheckResultUpdate {
+	if v != nil {
+		_u.SetTrackedHeaderValue(*v)
+	}
+	return _u
+}
+
+// ClearTrackedHeaderValue clears the value of the "tracked_header_value" field.
+func (_u *CheckResultUpdate) ClearTrackedHeaderValue() *CheckResultUpdate {
+	_u.mutation.ClearTrackedHeaderValue()
+	return _u
+}
+
+// SetRedirectChain sets the "redirect_chain" field.
+func (_u *CheckResultUpdate) SetRedirectChain(v []string) *CheckResultUpdate {
+	_u.mutation.SetRedirectChain(v)
+	return _u
+}
+
+// AppendRedirectChain appends value to the "redirect_chain" field.
+func (_u *CheckResultUpdate) AppendRedirectChain(v []string) *CheckResultUpdate {
+	_u.mutation.AppendRedirectChain(v)
+	return _u
+}
+
+// ClearRedirectChain clears the value of the "redirect_chain" field.
+func (_u *CheckResultUpdate) ClearRedirectChain() *CheckResultUpdate {
+	_u.mutation.ClearRedirectChain()
+	return _u
+}
+
+// SetBodyReadMs sets the "body_read_ms" field.
+func (_u *CheckResultUpdate) SetBodyReadMs(v float64) *CheckResultUpdate {
+	_u.mutation.ResetBodyReadMs()
+	_u.mutation.SetBodyReadMs(v)
+	return _u
+}
+
+// SetNillableBodyReadMs sets the "body_read_ms" field if the given value is not nil.
+func (_u *CheckResultUpdate) SetNillableBodyReadMs(v *float64) *CheckResultUpdate {
+	if v != nil {
+		_u.SetBodyReadMs(*v)
+	}
+	return _u
+}
+
+// AddBodyReadMs adds value to the "body_read_ms" field.
+func (_u *CheckResultUpdate) AddBodyReadMs(v float64) *CheckResultUpdate {
+	_u.mutation.AddBodyReadMs(v)
+	return _u
+}
+
+// ClearBodyReadMs clears the value of the "body_read_ms" field.
+func (_u *CheckResultUpdate) ClearBodyReadMs() *CheckResultUpdate {
+	_u.mutation.ClearBodyReadMs()
+	return _u
+}
+
+// SetSelectorMs sets the "selector_ms" field.
+func (_u *CheckResultUpdate) SetSelectorMs(v float64) *CheckResultUpdate {
+	_u.mutation.ResetSelectorMs()
+	_u.mutation.SetSelectorMs(v)
+	return _u
+}
+
+// SetNillableSelectorMs sets the "selector_ms" field if the given value is not nil.
+func (_u *CheckResultUpdate) SetNillableSelectorMs(v *float64) *CheckResultUpdate {
+	if v != nil {
+		_u.SetSelectorMs(*v)
+	}
+	return _u
+}
+
+// AddSelectorMs adds value to the "selector_ms" field.
+func (_u *CheckResultUpdate) AddSelectorMs(v float64) *CheckResultUpdate {
+	_u.mutation.AddSelectorMs(v)
+	return _u
+}
+
+// ClearSelectorMs clears the value of the "selector_ms" field.
+func (_u *CheckResultUpdate) ClearSelectorMs() *CheckResultUpdate {
+	_u.mutation.ClearSelectorMs()
+	return _u
+}
+
+// SetDiffMs sets the "diff_ms" field.
+func (_u *CheckResultUpdate) SetDiffMs(v float64) *CheckResultUpdate {
+	_u.mutation.ResetDiffMs()
+	_u.mutation.SetDiffMs(v)
+	return _u
+}
+
+// SetNillableDiffMs sets the "diff_ms" field if the given value is not nil.
+func (_u *CheckResultUpdate) SetNillableDiffMs(v *float64) *CheckResultUpdate {
+	if v != nil {
+		_u.SetDiffMs(*v)
+	}
+	return _u
+}
+
+// AddDiffMs adds value to the "diff_ms" field.
+func (_u *CheckResultUpdate) AddDiffMs(v float64) *CheckResultUpdate {
+	_u.mutation.AddDiffMs(v)
+	return _u
+}
+
+// ClearDiffMs clears the value of the "diff_ms" field.
+func (_u *CheckResultUpdate) ClearDiffMs() *CheckResultUpdate {
+	_u.mutation.ClearDiffMs()
+	return _u
+}
+
 // SetCheckedAt sets the "checked_at" field.
 func (_u *CheckResultUpdate) SetCheckedAt(v time.Time) *CheckResultUpdate {
 	_u.mutation.SetCheckedAt(v)
@@ -245,9 +458,17 @@ func (_u *CheckResultUpdate) SetNillableCheckedAt(v *time.Time) *CheckResultUpda
 	return _u
 }
 
-// SetMonitorID sets the "monitor" edge to the Monitor entity by ID.
-func (_u *CheckResultUpdate) SetMonitorID(id int) *CheckResultUpdate {
-	_u.mutation.SetMonitorID(id)
+// SetMonitorID sets the "monitor_id" field.
+func (_u *CheckResultUpdate) SetMonitorID(v int) *CheckResultUpdate {
+	_u.mutation.SetMonitorID(v)
+	return _u
+}
+
+// SetNillableMonitorID sets the "monitor_id" field if the given value is not nil.
+func (_u *CheckResultUpdate) SetNillableMonitorID(v *int) *CheckResultUpdate {
+	if v != nil {
+		_u.SetMonitorID(*v)
+	}
 	return _u
 }
 
@@ -374,6 +595,80 @@ func (_u *CheckResultUpdate) sqlSave(ctx context.Context) (_node int, err error)
 	if _u.mutation.DiffDetailsCleared() {
 		_spec.ClearField(checkresult.FieldDiffDetails, field.TypeString)
 	}
+	if value, ok := _u.mutation.BodySnapshot(); ok {
+		_spec.SetField(checkresult.FieldBodySnapshot, field.TypeBytes, value)
+	}
+	if _u.mutation.BodySnapshotCleared() {
+		_spec.ClearField(checkresult.FieldBodySnapshot, field.TypeBytes)
+	}
+	if value, ok := _u.mutation.BodySnapshotEncoding(); ok {
+		_spec.SetField(checkresult.FieldBodySnapshotEncoding, field.TypeString, value)
+	}
+	if _u.mutation.BodySnapshotEncodingCleared() {
+		_spec.ClearField(checkresult.FieldBodySnapshotEncoding, field.TypeString)
+	}
+	if value, ok := _u.mutation.BodySize(); ok {
+		_spec.SetField(checkresult.FieldBodySize, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedBodySize(); ok {
+		_spec.AddField(checkresult.FieldBodySize, field.TypeInt, value)
+	}
+	if _u.mutation.BodySizeCleared() {
+		_spec.ClearField(checkresult.FieldBodySize, field.TypeInt)
+	}
+	if value, ok := _u.mutation.BodySnapshotTruncated(); ok {
+		_spec.SetField(checkresult.FieldBodySnapshotTruncated, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.BodyHash(); ok {
+		_spec.SetField(checkresult.FieldBodyHash, field.TypeString, value)
+	}
+	if _u.mutation.BodyHashCleared() {
+		_spec.ClearField(checkresult.FieldBodyHash, field.TypeString)
+	}
+	if value, ok := _u.mutation.TrackedHeaderValue(); ok {
+		_spec.SetField(checkresult.FieldTrackedHeaderValue, field.TypeString, value)
+	}
+	if _u.mutation.TrackedHeaderValueCleared() {
+		_spec.ClearField(checkresult.FieldTrackedHeaderValue, field.TypeString)
+	}
+	if value, ok := _u.mutation.RedirectChain(); ok {
+		_spec.SetField(checkresult.FieldRedirectChain, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedRedirectChain(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, checkresult.FieldRedirectChain, value)
+		})
+	}
+	if _u.mutation.RedirectChainCleared() {
+		_spec.ClearField(checkresult.FieldRedirectChain, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.BodyReadMs(); ok {
+		_spec.SetField(checkresult.FieldBodyReadMs, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedBodyReadMs(); ok {
+		_spec.AddField(checkresult.FieldBodyReadMs, field.TypeFloat64, value)
+	}
+	if _u.mutation.BodyReadMsCleared() {
+		_spec.ClearField(checkresult.FieldBodyReadMs, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.SelectorMs(); ok {
+		_spec.SetField(checkresult.FieldSelectorMs, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedSelectorMs(); ok {
+		_spec.AddField(checkresult.FieldSelectorMs, field.TypeFloat64, value)
+	}
+	if _u.mutation.SelectorMsCleared() {
+		_spec.ClearField(checkresult.FieldSelectorMs, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.DiffMs(); ok {
+		_spec.SetField(checkresult.FieldDiffMs, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedDiffMs(); ok {
+		_spec.AddField(checkresult.FieldDiffMs, field.TypeFloat64, value)
+	}
+	if _u.mutation.DiffMsCleared() {
+		_spec.ClearField(checkresult.FieldDiffMs, field.TypeFloat64)
+	}
 	if value, ok := _u.mutation.CheckedAt(); ok {
 		_spec.SetField(checkresult.FieldCheckedAt, field.TypeTime, value)
 	}
@@ -628,6 +923,218 @@ func (_u *CheckResultUpdateOne) ClearDiffDetails() *CheckResultUpdateOne {
 	return _u
 }
 
+// SetBodySnapshot sets the "body_snapshot" field.
+func (_u *CheckResultUpdateOne) SetBodySnapshot(v []byte) *CheckResultUpdateOne {
+	_u.mutation.SetBodySnapshot(v)
+	return _u
+}
+
+// ClearBodySnapshot clears the value of the "body_snapshot" field.
+func (_u *CheckResultUpdateOne) ClearBodySnapshot() *CheckResultUpdateOne {
+	_u.mutation.ClearBodySnapshot()
+	return _u
+}
+
+// SetBodySnapshotEncoding sets the "body_snapshot_encoding" field.
+func (_u *CheckResultUpdateOne) SetBodySnapshotEncoding(v string) *CheckResultUpdateOne {
+	_u.mutation.SetBodySnapshotEncoding(v)
+	return _u
+}
+
+// SetNillableBodySnapshotEncoding sets the "body_snapshot_encoding" field if the given value is not nil.
+func (_u *CheckResultUpdateOne) SetNillableBodySnapshotEncoding(v *string) *CheckResultUpdateOne {
+	if v != nil {
+		_u.SetBodySnapshotEncoding(*v)
+	}
+	return _u
+}
+
+// ClearBodySnapshotEncoding clears the value of the "body_snapshot_encoding" field.
+func (_u *CheckResultUpdateOne) ClearBodySnapshotEncoding() *CheckResultUpdateOne {
+	_u.mutation.ClearBodySnapshotEncoding()
+	return _u
+}
+
+// SetBodySize sets the "body_size" field.
+func (_u *CheckResultUpdateOne) SetBodySize(v int) *CheckResultUpdateOne {
+	_u.mutation.ResetBodySize()
+	_u.mutation.SetBodySize(v)
+	return _u
+}
+
+// SetNillableBodySize sets the "body_size" field if the given value is not nil.
+func (_u *CheckResultUpdateOne) SetNillableBodySize(v *int) *CheckResultUpdateOne {
+	if v != nil {
+		_u.SetBodySize(*v)
+	}
+	return _u
+}
+
+// AddBodySize adds value to the "body_size" field.
+func (_u *CheckResultUpdateOne) AddBodySize(v int) *CheckResultUpdateOne {
+	_u.mutation.AddBodySize(v)
+	return _u
+}
+
+// ClearBodySize clears the value of the "body_size" field.
+func (_u *CheckResultUpdateOne) ClearBodySize() *CheckResultUpdateOne {
+	_u.mutation.ClearBodySize()
+	return _u
+}
+
+// SetBodySnapshotTruncated sets the "body_snapshot_truncated" field.
+func (_u *CheckResultUpdateOne) SetBodySnapshotTruncated(v bool) *CheckResultUpdateOne {
+	_u.mutation.SetBodySnapshotTruncated(v)
+	return _u
+}
+
+// SetNillableBodySnapshotTruncated sets the "body_snapshot_truncated" field if the given value is not nil.
+func (_u *CheckResultUpdateOne) SetNillableBodySnapshotTruncated(v *bool) *CheckResultUpdateOne {
+	if v != nil {
+		_u.SetBodySnapshotTruncated(*v)
+	}
+	return _u
+}
+
+// SetBodyHash sets the "body_hash" field.
+func (_u *CheckResultUpdateOne) SetBodyHash(v string) *CheckResultUpdateOne {
+	_u.mutation.SetBodyHash(v)
+	return _u
+}
+
+// SetNillableBodyHash sets the "body_hash" field if the given value is not nil.
+func (_u *CheckResultUpdateOne) SetNillableBodyHash(v *string) *CheckResultUpdateOne {
+	if v != nil {
+		_u.SetBodyHash(*v)
+	}
+	return _u
+}
+
+// ClearBodyHash clears the value of the "body_hash" field.
+func (_u *CheckResultUpdateOne) ClearBodyHash() *CheckResultUpdateOne {
+	_u.mutation.ClearBodyHash()
+	return _u
+}
+
+// SetTrackedHeaderValue sets the "tracked_header_value" field.
+func (_u *CheckResultUpdateOne) SetTrackedHeaderValue(v string) *CheckResultUpdateOne {
+	_u.mutation.SetTrackedHeaderValue(v)
+	return _u
+}
+
+// SetNillableTrackedHeaderValue sets the "tracked_header_value" field if the given value is not nil.
+func (_u *CheckResultUpdateOne) SetNillableTrackedHeaderValue(v *string) *CheckResultUpdateOne {
+	if v != nil {
+		_u.SetTrackedHeaderValue(*v)
+	}
+	return _u
+}
+
+// ClearTrackedHeaderValue clears the value of the "tracked_header_value" field.
+func (_u *CheckResultUpdateOne) ClearTrackedHeaderValue() *CheckResultUpdateOne {
+	_u.mutation.ClearTrackedHeaderValue()
+	return _u
+}
+
+// SetRedirectChain sets the "redirect_chain" field.
+func (_u *CheckResultUpdateOne) SetRedirectChain(v []string) *CheckResultUpdateOne {
+	_u.mutation.SetRedirectChain(v)
+	return _u
+}
+
+// AppendRedirectChain appends value to the "redirect_chain" field.
+func (_u *CheckResultUpdateOne) AppendRedirectChain(v []string) *CheckResultUpdateOne {
+	_u.mutation.AppendRedirectChain(v)
+	return _u
+}
+
+// ClearRedirectChain clears the value of the "redirect_chain" field.
+func (_u *CheckResultUpdateOne) ClearRedirectChain() *CheckResultUpdateOne {
+	_u.mutation.ClearRedirectChain()
+	return _u
+}
+
+// SetBodyReadMs sets the "body_read_ms" field.
+func (_u *CheckResultUpdateOne) SetBodyReadMs(v float64) *CheckResultUpdateOne {
+	_u.mutation.ResetBodyReadMs()
+	_u.mutation.SetBodyReadMs(v)
+	return _u
+}
+
+// SetNillableBodyReadMs sets the "body_read_ms" field if the given value is not nil.
+func (_u *CheckResultUpdateOne) SetNillableBodyReadMs(v *float64) *CheckResultUpdateOne {
+	if v != nil {
+		_u.SetBodyReadMs(*v)
+	}
+	return _u
+}
+
+// AddBodyReadMs adds value to the "body_read_ms" field.
+func (_u *CheckResultUpdateOne) AddBodyReadMs(v float64) *CheckResultUpdateOne {
+	_u.mutation.AddBodyReadMs(v)
+	return _u
+}
+
+// ClearBodyReadMs clears the value of the "body_read_ms" field.
+func (_u *CheckResultUpdateOne) ClearBodyReadMs() *CheckResultUpdateOne {
+	_u.mutation.ClearBodyReadMs()
+	return _u
+}
+
+// SetSelectorMs sets the "selector_ms" field.
+func (_u *CheckResultUpdateOne) SetSelectorMs(v float64) *CheckResultUpdateOne {
+	_u.mutation.ResetSelectorMs()
+	_u.mutation.SetSelectorMs(v)
+	return _u
+}
+
+// SetNillableSelectorMs sets the "selector_ms" field if the given value is not nil.
+func (_u *CheckResultUpdateOne) SetNillableSelectorMs(v *float64) *CheckResultUpdateOne {
+	if v != nil {
+		_u.SetSelectorMs(*v)
+	}
+	return _u
+}
+
+// AddSelectorMs adds value to the "selector_ms" field.
+func (_u *CheckResultUpdateOne) AddSelectorMs(v float64) *CheckResultUpdateOne {
+	_u.mutation.AddSelectorMs(v)
+	return _u
+}
+
+// ClearSelectorMs clears the value of the "selector_ms" field.
+func (_u *CheckResultUpdateOne) ClearSelectorMs() *CheckResultUpdateOne {
+	_u.mutation.ClearSelectorMs()
+	return _u
+}
+
+// SetDiffMs sets the "diff_ms" field.
+func (_u *CheckResultUpdateOne) SetDiffMs(v float64) *CheckResultUpdateOne {
+	_u.mutation.ResetDiffMs()
+	_u.mutation.SetDiffMs(v)
+	return _u
+}
+
+// SetNillableDiffMs sets the "diff_ms" field if the given value is not nil.
+func (_u *CheckResultUpdateOne) SetNillableDiffMs(v *float64) *CheckResultUpdateOne {
+	if v != nil {
+		_u.SetDiffMs(*v)
+	}
+	return _u
+}
+
+// AddDiffMs adds value to the "diff_ms" field.
+func (_u *CheckResultUpdateOne) AddDiffMs(v float64) *CheckResultUpdateOne {
+	_u.mutation.AddDiffMs(v)
+	return _u
+}
+
+// ClearDiffMs clears the value of the "diff_ms" field.
+func (_u *CheckResultUpdateOne) ClearDiffMs() *CheckResultUpdateOne {
+	_u.mutation.ClearDiffMs()
+	return _u
+}
+
 // SetCheckedAt sets the "checked_at" field.
 func (_u *CheckResultUpdateOne) SetCheckedAt(v time.Time) *CheckResultUpdateOne {
 	_u.mutation.SetCheckedAt(v)
@@ -642,9 +1149,17 @@ func (_u *CheckResultUpdateOne) SetNillableCheckedAt(v *time.Time) *CheckResultU
 	return _u
 }
 
-// SetMonitorID sets the "monitor" edge to the Monitor entity by ID.
-func (_u *CheckResultUpdateOne) SetMonitorID(id int) *CheckResultUpdateOne {
-	_u.mutation.SetMonitorID(id)
+// SetMonitorID sets the "monitor_id" field.
+func (_u *CheckResultUpdateOne) SetMonitorID(v int) *CheckResultUpdateOne {
+	_u.mutation.SetMonitorID(v)
+	return _u
+}
+
+// SetNillableMonitorID sets the "monitor_id" field if the given value is not nil.
+func (_u *CheckResultUpdateOne) SetNillableMonitorID(v *int) *CheckResultUpdateOne {
+	if v != nil {
+		_u.SetMonitorID(*v)
+	}
 	return _u
 }
 
@@ -801,6 +1316,80 @@ func (_u *CheckResultUpdateOne) sqlSave(ctx context.Context) (_node *CheckResult
 	if _u.mutation.DiffDetailsCleared() {
 		_spec.ClearField(checkresult.FieldDiffDetails, field.TypeString)
 	}
+	if value, ok := _u.mutation.BodySnapshot(); ok {
+		_spec.SetField(checkresult.FieldBodySnapshot, field.TypeBytes, value)
+	}
+	if _u.mutation.BodySnapshotCleared() {
+		_spec.ClearField(checkresult.FieldBodySnapshot, field.TypeBytes)
+	}
+	if value, ok := _u.mutation.BodySnapshotEncoding(); ok {
+		_spec.SetField(checkresult.FieldBodySnapshotEncoding, field.TypeString, value)
+	}
+	if _u.mutation.BodySnapshotEncodingCleared() {
+		_spec.ClearField(checkresult.FieldBodySnapshotEncoding, field.TypeString)
+	}
+	if value, ok := _u.mutation.BodySize(); ok {
+		_spec.SetField(checkresult.FieldBodySize, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedBodySize(); ok {
+		_spec.AddField(checkresult.FieldBodySize, field.TypeInt, value)
+	}
+	if _u.mutation.BodySizeCleared() {
+		_spec.ClearField(checkresult.FieldBodySize, field.TypeInt)
+	}
+	if value, ok := _u.mutation.BodySnapshotTruncated(); ok {
+		_spec.SetField(checkresult.FieldBodySnapshotTruncated, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.BodyHash(); ok {
+		_spec.SetField(checkresult.FieldBodyHash, field.TypeString, value)
+	}
+	if _u.mutation.BodyHashCleared() {
+		_spec.ClearField(checkresult.FieldBodyHash, field.TypeString)
+	}
+	if value, ok := _u.mutation.TrackedHeaderValue(); ok {
+		_spec.SetField(checkresult.FieldTrackedHeaderValue, field.TypeString, value)
+	}
+	if _u.mutation.TrackedHeaderValueCleared() {
+		_spec.ClearField(checkresult.FieldTrackedHeaderValue, field.TypeString)
+	}
+	if value, ok := _u.mutation.RedirectChain(); ok {
+		_spec.SetField(checkresult.FieldRedirectChain, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedRedirectChain(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, checkresult.FieldRedirectChain, value)
+		})
+	}
+	if _u.mutation.RedirectChainCleared() {
+		_spec.ClearField(checkresult.FieldRedirectChain, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.BodyReadMs(); ok {
+		_spec.SetField(checkresult.FieldBodyReadMs, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedBodyReadMs(); ok {
+		_spec.AddField(checkresult.FieldBodyReadMs, field.TypeFloat64, value)
+	}
+	if _u.mutation.BodyReadMsCleared() {
+		_spec.ClearField(checkresult.FieldBodyReadMs, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.SelectorMs(); ok {
+		_spec.SetField(checkresult.FieldSelectorMs, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedSelectorMs(); ok {
+		_spec.AddField(checkresult.FieldSelectorMs, field.TypeFloat64, value)
+	}
+	if _u.mutation.SelectorMsCleared() {
+		_spec.ClearField(checkresult.FieldSelectorMs, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.DiffMs(); ok {
+		_spec.SetField(checkresult.FieldDiffMs, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedDiffMs(); ok {
+		_spec.AddField(checkresult.FieldDiffMs, field.TypeFloat64, value)
+	}
+	if _u.mutation.DiffMsCleared() {
+		_spec.ClearField(checkresult.FieldDiffMs, field.TypeFloat64)
+	}
 	if value, ok := _u.mutation.CheckedAt(); ok {
 		_spec.SetField(checkresult.FieldCheckedAt, field.TypeTime, value)
 	}
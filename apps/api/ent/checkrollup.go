@@ -0,0 +1,264 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"goanna/apps/api/ent/checkrollup"
+	"goanna/apps/api/ent/monitor"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// CheckRollup is the model entity for the CheckRollup schema.
+type CheckRollup struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// MonitorID holds the value of the "monitor_id" field.
+	MonitorID int `json:"monitor_id,omitempty"`
+	// Period holds the value of the "period" field.
+	Period checkrollup.Period `json:"period,omitempty"`
+	// BucketStart holds the value of the "bucket_start" field.
+	BucketStart time.Time `json:"bucket_start,omitempty"`
+	// Checks holds the value of the "checks" field.
+	Checks int `json:"checks,omitempty"`
+	// Successes holds the value of the "successes" field.
+	Successes int `json:"successes,omitempty"`
+	// Errors holds the value of the "errors" field.
+	Errors int `json:"errors,omitempty"`
+	// Changes holds the value of the "changes" field.
+	Changes int `json:"changes,omitempty"`
+	// LatencySamples holds the value of the "latency_samples" field.
+	LatencySamples int `json:"latency_samples,omitempty"`
+	// LatencyTotalMs holds the value of the "latency_total_ms" field.
+	LatencyTotalMs int64 `json:"latency_total_ms,omitempty"`
+	// LatencyMaxMs holds the value of the "latency_max_ms" field.
+	LatencyMaxMs *int `json:"latency_max_ms,omitempty"`
+	// LatencyHistogram holds the value of the "latency_histogram" field.
+	LatencyHistogram map[string]int `json:"latency_histogram,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the CheckRollupQuery when eager-loading is set.
+	Edges        CheckRollupEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// CheckRollupEdges holds the relations/edges for other nodes in the graph.
+type CheckRollupEdges struct {
+	// Monitor holds the value of the monitor edge.
+	Monitor *Monitor `json:"monitor,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// MonitorOrErr returns the Monitor value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e CheckRollupEdges) MonitorOrErr() (*Monitor, error) {
+	if e.Monitor != nil {
+		return e.Monitor, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: monitor.Label}
+	}
+	return nil, &NotLoadedError{edge: "monitor"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*CheckRollup) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case checkrollup.FieldLatencyHistogram:
+			values[i] = new([]byte)
+		case checkrollup.FieldID, checkrollup.FieldMonitorID, checkrollup.FieldChecks, checkrollup.FieldSuccesses, checkrollup.FieldErrors, checkrollup.FieldChanges, checkrollup.FieldLatencySamples, checkrollup.FieldLatencyTotalMs, checkrollup.FieldLatencyMaxMs:
+			values[i] = new(sql.NullInt64)
+		case checkrollup.FieldPeriod:
+			values[i] = new(sql.NullString)
+		case checkrollup.FieldBucketStart, checkrollup.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the CheckRollup fields.
+func (_m *CheckRollup) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case checkrollup.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case checkrollup.FieldMonitorID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field monitor_id", values[i])
+			} else if value.Valid {
+				_m.MonitorID = int(value.Int64)
+			}
+		case checkrollup.FieldPeriod:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field period", values[i])
+			} else if value.Valid {
+				_m.Period = checkrollup.Period(value.String)
+			}
+		case checkrollup.FieldBucketStart:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field bucket_start", values[i])
+			} else if value.Valid {
+				_m.BucketStart = value.Time
+			}
+		case checkrollup.FieldChecks:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field checks", values[i])
+			} else if value.Valid {
+				_m.Checks = int(value.Int64)
+			}
+		case checkrollup.FieldSuccesses:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field successes", values[i])
+			} else if value.Valid {
+				_m.Successes = int(value.Int64)
+			}
+		case checkrollup.FieldErrors:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field errors", values[i])
+			} else if value.Valid {
+				_m.Errors = int(value.Int64)
+			}
+		case checkrollup.FieldChanges:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field changes", values[i])
+			} else if value.Valid {
+				_m.Changes = int(value.Int64)
+			}
+		case checkrollup.FieldLatencySamples:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field latency_samples", values[i])
+			} else if value.Valid {
+				_m.LatencySamples = int(value.Int64)
+			}
+		case checkrollup.FieldLatencyTotalMs:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field latency_total_ms", values[i])
+			} else if value.Valid {
+				_m.LatencyTotalMs = value.Int64
+			}
+		case checkrollup.FieldLatencyMaxMs:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field latency_max_ms", values[i])
+			} else if value.Valid {
+				_m.LatencyMaxMs = new(int)
+				*_m.LatencyMaxMs = int(value.Int64)
+			}
+		case checkrollup.FieldLatencyHistogram:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field latency_histogram", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.LatencyHistogram); err != nil {
+					return fmt.Errorf("unmarshal field latency_histogram: %w", err)
+				}
+			}
+		case checkrollup.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the CheckRollup.
+// This includes values selected through modifiers, order, etc.
+func (_m *CheckRollup) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryMonitor queries the "monitor" edge of the CheckRollup entity.
+func (_m *CheckRollup) QueryMonitor() *MonitorQuery {
+	return NewCheckRollupClient(_m.config).QueryMonitor(_m)
+}
+
+// Update returns a builder for updating this CheckRollup.
+// Note that you need to call CheckRollup.Unwrap() before calling this method if this CheckRollup
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *CheckRollup) Update() *CheckRollupUpdateOne {
+	return NewCheckRollupClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the CheckRollup entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *CheckRollup) Unwrap() *CheckRollup {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: CheckRollup is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *CheckRollup) String() string {
+	var builder strings.Builder
+	builder.WriteString("CheckRollup(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("monitor_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.MonitorID))
+	builder.WriteString(", ")
+	builder.WriteString("period=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Period))
+	builder.WriteString(", ")
+	builder.WriteString("bucket_start=")
+	builder.WriteString(_m.BucketStart.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("checks=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Checks))
+	builder.WriteString(", ")
+	builder.WriteString("successes=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Successes))
+	builder.WriteString(", ")
+	builder.WriteString("errors=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Errors))
+	builder.WriteString(", ")
+	builder.WriteString("changes=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Changes))
+	builder.WriteString(", ")
+	builder.WriteString("latency_samples=")
+	builder.WriteString(fmt.Sprintf("%v", _m.LatencySamples))
+	builder.WriteString(", ")
+	builder.WriteString("latency_total_ms=")
+	builder.WriteString(fmt.Sprintf("%v", _m.LatencyTotalMs))
+	builder.WriteString(", ")
+	if v := _m.LatencyMaxMs; v != nil {
+		builder.WriteString("latency_max_ms=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("latency_histogram=")
+	builder.WriteString(fmt.Sprintf("%v", _m.LatencyHistogram))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// CheckRollups is a parsable slice of CheckRollup.
+type CheckRollups []*CheckRollup
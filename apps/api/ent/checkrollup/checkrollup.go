@@ -0,0 +1,199 @@
+// Code generated by ent, DO NOT EDIT.
+
+package checkrollup
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the checkrollup type in the database.
+	Label = "check_rollup"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldMonitorID holds the string denoting the monitor_id field in the database.
+	FieldMonitorID = "monitor_id"
+	// FieldPeriod holds the string denoting the period field in the database.
+	FieldPeriod = "period"
+	// FieldBucketStart holds the string denoting the bucket_start field in the database.
+	FieldBucketStart = "bucket_start"
+	// FieldChecks holds the string denoting the checks field in the database.
+	FieldChecks = "checks"
+	// FieldSuccesses holds the string denoting the successes field in the database.
+	FieldSuccesses = "successes"
+	// FieldErrors holds the string denoting the errors field in the database.
+	FieldErrors = "errors"
+	// FieldChanges holds the string denoting the changes field in the database.
+	FieldChanges = "changes"
+	// FieldLatencySamples holds the string denoting the latency_samples field in the database.
+	FieldLatencySamples = "latency_samples"
+	// FieldLatencyTotalMs holds the string denoting the latency_total_ms field in the database.
+	FieldLatencyTotalMs = "latency_total_ms"
+	// FieldLatencyMaxMs holds the string denoting the latency_max_ms field in the database.
+	FieldLatencyMaxMs = "latency_max_ms"
+	// FieldLatencyHistogram holds the string denoting the latency_histogram field in the database.
+	FieldLatencyHistogram = "latency_histogram"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// EdgeMonitor holds the string denoting the monitor edge name in mutations.
+	EdgeMonitor = "monitor"
+	// Table holds the table name of the checkrollup in the database.
+	Table = "check_rollups"
+	// MonitorTable is the table that holds the monitor relation/edge.
+	MonitorTable = "check_rollups"
+	// MonitorInverseTable is the table name for the Monitor entity.
+	// It exists in this package in order to avoid circular dependency with the "monitor" package.
+	MonitorInverseTable = "monitors"
+	// MonitorColumn is the table column denoting the monitor relation/edge.
+	MonitorColumn = "monitor_id"
+)
+
+// Columns holds all SQL columns for checkrollup fields.
+var Columns = []string{
+	FieldID,
+	FieldMonitorID,
+	FieldPeriod,
+	FieldBucketStart,
+	FieldChecks,
+	FieldSuccesses,
+	FieldErrors,
+	FieldChanges,
+	FieldLatencySamples,
+	FieldLatencyTotalMs,
+	FieldLatencyMaxMs,
+	FieldLatencyHistogram,
+	FieldUpdatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultChecks holds the default value on creation for the "checks" field.
+	DefaultChecks int
+	// DefaultSuccesses holds the default value on creation for the "successes" field.
+	DefaultSuccesses int
+	// DefaultErrors holds the default value on creation for the "errors" field.
+	DefaultErrors int
+	// DefaultChanges holds the default value on creation for the "changes" field.
+	DefaultChanges int
+	// DefaultLatencySamples holds the default value on creation for the "latency_samples" field.
+	DefaultLatencySamples int
+	// DefaultLatencyTotalMs holds the default value on creation for the "latency_total_ms" field.
+	DefaultLatencyTotalMs int64
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+)
+
+// Period defines the type for the "period" enum field.
+type Period string
+
+// Period values.
+const (
+	PeriodHour Period = "hour"
+	PeriodDay  Period = "day"
+)
+
+func (pe Period) String() string {
+	return string(pe)
+}
+
+// PeriodValidator is a validator for the "period" field enum values. It is called by the builders before save.
+func PeriodValidator(pe Period) error {
+	switch pe {
+	case PeriodHour, PeriodDay:
+		return nil
+	default:
+		return fmt.Errorf("checkrollup: invalid enum value for period field: %q", pe)
+	}
+}
+
+// OrderOption defines the ordering options for the CheckRollup queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByMonitorID orders the results by the monitor_id field.
+func ByMonitorID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMonitorID, opts...).ToFunc()
+}
+
+// ByPeriod orders the results by the period field.
+func ByPeriod(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPeriod, opts...).ToFunc()
+}
+
+// ByBucketStart orders the results by the bucket_start field.
+func ByBucketStart(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBucketStart, opts...).ToFunc()
+}
+
+// ByChecks orders the results by the checks field.
+func ByChecks(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldChecks, opts...).ToFunc()
+}
+
+// BySuccesses orders the results by the successes field.
+func BySuccesses(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSuccesses, opts...).ToFunc()
+}
+
+// ByErrors orders the results by the errors field.
+func ByErrors(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldErrors, opts...).ToFunc()
+}
+
+// ByChanges orders the results by the changes field.
+func ByChanges(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldChanges, opts...).ToFunc()
+}
+
+// ByLatencySamples orders the results by the latency_samples field.
+func ByLatencySamples(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLatencySamples, opts...).ToFunc()
+}
+
+// ByLatencyTotalMs orders the results by the latency_total_ms field.
+func ByLatencyTotalMs(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLatencyTotalMs, opts...).ToFunc()
+}
+
+// ByLatencyMaxMs orders the results by the latency_max_ms field.
+func ByLatencyMaxMs(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLatencyMaxMs, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByMonitorField orders the results by monitor field.
+func ByMonitorField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newMonitorStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newMonitorStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(MonitorInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, MonitorTable, MonitorColumn),
+	)
+}
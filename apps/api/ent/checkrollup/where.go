@@ -0,0 +1,564 @@
+// Code generated by ent, DO NOT EDIT.
+
+package checkrollup
+
+import (
+	"goanna/apps/api/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.CheckRollup {
+	return predicate.CheckRollup(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.CheckRollup {
+	return predicate.CheckRollup(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.CheckRollup {
+	return predicate.CheckRollup(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.CheckRollup {
+	return predicate.CheckRollup(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.CheckRollup {
+	return predicate.CheckRollup(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.CheckRollup {
+	return predicate.CheckRollup(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.CheckRollup {
+	return predicate.CheckRollup(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.CheckRollup {
+	return predicate.CheckRollup(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.CheckRollup {
+	return predicate.CheckRollup(sql.FieldLTE(FieldID, id))
+}
+
+// MonitorID applies equality check predicate on the "monitor_id" field. It's identical to MonitorIDEQ.
+func MonitorID(v int) predicate.CheckRollup {
+	return predicate.CheckRollup(sql.FieldEQ(FieldMonitorID, v))
+}
+
+// BucketStart applies equality check predicate on the "bucket_start" field. It's identical to BucketStartEQ.
+func BucketStart(v time.Time) predicate.CheckRollup {
+	return predicate.CheckRollup(sql.FieldEQ(FieldBucketStart, v))
+}
+
+// Checks applies equality check predicate on the "checks" field. It's identical to ChecksEQ.
+func Checks(v int) predicate.CheckRollup {
+	return predicate.CheckRollup(sql.FieldEQ(FieldChecks, v))
+}
+
+// Successes applies equality check predicate on the "successes" field. It's identical to SuccessesEQ.
+func Successes(v int) predicate.CheckRollup {
+	return predicate.CheckRollup(sql.FieldEQ(FieldSuccesses, v))
+}
+
+// Errors applies equality check predicate on the "errors" field. It's identical to ErrorsEQ.
+func Errors(v int) predicate.CheckRollup {
+	return predicate.CheckRollup(sql.FieldEQ(FieldErrors, v))
+}
+
+// Changes applies equality check predicate on the "changes" field. It's identical to ChangesEQ.
+func Changes(v int) predicate.CheckRollup {
+	return predicate.CheckRollup(sql.FieldEQ(FieldChanges, v))
+}
+
+// LatencySamples applies equality check predicate on the "latency_samples" field. It's identical to LatencySamplesEQ.
+func LatencySamples(v int) predicate.CheckRollup {
+	return predicate.CheckRollup(sql.FieldEQ(FieldLatencySamples, v))
+}
+
+// LatencyTotalMs applies equality check predicate on the "latency_total_ms" field. It's identical to LatencyTotalMsEQ.
+func LatencyTotalMs(v int64) predicate.CheckRollup {
+	return predicate.CheckRollup(sql.FieldEQ(FieldLatencyTotalMs, v))
+}
+
+// LatencyMaxMs applies equality check predicate on the "latency_max_ms" field. It's identical to LatencyMaxMsEQ.
+func LatencyMaxMs(v int) predicate.CheckRollup {
+	return predicate.CheckRollup(sql.FieldEQ(FieldLatencyMaxMs, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.CheckRollup {
+	return predicate.CheckRollup(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// MonitorIDEQ applies the EQ predicate on the "monitor_id" field.
+func MonitorIDEQ(v int) predicate.CheckRollup {
+	return predicate.CheckRollup(sql.FieldEQ(FieldMonitorID, v))
+}
+
+// MonitorIDNEQ applies the NEQ predicate on the "monitor_id" field.
+func MonitorIDNEQ(v int) predicate.CheckRollup {
+	return predicate.CheckRollup(sql.FieldNEQ(FieldMonitorID, v))
+}
+
+// MonitorIDIn applies the In predicate on the "monitor_id" field.
+func MonitorIDIn(vs ...int) predicate.CheckRollup {
+	return predicate.CheckRollup(sql.FieldIn(FieldMonitorID, vs...))
+}
+
+// MonitorIDNotIn applies the NotIn predicate on the "monitor_id" field.
+func MonitorIDNotIn(vs ...int) predicate.CheckRollup {
+	return predicate.CheckRollup(sql.FieldNotIn(FieldMonitorID, vs...))
+}
+
+// PeriodEQ applies the EQ predicate on the "period" field.
+func PeriodEQ(v Period) predicate.CheckRollup {
+	return predicate.CheckRollup(sql.FieldEQ(FieldPeriod, v))
+}
+
+// PeriodNEQ applies the NEQ predicate on the "period" field.
+func PeriodNEQ(v Period) predicate.CheckRollup {
+	return predicate.CheckRollup(sql.FieldNEQ(FieldPeriod, v))
+}
+
+// PeriodIn applies the In predicate on the "period" field.
+func PeriodIn(vs ...Period) predicate.CheckRollup {
+	return predicate.CheckRollup(sql.FieldIn(FieldPeriod, vs...))
+}
+
+// PeriodNotIn applies the NotIn predicate on the "period" field.
+func PeriodNotIn(vs ...Period) predicate.CheckRollup {
+	return predicate.CheckRollup(sql.FieldNotIn(FieldPeriod, vs...))
+}
+
+// BucketStartEQ applies the EQ predicate on the "bucket_start" field.
+func BucketStartEQ(v time.Time) predicate.CheckRollup {
+	return predicate.CheckRollup(sql.FieldEQ(FieldBucketStart, v))
+}
+
+// BucketStartNEQ applies the NEQ predicate on the "bucket_start" field.
+func BucketStartNEQ(v time.Time) predicate.CheckRollup {
+	return predicate.CheckRollup(sql.FieldNEQ(FieldBucketStart, v))
+}
+
+// BucketStartIn applies the In predicate on the "bucket_start" field.
+func BucketStartIn(vs ...time.Time) predicate.CheckRollup {
+	return predicate.CheckRollup(sql.FieldIn(FieldBucketStart, vs...))
+}
+
+// BucketStartNotIn applies the NotIn predicate on the "bucket_start" field.
+func BucketStartNotIn(vs ...time.Time) predicate.CheckRollup {
+	return predicate.CheckRollup(sql.FieldNotIn(FieldBucketStart, vs...))
+}
+
+// BucketStartGT applies the GT predicate on the "bucket_start" field.
+func BucketStartGT(v time.Time) predicate.CheckRollup {
+	return predicate.CheckRollup(sql.FieldGT(FieldBucketStart, v))
+}
+
+// BucketStartGTE applies the GTE predicate on the "bucket_start" field.
+func BucketStartGTE(v time.Time) predicate.CheckRollup {
+	return predicate.CheckRollup(sql.FieldGTE(FieldBucketStart, v))
+}
+
+// BucketStartLT applies the LT predicate on the "bucket_start" field.
+func BucketStartLT(v time.Time) predicate.CheckRollup {
+	return predicate.CheckRollup(sql.FieldLT(FieldBucketStart, v))
+}
+
+// BucketStartLTE applies the LTE predicate on the "bucket_start" field.
+func BucketStartLTE(v time.Time) predicate.CheckRollup {
+	return predicate.CheckRollup(sql.FieldLTE(FieldBucketStart, v))
+}
+
+// ChecksEQ applies the EQ predicate on the "checks" field.
+func ChecksEQ(v int) predicate.CheckRollup {
+	return predicate.CheckRollup(sql.FieldEQ(FieldChecks, v))
+}
+
+// ChecksNEQ applies the NEQ predicate on the "checks" field.
+func ChecksNEQ(v int) predicate.CheckRollup {
+	return predicate.CheckRollup(sql.FieldNEQ(FieldChecks, v))
+}
+
+// ChecksIn applies the In predicate on the "checks" field.
+func ChecksIn(vs ...int) predicate.CheckRollup {
+	return predicate.CheckRollup(sql.FieldIn(FieldChecks, vs...))
+}
+
+// ChecksNotIn applies the NotIn predicate on the "checks" field.
+func ChecksNotIn(vs ...int) predicate.CheckRollup {
+	return predicate.CheckRollup(sql.FieldNotIn(FieldChecks, vs...))
+}
+
+// ChecksGT applies the GT predicate on the "checks" field.
+func ChecksGT(v int) predicate.CheckRollup {
+	return predicate.CheckRollup(sql.FieldGT(FieldChecks, v))
+}
+
+// ChecksGTE applies the GTE predicate on the "checks" field.
+func ChecksGTE(v int) predicate.CheckRollup {
+	return predicate.CheckRollup(sql.FieldGTE(FieldChecks, v))
+}
+
+// ChecksLT applies the LT predicate on the "checks" field.
+func ChecksLT(v int) predicate.CheckRollup {
+	return predicate.CheckRollup(sql.FieldLT(FieldChecks, v))
+}
+
+// ChecksLTE applies the LTE predicate on the "checks" field.
+func ChecksLTE(v int) predicate.CheckRollup {
+	return predicate.CheckRollup(sql.FieldLTE(FieldChecks, v))
+}
+
+// SuccessesEQ applies the EQ predicate on the "successes" field.
+func SuccessesEQ(v int) predicate.CheckRollup {
+	return predicate.CheckRollup(sql.FieldEQ(FieldSuccesses, v))
+}
+
+// SuccessesNEQ applies the NEQ predicate on the "successes" field.
+func SuccessesNEQ(v int) predicate.CheckRollup {
+	return predicate.CheckRollup(sql.FieldNEQ(FieldSuccesses, v))
+}
+
+// SuccessesIn applies the In predicate on the "successes" field.
+func SuccessesIn(vs ...int) predicate.CheckRollup {
+	return predicate.CheckRollup(sql.FieldIn(FieldSuccesses, vs...))
+}
+
+// SuccessesNotIn applies the NotIn predicate on the "successes" field.
+func SuccessesNotIn(vs ...int) predicate.CheckRollup {
+	return predicate.CheckRollup(sql.FieldNotIn(FieldSuccesses, vs...))
+}
+
+// SuccessesGT applies the GT predicate on the "successes" field.
+func SuccessesGT(v int) predicate.CheckRollup {
+	return predicate.CheckRollup(sql.FieldGT(FieldSuccesses, v))
+}
+
+// SuccessesGTE applies the GTE predicate on the "successes" field.
+func SuccessesGTE(v int) predicate.CheckRollup {
+	return predicate.CheckRollup(sql.FieldGTE(FieldSuccesses, v))
+}
+
+// SuccessesLT applies the LT predicate on the "successes" field.
+func SuccessesLT(v int) predicate.CheckRollup {
+	return predicate.CheckRollup(sql.FieldLT(FieldSuccesses, v))
+}
+
+// SuccessesLTE applies the LTE predicate on the "successes" field.
+func SuccessesLTE(v int) predicate.CheckRollup {
+	return predicate.CheckRollup(sql.FieldLTE(FieldSuccesses, v))
+}
+
+// ErrorsEQ applies the EQ predicate on the "errors" field.
+func ErrorsEQ(v int) predicate.CheckRollup {
+	return predicate.CheckRollup(sql.FieldEQ(FieldErrors, v))
+}
+
+// ErrorsNEQ applies the NEQ predicate on the "errors" field.
+func ErrorsNEQ(v int) predicate.CheckRollup {
+	return predicate.CheckRollup(sql.FieldNEQ(FieldErrors, v))
+}
+
+// ErrorsIn applies the In predicate on the "errors" field.
+func ErrorsIn(vs ...int) predicate.CheckRollup {
+	return predicate.CheckRollup(sql.FieldIn(FieldErrors, vs...))
+}
+
+// ErrorsNotIn applies the NotIn predicate on the "errors" field.
+func ErrorsNotIn(vs ...int) predicate.CheckRollup {
+	return predicate.CheckRollup(sql.FieldNotIn(FieldErrors, vs...))
+}
+
+// ErrorsGT applies the GT predicate on the "errors" field.
+func ErrorsGT(v int) predicate.CheckRollup {
+	return predicate.CheckRollup(sql.FieldGT(FieldErrors, v))
+}
+
+// ErrorsGTE applies the GTE predicate on the "errors" field.
+func ErrorsGTE(v int) predicate.CheckRollup {
+	return predicate.CheckRollup(sql.FieldGTE(FieldErrors, v))
+}
+
+// ErrorsLT applies the LT predicate on the "errors" field.
+func ErrorsLT(v int) predicate.CheckRollup {
+	return predicate.CheckRollup(sql.FieldLT(FieldErrors, v))
+}
+
+// ErrorsLTE applies the LTE predicate on the "errors" field.
+func ErrorsLTE(v int) predicate.CheckRollup {
+	return predicate.CheckRollup(sql.FieldLTE(FieldErrors, v))
+}
+
+// ChangesEQ applies the EQ predicate on the "changes" field.
+func ChangesEQ(v int) predicate.CheckRollup {
+	return predicate.CheckRollup(sql.FieldEQ(FieldChanges, v))
+}
+
+// ChangesNEQ applies the NEQ predicate on the "changes" field.
+func ChangesNEQ(v int) predicate.CheckRollup {
+	return predicate.CheckRollup(sql.FieldNEQ(FieldChanges, v))
+}
+
+// ChangesIn applies the In predicate on the "changes" field.
+func ChangesIn(vs ...int) predicate.CheckRollup {
+	return predicate.CheckRollup(sql.FieldIn(FieldChanges, vs...))
+}
+
+// ChangesNotIn applies the NotIn predicate on the "changes" field.
+func ChangesNotIn(vs ...int) predicate.CheckRollup {
+	return predicate.CheckRollup(sql.FieldNotIn(FieldChanges, vs...))
+}
+
+// ChangesGT applies the GT predicate on the "changes" field.
+func ChangesGT(v int) predicate.CheckRollup {
+	return predicate.CheckRollup(sql.FieldGT(FieldChanges, v))
+}
+
+// ChangesGTE applies the GTE predicate on the "changes" field.
+func ChangesGTE(v int) predicate.CheckRollup {
+	return predicate.CheckRollup(sql.FieldGTE(FieldChanges, v))
+}
+
+// ChangesLT applies the LT predicate on the "changes" field.
+func ChangesLT(v int) predicate.CheckRollup {
+	return predicate.CheckRollup(sql.FieldLT(FieldChanges, v))
+}
+
+// ChangesLTE applies the LTE predicate on the "changes" field.
+func ChangesLTE(v int) predicate.CheckRollup {
+	return predicate.CheckRollup(sql.FieldLTE(FieldChanges, v))
+}
+
+// LatencySamplesEQ applies the EQ predicate on the "latency_samples" field.
+func LatencySamplesEQ(v int) predicate.CheckRollup {
+	return predicate.CheckRollup(sql.FieldEQ(FieldLatencySamples, v))
+}
+
+// LatencySamplesNEQ applies the NEQ predicate on the "latency_samples" field.
+func LatencySamplesNEQ(v int) predicate.CheckRollup {
+	return predicate.CheckRollup(sql.FieldNEQ(FieldLatencySamples, v))
+}
+
+// LatencySamplesIn applies the In predicate on the "latency_samples" field.
+func LatencySamplesIn(vs ...int) predicate.CheckRollup {
+	return predicate.CheckRollup(sql.FieldIn(FieldLatencySamples, vs...))
+}
+
+// LatencySamplesNotIn applies the NotIn predicate on the "latency_samples" field.
+func LatencySamplesNotIn(vs ...int) predicate.CheckRollup {
+	return predicate.CheckRollup(sql.FieldNotIn(FieldLatencySamples, vs...))
+}
+
+// LatencySamplesGT applies the GT predicate on the "latency_samples" field.
+func LatencySamplesGT(v int) predicate.CheckRollup {
+	return predicate.CheckRollup(sql.FieldGT(FieldLatencySamples, v))
+}
+
+// LatencySamplesGTE applies the GTE predicate on the "latency_samples" field.
+func LatencySamplesGTE(v int) predicate.CheckRollup {
+	return predicate.CheckRollup(sql.FieldGTE(FieldLatencySamples, v))
+}
+
+// LatencySamplesLT applies the LT predicate on the "latency_samples" field.
+func LatencySamplesLT(v int) predicate.CheckRollup {
+	return predicate.CheckRollup(sql.FieldLT(FieldLatencySamples, v))
+}
+
+// LatencySamplesLTE applies the LTE predicate on the "latency_samples" field.
+func LatencySamplesLTE(v int) predicate.CheckRollup {
+	return predicate.CheckRollup(sql.FieldLTE(FieldLatencySamples, v))
+}
+
+// LatencyTotalMsEQ applies the EQ predicate on the "latency_total_ms" field.
+func LatencyTotalMsEQ(v int64) predicate.CheckRollup {
+	return predicate.CheckRollup(sql.FieldEQ(FieldLatencyTotalMs, v))
+}
+
+// LatencyTotalMsNEQ applies the NEQ predicate on the "latency_total_ms" field.
+func LatencyTotalMsNEQ(v int64) predicate.CheckRollup {
+	return predicate.CheckRollup(sql.FieldNEQ(FieldLatencyTotalMs, v))
+}
+
+// LatencyTotalMsIn applies the In predicate on the "latency_total_ms" field.
+func LatencyTotalMsIn(vs ...int64) predicate.CheckRollup {
+	return predicate.CheckRollup(sql.FieldIn(FieldLatencyTotalMs, vs...))
+}
+
+// LatencyTotalMsNotIn applies the NotIn predicate on the "latency_total_ms" field.
+func LatencyTotalMsNotIn(vs ...int64) predicate.CheckRollup {
+	return predicate.CheckRollup(sql.FieldNotIn(FieldLatencyTotalMs, vs...))
+}
+
+// LatencyTotalMsGT applies the GT predicate on the "latency_total_ms" field.
+func LatencyTotalMsGT(v int64) predicate.CheckRollup {
+	return predicate.CheckRollup(sql.FieldGT(FieldLatencyTotalMs, v))
+}
+
+// LatencyTotalMsGTE applies the GTE predicate on the "latency_total_ms" field.
+func LatencyTotalMsGTE(v int64) predicate.CheckRollup {
+	return predicate.CheckRollup(sql.FieldGTE(FieldLatencyTotalMs, v))
+}
+
+// LatencyTotalMsLT applies the LT predicate on the "latency_total_ms" field.
+func LatencyTotalMsLT(v int64) predicate.CheckRollup {
+	return predicate.CheckRollup(sql.FieldLT(FieldLatencyTotalMs, v))
+}
+
+// LatencyTotalMsLTE applies the LTE predicate on the "latency_total_ms" field.
+func LatencyTotalMsLTE(v int64) predicate.CheckRollup {
+	return predicate.CheckRollup(sql.FieldLTE(FieldLatencyTotalMs, v))
+}
+
+// LatencyMaxMsEQ applies the EQ predicate on the "latency_max_ms" field.
+func LatencyMaxMsEQ(v int) predicate.CheckRollup {
+	return predicate.CheckRollup(sql.FieldEQ(FieldLatencyMaxMs, v))
+}
+
+// LatencyMaxMsNEQ applies the NEQ predicate on the "latency_max_ms" field.
+func LatencyMaxMsNEQ(v int) predicate.CheckRollup {
+	return predicate.CheckRollup(sql.FieldNEQ(FieldLatencyMaxMs, v))
+}
+
+// LatencyMaxMsIn applies the In predicate on the "latency_max_ms" field.
+func LatencyMaxMsIn(vs ...int) predicate.CheckRollup {
+	return predicate.CheckRollup(sql.FieldIn(FieldLatencyMaxMs, vs...))
+}
+
+// LatencyMaxMsNotIn applies the NotIn predicate on the "latency_max_ms" field.
+func LatencyMaxMsNotIn(vs ...int) predicate.CheckRollup {
+	return predicate.CheckRollup(sql.FieldNotIn(FieldLatencyMaxMs, vs...))
+}
+
+// LatencyMaxMsGT applies the GT predicate on the "latency_max_ms" field.
+func LatencyMaxMsGT(v int) predicate.CheckRollup {
+	return predicate.CheckRollup(sql.FieldGT(FieldLatencyMaxMs, v))
+}
+
+// LatencyMaxMsGTE applies the GTE predicate on the "latency_max_ms" field.
+func LatencyMaxMsGTE(v int) predicate.CheckRollup {
+	return predicate.CheckRollup(sql.FieldGTE(FieldLatencyMaxMs, v))
+}
+
+// LatencyMaxMsLT applies the LT predicate on the "latency_max_ms" field.
+func LatencyMaxMsLT(v int) predicate.CheckRollup {
+	return predicate.CheckRollup(sql.FieldLT(FieldLatencyMaxMs, v))
+}
+
+// LatencyMaxMsLTE applies the LTE predicate on the "latency_max_ms" field.
+func LatencyMaxMsLTE(v int) predicate.CheckRollup {
+	return predicate.CheckRollup(sql.FieldLTE(FieldLatencyMaxMs, v))
+}
+
+// LatencyMaxMsIsNil applies the IsNil predicate on the "latency_max_ms" field.
+func LatencyMaxMsIsNil() predicate.CheckRollup {
+	return predicate.CheckRollup(sql.FieldIsNull(FieldLatencyMaxMs))
+}
+
+// LatencyMaxMsNotNil applies the NotNil predicate on the "latency_max_ms" field.
+func LatencyMaxMsNotNil() predicate.CheckRollup {
+	return predicate.CheckRollup(sql.FieldNotNull(FieldLatencyMaxMs))
+}
+
+// LatencyHistogramIsNil applies the IsNil predicate on the "latency_histogram" field.
+func LatencyHistogramIsNil() predicate.CheckRollup {
+	return predicate.CheckRollup(sql.FieldIsNull(FieldLatencyHistogram))
+}
+
+// LatencyHistogramNotNil applies the NotNil predicate on the "latency_histogram" field.
+func LatencyHistogramNotNil() predicate.CheckRollup {
+	return predicate.CheckRollup(sql.FieldNotNull(FieldLatencyHistogram))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.CheckRollup {
+	return predicate.CheckRollup(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.CheckRollup {
+	return predicate.CheckRollup(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.CheckRollup {
+	return predicate.CheckRollup(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.CheckRollup {
+	return predicate.CheckRollup(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.CheckRollup {
+	return predicate.CheckRollup(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.CheckRollup {
+	return predicate.CheckRollup(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.CheckRollup {
+	return predicate.CheckRollup(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.CheckRollup {
+	return predicate.CheckRollup(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// HasMonitor applies the HasEdge predicate on the "monitor" edge.
+func HasMonitor() predicate.CheckRollup {
+	return predicate.CheckRollup(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, MonitorTable, MonitorColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasMonitorWith applies the HasEdge predicate on the "monitor" edge with a given conditions (other predicates).
+func HasMonitorWith(preds ...predicate.Monitor) predicate.CheckRollup {
+	return predicate.CheckRollup(func(s *sql.Selector) {
+		step := newMonitorStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.CheckRollup) predicate.CheckRollup {
+	return predicate.CheckRollup(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.CheckRollup) predicate.CheckRollup {
+	return predicate.CheckRollup(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.CheckRollup) predicate.CheckRollup {
+	return predicate.CheckRollup(sql.NotPredicates(p))
+}
@@ -0,0 +1,446 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"goanna/apps/api/ent/checkrollup"
+	"goanna/apps/api/ent/monitor"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// CheckRollupCreate is the builder for creating a CheckRollup entity.
+type CheckRollupCreate struct {
+	config
+	mutation *CheckRollupMutation
+	hooks    []Hook
+}
+
+// SetMonitorID sets the "monitor_id" field.
+func (_c *CheckRollupCreate) SetMonitorID(v int) *CheckRollupCreate {
+	_c.mutation.SetMonitorID(v)
+	return _c
+}
+
+// SetPeriod sets the "period" field.
+func (_c *CheckRollupCreate) SetPeriod(v checkrollup.Period) *CheckRollupCreate {
+	_c.mutation.SetPeriod(v)
+	return _c
+}
+
+// SetBucketStart sets the "bucket_start" field.
+func (_c *CheckRollupCreate) SetBucketStart(v time.Time) *CheckRollupCreate {
+	_c.mutation.SetBucketStart(v)
+	return _c
+}
+
+// SetChecks sets the "checks" field.
+func (_c *CheckRollupCreate) SetChecks(v int) *CheckRollupCreate {
+	_c.mutation.SetChecks(v)
+	return _c
+}
+
+// SetNillableChecks sets the "checks" field if the given value is not nil.
+func (_c *CheckRollupCreate) SetNillableChecks(v *int) *CheckRollupCreate {
+	if v != nil {
+		_c.SetChecks(*v)
+	}
+	return _c
+}
+
+// SetSuccesses sets the "successes" field.
+func (_c *CheckRollupCreate) SetSuccesses(v int) *CheckRollupCreate {
+	_c.mutation.SetSuccesses(v)
+	return _c
+}
+
+// SetNillableSuccesses sets the "successes" field if the given value is not nil.
+func (_c *CheckRollupCreate) SetNillableSuccesses(v *int) *CheckRollupCreate {
+	if v != nil {
+		_c.SetSuccesses(*v)
+	}
+	return _c
+}
+
+// SetErrors sets the "errors" field.
+func (_c *CheckRollupCreate) SetErrors(v int) *CheckRollupCreate {
+	_c.mutation.SetErrors(v)
+	return _c
+}
+
+// SetNillableErrors sets the "errors" field if the given value is not nil.
+func (_c *CheckRollupCreate) SetNillableErrors(v *int) *CheckRollupCreate {
+	if v != nil {
+		_c.SetErrors(*v)
+	}
+	return _c
+}
+
+// SetChanges sets the "changes" field.
+func (_c *CheckRollupCreate) SetChanges(v int) *CheckRollupCreate {
+	_c.mutation.SetChanges(v)
+	return _c
+}
+
+// SetNillableChanges sets the "changes" field if the given value is not nil.
+func (_c *CheckRollupCreate) SetNillableChanges(v *int) *CheckRollupCreate {
+	if v != nil {
+		_c.SetChanges(*v)
+	}
+	return _c
+}
+
+// SetLatencySamples sets the "latency_samples" field.
+func (_c *CheckRollupCreate) SetLatencySamples(v int) *CheckRollupCreate {
+	_c.mutation.SetLatencySamples(v)
+	return _c
+}
+
+// SetNillableLatencySamples sets the "latency_samples" field if the given value is not nil.
+func (_c *CheckRollupCreate) SetNillableLatencySamples(v *int) *CheckRollupCreate {
+	if v != nil {
+		_c.SetLatencySamples(*v)
+	}
+	return _c
+}
+
+// SetLatencyTotalMs sets the "latency_total_ms" field.
+func (_c *CheckRollupCreate) SetLatencyTotalMs(v int64) *CheckRollupCreate {
+	_c.mutation.SetLatencyTotalMs(v)
+	return _c
+}
+
+// SetNillableLatencyTotalMs sets the "latency_total_ms" field if the given value is not nil.
+func (_c *CheckRollupCreate) SetNillableLatencyTotalMs(v *int64) *CheckRollupCreate {
+	if v != nil {
+		_c.SetLatencyTotalMs(*v)
+	}
+	return _c
+}
+
+// SetLatencyMaxMs sets the "latency_max_ms" field.
+func (_c *CheckRollupCreate) SetLatencyMaxMs(v int) *CheckRollupCreate {
+	_c.mutation.SetLatencyMaxMs(v)
+	return _c
+}
+
+// SetNillableLatencyMaxMs sets the "latency_max_ms" field if the given value is not nil.
+func (_c *CheckRollupCreate) SetNillableLatencyMaxMs(v *int) *CheckRollupCreate {
+	if v != nil {
+		_c.SetLatencyMaxMs(*v)
+	}
+	return _c
+}
+
+// SetLatencyHistogram sets the "latency_histogram" field.
+func (_c *CheckRollupCreate) SetLatencyHistogram(v map[string]int) *CheckRollupCreate {
+	_c.mutation.SetLatencyHistogram(v)
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *CheckRollupCreate) SetUpdatedAt(v time.Time) *CheckRollupCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *CheckRollupCreate) SetNillableUpdatedAt(v *time.Time) *CheckRollupCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetMonitor sets the "monitor" edge to the Monitor entity.
+func (_c *CheckRollupCreate) SetMonitor(v *Monitor) *CheckRollupCreate {
+	return _c.SetMonitorID(v.ID)
+}
+
+// Mutation returns the CheckRollupMutation object of the builder.
+func (_c *CheckRollupCreate) Mutation() *CheckRollupMutation {
+	return _c.mutation
+}
+
+// Save creates the CheckRollup in the database.
+func (_c *CheckRollupCreate) Save(ctx context.Context) (*CheckRollup, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *CheckRollupCreate) SaveX(ctx context.Context) *CheckRollup {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *CheckRollupCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *CheckRollupCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *CheckRollupCreate) defaults() {
+	if _, ok := _c.mutation.Checks(); !ok {
+		v := checkrollup.DefaultChecks
+		_c.mutation.SetChecks(v)
+	}
+	if _, ok := _c.mutation.Successes(); !ok {
+		v := checkrollup.DefaultSuccesses
+		_c.mutation.SetSuccesses(v)
+	}
+	if _, ok := _c.mutation.Errors(); !ok {
+		v := checkrollup.DefaultErrors
+		_c.mutation.SetErrors(v)
+	}
+	if _, ok := _c.mutation.Changes(); !ok {
+		v := checkrollup.DefaultChanges
+		_c.mutation.SetChanges(v)
+	}
+	if _, ok := _c.mutation.LatencySamples(); !ok {
+		v := checkrollup.DefaultLatencySamples
+		_c.mutation.SetLatencySamples(v)
+	}
+	if _, ok := _c.mutation.LatencyTotalMs(); !ok {
+		v := checkrollup.DefaultLatencyTotalMs
+		_c.mutation.SetLatencyTotalMs(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := checkrollup.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *CheckRollupCreate) check() error {
+	if _, ok := _c.mutation.MonitorID(); !ok {
+		return &ValidationError{Name: "monitor_id", err: errors.New(`ent: missing required field "CheckRollup.monitor_id"`)}
+	}
+	if _, ok := _c.mutation.Period(); !ok {
+		return &ValidationError{Name: "period", err: errors.New(`ent: missing required field "CheckRollup.period"`)}
+	}
+	if v, ok := _c.mutation.Period(); ok {
+		if err := checkrollup.PeriodValidator(v); err != nil {
+			return &ValidationError{Name: "period", err: fmt.Errorf(`ent: validator failed for field "CheckRollup.period": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.BucketStart(); !ok {
+		return &ValidationError{Name: "bucket_start", err: errors.New(`ent: missing required field "CheckRollup.bucket_start"`)}
+	}
+	if _, ok := _c.mutation.Checks(); !ok {
+		return &ValidationError{Name: "checks", err: errors.New(`ent: missing required field "CheckRollup.checks"`)}
+	}
+	if _, ok := _c.mutation.Successes(); !ok {
+		return &ValidationError{Name: "successes", err: errors.New(`ent: missing required field "CheckRollup.successes"`)}
+	}
+	if _, ok := _c.mutation.Errors(); !ok {
+		return &ValidationError{Name: "errors", err: errors.New(`ent: missing required field "CheckRollup.errors"`)}
+	}
+	if _, ok := _c.mutation.Changes(); !ok {
+		return &ValidationError{Name: "changes", err: errors.New(`ent: missing required field "CheckRollup.changes"`)}
+	}
+	if _, ok := _c.mutation.LatencySamples(); !ok {
+		return &ValidationError{Name: "latency_samples", err: errors.New(`ent: missing required field "CheckRollup.latency_samples"`)}
+	}
+	if _, ok := _c.mutation.LatencyTotalMs(); !ok {
+		return &ValidationError{Name: "latency_total_ms", err: errors.New(`ent: missing required field "CheckRollup.latency_total_ms"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "CheckRollup.updated_at"`)}
+	}
+	if len(_c.mutation.MonitorIDs()) == 0 {
+		return &ValidationError{Name: "monitor", err: errors.New(`ent: missing required edge "CheckRollup.monitor"`)}
+	}
+	return nil
+}
+
+func (_c *CheckRollupCreate) sqlSave(ctx context.Context) (*CheckRollup, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *CheckRollupCreate) createSpec() (*CheckRollup, *sqlgraph.CreateSpec) {
+	var (
+		_node = &CheckRollup{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(checkrollup.Table, sqlgraph.NewFieldSpec(checkrollup.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.Period(); ok {
+		_spec.SetField(checkrollup.FieldPeriod, field.TypeEnum, value)
+		_node.Period = value
+	}
+	if value, ok := _c.mutation.BucketStart(); ok {
+		_spec.SetField(checkrollup.FieldBucketStart, field.TypeTime, value)
+		_node.BucketStart = value
+	}
+	if value, ok := _c.mutation.Checks(); ok {
+		_spec.SetField(checkrollup.FieldChecks, field.TypeInt, value)
+		_node.Checks = value
+	}
+	if value, ok := _c.mutation.Successes(); ok {
+		_spec.SetField(checkrollup.FieldSuccesses, field.TypeInt, value)
+		_node.Successes = value
+	}
+	if value, ok := _c.mutation.Errors(); ok {
+		_spec.SetField(checkrollup.FieldErrors, field.TypeInt, value)
+		_node.Errors = value
+	}
+	if value, ok := _c.mutation.Changes(); ok {
+		_spec.SetField(checkrollup.FieldChanges, field.TypeInt, value)
+		_node.Changes = value
+	}
+	if value, ok := _c.mutation.LatencySamples(); ok {
+		_spec.SetField(checkrollup.FieldLatencySamples, field.TypeInt, value)
+		_node.LatencySamples = value
+	}
+	if value, ok := _c.mutation.LatencyTotalMs(); ok {
+		_spec.SetField(checkrollup.FieldLatencyTotalMs, field.TypeInt64, value)
+		_node.LatencyTotalMs = value
+	}
+	if value, ok := _c.mutation.LatencyMaxMs(); ok {
+		_spec.SetField(checkrollup.FieldLatencyMaxMs, field.TypeInt, value)
+		_node.LatencyMaxMs = &value
+	}
+	if value, ok := _c.mutation.LatencyHistogram(); ok {
+		_spec.SetField(checkrollup.FieldLatencyHistogram, field.TypeJSON, value)
+		_node.LatencyHistogram = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(checkrollup.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if nodes := _c.mutation.MonitorIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   checkrollup.MonitorTable,
+			Columns: []string{checkrollup.MonitorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(monitor.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.MonitorID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// CheckRollupCreateBulk is the builder for creating many CheckRollup entities in bulk.
+type CheckRollupCreateBulk struct {
+	config
+	err      error
+	builders []*CheckRollupCreate
+}
+
+// Save creates the CheckRollup entities in the database.
+func (_c *CheckRollupCreateBulk) Save(ctx context.Context) ([]*CheckRollup, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*CheckRollup, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*CheckRollupMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *CheckRollupCreateBulk) SaveX(ctx context.Context) []*CheckRollup {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *CheckRollupCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *CheckRollupCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,853 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"goanna/apps/api/ent/checkrollup"
+	"goanna/apps/api/ent/monitor"
+	"goanna/apps/api/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// CheckRollupUpdate is the builder for updating CheckRollup entities.
+type CheckRollupUpdate struct {
+	config
+	hooks    []Hook
+	mutation *CheckRollupMutation
+}
+
+// Where appends a list predicates to the CheckRollupUpdate builder.
+func (_u *CheckRollupUpdate) Where(ps ...predicate.CheckRollup) *CheckRollupUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetMonitorID sets the "monitor_id" field.
+func (_u *CheckRollupUpdate) SetMonitorID(v int) *CheckRollupUpdate {
+	_u.mutation.SetMonitorID(v)
+	return _u
+}
+
+// SetNillableMonitorID sets the "monitor_id" field if the given value is not nil.
+func (_u *CheckRollupUpdate) SetNillableMonitorID(v *int) *CheckRollupUpdate {
+	if v != nil {
+		_u.SetMonitorID(*v)
+	}
+	return _u
+}
+
+// SetPeriod sets the "period" field.
+func (_u *CheckRollupUpdate) SetPeriod(v checkrollup.Period) *CheckRollupUpdate {
+	_u.mutation.SetPeriod(v)
+	return _u
+}
+
+// SetNillablePeriod sets the "period" field if the given value is not nil.
+func (_u *CheckRollupUpdate) SetNillablePeriod(v *checkrollup.Period) *CheckRollupUpdate {
+	if v != nil {
+		_u.SetPeriod(*v)
+	}
+	return _u
+}
+
+// SetBucketStart sets the "bucket_start" field.
+func (_u *CheckRollupUpdate) SetBucketStart(v time.Time) *CheckRollupUpdate {
+	_u.mutation.SetBucketStart(v)
+	return _u
+}
+
+// SetNillableBucketStart sets the "bucket_start" field if the given value is not nil.
+func (_u *CheckRollupUpdate) SetNillableBucketStart(v *time.Time) *CheckRollupUpdate {
+	if v != nil {
+		_u.SetBucketStart(*v)
+	}
+	return _u
+}
+
+// SetChecks sets the "checks" field.
+func (_u *CheckRollupUpdate) SetChecks(v int) *CheckRollupUpdate {
+	_u.mutation.ResetChecks()
+	_u.mutation.SetChecks(v)
+	return _u
+}
+
+// SetNillableChecks sets the "checks" field if the given value is not nil.
+func (_u *CheckRollupUpdate) SetNillableChecks(v *int) *CheckRollupUpdate {
+	if v != nil {
+		_u.SetChecks(*v)
+	}
+	return _u
+}
+
+// AddChecks adds value to the "checks" field.
+func (_u *CheckRollupUpdate) AddChecks(v int) *CheckRollupUpdate {
+	_u.mutation.AddChecks(v)
+	return _u
+}
+
+// SetSuccesses sets the "successes" field.
+func (_u *CheckRollupUpdate) SetSuccesses(v int) *CheckRollupUpdate {
+	_u.mutation.ResetSuccesses()
+	_u.mutation.SetSuccesses(v)
+	return _u
+}
+
+// SetNillableSuccesses sets the "successes" field if the given value is not nil.
+func (_u *CheckRollupUpdate) SetNillableSuccesses(v *int) *CheckRollupUpdate {
+	if v != nil {
+		_u.SetSuccesses(*v)
+	}
+	return _u
+}
+
+// AddSuccesses adds value to the "successes" field.
+func (_u *CheckRollupUpdate) AddSuccesses(v int) *CheckRollupUpdate {
+	_u.mutation.AddSuccesses(v)
+	return _u
+}
+
+// SetErrors sets the "errors" field.
+func (_u *CheckRollupUpdate) SetErrors(v int) *CheckRollupUpdate {
+	_u.mutation.ResetErrors()
+	_u.mutation.SetErrors(v)
+	return _u
+}
+
+// SetNillableErrors sets the "errors" field if the given value is not nil.
+func (_u *CheckRollupUpdate) SetNillableErrors(v *int) *CheckRollupUpdate {
+	if v != nil {
+		_u.SetErrors(*v)
+	}
+	return _u
+}
+
+// AddErrors adds value to the "errors" field.
+func (_u *CheckRollupUpdate) AddErrors(v int) *CheckRollupUpdate {
+	_u.mutation.AddErrors(v)
+	return _u
+}
+
+// SetChanges sets the "changes" field.
+func (_u *CheckRollupUpdate) SetChanges(v int) *CheckRollupUpdate {
+	_u.mutation.ResetChanges()
+	_u.mutation.SetChanges(v)
+	return _u
+}
+
+// SetNillableChanges sets the "changes" field if the given value is not nil.
+func (_u *CheckRollupUpdate) SetNillableChanges(v *int) *CheckRollupUpdate {
+	if v != nil {
+		_u.SetChanges(*v)
+	}
+	return _u
+}
+
+// AddChanges adds value to the "changes" field.
+func (_u *CheckRollupUpdate) AddChanges(v int) *CheckRollupUpdate {
+	_u.mutation.AddChanges(v)
+	return _u
+}
+
+// SetLatencySamples sets the "latency_samples" field.
+func (_u *CheckRollupUpdate) SetLatencySamples(v int) *CheckRollupUpdate {
+	_u.mutation.ResetLatencySamples()
+	_u.mutation.SetLatencySamples(v)
+	return _u
+}
+
+// SetNillableLatencySamples sets the "latency_samples" field if the given value is not nil.
+func (_u *CheckRollupUpdate) SetNillableLatencySamples(v *int) *CheckRollupUpdate {
+	if v != nil {
+		_u.SetLatencySamples(*v)
+	}
+	return _u
+}
+
+// AddLatencySamples adds value to the "latency_samples" field.
+func (_u *CheckRollupUpdate) AddLatencySamples(v int) *CheckRollupUpdate {
+	_u.mutation.AddLatencySamples(v)
+	return _u
+}
+
+// SetLatencyTotalMs sets the "latency_total_ms" field.
+func (_u *CheckRollupUpdate) SetLatencyTotalMs(v int64) *CheckRollupUpdate {
+	_u.mutation.ResetLatencyTotalMs()
+	_u.mutation.SetLatencyTotalMs(v)
+	return _u
+}
+
+// SetNillableLatencyTotalMs sets the "latency_total_ms" field if the given value is not nil.
+func (_u *CheckRollupUpdate) SetNillableLatencyTotalMs(v *int64) *CheckRollupUpdate {
+	if v != nil {
+		_u.SetLatencyTotalMs(*v)
+	}
+	return _u
+}
+
+// AddLatencyTotalMs adds value to the "latency_total_ms" field.
+func (_u *CheckRollupUpdate) AddLatencyTotalMs(v int64) *CheckRollupUpdate {
+	_u.mutation.AddLatencyTotalMs(v)
+	return _u
+}
+
+// SetLatencyMaxMs sets the "latency_max_ms" field.
+func (_u *CheckRollupUpdate) SetLatencyMaxMs(v int) *CheckRollupUpdate {
+	_u.mutation.ResetLatencyMaxMs()
+	_u.mutation.SetLatencyMaxMs(v)
+	return _u
+}
+
+// SetNillableLatencyMaxMs sets the "latency_max_ms" field if the given value is not nil.
+func (_u *CheckRollupUpdate) SetNillableLatencyMaxMs(v *int) *CheckRollupUpdate {
+	if v != nil {
+		_u.SetLatencyMaxMs(*v)
+	}
+	return _u
+}
+
+// AddLatencyMaxMs adds value to the "latency_max_ms" field.
+func (_u *CheckRollupUpdate) AddLatencyMaxMs(v int) *CheckRollupUpdate {
+	_u.mutation.AddLatencyMaxMs(v)
+	return _u
+}
+
+// ClearLatencyMaxMs clears the value of the "latency_max_ms" field.
+func (_u *CheckRollupUpdate) ClearLatencyMaxMs() *CheckRollupUpdate {
+	_u.mutation.ClearLatencyMaxMs()
+	return _u
+}
+
+// SetLatencyHistogram sets the "latency_histogram" field.
+func (_u *CheckRollupUpdate) SetLatencyHistogram(v map[string]int) *CheckRollupUpdate {
+	_u.mutation.SetLatencyHistogram(v)
+	return _u
+}
+
+// ClearLatencyHistogram clears the value of the "latency_histogram" field.
+func (_u *CheckRollupUpdate) ClearLatencyHistogram() *CheckRollupUpdate {
+	_u.mutation.ClearLatencyHistogram()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *CheckRollupUpdate) SetUpdatedAt(v time.Time) *CheckRollupUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetMonitor sets the "monitor" edge to the Monitor entity.
+func (_u *CheckRollupUpdate) SetMonitor(v *Monitor) *CheckRollupUpdate {
+	return _u.SetMonitorID(v.ID)
+}
+
+// Mutation returns the CheckRollupMutation object of the builder.
+func (_u *CheckRollupUpdate) Mutation() *CheckRollupMutation {
+	return _u.mutation
+}
+
+// ClearMonitor clears the "monitor" edge to the Monitor entity.
+func (_u *CheckRollupUpdate) ClearMonitor() *CheckRollupUpdate {
+	_u.mutation.ClearMonitor()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *CheckRollupUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *CheckRollupUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *CheckRollupUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *CheckRollupUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *CheckRollupUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := checkrollup.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *CheckRollupUpdate) check() error {
+	if v, ok := _u.mutation.Period(); ok {
+		if err := checkrollup.PeriodValidator(v); err != nil {
+			return &ValidationError{Name: "period", err: fmt.Errorf(`ent: validator failed for field "CheckRollup.period": %w`, err)}
+		}
+	}
+	if _u.mutation.MonitorCleared() && len(_u.mutation.MonitorIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "CheckRollup.monitor"`)
+	}
+	return nil
+}
+
+func (_u *CheckRollupUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(checkrollup.Table, checkrollup.Columns, sqlgraph.NewFieldSpec(checkrollup.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Period(); ok {
+		_spec.SetField(checkrollup.FieldPeriod, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.BucketStart(); ok {
+		_spec.SetField(checkrollup.FieldBucketStart, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Checks(); ok {
+		_spec.SetField(checkrollup.FieldChecks, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedChecks(); ok {
+		_spec.AddField(checkrollup.FieldChecks, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Successes(); ok {
+		_spec.SetField(checkrollup.FieldSuccesses, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedSuccesses(); ok {
+		_spec.AddField(checkrollup.FieldSuccesses, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Errors(); ok {
+		_spec.SetField(checkrollup.FieldErrors, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedErrors(); ok {
+		_spec.AddField(checkrollup.FieldErrors, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Changes(); ok {
+		_spec.SetField(checkrollup.FieldChanges, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedChanges(); ok {
+		_spec.AddField(checkrollup.FieldChanges, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.LatencySamples(); ok {
+		_spec.SetField(checkrollup.FieldLatencySamples, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedLatencySamples(); ok {
+		_spec.AddField(checkrollup.FieldLatencySamples, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.LatencyTotalMs(); ok {
+		_spec.SetField(checkrollup.FieldLatencyTotalMs, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedLatencyTotalMs(); ok {
+		_spec.AddField(checkrollup.FieldLatencyTotalMs, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.LatencyMaxMs(); ok {
+		_spec.SetField(checkrollup.FieldLatencyMaxMs, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedLatencyMaxMs(); ok {
+		_spec.AddField(checkrollup.FieldLatencyMaxMs, field.TypeInt, value)
+	}
+	if _u.mutation.LatencyMaxMsCleared() {
+		_spec.ClearField(checkrollup.FieldLatencyMaxMs, field.TypeInt)
+	}
+	if value, ok := _u.mutation.LatencyHistogram(); ok {
+		_spec.SetField(checkrollup.FieldLatencyHistogram, field.TypeJSON, value)
+	}
+	if _u.mutation.LatencyHistogramCleared() {
+		_spec.ClearField(checkrollup.FieldLatencyHistogram, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(checkrollup.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.MonitorCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   checkrollup.MonitorTable,
+			Columns: []string{checkrollup.MonitorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(monitor.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.MonitorIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   checkrollup.MonitorTable,
+			Columns: []string{checkrollup.MonitorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(monitor.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{checkrollup.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// CheckRollupUpdateOne is the builder for updating a single CheckRollup entity.
+type CheckRollupUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *CheckRollupMutation
+}
+
+// SetMonitorID sets the "monitor_id" field.
+func (_u *CheckRollupUpdateOne) SetMonitorID(v int) *CheckRollupUpdateOne {
+	_u.mutation.SetMonitorID(v)
+	return _u
+}
+
+// SetNillableMonitorID sets the "monitor_id" field if the given value is not nil.
+func (_u *CheckRollupUpdateOne) SetNillableMonitorID(v *int) *CheckRollupUpdateOne {
+	if v != nil {
+		_u.SetMonitorID(*v)
+	}
+	return _u
+}
+
+// SetPeriod sets the "period" field.
+func (_u *CheckRollupUpdateOne) SetPeriod(v checkrollup.Period) *CheckRollupUpdateOne {
+	_u.mutation.SetPeriod(v)
+	return _u
+}
+
+// SetNillablePeriod sets the "period" field if the given value is not nil.
+func (_u *CheckRollupUpdateOne) SetNillablePeriod(v *checkrollup.Period) *CheckRollupUpdateOne {
+	if v != nil {
+		_u.SetPeriod(*v)
+	}
+	return _u
+}
+
+// SetBucketStart sets the "bucket_start" field.
+func (_u *CheckRollupUpdateOne) SetBucketStart(v time.Time) *CheckRollupUpdateOne {
+	_u.mutation.SetBucketStart(v)
+	return _u
+}
+
+// SetNillableBucketStart sets the "bucket_start" field if the given value is not nil.
+func (_u *CheckRollupUpdateOne) SetNillableBucketStart(v *time.Time) *CheckRollupUpdateOne {
+	if v != nil {
+		_u.SetBucketStart(*v)
+	}
+	return _u
+}
+
+// SetChecks sets the "checks" field.
+func (_u *CheckRollupUpdateOne) SetChecks(v int) *CheckRollupUpdateOne {
+	_u.mutation.ResetChecks()
+	_u.mutation.SetChecks(v)
+	return _u
+}
+
+// SetNillableChecks sets the "checks" field if the given value is not nil.
+func (_u *CheckRollupUpdateOne) SetNillableChecks(v *int) *CheckRollupUpdateOne {
+	if v != nil {
+		_u.SetChecks(*v)
+	}
+	return _u
+}
+
+// AddChecks adds value to the "checks" field.
+func (_u *CheckRollupUpdateOne) AddChecks(v int) *CheckRollupUpdateOne {
+	_u.mutation.AddChecks(v)
+	return _u
+}
+
+// SetSuccesses sets the "successes" field.
+func (_u *CheckRollupUpdateOne) SetSuccesses(v int) *CheckRollupUpdateOne {
+	_u.mutation.ResetSuccesses()
+	_u.mutation.SetSuccesses(v)
+	return _u
+}
+
+// SetNillableSuccesses sets the "successes" field if the given value is not nil.
+func (_u *CheckRollupUpdateOne) SetNillableSuccesses(v *int) *CheckRollupUpdateOne {
+	if v != nil {
+		_u.SetSuccesses(*v)
+	}
+	return _u
+}
+
+// AddSuccesses adds value to the "successes" field.
+func (_u *CheckRollupUpdateOne) AddSuccesses(v int) *CheckRollupUpdateOne {
+	_u.mutation.AddSuccesses(v)
+	return _u
+}
+
+// SetErrors sets the "errors" field.
+func (_u *CheckRollupUpdateOne) SetErrors(v int) *CheckRollupUpdateOne {
+	_u.mutation.ResetErrors()
+	_u.mutation.SetErrors(v)
+	return _u
+}
+
+// SetNillableErrors sets the "errors" field if the given value is not nil.
+func (_u *CheckRollupUpdateOne) SetNillableErrors(v *int) *CheckRollupUpdateOne {
+	if v != nil {
+		_u.SetErrors(*v)
+	}
+	return _u
+}
+
+// AddErrors adds value to the "errors" field.
+func (_u *CheckRollupUpdateOne) AddErrors(v int) *CheckRollupUpdateOne {
+	_u.mutation.AddErrors(v)
+	return _u
+}
+
+// SetChanges sets the "changes" field.
+func (_u *CheckRollupUpdateOne) SetChanges(v int) *CheckRollupUpdateOne {
+	_u.mutation.ResetChanges()
+	_u.mutation.SetChanges(v)
+	return _u
+}
+
+// SetNillableChanges sets the "changes" field if the given value is not nil.
+func (_u *CheckRollupUpdateOne) SetNillableChanges(v *int) *CheckRollupUpdateOne {
+	if v != nil {
+		_u.SetChanges(*v)
+	}
+	return _u
+}
+
+// AddChanges adds value to the "changes" field.
+func (_u *CheckRollupUpdateOne) AddChanges(v int) *CheckRollupUpdateOne {
+	_u.mutation.AddChanges(v)
+	return _u
+}
+
+// SetLatencySamples sets the "latency_samples" field.
+func (_u *CheckRollupUpdateOne) SetLatencySamples(v int) *CheckRollupUpdateOne {
+	_u.mutation.ResetLatencySamples()
+	_u.mutation.SetLatencySamples(v)
+	return _u
+}
+
+// SetNillableLatencySamples sets the "latency_samples" field if the given value is not nil.
+func (_u *CheckRollupUpdateOne) SetNillableLatencySamples(v *int) *CheckRollupUpdateOne {
+	if v != nil {
+		_u.SetLatencySamples(*v)
+	}
+	return _u
+}
+
+// AddLatencySamples adds value to the "latency_samples" field.
+func (_u *CheckRollupUpdateOne) AddLatencySamples(v int) *CheckRollupUpdateOne {
+	_u.mutation.AddLatencySamples(v)
+	return _u
+}
+
+// SetLatencyTotalMs sets the "latency_total_ms" field.
+func (_u *CheckRollupUpdateOne) SetLatencyTotalMs(v int64) *CheckRollupUpdateOne {
+	_u.mutation.ResetLatencyTotalMs()
+	_u.mutation.SetLatencyTotalMs(v)
+	return _u
+}
+
+// SetNillableLatencyTotalMs sets the "latency_total_ms" field if the given value is not nil.
+func (_u *CheckRollupUpdateOne) SetNillableLatencyTotalMs(v *int64) *CheckRollupUpdateOne {
+	if v != nil {
+		_u.SetLatencyTotalMs(*v)
+	}
+	return _u
+}
+
+// AddLatencyTotalMs adds value to the "latency_total_ms" field.
+func (_u *CheckRollupUpdateOne) AddLatencyTotalMs(v int64) *CheckRollupUpdateOne {
+	_u.mutation.AddLatencyTotalMs(v)
+	return _u
+}
+
+// SetLatencyMaxMs sets the "latency_max_ms" field.
+func (_u *CheckRollupUpdateOne) SetLatencyMaxMs(v int) *CheckRollupUpdateOne {
+	_u.mutation.ResetLatencyMaxMs()
+	_u.mutation.SetLatencyMaxMs(v)
+	return _u
+}
+
+// SetNillableLatencyMaxMs sets the "latency_max_ms" field if the given value is not nil.
+func (_u *CheckRollupUpdateOne) SetNillableLatencyMaxMs(v *int) *CheckRollupUpdateOne {
+	if v != nil {
+		_u.SetLatencyMaxMs(*v)
+	}
+	return _u
+}
+
+// AddLatencyMaxMs adds value to the "latency_max_ms" field.
+func (_u *CheckRollupUpdateOne) AddLatencyMaxMs(v int) *CheckRollupUpdateOne {
+	_u.mutation.AddLatencyMaxMs(v)
+	return _u
+}
+
+// ClearLatencyMaxMs clears the value of the "latency_max_ms" field.
+func (_u *CheckRollupUpdateOne) ClearLatencyMaxMs() *CheckRollupUpdateOne {
+	_u.mutation.ClearLatencyMaxMs()
+	return _u
+}
+
+// SetLatencyHistogram sets the "latency_histogram" field.
+func (_u *CheckRollupUpdateOne) SetLatencyHistogram(v map[string]int) *CheckRollupUpdateOne {
+	_u.mutation.SetLatencyHistogram(v)
+	return _u
+}
+
+// ClearLatencyHistogram clears the value of the "latency_histogram" field.
+func (_u *CheckRollupUpdateOne) ClearLatencyHistogram() *CheckRollupUpdateOne {
+	_u.mutation.ClearLatencyHistogram()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *CheckRollupUpdateOne) SetUpdatedAt(v time.Time) *CheckRollupUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetMonitor sets the "monitor" edge to the Monitor entity.
+func (_u *CheckRollupUpdateOne) SetMonitor(v *Monitor) *CheckRollupUpdateOne {
+	return _u.SetMonitorID(v.ID)
+}
+
+// Mutation returns the CheckRollupMutation object of the builder.
+func (_u *CheckRollupUpdateOne) Mutation() *CheckRollupMutation {
+	return _u.mutation
+}
+
+// ClearMonitor clears the "monitor" edge to the Monitor entity.
+func (_u *CheckRollupUpdateOne) ClearMonitor() *CheckRollupUpdateOne {
+	_u.mutation.ClearMonitor()
+	return _u
+}
+
+// Where appends a list predicates to the CheckRollupUpdate builder.
+func (_u *CheckRollupUpdateOne) Where(ps ...predicate.CheckRollup) *CheckRollupUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *CheckRollupUpdateOne) Select(field string, fields ...string) *CheckRollupUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated CheckRollup entity.
+func (_u *CheckRollupUpdateOne) Save(ctx context.Context) (*CheckRollup, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *CheckRollupUpdateOne) SaveX(ctx context.Context) *CheckRollup {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *CheckRollupUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *CheckRollupUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *CheckRollupUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := checkrollup.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *CheckRollupUpdateOne) check() error {
+	if v, ok := _u.mutation.Period(); ok {
+		if err := checkrollup.PeriodValidator(v); err != nil {
+			return &ValidationError{Name: "period", err: fmt.Errorf(`ent: validator failed for field "CheckRollup.period": %w`, err)}
+		}
+	}
+	if _u.mutation.MonitorCleared() && len(_u.mutation.MonitorIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "CheckRollup.monitor"`)
+	}
+	return nil
+}
+
+func (_u *CheckRollupUpdateOne) sqlSave(ctx context.Context) (_node *CheckRollup, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(checkrollup.Table, checkrollup.Columns, sqlgraph.NewFieldSpec(checkrollup.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "CheckRollup.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, checkrollup.FieldID)
+		for _, f := range fields {
+			if !checkrollup.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != checkrollup.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Period(); ok {
+		_spec.SetField(checkrollup.FieldPeriod, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.BucketStart(); ok {
+		_spec.SetField(checkrollup.FieldBucketStart, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Checks(); ok {
+		_spec.SetField(checkrollup.FieldChecks, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedChecks(); ok {
+		_spec.AddField(checkrollup.FieldChecks, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Successes(); ok {
+		_spec.SetField(checkrollup.FieldSuccesses, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedSuccesses(); ok {
+		_spec.AddField(checkrollup.FieldSuccesses, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Errors(); ok {
+		_spec.SetField(checkrollup.FieldErrors, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedErrors(); ok {
+		_spec.AddField(checkrollup.FieldErrors, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Changes(); ok {
+		_spec.SetField(checkrollup.FieldChanges, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedChanges(); ok {
+		_spec.AddField(checkrollup.FieldChanges, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.LatencySamples(); ok {
+		_spec.SetField(checkrollup.FieldLatencySamples, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedLatencySamples(); ok {
+		_spec.AddField(checkrollup.FieldLatencySamples, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.LatencyTotalMs(); ok {
+		_spec.SetField(checkrollup.FieldLatencyTotalMs, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedLatencyTotalMs(); ok {
+		_spec.AddField(checkrollup.FieldLatencyTotalMs, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.LatencyMaxMs(); ok {
+		_spec.SetField(checkrollup.FieldLatencyMaxMs, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedLatencyMaxMs(); ok {
+		_spec.AddField(checkrollup.FieldLatencyMaxMs, field.TypeInt, value)
+	}
+	if _u.mutation.LatencyMaxMsCleared() {
+		_spec.ClearField(checkrollup.FieldLatencyMaxMs, field.TypeInt)
+	}
+	if value, ok := _u.mutation.LatencyHistogram(); ok {
+		_spec.SetField(checkrollup.FieldLatencyHistogram, field.TypeJSON, value)
+	}
+	if _u.mutation.LatencyHistogramCleared() {
+		_spec.ClearField(checkrollup.FieldLatencyHistogram, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(checkrollup.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.MonitorCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   checkrollup.MonitorTable,
+			Columns: []string{checkrollup.MonitorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(monitor.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.MonitorIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   checkrollup.MonitorTable,
+			Columns: []string{checkrollup.MonitorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(monitor.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &CheckRollup{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{checkrollup.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
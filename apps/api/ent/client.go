@@ -12,11 +12,17 @@ import (
 	"goanna/apps/api/ent/migrate"
 
 	"goanna/apps/api/ent/checkresult"
+	"goanna/apps/api/ent/checkrollup"
+	"goanna/apps/api/ent/headerprofile"
 	"goanna/apps/api/ent/monitor"
 	"goanna/apps/api/ent/monitorruntime"
+	"goanna/apps/api/ent/monitorversion"
 	"goanna/apps/api/ent/notificationchannel"
 	"goanna/apps/api/ent/notificationevent"
+	"goanna/apps/api/ent/selectorpayload"
+	"goanna/apps/api/ent/session"
 	"goanna/apps/api/ent/systemconfig"
+	"goanna/apps/api/ent/user"
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect"
@@ -31,16 +37,28 @@ type Client struct {
 	Schema *migrate.Schema
 	// CheckResult is the client for interacting with the CheckResult builders.
 	CheckResult *CheckResultClient
+	// CheckRollup is the client for interacting with the CheckRollup builders.
+	CheckRollup *CheckRollupClient
+	// HeaderProfile is the client for interacting with the HeaderProfile builders.
+	HeaderProfile *HeaderProfileClient
 	// Monitor is the client for interacting with the Monitor builders.
 	Monitor *MonitorClient
 	// MonitorRuntime is the client for interacting with the MonitorRuntime builders.
 	MonitorRuntime *MonitorRuntimeClient
+	// MonitorVersion is the client for interacting with the MonitorVersion builders.
+	MonitorVersion *MonitorVersionClient
 	// NotificationChannel is the client for interacting with the NotificationChannel builders.
 	NotificationChannel *NotificationChannelClient
 	// NotificationEvent is the client for interacting with the NotificationEvent builders.
 	NotificationEvent *NotificationEventClient
+	// SelectorPayload is the client for interacting with the SelectorPayload builders.
+	SelectorPayload *SelectorPayloadClient
+	// Session is the client for interacting with the Session builders.
+	Session *SessionClient
 	// SystemConfig is the client for interacting with the SystemConfig builders.
 	SystemConfig *SystemConfigClient
+	// User is the client for interacting with the User builders.
+	User *UserClient
 }
 
 // NewClient creates a new client configured with the given options.
@@ -53,11 +71,17 @@ func NewClient(opts ...Option) *Client {
 func (c *Client) init() {
 	c.Schema = migrate.NewSchema(c.driver)
 	c.CheckResult = NewCheckResultClient(c.config)
+	c.CheckRollup = NewCheckRollupClient(c.config)
+	c.HeaderProfile = NewHeaderProfileClient(c.config)
 	c.Monitor = NewMonitorClient(c.config)
 	c.MonitorRuntime = NewMonitorRuntimeClient(c.config)
+	c.MonitorVersion = NewMonitorVersionClient(c.config)
 	c.NotificationChannel = NewNotificationChannelClient(c.config)
 	c.NotificationEvent = NewNotificationEventClient(c.config)
+	c.SelectorPayload = NewSelectorPayloadClient(c.config)
+	c.Session = NewSessionClient(c.config)
 	c.SystemConfig = NewSystemConfigClient(c.config)
+	c.User = NewUserClient(c.config)
 }
 
 type (
@@ -151,11 +175,17 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 		ctx:                 ctx,
 		config:              cfg,
 		CheckResult:         NewCheckResultClient(cfg),
+		CheckRollup:         NewCheckRollupClient(cfg),
+		HeaderProfile:       NewHeaderProfileClient(cfg),
 		Monitor:             NewMonitorClient(cfg),
 		MonitorRuntime:      NewMonitorRuntimeClient(cfg),
+		MonitorVersion:      NewMonitorVersionClient(cfg),
 		NotificationChannel: NewNotificationChannelClient(cfg),
 		NotificationEvent:   NewNotificationEventClient(cfg),
+		SelectorPayload:     NewSelectorPayloadClient(cfg),
+		Session:             NewSessionClient(cfg),
 		SystemConfig:        NewSystemConfigClient(cfg),
+		User:                NewUserClient(cfg),
 	}, nil
 }
 
@@ -176,11 +206,17 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 		ctx:                 ctx,
 		config:              cfg,
 		CheckResult:         NewCheckResultClient(cfg),
+		CheckRollup:         NewCheckRollupClient(cfg),
+		HeaderProfile:       NewHeaderProfileClient(cfg),
 		Monitor:             NewMonitorClient(cfg),
 		MonitorRuntime:      NewMonitorRuntimeClient(cfg),
+		MonitorVersion:      NewMonitorVersionClient(cfg),
 		NotificationChannel: NewNotificationChannelClient(cfg),
 		NotificationEvent:   NewNotificationEventClient(cfg),
+		SelectorPayload:     NewSelectorPayloadClient(cfg),
+		Session:             NewSessionClient(cfg),
 		SystemConfig:        NewSystemConfigClient(cfg),
+		User:                NewUserClient(cfg),
 	}, nil
 }
 
@@ -210,8 +246,9 @@ func (c *Client) Close() error {
 // In order to add hooks to a specific client, call: `client.Node.Use(...)`.
 func (c *Client) Use(hooks ...Hook) {
 	for _, n := range []interface{ Use(...Hook) }{
-		c.CheckResult, c.Monitor, c.MonitorRuntime, c.NotificationChannel,
-		c.NotificationEvent, c.SystemConfig,
+		c.CheckResult, c.CheckRollup, c.HeaderProfile, c.Monitor, c.MonitorRuntime,
+		c.MonitorVersion, c.NotificationChannel, c.NotificationEvent,
+		c.SelectorPayload, c.Session, c.SystemConfig, c.User,
 	} {
 		n.Use(hooks...)
 	}
@@ -221,8 +258,9 @@ func (c *Client) Use(hooks ...Hook) {
 // In order to add interceptors to a specific client, call: `client.Node.Intercept(...)`.
 func (c *Client) Intercept(interceptors ...Interceptor) {
 	for _, n := range []interface{ Intercept(...Interceptor) }{
-		c.CheckResult, c.Monitor, c.MonitorRuntime, c.NotificationChannel,
-		c.NotificationEvent, c.SystemConfig,
+		c.CheckResult, c.CheckRollup, c.HeaderProfile, c.Monitor, c.MonitorRuntime,
+		c.MonitorVersion, c.NotificationChannel, c.NotificationEvent,
+		c.SelectorPayload, c.Session, c.SystemConfig, c.User,
 	} {
 		n.Intercept(interceptors...)
 	}
@@ -233,16 +271,28 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 	switch m := m.(type) {
 	case *CheckResultMutation:
 		return c.CheckResult.mutate(ctx, m)
+	case *CheckRollupMutation:
+		return c.CheckRollup.mutate(ctx, m)
+	case *HeaderProfileMutation:
+		return c.HeaderProfile.mutate(ctx, m)
 	case *MonitorMutation:
 		return c.Monitor.mutate(ctx, m)
 	case *MonitorRuntimeMutation:
 		return c.MonitorRuntime.mutate(ctx, m)
+	case *MonitorVersionMutation:
+		return c.MonitorVersion.mutate(ctx, m)
 	case *NotificationChannelMutation:
 		return c.NotificationChannel.mutate(ctx, m)
 	case *NotificationEventMutation:
 		return c.NotificationEvent.mutate(ctx, m)
+	case *SelectorPayloadMutation:
+		return c.SelectorPayload.mutate(ctx, m)
+	case *SessionMutation:
+		return c.Session.mutate(ctx, m)
 	case *SystemConfigMutation:
 		return c.SystemConfig.mutate(ctx, m)
+	case *UserMutation:
+		return c.User.mutate(ctx, m)
 	default:
 		return nil, fmt.Errorf("ent: unknown mutation type %T", m)
 	}
@@ -397,6 +447,304 @@ func (c *CheckResultClient) mutate(ctx context.Context, m *CheckResultMutation)
 	}
 }
 
+// CheckRollupClient is a client for the CheckRollup schema.
+type CheckRollupClient struct {
+	config
+}
+
+// NewCheckRollupClient returns a client for the CheckRollup from the given config.
+func NewCheckRollupClient(c config) *CheckRollupClient {
+	return &CheckRollupClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `checkrollup.Hooks(f(g(h())))`.
+func (c *CheckRollupClient) Use(hooks ...Hook) {
+	c.hooks.CheckRollup = append(c.hooks.CheckRollup, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `checkrollup.Intercept(f(g(h())))`.
+func (c *CheckRollupClient) Intercept(interceptors ...Interceptor) {
+	c.inters.CheckRollup = append(c.inters.CheckRollup, interceptors...)
+}
+
+// Create returns a builder for creating a CheckRollup entity.
+func (c *CheckRollupClient) Create() *CheckRollupCreate {
+	mutation := newCheckRollupMutation(c.config, OpCreate)
+	return &CheckRollupCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of CheckRollup entities.
+func (c *CheckRollupClient) CreateBulk(builders ...*CheckRollupCreate) *CheckRollupCreateBulk {
+	return &CheckRollupCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *CheckRollupClient) MapCreateBulk(slice any, setFunc func(*CheckRollupCreate, int)) *CheckRollupCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &CheckRollupCreateBulk{err: fmt.Errorf("calling to CheckRollupClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*CheckRollupCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &CheckRollupCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for CheckRollup.
+func (c *CheckRollupClient) Update() *CheckRollupUpdate {
+	mutation := newCheckRollupMutation(c.config, OpUpdate)
+	return &CheckRollupUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *CheckRollupClient) UpdateOne(_m *CheckRollup) *CheckRollupUpdateOne {
+	mutation := newCheckRollupMutation(c.config, OpUpdateOne, withCheckRollup(_m))
+	return &CheckRollupUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *CheckRollupClient) UpdateOneID(id int) *CheckRollupUpdateOne {
+	mutation := newCheckRollupMutation(c.config, OpUpdateOne, withCheckRollupID(id))
+	return &CheckRollupUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for CheckRollup.
+func (c *CheckRollupClient) Delete() *CheckRollupDelete {
+	mutation := newCheckRollupMutation(c.config, OpDelete)
+	return &CheckRollupDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *CheckRollupClient) DeleteOne(_m *CheckRollup) *CheckRollupDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *CheckRollupClient) DeleteOneID(id int) *CheckRollupDeleteOne {
+	builder := c.Delete().Where(checkrollup.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &CheckRollupDeleteOne{builder}
+}
+
+// Query returns a query builder for CheckRollup.
+func (c *CheckRollupClient) Query() *CheckRollupQuery {
+	return &CheckRollupQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeCheckRollup},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a CheckRollup entity by its id.
+func (c *CheckRollupClient) Get(ctx context.Context, id int) (*CheckRollup, error) {
+	return c.Query().Where(checkrollup.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *CheckRollupClient) GetX(ctx context.Context, id int) *CheckRollup {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryMonitor queries the monitor edge of a CheckRollup.
+func (c *CheckRollupClient) QueryMonitor(_m *CheckRollup) *MonitorQuery {
+	query := (&MonitorClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(checkrollup.Table, checkrollup.FieldID, id),
+			sqlgraph.To(monitor.Table, monitor.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, checkrollup.MonitorTable, checkrollup.MonitorColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *CheckRollupClient) Hooks() []Hook {
+	return c.hooks.CheckRollup
+}
+
+// Interceptors returns the client interceptors.
+func (c *CheckRollupClient) Interceptors() []Interceptor {
+	return c.inters.CheckRollup
+}
+
+func (c *CheckRollupClient) mutate(ctx context.Context, m *CheckRollupMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&CheckRollupCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&CheckRollupUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&CheckRollupUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&CheckRollupDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown CheckRollup mutation op: %q", m.Op())
+	}
+}
+
+// HeaderProfileClient is a client for the HeaderProfile schema.
+type HeaderProfileClient struct {
+	config
+}
+
+// NewHeaderProfileClient returns a client for the HeaderProfile from the given config.
+func NewHeaderProfileClient(c config) *HeaderProfileClient {
+	return &HeaderProfileClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `headerprofile.Hooks(f(g(h())))`.
+func (c *HeaderProfileClient) Use(hooks ...Hook) {
+	c.hooks.HeaderProfile = append(c.hooks.HeaderProfile, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `headerprofile.Intercept(f(g(h())))`.
+func (c *HeaderProfileClient) Intercept(interceptors ...Interceptor) {
+	c.inters.HeaderProfile = append(c.inters.HeaderProfile, interceptors...)
+}
+
+// Create returns a builder for creating a HeaderProfile entity.
+func (c *HeaderProfileClient) Create() *HeaderProfileCreate {
+	mutation := newHeaderProfileMutation(c.config, OpCreate)
+	return &HeaderProfileCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of HeaderProfile entities.
+func (c *HeaderProfileClient) CreateBulk(builders ...*HeaderProfileCreate) *HeaderProfileCreateBulk {
+	return &HeaderProfileCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *HeaderProfileClient) MapCreateBulk(slice any, setFunc func(*HeaderProfileCreate, int)) *HeaderProfileCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &HeaderProfileCreateBulk{err: fmt.Errorf("calling to HeaderProfileClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*HeaderProfileCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &HeaderProfileCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for HeaderProfile.
+func (c *HeaderProfileClient) Update() *HeaderProfileUpdate {
+	mutation := newHeaderProfileMutation(c.config, OpUpdate)
+	return &HeaderProfileUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *HeaderProfileClient) UpdateOne(_m *HeaderProfile) *HeaderProfileUpdateOne {
+	mutation := newHeaderProfileMutation(c.config, OpUpdateOne, withHeaderProfile(_m))
+	return &HeaderProfileUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *HeaderProfileClient) UpdateOneID(id int) *HeaderProfileUpdateOne {
+	mutation := newHeaderProfileMutation(c.config, OpUpdateOne, withHeaderProfileID(id))
+	return &HeaderProfileUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for HeaderProfile.
+func (c *HeaderProfileClient) Delete() *HeaderProfileDelete {
+	mutation := newHeaderProfileMutation(c.config, OpDelete)
+	return &HeaderProfileDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *HeaderProfileClient) DeleteOne(_m *HeaderProfile) *HeaderProfileDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *HeaderProfileClient) DeleteOneID(id int) *HeaderProfileDeleteOne {
+	builder := c.Delete().Where(headerprofile.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &HeaderProfileDeleteOne{builder}
+}
+
+// Query returns a query builder for HeaderProfile.
+func (c *HeaderProfileClient) Query() *HeaderProfileQuery {
+	return &HeaderProfileQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeHeaderProfile},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a HeaderProfile entity by its id.
+func (c *HeaderProfileClient) Get(ctx context.Context, id int) (*HeaderProfile, error) {
+	return c.Query().Where(headerprofile.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *HeaderProfileClient) GetX(ctx context.Context, id int) *HeaderProfile {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryMonitors queries the monitors edge of a HeaderProfile.
+func (c *HeaderProfileClient) QueryMonitors(_m *HeaderProfile) *MonitorQuery {
+	query := (&MonitorClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(headerprofile.Table, headerprofile.FieldID, id),
+			sqlgraph.To(monitor.Table, monitor.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, headerprofile.MonitorsTable, headerprofile.MonitorsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *HeaderProfileClient) Hooks() []Hook {
+	return c.hooks.HeaderProfile
+}
+
+// Interceptors returns the client interceptors.
+func (c *HeaderProfileClient) Interceptors() []Interceptor {
+	return c.inters.HeaderProfile
+}
+
+func (c *HeaderProfileClient) mutate(ctx context.Context, m *HeaderProfileMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&HeaderProfileCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&HeaderProfileUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&HeaderProfileUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&HeaderProfileDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown HeaderProfile mutation op: %q", m.Op())
+	}
+}
+
 // MonitorClient is a client for the Monitor schema.
 type MonitorClient struct {
 	config
@@ -553,6 +901,54 @@ func (c *MonitorClient) QueryRuntime(_m *Monitor) *MonitorRuntimeQuery {
 	return query
 }
 
+// QueryVersions queries the versions edge of a Monitor.
+func (c *MonitorClient) QueryVersions(_m *Monitor) *MonitorVersionQuery {
+	query := (&MonitorVersionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(monitor.Table, monitor.FieldID, id),
+			sqlgraph.To(monitorversion.Table, monitorversion.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, monitor.VersionsTable, monitor.VersionsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryRollups queries the rollups edge of a Monitor.
+func (c *MonitorClient) QueryRollups(_m *Monitor) *CheckRollupQuery {
+	query := (&CheckRollupClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(monitor.Table, monitor.FieldID, id),
+			sqlgraph.To(checkrollup.Table, checkrollup.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, monitor.RollupsTable, monitor.RollupsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryHeaderProfile queries the header_profile edge of a Monitor.
+func (c *MonitorClient) QueryHeaderProfile(_m *Monitor) *HeaderProfileQuery {
+	query := (&HeaderProfileClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(monitor.Table, monitor.FieldID, id),
+			sqlgraph.To(headerprofile.Table, headerprofile.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, monitor.HeaderProfileTable, monitor.HeaderProfileColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
 func (c *MonitorClient) Hooks() []Hook {
 	return c.hooks.Monitor
@@ -727,107 +1123,107 @@ func (c *MonitorRuntimeClient) mutate(ctx context.Context, m *MonitorRuntimeMuta
 	}
 }
 
-// NotificationChannelClient is a client for the NotificationChannel schema.
-type NotificationChannelClient struct {
+// MonitorVersionClient is a client for the MonitorVersion schema.
+type MonitorVersionClient struct {
 	config
 }
 
-// NewNotificationChannelClient returns a client for the NotificationChannel from the given config.
-func NewNotificationChannelClient(c config) *NotificationChannelClient {
-	return &NotificationChannelClient{config: c}
+// NewMonitorVersionClient returns a client for the MonitorVersion from the given config.
+func NewMonitorVersionClient(c config) *MonitorVersionClient {
+	return &MonitorVersionClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `notificationchannel.Hooks(f(g(h())))`.
-func (c *NotificationChannelClient) Use(hooks ...Hook) {
-	c.hooks.NotificationChannel = append(c.hooks.NotificationChannel, hooks...)
+// A call to `Use(f, g, h)` equals to `monitorversion.Hooks(f(g(h())))`.
+func (c *MonitorVersionClient) Use(hooks ...Hook) {
+	c.hooks.MonitorVersion = append(c.hooks.MonitorVersion, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `notificationchannel.Intercept(f(g(h())))`.
-func (c *NotificationChannelClient) Intercept(interceptors ...Interceptor) {
-	c.inters.NotificationChannel = append(c.inters.NotificationChannel, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `monitorversion.Intercept(f(g(h())))`.
+func (c *MonitorVersionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.MonitorVersion = append(c.inters.MonitorVersion, interceptors...)
 }
 
-// Create returns a builder for creating a NotificationChannel entity.
-func (c *NotificationChannelClient) Create() *NotificationChannelCreate {
-	mutation := newNotificationChannelMutation(c.config, OpCreate)
-	return &NotificationChannelCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a MonitorVersion entity.
+func (c *MonitorVersionClient) Create() *MonitorVersionCreate {
+	mutation := newMonitorVersionMutation(c.config, OpCreate)
+	return &MonitorVersionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of NotificationChannel entities.
-func (c *NotificationChannelClient) CreateBulk(builders ...*NotificationChannelCreate) *NotificationChannelCreateBulk {
-	return &NotificationChannelCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of MonitorVersion entities.
+func (c *MonitorVersionClient) CreateBulk(builders ...*MonitorVersionCreate) *MonitorVersionCreateBulk {
+	return &MonitorVersionCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *NotificationChannelClient) MapCreateBulk(slice any, setFunc func(*NotificationChannelCreate, int)) *NotificationChannelCreateBulk {
+func (c *MonitorVersionClient) MapCreateBulk(slice any, setFunc func(*MonitorVersionCreate, int)) *MonitorVersionCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &NotificationChannelCreateBulk{err: fmt.Errorf("calling to NotificationChannelClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &MonitorVersionCreateBulk{err: fmt.Errorf("calling to MonitorVersionClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*NotificationChannelCreate, rv.Len())
+	builders := make([]*MonitorVersionCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &NotificationChannelCreateBulk{config: c.config, builders: builders}
+	return &MonitorVersionCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for NotificationChannel.
-func (c *NotificationChannelClient) Update() *NotificationChannelUpdate {
-	mutation := newNotificationChannelMutation(c.config, OpUpdate)
-	return &NotificationChannelUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for MonitorVersion.
+func (c *MonitorVersionClient) Update() *MonitorVersionUpdate {
+	mutation := newMonitorVersionMutation(c.config, OpUpdate)
+	return &MonitorVersionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *NotificationChannelClient) UpdateOne(_m *NotificationChannel) *NotificationChannelUpdateOne {
-	mutation := newNotificationChannelMutation(c.config, OpUpdateOne, withNotificationChannel(_m))
-	return &NotificationChannelUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *MonitorVersionClient) UpdateOne(_m *MonitorVersion) *MonitorVersionUpdateOne {
+	mutation := newMonitorVersionMutation(c.config, OpUpdateOne, withMonitorVersion(_m))
+	return &MonitorVersionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *NotificationChannelClient) UpdateOneID(id int) *NotificationChannelUpdateOne {
-	mutation := newNotificationChannelMutation(c.config, OpUpdateOne, withNotificationChannelID(id))
-	return &NotificationChannelUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *MonitorVersionClient) UpdateOneID(id int) *MonitorVersionUpdateOne {
+	mutation := newMonitorVersionMutation(c.config, OpUpdateOne, withMonitorVersionID(id))
+	return &MonitorVersionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for NotificationChannel.
-func (c *NotificationChannelClient) Delete() *NotificationChannelDelete {
-	mutation := newNotificationChannelMutation(c.config, OpDelete)
-	return &NotificationChannelDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for MonitorVersion.
+func (c *MonitorVersionClient) Delete() *MonitorVersionDelete {
+	mutation := newMonitorVersionMutation(c.config, OpDelete)
+	return &MonitorVersionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *NotificationChannelClient) DeleteOne(_m *NotificationChannel) *NotificationChannelDeleteOne {
+func (c *MonitorVersionClient) DeleteOne(_m *MonitorVersion) *MonitorVersionDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *NotificationChannelClient) DeleteOneID(id int) *NotificationChannelDeleteOne {
-	builder := c.Delete().Where(notificationchannel.ID(id))
+func (c *MonitorVersionClient) DeleteOneID(id int) *MonitorVersionDeleteOne {
+	builder := c.Delete().Where(monitorversion.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &NotificationChannelDeleteOne{builder}
+	return &MonitorVersionDeleteOne{builder}
 }
 
-// Query returns a query builder for NotificationChannel.
-func (c *NotificationChannelClient) Query() *NotificationChannelQuery {
-	return &NotificationChannelQuery{
+// Query returns a query builder for MonitorVersion.
+func (c *MonitorVersionClient) Query() *MonitorVersionQuery {
+	return &MonitorVersionQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeNotificationChannel},
+		ctx:    &QueryContext{Type: TypeMonitorVersion},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a NotificationChannel entity by its id.
-func (c *NotificationChannelClient) Get(ctx context.Context, id int) (*NotificationChannel, error) {
-	return c.Query().Where(notificationchannel.ID(id)).Only(ctx)
+// Get returns a MonitorVersion entity by its id.
+func (c *MonitorVersionClient) Get(ctx context.Context, id int) (*MonitorVersion, error) {
+	return c.Query().Where(monitorversion.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *NotificationChannelClient) GetX(ctx context.Context, id int) *NotificationChannel {
+func (c *MonitorVersionClient) GetX(ctx context.Context, id int) *MonitorVersion {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -835,7 +1231,156 @@ func (c *NotificationChannelClient) GetX(ctx context.Context, id int) *Notificat
 	return obj
 }
 
-// QueryNotificationEvents queries the notification_events edge of a NotificationChannel.
+// QueryMonitor queries the monitor edge of a MonitorVersion.
+func (c *MonitorVersionClient) QueryMonitor(_m *MonitorVersion) *MonitorQuery {
+	query := (&MonitorClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(monitorversion.Table, monitorversion.FieldID, id),
+			sqlgraph.To(monitor.Table, monitor.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, monitorversion.MonitorTable, monitorversion.MonitorColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *MonitorVersionClient) Hooks() []Hook {
+	return c.hooks.MonitorVersion
+}
+
+// Interceptors returns the client interceptors.
+func (c *MonitorVersionClient) Interceptors() []Interceptor {
+	return c.inters.MonitorVersion
+}
+
+func (c *MonitorVersionClient) mutate(ctx context.Context, m *MonitorVersionMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&MonitorVersionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&MonitorVersionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&MonitorVersionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&MonitorVersionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown MonitorVersion mutation op: %q", m.Op())
+	}
+}
+
+// NotificationChannelClient is a client for the NotificationChannel schema.
+type NotificationChannelClient struct {
+	config
+}
+
+// NewNotificationChannelClient returns a client for the NotificationChannel from the given config.
+func NewNotificationChannelClient(c config) *NotificationChannelClient {
+	return &NotificationChannelClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `notificationchannel.Hooks(f(g(h())))`.
+func (c *NotificationChannelClient) Use(hooks ...Hook) {
+	c.hooks.NotificationChannel = append(c.hooks.NotificationChannel, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `notificationchannel.Intercept(f(g(h())))`.
+func (c *NotificationChannelClient) Intercept(interceptors ...Interceptor) {
+	c.inters.NotificationChannel = append(c.inters.NotificationChannel, interceptors...)
+}
+
+// Create returns a builder for creating a NotificationChannel entity.
+func (c *NotificationChannelClient) Create() *NotificationChannelCreate {
+	mutation := newNotificationChannelMutation(c.config, OpCreate)
+	return &NotificationChannelCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of NotificationChannel entities.
+func (c *NotificationChannelClient) CreateBulk(builders ...*NotificationChannelCreate) *NotificationChannelCreateBulk {
+	return &NotificationChannelCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *NotificationChannelClient) MapCreateBulk(slice any, setFunc func(*NotificationChannelCreate, int)) *NotificationChannelCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &NotificationChannelCreateBulk{err: fmt.Errorf("calling to NotificationChannelClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*NotificationChannelCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &NotificationChannelCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for NotificationChannel.
+func (c *NotificationChannelClient) Update() *NotificationChannelUpdate {
+	mutation := newNotificationChannelMutation(c.config, OpUpdate)
+	return &NotificationChannelUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *NotificationChannelClient) UpdateOne(_m *NotificationChannel) *NotificationChannelUpdateOne {
+	mutation := newNotificationChannelMutation(c.config, OpUpdateOne, withNotificationChannel(_m))
+	return &NotificationChannelUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *NotificationChannelClient) UpdateOneID(id int) *NotificationChannelUpdateOne {
+	mutation := newNotificationChannelMutation(c.config, OpUpdateOne, withNotificationChannelID(id))
+	return &NotificationChannelUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for NotificationChannel.
+func (c *NotificationChannelClient) Delete() *NotificationChannelDelete {
+	mutation := newNotificationChannelMutation(c.config, OpDelete)
+	return &NotificationChannelDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *NotificationChannelClient) DeleteOne(_m *NotificationChannel) *NotificationChannelDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *NotificationChannelClient) DeleteOneID(id int) *NotificationChannelDeleteOne {
+	builder := c.Delete().Where(notificationchannel.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &NotificationChannelDeleteOne{builder}
+}
+
+// Query returns a query builder for NotificationChannel.
+func (c *NotificationChannelClient) Query() *NotificationChannelQuery {
+	return &NotificationChannelQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeNotificationChannel},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a NotificationChannel entity by its id.
+func (c *NotificationChannelClient) Get(ctx context.Context, id int) (*NotificationChannel, error) {
+	return c.Query().Where(notificationchannel.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *NotificationChannelClient) GetX(ctx context.Context, id int) *NotificationChannel {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryNotificationEvents queries the notification_events edge of a NotificationChannel.
 func (c *NotificationChannelClient) QueryNotificationEvents(_m *NotificationChannel) *NotificationEventQuery {
 	query := (&NotificationEventClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
@@ -1016,6 +1561,38 @@ func (c *NotificationEventClient) QueryChannel(_m *NotificationEvent) *Notificat
 	return query
 }
 
+// QueryEscalatedFrom queries the escalated_from edge of a NotificationEvent.
+func (c *NotificationEventClient) QueryEscalatedFrom(_m *NotificationEvent) *NotificationEventQuery {
+	query := (&NotificationEventClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(notificationevent.Table, notificationevent.FieldID, id),
+			sqlgraph.To(notificationevent.Table, notificationevent.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, notificationevent.EscalatedFromTable, notificationevent.EscalatedFromColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryEscalations queries the escalations edge of a NotificationEvent.
+func (c *NotificationEventClient) QueryEscalations(_m *NotificationEvent) *NotificationEventQuery {
+	query := (&NotificationEventClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(notificationevent.Table, notificationevent.FieldID, id),
+			sqlgraph.To(notificationevent.Table, notificationevent.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, notificationevent.EscalationsTable, notificationevent.EscalationsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
 func (c *NotificationEventClient) Hooks() []Hook {
 	return c.hooks.NotificationEvent
@@ -1041,6 +1618,288 @@ func (c *NotificationEventClient) mutate(ctx context.Context, m *NotificationEve
 	}
 }
 
+// SelectorPayloadClient is a client for the SelectorPayload schema.
+type SelectorPayloadClient struct {
+	config
+}
+
+// NewSelectorPayloadClient returns a client for the SelectorPayload from the given config.
+func NewSelectorPayloadClient(c config) *SelectorPayloadClient {
+	return &SelectorPayloadClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `selectorpayload.Hooks(f(g(h())))`.
+func (c *SelectorPayloadClient) Use(hooks ...Hook) {
+	c.hooks.SelectorPayload = append(c.hooks.SelectorPayload, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `selectorpayload.Intercept(f(g(h())))`.
+func (c *SelectorPayloadClient) Intercept(interceptors ...Interceptor) {
+	c.inters.SelectorPayload = append(c.inters.SelectorPayload, interceptors...)
+}
+
+// Create returns a builder for creating a SelectorPayload entity.
+func (c *SelectorPayloadClient) Create() *SelectorPayloadCreate {
+	mutation := newSelectorPayloadMutation(c.config, OpCreate)
+	return &SelectorPayloadCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of SelectorPayload entities.
+func (c *SelectorPayloadClient) CreateBulk(builders ...*SelectorPayloadCreate) *SelectorPayloadCreateBulk {
+	return &SelectorPayloadCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *SelectorPayloadClient) MapCreateBulk(slice any, setFunc func(*SelectorPayloadCreate, int)) *SelectorPayloadCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &SelectorPayloadCreateBulk{err: fmt.Errorf("calling to SelectorPayloadClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*SelectorPayloadCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &SelectorPayloadCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for SelectorPayload.
+func (c *SelectorPayloadClient) Update() *SelectorPayloadUpdate {
+	mutation := newSelectorPayloadMutation(c.config, OpUpdate)
+	return &SelectorPayloadUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *SelectorPayloadClient) UpdateOne(_m *SelectorPayload) *SelectorPayloadUpdateOne {
+	mutation := newSelectorPayloadMutation(c.config, OpUpdateOne, withSelectorPayload(_m))
+	return &SelectorPayloadUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *SelectorPayloadClient) UpdateOneID(id int) *SelectorPayloadUpdateOne {
+	mutation := newSelectorPayloadMutation(c.config, OpUpdateOne, withSelectorPayloadID(id))
+	return &SelectorPayloadUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for SelectorPayload.
+func (c *SelectorPayloadClient) Delete() *SelectorPayloadDelete {
+	mutation := newSelectorPayloadMutation(c.config, OpDelete)
+	return &SelectorPayloadDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *SelectorPayloadClient) DeleteOne(_m *SelectorPayload) *SelectorPayloadDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *SelectorPayloadClient) DeleteOneID(id int) *SelectorPayloadDeleteOne {
+	builder := c.Delete().Where(selectorpayload.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &SelectorPayloadDeleteOne{builder}
+}
+
+// Query returns a query builder for SelectorPayload.
+func (c *SelectorPayloadClient) Query() *SelectorPayloadQuery {
+	return &SelectorPayloadQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeSelectorPayload},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a SelectorPayload entity by its id.
+func (c *SelectorPayloadClient) Get(ctx context.Context, id int) (*SelectorPayload, error) {
+	return c.Query().Where(selectorpayload.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *SelectorPayloadClient) GetX(ctx context.Context, id int) *SelectorPayload {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *SelectorPayloadClient) Hooks() []Hook {
+	return c.hooks.SelectorPayload
+}
+
+// Interceptors returns the client interceptors.
+func (c *SelectorPayloadClient) Interceptors() []Interceptor {
+	return c.inters.SelectorPayload
+}
+
+func (c *SelectorPayloadClient) mutate(ctx context.Context, m *SelectorPayloadMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&SelectorPayloadCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&SelectorPayloadUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&SelectorPayloadUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&SelectorPayloadDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown SelectorPayload mutation op: %q", m.Op())
+	}
+}
+
+// SessionClient is a client for the Session schema.
+type SessionClient struct {
+	config
+}
+
+// NewSessionClient returns a client for the Session from the given config.
+func NewSessionClient(c config) *SessionClient {
+	return &SessionClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `session.Hooks(f(g(h())))`.
+func (c *SessionClient) Use(hooks ...Hook) {
+	c.hooks.Session = append(c.hooks.Session, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `session.Intercept(f(g(h())))`.
+func (c *SessionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Session = append(c.inters.Session, interceptors...)
+}
+
+// Create returns a builder for creating a Session entity.
+func (c *SessionClient) Create() *SessionCreate {
+	mutation := newSessionMutation(c.config, OpCreate)
+	return &SessionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Session entities.
+func (c *SessionClient) CreateBulk(builders ...*SessionCreate) *SessionCreateBulk {
+	return &SessionCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *SessionClient) MapCreateBulk(slice any, setFunc func(*SessionCreate, int)) *SessionCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &SessionCreateBulk{err: fmt.Errorf("calling to SessionClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*SessionCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &SessionCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Session.
+func (c *SessionClient) Update() *SessionUpdate {
+	mutation := newSessionMutation(c.config, OpUpdate)
+	return &SessionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *SessionClient) UpdateOne(_m *Session) *SessionUpdateOne {
+	mutation := newSessionMutation(c.config, OpUpdateOne, withSession(_m))
+	return &SessionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *SessionClient) UpdateOneID(id int) *SessionUpdateOne {
+	mutation := newSessionMutation(c.config, OpUpdateOne, withSessionID(id))
+	return &SessionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Session.
+func (c *SessionClient) Delete() *SessionDelete {
+	mutation := newSessionMutation(c.config, OpDelete)
+	return &SessionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *SessionClient) DeleteOne(_m *Session) *SessionDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *SessionClient) DeleteOneID(id int) *SessionDeleteOne {
+	builder := c.Delete().Where(session.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &SessionDeleteOne{builder}
+}
+
+// Query returns a query builder for Session.
+func (c *SessionClient) Query() *SessionQuery {
+	return &SessionQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeSession},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Session entity by its id.
+func (c *SessionClient) Get(ctx context.Context, id int) (*Session, error) {
+	return c.Query().Where(session.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *SessionClient) GetX(ctx context.Context, id int) *Session {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryUser queries the user edge of a Session.
+func (c *SessionClient) QueryUser(_m *Session) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(session.Table, session.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, session.UserTable, session.UserColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *SessionClient) Hooks() []Hook {
+	return c.hooks.Session
+}
+
+// Interceptors returns the client interceptors.
+func (c *SessionClient) Interceptors() []Interceptor {
+	return c.inters.Session
+}
+
+func (c *SessionClient) mutate(ctx context.Context, m *SessionMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&SessionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&SessionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&SessionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&SessionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Session mutation op: %q", m.Op())
+	}
+}
+
 // SystemConfigClient is a client for the SystemConfig schema.
 type SystemConfigClient struct {
 	config
@@ -1174,14 +2033,165 @@ func (c *SystemConfigClient) mutate(ctx context.Context, m *SystemConfigMutation
 	}
 }
 
+// UserClient is a client for the User schema.
+type UserClient struct {
+	config
+}
+
+// NewUserClient returns a client for the User from the given config.
+func NewUserClient(c config) *UserClient {
+	return &UserClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `user.Hooks(f(g(h())))`.
+func (c *UserClient) Use(hooks ...Hook) {
+	c.hooks.User = append(c.hooks.User, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `user.Intercept(f(g(h())))`.
+func (c *UserClient) Intercept(interceptors ...Interceptor) {
+	c.inters.User = append(c.inters.User, interceptors...)
+}
+
+// Create returns a builder for creating a User entity.
+func (c *UserClient) Create() *UserCreate {
+	mutation := newUserMutation(c.config, OpCreate)
+	return &UserCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of User entities.
+func (c *UserClient) CreateBulk(builders ...*UserCreate) *UserCreateBulk {
+	return &UserCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *UserClient) MapCreateBulk(slice any, setFunc func(*UserCreate, int)) *UserCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &UserCreateBulk{err: fmt.Errorf("calling to UserClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*UserCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &UserCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for User.
+func (c *UserClient) Update() *UserUpdate {
+	mutation := newUserMutation(c.config, OpUpdate)
+	return &UserUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *UserClient) UpdateOne(_m *User) *UserUpdateOne {
+	mutation := newUserMutation(c.config, OpUpdateOne, withUser(_m))
+	return &UserUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *UserClient) UpdateOneID(id int) *UserUpdateOne {
+	mutation := newUserMutation(c.config, OpUpdateOne, withUserID(id))
+	return &UserUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for User.
+func (c *UserClient) Delete() *UserDelete {
+	mutation := newUserMutation(c.config, OpDelete)
+	return &UserDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *UserClient) DeleteOne(_m *User) *UserDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *UserClient) DeleteOneID(id int) *UserDeleteOne {
+	builder := c.Delete().Where(user.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &UserDeleteOne{builder}
+}
+
+// Query returns a query builder for User.
+func (c *UserClient) Query() *UserQuery {
+	return &UserQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeUser},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a User entity by its id.
+func (c *UserClient) Get(ctx context.Context, id int) (*User, error) {
+	return c.Query().Where(user.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *UserClient) GetX(ctx context.Context, id int) *User {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QuerySessions queries the sessions edge of a User.
+func (c *UserClient) QuerySessions(_m *User) *SessionQuery {
+	query := (&SessionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(session.Table, session.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.SessionsTable, user.SessionsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *UserClient) Hooks() []Hook {
+	return c.hooks.User
+}
+
+// Interceptors returns the client interceptors.
+func (c *UserClient) Interceptors() []Interceptor {
+	return c.inters.User
+}
+
+func (c *UserClient) mutate(ctx context.Context, m *UserMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&UserCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&UserUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&UserUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&UserDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown User mutation op: %q", m.Op())
+	}
+}
+
 // hooks and interceptors per client, for fast access.
 type (
 	hooks struct {
-		CheckResult, Monitor, MonitorRuntime, NotificationChannel, NotificationEvent,
-		SystemConfig []ent.Hook
+		CheckResult, CheckRollup, HeaderProfile, Monitor, MonitorRuntime,
+		MonitorVersion, NotificationChannel, NotificationEvent, SelectorPayload,
+		Session, SystemConfig, User []ent.Hook
 	}
 	inters struct {
-		CheckResult, Monitor, MonitorRuntime, NotificationChannel, NotificationEvent,
-		SystemConfig []ent.Interceptor
+		CheckResult, CheckRollup, HeaderProfile, Monitor, MonitorRuntime,
+		MonitorVersion, NotificationChannel, NotificationEvent, SelectorPayload,
+		Session, SystemConfig, User []ent.Interceptor
 	}
 )
@@ -7,11 +7,17 @@ import (
 	"errors"
 	"fmt"
 	"goanna/apps/api/ent/checkresult"
+	"goanna/apps/api/ent/checkrollup"
+	"goanna/apps/api/ent/headerprofile"
 	"goanna/apps/api/ent/monitor"
 	"goanna/apps/api/ent/monitorruntime"
+	"goanna/apps/api/ent/monitorversion"
 	"goanna/apps/api/ent/notificationchannel"
 	"goanna/apps/api/ent/notificationevent"
+	"goanna/apps/api/ent/selectorpayload"
+	"goanna/apps/api/ent/session"
 	"goanna/apps/api/ent/systemconfig"
+	"goanna/apps/api/ent/user"
 	"reflect"
 	"sync"
 
@@ -79,11 +85,17 @@ func checkColumn(t, c string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
 			checkresult.Table:         checkresult.ValidColumn,
+			checkrollup.Table:         checkrollup.ValidColumn,
+			headerprofile.Table:       headerprofile.ValidColumn,
 			monitor.Table:             monitor.ValidColumn,
 			monitorruntime.Table:      monitorruntime.ValidColumn,
+			monitorversion.Table:      monitorversion.ValidColumn,
 			notificationchannel.Table: notificationchannel.ValidColumn,
 			notificationevent.Table:   notificationevent.ValidColumn,
+			selectorpayload.Table:     selectorpayload.ValidColumn,
+			session.Table:             session.ValidColumn,
 			systemconfig.Table:        systemconfig.ValidColumn,
+			user.Table:                user.ValidColumn,
 		})
 	})
 	return columnCheck(t, c)
@@ -0,0 +1,287 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"goanna/apps/api/ent/headerprofile"
+	"goanna/apps/api/ent/monitor"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// HeaderProfileCreate is the builder for creating a HeaderProfile entity.
+type HeaderProfileCreate struct {
+	config
+	mutation *HeaderProfileMutation
+	hooks    []Hook
+}
+
+// SetName sets the "name" field.
+func (_c *HeaderProfileCreate) SetName(v string) *HeaderProfileCreate {
+	_c.mutation.SetName(v)
+	return _c
+}
+
+// SetHeaders sets the "headers" field.
+func (_c *HeaderProfileCreate) SetHeaders(v map[string]string) *HeaderProfileCreate {
+	_c.mutation.SetHeaders(v)
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *HeaderProfileCreate) SetCreatedAt(v time.Time) *HeaderProfileCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *HeaderProfileCreate) SetNillableCreatedAt(v *time.Time) *HeaderProfileCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *HeaderProfileCreate) SetUpdatedAt(v time.Time) *HeaderProfileCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *HeaderProfileCreate) SetNillableUpdatedAt(v *time.Time) *HeaderProfileCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// AddMonitorIDs adds the "monitors" edge to the Monitor entity by IDs.
+func (_c *HeaderProfileCreate) AddMonitorIDs(ids ...int) *HeaderProfileCreate {
+	_c.mutation.AddMonitorIDs(ids...)
+	return _c
+}
+
+// AddMonitors adds the "monitors" edges to the Monitor entity.
+func (_c *HeaderProfileCreate) AddMonitors(v ...*Monitor) *HeaderProfileCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddMonitorIDs(ids...)
+}
+
+// Mutation returns the HeaderProfileMutation object of the builder.
+func (_c *HeaderProfileCreate) Mutation() *HeaderProfileMutation {
+	return _c.mutation
+}
+
+// Save creates the HeaderProfile in the database.
+func (_c *HeaderProfileCreate) Save(ctx context.Context) (*HeaderProfile, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *HeaderProfileCreate) SaveX(ctx context.Context) *HeaderProfile {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *HeaderProfileCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *HeaderProfileCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *HeaderProfileCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := headerprofile.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := headerprofile.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *HeaderProfileCreate) check() error {
+	if _, ok := _c.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "HeaderProfile.name"`)}
+	}
+	if v, ok := _c.mutation.Name(); ok {
+		if err := headerprofile.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "HeaderProfile.name": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "HeaderProfile.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "HeaderProfile.updated_at"`)}
+	}
+	return nil
+}
+
+func (_c *HeaderProfileCreate) sqlSave(ctx context.Context) (*HeaderProfile, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *HeaderProfileCreate) createSpec() (*HeaderProfile, *sqlgraph.CreateSpec) {
+	var (
+		_node = &HeaderProfile{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(headerprofile.Table, sqlgraph.NewFieldSpec(headerprofile.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.Name(); ok {
+		_spec.SetField(headerprofile.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := _c.mutation.Headers(); ok {
+		_spec.SetField(headerprofile.FieldHeaders, field.TypeJSON, value)
+		_node.Headers = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(headerprofile.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(headerprofile.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if nodes := _c.mutation.MonitorsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   headerprofile.MonitorsTable,
+			Columns: []string{headerprofile.MonitorsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(monitor.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// HeaderProfileCreateBulk is the builder for creating many HeaderProfile entities in bulk.
+type HeaderProfileCreateBulk struct {
+	config
+	err      error
+	builders []*HeaderProfileCreate
+}
+
+// Save creates the HeaderProfile entities in the database.
+func (_c *HeaderProfileCreateBulk) Save(ctx context.Context) ([]*HeaderProfile, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*HeaderProfile, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*HeaderProfileMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *HeaderProfileCreateBulk) SaveX(ctx context.Context) []*HeaderProfile {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *HeaderProfileCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *HeaderProfileCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
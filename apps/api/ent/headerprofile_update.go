@@ -0,0 +1,471 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"goanna/apps/api/ent/headerprofile"
+	"goanna/apps/api/ent/monitor"
+	"goanna/apps/api/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// HeaderProfileUpdate is the builder for updating HeaderProfile entities.
+type HeaderProfileUpdate struct {
+	config
+	hooks    []Hook
+	mutation *HeaderProfileMutation
+}
+
+// Where appends a list predicates to the HeaderProfileUpdate builder.
+func (_u *HeaderProfileUpdate) Where(ps ...predicate.HeaderProfile) *HeaderProfileUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *HeaderProfileUpdate) SetName(v string) *HeaderProfileUpdate {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *HeaderProfileUpdate) SetNillableName(v *string) *HeaderProfileUpdate {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetHeaders sets the "headers" field.
+func (_u *HeaderProfileUpdate) SetHeaders(v map[string]string) *HeaderProfileUpdate {
+	_u.mutation.SetHeaders(v)
+	return _u
+}
+
+// ClearHeaders clears the value of the "headers" field.
+func (_u *HeaderProfileUpdate) ClearHeaders() *HeaderProfileUpdate {
+	_u.mutation.ClearHeaders()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *HeaderProfileUpdate) SetUpdatedAt(v time.Time) *HeaderProfileUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// AddMonitorIDs adds the "monitors" edge to the Monitor entity by IDs.
+func (_u *HeaderProfileUpdate) AddMonitorIDs(ids ...int) *HeaderProfileUpdate {
+	_u.mutation.AddMonitorIDs(ids...)
+	return _u
+}
+
+// AddMonitors adds the "monitors" edges to the Monitor entity.
+func (_u *HeaderProfileUpdate) AddMonitors(v ...*Monitor) *HeaderProfileUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddMonitorIDs(ids...)
+}
+
+// Mutation returns the HeaderProfileMutation object of the builder.
+func (_u *HeaderProfileUpdate) Mutation() *HeaderProfileMutation {
+	return _u.mutation
+}
+
+// ClearMonitors clears all "monitors" edges to the Monitor entity.
+func (_u *HeaderProfileUpdate) ClearMonitors() *HeaderProfileUpdate {
+	_u.mutation.ClearMonitors()
+	return _u
+}
+
+// RemoveMonitorIDs removes the "monitors" edge to Monitor entities by IDs.
+func (_u *HeaderProfileUpdate) RemoveMonitorIDs(ids ...int) *HeaderProfileUpdate {
+	_u.mutation.RemoveMonitorIDs(ids...)
+	return _u
+}
+
+// RemoveMonitors removes "monitors" edges to Monitor entities.
+func (_u *HeaderProfileUpdate) RemoveMonitors(v ...*Monitor) *HeaderProfileUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveMonitorIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *HeaderProfileUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *HeaderProfileUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *HeaderProfileUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *HeaderProfileUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *HeaderProfileUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := headerprofile.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *HeaderProfileUpdate) check() error {
+	if v, ok := _u.mutation.Name(); ok {
+		if err := headerprofile.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "HeaderProfile.name": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *HeaderProfileUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(headerprofile.Table, headerprofile.Columns, sqlgraph.NewFieldSpec(headerprofile.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(headerprofile.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Headers(); ok {
+		_spec.SetField(headerprofile.FieldHeaders, field.TypeJSON, value)
+	}
+	if _u.mutation.HeadersCleared() {
+		_spec.ClearField(headerprofile.FieldHeaders, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(headerprofile.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.MonitorsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   headerprofile.MonitorsTable,
+			Columns: []string{headerprofile.MonitorsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(monitor.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedMonitorsIDs(); len(nodes) > 0 && !_u.mutation.MonitorsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   headerprofile.MonitorsTable,
+			Columns: []string{headerprofile.MonitorsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(monitor.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.MonitorsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   headerprofile.MonitorsTable,
+			Columns: []string{headerprofile.MonitorsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(monitor.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{headerprofile.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// HeaderProfileUpdateOne is the builder for updating a single HeaderProfile entity.
+type HeaderProfileUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *HeaderProfileMutation
+}
+
+// SetName sets the "name" field.
+func (_u *HeaderProfileUpdateOne) SetName(v string) *HeaderProfileUpdateOne {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *HeaderProfileUpdateOne) SetNillableName(v *string) *HeaderProfileUpdateOne {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetHeaders sets the "headers" field.
+func (_u *HeaderProfileUpdateOne) SetHeaders(v map[string]string) *HeaderProfileUpdateOne {
+	_u.mutation.SetHeaders(v)
+	return _u
+}
+
+// ClearHeaders clears the value of the "headers" field.
+func (_u *HeaderProfileUpdateOne) ClearHeaders() *HeaderProfileUpdateOne {
+	_u.mutation.ClearHeaders()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *HeaderProfileUpdateOne) SetUpdatedAt(v time.Time) *HeaderProfileUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// AddMonitorIDs adds the "monitors" edge to the Monitor entity by IDs.
+func (_u *HeaderProfileUpdateOne) AddMonitorIDs(ids ...int) *HeaderProfileUpdateOne {
+	_u.mutation.AddMonitorIDs(ids...)
+	return _u
+}
+
+// AddMonitors adds the "monitors" edges to the Monitor entity.
+func (_u *HeaderProfileUpdateOne) AddMonitors(v ...*Monitor) *HeaderProfileUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddMonitorIDs(ids...)
+}
+
+// Mutation returns the HeaderProfileMutation object of the builder.
+func (_u *HeaderProfileUpdateOne) Mutation() *HeaderProfileMutation {
+	return _u.mutation
+}
+
+// ClearMonitors clears all "monitors" edges to the Monitor entity.
+func (_u *HeaderProfileUpdateOne) ClearMonitors() *HeaderProfileUpdateOne {
+	_u.mutation.ClearMonitors()
+	return _u
+}
+
+// RemoveMonitorIDs removes the "monitors" edge to Monitor entities by IDs.
+func (_u *HeaderProfileUpdateOne) RemoveMonitorIDs(ids ...int) *HeaderProfileUpdateOne {
+	_u.mutation.RemoveMonitorIDs(ids...)
+	return _u
+}
+
+// RemoveMonitors removes "monitors" edges to Monitor entities.
+func (_u *HeaderProfileUpdateOne) RemoveMonitors(v ...*Monitor) *HeaderProfileUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveMonitorIDs(ids...)
+}
+
+// Where appends a list predicates to the HeaderProfileUpdate builder.
+func (_u *HeaderProfileUpdateOne) Where(ps ...predicate.HeaderProfile) *HeaderProfileUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *HeaderProfileUpdateOne) Select(field string, fields ...string) *HeaderProfileUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated HeaderProfile entity.
+func (_u *HeaderProfileUpdateOne) Save(ctx context.Context) (*HeaderProfile, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *HeaderProfileUpdateOne) SaveX(ctx context.Context) *HeaderProfile {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *HeaderProfileUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *HeaderProfileUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *HeaderProfileUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := headerprofile.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *HeaderProfileUpdateOne) check() error {
+	if v, ok := _u.mutation.Name(); ok {
+		if err := headerprofile.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "HeaderProfile.name": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *HeaderProfileUpdateOne) sqlSave(ctx context.Context) (_node *HeaderProfile, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(headerprofile.Table, headerprofile.Columns, sqlgraph.NewFieldSpec(headerprofile.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "HeaderProfile.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, headerprofile.FieldID)
+		for _, f := range fields {
+			if !headerprofile.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != headerprofile.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(headerprofile.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Headers(); ok {
+		_spec.SetField(headerprofile.FieldHeaders, field.TypeJSON, value)
+	}
+	if _u.mutation.HeadersCleared() {
+		_spec.ClearField(headerprofile.FieldHeaders, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(headerprofile.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.MonitorsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   headerprofile.MonitorsTable,
+			Columns: []string{headerprofile.MonitorsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(monitor.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedMonitorsIDs(); len(nodes) > 0 && !_u.mutation.MonitorsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   headerprofile.MonitorsTable,
+			Columns: []string{headerprofile.MonitorsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(monitor.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.MonitorsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   headerprofile.MonitorsTable,
+			Columns: []string{headerprofile.MonitorsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(monitor.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &HeaderProfile{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{headerprofile.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
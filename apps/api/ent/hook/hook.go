@@ -20,6 +20,30 @@ func (f CheckResultFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value,
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.CheckResultMutation", m)
 }
 
+// The CheckRollupFunc type is an adapter to allow the use of ordinary
+// function as CheckRollup mutator.
+type CheckRollupFunc func(context.Context, *ent.CheckRollupMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f CheckRollupFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.CheckRollupMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.CheckRollupMutation", m)
+}
+
+// The HeaderProfileFunc type is an adapter to allow the use of ordinary
+// function as HeaderProfile mutator.
+type HeaderProfileFunc func(context.Context, *ent.HeaderProfileMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f HeaderProfileFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.HeaderProfileMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.HeaderProfileMutation", m)
+}
+
 // The MonitorFunc type is an adapter to allow the use of ordinary
 // function as Monitor mutator.
 type MonitorFunc func(context.Context, *ent.MonitorMutation) (ent.Value, error)
@@ -44,6 +68,18 @@ func (f MonitorRuntimeFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Val
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.MonitorRuntimeMutation", m)
 }
 
+// The MonitorVersionFunc type is an adapter to allow the use of ordinary
+// function as MonitorVersion mutator.
+type MonitorVersionFunc func(context.Context, *ent.MonitorVersionMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f MonitorVersionFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.MonitorVersionMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.MonitorVersionMutation", m)
+}
+
 // The NotificationChannelFunc type is an adapter to allow the use of ordinary
 // function as NotificationChannel mutator.
 type NotificationChannelFunc func(context.Context, *ent.NotificationChannelMutation) (ent.Value, error)
@@ -68,6 +104,30 @@ func (f NotificationEventFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.NotificationEventMutation", m)
 }
 
+// The SelectorPayloadFunc type is an adapter to allow the use of ordinary
+// function as SelectorPayload mutator.
+type SelectorPayloadFunc func(context.Context, *ent.SelectorPayloadMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f SelectorPayloadFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.SelectorPayloadMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.SelectorPayloadMutation", m)
+}
+
+// The SessionFunc type is an adapter to allow the use of ordinary
+// function as Session mutator.
+type SessionFunc func(context.Context, *ent.SessionMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f SessionFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.SessionMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.SessionMutation", m)
+}
+
 // The SystemConfigFunc type is an adapter to allow the use of ordinary
 // function as SystemConfig mutator.
 type SystemConfigFunc func(context.Context, *ent.SystemConfigMutation) (ent.Value, error)
@@ -80,6 +140,18 @@ func (f SystemConfigFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.SystemConfigMutation", m)
 }
 
+// The UserFunc type is an adapter to allow the use of ordinary
+// function as User mutator.
+type UserFunc func(context.Context, *ent.UserMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f UserFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.UserMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.UserMutation", m)
+}
+
 // Condition is a hook condition function.
 type Condition func(context.Context, ent.Mutation) bool
 
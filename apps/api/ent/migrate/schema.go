@@ -14,13 +14,23 @@ var (
 		{Name: "status", Type: field.TypeString, Default: "unknown"},
 		{Name: "status_code", Type: field.TypeInt, Nullable: true},
 		{Name: "response_time_ms", Type: field.TypeInt, Nullable: true},
-		{Name: "error_message", Type: field.TypeString, Nullable: true},
+		{Name: "error_message", Type: field.TypeString, Nullable: true, Size: 2147483647},
 		{Name: "selection_type", Type: field.TypeString, Nullable: true},
-		{Name: "selection_value", Type: field.TypeString, Nullable: true},
+		{Name: "selection_value", Type: field.TypeString, Nullable: true, Size: 2147483647},
 		{Name: "diff_changed", Type: field.TypeBool, Default: false},
 		{Name: "diff_kind", Type: field.TypeString, Nullable: true},
-		{Name: "diff_summary", Type: field.TypeString, Nullable: true},
-		{Name: "diff_details", Type: field.TypeString, Nullable: true},
+		{Name: "diff_summary", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "diff_details", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "body_snapshot", Type: field.TypeBytes, Nullable: true, SchemaType: map[string]string{"mysql": "longblob"}},
+		{Name: "body_snapshot_encoding", Type: field.TypeString, Nullable: true},
+		{Name: "body_size", Type: field.TypeInt, Nullable: true},
+		{Name: "body_snapshot_truncated", Type: field.TypeBool, Default: false},
+		{Name: "body_hash", Type: field.TypeString, Nullable: true},
+		{Name: "tracked_header_value", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "redirect_chain", Type: field.TypeJSON, Nullable: true},
+		{Name: "body_read_ms", Type: field.TypeFloat64, Nullable: true},
+		{Name: "selector_ms", Type: field.TypeFloat64, Nullable: true},
+		{Name: "diff_ms", Type: field.TypeFloat64, Nullable: true},
 		{Name: "checked_at", Type: field.TypeTime},
 		{Name: "monitor_check_results", Type: field.TypeInt},
 	}
@@ -32,36 +42,138 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "check_results_monitors_check_results",
-				Columns:    []*schema.Column{CheckResultsColumns[12]},
+				Columns:    []*schema.Column{CheckResultsColumns[22]},
 				RefColumns: []*schema.Column{MonitorsColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
 		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "checkresult_monitor_check_results_checked_at",
+				Unique:  false,
+				Columns: []*schema.Column{CheckResultsColumns[22], CheckResultsColumns[21]},
+			},
+		},
+	}
+	// CheckRollupsColumns holds the columns for the "check_rollups" table.
+	CheckRollupsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "period", Type: field.TypeEnum, Enums: []string{"hour", "day"}},
+		{Name: "bucket_start", Type: field.TypeTime},
+		{Name: "checks", Type: field.TypeInt, Default: 0},
+		{Name: "successes", Type: field.TypeInt, Default: 0},
+		{Name: "errors", Type: field.TypeInt, Default: 0},
+		{Name: "changes", Type: field.TypeInt, Default: 0},
+		{Name: "latency_samples", Type: field.TypeInt, Default: 0},
+		{Name: "latency_total_ms", Type: field.TypeInt64, Default: 0},
+		{Name: "latency_max_ms", Type: field.TypeInt, Nullable: true},
+		{Name: "latency_histogram", Type: field.TypeJSON, Nullable: true},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "monitor_id", Type: field.TypeInt},
+	}
+	// CheckRollupsTable holds the schema information for the "check_rollups" table.
+	CheckRollupsTable = &schema.Table{
+		Name:       "check_rollups",
+		Columns:    CheckRollupsColumns,
+		PrimaryKey: []*schema.Column{CheckRollupsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "check_rollups_monitors_rollups",
+				Columns:    []*schema.Column{CheckRollupsColumns[12]},
+				RefColumns: []*schema.Column{MonitorsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "checkrollup_monitor_id_period_bucket_start",
+				Unique:  true,
+				Columns: []*schema.Column{CheckRollupsColumns[12], CheckRollupsColumns[1], CheckRollupsColumns[2]},
+			},
+		},
+	}
+	// HeaderProfilesColumns holds the columns for the "header_profiles" table.
+	HeaderProfilesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "name", Type: field.TypeString, Unique: true},
+		{Name: "headers", Type: field.TypeJSON, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// HeaderProfilesTable holds the schema information for the "header_profiles" table.
+	HeaderProfilesTable = &schema.Table{
+		Name:       "header_profiles",
+		Columns:    HeaderProfilesColumns,
+		PrimaryKey: []*schema.Column{HeaderProfilesColumns[0]},
 	}
 	// MonitorsColumns holds the columns for the "monitors" table.
 	MonitorsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
 		{Name: "label", Type: field.TypeString, Nullable: true},
 		{Name: "method", Type: field.TypeString, Default: "GET"},
-		{Name: "url", Type: field.TypeString},
-		{Name: "icon_url", Type: field.TypeString, Nullable: true},
-		{Name: "body", Type: field.TypeString, Nullable: true},
+		{Name: "url", Type: field.TypeString, Size: 2147483647},
+		{Name: "icon_url", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "body", Type: field.TypeString, Nullable: true, Size: 2147483647},
 		{Name: "headers", Type: field.TypeJSON, Nullable: true},
+		{Name: "user_agent", Type: field.TypeString, Nullable: true},
 		{Name: "auth", Type: field.TypeJSON, Nullable: true},
 		{Name: "notification_channels", Type: field.TypeJSON, Nullable: true},
-		{Name: "selector", Type: field.TypeString, Nullable: true},
-		{Name: "expected_type", Type: field.TypeEnum, Enums: []string{"json", "html", "text"}, Default: "json"},
-		{Name: "expected_response", Type: field.TypeString, Nullable: true},
+		{Name: "escalation_channels", Type: field.TypeJSON, Nullable: true},
+		{Name: "tags", Type: field.TypeJSON, Nullable: true},
+		{Name: "escalation_after_minutes", Type: field.TypeInt, Nullable: true},
+		{Name: "selector", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "expected_status", Type: field.TypeString, Nullable: true},
+		{Name: "retry_on", Type: field.TypeString, Nullable: true},
+		{Name: "expected_type", Type: field.TypeEnum, Enums: []string{"json", "html", "text", "feed", "sitemap"}, Default: "json"},
+		{Name: "expected_response", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "must_contain", Type: field.TypeJSON, Nullable: true},
+		{Name: "must_not_contain", Type: field.TypeJSON, Nullable: true},
+		{Name: "treat_not_found_as_success", Type: field.TypeBool, Default: false},
+		{Name: "accept_empty_body", Type: field.TypeBool, Default: false},
+		{Name: "watchdog_minutes", Type: field.TypeInt, Nullable: true},
+		{Name: "header_assertions", Type: field.TypeJSON, Nullable: true},
+		{Name: "track_header", Type: field.TypeString, Nullable: true},
+		{Name: "numeric_tolerance", Type: field.TypeFloat64, Nullable: true},
+		{Name: "redirect_policy", Type: field.TypeEnum, Enums: []string{"follow", "none", "record"}, Default: "follow"},
+		{Name: "max_redirects", Type: field.TypeInt, Nullable: true},
+		{Name: "max_response_bytes", Type: field.TypeInt, Nullable: true},
+		{Name: "checks_history_limit", Type: field.TypeInt, Nullable: true},
+		{Name: "cookie_jar", Type: field.TypeBool, Default: false},
+		{Name: "host_overrides", Type: field.TypeJSON, Nullable: true},
+		{Name: "ip_family", Type: field.TypeEnum, Enums: []string{"any", "ipv4", "ipv6"}, Default: "any"},
+		{Name: "tls_ca_pem", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "tls_insecure_skip_verify", Type: field.TypeBool, Default: false},
+		{Name: "tls_min_version", Type: field.TypeString, Nullable: true},
+		{Name: "tls_server_name", Type: field.TypeString, Nullable: true},
 		{Name: "cron", Type: field.TypeString},
+		{Name: "timezone", Type: field.TypeString, Nullable: true},
+		{Name: "jitter_seconds", Type: field.TypeInt, Nullable: true},
+		{Name: "dst_policy", Type: field.TypeEnum, Enums: []string{"skip", "next_valid", "run_twice"}, Default: "next_valid"},
+		{Name: "body_snapshot", Type: field.TypeEnum, Enums: []string{"off", "raw", "gzip"}, Default: "off"},
+		{Name: "content_hash", Type: field.TypeEnum, Enums: []string{"off", "raw", "normalized"}, Default: "off"},
+		{Name: "fetch_mode", Type: field.TypeEnum, Enums: []string{"http", "rendered", "heartbeat"}, Default: "http"},
+		{Name: "heartbeat_token", Type: field.TypeString, Unique: true, Nullable: true},
 		{Name: "enabled", Type: field.TypeBool, Default: true},
+		{Name: "status_page", Type: field.TypeBool, Default: false},
+		{Name: "sort_index", Type: field.TypeInt, Default: 0},
+		{Name: "archived_at", Type: field.TypeTime, Nullable: true},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "header_profile_id", Type: field.TypeInt, Nullable: true},
 	}
 	// MonitorsTable holds the schema information for the "monitors" table.
 	MonitorsTable = &schema.Table{
 		Name:       "monitors",
 		Columns:    MonitorsColumns,
 		PrimaryKey: []*schema.Column{MonitorsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "monitors_header_profiles_monitors",
+				Columns:    []*schema.Column{MonitorsColumns[51]},
+				RefColumns: []*schema.Column{HeaderProfilesColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
 	}
 	// MonitorRuntimesColumns holds the columns for the "monitor_runtimes" table.
 	MonitorRuntimesColumns = []*schema.Column{
@@ -78,8 +190,21 @@ var (
 		{Name: "last_error_at", Type: field.TypeTime, Nullable: true},
 		{Name: "last_status_code", Type: field.TypeInt, Nullable: true},
 		{Name: "last_duration_ms", Type: field.TypeInt, Nullable: true},
-		{Name: "last_error_message", Type: field.TypeString, Nullable: true},
+		{Name: "last_error_message", Type: field.TypeString, Nullable: true, Size: 2147483647},
 		{Name: "next_run_at", Type: field.TypeTime, Nullable: true},
+		{Name: "failing_since", Type: field.TypeTime, Nullable: true},
+		{Name: "escalated_at", Type: field.TypeTime, Nullable: true},
+		{Name: "acknowledged_at", Type: field.TypeTime, Nullable: true},
+		{Name: "daily_change_counts", Type: field.TypeJSON, Nullable: true},
+		{Name: "last_change_at", Type: field.TypeTime, Nullable: true},
+		{Name: "error_repeating_since", Type: field.TypeTime, Nullable: true},
+		{Name: "expect_change_until", Type: field.TypeTime, Nullable: true},
+		{Name: "watchdog_alerted_at", Type: field.TypeTime, Nullable: true},
+		{Name: "last_ping_at", Type: field.TypeTime, Nullable: true},
+		{Name: "circuit_opened_at", Type: field.TypeTime, Nullable: true},
+		{Name: "cookies", Type: field.TypeJSON, Nullable: true},
+		{Name: "claimed_by", Type: field.TypeString, Nullable: true},
+		{Name: "claimed_until", Type: field.TypeTime, Nullable: true},
 		{Name: "updated_at", Type: field.TypeTime},
 		{Name: "monitor_runtime", Type: field.TypeInt, Unique: true},
 	}
@@ -91,12 +216,42 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "monitor_runtimes_monitors_runtime",
-				Columns:    []*schema.Column{MonitorRuntimesColumns[16]},
+				Columns:    []*schema.Column{MonitorRuntimesColumns[29]},
 				RefColumns: []*schema.Column{MonitorsColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
 		},
 	}
+	// MonitorVersionsColumns holds the columns for the "monitor_versions" table.
+	MonitorVersionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "version", Type: field.TypeInt},
+		{Name: "config", Type: field.TypeJSON},
+		{Name: "created_by", Type: field.TypeString, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "monitor_id", Type: field.TypeInt},
+	}
+	// MonitorVersionsTable holds the schema information for the "monitor_versions" table.
+	MonitorVersionsTable = &schema.Table{
+		Name:       "monitor_versions",
+		Columns:    MonitorVersionsColumns,
+		PrimaryKey: []*schema.Column{MonitorVersionsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "monitor_versions_monitors_versions",
+				Columns:    []*schema.Column{MonitorVersionsColumns[5]},
+				RefColumns: []*schema.Column{MonitorsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "monitorversion_monitor_id_version",
+				Unique:  true,
+				Columns: []*schema.Column{MonitorVersionsColumns[5], MonitorVersionsColumns[1]},
+			},
+		},
+	}
 	// NotificationChannelsColumns holds the columns for the "notification_channels" table.
 	NotificationChannelsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -125,10 +280,14 @@ var (
 	NotificationEventsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
 		{Name: "status", Type: field.TypeString, Default: "pending"},
-		{Name: "message", Type: field.TypeString, Nullable: true},
+		{Name: "kind", Type: field.TypeEnum, Enums: []string{"diff", "failure", "escalation", "stale", "watchdog", "circuit_breaker"}, Default: "diff"},
+		{Name: "escalation_level", Type: field.TypeInt, Default: 0},
+		{Name: "message", Type: field.TypeString, Nullable: true, Size: 2147483647},
 		{Name: "sent_at", Type: field.TypeTime},
+		{Name: "run_id", Type: field.TypeString, Nullable: true},
 		{Name: "monitor_notification_events", Type: field.TypeInt},
 		{Name: "notification_channel_notification_events", Type: field.TypeInt},
+		{Name: "notification_event_escalations", Type: field.TypeInt, Nullable: true},
 	}
 	// NotificationEventsTable holds the schema information for the "notification_events" table.
 	NotificationEventsTable = &schema.Table{
@@ -138,16 +297,65 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "notification_events_monitors_notification_events",
-				Columns:    []*schema.Column{NotificationEventsColumns[4]},
+				Columns:    []*schema.Column{NotificationEventsColumns[7]},
 				RefColumns: []*schema.Column{MonitorsColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
 			{
 				Symbol:     "notification_events_notification_channels_notification_events",
-				Columns:    []*schema.Column{NotificationEventsColumns[5]},
+				Columns:    []*schema.Column{NotificationEventsColumns[8]},
 				RefColumns: []*schema.Column{NotificationChannelsColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
+			{
+				Symbol:     "notification_events_notification_events_escalations",
+				Columns:    []*schema.Column{NotificationEventsColumns[9]},
+				RefColumns: []*schema.Column{NotificationEventsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+	}
+	// SelectorPayloadsColumns holds the columns for the "selector_payloads" table.
+	SelectorPayloadsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "token", Type: field.TypeString, Unique: true},
+		{Name: "payload", Type: field.TypeBytes, SchemaType: map[string]string{"mysql": "longblob"}},
+		{Name: "expires_at", Type: field.TypeTime},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// SelectorPayloadsTable holds the schema information for the "selector_payloads" table.
+	SelectorPayloadsTable = &schema.Table{
+		Name:       "selector_payloads",
+		Columns:    SelectorPayloadsColumns,
+		PrimaryKey: []*schema.Column{SelectorPayloadsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "selectorpayload_expires_at",
+				Unique:  false,
+				Columns: []*schema.Column{SelectorPayloadsColumns[3]},
+			},
+		},
+	}
+	// SessionsColumns holds the columns for the "sessions" table.
+	SessionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "token_hash", Type: field.TypeString, Unique: true},
+		{Name: "expires_at", Type: field.TypeTime},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "user_id", Type: field.TypeInt},
+	}
+	// SessionsTable holds the schema information for the "sessions" table.
+	SessionsTable = &schema.Table{
+		Name:       "sessions",
+		Columns:    SessionsColumns,
+		PrimaryKey: []*schema.Column{SessionsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "sessions_users_sessions",
+				Columns:    []*schema.Column{SessionsColumns[4]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
 		},
 	}
 	// SystemConfigsColumns holds the columns for the "system_configs" table.
@@ -156,6 +364,28 @@ var (
 		{Name: "key", Type: field.TypeString, Default: "global"},
 		{Name: "checks_history_limit", Type: field.TypeInt, Default: 200},
 		{Name: "timezone", Type: field.TypeString, Nullable: true},
+		{Name: "paused", Type: field.TypeBool, Default: false},
+		{Name: "notifications_paused", Type: field.TypeBool, Default: false},
+		{Name: "paused_at", Type: field.TypeTime, Nullable: true},
+		{Name: "stale_after_days", Type: field.TypeInt, Default: 14},
+		{Name: "stale_notifications", Type: field.TypeBool, Default: false},
+		{Name: "stale_notified_at", Type: field.TypeTime, Nullable: true},
+		{Name: "schedule_jitter_seconds", Type: field.TypeInt, Default: 0},
+		{Name: "circuit_breaker_threshold", Type: field.TypeInt, Default: 0},
+		{Name: "circuit_breaker_action", Type: field.TypeEnum, Enums: []string{"backoff", "suspend"}, Default: "backoff"},
+		{Name: "circuit_breaker_backoff_minutes", Type: field.TypeInt, Default: 60},
+		{Name: "catch_up_policy", Type: field.TypeEnum, Enums: []string{"run_all_missed", "run_latest_only", "skip"}, Default: "run_latest_only"},
+		{Name: "catch_up_max_age_minutes", Type: field.TypeInt, Default: 0},
+		{Name: "default_user_agent", Type: field.TypeString, Nullable: true},
+		{Name: "default_headers", Type: field.TypeJSON, Nullable: true},
+		{Name: "proxy_url", Type: field.TypeString, Nullable: true},
+		{Name: "proxy_bypass", Type: field.TypeString, Nullable: true},
+		{Name: "request_timeout_seconds", Type: field.TypeInt, Default: 15},
+		{Name: "max_retries", Type: field.TypeInt, Default: 2},
+		{Name: "retry_backoff_seconds", Type: field.TypeInt, Default: 1},
+		{Name: "tick_interval_seconds", Type: field.TypeInt, Default: 60},
+		{Name: "schedule_lookahead_seconds", Type: field.TypeInt, Default: 0},
+		{Name: "max_response_body_bytes", Type: field.TypeInt, Nullable: true},
 		{Name: "updated_at", Type: field.TypeTime},
 	}
 	// SystemConfigsTable holds the schema information for the "system_configs" table.
@@ -171,20 +401,46 @@ var (
 			},
 		},
 	}
+	// UsersColumns holds the columns for the "users" table.
+	UsersColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "username", Type: field.TypeString, Unique: true},
+		{Name: "password_hash", Type: field.TypeString},
+		{Name: "role", Type: field.TypeEnum, Enums: []string{"admin", "viewer"}, Default: "viewer"},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// UsersTable holds the schema information for the "users" table.
+	UsersTable = &schema.Table{
+		Name:       "users",
+		Columns:    UsersColumns,
+		PrimaryKey: []*schema.Column{UsersColumns[0]},
+	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
 		CheckResultsTable,
+		CheckRollupsTable,
+		HeaderProfilesTable,
 		MonitorsTable,
 		MonitorRuntimesTable,
+		MonitorVersionsTable,
 		NotificationChannelsTable,
 		NotificationEventsTable,
+		SelectorPayloadsTable,
+		SessionsTable,
 		SystemConfigsTable,
+		UsersTable,
 	}
 )
 
 func init() {
 	CheckResultsTable.ForeignKeys[0].RefTable = MonitorsTable
+	CheckRollupsTable.ForeignKeys[0].RefTable = MonitorsTable
+	MonitorsTable.ForeignKeys[0].RefTable = HeaderProfilesTable
 	MonitorRuntimesTable.ForeignKeys[0].RefTable = MonitorsTable
+	MonitorVersionsTable.ForeignKeys[0].RefTable = MonitorsTable
 	NotificationEventsTable.ForeignKeys[0].RefTable = MonitorsTable
 	NotificationEventsTable.ForeignKeys[1].RefTable = NotificationChannelsTable
+	NotificationEventsTable.ForeignKeys[2].RefTable = NotificationEventsTable
+	SessionsTable.ForeignKeys[0].RefTable = UsersTable
 }
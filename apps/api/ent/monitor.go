@@ -5,6 +5,7 @@ package ent
 import (
 	"encoding/json"
 	"fmt"
+	"goanna/apps/api/ent/headerprofile"
 	"goanna/apps/api/ent/monitor"
 	"goanna/apps/api/ent/monitorruntime"
 	"strings"
@@ -31,20 +32,92 @@ type Monitor struct {
 	Body *string `json:"body,omitempty"`
 	// Headers holds the value of the "headers" field.
 	Headers map[string]string `json:"headers,omitempty"`
+	// UserAgent holds the value of the "user_agent" field.
+	UserAgent *string `json:"user_agent,omitempty"`
+	// HeaderProfileID holds the value of the "header_profile_id" field.
+	HeaderProfileID *int `json:"header_profile_id,omitempty"`
 	// Auth holds the value of the "auth" field.
 	Auth map[string]string `json:"auth,omitempty"`
 	// NotificationChannels holds the value of the "notification_channels" field.
 	NotificationChannels []string `json:"notification_channels,omitempty"`
+	// EscalationChannels holds the value of the "escalation_channels" field.
+	EscalationChannels []string `json:"escalation_channels,omitempty"`
+	// Tags holds the value of the "tags" field.
+	Tags []string `json:"tags,omitempty"`
+	// EscalationAfterMinutes holds the value of the "escalation_after_minutes" field.
+	EscalationAfterMinutes *int `json:"escalation_after_minutes,omitempty"`
 	// Selector holds the value of the "selector" field.
 	Selector *string `json:"selector,omitempty"`
+	// ExpectedStatus holds the value of the "expected_status" field.
+	ExpectedStatus *string `json:"expected_status,omitempty"`
+	// RetryOn holds the value of the "retry_on" field.
+	RetryOn *string `json:"retry_on,omitempty"`
 	// ExpectedType holds the value of the "expected_type" field.
 	ExpectedType monitor.ExpectedType `json:"expected_type,omitempty"`
 	// ExpectedResponse holds the value of the "expected_response" field.
 	ExpectedResponse *string `json:"expected_response,omitempty"`
+	// MustContain holds the value of the "must_contain" field.
+	MustContain []string `json:"must_contain,omitempty"`
+	// MustNotContain holds the value of the "must_not_contain" field.
+	MustNotContain []string `json:"must_not_contain,omitempty"`
+	// TreatNotFoundAsSuccess holds the value of the "treat_not_found_as_success" field.
+	TreatNotFoundAsSuccess bool `json:"treat_not_found_as_success,omitempty"`
+	// AcceptEmptyBody holds the value of the "accept_empty_body" field.
+	AcceptEmptyBody bool `json:"accept_empty_body,omitempty"`
+	// WatchdogMinutes holds the value of the "watchdog_minutes" field.
+	WatchdogMinutes *int `json:"watchdog_minutes,omitempty"`
+	// HeaderAssertions holds the value of the "header_assertions" field.
+	HeaderAssertions map[string]string `json:"header_assertions,omitempty"`
+	// TrackHeader holds the value of the "track_header" field.
+	TrackHeader *string `json:"track_header,omitempty"`
+	// NumericTolerance holds the value of the "numeric_tolerance" field.
+	NumericTolerance *float64 `json:"numeric_tolerance,omitempty"`
+	// RedirectPolicy holds the value of the "redirect_policy" field.
+	RedirectPolicy monitor.RedirectPolicy `json:"redirect_policy,omitempty"`
+	// MaxRedirects holds the value of the "max_redirects" field.
+	MaxRedirects *int `json:"max_redirects,omitempty"`
+	// MaxResponseBytes holds the value of the "max_response_bytes" field.
+	MaxResponseBytes *int `json:"max_response_bytes,omitempty"`
+	// ChecksHistoryLimit holds the value of the "checks_history_limit" field.
+	ChecksHistoryLimit *int `json:"checks_history_limit,omitempty"`
+	// CookieJar holds the value of the "cookie_jar" field.
+	CookieJar bool `json:"cookie_jar,omitempty"`
+	// HostOverrides holds the value of the "host_overrides" field.
+	HostOverrides map[string]string `json:"host_overrides,omitempty"`
+	// IPFamily holds the value of the "ip_family" field.
+	IPFamily monitor.IPFamily `json:"ip_family,omitempty"`
+	// TLSCaPem holds the value of the "tls_ca_pem" field.
+	TLSCaPem *string `json:"tls_ca_pem,omitempty"`
+	// TLSInsecureSkipVerify holds the value of the "tls_insecure_skip_verify" field.
+	TLSInsecureSkipVerify bool `json:"tls_insecure_skip_verify,omitempty"`
+	// TLSMinVersion holds the value of the "tls_min_version" field.
+	TLSMinVersion *string `json:"tls_min_version,omitempty"`
+	// TLSServerName holds the value of the "tls_server_name" field.
+	TLSServerName *string `json:"tls_server_name,omitempty"`
 	// Cron holds the value of the "cron" field.
 	Cron string `json:"cron,omitempty"`
+	// Timezone holds the value of the "timezone" field.
+	Timezone *string `json:"timezone,omitempty"`
+	// JitterSeconds holds the value of the "jitter_seconds" field.
+	JitterSeconds *int `json:"jitter_seconds,omitempty"`
+	// DstPolicy holds the value of the "dst_policy" field.
+	DstPolicy monitor.DstPolicy `json:"dst_policy,omitempty"`
+	// BodySnapshot holds the value of the "body_snapshot" field.
+	BodySnapshot monitor.BodySnapshot `json:"body_snapshot,omitempty"`
+	// ContentHash holds the value of the "content_hash" field.
+	ContentHash monitor.ContentHash `json:"content_hash,omitempty"`
+	// FetchMode holds the value of the "fetch_mode" field.
+	FetchMode monitor.FetchMode `json:"fetch_mode,omitempty"`
+	// HeartbeatToken holds the value of the "heartbeat_token" field.
+	HeartbeatToken *string `json:"heartbeat_token,omitempty"`
 	// Enabled holds the value of the "enabled" field.
 	Enabled bool `json:"enabled,omitempty"`
+	// StatusPage holds the value of the "status_page" field.
+	StatusPage bool `json:"status_page,omitempty"`
+	// SortIndex holds the value of the "sort_index" field.
+	SortIndex int `json:"sort_index,omitempty"`
+	// ArchivedAt holds the value of the "archived_at" field.
+	ArchivedAt *time.Time `json:"archived_at,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	// UpdatedAt holds the value of the "updated_at" field.
@@ -63,9 +136,15 @@ type MonitorEdges struct {
 	NotificationEvents []*NotificationEvent `json:"notification_events,omitempty"`
 	// Runtime holds the value of the runtime edge.
 	Runtime *MonitorRuntime `json:"runtime,omitempty"`
+	// Versions holds the value of the versions edge.
+	Versions []*MonitorVersion `json:"versions,omitempty"`
+	// Rollups holds the value of the rollups edge.
+	Rollups []*CheckRollup `json:"rollups,omitempty"`
+	// HeaderProfile holds the value of the header_profile edge.
+	HeaderProfile *HeaderProfile `json:"header_profile,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [3]bool
+	loadedTypes [6]bool
 }
 
 // CheckResultsOrErr returns the CheckResults value or an error if the edge
@@ -97,20 +176,51 @@ func (e MonitorEdges) RuntimeOrErr() (*MonitorRuntime, error) {
 	return nil, &NotLoadedError{edge: "runtime"}
 }
 
+// VersionsOrErr returns the Versions value or an error if the edge
+// was not loaded in eager-loading.
+func (e MonitorEdges) VersionsOrErr() ([]*MonitorVersion, error) {
+	if e.loadedTypes[3] {
+		return e.Versions, nil
+	}
+	return nil, &NotLoadedError{edge: "versions"}
+}
+
+// RollupsOrErr returns the Rollups value or an error if the edge
+// was not loaded in eager-loading.
+func (e MonitorEdges) RollupsOrErr() ([]*CheckRollup, error) {
+	if e.loadedTypes[4] {
+		return e.Rollups, nil
+	}
+	return nil, &NotLoadedError{edge: "rollups"}
+}
+
+// HeaderProfileOrErr returns the HeaderProfile value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e MonitorEdges) HeaderProfileOrErr() (*HeaderProfile, error) {
+	if e.HeaderProfile != nil {
+		return e.HeaderProfile, nil
+	} else if e.loadedTypes[5] {
+		return nil, &NotFoundError{label: headerprofile.Label}
+	}
+	return nil, &NotLoadedError{edge: "header_profile"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Monitor) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case monitor.FieldHeaders, monitor.FieldAuth, monitor.FieldNotificationChannels:
+		case monitor.FieldHeaders, monitor.FieldAuth, monitor.FieldNotificationChannels, monitor.FieldEscalationChannels, monitor.FieldTags, monitor.FieldMustContain, monitor.FieldMustNotContain, monitor.FieldHeaderAssertions, monitor.FieldHostOverrides:
 			values[i] = new([]byte)
-		case monitor.FieldEnabled:
+		case monitor.FieldTreatNotFoundAsSuccess, monitor.FieldAcceptEmptyBody, monitor.FieldCookieJar, monitor.FieldTLSInsecureSkipVerify, monitor.FieldEnabled, monitor.FieldStatusPage:
 			values[i] = new(sql.NullBool)
-		case monitor.FieldID:
+		case monitor.FieldNumericTolerance:
+			values[i] = new(sql.NullFloat64)
+		case monitor.FieldID, monitor.FieldHeaderProfileID, monitor.FieldEscalationAfterMinutes, monitor.FieldWatchdogMinutes, monitor.FieldMaxRedirects, monitor.FieldMaxResponseBytes, monitor.FieldChecksHistoryLimit, monitor.FieldJitterSeconds, monitor.FieldSortIndex:
 			values[i] = new(sql.NullInt64)
-		case monitor.FieldLabel, monitor.FieldMethod, monitor.FieldURL, monitor.FieldIconURL, monitor.FieldBody, monitor.FieldSelector, monitor.FieldExpectedType, monitor.FieldExpectedResponse, monitor.FieldCron:
+		case monitor.FieldLabel, monitor.FieldMethod, monitor.FieldURL, monitor.FieldIconURL, monitor.FieldBody, monitor.FieldUserAgent, monitor.FieldSelector, monitor.FieldExpectedStatus, monitor.FieldRetryOn, monitor.FieldExpectedType, monitor.FieldExpectedResponse, monitor.FieldTrackHeader, monitor.FieldRedirectPolicy, monitor.FieldIPFamily, monitor.FieldTLSCaPem, monitor.FieldTLSMinVersion, monitor.FieldTLSServerName, monitor.FieldCron, monitor.FieldTimezone, monitor.FieldDstPolicy, monitor.FieldBodySnapshot, monitor.FieldContentHash, monitor.FieldFetchMode, monitor.FieldHeartbeatToken:
 			values[i] = new(sql.NullString)
-		case monitor.FieldCreatedAt, monitor.FieldUpdatedAt:
+		case monitor.FieldArchivedAt, monitor.FieldCreatedAt, monitor.FieldUpdatedAt:
 			values[i] = new(sql.NullTime)
 		default:
 			values[i] = new(sql.UnknownType)
@@ -174,6 +284,20 @@ func (_m *Monitor) assignValues(columns []string, values []any) error {
 					return fmt.Errorf("unmarshal field headers: %w", err)
 				}
 			}
+		case monitor.FieldUserAgent:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field user_agent", values[i])
+			} else if value.Valid {
+				_m.UserAgent = new(string)
+				*_m.UserAgent = value.String
+			}
+		case monitor.FieldHeaderProfileID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field header_profile_id", values[i])
+			} else if value.Valid {
+				_m.HeaderProfileID = new(int)
+				*_m.HeaderProfileID = int(value.Int64)
+			}
 		case monitor.FieldAuth:
 			if value, ok := values[i].(*[]byte); !ok {
 				return fmt.Errorf("unexpected type %T for field auth", values[i])
@@ -190,6 +314,29 @@ func (_m *Monitor) assignValues(columns []string, values []any) error {
 					return fmt.Errorf("unmarshal field notification_channels: %w", err)
 				}
 			}
+		case monitor.FieldEscalationChannels:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field escalation_channels", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.EscalationChannels); err != nil {
+					return fmt.Errorf("unmarshal field escalation_channels: %w", err)
+				}
+			}
+		case monitor.FieldTags:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field tags", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Tags); err != nil {
+					return fmt.Errorf("unmarshal field tags: %w", err)
+				}
+			}
+		case monitor.FieldEscalationAfterMinutes:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field escalation_after_minutes", values[i])
+			} else if value.Valid {
+				_m.EscalationAfterMinutes = new(int)
+				*_m.EscalationAfterMinutes = int(value.Int64)
+			}
 		case monitor.FieldSelector:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field selector", values[i])
@@ -197,6 +344,20 @@ func (_m *Monitor) assignValues(columns []string, values []any) error {
 				_m.Selector = new(string)
 				*_m.Selector = value.String
 			}
+		case monitor.FieldExpectedStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field expected_status", values[i])
+			} else if value.Valid {
+				_m.ExpectedStatus = new(string)
+				*_m.ExpectedStatus = value.String
+			}
+		case monitor.FieldRetryOn:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field retry_on", values[i])
+			} else if value.Valid {
+				_m.RetryOn = new(string)
+				*_m.RetryOn = value.String
+			}
 		case monitor.FieldExpectedType:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field expected_type", values[i])
@@ -210,18 +371,213 @@ func (_m *Monitor) assignValues(columns []string, values []any) error {
 				_m.ExpectedResponse = new(string)
 				*_m.ExpectedResponse = value.String
 			}
+		case monitor.FieldMustContain:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field must_contain", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.MustContain); err != nil {
+					return fmt.Errorf("unmarshal field must_contain: %w", err)
+				}
+			}
+		case monitor.FieldMustNotContain:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field must_not_contain", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.MustNotContain); err != nil {
+					return fmt.Errorf("unmarshal field must_not_contain: %w", err)
+				}
+			}
+		case monitor.FieldTreatNotFoundAsSuccess:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field treat_not_found_as_success", values[i])
+			} else if value.Valid {
+				_m.TreatNotFoundAsSuccess = value.Bool
+			}
+		case monitor.FieldAcceptEmptyBody:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field accept_empty_body", values[i])
+			} else if value.Valid {
+				_m.AcceptEmptyBody = value.Bool
+			}
+		case monitor.FieldWatchdogMinutes:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field watchdog_minutes", values[i])
+			} else if value.Valid {
+				_m.WatchdogMinutes = new(int)
+				*_m.WatchdogMinutes = int(value.Int64)
+			}
+		case monitor.FieldHeaderAssertions:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field header_assertions", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.HeaderAssertions); err != nil {
+					return fmt.Errorf("unmarshal field header_assertions: %w", err)
+				}
+			}
+		case monitor.FieldTrackHeader:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field track_header", values[i])
+			} else if value.Valid {
+				_m.TrackHeader = new(string)
+				*_m.TrackHeader = value.String
+			}
+		case monitor.FieldNumericTolerance:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field numeric_tolerance", values[i])
+			} else if value.Valid {
+				_m.NumericTolerance = new(float64)
+				*_m.NumericTolerance = value.Float64
+			}
+		case monitor.FieldRedirectPolicy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field redirect_policy", values[i])
+			} else if value.Valid {
+				_m.RedirectPolicy = monitor.RedirectPolicy(value.String)
+			}
+		case monitor.FieldMaxRedirects:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field max_redirects", values[i])
+			} else if value.Valid {
+				_m.MaxRedirects = new(int)
+				*_m.MaxRedirects = int(value.Int64)
+			}
+		case monitor.FieldMaxResponseBytes:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field max_response_bytes", values[i])
+			} else if value.Valid {
+				_m.MaxResponseBytes = new(int)
+				*_m.MaxResponseBytes = int(value.Int64)
+			}
+		case monitor.FieldChecksHistoryLimit:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field checks_history_limit", values[i])
+			} else if value.Valid {
+				_m.ChecksHistoryLimit = new(int)
+				*_m.ChecksHistoryLimit = int(value.Int64)
+			}
+		case monitor.FieldCookieJar:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field cookie_jar", values[i])
+			} else if value.Valid {
+				_m.CookieJar = value.Bool
+			}
+		case monitor.FieldHostOverrides:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field host_overrides", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.HostOverrides); err != nil {
+					return fmt.Errorf("unmarshal field host_overrides: %w", err)
+				}
+			}
+		case monitor.FieldIPFamily:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field ip_family", values[i])
+			} else if value.Valid {
+				_m.IPFamily = monitor.IPFamily(value.String)
+			}
+		case monitor.FieldTLSCaPem:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field tls_ca_pem", values[i])
+			} else if value.Valid {
+				_m.TLSCaPem = new(string)
+				*_m.TLSCaPem = value.String
+			}
+		case monitor.FieldTLSInsecureSkipVerify:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field tls_insecure_skip_verify", values[i])
+			} else if value.Valid {
+				_m.TLSInsecureSkipVerify = value.Bool
+			}
+		case monitor.FieldTLSMinVersion:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field tls_min_version", values[i])
+			} else if value.Valid {
+				_m.TLSMinVersion = new(string)
+				*_m.TLSMinVersion = value.String
+			}
+		case monitor.FieldTLSServerName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field tls_server_name", values[i])
+			} else if value.Valid {
+				_m.TLSServerName = new(string)
+				*_m.TLSServerName = value.String
+			}
 		case monitor.FieldCron:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field cron", values[i])
 			} else if value.Valid {
 				_m.Cron = value.String
 			}
+		case monitor.FieldTimezone:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field timezone", values[i])
+			} else if value.Valid {
+				_m.Timezone = new(string)
+				*_m.Timezone = value.String
+			}
+		case monitor.FieldJitterSeconds:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field jitter_seconds", values[i])
+			} else if value.Valid {
+				_m.JitterSeconds = new(int)
+				*_m.JitterSeconds = int(value.Int64)
+			}
+		case monitor.FieldDstPolicy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field dst_policy", values[i])
+			} else if value.Valid {
+				_m.DstPolicy = monitor.DstPolicy(value.String)
+			}
+		case monitor.FieldBodySnapshot:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field body_snapshot", values[i])
+			} else if value.Valid {
+				_m.BodySnapshot = monitor.BodySnapshot(value.String)
+			}
+		case monitor.FieldContentHash:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field content_hash", values[i])
+			} else if value.Valid {
+				_m.ContentHash = monitor.ContentHash(value.String)
+			}
+		case monitor.FieldFetchMode:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field fetch_mode", values[i])
+			} else if value.Valid {
+				_m.FetchMode = monitor.FetchMode(value.String)
+			}
+		case monitor.FieldHeartbeatToken:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field heartbeat_token", values[i])
+			} else if value.Valid {
+				_m.HeartbeatToken = new(string)
+				*_m.HeartbeatToken = value.String
+			}
 		case monitor.FieldEnabled:
 			if value, ok := values[i].(*sql.NullBool); !ok {
 				return fmt.Errorf("unexpected type %T for field enabled", values[i])
 			} else if value.Valid {
 				_m.Enabled = value.Bool
 			}
+		case monitor.FieldStatusPage:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field status_page", values[i])
+			} else if value.Valid {
+				_m.StatusPage = value.Bool
+			}
+		case monitor.FieldSortIndex:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field sort_index", values[i])
+			} else if value.Valid {
+				_m.SortIndex = int(value.Int64)
+			}
+		case monitor.FieldArchivedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field archived_at", values[i])
+			} else if value.Valid {
+				_m.ArchivedAt = new(time.Time)
+				*_m.ArchivedAt = value.Time
+			}
 		case monitor.FieldCreatedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field created_at", values[i])
@@ -262,6 +618,21 @@ func (_m *Monitor) QueryRuntime() *MonitorRuntimeQuery {
 	return NewMonitorClient(_m.config).QueryRuntime(_m)
 }
 
+// QueryVersions queries the "versions" edge of the Monitor entity.
+func (_m *Monitor) QueryVersions() *MonitorVersionQuery {
+	return NewMonitorClient(_m.config).QueryVersions(_m)
+}
+
+// QueryRollups queries the "rollups" edge of the Monitor entity.
+func (_m *Monitor) QueryRollups() *CheckRollupQuery {
+	return NewMonitorClient(_m.config).QueryRollups(_m)
+}
+
+// QueryHeaderProfile queries the "header_profile" edge of the Monitor entity.
+func (_m *Monitor) QueryHeaderProfile() *HeaderProfileQuery {
+	return NewMonitorClient(_m.config).QueryHeaderProfile(_m)
+}
+
 // Update returns a builder for updating this Monitor.
 // Note that you need to call Monitor.Unwrap() before calling this method if this Monitor
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -309,17 +680,48 @@ func (_m *Monitor) String() string {
 	builder.WriteString("headers=")
 	builder.WriteString(fmt.Sprintf("%v", _m.Headers))
 	builder.WriteString(", ")
+	if v := _m.UserAgent; v != nil {
+		builder.WriteString("user_agent=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := _m.HeaderProfileID; v != nil {
+		builder.WriteString("header_profile_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
 	builder.WriteString("auth=")
 	builder.WriteString(fmt.Sprintf("%v", _m.Auth))
 	builder.WriteString(", ")
 	builder.WriteString("notification_channels=")
 	builder.WriteString(fmt.Sprintf("%v", _m.NotificationChannels))
 	builder.WriteString(", ")
+	builder.WriteString("escalation_channels=")
+	builder.WriteString(fmt.Sprintf("%v", _m.EscalationChannels))
+	builder.WriteString(", ")
+	builder.WriteString("tags=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Tags))
+	builder.WriteString(", ")
+	if v := _m.EscalationAfterMinutes; v != nil {
+		builder.WriteString("escalation_after_minutes=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
 	if v := _m.Selector; v != nil {
 		builder.WriteString("selector=")
 		builder.WriteString(*v)
 	}
 	builder.WriteString(", ")
+	if v := _m.ExpectedStatus; v != nil {
+		builder.WriteString("expected_status=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := _m.RetryOn; v != nil {
+		builder.WriteString("retry_on=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
 	builder.WriteString("expected_type=")
 	builder.WriteString(fmt.Sprintf("%v", _m.ExpectedType))
 	builder.WriteString(", ")
@@ -328,12 +730,125 @@ func (_m *Monitor) String() string {
 		builder.WriteString(*v)
 	}
 	builder.WriteString(", ")
+	builder.WriteString("must_contain=")
+	builder.WriteString(fmt.Sprintf("%v", _m.MustContain))
+	builder.WriteString(", ")
+	builder.WriteString("must_not_contain=")
+	builder.WriteString(fmt.Sprintf("%v", _m.MustNotContain))
+	builder.WriteString(", ")
+	builder.WriteString("treat_not_found_as_success=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TreatNotFoundAsSuccess))
+	builder.WriteString(", ")
+	builder.WriteString("accept_empty_body=")
+	builder.WriteString(fmt.Sprintf("%v", _m.AcceptEmptyBody))
+	builder.WriteString(", ")
+	if v := _m.WatchdogMinutes; v != nil {
+		builder.WriteString("watchdog_minutes=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("header_assertions=")
+	builder.WriteString(fmt.Sprintf("%v", _m.HeaderAssertions))
+	builder.WriteString(", ")
+	if v := _m.TrackHeader; v != nil {
+		builder.WriteString("track_header=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := _m.NumericTolerance; v != nil {
+		builder.WriteString("numeric_tolerance=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("redirect_policy=")
+	builder.WriteString(fmt.Sprintf("%v", _m.RedirectPolicy))
+	builder.WriteString(", ")
+	if v := _m.MaxRedirects; v != nil {
+		builder.WriteString("max_redirects=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.MaxResponseBytes; v != nil {
+		builder.WriteString("max_response_bytes=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.ChecksHistoryLimit; v != nil {
+		builder.WriteString("checks_history_limit=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("cookie_jar=")
+	builder.WriteString(fmt.Sprintf("%v", _m.CookieJar))
+	builder.WriteString(", ")
+	builder.WriteString("host_overrides=")
+	builder.WriteString(fmt.Sprintf("%v", _m.HostOverrides))
+	builder.WriteString(", ")
+	builder.WriteString("ip_family=")
+	builder.WriteString(fmt.Sprintf("%v", _m.IPFamily))
+	builder.WriteString(", ")
+	if v := _m.TLSCaPem; v != nil {
+		builder.WriteString("tls_ca_pem=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("tls_insecure_skip_verify=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TLSInsecureSkipVerify))
+	builder.WriteString(", ")
+	if v := _m.TLSMinVersion; v != nil {
+		builder.WriteString("tls_min_version=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := _m.TLSServerName; v != nil {
+		builder.WriteString("tls_server_name=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
 	builder.WriteString("cron=")
 	builder.WriteString(_m.Cron)
 	builder.WriteString(", ")
+	if v := _m.Timezone; v != nil {
+		builder.WriteString("timezone=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := _m.JitterSeconds; v != nil {
+		builder.WriteString("jitter_seconds=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("dst_policy=")
+	builder.WriteString(fmt.Sprintf("%v", _m.DstPolicy))
+	builder.WriteString(", ")
+	builder.WriteString("body_snapshot=")
+	builder.WriteString(fmt.Sprintf("%v", _m.BodySnapshot))
+	builder.WriteString(", ")
+	builder.WriteString("content_hash=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ContentHash))
+	builder.WriteString(", ")
+	builder.WriteString("fetch_mode=")
+	builder.WriteString(fmt.Sprintf("%v", _m.FetchMode))
+	builder.WriteString(", ")
+	if v := _m.HeartbeatToken; v != nil {
+		builder.WriteString("heartbeat_token=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
 	builder.WriteString("enabled=")
 	builder.WriteString(fmt.Sprintf("%v", _m.Enabled))
 	builder.WriteString(", ")
+	builder.WriteString("status_page=")
+	builder.WriteString(fmt.Sprintf("%v", _m.StatusPage))
+	builder.WriteString(", ")
+	builder.WriteString("sort_index=")
+	builder.WriteString(fmt.Sprintf("%v", _m.SortIndex))
+	builder.WriteString(", ")
+	if v := _m.ArchivedAt; v != nil {
+		builder.WriteString("archived_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
 	builder.WriteString("created_at=")
 	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
 	builder.WriteString(", ")
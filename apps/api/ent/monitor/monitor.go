@@ -27,20 +27,92 @@ const (
 	FieldBody = "body"
 	// FieldHeaders holds the string denoting the headers field in the database.
 	FieldHeaders = "headers"
+	// FieldUserAgent holds the string denoting the user_agent field in the database.
+	FieldUserAgent = "user_agent"
+	// FieldHeaderProfileID holds the string denoting the header_profile_id field in the database.
+	FieldHeaderProfileID = "header_profile_id"
 	// FieldAuth holds the string denoting the auth field in the database.
 	FieldAuth = "auth"
 	// FieldNotificationChannels holds the string denoting the notification_channels field in the database.
 	FieldNotificationChannels = "notification_channels"
+	// FieldEscalationChannels holds the string denoting the escalation_channels field in the database.
+	FieldEscalationChannels = "escalation_channels"
+	// FieldTags holds the string denoting the tags field in the database.
+	FieldTags = "tags"
+	// FieldEscalationAfterMinutes holds the string denoting the escalation_after_minutes field in the database.
+	FieldEscalationAfterMinutes = "escalation_after_minutes"
 	// FieldSelector holds the string denoting the selector field in the database.
 	FieldSelector = "selector"
+	// FieldExpectedStatus holds the string denoting the expected_status field in the database.
+	FieldExpectedStatus = "expected_status"
+	// FieldRetryOn holds the string denoting the retry_on field in the database.
+	FieldRetryOn = "retry_on"
 	// FieldExpectedType holds the string denoting the expected_type field in the database.
 	FieldExpectedType = "expected_type"
 	// FieldExpectedResponse holds the string denoting the expected_response field in the database.
 	FieldExpectedResponse = "expected_response"
+	// FieldMustContain holds the string denoting the must_contain field in the database.
+	FieldMustContain = "must_contain"
+	// FieldMustNotContain holds the string denoting the must_not_contain field in the database.
+	FieldMustNotContain = "must_not_contain"
+	// FieldTreatNotFoundAsSuccess holds the string denoting the treat_not_found_as_success field in the database.
+	FieldTreatNotFoundAsSuccess = "treat_not_found_as_success"
+	// FieldAcceptEmptyBody holds the string denoting the accept_empty_body field in the database.
+	FieldAcceptEmptyBody = "accept_empty_body"
+	// FieldWatchdogMinutes holds the string denoting the watchdog_minutes field in the database.
+	FieldWatchdogMinutes = "watchdog_minutes"
+	// FieldHeaderAssertions holds the string denoting the header_assertions field in the database.
+	FieldHeaderAssertions = "header_assertions"
+	// FieldTrackHeader holds the string denoting the track_header field in the database.
+	FieldTrackHeader = "track_header"
+	// FieldNumericTolerance holds the string denoting the numeric_tolerance field in the database.
+	FieldNumericTolerance = "numeric_tolerance"
+	// FieldRedirectPolicy holds the string denoting the redirect_policy field in the database.
+	FieldRedirectPolicy = "redirect_policy"
+	// FieldMaxRedirects holds the string denoting the max_redirects field in the database.
+	FieldMaxRedirects = "max_redirects"
+	// FieldMaxResponseBytes holds the string denoting the max_response_bytes field in the database.
+	FieldMaxResponseBytes = "max_response_bytes"
+	// FieldChecksHistoryLimit holds the string denoting the checks_history_limit field in the database.
+	FieldChecksHistoryLimit = "checks_history_limit"
+	// FieldCookieJar holds the string denoting the cookie_jar field in the database.
+	FieldCookieJar = "cookie_jar"
+	// FieldHostOverrides holds the string denoting the host_overrides field in the database.
+	FieldHostOverrides = "host_overrides"
+	// FieldIPFamily holds the string denoting the ip_family field in the database.
+	FieldIPFamily = "ip_family"
+	// FieldTLSCaPem holds the string denoting the tls_ca_pem field in the database.
+	FieldTLSCaPem = "tls_ca_pem"
+	// FieldTLSInsecureSkipVerify holds the string denoting the tls_insecure_skip_verify field in the database.
+	FieldTLSInsecureSkipVerify = "tls_insecure_skip_verify"
+	// FieldTLSMinVersion holds the string denoting the tls_min_version field in the database.
+	FieldTLSMinVersion = "tls_min_version"
+	// FieldTLSServerName holds the string denoting the tls_server_name field in the database.
+	FieldTLSServerName = "tls_server_name"
 	// FieldCron holds the string denoting the cron field in the database.
 	FieldCron = "cron"
+	// FieldTimezone holds the string denoting the timezone field in the database.
+	FieldTimezone = "timezone"
+	// FieldJitterSeconds holds the string denoting the jitter_seconds field in the database.
+	FieldJitterSeconds = "jitter_seconds"
+	// FieldDstPolicy holds the string denoting the dst_policy field in the database.
+	FieldDstPolicy = "dst_policy"
+	// FieldBodySnapshot holds the string denoting the body_snapshot field in the database.
+	FieldBodySnapshot = "body_snapshot"
+	// FieldContentHash holds the string denoting the content_hash field in the database.
+	FieldContentHash = "content_hash"
+	// FieldFetchMode holds the string denoting the fetch_mode field in the database.
+	FieldFetchMode = "fetch_mode"
+	// FieldHeartbeatToken holds the string denoting the heartbeat_token field in the database.
+	FieldHeartbeatToken = "heartbeat_token"
 	// FieldEnabled holds the string denoting the enabled field in the database.
 	FieldEnabled = "enabled"
+	// FieldStatusPage holds the string denoting the status_page field in the database.
+	FieldStatusPage = "status_page"
+	// FieldSortIndex holds the string denoting the sort_index field in the database.
+	FieldSortIndex = "sort_index"
+	// FieldArchivedAt holds the string denoting the archived_at field in the database.
+	FieldArchivedAt = "archived_at"
 	// FieldCreatedAt holds the string denoting the created_at field in the database.
 	FieldCreatedAt = "created_at"
 	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
@@ -51,6 +123,12 @@ const (
 	EdgeNotificationEvents = "notification_events"
 	// EdgeRuntime holds the string denoting the runtime edge name in mutations.
 	EdgeRuntime = "runtime"
+	// EdgeVersions holds the string denoting the versions edge name in mutations.
+	EdgeVersions = "versions"
+	// EdgeRollups holds the string denoting the rollups edge name in mutations.
+	EdgeRollups = "rollups"
+	// EdgeHeaderProfile holds the string denoting the header_profile edge name in mutations.
+	EdgeHeaderProfile = "header_profile"
 	// Table holds the table name of the monitor in the database.
 	Table = "monitors"
 	// CheckResultsTable is the table that holds the check_results relation/edge.
@@ -74,6 +152,27 @@ const (
 	RuntimeInverseTable = "monitor_runtimes"
 	// RuntimeColumn is the table column denoting the runtime relation/edge.
 	RuntimeColumn = "monitor_runtime"
+	// VersionsTable is the table that holds the versions relation/edge.
+	VersionsTable = "monitor_versions"
+	// VersionsInverseTable is the table name for the MonitorVersion entity.
+	// It exists in this package in order to avoid circular dependency with the "monitorversion" package.
+	VersionsInverseTable = "monitor_versions"
+	// VersionsColumn is the table column denoting the versions relation/edge.
+	VersionsColumn = "monitor_id"
+	// RollupsTable is the table that holds the rollups relation/edge.
+	RollupsTable = "check_rollups"
+	// RollupsInverseTable is the table name for the CheckRollup entity.
+	// It exists in this package in order to avoid circular dependency with the "checkrollup" package.
+	RollupsInverseTable = "check_rollups"
+	// RollupsColumn is the table column denoting the rollups relation/edge.
+	RollupsColumn = "monitor_id"
+	// HeaderProfileTable is the table that holds the header_profile relation/edge.
+	HeaderProfileTable = "monitors"
+	// HeaderProfileInverseTable is the table name for the HeaderProfile entity.
+	// It exists in this package in order to avoid circular dependency with the "headerprofile" package.
+	HeaderProfileInverseTable = "header_profiles"
+	// HeaderProfileColumn is the table column denoting the header_profile relation/edge.
+	HeaderProfileColumn = "header_profile_id"
 )
 
 // Columns holds all SQL columns for monitor fields.
@@ -85,13 +184,49 @@ var Columns = []string{
 	FieldIconURL,
 	FieldBody,
 	FieldHeaders,
+	FieldUserAgent,
+	FieldHeaderProfileID,
 	FieldAuth,
 	FieldNotificationChannels,
+	FieldEscalationChannels,
+	FieldTags,
+	FieldEscalationAfterMinutes,
 	FieldSelector,
+	FieldExpectedStatus,
+	FieldRetryOn,
 	FieldExpectedType,
 	FieldExpectedResponse,
+	FieldMustContain,
+	FieldMustNotContain,
+	FieldTreatNotFoundAsSuccess,
+	FieldAcceptEmptyBody,
+	FieldWatchdogMinutes,
+	FieldHeaderAssertions,
+	FieldTrackHeader,
+	FieldNumericTolerance,
+	FieldRedirectPolicy,
+	FieldMaxRedirects,
+	FieldMaxResponseBytes,
+	FieldChecksHistoryLimit,
+	FieldCookieJar,
+	FieldHostOverrides,
+	FieldIPFamily,
+	FieldTLSCaPem,
+	FieldTLSInsecureSkipVerify,
+	FieldTLSMinVersion,
+	FieldTLSServerName,
 	FieldCron,
+	FieldTimezone,
+	FieldJitterSeconds,
+	FieldDstPolicy,
+	FieldBodySnapshot,
+	FieldContentHash,
+	FieldFetchMode,
+	FieldHeartbeatToken,
 	FieldEnabled,
+	FieldStatusPage,
+	FieldSortIndex,
+	FieldArchivedAt,
 	FieldCreatedAt,
 	FieldUpdatedAt,
 }
@@ -111,10 +246,36 @@ var (
 	DefaultMethod string
 	// URLValidator is a validator for the "url" field. It is called by the builders before save.
 	URLValidator func(string) error
+	// EscalationAfterMinutesValidator is a validator for the "escalation_after_minutes" field. It is called by the builders before save.
+	EscalationAfterMinutesValidator func(int) error
+	// DefaultTreatNotFoundAsSuccess holds the default value on creation for the "treat_not_found_as_success" field.
+	DefaultTreatNotFoundAsSuccess bool
+	// DefaultAcceptEmptyBody holds the default value on creation for the "accept_empty_body" field.
+	DefaultAcceptEmptyBody bool
+	// WatchdogMinutesValidator is a validator for the "watchdog_minutes" field. It is called by the builders before save.
+	WatchdogMinutesValidator func(int) error
+	// NumericToleranceValidator is a validator for the "numeric_tolerance" field. It is called by the builders before save.
+	NumericToleranceValidator func(float64) error
+	// MaxRedirectsValidator is a validator for the "max_redirects" field. It is called by the builders before save.
+	MaxRedirectsValidator func(int) error
+	// MaxResponseBytesValidator is a validator for the "max_response_bytes" field. It is called by the builders before save.
+	MaxResponseBytesValidator func(int) error
+	// ChecksHistoryLimitValidator is a validator for the "checks_history_limit" field. It is called by the builders before save.
+	ChecksHistoryLimitValidator func(int) error
+	// DefaultCookieJar holds the default value on creation for the "cookie_jar" field.
+	DefaultCookieJar bool
+	// DefaultTLSInsecureSkipVerify holds the default value on creation for the "tls_insecure_skip_verify" field.
+	DefaultTLSInsecureSkipVerify bool
 	// CronValidator is a validator for the "cron" field. It is called by the builders before save.
 	CronValidator func(string) error
+	// JitterSecondsValidator is a validator for the "jitter_seconds" field. It is called by the builders before save.
+	JitterSecondsValidator func(int) error
 	// DefaultEnabled holds the default value on creation for the "enabled" field.
 	DefaultEnabled bool
+	// DefaultStatusPage holds the default value on creation for the "status_page" field.
+	DefaultStatusPage bool
+	// DefaultSortIndex holds the default value on creation for the "sort_index" field.
+	DefaultSortIndex int
 	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
 	DefaultCreatedAt func() time.Time
 	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
@@ -131,9 +292,11 @@ const DefaultExpectedType = ExpectedTypeJSON
 
 // ExpectedType values.
 const (
-	ExpectedTypeJSON ExpectedType = "json"
-	ExpectedTypeHTML ExpectedType = "html"
-	ExpectedTypeText ExpectedType = "text"
+	ExpectedTypeJSON    ExpectedType = "json"
+	ExpectedTypeHTML    ExpectedType = "html"
+	ExpectedTypeText    ExpectedType = "text"
+	ExpectedTypeFeed    ExpectedType = "feed"
+	ExpectedTypeSitemap ExpectedType = "sitemap"
 )
 
 func (et ExpectedType) String() string {
@@ -143,13 +306,175 @@ func (et ExpectedType) String() string {
 // ExpectedTypeValidator is a validator for the "expected_type" field enum values. It is called by the builders before save.
 func ExpectedTypeValidator(et ExpectedType) error {
 	switch et {
-	case ExpectedTypeJSON, ExpectedTypeHTML, ExpectedTypeText:
+	case ExpectedTypeJSON, ExpectedTypeHTML, ExpectedTypeText, ExpectedTypeFeed, ExpectedTypeSitemap:
 		return nil
 	default:
 		return fmt.Errorf("monitor: invalid enum value for expected_type field: %q", et)
 	}
 }
 
+// RedirectPolicy defines the type for the "redirect_policy" enum field.
+type RedirectPolicy string
+
+// RedirectPolicyFollow is the default value of the RedirectPolicy enum.
+const DefaultRedirectPolicy = RedirectPolicyFollow
+
+// RedirectPolicy values.
+const (
+	RedirectPolicyFollow RedirectPolicy = "follow"
+	RedirectPolicyNone   RedirectPolicy = "none"
+	RedirectPolicyRecord RedirectPolicy = "record"
+)
+
+func (rp RedirectPolicy) String() string {
+	return string(rp)
+}
+
+// RedirectPolicyValidator is a validator for the "redirect_policy" field enum values. It is called by the builders before save.
+func RedirectPolicyValidator(rp RedirectPolicy) error {
+	switch rp {
+	case RedirectPolicyFollow, RedirectPolicyNone, RedirectPolicyRecord:
+		return nil
+	default:
+		return fmt.Errorf("monitor: invalid enum value for redirect_policy field: %q", rp)
+	}
+}
+
+// IPFamily defines the type for the "ip_family" enum field.
+type IPFamily string
+
+// IPFamilyAny is the default value of the IPFamily enum.
+const DefaultIPFamily = IPFamilyAny
+
+// IPFamily values.
+const (
+	IPFamilyAny  IPFamily = "any"
+	IPFamilyIpv4 IPFamily = "ipv4"
+	IPFamilyIpv6 IPFamily = "ipv6"
+)
+
+func (_if IPFamily) String() string {
+	return string(_if)
+}
+
+// IPFamilyValidator is a validator for the "ip_family" field enum values. It is called by the builders before save.
+func IPFamilyValidator(_if IPFamily) error {
+	switch _if {
+	case IPFamilyAny, IPFamilyIpv4, IPFamilyIpv6:
+		return nil
+	default:
+		return fmt.Errorf("monitor: invalid enum value for ip_family field: %q", _if)
+	}
+}
+
+// DstPolicy defines the type for the "dst_policy" enum field.
+type DstPolicy string
+
+// DstPolicyNextValid is the default value of the DstPolicy enum.
+const DefaultDstPolicy = DstPolicyNextValid
+
+// DstPolicy values.
+const (
+	DstPolicySkip      DstPolicy = "skip"
+	DstPolicyNextValid DstPolicy = "next_valid"
+	DstPolicyRunTwice  DstPolicy = "run_twice"
+)
+
+func (dp DstPolicy) String() string {
+	return string(dp)
+}
+
+// DstPolicyValidator is a validator for the "dst_policy" field enum values. It is called by the builders before save.
+func DstPolicyValidator(dp DstPolicy) error {
+	switch dp {
+	case DstPolicySkip, DstPolicyNextValid, DstPolicyRunTwice:
+		return nil
+	default:
+		return fmt.Errorf("monitor: invalid enum value for dst_policy field: %q", dp)
+	}
+}
+
+// BodySnapshot defines the type for the "body_snapshot" enum field.
+type BodySnapshot string
+
+// BodySnapshotOff is the default value of the BodySnapshot enum.
+const DefaultBodySnapshot = BodySnapshotOff
+
+// BodySnapshot values.
+const (
+	BodySnapshotOff  BodySnapshot = "off"
+	BodySnapshotRaw  BodySnapshot = "raw"
+	BodySnapshotGzip BodySnapshot = "gzip"
+)
+
+func (bs BodySnapshot) String() string {
+	return string(bs)
+}
+
+// BodySnapshotValidator is a validator for the "body_snapshot" field enum values. It is called by the builders before save.
+func BodySnapshotValidator(bs BodySnapshot) error {
+	switch bs {
+	case BodySnapshotOff, BodySnapshotRaw, BodySnapshotGzip:
+		return nil
+	default:
+		return fmt.Errorf("monitor: invalid enum value for body_snapshot field: %q", bs)
+	}
+}
+
+// ContentHash defines the type for the "content_hash" enum field.
+type ContentHash string
+
+// ContentHashOff is the default value of the ContentHash enum.
+const DefaultContentHash = ContentHashOff
+
+// ContentHash values.
+const (
+	ContentHashOff        ContentHash = "off"
+	ContentHashRaw        ContentHash = "raw"
+	ContentHashNormalized ContentHash = "normalized"
+)
+
+func (ch ContentHash) String() string {
+	return string(ch)
+}
+
+// ContentHashValidator is a validator for the "content_hash" field enum values. It is called by the builders before save.
+func ContentHashValidator(ch ContentHash) error {
+	switch ch {
+	case ContentHashOff, ContentHashRaw, ContentHashNormalized:
+		return nil
+	default:
+		return fmt.Errorf("monitor: invalid enum value for content_hash field: %q", ch)
+	}
+}
+
+// FetchMode defines the type for the "fetch_mode" enum field.
+type FetchMode string
+
+// FetchModeHTTP is the default value of the FetchMode enum.
+const DefaultFetchMode = FetchModeHTTP
+
+// FetchMode values.
+const (
+	FetchModeHTTP      FetchMode = "http"
+	FetchModeRendered  FetchMode = "rendered"
+	FetchModeHeartbeat FetchMode = "heartbeat"
+)
+
+func (fm FetchMode) String() string {
+	return string(fm)
+}
+
+// FetchModeValidator is a validator for the "fetch_mode" field enum values. It is called by the builders before save.
+func FetchModeValidator(fm FetchMode) error {
+	switch fm {
+	case FetchModeHTTP, FetchModeRendered, FetchModeHeartbeat:
+		return nil
+	default:
+		return fmt.Errorf("monitor: invalid enum value for fetch_mode field: %q", fm)
+	}
+}
+
 // OrderOption defines the ordering options for the Monitor queries.
 type OrderOption func(*sql.Selector)
 
@@ -183,11 +508,36 @@ func ByBody(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldBody, opts...).ToFunc()
 }
 
+// ByUserAgent orders the results by the user_agent field.
+func ByUserAgent(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUserAgent, opts...).ToFunc()
+}
+
+// ByHeaderProfileID orders the results by the header_profile_id field.
+func ByHeaderProfileID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldHeaderProfileID, opts...).ToFunc()
+}
+
+// ByEscalationAfterMinutes orders the results by the escalation_after_minutes field.
+func ByEscalationAfterMinutes(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEscalationAfterMinutes, opts...).ToFunc()
+}
+
 // BySelector orders the results by the selector field.
 func BySelector(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldSelector, opts...).ToFunc()
 }
 
+// ByExpectedStatus orders the results by the expected_status field.
+func ByExpectedStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldExpectedStatus, opts...).ToFunc()
+}
+
+// ByRetryOn orders the results by the retry_on field.
+func ByRetryOn(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRetryOn, opts...).ToFunc()
+}
+
 // ByExpectedType orders the results by the expected_type field.
 func ByExpectedType(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldExpectedType, opts...).ToFunc()
@@ -198,16 +548,141 @@ func ByExpectedResponse(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldExpectedResponse, opts...).ToFunc()
 }
 
+// ByTreatNotFoundAsSuccess orders the results by the treat_not_found_as_success field.
+func ByTreatNotFoundAsSuccess(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTreatNotFoundAsSuccess, opts...).ToFunc()
+}
+
+// ByAcceptEmptyBody orders the results by the accept_empty_body field.
+func ByAcceptEmptyBody(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAcceptEmptyBody, opts...).ToFunc()
+}
+
+// ByWatchdogMinutes orders the results by the watchdog_minutes field.
+func ByWatchdogMinutes(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldWatchdogMinutes, opts...).ToFunc()
+}
+
+// ByTrackHeader orders the results by the track_header field.
+func ByTrackHeader(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTrackHeader, opts...).ToFunc()
+}
+
+// ByNumericTolerance orders the results by the numeric_tolerance field.
+func ByNumericTolerance(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldNumericTolerance, opts...).ToFunc()
+}
+
+// ByRedirectPolicy orders the results by the redirect_policy field.
+func ByRedirectPolicy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRedirectPolicy, opts...).ToFunc()
+}
+
+// ByMaxRedirects orders the results by the max_redirects field.
+func ByMaxRedirects(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMaxRedirects, opts...).ToFunc()
+}
+
+// ByMaxResponseBytes orders the results by the max_response_bytes field.
+func ByMaxResponseBytes(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMaxResponseBytes, opts...).ToFunc()
+}
+
+// ByChecksHistoryLimit orders the results by the checks_history_limit field.
+func ByChecksHistoryLimit(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldChecksHistoryLimit, opts...).ToFunc()
+}
+
+// ByCookieJar orders the results by the cookie_jar field.
+func ByCookieJar(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCookieJar, opts...).ToFunc()
+}
+
+// ByIPFamily orders the results by the ip_family field.
+func ByIPFamily(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldIPFamily, opts...).ToFunc()
+}
+
+// ByTLSCaPem orders the results by the tls_ca_pem field.
+func ByTLSCaPem(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTLSCaPem, opts...).ToFunc()
+}
+
+// ByTLSInsecureSkipVerify orders the results by the tls_insecure_skip_verify field.
+func ByTLSInsecureSkipVerify(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTLSInsecureSkipVerify, opts...).ToFunc()
+}
+
+// ByTLSMinVersion orders the results by the tls_min_version field.
+func ByTLSMinVersion(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTLSMinVersion, opts...).ToFunc()
+}
+
+// ByTLSServerName orders the results by the tls_server_name field.
+func ByTLSServerName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTLSServerName, opts...).ToFunc()
+}
+
 // ByCron orders the results by the cron field.
 func ByCron(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCron, opts...).ToFunc()
 }
 
+// ByTimezone orders the results by the timezone field.
+func ByTimezone(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTimezone, opts...).ToFunc()
+}
+
+// ByJitterSeconds orders the results by the jitter_seconds field.
+func ByJitterSeconds(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldJitterSeconds, opts...).ToFunc()
+}
+
+// ByDstPolicy orders the results by the dst_policy field.
+func ByDstPolicy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDstPolicy, opts...).ToFunc()
+}
+
+// ByBodySnapshot orders the results by the body_snapshot field.
+func ByBodySnapshot(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBodySnapshot, opts...).ToFunc()
+}
+
+// ByContentHash orders the results by the content_hash field.
+func ByContentHash(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldContentHash, opts...).ToFunc()
+}
+
+// ByFetchMode orders the results by the fetch_mode field.
+func ByFetchMode(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFetchMode, opts...).ToFunc()
+}
+
+// ByHeartbeatToken orders the results by the heartbeat_token field.
+func ByHeartbeatToken(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldHeartbeatToken, opts...).ToFunc()
+}
+
 // ByEnabled orders the results by the enabled field.
 func ByEnabled(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldEnabled, opts...).ToFunc()
 }
 
+// ByStatusPage orders the results by the status_page field.
+func ByStatusPage(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatusPage, opts...).ToFunc()
+}
+
+// BySortIndex orders the results by the sort_index field.
+func BySortIndex(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSortIndex, opts...).ToFunc()
+}
+
+// ByArchivedAt orders the results by the archived_at field.
+func ByArchivedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldArchivedAt, opts...).ToFunc()
+}
+
 // ByCreatedAt orders the results by the created_at field.
 func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
@@ -252,6 +727,41 @@ func ByRuntimeField(field string, opts ...sql.OrderTermOption) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newRuntimeStep(), sql.OrderByField(field, opts...))
 	}
 }
+
+// ByVersionsCount orders the results by versions count.
+func ByVersionsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newVersionsStep(), opts...)
+	}
+}
+
+// ByVersions orders the results by versions terms.
+func ByVersions(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newVersionsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByRollupsCount orders the results by rollups count.
+func ByRollupsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newRollupsStep(), opts...)
+	}
+}
+
+// ByRollups orders the results by rollups terms.
+func ByRollups(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newRollupsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByHeaderProfileField orders the results by header_profile field.
+func ByHeaderProfileField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newHeaderProfileStep(), sql.OrderByField(field, opts...))
+	}
+}
 func newCheckResultsStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -273,3 +783,24 @@ func newRuntimeStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2O, false, RuntimeTable, RuntimeColumn),
 	)
 }
+func newVersionsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(VersionsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, VersionsTable, VersionsColumn),
+	)
+}
+func newRollupsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(RollupsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, RollupsTable, RollupsColumn),
+	)
+}
+func newHeaderProfileStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(HeaderProfileInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, HeaderProfileTable, HeaderProfileColumn),
+	)
+}
@@ -75,26 +75,146 @@ func Body(v string) predicate.Monitor {
 	return predicate.Monitor(sql.FieldEQ(FieldBody, v))
 }
 
+// UserAgent applies equality check predicate on the "user_agent" field. It's identical to UserAgentEQ.
+func UserAgent(v string) predicate.Monitor {
+	return predicate.Monitor(sql.FieldEQ(FieldUserAgent, v))
+}
+
+// HeaderProfileID applies equality check predicate on the "header_profile_id" field. It's identical to HeaderProfileIDEQ.
+func HeaderProfileID(v int) predicate.Monitor {
+	return predicate.Monitor(sql.FieldEQ(FieldHeaderProfileID, v))
+}
+
+// EscalationAfterMinutes applies equality check predicate on the "escalation_after_minutes" field. It's identical to EscalationAfterMinutesEQ.
+func EscalationAfterMinutes(v int) predicate.Monitor {
+	return predicate.Monitor(sql.FieldEQ(FieldEscalationAfterMinutes, v))
+}
+
 // Selector applies equality check predicate on the "selector" field. It's identical to SelectorEQ.
 func Selector(v string) predicate.Monitor {
 	return predicate.Monitor(sql.FieldEQ(FieldSelector, v))
 }
 
+// ExpectedStatus applies equality check predicate on the "expected_status" field. It's identical to ExpectedStatusEQ.
+func ExpectedStatus(v string) predicate.Monitor {
+	return predicate.Monitor(sql.FieldEQ(FieldExpectedStatus, v))
+}
+
+// RetryOn applies equality check predicate on the "retry_on" field. It's identical to RetryOnEQ.
+func RetryOn(v string) predicate.Monitor {
+	return predicate.Monitor(sql.FieldEQ(FieldRetryOn, v))
+}
+
 // ExpectedResponse applies equality check predicate on the "expected_response" field. It's identical to ExpectedResponseEQ.
 func ExpectedResponse(v string) predicate.Monitor {
 	return predicate.Monitor(sql.FieldEQ(FieldExpectedResponse, v))
 }
 
+// TreatNotFoundAsSuccess applies equality check predicate on the "treat_not_found_as_success" field. It's identical to TreatNotFoundAsSuccessEQ.
+func TreatNotFoundAsSuccess(v bool) predicate.Monitor {
+	return predicate.Monitor(sql.FieldEQ(FieldTreatNotFoundAsSuccess, v))
+}
+
+// AcceptEmptyBody applies equality check predicate on the "accept_empty_body" field. It's identical to AcceptEmptyBodyEQ.
+func AcceptEmptyBody(v bool) predicate.Monitor {
+	return predicate.Monitor(sql.FieldEQ(FieldAcceptEmptyBody, v))
+}
+
+// WatchdogMinutes applies equality check predicate on the "watchdog_minutes" field. It's identical to WatchdogMinutesEQ.
+func WatchdogMinutes(v int) predicate.Monitor {
+	return predicate.Monitor(sql.FieldEQ(FieldWatchdogMinutes, v))
+}
+
+// TrackHeader applies equality check predicate on the "track_header" field. It's identical to TrackHeaderEQ.
+func TrackHeader(v string) predicate.Monitor {
+	return predicate.Monitor(sql.FieldEQ(FieldTrackHeader, v))
+}
+
+// NumericTolerance applies equality check predicate on the "numeric_tolerance" field. It's identical to NumericToleranceEQ.
+func NumericTolerance(v float64) predicate.Monitor {
+	return predicate.Monitor(sql.FieldEQ(FieldNumericTolerance, v))
+}
+
+// MaxRedirects applies equality check predicate on the "max_redirects" field. It's identical to MaxRedirectsEQ.
+func MaxRedirects(v int) predicate.Monitor {
+	return predicate.Monitor(sql.FieldEQ(FieldMaxRedirects, v))
+}
+
+// MaxResponseBytes applies equality check predicate on the "max_response_bytes" field. It's identical to MaxResponseBytesEQ.
+func MaxResponseBytes(v int) predicate.Monitor {
+	return predicate.Monitor(sql.FieldEQ(FieldMaxResponseBytes, v))
+}
+
+// ChecksHistoryLimit applies equality check predicate on the "checks_history_limit" field. It's identical to ChecksHistoryLimitEQ.
+func ChecksHistoryLimit(v int) predicate.Monitor {
+	return predicate.Monitor(sql.FieldEQ(FieldChecksHistoryLimit, v))
+}
+
+// CookieJar applies equality check predicate on the "cookie_jar" field. It's identical to CookieJarEQ.
+func CookieJar(v bool) predicate.Monitor {
+	return predicate.Monitor(sql.FieldEQ(FieldCookieJar, v))
+}
+
+// TLSCaPem applies equality check predicate on the "tls_ca_pem" field. It's identical to TLSCaPemEQ.
+func TLSCaPem(v string) predicate.Monitor {
+	return predicate.Monitor(sql.FieldEQ(FieldTLSCaPem, v))
+}
+
+// TLSInsecureSkipVerify applies equality check predicate on the "tls_insecure_skip_verify" field. It's identical to TLSInsecureSkipVerifyEQ.
+func TLSInsecureSkipVerify(v bool) predicate.Monitor {
+	return predicate.Monitor(sql.FieldEQ(FieldTLSInsecureSkipVerify, v))
+}
+
+// TLSMinVersion applies equality check predicate on the "tls_min_version" field. It's identical to TLSMinVersionEQ.
+func TLSMinVersion(v string) predicate.Monitor {
+	return predicate.Monitor(sql.FieldEQ(FieldTLSMinVersion, v))
+}
+
+// TLSServerName applies equality check predicate on the "tls_server_name" field. It's identical to TLSServerNameEQ.
+func TLSServerName(v string) predicate.Monitor {
+	return predicate.Monitor(sql.FieldEQ(FieldTLSServerName, v))
+}
+
 // Cron applies equality check predicate on the "cron" field. It's identical to CronEQ.
 func Cron(v string) predicate.Monitor {
 	return predicate.Monitor(sql.FieldEQ(FieldCron, v))
 }
 
+// Timezone applies equality check predicate on the "timezone" field. It's identical to TimezoneEQ.
+func Timezone(v string) predicate.Monitor {
+	return predicate.Monitor(sql.FieldEQ(FieldTimezone, v))
+}
+
+// JitterSeconds applies equality check predicate on the "jitter_seconds" field. It's identical to JitterSecondsEQ.
+func JitterSeconds(v int) predicate.Monitor {
+	return predicate.Monitor(sql.FieldEQ(FieldJitterSeconds, v))
+}
+
+// HeartbeatToken applies equality check predicate on the "heartbeat_token" field. It's identical to HeartbeatTokenEQ.
+func HeartbeatToken(v string) predicate.Monitor {
+	return predicate.Monitor(sql.FieldEQ(FieldHeartbeatToken, v))
+}
+
 // Enabled applies equality check predicate on the "enabled" field. It's identical to EnabledEQ.
 func Enabled(v bool) predicate.Monitor {
 	return predicate.Monitor(sql.FieldEQ(FieldEnabled, v))
 }
 
+// StatusPage applies equality check predicate on the "status_page" field. It's identical to StatusPageEQ.
+func StatusPage(v bool) predicate.Monitor {
+	return predicate.Monitor(sql.FieldEQ(FieldStatusPage, v))
+}
+
+// SortIndex applies equality check predicate on the "sort_index" field. It's identical to SortIndexEQ.
+func SortIndex(v int) predicate.Monitor {
+	return predicate.Monitor(sql.FieldEQ(FieldSortIndex, v))
+}
+
+// ArchivedAt applies equality check predicate on the "archived_at" field. It's identical to ArchivedAtEQ.
+func ArchivedAt(v time.Time) predicate.Monitor {
+	return predicate.Monitor(sql.FieldEQ(FieldArchivedAt, v))
+}
+
 // CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
 func CreatedAt(v time.Time) predicate.Monitor {
 	return predicate.Monitor(sql.FieldEQ(FieldCreatedAt, v))
@@ -470,6 +590,111 @@ func HeadersNotNil() predicate.Monitor {
 	return predicate.Monitor(sql.FieldNotNull(FieldHeaders))
 }
 
+// UserAgentEQ applies the EQ predicate on the "user_agent" field.
+func UserAgentEQ(v string) predicate.Monitor {
+	return predicate.Monitor(sql.FieldEQ(FieldUserAgent, v))
+}
+
+// UserAgentNEQ applies the NEQ predicate on the "user_agent" field.
+func UserAgentNEQ(v string) predicate.Monitor {
+	return predicate.Monitor(sql.FieldNEQ(FieldUserAgent, v))
+}
+
+// UserAgentIn applies the In predicate on the "user_agent" field.
+func UserAgentIn(vs ...string) predicate.Monitor {
+	return predicate.Monitor(sql.FieldIn(FieldUserAgent, vs...))
+}
+
+// UserAgentNotIn applies the NotIn predicate on the "user_agent" field.
+func UserAgentNotIn(vs ...string) predicate.Monitor {
+	return predicate.Monitor(sql.FieldNotIn(FieldUserAgent, vs...))
+}
+
+// UserAgentGT applies the GT predicate on the "user_agent" field.
+func UserAgentGT(v string) predicate.Monitor {
+	return predicate.Monitor(sql.FieldGT(FieldUserAgent, v))
+}
+
+// UserAgentGTE applies the GTE predicate on the "user_agent" field.
+func UserAgentGTE(v string) predicate.Monitor {
+	return predicate.Monitor(sql.FieldGTE(FieldUserAgent, v))
+}
+
+// UserAgentLT applies the LT predicate on the "user_agent" field.
+func UserAgentLT(v string) predicate.Monitor {
+	return predicate.Monitor(sql.FieldLT(FieldUserAgent, v))
+}
+
+// UserAgentLTE applies the LTE predicate on the "user_agent" field.
+func UserAgentLTE(v string) predicate.Monitor {
+	return predicate.Monitor(sql.FieldLTE(FieldUserAgent, v))
+}
+
+// UserAgentContains applies the Contains predicate on the "user_agent" field.
+func UserAgentContains(v string) predicate.Monitor {
+	return predicate.Monitor(sql.FieldContains(FieldUserAgent, v))
+}
+
+// UserAgentHasPrefix applies the HasPrefix predicate on the "user_agent" field.
+func UserAgentHasPrefix(v string) predicate.Monitor {
+	return predicate.Monitor(sql.FieldHasPrefix(FieldUserAgent, v))
+}
+
+// UserAgentHasSuffix applies the HasSuffix predicate on the "user_agent" field.
+func UserAgentHasSuffix(v string) predicate.Monitor {
+	return predicate.Monitor(sql.FieldHasSuffix(FieldUserAgent, v))
+}
+
+// UserAgentIsNil applies the IsNil predicate on the "user_agent" field.
+func UserAgentIsNil() predicate.Monitor {
+	return predicate.Monitor(sql.FieldIsNull(FieldUserAgent))
+}
+
+// UserAgentNotNil applies the NotNil predicate on the "user_agent" field.
+func UserAgentNotNil() predicate.Monitor {
+	return predicate.Monitor(sql.FieldNotNull(FieldUserAgent))
+}
+
+// UserAgentEqualFold applies the EqualFold predicate on the "user_agent" field.
+func UserAgentEqualFold(v string) predicate.Monitor {
+	return predicate.Monitor(sql.FieldEqualFold(FieldUserAgent, v))
+}
+
+// UserAgentContainsFold applies the ContainsFold predicate on the "user_agent" field.
+func UserAgentContainsFold(v string) predicate.Monitor {
+	return predicate.Monitor(sql.FieldContainsFold(FieldUserAgent, v))
+}
+
+// HeaderProfileIDEQ applies the EQ predicate on the "header_profile_id" field.
+func HeaderProfileIDEQ(v int) predicate.Monitor {
+	return predicate.Monitor(sql.FieldEQ(FieldHeaderProfileID, v))
+}
+
+// HeaderProfileIDNEQ applies the NEQ predicate on the "header_profile_id" field.
+func HeaderProfileIDNEQ(v int) predicate.Monitor {
+	return predicate.Monitor(sql.FieldNEQ(FieldHeaderProfileID, v))
+}
+
+// HeaderProfileIDIn applies the In predicate on the "header_profile_id" field.
+func HeaderProfileIDIn(vs ...int) predicate.Monitor {
+	return predicate.Monitor(sql.FieldIn(FieldHeaderProfileID, vs...))
+}
+
+// HeaderProfileIDNotIn applies the NotIn predicate on the "header_profile_id" field.
+func HeaderProfileIDNotIn(vs ...int) predicate.Monitor {
+	return predicate.Monitor(sql.FieldNotIn(FieldHeaderProfileID, vs...))
+}
+
+// HeaderProfileIDIsNil applies the IsNil predicate on the "header_profile_id" field.
+func HeaderProfileIDIsNil() predicate.Monitor {
+	return predicate.Monitor(sql.FieldIsNull(FieldHeaderProfileID))
+}
+
+// HeaderProfileIDNotNil applies the NotNil predicate on the "header_profile_id" field.
+func HeaderProfileIDNotNil() predicate.Monitor {
+	return predicate.Monitor(sql.FieldNotNull(FieldHeaderProfileID))
+}
+
 // AuthIsNil applies the IsNil predicate on the "auth" field.
 func AuthIsNil() predicate.Monitor {
 	return predicate.Monitor(sql.FieldIsNull(FieldAuth))
@@ -490,6 +715,76 @@ func NotificationChannelsNotNil() predicate.Monitor {
 	return predicate.Monitor(sql.FieldNotNull(FieldNotificationChannels))
 }
 
+// EscalationChannelsIsNil applies the IsNil predicate on the "escalation_channels" field.
+func EscalationChannelsIsNil() predicate.Monitor {
+	return predicate.Monitor(sql.FieldIsNull(FieldEscalationChannels))
+}
+
+// EscalationChannelsNotNil applies the NotNil predicate on the "escalation_channels" field.
+func EscalationChannelsNotNil() predicate.Monitor {
+	return predicate.Monitor(sql.FieldNotNull(FieldEscalationChannels))
+}
+
+// TagsIsNil applies the IsNil predicate on the "tags" field.
+func TagsIsNil() predicate.Monitor {
+	return predicate.Monitor(sql.FieldIsNull(FieldTags))
+}
+
+// TagsNotNil applies the NotNil predicate on the "tags" field.
+func TagsNotNil() predicate.Monitor {
+	return predicate.Monitor(sql.FieldNotNull(FieldTags))
+}
+
+// EscalationAfterMinutesEQ applies the EQ predicate on the "escalation_after_minutes" field.
+func EscalationAfterMinutesEQ(v int) predicate.Monitor {
+	return predicate.Monitor(sql.FieldEQ(FieldEscalationAfterMinutes, v))
+}
+
+// EscalationAfterMinutesNEQ applies the NEQ predicate on the "escalation_after_minutes" field.
+func EscalationAfterMinutesNEQ(v int) predicate.Monitor {
+	return predicate.Monitor(sql.FieldNEQ(FieldEscalationAfterMinutes, v))
+}
+
+// EscalationAfterMinutesIn applies the In predicate on the "escalation_after_minutes" field.
+func EscalationAfterMinutesIn(vs ...int) predicate.Monitor {
+	return predicate.Monitor(sql.FieldIn(FieldEscalationAfterMinutes, vs...))
+}
+
+// EscalationAfterMinutesNotIn applies the NotIn predicate on the "escalation_after_minutes" field.
+func EscalationAfterMinutesNotIn(vs ...int) predicate.Monitor {
+	return predicate.Monitor(sql.FieldNotIn(FieldEscalationAfterMinutes, vs...))
+}
+
+// EscalationAfterMinutesGT applies the GT predicate on the "escalation_after_minutes" field.
+func EscalationAfterMinutesGT(v int) predicate.Monitor {
+	return predicate.Monitor(sql.FieldGT(FieldEscalationAfterMinutes, v))
+}
+
+// EscalationAfterMinutesGTE applies the GTE predicate on the "escalation_after_minutes" field.
+func EscalationAfterMinutesGTE(v int) predicate.Monitor {
+	return predicate.Monitor(sql.FieldGTE(FieldEscalationAfterMinutes, v))
+}
+
+// EscalationAfterMinutesLT applies the LT predicate on the "escalation_after_minutes" field.
+func EscalationAfterMinutesLT(v int) predicate.Monitor {
+	return predicate.Monitor(sql.FieldLT(FieldEscalationAfterMinutes, v))
+}
+
+// EscalationAfterMinutesLTE applies the LTE predicate on the "escalation_after_minutes" field.
+func EscalationAfterMinutesLTE(v int) predicate.Monitor {
+	return predicate.Monitor(sql.FieldLTE(FieldEscalationAfterMinutes, v))
+}
+
+// EscalationAfterMinutesIsNil applies the IsNil predicate on the "escalation_after_minutes" field.
+func EscalationAfterMinutesIsNil() predicate.Monitor {
+	return predicate.Monitor(sql.FieldIsNull(FieldEscalationAfterMinutes))
+}
+
+// EscalationAfterMinutesNotNil applies the NotNil predicate on the "escalation_after_minutes" field.
+func EscalationAfterMinutesNotNil() predicate.Monitor {
+	return predicate.Monitor(sql.FieldNotNull(FieldEscalationAfterMinutes))
+}
+
 // SelectorEQ applies the EQ predicate on the "selector" field.
 func SelectorEQ(v string) predicate.Monitor {
 	return predicate.Monitor(sql.FieldEQ(FieldSelector, v))
@@ -565,6 +860,156 @@ func SelectorContainsFold(v string) predicate.Monitor {
 	return predicate.Monitor(sql.FieldContainsFold(FieldSelector, v))
 }
 
+// ExpectedStatusEQ applies the EQ predicate on the "expected_status" field.
+func ExpectedStatusEQ(v string) predicate.Monitor {
+	return predicate.Monitor(sql.FieldEQ(FieldExpectedStatus, v))
+}
+
+// ExpectedStatusNEQ applies the NEQ predicate on the "expected_status" field.
+func ExpectedStatusNEQ(v string) predicate.Monitor {
+	return predicate.Monitor(sql.FieldNEQ(FieldExpectedStatus, v))
+}
+
+// ExpectedStatusIn applies the In predicate on the "expected_status" field.
+func ExpectedStatusIn(vs ...string) predicate.Monitor {
+	return predicate.Monitor(sql.FieldIn(FieldExpectedStatus, vs...))
+}
+
+// ExpectedStatusNotIn applies the NotIn predicate on the "expected_status" field.
+func ExpectedStatusNotIn(vs ...string) predicate.Monitor {
+	return predicate.Monitor(sql.FieldNotIn(FieldExpectedStatus, vs...))
+}
+
+// ExpectedStatusGT applies the GT predicate on the "expected_status" field.
+func ExpectedStatusGT(v string) predicate.Monitor {
+	return predicate.Monitor(sql.FieldGT(FieldExpectedStatus, v))
+}
+
+// ExpectedStatusGTE applies the GTE predicate on the "expected_status" field.
+func ExpectedStatusGTE(v string) predicate.Monitor {
+	return predicate.Monitor(sql.FieldGTE(FieldExpectedStatus, v))
+}
+
+// ExpectedStatusLT applies the LT predicate on the "expected_status" field.
+func ExpectedStatusLT(v string) predicate.Monitor {
+	return predicate.Monitor(sql.FieldLT(FieldExpectedStatus, v))
+}
+
+// ExpectedStatusLTE applies the LTE predicate on the "expected_status" field.
+func ExpectedStatusLTE(v string) predicate.Monitor {
+	return predicate.Monitor(sql.FieldLTE(FieldExpectedStatus, v))
+}
+
+// ExpectedStatusContains applies the Contains predicate on the "expected_status" field.
+func ExpectedStatusContains(v string) predicate.Monitor {
+	return predicate.Monitor(sql.FieldContains(FieldExpectedStatus, v))
+}
+
+// ExpectedStatusHasPrefix applies the HasPrefix predicate on the "expected_status" field.
+func ExpectedStatusHasPrefix(v string) predicate.Monitor {
+	return predicate.Monitor(sql.FieldHasPrefix(FieldExpectedStatus, v))
+}
+
+// ExpectedStatusHasSuffix applies the HasSuffix predicate on the "expected_status" field.
+func ExpectedStatusHasSuffix(v string) predicate.Monitor {
+	return predicate.Monitor(sql.FieldHasSuffix(FieldExpectedStatus, v))
+}
+
+// ExpectedStatusIsNil applies the IsNil predicate on the "expected_status" field.
+func ExpectedStatusIsNil() predicate.Monitor {
+	return predicate.Monitor(sql.FieldIsNull(FieldExpectedStatus))
+}
+
+// ExpectedStatusNotNil applies the NotNil predicate on the "expected_status" field.
+func ExpectedStatusNotNil() predicate.Monitor {
+	return predicate.Monitor(sql.FieldNotNull(FieldExpectedStatus))
+}
+
+// ExpectedStatusEqualFold applies the EqualFold predicate on the "expected_status" field.
+func ExpectedStatusEqualFold(v string) predicate.Monitor {
+	return predicate.Monitor(sql.FieldEqualFold(FieldExpectedStatus, v))
+}
+
+// ExpectedStatusContainsFold applies the ContainsFold predicate on the "expected_status" field.
+func ExpectedStatusContainsFold(v string) predicate.Monitor {
+	return predicate.Monitor(sql.FieldContainsFold(FieldExpectedStatus, v))
+}
+
+// RetryOnEQ applies the EQ predicate on the "retry_on" field.
+func RetryOnEQ(v string) predicate.Monitor {
+	return predicate.Monitor(sql.FieldEQ(FieldRetryOn, v))
+}
+
+// RetryOnNEQ applies the NEQ predicate on the "retry_on" field.
+func RetryOnNEQ(v string) predicate.Monitor {
+	return predicate.Monitor(sql.FieldNEQ(FieldRetryOn, v))
+}
+
+// RetryOnIn applies the In predicate on the "retry_on" field.
+func RetryOnIn(vs ...string) predicate.Monitor {
+	return predicate.Monitor(sql.FieldIn(FieldRetryOn, vs...))
+}
+
+// RetryOnNotIn applies the NotIn predicate on the "retry_on" field.
+func RetryOnNotIn(vs ...string) predicate.Monitor {
+	return predicate.Monitor(sql.FieldNotIn(FieldRetryOn, vs...))
+}
+
+// RetryOnGT applies the GT predicate on the "retry_on" field.
+func RetryOnGT(v string) predicate.Monitor {
+	return predicate.Monitor(sql.FieldGT(FieldRetryOn, v))
+}
+
+// RetryOnGTE applies the GTE predicate on the "retry_on" field.
+func RetryOnGTE(v string) predicate.Monitor {
+	return predicate.Monitor(sql.FieldGTE(FieldRetryOn, v))
+}
+
+// RetryOnLT applies the LT predicate on the "retry_on" field.
+func RetryOnLT(v string) predicate.Monitor {
+	return predicate.Monitor(sql.FieldLT(FieldRetryOn, v))
+}
+
+// RetryOnLTE applies the LTE predicate on the "retry_on" field.
+func RetryOnLTE(v string) predicate.Monitor {
+	return predicate.Monitor(sql.FieldLTE(FieldRetryOn, v))
+}
+
+// RetryOnContains applies the Contains predicate on the "retry_on" field.
+func RetryOnContains(v string) predicate.Monitor {
+	return predicate.Monitor(sql.FieldContains(FieldRetryOn, v))
+}
+
+// RetryOnHasPrefix applies the HasPrefix predicate on the "retry_on" field.
+func RetryOnHasPrefix(v string) predicate.Monitor {
+	return predicate.Monitor(sql.FieldHasPrefix(FieldRetryOn, v))
+}
+
+// RetryOnHasSuffix applies the HasSuffix predicate on the "retry_on" field.
+func RetryOnHasSuffix(v string) predicate.Monitor {
+	return predicate.Monitor(sql.FieldHasSuffix(FieldRetryOn, v))
+}
+
+// RetryOnIsNil applies the IsNil predicate on the "retry_on" field.
+func RetryOnIsNil() predicate.Monitor {
+	return predicate.Monitor(sql.FieldIsNull(FieldRetryOn))
+}
+
+// RetryOnNotNil applies the NotNil predicate on the "retry_on" field.
+func RetryOnNotNil() predicate.Monitor {
+	return predicate.Monitor(sql.FieldNotNull(FieldRetryOn))
+}
+
+// RetryOnEqualFold applies the EqualFold predicate on the "retry_on" field.
+func RetryOnEqualFold(v string) predicate.Monitor {
+	return predicate.Monitor(sql.FieldEqualFold(FieldRetryOn, v))
+}
+
+// RetryOnContainsFold applies the ContainsFold predicate on the "retry_on" field.
+func RetryOnContainsFold(v string) predicate.Monitor {
+	return predicate.Monitor(sql.FieldContainsFold(FieldRetryOn, v))
+}
+
 // ExpectedTypeEQ applies the EQ predicate on the "expected_type" field.
 func ExpectedTypeEQ(v ExpectedType) predicate.Monitor {
 	return predicate.Monitor(sql.FieldEQ(FieldExpectedType, v))
@@ -660,134 +1105,1184 @@ func ExpectedResponseContainsFold(v string) predicate.Monitor {
 	return predicate.Monitor(sql.FieldContainsFold(FieldExpectedResponse, v))
 }
 
-// CronEQ applies the EQ predicate on the "cron" field.
-func CronEQ(v string) predicate.Monitor {
-	return predicate.Monitor(sql.FieldEQ(FieldCron, v))
+// MustContainIsNil applies the IsNil predicate on the "must_contain" field.
+func MustContainIsNil() predicate.Monitor {
+	return predicate.Monitor(sql.FieldIsNull(FieldMustContain))
 }
 
-// CronNEQ applies the NEQ predicate on the "cron" field.
-func CronNEQ(v string) predicate.Monitor {
-	return predicate.Monitor(sql.FieldNEQ(FieldCron, v))
+// MustContainNotNil applies the NotNil predicate on the "must_contain" field.
+func MustContainNotNil() predicate.Monitor {
+	return predicate.Monitor(sql.FieldNotNull(FieldMustContain))
 }
 
-// CronIn applies the In predicate on the "cron" field.
-func CronIn(vs ...string) predicate.Monitor {
-	return predicate.Monitor(sql.FieldIn(FieldCron, vs...))
+// MustNotContainIsNil applies the IsNil predicate on the "must_not_contain" field.
+func MustNotContainIsNil() predicate.Monitor {
+	return predicate.Monitor(sql.FieldIsNull(FieldMustNotContain))
 }
 
-// CronNotIn applies the NotIn predicate on the "cron" field.
-func CronNotIn(vs ...string) predicate.Monitor {
-	return predicate.Monitor(sql.FieldNotIn(FieldCron, vs...))
+// MustNotContainNotNil applies the NotNil predicate on the "must_not_contain" field.
+func MustNotContainNotNil() predicate.Monitor {
+	return predicate.Monitor(sql.FieldNotNull(FieldMustNotContain))
 }
 
-// CronGT applies the GT predicate on the "cron" field.
-func CronGT(v string) predicate.Monitor {
-	return predicate.Monitor(sql.FieldGT(FieldCron, v))
+// TreatNotFoundAsSuccessEQ applies the EQ predicate on the "treat_not_found_as_success" field.
+func TreatNotFoundAsSuccessEQ(v bool) predicate.Monitor {
+	return predicate.Monitor(sql.FieldEQ(FieldTreatNotFoundAsSuccess, v))
 }
 
-// CronGTE applies the GTE predicate on the "cron" field.
-func CronGTE(v string) predicate.Monitor {
-	return predicate.Monitor(sql.FieldGTE(FieldCron, v))
+// TreatNotFoundAsSuccessNEQ applies the NEQ predicate on the "treat_not_found_as_success" field.
+func TreatNotFoundAsSuccessNEQ(v bool) predicate.Monitor {
+	return predicate.Monitor(sql.FieldNEQ(FieldTreatNotFoundAsSuccess, v))
 }
 
-// CronLT applies the LT predicate on the "cron" field.
-func CronLT(v string) predicate.Monitor {
-	return predicate.Monitor(sql.FieldLT(FieldCron, v))
+// AcceptEmptyBodyEQ applies the EQ predicate on the "accept_empty_body" field.
+func AcceptEmptyBodyEQ(v bool) predicate.Monitor {
+	return predicate.Monitor(sql.FieldEQ(FieldAcceptEmptyBody, v))
 }
 
-// CronLTE applies the LTE predicate on the "cron" field.
-func CronLTE(v string) predicate.Monitor {
-	return predicate.Monitor(sql.FieldLTE(FieldCron, v))
+// AcceptEmptyBodyNEQ applies the NEQ predicate on the "accept_empty_body" field.
+func AcceptEmptyBodyNEQ(v bool) predicate.Monitor {
+	return predicate.Monitor(sql.FieldNEQ(FieldAcceptEmptyBody, v))
 }
 
-// CronContains applies the Contains predicate on the "cron" field.
-func CronContains(v string) predicate.Monitor {
-	return predicate.Monitor(sql.FieldContains(FieldCron, v))
+// WatchdogMinutesEQ applies the EQ predicate on the "watchdog_minutes" field.
+func WatchdogMinutesEQ(v int) predicate.Monitor {
+	return predicate.Monitor(sql.FieldEQ(FieldWatchdogMinutes, v))
 }
 
-// CronHasPrefix applies the HasPrefix predicate on the "cron" field.
-func CronHasPrefix(v string) predicate.Monitor {
-	return predicate.Monitor(sql.FieldHasPrefix(FieldCron, v))
+// WatchdogMinutesNEQ applies the NEQ predicate on the "watchdog_minutes" field.
+func WatchdogMinutesNEQ(v int) predicate.Monitor {
+	return predicate.Monitor(sql.FieldNEQ(FieldWatchdogMinutes, v))
 }
 
-// CronHasSuffix applies the HasSuffix predicate on the "cron" field.
-func CronHasSuffix(v string) predicate.Monitor {
-	return predicate.Monitor(sql.FieldHasSuffix(FieldCron, v))
+// WatchdogMinutesIn applies the In predicate on the "watchdog_minutes" field.
+func WatchdogMinutesIn(vs ...int) predicate.Monitor {
+	return predicate.Monitor(sql.FieldIn(FieldWatchdogMinutes, vs...))
 }
 
-// CronEqualFold applies the EqualFold predicate on the "cron" field.
-func CronEqualFold(v string) predicate.Monitor {
-	return predicate.Monitor(sql.FieldEqualFold(FieldCron, v))
+// WatchdogMinutesNotIn applies the NotIn predicate on the "watchdog_minutes" field.
+func WatchdogMinutesNotIn(vs ...int) predicate.Monitor {
+	return predicate.Monitor(sql.FieldNotIn(FieldWatchdogMinutes, vs...))
 }
 
-// CronContainsFold applies the ContainsFold predicate on the "cron" field.
-func CronContainsFold(v string) predicate.Monitor {
-	return predicate.Monitor(sql.FieldContainsFold(FieldCron, v))
+// WatchdogMinutesGT applies the GT predicate on the "watchdog_minutes" field.
+func WatchdogMinutesGT(v int) predicate.Monitor {
+	return predicate.Monitor(sql.FieldGT(FieldWatchdogMinutes, v))
 }
 
-// EnabledEQ applies the EQ predicate on the "enabled" field.
-func EnabledEQ(v bool) predicate.Monitor {
-	return predicate.Monitor(sql.FieldEQ(FieldEnabled, v))
+// WatchdogMinutesGTE applies the GTE predicate on the "watchdog_minutes" field.
+func WatchdogMinutesGTE(v int) predicate.Monitor {
+	return predicate.Monitor(sql.FieldGTE(FieldWatchdogMinutes, v))
 }
 
-// EnabledNEQ applies the NEQ predicate on the "enabled" field.
-func EnabledNEQ(v bool) predicate.Monitor {
-	return predicate.Monitor(sql.FieldNEQ(FieldEnabled, v))
+// WatchdogMinutesLT applies the LT predicate on the "watchdog_minutes" field.
+func WatchdogMinutesLT(v int) predicate.Monitor {
+	return predicate.Monitor(sql.FieldLT(FieldWatchdogMinutes, v))
 }
 
-// CreatedAtEQ applies the EQ predicate on the "created_at" field.
-func CreatedAtEQ(v time.Time) predicate.Monitor {
-	return predicate.Monitor(sql.FieldEQ(FieldCreatedAt, v))
+// WatchdogMinutesLTE applies the LTE predicate on the "watchdog_minutes" field.
+func WatchdogMinutesLTE(v int) predicate.Monitor {
+	return predicate.Monitor(sql.FieldLTE(FieldWatchdogMinutes, v))
 }
 
-// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
-func CreatedAtNEQ(v time.Time) predicate.Monitor {
-	return predicate.Monitor(sql.FieldNEQ(FieldCreatedAt, v))
+// WatchdogMinutesIsNil applies the IsNil predicate on the "watchdog_minutes" field.
+func WatchdogMinutesIsNil() predicate.Monitor {
+	return predicate.Monitor(sql.FieldIsNull(FieldWatchdogMinutes))
 }
 
-// CreatedAtIn applies the In predicate on the "created_at" field.
-func CreatedAtIn(vs ...time.Time) predicate.Monitor {
-	return predicate.Monitor(sql.FieldIn(FieldCreatedAt, vs...))
+// WatchdogMinutesNotNil applies the NotNil predicate on the "watchdog_minutes" field.
+func WatchdogMinutesNotNil() predicate.Monitor {
+	return predicate.Monitor(sql.FieldNotNull(FieldWatchdogMinutes))
 }
 
-// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
-func CreatedAtNotIn(vs ...time.Time) predicate.Monitor {
-	return predicate.Monitor(sql.FieldNotIn(FieldCreatedAt, vs...))
+// HeaderAssertionsIsNil applies the IsNil predicate on the "header_assertions" field.
+func HeaderAssertionsIsNil() predicate.Monitor {
+	return predicate.Monitor(sql.FieldIsNull(FieldHeaderAssertions))
 }
 
-// CreatedAtGT applies the GT predicate on the "created_at" field.
-func CreatedAtGT(v time.Time) predicate.Monitor {
-	return predicate.Monitor(sql.FieldGT(FieldCreatedAt, v))
+// HeaderAssertionsNotNil applies the NotNil predicate on the "header_assertions" field.
+func HeaderAssertionsNotNil() predicate.Monitor {
+	return predicate.Monitor(sql.FieldNotNull(FieldHeaderAssertions))
 }
 
-// CreatedAtGTE applies the GTE predicate on the "created_at" field.
-func CreatedAtGTE(v time.Time) predicate.Monitor {
-	return predicate.Monitor(sql.FieldGTE(FieldCreatedAt, v))
+// TrackHeaderEQ applies the EQ predicate on the "track_header" field.
+func TrackHeaderEQ(v string) predicate.Monitor {
+	return predicate.Monitor(sql.FieldEQ(FieldTrackHeader, v))
 }
 
-// CreatedAtLT applies the LT predicate on the "created_at" field.
-func CreatedAtLT(v time.Time) predicate.Monitor {
-	return predicate.Monitor(sql.FieldLT(FieldCreatedAt, v))
+// TrackHeaderNEQ applies the NEQ predicate on the "track_header" field.
+func TrackHeaderNEQ(v string) predicate.Monitor {
+	return predicate.Monitor(sql.FieldNEQ(FieldTrackHeader, v))
 }
 
-// CreatedAtLTE applies the LTE predicate on the "created_at" field.
-func CreatedAtLTE(v time.Time) predicate.Monitor {
-	return predicate.Monitor(sql.FieldLTE(FieldCreatedAt, v))
+// TrackHeaderIn applies the In predicate on the "track_header" field.
+func TrackHeaderIn(vs ...string) predicate.Monitor {
+	return predicate.Monitor(sql.FieldIn(FieldTrackHeader, vs...))
 }
 
-// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
-func UpdatedAtEQ(v time.Time) predicate.Monitor {
-	return predicate.Monitor(sql.FieldEQ(FieldUpdatedAt, v))
+// TrackHeaderNotIn applies the NotIn predicate on the "track_header" field.
+func TrackHeaderNotIn(vs ...string) predicate.Monitor {
+	return predicate.Monitor(sql.FieldNotIn(FieldTrackHeader, vs...))
 }
 
-// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
-func UpdatedAtNEQ(v time.Time) predicate.Monitor {
-	return predicate.Monitor(sql.FieldNEQ(FieldUpdatedAt, v))
+// TrackHeaderGT applies the GT predicate on the "track_header" field.
+func TrackHeaderGT(v string) predicate.Monitor {
+	return predicate.Monitor(sql.FieldGT(FieldTrackHeader, v))
 }
 
-// UpdatedAtIn applies the In predicate on the "updated_at" field.
-func UpdatedAtIn(vs ...time.Time) predicate.Monitor {
-	return predicate.Monitor(sql.FieldIn(FieldUpdatedAt, vs...))
+// TrackHeaderGTE applies the GTE predicate on the "track_header" field.
+func TrackHeaderGTE(v string) predicate.Monitor {
+	return predicate.Monitor(sql.FieldGTE(FieldTrackHeader, v))
+}
+
+// TrackHeaderLT applies the LT predicate on the "track_header" field.
+func TrackHeaderLT(v string) predicate.Monitor {
+	return predicate.Monitor(sql.FieldLT(FieldTrackHeader, v))
+}
+
+// TrackHeaderLTE applies the LTE predicate on the "track_header" field.
+func TrackHeaderLTE(v string) predicate.Monitor {
+	return predicate.Monitor(sql.FieldLTE(FieldTrackHeader, v))
+}
+
+// TrackHeaderContains applies the Contains predicate on the "track_header" field.
+func TrackHeaderContains(v string) predicate.Monitor {
+	return predicate.Monitor(sql.FieldContains(FieldTrackHeader, v))
+}
+
+// TrackHeaderHasPrefix applies the HasPrefix predicate on the "track_header" field.
+func TrackHeaderHasPrefix(v string) predicate.Monitor {
+	return predicate.Monitor(sql.FieldHasPrefix(FieldTrackHeader, v))
+}
+
+// TrackHeaderHasSuffix applies the HasSuffix predicate on the "track_header" field.
+func TrackHeaderHasSuffix(v string) predicate.Monitor {
+	return predicate.Monitor(sql.FieldHasSuffix(FieldTrackHeader, v))
+}
+
+// TrackHeaderIsNil applies the IsNil predicate on the "track_header" field.
+func TrackHeaderIsNil() predicate.Monitor {
+	return predicate.Monitor(sql.FieldIsNull(FieldTrackHeader))
+}
+
+// TrackHeaderNotNil applies the NotNil predicate on the "track_header" field.
+func TrackHeaderNotNil() predicate.Monitor {
+	return predicate.Monitor(sql.FieldNotNull(FieldTrackHeader))
+}
+
+// TrackHeaderEqualFold applies the EqualFold predicate on the "track_header" field.
+func TrackHeaderEqualFold(v string) predicate.Monitor {
+	return predicate.Monitor(sql.FieldEqualFold(FieldTrackHeader, v))
+}
+
+// TrackHeaderContainsFold applies the ContainsFold predicate on the "track_header" field.
+func TrackHeaderContainsFold(v string) predicate.Monitor {
+	return predicate.Monitor(sql.FieldContainsFold(FieldTrackHeader, v))
+}
+
+// NumericToleranceEQ applies the EQ predicate on the "numeric_tolerance" field.
+func NumericToleranceEQ(v float64) predicate.Monitor {
+	return predicate.Monitor(sql.FieldEQ(FieldNumericTolerance, v))
+}
+
+// NumericToleranceNEQ applies the NEQ predicate on the "numeric_tolerance" field.
+func NumericToleranceNEQ(v float64) predicate.Monitor {
+	return predicate.Monitor(sql.FieldNEQ(FieldNumericTolerance, v))
+}
+
+// NumericToleranceIn applies the In predicate on the "numeric_tolerance" field.
+func NumericToleranceIn(vs ...float64) predicate.Monitor {
+	return predicate.Monitor(sql.FieldIn(FieldNumericTolerance, vs...))
+}
+
+// NumericToleranceNotIn applies the NotIn predicate on the "numeric_tolerance" field.
+func NumericToleranceNotIn(vs ...float64) predicate.Monitor {
+	return predicate.Monitor(sql.FieldNotIn(FieldNumericTolerance, vs...))
+}
+
+// NumericToleranceGT applies the GT predicate on the "numeric_tolerance" field.
+func NumericToleranceGT(v float64) predicate.Monitor {
+	return predicate.Monitor(sql.FieldGT(FieldNumericTolerance, v))
+}
+
+// NumericToleranceGTE applies the GTE predicate on the "numeric_tolerance" field.
+func NumericToleranceGTE(v float64) predicate.Monitor {
+	return predicate.Monitor(sql.FieldGTE(FieldNumericTolerance, v))
+}
+
+// NumericToleranceLT applies the LT predicate on the "numeric_tolerance" field.
+func NumericToleranceLT(v float64) predicate.Monitor {
+	return predicate.Monitor(sql.FieldLT(FieldNumericTolerance, v))
+}
+
+// NumericToleranceLTE applies the LTE predicate on the "numeric_tolerance" field.
+func NumericToleranceLTE(v float64) predicate.Monitor {
+	return predicate.Monitor(sql.FieldLTE(FieldNumericTolerance, v))
+}
+
+// NumericToleranceIsNil applies the IsNil predicate on the "numeric_tolerance" field.
+func NumericToleranceIsNil() predicate.Monitor {
+	return predicate.Monitor(sql.FieldIsNull(FieldNumericTolerance))
+}
+
+// NumericToleranceNotNil applies the NotNil predicate on the "numeric_tolerance" field.
+func NumericToleranceNotNil() predicate.Monitor {
+	return predicate.Monitor(sql.FieldNotNull(FieldNumericTolerance))
+}
+
+// RedirectPolicyEQ applies the EQ predicate on the "redirect_policy" field.
+func RedirectPolicyEQ(v RedirectPolicy) predicate.Monitor {
+	return predicate.Monitor(sql.FieldEQ(FieldRedirectPolicy, v))
+}
+
+// RedirectPolicyNEQ applies the NEQ predicate on the "redirect_policy" field.
+func RedirectPolicyNEQ(v RedirectPolicy) predicate.Monitor {
+	return predicate.Monitor(sql.FieldNEQ(FieldRedirectPolicy, v))
+}
+
+// RedirectPolicyIn applies the In predicate on the "redirect_policy" field.
+func RedirectPolicyIn(vs ...RedirectPolicy) predicate.Monitor {
+	return predicate.Monitor(sql.FieldIn(FieldRedirectPolicy, vs...))
+}
+
+// RedirectPolicyNotIn applies the NotIn predicate on the "redirect_policy" field.
+func RedirectPolicyNotIn(vs ...RedirectPolicy) predicate.Monitor {
+	return predicate.Monitor(sql.FieldNotIn(FieldRedirectPolicy, vs...))
+}
+
+// MaxRedirectsEQ applies the EQ predicate on the "max_redirects" field.
+func MaxRedirectsEQ(v int) predicate.Monitor {
+	return predicate.Monitor(sql.FieldEQ(FieldMaxRedirects, v))
+}
+
+// MaxRedirectsNEQ applies the NEQ predicate on the "max_redirects" field.
+func MaxRedirectsNEQ(v int) predicate.Monitor {
+	return predicate.Monitor(sql.FieldNEQ(FieldMaxRedirects, v))
+}
+
+// MaxRedirectsIn applies the In predicate on the "max_redirects" field.
+func MaxRedirectsIn(vs ...int) predicate.Monitor {
+	return predicate.Monitor(sql.FieldIn(FieldMaxRedirects, vs...))
+}
+
+// MaxRedirectsNotIn applies the NotIn predicate on the "max_redirects" field.
+func MaxRedirectsNotIn(vs ...int) predicate.Monitor {
+	return predicate.Monitor(sql.FieldNotIn(FieldMaxRedirects, vs...))
+}
+
+// MaxRedirectsGT applies the GT predicate on the "max_redirects" field.
+func MaxRedirectsGT(v int) predicate.Monitor {
+	return predicate.Monitor(sql.FieldGT(FieldMaxRedirects, v))
+}
+
+// MaxRedirectsGTE applies the GTE predicate on the "max_redirects" field.
+func MaxRedirectsGTE(v int) predicate.Monitor {
+	return predicate.Monitor(sql.FieldGTE(FieldMaxRedirects, v))
+}
+
+// MaxRedirectsLT applies the LT predicate on the "max_redirects" field.
+func MaxRedirectsLT(v int) predicate.Monitor {
+	return predicate.Monitor(sql.FieldLT(FieldMaxRedirects, v))
+}
+
+// MaxRedirectsLTE applies the LTE predicate on the "max_redirects" field.
+func MaxRedirectsLTE(v int) predicate.Monitor {
+	return predicate.Monitor(sql.FieldLTE(FieldMaxRedirects, v))
+}
+
+// MaxRedirectsIsNil applies the IsNil predicate on the "max_redirects" field.
+func MaxRedirectsIsNil() predicate.Monitor {
+	return predicate.Monitor(sql.FieldIsNull(FieldMaxRedirects))
+}
+
+// MaxRedirectsNotNil applies the NotNil predicate on the "max_redirects" field.
+func MaxRedirectsNotNil() predicate.Monitor {
+	return predicate.Monitor(sql.FieldNotNull(FieldMaxRedirects))
+}
+
+// MaxResponseBytesEQ applies the EQ predicate on the "max_response_bytes" field.
+func MaxResponseBytesEQ(v int) predicate.Monitor {
+	return predicate.Monitor(sql.FieldEQ(FieldMaxResponseBytes, v))
+}
+
+// MaxResponseBytesNEQ applies the NEQ predicate on the "max_response_bytes" field.
+func MaxResponseBytesNEQ(v int) predicate.Monitor {
+	return predicate.Monitor(sql.FieldNEQ(FieldMaxResponseBytes, v))
+}
+
+// MaxResponseBytesIn applies the In predicate on the "max_response_bytes" field.
+func MaxResponseBytesIn(vs ...int) predicate.Monitor {
+	return predicate.Monitor(sql.FieldIn(FieldMaxResponseBytes, vs...))
+}
+
+// MaxResponseBytesNotIn applies the NotIn predicate on the "max_response_bytes" field.
+func MaxResponseBytesNotIn(vs ...int) predicate.Monitor {
+	return predicate.Monitor(sql.FieldNotIn(FieldMaxResponseBytes, vs...))
+}
+
+// MaxResponseBytesGT applies the GT predicate on the "max_response_bytes" field.
+func MaxResponseBytesGT(v int) predicate.Monitor {
+	return predicate.Monitor(sql.FieldGT(FieldMaxResponseBytes, v))
+}
+
+// MaxResponseBytesGTE applies the GTE predicate on the "max_response_bytes" field.
+func MaxResponseBytesGTE(v int) predicate.Monitor {
+	return predicate.Monitor(sql.FieldGTE(FieldMaxResponseBytes, v))
+}
+
+// MaxResponseBytesLT applies the LT predicate on the "max_response_bytes" field.
+func MaxResponseBytesLT(v int) predicate.Monitor {
+	return predicate.Monitor(sql.FieldLT(FieldMaxResponseBytes, v))
+}
+
+// MaxResponseBytesLTE applies the LTE predicate on the "max_response_bytes" field.
+func MaxResponseBytesLTE(v int) predicate.Monitor {
+	return predicate.Monitor(sql.FieldLTE(FieldMaxResponseBytes, v))
+}
+
+// MaxResponseBytesIsNil applies the IsNil predicate on the "max_response_bytes" field.
+func MaxResponseBytesIsNil() predicate.Monitor {
+	return predicate.Monitor(sql.FieldIsNull(FieldMaxResponseBytes))
+}
+
+// MaxResponseBytesNotNil applies the NotNil predicate on the "max_response_bytes" field.
+func MaxResponseBytesNotNil() predicate.Monitor {
+	return predicate.Monitor(sql.FieldNotNull(FieldMaxResponseBytes))
+}
+
+// ChecksHistoryLimitEQ applies the EQ predicate on the "checks_history_limit" field.
+func ChecksHistoryLimitEQ(v int) predicate.Monitor {
+	return predicate.Monitor(sql.FieldEQ(FieldChecksHistoryLimit, v))
+}
+
+// ChecksHistoryLimitNEQ applies the NEQ predicate on the "checks_history_limit" field.
+func ChecksHistoryLimitNEQ(v int) predicate.Monitor {
+	return predicate.Monitor(sql.FieldNEQ(FieldChecksHistoryLimit, v))
+}
+
+// ChecksHistoryLimitIn applies the In predicate on the "checks_history_limit" field.
+func ChecksHistoryLimitIn(vs ...int) predicate.Monitor {
+	return predicate.Monitor(sql.FieldIn(FieldChecksHistoryLimit, vs...))
+}
+
+// ChecksHistoryLimitNotIn applies the NotIn predicate on the "checks_history_limit" field.
+func ChecksHistoryLimitNotIn(vs ...int) predicate.Monitor {
+	return predicate.Monitor(sql.FieldNotIn(FieldChecksHistoryLimit, vs...))
+}
+
+// ChecksHistoryLimitGT applies the GT predicate on the "checks_history_limit" field.
+func ChecksHistoryLimitGT(v int) predicate.Monitor {
+	return predicate.Monitor(sql.FieldGT(FieldChecksHistoryLimit, v))
+}
+
+// ChecksHistoryLimitGTE applies the GTE predicate on the "checks_history_limit" field.
+func ChecksHistoryLimitGTE(v int) predicate.Monitor {
+	return predicate.Monitor(sql.FieldGTE(FieldChecksHistoryLimit, v))
+}
+
+// ChecksHistoryLimitLT applies the LT predicate on the "checks_history_limit" field.
+func ChecksHistoryLimitLT(v int) predicate.Monitor {
+	return predicate.Monitor(sql.FieldLT(FieldChecksHistoryLimit, v))
+}
+
+// ChecksHistoryLimitLTE applies the LTE predicate on the "checks_history_limit" field.
+func ChecksHistoryLimitLTE(v int) predicate.Monitor {
+	return predicate.Monitor(sql.FieldLTE(FieldChecksHistoryLimit, v))
+}
+
+// ChecksHistoryLimitIsNil applies the IsNil predicate on the "checks_history_limit" field.
+func ChecksHistoryLimitIsNil() predicate.Monitor {
+	return predicate.Monitor(sql.FieldIsNull(FieldChecksHistoryLimit))
+}
+
+// ChecksHistoryLimitNotNil applies the NotNil predicate on the "checks_history_limit" field.
+func ChecksHistoryLimitNotNil() predicate.Monitor {
+	return predicate.Monitor(sql.FieldNotNull(FieldChecksHistoryLimit))
+}
+
+// CookieJarEQ applies the EQ predicate on the "cookie_jar" field.
+func CookieJarEQ(v bool) predicate.Monitor {
+	return predicate.Monitor(sql.FieldEQ(FieldCookieJar, v))
+}
+
+// CookieJarNEQ applies the NEQ predicate on the "cookie_jar" field.
+func CookieJarNEQ(v bool) predicate.Monitor {
+	return predicate.Monitor(sql.FieldNEQ(FieldCookieJar, v))
+}
+
+// HostOverridesIsNil applies the IsNil predicate on the "host_overrides" field.
+func HostOverridesIsNil() predicate.Monitor {
+	return predicate.Monitor(sql.FieldIsNull(FieldHostOverrides))
+}
+
+// HostOverridesNotNil applies the NotNil predicate on the "host_overrides" field.
+func HostOverridesNotNil() predicate.Monitor {
+	return predicate.Monitor(sql.FieldNotNull(FieldHostOverrides))
+}
+
+// IPFamilyEQ applies the EQ predicate on the "ip_family" field.
+func IPFamilyEQ(v IPFamily) predicate.Monitor {
+	return predicate.Monitor(sql.FieldEQ(FieldIPFamily, v))
+}
+
+// IPFamilyNEQ applies the NEQ predicate on the "ip_family" field.
+func IPFamilyNEQ(v IPFamily) predicate.Monitor {
+	return predicate.Monitor(sql.FieldNEQ(FieldIPFamily, v))
+}
+
+// IPFamilyIn applies the In predicate on the "ip_family" field.
+func IPFamilyIn(vs ...IPFamily) predicate.Monitor {
+	return predicate.Monitor(sql.FieldIn(FieldIPFamily, vs...))
+}
+
+// IPFamilyNotIn applies the NotIn predicate on the "ip_family" field.
+func IPFamilyNotIn(vs ...IPFamily) predicate.Monitor {
+	return predicate.Monitor(sql.FieldNotIn(FieldIPFamily, vs...))
+}
+
+// TLSCaPemEQ applies the EQ predicate on the "tls_ca_pem" field.
+func TLSCaPemEQ(v string) predicate.Monitor {
+	return predicate.Monitor(sql.FieldEQ(FieldTLSCaPem, v))
+}
+
+// TLSCaPemNEQ applies the NEQ predicate on the "tls_ca_pem" field.
+func TLSCaPemNEQ(v string) predicate.Monitor {
+	return predicate.Monitor(sql.FieldNEQ(FieldTLSCaPem, v))
+}
+
+// TLSCaPemIn applies the In predicate on the "tls_ca_pem" field.
+func TLSCaPemIn(vs ...string) predicate.Monitor {
+	return predicate.Monitor(sql.FieldIn(FieldTLSCaPem, vs...))
+}
+
+// TLSCaPemNotIn applies the NotIn predicate on the "tls_ca_pem" field.
+func TLSCaPemNotIn(vs ...string) predicate.Monitor {
+	return predicate.Monitor(sql.FieldNotIn(FieldTLSCaPem, vs...))
+}
+
+// TLSCaPemGT applies the GT predicate on the "tls_ca_pem" field.
+func TLSCaPemGT(v string) predicate.Monitor {
+	return predicate.Monitor(sql.FieldGT(FieldTLSCaPem, v))
+}
+
+// TLSCaPemGTE applies the GTE predicate on the "tls_ca_pem" field.
+func TLSCaPemGTE(v string) predicate.Monitor {
+	return predicate.Monitor(sql.FieldGTE(FieldTLSCaPem, v))
+}
+
+// TLSCaPemLT applies the LT predicate on the "tls_ca_pem" field.
+func TLSCaPemLT(v string) predicate.Monitor {
+	return predicate.Monitor(sql.FieldLT(FieldTLSCaPem, v))
+}
+
+// TLSCaPemLTE applies the LTE predicate on the "tls_ca_pem" field.
+func TLSCaPemLTE(v string) predicate.Monitor {
+	return predicate.Monitor(sql.FieldLTE(FieldTLSCaPem, v))
+}
+
+// TLSCaPemContains applies the Contains predicate on the "tls_ca_pem" field.
+func TLSCaPemContains(v string) predicate.Monitor {
+	return predicate.Monitor(sql.FieldContains(FieldTLSCaPem, v))
+}
+
+// TLSCaPemHasPrefix applies the HasPrefix predicate on the "tls_ca_pem" field.
+func TLSCaPemHasPrefix(v string) predicate.Monitor {
+	return predicate.Monitor(sql.FieldHasPrefix(FieldTLSCaPem, v))
+}
+
+// TLSCaPemHasSuffix applies the HasSuffix predicate on the "tls_ca_pem" field.
+func TLSCaPemHasSuffix(v string) predicate.Monitor {
+	return predicate.Monitor(sql.FieldHasSuffix(FieldTLSCaPem, v))
+}
+
+// TLSCaPemIsNil applies the IsNil predicate on the "tls_ca_pem" field.
+func TLSCaPemIsNil() predicate.Monitor {
+	return predicate.Monitor(sql.FieldIsNull(FieldTLSCaPem))
+}
+
+// TLSCaPemNotNil applies the NotNil predicate on the "tls_ca_pem" field.
+func TLSCaPemNotNil() predicate.Monitor {
+	return predicate.Monitor(sql.FieldNotNull(FieldTLSCaPem))
+}
+
+// TLSCaPemEqualFold applies the EqualFold predicate on the "tls_ca_pem" field.
+func TLSCaPemEqualFold(v string) predicate.Monitor {
+	return predicate.Monitor(sql.FieldEqualFold(FieldTLSCaPem, v))
+}
+
+// TLSCaPemContainsFold applies the ContainsFold predicate on the "tls_ca_pem" field.
+func TLSCaPemContainsFold(v string) predicate.Monitor {
+	return predicate.Monitor(sql.FieldContainsFold(FieldTLSCaPem, v))
+}
+
+// TLSInsecureSkipVerifyEQ applies the EQ predicate on the "tls_insecure_skip_verify" field.
+func TLSInsecureSkipVerifyEQ(v bool) predicate.Monitor {
+	return predicate.Monitor(sql.FieldEQ(FieldTLSInsecureSkipVerify, v))
+}
+
+// TLSInsecureSkipVerifyNEQ applies the NEQ predicate on the "tls_insecure_skip_verify" field.
+func TLSInsecureSkipVerifyNEQ(v bool) predicate.Monitor {
+	return predicate.Monitor(sql.FieldNEQ(FieldTLSInsecureSkipVerify, v))
+}
+
+// TLSMinVersionEQ applies the EQ predicate on the "tls_min_version" field.
+func TLSMinVersionEQ(v string) predicate.Monitor {
+	return predicate.Monitor(sql.FieldEQ(FieldTLSMinVersion, v))
+}
+
+// TLSMinVersionNEQ applies the NEQ predicate on the "tls_min_version" field.
+func TLSMinVersionNEQ(v string) predicate.Monitor {
+	return predicate.Monitor(sql.FieldNEQ(FieldTLSMinVersion, v))
+}
+
+// TLSMinVersionIn applies the In predicate on the "tls_min_version" field.
+func TLSMinVersionIn(vs ...string) predicate.Monitor {
+	return predicate.Monitor(sql.FieldIn(FieldTLSMinVersion, vs...))
+}
+
+// TLSMinVersionNotIn applies the NotIn predicate on the "tls_min_version" field.
+func TLSMinVersionNotIn(vs ...string) predicate.Monitor {
+	return predicate.Monitor(sql.FieldNotIn(FieldTLSMinVersion, vs...))
+}
+
+// TLSMinVersionGT applies the GT predicate on the "tls_min_version" field.
+func TLSMinVersionGT(v string) predicate.Monitor {
+	return predicate.Monitor(sql.FieldGT(FieldTLSMinVersion, v))
+}
+
+// TLSMinVersionGTE applies the GTE predicate on the "tls_min_version" field.
+func TLSMinVersionGTE(v string) predicate.Monitor {
+	return predicate.Monitor(sql.FieldGTE(FieldTLSMinVersion, v))
+}
+
+// TLSMinVersionLT applies the LT predicate on the "tls_min_version" field.
+func TLSMinVersionLT(v string) predicate.Monitor {
+	return predicate.Monitor(sql.FieldLT(FieldTLSMinVersion, v))
+}
+
+// TLSMinVersionLTE applies the LTE predicate on the "tls_min_version" field.
+func TLSMinVersionLTE(v string) predicate.Monitor {
+	return predicate.Monitor(sql.FieldLTE(FieldTLSMinVersion, v))
+}
+
+// TLSMinVersionContains applies the Contains predicate on the "tls_min_version" field.
+func TLSMinVersionContains(v string) predicate.Monitor {
+	return predicate.Monitor(sql.FieldContains(FieldTLSMinVersion, v))
+}
+
+// TLSMinVersionHasPrefix applies the HasPrefix predicate on the "tls_min_version" field.
+func TLSMinVersionHasPrefix(v string) predicate.Monitor {
+	return predicate.Monitor(sql.FieldHasPrefix(FieldTLSMinVersion, v))
+}
+
+// TLSMinVersionHasSuffix applies the HasSuffix predicate on the "tls_min_version" field.
+func TLSMinVersionHasSuffix(v string) predicate.Monitor {
+	return predicate.Monitor(sql.FieldHasSuffix(FieldTLSMinVersion, v))
+}
+
+// TLSMinVersionIsNil applies the IsNil predicate on the "tls_min_version" field.
+func TLSMinVersionIsNil() predicate.Monitor {
+	return predicate.Monitor(sql.FieldIsNull(FieldTLSMinVersion))
+}
+
+// TLSMinVersionNotNil applies the NotNil predicate on the "tls_min_version" field.
+func TLSMinVersionNotNil() predicate.Monitor {
+	return predicate.Monitor(sql.FieldNotNull(FieldTLSMinVersion))
+}
+
+// TLSMinVersionEqualFold applies the EqualFold predicate on the "tls_min_version" field.
+func TLSMinVersionEqualFold(v string) predicate.Monitor {
+	return predicate.Monitor(sql.FieldEqualFold(FieldTLSMinVersion, v))
+}
+
+// TLSMinVersionContainsFold applies the ContainsFold predicate on the "tls_min_version" field.
+func TLSMinVersionContainsFold(v string) predicate.Monitor {
+	return predicate.Monitor(sql.FieldContainsFold(FieldTLSMinVersion, v))
+}
+
+// TLSServerNameEQ applies the EQ predicate on the "tls_server_name" field.
+func TLSServerNameEQ(v string) predicate.Monitor {
+	return predicate.Monitor(sql.FieldEQ(FieldTLSServerName, v))
+}
+
+// TLSServerNameNEQ applies the NEQ predicate on the "tls_server_name" field.
+func TLSServerNameNEQ(v string) predicate.Monitor {
+	return predicate.Monitor(sql.FieldNEQ(FieldTLSServerName, v))
+}
+
+// TLSServerNameIn applies the In predicate on the "tls_server_name" field.
+func TLSServerNameIn(vs ...string) predicate.Monitor {
+	return predicate.Monitor(sql.FieldIn(FieldTLSServerName, vs...))
+}
+
+// TLSServerNameNotIn applies the NotIn predicate on the "tls_server_name" field.
+func TLSServerNameNotIn(vs ...string) predicate.Monitor {
+	return predicate.Monitor(sql.FieldNotIn(FieldTLSServerName, vs...))
+}
+
+// TLSServerNameGT applies the GT predicate on the "tls_server_name" field.
+func TLSServerNameGT(v string) predicate.Monitor {
+	return predicate.Monitor(sql.FieldGT(FieldTLSServerName, v))
+}
+
+// TLSServerNameGTE applies the GTE predicate on the "tls_server_name" field.
+func TLSServerNameGTE(v string) predicate.Monitor {
+	return predicate.Monitor(sql.FieldGTE(FieldTLSServerName, v))
+}
+
+// TLSServerNameLT applies the LT predicate on the "tls_server_name" field.
+func TLSServerNameLT(v string) predicate.Monitor {
+	return predicate.Monitor(sql.FieldLT(FieldTLSServerName, v))
+}
+
+// TLSServerNameLTE applies the LTE predicate on the "tls_server_name" field.
+func TLSServerNameLTE(v string) predicate.Monitor {
+	return predicate.Monitor(sql.FieldLTE(FieldTLSServerName, v))
+}
+
+// TLSServerNameContains applies the Contains predicate on the "tls_server_name" field.
+func TLSServerNameContains(v string) predicate.Monitor {
+	return predicate.Monitor(sql.FieldContains(FieldTLSServerName, v))
+}
+
+// TLSServerNameHasPrefix applies the HasPrefix predicate on the "tls_server_name" field.
+func TLSServerNameHasPrefix(v string) predicate.Monitor {
+	return predicate.Monitor(sql.FieldHasPrefix(FieldTLSServerName, v))
+}
+
+// TLSServerNameHasSuffix applies the HasSuffix predicate on the "tls_server_name" field.
+func TLSServerNameHasSuffix(v string) predicate.Monitor {
+	return predicate.Monitor(sql.FieldHasSuffix(FieldTLSServerName, v))
+}
+
+// TLSServerNameIsNil applies the IsNil predicate on the "tls_server_name" field.
+func TLSServerNameIsNil() predicate.Monitor {
+	return predicate.Monitor(sql.FieldIsNull(FieldTLSServerName))
+}
+
+// TLSServerNameNotNil applies the NotNil predicate on the "tls_server_name" field.
+func TLSServerNameNotNil() predicate.Monitor {
+	return predicate.Monitor(sql.FieldNotNull(FieldTLSServerName))
+}
+
+// TLSServerNameEqualFold applies the EqualFold predicate on the "tls_server_name" field.
+func TLSServerNameEqualFold(v string) predicate.Monitor {
+	return predicate.Monitor(sql.FieldEqualFold(FieldTLSServerName, v))
+}
+
+// TLSServerNameContainsFold applies the ContainsFold predicate on the "tls_server_name" field.
+func TLSServerNameContainsFold(v string) predicate.Monitor {
+	return predicate.Monitor(sql.FieldContainsFold(FieldTLSServerName, v))
+}
+
+// CronEQ applies the EQ predicate on the "cron" field.
+func CronEQ(v string) predicate.Monitor {
+	return predicate.Monitor(sql.FieldEQ(FieldCron, v))
+}
+
+// CronNEQ applies the NEQ predicate on the "cron" field.
+func CronNEQ(v string) predicate.Monitor {
+	return predicate.Monitor(sql.FieldNEQ(FieldCron, v))
+}
+
+// CronIn applies the In predicate on the "cron" field.
+func CronIn(vs ...string) predicate.Monitor {
+	return predicate.Monitor(sql.FieldIn(FieldCron, vs...))
+}
+
+// CronNotIn applies the NotIn predicate on the "cron" field.
+func CronNotIn(vs ...string) predicate.Monitor {
+	return predicate.Monitor(sql.FieldNotIn(FieldCron, vs...))
+}
+
+// CronGT applies the GT predicate on the "cron" field.
+func CronGT(v string) predicate.Monitor {
+	return predicate.Monitor(sql.FieldGT(FieldCron, v))
+}
+
+// CronGTE applies the GTE predicate on the "cron" field.
+func CronGTE(v string) predicate.Monitor {
+	return predicate.Monitor(sql.FieldGTE(FieldCron, v))
+}
+
+// CronLT applies the LT predicate on the "cron" field.
+func CronLT(v string) predicate.Monitor {
+	return predicate.Monitor(sql.FieldLT(FieldCron, v))
+}
+
+// CronLTE applies the LTE predicate on the "cron" field.
+func CronLTE(v string) predicate.Monitor {
+	return predicate.Monitor(sql.FieldLTE(FieldCron, v))
+}
+
+// CronContains applies the Contains predicate on the "cron" field.
+func CronContains(v string) predicate.Monitor {
+	return predicate.Monitor(sql.FieldContains(FieldCron, v))
+}
+
+// CronHasPrefix applies the HasPrefix predicate on the "cron" field.
+func CronHasPrefix(v string) predicate.Monitor {
+	return predicate.Monitor(sql.FieldHasPrefix(FieldCron, v))
+}
+
+// CronHasSuffix applies the HasSuffix predicate on the "cron" field.
+func CronHasSuffix(v string) predicate.Monitor {
+	return predicate.Monitor(sql.FieldHasSuffix(FieldCron, v))
+}
+
+// CronEqualFold applies the EqualFold predicate on the "cron" field.
+func CronEqualFold(v string) predicate.Monitor {
+	return predicate.Monitor(sql.FieldEqualFold(FieldCron, v))
+}
+
+// CronContainsFold applies the ContainsFold predicate on the "cron" field.
+func CronContainsFold(v string) predicate.Monitor {
+	return predicate.Monitor(sql.FieldContainsFold(FieldCron, v))
+}
+
+// TimezoneEQ applies the EQ predicate on the "timezone" field.
+func TimezoneEQ(v string) predicate.Monitor {
+	return predicate.Monitor(sql.FieldEQ(FieldTimezone, v))
+}
+
+// TimezoneNEQ applies the NEQ predicate on the "timezone" field.
+func TimezoneNEQ(v string) predicate.Monitor {
+	return predicate.Monitor(sql.FieldNEQ(FieldTimezone, v))
+}
+
+// TimezoneIn applies the In predicate on the "timezone" field.
+func TimezoneIn(vs ...string) predicate.Monitor {
+	return predicate.Monitor(sql.FieldIn(FieldTimezone, vs...))
+}
+
+// TimezoneNotIn applies the NotIn predicate on the "timezone" field.
+func TimezoneNotIn(vs ...string) predicate.Monitor {
+	return predicate.Monitor(sql.FieldNotIn(FieldTimezone, vs...))
+}
+
+// TimezoneGT applies the GT predicate on the "timezone" field.
+func TimezoneGT(v string) predicate.Monitor {
+	return predicate.Monitor(sql.FieldGT(FieldTimezone, v))
+}
+
+// TimezoneGTE applies the GTE predicate on the "timezone" field.
+func TimezoneGTE(v string) predicate.Monitor {
+	return predicate.Monitor(sql.FieldGTE(FieldTimezone, v))
+}
+
+// TimezoneLT applies the LT predicate on the "timezone" field.
+func TimezoneLT(v string) predicate.Monitor {
+	return predicate.Monitor(sql.FieldLT(FieldTimezone, v))
+}
+
+// TimezoneLTE applies the LTE predicate on the "timezone" field.
+func TimezoneLTE(v string) predicate.Monitor {
+	return predicate.Monitor(sql.FieldLTE(FieldTimezone, v))
+}
+
+// TimezoneContains applies the Contains predicate on the "timezone" field.
+func TimezoneContains(v string) predicate.Monitor {
+	return predicate.Monitor(sql.FieldContains(FieldTimezone, v))
+}
+
+// TimezoneHasPrefix applies the HasPrefix predicate on the "timezone" field.
+func TimezoneHasPrefix(v string) predicate.Monitor {
+	return predicate.Monitor(sql.FieldHasPrefix(FieldTimezone, v))
+}
+
+// TimezoneHasSuffix applies the HasSuffix predicate on the "timezone" field.
+func TimezoneHasSuffix(v string) predicate.Monitor {
+	return predicate.Monitor(sql.FieldHasSuffix(FieldTimezone, v))
+}
+
+// TimezoneIsNil applies the IsNil predicate on the "timezone" field.
+func TimezoneIsNil() predicate.Monitor {
+	return predicate.Monitor(sql.FieldIsNull(FieldTimezone))
+}
+
+// TimezoneNotNil applies the NotNil predicate on the "timezone" field.
+func TimezoneNotNil() predicate.Monitor {
+	return predicate.Monitor(sql.FieldNotNull(FieldTimezone))
+}
+
+// TimezoneEqualFold applies the EqualFold predicate on the "timezone" field.
+func TimezoneEqualFold(v string) predicate.Monitor {
+	return predicate.Monitor(sql.FieldEqualFold(FieldTimezone, v))
+}
+
+// TimezoneContainsFold applies the ContainsFold predicate on the "timezone" field.
+func TimezoneContainsFold(v string) predicate.Monitor {
+	return predicate.Monitor(sql.FieldContainsFold(FieldTimezone, v))
+}
+
+// JitterSecondsEQ applies the EQ predicate on the "jitter_seconds" field.
+func JitterSecondsEQ(v int) predicate.Monitor {
+	return predicate.Monitor(sql.FieldEQ(FieldJitterSeconds, v))
+}
+
+// JitterSecondsNEQ applies the NEQ predicate on the "jitter_seconds" field.
+func JitterSecondsNEQ(v int) predicate.Monitor {
+	return predicate.Monitor(sql.FieldNEQ(FieldJitterSeconds, v))
+}
+
+// JitterSecondsIn applies the In predicate on the "jitter_seconds" field.
+func JitterSecondsIn(vs ...int) predicate.Monitor {
+	return predicate.Monitor(sql.FieldIn(FieldJitterSeconds, vs...))
+}
+
+// JitterSecondsNotIn applies the NotIn predicate on the "jitter_seconds" field.
+func JitterSecondsNotIn(vs ...int) predicate.Monitor {
+	return predicate.Monitor(sql.FieldNotIn(FieldJitterSeconds, vs...))
+}
+
+// JitterSecondsGT applies the GT predicate on the "jitter_seconds" field.
+func JitterSecondsGT(v int) predicate.Monitor {
+	return predicate.Monitor(sql.FieldGT(FieldJitterSeconds, v))
+}
+
+// JitterSecondsGTE applies the GTE predicate on the "jitter_seconds" field.
+func JitterSecondsGTE(v int) predicate.Monitor {
+	return predicate.Monitor(sql.FieldGTE(FieldJitterSeconds, v))
+}
+
+// JitterSecondsLT applies the LT predicate on the "jitter_seconds" field.
+func JitterSecondsLT(v int) predicate.Monitor {
+	return predicate.Monitor(sql.FieldLT(FieldJitterSeconds, v))
+}
+
+// JitterSecondsLTE applies the LTE predicate on the "jitter_seconds" field.
+func JitterSecondsLTE(v int) predicate.Monitor {
+	return predicate.Monitor(sql.FieldLTE(FieldJitterSeconds, v))
+}
+
+// JitterSecondsIsNil applies the IsNil predicate on the "jitter_seconds" field.
+func JitterSecondsIsNil() predicate.Monitor {
+	return predicate.Monitor(sql.FieldIsNull(FieldJitterSeconds))
+}
+
+// JitterSecondsNotNil applies the NotNil predicate on the "jitter_seconds" field.
+func JitterSecondsNotNil() predicate.Monitor {
+	return predicate.Monitor(sql.FieldNotNull(FieldJitterSeconds))
+}
+
+// DstPolicyEQ applies the EQ predicate on the "dst_policy" field.
+func DstPolicyEQ(v DstPolicy) predicate.Monitor {
+	return predicate.Monitor(sql.FieldEQ(FieldDstPolicy, v))
+}
+
+// DstPolicyNEQ applies the NEQ predicate on the "dst_policy" field.
+func DstPolicyNEQ(v DstPolicy) predicate.Monitor {
+	return predicate.Monitor(sql.FieldNEQ(FieldDstPolicy, v))
+}
+
+// DstPolicyIn applies the In predicate on the "dst_policy" field.
+func DstPolicyIn(vs ...DstPolicy) predicate.Monitor {
+	return predicate.Monitor(sql.FieldIn(FieldDstPolicy, vs...))
+}
+
+// DstPolicyNotIn applies the NotIn predicate on the "dst_policy" field.
+func DstPolicyNotIn(vs ...DstPolicy) predicate.Monitor {
+	return predicate.Monitor(sql.FieldNotIn(FieldDstPolicy, vs...))
+}
+
+// BodySnapshotEQ applies the EQ predicate on the "body_snapshot" field.
+func BodySnapshotEQ(v BodySnapshot) predicate.Monitor {
+	return predicate.Monitor(sql.FieldEQ(FieldBodySnapshot, v))
+}
+
+// BodySnapshotNEQ applies the NEQ predicate on the "body_snapshot" field.
+func BodySnapshotNEQ(v BodySnapshot) predicate.Monitor {
+	return predicate.Monitor(sql.FieldNEQ(FieldBodySnapshot, v))
+}
+
+// BodySnapshotIn applies the In predicate on the "body_snapshot" field.
+func BodySnapshotIn(vs ...BodySnapshot) predicate.Monitor {
+	return predicate.Monitor(sql.FieldIn(FieldBodySnapshot, vs...))
+}
+
+// BodySnapshotNotIn applies the NotIn predicate on the "body_snapshot" field.
+func BodySnapshotNotIn(vs ...BodySnapshot) predicate.Monitor {
+	return predicate.Monitor(sql.FieldNotIn(FieldBodySnapshot, vs...))
+}
+
+// ContentHashEQ applies the EQ predicate on the "content_hash" field.
+func ContentHashEQ(v ContentHash) predicate.Monitor {
+	return predicate.Monitor(sql.FieldEQ(FieldContentHash, v))
+}
+
+// ContentHashNEQ applies the NEQ predicate on the "content_hash" field.
+func ContentHashNEQ(v ContentHash) predicate.Monitor {
+	return predicate.Monitor(sql.FieldNEQ(FieldContentHash, v))
+}
+
+// ContentHashIn applies the In predicate on the "content_hash" field.
+func ContentHashIn(vs ...ContentHash) predicate.Monitor {
+	return predicate.Monitor(sql.FieldIn(FieldContentHash, vs...))
+}
+
+// ContentHashNotIn applies the NotIn predicate on the "content_hash" field.
+func ContentHashNotIn(vs ...ContentHash) predicate.Monitor {
+	return predicate.Monitor(sql.FieldNotIn(FieldContentHash, vs...))
+}
+
+// FetchModeEQ applies the EQ predicate on the "fetch_mode" field.
+func FetchModeEQ(v FetchMode) predicate.Monitor {
+	return predicate.Monitor(sql.FieldEQ(FieldFetchMode, v))
+}
+
+// FetchModeNEQ applies the NEQ predicate on the "fetch_mode" field.
+func FetchModeNEQ(v FetchMode) predicate.Monitor {
+	return predicate.Monitor(sql.FieldNEQ(FieldFetchMode, v))
+}
+
+// FetchModeIn applies the In predicate on the "fetch_mode" field.
+func FetchModeIn(vs ...FetchMode) predicate.Monitor {
+	return predicate.Monitor(sql.FieldIn(FieldFetchMode, vs...))
+}
+
+// FetchModeNotIn applies the NotIn predicate on the "fetch_mode" field.
+func FetchModeNotIn(vs ...FetchMode) predicate.Monitor {
+	return predicate.Monitor(sql.FieldNotIn(FieldFetchMode, vs...))
+}
+
+// HeartbeatTokenEQ applies the EQ predicate on the "heartbeat_token" field.
+func HeartbeatTokenEQ(v string) predicate.Monitor {
+	return predicate.Monitor(sql.FieldEQ(FieldHeartbeatToken, v))
+}
+
+// HeartbeatTokenNEQ applies the NEQ predicate on the "heartbeat_token" field.
+func HeartbeatTokenNEQ(v string) predicate.Monitor {
+	return predicate.Monitor(sql.FieldNEQ(FieldHeartbeatToken, v))
+}
+
+// HeartbeatTokenIn applies the In predicate on the "heartbeat_token" field.
+func HeartbeatTokenIn(vs ...string) predicate.Monitor {
+	return predicate.Monitor(sql.FieldIn(FieldHeartbeatToken, vs...))
+}
+
+// HeartbeatTokenNotIn applies the NotIn predicate on the "heartbeat_token" field.
+func HeartbeatTokenNotIn(vs ...string) predicate.Monitor {
+	return predicate.Monitor(sql.FieldNotIn(FieldHeartbeatToken, vs...))
+}
+
+// HeartbeatTokenGT applies the GT predicate on the "heartbeat_token" field.
+func HeartbeatTokenGT(v string) predicate.Monitor {
+	return predicate.Monitor(sql.FieldGT(FieldHeartbeatToken, v))
+}
+
+// HeartbeatTokenGTE applies the GTE predicate on the "heartbeat_token" field.
+func HeartbeatTokenGTE(v string) predicate.Monitor {
+	return predicate.Monitor(sql.FieldGTE(FieldHeartbeatToken, v))
+}
+
+// HeartbeatTokenLT applies the LT predicate on the "heartbeat_token" field.
+func HeartbeatTokenLT(v string) predicate.Monitor {
+	return predicate.Monitor(sql.FieldLT(FieldHeartbeatToken, v))
+}
+
+// HeartbeatTokenLTE applies the LTE predicate on the "heartbeat_token" field.
+func HeartbeatTokenLTE(v string) predicate.Monitor {
+	return predicate.Monitor(sql.FieldLTE(FieldHeartbeatToken, v))
+}
+
+// HeartbeatTokenContains applies the Contains predicate on the "heartbeat_token" field.
+func HeartbeatTokenContains(v string) predicate.Monitor {
+	return predicate.Monitor(sql.FieldContains(FieldHeartbeatToken, v))
+}
+
+// HeartbeatTokenHasPrefix applies the HasPrefix predicate on the "heartbeat_token" field.
+func HeartbeatTokenHasPrefix(v string) predicate.Monitor {
+	return predicate.Monitor(sql.FieldHasPrefix(FieldHeartbeatToken, v))
+}
+
+// HeartbeatTokenHasSuffix applies the HasSuffix predicate on the "heartbeat_token" field.
+func HeartbeatTokenHasSuffix(v string) predicate.Monitor {
+	return predicate.Monitor(sql.FieldHasSuffix(FieldHeartbeatToken, v))
+}
+
+// HeartbeatTokenIsNil applies the IsNil predicate on the "heartbeat_token" field.
+func HeartbeatTokenIsNil() predicate.Monitor {
+	return predicate.Monitor(sql.FieldIsNull(FieldHeartbeatToken))
+}
+
+// HeartbeatTokenNotNil applies the NotNil predicate on the "heartbeat_token" field.
+func HeartbeatTokenNotNil() predicate.Monitor {
+	return predicate.Monitor(sql.FieldNotNull(FieldHeartbeatToken))
+}
+
+// HeartbeatTokenEqualFold applies the EqualFold predicate on the "heartbeat_token" field.
+func HeartbeatTokenEqualFold(v string) predicate.Monitor {
+	return predicate.Monitor(sql.FieldEqualFold(FieldHeartbeatToken, v))
+}
+
+// HeartbeatTokenContainsFold applies the ContainsFold predicate on the "heartbeat_token" field.
+func HeartbeatTokenContainsFold(v string) predicate.Monitor {
+	return predicate.Monitor(sql.FieldContainsFold(FieldHeartbeatToken, v))
+}
+
+// EnabledEQ applies the EQ predicate on the "enabled" field.
+func EnabledEQ(v bool) predicate.Monitor {
+	return predicate.Monitor(sql.FieldEQ(FieldEnabled, v))
+}
+
+// EnabledNEQ applies the NEQ predicate on the "enabled" field.
+func EnabledNEQ(v bool) predicate.Monitor {
+	return predicate.Monitor(sql.FieldNEQ(FieldEnabled, v))
+}
+
+// StatusPageEQ applies the EQ predicate on the "status_page" field.
+func StatusPageEQ(v bool) predicate.Monitor {
+	return predicate.Monitor(sql.FieldEQ(FieldStatusPage, v))
+}
+
+// StatusPageNEQ applies the NEQ predicate on the "status_page" field.
+func StatusPageNEQ(v bool) predicate.Monitor {
+	return predicate.Monitor(sql.FieldNEQ(FieldStatusPage, v))
+}
+
+// SortIndexEQ applies the EQ predicate on the "sort_index" field.
+func SortIndexEQ(v int) predicate.Monitor {
+	return predicate.Monitor(sql.FieldEQ(FieldSortIndex, v))
+}
+
+// SortIndexNEQ applies the NEQ predicate on the "sort_index" field.
+func SortIndexNEQ(v int) predicate.Monitor {
+	return predicate.Monitor(sql.FieldNEQ(FieldSortIndex, v))
+}
+
+// SortIndexIn applies the In predicate on the "sort_index" field.
+func SortIndexIn(vs ...int) predicate.Monitor {
+	return predicate.Monitor(sql.FieldIn(FieldSortIndex, vs...))
+}
+
+// SortIndexNotIn applies the NotIn predicate on the "sort_index" field.
+func SortIndexNotIn(vs ...int) predicate.Monitor {
+	return predicate.Monitor(sql.FieldNotIn(FieldSortIndex, vs...))
+}
+
+// SortIndexGT applies the GT predicate on the "sort_index" field.
+func SortIndexGT(v int) predicate.Monitor {
+	return predicate.Monitor(sql.FieldGT(FieldSortIndex, v))
+}
+
+// SortIndexGTE applies the GTE predicate on the "sort_index" field.
+func SortIndexGTE(v int) predicate.Monitor {
+	return predicate.Monitor(sql.FieldGTE(FieldSortIndex, v))
+}
+
+// SortIndexLT applies the LT predicate on the "sort_index" field.
+func SortIndexLT(v int) predicate.Monitor {
+	return predicate.Monitor(sql.FieldLT(FieldSortIndex, v))
+}
+
+// SortIndexLTE applies the LTE predicate on the "sort_index" field.
+func SortIndexLTE(v int) predicate.Monitor {
+	return predicate.Monitor(sql.FieldLTE(FieldSortIndex, v))
+}
+
+// ArchivedAtEQ applies the EQ predicate on the "archived_at" field.
+func ArchivedAtEQ(v time.Time) predicate.Monitor {
+	return predicate.Monitor(sql.FieldEQ(FieldArchivedAt, v))
+}
+
+// ArchivedAtNEQ applies the NEQ predicate on the "archived_at" field.
+func ArchivedAtNEQ(v time.Time) predicate.Monitor {
+	return predicate.Monitor(sql.FieldNEQ(FieldArchivedAt, v))
+}
+
+// ArchivedAtIn applies the In predicate on the "archived_at" field.
+func ArchivedAtIn(vs ...time.Time) predicate.Monitor {
+	return predicate.Monitor(sql.FieldIn(FieldArchivedAt, vs...))
+}
+
+// ArchivedAtNotIn applies the NotIn predicate on the "archived_at" field.
+func ArchivedAtNotIn(vs ...time.Time) predicate.Monitor {
+	return predicate.Monitor(sql.FieldNotIn(FieldArchivedAt, vs...))
+}
+
+// ArchivedAtGT applies the GT predicate on the "archived_at" field.
+func ArchivedAtGT(v time.Time) predicate.Monitor {
+	return predicate.Monitor(sql.FieldGT(FieldArchivedAt, v))
+}
+
+// ArchivedAtGTE applies the GTE predicate on the "archived_at" field.
+func ArchivedAtGTE(v time.Time) predicate.Monitor {
+	return predicate.Monitor(sql.FieldGTE(FieldArchivedAt, v))
+}
+
+// ArchivedAtLT applies the LT predicate on the "archived_at" field.
+func ArchivedAtLT(v time.Time) predicate.Monitor {
+	return predicate.Monitor(sql.FieldLT(FieldArchivedAt, v))
+}
+
+// ArchivedAtLTE applies the LTE predicate on the "archived_at" field.
+func ArchivedAtLTE(v time.Time) predicate.Monitor {
+	return predicate.Monitor(sql.FieldLTE(FieldArchivedAt, v))
+}
+
+// ArchivedAtIsNil applies the IsNil predicate on the "archived_at" field.
+func ArchivedAtIsNil() predicate.Monitor {
+	return predicate.Monitor(sql.FieldIsNull(FieldArchivedAt))
+}
+
+// ArchivedAtNotNil applies the NotNil predicate on the "archived_at" field.
+func ArchivedAtNotNil() predicate.Monitor {
+	return predicate.Monitor(sql.FieldNotNull(FieldArchivedAt))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.Monitor {
+	return predicate.Monitor(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.Monitor {
+	return predicate.Monitor(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.Monitor {
+	return predicate.Monitor(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.Monitor {
+	return predicate.Monitor(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.Monitor {
+	return predicate.Monitor(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.Monitor {
+	return predicate.Monitor(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.Monitor {
+	return predicate.Monitor(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.Monitor {
+	return predicate.Monitor(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.Monitor {
+	return predicate.Monitor(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.Monitor {
+	return predicate.Monitor(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.Monitor {
+	return predicate.Monitor(sql.FieldIn(FieldUpdatedAt, vs...))
 }
 
 // UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
@@ -884,6 +2379,75 @@ func HasRuntimeWith(preds ...predicate.MonitorRuntime) predicate.Monitor {
 	})
 }
 
+// HasVersions applies the HasEdge predicate on the "versions" edge.
+func HasVersions() predicate.Monitor {
+	return predicate.Monitor(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, VersionsTable, VersionsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasVersionsWith applies the HasEdge predicate on the "versions" edge with a given conditions (other predicates).
+func HasVersionsWith(preds ...predicate.MonitorVersion) predicate.Monitor {
+	return predicate.Monitor(func(s *sql.Selector) {
+		step := newVersionsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasRollups applies the HasEdge predicate on the "rollups" edge.
+func HasRollups() predicate.Monitor {
+	return predicate.Monitor(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, RollupsTable, RollupsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasRollupsWith applies the HasEdge predicate on the "rollups" edge with a given conditions (other predicates).
+func HasRollupsWith(preds ...predicate.CheckRollup) predicate.Monitor {
+	return predicate.Monitor(func(s *sql.Selector) {
+		step := newRollupsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasHeaderProfile applies the HasEdge predicate on the "header_profile" edge.
+func HasHeaderProfile() predicate.Monitor {
+	return predicate.Monitor(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, HeaderProfileTable, HeaderProfileColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasHeaderProfileWith applies the HasEdge predicate on the "header_profile" edge with a given conditions (other predicates).
+func HasHeaderProfileWith(preds ...predicate.HeaderProfile) predicate.Monitor {
+	return predicate.Monitor(func(s *sql.Selector) {
+		step := newHeaderProfileStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Monitor) predicate.Monitor {
 	return predicate.Monitor(sql.AndPredicates(predicates...))
@@ -7,8 +7,11 @@ import (
 	"errors"
 	"fmt"
 	"goanna/apps/api/ent/checkresult"
+	"goanna/apps/api/ent/checkrollup"
+	"goanna/apps/api/ent/headerprofile"
 	"goanna/apps/api/ent/monitor"
 	"goanna/apps/api/ent/monitorruntime"
+	"goanna/apps/api/ent/monitorversion"
 	"goanna/apps/api/ent/notificationevent"
 	"time"
 
@@ -91,6 +94,34 @@ func (_c *MonitorCreate) SetHeaders(v map[string]string) *MonitorCreate {
 	return _c
 }
 
+// SetUserAgent sets the "user_agent" field.
+func (_c *MonitorCreate) SetUserAgent(v string) *MonitorCreate {
+	_c.mutation.SetUserAgent(v)
+	return _c
+}
+
+// SetNillableUserAgent sets the "user_agent" field if the given value is not nil.
+func (_c *MonitorCreate) SetNillableUserAgent(v *string) *MonitorCreate {
+	if v != nil {
+		_c.SetUserAgent(*v)
+	}
+	return _c
+}
+
+// SetHeaderProfileID sets the "header_profile_id" field.
+func (_c *MonitorCreate) SetHeaderProfileID(v int) *MonitorCreate {
+	_c.mutation.SetHeaderProfileID(v)
+	return _c
+}
+
+// SetNillableHeaderProfileID sets the "header_profile_id" field if the given value is not nil.
+func (_c *MonitorCreate) SetNillableHeaderProfileID(v *int) *MonitorCreate {
+	if v != nil {
+		_c.SetHeaderProfileID(*v)
+	}
+	return _c
+}
+
 // SetAuth sets the "auth" field.
 func (_c *MonitorCreate) SetAuth(v map[string]string) *MonitorCreate {
 	_c.mutation.SetAuth(v)
@@ -103,6 +134,32 @@ func (_c *MonitorCreate) SetNotificationChannels(v []string) *MonitorCreate {
 	return _c
 }
 
+// SetEscalationChannels sets the "escalation_channels" field.
+func (_c *MonitorCreate) SetEscalationChannels(v []string) *MonitorCreate {
+	_c.mutation.SetEscalationChannels(v)
+	return _c
+}
+
+// SetTags sets the "tags" field.
+func (_c *MonitorCreate) SetTags(v []string) *MonitorCreate {
+	_c.mutation.SetTags(v)
+	return _c
+}
+
+// SetEscalationAfterMinutes sets the "escalation_after_minutes" field.
+func (_c *MonitorCreate) SetEscalationAfterMinutes(v int) *MonitorCreate {
+	_c.mutation.SetEscalationAfterMinutes(v)
+	return _c
+}
+
+// SetNillableEscalationAfterMinutes sets the "escalation_after_minutes" field if the given value is not nil.
+func (_c *MonitorCreate) SetNillableEscalationAfterMinutes(v *int) *MonitorCreate {
+	if v != nil {
+		_c.SetEscalationAfterMinutes(*v)
+	}
+	return _c
+}
+
 // SetSelector sets the "selector" field.
 func (_c *MonitorCreate) SetSelector(v string) *MonitorCreate {
 	_c.mutation.SetSelector(v)
@@ -117,6 +174,34 @@ func (_c *MonitorCreate) SetNillableSelector(v *string) *MonitorCreate {
 	return _c
 }
 
+// SetExpectedStatus sets the "expected_status" field.
+func (_c *MonitorCreate) SetExpectedStatus(v string) *MonitorCreate {
+	_c.mutation.SetExpectedStatus(v)
+	return _c
+}
+
+// SetNillableExpectedStatus sets the "expected_status" field if the given value is not nil.
+func (_c *MonitorCreate) SetNillableExpectedStatus(v *string) *MonitorCreate {
+	if v != nil {
+		_c.SetExpectedStatus(*v)
+	}
+	return _c
+}
+
+// SetRetryOn sets the "retry_on" field.
+func (_c *MonitorCreate) SetRetryOn(v string) *MonitorCreate {
+	_c.mutation.SetRetryOn(v)
+	return _c
+}
+
+// SetNillableRetryOn sets the "retry_on" field if the given value is not nil.
+func (_c *MonitorCreate) SetNillableRetryOn(v *string) *MonitorCreate {
+	if v != nil {
+		_c.SetRetryOn(*v)
+	}
+	return _c
+}
+
 // SetExpectedType sets the "expected_type" field.
 func (_c *MonitorCreate) SetExpectedType(v monitor.ExpectedType) *MonitorCreate {
 	_c.mutation.SetExpectedType(v)
@@ -145,12 +230,344 @@ func (_c *MonitorCreate) SetNillableExpectedResponse(v *string) *MonitorCreate {
 	return _c
 }
 
+// SetMustContain sets the "must_contain" field.
+func (_c *MonitorCreate) SetMustContain(v []string) *MonitorCreate {
+	_c.mutation.SetMustContain(v)
+	return _c
+}
+
+// SetMustNotContain sets the "must_not_contain" field.
+func (_c *MonitorCreate) SetMustNotContain(v []string) *MonitorCreate {
+	_c.mutation.SetMustNotContain(v)
+	return _c
+}
+
+// SetTreatNotFoundAsSuccess sets the "treat_not_found_as_success" field.
+func (_c *MonitorCreate) SetTreatNotFoundAsSuccess(v bool) *MonitorCreate {
+	_c.mutation.SetTreatNotFoundAsSuccess(v)
+	return _c
+}
+
+// SetNillableTreatNotFoundAsSuccess sets the "treat_not_found_as_success" field if the given value is not nil.
+func (_c *MonitorCreate) SetNillableTreatNotFoundAsSuccess(v *bool) *MonitorCreate {
+	if v != nil {
+		_c.SetTreatNotFoundAsSuccess(*v)
+	}
+	return _c
+}
+
+// SetAcceptEmptyBody sets the "accept_empty_body" field.
+func (_c *MonitorCreate) SetAcceptEmptyBody(v bool) *MonitorCreate {
+	_c.mutation.SetAcceptEmptyBody(v)
+	return _c
+}
+
+// SetNillableAcceptEmptyBody sets the "accept_empty_body" field if the given value is not nil.
+func (_c *MonitorCreate) SetNillableAcceptEmptyBody(v *bool) *MonitorCreate {
+	if v != nil {
+		_c.SetAcceptEmptyBody(*v)
+	}
+	return _c
+}
+
+// SetWatchdogMinutes sets the "watchdog_minutes" field.
+func (_c *MonitorCreate) SetWatchdogMinutes(v int) *MonitorCreate {
+	_c.mutation.SetWatchdogMinutes(v)
+	return _c
+}
+
+// SetNillableWatchdogMinutes sets the "watchdog_minutes" field if the given value is not nil.
+func (_c *MonitorCreate) SetNillableWatchdogMinutes(v *int) *MonitorCreate {
+	if v != nil {
+		_c.SetWatchdogMinutes(*v)
+	}
+	return _c
+}
+
+// SetHeaderAssertions sets the "header_assertions" field.
+func (_c *MonitorCreate) SetHeaderAssertions(v map[string]string) *MonitorCreate {
+	_c.mutation.SetHeaderAssertions(v)
+	return _c
+}
+
+// SetTrackHeader sets the "track_header" field.
+func (_c *MonitorCreate) SetTrackHeader(v string) *MonitorCreate {
+	_c.mutation.SetTrackHeader(v)
+	return _c
+}
+
+// SetNillableTrackHeader sets the "track_header" field if the given value is not nil.
+func (_c *MonitorCreate) SetNillableTrackHeader(v *string) *MonitorCreate {
+	if v != nil {
+		_c.SetTrackHeader(*v)
+	}
+	return _c
+}
+
+// SetNumericTolerance sets the "numeric_tolerance" field.
+func (_c *MonitorCreate) SetNumericTolerance(v float64) *MonitorCreate {
+	_c.mutation.SetNumericTolerance(v)
+	return _c
+}
+
+// SetNillableNumericTolerance sets the "numeric_tolerance" field if the given value is not nil.
+func (_c *MonitorCreate) SetNillableNumericTolerance(v *float64) *MonitorCreate {
+	if v != nil {
+		_c.SetNumericTolerance(*v)
+	}
+	return _c
+}
+
+// SetRedirectPolicy sets the "redirect_policy" field.
+func (_c *MonitorCreate) SetRedirectPolicy(v monitor.RedirectPolicy) *MonitorCreate {
+	_c.mutation.SetRedirectPolicy(v)
+	return _c
+}
+
+// SetNillableRedirectPolicy sets the "redirect_policy" field if the given value is not nil.
+func (_c *MonitorCreate) SetNillableRedirectPolicy(v *monitor.RedirectPolicy) *MonitorCreate {
+	if v != nil {
+		_c.SetRedirectPolicy(*v)
+	}
+	return _c
+}
+
+// SetMaxRedirects sets the "max_redirects" field.
+func (_c *MonitorCreate) SetMaxRedirects(v int) *MonitorCreate {
+	_c.mutation.SetMaxRedirects(v)
+	return _c
+}
+
+// SetNillableMaxRedirects sets the "max_redirects" field if the given value is not nil.
+func (_c *MonitorCreate) SetNillableMaxRedirects(v *int) *MonitorCreate {
+	if v != nil {
+		_c.SetMaxRedirects(*v)
+	}
+	return _c
+}
+
+// SetMaxResponseBytes sets the "max_response_bytes" field.
+func (_c *MonitorCreate) SetMaxResponseBytes(v int) *MonitorCreate {
+	_c.mutation.SetMaxResponseBytes(v)
+	return _c
+}
+
+// SetNillableMaxResponseBytes sets the "max_response_bytes" field if the given value is not nil.
+func (_c *MonitorCreate) SetNillableMaxResponseBytes(v *int) *MonitorCreate {
+	if v != nil {
+		_c.SetMaxResponseBytes(*v)
+	}
+	return _c
+}
+
+// SetChecksHistoryLimit sets the "checks_history_limit" field.
+func (_c *MonitorCreate) SetChecksHistoryLimit(v int) *MonitorCreate {
+	_c.mutation.SetChecksHistoryLimit(v)
+	return _c
+}
+
+// SetNillableChecksHistoryLimit sets the "checks_history_limit" field if the given value is not nil.
+func (_c *MonitorCreate) SetNillableChecksHistoryLimit(v *int) *MonitorCreate {
+	if v != nil {
+		_c.SetChecksHistoryLimit(*v)
+	}
+	return _c
+}
+
+// SetCookieJar sets the "cookie_jar" field.
+func (_c *MonitorCreate) SetCookieJar(v bool) *MonitorCreate {
+	_c.mutation.SetCookieJar(v)
+	return _c
+}
+
+// SetNillableCookieJar sets the "cookie_jar" field if the given value is not nil.
+func (_c *MonitorCreate) SetNillableCookieJar(v *bool) *MonitorCreate {
+	if v != nil {
+		_c.SetCookieJar(*v)
+	}
+	return _c
+}
+
+// SetHostOverrides sets the "host_overrides" field.
+func (_c *MonitorCreate) SetHostOverrides(v map[string]string) *MonitorCreate {
+	_c.mutation.SetHostOverrides(v)
+	return _c
+}
+
+// SetIPFamily sets the "ip_family" field.
+func (_c *MonitorCreate) SetIPFamily(v monitor.IPFamily) *MonitorCreate {
+	_c.mutation.SetIPFamily(v)
+	return _c
+}
+
+// SetNillableIPFamily sets the "ip_family" field if the given value is not nil.
+func (_c *MonitorCreate) SetNillableIPFamily(v *monitor.IPFamily) *MonitorCreate {
+	if v != nil {
+		_c.SetIPFamily(*v)
+	}
+	return _c
+}
+
+// SetTLSCaPem sets the "tls_ca_pem" field.
+func (_c *MonitorCreate) SetTLSCaPem(v string) *MonitorCreate {
+	_c.mutation.SetTLSCaPem(v)
+	return _c
+}
+
+// SetNillableTLSCaPem sets the "tls_ca_pem" field if the given value is not nil.
+func (_c *MonitorCreate) SetNillableTLSCaPem(v *string) *MonitorCreate {
+	if v != nil {
+		_c.SetTLSCaPem(*v)
+	}
+	return _c
+}
+
+// SetTLSInsecureSkipVerify sets the "tls_insecure_skip_verify" field.
+func (_c *MonitorCreate) SetTLSInsecureSkipVerify(v bool) *MonitorCreate {
+	_c.mutation.SetTLSInsecureSkipVerify(v)
+	return _c
+}
+
+// SetNillableTLSInsecureSkipVerify sets the "tls_insecure_skip_verify" field if the given value is not nil.
+func (_c *MonitorCreate) SetNillableTLSInsecureSkipVerify(v *bool) *MonitorCreate {
+	if v != nil {
+		_c.SetTLSInsecureSkipVerify(*v)
+	}
+	return _c
+}
+
+// SetTLSMinVersion sets the "tls_min_version" field.
+func (_c *MonitorCreate) SetTLSMinVersion(v string) *MonitorCreate {
+	_c.mutation.SetTLSMinVersion(v)
+	return _c
+}
+
+// SetNillableTLSMinVersion sets the "tls_min_version" field if the given value is not nil.
+func (_c *MonitorCreate) SetNillableTLSMinVersion(v *string) *MonitorCreate {
+	if v != nil {
+		_c.SetTLSMinVersion(*v)
+	}
+	return _c
+}
+
+// SetTLSServerName sets the "tls_server_name" field.
+func (_c *MonitorCreate) SetTLSServerName(v string) *MonitorCreate {
+	_c.mutation.SetTLSServerName(v)
+	return _c
+}
+
+// SetNillableTLSServerName sets the "tls_server_name" field if the given value is not nil.
+func (_c *MonitorCreate) SetNillableTLSServerName(v *string) *MonitorCreate {
+	if v != nil {
+		_c.SetTLSServerName(*v)
+	}
+	return _c
+}
+
 // SetCron sets the "cron" field.
 func (_c *MonitorCreate) SetCron(v string) *MonitorCreate {
 	_c.mutation.SetCron(v)
 	return _c
 }
 
+// SetTimezone sets the "timezone" field.
+func (_c *MonitorCreate) SetTimezone(v string) *MonitorCreate {
+	_c.mutation.SetTimezone(v)
+	return _c
+}
+
+// SetNillableTimezone sets the "timezone" field if the given value is not nil.
+func (_c *MonitorCreate) SetNillableTimezone(v *string) *MonitorCreate {
+	if v != nil {
+		_c.SetTimezone(*v)
+	}
+	return _c
+}
+
+// SetJitterSeconds sets the "jitter_seconds" field.
+func (_c *MonitorCreate) SetJitterSeconds(v int) *MonitorCreate {
+	_c.mutation.SetJitterSeconds(v)
+	return _c
+}
+
+// SetNillableJitterSeconds sets the "jitter_seconds" field if the given value is not nil.
+func (_c *MonitorCreate) SetNillableJitterSeconds(v *int) *MonitorCreate {
+	if v != nil {
+		_c.SetJitterSeconds(*v)
+	}
+	return _c
+}
+
+// SetDstPolicy sets the "dst_policy" field.
+func (_c *MonitorCreate) SetDstPolicy(v monitor.DstPolicy) *MonitorCreate {
+	_c.mutation.SetDstPolicy(v)
+	return _c
+}
+
+// SetNillableDstPolicy sets the "dst_policy" field if the given value is not nil.
+func (_c *MonitorCreate) SetNillableDstPolicy(v *monitor.DstPolicy) *MonitorCreate {
+	if v != nil {
+		_c.SetDstPolicy(*v)
+	}
+	return _c
+}
+
+// SetBodySnapshot sets the "body_snapshot" field.
+func (_c *MonitorCreate) SetBodySnapshot(v monitor.BodySnapshot) *MonitorCreate {
+	_c.mutation.SetBodySnapshot(v)
+	return _c
+}
+
+// SetNillableBodySnapshot sets the "body_snapshot" field if the given value is not nil.
+func (_c *MonitorCreate) SetNillableBodySnapshot(v *monitor.BodySnapshot) *MonitorCreate {
+	if v != nil {
+		_c.SetBodySnapshot(*v)
+	}
+	return _c
+}
+
+// SetContentHash sets the "content_hash" field.
+func (_c *MonitorCreate) SetContentHash(v monitor.ContentHash) *MonitorCreate {
+	_c.mutation.SetContentHash(v)
+	return _c
+}
+
+// SetNillableContentHash sets the "content_hash" field if the given value is not nil.
+func (_c *MonitorCreate) SetNillableContentHash(v *monitor.ContentHash) *MonitorCreate {
+	if v != nil {
+		_c.SetContentHash(*v)
+	}
+	return _c
+}
+
+// SetFetchMode sets the "fetch_mode" field.
+func (_c *MonitorCreate) SetFetchMode(v monitor.FetchMode) *MonitorCreate {
+	_c.mutation.SetFetchMode(v)
+	return _c
+}
+
+// SetNillableFetchMode sets the "fetch_mode" field if the given value is not nil.
+func (_c *MonitorCreate) SetNillableFetchMode(v *monitor.FetchMode) *MonitorCreate {
+	if v != nil {
+		_c.SetFetchMode(*v)
+	}
+	return _c
+}
+
+// SetHeartbeatToken sets the "heartbeat_token" field.
+func (_c *MonitorCreate) SetHeartbeatToken(v string) *MonitorCreate {
+	_c.mutation.SetHeartbeatToken(v)
+	return _c
+}
+
+// SetNillableHeartbeatToken sets the "heartbeat_token" field if the given value is not nil.
+func (_c *MonitorCreate) SetNillableHeartbeatToken(v *string) *MonitorCreate {
+	if v != nil {
+		_c.SetHeartbeatToken(*v)
+	}
+	return _c
+}
+
 // SetEnabled sets the "enabled" field.
 func (_c *MonitorCreate) SetEnabled(v bool) *MonitorCreate {
 	_c.mutation.SetEnabled(v)
@@ -165,6 +582,48 @@ func (_c *MonitorCreate) SetNillableEnabled(v *bool) *MonitorCreate {
 	return _c
 }
 
+// SetStatusPage sets the "status_page" field.
+func (_c *MonitorCreate) SetStatusPage(v bool) *MonitorCreate {
+	_c.mutation.SetStatusPage(v)
+	return _c
+}
+
+// SetNillableStatusPage sets the "status_page" field if the given value is not nil.
+func (_c *MonitorCreate) SetNillableStatusPage(v *bool) *MonitorCreate {
+	if v != nil {
+		_c.SetStatusPage(*v)
+	}
+	return _c
+}
+
+// SetSortIndex sets the "sort_index" field.
+func (_c *MonitorCreate) SetSortIndex(v int) *MonitorCreate {
+	_c.mutation.SetSortIndex(v)
+	return _c
+}
+
+// SetNillableSortIndex sets the "sort_index" field if the given value is not nil.
+func (_c *MonitorCreate) SetNillableSortIndex(v *int) *MonitorCreate {
+	if v != nil {
+		_c.SetSortIndex(*v)
+	}
+	return _c
+}
+
+// SetArchivedAt sets the "archived_at" field.
+func (_c *MonitorCreate) SetArchivedAt(v time.Time) *MonitorCreate {
+	_c.mutation.SetArchivedAt(v)
+	return _c
+}
+
+// SetNillableArchivedAt sets the "archived_at" field if the given value is not nil.
+func (_c *MonitorCreate) SetNillableArchivedAt(v *time.Time) *MonitorCreate {
+	if v != nil {
+		_c.SetArchivedAt(*v)
+	}
+	return _c
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (_c *MonitorCreate) SetCreatedAt(v time.Time) *MonitorCreate {
 	_c.mutation.SetCreatedAt(v)
@@ -242,6 +701,41 @@ func (_c *MonitorCreate) SetRuntime(v *MonitorRuntime) *MonitorCreate {
 	return _c.SetRuntimeID(v.ID)
 }
 
+// AddVersionIDs adds the "versions" edge to the MonitorVersion entity by IDs.
+func (_c *MonitorCreate) AddVersionIDs(ids ...int) *MonitorCreate {
+	_c.mutation.AddVersionIDs(ids...)
+	return _c
+}
+
+// AddVersions adds the "versions" edges to the MonitorVersion entity.
+func (_c *MonitorCreate) AddVersions(v ...*MonitorVersion) *MonitorCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddVersionIDs(ids...)
+}
+
+// AddRollupIDs adds the "rollups" edge to the CheckRollup entity by IDs.
+func (_c *MonitorCreate) AddRollupIDs(ids ...int) *MonitorCreate {
+	_c.mutation.AddRollupIDs(ids...)
+	return _c
+}
+
+// AddRollups adds the "rollups" edges to the CheckRollup entity.
+func (_c *MonitorCreate) AddRollups(v ...*CheckRollup) *MonitorCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddRollupIDs(ids...)
+}
+
+// SetHeaderProfile sets the "header_profile" edge to the HeaderProfile entity.
+func (_c *MonitorCreate) SetHeaderProfile(v *HeaderProfile) *MonitorCreate {
+	return _c.SetHeaderProfileID(v.ID)
+}
+
 // Mutation returns the MonitorMutation object of the builder.
 func (_c *MonitorCreate) Mutation() *MonitorMutation {
 	return _c.mutation
@@ -285,10 +779,58 @@ func (_c *MonitorCreate) defaults() {
 		v := monitor.DefaultExpectedType
 		_c.mutation.SetExpectedType(v)
 	}
+	if _, ok := _c.mutation.TreatNotFoundAsSuccess(); !ok {
+		v := monitor.DefaultTreatNotFoundAsSuccess
+		_c.mutation.SetTreatNotFoundAsSuccess(v)
+	}
+	if _, ok := _c.mutation.AcceptEmptyBody(); !ok {
+		v := monitor.DefaultAcceptEmptyBody
+		_c.mutation.SetAcceptEmptyBody(v)
+	}
+	if _, ok := _c.mutation.RedirectPolicy(); !ok {
+		v := monitor.DefaultRedirectPolicy
+		_c.mutation.SetRedirectPolicy(v)
+	}
+	if _, ok := _c.mutation.CookieJar(); !ok {
+		v := monitor.DefaultCookieJar
+		_c.mutation.SetCookieJar(v)
+	}
+	if _, ok := _c.mutation.IPFamily(); !ok {
+		v := monitor.DefaultIPFamily
+		_c.mutation.SetIPFamily(v)
+	}
+	if _, ok := _c.mutation.TLSInsecureSkipVerify(); !ok {
+		v := monitor.DefaultTLSInsecureSkipVerify
+		_c.mutation.SetTLSInsecureSkipVerify(v)
+	}
+	if _, ok := _c.mutation.DstPolicy(); !ok {
+		v := monitor.DefaultDstPolicy
+		_c.mutation.SetDstPolicy(v)
+	}
+	if _, ok := _c.mutation.BodySnapshot(); !ok {
+		v := monitor.DefaultBodySnapshot
+		_c.mutation.SetBodySnapshot(v)
+	}
+	if _, ok := _c.mutation.ContentHash(); !ok {
+		v := monitor.DefaultContentHash
+		_c.mutation.SetContentHash(v)
+	}
+	if _, ok := _c.mutation.FetchMode(); !ok {
+		v := monitor.DefaultFetchMode
+		_c.mutation.SetFetchMode(v)
+	}
 	if _, ok := _c.mutation.Enabled(); !ok {
 		v := monitor.DefaultEnabled
 		_c.mutation.SetEnabled(v)
 	}
+	if _, ok := _c.mutation.StatusPage(); !ok {
+		v := monitor.DefaultStatusPage
+		_c.mutation.SetStatusPage(v)
+	}
+	if _, ok := _c.mutation.SortIndex(); !ok {
+		v := monitor.DefaultSortIndex
+		_c.mutation.SetSortIndex(v)
+	}
 	if _, ok := _c.mutation.CreatedAt(); !ok {
 		v := monitor.DefaultCreatedAt()
 		_c.mutation.SetCreatedAt(v)
@@ -312,6 +854,11 @@ func (_c *MonitorCreate) check() error {
 			return &ValidationError{Name: "url", err: fmt.Errorf(`ent: validator failed for field "Monitor.url": %w`, err)}
 		}
 	}
+	if v, ok := _c.mutation.EscalationAfterMinutes(); ok {
+		if err := monitor.EscalationAfterMinutesValidator(v); err != nil {
+			return &ValidationError{Name: "escalation_after_minutes", err: fmt.Errorf(`ent: validator failed for field "Monitor.escalation_after_minutes": %w`, err)}
+		}
+	}
 	if _, ok := _c.mutation.ExpectedType(); !ok {
 		return &ValidationError{Name: "expected_type", err: errors.New(`ent: missing required field "Monitor.expected_type"`)}
 	}
@@ -320,6 +867,59 @@ func (_c *MonitorCreate) check() error {
 			return &ValidationError{Name: "expected_type", err: fmt.Errorf(`ent: validator failed for field "Monitor.expected_type": %w`, err)}
 		}
 	}
+	if _, ok := _c.mutation.TreatNotFoundAsSuccess(); !ok {
+		return &ValidationError{Name: "treat_not_found_as_success", err: errors.New(`ent: missing required field "Monitor.treat_not_found_as_success"`)}
+	}
+	if _, ok := _c.mutation.AcceptEmptyBody(); !ok {
+		return &ValidationError{Name: "accept_empty_body", err: errors.New(`ent: missing required field "Monitor.accept_empty_body"`)}
+	}
+	if v, ok := _c.mutation.WatchdogMinutes(); ok {
+		if err := monitor.WatchdogMinutesValidator(v); err != nil {
+			return &ValidationError{Name: "watchdog_minutes", err: fmt.Errorf(`ent: validator failed for field "Monitor.watchdog_minutes": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.NumericTolerance(); ok {
+		if err := monitor.NumericToleranceValidator(v); err != nil {
+			return &ValidationError{Name: "numeric_tolerance", err: fmt.Errorf(`ent: validator failed for field "Monitor.numeric_tolerance": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.RedirectPolicy(); !ok {
+		return &ValidationError{Name: "redirect_policy", err: errors.New(`ent: missing required field "Monitor.redirect_policy"`)}
+	}
+	if v, ok := _c.mutation.RedirectPolicy(); ok {
+		if err := monitor.RedirectPolicyValidator(v); err != nil {
+			return &ValidationError{Name: "redirect_policy", err: fmt.Errorf(`ent: validator failed for field "Monitor.redirect_policy": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.MaxRedirects(); ok {
+		if err := monitor.MaxRedirectsValidator(v); err != nil {
+			return &ValidationError{Name: "max_redirects", err: fmt.Errorf(`ent: validator failed for field "Monitor.max_redirects": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.MaxResponseBytes(); ok {
+		if err := monitor.MaxResponseBytesValidator(v); err != nil {
+			return &ValidationError{Name: "max_response_bytes", err: fmt.Errorf(`ent: validator failed for field "Monitor.max_response_bytes": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.ChecksHistoryLimit(); ok {
+		if err := monitor.ChecksHistoryLimitValidator(v); err != nil {
+			return &ValidationError{Name: "checks_history_limit", err: fmt.Errorf(`ent: validator failed for field "Monitor.checks_history_limit": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.CookieJar(); !ok {
+		return &ValidationError{Name: "cookie_jar", err: errors.New(`ent: missing required field "Monitor.cookie_jar"`)}
+	}
+	if _, ok := _c.mutation.IPFamily(); !ok {
+		return &ValidationError{Name: "ip_family", err: errors.New(`ent: missing required field "Monitor.ip_family"`)}
+	}
+	if v, ok := _c.mutation.IPFamily(); ok {
+		if err := monitor.IPFamilyValidator(v); err != nil {
+			return &ValidationError{Name: "ip_family", err: fmt.Errorf(`ent: validator failed for field "Monitor.ip_family": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.TLSInsecureSkipVerify(); !ok {
+		return &ValidationError{Name: "tls_insecure_skip_verify", err: errors.New(`ent: missing required field "Monitor.tls_insecure_skip_verify"`)}
+	}
 	if _, ok := _c.mutation.Cron(); !ok {
 		return &ValidationError{Name: "cron", err: errors.New(`ent: missing required field "Monitor.cron"`)}
 	}
@@ -328,9 +928,52 @@ func (_c *MonitorCreate) check() error {
 			return &ValidationError{Name: "cron", err: fmt.Errorf(`ent: validator failed for field "Monitor.cron": %w`, err)}
 		}
 	}
+	if v, ok := _c.mutation.JitterSeconds(); ok {
+		if err := monitor.JitterSecondsValidator(v); err != nil {
+			return &ValidationError{Name: "jitter_seconds", err: fmt.Errorf(`ent: validator failed for field "Monitor.jitter_seconds": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.DstPolicy(); !ok {
+		return &ValidationError{Name: "dst_policy", err: errors.New(`ent: missing required field "Monitor.dst_policy"`)}
+	}
+	if v, ok := _c.mutation.DstPolicy(); ok {
+		if err := monitor.DstPolicyValidator(v); err != nil {
+			return &ValidationError{Name: "dst_policy", err: fmt.Errorf(`ent: validator failed for field "Monitor.dst_policy": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.BodySnapshot(); !ok {
+		return &ValidationError{Name: "body_snapshot", err: errors.New(`ent: missing required field "Monitor.body_snapshot"`)}
+	}
+	if v, ok := _c.mutation.BodySnapshot(); ok {
+		if err := monitor.BodySnapshotValidator(v); err != nil {
+			return &ValidationError{Name: "body_snapshot", err: fmt.Errorf(`ent: validator failed for field "Monitor.body_snapshot": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.ContentHash(); !ok {
+		return &ValidationError{Name: "content_hash", err: errors.New(`ent: missing required field "Monitor.content_hash"`)}
+	}
+	if v, ok := _c.mutation.ContentHash(); ok {
+		if err := monitor.ContentHashValidator(v); err != nil {
+			return &ValidationError{Name: "content_hash", err: fmt.Errorf(`ent: validator failed for field "Monitor.content_hash": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.FetchMode(); !ok {
+		return &ValidationError{Name: "fetch_mode", err: errors.New(`ent: missing required field "Monitor.fetch_mode"`)}
+	}
+	if v, ok := _c.mutation.FetchMode(); ok {
+		if err := monitor.FetchModeValidator(v); err != nil {
+			return &ValidationError{Name: "fetch_mode", err: fmt.Errorf(`ent: validator failed for field "Monitor.fetch_mode": %w`, err)}
+		}
+	}
 	if _, ok := _c.mutation.Enabled(); !ok {
 		return &ValidationError{Name: "enabled", err: errors.New(`ent: missing required field "Monitor.enabled"`)}
 	}
+	if _, ok := _c.mutation.StatusPage(); !ok {
+		return &ValidationError{Name: "status_page", err: errors.New(`ent: missing required field "Monitor.status_page"`)}
+	}
+	if _, ok := _c.mutation.SortIndex(); !ok {
+		return &ValidationError{Name: "sort_index", err: errors.New(`ent: missing required field "Monitor.sort_index"`)}
+	}
 	if _, ok := _c.mutation.CreatedAt(); !ok {
 		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Monitor.created_at"`)}
 	}
@@ -387,6 +1030,10 @@ func (_c *MonitorCreate) createSpec() (*Monitor, *sqlgraph.CreateSpec) {
 		_spec.SetField(monitor.FieldHeaders, field.TypeJSON, value)
 		_node.Headers = value
 	}
+	if value, ok := _c.mutation.UserAgent(); ok {
+		_spec.SetField(monitor.FieldUserAgent, field.TypeString, value)
+		_node.UserAgent = &value
+	}
 	if value, ok := _c.mutation.Auth(); ok {
 		_spec.SetField(monitor.FieldAuth, field.TypeJSON, value)
 		_node.Auth = value
@@ -395,10 +1042,30 @@ func (_c *MonitorCreate) createSpec() (*Monitor, *sqlgraph.CreateSpec) {
 		_spec.SetField(monitor.FieldNotificationChannels, field.TypeJSON, value)
 		_node.NotificationChannels = value
 	}
+	if value, ok := _c.mutation.EscalationChannels(); ok {
+		_spec.SetField(monitor.FieldEscalationChannels, field.TypeJSON, value)
+		_node.EscalationChannels = value
+	}
+	if value, ok := _c.mutation.Tags(); ok {
+		_spec.SetField(monitor.FieldTags, field.TypeJSON, value)
+		_node.Tags = value
+	}
+	if value, ok := _c.mutation.EscalationAfterMinutes(); ok {
+		_spec.SetField(monitor.FieldEscalationAfterMinutes, field.TypeInt, value)
+		_node.EscalationAfterMinutes = &value
+	}
 	if value, ok := _c.mutation.Selector(); ok {
 		_spec.SetField(monitor.FieldSelector, field.TypeString, value)
 		_node.Selector = &value
 	}
+	if value, ok := _c.mutation.ExpectedStatus(); ok {
+		_spec.SetField(monitor.FieldExpectedStatus, field.TypeString, value)
+		_node.ExpectedStatus = &value
+	}
+	if value, ok := _c.mutation.RetryOn(); ok {
+		_spec.SetField(monitor.FieldRetryOn, field.TypeString, value)
+		_node.RetryOn = &value
+	}
 	if value, ok := _c.mutation.ExpectedType(); ok {
 		_spec.SetField(monitor.FieldExpectedType, field.TypeEnum, value)
 		_node.ExpectedType = value
@@ -407,14 +1074,130 @@ func (_c *MonitorCreate) createSpec() (*Monitor, *sqlgraph.CreateSpec) {
 		_spec.SetField(monitor.FieldExpectedResponse, field.TypeString, value)
 		_node.ExpectedResponse = &value
 	}
+	if value, ok := _c.mutation.MustContain(); ok {
+		_spec.SetField(monitor.FieldMustContain, field.TypeJSON, value)
+		_node.MustContain = value
+	}
+	if value, ok := _c.mutation.MustNotContain(); ok {
+		_spec.SetField(monitor.FieldMustNotContain, field.TypeJSON, value)
+		_node.MustNotContain = value
+	}
+	if value, ok := _c.mutation.TreatNotFoundAsSuccess(); ok {
+		_spec.SetField(monitor.FieldTreatNotFoundAsSuccess, field.TypeBool, value)
+		_node.TreatNotFoundAsSuccess = value
+	}
+	if value, ok := _c.mutation.AcceptEmptyBody(); ok {
+		_spec.SetField(monitor.FieldAcceptEmptyBody, field.TypeBool, value)
+		_node.AcceptEmptyBody = value
+	}
+	if value, ok := _c.mutation.WatchdogMinutes(); ok {
+		_spec.SetField(monitor.FieldWatchdogMinutes, field.TypeInt, value)
+		_node.WatchdogMinutes = &value
+	}
+	if value, ok := _c.mutation.HeaderAssertions(); ok {
+		_spec.SetField(monitor.FieldHeaderAssertions, field.TypeJSON, value)
+		_node.HeaderAssertions = value
+	}
+	if value, ok := _c.mutation.TrackHeader(); ok {
+		_spec.SetField(monitor.FieldTrackHeader, field.TypeString, value)
+		_node.TrackHeader = &value
+	}
+	if value, ok := _c.mutation.NumericTolerance(); ok {
+		_spec.SetField(monitor.FieldNumericTolerance, field.TypeFloat64, value)
+		_node.NumericTolerance = &value
+	}
+	if value, ok := _c.mutation.RedirectPolicy(); ok {
+		_spec.SetField(monitor.FieldRedirectPolicy, field.TypeEnum, value)
+		_node.RedirectPolicy = value
+	}
+	if value, ok := _c.mutation.MaxRedirects(); ok {
+		_spec.SetField(monitor.FieldMaxRedirects, field.TypeInt, value)
+		_node.MaxRedirects = &value
+	}
+	if value, ok := _c.mutation.MaxResponseBytes(); ok {
+		_spec.SetField(monitor.FieldMaxResponseBytes, field.TypeInt, value)
+		_node.MaxResponseBytes = &value
+	}
+	if value, ok := _c.mutation.ChecksHistoryLimit(); ok {
+		_spec.SetField(monitor.FieldChecksHistoryLimit, field.TypeInt, value)
+		_node.ChecksHistoryLimit = &value
+	}
+	if value, ok := _c.mutation.CookieJar(); ok {
+		_spec.SetField(monitor.FieldCookieJar, field.TypeBool, value)
+		_node.CookieJar = value
+	}
+	if value, ok := _c.mutation.HostOverrides(); ok {
+		_spec.SetField(monitor.FieldHostOverrides, field.TypeJSON, value)
+		_node.HostOverrides = value
+	}
+	if value, ok := _c.mutation.IPFamily(); ok {
+		_spec.SetField(monitor.FieldIPFamily, field.TypeEnum, value)
+		_node.IPFamily = value
+	}
+	if value, ok := _c.mutation.TLSCaPem(); ok {
+		_spec.SetField(monitor.FieldTLSCaPem, field.TypeString, value)
+		_node.TLSCaPem = &value
+	}
+	if value, ok := _c.mutation.TLSInsecureSkipVerify(); ok {
+		_spec.SetField(monitor.FieldTLSInsecureSkipVerify, field.TypeBool, value)
+		_node.TLSInsecureSkipVerify = value
+	}
+	if value, ok := _c.mutation.TLSMinVersion(); ok {
+		_spec.SetField(monitor.FieldTLSMinVersion, field.TypeString, value)
+		_node.TLSMinVersion = &value
+	}
+	if value, ok := _c.mutation.TLSServerName(); ok {
+		_spec.SetField(monitor.FieldTLSServerName, field.TypeString, value)
+		_node.TLSServerName = &value
+	}
 	if value, ok := _c.mutation.Cron(); ok {
 		_spec.SetField(monitor.FieldCron, field.TypeString, value)
 		_node.Cron = value
 	}
+	if value, ok := _c.mutation.Timezone(); ok {
+		_spec.SetField(monitor.FieldTimezone, field.TypeString, value)
+		_node.Timezone = &value
+	}
+	if value, ok := _c.mutation.JitterSeconds(); ok {
+		_spec.SetField(monitor.FieldJitterSeconds, field.TypeInt, value)
+		_node.JitterSeconds = &value
+	}
+	if value, ok := _c.mutation.DstPolicy(); ok {
+		_spec.SetField(monitor.FieldDstPolicy, field.TypeEnum, value)
+		_node.DstPolicy = value
+	}
+	if value, ok := _c.mutation.BodySnapshot(); ok {
+		_spec.SetField(monitor.FieldBodySnapshot, field.TypeEnum, value)
+		_node.BodySnapshot = value
+	}
+	if value, ok := _c.mutation.ContentHash(); ok {
+		_spec.SetField(monitor.FieldContentHash, field.TypeEnum, value)
+		_node.ContentHash = value
+	}
+	if value, ok := _c.mutation.FetchMode(); ok {
+		_spec.SetField(monitor.FieldFetchMode, field.TypeEnum, value)
+		_node.FetchMode = value
+	}
+	if value, ok := _c.mutation.HeartbeatToken(); ok {
+		_spec.SetField(monitor.FieldHeartbeatToken, field.TypeString, value)
+		_node.HeartbeatToken = &value
+	}
 	if value, ok := _c.mutation.Enabled(); ok {
 		_spec.SetField(monitor.FieldEnabled, field.TypeBool, value)
 		_node.Enabled = value
 	}
+	if value, ok := _c.mutation.StatusPage(); ok {
+		_spec.SetField(monitor.FieldStatusPage, field.TypeBool, value)
+		_node.StatusPage = value
+	}
+	if value, ok := _c.mutation.SortIndex(); ok {
+		_spec.SetField(monitor.FieldSortIndex, field.TypeInt, value)
+		_node.SortIndex = value
+	}
+	if value, ok := _c.mutation.ArchivedAt(); ok {
+		_spec.SetField(monitor.FieldArchivedAt, field.TypeTime, value)
+		_node.ArchivedAt = &value
+	}
 	if value, ok := _c.mutation.CreatedAt(); ok {
 		_spec.SetField(monitor.FieldCreatedAt, field.TypeTime, value)
 		_node.CreatedAt = value
@@ -471,6 +1254,55 @@ func (_c *MonitorCreate) createSpec() (*Monitor, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.VersionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   monitor.VersionsTable,
+			Columns: []string{monitor.VersionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(monitorversion.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.RollupsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   monitor.RollupsTable,
+			Columns: []string{monitor.RollupsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(checkrollup.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.HeaderProfileIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   monitor.HeaderProfileTable,
+			Columns: []string{monitor.HeaderProfileColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(headerprofile.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.HeaderProfileID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
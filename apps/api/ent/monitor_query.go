@@ -7,8 +7,11 @@ import (
 	"database/sql/driver"
 	"fmt"
 	"goanna/apps/api/ent/checkresult"
+	"goanna/apps/api/ent/checkrollup"
+	"goanna/apps/api/ent/headerprofile"
 	"goanna/apps/api/ent/monitor"
 	"goanna/apps/api/ent/monitorruntime"
+	"goanna/apps/api/ent/monitorversion"
 	"goanna/apps/api/ent/notificationevent"
 	"goanna/apps/api/ent/predicate"
 	"math"
@@ -29,6 +32,9 @@ type MonitorQuery struct {
 	withCheckResults       *CheckResultQuery
 	withNotificationEvents *NotificationEventQuery
 	withRuntime            *MonitorRuntimeQuery
+	withVersions           *MonitorVersionQuery
+	withRollups            *CheckRollupQuery
+	withHeaderProfile      *HeaderProfileQuery
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -131,6 +137,72 @@ func (_q *MonitorQuery) QueryRuntime() *MonitorRuntimeQuery {
 	return query
 }
 
+// QueryVersions chains the current query on the "versions" edge.
+func (_q *MonitorQuery) QueryVersions() *MonitorVersionQuery {
+	query := (&MonitorVersionClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(monitor.Table, monitor.FieldID, selector),
+			sqlgraph.To(monitorversion.Table, monitorversion.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, monitor.VersionsTable, monitor.VersionsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryRollups chains the current query on the "rollups" edge.
+func (_q *MonitorQuery) QueryRollups() *CheckRollupQuery {
+	query := (&CheckRollupClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(monitor.Table, monitor.FieldID, selector),
+			sqlgraph.To(checkrollup.Table, checkrollup.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, monitor.RollupsTable, monitor.RollupsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryHeaderProfile chains the current query on the "header_profile" edge.
+func (_q *MonitorQuery) QueryHeaderProfile() *HeaderProfileQuery {
+	query := (&HeaderProfileClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(monitor.Table, monitor.FieldID, selector),
+			sqlgraph.To(headerprofile.Table, headerprofile.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, monitor.HeaderProfileTable, monitor.HeaderProfileColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Monitor entity from the query.
 // Returns a *NotFoundError when no Monitor was found.
 func (_q *MonitorQuery) First(ctx context.Context) (*Monitor, error) {
@@ -326,6 +398,9 @@ func (_q *MonitorQuery) Clone() *MonitorQuery {
 		withCheckResults:       _q.withCheckResults.Clone(),
 		withNotificationEvents: _q.withNotificationEvents.Clone(),
 		withRuntime:            _q.withRuntime.Clone(),
+		withVersions:           _q.withVersions.Clone(),
+		withRollups:            _q.withRollups.Clone(),
+		withHeaderProfile:      _q.withHeaderProfile.Clone(),
 		// clone intermediate query.
 		sql:  _q.sql.Clone(),
 		path: _q.path,
@@ -365,6 +440,39 @@ func (_q *MonitorQuery) WithRuntime(opts ...func(*MonitorRuntimeQuery)) *Monitor
 	return _q
 }
 
+// WithVersions tells the query-builder to eager-load the nodes that are connected to
+// the "versions" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *MonitorQuery) WithVersions(opts ...func(*MonitorVersionQuery)) *MonitorQuery {
+	query := (&MonitorVersionClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withVersions = query
+	return _q
+}
+
+// WithRollups tells the query-builder to eager-load the nodes that are connected to
+// the "rollups" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *MonitorQuery) WithRollups(opts ...func(*CheckRollupQuery)) *MonitorQuery {
+	query := (&CheckRollupClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withRollups = query
+	return _q
+}
+
+// WithHeaderProfile tells the query-builder to eager-load the nodes that are connected to
+// the "header_profile" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *MonitorQuery) WithHeaderProfile(opts ...func(*HeaderProfileQuery)) *MonitorQuery {
+	query := (&HeaderProfileClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withHeaderProfile = query
+	return _q
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -443,10 +551,13 @@ func (_q *MonitorQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Moni
 	var (
 		nodes       = []*Monitor{}
 		_spec       = _q.querySpec()
-		loadedTypes = [3]bool{
+		loadedTypes = [6]bool{
 			_q.withCheckResults != nil,
 			_q.withNotificationEvents != nil,
 			_q.withRuntime != nil,
+			_q.withVersions != nil,
+			_q.withRollups != nil,
+			_q.withHeaderProfile != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
@@ -489,6 +600,26 @@ func (_q *MonitorQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Moni
 			return nil, err
 		}
 	}
+	if query := _q.withVersions; query != nil {
+		if err := _q.loadVersions(ctx, query, nodes,
+			func(n *Monitor) { n.Edges.Versions = []*MonitorVersion{} },
+			func(n *Monitor, e *MonitorVersion) { n.Edges.Versions = append(n.Edges.Versions, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withRollups; query != nil {
+		if err := _q.loadRollups(ctx, query, nodes,
+			func(n *Monitor) { n.Edges.Rollups = []*CheckRollup{} },
+			func(n *Monitor, e *CheckRollup) { n.Edges.Rollups = append(n.Edges.Rollups, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withHeaderProfile; query != nil {
+		if err := _q.loadHeaderProfile(ctx, query, nodes, nil,
+			func(n *Monitor, e *HeaderProfile) { n.Edges.HeaderProfile = e }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -502,7 +633,9 @@ func (_q *MonitorQuery) loadCheckResults(ctx context.Context, query *CheckResult
 			init(nodes[i])
 		}
 	}
-	query.withFKs = true
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(checkresult.FieldMonitorID)
+	}
 	query.Where(predicate.CheckResult(func(s *sql.Selector) {
 		s.Where(sql.InValues(s.C(monitor.CheckResultsColumn), fks...))
 	}))
@@ -511,13 +644,10 @@ func (_q *MonitorQuery) loadCheckResults(ctx context.Context, query *CheckResult
 		return err
 	}
 	for _, n := range neighbors {
-		fk := n.monitor_check_results
-		if fk == nil {
-			return fmt.Errorf(`foreign-key "monitor_check_results" is nil for node %v`, n.ID)
-		}
-		node, ok := nodeids[*fk]
+		fk := n.MonitorID
+		node, ok := nodeids[fk]
 		if !ok {
-			return fmt.Errorf(`unexpected referenced foreign-key "monitor_check_results" returned %v for node %v`, *fk, n.ID)
+			return fmt.Errorf(`unexpected referenced foreign-key "monitor_id" returned %v for node %v`, fk, n.ID)
 		}
 		assign(node, n)
 	}
@@ -582,6 +712,98 @@ func (_q *MonitorQuery) loadRuntime(ctx context.Context, query *MonitorRuntimeQu
 	}
 	return nil
 }
+func (_q *MonitorQuery) loadVersions(ctx context.Context, query *MonitorVersionQuery, nodes []*Monitor, init func(*Monitor), assign func(*Monitor, *MonitorVersion)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Monitor)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(monitorversion.FieldMonitorID)
+	}
+	query.Where(predicate.MonitorVersion(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(monitor.VersionsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.MonitorID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "monitor_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *MonitorQuery) loadRollups(ctx context.Context, query *CheckRollupQuery, nodes []*Monitor, init func(*Monitor), assign func(*Monitor, *CheckRollup)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Monitor)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(checkrollup.FieldMonitorID)
+	}
+	query.Where(predicate.CheckRollup(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(monitor.RollupsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.MonitorID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "monitor_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *MonitorQuery) loadHeaderProfile(ctx context.Context, query *HeaderProfileQuery, nodes []*Monitor, init func(*Monitor), assign func(*Monitor, *HeaderProfile)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*Monitor)
+	for i := range nodes {
+		if nodes[i].HeaderProfileID == nil {
+			continue
+		}
+		fk := *nodes[i].HeaderProfileID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(headerprofile.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "header_profile_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
 
 func (_q *MonitorQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := _q.querySpec()
@@ -608,6 +830,9 @@ func (_q *MonitorQuery) querySpec() *sqlgraph.QuerySpec {
 				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
 			}
 		}
+		if _q.withHeaderProfile != nil {
+			_spec.Node.AddColumnOnce(monitor.FieldHeaderProfileID)
+		}
 	}
 	if ps := _q.predicates; len(ps) > 0 {
 		_spec.Predicate = func(selector *sql.Selector) {
@@ -7,8 +7,11 @@ import (
 	"errors"
 	"fmt"
 	"goanna/apps/api/ent/checkresult"
+	"goanna/apps/api/ent/checkrollup"
+	"goanna/apps/api/ent/headerprofile"
 	"goanna/apps/api/ent/monitor"
 	"goanna/apps/api/ent/monitorruntime"
+	"goanna/apps/api/ent/monitorversion"
 	"goanna/apps/api/ent/notificationevent"
 	"goanna/apps/api/ent/predicate"
 	"time"
@@ -132,6 +135,46 @@ func (_u *MonitorUpdate) ClearHeaders() *MonitorUpdate {
 	return _u
 }
 
+// SetUserAgent sets the "user_agent" field.
+func (_u *MonitorUpdate) SetUserAgent(v string) *MonitorUpdate {
+	_u.mutation.SetUserAgent(v)
+	return _u
+}
+
+// SetNillableUserAgent sets the "user_agent" field if the given value is not nil.
+func (_u *MonitorUpdate) SetNillableUserAgent(v *string) *MonitorUpdate {
+	if v != nil {
+		_u.SetUserAgent(*v)
+	}
+	return _u
+}
+
+// ClearUserAgent clears the value of the "user_agent" field.
+func (_u *MonitorUpdate) ClearUserAgent() *MonitorUpdate {
+	_u.mutation.ClearUserAgent()
+	return _u
+}
+
+// SetHeaderProfileID sets the "header_profile_id" field.
+func (_u *MonitorUpdate) SetHeaderProfileID(v int) *MonitorUpdate {
+	_u.mutation.SetHeaderProfileID(v)
+	return _u
+}
+
+// SetNillableHeaderProfileID sets the "header_profile_id" field if the given value is not nil.
+func (_u *MonitorUpdate) SetNillableHeaderProfileID(v *int) *MonitorUpdate {
+	if v != nil {
+		_u.SetHeaderProfileID(*v)
+	}
+	return _u
+}
+
+// ClearHeaderProfileID clears the value of the "header_profile_id" field.
+func (_u *MonitorUpdate) ClearHeaderProfileID() *MonitorUpdate {
+	_u.mutation.ClearHeaderProfileID()
+	return _u
+}
+
 // SetAuth sets the "auth" field.
 func (_u *MonitorUpdate) SetAuth(v map[string]string) *MonitorUpdate {
 	_u.mutation.SetAuth(v)
@@ -162,6 +205,69 @@ func (_u *MonitorUpdate) ClearNotificationChannels() *MonitorUpdate {
 	return _u
 }
 
+// SetEscalationChannels sets the "escalation_channels" field.
+func (_u *MonitorUpdate) SetEscalationChannels(v []string) *MonitorUpdate {
+	_u.mutation.SetEscalationChannels(v)
+	return _u
+}
+
+// AppendEscalationChannels appends value to the "escalation_channels" field.
+func (_u *MonitorUpdate) AppendEscalationChannels(v []string) *MonitorUpdate {
+	_u.mutation.AppendEscalationChannels(v)
+	return _u
+}
+
+// ClearEscalationChannels clears the value of the "escalation_channels" field.
+func (_u *MonitorUpdate) ClearEscalationChannels() *MonitorUpdate {
+	_u.mutation.ClearEscalationChannels()
+	return _u
+}
+
+// SetTags sets the "tags" field.
+func (_u *MonitorUpdate) SetTags(v []string) *MonitorUpdate {
+	_u.mutation.SetTags(v)
+	return _u
+}
+
+// AppendTags appends value to the "tags" field.
+func (_u *MonitorUpdate) AppendTags(v []string) *MonitorUpdate {
+	_u.mutation.AppendTags(v)
+	return _u
+}
+
+// ClearTags clears the value of the "tags" field.
+func (_u *MonitorUpdate) ClearTags() *MonitorUpdate {
+	_u.mutation.ClearTags()
+	return _u
+}
+
+// SetEscalationAfterMinutes sets the "escalation_after_minutes" field.
+func (_u *MonitorUpdate) SetEscalationAfterMinutes(v int) *MonitorUpdate {
+	_u.mutation.ResetEscalationAfterMinutes()
+	_u.mutation.SetEscalationAfterMinutes(v)
+	return _u
+}
+
+// SetNillableEscalationAfterMinutes sets the "escalation_after_minutes" field if the given value is not nil.
+func (_u *MonitorUpdate) SetNillableEscalationAfterMinutes(v *int) *MonitorUpdate {
+	if v != nil {
+		_u.SetEscalationAfterMinutes(*v)
+	}
+	return _u
+}
+
+// AddEscalationAfterMinutes adds value to the "escalation_after_minutes" field.
+func (_u *MonitorUpdate) AddEscalationAfterMinutes(v int) *MonitorUpdate {
+	_u.mutation.AddEscalationAfterMinutes(v)
+	return _u
+}
+
+// ClearEscalationAfterMinutes clears the value of the "escalation_after_minutes" field.
+func (_u *MonitorUpdate) ClearEscalationAfterMinutes() *MonitorUpdate {
+	_u.mutation.ClearEscalationAfterMinutes()
+	return _u
+}
+
 // SetSelector sets the "selector" field.
 func (_u *MonitorUpdate) SetSelector(v string) *MonitorUpdate {
 	_u.mutation.SetSelector(v)
@@ -182,6 +288,46 @@ func (_u *MonitorUpdate) ClearSelector() *MonitorUpdate {
 	return _u
 }
 
+// SetExpectedStatus sets the "expected_status" field.
+func (_u *MonitorUpdate) SetExpectedStatus(v string) *MonitorUpdate {
+	_u.mutation.SetExpectedStatus(v)
+	return _u
+}
+
+// SetNillableExpectedStatus sets the "expected_status" field if the given value is not nil.
+func (_u *MonitorUpdate) SetNillableExpectedStatus(v *string) *MonitorUpdate {
+	if v != nil {
+		_u.SetExpectedStatus(*v)
+	}
+	return _u
+}
+
+// ClearExpectedStatus clears the value of the "expected_status" field.
+func (_u *MonitorUpdate) ClearExpectedStatus() *MonitorUpdate {
+	_u.mutation.ClearExpectedStatus()
+	return _u
+}
+
+// SetRetryOn sets the "retry_on" field.
+func (_u *MonitorUpdate) SetRetryOn(v string) *MonitorUpdate {
+	_u.mutation.SetRetryOn(v)
+	return _u
+}
+
+// SetNillableRetryOn sets the "retry_on" field if the given value is not nil.
+func (_u *MonitorUpdate) SetNillableRetryOn(v *string) *MonitorUpdate {
+	if v != nil {
+		_u.SetRetryOn(*v)
+	}
+	return _u
+}
+
+// ClearRetryOn clears the value of the "retry_on" field.
+func (_u *MonitorUpdate) ClearRetryOn() *MonitorUpdate {
+	_u.mutation.ClearRetryOn()
+	return _u
+}
+
 // SetExpectedType sets the "expected_type" field.
 func (_u *MonitorUpdate) SetExpectedType(v monitor.ExpectedType) *MonitorUpdate {
 	_u.mutation.SetExpectedType(v)
@@ -216,632 +362,2334 @@ func (_u *MonitorUpdate) ClearExpectedResponse() *MonitorUpdate {
 	return _u
 }
 
-// SetCron sets the "cron" field.
-func (_u *MonitorUpdate) SetCron(v string) *MonitorUpdate {
-	_u.mutation.SetCron(v)
+// SetMustContain sets the "must_contain" field.
+func (_u *MonitorUpdate) SetMustContain(v []string) *MonitorUpdate {
+	_u.mutation.SetMustContain(v)
 	return _u
 }
 
-// SetNillableCron sets the "cron" field if the given value is not nil.
-func (_u *MonitorUpdate) SetNillableCron(v *string) *MonitorUpdate {
-	if v != nil {
-		_u.SetCron(*v)
-	}
+// AppendMustContain appends value to the "must_contain" field.
+func (_u *MonitorUpdate) AppendMustContain(v []string) *MonitorUpdate {
+	_u.mutation.AppendMustContain(v)
 	return _u
 }
 
-// SetEnabled sets the "enabled" field.
-func (_u *MonitorUpdate) SetEnabled(v bool) *MonitorUpdate {
-	_u.mutation.SetEnabled(v)
+// ClearMustContain clears the value of the "must_contain" field.
+func (_u *MonitorUpdate) ClearMustContain() *MonitorUpdate {
+	_u.mutation.ClearMustContain()
 	return _u
 }
 
-// SetNillableEnabled sets the "enabled" field if the given value is not nil.
-func (_u *MonitorUpdate) SetNillableEnabled(v *bool) *MonitorUpdate {
-	if v != nil {
-		_u.SetEnabled(*v)
-	}
+// SetMustNotContain sets the "must_not_contain" field.
+func (_u *MonitorUpdate) SetMustNotContain(v []string) *MonitorUpdate {
+	_u.mutation.SetMustNotContain(v)
 	return _u
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (_u *MonitorUpdate) SetUpdatedAt(v time.Time) *MonitorUpdate {
-	_u.mutation.SetUpdatedAt(v)
+// AppendMustNotContain appends value to the "must_not_contain" field.
+func (_u *MonitorUpdate) AppendMustNotContain(v []string) *MonitorUpdate {
+	_u.mutation.AppendMustNotContain(v)
 	return _u
 }
 
-// AddCheckResultIDs adds the "check_results" edge to the CheckResult entity by IDs.
-func (_u *MonitorUpdate) AddCheckResultIDs(ids ...int) *MonitorUpdate {
-	_u.mutation.AddCheckResultIDs(ids...)
+// ClearMustNotContain clears the value of the "must_not_contain" field.
+func (_u *MonitorUpdate) ClearMustNotContain() *MonitorUpdate {
+	_u.mutation.ClearMustNotContain()
 	return _u
 }
 
-// AddCheckResults adds the "check_results" edges to the CheckResult entity.
-func (_u *MonitorUpdate) AddCheckResults(v ...*CheckResult) *MonitorUpdate {
-	ids := make([]int, len(v))
-	for i := range v {
-		ids[i] = v[i].ID
+// SetTreatNotFoundAsSuccess sets the "treat_not_found_as_success" field.
+func (_u *MonitorUpdate) SetTreatNotFoundAsSuccess(v bool) *MonitorUpdate {
+	_u.mutation.SetTreatNotFoundAsSuccess(v)
+	return _u
+}
+
+// SetNillableTreatNotFoundAsSuccess sets the "treat_not_found_as_success" field if the given value is not nil.
+func (_u *MonitorUpdate) SetNillableTreatNotFoundAsSuccess(v *bool) *MonitorUpdate {
+	if v != nil {
+		_u.SetTreatNotFoundAsSuccess(*v)
 	}
-	return _u.AddCheckResultIDs(ids...)
+	return _u
 }
 
-// AddNotificationEventIDs adds the "notification_events" edge to the NotificationEvent entity by IDs.
-func (_u *MonitorUpdate) AddNotificationEventIDs(ids ...int) *MonitorUpdate {
-	_u.mutation.AddNotificationEventIDs(ids...)
+// SetAcceptEmptyBody sets the "accept_empty_body" field.
+func (_u *MonitorUpdate) SetAcceptEmptyBody(v bool) *MonitorUpdate {
+	_u.mutation.SetAcceptEmptyBody(v)
 	return _u
 }
 
-// AddNotificationEvents adds the "notification_events" edges to the NotificationEvent entity.
-func (_u *MonitorUpdate) AddNotificationEvents(v ...*NotificationEvent) *MonitorUpdate {
-	ids := make([]int, len(v))
-	for i := range v {
-		ids[i] = v[i].ID
+// SetNillableAcceptEmptyBody sets the "accept_empty_body" field if the given value is not nil.
+func (_u *MonitorUpdate) SetNillableAcceptEmptyBody(v *bool) *MonitorUpdate {
+	if v != nil {
+		_u.SetAcceptEmptyBody(*v)
 	}
-	return _u.AddNotificationEventIDs(ids...)
+	return _u
 }
 
-// SetRuntimeID sets the "runtime" edge to the MonitorRuntime entity by ID.
-func (_u *MonitorUpdate) SetRuntimeID(id int) *MonitorUpdate {
-	_u.mutation.SetRuntimeID(id)
+// SetWatchdogMinutes sets the "watchdog_minutes" field.
+func (_u *MonitorUpdate) SetWatchdogMinutes(v int) *MonitorUpdate {
+	_u.mutation.ResetWatchdogMinutes()
+	_u.mutation.SetWatchdogMinutes(v)
 	return _u
 }
 
-// SetNillableRuntimeID sets the "runtime" edge to the MonitorRuntime entity by ID if the given value is not nil.
-func (_u *MonitorUpdate) SetNillableRuntimeID(id *int) *MonitorUpdate {
-	if id != nil {
-		_u = _u.SetRuntimeID(*id)
+// SetNillableWatchdogMinutes sets the "watchdog_minutes" field if the given value is not nil.
+func (_u *MonitorUpdate) SetNillableWatchdogMinutes(v *int) *MonitorUpdate {
+	if v != nil {
+		_u.SetWatchdogMinutes(*v)
 	}
 	return _u
 }
 
-// SetRuntime sets the "runtime" edge to the MonitorRuntime entity.
-func (_u *MonitorUpdate) SetRuntime(v *MonitorRuntime) *MonitorUpdate {
-	return _u.SetRuntimeID(v.ID)
+// AddWatchdogMinutes adds value to the "watchdog_minutes" field.
+func (_u *MonitorUpdate) AddWatchdogMinutes(v int) *MonitorUpdate {
+	_u.mutation.AddWatchdogMinutes(v)
+	return _u
 }
 
-// Mutation returns the MonitorMutation object of the builder.
-func (_u *MonitorUpdate) Mutation() *MonitorMutation {
-	return _u.mutation
+// ClearWatchdogMinutes clears the value of the "watchdog_minutes" field.
+func (_u *MonitorUpdate) ClearWatchdogMinutes() *MonitorUpdate {
+	_u.mutation.ClearWatchdogMinutes()
+	return _u
 }
 
-// ClearCheckResults clears all "check_results" edges to the CheckResult entity.
-func (_u *MonitorUpdate) ClearCheckResults() *MonitorUpdate {
-	_u.mutation.ClearCheckResults()
+// SetHeaderAssertions sets the "header_assertions" field.
+func (_u *MonitorUpdate) SetHeaderAssertions(v map[string]string) *MonitorUpdate {
+	_u.mutation.SetHeaderAssertions(v)
 	return _u
 }
 
-// RemoveCheckResultIDs removes the "check_results" edge to CheckResult entities by IDs.
-func (_u *MonitorUpdate) RemoveCheckResultIDs(ids ...int) *MonitorUpdate {
-	_u.mutation.RemoveCheckResultIDs(ids...)
+// ClearHeaderAssertions clears the value of the "header_assertions" field.
+func (_u *MonitorUpdate) ClearHeaderAssertions() *MonitorUpdate {
+	_u.mutation.ClearHeaderAssertions()
 	return _u
 }
 
-// RemoveCheckResults removes "check_results" edges to CheckResult entities.
-func (_u *MonitorUpdate) RemoveCheckResults(v ...*CheckResult) *MonitorUpdate {
-	ids := make([]int, len(v))
-	for i := range v {
-		ids[i] = v[i].ID
+// SetTrackHeader sets the "track_header" field.
+func (_u *MonitorUpdate) SetTrackHeader(v string) *MonitorUpdate {
+	_u.mutation.SetTrackHeader(v)
+	return _u
+}
+
+// SetNillableTrackHeader sets the "track_header" field if the given value is not nil.
+func (_u *MonitorUpdate) SetNillableTrackHeader(v *string) *MonitorUpdate {
+	if v != nil {
+		_u.SetTrackHeader(*v)
 	}
-	return _u.RemoveCheckResultIDs(ids...)
+	return _u
 }
 
-// ClearNotificationEvents clears all "notification_events" edges to the NotificationEvent entity.
-func (_u *MonitorUpdate) ClearNotificationEvents() *MonitorUpdate {
-	_u.mutation.ClearNotificationEvents()
+// ClearTrackHeader clears the value of the "track_header" field.
+func (_u *MonitorUpdate) ClearTrackHeader() *MonitorUpdate {
+	_u.mutation.ClearTrackHeader()
 	return _u
 }
 
-// RemoveNotificationEventIDs removes the "notification_events" edge to NotificationEvent entities by IDs.
-func (_u *MonitorUpdate) RemoveNotificationEventIDs(ids ...int) *MonitorUpdate {
-	_u.mutation.RemoveNotificationEventIDs(ids...)
+// SetNumericTolerance sets the "numeric_tolerance" field.
+func (_u *MonitorUpdate) SetNumericTolerance(v float64) *MonitorUpdate {
+	_u.mutation.ResetNumericTolerance()
+	_u.mutation.SetNumericTolerance(v)
 	return _u
 }
 
-// RemoveNotificationEvents removes "notification_events" edges to NotificationEvent entities.
-func (_u *MonitorUpdate) RemoveNotificationEvents(v ...*NotificationEvent) *MonitorUpdate {
-	ids := make([]int, len(v))
-	for i := range v {
-		ids[i] = v[i].ID
+// SetNillableNumericTolerance sets the "numeric_tolerance" field if the given value is not nil.
+func (_u *MonitorUpdate) SetNillableNumericTolerance(v *float64) *MonitorUpdate {
+	if v != nil {
+		_u.SetNumericTolerance(*v)
 	}
-	return _u.RemoveNotificationEventIDs(ids...)
+	return _u
 }
 
-// ClearRuntime clears the "runtime" edge to the MonitorRuntime entity.
-func (_u *MonitorUpdate) ClearRuntime() *MonitorUpdate {
-	_u.mutation.ClearRuntime()
+// AddNumericTolerance adds value to the "numeric_tolerance" field.
+func (_u *MonitorUpdate) AddNumericTolerance(v float64) *MonitorUpdate {
+	_u.mutation.AddNumericTolerance(v)
 	return _u
 }
 
-// Save executes the query and returns the number of nodes affected by the update operation.
-func (_u *MonitorUpdate) Save(ctx context.Context) (int, error) {
-	_u.defaults()
-	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+// ClearNumericTolerance clears the value of the "numeric_tolerance" field.
+func (_u *MonitorUpdate) ClearNumericTolerance() *MonitorUpdate {
+	_u.mutation.ClearNumericTolerance()
+	return _u
 }
 
-// SaveX is like Save, but panics if an error occurs.
-func (_u *MonitorUpdate) SaveX(ctx context.Context) int {
-	affected, err := _u.Save(ctx)
-	if err != nil {
-		panic(err)
+// SetRedirectPolicy sets the "redirect_policy" field.
+func (_u *MonitorUpdate) SetRedirectPolicy(v monitor.RedirectPolicy) *MonitorUpdate {
+	_u.mutation.SetRedirectPolicy(v)
+	return _u
+}
+
+// SetNillableRedirectPolicy sets the "redirect_policy" field if the given value is not nil.
+func (_u *MonitorUpdate) SetNillableRedirectPolicy(v *monitor.RedirectPolicy) *MonitorUpdate {
+	if v != nil {
+		_u.SetRedirectPolicy(*v)
 	}
-	return affected
+	return _u
 }
 
-// Exec executes the query.
-func (_u *MonitorUpdate) Exec(ctx context.Context) error {
-	_, err := _u.Save(ctx)
-	return err
+// SetMaxRedirects sets the "max_redirects" field.
+func (_u *MonitorUpdate) SetMaxRedirects(v int) *MonitorUpdate {
+	_u.mutation.ResetMaxRedirects()
+	_u.mutation.SetMaxRedirects(v)
+	return _u
 }
 
-// ExecX is like Exec, but panics if an error occurs.
-func (_u *MonitorUpdate) ExecX(ctx context.Context) {
-	if err := _u.Exec(ctx); err != nil {
-		panic(err)
+// SetNillableMaxRedirects sets the "max_redirects" field if the given value is not nil.
+func (_u *MonitorUpdate) SetNillableMaxRedirects(v *int) *MonitorUpdate {
+	if v != nil {
+		_u.SetMaxRedirects(*v)
 	}
+	return _u
 }
 
-// defaults sets the default values of the builder before save.
-func (_u *MonitorUpdate) defaults() {
-	if _, ok := _u.mutation.UpdatedAt(); !ok {
-		v := monitor.UpdateDefaultUpdatedAt()
-		_u.mutation.SetUpdatedAt(v)
-	}
+// AddMaxRedirects adds value to the "max_redirects" field.
+func (_u *MonitorUpdate) AddMaxRedirects(v int) *MonitorUpdate {
+	_u.mutation.AddMaxRedirects(v)
+	return _u
 }
 
-// check runs all checks and user-defined validators on the builder.
-func (_u *MonitorUpdate) check() error {
-	if v, ok := _u.mutation.URL(); ok {
-		if err := monitor.URLValidator(v); err != nil {
-			return &ValidationError{Name: "url", err: fmt.Errorf(`ent: validator failed for field "Monitor.url": %w`, err)}
-		}
-	}
-	if v, ok := _u.mutation.ExpectedType(); ok {
-		if err := monitor.ExpectedTypeValidator(v); err != nil {
-			return &ValidationError{Name: "expected_type", err: fmt.Errorf(`ent: validator failed for field "Monitor.expected_type": %w`, err)}
-		}
+// ClearMaxRedirects clears the value of the "max_redirects" field.
+func (_u *MonitorUpdate) ClearMaxRedirects() *MonitorUpdate {
+	_u.mutation.ClearMaxRedirects()
+	return _u
+}
+
+// SetMaxResponseBytes sets the "max_response_bytes" field.
+func (_u *MonitorUpdate) SetMaxResponseBytes(v int) *MonitorUpdate {
+	_u.mutation.ResetMaxResponseBytes()
+	_u.mutation.SetMaxResponseBytes(v)
+	return _u
+}
+
+// SetNillableMaxResponseBytes sets the "max_response_bytes" field if the given value is not nil.
+func (_u *MonitorUpdate) SetNillableMaxResponseBytes(v *int) *MonitorUpdate {
+	if v != nil {
+		_u.SetMaxResponseBytes(*v)
 	}
-	if v, ok := _u.mutation.Cron(); ok {
-		if err := monitor.CronValidator(v); err != nil {
-			return &ValidationError{Name: "cron", err: fmt.Errorf(`ent: validator failed for field "Monitor.cron": %w`, err)}
-		}
+	return _u
+}
+
+// AddMaxResponseBytes adds value to the "max_response_bytes" field.
+func (_u *MonitorUpdate) AddMaxResponseBytes(v int) *MonitorUpdate {
+	_u.mutation.AddMaxResponseBytes(v)
+	return _u
+}
+
+// ClearMaxResponseBytes clears the value of the "max_response_bytes" field.
+func (_u *MonitorUpdate) ClearMaxResponseBytes() *MonitorUpdate {
+	_u.mutation.ClearMaxResponseBytes()
+	return _u
+}
+
+// SetChecksHistoryLimit sets the "checks_history_limit" field.
+func (_u *MonitorUpdate) SetChecksHistoryLimit(v int) *MonitorUpdate {
+	_u.mutation.ResetChecksHistoryLimit()
+	_u.mutation.SetChecksHistoryLimit(v)
+	return _u
+}
+
+// SetNillableChecksHistoryLimit sets the "checks_history_limit" field if the given value is not nil.
+func (_u *MonitorUpdate) SetNillableChecksHistoryLimit(v *int) *MonitorUpdate {
+	if v != nil {
+		_u.SetChecksHistoryLimit(*v)
 	}
-	return nil
+	return _u
 }
 
-func (_u *MonitorUpdate) sqlSave(ctx context.Context) (_node int, err error) {
-	if err := _u.check(); err != nil {
-		return _node, err
+// AddChecksHistoryLimit adds value to the "checks_history_limit" field.
+func (_u *MonitorUpdate) AddChecksHistoryLimit(v int) *MonitorUpdate {
+	_u.mutation.AddChecksHistoryLimit(v)
+	return _u
+}
+
+// ClearChecksHistoryLimit clears the value of the "checks_history_limit" field.
+func (_u *MonitorUpdate) ClearChecksHistoryLimit() *MonitorUpdate {
+	_u.mutation.ClearChecksHistoryLimit()
+	return _u
+}
+
+// SetCookieJar sets the "cookie_jar" field.
+func (_u *MonitorUpdate) SetCookieJar(v bool) *MonitorUpdate {
+	_u.mutation.SetCookieJar(v)
+	return _u
+}
+
+// SetNillableCookieJar sets the "cookie_jar" field if the given value is not nil.
+func (_u *MonitorUpdate) SetNillableCookieJar(v *bool) *MonitorUpdate {
+	if v != nil {
+		_u.SetCookieJar(*v)
 	}
-	_spec := sqlgraph.NewUpdateSpec(monitor.Table, monitor.Columns, sqlgraph.NewFieldSpec(monitor.FieldID, field.TypeInt))
-	if ps := _u.mutation.predicates; len(ps) > 0 {
-		_spec.Predicate = func(selector *sql.Selector) {
-			for i := range ps {
-				ps[i](selector)
-			}
-		}
+	return _u
+}
+
+// SetHostOverrides sets the "host_overrides" field.
+func (_u *MonitorUpdate) SetHostOverrides(v map[string]string) *MonitorUpdate {
+	_u.mutation.SetHostOverrides(v)
+	return _u
+}
+
+// ClearHostOverrides clears the value of the "host_overrides" field.
+func (_u *MonitorUpdate) ClearHostOverrides() *MonitorUpdate {
+	_u.mutation.ClearHostOverrides()
+	return _u
+}
+
+// SetIPFamily sets the "ip_family" field.
+func (_u *MonitorUpdate) SetIPFamily(v monitor.IPFamily) *MonitorUpdate {
+	_u.mutation.SetIPFamily(v)
+	return _u
+}
+
+// SetNillableIPFamily sets the "ip_family" field if the given value is not nil.
+func (_u *MonitorUpdate) SetNillableIPFamily(v *monitor.IPFamily) *MonitorUpdate {
+	if v != nil {
+		_u.SetIPFamily(*v)
 	}
-	if value, ok := _u.mutation.Label(); ok {
-		_spec.SetField(monitor.FieldLabel, field.TypeString, value)
+	return _u
+}
+
+// SetTLSCaPem sets the "tls_ca_pem" field.
+func (_u *MonitorUpdate) SetTLSCaPem(v string) *MonitorUpdate {
+	_u.mutation.SetTLSCaPem(v)
+	return _u
+}
+
+// SetNillableTLSCaPem sets the "tls_ca_pem" field if the given value is not nil.
+func (_u *MonitorUpdate) SetNillableTLSCaPem(v *string) *MonitorUpdate {
+	if v != nil {
+		_u.SetTLSCaPem(*v)
 	}
-	if _u.mutation.LabelCleared() {
-		_spec.ClearField(monitor.FieldLabel, field.TypeString)
+	return _u
+}
+
+// ClearTLSCaPem clears the value of the "tls_ca_pem" field.
+func (_u *MonitorUpdate) ClearTLSCaPem() *MonitorUpdate {
+	_u.mutation.ClearTLSCaPem()
+	return _u
+}
+
+// SetTLSInsecureSkipVerify sets the "tls_insecure_skip_verify" field.
+func (_u *MonitorUpdate) SetTLSInsecureSkipVerify(v bool) *MonitorUpdate {
+	_u.mutation.SetTLSInsecureSkipVerify(v)
+	return _u
+}
+
+// SetNillableTLSInsecureSkipVerify sets the "tls_insecure_skip_verify" field if the given value is not nil.
+func (_u *MonitorUpdate) SetNillableTLSInsecureSkipVerify(v *bool) *MonitorUpdate {
+	if v != nil {
+		_u.SetTLSInsecureSkipVerify(*v)
 	}
-	if value, ok := _u.mutation.Method(); ok {
-		_spec.SetField(monitor.FieldMethod, field.TypeString, value)
+	return _u
+}
+
+// SetTLSMinVersion sets the "tls_min_version" field.
+func (_u *MonitorUpdate) SetTLSMinVersion(v string) *MonitorUpdate {
+	_u.mutation.SetTLSMinVersion(v)
+	return _u
+}
+
+// SetNillableTLSMinVersion sets the "tls_min_version" field if the given value is not nil.
+func (_u *MonitorUpdate) SetNillableTLSMinVersion(v *string) *MonitorUpdate {
+	if v != nil {
+		_u.SetTLSMinVersion(*v)
 	}
-	if value, ok := _u.mutation.URL(); ok {
-		_spec.SetField(monitor.FieldURL, field.TypeString, value)
+	return _u
+}
+
+// ClearTLSMinVersion clears the value of the "tls_min_version" field.
+func (_u *MonitorUpdate) ClearTLSMinVersion() *MonitorUpdate {
+	_u.mutation.ClearTLSMinVersion()
+	return _u
+}
+
+// SetTLSServerName sets the "tls_server_name" field.
+func (_u *MonitorUpdate) SetTLSServerName(v string) *MonitorUpdate {
+	_u.mutation.SetTLSServerName(v)
+	return _u
+}
+
+// SetNillableTLSServerName sets the "tls_server_name" field if the given value is not nil.
+func (_u *MonitorUpdate) SetNillableTLSServerName(v *string) *MonitorUpdate {
+	if v != nil {
+		_u.SetTLSServerName(*v)
 	}
-	if value, ok := _u.mutation.IconURL(); ok {
-		_spec.SetField(monitor.FieldIconURL, field.TypeString, value)
+	return _u
+}
+
+// ClearTLSServerName clears the value of the "tls_server_name" field.
+func (_u *MonitorUpdate) ClearTLSServerName() *MonitorUpdate {
+	_u.mutation.ClearTLSServerName()
+	return _u
+}
+
+// SetCron sets the "cron" field.
+func (_u *MonitorUpdate) SetCron(v string) *MonitorUpdate {
+	_u.mutation.SetCron(v)
+	return _u
+}
+
+// SetNillableCron sets the "cron" field if the given value is not nil.
+func (_u *MonitorUpdate) SetNillableCron(v *string) *MonitorUpdate {
+	if v != nil {
+		_u.SetCron(*v)
 	}
-	if _u.mutation.IconURLCleared() {
-		_spec.ClearField(monitor.FieldIconURL, field.TypeString)
+	return _u
+}
+
+// SetTimezone sets the "timezone" field.
+func (_u *MonitorUpdate) SetTimezone(v string) *MonitorUpdate {
+	_u.mutation.SetTimezone(v)
+	return _u
+}
+
+// SetNillableTimezone sets the "timezone" field if the given value is not nil.
+func (_u *MonitorUpdate) SetNillableTimezone(v *string) *MonitorUpdate {
+	if v != nil {
+		_u.SetTimezone(*v)
 	}
-	if value, ok := _u.mutation.Body(); ok {
-		_spec.SetField(monitor.FieldBody, field.TypeString, value)
+	return _u
+}
+
+// ClearTimezone clears the value of the "timezone" field.
+func (_u *MonitorUpdate) ClearTimezone() *MonitorUpdate {
+	_u.mutation.ClearTimezone()
+	return _u
+}
+
+// SetJitterSeconds sets the "jitter_seconds" field.
+func (_u *MonitorUpdate) SetJitterSeconds(v int) *MonitorUpdate {
+	_u.mutation.ResetJitterSeconds()
+	_u.mutation.SetJitterSeconds(v)
+	return _u
+}
+
+// SetNillableJitterSeconds sets the "jitter_seconds" field if the given value is not nil.
+func (_u *MonitorUpdate) SetNillableJitterSeconds(v *int) *MonitorUpdate {
+	if v != nil {
+		_u.SetJitterSeconds(*v)
 	}
-	if _u.mutation.BodyCleared() {
-		_spec.ClearField(monitor.FieldBody, field.TypeString)
+	return _u
+}
+
+// AddJitterSeconds adds value to the "jitter_seconds" field.
+func (_u *MonitorUpdate) AddJitterSeconds(v int) *MonitorUpdate {
+	_u.mutation.AddJitterSeconds(v)
+	return _u
+}
+
+// ClearJitterSeconds clears the value of the "jitter_seconds" field.
+func (_u *MonitorUpdate) ClearJitterSeconds() *MonitorUpdate {
+	_u.mutation.ClearJitterSeconds()
+	return _u
+}
+
+// SetDstPolicy sets the "dst_policy" field.
+func (_u *MonitorUpdate) SetDstPolicy(v monitor.DstPolicy) *MonitorUpdate {
+	_u.mutation.SetDstPolicy(v)
+	return _u
+}
+
+// SetNillableDstPolicy sets the "dst_policy" field if the given value is not nil.
+func (_u *MonitorUpdate) SetNillableDstPolicy(v *monitor.DstPolicy) *MonitorUpdate {
+	if v != nil {
+		_u.SetDstPolicy(*v)
 	}
-	if value, ok := _u.mutation.Headers(); ok {
-		_spec.SetField(monitor.FieldHeaders, field.TypeJSON, value)
+	return _u
+}
+
+// SetBodySnapshot sets the "body_snapshot" field.
+func (_u *MonitorUpdate) SetBodySnapshot(v monitor.BodySnapshot) *MonitorUpdate {
+	_u.mutation.SetBodySnapshot(v)
+	return _u
+}
+
+// SetNillableBodySnapshot sets the "body_snapshot" field if the given value is not nil.
+func (_u *MonitorUpdate) SetNillableBodySnapshot(v *monitor.BodySnapshot) *MonitorUpdate {
+	if v != nil {
+		_u.SetBodySnapshot(*v)
 	}
-	if _u.mutation.HeadersCleared() {
-		_spec.ClearField(monitor.FieldHeaders, field.TypeJSON)
+	return _u
+}
+
+// SetContentHash sets the "content_hash" field.
+func (_u *MonitorUpdate) SetContentHash(v monitor.ContentHash) *MonitorUpdate {
+	_u.mutation.SetContentHash(v)
+	return _u
+}
+
+// SetNillableContentHash sets the "content_hash" field if the given value is not nil.
+func (_u *MonitorUpdate) SetNillableContentHash(v *monitor.ContentHash) *MonitorUpdate {
+	if v != nil {
+		_u.SetContentHash(*v)
 	}
-	if value, ok := _u.mutation.Auth(); ok {
-		_spec.SetField(monitor.FieldAuth, field.TypeJSON, value)
+	return _u
+}
+
+// SetFetchMode sets the "fetch_mode" field.
+func (_u *MonitorUpdate) SetFetchMode(v monitor.FetchMode) *MonitorUpdate {
+	_u.mutation.SetFetchMode(v)
+	return _u
+}
+
+// SetNillableFetchMode sets the "fetch_mode" field if the given value is not nil.
+func (_u *MonitorUpdate) SetNillableFetchMode(v *monitor.FetchMode) *MonitorUpdate {
+	if v != nil {
+		_u.SetFetchMode(*v)
 	}
-	if _u.mutation.AuthCleared() {
-		_spec.ClearField(monitor.FieldAuth, field.TypeJSON)
+	return _u
+}
+
+// SetHeartbeatToken sets the "heartbeat_token" field.
+func (_u *MonitorUpdate) SetHeartbeatToken(v string) *MonitorUpdate {
+	_u.mutation.SetHeartbeatToken(v)
+	return _u
+}
+
+// SetNillableHeartbeatToken sets the "heartbeat_token" field if the given value is not nil.
+func (_u *MonitorUpdate) SetNillableHeartbeatToken(v *string) *MonitorUpdate {
+	if v != nil {
+		_u.SetHeartbeatToken(*v)
 	}
-	if value, ok := _u.mutation.NotificationChannels(); ok {
-		_spec.SetField(monitor.FieldNotificationChannels, field.TypeJSON, value)
+	return _u
+}
+
+// ClearHeartbeatToken clears the value of the "heartbeat_token" field.
+func (_u *MonitorUpdate) ClearHeartbeatToken() *MonitorUpdate {
+	_u.mutation.ClearHeartbeatToken()
+	return _u
+}
+
+// SetEnabled sets the "enabled" field.
+func (_u *MonitorUpdate) SetEnabled(v bool) *MonitorUpdate {
+	_u.mutation.SetEnabled(v)
+	return _u
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (_u *MonitorUpdate) SetNillableEnabled(v *bool) *MonitorUpdate {
+	if v != nil {
+		_u.SetEnabled(*v)
 	}
-	if value, ok := _u.mutation.AppendedNotificationChannels(); ok {
-		_spec.AddModifier(func(u *sql.UpdateBuilder) {
-			sqljson.Append(u, monitor.FieldNotificationChannels, value)
-		})
+	return _u
+}
+
+// SetStatusPage sets the "status_page" field.
+func (_u *MonitorUpdate) SetStatusPage(v bool) *MonitorUpdate {
+	_u.mutation.SetStatusPage(v)
+	return _u
+}
+
+// SetNillableStatusPage sets the "status_page" field if the given value is not nil.
+func (_u *MonitorUpdate) SetNillableStatusPage(v *bool) *MonitorUpdate {
+	if v != nil {
+		_u.SetStatusPage(*v)
 	}
-	if _u.mutation.NotificationChannelsCleared() {
-		_spec.ClearField(monitor.FieldNotificationChannels, field.TypeJSON)
+	return _u
+}
+
+// SetSortIndex sets the "sort_index" field.
+func (_u *MonitorUpdate) SetSortIndex(v int) *MonitorUpdate {
+	_u.mutation.ResetSortIndex()
+	_u.mutation.SetSortIndex(v)
+	return _u
+}
+
+// SetNillableSortIndex sets the "sort_index" field if the given value is not nil.
+func (_u *MonitorUpdate) SetNillableSortIndex(v *int) *MonitorUpdate {
+	if v != nil {
+		_u.SetSortIndex(*v)
 	}
-	if value, ok := _u.mutation.Selector(); ok {
-		_spec.SetField(monitor.FieldSelector, field.TypeString, value)
+	return _u
+}
+
+// AddSortIndex adds value to the "sort_index" field.
+func (_u *MonitorUpdate) AddSortIndex(v int) *MonitorUpdate {
+	_u.mutation.AddSortIndex(v)
+	return _u
+}
+
+// SetArchivedAt sets the "archived_at" field.
+func (_u *MonitorUpdate) SetArchivedAt(v time.Time) *MonitorUpdate {
+	_u.mutation.SetArchivedAt(v)
+	return _u
+}
+
+// SetNillableArchivedAt sets the "archived_at" field if the given value is not nil.
+func (_u *MonitorUpdate) SetNillableArchivedAt(v *time.Time) *MonitorUpdate {
+	if v != nil {
+		_u.SetArchivedAt(*v)
 	}
-	if _u.mutation.SelectorCleared() {
-		_spec.ClearField(monitor.FieldSelector, field.TypeString)
+	return _u
+}
+
+// ClearArchivedAt clears the value of the "archived_at" field.
+func (_u *MonitorUpdate) ClearArchivedAt() *MonitorUpdate {
+	_u.mutation.ClearArchivedAt()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *MonitorUpdate) SetUpdatedAt(v time.Time) *MonitorUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// AddCheckResultIDs adds the "check_results" edge to the CheckResult entity by IDs.
+func (_u *MonitorUpdate) AddCheckResultIDs(ids ...int) *MonitorUpdate {
+	_u.mutation.AddCheckResultIDs(ids...)
+	return _u
+}
+
+// AddCheckResults adds the "check_results" edges to the CheckResult entity.
+func (_u *MonitorUpdate) AddCheckResults(v ...*CheckResult) *MonitorUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
 	}
-	if value, ok := _u.mutation.ExpectedType(); ok {
-		_spec.SetField(monitor.FieldExpectedType, field.TypeEnum, value)
+	return _u.AddCheckResultIDs(ids...)
+}
+
+// AddNotificationEventIDs adds the "notification_events" edge to the NotificationEvent entity by IDs.
+func (_u *MonitorUpdate) AddNotificationEventIDs(ids ...int) *MonitorUpdate {
+	_u.mutation.AddNotificationEventIDs(ids...)
+	return _u
+}
+
+// AddNotificationEvents adds the "notification_events" edges to the NotificationEvent entity.
+func (_u *MonitorUpdate) AddNotificationEvents(v ...*NotificationEvent) *MonitorUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
 	}
-	if value, ok := _u.mutation.ExpectedResponse(); ok {
-		_spec.SetField(monitor.FieldExpectedResponse, field.TypeString, value)
+	return _u.AddNotificationEventIDs(ids...)
+}
+
+// SetRuntimeID sets the "runtime" edge to the MonitorRuntime entity by ID.
+func (_u *MonitorUpdate) SetRuntimeID(id int) *MonitorUpdate {
+	_u.mutation.SetRuntimeID(id)
+	return _u
+}
+
+// SetNillableRuntimeID sets the "runtime" edge to the MonitorRuntime entity by ID if the given value is not nil.
+func (_u *MonitorUpdate) SetNillableRuntimeID(id *int) *MonitorUpdate {
+	if id != nil {
+		_u = _u.SetRuntimeID(*id)
 	}
-	if _u.mutation.ExpectedResponseCleared() {
-		_spec.ClearField(monitor.FieldExpectedResponse, field.TypeString)
+	return _u
+}
+
+// SetRuntime sets the "runtime" edge to the MonitorRuntime entity.
+func (_u *MonitorUpdate) SetRuntime(v *MonitorRuntime) *MonitorUpdate {
+	return _u.SetRuntimeID(v.ID)
+}
+
+// AddVersionIDs adds the "versions" edge to the MonitorVersion entity by IDs.
+func (_u *MonitorUpdate) AddVersionIDs(ids ...int) *MonitorUpdate {
+	_u.mutation.AddVersionIDs(ids...)
+	return _u
+}
+
+// AddVersions adds the "versions" edges to the MonitorVersion entity.
+func (_u *MonitorUpdate) AddVersions(v ...*MonitorVersion) *MonitorUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
 	}
-	if value, ok := _u.mutation.Cron(); ok {
-		_spec.SetField(monitor.FieldCron, field.TypeString, value)
+	return _u.AddVersionIDs(ids...)
+}
+
+// AddRollupIDs adds the "rollups" edge to the CheckRollup entity by IDs.
+func (_u *MonitorUpdate) AddRollupIDs(ids ...int) *MonitorUpdate {
+	_u.mutation.AddRollupIDs(ids...)
+	return _u
+}
+
+// AddRollups adds the "rollups" edges to the CheckRollup entity.
+func (_u *MonitorUpdate) AddRollups(v ...*CheckRollup) *MonitorUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
 	}
-	if value, ok := _u.mutation.Enabled(); ok {
-		_spec.SetField(monitor.FieldEnabled, field.TypeBool, value)
+	return _u.AddRollupIDs(ids...)
+}
+
+// SetHeaderProfile sets the "header_profile" edge to the HeaderProfile entity.
+func (_u *MonitorUpdate) SetHeaderProfile(v *HeaderProfile) *MonitorUpdate {
+	return _u.SetHeaderProfileID(v.ID)
+}
+
+// Mutation returns the MonitorMutation object of the builder.
+func (_u *MonitorUpdate) Mutation() *MonitorMutation {
+	return _u.mutation
+}
+
+// ClearCheckResults clears all "check_results" edges to the CheckResult entity.
+func (_u *MonitorUpdate) ClearCheckResults() *MonitorUpdate {
+	_u.mutation.ClearCheckResults()
+	return _u
+}
+
+// RemoveCheckResultIDs removes the "check_results" edge to CheckResult entities by IDs.
+func (_u *MonitorUpdate) RemoveCheckResultIDs(ids ...int) *MonitorUpdate {
+	_u.mutation.RemoveCheckResultIDs(ids...)
+	return _u
+}
+
+// RemoveCheckResults removes "check_results" edges to CheckResult entities.
+func (_u *MonitorUpdate) RemoveCheckResults(v ...*CheckResult) *MonitorUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
 	}
-	if value, ok := _u.mutation.UpdatedAt(); ok {
-		_spec.SetField(monitor.FieldUpdatedAt, field.TypeTime, value)
+	return _u.RemoveCheckResultIDs(ids...)
+}
+
+// ClearNotificationEvents clears all "notification_events" edges to the NotificationEvent entity.
+func (_u *MonitorUpdate) ClearNotificationEvents() *MonitorUpdate {
+	_u.mutation.ClearNotificationEvents()
+	return _u
+}
+
+// RemoveNotificationEventIDs removes the "notification_events" edge to NotificationEvent entities by IDs.
+func (_u *MonitorUpdate) RemoveNotificationEventIDs(ids ...int) *MonitorUpdate {
+	_u.mutation.RemoveNotificationEventIDs(ids...)
+	return _u
+}
+
+// RemoveNotificationEvents removes "notification_events" edges to NotificationEvent entities.
+func (_u *MonitorUpdate) RemoveNotificationEvents(v ...*NotificationEvent) *MonitorUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
 	}
-	if _u.mutation.CheckResultsCleared() {
-		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
-			Inverse: false,
-			Table:   monitor.CheckResultsTable,
-			Columns: []string{monitor.CheckResultsColumn},
-			Bidi:    false,
-			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(checkresult.FieldID, field.TypeInt),
-			},
+	return _u.RemoveNotificationEventIDs(ids...)
+}
+
+// ClearRuntime clears the "runtime" edge to the MonitorRuntime entity.
+func (_u *MonitorUpdate) ClearRuntime() *MonitorUpdate {
+	_u.mutation.ClearRuntime()
+	return _u
+}
+
+// ClearVersions clears all "versions" edges to the MonitorVersion entity.
+func (_u *MonitorUpdate) ClearVersions() *MonitorUpdate {
+	_u.mutation.ClearVersions()
+	return _u
+}
+
+// RemoveVersionIDs removes the "versions" edge to MonitorVersion entities by IDs.
+func (_u *MonitorUpdate) RemoveVersionIDs(ids ...int) *MonitorUpdate {
+	_u.mutation.RemoveVersionIDs(ids...)
+	return _u
+}
+
+// RemoveVersions removes "versions" edges to MonitorVersion entities.
+func (_u *MonitorUpdate) RemoveVersions(v ...*MonitorVersion) *MonitorUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveVersionIDs(ids...)
+}
+
+// ClearRollups clears all "rollups" edges to the CheckRollup entity.
+func (_u *MonitorUpdate) ClearRollups() *MonitorUpdate {
+	_u.mutation.ClearRollups()
+	return _u
+}
+
+// RemoveRollupIDs removes the "rollups" edge to CheckRollup entities by IDs.
+func (_u *MonitorUpdate) RemoveRollupIDs(ids ...int) *MonitorUpdate {
+	_u.mutation.RemoveRollupIDs(ids...)
+	return _u
+}
+
+// RemoveRollups removes "rollups" edges to CheckRollup entities.
+func (_u *MonitorUpdate) RemoveRollups(v ...*CheckRollup) *MonitorUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveRollupIDs(ids...)
+}
+
+// ClearHeaderProfile clears the "header_profile" edge to the HeaderProfile entity.
+func (_u *MonitorUpdate) ClearHeaderProfile() *MonitorUpdate {
+	_u.mutation.ClearHeaderProfile()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *MonitorUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *MonitorUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *MonitorUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *MonitorUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *MonitorUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := monitor.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *MonitorUpdate) check() error {
+	if v, ok := _u.mutation.URL(); ok {
+		if err := monitor.URLValidator(v); err != nil {
+			return &ValidationError{Name: "url", err: fmt.Errorf(`ent: validator failed for field "Monitor.url": %w`, err)}
 		}
-		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedCheckResultsIDs(); len(nodes) > 0 && !_u.mutation.CheckResultsCleared() {
-		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
-			Inverse: false,
-			Table:   monitor.CheckResultsTable,
-			Columns: []string{monitor.CheckResultsColumn},
-			Bidi:    false,
-			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(checkresult.FieldID, field.TypeInt),
-			},
+	if v, ok := _u.mutation.EscalationAfterMinutes(); ok {
+		if err := monitor.EscalationAfterMinutesValidator(v); err != nil {
+			return &ValidationError{Name: "escalation_after_minutes", err: fmt.Errorf(`ent: validator failed for field "Monitor.escalation_after_minutes": %w`, err)}
 		}
-		for _, k := range nodes {
-			edge.Target.Nodes = append(edge.Target.Nodes, k)
+	}
+	if v, ok := _u.mutation.ExpectedType(); ok {
+		if err := monitor.ExpectedTypeValidator(v); err != nil {
+			return &ValidationError{Name: "expected_type", err: fmt.Errorf(`ent: validator failed for field "Monitor.expected_type": %w`, err)}
 		}
-		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.CheckResultsIDs(); len(nodes) > 0 {
-		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
-			Inverse: false,
-			Table:   monitor.CheckResultsTable,
-			Columns: []string{monitor.CheckResultsColumn},
-			Bidi:    false,
-			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(checkresult.FieldID, field.TypeInt),
-			},
+	if v, ok := _u.mutation.WatchdogMinutes(); ok {
+		if err := monitor.WatchdogMinutesValidator(v); err != nil {
+			return &ValidationError{Name: "watchdog_minutes", err: fmt.Errorf(`ent: validator failed for field "Monitor.watchdog_minutes": %w`, err)}
 		}
-		for _, k := range nodes {
-			edge.Target.Nodes = append(edge.Target.Nodes, k)
+	}
+	if v, ok := _u.mutation.NumericTolerance(); ok {
+		if err := monitor.NumericToleranceValidator(v); err != nil {
+			return &ValidationError{Name: "numeric_tolerance", err: fmt.Errorf(`ent: validator failed for field "Monitor.numeric_tolerance": %w`, err)}
 		}
-		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.NotificationEventsCleared() {
-		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
-			Inverse: false,
-			Table:   monitor.NotificationEventsTable,
-			Columns: []string{monitor.NotificationEventsColumn},
-			Bidi:    false,
-			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(notificationevent.FieldID, field.TypeInt),
-			},
+	if v, ok := _u.mutation.RedirectPolicy(); ok {
+		if err := monitor.RedirectPolicyValidator(v); err != nil {
+			return &ValidationError{Name: "redirect_policy", err: fmt.Errorf(`ent: validator failed for field "Monitor.redirect_policy": %w`, err)}
 		}
-		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedNotificationEventsIDs(); len(nodes) > 0 && !_u.mutation.NotificationEventsCleared() {
-		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
-			Inverse: false,
-			Table:   monitor.NotificationEventsTable,
-			Columns: []string{monitor.NotificationEventsColumn},
-			Bidi:    false,
-			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(notificationevent.FieldID, field.TypeInt),
-			},
-		}
-		for _, k := range nodes {
-			edge.Target.Nodes = append(edge.Target.Nodes, k)
-		}
-		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	if v, ok := _u.mutation.MaxRedirects(); ok {
+		if err := monitor.MaxRedirectsValidator(v); err != nil {
+			return &ValidationError{Name: "max_redirects", err: fmt.Errorf(`ent: validator failed for field "Monitor.max_redirects": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.MaxResponseBytes(); ok {
+		if err := monitor.MaxResponseBytesValidator(v); err != nil {
+			return &ValidationError{Name: "max_response_bytes", err: fmt.Errorf(`ent: validator failed for field "Monitor.max_response_bytes": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ChecksHistoryLimit(); ok {
+		if err := monitor.ChecksHistoryLimitValidator(v); err != nil {
+			return &ValidationError{Name: "checks_history_limit", err: fmt.Errorf(`ent: validator failed for field "Monitor.checks_history_limit": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.IPFamily(); ok {
+		if err := monitor.IPFamilyValidator(v); err != nil {
+			return &ValidationError{Name: "ip_family", err: fmt.Errorf(`ent: validator failed for field "Monitor.ip_family": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Cron(); ok {
+		if err := monitor.CronValidator(v); err != nil {
+			return &ValidationError{Name: "cron", err: fmt.Errorf(`ent: validator failed for field "Monitor.cron": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.JitterSeconds(); ok {
+		if err := monitor.JitterSecondsValidator(v); err != nil {
+			return &ValidationError{Name: "jitter_seconds", err: fmt.Errorf(`ent: validator failed for field "Monitor.jitter_seconds": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.DstPolicy(); ok {
+		if err := monitor.DstPolicyValidator(v); err != nil {
+			return &ValidationError{Name: "dst_policy", err: fmt.Errorf(`ent: validator failed for field "Monitor.dst_policy": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.BodySnapshot(); ok {
+		if err := monitor.BodySnapshotValidator(v); err != nil {
+			return &ValidationError{Name: "body_snapshot", err: fmt.Errorf(`ent: validator failed for field "Monitor.body_snapshot": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ContentHash(); ok {
+		if err := monitor.ContentHashValidator(v); err != nil {
+			return &ValidationError{Name: "content_hash", err: fmt.Errorf(`ent: validator failed for field "Monitor.content_hash": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.FetchMode(); ok {
+		if err := monitor.FetchModeValidator(v); err != nil {
+			return &ValidationError{Name: "fetch_mode", err: fmt.Errorf(`ent: validator failed for field "Monitor.fetch_mode": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *MonitorUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(monitor.Table, monitor.Columns, sqlgraph.NewFieldSpec(monitor.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Label(); ok {
+		_spec.SetField(monitor.FieldLabel, field.TypeString, value)
+	}
+	if _u.mutation.LabelCleared() {
+		_spec.ClearField(monitor.FieldLabel, field.TypeString)
+	}
+	if value, ok := _u.mutation.Method(); ok {
+		_spec.SetField(monitor.FieldMethod, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.URL(); ok {
+		_spec.SetField(monitor.FieldURL, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.IconURL(); ok {
+		_spec.SetField(monitor.FieldIconURL, field.TypeString, value)
+	}
+	if _u.mutation.IconURLCleared() {
+		_spec.ClearField(monitor.FieldIconURL, field.TypeString)
+	}
+	if value, ok := _u.mutation.Body(); ok {
+		_spec.SetField(monitor.FieldBody, field.TypeString, value)
+	}
+	if _u.mutation.BodyCleared() {
+		_spec.ClearField(monitor.FieldBody, field.TypeString)
+	}
+	if value, ok := _u.mutation.Headers(); ok {
+		_spec.SetField(monitor.FieldHeaders, field.TypeJSON, value)
+	}
+	if _u.mutation.HeadersCleared() {
+		_spec.ClearField(monitor.FieldHeaders, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.UserAgent(); ok {
+		_spec.SetField(monitor.FieldUserAgent, field.TypeString, value)
+	}
+	if _u.mutation.UserAgentCleared() {
+		_spec.ClearField(monitor.FieldUserAgent, field.TypeString)
+	}
+	if value, ok := _u.mutation.Auth(); ok {
+		_spec.SetField(monitor.FieldAuth, field.TypeJSON, value)
+	}
+	if _u.mutation.AuthCleared() {
+		_spec.ClearField(monitor.FieldAuth, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.NotificationChannels(); ok {
+		_spec.SetField(monitor.FieldNotificationChannels, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedNotificationChannels(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, monitor.FieldNotificationChannels, value)
+		})
+	}
+	if _u.mutation.NotificationChannelsCleared() {
+		_spec.ClearField(monitor.FieldNotificationChannels, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.EscalationChannels(); ok {
+		_spec.SetField(monitor.FieldEscalationChannels, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedEscalationChannels(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, monitor.FieldEscalationChannels, value)
+		})
+	}
+	if _u.mutation.EscalationChannelsCleared() {
+		_spec.ClearField(monitor.FieldEscalationChannels, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Tags(); ok {
+		_spec.SetField(monitor.FieldTags, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedTags(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, monitor.FieldTags, value)
+		})
+	}
+	if _u.mutation.TagsCleared() {
+		_spec.ClearField(monitor.FieldTags, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.EscalationAfterMinutes(); ok {
+		_spec.SetField(monitor.FieldEscalationAfterMinutes, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedEscalationAfterMinutes(); ok {
+		_spec.AddField(monitor.FieldEscalationAfterMinutes, field.TypeInt, value)
+	}
+	if _u.mutation.EscalationAfterMinutesCleared() {
+		_spec.ClearField(monitor.FieldEscalationAfterMinutes, field.TypeInt)
+	}
+	if value, ok := _u.mutation.Selector(); ok {
+		_spec.SetField(monitor.FieldSelector, field.TypeString, value)
+	}
+	if _u.mutation.SelectorCleared() {
+		_spec.ClearField(monitor.FieldSelector, field.TypeString)
+	}
+	if value, ok := _u.mutation.ExpectedStatus(); ok {
+		_spec.SetField(monitor.FieldExpectedStatus, field.TypeString, value)
+	}
+	if _u.mutation.ExpectedStatusCleared() {
+		_spec.ClearField(monitor.FieldExpectedStatus, field.TypeString)
+	}
+	if value, ok := _u.mutation.RetryOn(); ok {
+		_spec.SetField(monitor.FieldRetryOn, field.TypeString, value)
+	}
+	if _u.mutation.RetryOnCleared() {
+		_spec.ClearField(monitor.FieldRetryOn, field.TypeString)
+	}
+	if value, ok := _u.mutation.ExpectedType(); ok {
+		_spec.SetField(monitor.FieldExpectedType, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.ExpectedResponse(); ok {
+		_spec.SetField(monitor.FieldExpectedResponse, field.TypeString, value)
+	}
+	if _u.mutation.ExpectedResponseCleared() {
+		_spec.ClearField(monitor.FieldExpectedResponse, field.TypeString)
+	}
+	if value, ok := _u.mutation.MustContain(); ok {
+		_spec.SetField(monitor.FieldMustContain, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedMustContain(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, monitor.FieldMustContain, value)
+		})
+	}
+	if _u.mutation.MustContainCleared() {
+		_spec.ClearField(monitor.FieldMustContain, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.MustNotContain(); ok {
+		_spec.SetField(monitor.FieldMustNotContain, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedMustNotContain(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, monitor.FieldMustNotContain, value)
+		})
+	}
+	if _u.mutation.MustNotContainCleared() {
+		_spec.ClearField(monitor.FieldMustNotContain, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.TreatNotFoundAsSuccess(); ok {
+		_spec.SetField(monitor.FieldTreatNotFoundAsSuccess, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.AcceptEmptyBody(); ok {
+		_spec.SetField(monitor.FieldAcceptEmptyBody, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.WatchdogMinutes(); ok {
+		_spec.SetField(monitor.FieldWatchdogMinutes, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedWatchdogMinutes(); ok {
+		_spec.AddField(monitor.FieldWatchdogMinutes, field.TypeInt, value)
+	}
+	if _u.mutation.WatchdogMinutesCleared() {
+		_spec.ClearField(monitor.FieldWatchdogMinutes, field.TypeInt)
+	}
+	if value, ok := _u.mutation.HeaderAssertions(); ok {
+		_spec.SetField(monitor.FieldHeaderAssertions, field.TypeJSON, value)
+	}
+	if _u.mutation.HeaderAssertionsCleared() {
+		_spec.ClearField(monitor.FieldHeaderAssertions, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.TrackHeader(); ok {
+		_spec.SetField(monitor.FieldTrackHeader, field.TypeString, value)
+	}
+	if _u.mutation.TrackHeaderCleared() {
+		_spec.ClearField(monitor.FieldTrackHeader, field.TypeString)
+	}
+	if value, ok := _u.mutation.NumericTolerance(); ok {
+		_spec.SetField(monitor.FieldNumericTolerance, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedNumericTolerance(); ok {
+		_spec.AddField(monitor.FieldNumericTolerance, field.TypeFloat64, value)
+	}
+	if _u.mutation.NumericToleranceCleared() {
+		_spec.ClearField(monitor.FieldNumericTolerance, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.RedirectPolicy(); ok {
+		_spec.SetField(monitor.FieldRedirectPolicy, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.MaxRedirects(); ok {
+		_spec.SetField(monitor.FieldMaxRedirects, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedMaxRedirects(); ok {
+		_spec.AddField(monitor.FieldMaxRedirects, field.TypeInt, value)
+	}
+	if _u.mutation.MaxRedirectsCleared() {
+		_spec.ClearField(monitor.FieldMaxRedirects, field.TypeInt)
+	}
+	if value, ok := _u.mutation.MaxResponseBytes(); ok {
+		_spec.SetField(monitor.FieldMaxResponseBytes, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedMaxResponseBytes(); ok {
+		_spec.AddField(monitor.FieldMaxResponseBytes, field.TypeInt, value)
+	}
+	if _u.mutation.MaxResponseBytesCleared() {
+		_spec.ClearField(monitor.FieldMaxResponseBytes, field.TypeInt)
+	}
+	if value, ok := _u.mutation.ChecksHistoryLimit(); ok {
+		_spec.SetField(monitor.FieldChecksHistoryLimit, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedChecksHistoryLimit(); ok {
+		_spec.AddField(monitor.FieldChecksHistoryLimit, field.TypeInt, value)
+	}
+	if _u.mutation.ChecksHistoryLimitCleared() {
+		_spec.ClearField(monitor.FieldChecksHistoryLimit, field.TypeInt)
+	}
+	if value, ok := _u.mutation.CookieJar(); ok {
+		_spec.SetField(monitor.FieldCookieJar, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.HostOverrides(); ok {
+		_spec.SetField(monitor.FieldHostOverrides, field.TypeJSON, value)
+	}
+	if _u.mutation.HostOverridesCleared() {
+		_spec.ClearField(monitor.FieldHostOverrides, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.IPFamily(); ok {
+		_spec.SetField(monitor.FieldIPFamily, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.TLSCaPem(); ok {
+		_spec.SetField(monitor.FieldTLSCaPem, field.TypeString, value)
+	}
+	if _u.mutation.TLSCaPemCleared() {
+		_spec.ClearField(monitor.FieldTLSCaPem, field.TypeString)
+	}
+	if value, ok := _u.mutation.TLSInsecureSkipVerify(); ok {
+		_spec.SetField(monitor.FieldTLSInsecureSkipVerify, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.TLSMinVersion(); ok {
+		_spec.SetField(monitor.FieldTLSMinVersion, field.TypeString, value)
+	}
+	if _u.mutation.TLSMinVersionCleared() {
+		_spec.ClearField(monitor.FieldTLSMinVersion, field.TypeString)
+	}
+	if value, ok := _u.mutation.TLSServerName(); ok {
+		_spec.SetField(monitor.FieldTLSServerName, field.TypeString, value)
+	}
+	if _u.mutation.TLSServerNameCleared() {
+		_spec.ClearField(monitor.FieldTLSServerName, field.TypeString)
+	}
+	if value, ok := _u.mutation.Cron(); ok {
+		_spec.SetField(monitor.FieldCron, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Timezone(); ok {
+		_spec.SetField(monitor.FieldTimezone, field.TypeString, value)
+	}
+	if _u.mutation.TimezoneCleared() {
+		_spec.ClearField(monitor.FieldTimezone, field.TypeString)
+	}
+	if value, ok := _u.mutation.JitterSeconds(); ok {
+		_spec.SetField(monitor.FieldJitterSeconds, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedJitterSeconds(); ok {
+		_spec.AddField(monitor.FieldJitterSeconds, field.TypeInt, value)
+	}
+	if _u.mutation.JitterSecondsCleared() {
+		_spec.ClearField(monitor.FieldJitterSeconds, field.TypeInt)
+	}
+	if value, ok := _u.mutation.DstPolicy(); ok {
+		_spec.SetField(monitor.FieldDstPolicy, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.BodySnapshot(); ok {
+		_spec.SetField(monitor.FieldBodySnapshot, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.ContentHash(); ok {
+		_spec.SetField(monitor.FieldContentHash, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.FetchMode(); ok {
+		_spec.SetField(monitor.FieldFetchMode, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.HeartbeatToken(); ok {
+		_spec.SetField(monitor.FieldHeartbeatToken, field.TypeString, value)
+	}
+	if _u.mutation.HeartbeatTokenCleared() {
+		_spec.ClearField(monitor.FieldHeartbeatToken, field.TypeString)
+	}
+	if value, ok := _u.mutation.Enabled(); ok {
+		_spec.SetField(monitor.FieldEnabled, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.StatusPage(); ok {
+		_spec.SetField(monitor.FieldStatusPage, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.SortIndex(); ok {
+		_spec.SetField(monitor.FieldSortIndex, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedSortIndex(); ok {
+		_spec.AddField(monitor.FieldSortIndex, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.ArchivedAt(); ok {
+		_spec.SetField(monitor.FieldArchivedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ArchivedAtCleared() {
+		_spec.ClearField(monitor.FieldArchivedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(monitor.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.CheckResultsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   monitor.CheckResultsTable,
+			Columns: []string{monitor.CheckResultsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(checkresult.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedCheckResultsIDs(); len(nodes) > 0 && !_u.mutation.CheckResultsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   monitor.CheckResultsTable,
+			Columns: []string{monitor.CheckResultsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(checkresult.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.CheckResultsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   monitor.CheckResultsTable,
+			Columns: []string{monitor.CheckResultsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(checkresult.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.NotificationEventsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   monitor.NotificationEventsTable,
+			Columns: []string{monitor.NotificationEventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notificationevent.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedNotificationEventsIDs(); len(nodes) > 0 && !_u.mutation.NotificationEventsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   monitor.NotificationEventsTable,
+			Columns: []string{monitor.NotificationEventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notificationevent.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.NotificationEventsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   monitor.NotificationEventsTable,
+			Columns: []string{monitor.NotificationEventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notificationevent.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.RuntimeCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   monitor.RuntimeTable,
+			Columns: []string{monitor.RuntimeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(monitorruntime.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RuntimeIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   monitor.RuntimeTable,
+			Columns: []string{monitor.RuntimeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(monitorruntime.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.VersionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   monitor.VersionsTable,
+			Columns: []string{monitor.VersionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(monitorversion.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedVersionsIDs(); len(nodes) > 0 && !_u.mutation.VersionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   monitor.VersionsTable,
+			Columns: []string{monitor.VersionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(monitorversion.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.VersionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   monitor.VersionsTable,
+			Columns: []string{monitor.VersionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(monitorversion.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.RollupsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   monitor.RollupsTable,
+			Columns: []string{monitor.RollupsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(checkrollup.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedRollupsIDs(); len(nodes) > 0 && !_u.mutation.RollupsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   monitor.RollupsTable,
+			Columns: []string{monitor.RollupsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(checkrollup.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RollupsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   monitor.RollupsTable,
+			Columns: []string{monitor.RollupsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(checkrollup.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.HeaderProfileCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   monitor.HeaderProfileTable,
+			Columns: []string{monitor.HeaderProfileColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(headerprofile.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.HeaderProfileIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   monitor.HeaderProfileTable,
+			Columns: []string{monitor.HeaderProfileColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(headerprofile.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{monitor.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// MonitorUpdateOne is the builder for updating a single Monitor entity.
+type MonitorUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *MonitorMutation
+}
+
+// SetLabel sets the "label" field.
+func (_u *MonitorUpdateOne) SetLabel(v string) *MonitorUpdateOne {
+	_u.mutation.SetLabel(v)
+	return _u
+}
+
+// SetNillableLabel sets the "label" field if the given value is not nil.
+func (_u *MonitorUpdateOne) SetNillableLabel(v *string) *MonitorUpdateOne {
+	if v != nil {
+		_u.SetLabel(*v)
+	}
+	return _u
+}
+
+// ClearLabel clears the value of the "label" field.
+func (_u *MonitorUpdateOne) ClearLabel() *MonitorUpdateOne {
+	_u.mutation.ClearLabel()
+	return _u
+}
+
+// SetMethod sets the "method" field.
+func (_u *MonitorUpdateOne) SetMethod(v string) *MonitorUpdateOne {
+	_u.mutation.SetMethod(v)
+	return _u
+}
+
+// SetNillableMethod sets the "method" field if the given value is not nil.
+func (_u *MonitorUpdateOne) SetNillableMethod(v *string) *MonitorUpdateOne {
+	if v != nil {
+		_u.SetMethod(*v)
+	}
+	return _u
+}
+
+// SetURL sets the "url" field.
+func (_u *MonitorUpdateOne) SetURL(v string) *MonitorUpdateOne {
+	_u.mutation.SetURL(v)
+	return _u
+}
+
+// SetNillableURL sets the "url" field if the given value is not nil.
+func (_u *MonitorUpdateOne) SetNillableURL(v *string) *MonitorUpdateOne {
+	if v != nil {
+		_u.SetURL(*v)
+	}
+	return _u
+}
+
+// SetIconURL sets the "icon_url" field.
+func (_u *MonitorUpdateOne) SetIconURL(v string) *MonitorUpdateOne {
+	_u.mutation.SetIconURL(v)
+	return _u
+}
+
+// SetNillableIconURL sets the "icon_url" field if the given value is not nil.
+func (_u *MonitorUpdateOne) SetNillableIconURL(v *string) *MonitorUpdateOne {
+	if v != nil {
+		_u.SetIconURL(*v)
+	}
+	return _u
+}
+
+// ClearIconURL clears the value of the "icon_url" field.
+func (_u *MonitorUpdateOne) ClearIconURL() *MonitorUpdateOne {
+	_u.mutation.ClearIconURL()
+	return _u
+}
+
+// SetBody sets the "body" field.
+func (_u *MonitorUpdateOne) SetBody(v string) *MonitorUpdateOne {
+	_u.mutation.SetBody(v)
+	return _u
+}
+
+// SetNillableBody sets the "body" field if the given value is not nil.
+func (_u *MonitorUpdateOne) SetNillableBody(v *string) *MonitorUpdateOne {
+	if v != nil {
+		_u.SetBody(*v)
+	}
+	return _u
+}
+
+// ClearBody clears the value of the "body" field.
+func (_u *MonitorUpdateOne) ClearBody() *MonitorUpdateOne {
+	_u.mutation.ClearBody()
+	return _u
+}
+
+// SetHeaders sets the "headers" field.
+func (_u *MonitorUpdateOne) SetHeaders(v map[string]string) *MonitorUpdateOne {
+	_u.mutation.SetHeaders(v)
+	return _u
+}
+
+// ClearHeaders clears the value of the "headers" field.
+func (_u *MonitorUpdateOne) ClearHeaders() *MonitorUpdateOne {
+	_u.mutation.ClearHeaders()
+	return _u
+}
+
+// SetUserAgent sets the "user_agent" field.
+func (_u *MonitorUpdateOne) SetUserAgent(v string) *MonitorUpdateOne {
+	_u.mutation.SetUserAgent(v)
+	return _u
+}
+
+// SetNillableUserAgent sets the "user_agent" field if the given value is not nil.
+func (_u *MonitorUpdateOne) SetNillableUserAgent(v *string) *MonitorUpdateOne {
+	if v != nil {
+		_u.SetUserAgent(*v)
+	}
+	return _u
+}
+
+// ClearUserAgent clears the value of the "user_agent" field.
+func (_u *MonitorUpdateOne) ClearUserAgent() *MonitorUpdateOne {
+	_u.mutation.ClearUserAgent()
+	return _u
+}
+
+// SetHeaderProfileID sets the "header_profile_id" field.
+func (_u *MonitorUpdateOne) SetHeaderProfileID(v int) *MonitorUpdateOne {
+	_u.mutation.SetHeaderProfileID(v)
+	return _u
+}
+
+// SetNillableHeaderProfileID sets the "header_profile_id" field if the given value is not nil.
+func (_u *MonitorUpdateOne) SetNillableHeaderProfileID(v *int) *MonitorUpdateOne {
+	if v != nil {
+		_u.SetHeaderProfileID(*v)
+	}
+	return _u
+}
+
+// ClearHeaderProfileID clears the value of the "header_profile_id" field.
+func (_u *MonitorUpdateOne) ClearHeaderProfileID() *MonitorUpdateOne {
+	_u.mutation.ClearHeaderProfileID()
+	return _u
+}
+
+// SetAuth sets the "auth" field.
+func (_u *MonitorUpdateOne) SetAuth(v map[string]string) *MonitorUpdateOne {
+	_u.mutation.SetAuth(v)
+	return _u
+}
+
+// ClearAuth clears the value of the "auth" field.
+func (_u *MonitorUpdateOne) ClearAuth() *MonitorUpdateOne {
+	_u.mutation.ClearAuth()
+	return _u
+}
+
+// SetNotificationChannels sets the "notification_channels" field.
+func (_u *MonitorUpdateOne) SetNotificationChannels(v []string) *MonitorUpdateOne {
+	_u.mutation.SetNotificationChannels(v)
+	return _u
+}
+
+// AppendNotificationChannels appends value to the "notification_channels" field.
+func (_u *MonitorUpdateOne) AppendNotificationChannels(v []string) *MonitorUpdateOne {
+	_u.mutation.AppendNotificationChannels(v)
+	return _u
+}
+
+// ClearNotificationChannels clears the value of the "notification_channels" field.
+func (_u *MonitorUpdateOne) ClearNotificationChannels() *MonitorUpdateOne {
+	_u.mutation.ClearNotificationChannels()
+	return _u
+}
+
+// SetEscalationChannels sets the "escalation_channels" field.
+func (_u *MonitorUpdateOne) SetEscalationChannels(v []string) *MonitorUpdateOne {
+	_u.mutation.SetEscalationChannels(v)
+	return _u
+}
+
+// AppendEscalationChannels appends value to the "escalation_channels" field.
+func (_u *MonitorUpdateOne) AppendEscalationChannels(v []string) *MonitorUpdateOne {
+	_u.mutation.AppendEscalationChannels(v)
+	return _u
+}
+
+// ClearEscalationChannels clears the value of the "escalation_channels" field.
+func (_u *MonitorUpdateOne) ClearEscalationChannels() *MonitorUpdateOne {
+	_u.mutation.ClearEscalationChannels()
+	return _u
+}
+
+// SetTags sets the "tags" field.
+func (_u *MonitorUpdateOne) SetTags(v []string) *MonitorUpdateOne {
+	_u.mutation.SetTags(v)
+	return _u
+}
+
+// AppendTags appends value to the "tags" field.
+func (_u *MonitorUpdateOne) AppendTags(v []string) *MonitorUpdateOne {
+	_u.mutation.AppendTags(v)
+	return _u
+}
+
+// ClearTags clears the value of the "tags" field.
+func (_u *MonitorUpdateOne) ClearTags() *MonitorUpdateOne {
+	_u.mutation.ClearTags()
+	return _u
+}
+
+// SetEscalationAfterMinutes sets the "escalation_after_minutes" field.
+func (_u *MonitorUpdateOne) SetEscalationAfterMinutes(v int) *MonitorUpdateOne {
+	_u.mutation.ResetEscalationAfterMinutes()
+	_u.mutation.SetEscalationAfterMinutes(v)
+	return _u
+}
+
+// SetNillableEscalationAfterMinutes sets the "escalation_after_minutes" field if the given value is not nil.
+func (_u *MonitorUpdateOne) SetNillableEscalationAfterMinutes(v *int) *MonitorUpdateOne {
+	if v != nil {
+		_u.SetEscalationAfterMinutes(*v)
+	}
+	return _u
+}
+
+// AddEscalationAfterMinutes adds value to the "escalation_after_minutes" field.
+func (_u *MonitorUpdateOne) AddEscalationAfterMinutes(v int) *MonitorUpdateOne {
+	_u.mutation.AddEscalationAfterMinutes(v)
+	return _u
+}
+
+// ClearEscalationAfterMinutes clears the value of the "escalation_after_minutes" field.
+func (_u *MonitorUpdateOne) ClearEscalationAfterMinutes() *MonitorUpdateOne {
+	_u.mutation.ClearEscalationAfterMinutes()
+	return _u
+}
+
+// SetSelector sets the "selector" field.
+func (_u *MonitorUpdateOne) SetSelector(v string) *MonitorUpdateOne {
+	_u.mutation.SetSelector(v)
+	return _u
+}
+
+// SetNillableSelector sets the "selector" field if the given value is not nil.
+func (_u *MonitorUpdateOne) SetNillableSelector(v *string) *MonitorUpdateOne {
+	if v != nil {
+		_u.SetSelector(*v)
+	}
+	return _u
+}
+
+// ClearSelector clears the value of the "selector" field.
+func (_u *MonitorUpdateOne) ClearSelector() *MonitorUpdateOne {
+	_u.mutation.ClearSelector()
+	return _u
+}
+
+// SetExpectedStatus sets the "expected_status" field.
+func (_u *MonitorUpdateOne) SetExpectedStatus(v string) *MonitorUpdateOne {
+	_u.mutation.SetExpectedStatus(v)
+	return _u
+}
+
+// SetNillableExpectedStatus sets the "expected_status" field if the given value is not nil.
+func (_u *MonitorUpdateOne) SetNillableExpectedStatus(v *string) *MonitorUpdateOne {
+	if v != nil {
+		_u.SetExpectedStatus(*v)
+	}
+	return _u
+}
+
+// ClearExpectedStatus clears the value of the "expected_status" field.
+func (_u *MonitorUpdateOne) ClearExpectedStatus() *MonitorUpdateOne {
+	_u.mutation.ClearExpectedStatus()
+	return _u
+}
+
+// SetRetryOn sets the "retry_on" field.
+func (_u *MonitorUpdateOne) SetRetryOn(v string) *MonitorUpdateOne {
+	_u.mutation.SetRetryOn(v)
+	return _u
+}
+
+// SetNillableRetryOn sets the "retry_on" field if the given value is not nil.
+func (_u *MonitorUpdateOne) SetNillableRetryOn(v *string) *MonitorUpdateOne {
+	if v != nil {
+		_u.SetRetryOn(*v)
+	}
+	return _u
+}
+
+// ClearRetryOn clears the value of the "retry_on" field.
+func (_u *MonitorUpdateOne) ClearRetryOn() *MonitorUpdateOne {
+	_u.mutation.ClearRetryOn()
+	return _u
+}
+
+// SetExpectedType sets the "expected_type" field.
+func (_u *MonitorUpdateOne) SetExpectedType(v monitor.ExpectedType) *MonitorUpdateOne {
+	_u.mutation.SetExpectedType(v)
+	return _u
+}
+
+// SetNillableExpectedType sets the "expected_type" field if the given value is not nil.
+func (_u *MonitorUpdateOne) SetNillableExpectedType(v *monitor.ExpectedType) *MonitorUpdateOne {
+	if v != nil {
+		_u.SetExpectedType(*v)
+	}
+	return _u
+}
+
+// SetExpectedResponse sets the "expected_response" field.
+func (_u *MonitorUpdateOne) SetExpectedResponse(v string) *MonitorUpdateOne {
+	_u.mutation.SetExpectedResponse(v)
+	return _u
+}
+
+// SetNillableExpectedResponse sets the "expected_response" field if the given value is not nil.
+func (_u *MonitorUpdateOne) SetNillableExpectedResponse(v *string) *MonitorUpdateOne {
+	if v != nil {
+		_u.SetExpectedResponse(*v)
+	}
+	return _u
+}
+
+// ClearExpectedResponse clears the value of the "expected_response" field.
+func (_u *MonitorUpdateOne) ClearExpectedResponse() *MonitorUpdateOne {
+	_u.mutation.ClearExpectedResponse()
+	return _u
+}
+
+// SetMustContain sets the "must_contain" field.
+func (_u *MonitorUpdateOne) SetMustContain(v []string) *MonitorUpdateOne {
+	_u.mutation.SetMustContain(v)
+	return _u
+}
+
+// AppendMustContain appends value to the "must_contain" field.
+func (_u *MonitorUpdateOne) AppendMustContain(v []string) *MonitorUpdateOne {
+	_u.mutation.AppendMustContain(v)
+	return _u
+}
+
+// ClearMustContain clears the value of the "must_contain" field.
+func (_u *MonitorUpdateOne) ClearMustContain() *MonitorUpdateOne {
+	_u.mutation.ClearMustContain()
+	return _u
+}
+
+// SetMustNotContain sets the "must_not_contain" field.
+func (_u *MonitorUpdateOne) SetMustNotContain(v []string) *MonitorUpdateOne {
+	_u.mutation.SetMustNotContain(v)
+	return _u
+}
+
+// AppendMustNotContain appends value to the "must_not_contain" field.
+func (_u *MonitorUpdateOne) AppendMustNotContain(v []string) *MonitorUpdateOne {
+	_u.mutation.AppendMustNotContain(v)
+	return _u
+}
+
+// ClearMustNotContain clears the value of the "must_not_contain" field.
+func (_u *MonitorUpdateOne) ClearMustNotContain() *MonitorUpdateOne {
+	_u.mutation.ClearMustNotContain()
+	return _u
+}
+
+// SetTreatNotFoundAsSuccess sets the "treat_not_found_as_success" field.
+func (_u *MonitorUpdateOne) SetTreatNotFoundAsSuccess(v bool) *MonitorUpdateOne {
+	_u.mutation.SetTreatNotFoundAsSuccess(v)
+	return _u
+}
+
+// SetNillableTreatNotFoundAsSuccess sets the "treat_not_found_as_success" field if the given value is not nil.
+func (_u *MonitorUpdateOne) SetNillableTreatNotFoundAsSuccess(v *bool) *MonitorUpdateOne {
+	if v != nil {
+		_u.SetTreatNotFoundAsSuccess(*v)
+	}
+	return _u
+}
+
+// SetAcceptEmptyBody sets the "accept_empty_body" field.
+func (_u *MonitorUpdateOne) SetAcceptEmptyBody(v bool) *MonitorUpdateOne {
+	_u.mutation.SetAcceptEmptyBody(v)
+	return _u
+}
+
+// SetNillableAcceptEmptyBody sets the "accept_empty_body" field if the given value is not nil.
+func (_u *MonitorUpdateOne) SetNillableAcceptEmptyBody(v *bool) *MonitorUpdateOne {
+	if v != nil {
+		_u.SetAcceptEmptyBody(*v)
+	}
+	return _u
+}
+
+// SetWatchdogMinutes sets the "watchdog_minutes" field.
+func (_u *MonitorUpdateOne) SetWatchdogMinutes(v int) *MonitorUpdateOne {
+	_u.mutation.ResetWatchdogMinutes()
+	_u.mutation.SetWatchdogMinutes(v)
+	return _u
+}
+
+// SetNillableWatchdogMinutes sets the "watchdog_minutes" field if the given value is not nil.
+func (_u *MonitorUpdateOne) SetNillableWatchdogMinutes(v *int) *MonitorUpdateOne {
+	if v != nil {
+		_u.SetWatchdogMinutes(*v)
+	}
+	return _u
+}
+
+// AddWatchdogMinutes adds value to the "watchdog_minutes" field.
+func (_u *MonitorUpdateOne) AddWatchdogMinutes(v int) *MonitorUpdateOne {
+	_u.mutation.AddWatchdogMinutes(v)
+	return _u
+}
+
+// ClearWatchdogMinutes clears the value of the "watchdog_minutes" field.
+func (_u *MonitorUpdateOne) ClearWatchdogMinutes() *MonitorUpdateOne {
+	_u.mutation.ClearWatchdogMinutes()
+	return _u
+}
+
+// SetHeaderAssertions sets the "header_assertions" field.
+func (_u *MonitorUpdateOne) SetHeaderAssertions(v map[string]string) *MonitorUpdateOne {
+	_u.mutation.SetHeaderAssertions(v)
+	return _u
+}
+
+// ClearHeaderAssertions clears the value of the "header_assertions" field.
+func (_u *MonitorUpdateOne) ClearHeaderAssertions() *MonitorUpdateOne {
+	_u.mutation.ClearHeaderAssertions()
+	return _u
+}
+
+// SetTrackHeader sets the "track_header" field.
+func (_u *MonitorUpdateOne) SetTrackHeader(v string) *MonitorUpdateOne {
+	_u.mutation.SetTrackHeader(v)
+	return _u
+}
+
+// SetNillableTrackHeader sets the "track_header" field if the given value is not nil.
+func (_u *MonitorUpdateOne) SetNillableTrackHeader(v *string) *MonitorUpdateOne {
+	if v != nil {
+		_u.SetTrackHeader(*v)
+	}
+	return _u
+}
+
+// ClearTrackHeader clears the value of the "track_header" field.
+func (_u *MonitorUpdateOne) ClearTrackHeader() *MonitorUpdateOne {
+	_u.mutation.ClearTrackHeader()
+	return _u
+}
+
+// SetNumericTolerance sets the "numeric_tolerance" field.
+func (_u *MonitorUpdateOne) SetNumericTolerance(v float64) *MonitorUpdateOne {
+	_u.mutation.ResetNumericTolerance()
+	_u.mutation.SetNumericTolerance(v)
+	return _u
+}
+
+// SetNillableNumericTolerance sets the "numeric_tolerance" field if the given value is not nil.
+func (_u *MonitorUpdateOne) SetNillableNumericTolerance(v *float64) *MonitorUpdateOne {
+	if v != nil {
+		_u.SetNumericTolerance(*v)
+	}
+	return _u
+}
+
+// AddNumericTolerance adds value to the "numeric_tolerance" field.
+func (_u *MonitorUpdateOne) AddNumericTolerance(v float64) *MonitorUpdateOne {
+	_u.mutation.AddNumericTolerance(v)
+	return _u
+}
+
+// ClearNumericTolerance clears the value of the "numeric_tolerance" field.
+func (_u *MonitorUpdateOne) ClearNumericTolerance() *MonitorUpdateOne {
+	_u.mutation.ClearNumericTolerance()
+	return _u
+}
+
+// SetRedirectPolicy sets the "redirect_policy" field.
+func (_u *MonitorUpdateOne) SetRedirectPolicy(v monitor.RedirectPolicy) *MonitorUpdateOne {
+	_u.mutation.SetRedirectPolicy(v)
+	return _u
+}
+
+// SetNillableRedirectPolicy sets the "redirect_policy" field if the given value is not nil.
+func (_u *MonitorUpdateOne) SetNillableRedirectPolicy(v *monitor.RedirectPolicy) *MonitorUpdateOne {
+	if v != nil {
+		_u.SetRedirectPolicy(*v)
+	}
+	return _u
+}
+
+// SetMaxRedirects sets the "max_redirects" field.
+func (_u *MonitorUpdateOne) SetMaxRedirects(v int) *MonitorUpdateOne {
+	_u.mutation.ResetMaxRedirects()
+	_u.mutation.SetMaxRedirects(v)
+	return _u
+}
+
+// SetNillableMaxRedirects sets the "max_redirects" field if the given value is not nil.
+func (_u *MonitorUpdateOne) SetNillableMaxRedirects(v *int) *MonitorUpdateOne {
+	if v != nil {
+		_u.SetMaxRedirects(*v)
+	}
+	return _u
+}
+
+// AddMaxRedirects adds value to the "max_redirects" field.
+func (_u *MonitorUpdateOne) AddMaxRedirects(v int) *MonitorUpdateOne {
+	_u.mutation.AddMaxRedirects(v)
+	return _u
+}
+
+// ClearMaxRedirects clears the value of the "max_redirects" field.
+func (_u *MonitorUpdateOne) ClearMaxRedirects() *MonitorUpdateOne {
+	_u.mutation.ClearMaxRedirects()
+	return _u
+}
+
+// SetMaxResponseBytes sets the "max_response_bytes" field.
+func (_u *MonitorUpdateOne) SetMaxResponseBytes(v int) *MonitorUpdateOne {
+	_u.mutation.ResetMaxResponseBytes()
+	_u.mutation.SetMaxResponseBytes(v)
+	return _u
+}
+
+// SetNillableMaxResponseBytes sets the "max_response_bytes" field if the given value is not nil.
+func (_u *MonitorUpdateOne) SetNillableMaxResponseBytes(v *int) *MonitorUpdateOne {
+	if v != nil {
+		_u.SetMaxResponseBytes(*v)
 	}
-	if nodes := _u.mutation.NotificationEventsIDs(); len(nodes) > 0 {
-		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
-			Inverse: false,
-			Table:   monitor.NotificationEventsTable,
-			Columns: []string{monitor.NotificationEventsColumn},
-			Bidi:    false,
-			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(notificationevent.FieldID, field.TypeInt),
-			},
-		}
-		for _, k := range nodes {
-			edge.Target.Nodes = append(edge.Target.Nodes, k)
-		}
-		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	return _u
+}
+
+// AddMaxResponseBytes adds value to the "max_response_bytes" field.
+func (_u *MonitorUpdateOne) AddMaxResponseBytes(v int) *MonitorUpdateOne {
+	_u.mutation.AddMaxResponseBytes(v)
+	return _u
+}
+
+// ClearMaxResponseBytes clears the value of the "max_response_bytes" field.
+func (_u *MonitorUpdateOne) ClearMaxResponseBytes() *MonitorUpdateOne {
+	_u.mutation.ClearMaxResponseBytes()
+	return _u
+}
+
+// SetChecksHistoryLimit sets the "checks_history_limit" field.
+func (_u *MonitorUpdateOne) SetChecksHistoryLimit(v int) *MonitorUpdateOne {
+	_u.mutation.ResetChecksHistoryLimit()
+	_u.mutation.SetChecksHistoryLimit(v)
+	return _u
+}
+
+// SetNillableChecksHistoryLimit sets the "checks_history_limit" field if the given value is not nil.
+func (_u *MonitorUpdateOne) SetNillableChecksHistoryLimit(v *int) *MonitorUpdateOne {
+	if v != nil {
+		_u.SetChecksHistoryLimit(*v)
 	}
-	if _u.mutation.RuntimeCleared() {
-		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2O,
-			Inverse: false,
-			Table:   monitor.RuntimeTable,
-			Columns: []string{monitor.RuntimeColumn},
-			Bidi:    false,
-			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(monitorruntime.FieldID, field.TypeInt),
-			},
-		}
-		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	return _u
+}
+
+// AddChecksHistoryLimit adds value to the "checks_history_limit" field.
+func (_u *MonitorUpdateOne) AddChecksHistoryLimit(v int) *MonitorUpdateOne {
+	_u.mutation.AddChecksHistoryLimit(v)
+	return _u
+}
+
+// ClearChecksHistoryLimit clears the value of the "checks_history_limit" field.
+func (_u *MonitorUpdateOne) ClearChecksHistoryLimit() *MonitorUpdateOne {
+	_u.mutation.ClearChecksHistoryLimit()
+	return _u
+}
+
+// SetCookieJar sets the "cookie_jar" field.
+func (_u *MonitorUpdateOne) SetCookieJar(v bool) *MonitorUpdateOne {
+	_u.mutation.SetCookieJar(v)
+	return _u
+}
+
+// SetNillableCookieJar sets the "cookie_jar" field if the given value is not nil.
+func (_u *MonitorUpdateOne) SetNillableCookieJar(v *bool) *MonitorUpdateOne {
+	if v != nil {
+		_u.SetCookieJar(*v)
 	}
-	if nodes := _u.mutation.RuntimeIDs(); len(nodes) > 0 {
-		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2O,
-			Inverse: false,
-			Table:   monitor.RuntimeTable,
-			Columns: []string{monitor.RuntimeColumn},
-			Bidi:    false,
-			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(monitorruntime.FieldID, field.TypeInt),
-			},
-		}
-		for _, k := range nodes {
-			edge.Target.Nodes = append(edge.Target.Nodes, k)
-		}
-		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	return _u
+}
+
+// SetHostOverrides sets the "host_overrides" field.
+func (_u *MonitorUpdateOne) SetHostOverrides(v map[string]string) *MonitorUpdateOne {
+	_u.mutation.SetHostOverrides(v)
+	return _u
+}
+
+// ClearHostOverrides clears the value of the "host_overrides" field.
+func (_u *MonitorUpdateOne) ClearHostOverrides() *MonitorUpdateOne {
+	_u.mutation.ClearHostOverrides()
+	return _u
+}
+
+// SetIPFamily sets the "ip_family" field.
+func (_u *MonitorUpdateOne) SetIPFamily(v monitor.IPFamily) *MonitorUpdateOne {
+	_u.mutation.SetIPFamily(v)
+	return _u
+}
+
+// SetNillableIPFamily sets the "ip_family" field if the given value is not nil.
+func (_u *MonitorUpdateOne) SetNillableIPFamily(v *monitor.IPFamily) *MonitorUpdateOne {
+	if v != nil {
+		_u.SetIPFamily(*v)
 	}
-	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
-		if _, ok := err.(*sqlgraph.NotFoundError); ok {
-			err = &NotFoundError{monitor.Label}
-		} else if sqlgraph.IsConstraintError(err) {
-			err = &ConstraintError{msg: err.Error(), wrap: err}
-		}
-		return 0, err
+	return _u
+}
+
+// SetTLSCaPem sets the "tls_ca_pem" field.
+func (_u *MonitorUpdateOne) SetTLSCaPem(v string) *MonitorUpdateOne {
+	_u.mutation.SetTLSCaPem(v)
+	return _u
+}
+
+// SetNillableTLSCaPem sets the "tls_ca_pem" field if the given value is not nil.
+func (_u *MonitorUpdateOne) SetNillableTLSCaPem(v *string) *MonitorUpdateOne {
+	if v != nil {
+		_u.SetTLSCaPem(*v)
 	}
-	_u.mutation.done = true
-	return _node, nil
+	return _u
 }
 
-// MonitorUpdateOne is the builder for updating a single Monitor entity.
-type MonitorUpdateOne struct {
-	config
-	fields   []string
-	hooks    []Hook
-	mutation *MonitorMutation
+// ClearTLSCaPem clears the value of the "tls_ca_pem" field.
+func (_u *MonitorUpdateOne) ClearTLSCaPem() *MonitorUpdateOne {
+	_u.mutation.ClearTLSCaPem()
+	return _u
 }
 
-// SetLabel sets the "label" field.
-func (_u *MonitorUpdateOne) SetLabel(v string) *MonitorUpdateOne {
-	_u.mutation.SetLabel(v)
+// SetTLSInsecureSkipVerify sets the "tls_insecure_skip_verify" field.
+func (_u *MonitorUpdateOne) SetTLSInsecureSkipVerify(v bool) *MonitorUpdateOne {
+	_u.mutation.SetTLSInsecureSkipVerify(v)
 	return _u
 }
 
-// SetNillableLabel sets the "label" field if the given value is not nil.
-func (_u *MonitorUpdateOne) SetNillableLabel(v *string) *MonitorUpdateOne {
+// SetNillableTLSInsecureSkipVerify sets the "tls_insecure_skip_verify" field if the given value is not nil.
+func (_u *MonitorUpdateOne) SetNillableTLSInsecureSkipVerify(v *bool) *MonitorUpdateOne {
 	if v != nil {
-		_u.SetLabel(*v)
+		_u.SetTLSInsecureSkipVerify(*v)
 	}
 	return _u
 }
 
-// ClearLabel clears the value of the "label" field.
-func (_u *MonitorUpdateOne) ClearLabel() *MonitorUpdateOne {
-	_u.mutation.ClearLabel()
+// SetTLSMinVersion sets the "tls_min_version" field.
+func (_u *MonitorUpdateOne) SetTLSMinVersion(v string) *MonitorUpdateOne {
+	_u.mutation.SetTLSMinVersion(v)
 	return _u
 }
 
-// SetMethod sets the "method" field.
-func (_u *MonitorUpdateOne) SetMethod(v string) *MonitorUpdateOne {
-	_u.mutation.SetMethod(v)
+// SetNillableTLSMinVersion sets the "tls_min_version" field if the given value is not nil.
+func (_u *MonitorUpdateOne) SetNillableTLSMinVersion(v *string) *MonitorUpdateOne {
+	if v != nil {
+		_u.SetTLSMinVersion(*v)
+	}
 	return _u
 }
 
-// SetNillableMethod sets the "method" field if the given value is not nil.
-func (_u *MonitorUpdateOne) SetNillableMethod(v *string) *MonitorUpdateOne {
+// ClearTLSMinVersion clears the value of the "tls_min_version" field.
+func (_u *MonitorUpdateOne) ClearTLSMinVersion() *MonitorUpdateOne {
+	_u.mutation.ClearTLSMinVersion()
+	return _u
+}
+
+// SetTLSServerName sets the "tls_server_name" field.
+func (_u *MonitorUpdateOne) SetTLSServerName(v string) *MonitorUpdateOne {
+	_u.mutation.SetTLSServerName(v)
+	return _u
+}
+
+// SetNillableTLSServerName sets the "tls_server_name" field if the given value is not nil.
+func (_u *MonitorUpdateOne) SetNillableTLSServerName(v *string) *MonitorUpdateOne {
 	if v != nil {
-		_u.SetMethod(*v)
+		_u.SetTLSServerName(*v)
 	}
 	return _u
 }
 
-// SetURL sets the "url" field.
-func (_u *MonitorUpdateOne) SetURL(v string) *MonitorUpdateOne {
-	_u.mutation.SetURL(v)
+// ClearTLSServerName clears the value of the "tls_server_name" field.
+func (_u *MonitorUpdateOne) ClearTLSServerName() *MonitorUpdateOne {
+	_u.mutation.ClearTLSServerName()
 	return _u
 }
 
-// SetNillableURL sets the "url" field if the given value is not nil.
-func (_u *MonitorUpdateOne) SetNillableURL(v *string) *MonitorUpdateOne {
+// SetCron sets the "cron" field.
+func (_u *MonitorUpdateOne) SetCron(v string) *MonitorUpdateOne {
+	_u.mutation.SetCron(v)
+	return _u
+}
+
+// SetNillableCron sets the "cron" field if the given value is not nil.
+func (_u *MonitorUpdateOne) SetNillableCron(v *string) *MonitorUpdateOne {
 	if v != nil {
-		_u.SetURL(*v)
+		_u.SetCron(*v)
 	}
 	return _u
 }
 
-// SetIconURL sets the "icon_url" field.
-func (_u *MonitorUpdateOne) SetIconURL(v string) *MonitorUpdateOne {
-	_u.mutation.SetIconURL(v)
+// SetTimezone sets the "timezone" field.
+func (_u *MonitorUpdateOne) SetTimezone(v string) *MonitorUpdateOne {
+	_u.mutation.SetTimezone(v)
 	return _u
 }
 
-// SetNillableIconURL sets the "icon_url" field if the given value is not nil.
-func (_u *MonitorUpdateOne) SetNillableIconURL(v *string) *MonitorUpdateOne {
+// SetNillableTimezone sets the "timezone" field if the given value is not nil.
+func (_u *MonitorUpdateOne) SetNillableTimezone(v *string) *MonitorUpdateOne {
 	if v != nil {
-		_u.SetIconURL(*v)
+		_u.SetTimezone(*v)
 	}
 	return _u
 }
 
-// ClearIconURL clears the value of the "icon_url" field.
-func (_u *MonitorUpdateOne) ClearIconURL() *MonitorUpdateOne {
-	_u.mutation.ClearIconURL()
+// ClearTimezone clears the value of the "timezone" field.
+func (_u *MonitorUpdateOne) ClearTimezone() *MonitorUpdateOne {
+	_u.mutation.ClearTimezone()
 	return _u
 }
 
-// SetBody sets the "body" field.
-func (_u *MonitorUpdateOne) SetBody(v string) *MonitorUpdateOne {
-	_u.mutation.SetBody(v)
+// SetJitterSeconds sets the "jitter_seconds" field.
+func (_u *MonitorUpdateOne) SetJitterSeconds(v int) *MonitorUpdateOne {
+	_u.mutation.ResetJitterSeconds()
+	_u.mutation.SetJitterSeconds(v)
 	return _u
 }
 
-// SetNillableBody sets the "body" field if the given value is not nil.
-func (_u *MonitorUpdateOne) SetNillableBody(v *string) *MonitorUpdateOne {
+// SetNillableJitterSeconds sets the "jitter_seconds" field if the given value is not nil.
+func (_u *MonitorUpdateOne) SetNillableJitterSeconds(v *int) *MonitorUpdateOne {
 	if v != nil {
-		_u.SetBody(*v)
+		_u.SetJitterSeconds(*v)
 	}
 	return _u
 }
 
-// ClearBody clears the value of the "body" field.
-func (_u *MonitorUpdateOne) ClearBody() *MonitorUpdateOne {
-	_u.mutation.ClearBody()
+// AddJitterSeconds adds value to the "jitter_seconds" field.
+func (_u *MonitorUpdateOne) AddJitterSeconds(v int) *MonitorUpdateOne {
+	_u.mutation.AddJitterSeconds(v)
 	return _u
 }
 
-// SetHeaders sets the "headers" field.
-func (_u *MonitorUpdateOne) SetHeaders(v map[string]string) *MonitorUpdateOne {
-	_u.mutation.SetHeaders(v)
+// ClearJitterSeconds clears the value of the "jitter_seconds" field.
+func (_u *MonitorUpdateOne) ClearJitterSeconds() *MonitorUpdateOne {
+	_u.mutation.ClearJitterSeconds()
 	return _u
 }
 
-// ClearHeaders clears the value of the "headers" field.
-func (_u *MonitorUpdateOne) ClearHeaders() *MonitorUpdateOne {
-	_u.mutation.ClearHeaders()
+// SetDstPolicy sets the "dst_policy" field.
+func (_u *MonitorUpdateOne) SetDstPolicy(v monitor.DstPolicy) *MonitorUpdateOne {
+	_u.mutation.SetDstPolicy(v)
 	return _u
 }
 
-// SetAuth sets the "auth" field.
-func (_u *MonitorUpdateOne) SetAuth(v map[string]string) *MonitorUpdateOne {
-	_u.mutation.SetAuth(v)
+// SetNillableDstPolicy sets the "dst_policy" field if the given value is not nil.
+func (_u *MonitorUpdateOne) SetNillableDstPolicy(v *monitor.DstPolicy) *MonitorUpdateOne {
+	if v != nil {
+		_u.SetDstPolicy(*v)
+	}
 	return _u
 }
 
-// ClearAuth clears the value of the "auth" field.
-func (_u *MonitorUpdateOne) ClearAuth() *MonitorUpdateOne {
-	_u.mutation.ClearAuth()
+// SetBodySnapshot sets the "body_snapshot" field.
+func (_u *MonitorUpdateOne) SetBodySnapshot(v monitor.BodySnapshot) *MonitorUpdateOne {
+	_u.mutation.SetBodySnapshot(v)
 	return _u
 }
 
-// SetNotificationChannels sets the "notification_channels" field.
-func (_u *MonitorUpdateOne) SetNotificationChannels(v []string) *MonitorUpdateOne {
-	_u.mutation.SetNotificationChannels(v)
+// SetNillableBodySnapshot sets the "body_snapshot" field if the given value is not nil.
+func (_u *MonitorUpdateOne) SetNillableBodySnapshot(v *monitor.BodySnapshot) *MonitorUpdateOne {
+	if v != nil {
+		_u.SetBodySnapshot(*v)
+	}
 	return _u
 }
 
-// AppendNotificationChannels appends value to the "notification_channels" field.
-func (_u *MonitorUpdateOne) AppendNotificationChannels(v []string) *MonitorUpdateOne {
-	_u.mutation.AppendNotificationChannels(v)
+// SetContentHash sets the "content_hash" field.
+func (_u *MonitorUpdateOne) SetContentHash(v monitor.ContentHash) *MonitorUpdateOne {
+	_u.mutation.SetContentHash(v)
 	return _u
 }
 
-// ClearNotificationChannels clears the value of the "notification_channels" field.
-func (_u *MonitorUpdateOne) ClearNotificationChannels() *MonitorUpdateOne {
-	_u.mutation.ClearNotificationChannels()
+// SetNillableContentHash sets the "content_hash" field if the given value is not nil.
+func (_u *MonitorUpdateOne) SetNillableContentHash(v *monitor.ContentHash) *MonitorUpdateOne {
+	if v != nil {
+		_u.SetContentHash(*v)
+	}
 	return _u
 }
 
-// SetSelector sets the "selector" field.
-func (_u *MonitorUpdateOne) SetSelector(v string) *MonitorUpdateOne {
-	_u.mutation.SetSelector(v)
+// SetFetchMode sets the "fetch_mode" field.
+func (_u *MonitorUpdateOne) SetFetchMode(v monitor.FetchMode) *MonitorUpdateOne {
+	_u.mutation.SetFetchMode(v)
 	return _u
 }
 
-// SetNillableSelector sets the "selector" field if the given value is not nil.
-func (_u *MonitorUpdateOne) SetNillableSelector(v *string) *MonitorUpdateOne {
+// SetNillableFetchMode sets the "fetch_mode" field if the given value is not nil.
+func (_u *MonitorUpdateOne) SetNillableFetchMode(v *monitor.FetchMode) *MonitorUpdateOne {
 	if v != nil {
-		_u.SetSelector(*v)
+		_u.SetFetchMode(*v)
 	}
 	return _u
 }
 
-// ClearSelector clears the value of the "selector" field.
-func (_u *MonitorUpdateOne) ClearSelector() *MonitorUpdateOne {
-	_u.mutation.ClearSelector()
+// SetHeartbeatToken sets the "heartbeat_token" field.
+func (_u *MonitorUpdateOne) SetHeartbeatToken(v string) *MonitorUpdateOne {
+	_u.mutation.SetHeartbeatToken(v)
 	return _u
 }
 
-// SetExpectedType sets the "expected_type" field.
-func (_u *MonitorUpdateOne) SetExpectedType(v monitor.ExpectedType) *MonitorUpdateOne {
-	_u.mutation.SetExpectedType(v)
+// SetNillableHeartbeatToken sets the "heartbeat_token" field if the given value is not nil.
+func (_u *MonitorUpdateOne) SetNillableHeartbeatToken(v *string) *MonitorUpdateOne {
+	if v != nil {
+		_u.SetHeartbeatToken(*v)
+	}
 	return _u
 }
 
-// SetNillableExpectedType sets the "expected_type" field if the given value is not nil.
-func (_u *MonitorUpdateOne) SetNillableExpectedType(v *monitor.ExpectedType) *MonitorUpdateOne {
+// ClearHeartbeatToken clears the value of the "heartbeat_token" field.
+func (_u *MonitorUpdateOne) ClearHeartbeatToken() *MonitorUpdateOne {
+	_u.mutation.ClearHeartbeatToken()
+	return _u
+}
+
+// SetEnabled sets the "enabled" field.
+func (_u *MonitorUpdateOne) SetEnabled(v bool) *MonitorUpdateOne {
+	_u.mutation.SetEnabled(v)
+	return _u
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (_u *MonitorUpdateOne) SetNillableEnabled(v *bool) *MonitorUpdateOne {
 	if v != nil {
-		_u.SetExpectedType(*v)
+		_u.SetEnabled(*v)
 	}
 	return _u
 }
 
-// SetExpectedResponse sets the "expected_response" field.
-func (_u *MonitorUpdateOne) SetExpectedResponse(v string) *MonitorUpdateOne {
-	_u.mutation.SetExpectedResponse(v)
+// SetStatusPage sets the "status_page" field.
+func (_u *MonitorUpdateOne) SetStatusPage(v bool) *MonitorUpdateOne {
+	_u.mutation.SetStatusPage(v)
 	return _u
 }
 
-// SetNillableExpectedResponse sets the "expected_response" field if the given value is not nil.
-func (_u *MonitorUpdateOne) SetNillableExpectedResponse(v *string) *MonitorUpdateOne {
+// SetNillableStatusPage sets the "status_page" field if the given value is not nil.
+func (_u *MonitorUpdateOne) SetNillableStatusPage(v *bool) *MonitorUpdateOne {
 	if v != nil {
-		_u.SetExpectedResponse(*v)
+		_u.SetStatusPage(*v)
 	}
 	return _u
 }
 
-// ClearExpectedResponse clears the value of the "expected_response" field.
-func (_u *MonitorUpdateOne) ClearExpectedResponse() *MonitorUpdateOne {
-	_u.mutation.ClearExpectedResponse()
+// SetSortIndex sets the "sort_index" field.
+func (_u *MonitorUpdateOne) SetSortIndex(v int) *MonitorUpdateOne {
+	_u.mutation.ResetSortIndex()
+	_u.mutation.SetSortIndex(v)
 	return _u
 }
 
-// SetCron sets the "cron" field.
-func (_u *MonitorUpdateOne) SetCron(v string) *MonitorUpdateOne {
-	_u.mutation.SetCron(v)
+// SetNillableSortIndex sets the "sort_index" field if the given value is not nil.
+func (_u *MonitorUpdateOne) SetNillableSortIndex(v *int) *MonitorUpdateOne {
+	if v != nil {
+		_u.SetSortIndex(*v)
+	}
 	return _u
 }
 
-// SetNillableCron sets the "cron" field if the given value is not nil.
-func (_u *MonitorUpdateOne) SetNillableCron(v *string) *MonitorUpdateOne {
-	if v != nil {
-		_u.SetCron(*v)
-	}
+// AddSortIndex adds value to the "sort_index" field.
+func (_u *MonitorUpdateOne) AddSortIndex(v int) *MonitorUpdateOne {
+	_u.mutation.AddSortIndex(v)
 	return _u
 }
 
-// SetEnabled sets the "enabled" field.
-func (_u *MonitorUpdateOne) SetEnabled(v bool) *MonitorUpdateOne {
-	_u.mutation.SetEnabled(v)
+// SetArchivedAt sets the "archived_at" field.
+func (_u *MonitorUpdateOne) SetArchivedAt(v time.Time) *MonitorUpdateOne {
+	_u.mutation.SetArchivedAt(v)
 	return _u
 }
 
-// SetNillableEnabled sets the "enabled" field if the given value is not nil.
-func (_u *MonitorUpdateOne) SetNillableEnabled(v *bool) *MonitorUpdateOne {
+// SetNillableArchivedAt sets the "archived_at" field if the given value is not nil.
+func (_u *MonitorUpdateOne) SetNillableArchivedAt(v *time.Time) *MonitorUpdateOne {
 	if v != nil {
-		_u.SetEnabled(*v)
+		_u.SetArchivedAt(*v)
 	}
 	return _u
 }
 
+// ClearArchivedAt clears the value of the "archived_at" field.
+func (_u *MonitorUpdateOne) ClearArchivedAt() *MonitorUpdateOne {
+	_u.mutation.ClearArchivedAt()
+	return _u
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (_u *MonitorUpdateOne) SetUpdatedAt(v time.Time) *MonitorUpdateOne {
 	_u.mutation.SetUpdatedAt(v)
@@ -897,6 +2745,41 @@ func (_u *MonitorUpdateOne) SetRuntime(v *MonitorRuntime) *MonitorUpdateOne {
 	return _u.SetRuntimeID(v.ID)
 }
 
+// AddVersionIDs adds the "versions" edge to the MonitorVersion entity by IDs.
+func (_u *MonitorUpdateOne) AddVersionIDs(ids ...int) *MonitorUpdateOne {
+	_u.mutation.AddVersionIDs(ids...)
+	return _u
+}
+
+// AddVersions adds the "versions" edges to the MonitorVersion entity.
+func (_u *MonitorUpdateOne) AddVersions(v ...*MonitorVersion) *MonitorUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddVersionIDs(ids...)
+}
+
+// AddRollupIDs adds the "rollups" edge to the CheckRollup entity by IDs.
+func (_u *MonitorUpdateOne) AddRollupIDs(ids ...int) *MonitorUpdateOne {
+	_u.mutation.AddRollupIDs(ids...)
+	return _u
+}
+
+// AddRollups adds the "rollups" edges to the CheckRollup entity.
+func (_u *MonitorUpdateOne) AddRollups(v ...*CheckRollup) *MonitorUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddRollupIDs(ids...)
+}
+
+// SetHeaderProfile sets the "header_profile" edge to the HeaderProfile entity.
+func (_u *MonitorUpdateOne) SetHeaderProfile(v *HeaderProfile) *MonitorUpdateOne {
+	return _u.SetHeaderProfileID(v.ID)
+}
+
 // Mutation returns the MonitorMutation object of the builder.
 func (_u *MonitorUpdateOne) Mutation() *MonitorMutation {
 	return _u.mutation
@@ -950,6 +2833,54 @@ func (_u *MonitorUpdateOne) ClearRuntime() *MonitorUpdateOne {
 	return _u
 }
 
+// ClearVersions clears all "versions" edges to the MonitorVersion entity.
+func (_u *MonitorUpdateOne) ClearVersions() *MonitorUpdateOne {
+	_u.mutation.ClearVersions()
+	return _u
+}
+
+// RemoveVersionIDs removes the "versions" edge to MonitorVersion entities by IDs.
+func (_u *MonitorUpdateOne) RemoveVersionIDs(ids ...int) *MonitorUpdateOne {
+	_u.mutation.RemoveVersionIDs(ids...)
+	return _u
+}
+
+// RemoveVersions removes "versions" edges to MonitorVersion entities.
+func (_u *MonitorUpdateOne) RemoveVersions(v ...*MonitorVersion) *MonitorUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveVersionIDs(ids...)
+}
+
+// ClearRollups clears all "rollups" edges to the CheckRollup entity.
+func (_u *MonitorUpdateOne) ClearRollups() *MonitorUpdateOne {
+	_u.mutation.ClearRollups()
+	return _u
+}
+
+// RemoveRollupIDs removes the "rollups" edge to CheckRollup entities by IDs.
+func (_u *MonitorUpdateOne) RemoveRollupIDs(ids ...int) *MonitorUpdateOne {
+	_u.mutation.RemoveRollupIDs(ids...)
+	return _u
+}
+
+// RemoveRollups removes "rollups" edges to CheckRollup entities.
+func (_u *MonitorUpdateOne) RemoveRollups(v ...*CheckRollup) *MonitorUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveRollupIDs(ids...)
+}
+
+// ClearHeaderProfile clears the "header_profile" edge to the HeaderProfile entity.
+func (_u *MonitorUpdateOne) ClearHeaderProfile() *MonitorUpdateOne {
+	_u.mutation.ClearHeaderProfile()
+	return _u
+}
+
 // Where appends a list predicates to the MonitorUpdate builder.
 func (_u *MonitorUpdateOne) Where(ps ...predicate.Monitor) *MonitorUpdateOne {
 	_u.mutation.Where(ps...)
@@ -1006,16 +2937,81 @@ func (_u *MonitorUpdateOne) check() error {
 			return &ValidationError{Name: "url", err: fmt.Errorf(`ent: validator failed for field "Monitor.url": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.EscalationAfterMinutes(); ok {
+		if err := monitor.EscalationAfterMinutesValidator(v); err != nil {
+			return &ValidationError{Name: "escalation_after_minutes", err: fmt.Errorf(`ent: validator failed for field "Monitor.escalation_after_minutes": %w`, err)}
+		}
+	}
 	if v, ok := _u.mutation.ExpectedType(); ok {
 		if err := monitor.ExpectedTypeValidator(v); err != nil {
 			return &ValidationError{Name: "expected_type", err: fmt.Errorf(`ent: validator failed for field "Monitor.expected_type": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.WatchdogMinutes(); ok {
+		if err := monitor.WatchdogMinutesValidator(v); err != nil {
+			return &ValidationError{Name: "watchdog_minutes", err: fmt.Errorf(`ent: validator failed for field "Monitor.watchdog_minutes": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.NumericTolerance(); ok {
+		if err := monitor.NumericToleranceValidator(v); err != nil {
+			return &ValidationError{Name: "numeric_tolerance", err: fmt.Errorf(`ent: validator failed for field "Monitor.numeric_tolerance": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.RedirectPolicy(); ok {
+		if err := monitor.RedirectPolicyValidator(v); err != nil {
+			return &ValidationError{Name: "redirect_policy", err: fmt.Errorf(`ent: validator failed for field "Monitor.redirect_policy": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.MaxRedirects(); ok {
+		if err := monitor.MaxRedirectsValidator(v); err != nil {
+			return &ValidationError{Name: "max_redirects", err: fmt.Errorf(`ent: validator failed for field "Monitor.max_redirects": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.MaxResponseBytes(); ok {
+		if err := monitor.MaxResponseBytesValidator(v); err != nil {
+			return &ValidationError{Name: "max_response_bytes", err: fmt.Errorf(`ent: validator failed for field "Monitor.max_response_bytes": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ChecksHistoryLimit(); ok {
+		if err := monitor.ChecksHistoryLimitValidator(v); err != nil {
+			return &ValidationError{Name: "checks_history_limit", err: fmt.Errorf(`ent: validator failed for field "Monitor.checks_history_limit": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.IPFamily(); ok {
+		if err := monitor.IPFamilyValidator(v); err != nil {
+			return &ValidationError{Name: "ip_family", err: fmt.Errorf(`ent: validator failed for field "Monitor.ip_family": %w`, err)}
+		}
+	}
 	if v, ok := _u.mutation.Cron(); ok {
 		if err := monitor.CronValidator(v); err != nil {
 			return &ValidationError{Name: "cron", err: fmt.Errorf(`ent: validator failed for field "Monitor.cron": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.JitterSeconds(); ok {
+		if err := monitor.JitterSecondsValidator(v); err != nil {
+			return &ValidationError{Name: "jitter_seconds", err: fmt.Errorf(`ent: validator failed for field "Monitor.jitter_seconds": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.DstPolicy(); ok {
+		if err := monitor.DstPolicyValidator(v); err != nil {
+			return &ValidationError{Name: "dst_policy", err: fmt.Errorf(`ent: validator failed for field "Monitor.dst_policy": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.BodySnapshot(); ok {
+		if err := monitor.BodySnapshotValidator(v); err != nil {
+			return &ValidationError{Name: "body_snapshot", err: fmt.Errorf(`ent: validator failed for field "Monitor.body_snapshot": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ContentHash(); ok {
+		if err := monitor.ContentHashValidator(v); err != nil {
+			return &ValidationError{Name: "content_hash", err: fmt.Errorf(`ent: validator failed for field "Monitor.content_hash": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.FetchMode(); ok {
+		if err := monitor.FetchModeValidator(v); err != nil {
+			return &ValidationError{Name: "fetch_mode", err: fmt.Errorf(`ent: validator failed for field "Monitor.fetch_mode": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -1078,6 +3074,12 @@ func (_u *MonitorUpdateOne) sqlSave(ctx context.Context) (_node *Monitor, err er
 	if _u.mutation.HeadersCleared() {
 		_spec.ClearField(monitor.FieldHeaders, field.TypeJSON)
 	}
+	if value, ok := _u.mutation.UserAgent(); ok {
+		_spec.SetField(monitor.FieldUserAgent, field.TypeString, value)
+	}
+	if _u.mutation.UserAgentCleared() {
+		_spec.ClearField(monitor.FieldUserAgent, field.TypeString)
+	}
 	if value, ok := _u.mutation.Auth(); ok {
 		_spec.SetField(monitor.FieldAuth, field.TypeJSON, value)
 	}
@@ -1095,12 +3097,55 @@ func (_u *MonitorUpdateOne) sqlSave(ctx context.Context) (_node *Monitor, err er
 	if _u.mutation.NotificationChannelsCleared() {
 		_spec.ClearField(monitor.FieldNotificationChannels, field.TypeJSON)
 	}
+	if value, ok := _u.mutation.EscalationChannels(); ok {
+		_spec.SetField(monitor.FieldEscalationChannels, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedEscalationChannels(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, monitor.FieldEscalationChannels, value)
+		})
+	}
+	if _u.mutation.EscalationChannelsCleared() {
+		_spec.ClearField(monitor.FieldEscalationChannels, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Tags(); ok {
+		_spec.SetField(monitor.FieldTags, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedTags(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, monitor.FieldTags, value)
+		})
+	}
+	if _u.mutation.TagsCleared() {
+		_spec.ClearField(monitor.FieldTags, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.EscalationAfterMinutes(); ok {
+		_spec.SetField(monitor.FieldEscalationAfterMinutes, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedEscalationAfterMinutes(); ok {
+		_spec.AddField(monitor.FieldEscalationAfterMinutes, field.TypeInt, value)
+	}
+	if _u.mutation.EscalationAfterMinutesCleared() {
+		_spec.ClearField(monitor.FieldEscalationAfterMinutes, field.TypeInt)
+	}
 	if value, ok := _u.mutation.Selector(); ok {
 		_spec.SetField(monitor.FieldSelector, field.TypeString, value)
 	}
 	if _u.mutation.SelectorCleared() {
 		_spec.ClearField(monitor.FieldSelector, field.TypeString)
 	}
+	if value, ok := _u.mutation.ExpectedStatus(); ok {
+		_spec.SetField(monitor.FieldExpectedStatus, field.TypeString, value)
+	}
+	if _u.mutation.ExpectedStatusCleared() {
+		_spec.ClearField(monitor.FieldExpectedStatus, field.TypeString)
+	}
+	if value, ok := _u.mutation.RetryOn(); ok {
+		_spec.SetField(monitor.FieldRetryOn, field.TypeString, value)
+	}
+	if _u.mutation.RetryOnCleared() {
+		_spec.ClearField(monitor.FieldRetryOn, field.TypeString)
+	}
 	if value, ok := _u.mutation.ExpectedType(); ok {
 		_spec.SetField(monitor.FieldExpectedType, field.TypeEnum, value)
 	}
@@ -1110,12 +3155,181 @@ func (_u *MonitorUpdateOne) sqlSave(ctx context.Context) (_node *Monitor, err er
 	if _u.mutation.ExpectedResponseCleared() {
 		_spec.ClearField(monitor.FieldExpectedResponse, field.TypeString)
 	}
+	if value, ok := _u.mutation.MustContain(); ok {
+		_spec.SetField(monitor.FieldMustContain, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedMustContain(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, monitor.FieldMustContain, value)
+		})
+	}
+	if _u.mutation.MustContainCleared() {
+		_spec.ClearField(monitor.FieldMustContain, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.MustNotContain(); ok {
+		_spec.SetField(monitor.FieldMustNotContain, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedMustNotContain(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, monitor.FieldMustNotContain, value)
+		})
+	}
+	if _u.mutation.MustNotContainCleared() {
+		_spec.ClearField(monitor.FieldMustNotContain, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.TreatNotFoundAsSuccess(); ok {
+		_spec.SetField(monitor.FieldTreatNotFoundAsSuccess, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.AcceptEmptyBody(); ok {
+		_spec.SetField(monitor.FieldAcceptEmptyBody, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.WatchdogMinutes(); ok {
+		_spec.SetField(monitor.FieldWatchdogMinutes, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedWatchdogMinutes(); ok {
+		_spec.AddField(monitor.FieldWatchdogMinutes, field.TypeInt, value)
+	}
+	if _u.mutation.WatchdogMinutesCleared() {
+		_spec.ClearField(monitor.FieldWatchdogMinutes, field.TypeInt)
+	}
+	if value, ok := _u.mutation.HeaderAssertions(); ok {
+		_spec.SetField(monitor.FieldHeaderAssertions, field.TypeJSON, value)
+	}
+	if _u.mutation.HeaderAssertionsCleared() {
+		_spec.ClearField(monitor.FieldHeaderAssertions, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.TrackHeader(); ok {
+		_spec.SetField(monitor.FieldTrackHeader, field.TypeString, value)
+	}
+	if _u.mutation.TrackHeaderCleared() {
+		_spec.ClearField(monitor.FieldTrackHeader, field.TypeString)
+	}
+	if value, ok := _u.mutation.NumericTolerance(); ok {
+		_spec.SetField(monitor.FieldNumericTolerance, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedNumericTolerance(); ok {
+		_spec.AddField(monitor.FieldNumericTolerance, field.TypeFloat64, value)
+	}
+	if _u.mutation.NumericToleranceCleared() {
+		_spec.ClearField(monitor.FieldNumericTolerance, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.RedirectPolicy(); ok {
+		_spec.SetField(monitor.FieldRedirectPolicy, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.MaxRedirects(); ok {
+		_spec.SetField(monitor.FieldMaxRedirects, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedMaxRedirects(); ok {
+		_spec.AddField(monitor.FieldMaxRedirects, field.TypeInt, value)
+	}
+	if _u.mutation.MaxRedirectsCleared() {
+		_spec.ClearField(monitor.FieldMaxRedirects, field.TypeInt)
+	}
+	if value, ok := _u.mutation.MaxResponseBytes(); ok {
+		_spec.SetField(monitor.FieldMaxResponseBytes, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedMaxResponseBytes(); ok {
+		_spec.AddField(monitor.FieldMaxResponseBytes, field.TypeInt, value)
+	}
+	if _u.mutation.MaxResponseBytesCleared() {
+		_spec.ClearField(monitor.FieldMaxResponseBytes, field.TypeInt)
+	}
+	if value, ok := _u.mutation.ChecksHistoryLimit(); ok {
+		_spec.SetField(monitor.FieldChecksHistoryLimit, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedChecksHistoryLimit(); ok {
+		_spec.AddField(monitor.FieldChecksHistoryLimit, field.TypeInt, value)
+	}
+	if _u.mutation.ChecksHistoryLimitCleared() {
+		_spec.ClearField(monitor.FieldChecksHistoryLimit, field.TypeInt)
+	}
+	if value, ok := _u.mutation.CookieJar(); ok {
+		_spec.SetField(monitor.FieldCookieJar, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.HostOverrides(); ok {
+		_spec.SetField(monitor.FieldHostOverrides, field.TypeJSON, value)
+	}
+	if _u.mutation.HostOverridesCleared() {
+		_spec.ClearField(monitor.FieldHostOverrides, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.IPFamily(); ok {
+		_spec.SetField(monitor.FieldIPFamily, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.TLSCaPem(); ok {
+		_spec.SetField(monitor.FieldTLSCaPem, field.TypeString, value)
+	}
+	if _u.mutation.TLSCaPemCleared() {
+		_spec.ClearField(monitor.FieldTLSCaPem, field.TypeString)
+	}
+	if value, ok := _u.mutation.TLSInsecureSkipVerify(); ok {
+		_spec.SetField(monitor.FieldTLSInsecureSkipVerify, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.TLSMinVersion(); ok {
+		_spec.SetField(monitor.FieldTLSMinVersion, field.TypeString, value)
+	}
+	if _u.mutation.TLSMinVersionCleared() {
+		_spec.ClearField(monitor.FieldTLSMinVersion, field.TypeString)
+	}
+	if value, ok := _u.mutation.TLSServerName(); ok {
+		_spec.SetField(monitor.FieldTLSServerName, field.TypeString, value)
+	}
+	if _u.mutation.TLSServerNameCleared() {
+		_spec.ClearField(monitor.FieldTLSServerName, field.TypeString)
+	}
 	if value, ok := _u.mutation.Cron(); ok {
 		_spec.SetField(monitor.FieldCron, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.Timezone(); ok {
+		_spec.SetField(monitor.FieldTimezone, field.TypeString, value)
+	}
+	if _u.mutation.TimezoneCleared() {
+		_spec.ClearField(monitor.FieldTimezone, field.TypeString)
+	}
+	if value, ok := _u.mutation.JitterSeconds(); ok {
+		_spec.SetField(monitor.FieldJitterSeconds, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedJitterSeconds(); ok {
+		_spec.AddField(monitor.FieldJitterSeconds, field.TypeInt, value)
+	}
+	if _u.mutation.JitterSecondsCleared() {
+		_spec.ClearField(monitor.FieldJitterSeconds, field.TypeInt)
+	}
+	if value, ok := _u.mutation.DstPolicy(); ok {
+		_spec.SetField(monitor.FieldDstPolicy, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.BodySnapshot(); ok {
+		_spec.SetField(monitor.FieldBodySnapshot, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.ContentHash(); ok {
+		_spec.SetField(monitor.FieldContentHash, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.FetchMode(); ok {
+		_spec.SetField(monitor.FieldFetchMode, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.HeartbeatToken(); ok {
+		_spec.SetField(monitor.FieldHeartbeatToken, field.TypeString, value)
+	}
+	if _u.mutation.HeartbeatTokenCleared() {
+		_spec.ClearField(monitor.FieldHeartbeatToken, field.TypeString)
+	}
 	if value, ok := _u.mutation.Enabled(); ok {
 		_spec.SetField(monitor.FieldEnabled, field.TypeBool, value)
 	}
+	if value, ok := _u.mutation.StatusPage(); ok {
+		_spec.SetField(monitor.FieldStatusPage, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.SortIndex(); ok {
+		_spec.SetField(monitor.FieldSortIndex, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedSortIndex(); ok {
+		_spec.AddField(monitor.FieldSortIndex, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.ArchivedAt(); ok {
+		_spec.SetField(monitor.FieldArchivedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ArchivedAtCleared() {
+		_spec.ClearField(monitor.FieldArchivedAt, field.TypeTime)
+	}
 	if value, ok := _u.mutation.UpdatedAt(); ok {
 		_spec.SetField(monitor.FieldUpdatedAt, field.TypeTime, value)
 	}
@@ -1238,6 +3452,125 @@ func (_u *MonitorUpdateOne) sqlSave(ctx context.Context) (_node *Monitor, err er
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.VersionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   monitor.VersionsTable,
+			Columns: []string{monitor.VersionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(monitorversion.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedVersionsIDs(); len(nodes) > 0 && !_u.mutation.VersionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   monitor.VersionsTable,
+			Columns: []string{monitor.VersionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(monitorversion.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.VersionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   monitor.VersionsTable,
+			Columns: []string{monitor.VersionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(monitorversion.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.RollupsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   monitor.RollupsTable,
+			Columns: []string{monitor.RollupsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(checkrollup.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedRollupsIDs(); len(nodes) > 0 && !_u.mutation.RollupsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   monitor.RollupsTable,
+			Columns: []string{monitor.RollupsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(checkrollup.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RollupsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   monitor.RollupsTable,
+			Columns: []string{monitor.RollupsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(checkrollup.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.HeaderProfileCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   monitor.HeaderProfileTable,
+			Columns: []string{monitor.HeaderProfileColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(headerprofile.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.HeaderProfileIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   monitor.HeaderProfileTable,
+			Columns: []string{monitor.HeaderProfileColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(headerprofile.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &Monitor{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
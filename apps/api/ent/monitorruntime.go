@@ -3,9 +3,11 @@
 package ent
 
 import (
+	"encoding/json"
 	"fmt"
 	"goanna/apps/api/ent/monitor"
 	"goanna/apps/api/ent/monitorruntime"
+	"goanna/apps/api/ent/schema"
 	"strings"
 	"time"
 
@@ -46,6 +48,32 @@ type MonitorRuntime struct {
 	LastErrorMessage *string `json:"last_error_message,omitempty"`
 	// NextRunAt holds the value of the "next_run_at" field.
 	NextRunAt *time.Time `json:"next_run_at,omitempty"`
+	// FailingSince holds the value of the "failing_since" field.
+	FailingSince *time.Time `json:"failing_since,omitempty"`
+	// EscalatedAt holds the value of the "escalated_at" field.
+	EscalatedAt *time.Time `json:"escalated_at,omitempty"`
+	// AcknowledgedAt holds the value of the "acknowledged_at" field.
+	AcknowledgedAt *time.Time `json:"acknowledged_at,omitempty"`
+	// DailyChangeCounts holds the value of the "daily_change_counts" field.
+	DailyChangeCounts map[string]int `json:"daily_change_counts,omitempty"`
+	// LastChangeAt holds the value of the "last_change_at" field.
+	LastChangeAt *time.Time `json:"last_change_at,omitempty"`
+	// ErrorRepeatingSince holds the value of the "error_repeating_since" field.
+	ErrorRepeatingSince *time.Time `json:"error_repeating_since,omitempty"`
+	// ExpectChangeUntil holds the value of the "expect_change_until" field.
+	ExpectChangeUntil *time.Time `json:"expect_change_until,omitempty"`
+	// WatchdogAlertedAt holds the value of the "watchdog_alerted_at" field.
+	WatchdogAlertedAt *time.Time `json:"watchdog_alerted_at,omitempty"`
+	// LastPingAt holds the value of the "last_ping_at" field.
+	LastPingAt *time.Time `json:"last_ping_at,omitempty"`
+	// CircuitOpenedAt holds the value of the "circuit_opened_at" field.
+	CircuitOpenedAt *time.Time `json:"circuit_opened_at,omitempty"`
+	// Cookies holds the value of the "cookies" field.
+	Cookies []schema.StoredCookie `json:"cookies,omitempty"`
+	// ClaimedBy holds the value of the "claimed_by" field.
+	ClaimedBy *string `json:"claimed_by,omitempty"`
+	// ClaimedUntil holds the value of the "claimed_until" field.
+	ClaimedUntil *time.Time `json:"claimed_until,omitempty"`
 	// UpdatedAt holds the value of the "updated_at" field.
 	UpdatedAt time.Time `json:"updated_at,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
@@ -80,11 +108,13 @@ func (*MonitorRuntime) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
+		case monitorruntime.FieldDailyChangeCounts, monitorruntime.FieldCookies:
+			values[i] = new([]byte)
 		case monitorruntime.FieldID, monitorruntime.FieldCheckCount, monitorruntime.FieldSuccessCount, monitorruntime.FieldErrorCount, monitorruntime.FieldRetryCount, monitorruntime.FieldConsecutiveSuccesses, monitorruntime.FieldConsecutiveErrors, monitorruntime.FieldLastStatusCode, monitorruntime.FieldLastDurationMs:
 			values[i] = new(sql.NullInt64)
-		case monitorruntime.FieldStatus, monitorruntime.FieldLastErrorMessage:
+		case monitorruntime.FieldStatus, monitorruntime.FieldLastErrorMessage, monitorruntime.FieldClaimedBy:
 			values[i] = new(sql.NullString)
-		case monitorruntime.FieldLastCheckAt, monitorruntime.FieldLastSuccessAt, monitorruntime.FieldLastErrorAt, monitorruntime.FieldNextRunAt, monitorruntime.FieldUpdatedAt:
+		case monitorruntime.FieldLastCheckAt, monitorruntime.FieldLastSuccessAt, monitorruntime.FieldLastErrorAt, monitorruntime.FieldNextRunAt, monitorruntime.FieldFailingSince, monitorruntime.FieldEscalatedAt, monitorruntime.FieldAcknowledgedAt, monitorruntime.FieldLastChangeAt, monitorruntime.FieldErrorRepeatingSince, monitorruntime.FieldExpectChangeUntil, monitorruntime.FieldWatchdogAlertedAt, monitorruntime.FieldLastPingAt, monitorruntime.FieldCircuitOpenedAt, monitorruntime.FieldClaimedUntil, monitorruntime.FieldUpdatedAt:
 			values[i] = new(sql.NullTime)
 		case monitorruntime.ForeignKeys[0]: // monitor_runtime
 			values[i] = new(sql.NullInt64)
@@ -200,6 +230,99 @@ func (_m *MonitorRuntime) assignValues(columns []string, values []any) error {
 				_m.NextRunAt = new(time.Time)
 				*_m.NextRunAt = value.Time
 			}
+		case monitorruntime.FieldFailingSince:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field failing_since", values[i])
+			} else if value.Valid {
+				_m.FailingSince = new(time.Time)
+				*_m.FailingSince = value.Time
+			}
+		case monitorruntime.FieldEscalatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field escalated_at", values[i])
+			} else if value.Valid {
+				_m.EscalatedAt = new(time.Time)
+				*_m.EscalatedAt = value.Time
+			}
+		case monitorruntime.FieldAcknowledgedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field acknowledged_at", values[i])
+			} else if value.Valid {
+				_m.AcknowledgedAt = new(time.Time)
+				*_m.AcknowledgedAt = value.Time
+			}
+		case monitorruntime.FieldDailyChangeCounts:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field daily_change_counts", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.DailyChangeCounts); err != nil {
+					return fmt.Errorf("unmarshal field daily_change_counts: %w", err)
+				}
+			}
+		case monitorruntime.FieldLastChangeAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field last_change_at", values[i])
+			} else if value.Valid {
+				_m.LastChangeAt = new(time.Time)
+				*_m.LastChangeAt = value.Time
+			}
+		case monitorruntime.FieldErrorRepeatingSince:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field error_repeating_since", values[i])
+			} else if value.Valid {
+				_m.ErrorRepeatingSince = new(time.Time)
+				*_m.ErrorRepeatingSince = value.Time
+			}
+		case monitorruntime.FieldExpectChangeUntil:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field expect_change_until", values[i])
+			} else if value.Valid {
+				_m.ExpectChangeUntil = new(time.Time)
+				*_m.ExpectChangeUntil = value.Time
+			}
+		case monitorruntime.FieldWatchdogAlertedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field watchdog_alerted_at", values[i])
+			} else if value.Valid {
+				_m.WatchdogAlertedAt = new(time.Time)
+				*_m.WatchdogAlertedAt = value.Time
+			}
+		case monitorruntime.FieldLastPingAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field last_ping_at", values[i])
+			} else if value.Valid {
+				_m.LastPingAt = new(time.Time)
+				*_m.LastPingAt = value.Time
+			}
+		case monitorruntime.FieldCircuitOpenedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field circuit_opened_at", values[i])
+			} else if value.Valid {
+				_m.CircuitOpenedAt = new(time.Time)
+				*_m.CircuitOpenedAt = value.Time
+			}
+		case monitorruntime.FieldCookies:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field cookies", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Cookies); err != nil {
+					return fmt.Errorf("unmarshal field cookies: %w", err)
+				}
+			}
+		case monitorruntime.FieldClaimedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field claimed_by", values[i])
+			} else if value.Valid {
+				_m.ClaimedBy = new(string)
+				*_m.ClaimedBy = value.String
+			}
+		case monitorruntime.FieldClaimedUntil:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field claimed_until", values[i])
+			} else if value.Valid {
+				_m.ClaimedUntil = new(time.Time)
+				*_m.ClaimedUntil = value.Time
+			}
 		case monitorruntime.FieldUpdatedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
@@ -310,6 +433,67 @@ func (_m *MonitorRuntime) String() string {
 		builder.WriteString(v.Format(time.ANSIC))
 	}
 	builder.WriteString(", ")
+	if v := _m.FailingSince; v != nil {
+		builder.WriteString("failing_since=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.EscalatedAt; v != nil {
+		builder.WriteString("escalated_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.AcknowledgedAt; v != nil {
+		builder.WriteString("acknowledged_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("daily_change_counts=")
+	builder.WriteString(fmt.Sprintf("%v", _m.DailyChangeCounts))
+	builder.WriteString(", ")
+	if v := _m.LastChangeAt; v != nil {
+		builder.WriteString("last_change_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.ErrorRepeatingSince; v != nil {
+		builder.WriteString("error_repeating_since=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.ExpectChangeUntil; v != nil {
+		builder.WriteString("expect_change_until=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.WatchdogAlertedAt; v != nil {
+		builder.WriteString("watchdog_alerted_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.LastPingAt; v != nil {
+		builder.WriteString("last_ping_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.CircuitOpenedAt; v != nil {
+		builder.WriteString("circuit_opened_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("cookies=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Cookies))
+	builder.WriteString(", ")
+	if v := _m.ClaimedBy; v != nil {
+		builder.WriteString("claimed_by=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := _m.ClaimedUntil; v != nil {
+		builder.WriteString("claimed_until=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
 	builder.WriteString("updated_at=")
 	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
 	builder.WriteByte(')')
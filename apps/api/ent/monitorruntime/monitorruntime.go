@@ -43,6 +43,32 @@ const (
 	FieldLastErrorMessage = "last_error_message"
 	// FieldNextRunAt holds the string denoting the next_run_at field in the database.
 	FieldNextRunAt = "next_run_at"
+	// FieldFailingSince holds the string denoting the failing_since field in the database.
+	FieldFailingSince = "failing_since"
+	// FieldEscalatedAt holds the string denoting the escalated_at field in the database.
+	FieldEscalatedAt = "escalated_at"
+	// FieldAcknowledgedAt holds the string denoting the acknowledged_at field in the database.
+	FieldAcknowledgedAt = "acknowledged_at"
+	// FieldDailyChangeCounts holds the string denoting the daily_change_counts field in the database.
+	FieldDailyChangeCounts = "daily_change_counts"
+	// FieldLastChangeAt holds the string denoting the last_change_at field in the database.
+	FieldLastChangeAt = "last_change_at"
+	// FieldErrorRepeatingSince holds the string denoting the error_repeating_since field in the database.
+	FieldErrorRepeatingSince = "error_repeating_since"
+	// FieldExpectChangeUntil holds the string denoting the expect_change_until field in the database.
+	FieldExpectChangeUntil = "expect_change_until"
+	// FieldWatchdogAlertedAt holds the string denoting the watchdog_alerted_at field in the database.
+	FieldWatchdogAlertedAt = "watchdog_alerted_at"
+	// FieldLastPingAt holds the string denoting the last_ping_at field in the database.
+	FieldLastPingAt = "last_ping_at"
+	// FieldCircuitOpenedAt holds the string denoting the circuit_opened_at field in the database.
+	FieldCircuitOpenedAt = "circuit_opened_at"
+	// FieldCookies holds the string denoting the cookies field in the database.
+	FieldCookies = "cookies"
+	// FieldClaimedBy holds the string denoting the claimed_by field in the database.
+	FieldClaimedBy = "claimed_by"
+	// FieldClaimedUntil holds the string denoting the claimed_until field in the database.
+	FieldClaimedUntil = "claimed_until"
 	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
 	FieldUpdatedAt = "updated_at"
 	// EdgeMonitor holds the string denoting the monitor edge name in mutations.
@@ -75,6 +101,19 @@ var Columns = []string{
 	FieldLastDurationMs,
 	FieldLastErrorMessage,
 	FieldNextRunAt,
+	FieldFailingSince,
+	FieldEscalatedAt,
+	FieldAcknowledgedAt,
+	FieldDailyChangeCounts,
+	FieldLastChangeAt,
+	FieldErrorRepeatingSince,
+	FieldExpectChangeUntil,
+	FieldWatchdogAlertedAt,
+	FieldLastPingAt,
+	FieldCircuitOpenedAt,
+	FieldCookies,
+	FieldClaimedBy,
+	FieldClaimedUntil,
 	FieldUpdatedAt,
 }
 
@@ -225,6 +264,61 @@ func ByNextRunAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldNextRunAt, opts...).ToFunc()
 }
 
+// ByFailingSince orders the results by the failing_since field.
+func ByFailingSince(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFailingSince, opts...).ToFunc()
+}
+
+// ByEscalatedAt orders the results by the escalated_at field.
+func ByEscalatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEscalatedAt, opts...).ToFunc()
+}
+
+// ByAcknowledgedAt orders the results by the acknowledged_at field.
+func ByAcknowledgedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAcknowledgedAt, opts...).ToFunc()
+}
+
+// ByLastChangeAt orders the results by the last_change_at field.
+func ByLastChangeAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLastChangeAt, opts...).ToFunc()
+}
+
+// ByErrorRepeatingSince orders the results by the error_repeating_since field.
+func ByErrorRepeatingSince(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldErrorRepeatingSince, opts...).ToFunc()
+}
+
+// ByExpectChangeUntil orders the results by the expect_change_until field.
+func ByExpectChangeUntil(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldExpectChangeUntil, opts...).ToFunc()
+}
+
+// ByWatchdogAlertedAt orders the results by the watchdog_alerted_at field.
+func ByWatchdogAlertedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldWatchdogAlertedAt, opts...).ToFunc()
+}
+
+// ByLastPingAt orders the results by the last_ping_at field.
+func ByLastPingAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLastPingAt, opts...).ToFunc()
+}
+
+// ByCircuitOpenedAt orders the results by the circuit_opened_at field.
+func ByCircuitOpenedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCircuitOpenedAt, opts...).ToFunc()
+}
+
+// ByClaimedBy orders the results by the claimed_by field.
+func ByClaimedBy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldClaimedBy, opts...).ToFunc()
+}
+
+// ByClaimedUntil orders the results by the claimed_until field.
+func ByClaimedUntil(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldClaimedUntil, opts...).ToFunc()
+}
+
 // ByUpdatedAt orders the results by the updated_at field.
 func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
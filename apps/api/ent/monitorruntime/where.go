@@ -120,6 +120,61 @@ func NextRunAt(v time.Time) predicate.MonitorRuntime {
 	return predicate.MonitorRuntime(sql.FieldEQ(FieldNextRunAt, v))
 }
 
+// FailingSince applies equality check predicate on the "failing_since" field. It's identical to FailingSinceEQ.
+func FailingSince(v time.Time) predicate.MonitorRuntime {
+	return predicate.MonitorRuntime(sql.FieldEQ(FieldFailingSince, v))
+}
+
+// EscalatedAt applies equality check predicate on the "escalated_at" field. It's identical to EscalatedAtEQ.
+func EscalatedAt(v time.Time) predicate.MonitorRuntime {
+	return predicate.MonitorRuntime(sql.FieldEQ(FieldEscalatedAt, v))
+}
+
+// AcknowledgedAt applies equality check predicate on the "acknowledged_at" field. It's identical to AcknowledgedAtEQ.
+func AcknowledgedAt(v time.Time) predicate.MonitorRuntime {
+	return predicate.MonitorRuntime(sql.FieldEQ(FieldAcknowledgedAt, v))
+}
+
+// LastChangeAt applies equality check predicate on the "last_change_at" field. It's identical to LastChangeAtEQ.
+func LastChangeAt(v time.Time) predicate.MonitorRuntime {
+	return predicate.MonitorRuntime(sql.FieldEQ(FieldLastChangeAt, v))
+}
+
+// ErrorRepeatingSince applies equality check predicate on the "error_repeating_since" field. It's identical to ErrorRepeatingSinceEQ.
+func ErrorRepeatingSince(v time.Time) predicate.MonitorRuntime {
+	return predicate.MonitorRuntime(sql.FieldEQ(FieldErrorRepeatingSince, v))
+}
+
+// ExpectChangeUntil applies equality check predicate on the "expect_change_until" field. It's identical to ExpectChangeUntilEQ.
+func ExpectChangeUntil(v time.Time) predicate.MonitorRuntime {
+	return predicate.MonitorRuntime(sql.FieldEQ(FieldExpectChangeUntil, v))
+}
+
+// WatchdogAlertedAt applies equality check predicate on the "watchdog_alerted_at" field. It's identical to WatchdogAlertedAtEQ.
+func WatchdogAlertedAt(v time.Time) predicate.MonitorRuntime {
+	return predicate.MonitorRuntime(sql.FieldEQ(FieldWatchdogAlertedAt, v))
+}
+
+// LastPingAt applies equality check predicate on the "last_ping_at" field. It's identical to LastPingAtEQ.
+func LastPingAt(v time.Time) predicate.MonitorRuntime {
+	return predicate.MonitorRuntime(sql.FieldEQ(FieldLastPingAt, v))
+}
+
+// CircuitOpenedAt applies equality check predicate on the "circuit_opened_at" field. It's identical to CircuitOpenedAtEQ.
+func CircuitOpenedAt(v time.Time) predicate.MonitorRuntime {
+	return predicate.MonitorRuntime(sql.FieldEQ(FieldCircuitOpenedAt, v))
+}
+
+// ClaimedBy applies equality check predicate on the "claimed_by" field. It's identical to ClaimedByEQ.
+func ClaimedBy(v string) predicate.MonitorRuntime {
+	return predicate.MonitorRuntime(sql.FieldEQ(FieldClaimedBy, v))
+}
+
+// ClaimedUntil applies equality check predicate on the "claimed_until" field. It's identical to ClaimedUntilEQ.
+func ClaimedUntil(v time.Time) predicate.MonitorRuntime {
+	return predicate.MonitorRuntime(sql.FieldEQ(FieldClaimedUntil, v))
+}
+
 // UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
 func UpdatedAt(v time.Time) predicate.MonitorRuntime {
 	return predicate.MonitorRuntime(sql.FieldEQ(FieldUpdatedAt, v))
@@ -760,6 +815,601 @@ func NextRunAtNotNil() predicate.MonitorRuntime {
 	return predicate.MonitorRuntime(sql.FieldNotNull(FieldNextRunAt))
 }
 
+// FailingSinceEQ applies the EQ predicate on the "failing_since" field.
+func FailingSinceEQ(v time.Time) predicate.MonitorRuntime {
+	return predicate.MonitorRuntime(sql.FieldEQ(FieldFailingSince, v))
+}
+
+// FailingSinceNEQ applies the NEQ predicate on the "failing_since" field.
+func FailingSinceNEQ(v time.Time) predicate.MonitorRuntime {
+	return predicate.MonitorRuntime(sql.FieldNEQ(FieldFailingSince, v))
+}
+
+// FailingSinceIn applies the In predicate on the "failing_since" field.
+func FailingSinceIn(vs ...time.Time) predicate.MonitorRuntime {
+	return predicate.MonitorRuntime(sql.FieldIn(FieldFailingSince, vs...))
+}
+
+// FailingSinceNotIn applies the NotIn predicate on the "failing_since" field.
+func FailingSinceNotIn(vs ...time.Time) predicate.MonitorRuntime {
+	return predicate.MonitorRuntime(sql.FieldNotIn(FieldFailingSince, vs...))
+}
+
+// FailingSinceGT applies the GT predicate on the "failing_since" field.
+func FailingSinceGT(v time.Time) predicate.MonitorRuntime {
+	return predicate.MonitorRuntime(sql.FieldGT(FieldFailingSince, v))
+}
+
+// FailingSinceGTE applies the GTE predicate on the "failing_since" field.
+func FailingSinceGTE(v time.Time) predicate.MonitorRuntime {
+	return predicate.MonitorRuntime(sql.FieldGTE(FieldFailingSince, v))
+}
+
+// FailingSinceLT applies the LT predicate on the "failing_since" field.
+func FailingSinceLT(v time.Time) predicate.MonitorRuntime {
+	return predicate.MonitorRuntime(sql.FieldLT(FieldFailingSince, v))
+}
+
+// FailingSinceLTE applies the LTE predicate on the "failing_since" field.
+func FailingSinceLTE(v time.Time) predicate.MonitorRuntime {
+	return predicate.MonitorRuntime(sql.FieldLTE(FieldFailingSince, v))
+}
+
+// FailingSinceIsNil applies the IsNil predicate on the "failing_since" field.
+func FailingSinceIsNil() predicate.MonitorRuntime {
+	return predicate.MonitorRuntime(sql.FieldIsNull(FieldFailingSince))
+}
+
+// FailingSinceNotNil applies the NotNil predicate on the "failing_since" field.
+func FailingSinceNotNil() predicate.MonitorRuntime {
+	return predicate.MonitorRuntime(sql.FieldNotNull(FieldFailingSince))
+}
+
+// EscalatedAtEQ applies the EQ predicate on the "escalated_at" field.
+func EscalatedAtEQ(v time.Time) predicate.MonitorRuntime {
+	return predicate.MonitorRuntime(sql.FieldEQ(FieldEscalatedAt, v))
+}
+
+// EscalatedAtNEQ applies the NEQ predicate on the "escalated_at" field.
+func EscalatedAtNEQ(v time.Time) predicate.MonitorRuntime {
+	return predicate.MonitorRuntime(sql.FieldNEQ(FieldEscalatedAt, v))
+}
+
+// EscalatedAtIn applies the In predicate on the "escalated_at" field.
+func EscalatedAtIn(vs ...time.Time) predicate.MonitorRuntime {
+	return predicate.MonitorRuntime(sql.FieldIn(FieldEscalatedAt, vs...))
+}
+
+// EscalatedAtNotIn applies the NotIn predicate on the "escalated_at" field.
+func EscalatedAtNotIn(vs ...time.Time) predicate.MonitorRuntime {
+	return predicate.MonitorRuntime(sql.FieldNotIn(FieldEscalatedAt, vs...))
+}
+
+// EscalatedAtGT applies the GT predicate on the "escalated_at" field.
+func EscalatedAtGT(v time.Time) predicate.MonitorRuntime {
+	return predicate.MonitorRuntime(sql.FieldGT(FieldEscalatedAt, v))
+}
+
+// EscalatedAtGTE applies the GTE predicate on the "escalated_at" field.
+func EscalatedAtGTE(v time.Time) predicate.MonitorRuntime {
+	return predicate.MonitorRuntime(sql.FieldGTE(FieldEscalatedAt, v))
+}
+
+// EscalatedAtLT applies the LT predicate on the "escalated_at" field.
+func EscalatedAtLT(v time.Time) predicate.MonitorRuntime {
+	return predicate.MonitorRuntime(sql.FieldLT(FieldEscalatedAt, v))
+}
+
+// EscalatedAtLTE applies the LTE predicate on the "escalated_at" field.
+func EscalatedAtLTE(v time.Time) predicate.MonitorRuntime {
+	return predicate.MonitorRuntime(sql.FieldLTE(FieldEscalatedAt, v))
+}
+
+// EscalatedAtIsNil applies the IsNil predicate on the "escalated_at" field.
+func EscalatedAtIsNil() predicate.MonitorRuntime {
+	return predicate.MonitorRuntime(sql.FieldIsNull(FieldEscalatedAt))
+}
+
+// EscalatedAtNotNil applies the NotNil predicate on the "escalated_at" field.
+func EscalatedAtNotNil() predicate.MonitorRuntime {
+	return predicate.MonitorRuntime(sql.FieldNotNull(FieldEscalatedAt))
+}
+
+// AcknowledgedAtEQ applies the EQ predicate on the "acknowledged_at" field.
+func AcknowledgedAtEQ(v time.Time) predicate.MonitorRuntime {
+	return predicate.MonitorRuntime(sql.FieldEQ(FieldAcknowledgedAt, v))
+}
+
+// AcknowledgedAtNEQ applies the NEQ predicate on the "acknowledged_at" field.
+func AcknowledgedAtNEQ(v time.Time) predicate.MonitorRuntime {
+	return predicate.MonitorRuntime(sql.FieldNEQ(FieldAcknowledgedAt, v))
+}
+
+// AcknowledgedAtIn applies the In predicate on the "acknowledged_at" field.
+func AcknowledgedAtIn(vs ...time.Time) predicate.MonitorRuntime {
+	return predicate.MonitorRuntime(sql.FieldIn(FieldAcknowledgedAt, vs...))
+}
+
+// AcknowledgedAtNotIn applies the NotIn predicate on the "acknowledged_at" field.
+func AcknowledgedAtNotIn(vs ...time.Time) predicate.MonitorRuntime {
+	return predicate.MonitorRuntime(sql.FieldNotIn(FieldAcknowledgedAt, vs...))
+}
+
+// AcknowledgedAtGT applies the GT predicate on the "acknowledged_at" field.
+func AcknowledgedAtGT(v time.Time) predicate.MonitorRuntime {
+	return predicate.MonitorRuntime(sql.FieldGT(FieldAcknowledgedAt, v))
+}
+
+// AcknowledgedAtGTE applies the GTE predicate on the "acknowledged_at" field.
+func AcknowledgedAtGTE(v time.Time) predicate.MonitorRuntime {
+	return predicate.MonitorRuntime(sql.FieldGTE(FieldAcknowledgedAt, v))
+}
+
+// AcknowledgedAtLT applies the LT predicate on the "acknowledged_at" field.
+func AcknowledgedAtLT(v time.Time) predicate.MonitorRuntime {
+	return predicate.MonitorRuntime(sql.FieldLT(FieldAcknowledgedAt, v))
+}
+
+// AcknowledgedAtLTE applies the LTE predicate on the "acknowledged_at" field.
+func AcknowledgedAtLTE(v time.Time) predicate.MonitorRuntime {
+	return predicate.MonitorRuntime(sql.FieldLTE(FieldAcknowledgedAt, v))
+}
+
+// AcknowledgedAtIsNil applies the IsNil predicate on the "acknowledged_at" field.
+func AcknowledgedAtIsNil() predicate.MonitorRuntime {
+	return predicate.MonitorRuntime(sql.FieldIsNull(FieldAcknowledgedAt))
+}
+
+// AcknowledgedAtNotNil applies the NotNil predicate on the "acknowledged_at" field.
+func AcknowledgedAtNotNil() predicate.MonitorRuntime {
+	return predicate.MonitorRuntime(sql.FieldNotNull(FieldAcknowledgedAt))
+}
+
+// DailyChangeCountsIsNil applies the IsNil predicate on the "daily_change_counts" field.
+func DailyChangeCountsIsNil() predicate.MonitorRuntime {
+	return predicate.MonitorRuntime(sql.FieldIsNull(FieldDailyChangeCounts))
+}
+
+// DailyChangeCountsNotNil applies the NotNil predicate on the "daily_change_counts" field.
+func DailyChangeCountsNotNil() predicate.MonitorRuntime {
+	return predicate.MonitorRuntime(sql.FieldNotNull(FieldDailyChangeCounts))
+}
+
+// LastChangeAtEQ applies the EQ predicate on the "last_change_at" field.
+func LastChangeAtEQ(v time.Time) predicate.MonitorRuntime {
+	return predicate.MonitorRuntime(sql.FieldEQ(FieldLastChangeAt, v))
+}
+
+// LastChangeAtNEQ applies the NEQ predicate on the "last_change_at" field.
+func LastChangeAtNEQ(v time.Time) predicate.MonitorRuntime {
+	return predicate.MonitorRuntime(sql.FieldNEQ(FieldLastChangeAt, v))
+}
+
+// LastChangeAtIn applies the In predicate on the "last_change_at" field.
+func LastChangeAtIn(vs ...time.Time) predicate.MonitorRuntime {
+	return predicate.MonitorRuntime(sql.FieldIn(FieldLastChangeAt, vs...))
+}
+
+// LastChangeAtNotIn applies the NotIn predicate on the "last_change_at" field.
+func LastChangeAtNotIn(vs ...time.Time) predicate.MonitorRuntime {
+	return predicate.MonitorRuntime(sql.FieldNotIn(FieldLastChangeAt, vs...))
+}
+
+// LastChangeAtGT applies the GT predicate on the "last_change_at" field.
+func LastChangeAtGT(v time.Time) predicate.MonitorRuntime {
+	return predicate.MonitorRuntime(sql.FieldGT(FieldLastChangeAt, v))
+}
+
+// LastChangeAtGTE applies the GTE predicate on the "last_change_at" field.
+func LastChangeAtGTE(v time.Time) predicate.MonitorRuntime {
+	return predicate.MonitorRuntime(sql.FieldGTE(FieldLastChangeAt, v))
+}
+
+// LastChangeAtLT applies the LT predicate on the "last_change_at" field.
+func LastChangeAtLT(v time.Time) predicate.MonitorRuntime {
+	return predicate.MonitorRuntime(sql.FieldLT(FieldLastChangeAt, v))
+}
+
+// LastChangeAtLTE applies the LTE predicate on the "last_change_at" field.
+func LastChangeAtLTE(v time.Time) predicate.MonitorRuntime {
+	return predicate.MonitorRuntime(sql.FieldLTE(FieldLastChangeAt, v))
+}
+
+// LastChangeAtIsNil applies the IsNil predicate on the "last_change_at" field.
+func LastChangeAtIsNil() predicate.MonitorRuntime {
+	return predicate.MonitorRuntime(sql.FieldIsNull(FieldLastChangeAt))
+}
+
+// LastChangeAtNotNil applies the NotNil predicate on the "last_change_at" field.
+func LastChangeAtNotNil() predicate.MonitorRuntime {
+	return predicate.MonitorRuntime(sql.FieldNotNull(FieldLastChangeAt))
+}
+
+// ErrorRepeatingSinceEQ applies the EQ predicate on the "error_repeating_since" field.
+func ErrorRepeatingSinceEQ(v time.Time) predicate.MonitorRuntime {
+	return predicate.MonitorRuntime(sql.FieldEQ(FieldErrorRepeatingSince, v))
+}
+
+// ErrorRepeatingSinceNEQ applies the NEQ predicate on the "error_repeating_since" field.
+func ErrorRepeatingSinceNEQ(v time.Time) predicate.MonitorRuntime {
+	return predicate.MonitorRuntime(sql.FieldNEQ(FieldErrorRepeatingSince, v))
+}
+
+// ErrorRepeatingSinceIn applies the In predicate on the "error_repeating_since" field.
+func ErrorRepeatingSinceIn(vs ...time.Time) predicate.MonitorRuntime {
+	return predicate.MonitorRuntime(sql.FieldIn(FieldErrorRepeatingSince, vs...))
+}
+
+// ErrorRepeatingSinceNotIn applies the NotIn predicate on the "error_repeating_since" field.
+func ErrorRepeatingSinceNotIn(vs ...time.Time) predicate.MonitorRuntime {
+	return predicate.MonitorRuntime(sql.FieldNotIn(FieldErrorRepeatingSince, vs...))
+}
+
+// ErrorRepeatingSinceGT applies the GT predicate on the "error_repeating_since" field.
+func ErrorRepeatingSinceGT(v time.Time) predicate.MonitorRuntime {
+	return predicate.MonitorRuntime(sql.FieldGT(FieldErrorRepeatingSince, v))
+}
+
+// ErrorRepeatingSinceGTE applies the GTE predicate on the "error_repeating_since" field.
+func ErrorRepeatingSinceGTE(v time.Time) predicate.MonitorRuntime {
+	return predicate.MonitorRuntime(sql.FieldGTE(FieldErrorRepeatingSince, v))
+}
+
+// ErrorRepeatingSinceLT applies the LT predicate on the "error_repeating_since" field.
+func ErrorRepeatingSinceLT(v time.Time) predicate.MonitorRuntime {
+	return predicate.MonitorRuntime(sql.FieldLT(FieldErrorRepeatingSince, v))
+}
+
+// ErrorRepeatingSinceLTE applies the LTE predicate on the "error_repeating_since" field.
+func ErrorRepeatingSinceLTE(v time.Time) predicate.MonitorRuntime {
+	return predicate.MonitorRuntime(sql.FieldLTE(FieldErrorRepeatingSince, v))
+}
+
+// ErrorRepeatingSinceIsNil applies the IsNil predicate on the "error_repeating_since" field.
+func ErrorRepeatingSinceIsNil() predicate.MonitorRuntime {
+	return predicate.MonitorRuntime(sql.FieldIsNull(FieldErrorRepeatingSince))
+}
+
+// ErrorRepeatingSinceNotNil applies the NotNil predicate on the "error_repeating_since" field.
+func ErrorRepeatingSinceNotNil() predicate.MonitorRuntime {
+	return predicate.MonitorRuntime(sql.FieldNotNull(FieldErrorRepeatingSince))
+}
+
+// ExpectChangeUntilEQ applies the EQ predicate on the "expect_change_until" field.
+func ExpectChangeUntilEQ(v time.Time) predicate.MonitorRuntime {
+	return predicate.MonitorRuntime(sql.FieldEQ(FieldExpectChangeUntil, v))
+}
+
+// ExpectChangeUntilNEQ applies the NEQ predicate on the "expect_change_until" field.
+func ExpectChangeUntilNEQ(v time.Time) predicate.MonitorRuntime {
+	return predicate.MonitorRuntime(sql.FieldNEQ(FieldExpectChangeUntil, v))
+}
+
+// ExpectChangeUntilIn applies the In predicate on the "expect_change_until" field.
+func ExpectChangeUntilIn(vs ...time.Time) predicate.MonitorRuntime {
+	return predicate.MonitorRuntime(sql.FieldIn(FieldExpectChangeUntil, vs...))
+}
+
+// ExpectChangeUntilNotIn applies the NotIn predicate on the "expect_change_until" field.
+func ExpectChangeUntilNotIn(vs ...time.Time) predicate.MonitorRuntime {
+	return predicate.MonitorRuntime(sql.FieldNotIn(FieldExpectChangeUntil, vs...))
+}
+
+// ExpectChangeUntilGT applies the GT predicate on the "expect_change_until" field.
+func ExpectChangeUntilGT(v time.Time) predicate.MonitorRuntime {
+	return predicate.MonitorRuntime(sql.FieldGT(FieldExpectChangeUntil, v))
+}
+
+// ExpectChangeUntilGTE applies the GTE predicate on the "expect_change_until" field.
+func ExpectChangeUntilGTE(v time.Time) predicate.MonitorRuntime {
+	return predicate.MonitorRuntime(sql.FieldGTE(FieldExpectChangeUntil, v))
+}
+
+// ExpectChangeUntilLT applies the LT predicate on the "expect_change_until" field.
+func ExpectChangeUntilLT(v time.Time) predicate.MonitorRuntime {
+	return predicate.MonitorRuntime(sql.FieldLT(FieldExpectChangeUntil, v))
+}
+
+// ExpectChangeUntilLTE applies the LTE predicate on the "expect_change_until" field.
+func ExpectChangeUntilLTE(v time.Time) predicate.MonitorRuntime {
+	return predicate.MonitorRuntime(sql.FieldLTE(FieldExpectChangeUntil, v))
+}
+
+// ExpectChangeUntilIsNil applies the IsNil predicate on the "expect_change_until" field.
+func ExpectChangeUntilIsNil() predicate.MonitorRuntime {
+	return predicate.MonitorRuntime(sql.FieldIsNull(FieldExpectChangeUntil))
+}
+
+// ExpectChangeUntilNotNil applies the NotNil predicate on the "expect_change_until" field.
+func ExpectChangeUntilNotNil() predicate.MonitorRuntime {
+	return predicate.MonitorRuntime(sql.FieldNotNull(FieldExpectChangeUntil))
+}
+
+// WatchdogAlertedAtEQ applies the EQ predicate on the "watchdog_alerted_at" field.
+func WatchdogAlertedAtEQ(v time.Time) predicate.MonitorRuntime {
+	return predicate.MonitorRuntime(sql.FieldEQ(FieldWatchdogAlertedAt, v))
+}
+
+// WatchdogAlertedAtNEQ applies the NEQ predicate on the "watchdog_alerted_at" field.
+func WatchdogAlertedAtNEQ(v time.Time) predicate.MonitorRuntime {
+	return predicate.MonitorRuntime(sql.FieldNEQ(FieldWatchdogAlertedAt, v))
+}
+
+// WatchdogAlertedAtIn applies the In predicate on the "watchdog_alerted_at" field.
+func WatchdogAlertedAtIn(vs ...time.Time) predicate.MonitorRuntime {
+	return predicate.MonitorRuntime(sql.FieldIn(FieldWatchdogAlertedAt, vs...))
+}
+
+// WatchdogAlertedAtNotIn applies the NotIn predicate on the "watchdog_alerted_at" field.
+func WatchdogAlertedAtNotIn(vs ...time.Time) predicate.MonitorRuntime {
+	return predicate.MonitorRuntime(sql.FieldNotIn(FieldWatchdogAlertedAt, vs...))
+}
+
+// WatchdogAlertedAtGT applies the GT predicate on the "watchdog_alerted_at" field.
+func WatchdogAlertedAtGT(v time.Time) predicate.MonitorRuntime {
+	return predicate.MonitorRuntime(sql.FieldGT(FieldWatchdogAlertedAt, v))
+}
+
+// WatchdogAlertedAtGTE applies the GTE predicate on the "watchdog_alerted_at" field.
+func WatchdogAlertedAtGTE(v time.Time) predicate.MonitorRuntime {
+	return predicate.MonitorRuntime(sql.FieldGTE(FieldWatchdogAlertedAt, v))
+}
+
+// WatchdogAlertedAtLT applies the LT predicate on the "watchdog_alerted_at" field.
+func WatchdogAlertedAtLT(v time.Time) predicate.MonitorRuntime {
+	return predicate.MonitorRuntime(sql.FieldLT(FieldWatchdogAlertedAt, v))
+}
+
+// WatchdogAlertedAtLTE applies the LTE predicate on the "watchdog_alerted_at" field.
+func WatchdogAlertedAtLTE(v time.Time) predicate.MonitorRuntime {
+	return predicate.MonitorRuntime(sql.FieldLTE(FieldWatchdogAlertedAt, v))
+}
+
+// WatchdogAlertedAtIsNil applies the IsNil predicate on the "watchdog_alerted_at" field.
+func WatchdogAlertedAtIsNil() predicate.MonitorRuntime {
+	return predicate.MonitorRuntime(sql.FieldIsNull(FieldWatchdogAlertedAt))
+}
+
+// WatchdogAlertedAtNotNil applies the NotNil predicate on the "watchdog_alerted_at" field.
+func WatchdogAlertedAtNotNil() predicate.MonitorRuntime {
+	return predicate.MonitorRuntime(sql.FieldNotNull(FieldWatchdogAlertedAt))
+}
+
+// LastPingAtEQ applies the EQ predicate on the "last_ping_at" field.
+func LastPingAtEQ(v time.Time) predicate.MonitorRuntime {
+	return predicate.MonitorRuntime(sql.FieldEQ(FieldLastPingAt, v))
+}
+
+// LastPingAtNEQ applies the NEQ predicate on the "last_ping_at" field.
+func LastPingAtNEQ(v time.Time) predicate.MonitorRuntime {
+	return predicate.MonitorRuntime(sql.FieldNEQ(FieldLastPingAt, v))
+}
+
+// LastPingAtIn applies the In predicate on the "last_ping_at" field.
+func LastPingAtIn(vs ...time.Time) predicate.MonitorRuntime {
+	return predicate.MonitorRuntime(sql.FieldIn(FieldLastPingAt, vs...))
+}
+
+// LastPingAtNotIn applies the NotIn predicate on the "last_ping_at" field.
+func LastPingAtNotIn(vs ...time.Time) predicate.MonitorRuntime {
+	return predicate.MonitorRuntime(sql.FieldNotIn(FieldLastPingAt, vs...))
+}
+
+// LastPingAtGT applies the GT predicate on the "last_ping_at" field.
+func LastPingAtGT(v time.Time) predicate.MonitorRuntime {
+	return predicate.MonitorRuntime(sql.FieldGT(FieldLastPingAt, v))
+}
+
+// LastPingAtGTE applies the GTE predicate on the "last_ping_at" field.
+func LastPingAtGTE(v time.Time) predicate.MonitorRuntime {
+	return predicate.MonitorRuntime(sql.FieldGTE(FieldLastPingAt, v))
+}
+
+// LastPingAtLT applies the LT predicate on the "last_ping_at" field.
+func LastPingAtLT(v time.Time) predicate.MonitorRuntime {
+	return predicate.MonitorRuntime(sql.FieldLT(FieldLastPingAt, v))
+}
+
+// LastPingAtLTE applies the LTE predicate on the "last_ping_at" field.
+func LastPingAtLTE(v time.Time) predicate.MonitorRuntime {
+	return predicate.MonitorRuntime(sql.FieldLTE(FieldLastPingAt, v))
+}
+
+// LastPingAtIsNil applies the IsNil predicate on the "last_ping_at" field.
+func LastPingAtIsNil() predicate.MonitorRuntime {
+	return predicate.MonitorRuntime(sql.FieldIsNull(FieldLastPingAt))
+}
+
+// LastPingAtNotNil applies the NotNil predicate on the "last_ping_at" field.
+func LastPingAtNotNil() predicate.MonitorRuntime {
+	return predicate.MonitorRuntime(sql.FieldNotNull(FieldLastPingAt))
+}
+
+// CircuitOpenedAtEQ applies the EQ predicate on the "circuit_opened_at" field.
+func CircuitOpenedAtEQ(v time.Time) predicate.MonitorRuntime {
+	return predicate.MonitorRuntime(sql.FieldEQ(FieldCircuitOpenedAt, v))
+}
+
+// CircuitOpenedAtNEQ applies the NEQ predicate on the "circuit_opened_at" field.
+func CircuitOpenedAtNEQ(v time.Time) predicate.MonitorRuntime {
+	return predicate.MonitorRuntime(sql.FieldNEQ(FieldCircuitOpenedAt, v))
+}
+
+// CircuitOpenedAtIn applies the In predicate on the "circuit_opened_at" field.
+func CircuitOpenedAtIn(vs ...time.Time) predicate.MonitorRuntime {
+	return predicate.MonitorRuntime(sql.FieldIn(FieldCircuitOpenedAt, vs...))
+}
+
+// CircuitOpenedAtNotIn applies the NotIn predicate on the "circuit_opened_at" field.
+func CircuitOpenedAtNotIn(vs ...time.Time) predicate.MonitorRuntime {
+	return predicate.MonitorRuntime(sql.FieldNotIn(FieldCircuitOpenedAt, vs...))
+}
+
+// CircuitOpenedAtGT applies the GT predicate on the "circuit_opened_at" field.
+func CircuitOpenedAtGT(v time.Time) predicate.MonitorRuntime {
+	return predicate.MonitorRuntime(sql.FieldGT(FieldCircuitOpenedAt, v))
+}
+
+// CircuitOpenedAtGTE applies the GTE predicate on the "circuit_opened_at" field.
+func CircuitOpenedAtGTE(v time.Time) predicate.MonitorRuntime {
+	return predicate.MonitorRuntime(sql.FieldGTE(FieldCircuitOpenedAt, v))
+}
+
+// CircuitOpenedAtLT applies the LT predicate on the "circuit_opened_at" field.
+func CircuitOpenedAtLT(v time.Time) predicate.MonitorRuntime {
+	return predicate.MonitorRuntime(sql.FieldLT(FieldCircuitOpenedAt, v))
+}
+
+// CircuitOpenedAtLTE applies the LTE predicate on the "circuit_opened_at" field.
+func CircuitOpenedAtLTE(v time.Time) predicate.MonitorRuntime {
+	return predicate.MonitorRuntime(sql.FieldLTE(FieldCircuitOpenedAt, v))
+}
+
+// CircuitOpenedAtIsNil applies the IsNil predicate on the "circuit_opened_at" field.
+func CircuitOpenedAtIsNil() predicate.MonitorRuntime {
+	return predicate.MonitorRuntime(sql.FieldIsNull(FieldCircuitOpenedAt))
+}
+
+// CircuitOpenedAtNotNil applies the NotNil predicate on the "circuit_opened_at" field.
+func CircuitOpenedAtNotNil() predicate.MonitorRuntime {
+	return predicate.MonitorRuntime(sql.FieldNotNull(FieldCircuitOpenedAt))
+}
+
+// CookiesIsNil applies the IsNil predicate on the "cookies" field.
+func CookiesIsNil() predicate.MonitorRuntime {
+	return predicate.MonitorRuntime(sql.FieldIsNull(FieldCookies))
+}
+
+// CookiesNotNil applies the NotNil predicate on the "cookies" field.
+func CookiesNotNil() predicate.MonitorRuntime {
+	return predicate.MonitorRuntime(sql.FieldNotNull(FieldCookies))
+}
+
+// ClaimedByEQ applies the EQ predicate on the "claimed_by" field.
+func ClaimedByEQ(v string) predicate.MonitorRuntime {
+	return predicate.MonitorRuntime(sql.FieldEQ(FieldClaimedBy, v))
+}
+
+// ClaimedByNEQ applies the NEQ predicate on the "claimed_by" field.
+func ClaimedByNEQ(v string) predicate.MonitorRuntime {
+	return predicate.MonitorRuntime(sql.FieldNEQ(FieldClaimedBy, v))
+}
+
+// ClaimedByIn applies the In predicate on the "claimed_by" field.
+func ClaimedByIn(vs ...string) predicate.MonitorRuntime {
+	return predicate.MonitorRuntime(sql.FieldIn(FieldClaimedBy, vs...))
+}
+
+// ClaimedByNotIn applies the NotIn predicate on the "claimed_by" field.
+func ClaimedByNotIn(vs ...string) predicate.MonitorRuntime {
+	return predicate.MonitorRuntime(sql.FieldNotIn(FieldClaimedBy, vs...))
+}
+
+// ClaimedByGT applies the GT predicate on the "claimed_by" field.
+func ClaimedByGT(v string) predicate.MonitorRuntime {
+	return predicate.MonitorRuntime(sql.FieldGT(FieldClaimedBy, v))
+}
+
+// ClaimedByGTE applies the GTE predicate on the "claimed_by" field.
+func ClaimedByGTE(v string) predicate.MonitorRuntime {
+	return predicate.MonitorRuntime(sql.FieldGTE(FieldClaimedBy, v))
+}
+
+// ClaimedByLT applies the LT predicate on the "claimed_by" field.
+func ClaimedByLT(v string) predicate.MonitorRuntime {
+	return predicate.MonitorRuntime(sql.FieldLT(FieldClaimedBy, v))
+}
+
+// ClaimedByLTE applies the LTE predicate on the "claimed_by" field.
+func ClaimedByLTE(v string) predicate.MonitorRuntime {
+	return predicate.MonitorRuntime(sql.FieldLTE(FieldClaimedBy, v))
+}
+
+// ClaimedByContains applies the Contains predicate on the "claimed_by" field.
+func ClaimedByContains(v string) predicate.MonitorRuntime {
+	return predicate.MonitorRuntime(sql.FieldContains(FieldClaimedBy, v))
+}
+
+// ClaimedByHasPrefix applies the HasPrefix predicate on the "claimed_by" field.
+func ClaimedByHasPrefix(v string) predicate.MonitorRuntime {
+	return predicate.MonitorRuntime(sql.FieldHasPrefix(FieldClaimedBy, v))
+}
+
+// ClaimedByHasSuffix applies the HasSuffix predicate on the "claimed_by" field.
+func ClaimedByHasSuffix(v string) predicate.MonitorRuntime {
+	return predicate.MonitorRuntime(sql.FieldHasSuffix(FieldClaimedBy, v))
+}
+
+// ClaimedByIsNil applies the IsNil predicate on the "claimed_by" field.
+func ClaimedByIsNil() predicate.MonitorRuntime {
+	return predicate.MonitorRuntime(sql.FieldIsNull(FieldClaimedBy))
+}
+
+// ClaimedByNotNil applies the NotNil predicate on the "claimed_by" field.
+func ClaimedByNotNil() predicate.MonitorRuntime {
+	return predicate.MonitorRuntime(sql.FieldNotNull(FieldClaimedBy))
+}
+
+// ClaimedByEqualFold applies the EqualFold predicate on the "claimed_by" field.
+func ClaimedByEqualFold(v string) predicate.MonitorRuntime {
+	return predicate.MonitorRuntime(sql.FieldEqualFold(FieldClaimedBy, v))
+}
+
+// ClaimedByContainsFold applies the ContainsFold predicate on the "claimed_by" field.
+func ClaimedByContainsFold(v string) predicate.MonitorRuntime {
+	return predicate.MonitorRuntime(sql.FieldContainsFold(FieldClaimedBy, v))
+}
+
+// ClaimedUntilEQ applies the EQ predicate on the "claimed_until" field.
+func ClaimedUntilEQ(v time.Time) predicate.MonitorRuntime {
+	return predicate.MonitorRuntime(sql.FieldEQ(FieldClaimedUntil, v))
+}
+
+// ClaimedUntilNEQ applies the NEQ predicate on the "claimed_until" field.
+func ClaimedUntilNEQ(v time.Time) predicate.MonitorRuntime {
+	return predicate.MonitorRuntime(sql.FieldNEQ(FieldClaimedUntil, v))
+}
+
+// ClaimedUntilIn applies the In predicate on the "claimed_until" field.
+func ClaimedUntilIn(vs ...time.Time) predicate.MonitorRuntime {
+	return predicate.MonitorRuntime(sql.FieldIn(FieldClaimedUntil, vs...))
+}
+
+// ClaimedUntilNotIn applies the NotIn predicate on the "claimed_until" field.
+func ClaimedUntilNotIn(vs ...time.Time) predicate.MonitorRuntime {
+	return predicate.MonitorRuntime(sql.FieldNotIn(FieldClaimedUntil, vs...))
+}
+
+// ClaimedUntilGT applies the GT predicate on the "claimed_until" field.
+func ClaimedUntilGT(v time.Time) predicate.MonitorRuntime {
+	return predicate.MonitorRuntime(sql.FieldGT(FieldClaimedUntil, v))
+}
+
+// ClaimedUntilGTE applies the GTE predicate on the "claimed_until" field.
+func ClaimedUntilGTE(v time.Time) predicate.MonitorRuntime {
+	return predicate.MonitorRuntime(sql.FieldGTE(FieldClaimedUntil, v))
+}
+
+// ClaimedUntilLT applies the LT predicate on the "claimed_until" field.
+func ClaimedUntilLT(v time.Time) predicate.MonitorRuntime {
+	return predicate.MonitorRuntime(sql.FieldLT(FieldClaimedUntil, v))
+}
+
+// ClaimedUntilLTE applies the LTE predicate on the "claimed_until" field.
+func ClaimedUntilLTE(v time.Time) predicate.MonitorRuntime {
+	return predicate.MonitorRuntime(sql.FieldLTE(FieldClaimedUntil, v))
+}
+
+// ClaimedUntilIsNil applies the IsNil predicate on the "claimed_until" field.
+func ClaimedUntilIsNil() predicate.MonitorRuntime {
+	return predicate.MonitorRuntime(sql.FieldIsNull(FieldClaimedUntil))
+}
+
+// ClaimedUntilNotNil applies the NotNil predicate on the "claimed_until" field.
+func ClaimedUntilNotNil() predicate.MonitorRuntime {
+	return predicate.MonitorRuntime(sql.FieldNotNull(FieldClaimedUntil))
+}
+
 // UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
 func UpdatedAtEQ(v time.Time) predicate.MonitorRuntime {
 	return predicate.MonitorRuntime(sql.FieldEQ(FieldUpdatedAt, v))
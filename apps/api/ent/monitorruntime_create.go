@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"goanna/apps/api/ent/monitor"
 	"goanna/apps/api/ent/monitorruntime"
+	"goanna/apps/api/ent/schema"
 	"time"
 
 	"entgo.io/ent/dialect/sql/sqlgraph"
@@ -217,6 +218,172 @@ func (_c *MonitorRuntimeCreate) SetNillableNextRunAt(v *time.Time) *MonitorRunti
 	return _c
 }
 
+// SetFailingSince sets the "failing_since" field.
+func (_c *MonitorRuntimeCreate) SetFailingSince(v time.Time) *MonitorRuntimeCreate {
+	_c.mutation.SetFailingSince(v)
+	return _c
+}
+
+// SetNillableFailingSince sets the "failing_since" field if the given value is not nil.
+func (_c *MonitorRuntimeCreate) SetNillableFailingSince(v *time.Time) *MonitorRuntimeCreate {
+	if v != nil {
+		_c.SetFailingSince(*v)
+	}
+	return _c
+}
+
+// SetEscalatedAt sets the "escalated_at" field.
+func (_c *MonitorRuntimeCreate) SetEscalatedAt(v time.Time) *MonitorRuntimeCreate {
+	_c.mutation.SetEscalatedAt(v)
+	return _c
+}
+
+// SetNillableEscalatedAt sets the "escalated_at" field if the given value is not nil.
+func (_c *MonitorRuntimeCreate) SetNillableEscalatedAt(v *time.Time) *MonitorRuntimeCreate {
+	if v != nil {
+		_c.SetEscalatedAt(*v)
+	}
+	return _c
+}
+
+// SetAcknowledgedAt sets the "acknowledged_at" field.
+func (_c *MonitorRuntimeCreate) SetAcknowledgedAt(v time.Time) *MonitorRuntimeCreate {
+	_c.mutation.SetAcknowledgedAt(v)
+	return _c
+}
+
+// SetNillableAcknowledgedAt sets the "acknowledged_at" field if the given value is not nil.
+func (_c *MonitorRuntimeCreate) SetNillableAcknowledgedAt(v *time.Time) *MonitorRuntimeCreate {
+	if v != nil {
+		_c.SetAcknowledgedAt(*v)
+	}
+	return _c
+}
+
+// SetDailyChangeCounts sets the "daily_change_counts" field.
+func (_c *MonitorRuntimeCreate) SetDailyChangeCounts(v map[string]int) *MonitorRuntimeCreate {
+	_c.mutation.SetDailyChangeCounts(v)
+	return _c
+}
+
+// SetLastChangeAt sets the "last_change_at" field.
+func (_c *MonitorRuntimeCreate) SetLastChangeAt(v time.Time) *MonitorRuntimeCreate {
+	_c.mutation.SetLastChangeAt(v)
+	return _c
+}
+
+// SetNillableLastChangeAt sets the "last_change_at" field if the given value is not nil.
+func (_c *MonitorRuntimeCreate) SetNillableLastChangeAt(v *time.Time) *MonitorRuntimeCreate {
+	if v != nil {
+		_c.SetLastChangeAt(*v)
+	}
+	return _c
+}
+
+// SetErrorRepeatingSince sets the "error_repeating_since" field.
+func (_c *MonitorRuntimeCreate) SetErrorRepeatingSince(v time.Time) *MonitorRuntimeCreate {
+	_c.mutation.SetErrorRepeatingSince(v)
+	return _c
+}
+
+// SetNillableErrorRepeatingSince sets the "error_repeating_since" field if the given value is not nil.
+func (_c *MonitorRuntimeCreate) SetNillableErrorRepeatingSince(v *time.Time) *MonitorRuntimeCreate {
+	if v != nil {
+		_c.SetErrorRepeatingSince(*v)
+	}
+	return _c
+}
+
+// SetExpectChangeUntil sets the "expect_change_until" field.
+func (_c *MonitorRuntimeCreate) SetExpectChangeUntil(v time.Time) *MonitorRuntimeCreate {
+	_c.mutation.SetExpectChangeUntil(v)
+	return _c
+}
+
+// SetNillableExpectChangeUntil sets the "expect_change_until" field if the given value is not nil.
+func (_c *MonitorRuntimeCreate) SetNillableExpectChangeUntil(v *time.Time) *MonitorRuntimeCreate {
+	if v != nil {
+		_c.SetExpectChangeUntil(*v)
+	}
+	return _c
+}
+
+// SetWatchdogAlertedAt sets the "watchdog_alerted_at" field.
+func (_c *MonitorRuntimeCreate) SetWatchdogAlertedAt(v time.Time) *MonitorRuntimeCreate {
+	_c.mutation.SetWatchdogAlertedAt(v)
+	return _c
+}
+
+// SetNillableWatchdogAlertedAt sets the "watchdog_alerted_at" field if the given value is not nil.
+func (_c *MonitorRuntimeCreate) SetNillableWatchdogAlertedAt(v *time.Time) *MonitorRuntimeCreate {
+	if v != nil {
+		_c.SetWatchdogAlertedAt(*v)
+	}
+	return _c
+}
+
+// SetLastPingAt sets the "last_ping_at" field.
+func (_c *MonitorRuntimeCreate) SetLastPingAt(v time.Time) *MonitorRuntimeCreate {
+	_c.mutation.SetLastPingAt(v)
+	return _c
+}
+
+// SetNillableLastPingAt sets the "last_ping_at" field if the given value is not nil.
+func (_c *MonitorRuntimeCreate) SetNillableLastPingAt(v *time.Time) *MonitorRuntimeCreate {
+	if v != nil {
+		_c.SetLastPingAt(*v)
+	}
+	return _c
+}
+
+// SetCircuitOpenedAt sets the "circuit_opened_at" field.
+func (_c *MonitorRuntimeCreate) SetCircuitOpenedAt(v time.Time) *MonitorRuntimeCreate {
+	_c.mutation.SetCircuitOpenedAt(v)
+	return _c
+}
+
+// SetNillableCircuitOpenedAt sets the "circuit_opened_at" field if the given value is not nil.
+func (_c *MonitorRuntimeCreate) SetNillableCircuitOpenedAt(v *time.Time) *MonitorRuntimeCreate {
+	if v != nil {
+		_c.SetCircuitOpenedAt(*v)
+	}
+	return _c
+}
+
+// SetCookies sets the "cookies" field.
+func (_c *MonitorRuntimeCreate) SetCookies(v []schema.StoredCookie) *MonitorRuntimeCreate {
+	_c.mutation.SetCookies(v)
+	return _c
+}
+
+// SetClaimedBy sets the "claimed_by" field.
+func (_c *MonitorRuntimeCreate) SetClaimedBy(v string) *MonitorRuntimeCreate {
+	_c.mutation.SetClaimedBy(v)
+	return _c
+}
+
+// SetNillableClaimedBy sets the "claimed_by" field if the given value is not nil.
+func (_c *MonitorRuntimeCreate) SetNillableClaimedBy(v *string) *MonitorRuntimeCreate {
+	if v != nil {
+		_c.SetClaimedBy(*v)
+	}
+	return _c
+}
+
+// SetClaimedUntil sets the "claimed_until" field.
+func (_c *MonitorRuntimeCreate) SetClaimedUntil(v time.Time) *MonitorRuntimeCreate {
+	_c.mutation.SetClaimedUntil(v)
+	return _c
+}
+
+// SetNillableClaimedUntil sets the "claimed_until" field if the given value is not nil.
+func (_c *MonitorRuntimeCreate) SetNillableClaimedUntil(v *time.Time) *MonitorRuntimeCreate {
+	if v != nil {
+		_c.SetClaimedUntil(*v)
+	}
+	return _c
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (_c *MonitorRuntimeCreate) SetUpdatedAt(v time.Time) *MonitorRuntimeCreate {
 	_c.mutation.SetUpdatedAt(v)
@@ -427,6 +594,58 @@ func (_c *MonitorRuntimeCreate) createSpec() (*MonitorRuntime, *sqlgraph.CreateS
 		_spec.SetField(monitorruntime.FieldNextRunAt, field.TypeTime, value)
 		_node.NextRunAt = &value
 	}
+	if value, ok := _c.mutation.FailingSince(); ok {
+		_spec.SetField(monitorruntime.FieldFailingSince, field.TypeTime, value)
+		_node.FailingSince = &value
+	}
+	if value, ok := _c.mutation.EscalatedAt(); ok {
+		_spec.SetField(monitorruntime.FieldEscalatedAt, field.TypeTime, value)
+		_node.EscalatedAt = &value
+	}
+	if value, ok := _c.mutation.AcknowledgedAt(); ok {
+		_spec.SetField(monitorruntime.FieldAcknowledgedAt, field.TypeTime, value)
+		_node.AcknowledgedAt = &value
+	}
+	if value, ok := _c.mutation.DailyChangeCounts(); ok {
+		_spec.SetField(monitorruntime.FieldDailyChangeCounts, field.TypeJSON, value)
+		_node.DailyChangeCounts = value
+	}
+	if value, ok := _c.mutation.LastChangeAt(); ok {
+		_spec.SetField(monitorruntime.FieldLastChangeAt, field.TypeTime, value)
+		_node.LastChangeAt = &value
+	}
+	if value, ok := _c.mutation.ErrorRepeatingSince(); ok {
+		_spec.SetField(monitorruntime.FieldErrorRepeatingSince, field.TypeTime, value)
+		_node.ErrorRepeatingSince = &value
+	}
+	if value, ok := _c.mutation.ExpectChangeUntil(); ok {
+		_spec.SetField(monitorruntime.FieldExpectChangeUntil, field.TypeTime, value)
+		_node.ExpectChangeUntil = &value
+	}
+	if value, ok := _c.mutation.WatchdogAlertedAt(); ok {
+		_spec.SetField(monitorruntime.FieldWatchdogAlertedAt, field.TypeTime, value)
+		_node.WatchdogAlertedAt = &value
+	}
+	if value, ok := _c.mutation.LastPingAt(); ok {
+		_spec.SetField(monitorruntime.FieldLastPingAt, field.TypeTime, value)
+		_node.LastPingAt = &value
+	}
+	if value, ok := _c.mutation.CircuitOpenedAt(); ok {
+		_spec.SetField(monitorruntime.FieldCircuitOpenedAt, field.TypeTime, value)
+		_node.CircuitOpenedAt = &value
+	}
+	if value, ok := _c.mutation.Cookies(); ok {
+		_spec.SetField(monitorruntime.FieldCookies, field.TypeJSON, value)
+		_node.Cookies = value
+	}
+	if value, ok := _c.mutation.ClaimedBy(); ok {
+		_spec.SetField(monitorruntime.FieldClaimedBy, field.TypeString, value)
+		_node.ClaimedBy = &value
+	}
+	if value, ok := _c.mutation.ClaimedUntil(); ok {
+		_spec.SetField(monitorruntime.FieldClaimedUntil, field.TypeTime, value)
+		_node.ClaimedUntil = &value
+	}
 	if value, ok := _c.mutation.UpdatedAt(); ok {
 		_spec.SetField(monitorruntime.FieldUpdatedAt, field.TypeTime, value)
 		_node.UpdatedAt = value
@@ -9,10 +9,12 @@ import (
 	"goanna/apps/api/ent/monitor"
 	"goanna/apps/api/ent/monitorruntime"
 	"goanna/apps/api/ent/predicate"
+	"goanna/apps/api/ent/schema"
 	"time"
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
 	"entgo.io/ent/schema/field"
 )
 
@@ -323,6 +325,256 @@ func (_u *MonitorRuntimeUpdate) ClearNextRunAt() *MonitorRuntimeUpdate {
 	return _u
 }
 
+// SetFailingSince sets the "failing_since" field.
+func (_u *MonitorRuntimeUpdate) SetFailingSince(v time.Time) *MonitorRuntimeUpdate {
+	_u.mutation.SetFailingSince(v)
+	return _u
+}
+
+// SetNillableFailingSince sets the "failing_since" field if the given value is not nil.
+func (_u *MonitorRuntimeUpdate) SetNillableFailingSince(v *time.Time) *MonitorRuntimeUpdate {
+	if v != nil {
+		_u.SetFailingSince(*v)
+	}
+	return _u
+}
+
+// ClearFailingSince clears the value of the "failing_since" field.
+func (_u *MonitorRuntimeUpdate) ClearFailingSince() *MonitorRuntimeUpdate {
+	_u.mutation.ClearFailingSince()
+	return _u
+}
+
+// SetEscalatedAt sets the "escalated_at" field.
+func (_u *MonitorRuntimeUpdate) SetEscalatedAt(v time.Time) *MonitorRuntimeUpdate {
+	_u.mutation.SetEscalatedAt(v)
+	return _u
+}
+
+// SetNillableEscalatedAt sets the "escalated_at" field if the given value is not nil.
+func (_u *MonitorRuntimeUpdate) SetNillableEscalatedAt(v *time.Time) *MonitorRuntimeUpdate {
+	if v != nil {
+		_u.SetEscalatedAt(*v)
+	}
+	return _u
+}
+
+// ClearEscalatedAt clears the value of the "escalated_at" field.
+func (_u *MonitorRuntimeUpdate) ClearEscalatedAt() *MonitorRuntimeUpdate {
+	_u.mutation.ClearEscalatedAt()
+	return _u
+}
+
+// SetAcknowledgedAt sets the "acknowledged_at" field.
+func (_u *MonitorRuntimeUpdate) SetAcknowledgedAt(v time.Time) *MonitorRuntimeUpdate {
+	_u.mutation.SetAcknowledgedAt(v)
+	return _u
+}
+
+// SetNillableAcknowledgedAt sets the "acknowledged_at" field if the given value is not nil.
+func (_u *MonitorRuntimeUpdate) SetNillableAcknowledgedAt(v *time.Time) *MonitorRuntimeUpdate {
+	if v != nil {
+		_u.SetAcknowledgedAt(*v)
+	}
+	return _u
+}
+
+// ClearAcknowledgedAt clears the value of the "acknowledged_at" field.
+func (_u *MonitorRuntimeUpdate) ClearAcknowledgedAt() *MonitorRuntimeUpdate {
+	_u.mutation.ClearAcknowledgedAt()
+	return _u
+}
+
+// SetDailyChangeCounts sets the "daily_change_counts" field.
+func (_u *MonitorRuntimeUpdate) SetDailyChangeCounts(v map[string]int) *MonitorRuntimeUpdate {
+	_u.mutation.SetDailyChangeCounts(v)
+	return _u
+}
+
+// ClearDailyChangeCounts clears the value of the "daily_change_counts" field.
+func (_u *MonitorRuntimeUpdate) ClearDailyChangeCounts() *MonitorRuntimeUpdate {
+	_u.mutation.ClearDailyChangeCounts()
+	return _u
+}
+
+// SetLastChangeAt sets the "last_change_at" field.
+func (_u *MonitorRuntimeUpdate) SetLastChangeAt(v time.Time) *MonitorRuntimeUpdate {
+	_u.mutation.SetLastChangeAt(v)
+	return _u
+}
+
+// SetNillableLastChangeAt sets the "last_change_at" field if the given value is not nil.
+func (_u *MonitorRuntimeUpdate) SetNillableLastChangeAt(v *time.Time) *MonitorRuntimeUpdate {
+	if v != nil {
+		_u.SetLastChangeAt(*v)
+	}
+	return _u
+}
+
+// ClearLastChangeAt clears the value of the "last_change_at" field.
+func (_u *MonitorRuntimeUpdate) ClearLastChangeAt() *MonitorRuntimeUpdate {
+	_u.mutation.ClearLastChangeAt()
+	return _u
+}
+
+// SetErrorRepeatingSince sets the "error_repeating_since" field.
+func (_u *MonitorRuntimeUpdate) SetErrorRepeatingSince(v time.Time) *MonitorRuntimeUpdate {
+	_u.mutation.SetErrorRepeatingSince(v)
+	return _u
+}
+
+// SetNillableErrorRepeatingSince sets the "error_repeating_since" field if the given value is not nil.
+func (_u *MonitorRuntimeUpdate) SetNillableErrorRepeatingSince(v *time.Time) *MonitorRuntimeUpdate {
+	if v != nil {
+		_u.SetErrorRepeatingSince(*v)
+	}
+	return _u
+}
+
+// ClearErrorRepeatingSince clears the value of the "error_repeating_since" field.
+func (_u *MonitorRuntimeUpdate) ClearErrorRepeatingSince() *MonitorRuntimeUpdate {
+	_u.mutation.ClearErrorRepeatingSince()
+	return _u
+}
+
+// SetExpectChangeUntil sets the "expect_change_until" field.
+func (_u *MonitorRuntimeUpdate) SetExpectChangeUntil(v time.Time) *MonitorRuntimeUpdate {
+	_u.mutation.SetExpectChangeUntil(v)
+	return _u
+}
+
+// SetNillableExpectChangeUntil sets the "expect_change_until" field if the given value is not nil.
+func (_u *MonitorRuntimeUpdate) SetNillableExpectChangeUntil(v *time.Time) *MonitorRuntimeUpdate {
+	if v != nil {
+		_u.SetExpectChangeUntil(*v)
+	}
+	return _u
+}
+
+// ClearExpectChangeUntil clears the value of the "expect_change_until" field.
+func (_u *MonitorRuntimeUpdate) ClearExpectChangeUntil() *MonitorRuntimeUpdate {
+	_u.mutation.ClearExpectChangeUntil()
+	return _u
+}
+
+// SetWatchdogAlertedAt sets the "watchdog_alerted_at" field.
+func (_u *MonitorRuntimeUpdate) SetWatchdogAlertedAt(v time.Time) *MonitorRuntimeUpdate {
+	_u.mutation.SetWatchdogAlertedAt(v)
+	return _u
+}
+
+// SetNillableWatchdogAlertedAt sets the "watchdog_alerted_at" field if the given value is not nil.
+func (_u *MonitorRuntimeUpdate) SetNillableWatchdogAlertedAt(v *time.Time) *MonitorRuntimeUpdate {
+	if v != nil {
+		_u.SetWatchdogAlertedAt(*v)
+	}
+	return _u
+}
+
+// ClearWatchdogAlertedAt clears the value of the "watchdog_alerted_at" field.
+func (_u *MonitorRuntimeUpdate) ClearWatchdogAlertedAt() *MonitorRuntimeUpdate {
+	_u.mutation.ClearWatchdogAlertedAt()
+	return _u
+}
+
+// SetLastPingAt sets the "last_ping_at" field.
+func (_u *MonitorRuntimeUpdate) SetLastPingAt(v time.Time) *MonitorRuntimeUpdate {
+	_u.mutation.SetLastPingAt(v)
+	return _u
+}
+
+// SetNillableLastPingAt sets the "last_ping_at" field if the given value is not nil.
+func (_u *MonitorRuntimeUpdate) SetNillableLastPingAt(v *time.Time) *MonitorRuntimeUpdate {
+	if v != nil {
+		_u.SetLastPingAt(*v)
+	}
+	return _u
+}
+
+// ClearLastPingAt clears the value of the "last_ping_at" field.
+func (_u *MonitorRuntimeUpdate) ClearLastPingAt() *MonitorRuntimeUpdate {
+	_u.mutation.ClearLastPingAt()
+	return _u
+}
+
+// SetCircuitOpenedAt sets the "circuit_opened_at" field.
+func (_u *MonitorRuntimeUpdate) SetCircuitOpenedAt(v time.Time) *MonitorRuntimeUpdate {
+	_u.mutation.SetCircuitOpenedAt(v)
+	return _u
+}
+
+// SetNillableCircuitOpenedAt sets the "circuit_opened_at" field if the given value is not nil.
+func (_u *MonitorRuntimeUpdate) SetNillableCircuitOpenedAt(v *time.Time) *MonitorRuntimeUpdate {
+	if v != nil {
+		_u.SetCircuitOpenedAt(*v)
+	}
+	return _u
+}
+
+// ClearCircuitOpenedAt clears the value of the "circuit_opened_at" field.
+func (_u *MonitorRuntimeUpdate) ClearCircuitOpenedAt() *MonitorRuntimeUpdate {
+	_u.mutation.ClearCircuitOpenedAt()
+	return _u
+}
+
+// SetCookies sets the "cookies" field.
+func (_u *MonitorRuntimeUpdate) SetCookies(v []schema.StoredCookie) *MonitorRuntimeUpdate {
+	_u.mutation.SetCookies(v)
+	return _u
+}
+
+// AppendCookies appends value to the "cookies" field.
+func (_u *MonitorRuntimeUpdate) AppendCookies(v []schema.StoredCookie) *MonitorRuntimeUpdate {
+	_u.mutation.AppendCookies(v)
+	return _u
+}
+
+// ClearCookies clears the value of the "cookies" field.
+func (_u *MonitorRuntimeUpdate) ClearCookies() *MonitorRuntimeUpdate {
+	_u.mutation.ClearCookies()
+	return _u
+}
+
+// SetClaimedBy sets the "claimed_by" field.
+func (_u *MonitorRuntimeUpdate) SetClaimedBy(v string) *MonitorRuntimeUpdate {
+	_u.mutation.SetClaimedBy(v)
+	return _u
+}
+
+// SetNillableClaimedBy sets the "claimed_by" field if the given value is not nil.
+func (_u *MonitorRuntimeUpdate) SetNillableClaimedBy(v *string) *MonitorRuntimeUpdate {
+	if v != nil {
+		_u.SetClaimedBy(*v)
+	}
+	return _u
+}
+
+// ClearClaimedBy clears the value of the "claimed_by" field.
+func (_u *MonitorRuntimeUpdate) ClearClaimedBy() *MonitorRuntimeUpdate {
+	_u.mutation.ClearClaimedBy()
+	return _u
+}
+
+// SetClaimedUntil sets the "claimed_until" field.
+func (_u *MonitorRuntimeUpdate) SetClaimedUntil(v time.Time) *MonitorRuntimeUpdate {
+	_u.mutation.SetClaimedUntil(v)
+	return _u
+}
+
+// SetNillableClaimedUntil sets the "claimed_until" field if the given value is not nil.
+func (_u *MonitorRuntimeUpdate) SetNillableClaimedUntil(v *time.Time) *MonitorRuntimeUpdate {
+	if v != nil {
+		_u.SetClaimedUntil(*v)
+	}
+	return _u
+}
+
+// ClearClaimedUntil clears the value of the "claimed_until" field.
+func (_u *MonitorRuntimeUpdate) ClearClaimedUntil() *MonitorRuntimeUpdate {
+	_u.mutation.ClearClaimedUntil()
+	return _u
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (_u *MonitorRuntimeUpdate) SetUpdatedAt(v time.Time) *MonitorRuntimeUpdate {
 	_u.mutation.SetUpdatedAt(v)
@@ -499,6 +751,89 @@ func (_u *MonitorRuntimeUpdate) sqlSave(ctx context.Context) (_node int, err err
 	if _u.mutation.NextRunAtCleared() {
 		_spec.ClearField(monitorruntime.FieldNextRunAt, field.TypeTime)
 	}
+	if value, ok := _u.mutation.FailingSince(); ok {
+		_spec.SetField(monitorruntime.FieldFailingSince, field.TypeTime, value)
+	}
+	if _u.mutation.FailingSinceCleared() {
+		_spec.ClearField(monitorruntime.FieldFailingSince, field.TypeTime)
+	}
+	if value, ok := _u.mutation.EscalatedAt(); ok {
+		_spec.SetField(monitorruntime.FieldEscalatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.EscalatedAtCleared() {
+		_spec.ClearField(monitorruntime.FieldEscalatedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.AcknowledgedAt(); ok {
+		_spec.SetField(monitorruntime.FieldAcknowledgedAt, field.TypeTime, value)
+	}
+	if _u.mutation.AcknowledgedAtCleared() {
+		_spec.ClearField(monitorruntime.FieldAcknowledgedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.DailyChangeCounts(); ok {
+		_spec.SetField(monitorruntime.FieldDailyChangeCounts, field.TypeJSON, value)
+	}
+	if _u.mutation.DailyChangeCountsCleared() {
+		_spec.ClearField(monitorruntime.FieldDailyChangeCounts, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.LastChangeAt(); ok {
+		_spec.SetField(monitorruntime.FieldLastChangeAt, field.TypeTime, value)
+	}
+	if _u.mutation.LastChangeAtCleared() {
+		_spec.ClearField(monitorruntime.FieldLastChangeAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.ErrorRepeatingSince(); ok {
+		_spec.SetField(monitorruntime.FieldErrorRepeatingSince, field.TypeTime, value)
+	}
+	if _u.mutation.ErrorRepeatingSinceCleared() {
+		_spec.ClearField(monitorruntime.FieldErrorRepeatingSince, field.TypeTime)
+	}
+	if value, ok := _u.mutation.ExpectChangeUntil(); ok {
+		_spec.SetField(monitorruntime.FieldExpectChangeUntil, field.TypeTime, value)
+	}
+	if _u.mutation.ExpectChangeUntilCleared() {
+		_spec.ClearField(monitorruntime.FieldExpectChangeUntil, field.TypeTime)
+	}
+	if value, ok := _u.mutation.WatchdogAlertedAt(); ok {
+		_spec.SetField(monitorruntime.FieldWatchdogAlertedAt, field.TypeTime, value)
+	}
+	if _u.mutation.WatchdogAlertedAtCleared() {
+		_spec.ClearField(monitorruntime.FieldWatchdogAlertedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.LastPingAt(); ok {
+		_spec.SetField(monitorruntime.FieldLastPingAt, field.TypeTime, value)
+	}
+	if _u.mutation.LastPingAtCleared() {
+		_spec.ClearField(monitorruntime.FieldLastPingAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.CircuitOpenedAt(); ok {
+		_spec.SetField(monitorruntime.FieldCircuitOpenedAt, field.TypeTime, value)
+	}
+	if _u.mutation.CircuitOpenedAtCleared() {
+		_spec.ClearField(monitorruntime.FieldCircuitOpenedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.Cookies(); ok {
+		_spec.SetField(monitorruntime.FieldCookies, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedCookies(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, monitorruntime.FieldCookies, value)
+		})
+	}
+	if _u.mutation.CookiesCleared() {
+		_spec.ClearField(monitorruntime.FieldCookies, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.ClaimedBy(); ok {
+		_spec.SetField(monitorruntime.FieldClaimedBy, field.TypeString, value)
+	}
+	if _u.mutation.ClaimedByCleared() {
+		_spec.ClearField(monitorruntime.FieldClaimedBy, field.TypeString)
+	}
+	if value, ok := _u.mutation.ClaimedUntil(); ok {
+		_spec.SetField(monitorruntime.FieldClaimedUntil, field.TypeTime, value)
+	}
+	if _u.mutation.ClaimedUntilCleared() {
+		_spec.ClearField(monitorruntime.FieldClaimedUntil, field.TypeTime)
+	}
 	if value, ok := _u.mutation.UpdatedAt(); ok {
 		_spec.SetField(monitorruntime.FieldUpdatedAt, field.TypeTime, value)
 	}
@@ -845,6 +1180,256 @@ func (_u *MonitorRuntimeUpdateOne) ClearNextRunAt() *MonitorRuntimeUpdateOne {
 	return _u
 }
 
+// SetFailingSince sets the "failing_since" field.
+func (_u *MonitorRuntimeUpdateOne) SetFailingSince(v time.Time) *MonitorRuntimeUpdateOne {
+	_u.mutation.SetFailingSince(v)
+	return _u
+}
+
+// SetNillableFailingSince sets the "failing_since" field if the given value is not nil.
+func (_u *MonitorRuntimeUpdateOne) SetNillableFailingSince(v *time.Time) *MonitorRuntimeUpdateOne {
+	if v != nil {
+		_u.SetFailingSince(*v)
+	}
+	return _u
+}
+
+// ClearFailingSince clears the value of the "failing_since" field.
+func (_u *MonitorRuntimeUpdateOne) ClearFailingSince() *MonitorRuntimeUpdateOne {
+	_u.mutation.ClearFailingSince()
+	return _u
+}
+
+// SetEscalatedAt sets the "escalated_at" field.
+func (_u *MonitorRuntimeUpdateOne) SetEscalatedAt(v time.Time) *MonitorRuntimeUpdateOne {
+	_u.mutation.SetEscalatedAt(v)
+	return _u
+}
+
+// SetNillableEscalatedAt sets the "escalated_at" field if the given value is not nil.
+func (_u *MonitorRuntimeUpdateOne) SetNillableEscalatedAt(v *time.Time) *MonitorRuntimeUpdateOne {
+	if v != nil {
+		_u.SetEscalatedAt(*v)
+	}
+	return _u
+}
+
+// ClearEscalatedAt clears the value of the "escalated_at" field.
+func (_u *MonitorRuntimeUpdateOne) ClearEscalatedAt() *MonitorRuntimeUpdateOne {
+	_u.mutation.ClearEscalatedAt()
+	return _u
+}
+
+// SetAcknowledgedAt sets the "acknowledged_at" field.
+func (_u *MonitorRuntimeUpdateOne) SetAcknowledgedAt(v time.Time) *MonitorRuntimeUpdateOne {
+	_u.mutation.SetAcknowledgedAt(v)
+	return _u
+}
+
+// SetNillableAcknowledgedAt sets the "acknowledged_at" field if the given value is not nil.
+func (_u *MonitorRuntimeUpdateOne) SetNillableAcknowledgedAt(v *time.Time) *MonitorRuntimeUpdateOne {
+	if v != nil {
+		_u.SetAcknowledgedAt(*v)
+	}
+	return _u
+}
+
+// ClearAcknowledgedAt clears the value of the "acknowledged_at" field.
+func (_u *MonitorRuntimeUpdateOne) ClearAcknowledgedAt() *MonitorRuntimeUpdateOne {
+	_u.mutation.ClearAcknowledgedAt()
+	return _u
+}
+
+// SetDailyChangeCounts sets the "daily_change_counts" field.
+func (_u *MonitorRuntimeUpdateOne) SetDailyChangeCounts(v map[string]int) *MonitorRuntimeUpdateOne {
+	_u.mutation.SetDailyChangeCounts(v)
+	return _u
+}
+
+// ClearDailyChangeCounts clears the value of the "daily_change_counts" field.
+func (_u *MonitorRuntimeUpdateOne) ClearDailyChangeCounts() *MonitorRuntimeUpdateOne {
+	_u.mutation.ClearDailyChangeCounts()
+	return _u
+}
+
+// SetLastChangeAt sets the "last_change_at" field.
+func (_u *MonitorRuntimeUpdateOne) SetLastChangeAt(v time.Time) *MonitorRuntimeUpdateOne {
+	_u.mutation.SetLastChangeAt(v)
+	return _u
+}
+
+// SetNillableLastChangeAt sets the "last_change_at" field if the given value is not nil.
+func (_u *MonitorRuntimeUpdateOne) SetNillableLastChangeAt(v *time.Time) *MonitorRuntimeUpdateOne {
+	if v != nil {
+		_u.SetLastChangeAt(*v)
+	}
+	return _u
+}
+
+// ClearLastChangeAt clears the value of the "last_change_at" field.
+func (_u *MonitorRuntimeUpdateOne) ClearLastChangeAt() *MonitorRuntimeUpdateOne {
+	_u.mutation.ClearLastChangeAt()
+	return _u
+}
+
+// SetErrorRepeatingSince sets the "error_repeating_since" field.
+func (_u *MonitorRuntimeUpdateOne) SetErrorRepeatingSince(v time.Time) *MonitorRuntimeUpdateOne {
+	_u.mutation.SetErrorRepeatingSince(v)
+	return _u
+}
+
+// SetNillableErrorRepeatingSince sets the "error_repeating_since" field if the given value is not nil.
+func (_u *MonitorRuntimeUpdateOne) SetNillableErrorRepeatingSince(v *time.Time) *MonitorRuntimeUpdateOne {
+	if v != nil {
+		_u.SetErrorRepeatingSince(*v)
+	}
+	return _u
+}
+
+// ClearErrorRepeatingSince clears the value of the "error_repeating_since" field.
+func (_u *MonitorRuntimeUpdateOne) ClearErrorRepeatingSince() *MonitorRuntimeUpdateOne {
+	_u.mutation.ClearErrorRepeatingSince()
+	return _u
+}
+
+// SetExpectChangeUntil sets the "expect_change_until" field.
+func (_u *MonitorRuntimeUpdateOne) SetExpectChangeUntil(v time.Time) *MonitorRuntimeUpdateOne {
+	_u.mutation.SetExpectChangeUntil(v)
+	return _u
+}
+
+// SetNillableExpectChangeUntil sets the "expect_change_until" field if the given value is not nil.
+func (_u *MonitorRuntimeUpdateOne) SetNillableExpectChangeUntil(v *time.Time) *MonitorRuntimeUpdateOne {
+	if v != nil {
+		_u.SetExpectChangeUntil(*v)
+	}
+	return _u
+}
+
+// ClearExpectChangeUntil clears the value of the "expect_change_until" field.
+func (_u *MonitorRuntimeUpdateOne) ClearExpectChangeUntil() *MonitorRuntimeUpdateOne {
+	_u.mutation.ClearExpectChangeUntil()
+	return _u
+}
+
+// SetWatchdogAlertedAt sets the "watchdog_alerted_at" field.
+func (_u *MonitorRuntimeUpdateOne) SetWatchdogAlertedAt(v time.Time) *MonitorRuntimeUpdateOne {
+	_u.mutation.SetWatchdogAlertedAt(v)
+	return _u
+}
+
+// SetNillableWatchdogAlertedAt sets the "watchdog_alerted_at" field if the given value is not nil.
+func (_u *MonitorRuntimeUpdateOne) SetNillableWatchdogAlertedAt(v *time.Time) *MonitorRuntimeUpdateOne {
+	if v != nil {
+		_u.SetWatchdogAlertedAt(*v)
+	}
+	return _u
+}
+
+// ClearWatchdogAlertedAt clears the value of the "watchdog_alerted_at" field.
+func (_u *MonitorRuntimeUpdateOne) ClearWatchdogAlertedAt() *MonitorRuntimeUpdateOne {
+	_u.mutation.ClearWatchdogAlertedAt()
+	return _u
+}
+
+// SetLastPingAt sets the "last_ping_at" field.
+func (_u *MonitorRuntimeUpdateOne) SetLastPingAt(v time.Time) *MonitorRuntimeUpdateOne {
+	_u.mutation.SetLastPingAt(v)
+	return _u
+}
+
+// SetNillableLastPingAt sets the "last_ping_at" field if the given value is not nil.
+func (_u *MonitorRuntimeUpdateOne) SetNillableLastPingAt(v *time.Time) *MonitorRuntimeUpdateOne {
+	if v != nil {
+		_u.SetLastPingAt(*v)
+	}
+	return _u
+}
+
+// ClearLastPingAt clears the value of the "last_ping_at" field.
+func (_u *MonitorRuntimeUpdateOne) ClearLastPingAt() *MonitorRuntimeUpdateOne {
+	_u.mutation.ClearLastPingAt()
+	return _u
+}
+
+// SetCircuitOpenedAt sets the "circuit_opened_at" field.
+func (_u *MonitorRuntimeUpdateOne) SetCircuitOpenedAt(v time.Time) *MonitorRuntimeUpdateOne {
+	_u.mutation.SetCircuitOpenedAt(v)
+	return _u
+}
+
+// SetNillableCircuitOpenedAt sets the "circuit_opened_at" field if the given value is not nil.
+func (_u *MonitorRuntimeUpdateOne) SetNillableCircuitOpenedAt(v *time.Time) *MonitorRuntimeUpdateOne {
+	if v != nil {
+		_u.SetCircuitOpenedAt(*v)
+	}
+	return _u
+}
+
+// ClearCircuitOpenedAt clears the value of the "circuit_opened_at" field.
+func (_u *MonitorRuntimeUpdateOne) ClearCircuitOpenedAt() *MonitorRuntimeUpdateOne {
+	_u.mutation.ClearCircuitOpenedAt()
+	return _u
+}
+
+// SetCookies sets the "cookies" field.
+func (_u *MonitorRuntimeUpdateOne) SetCookies(v []schema.StoredCookie) *MonitorRuntimeUpdateOne {
+	_u.mutation.SetCookies(v)
+	return _u
+}
+
+// AppendCookies appends value to the "cookies" field.
+func (_u *MonitorRuntimeUpdateOne) AppendCookies(v []schema.StoredCookie) *MonitorRuntimeUpdateOne {
+	_u.mutation.AppendCookies(v)
+	return _u
+}
+
+// ClearCookies clears the value of the "cookies" field.
+func (_u *MonitorRuntimeUpdateOne) ClearCookies() *MonitorRuntimeUpdateOne {
+	_u.mutation.ClearCookies()
+	return _u
+}
+
+// SetClaimedBy sets the "claimed_by" field.
+func (_u *MonitorRuntimeUpdateOne) SetClaimedBy(v string) *MonitorRuntimeUpdateOne {
+	_u.mutation.SetClaimedBy(v)
+	return _u
+}
+
+// SetNillableClaimedBy sets the "claimed_by" field if the given value is not nil.
+func (_u *MonitorRuntimeUpdateOne) SetNillableClaimedBy(v *string) *MonitorRuntimeUpdateOne {
+	if v != nil {
+		_u.SetClaimedBy(*v)
+	}
+	return _u
+}
+
+// ClearClaimedBy clears the value of the "claimed_by" field.
+func (_u *MonitorRuntimeUpdateOne) ClearClaimedBy() *MonitorRuntimeUpdateOne {
+	_u.mutation.ClearClaimedBy()
+	return _u
+}
+
+// SetClaimedUntil sets the "claimed_until" field.
+func (_u *MonitorRuntimeUpdateOne) SetClaimedUntil(v time.Time) *MonitorRuntimeUpdateOne {
+	_u.mutation.SetClaimedUntil(v)
+	return _u
+}
+
+// SetNillableClaimedUntil sets the "claimed_until" field if the given value is not nil.
+func (_u *MonitorRuntimeUpdateOne) SetNillableClaimedUntil(v *time.Time) *MonitorRuntimeUpdateOne {
+	if v != nil {
+		_u.SetClaimedUntil(*v)
+	}
+	return _u
+}
+
+// ClearClaimedUntil clears the value of the "claimed_until" field.
+func (_u *MonitorRuntimeUpdateOne) ClearClaimedUntil() *MonitorRuntimeUpdateOne {
+	_u.mutation.ClearClaimedUntil()
+	return _u
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (_u *MonitorRuntimeUpdateOne) SetUpdatedAt(v time.Time) *MonitorRuntimeUpdateOne {
 	_u.mutation.SetUpdatedAt(v)
@@ -1051,6 +1636,89 @@ func (_u *MonitorRuntimeUpdateOne) sqlSave(ctx context.Context) (_node *MonitorR
 	if _u.mutation.NextRunAtCleared() {
 		_spec.ClearField(monitorruntime.FieldNextRunAt, field.TypeTime)
 	}
+	if value, ok := _u.mutation.FailingSince(); ok {
+		_spec.SetField(monitorruntime.FieldFailingSince, field.TypeTime, value)
+	}
+	if _u.mutation.FailingSinceCleared() {
+		_spec.ClearField(monitorruntime.FieldFailingSince, field.TypeTime)
+	}
+	if value, ok := _u.mutation.EscalatedAt(); ok {
+		_spec.SetField(monitorruntime.FieldEscalatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.EscalatedAtCleared() {
+		_spec.ClearField(monitorruntime.FieldEscalatedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.AcknowledgedAt(); ok {
+		_spec.SetField(monitorruntime.FieldAcknowledgedAt, field.TypeTime, value)
+	}
+	if _u.mutation.AcknowledgedAtCleared() {
+		_spec.ClearField(monitorruntime.FieldAcknowledgedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.DailyChangeCounts(); ok {
+		_spec.SetField(monitorruntime.FieldDailyChangeCounts, field.TypeJSON, value)
+	}
+	if _u.mutation.DailyChangeCountsCleared() {
+		_spec.ClearField(monitorruntime.FieldDailyChangeCounts, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.LastChangeAt(); ok {
+		_spec.SetField(monitorruntime.FieldLastChangeAt, field.TypeTime, value)
+	}
+	if _u.mutation.LastChangeAtCleared() {
+		_spec.ClearField(monitorruntime.FieldLastChangeAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.ErrorRepeatingSince(); ok {
+		_spec.SetField(monitorruntime.FieldErrorRepeatingSince, field.TypeTime, value)
+	}
+	if _u.mutation.ErrorRepeatingSinceCleared() {
+		_spec.ClearField(monitorruntime.FieldErrorRepeatingSince, field.TypeTime)
+	}
+	if value, ok := _u.mutation.ExpectChangeUntil(); ok {
+		_spec.SetField(monitorruntime.FieldExpectChangeUntil, field.TypeTime, value)
+	}
+	if _u.mutation.ExpectChangeUntilCleared() {
+		_spec.ClearField(monitorruntime.FieldExpectChangeUntil, field.TypeTime)
+	}
+	if value, ok := _u.mutation.WatchdogAlertedAt(); ok {
+		_spec.SetField(monitorruntime.FieldWatchdogAlertedAt, field.TypeTime, value)
+	}
+	if _u.mutation.WatchdogAlertedAtCleared() {
+		_spec.ClearField(monitorruntime.FieldWatchdogAlertedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.LastPingAt(); ok {
+		_spec.SetField(monitorruntime.FieldLastPingAt, field.TypeTime, value)
+	}
+	if _u.mutation.LastPingAtCleared() {
+		_spec.ClearField(monitorruntime.FieldLastPingAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.CircuitOpenedAt(); ok {
+		_spec.SetField(monitorruntime.FieldCircuitOpenedAt, field.TypeTime, value)
+	}
+	if _u.mutation.CircuitOpenedAtCleared() {
+		_spec.ClearField(monitorruntime.FieldCircuitOpenedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.Cookies(); ok {
+		_spec.SetField(monitorruntime.FieldCookies, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedCookies(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, monitorruntime.FieldCookies, value)
+		})
+	}
+	if _u.mutation.CookiesCleared() {
+		_spec.ClearField(monitorruntime.FieldCookies, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.ClaimedBy(); ok {
+		_spec.SetField(monitorruntime.FieldClaimedBy, field.TypeString, value)
+	}
+	if _u.mutation.ClaimedByCleared() {
+		_spec.ClearField(monitorruntime.FieldClaimedBy, field.TypeString)
+	}
+	if value, ok := _u.mutation.ClaimedUntil(); ok {
+		_spec.SetField(monitorruntime.FieldClaimedUntil, field.TypeTime, value)
+	}
+	if _u.mutation.ClaimedUntilCleared() {
+		_spec.ClearField(monitorruntime.FieldClaimedUntil, field.TypeTime)
+	}
 	if value, ok := _u.mutation.UpdatedAt(); ok {
 		_spec.SetField(monitorruntime.FieldUpdatedAt, field.TypeTime, value)
 	}
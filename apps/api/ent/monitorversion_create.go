@@ -0,0 +1,287 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"goanna/apps/api/ent/monitor"
+	"goanna/apps/api/ent/monitorversion"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// MonitorVersionCreate is the builder for creating a MonitorVersion entity.
+type MonitorVersionCreate struct {
+	config
+	mutation *MonitorVersionMutation
+	hooks    []Hook
+}
+
+// SetMonitorID sets the "monitor_id" field.
+func (_c *MonitorVersionCreate) SetMonitorID(v int) *MonitorVersionCreate {
+	_c.mutation.SetMonitorID(v)
+	return _c
+}
+
+// SetVersion sets the "version" field.
+func (_c *MonitorVersionCreate) SetVersion(v int) *MonitorVersionCreate {
+	_c.mutation.SetVersion(v)
+	return _c
+}
+
+// SetConfig sets the "config" field.
+func (_c *MonitorVersionCreate) SetConfig(v json.RawMessage) *MonitorVersionCreate {
+	_c.mutation.SetConfig(v)
+	return _c
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (_c *MonitorVersionCreate) SetCreatedBy(v string) *MonitorVersionCreate {
+	_c.mutation.SetCreatedBy(v)
+	return _c
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (_c *MonitorVersionCreate) SetNillableCreatedBy(v *string) *MonitorVersionCreate {
+	if v != nil {
+		_c.SetCreatedBy(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *MonitorVersionCreate) SetCreatedAt(v time.Time) *MonitorVersionCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *MonitorVersionCreate) SetNillableCreatedAt(v *time.Time) *MonitorVersionCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetMonitor sets the "monitor" edge to the Monitor entity.
+func (_c *MonitorVersionCreate) SetMonitor(v *Monitor) *MonitorVersionCreate {
+	return _c.SetMonitorID(v.ID)
+}
+
+// Mutation returns the MonitorVersionMutation object of the builder.
+func (_c *MonitorVersionCreate) Mutation() *MonitorVersionMutation {
+	return _c.mutation
+}
+
+// Save creates the MonitorVersion in the database.
+func (_c *MonitorVersionCreate) Save(ctx context.Context) (*MonitorVersion, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *MonitorVersionCreate) SaveX(ctx context.Context) *MonitorVersion {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *MonitorVersionCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *MonitorVersionCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *MonitorVersionCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := monitorversion.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *MonitorVersionCreate) check() error {
+	if _, ok := _c.mutation.MonitorID(); !ok {
+		return &ValidationError{Name: "monitor_id", err: errors.New(`ent: missing required field "MonitorVersion.monitor_id"`)}
+	}
+	if _, ok := _c.mutation.Version(); !ok {
+		return &ValidationError{Name: "version", err: errors.New(`ent: missing required field "MonitorVersion.version"`)}
+	}
+	if v, ok := _c.mutation.Version(); ok {
+		if err := monitorversion.VersionValidator(v); err != nil {
+			return &ValidationError{Name: "version", err: fmt.Errorf(`ent: validator failed for field "MonitorVersion.version": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Config(); !ok {
+		return &ValidationError{Name: "config", err: errors.New(`ent: missing required field "MonitorVersion.config"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "MonitorVersion.created_at"`)}
+	}
+	if len(_c.mutation.MonitorIDs()) == 0 {
+		return &ValidationError{Name: "monitor", err: errors.New(`ent: missing required edge "MonitorVersion.monitor"`)}
+	}
+	return nil
+}
+
+func (_c *MonitorVersionCreate) sqlSave(ctx context.Context) (*MonitorVersion, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *MonitorVersionCreate) createSpec() (*MonitorVersion, *sqlgraph.CreateSpec) {
+	var (
+		_node = &MonitorVersion{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(monitorversion.Table, sqlgraph.NewFieldSpec(monitorversion.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.Version(); ok {
+		_spec.SetField(monitorversion.FieldVersion, field.TypeInt, value)
+		_node.Version = value
+	}
+	if value, ok := _c.mutation.Config(); ok {
+		_spec.SetField(monitorversion.FieldConfig, field.TypeJSON, value)
+		_node.Config = value
+	}
+	if value, ok := _c.mutation.CreatedBy(); ok {
+		_spec.SetField(monitorversion.FieldCreatedBy, field.TypeString, value)
+		_node.CreatedBy = &value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(monitorversion.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := _c.mutation.MonitorIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   monitorversion.MonitorTable,
+			Columns: []string{monitorversion.MonitorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(monitor.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.MonitorID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// MonitorVersionCreateBulk is the builder for creating many MonitorVersion entities in bulk.
+type MonitorVersionCreateBulk struct {
+	config
+	err      error
+	builders []*MonitorVersionCreate
+}
+
+// Save creates the MonitorVersion entities in the database.
+func (_c *MonitorVersionCreateBulk) Save(ctx context.Context) ([]*MonitorVersion, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*MonitorVersion, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*MonitorVersionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *MonitorVersionCreateBulk) SaveX(ctx context.Context) []*MonitorVersion {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *MonitorVersionCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *MonitorVersionCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,464 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"goanna/apps/api/ent/monitor"
+	"goanna/apps/api/ent/monitorversion"
+	"goanna/apps/api/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+)
+
+// MonitorVersionUpdate is the builder for updating MonitorVersion entities.
+type MonitorVersionUpdate struct {
+	config
+	hooks    []Hook
+	mutation *MonitorVersionMutation
+}
+
+// Where appends a list predicates to the MonitorVersionUpdate builder.
+func (_u *MonitorVersionUpdate) Where(ps ...predicate.MonitorVersion) *MonitorVersionUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetMonitorID sets the "monitor_id" field.
+func (_u *MonitorVersionUpdate) SetMonitorID(v int) *MonitorVersionUpdate {
+	_u.mutation.SetMonitorID(v)
+	return _u
+}
+
+// SetNillableMonitorID sets the "monitor_id" field if the given value is not nil.
+func (_u *MonitorVersionUpdate) SetNillableMonitorID(v *int) *MonitorVersionUpdate {
+	if v != nil {
+		_u.SetMonitorID(*v)
+	}
+	return _u
+}
+
+// SetVersion sets the "version" field.
+func (_u *MonitorVersionUpdate) SetVersion(v int) *MonitorVersionUpdate {
+	_u.mutation.ResetVersion()
+	_u.mutation.SetVersion(v)
+	return _u
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (_u *MonitorVersionUpdate) SetNillableVersion(v *int) *MonitorVersionUpdate {
+	if v != nil {
+		_u.SetVersion(*v)
+	}
+	return _u
+}
+
+// AddVersion adds value to the "version" field.
+func (_u *MonitorVersionUpdate) AddVersion(v int) *MonitorVersionUpdate {
+	_u.mutation.AddVersion(v)
+	return _u
+}
+
+// SetConfig sets the "config" field.
+func (_u *MonitorVersionUpdate) SetConfig(v json.RawMessage) *MonitorVersionUpdate {
+	_u.mutation.SetConfig(v)
+	return _u
+}
+
+// AppendConfig appends value to the "config" field.
+func (_u *MonitorVersionUpdate) AppendConfig(v json.RawMessage) *MonitorVersionUpdate {
+	_u.mutation.AppendConfig(v)
+	return _u
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (_u *MonitorVersionUpdate) SetCreatedBy(v string) *MonitorVersionUpdate {
+	_u.mutation.SetCreatedBy(v)
+	return _u
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (_u *MonitorVersionUpdate) SetNillableCreatedBy(v *string) *MonitorVersionUpdate {
+	if v != nil {
+		_u.SetCreatedBy(*v)
+	}
+	return _u
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (_u *MonitorVersionUpdate) ClearCreatedBy() *MonitorVersionUpdate {
+	_u.mutation.ClearCreatedBy()
+	return _u
+}
+
+// SetMonitor sets the "monitor" edge to the Monitor entity.
+func (_u *MonitorVersionUpdate) SetMonitor(v *Monitor) *MonitorVersionUpdate {
+	return _u.SetMonitorID(v.ID)
+}
+
+// Mutation returns the MonitorVersionMutation object of the builder.
+func (_u *MonitorVersionUpdate) Mutation() *MonitorVersionMutation {
+	return _u.mutation
+}
+
+// ClearMonitor clears the "monitor" edge to the Monitor entity.
+func (_u *MonitorVersionUpdate) ClearMonitor() *MonitorVersionUpdate {
+	_u.mutation.ClearMonitor()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *MonitorVersionUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *MonitorVersionUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *MonitorVersionUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *MonitorVersionUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *MonitorVersionUpdate) check() error {
+	if v, ok := _u.mutation.Version(); ok {
+		if err := monitorversion.VersionValidator(v); err != nil {
+			return &ValidationError{Name: "version", err: fmt.Errorf(`ent: validator failed for field "MonitorVersion.version": %w`, err)}
+		}
+	}
+	if _u.mutation.MonitorCleared() && len(_u.mutation.MonitorIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "MonitorVersion.monitor"`)
+	}
+	return nil
+}
+
+func (_u *MonitorVersionUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(monitorversion.Table, monitorversion.Columns, sqlgraph.NewFieldSpec(monitorversion.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Version(); ok {
+		_spec.SetField(monitorversion.FieldVersion, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedVersion(); ok {
+		_spec.AddField(monitorversion.FieldVersion, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Config(); ok {
+		_spec.SetField(monitorversion.FieldConfig, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedConfig(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, monitorversion.FieldConfig, value)
+		})
+	}
+	if value, ok := _u.mutation.CreatedBy(); ok {
+		_spec.SetField(monitorversion.FieldCreatedBy, field.TypeString, value)
+	}
+	if _u.mutation.CreatedByCleared() {
+		_spec.ClearField(monitorversion.FieldCreatedBy, field.TypeString)
+	}
+	if _u.mutation.MonitorCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   monitorversion.MonitorTable,
+			Columns: []string{monitorversion.MonitorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(monitor.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.MonitorIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   monitorversion.MonitorTable,
+			Columns: []string{monitorversion.MonitorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(monitor.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{monitorversion.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// MonitorVersionUpdateOne is the builder for updating a single MonitorVersion entity.
+type MonitorVersionUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *MonitorVersionMutation
+}
+
+// SetMonitorID sets the "monitor_id" field.
+func (_u *MonitorVersionUpdateOne) SetMonitorID(v int) *MonitorVersionUpdateOne {
+	_u.mutation.SetMonitorID(v)
+	return _u
+}
+
+// SetNillableMonitorID sets the "monitor_id" field if the given value is not nil.
+func (_u *MonitorVersionUpdateOne) SetNillableMonitorID(v *int) *MonitorVersionUpdateOne {
+	if v != nil {
+		_u.SetMonitorID(*v)
+	}
+	return _u
+}
+
+// SetVersion sets the "version" field.
+func (_u *MonitorVersionUpdateOne) SetVersion(v int) *MonitorVersionUpdateOne {
+	_u.mutation.ResetVersion()
+	_u.mutation.SetVersion(v)
+	return _u
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (_u *MonitorVersionUpdateOne) SetNillableVersion(v *int) *MonitorVersionUpdateOne {
+	if v != nil {
+		_u.SetVersion(*v)
+	}
+	return _u
+}
+
+// AddVersion adds value to the "version" field.
+func (_u *MonitorVersionUpdateOne) AddVersion(v int) *MonitorVersionUpdateOne {
+	_u.mutation.AddVersion(v)
+	return _u
+}
+
+// SetConfig sets the "config" field.
+func (_u *MonitorVersionUpdateOne) SetConfig(v json.RawMessage) *MonitorVersionUpdateOne {
+	_u.mutation.SetConfig(v)
+	return _u
+}
+
+// AppendConfig appends value to the "config" field.
+func (_u *MonitorVersionUpdateOne) AppendConfig(v json.RawMessage) *MonitorVersionUpdateOne {
+	_u.mutation.AppendConfig(v)
+	return _u
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (_u *MonitorVersionUpdateOne) SetCreatedBy(v string) *MonitorVersionUpdateOne {
+	_u.mutation.SetCreatedBy(v)
+	return _u
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (_u *MonitorVersionUpdateOne) SetNillableCreatedBy(v *string) *MonitorVersionUpdateOne {
+	if v != nil {
+		_u.SetCreatedBy(*v)
+	}
+	return _u
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (_u *MonitorVersionUpdateOne) ClearCreatedBy() *MonitorVersionUpdateOne {
+	_u.mutation.ClearCreatedBy()
+	return _u
+}
+
+// SetMonitor sets the "monitor" edge to the Monitor entity.
+func (_u *MonitorVersionUpdateOne) SetMonitor(v *Monitor) *MonitorVersionUpdateOne {
+	return _u.SetMonitorID(v.ID)
+}
+
+// Mutation returns the MonitorVersionMutation object of the builder.
+func (_u *MonitorVersionUpdateOne) Mutation() *MonitorVersionMutation {
+	return _u.mutation
+}
+
+// ClearMonitor clears the "monitor" edge to the Monitor entity.
+func (_u *MonitorVersionUpdateOne) ClearMonitor() *MonitorVersionUpdateOne {
+	_u.mutation.ClearMonitor()
+	return _u
+}
+
+// Where appends a list predicates to the MonitorVersionUpdate builder.
+func (_u *MonitorVersionUpdateOne) Where(ps ...predicate.MonitorVersion) *MonitorVersionUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *MonitorVersionUpdateOne) Select(field string, fields ...string) *MonitorVersionUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated MonitorVersion entity.
+func (_u *MonitorVersionUpdateOne) Save(ctx context.Context) (*MonitorVersion, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *MonitorVersionUpdateOne) SaveX(ctx context.Context) *MonitorVersion {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *MonitorVersionUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *MonitorVersionUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *MonitorVersionUpdateOne) check() error {
+	if v, ok := _u.mutation.Version(); ok {
+		if err := monitorversion.VersionValidator(v); err != nil {
+			return &ValidationError{Name: "version", err: fmt.Errorf(`ent: validator failed for field "MonitorVersion.version": %w`, err)}
+		}
+	}
+	if _u.mutation.MonitorCleared() && len(_u.mutation.MonitorIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "MonitorVersion.monitor"`)
+	}
+	return nil
+}
+
+func (_u *MonitorVersionUpdateOne) sqlSave(ctx context.Context) (_node *MonitorVersion, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(monitorversion.Table, monitorversion.Columns, sqlgraph.NewFieldSpec(monitorversion.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "MonitorVersion.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, monitorversion.FieldID)
+		for _, f := range fields {
+			if !monitorversion.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != monitorversion.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Version(); ok {
+		_spec.SetField(monitorversion.FieldVersion, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedVersion(); ok {
+		_spec.AddField(monitorversion.FieldVersion, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Config(); ok {
+		_spec.SetField(monitorversion.FieldConfig, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedConfig(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, monitorversion.FieldConfig, value)
+		})
+	}
+	if value, ok := _u.mutation.CreatedBy(); ok {
+		_spec.SetField(monitorversion.FieldCreatedBy, field.TypeString, value)
+	}
+	if _u.mutation.CreatedByCleared() {
+		_spec.ClearField(monitorversion.FieldCreatedBy, field.TypeString)
+	}
+	if _u.mutation.MonitorCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   monitorversion.MonitorTable,
+			Columns: []string{monitorversion.MonitorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(monitor.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.MonitorIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   monitorversion.MonitorTable,
+			Columns: []string{monitorversion.MonitorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(monitor.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &MonitorVersion{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{monitorversion.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -4,15 +4,23 @@ package ent
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"goanna/apps/api/ent/checkresult"
+	"goanna/apps/api/ent/checkrollup"
+	"goanna/apps/api/ent/headerprofile"
 	"goanna/apps/api/ent/monitor"
 	"goanna/apps/api/ent/monitorruntime"
+	"goanna/apps/api/ent/monitorversion"
 	"goanna/apps/api/ent/notificationchannel"
 	"goanna/apps/api/ent/notificationevent"
 	"goanna/apps/api/ent/predicate"
+	"goanna/apps/api/ent/schema"
+	"goanna/apps/api/ent/selectorpayload"
+	"goanna/apps/api/ent/session"
 	"goanna/apps/api/ent/systemconfig"
+	"goanna/apps/api/ent/user"
 	"sync"
 	"time"
 
@@ -30,38 +38,59 @@ const (
 
 	// Node types.
 	TypeCheckResult         = "CheckResult"
+	TypeCheckRollup         = "CheckRollup"
+	TypeHeaderProfile       = "HeaderProfile"
 	TypeMonitor             = "Monitor"
 	TypeMonitorRuntime      = "MonitorRuntime"
+	TypeMonitorVersion      = "MonitorVersion"
 	TypeNotificationChannel = "NotificationChannel"
 	TypeNotificationEvent   = "NotificationEvent"
+	TypeSelectorPayload     = "SelectorPayload"
+	TypeSession             = "Session"
 	TypeSystemConfig        = "SystemConfig"
+	TypeUser                = "User"
 )
 
 // CheckResultMutation represents an operation that mutates the CheckResult nodes in the graph.
 type CheckResultMutation struct {
 	config
-	op                  Op
-	typ                 string
-	id                  *int
-	status              *string
-	status_code         *int
-	addstatus_code      *int
-	response_time_ms    *int
-	addresponse_time_ms *int
-	error_message       *string
-	selection_type      *string
-	selection_value     *string
-	diff_changed        *bool
-	diff_kind           *string
-	diff_summary        *string
-	diff_details        *string
-	checked_at          *time.Time
-	clearedFields       map[string]struct{}
-	monitor             *int
-	clearedmonitor      bool
-	done                bool
-	oldValue            func(context.Context) (*CheckResult, error)
-	predicates          []predicate.CheckResult
+	op                      Op
+	typ                     string
+	id                      *int
+	status                  *string
+	status_code             *int
+	addstatus_code          *int
+	response_time_ms        *int
+	addresponse_time_ms     *int
+	error_message           *string
+	selection_type          *string
+	selection_value         *string
+	diff_changed            *bool
+	diff_kind               *string
+	diff_summary            *string
+	diff_details            *string
+	body_snapshot           *[]byte
+	body_snapshot_encoding  *string
+	body_size               *int
+	addbody_size            *int
+	body_snapshot_truncated *bool
+	body_hash               *string
+	tracked_header_value    *string
+	redirect_chain          *[]string
+	appendredirect_chain    []string
+	body_read_ms            *float64
+	addbody_read_ms         *float64
+	selector_ms             *float64
+	addselector_ms          *float64
+	diff_ms                 *float64
+	adddiff_ms              *float64
+	checked_at              *time.Time
+	clearedFields           map[string]struct{}
+	monitor                 *int
+	clearedmonitor          bool
+	done                    bool
+	oldValue                func(context.Context) (*CheckResult, error)
+	predicates              []predicate.CheckResult
 }
 
 var _ ent.Mutation = (*CheckResultMutation)(nil)
@@ -668,1227 +697,11181 @@ func (m *CheckResultMutation) ResetDiffDetails() {
 	delete(m.clearedFields, checkresult.FieldDiffDetails)
 }
 
-// SetCheckedAt sets the "checked_at" field.
-func (m *CheckResultMutation) SetCheckedAt(t time.Time) {
-	m.checked_at = &t
+// SetBodySnapshot sets the "body_snapshot" field.
+func (m *CheckResultMutation) SetBodySnapshot(b []byte) {
+	m.body_snapshot = &b
 }
 
-// CheckedAt returns the value of the "checked_at" field in the mutation.
-func (m *CheckResultMutation) CheckedAt() (r time.Time, exists bool) {
-	v := m.checked_at
+// BodySnapshot returns the value of the "body_snapshot" field in the mutation.
+func (m *CheckResultMutation) BodySnapshot() (r []byte, exists bool) {
+	v := m.body_snapshot
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCheckedAt returns the old "checked_at" field's value of the CheckResult entity.
+// OldBodySnapshot returns the old "body_snapshot" field's value of the CheckResult entity.
 // If the CheckResult object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CheckResultMutation) OldCheckedAt(ctx context.Context) (v time.Time, err error) {
+func (m *CheckResultMutation) OldBodySnapshot(ctx context.Context) (v *[]byte, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCheckedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldBodySnapshot is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCheckedAt requires an ID field in the mutation")
+		return v, errors.New("OldBodySnapshot requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCheckedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldBodySnapshot: %w", err)
 	}
-	return oldValue.CheckedAt, nil
+	return oldValue.BodySnapshot, nil
 }
 
-// ResetCheckedAt resets all changes to the "checked_at" field.
-func (m *CheckResultMutation) ResetCheckedAt() {
-	m.checked_at = nil
+// ClearBodySnapshot clears the value of the "body_snapshot" field.
+func (m *CheckResultMutation) ClearBodySnapshot() {
+	m.body_snapshot = nil
+	m.clearedFields[checkresult.FieldBodySnapshot] = struct{}{}
 }
 
-// SetMonitorID sets the "monitor" edge to the Monitor entity by id.
-func (m *CheckResultMutation) SetMonitorID(id int) {
-	m.monitor = &id
+// BodySnapshotCleared returns if the "body_snapshot" field was cleared in this mutation.
+func (m *CheckResultMutation) BodySnapshotCleared() bool {
+	_, ok := m.clearedFields[checkresult.FieldBodySnapshot]
+	return ok
 }
 
-// ClearMonitor clears the "monitor" edge to the Monitor entity.
-func (m *CheckResultMutation) ClearMonitor() {
-	m.clearedmonitor = true
+// ResetBodySnapshot resets all changes to the "body_snapshot" field.
+func (m *CheckResultMutation) ResetBodySnapshot() {
+	m.body_snapshot = nil
+	delete(m.clearedFields, checkresult.FieldBodySnapshot)
 }
 
-// MonitorCleared reports if the "monitor" edge to the Monitor entity was cleared.
-func (m *CheckResultMutation) MonitorCleared() bool {
-	return m.clearedmonitor
+// SetBodySnapshotEncoding sets the "body_snapshot_encoding" field.
+func (m *CheckResultMutation) SetBodySnapshotEncoding(s string) {
+	m.body_snapshot_encoding = &s
 }
 
-// MonitorID returns the "monitor" edge ID in the mutation.
-func (m *CheckResultMutation) MonitorID() (id int, exists bool) {
-	if m.monitor != nil {
-		return *m.monitor, true
+// BodySnapshotEncoding returns the value of the "body_snapshot_encoding" field in the mutation.
+func (m *CheckResultMutation) BodySnapshotEncoding() (r string, exists bool) {
+	v := m.body_snapshot_encoding
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// MonitorIDs returns the "monitor" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// MonitorID instead. It exists only for internal usage by the builders.
-func (m *CheckResultMutation) MonitorIDs() (ids []int) {
-	if id := m.monitor; id != nil {
-		ids = append(ids, *id)
+// OldBodySnapshotEncoding returns the old "body_snapshot_encoding" field's value of the CheckResult entity.
+// If the CheckResult object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CheckResultMutation) OldBodySnapshotEncoding(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBodySnapshotEncoding is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBodySnapshotEncoding requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBodySnapshotEncoding: %w", err)
+	}
+	return oldValue.BodySnapshotEncoding, nil
 }
 
-// ResetMonitor resets all changes to the "monitor" edge.
-func (m *CheckResultMutation) ResetMonitor() {
-	m.monitor = nil
-	m.clearedmonitor = false
+// ClearBodySnapshotEncoding clears the value of the "body_snapshot_encoding" field.
+func (m *CheckResultMutation) ClearBodySnapshotEncoding() {
+	m.body_snapshot_encoding = nil
+	m.clearedFields[checkresult.FieldBodySnapshotEncoding] = struct{}{}
 }
 
-// Where appends a list predicates to the CheckResultMutation builder.
-func (m *CheckResultMutation) Where(ps ...predicate.CheckResult) {
-	m.predicates = append(m.predicates, ps...)
+// BodySnapshotEncodingCleared returns if the "body_snapshot_encoding" field was cleared in this mutation.
+func (m *CheckResultMutation) BodySnapshotEncodingCleared() bool {
+	_, ok := m.clearedFields[checkresult.FieldBodySnapshotEncoding]
+	return ok
 }
 
-// WhereP appends storage-level predicates to the CheckResultMutation builder. Using this method,
-// users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *CheckResultMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.CheckResult, len(ps))
-	for i := range ps {
-		p[i] = ps[i]
-	}
-	m.Where(p...)
+// ResetBodySnapshotEncoding resets all changes to the "body_snapshot_encoding" field.
+func (m *CheckResultMutation) ResetBodySnapshotEncoding() {
+	m.body_snapshot_encoding = nil
+	delete(m.clearedFields, checkresult.FieldBodySnapshotEncoding)
 }
 
-// Op returns the operation name.
-func (m *CheckResultMutation) Op() Op {
-	return m.op
+// SetBodySize sets the "body_size" field.
+func (m *CheckResultMutation) SetBodySize(i int) {
+	m.body_size = &i
+	m.addbody_size = nil
 }
 
-// SetOp allows setting the mutation operation.
-func (m *CheckResultMutation) SetOp(op Op) {
-	m.op = op
+// BodySize returns the value of the "body_size" field in the mutation.
+func (m *CheckResultMutation) BodySize() (r int, exists bool) {
+	v := m.body_size
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// Type returns the node type of this mutation (CheckResult).
-func (m *CheckResultMutation) Type() string {
-	return m.typ
+// OldBodySize returns the old "body_size" field's value of the CheckResult entity.
+// If the CheckResult object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CheckResultMutation) OldBodySize(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBodySize is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBodySize requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBodySize: %w", err)
+	}
+	return oldValue.BodySize, nil
 }
 
-// Fields returns all fields that were changed during this mutation. Note that in
-// order to get all numeric fields that were incremented/decremented, call
-// AddedFields().
-func (m *CheckResultMutation) Fields() []string {
-	fields := make([]string, 0, 11)
-	if m.status != nil {
-		fields = append(fields, checkresult.FieldStatus)
-	}
-	if m.status_code != nil {
-		fields = append(fields, checkresult.FieldStatusCode)
+// AddBodySize adds i to the "body_size" field.
+func (m *CheckResultMutation) AddBodySize(i int) {
+	if m.addbody_size != nil {
+		*m.addbody_size += i
+	} else {
+		m.addbody_size = &i
 	}
-	if m.response_time_ms != nil {
-		fields = append(fields, checkresult.FieldResponseTimeMs)
+}
+
+// AddedBodySize returns the value that was added to the "body_size" field in this mutation.
+func (m *CheckResultMutation) AddedBodySize() (r int, exists bool) {
+	v := m.addbody_size
+	if v == nil {
+		return
 	}
-	if m.error_message != nil {
-		fields = append(fields, checkresult.FieldErrorMessage)
+	return *v, true
+}
+
+// ClearBodySize clears the value of the "body_size" field.
+func (m *CheckResultMutation) ClearBodySize() {
+	m.body_size = nil
+	m.addbody_size = nil
+	m.clearedFields[checkresult.FieldBodySize] = struct{}{}
+}
+
+// BodySizeCleared returns if the "body_size" field was cleared in this mutation.
+func (m *CheckResultMutation) BodySizeCleared() bool {
+	_, ok := m.clearedFields[checkresult.FieldBodySize]
+	return ok
+}
+
+// ResetBodySize resets all changes to the "body_size" field.
+func (m *CheckResultMutation) ResetBodySize() {
+	m.body_size = nil
+	m.addbody_size = nil
+	delete(m.clearedFields, checkresult.FieldBodySize)
+}
+
+// SetBodySnapshotTruncated sets the "body_snapshot_truncated" field.
+func (m *CheckResultMutation) SetBodySnapshotTruncated(b bool) {
+	m.body_snapshot_truncated = &b
+}
+
+// BodySnapshotTruncated returns the value of the "body_snapshot_truncated" field in the mutation.
+func (m *CheckResultMutation) BodySnapshotTruncated() (r bool, exists bool) {
+	v := m.body_snapshot_truncated
+	if v == nil {
+		return
 	}
-	if m.selection_type != nil {
-		fields = append(fields, checkresult.FieldSelectionType)
+	return *v, true
+}
+
+// OldBodySnapshotTruncated returns the old "body_snapshot_truncated" field's value of the CheckResult entity.
+// If the CheckResult object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CheckResultMutation) OldBodySnapshotTruncated(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBodySnapshotTruncated is only allowed on UpdateOne operations")
 	}
-	if m.selection_value != nil {
-		fields = append(fields, checkresult.FieldSelectionValue)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBodySnapshotTruncated requires an ID field in the mutation")
 	}
-	if m.diff_changed != nil {
-		fields = append(fields, checkresult.FieldDiffChanged)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBodySnapshotTruncated: %w", err)
 	}
-	if m.diff_kind != nil {
-		fields = append(fields, checkresult.FieldDiffKind)
+	return oldValue.BodySnapshotTruncated, nil
+}
+
+// ResetBodySnapshotTruncated resets all changes to the "body_snapshot_truncated" field.
+func (m *CheckResultMutation) ResetBodySnapshotTruncated() {
+	m.body_snapshot_truncated = nil
+}
+
+// SetBodyHash sets the "body_hash" field.
+func (m *CheckResultMutation) SetBodyHash(s string) {
+	m.body_hash = &s
+}
+
+// BodyHash returns the value of the "body_hash" field in the mutation.
+func (m *CheckResultMutation) BodyHash() (r string, exists bool) {
+	v := m.body_hash
+	if v == nil {
+		return
 	}
-	if m.diff_summary != nil {
-		fields = append(fields, checkresult.FieldDiffSummary)
+	return *v, true
+}
+
+// OldBodyHash returns the old "body_hash" field's value of the CheckResult entity.
+// If the CheckResult object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CheckResultMutation) OldBodyHash(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBodyHash is only allowed on UpdateOne operations")
 	}
-	if m.diff_details != nil {
-		fields = append(fields, checkresult.FieldDiffDetails)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBodyHash requires an ID field in the mutation")
 	}
-	if m.checked_at != nil {
-		fields = append(fields, checkresult.FieldCheckedAt)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBodyHash: %w", err)
 	}
-	return fields
+	return oldValue.BodyHash, nil
 }
 
-// Field returns the value of a field with the given name. The second boolean
-// return value indicates that this field was not set, or was not defined in the
-// schema.
-func (m *CheckResultMutation) Field(name string) (ent.Value, bool) {
-	switch name {
-	case checkresult.FieldStatus:
-		return m.Status()
-	case checkresult.FieldStatusCode:
-		return m.StatusCode()
-	case checkresult.FieldResponseTimeMs:
-		return m.ResponseTimeMs()
-	case checkresult.FieldErrorMessage:
-		return m.ErrorMessage()
-	case checkresult.FieldSelectionType:
-		return m.SelectionType()
-	case checkresult.FieldSelectionValue:
-		return m.SelectionValue()
-	case checkresult.FieldDiffChanged:
-		return m.DiffChanged()
-	case checkresult.FieldDiffKind:
-		return m.DiffKind()
-	case checkresult.FieldDiffSummary:
-		return m.DiffSummary()
-	case checkresult.FieldDiffDetails:
-		return m.DiffDetails()
-	case checkresult.FieldCheckedAt:
-		return m.CheckedAt()
+// ClearBodyHash clears the value of the "body_hash" field.
+func (m *CheckResultMutation) ClearBodyHash() {
+	m.body_hash = nil
+	m.clearedFields[checkresult.FieldBodyHash] = struct{}{}
+}
+
+// BodyHashCleared returns if the "body_hash" field was cleared in this mutation.
+func (m *CheckResultMutation) BodyHashCleared() bool {
+	_, ok := m.clearedFields[checkresult.FieldBodyHash]
+	return ok
+}
+
+// ResetBodyHash resets all changes to the "body_hash" field.
+func (m *CheckResultMutation) ResetBodyHash() {
+	m.body_hash = nil
+	delete(m.clearedFields, checkresult.FieldBodyHash)
+}
+
+// SetTrackedHeaderValue sets the "tracked_header_value" field.
+func (m *CheckResultMutation) SetTrackedHeaderValue(s string) {
+	m.tracked_header_value = &s
+}
+
+// TrackedHeaderValue returns the value of the "tracked_header_value" field in the mutation.
+func (m *CheckResultMutation) TrackedHeaderValue() (r string, exists bool) {
+	v := m.tracked_header_value
+	if v == nil {
+		return
 	}
-	return nil, false
+	return *v, true
 }
 
-// OldField returns the old value of the field from the database. An error is
-// returned if the mutation operation is not UpdateOne, or the query to the
-// database failed.
-func (m *CheckResultMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
-	switch name {
-	case checkresult.FieldStatus:
-		return m.OldStatus(ctx)
-	case checkresult.FieldStatusCode:
-		return m.OldStatusCode(ctx)
-	case checkresult.FieldResponseTimeMs:
-		return m.OldResponseTimeMs(ctx)
-	case checkresult.FieldErrorMessage:
-		return m.OldErrorMessage(ctx)
-	case checkresult.FieldSelectionType:
-		return m.OldSelectionType(ctx)
-	case checkresult.FieldSelectionValue:
-		return m.OldSelectionValue(ctx)
-	case checkresult.FieldDiffChanged:
-		return m.OldDiffChanged(ctx)
-	case checkresult.FieldDiffKind:
-		return m.OldDiffKind(ctx)
-	case checkresult.FieldDiffSummary:
-		return m.OldDiffSummary(ctx)
-	case checkresult.FieldDiffDetails:
-		return m.OldDiffDetails(ctx)
-	case checkresult.FieldCheckedAt:
-		return m.OldCheckedAt(ctx)
+// OldTrackedHeaderValue returns the old "tracked_header_value" field's value of the CheckResult entity.
+// If the CheckResult object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CheckResultMutation) OldTrackedHeaderValue(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTrackedHeaderValue is only allowed on UpdateOne operations")
 	}
-	return nil, fmt.Errorf("unknown CheckResult field %s", name)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTrackedHeaderValue requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTrackedHeaderValue: %w", err)
+	}
+	return oldValue.TrackedHeaderValue, nil
 }
 
-// SetField sets the value of a field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *CheckResultMutation) SetField(name string, value ent.Value) error {
-	switch name {
-	case checkresult.FieldStatus:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetStatus(v)
-		return nil
-	case checkresult.FieldStatusCode:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetStatusCode(v)
-		return nil
-	case checkresult.FieldResponseTimeMs:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetResponseTimeMs(v)
-		return nil
-	case checkresult.FieldErrorMessage:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetErrorMessage(v)
-		return nil
-	case checkresult.FieldSelectionType:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetSelectionType(v)
-		return nil
-	case checkresult.FieldSelectionValue:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetSelectionValue(v)
-		return nil
-	case checkresult.FieldDiffChanged:
-		v, ok := value.(bool)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetDiffChanged(v)
-		return nil
-	case checkresult.FieldDiffKind:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetDiffKind(v)
-		return nil
-	case checkresult.FieldDiffSummary:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetDiffSummary(v)
-		return nil
-	case checkresult.FieldDiffDetails:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetDiffDetails(v)
-		return nil
-	case checkresult.FieldCheckedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCheckedAt(v)
-		return nil
+// ClearTrackedHeaderValue clears the value of the "tracked_header_value" field.
+func (m *CheckResultMutation) ClearTrackedHeaderValue() {
+	m.tracked_header_value = nil
+	m.clearedFields[checkresult.FieldTrackedHeaderValue] = struct{}{}
+}
+
+// TrackedHeaderValueCleared returns if the "tracked_header_value" field was cleared in this mutation.
+func (m *CheckResultMutation) TrackedHeaderValueCleared() bool {
+	_, ok := m.clearedFields[checkresult.FieldTrackedHeaderValue]
+	return ok
+}
+
+// ResetTrackedHeaderValue resets all changes to the "tracked_header_value" field.
+func (m *CheckResultMutation) ResetTrackedHeaderValue() {
+	m.tracked_header_value = nil
+	delete(m.clearedFields, checkresult.FieldTrackedHeaderValue)
+}
+
+// SetRedirectChain sets the "redirect_chain" field.
+func (m *CheckResultMutation) SetRedirectChain(s []string) {
+	m.redirect_chain = &s
+	m.appendredirect_chain = nil
+}
+
+// RedirectChain returns the value of the "redirect_chain" field in the mutation.
+func (m *CheckResultMutation) RedirectChain() (r []string, exists bool) {
+	v := m.redirect_chain
+	if v == nil {
+		return
 	}
-	return fmt.Errorf("unknown CheckResult field %s", name)
+	return *v, true
 }
 
-// AddedFields returns all numeric fields that were incremented/decremented during
-// this mutation.
-func (m *CheckResultMutation) AddedFields() []string {
-	var fields []string
-	if m.addstatus_code != nil {
-		fields = append(fields, checkresult.FieldStatusCode)
+// OldRedirectChain returns the old "redirect_chain" field's value of the CheckResult entity.
+// If the CheckResult object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CheckResultMutation) OldRedirectChain(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRedirectChain is only allowed on UpdateOne operations")
 	}
-	if m.addresponse_time_ms != nil {
-		fields = append(fields, checkresult.FieldResponseTimeMs)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRedirectChain requires an ID field in the mutation")
 	}
-	return fields
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRedirectChain: %w", err)
+	}
+	return oldValue.RedirectChain, nil
 }
 
-// AddedField returns the numeric value that was incremented/decremented on a field
-// with the given name. The second boolean return value indicates that this field
-// was not set, or was not defined in the schema.
-func (m *CheckResultMutation) AddedField(name string) (ent.Value, bool) {
-	switch name {
-	case checkresult.FieldStatusCode:
-		return m.AddedStatusCode()
-	case checkresult.FieldResponseTimeMs:
-		return m.AddedResponseTimeMs()
+// AppendRedirectChain adds s to the "redirect_chain" field.
+func (m *CheckResultMutation) AppendRedirectChain(s []string) {
+	m.appendredirect_chain = append(m.appendredirect_chain, s...)
+}
+
+// AppendedRedirectChain returns the list of values that were appended to the "redirect_chain" field in this mutation.
+func (m *CheckResultMutation) AppendedRedirectChain() ([]string, bool) {
+	if len(m.appendredirect_chain) == 0 {
+		return nil, false
 	}
-	return nil, false
+	return m.appendredirect_chain, true
 }
 
-// AddField adds the value to the field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *CheckResultMutation) AddField(name string, value ent.Value) error {
-	switch name {
-	case checkresult.FieldStatusCode:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddStatusCode(v)
-		return nil
-	case checkresult.FieldResponseTimeMs:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddResponseTimeMs(v)
-		return nil
+// ClearRedirectChain clears the value of the "redirect_chain" field.
+func (m *CheckResultMutation) ClearRedirectChain() {
+	m.redirect_chain = nil
+	m.appendredirect_chain = nil
+	m.clearedFields[checkresult.FieldRedirectChain] = struct{}{}
+}
+
+// RedirectChainCleared returns if the "redirect_chain" field was cleared in this mutation.
+func (m *CheckResultMutation) RedirectChainCleared() bool {
+	_, ok := m.clearedFields[checkresult.FieldRedirectChain]
+	return ok
+}
+
+// ResetRedirectChain resets all changes to the "redirect_chain" field.
+func (m *CheckResultMutation) ResetRedirectChain() {
+	m.redirect_chain = nil
+	m.appendredirect_chain = nil
+	delete(m.clearedFields, checkresult.FieldRedirectChain)
+}
+
+// SetBodyReadMs sets the "body_read_ms" field.
+func (m *CheckResultMutation) SetBodyReadMs(f float64) {
+	m.body_read_ms = &f
+	m.addbody_read_ms = nil
+}
+
+// BodyReadMs returns the value of the "body_read_ms" field in the mutation.
+func (m *CheckResultMutation) BodyReadMs() (r float64, exists bool) {
+	v := m.body_read_ms
+	if v == nil {
+		return
 	}
-	return fmt.Errorf("unknown CheckResult numeric field %s", name)
+	return *v, true
 }
 
-// ClearedFields returns all nullable fields that were cleared during this
-// mutation.
-func (m *CheckResultMutation) ClearedFields() []string {
-	var fields []string
-	if m.FieldCleared(checkresult.FieldStatusCode) {
-		fields = append(fields, checkresult.FieldStatusCode)
+// OldBodyReadMs returns the old "body_read_ms" field's value of the CheckResult entity.
+// If the CheckResult object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CheckResultMutation) OldBodyReadMs(ctx context.Context) (v *float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBodyReadMs is only allowed on UpdateOne operations")
 	}
-	if m.FieldCleared(checkresult.FieldResponseTimeMs) {
-		fields = append(fields, checkresult.FieldResponseTimeMs)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBodyReadMs requires an ID field in the mutation")
 	}
-	if m.FieldCleared(checkresult.FieldErrorMessage) {
-		fields = append(fields, checkresult.FieldErrorMessage)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBodyReadMs: %w", err)
 	}
-	if m.FieldCleared(checkresult.FieldSelectionType) {
-		fields = append(fields, checkresult.FieldSelectionType)
+	return oldValue.BodyReadMs, nil
+}
+
+// AddBodyReadMs adds f to the "body_read_ms" field.
+func (m *CheckResultMutation) AddBodyReadMs(f float64) {
+	if m.addbody_read_ms != nil {
+		*m.addbody_read_ms += f
+	} else {
+		m.addbody_read_ms = &f
 	}
-	if m.FieldCleared(checkresult.FieldSelectionValue) {
-		fields = append(fields, checkresult.FieldSelectionValue)
+}
+
+// AddedBodyReadMs returns the value that was added to the "body_read_ms" field in this mutation.
+func (m *CheckResultMutation) AddedBodyReadMs() (r float64, exists bool) {
+	v := m.addbody_read_ms
+	if v == nil {
+		return
 	}
-	if m.FieldCleared(checkresult.FieldDiffKind) {
-		fields = append(fields, checkresult.FieldDiffKind)
+	return *v, true
+}
+
+// ClearBodyReadMs clears the value of the "body_read_ms" field.
+func (m *CheckResultMutation) ClearBodyReadMs() {
+	m.body_read_ms = nil
+	m.addbody_read_ms = nil
+	m.clearedFields[checkresult.FieldBodyReadMs] = struct{}{}
+}
+
+// BodyReadMsCleared returns if the "body_read_ms" field was cleared in this mutation.
+func (m *CheckResultMutation) BodyReadMsCleared() bool {
+	_, ok := m.clearedFields[checkresult.FieldBodyReadMs]
+	return ok
+}
+
+// ResetBodyReadMs resets all changes to the "body_read_ms" field.
+func (m *CheckResultMutation) ResetBodyReadMs() {
+	m.body_read_ms = nil
+	m.addbody_read_ms = nil
+	delete(m.clearedFields, checkresult.FieldBodyReadMs)
+}
+
+// SetSelectorMs sets the "selector_ms" field.
+func (m *CheckResultMutation) SetSelectorMs(f float64) {
+	m.selector_ms = &f
+	m.addselector_ms = nil
+}
+
+// SelectorMs returns the value of the "selector_ms" field in the mutation.
+func (m *CheckResultMutation) SelectorMs() (r float64, exists bool) {
+	v := m.selector_ms
+	if v == nil {
+		return
 	}
-	if m.FieldCleared(checkresult.FieldDiffSummary) {
-		fields = append(fields, checkresult.FieldDiffSummary)
+	return *v, true
+}
+
+// OldSelectorMs returns the old "selector_ms" field's value of the CheckResult entity.
+// If the CheckResult object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CheckResultMutation) OldSelectorMs(ctx context.Context) (v *float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSelectorMs is only allowed on UpdateOne operations")
 	}
-	if m.FieldCleared(checkresult.FieldDiffDetails) {
-		fields = append(fields, checkresult.FieldDiffDetails)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSelectorMs requires an ID field in the mutation")
 	}
-	return fields
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSelectorMs: %w", err)
+	}
+	return oldValue.SelectorMs, nil
 }
 
-// FieldCleared returns a boolean indicating if a field with the given name was
-// cleared in this mutation.
-func (m *CheckResultMutation) FieldCleared(name string) bool {
-	_, ok := m.clearedFields[name]
+// AddSelectorMs adds f to the "selector_ms" field.
+func (m *CheckResultMutation) AddSelectorMs(f float64) {
+	if m.addselector_ms != nil {
+		*m.addselector_ms += f
+	} else {
+		m.addselector_ms = &f
+	}
+}
+
+// AddedSelectorMs returns the value that was added to the "selector_ms" field in this mutation.
+func (m *CheckResultMutation) AddedSelectorMs() (r float64, exists bool) {
+	v := m.addselector_ms
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearSelectorMs clears the value of the "selector_ms" field.
+func (m *CheckResultMutation) ClearSelectorMs() {
+	m.selector_ms = nil
+	m.addselector_ms = nil
+	m.clearedFields[checkresult.FieldSelectorMs] = struct{}{}
+}
+
+// SelectorMsCleared returns if the "selector_ms" field was cleared in this mutation.
+func (m *CheckResultMutation) SelectorMsCleared() bool {
+	_, ok := m.clearedFields[checkresult.FieldSelectorMs]
 	return ok
 }
 
-// ClearField clears the value of the field with the given name. It returns an
-// error if the field is not defined in the schema.
-func (m *CheckResultMutation) ClearField(name string) error {
-	switch name {
-	case checkresult.FieldStatusCode:
-		m.ClearStatusCode()
-		return nil
-	case checkresult.FieldResponseTimeMs:
-		m.ClearResponseTimeMs()
-		return nil
-	case checkresult.FieldErrorMessage:
-		m.ClearErrorMessage()
-		return nil
-	case checkresult.FieldSelectionType:
-		m.ClearSelectionType()
-		return nil
-	case checkresult.FieldSelectionValue:
-		m.ClearSelectionValue()
-		return nil
-	case checkresult.FieldDiffKind:
-		m.ClearDiffKind()
-		return nil
-	case checkresult.FieldDiffSummary:
-		m.ClearDiffSummary()
-		return nil
-	case checkresult.FieldDiffDetails:
-		m.ClearDiffDetails()
-		return nil
+// ResetSelectorMs resets all changes to the "selector_ms" field.
+func (m *CheckResultMutation) ResetSelectorMs() {
+	m.selector_ms = nil
+	m.addselector_ms = nil
+	delete(m.clearedFields, checkresult.FieldSelectorMs)
+}
+
+// SetDiffMs sets the "diff_ms" field.
+func (m *CheckResultMutation) SetDiffMs(f float64) {
+	m.diff_ms = &f
+	m.adddiff_ms = nil
+}
+
+// DiffMs returns the value of the "diff_ms" field in the mutation.
+func (m *CheckResultMutation) DiffMs() (r float64, exists bool) {
+	v := m.diff_ms
+	if v == nil {
+		return
 	}
-	return fmt.Errorf("unknown CheckResult nullable field %s", name)
+	return *v, true
 }
 
-// ResetField resets all changes in the mutation for the field with the given name.
-// It returns an error if the field is not defined in the schema.
-func (m *CheckResultMutation) ResetField(name string) error {
-	switch name {
-	case checkresult.FieldStatus:
-		m.ResetStatus()
-		return nil
-	case checkresult.FieldStatusCode:
-		m.ResetStatusCode()
-		return nil
-	case checkresult.FieldResponseTimeMs:
-		m.ResetResponseTimeMs()
-		return nil
-	case checkresult.FieldErrorMessage:
-		m.ResetErrorMessage()
-		return nil
-	case checkresult.FieldSelectionType:
-		m.ResetSelectionType()
-		return nil
-	case checkresult.FieldSelectionValue:
-		m.ResetSelectionValue()
-		return nil
-	case checkresult.FieldDiffChanged:
-		m.ResetDiffChanged()
-		return nil
-	case checkresult.FieldDiffKind:
-		m.ResetDiffKind()
-		return nil
-	case checkresult.FieldDiffSummary:
-		m.ResetDiffSummary()
-		return nil
-	case checkresult.FieldDiffDetails:
-		m.ResetDiffDetails()
-		return nil
-	case checkresult.FieldCheckedAt:
-		m.ResetCheckedAt()
-		return nil
+// OldDiffMs returns the old "diff_ms" field's value of the CheckResult entity.
+// If the CheckResult object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CheckResultMutation) OldDiffMs(ctx context.Context) (v *float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDiffMs is only allowed on UpdateOne operations")
 	}
-	return fmt.Errorf("unknown CheckResult field %s", name)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDiffMs requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDiffMs: %w", err)
+	}
+	return oldValue.DiffMs, nil
 }
 
-// AddedEdges returns all edge names that were set/added in this mutation.
-func (m *CheckResultMutation) AddedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.monitor != nil {
-		edges = append(edges, checkresult.EdgeMonitor)
+// AddDiffMs adds f to the "diff_ms" field.
+func (m *CheckResultMutation) AddDiffMs(f float64) {
+	if m.adddiff_ms != nil {
+		*m.adddiff_ms += f
+	} else {
+		m.adddiff_ms = &f
 	}
-	return edges
 }
 
-// AddedIDs returns all IDs (to other nodes) that were added for the given edge
-// name in this mutation.
-func (m *CheckResultMutation) AddedIDs(name string) []ent.Value {
-	switch name {
-	case checkresult.EdgeMonitor:
-		if id := m.monitor; id != nil {
-			return []ent.Value{*id}
-		}
+// AddedDiffMs returns the value that was added to the "diff_ms" field in this mutation.
+func (m *CheckResultMutation) AddedDiffMs() (r float64, exists bool) {
+	v := m.adddiff_ms
+	if v == nil {
+		return
 	}
-	return nil
+	return *v, true
 }
 
-// RemovedEdges returns all edge names that were removed in this mutation.
-func (m *CheckResultMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 1)
-	return edges
+// ClearDiffMs clears the value of the "diff_ms" field.
+func (m *CheckResultMutation) ClearDiffMs() {
+	m.diff_ms = nil
+	m.adddiff_ms = nil
+	m.clearedFields[checkresult.FieldDiffMs] = struct{}{}
 }
 
-// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
-// the given name in this mutation.
-func (m *CheckResultMutation) RemovedIDs(name string) []ent.Value {
-	return nil
+// DiffMsCleared returns if the "diff_ms" field was cleared in this mutation.
+func (m *CheckResultMutation) DiffMsCleared() bool {
+	_, ok := m.clearedFields[checkresult.FieldDiffMs]
+	return ok
 }
 
-// ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *CheckResultMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.clearedmonitor {
-		edges = append(edges, checkresult.EdgeMonitor)
-	}
-	return edges
+// ResetDiffMs resets all changes to the "diff_ms" field.
+func (m *CheckResultMutation) ResetDiffMs() {
+	m.diff_ms = nil
+	m.adddiff_ms = nil
+	delete(m.clearedFields, checkresult.FieldDiffMs)
 }
 
-// EdgeCleared returns a boolean which indicates if the edge with the given name
-// was cleared in this mutation.
-func (m *CheckResultMutation) EdgeCleared(name string) bool {
-	switch name {
-	case checkresult.EdgeMonitor:
-		return m.clearedmonitor
-	}
-	return false
+// SetCheckedAt sets the "checked_at" field.
+func (m *CheckResultMutation) SetCheckedAt(t time.Time) {
+	m.checked_at = &t
 }
 
-// ClearEdge clears the value of the edge with the given name. It returns an error
-// if that edge is not defined in the schema.
-func (m *CheckResultMutation) ClearEdge(name string) error {
-	switch name {
-	case checkresult.EdgeMonitor:
-		m.ClearMonitor()
-		return nil
+// CheckedAt returns the value of the "checked_at" field in the mutation.
+func (m *CheckResultMutation) CheckedAt() (r time.Time, exists bool) {
+	v := m.checked_at
+	if v == nil {
+		return
 	}
-	return fmt.Errorf("unknown CheckResult unique edge %s", name)
+	return *v, true
 }
 
-// ResetEdge resets all changes to the edge with the given name in this mutation.
-// It returns an error if the edge is not defined in the schema.
-func (m *CheckResultMutation) ResetEdge(name string) error {
-	switch name {
-	case checkresult.EdgeMonitor:
-		m.ResetMonitor()
-		return nil
+// OldCheckedAt returns the old "checked_at" field's value of the CheckResult entity.
+// If the CheckResult object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CheckResultMutation) OldCheckedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCheckedAt is only allowed on UpdateOne operations")
 	}
-	return fmt.Errorf("unknown CheckResult edge %s", name)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCheckedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCheckedAt: %w", err)
+	}
+	return oldValue.CheckedAt, nil
 }
 
-// MonitorMutation represents an operation that mutates the Monitor nodes in the graph.
-type MonitorMutation struct {
-	config
-	op                          Op
-	typ                         string
-	id                          *int
-	label                       *string
-	method                      *string
-	url                         *string
-	icon_url                    *string
-	body                        *string
-	headers                     *map[string]string
-	auth                        *map[string]string
-	notification_channels       *[]string
-	appendnotification_channels []string
-	selector                    *string
-	expected_type               *monitor.ExpectedType
-	expected_response           *string
-	cron                        *string
-	enabled                     *bool
-	created_at                  *time.Time
-	updated_at                  *time.Time
-	clearedFields               map[string]struct{}
-	check_results               map[int]struct{}
-	removedcheck_results        map[int]struct{}
-	clearedcheck_results        bool
-	notification_events         map[int]struct{}
-	removednotification_events  map[int]struct{}
-	clearednotification_events  bool
-	runtime                     *int
-	clearedruntime              bool
-	done                        bool
-	oldValue                    func(context.Context) (*Monitor, error)
-	predicates                  []predicate.Monitor
+// ResetCheckedAt resets all changes to the "checked_at" field.
+func (m *CheckResultMutation) ResetCheckedAt() {
+	m.checked_at = nil
 }
 
-var _ ent.Mutation = (*MonitorMutation)(nil)
-
-// monitorOption allows management of the mutation configuration using functional options.
-type monitorOption func(*MonitorMutation)
+// SetMonitorID sets the "monitor_id" field.
+func (m *CheckResultMutation) SetMonitorID(i int) {
+	m.monitor = &i
+}
 
-// newMonitorMutation creates new mutation for the Monitor entity.
-func newMonitorMutation(c config, op Op, opts ...monitorOption) *MonitorMutation {
-	m := &MonitorMutation{
-		config:        c,
-		op:            op,
-		typ:           TypeMonitor,
-		clearedFields: make(map[string]struct{}),
-	}
-	for _, opt := range opts {
-		opt(m)
+// MonitorID returns the value of the "monitor_id" field in the mutation.
+func (m *CheckResultMutation) MonitorID() (r int, exists bool) {
+	v := m.monitor
+	if v == nil {
+		return
 	}
-	return m
+	return *v, true
 }
 
-// withMonitorID sets the ID field of the mutation.
-func withMonitorID(id int) monitorOption {
-	return func(m *MonitorMutation) {
-		var (
-			err   error
-			once  sync.Once
-			value *Monitor
-		)
-		m.oldValue = func(ctx context.Context) (*Monitor, error) {
-			once.Do(func() {
-				if m.done {
-					err = errors.New("querying old values post mutation is not allowed")
-				} else {
-					value, err = m.Client().Monitor.Get(ctx, id)
-				}
-			})
-			return value, err
-		}
-		m.id = &id
+// OldMonitorID returns the old "monitor_id" field's value of the CheckResult entity.
+// If the CheckResult object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CheckResultMutation) OldMonitorID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMonitorID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMonitorID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMonitorID: %w", err)
 	}
+	return oldValue.MonitorID, nil
 }
 
-// withMonitor sets the old Monitor of the mutation.
-func withMonitor(node *Monitor) monitorOption {
-	return func(m *MonitorMutation) {
-		m.oldValue = func(context.Context) (*Monitor, error) {
-			return node, nil
-		}
-		m.id = &node.ID
-	}
+// ResetMonitorID resets all changes to the "monitor_id" field.
+func (m *CheckResultMutation) ResetMonitorID() {
+	m.monitor = nil
 }
 
-// Client returns a new `ent.Client` from the mutation. If the mutation was
-// executed in a transaction (ent.Tx), a transactional client is returned.
-func (m MonitorMutation) Client() *Client {
-	client := &Client{config: m.config}
-	client.init()
-	return client
+// ClearMonitor clears the "monitor" edge to the Monitor entity.
+func (m *CheckResultMutation) ClearMonitor() {
+	m.clearedmonitor = true
+	m.clearedFields[checkresult.FieldMonitorID] = struct{}{}
 }
 
-// Tx returns an `ent.Tx` for mutations that were executed in transactions;
-// it returns an error otherwise.
-func (m MonitorMutation) Tx() (*Tx, error) {
-	if _, ok := m.driver.(*txDriver); !ok {
-		return nil, errors.New("ent: mutation is not running in a transaction")
-	}
-	tx := &Tx{config: m.config}
-	tx.init()
-	return tx, nil
+// MonitorCleared reports if the "monitor" edge to the Monitor entity was cleared.
+func (m *CheckResultMutation) MonitorCleared() bool {
+	return m.clearedmonitor
 }
 
-// ID returns the ID value in the mutation. Note that the ID is only available
-// if it was provided to the builder or after it was returned from the database.
-func (m *MonitorMutation) ID() (id int, exists bool) {
-	if m.id == nil {
-		return
+// MonitorIDs returns the "monitor" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// MonitorID instead. It exists only for internal usage by the builders.
+func (m *CheckResultMutation) MonitorIDs() (ids []int) {
+	if id := m.monitor; id != nil {
+		ids = append(ids, *id)
 	}
-	return *m.id, true
+	return
 }
 
-// IDs queries the database and returns the entity ids that match the mutation's predicate.
-// That means, if the mutation is applied within a transaction with an isolation level such
-// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
-// or updated by the mutation.
-func (m *MonitorMutation) IDs(ctx context.Context) ([]int, error) {
-	switch {
-	case m.op.Is(OpUpdateOne | OpDeleteOne):
-		id, exists := m.ID()
-		if exists {
-			return []int{id}, nil
-		}
-		fallthrough
-	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Monitor.Query().Where(m.predicates...).IDs(ctx)
-	default:
-		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
-	}
-}
-
-// SetLabel sets the "label" field.
-func (m *MonitorMutation) SetLabel(s string) {
-	m.label = &s
+// ResetMonitor resets all changes to the "monitor" edge.
+func (m *CheckResultMutation) ResetMonitor() {
+	m.monitor = nil
+	m.clearedmonitor = false
 }
 
-// Label returns the value of the "label" field in the mutation.
-func (m *MonitorMutation) Label() (r string, exists bool) {
-	v := m.label
-	if v == nil {
-		return
-	}
-	return *v, true
+// Where appends a list predicates to the CheckResultMutation builder.
+func (m *CheckResultMutation) Where(ps ...predicate.CheckResult) {
+	m.predicates = append(m.predicates, ps...)
 }
 
-// OldLabel returns the old "label" field's value of the Monitor entity.
-// If the Monitor object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MonitorMutation) OldLabel(ctx context.Context) (v *string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldLabel is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldLabel requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldLabel: %w", err)
+// WhereP appends storage-level predicates to the CheckResultMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *CheckResultMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.CheckResult, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
 	}
-	return oldValue.Label, nil
-}
-
-// ClearLabel clears the value of the "label" field.
-func (m *MonitorMutation) ClearLabel() {
-	m.label = nil
-	m.clearedFields[monitor.FieldLabel] = struct{}{}
+	m.Where(p...)
 }
 
-// LabelCleared returns if the "label" field was cleared in this mutation.
-func (m *MonitorMutation) LabelCleared() bool {
-	_, ok := m.clearedFields[monitor.FieldLabel]
-	return ok
+// Op returns the operation name.
+func (m *CheckResultMutation) Op() Op {
+	return m.op
 }
 
-// ResetLabel resets all changes to the "label" field.
-func (m *MonitorMutation) ResetLabel() {
-	m.label = nil
-	delete(m.clearedFields, monitor.FieldLabel)
+// SetOp allows setting the mutation operation.
+func (m *CheckResultMutation) SetOp(op Op) {
+	m.op = op
 }
 
-// SetMethod sets the "method" field.
-func (m *MonitorMutation) SetMethod(s string) {
-	m.method = &s
+// Type returns the node type of this mutation (CheckResult).
+func (m *CheckResultMutation) Type() string {
+	return m.typ
 }
 
-// Method returns the value of the "method" field in the mutation.
-func (m *MonitorMutation) Method() (r string, exists bool) {
-	v := m.method
-	if v == nil {
-		return
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *CheckResultMutation) Fields() []string {
+	fields := make([]string, 0, 22)
+	if m.status != nil {
+		fields = append(fields, checkresult.FieldStatus)
 	}
-	return *v, true
-}
-
-// OldMethod returns the old "method" field's value of the Monitor entity.
-// If the Monitor object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MonitorMutation) OldMethod(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldMethod is only allowed on UpdateOne operations")
+	if m.status_code != nil {
+		fields = append(fields, checkresult.FieldStatusCode)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldMethod requires an ID field in the mutation")
+	if m.response_time_ms != nil {
+		fields = append(fields, checkresult.FieldResponseTimeMs)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldMethod: %w", err)
+	if m.error_message != nil {
+		fields = append(fields, checkresult.FieldErrorMessage)
 	}
-	return oldValue.Method, nil
-}
-
-// ResetMethod resets all changes to the "method" field.
-func (m *MonitorMutation) ResetMethod() {
-	m.method = nil
-}
-
-// SetURL sets the "url" field.
-func (m *MonitorMutation) SetURL(s string) {
-	m.url = &s
-}
-
-// URL returns the value of the "url" field in the mutation.
-func (m *MonitorMutation) URL() (r string, exists bool) {
-	v := m.url
-	if v == nil {
-		return
+	if m.selection_type != nil {
+		fields = append(fields, checkresult.FieldSelectionType)
 	}
-	return *v, true
-}
-
-// OldURL returns the old "url" field's value of the Monitor entity.
-// If the Monitor object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MonitorMutation) OldURL(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldURL is only allowed on UpdateOne operations")
+	if m.selection_value != nil {
+		fields = append(fields, checkresult.FieldSelectionValue)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldURL requires an ID field in the mutation")
+	if m.diff_changed != nil {
+		fields = append(fields, checkresult.FieldDiffChanged)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldURL: %w", err)
+	if m.diff_kind != nil {
+		fields = append(fields, checkresult.FieldDiffKind)
 	}
-	return oldValue.URL, nil
-}
-
-// ResetURL resets all changes to the "url" field.
-func (m *MonitorMutation) ResetURL() {
-	m.url = nil
-}
-
-// SetIconURL sets the "icon_url" field.
-func (m *MonitorMutation) SetIconURL(s string) {
-	m.icon_url = &s
-}
-
-// IconURL returns the value of the "icon_url" field in the mutation.
-func (m *MonitorMutation) IconURL() (r string, exists bool) {
-	v := m.icon_url
-	if v == nil {
-		return
+	if m.diff_summary != nil {
+		fields = append(fields, checkresult.FieldDiffSummary)
 	}
-	return *v, true
-}
-
-// OldIconURL returns the old "icon_url" field's value of the Monitor entity.
-// If the Monitor object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MonitorMutation) OldIconURL(ctx context.Context) (v *string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldIconURL is only allowed on UpdateOne operations")
+	if m.diff_details != nil {
+		fields = append(fields, checkresult.FieldDiffDetails)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldIconURL requires an ID field in the mutation")
+	if m.body_snapshot != nil {
+		fields = append(fields, checkresult.FieldBodySnapshot)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldIconURL: %w", err)
+	if m.body_snapshot_encoding != nil {
+		fields = append(fields, checkresult.FieldBodySnapshotEncoding)
 	}
-	return oldValue.IconURL, nil
-}
-
-// ClearIconURL clears the value of the "icon_url" field.
-func (m *MonitorMutation) ClearIconURL() {
-	m.icon_url = nil
-	m.clearedFields[monitor.FieldIconURL] = struct{}{}
-}
-
-// IconURLCleared returns if the "icon_url" field was cleared in this mutation.
-func (m *MonitorMutation) IconURLCleared() bool {
-	_, ok := m.clearedFields[monitor.FieldIconURL]
-	return ok
-}
-
-// ResetIconURL resets all changes to the "icon_url" field.
-func (m *MonitorMutation) ResetIconURL() {
-	m.icon_url = nil
-	delete(m.clearedFields, monitor.FieldIconURL)
-}
-
-// SetBody sets the "body" field.
-func (m *MonitorMutation) SetBody(s string) {
-	m.body = &s
-}
-
-// Body returns the value of the "body" field in the mutation.
-func (m *MonitorMutation) Body() (r string, exists bool) {
-	v := m.body
-	if v == nil {
-		return
+	if m.body_size != nil {
+		fields = append(fields, checkresult.FieldBodySize)
 	}
-	return *v, true
-}
-
-// OldBody returns the old "body" field's value of the Monitor entity.
-// If the Monitor object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MonitorMutation) OldBody(ctx context.Context) (v *string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldBody is only allowed on UpdateOne operations")
+	if m.body_snapshot_truncated != nil {
+		fields = append(fields, checkresult.FieldBodySnapshotTruncated)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldBody requires an ID field in the mutation")
+	if m.body_hash != nil {
+		fields = append(fields, checkresult.FieldBodyHash)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldBody: %w", err)
+	if m.tracked_header_value != nil {
+		fields = append(fields, checkresult.FieldTrackedHeaderValue)
 	}
-	return oldValue.Body, nil
-}
-
-// ClearBody clears the value of the "body" field.
-func (m *MonitorMutation) ClearBody() {
-	m.body = nil
-	m.clearedFields[monitor.FieldBody] = struct{}{}
-}
-
-// BodyCleared returns if the "body" field was cleared in this mutation.
-func (m *MonitorMutation) BodyCleared() bool {
-	_, ok := m.clearedFields[monitor.FieldBody]
-	return ok
+	if m.redirect_chain != nil {
+		fields = append(fields, checkresult.FieldRedirectChain)
+	}
+	if m.body_read_ms != nil {
+		fields = append(fields, checkresult.FieldBodyReadMs)
+	}
+	if m.selector_ms != nil {
+		fields = append(fields, checkresult.FieldSelectorMs)
+	}
+	if m.diff_ms != nil {
+		fields = append(fields, checkresult.FieldDiffMs)
+	}
+	if m.checked_at != nil {
+		fields = append(fields, checkresult.FieldCheckedAt)
+	}
+	if m.monitor != nil {
+		fields = append(fields, checkresult.FieldMonitorID)
+	}
+	return fields
 }
 
-// ResetBody resets all changes to the "body" field.
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *CheckResultMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case checkresult.FieldStatus:
+		return m.Status()
+	case checkresult.FieldStatusCode:
+		return m.StatusCode()
+	case checkresult.FieldResponseTimeMs:
+		return m.ResponseTimeMs()
+	case checkresult.FieldErrorMessage:
+		return m.ErrorMessage()
+	case checkresult.FieldSelectionType:
+		return m.SelectionType()
+	case checkresult.FieldSelectionValue:
+		return m.SelectionValue()
+	case checkresult.FieldDiffChanged:
+		return m.DiffChanged()
+	case checkresult.FieldDiffKind:
+		return m.DiffKind()
+	case checkresult.FieldDiffSummary:
+		return m.DiffSummary()
+	case checkresult.FieldDiffDetails:
+		return m.DiffDetails()
+	case checkresult.FieldBodySnapshot:
+		return m.BodySnapshot()
+	case checkresult.FieldBodySnapshotEncoding:
+		return m.BodySnapshotEncoding()
+	case checkresult.FieldBodySize:
+		return m.BodySize()
+	case checkresult.FieldBodySnapshotTruncated:
+		return m.BodySnapshotTruncated()
+	case checkresult.FieldBodyHash:
+		return m.BodyHash()
+	case checkresult.FieldTrackedHeaderValue:
+		return m.TrackedHeaderValue()
+	case checkresult.FieldRedirectChain:
+		return m.RedirectChain()
+	case checkresult.FieldBodyReadMs:
+		return m.BodyReadMs()
+	case checkresult.FieldSelectorMs:
+		return m.SelectorMs()
+	case checkresult.FieldDiffMs:
+		return m.DiffMs()
+	case checkresult.FieldCheckedAt:
+		return m.CheckedAt()
+	case checkresult.FieldMonitorID:
+		return m.MonitorID()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *CheckResultMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case checkresult.FieldStatus:
+		return m.OldStatus(ctx)
+	case checkresult.FieldStatusCode:
+		return m.OldStatusCode(ctx)
+	case checkresult.FieldResponseTimeMs:
+		return m.OldResponseTimeMs(ctx)
+	case checkresult.FieldErrorMessage:
+		return m.OldErrorMessage(ctx)
+	case checkresult.FieldSelectionType:
+		return m.OldSelectionType(ctx)
+	case checkresult.FieldSelectionValue:
+		return m.OldSelectionValue(ctx)
+	case checkresult.FieldDiffChanged:
+		return m.OldDiffChanged(ctx)
+	case checkresult.FieldDiffKind:
+		return m.OldDiffKind(ctx)
+	case checkresult.FieldDiffSummary:
+		return m.OldDiffSummary(ctx)
+	case checkresult.FieldDiffDetails:
+		return m.OldDiffDetails(ctx)
+	case checkresult.FieldBodySnapshot:
+		return m.OldBodySnapshot(ctx)
+	case checkresult.FieldBodySnapshotEncoding:
+		return m.OldBodySnapshotEncoding(ctx)
+	case checkresult.FieldBodySize:
+		return m.OldBodySize(ctx)
+	case checkresult.FieldBodySnapshotTruncated:
+		return m.OldBodySnapshotTruncated(ctx)
+	case checkresult.FieldBodyHash:
+		return m.OldBodyHash(ctx)
+	case checkresult.FieldTrackedHeaderValue:
+		return m.OldTrackedHeaderValue(ctx)
+	case checkresult.FieldRedirectChain:
+		return m.OldRedirectChain(ctx)
+	case checkresult.FieldBodyReadMs:
+		return m.OldBodyReadMs(ctx)
+	case checkresult.FieldSelectorMs:
+		return m.OldSelectorMs(ctx)
+	case checkresult.FieldDiffMs:
+		return m.OldDiffMs(ctx)
+	case checkresult.FieldCheckedAt:
+		return m.OldCheckedAt(ctx)
+	case checkresult.FieldMonitorID:
+		return m.OldMonitorID(ctx)
+	}
+	return nil, fmt.Errorf("unknown CheckResult field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *CheckResultMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case checkresult.FieldStatus:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case checkresult.FieldStatusCode:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatusCode(v)
+		return nil
+	case checkresult.FieldResponseTimeMs:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetResponseTimeMs(v)
+		return nil
+	case checkresult.FieldErrorMessage:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetErrorMessage(v)
+		return nil
+	case checkresult.FieldSelectionType:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSelectionType(v)
+		return nil
+	case checkresult.FieldSelectionValue:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSelectionValue(v)
+		return nil
+	case checkresult.FieldDiffChanged:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDiffChanged(v)
+		return nil
+	case checkresult.FieldDiffKind:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDiffKind(v)
+		return nil
+	case checkresult.FieldDiffSummary:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDiffSummary(v)
+		return nil
+	case checkresult.FieldDiffDetails:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDiffDetails(v)
+		return nil
+	case checkresult.FieldBodySnapshot:
+		v, ok := value.([]byte)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBodySnapshot(v)
+		return nil
+	case checkresult.FieldBodySnapshotEncoding:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBodySnapshotEncoding(v)
+		return nil
+	case checkresult.FieldBodySize:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBodySize(v)
+		return nil
+	case checkresult.FieldBodySnapshotTruncated:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBodySnapshotTruncated(v)
+		return nil
+	case checkresult.FieldBodyHash:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBodyHash(v)
+		return nil
+	case checkresult.FieldTrackedHeaderValue:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTrackedHeaderValue(v)
+		return nil
+	case checkresult.FieldRedirectChain:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRedirectChain(v)
+		return nil
+	case checkresult.FieldBodyReadMs:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBodyReadMs(v)
+		return nil
+	case checkresult.FieldSelectorMs:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSelectorMs(v)
+		return nil
+	case checkresult.FieldDiffMs:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDiffMs(v)
+		return nil
+	case checkresult.FieldCheckedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCheckedAt(v)
+		return nil
+	case checkresult.FieldMonitorID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMonitorID(v)
+		return nil
+	}
+	return fmt.Errorf("unknown CheckResult field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *CheckResultMutation) AddedFields() []string {
+	var fields []string
+	if m.addstatus_code != nil {
+		fields = append(fields, checkresult.FieldStatusCode)
+	}
+	if m.addresponse_time_ms != nil {
+		fields = append(fields, checkresult.FieldResponseTimeMs)
+	}
+	if m.addbody_size != nil {
+		fields = append(fields, checkresult.FieldBodySize)
+	}
+	if m.addbody_read_ms != nil {
+		fields = append(fields, checkresult.FieldBodyReadMs)
+	}
+	if m.addselector_ms != nil {
+		fields = append(fields, checkresult.FieldSelectorMs)
+	}
+	if m.adddiff_ms != nil {
+		fields = append(fields, checkresult.FieldDiffMs)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *CheckResultMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case checkresult.FieldStatusCode:
+		return m.AddedStatusCode()
+	case checkresult.FieldResponseTimeMs:
+		return m.AddedResponseTimeMs()
+	case checkresult.FieldBodySize:
+		return m.AddedBodySize()
+	case checkresult.FieldBodyReadMs:
+		return m.AddedBodyReadMs()
+	case checkresult.FieldSelectorMs:
+		return m.AddedSelectorMs()
+	case checkresult.FieldDiffMs:
+		return m.AddedDiffMs()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *CheckResultMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case checkresult.FieldStatusCode:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddStatusCode(v)
+		return nil
+	case checkresult.FieldResponseTimeMs:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddResponseTimeMs(v)
+		return nil
+	case checkresult.FieldBodySize:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddBodySize(v)
+		return nil
+	case checkresult.FieldBodyReadMs:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddBodyReadMs(v)
+		return nil
+	case checkresult.FieldSelectorMs:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddSelectorMs(v)
+		return nil
+	case checkresult.FieldDiffMs:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddDiffMs(v)
+		return nil
+	}
+	return fmt.Errorf("unknown CheckResult numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *CheckResultMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(checkresult.FieldStatusCode) {
+		fields = append(fields, checkresult.FieldStatusCode)
+	}
+	if m.FieldCleared(checkresult.FieldResponseTimeMs) {
+		fields = append(fields, checkresult.FieldResponseTimeMs)
+	}
+	if m.FieldCleared(checkresult.FieldErrorMessage) {
+		fields = append(fields, checkresult.FieldErrorMessage)
+	}
+	if m.FieldCleared(checkresult.FieldSelectionType) {
+		fields = append(fields, checkresult.FieldSelectionType)
+	}
+	if m.FieldCleared(checkresult.FieldSelectionValue) {
+		fields = append(fields, checkresult.FieldSelectionValue)
+	}
+	if m.FieldCleared(checkresult.FieldDiffKind) {
+		fields = append(fields, checkresult.FieldDiffKind)
+	}
+	if m.FieldCleared(checkresult.FieldDiffSummary) {
+		fields = append(fields, checkresult.FieldDiffSummary)
+	}
+	if m.FieldCleared(checkresult.FieldDiffDetails) {
+		fields = append(fields, checkresult.FieldDiffDetails)
+	}
+	if m.FieldCleared(checkresult.FieldBodySnapshot) {
+		fields = append(fields, checkresult.FieldBodySnapshot)
+	}
+	if m.FieldCleared(checkresult.FieldBodySnapshotEncoding) {
+		fields = append(fields, checkresult.FieldBodySnapshotEncoding)
+	}
+	if m.FieldCleared(checkresult.FieldBodySize) {
+		fields = append(fields, checkresult.FieldBodySize)
+	}
+	if m.FieldCleared(checkresult.FieldBodyHash) {
+		fields = append(fields, checkresult.FieldBodyHash)
+	}
+	if m.FieldCleared(checkresult.FieldTrackedHeaderValue) {
+		fields = append(fields, checkresult.FieldTrackedHeaderValue)
+	}
+	if m.FieldCleared(checkresult.FieldRedirectChain) {
+		fields = append(fields, checkresult.FieldRedirectChain)
+	}
+	if m.FieldCleared(checkresult.FieldBodyReadMs) {
+		fields = append(fields, checkresult.FieldBodyReadMs)
+	}
+	if m.FieldCleared(checkresult.FieldSelectorMs) {
+		fields = append(fields, checkresult.FieldSelectorMs)
+	}
+	if m.FieldCleared(checkresult.FieldDiffMs) {
+		fields = append(fields, checkresult.FieldDiffMs)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *CheckResultMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *CheckResultMutation) ClearField(name string) error {
+	switch name {
+	case checkresult.FieldStatusCode:
+		m.ClearStatusCode()
+		return nil
+	case checkresult.FieldResponseTimeMs:
+		m.ClearResponseTimeMs()
+		return nil
+	case checkresult.FieldErrorMessage:
+		m.ClearErrorMessage()
+		return nil
+	case checkresult.FieldSelectionType:
+		m.ClearSelectionType()
+		return nil
+	case checkresult.FieldSelectionValue:
+		m.ClearSelectionValue()
+		return nil
+	case checkresult.FieldDiffKind:
+		m.ClearDiffKind()
+		return nil
+	case checkresult.FieldDiffSummary:
+		m.ClearDiffSummary()
+		return nil
+	case checkresult.FieldDiffDetails:
+		m.ClearDiffDetails()
+		return nil
+	case checkresult.FieldBodySnapshot:
+		m.ClearBodySnapshot()
+		return nil
+	case checkresult.FieldBodySnapshotEncoding:
+		m.ClearBodySnapshotEncoding()
+		return nil
+	case checkresult.FieldBodySize:
+		m.ClearBodySize()
+		return nil
+	case checkresult.FieldBodyHash:
+		m.ClearBodyHash()
+		return nil
+	case checkresult.FieldTrackedHeaderValue:
+		m.ClearTrackedHeaderValue()
+		return nil
+	case checkresult.FieldRedirectChain:
+		m.ClearRedirectChain()
+		return nil
+	case checkresult.FieldBodyReadMs:
+		m.ClearBodyReadMs()
+		return nil
+	case checkresult.FieldSelectorMs:
+		m.ClearSelectorMs()
+		return nil
+	case checkresult.FieldDiffMs:
+		m.ClearDiffMs()
+		return nil
+	}
+	return fmt.Errorf("unknown CheckResult nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *CheckResultMutation) ResetField(name string) error {
+	switch name {
+	case checkresult.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case checkresult.FieldStatusCode:
+		m.ResetStatusCode()
+		return nil
+	case checkresult.FieldResponseTimeMs:
+		m.ResetResponseTimeMs()
+		return nil
+	case checkresult.FieldErrorMessage:
+		m.ResetErrorMessage()
+		return nil
+	case checkresult.FieldSelectionType:
+		m.ResetSelectionType()
+		return nil
+	case checkresult.FieldSelectionValue:
+		m.ResetSelectionValue()
+		return nil
+	case checkresult.FieldDiffChanged:
+		m.ResetDiffChanged()
+		return nil
+	case checkresult.FieldDiffKind:
+		m.ResetDiffKind()
+		return nil
+	case checkresult.FieldDiffSummary:
+		m.ResetDiffSummary()
+		return nil
+	case checkresult.FieldDiffDetails:
+		m.ResetDiffDetails()
+		return nil
+	case checkresult.FieldBodySnapshot:
+		m.ResetBodySnapshot()
+		return nil
+	case checkresult.FieldBodySnapshotEncoding:
+		m.ResetBodySnapshotEncoding()
+		return nil
+	case checkresult.FieldBodySize:
+		m.ResetBodySize()
+		return nil
+	case checkresult.FieldBodySnapshotTruncated:
+		m.ResetBodySnapshotTruncated()
+		return nil
+	case checkresult.FieldBodyHash:
+		m.ResetBodyHash()
+		return nil
+	case checkresult.FieldTrackedHeaderValue:
+		m.ResetTrackedHeaderValue()
+		return nil
+	case checkresult.FieldRedirectChain:
+		m.ResetRedirectChain()
+		return nil
+	case checkresult.FieldBodyReadMs:
+		m.ResetBodyReadMs()
+		return nil
+	case checkresult.FieldSelectorMs:
+		m.ResetSelectorMs()
+		return nil
+	case checkresult.FieldDiffMs:
+		m.ResetDiffMs()
+		return nil
+	case checkresult.FieldCheckedAt:
+		m.ResetCheckedAt()
+		return nil
+	case checkresult.FieldMonitorID:
+		m.ResetMonitorID()
+		return nil
+	}
+	return fmt.Errorf("unknown CheckResult field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *CheckResultMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.monitor != nil {
+		edges = append(edges, checkresult.EdgeMonitor)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *CheckResultMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case checkresult.EdgeMonitor:
+		if id := m.monitor; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *CheckResultMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *CheckResultMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *CheckResultMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedmonitor {
+		edges = append(edges, checkresult.EdgeMonitor)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *CheckResultMutation) EdgeCleared(name string) bool {
+	switch name {
+	case checkresult.EdgeMonitor:
+		return m.clearedmonitor
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *CheckResultMutation) ClearEdge(name string) error {
+	switch name {
+	case checkresult.EdgeMonitor:
+		m.ClearMonitor()
+		return nil
+	}
+	return fmt.Errorf("unknown CheckResult unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *CheckResultMutation) ResetEdge(name string) error {
+	switch name {
+	case checkresult.EdgeMonitor:
+		m.ResetMonitor()
+		return nil
+	}
+	return fmt.Errorf("unknown CheckResult edge %s", name)
+}
+
+// CheckRollupMutation represents an operation that mutates the CheckRollup nodes in the graph.
+type CheckRollupMutation struct {
+	config
+	op                  Op
+	typ                 string
+	id                  *int
+	period              *checkrollup.Period
+	bucket_start        *time.Time
+	checks              *int
+	addchecks           *int
+	successes           *int
+	addsuccesses        *int
+	errors              *int
+	adderrors           *int
+	changes             *int
+	addchanges          *int
+	latency_samples     *int
+	addlatency_samples  *int
+	latency_total_ms    *int64
+	addlatency_total_ms *int64
+	latency_max_ms      *int
+	addlatency_max_ms   *int
+	latency_histogram   *map[string]int
+	updated_at          *time.Time
+	clearedFields       map[string]struct{}
+	monitor             *int
+	clearedmonitor      bool
+	done                bool
+	oldValue            func(context.Context) (*CheckRollup, error)
+	predicates          []predicate.CheckRollup
+}
+
+var _ ent.Mutation = (*CheckRollupMutation)(nil)
+
+// checkrollupOption allows management of the mutation configuration using functional options.
+type checkrollupOption func(*CheckRollupMutation)
+
+// newCheckRollupMutation creates new mutation for the CheckRollup entity.
+func newCheckRollupMutation(c config, op Op, opts ...checkrollupOption) *CheckRollupMutation {
+	m := &CheckRollupMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeCheckRollup,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withCheckRollupID sets the ID field of the mutation.
+func withCheckRollupID(id int) checkrollupOption {
+	return func(m *CheckRollupMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *CheckRollup
+		)
+		m.oldValue = func(ctx context.Context) (*CheckRollup, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().CheckRollup.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withCheckRollup sets the old CheckRollup of the mutation.
+func withCheckRollup(node *CheckRollup) checkrollupOption {
+	return func(m *CheckRollupMutation) {
+		m.oldValue = func(context.Context) (*CheckRollup, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m CheckRollupMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m CheckRollupMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *CheckRollupMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *CheckRollupMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().CheckRollup.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetMonitorID sets the "monitor_id" field.
+func (m *CheckRollupMutation) SetMonitorID(i int) {
+	m.monitor = &i
+}
+
+// MonitorID returns the value of the "monitor_id" field in the mutation.
+func (m *CheckRollupMutation) MonitorID() (r int, exists bool) {
+	v := m.monitor
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMonitorID returns the old "monitor_id" field's value of the CheckRollup entity.
+// If the CheckRollup object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CheckRollupMutation) OldMonitorID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMonitorID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMonitorID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMonitorID: %w", err)
+	}
+	return oldValue.MonitorID, nil
+}
+
+// ResetMonitorID resets all changes to the "monitor_id" field.
+func (m *CheckRollupMutation) ResetMonitorID() {
+	m.monitor = nil
+}
+
+// SetPeriod sets the "period" field.
+func (m *CheckRollupMutation) SetPeriod(c checkrollup.Period) {
+	m.period = &c
+}
+
+// Period returns the value of the "period" field in the mutation.
+func (m *CheckRollupMutation) Period() (r checkrollup.Period, exists bool) {
+	v := m.period
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPeriod returns the old "period" field's value of the CheckRollup entity.
+// If the CheckRollup object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CheckRollupMutation) OldPeriod(ctx context.Context) (v checkrollup.Period, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPeriod is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPeriod requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPeriod: %w", err)
+	}
+	return oldValue.Period, nil
+}
+
+// ResetPeriod resets all changes to the "period" field.
+func (m *CheckRollupMutation) ResetPeriod() {
+	m.period = nil
+}
+
+// SetBucketStart sets the "bucket_start" field.
+func (m *CheckRollupMutation) SetBucketStart(t time.Time) {
+	m.bucket_start = &t
+}
+
+// BucketStart returns the value of the "bucket_start" field in the mutation.
+func (m *CheckRollupMutation) BucketStart() (r time.Time, exists bool) {
+	v := m.bucket_start
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBucketStart returns the old "bucket_start" field's value of the CheckRollup entity.
+// If the CheckRollup object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CheckRollupMutation) OldBucketStart(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBucketStart is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBucketStart requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBucketStart: %w", err)
+	}
+	return oldValue.BucketStart, nil
+}
+
+// ResetBucketStart resets all changes to the "bucket_start" field.
+func (m *CheckRollupMutation) ResetBucketStart() {
+	m.bucket_start = nil
+}
+
+// SetChecks sets the "checks" field.
+func (m *CheckRollupMutation) SetChecks(i int) {
+	m.checks = &i
+	m.addchecks = nil
+}
+
+// Checks returns the value of the "checks" field in the mutation.
+func (m *CheckRollupMutation) Checks() (r int, exists bool) {
+	v := m.checks
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldChecks returns the old "checks" field's value of the CheckRollup entity.
+// If the CheckRollup object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CheckRollupMutation) OldChecks(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldChecks is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldChecks requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldChecks: %w", err)
+	}
+	return oldValue.Checks, nil
+}
+
+// AddChecks adds i to the "checks" field.
+func (m *CheckRollupMutation) AddChecks(i int) {
+	if m.addchecks != nil {
+		*m.addchecks += i
+	} else {
+		m.addchecks = &i
+	}
+}
+
+// AddedChecks returns the value that was added to the "checks" field in this mutation.
+func (m *CheckRollupMutation) AddedChecks() (r int, exists bool) {
+	v := m.addchecks
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetChecks resets all changes to the "checks" field.
+func (m *CheckRollupMutation) ResetChecks() {
+	m.checks = nil
+	m.addchecks = nil
+}
+
+// SetSuccesses sets the "successes" field.
+func (m *CheckRollupMutation) SetSuccesses(i int) {
+	m.successes = &i
+	m.addsuccesses = nil
+}
+
+// Successes returns the value of the "successes" field in the mutation.
+func (m *CheckRollupMutation) Successes() (r int, exists bool) {
+	v := m.successes
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSuccesses returns the old "successes" field's value of the CheckRollup entity.
+// If the CheckRollup object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CheckRollupMutation) OldSuccesses(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSuccesses is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSuccesses requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSuccesses: %w", err)
+	}
+	return oldValue.Successes, nil
+}
+
+// AddSuccesses adds i to the "successes" field.
+func (m *CheckRollupMutation) AddSuccesses(i int) {
+	if m.addsuccesses != nil {
+		*m.addsuccesses += i
+	} else {
+		m.addsuccesses = &i
+	}
+}
+
+// AddedSuccesses returns the value that was added to the "successes" field in this mutation.
+func (m *CheckRollupMutation) AddedSuccesses() (r int, exists bool) {
+	v := m.addsuccesses
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetSuccesses resets all changes to the "successes" field.
+func (m *CheckRollupMutation) ResetSuccesses() {
+	m.successes = nil
+	m.addsuccesses = nil
+}
+
+// SetErrors sets the "errors" field.
+func (m *CheckRollupMutation) SetErrors(i int) {
+	m.errors = &i
+	m.adderrors = nil
+}
+
+// Errors returns the value of the "errors" field in the mutation.
+func (m *CheckRollupMutation) Errors() (r int, exists bool) {
+	v := m.errors
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldErrors returns the old "errors" field's value of the CheckRollup entity.
+// If the CheckRollup object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CheckRollupMutation) OldErrors(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldErrors is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldErrors requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldErrors: %w", err)
+	}
+	return oldValue.Errors, nil
+}
+
+// AddErrors adds i to the "errors" field.
+func (m *CheckRollupMutation) AddErrors(i int) {
+	if m.adderrors != nil {
+		*m.adderrors += i
+	} else {
+		m.adderrors = &i
+	}
+}
+
+// AddedErrors returns the value that was added to the "errors" field in this mutation.
+func (m *CheckRollupMutation) AddedErrors() (r int, exists bool) {
+	v := m.adderrors
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetErrors resets all changes to the "errors" field.
+func (m *CheckRollupMutation) ResetErrors() {
+	m.errors = nil
+	m.adderrors = nil
+}
+
+// SetChanges sets the "changes" field.
+func (m *CheckRollupMutation) SetChanges(i int) {
+	m.changes = &i
+	m.addchanges = nil
+}
+
+// Changes returns the value of the "changes" field in the mutation.
+func (m *CheckRollupMutation) Changes() (r int, exists bool) {
+	v := m.changes
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldChanges returns the old "changes" field's value of the CheckRollup entity.
+// If the CheckRollup object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CheckRollupMutation) OldChanges(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldChanges is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldChanges requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldChanges: %w", err)
+	}
+	return oldValue.Changes, nil
+}
+
+// AddChanges adds i to the "changes" field.
+func (m *CheckRollupMutation) AddChanges(i int) {
+	if m.addchanges != nil {
+		*m.addchanges += i
+	} else {
+		m.addchanges = &i
+	}
+}
+
+// AddedChanges returns the value that was added to the "changes" field in this mutation.
+func (m *CheckRollupMutation) AddedChanges() (r int, exists bool) {
+	v := m.addchanges
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetChanges resets all changes to the "changes" field.
+func (m *CheckRollupMutation) ResetChanges() {
+	m.changes = nil
+	m.addchanges = nil
+}
+
+// SetLatencySamples sets the "latency_samples" field.
+func (m *CheckRollupMutation) SetLatencySamples(i int) {
+	m.latency_samples = &i
+	m.addlatency_samples = nil
+}
+
+// LatencySamples returns the value of the "latency_samples" field in the mutation.
+func (m *CheckRollupMutation) LatencySamples() (r int, exists bool) {
+	v := m.latency_samples
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLatencySamples returns the old "latency_samples" field's value of the CheckRollup entity.
+// If the CheckRollup object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CheckRollupMutation) OldLatencySamples(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLatencySamples is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLatencySamples requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLatencySamples: %w", err)
+	}
+	return oldValue.LatencySamples, nil
+}
+
+// AddLatencySamples adds i to the "latency_samples" field.
+func (m *CheckRollupMutation) AddLatencySamples(i int) {
+	if m.addlatency_samples != nil {
+		*m.addlatency_samples += i
+	} else {
+		m.addlatency_samples = &i
+	}
+}
+
+// AddedLatencySamples returns the value that was added to the "latency_samples" field in this mutation.
+func (m *CheckRollupMutation) AddedLatencySamples() (r int, exists bool) {
+	v := m.addlatency_samples
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetLatencySamples resets all changes to the "latency_samples" field.
+func (m *CheckRollupMutation) ResetLatencySamples() {
+	m.latency_samples = nil
+	m.addlatency_samples = nil
+}
+
+// SetLatencyTotalMs sets the "latency_total_ms" field.
+func (m *CheckRollupMutation) SetLatencyTotalMs(i int64) {
+	m.latency_total_ms = &i
+	m.addlatency_total_ms = nil
+}
+
+// LatencyTotalMs returns the value of the "latency_total_ms" field in the mutation.
+func (m *CheckRollupMutation) LatencyTotalMs() (r int64, exists bool) {
+	v := m.latency_total_ms
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLatencyTotalMs returns the old "latency_total_ms" field's value of the CheckRollup entity.
+// If the CheckRollup object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CheckRollupMutation) OldLatencyTotalMs(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLatencyTotalMs is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLatencyTotalMs requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLatencyTotalMs: %w", err)
+	}
+	return oldValue.LatencyTotalMs, nil
+}
+
+// AddLatencyTotalMs adds i to the "latency_total_ms" field.
+func (m *CheckRollupMutation) AddLatencyTotalMs(i int64) {
+	if m.addlatency_total_ms != nil {
+		*m.addlatency_total_ms += i
+	} else {
+		m.addlatency_total_ms = &i
+	}
+}
+
+// AddedLatencyTotalMs returns the value that was added to the "latency_total_ms" field in this mutation.
+func (m *CheckRollupMutation) AddedLatencyTotalMs() (r int64, exists bool) {
+	v := m.addlatency_total_ms
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetLatencyTotalMs resets all changes to the "latency_total_ms" field.
+func (m *CheckRollupMutation) ResetLatencyTotalMs() {
+	m.latency_total_ms = nil
+	m.addlatency_total_ms = nil
+}
+
+// SetLatencyMaxMs sets the "latency_max_ms" field.
+func (m *CheckRollupMutation) SetLatencyMaxMs(i int) {
+	m.latency_max_ms = &i
+	m.addlatency_max_ms = nil
+}
+
+// LatencyMaxMs returns the value of the "latency_max_ms" field in the mutation.
+func (m *CheckRollupMutation) LatencyMaxMs() (r int, exists bool) {
+	v := m.latency_max_ms
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLatencyMaxMs returns the old "latency_max_ms" field's value of the CheckRollup entity.
+// If the CheckRollup object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CheckRollupMutation) OldLatencyMaxMs(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLatencyMaxMs is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLatencyMaxMs requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLatencyMaxMs: %w", err)
+	}
+	return oldValue.LatencyMaxMs, nil
+}
+
+// AddLatencyMaxMs adds i to the "latency_max_ms" field.
+func (m *CheckRollupMutation) AddLatencyMaxMs(i int) {
+	if m.addlatency_max_ms != nil {
+		*m.addlatency_max_ms += i
+	} else {
+		m.addlatency_max_ms = &i
+	}
+}
+
+// AddedLatencyMaxMs returns the value that was added to the "latency_max_ms" field in this mutation.
+func (m *CheckRollupMutation) AddedLatencyMaxMs() (r int, exists bool) {
+	v := m.addlatency_max_ms
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearLatencyMaxMs clears the value of the "latency_max_ms" field.
+func (m *CheckRollupMutation) ClearLatencyMaxMs() {
+	m.latency_max_ms = nil
+	m.addlatency_max_ms = nil
+	m.clearedFields[checkrollup.FieldLatencyMaxMs] = struct{}{}
+}
+
+// LatencyMaxMsCleared returns if the "latency_max_ms" field was cleared in this mutation.
+func (m *CheckRollupMutation) LatencyMaxMsCleared() bool {
+	_, ok := m.clearedFields[checkrollup.FieldLatencyMaxMs]
+	return ok
+}
+
+// ResetLatencyMaxMs resets all changes to the "latency_max_ms" field.
+func (m *CheckRollupMutation) ResetLatencyMaxMs() {
+	m.latency_max_ms = nil
+	m.addlatency_max_ms = nil
+	delete(m.clearedFields, checkrollup.FieldLatencyMaxMs)
+}
+
+// SetLatencyHistogram sets the "latency_histogram" field.
+func (m *CheckRollupMutation) SetLatencyHistogram(value map[string]int) {
+	m.latency_histogram = &value
+}
+
+// LatencyHistogram returns the value of the "latency_histogram" field in the mutation.
+func (m *CheckRollupMutation) LatencyHistogram() (r map[string]int, exists bool) {
+	v := m.latency_histogram
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLatencyHistogram returns the old "latency_histogram" field's value of the CheckRollup entity.
+// If the CheckRollup object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CheckRollupMutation) OldLatencyHistogram(ctx context.Context) (v map[string]int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLatencyHistogram is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLatencyHistogram requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLatencyHistogram: %w", err)
+	}
+	return oldValue.LatencyHistogram, nil
+}
+
+// ClearLatencyHistogram clears the value of the "latency_histogram" field.
+func (m *CheckRollupMutation) ClearLatencyHistogram() {
+	m.latency_histogram = nil
+	m.clearedFields[checkrollup.FieldLatencyHistogram] = struct{}{}
+}
+
+// LatencyHistogramCleared returns if the "latency_histogram" field was cleared in this mutation.
+func (m *CheckRollupMutation) LatencyHistogramCleared() bool {
+	_, ok := m.clearedFields[checkrollup.FieldLatencyHistogram]
+	return ok
+}
+
+// ResetLatencyHistogram resets all changes to the "latency_histogram" field.
+func (m *CheckRollupMutation) ResetLatencyHistogram() {
+	m.latency_histogram = nil
+	delete(m.clearedFields, checkrollup.FieldLatencyHistogram)
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *CheckRollupMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *CheckRollupMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the CheckRollup entity.
+// If the CheckRollup object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CheckRollupMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *CheckRollupMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// ClearMonitor clears the "monitor" edge to the Monitor entity.
+func (m *CheckRollupMutation) ClearMonitor() {
+	m.clearedmonitor = true
+	m.clearedFields[checkrollup.FieldMonitorID] = struct{}{}
+}
+
+// MonitorCleared reports if the "monitor" edge to the Monitor entity was cleared.
+func (m *CheckRollupMutation) MonitorCleared() bool {
+	return m.clearedmonitor
+}
+
+// MonitorIDs returns the "monitor" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// MonitorID instead. It exists only for internal usage by the builders.
+func (m *CheckRollupMutation) MonitorIDs() (ids []int) {
+	if id := m.monitor; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetMonitor resets all changes to the "monitor" edge.
+func (m *CheckRollupMutation) ResetMonitor() {
+	m.monitor = nil
+	m.clearedmonitor = false
+}
+
+// Where appends a list predicates to the CheckRollupMutation builder.
+func (m *CheckRollupMutation) Where(ps ...predicate.CheckRollup) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the CheckRollupMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *CheckRollupMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.CheckRollup, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *CheckRollupMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *CheckRollupMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (CheckRollup).
+func (m *CheckRollupMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *CheckRollupMutation) Fields() []string {
+	fields := make([]string, 0, 12)
+	if m.monitor != nil {
+		fields = append(fields, checkrollup.FieldMonitorID)
+	}
+	if m.period != nil {
+		fields = append(fields, checkrollup.FieldPeriod)
+	}
+	if m.bucket_start != nil {
+		fields = append(fields, checkrollup.FieldBucketStart)
+	}
+	if m.checks != nil {
+		fields = append(fields, checkrollup.FieldChecks)
+	}
+	if m.successes != nil {
+		fields = append(fields, checkrollup.FieldSuccesses)
+	}
+	if m.errors != nil {
+		fields = append(fields, checkrollup.FieldErrors)
+	}
+	if m.changes != nil {
+		fields = append(fields, checkrollup.FieldChanges)
+	}
+	if m.latency_samples != nil {
+		fields = append(fields, checkrollup.FieldLatencySamples)
+	}
+	if m.latency_total_ms != nil {
+		fields = append(fields, checkrollup.FieldLatencyTotalMs)
+	}
+	if m.latency_max_ms != nil {
+		fields = append(fields, checkrollup.FieldLatencyMaxMs)
+	}
+	if m.latency_histogram != nil {
+		fields = append(fields, checkrollup.FieldLatencyHistogram)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, checkrollup.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *CheckRollupMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case checkrollup.FieldMonitorID:
+		return m.MonitorID()
+	case checkrollup.FieldPeriod:
+		return m.Period()
+	case checkrollup.FieldBucketStart:
+		return m.BucketStart()
+	case checkrollup.FieldChecks:
+		return m.Checks()
+	case checkrollup.FieldSuccesses:
+		return m.Successes()
+	case checkrollup.FieldErrors:
+		return m.Errors()
+	case checkrollup.FieldChanges:
+		return m.Changes()
+	case checkrollup.FieldLatencySamples:
+		return m.LatencySamples()
+	case checkrollup.FieldLatencyTotalMs:
+		return m.LatencyTotalMs()
+	case checkrollup.FieldLatencyMaxMs:
+		return m.LatencyMaxMs()
+	case checkrollup.FieldLatencyHistogram:
+		return m.LatencyHistogram()
+	case checkrollup.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *CheckRollupMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case checkrollup.FieldMonitorID:
+		return m.OldMonitorID(ctx)
+	case checkrollup.FieldPeriod:
+		return m.OldPeriod(ctx)
+	case checkrollup.FieldBucketStart:
+		return m.OldBucketStart(ctx)
+	case checkrollup.FieldChecks:
+		return m.OldChecks(ctx)
+	case checkrollup.FieldSuccesses:
+		return m.OldSuccesses(ctx)
+	case checkrollup.FieldErrors:
+		return m.OldErrors(ctx)
+	case checkrollup.FieldChanges:
+		return m.OldChanges(ctx)
+	case checkrollup.FieldLatencySamples:
+		return m.OldLatencySamples(ctx)
+	case checkrollup.FieldLatencyTotalMs:
+		return m.OldLatencyTotalMs(ctx)
+	case checkrollup.FieldLatencyMaxMs:
+		return m.OldLatencyMaxMs(ctx)
+	case checkrollup.FieldLatencyHistogram:
+		return m.OldLatencyHistogram(ctx)
+	case checkrollup.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown CheckRollup field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *CheckRollupMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case checkrollup.FieldMonitorID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMonitorID(v)
+		return nil
+	case checkrollup.FieldPeriod:
+		v, ok := value.(checkrollup.Period)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPeriod(v)
+		return nil
+	case checkrollup.FieldBucketStart:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBucketStart(v)
+		return nil
+	case checkrollup.FieldChecks:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetChecks(v)
+		return nil
+	case checkrollup.FieldSuccesses:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSuccesses(v)
+		return nil
+	case checkrollup.FieldErrors:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetErrors(v)
+		return nil
+	case checkrollup.FieldChanges:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetChanges(v)
+		return nil
+	case checkrollup.FieldLatencySamples:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLatencySamples(v)
+		return nil
+	case checkrollup.FieldLatencyTotalMs:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLatencyTotalMs(v)
+		return nil
+	case checkrollup.FieldLatencyMaxMs:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLatencyMaxMs(v)
+		return nil
+	case checkrollup.FieldLatencyHistogram:
+		v, ok := value.(map[string]int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLatencyHistogram(v)
+		return nil
+	case checkrollup.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown CheckRollup field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *CheckRollupMutation) AddedFields() []string {
+	var fields []string
+	if m.addchecks != nil {
+		fields = append(fields, checkrollup.FieldChecks)
+	}
+	if m.addsuccesses != nil {
+		fields = append(fields, checkrollup.FieldSuccesses)
+	}
+	if m.adderrors != nil {
+		fields = append(fields, checkrollup.FieldErrors)
+	}
+	if m.addchanges != nil {
+		fields = append(fields, checkrollup.FieldChanges)
+	}
+	if m.addlatency_samples != nil {
+		fields = append(fields, checkrollup.FieldLatencySamples)
+	}
+	if m.addlatency_total_ms != nil {
+		fields = append(fields, checkrollup.FieldLatencyTotalMs)
+	}
+	if m.addlatency_max_ms != nil {
+		fields = append(fields, checkrollup.FieldLatencyMaxMs)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *CheckRollupMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case checkrollup.FieldChecks:
+		return m.AddedChecks()
+	case checkrollup.FieldSuccesses:
+		return m.AddedSuccesses()
+	case checkrollup.FieldErrors:
+		return m.AddedErrors()
+	case checkrollup.FieldChanges:
+		return m.AddedChanges()
+	case checkrollup.FieldLatencySamples:
+		return m.AddedLatencySamples()
+	case checkrollup.FieldLatencyTotalMs:
+		return m.AddedLatencyTotalMs()
+	case checkrollup.FieldLatencyMaxMs:
+		return m.AddedLatencyMaxMs()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *CheckRollupMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case checkrollup.FieldChecks:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddChecks(v)
+		return nil
+	case checkrollup.FieldSuccesses:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddSuccesses(v)
+		return nil
+	case checkrollup.FieldErrors:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddErrors(v)
+		return nil
+	case checkrollup.FieldChanges:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddChanges(v)
+		return nil
+	case checkrollup.FieldLatencySamples:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddLatencySamples(v)
+		return nil
+	case checkrollup.FieldLatencyTotalMs:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddLatencyTotalMs(v)
+		return nil
+	case checkrollup.FieldLatencyMaxMs:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddLatencyMaxMs(v)
+		return nil
+	}
+	return fmt.Errorf("unknown CheckRollup numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *CheckRollupMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(checkrollup.FieldLatencyMaxMs) {
+		fields = append(fields, checkrollup.FieldLatencyMaxMs)
+	}
+	if m.FieldCleared(checkrollup.FieldLatencyHistogram) {
+		fields = append(fields, checkrollup.FieldLatencyHistogram)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *CheckRollupMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *CheckRollupMutation) ClearField(name string) error {
+	switch name {
+	case checkrollup.FieldLatencyMaxMs:
+		m.ClearLatencyMaxMs()
+		return nil
+	case checkrollup.FieldLatencyHistogram:
+		m.ClearLatencyHistogram()
+		return nil
+	}
+	return fmt.Errorf("unknown CheckRollup nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *CheckRollupMutation) ResetField(name string) error {
+	switch name {
+	case checkrollup.FieldMonitorID:
+		m.ResetMonitorID()
+		return nil
+	case checkrollup.FieldPeriod:
+		m.ResetPeriod()
+		return nil
+	case checkrollup.FieldBucketStart:
+		m.ResetBucketStart()
+		return nil
+	case checkrollup.FieldChecks:
+		m.ResetChecks()
+		return nil
+	case checkrollup.FieldSuccesses:
+		m.ResetSuccesses()
+		return nil
+	case checkrollup.FieldErrors:
+		m.ResetErrors()
+		return nil
+	case checkrollup.FieldChanges:
+		m.ResetChanges()
+		return nil
+	case checkrollup.FieldLatencySamples:
+		m.ResetLatencySamples()
+		return nil
+	case checkrollup.FieldLatencyTotalMs:
+		m.ResetLatencyTotalMs()
+		return nil
+	case checkrollup.FieldLatencyMaxMs:
+		m.ResetLatencyMaxMs()
+		return nil
+	case checkrollup.FieldLatencyHistogram:
+		m.ResetLatencyHistogram()
+		return nil
+	case checkrollup.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown CheckRollup field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *CheckRollupMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.monitor != nil {
+		edges = append(edges, checkrollup.EdgeMonitor)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *CheckRollupMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case checkrollup.EdgeMonitor:
+		if id := m.monitor; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *CheckRollupMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *CheckRollupMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *CheckRollupMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedmonitor {
+		edges = append(edges, checkrollup.EdgeMonitor)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *CheckRollupMutation) EdgeCleared(name string) bool {
+	switch name {
+	case checkrollup.EdgeMonitor:
+		return m.clearedmonitor
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *CheckRollupMutation) ClearEdge(name string) error {
+	switch name {
+	case checkrollup.EdgeMonitor:
+		m.ClearMonitor()
+		return nil
+	}
+	return fmt.Errorf("unknown CheckRollup unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *CheckRollupMutation) ResetEdge(name string) error {
+	switch name {
+	case checkrollup.EdgeMonitor:
+		m.ResetMonitor()
+		return nil
+	}
+	return fmt.Errorf("unknown CheckRollup edge %s", name)
+}
+
+// HeaderProfileMutation represents an operation that mutates the HeaderProfile nodes in the graph.
+type HeaderProfileMutation struct {
+	config
+	op              Op
+	typ             string
+	id              *int
+	name            *string
+	headers         *map[string]string
+	created_at      *time.Time
+	updated_at      *time.Time
+	clearedFields   map[string]struct{}
+	monitors        map[int]struct{}
+	removedmonitors map[int]struct{}
+	clearedmonitors bool
+	done            bool
+	oldValue        func(context.Context) (*HeaderProfile, error)
+	predicates      []predicate.HeaderProfile
+}
+
+var _ ent.Mutation = (*HeaderProfileMutation)(nil)
+
+// headerprofileOption allows management of the mutation configuration using functional options.
+type headerprofileOption func(*HeaderProfileMutation)
+
+// newHeaderProfileMutation creates new mutation for the HeaderProfile entity.
+func newHeaderProfileMutation(c config, op Op, opts ...headerprofileOption) *HeaderProfileMutation {
+	m := &HeaderProfileMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeHeaderProfile,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withHeaderProfileID sets the ID field of the mutation.
+func withHeaderProfileID(id int) headerprofileOption {
+	return func(m *HeaderProfileMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *HeaderProfile
+		)
+		m.oldValue = func(ctx context.Context) (*HeaderProfile, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().HeaderProfile.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withHeaderProfile sets the old HeaderProfile of the mutation.
+func withHeaderProfile(node *HeaderProfile) headerprofileOption {
+	return func(m *HeaderProfileMutation) {
+		m.oldValue = func(context.Context) (*HeaderProfile, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m HeaderProfileMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m HeaderProfileMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *HeaderProfileMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *HeaderProfileMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().HeaderProfile.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetName sets the "name" field.
+func (m *HeaderProfileMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *HeaderProfileMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the HeaderProfile entity.
+// If the HeaderProfile object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *HeaderProfileMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *HeaderProfileMutation) ResetName() {
+	m.name = nil
+}
+
+// SetHeaders sets the "headers" field.
+func (m *HeaderProfileMutation) SetHeaders(value map[string]string) {
+	m.headers = &value
+}
+
+// Headers returns the value of the "headers" field in the mutation.
+func (m *HeaderProfileMutation) Headers() (r map[string]string, exists bool) {
+	v := m.headers
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldHeaders returns the old "headers" field's value of the HeaderProfile entity.
+// If the HeaderProfile object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *HeaderProfileMutation) OldHeaders(ctx context.Context) (v map[string]string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldHeaders is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldHeaders requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldHeaders: %w", err)
+	}
+	return oldValue.Headers, nil
+}
+
+// ClearHeaders clears the value of the "headers" field.
+func (m *HeaderProfileMutation) ClearHeaders() {
+	m.headers = nil
+	m.clearedFields[headerprofile.FieldHeaders] = struct{}{}
+}
+
+// HeadersCleared returns if the "headers" field was cleared in this mutation.
+func (m *HeaderProfileMutation) HeadersCleared() bool {
+	_, ok := m.clearedFields[headerprofile.FieldHeaders]
+	return ok
+}
+
+// ResetHeaders resets all changes to the "headers" field.
+func (m *HeaderProfileMutation) ResetHeaders() {
+	m.headers = nil
+	delete(m.clearedFields, headerprofile.FieldHeaders)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *HeaderProfileMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *HeaderProfileMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the HeaderProfile entity.
+// If the HeaderProfile object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *HeaderProfileMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *HeaderProfileMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *HeaderProfileMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *HeaderProfileMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the HeaderProfile entity.
+// If the HeaderProfile object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *HeaderProfileMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *HeaderProfileMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// AddMonitorIDs adds the "monitors" edge to the Monitor entity by ids.
+func (m *HeaderProfileMutation) AddMonitorIDs(ids ...int) {
+	if m.monitors == nil {
+		m.monitors = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.monitors[ids[i]] = struct{}{}
+	}
+}
+
+// ClearMonitors clears the "monitors" edge to the Monitor entity.
+func (m *HeaderProfileMutation) ClearMonitors() {
+	m.clearedmonitors = true
+}
+
+// MonitorsCleared reports if the "monitors" edge to the Monitor entity was cleared.
+func (m *HeaderProfileMutation) MonitorsCleared() bool {
+	return m.clearedmonitors
+}
+
+// RemoveMonitorIDs removes the "monitors" edge to the Monitor entity by IDs.
+func (m *HeaderProfileMutation) RemoveMonitorIDs(ids ...int) {
+	if m.removedmonitors == nil {
+		m.removedmonitors = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.monitors, ids[i])
+		m.removedmonitors[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedMonitors returns the removed IDs of the "monitors" edge to the Monitor entity.
+func (m *HeaderProfileMutation) RemovedMonitorsIDs() (ids []int) {
+	for id := range m.removedmonitors {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// MonitorsIDs returns the "monitors" edge IDs in the mutation.
+func (m *HeaderProfileMutation) MonitorsIDs() (ids []int) {
+	for id := range m.monitors {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetMonitors resets all changes to the "monitors" edge.
+func (m *HeaderProfileMutation) ResetMonitors() {
+	m.monitors = nil
+	m.clearedmonitors = false
+	m.removedmonitors = nil
+}
+
+// Where appends a list predicates to the HeaderProfileMutation builder.
+func (m *HeaderProfileMutation) Where(ps ...predicate.HeaderProfile) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the HeaderProfileMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *HeaderProfileMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.HeaderProfile, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *HeaderProfileMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *HeaderProfileMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (HeaderProfile).
+func (m *HeaderProfileMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *HeaderProfileMutation) Fields() []string {
+	fields := make([]string, 0, 4)
+	if m.name != nil {
+		fields = append(fields, headerprofile.FieldName)
+	}
+	if m.headers != nil {
+		fields = append(fields, headerprofile.FieldHeaders)
+	}
+	if m.created_at != nil {
+		fields = append(fields, headerprofile.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, headerprofile.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *HeaderProfileMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case headerprofile.FieldName:
+		return m.Name()
+	case headerprofile.FieldHeaders:
+		return m.Headers()
+	case headerprofile.FieldCreatedAt:
+		return m.CreatedAt()
+	case headerprofile.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *HeaderProfileMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case headerprofile.FieldName:
+		return m.OldName(ctx)
+	case headerprofile.FieldHeaders:
+		return m.OldHeaders(ctx)
+	case headerprofile.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case headerprofile.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown HeaderProfile field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *HeaderProfileMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case headerprofile.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case headerprofile.FieldHeaders:
+		v, ok := value.(map[string]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetHeaders(v)
+		return nil
+	case headerprofile.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case headerprofile.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown HeaderProfile field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *HeaderProfileMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *HeaderProfileMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *HeaderProfileMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown HeaderProfile numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *HeaderProfileMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(headerprofile.FieldHeaders) {
+		fields = append(fields, headerprofile.FieldHeaders)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *HeaderProfileMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *HeaderProfileMutation) ClearField(name string) error {
+	switch name {
+	case headerprofile.FieldHeaders:
+		m.ClearHeaders()
+		return nil
+	}
+	return fmt.Errorf("unknown HeaderProfile nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *HeaderProfileMutation) ResetField(name string) error {
+	switch name {
+	case headerprofile.FieldName:
+		m.ResetName()
+		return nil
+	case headerprofile.FieldHeaders:
+		m.ResetHeaders()
+		return nil
+	case headerprofile.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case headerprofile.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown HeaderProfile field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *HeaderProfileMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.monitors != nil {
+		edges = append(edges, headerprofile.EdgeMonitors)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *HeaderProfileMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case headerprofile.EdgeMonitors:
+		ids := make([]ent.Value, 0, len(m.monitors))
+		for id := range m.monitors {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *HeaderProfileMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.removedmonitors != nil {
+		edges = append(edges, headerprofile.EdgeMonitors)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *HeaderProfileMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case headerprofile.EdgeMonitors:
+		ids := make([]ent.Value, 0, len(m.removedmonitors))
+		for id := range m.removedmonitors {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *HeaderProfileMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedmonitors {
+		edges = append(edges, headerprofile.EdgeMonitors)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *HeaderProfileMutation) EdgeCleared(name string) bool {
+	switch name {
+	case headerprofile.EdgeMonitors:
+		return m.clearedmonitors
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *HeaderProfileMutation) ClearEdge(name string) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown HeaderProfile unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *HeaderProfileMutation) ResetEdge(name string) error {
+	switch name {
+	case headerprofile.EdgeMonitors:
+		m.ResetMonitors()
+		return nil
+	}
+	return fmt.Errorf("unknown HeaderProfile edge %s", name)
+}
+
+// MonitorMutation represents an operation that mutates the Monitor nodes in the graph.
+type MonitorMutation struct {
+	config
+	op                          Op
+	typ                         string
+	id                          *int
+	label                       *string
+	method                      *string
+	url                         *string
+	icon_url                    *string
+	body                        *string
+	headers                     *map[string]string
+	user_agent                  *string
+	auth                        *map[string]string
+	notification_channels       *[]string
+	appendnotification_channels []string
+	escalation_channels         *[]string
+	appendescalation_channels   []string
+	tags                        *[]string
+	appendtags                  []string
+	escalation_after_minutes    *int
+	addescalation_after_minutes *int
+	selector                    *string
+	expected_status             *string
+	retry_on                    *string
+	expected_type               *monitor.ExpectedType
+	expected_response           *string
+	must_contain                *[]string
+	appendmust_contain          []string
+	must_not_contain            *[]string
+	appendmust_not_contain      []string
+	treat_not_found_as_success  *bool
+	accept_empty_body           *bool
+	watchdog_minutes            *int
+	addwatchdog_minutes         *int
+	header_assertions           *map[string]string
+	track_header                *string
+	numeric_tolerance           *float64
+	addnumeric_tolerance        *float64
+	redirect_policy             *monitor.RedirectPolicy
+	max_redirects               *int
+	addmax_redirects            *int
+	max_response_bytes          *int
+	addmax_response_bytes       *int
+	checks_history_limit        *int
+	addchecks_history_limit     *int
+	cookie_jar                  *bool
+	host_overrides              *map[string]string
+	ip_family                   *monitor.IPFamily
+	tls_ca_pem                  *string
+	tls_insecure_skip_verify    *bool
+	tls_min_version             *string
+	tls_server_name             *string
+	cron                        *string
+	timezone                    *string
+	jitter_seconds              *int
+	addjitter_seconds           *int
+	dst_policy                  *monitor.DstPolicy
+	body_snapshot               *monitor.BodySnapshot
+	content_hash                *monitor.ContentHash
+	fetch_mode                  *monitor.FetchMode
+	heartbeat_token             *string
+	enabled                     *bool
+	status_page                 *bool
+	sort_index                  *int
+	addsort_index               *int
+	archived_at                 *time.Time
+	created_at                  *time.Time
+	updated_at                  *time.Time
+	clearedFields               map[string]struct{}
+	check_results               map[int]struct{}
+	removedcheck_results        map[int]struct{}
+	clearedcheck_results        bool
+	notification_events         map[int]struct{}
+	removednotification_events  map[int]struct{}
+	clearednotification_events  bool
+	runtime                     *int
+	clearedruntime              bool
+	versions                    map[int]struct{}
+	removedversions             map[int]struct{}
+	clearedversions             bool
+	rollups                     map[int]struct{}
+	removedrollups              map[int]struct{}
+	clearedrollups              bool
+	header_profile              *int
+	clearedheader_profile       bool
+	done                        bool
+	oldValue                    func(context.Context) (*Monitor, error)
+	predicates                  []predicate.Monitor
+}
+
+var _ ent.Mutation = (*MonitorMutation)(nil)
+
+// monitorOption allows management of the mutation configuration using functional options.
+type monitorOption func(*MonitorMutation)
+
+// newMonitorMutation creates new mutation for the Monitor entity.
+func newMonitorMutation(c config, op Op, opts ...monitorOption) *MonitorMutation {
+	m := &MonitorMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeMonitor,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withMonitorID sets the ID field of the mutation.
+func withMonitorID(id int) monitorOption {
+	return func(m *MonitorMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Monitor
+		)
+		m.oldValue = func(ctx context.Context) (*Monitor, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Monitor.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withMonitor sets the old Monitor of the mutation.
+func withMonitor(node *Monitor) monitorOption {
+	return func(m *MonitorMutation) {
+		m.oldValue = func(context.Context) (*Monitor, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m MonitorMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m MonitorMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *MonitorMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *MonitorMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Monitor.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetLabel sets the "label" field.
+func (m *MonitorMutation) SetLabel(s string) {
+	m.label = &s
+}
+
+// Label returns the value of the "label" field in the mutation.
+func (m *MonitorMutation) Label() (r string, exists bool) {
+	v := m.label
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLabel returns the old "label" field's value of the Monitor entity.
+// If the Monitor object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MonitorMutation) OldLabel(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLabel is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLabel requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLabel: %w", err)
+	}
+	return oldValue.Label, nil
+}
+
+// ClearLabel clears the value of the "label" field.
+func (m *MonitorMutation) ClearLabel() {
+	m.label = nil
+	m.clearedFields[monitor.FieldLabel] = struct{}{}
+}
+
+// LabelCleared returns if the "label" field was cleared in this mutation.
+func (m *MonitorMutation) LabelCleared() bool {
+	_, ok := m.clearedFields[monitor.FieldLabel]
+	return ok
+}
+
+// ResetLabel resets all changes to the "label" field.
+func (m *MonitorMutation) ResetLabel() {
+	m.label = nil
+	delete(m.clearedFields, monitor.FieldLabel)
+}
+
+// SetMethod sets the "method" field.
+func (m *MonitorMutation) SetMethod(s string) {
+	m.method = &s
+}
+
+// Method returns the value of the "method" field in the mutation.
+func (m *MonitorMutation) Method() (r string, exists bool) {
+	v := m.method
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMethod returns the old "method" field's value of the Monitor entity.
+// If the Monitor object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MonitorMutation) OldMethod(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMethod is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMethod requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMethod: %w", err)
+	}
+	return oldValue.Method, nil
+}
+
+// ResetMethod resets all changes to the "method" field.
+func (m *MonitorMutation) ResetMethod() {
+	m.method = nil
+}
+
+// SetURL sets the "url" field.
+func (m *MonitorMutation) SetURL(s string) {
+	m.url = &s
+}
+
+// URL returns the value of the "url" field in the mutation.
+func (m *MonitorMutation) URL() (r string, exists bool) {
+	v := m.url
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldURL returns the old "url" field's value of the Monitor entity.
+// If the Monitor object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MonitorMutation) OldURL(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldURL is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldURL requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldURL: %w", err)
+	}
+	return oldValue.URL, nil
+}
+
+// ResetURL resets all changes to the "url" field.
+func (m *MonitorMutation) ResetURL() {
+	m.url = nil
+}
+
+// SetIconURL sets the "icon_url" field.
+func (m *MonitorMutation) SetIconURL(s string) {
+	m.icon_url = &s
+}
+
+// IconURL returns the value of the "icon_url" field in the mutation.
+func (m *MonitorMutation) IconURL() (r string, exists bool) {
+	v := m.icon_url
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIconURL returns the old "icon_url" field's value of the Monitor entity.
+// If the Monitor object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MonitorMutation) OldIconURL(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIconURL is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIconURL requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIconURL: %w", err)
+	}
+	return oldValue.IconURL, nil
+}
+
+// ClearIconURL clears the value of the "icon_url" field.
+func (m *MonitorMutation) ClearIconURL() {
+	m.icon_url = nil
+	m.clearedFields[monitor.FieldIconURL] = struct{}{}
+}
+
+// IconURLCleared returns if the "icon_url" field was cleared in this mutation.
+func (m *MonitorMutation) IconURLCleared() bool {
+	_, ok := m.clearedFields[monitor.FieldIconURL]
+	return ok
+}
+
+// ResetIconURL resets all changes to the "icon_url" field.
+func (m *MonitorMutation) ResetIconURL() {
+	m.icon_url = nil
+	delete(m.clearedFields, monitor.FieldIconURL)
+}
+
+// SetBody sets the "body" field.
+func (m *MonitorMutation) SetBody(s string) {
+	m.body = &s
+}
+
+// Body returns the value of the "body" field in the mutation.
+func (m *MonitorMutation) Body() (r string, exists bool) {
+	v := m.body
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBody returns the old "body" field's value of the Monitor entity.
+// If the Monitor object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MonitorMutation) OldBody(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBody is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBody requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBody: %w", err)
+	}
+	return oldValue.Body, nil
+}
+
+// ClearBody clears the value of the "body" field.
+func (m *MonitorMutation) ClearBody() {
+	m.body = nil
+	m.clearedFields[monitor.FieldBody] = struct{}{}
+}
+
+// BodyCleared returns if the "body" field was cleared in this mutation.
+func (m *MonitorMutation) BodyCleared() bool {
+	_, ok := m.clearedFields[monitor.FieldBody]
+	return ok
+}
+
+// ResetBody resets all changes to the "body" field.
 func (m *MonitorMutation) ResetBody() {
 	m.body = nil
 	delete(m.clearedFields, monitor.FieldBody)
 }
 
-// SetHeaders sets the "headers" field.
-func (m *MonitorMutation) SetHeaders(value map[string]string) {
-	m.headers = &value
+// SetHeaders sets the "headers" field.
+func (m *MonitorMutation) SetHeaders(value map[string]string) {
+	m.headers = &value
+}
+
+// Headers returns the value of the "headers" field in the mutation.
+func (m *MonitorMutation) Headers() (r map[string]string, exists bool) {
+	v := m.headers
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldHeaders returns the old "headers" field's value of the Monitor entity.
+// If the Monitor object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MonitorMutation) OldHeaders(ctx context.Context) (v map[string]string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldHeaders is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldHeaders requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldHeaders: %w", err)
+	}
+	return oldValue.Headers, nil
+}
+
+// ClearHeaders clears the value of the "headers" field.
+func (m *MonitorMutation) ClearHeaders() {
+	m.headers = nil
+	m.clearedFields[monitor.FieldHeaders] = struct{}{}
+}
+
+// HeadersCleared returns if the "headers" field was cleared in this mutation.
+func (m *MonitorMutation) HeadersCleared() bool {
+	_, ok := m.clearedFields[monitor.FieldHeaders]
+	return ok
+}
+
+// ResetHeaders resets all changes to the "headers" field.
+func (m *MonitorMutation) ResetHeaders() {
+	m.headers = nil
+	delete(m.clearedFields, monitor.FieldHeaders)
+}
+
+// SetUserAgent sets the "user_agent" field.
+func (m *MonitorMutation) SetUserAgent(s string) {
+	m.user_agent = &s
+}
+
+// UserAgent returns the value of the "user_agent" field in the mutation.
+func (m *MonitorMutation) UserAgent() (r string, exists bool) {
+	v := m.user_agent
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserAgent returns the old "user_agent" field's value of the Monitor entity.
+// If the Monitor object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MonitorMutation) OldUserAgent(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserAgent is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserAgent requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserAgent: %w", err)
+	}
+	return oldValue.UserAgent, nil
+}
+
+// ClearUserAgent clears the value of the "user_agent" field.
+func (m *MonitorMutation) ClearUserAgent() {
+	m.user_agent = nil
+	m.clearedFields[monitor.FieldUserAgent] = struct{}{}
+}
+
+// UserAgentCleared returns if the "user_agent" field was cleared in this mutation.
+func (m *MonitorMutation) UserAgentCleared() bool {
+	_, ok := m.clearedFields[monitor.FieldUserAgent]
+	return ok
+}
+
+// ResetUserAgent resets all changes to the "user_agent" field.
+func (m *MonitorMutation) ResetUserAgent() {
+	m.user_agent = nil
+	delete(m.clearedFields, monitor.FieldUserAgent)
+}
+
+// SetHeaderProfileID sets the "header_profile_id" field.
+func (m *MonitorMutation) SetHeaderProfileID(i int) {
+	m.header_profile = &i
+}
+
+// HeaderProfileID returns the value of the "header_profile_id" field in the mutation.
+func (m *MonitorMutation) HeaderProfileID() (r int, exists bool) {
+	v := m.header_profile
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldHeaderProfileID returns the old "header_profile_id" field's value of the Monitor entity.
+// If the Monitor object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MonitorMutation) OldHeaderProfileID(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldHeaderProfileID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldHeaderProfileID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldHeaderProfileID: %w", err)
+	}
+	return oldValue.HeaderProfileID, nil
+}
+
+// ClearHeaderProfileID clears the value of the "header_profile_id" field.
+func (m *MonitorMutation) ClearHeaderProfileID() {
+	m.header_profile = nil
+	m.clearedFields[monitor.FieldHeaderProfileID] = struct{}{}
+}
+
+// HeaderProfileIDCleared returns if the "header_profile_id" field was cleared in this mutation.
+func (m *MonitorMutation) HeaderProfileIDCleared() bool {
+	_, ok := m.clearedFields[monitor.FieldHeaderProfileID]
+	return ok
+}
+
+// ResetHeaderProfileID resets all changes to the "header_profile_id" field.
+func (m *MonitorMutation) ResetHeaderProfileID() {
+	m.header_profile = nil
+	delete(m.clearedFields, monitor.FieldHeaderProfileID)
+}
+
+// SetAuth sets the "auth" field.
+func (m *MonitorMutation) SetAuth(value map[string]string) {
+	m.auth = &value
+}
+
+// Auth returns the value of the "auth" field in the mutation.
+func (m *MonitorMutation) Auth() (r map[string]string, exists bool) {
+	v := m.auth
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAuth returns the old "auth" field's value of the Monitor entity.
+// If the Monitor object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MonitorMutation) OldAuth(ctx context.Context) (v map[string]string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAuth is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAuth requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAuth: %w", err)
+	}
+	return oldValue.Auth, nil
+}
+
+// ClearAuth clears the value of the "auth" field.
+func (m *MonitorMutation) ClearAuth() {
+	m.auth = nil
+	m.clearedFields[monitor.FieldAuth] = struct{}{}
+}
+
+// AuthCleared returns if the "auth" field was cleared in this mutation.
+func (m *MonitorMutation) AuthCleared() bool {
+	_, ok := m.clearedFields[monitor.FieldAuth]
+	return ok
+}
+
+// ResetAuth resets all changes to the "auth" field.
+func (m *MonitorMutation) ResetAuth() {
+	m.auth = nil
+	delete(m.clearedFields, monitor.FieldAuth)
+}
+
+// SetNotificationChannels sets the "notification_channels" field.
+func (m *MonitorMutation) SetNotificationChannels(s []string) {
+	m.notification_channels = &s
+	m.appendnotification_channels = nil
+}
+
+// NotificationChannels returns the value of the "notification_channels" field in the mutation.
+func (m *MonitorMutation) NotificationChannels() (r []string, exists bool) {
+	v := m.notification_channels
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNotificationChannels returns the old "notification_channels" field's value of the Monitor entity.
+// If the Monitor object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MonitorMutation) OldNotificationChannels(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNotificationChannels is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNotificationChannels requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNotificationChannels: %w", err)
+	}
+	return oldValue.NotificationChannels, nil
+}
+
+// AppendNotificationChannels adds s to the "notification_channels" field.
+func (m *MonitorMutation) AppendNotificationChannels(s []string) {
+	m.appendnotification_channels = append(m.appendnotification_channels, s...)
+}
+
+// AppendedNotificationChannels returns the list of values that were appended to the "notification_channels" field in this mutation.
+func (m *MonitorMutation) AppendedNotificationChannels() ([]string, bool) {
+	if len(m.appendnotification_channels) == 0 {
+		return nil, false
+	}
+	return m.appendnotification_channels, true
+}
+
+// ClearNotificationChannels clears the value of the "notification_channels" field.
+func (m *MonitorMutation) ClearNotificationChannels() {
+	m.notification_channels = nil
+	m.appendnotification_channels = nil
+	m.clearedFields[monitor.FieldNotificationChannels] = struct{}{}
+}
+
+// NotificationChannelsCleared returns if the "notification_channels" field was cleared in this mutation.
+func (m *MonitorMutation) NotificationChannelsCleared() bool {
+	_, ok := m.clearedFields[monitor.FieldNotificationChannels]
+	return ok
+}
+
+// ResetNotificationChannels resets all changes to the "notification_channels" field.
+func (m *MonitorMutation) ResetNotificationChannels() {
+	m.notification_channels = nil
+	m.appendnotification_channels = nil
+	delete(m.clearedFields, monitor.FieldNotificationChannels)
+}
+
+// SetEscalationChannels sets the "escalation_channels" field.
+func (m *MonitorMutation) SetEscalationChannels(s []string) {
+	m.escalation_channels = &s
+	m.appendescalation_channels = nil
+}
+
+// EscalationChannels returns the value of the "escalation_channels" field in the mutation.
+func (m *MonitorMutation) EscalationChannels() (r []string, exists bool) {
+	v := m.escalation_channels
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEscalationChannels returns the old "escalation_channels" field's value of the Monitor entity.
+// If the Monitor object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MonitorMutation) OldEscalationChannels(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEscalationChannels is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEscalationChannels requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEscalationChannels: %w", err)
+	}
+	return oldValue.EscalationChannels, nil
+}
+
+// AppendEscalationChannels adds s to the "escalation_channels" field.
+func (m *MonitorMutation) AppendEscalationChannels(s []string) {
+	m.appendescalation_channels = append(m.appendescalation_channels, s...)
+}
+
+// AppendedEscalationChannels returns the list of values that were appended to the "escalation_channels" field in this mutation.
+func (m *MonitorMutation) AppendedEscalationChannels() ([]string, bool) {
+	if len(m.appendescalation_channels) == 0 {
+		return nil, false
+	}
+	return m.appendescalation_channels, true
+}
+
+// ClearEscalationChannels clears the value of the "escalation_channels" field.
+func (m *MonitorMutation) ClearEscalationChannels() {
+	m.escalation_channels = nil
+	m.appendescalation_channels = nil
+	m.clearedFields[monitor.FieldEscalationChannels] = struct{}{}
+}
+
+// EscalationChannelsCleared returns if the "escalation_channels" field was cleared in this mutation.
+func (m *MonitorMutation) EscalationChannelsCleared() bool {
+	_, ok := m.clearedFields[monitor.FieldEscalationChannels]
+	return ok
+}
+
+// ResetEscalationChannels resets all changes to the "escalation_channels" field.
+func (m *MonitorMutation) ResetEscalationChannels() {
+	m.escalation_channels = nil
+	m.appendescalation_channels = nil
+	delete(m.clearedFields, monitor.FieldEscalationChannels)
+}
+
+// SetTags sets the "tags" field.
+func (m *MonitorMutation) SetTags(s []string) {
+	m.tags = &s
+	m.appendtags = nil
+}
+
+// Tags returns the value of the "tags" field in the mutation.
+func (m *MonitorMutation) Tags() (r []string, exists bool) {
+	v := m.tags
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTags returns the old "tags" field's value of the Monitor entity.
+// If the Monitor object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MonitorMutation) OldTags(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTags is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTags requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTags: %w", err)
+	}
+	return oldValue.Tags, nil
+}
+
+// AppendTags adds s to the "tags" field.
+func (m *MonitorMutation) AppendTags(s []string) {
+	m.appendtags = append(m.appendtags, s...)
+}
+
+// AppendedTags returns the list of values that were appended to the "tags" field in this mutation.
+func (m *MonitorMutation) AppendedTags() ([]string, bool) {
+	if len(m.appendtags) == 0 {
+		return nil, false
+	}
+	return m.appendtags, true
+}
+
+// ClearTags clears the value of the "tags" field.
+func (m *MonitorMutation) ClearTags() {
+	m.tags = nil
+	m.appendtags = nil
+	m.clearedFields[monitor.FieldTags] = struct{}{}
+}
+
+// TagsCleared returns if the "tags" field was cleared in this mutation.
+func (m *MonitorMutation) TagsCleared() bool {
+	_, ok := m.clearedFields[monitor.FieldTags]
+	return ok
+}
+
+// ResetTags resets all changes to the "tags" field.
+func (m *MonitorMutation) ResetTags() {
+	m.tags = nil
+	m.appendtags = nil
+	delete(m.clearedFields, monitor.FieldTags)
+}
+
+// SetEscalationAfterMinutes sets the "escalation_after_minutes" field.
+func (m *MonitorMutation) SetEscalationAfterMinutes(i int) {
+	m.escalation_after_minutes = &i
+	m.addescalation_after_minutes = nil
+}
+
+// EscalationAfterMinutes returns the value of the "escalation_after_minutes" field in the mutation.
+func (m *MonitorMutation) EscalationAfterMinutes() (r int, exists bool) {
+	v := m.escalation_after_minutes
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEscalationAfterMinutes returns the old "escalation_after_minutes" field's value of the Monitor entity.
+// If the Monitor object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MonitorMutation) OldEscalationAfterMinutes(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEscalationAfterMinutes is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEscalationAfterMinutes requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEscalationAfterMinutes: %w", err)
+	}
+	return oldValue.EscalationAfterMinutes, nil
+}
+
+// AddEscalationAfterMinutes adds i to the "escalation_after_minutes" field.
+func (m *MonitorMutation) AddEscalationAfterMinutes(i int) {
+	if m.addescalation_after_minutes != nil {
+		*m.addescalation_after_minutes += i
+	} else {
+		m.addescalation_after_minutes = &i
+	}
+}
+
+// AddedEscalationAfterMinutes returns the value that was added to the "escalation_after_minutes" field in this mutation.
+func (m *MonitorMutation) AddedEscalationAfterMinutes() (r int, exists bool) {
+	v := m.addescalation_after_minutes
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearEscalationAfterMinutes clears the value of the "escalation_after_minutes" field.
+func (m *MonitorMutation) ClearEscalationAfterMinutes() {
+	m.escalation_after_minutes = nil
+	m.addescalation_after_minutes = nil
+	m.clearedFields[monitor.FieldEscalationAfterMinutes] = struct{}{}
+}
+
+// EscalationAfterMinutesCleared returns if the "escalation_after_minutes" field was cleared in this mutation.
+func (m *MonitorMutation) EscalationAfterMinutesCleared() bool {
+	_, ok := m.clearedFields[monitor.FieldEscalationAfterMinutes]
+	return ok
+}
+
+// ResetEscalationAfterMinutes resets all changes to the "escalation_after_minutes" field.
+func (m *MonitorMutation) ResetEscalationAfterMinutes() {
+	m.escalation_after_minutes = nil
+	m.addescalation_after_minutes = nil
+	delete(m.clearedFields, monitor.FieldEscalationAfterMinutes)
+}
+
+// SetSelector sets the "selector" field.
+func (m *MonitorMutation) SetSelector(s string) {
+	m.selector = &s
+}
+
+// Selector returns the value of the "selector" field in the mutation.
+func (m *MonitorMutation) Selector() (r string, exists bool) {
+	v := m.selector
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSelector returns the old "selector" field's value of the Monitor entity.
+// If the Monitor object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MonitorMutation) OldSelector(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSelector is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSelector requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSelector: %w", err)
+	}
+	return oldValue.Selector, nil
+}
+
+// ClearSelector clears the value of the "selector" field.
+func (m *MonitorMutation) ClearSelector() {
+	m.selector = nil
+	m.clearedFields[monitor.FieldSelector] = struct{}{}
+}
+
+// SelectorCleared returns if the "selector" field was cleared in this mutation.
+func (m *MonitorMutation) SelectorCleared() bool {
+	_, ok := m.clearedFields[monitor.FieldSelector]
+	return ok
+}
+
+// ResetSelector resets all changes to the "selector" field.
+func (m *MonitorMutation) ResetSelector() {
+	m.selector = nil
+	delete(m.clearedFields, monitor.FieldSelector)
+}
+
+// SetExpectedStatus sets the "expected_status" field.
+func (m *MonitorMutation) SetExpectedStatus(s string) {
+	m.expected_status = &s
+}
+
+// ExpectedStatus returns the value of the "expected_status" field in the mutation.
+func (m *MonitorMutation) ExpectedStatus() (r string, exists bool) {
+	v := m.expected_status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExpectedStatus returns the old "expected_status" field's value of the Monitor entity.
+// If the Monitor object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MonitorMutation) OldExpectedStatus(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExpectedStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExpectedStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExpectedStatus: %w", err)
+	}
+	return oldValue.ExpectedStatus, nil
+}
+
+// ClearExpectedStatus clears the value of the "expected_status" field.
+func (m *MonitorMutation) ClearExpectedStatus() {
+	m.expected_status = nil
+	m.clearedFields[monitor.FieldExpectedStatus] = struct{}{}
+}
+
+// ExpectedStatusCleared returns if the "expected_status" field was cleared in this mutation.
+func (m *MonitorMutation) ExpectedStatusCleared() bool {
+	_, ok := m.clearedFields[monitor.FieldExpectedStatus]
+	return ok
+}
+
+// ResetExpectedStatus resets all changes to the "expected_status" field.
+func (m *MonitorMutation) ResetExpectedStatus() {
+	m.expected_status = nil
+	delete(m.clearedFields, monitor.FieldExpectedStatus)
+}
+
+// SetRetryOn sets the "retry_on" field.
+func (m *MonitorMutation) SetRetryOn(s string) {
+	m.retry_on = &s
+}
+
+// RetryOn returns the value of the "retry_on" field in the mutation.
+func (m *MonitorMutation) RetryOn() (r string, exists bool) {
+	v := m.retry_on
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRetryOn returns the old "retry_on" field's value of the Monitor entity.
+// If the Monitor object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MonitorMutation) OldRetryOn(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRetryOn is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRetryOn requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRetryOn: %w", err)
+	}
+	return oldValue.RetryOn, nil
+}
+
+// ClearRetryOn clears the value of the "retry_on" field.
+func (m *MonitorMutation) ClearRetryOn() {
+	m.retry_on = nil
+	m.clearedFields[monitor.FieldRetryOn] = struct{}{}
+}
+
+// RetryOnCleared returns if the "retry_on" field was cleared in this mutation.
+func (m *MonitorMutation) RetryOnCleared() bool {
+	_, ok := m.clearedFields[monitor.FieldRetryOn]
+	return ok
+}
+
+// ResetRetryOn resets all changes to the "retry_on" field.
+func (m *MonitorMutation) ResetRetryOn() {
+	m.retry_on = nil
+	delete(m.clearedFields, monitor.FieldRetryOn)
+}
+
+// SetExpectedType sets the "expected_type" field.
+func (m *MonitorMutation) SetExpectedType(mt monitor.ExpectedType) {
+	m.expected_type = &mt
+}
+
+// ExpectedType returns the value of the "expected_type" field in the mutation.
+func (m *MonitorMutation) ExpectedType() (r monitor.ExpectedType, exists bool) {
+	v := m.expected_type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExpectedType returns the old "expected_type" field's value of the Monitor entity.
+// If the Monitor object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MonitorMutation) OldExpectedType(ctx context.Context) (v monitor.ExpectedType, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExpectedType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExpectedType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExpectedType: %w", err)
+	}
+	return oldValue.ExpectedType, nil
+}
+
+// ResetExpectedType resets all changes to the "expected_type" field.
+func (m *MonitorMutation) ResetExpectedType() {
+	m.expected_type = nil
+}
+
+// SetExpectedResponse sets the "expected_response" field.
+func (m *MonitorMutation) SetExpectedResponse(s string) {
+	m.expected_response = &s
+}
+
+// ExpectedResponse returns the value of the "expected_response" field in the mutation.
+func (m *MonitorMutation) ExpectedResponse() (r string, exists bool) {
+	v := m.expected_response
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExpectedResponse returns the old "expected_response" field's value of the Monitor entity.
+// If the Monitor object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MonitorMutation) OldExpectedResponse(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExpectedResponse is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExpectedResponse requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExpectedResponse: %w", err)
+	}
+	return oldValue.ExpectedResponse, nil
+}
+
+// ClearExpectedResponse clears the value of the "expected_response" field.
+func (m *MonitorMutation) ClearExpectedResponse() {
+	m.expected_response = nil
+	m.clearedFields[monitor.FieldExpectedResponse] = struct{}{}
+}
+
+// ExpectedResponseCleared returns if the "expected_response" field was cleared in this mutation.
+func (m *MonitorMutation) ExpectedResponseCleared() bool {
+	_, ok := m.clearedFields[monitor.FieldExpectedResponse]
+	return ok
+}
+
+// ResetExpectedResponse resets all changes to the "expected_response" field.
+func (m *MonitorMutation) ResetExpectedResponse() {
+	m.expected_response = nil
+	delete(m.clearedFields, monitor.FieldExpectedResponse)
+}
+
+// SetMustContain sets the "must_contain" field.
+func (m *MonitorMutation) SetMustContain(s []string) {
+	m.must_contain = &s
+	m.appendmust_contain = nil
+}
+
+// MustContain returns the value of the "must_contain" field in the mutation.
+func (m *MonitorMutation) MustContain() (r []string, exists bool) {
+	v := m.must_contain
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMustContain returns the old "must_contain" field's value of the Monitor entity.
+// If the Monitor object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MonitorMutation) OldMustContain(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMustContain is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMustContain requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMustContain: %w", err)
+	}
+	return oldValue.MustContain, nil
+}
+
+// AppendMustContain adds s to the "must_contain" field.
+func (m *MonitorMutation) AppendMustContain(s []string) {
+	m.appendmust_contain = append(m.appendmust_contain, s...)
+}
+
+// AppendedMustContain returns the list of values that were appended to the "must_contain" field in this mutation.
+func (m *MonitorMutation) AppendedMustContain() ([]string, bool) {
+	if len(m.appendmust_contain) == 0 {
+		return nil, false
+	}
+	return m.appendmust_contain, true
+}
+
+// ClearMustContain clears the value of the "must_contain" field.
+func (m *MonitorMutation) ClearMustContain() {
+	m.must_contain = nil
+	m.appendmust_contain = nil
+	m.clearedFields[monitor.FieldMustContain] = struct{}{}
+}
+
+// MustContainCleared returns if the "must_contain" field was cleared in this mutation.
+func (m *MonitorMutation) MustContainCleared() bool {
+	_, ok := m.clearedFields[monitor.FieldMustContain]
+	return ok
+}
+
+// ResetMustContain resets all changes to the "must_contain" field.
+func (m *MonitorMutation) ResetMustContain() {
+	m.must_contain = nil
+	m.appendmust_contain = nil
+	delete(m.clearedFields, monitor.FieldMustContain)
+}
+
+// SetMustNotContain sets the "must_not_contain" field.
+func (m *MonitorMutation) SetMustNotContain(s []string) {
+	m.must_not_contain = &s
+	m.appendmust_not_contain = nil
+}
+
+// MustNotContain returns the value of the "must_not_contain" field in the mutation.
+func (m *MonitorMutation) MustNotContain() (r []string, exists bool) {
+	v := m.must_not_contain
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMustNotContain returns the old "must_not_contain" field's value of the Monitor entity.
+// If the Monitor object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MonitorMutation) OldMustNotContain(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMustNotContain is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMustNotContain requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMustNotContain: %w", err)
+	}
+	return oldValue.MustNotContain, nil
+}
+
+// AppendMustNotContain adds s to the "must_not_contain" field.
+func (m *MonitorMutation) AppendMustNotContain(s []string) {
+	m.appendmust_not_contain = append(m.appendmust_not_contain, s...)
+}
+
+// AppendedMustNotContain returns the list of values that were appended to the "must_not_contain" field in this mutation.
+func (m *MonitorMutation) AppendedMustNotContain() ([]string, bool) {
+	if len(m.appendmust_not_contain) == 0 {
+		return nil, false
+	}
+	return m.appendmust_not_contain, true
+}
+
+// ClearMustNotContain clears the value of the "must_not_contain" field.
+func (m *MonitorMutation) ClearMustNotContain() {
+	m.must_not_contain = nil
+	m.appendmust_not_contain = nil
+	m.clearedFields[monitor.FieldMustNotContain] = struct{}{}
+}
+
+// MustNotContainCleared returns if the "must_not_contain" field was cleared in this mutation.
+func (m *MonitorMutation) MustNotContainCleared() bool {
+	_, ok := m.clearedFields[monitor.FieldMustNotContain]
+	return ok
+}
+
+// ResetMustNotContain resets all changes to the "must_not_contain" field.
+func (m *MonitorMutation) ResetMustNotContain() {
+	m.must_not_contain = nil
+	m.appendmust_not_contain = nil
+	delete(m.clearedFields, monitor.FieldMustNotContain)
+}
+
+// SetTreatNotFoundAsSuccess sets the "treat_not_found_as_success" field.
+func (m *MonitorMutation) SetTreatNotFoundAsSuccess(b bool) {
+	m.treat_not_found_as_success = &b
+}
+
+// TreatNotFoundAsSuccess returns the value of the "treat_not_found_as_success" field in the mutation.
+func (m *MonitorMutation) TreatNotFoundAsSuccess() (r bool, exists bool) {
+	v := m.treat_not_found_as_success
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTreatNotFoundAsSuccess returns the old "treat_not_found_as_success" field's value of the Monitor entity.
+// If the Monitor object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MonitorMutation) OldTreatNotFoundAsSuccess(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTreatNotFoundAsSuccess is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTreatNotFoundAsSuccess requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTreatNotFoundAsSuccess: %w", err)
+	}
+	return oldValue.TreatNotFoundAsSuccess, nil
+}
+
+// ResetTreatNotFoundAsSuccess resets all changes to the "treat_not_found_as_success" field.
+func (m *MonitorMutation) ResetTreatNotFoundAsSuccess() {
+	m.treat_not_found_as_success = nil
+}
+
+// SetAcceptEmptyBody sets the "accept_empty_body" field.
+func (m *MonitorMutation) SetAcceptEmptyBody(b bool) {
+	m.accept_empty_body = &b
+}
+
+// AcceptEmptyBody returns the value of the "accept_empty_body" field in the mutation.
+func (m *MonitorMutation) AcceptEmptyBody() (r bool, exists bool) {
+	v := m.accept_empty_body
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAcceptEmptyBody returns the old "accept_empty_body" field's value of the Monitor entity.
+// If the Monitor object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MonitorMutation) OldAcceptEmptyBody(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAcceptEmptyBody is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAcceptEmptyBody requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAcceptEmptyBody: %w", err)
+	}
+	return oldValue.AcceptEmptyBody, nil
+}
+
+// ResetAcceptEmptyBody resets all changes to the "accept_empty_body" field.
+func (m *MonitorMutation) ResetAcceptEmptyBody() {
+	m.accept_empty_body = nil
+}
+
+// SetWatchdogMinutes sets the "watchdog_minutes" field.
+func (m *MonitorMutation) SetWatchdogMinutes(i int) {
+	m.watchdog_minutes = &i
+	m.addwatchdog_minutes = nil
+}
+
+// WatchdogMinutes returns the value of the "watchdog_minutes" field in the mutation.
+func (m *MonitorMutation) WatchdogMinutes() (r int, exists bool) {
+	v := m.watchdog_minutes
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldWatchdogMinutes returns the old "watchdog_minutes" field's value of the Monitor entity.
+// If the Monitor object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MonitorMutation) OldWatchdogMinutes(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldWatchdogMinutes is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldWatchdogMinutes requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldWatchdogMinutes: %w", err)
+	}
+	return oldValue.WatchdogMinutes, nil
+}
+
+// AddWatchdogMinutes adds i to the "watchdog_minutes" field.
+func (m *MonitorMutation) AddWatchdogMinutes(i int) {
+	if m.addwatchdog_minutes != nil {
+		*m.addwatchdog_minutes += i
+	} else {
+		m.addwatchdog_minutes = &i
+	}
+}
+
+// AddedWatchdogMinutes returns the value that was added to the "watchdog_minutes" field in this mutation.
+func (m *MonitorMutation) AddedWatchdogMinutes() (r int, exists bool) {
+	v := m.addwatchdog_minutes
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearWatchdogMinutes clears the value of the "watchdog_minutes" field.
+func (m *MonitorMutation) ClearWatchdogMinutes() {
+	m.watchdog_minutes = nil
+	m.addwatchdog_minutes = nil
+	m.clearedFields[monitor.FieldWatchdogMinutes] = struct{}{}
+}
+
+// WatchdogMinutesCleared returns if the "watchdog_minutes" field was cleared in this mutation.
+func (m *MonitorMutation) WatchdogMinutesCleared() bool {
+	_, ok := m.clearedFields[monitor.FieldWatchdogMinutes]
+	return ok
+}
+
+// ResetWatchdogMinutes resets all changes to the "watchdog_minutes" field.
+func (m *MonitorMutation) ResetWatchdogMinutes() {
+	m.watchdog_minutes = nil
+	m.addwatchdog_minutes = nil
+	delete(m.clearedFields, monitor.FieldWatchdogMinutes)
+}
+
+// SetHeaderAssertions sets the "header_assertions" field.
+func (m *MonitorMutation) SetHeaderAssertions(value map[string]string) {
+	m.header_assertions = &value
+}
+
+// HeaderAssertions returns the value of the "header_assertions" field in the mutation.
+func (m *MonitorMutation) HeaderAssertions() (r map[string]string, exists bool) {
+	v := m.header_assertions
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldHeaderAssertions returns the old "header_assertions" field's value of the Monitor entity.
+// If the Monitor object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MonitorMutation) OldHeaderAssertions(ctx context.Context) (v map[string]string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldHeaderAssertions is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldHeaderAssertions requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldHeaderAssertions: %w", err)
+	}
+	return oldValue.HeaderAssertions, nil
+}
+
+// ClearHeaderAssertions clears the value of the "header_assertions" field.
+func (m *MonitorMutation) ClearHeaderAssertions() {
+	m.header_assertions = nil
+	m.clearedFields[monitor.FieldHeaderAssertions] = struct{}{}
+}
+
+// HeaderAssertionsCleared returns if the "header_assertions" field was cleared in this mutation.
+func (m *MonitorMutation) HeaderAssertionsCleared() bool {
+	_, ok := m.clearedFields[monitor.FieldHeaderAssertions]
+	return ok
+}
+
+// ResetHeaderAssertions resets all changes to the "header_assertions" field.
+func (m *MonitorMutation) ResetHeaderAssertions() {
+	m.header_assertions = nil
+	delete(m.clearedFields, monitor.FieldHeaderAssertions)
+}
+
+// SetTrackHeader sets the "track_header" field.
+func (m *MonitorMutation) SetTrackHeader(s string) {
+	m.track_header = &s
+}
+
+// TrackHeader returns the value of the "track_header" field in the mutation.
+func (m *MonitorMutation) TrackHeader() (r string, exists bool) {
+	v := m.track_header
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTrackHeader returns the old "track_header" field's value of the Monitor entity.
+// If the Monitor object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MonitorMutation) OldTrackHeader(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTrackHeader is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTrackHeader requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTrackHeader: %w", err)
+	}
+	return oldValue.TrackHeader, nil
+}
+
+// ClearTrackHeader clears the value of the "track_header" field.
+func (m *MonitorMutation) ClearTrackHeader() {
+	m.track_header = nil
+	m.clearedFields[monitor.FieldTrackHeader] = struct{}{}
+}
+
+// TrackHeaderCleared returns if the "track_header" field was cleared in this mutation.
+func (m *MonitorMutation) TrackHeaderCleared() bool {
+	_, ok := m.clearedFields[monitor.FieldTrackHeader]
+	return ok
+}
+
+// ResetTrackHeader resets all changes to the "track_header" field.
+func (m *MonitorMutation) ResetTrackHeader() {
+	m.track_header = nil
+	delete(m.clearedFields, monitor.FieldTrackHeader)
+}
+
+// SetNumericTolerance sets the "numeric_tolerance" field.
+func (m *MonitorMutation) SetNumericTolerance(f float64) {
+	m.numeric_tolerance = &f
+	m.addnumeric_tolerance = nil
+}
+
+// NumericTolerance returns the value of the "numeric_tolerance" field in the mutation.
+func (m *MonitorMutation) NumericTolerance() (r float64, exists bool) {
+	v := m.numeric_tolerance
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNumericTolerance returns the old "numeric_tolerance" field's value of the Monitor entity.
+// If the Monitor object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MonitorMutation) OldNumericTolerance(ctx context.Context) (v *float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNumericTolerance is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNumericTolerance requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNumericTolerance: %w", err)
+	}
+	return oldValue.NumericTolerance, nil
+}
+
+// AddNumericTolerance adds f to the "numeric_tolerance" field.
+func (m *MonitorMutation) AddNumericTolerance(f float64) {
+	if m.addnumeric_tolerance != nil {
+		*m.addnumeric_tolerance += f
+	} else {
+		m.addnumeric_tolerance = &f
+	}
+}
+
+// AddedNumericTolerance returns the value that was added to the "numeric_tolerance" field in this mutation.
+func (m *MonitorMutation) AddedNumericTolerance() (r float64, exists bool) {
+	v := m.addnumeric_tolerance
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearNumericTolerance clears the value of the "numeric_tolerance" field.
+func (m *MonitorMutation) ClearNumericTolerance() {
+	m.numeric_tolerance = nil
+	m.addnumeric_tolerance = nil
+	m.clearedFields[monitor.FieldNumericTolerance] = struct{}{}
+}
+
+// NumericToleranceCleared returns if the "numeric_tolerance" field was cleared in this mutation.
+func (m *MonitorMutation) NumericToleranceCleared() bool {
+	_, ok := m.clearedFields[monitor.FieldNumericTolerance]
+	return ok
+}
+
+// ResetNumericTolerance resets all changes to the "numeric_tolerance" field.
+func (m *MonitorMutation) ResetNumericTolerance() {
+	m.numeric_tolerance = nil
+	m.addnumeric_tolerance = nil
+	delete(m.clearedFields, monitor.FieldNumericTolerance)
+}
+
+// SetRedirectPolicy sets the "redirect_policy" field.
+func (m *MonitorMutation) SetRedirectPolicy(mp monitor.RedirectPolicy) {
+	m.redirect_policy = &mp
+}
+
+// RedirectPolicy returns the value of the "redirect_policy" field in the mutation.
+func (m *MonitorMutation) RedirectPolicy() (r monitor.RedirectPolicy, exists bool) {
+	v := m.redirect_policy
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRedirectPolicy returns the old "redirect_policy" field's value of the Monitor entity.
+// If the Monitor object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MonitorMutation) OldRedirectPolicy(ctx context.Context) (v monitor.RedirectPolicy, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRedirectPolicy is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRedirectPolicy requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRedirectPolicy: %w", err)
+	}
+	return oldValue.RedirectPolicy, nil
+}
+
+// ResetRedirectPolicy resets all changes to the "redirect_policy" field.
+func (m *MonitorMutation) ResetRedirectPolicy() {
+	m.redirect_policy = nil
+}
+
+// SetMaxRedirects sets the "max_redirects" field.
+func (m *MonitorMutation) SetMaxRedirects(i int) {
+	m.max_redirects = &i
+	m.addmax_redirects = nil
+}
+
+// MaxRedirects returns the value of the "max_redirects" field in the mutation.
+func (m *MonitorMutation) MaxRedirects() (r int, exists bool) {
+	v := m.max_redirects
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMaxRedirects returns the old "max_redirects" field's value of the Monitor entity.
+// If the Monitor object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MonitorMutation) OldMaxRedirects(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMaxRedirects is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMaxRedirects requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMaxRedirects: %w", err)
+	}
+	return oldValue.MaxRedirects, nil
+}
+
+// AddMaxRedirects adds i to the "max_redirects" field.
+func (m *MonitorMutation) AddMaxRedirects(i int) {
+	if m.addmax_redirects != nil {
+		*m.addmax_redirects += i
+	} else {
+		m.addmax_redirects = &i
+	}
+}
+
+// AddedMaxRedirects returns the value that was added to the "max_redirects" field in this mutation.
+func (m *MonitorMutation) AddedMaxRedirects() (r int, exists bool) {
+	v := m.addmax_redirects
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearMaxRedirects clears the value of the "max_redirects" field.
+func (m *MonitorMutation) ClearMaxRedirects() {
+	m.max_redirects = nil
+	m.addmax_redirects = nil
+	m.clearedFields[monitor.FieldMaxRedirects] = struct{}{}
+}
+
+// MaxRedirectsCleared returns if the "max_redirects" field was cleared in this mutation.
+func (m *MonitorMutation) MaxRedirectsCleared() bool {
+	_, ok := m.clearedFields[monitor.FieldMaxRedirects]
+	return ok
+}
+
+// ResetMaxRedirects resets all changes to the "max_redirects" field.
+func (m *MonitorMutation) ResetMaxRedirects() {
+	m.max_redirects = nil
+	m.addmax_redirects = nil
+	delete(m.clearedFields, monitor.FieldMaxRedirects)
+}
+
+// SetMaxResponseBytes sets the "max_response_bytes" field.
+func (m *MonitorMutation) SetMaxResponseBytes(i int) {
+	m.max_response_bytes = &i
+	m.addmax_response_bytes = nil
+}
+
+// MaxResponseBytes returns the value of the "max_response_bytes" field in the mutation.
+func (m *MonitorMutation) MaxResponseBytes() (r int, exists bool) {
+	v := m.max_response_bytes
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMaxResponseBytes returns the old "max_response_bytes" field's value of the Monitor entity.
+// If the Monitor object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MonitorMutation) OldMaxResponseBytes(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMaxResponseBytes is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMaxResponseBytes requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMaxResponseBytes: %w", err)
+	}
+	return oldValue.MaxResponseBytes, nil
+}
+
+// AddMaxResponseBytes adds i to the "max_response_bytes" field.
+func (m *MonitorMutation) AddMaxResponseBytes(i int) {
+	if m.addmax_response_bytes != nil {
+		*m.addmax_response_bytes += i
+	} else {
+		m.addmax_response_bytes = &i
+	}
+}
+
+// AddedMaxResponseBytes returns the value that was added to the "max_response_bytes" field in this mutation.
+func (m *MonitorMutation) AddedMaxResponseBytes() (r int, exists bool) {
+	v := m.addmax_response_bytes
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearMaxResponseBytes clears the value of the "max_response_bytes" field.
+func (m *MonitorMutation) ClearMaxResponseBytes() {
+	m.max_response_bytes = nil
+	m.addmax_response_bytes = nil
+	m.clearedFields[monitor.FieldMaxResponseBytes] = struct{}{}
+}
+
+// MaxResponseBytesCleared returns if the "max_response_bytes" field was cleared in this mutation.
+func (m *MonitorMutation) MaxResponseBytesCleared() bool {
+	_, ok := m.clearedFields[monitor.FieldMaxResponseBytes]
+	return ok
+}
+
+// ResetMaxResponseBytes resets all changes to the "max_response_bytes" field.
+func (m *MonitorMutation) ResetMaxResponseBytes() {
+	m.max_response_bytes = nil
+	m.addmax_response_bytes = nil
+	delete(m.clearedFields, monitor.FieldMaxResponseBytes)
+}
+
+// SetChecksHistoryLimit sets the "checks_history_limit" field.
+func (m *MonitorMutation) SetChecksHistoryLimit(i int) {
+	m.checks_history_limit = &i
+	m.addchecks_history_limit = nil
+}
+
+// ChecksHistoryLimit returns the value of the "checks_history_limit" field in the mutation.
+func (m *MonitorMutation) ChecksHistoryLimit() (r int, exists bool) {
+	v := m.checks_history_limit
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldChecksHistoryLimit returns the old "checks_history_limit" field's value of the Monitor entity.
+// If the Monitor object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MonitorMutation) OldChecksHistoryLimit(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldChecksHistoryLimit is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldChecksHistoryLimit requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldChecksHistoryLimit: %w", err)
+	}
+	return oldValue.ChecksHistoryLimit, nil
+}
+
+// AddChecksHistoryLimit adds i to the "checks_history_limit" field.
+func (m *MonitorMutation) AddChecksHistoryLimit(i int) {
+	if m.addchecks_history_limit != nil {
+		*m.addchecks_history_limit += i
+	} else {
+		m.addchecks_history_limit = &i
+	}
+}
+
+// AddedChecksHistoryLimit returns the value that was added to the "checks_history_limit" field in this mutation.
+func (m *MonitorMutation) AddedChecksHistoryLimit() (r int, exists bool) {
+	v := m.addchecks_history_limit
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearChecksHistoryLimit clears the value of the "checks_history_limit" field.
+func (m *MonitorMutation) ClearChecksHistoryLimit() {
+	m.checks_history_limit = nil
+	m.addchecks_history_limit = nil
+	m.clearedFields[monitor.FieldChecksHistoryLimit] = struct{}{}
+}
+
+// ChecksHistoryLimitCleared returns if the "checks_history_limit" field was cleared in this mutation.
+func (m *MonitorMutation) ChecksHistoryLimitCleared() bool {
+	_, ok := m.clearedFields[monitor.FieldChecksHistoryLimit]
+	return ok
+}
+
+// ResetChecksHistoryLimit resets all changes to the "checks_history_limit" field.
+func (m *MonitorMutation) ResetChecksHistoryLimit() {
+	m.checks_history_limit = nil
+	m.addchecks_history_limit = nil
+	delete(m.clearedFields, monitor.FieldChecksHistoryLimit)
+}
+
+// SetCookieJar sets the "cookie_jar" field.
+func (m *MonitorMutation) SetCookieJar(b bool) {
+	m.cookie_jar = &b
+}
+
+// CookieJar returns the value of the "cookie_jar" field in the mutation.
+func (m *MonitorMutation) CookieJar() (r bool, exists bool) {
+	v := m.cookie_jar
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCookieJar returns the old "cookie_jar" field's value of the Monitor entity.
+// If the Monitor object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MonitorMutation) OldCookieJar(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCookieJar is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCookieJar requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCookieJar: %w", err)
+	}
+	return oldValue.CookieJar, nil
+}
+
+// ResetCookieJar resets all changes to the "cookie_jar" field.
+func (m *MonitorMutation) ResetCookieJar() {
+	m.cookie_jar = nil
+}
+
+// SetHostOverrides sets the "host_overrides" field.
+func (m *MonitorMutation) SetHostOverrides(value map[string]string) {
+	m.host_overrides = &value
+}
+
+// HostOverrides returns the value of the "host_overrides" field in the mutation.
+func (m *MonitorMutation) HostOverrides() (r map[string]string, exists bool) {
+	v := m.host_overrides
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldHostOverrides returns the old "host_overrides" field's value of the Monitor entity.
+// If the Monitor object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MonitorMutation) OldHostOverrides(ctx context.Context) (v map[string]string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldHostOverrides is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldHostOverrides requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldHostOverrides: %w", err)
+	}
+	return oldValue.HostOverrides, nil
+}
+
+// ClearHostOverrides clears the value of the "host_overrides" field.
+func (m *MonitorMutation) ClearHostOverrides() {
+	m.host_overrides = nil
+	m.clearedFields[monitor.FieldHostOverrides] = struct{}{}
+}
+
+// HostOverridesCleared returns if the "host_overrides" field was cleared in this mutation.
+func (m *MonitorMutation) HostOverridesCleared() bool {
+	_, ok := m.clearedFields[monitor.FieldHostOverrides]
+	return ok
+}
+
+// ResetHostOverrides resets all changes to the "host_overrides" field.
+func (m *MonitorMutation) ResetHostOverrides() {
+	m.host_overrides = nil
+	delete(m.clearedFields, monitor.FieldHostOverrides)
+}
+
+// SetIPFamily sets the "ip_family" field.
+func (m *MonitorMutation) SetIPFamily(mf monitor.IPFamily) {
+	m.ip_family = &mf
+}
+
+// IPFamily returns the value of the "ip_family" field in the mutation.
+func (m *MonitorMutation) IPFamily() (r monitor.IPFamily, exists bool) {
+	v := m.ip_family
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIPFamily returns the old "ip_family" field's value of the Monitor entity.
+// If the Monitor object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MonitorMutation) OldIPFamily(ctx context.Context) (v monitor.IPFamily, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIPFamily is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIPFamily requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIPFamily: %w", err)
+	}
+	return oldValue.IPFamily, nil
+}
+
+// ResetIPFamily resets all changes to the "ip_family" field.
+func (m *MonitorMutation) ResetIPFamily() {
+	m.ip_family = nil
+}
+
+// SetTLSCaPem sets the "tls_ca_pem" field.
+func (m *MonitorMutation) SetTLSCaPem(s string) {
+	m.tls_ca_pem = &s
+}
+
+// TLSCaPem returns the value of the "tls_ca_pem" field in the mutation.
+func (m *MonitorMutation) TLSCaPem() (r string, exists bool) {
+	v := m.tls_ca_pem
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTLSCaPem returns the old "tls_ca_pem" field's value of the Monitor entity.
+// If the Monitor object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MonitorMutation) OldTLSCaPem(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTLSCaPem is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTLSCaPem requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTLSCaPem: %w", err)
+	}
+	return oldValue.TLSCaPem, nil
+}
+
+// ClearTLSCaPem clears the value of the "tls_ca_pem" field.
+func (m *MonitorMutation) ClearTLSCaPem() {
+	m.tls_ca_pem = nil
+	m.clearedFields[monitor.FieldTLSCaPem] = struct{}{}
+}
+
+// TLSCaPemCleared returns if the "tls_ca_pem" field was cleared in this mutation.
+func (m *MonitorMutation) TLSCaPemCleared() bool {
+	_, ok := m.clearedFields[monitor.FieldTLSCaPem]
+	return ok
+}
+
+// ResetTLSCaPem resets all changes to the "tls_ca_pem" field.
+func (m *MonitorMutation) ResetTLSCaPem() {
+	m.tls_ca_pem = nil
+	delete(m.clearedFields, monitor.FieldTLSCaPem)
+}
+
+// SetTLSInsecureSkipVerify sets the "tls_insecure_skip_verify" field.
+func (m *MonitorMutation) SetTLSInsecureSkipVerify(b bool) {
+	m.tls_insecure_skip_verify = &b
+}
+
+// TLSInsecureSkipVerify returns the value of the "tls_insecure_skip_verify" field in the mutation.
+func (m *MonitorMutation) TLSInsecureSkipVerify() (r bool, exists bool) {
+	v := m.tls_insecure_skip_verify
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTLSInsecureSkipVerify returns the old "tls_insecure_skip_verify" field's value of the Monitor entity.
+// If the Monitor object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MonitorMutation) OldTLSInsecureSkipVerify(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTLSInsecureSkipVerify is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTLSInsecureSkipVerify requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTLSInsecureSkipVerify: %w", err)
+	}
+	return oldValue.TLSInsecureSkipVerify, nil
+}
+
+// ResetTLSInsecureSkipVerify resets all changes to the "tls_insecure_skip_verify" field.
+func (m *MonitorMutation) ResetTLSInsecureSkipVerify() {
+	m.tls_insecure_skip_verify = nil
+}
+
+// SetTLSMinVersion sets the "tls_min_version" field.
+func (m *MonitorMutation) SetTLSMinVersion(s string) {
+	m.tls_min_version = &s
+}
+
+// TLSMinVersion returns the value of the "tls_min_version" field in the mutation.
+func (m *MonitorMutation) TLSMinVersion() (r string, exists bool) {
+	v := m.tls_min_version
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTLSMinVersion returns the old "tls_min_version" field's value of the Monitor entity.
+// If the Monitor object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MonitorMutation) OldTLSMinVersion(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTLSMinVersion is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTLSMinVersion requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTLSMinVersion: %w", err)
+	}
+	return oldValue.TLSMinVersion, nil
+}
+
+// ClearTLSMinVersion clears the value of the "tls_min_version" field.
+func (m *MonitorMutation) ClearTLSMinVersion() {
+	m.tls_min_version = nil
+	m.clearedFields[monitor.FieldTLSMinVersion] = struct{}{}
+}
+
+// TLSMinVersionCleared returns if the "tls_min_version" field was cleared in this mutation.
+func (m *MonitorMutation) TLSMinVersionCleared() bool {
+	_, ok := m.clearedFields[monitor.FieldTLSMinVersion]
+	return ok
+}
+
+// ResetTLSMinVersion resets all changes to the "tls_min_version" field.
+func (m *MonitorMutation) ResetTLSMinVersion() {
+	m.tls_min_version = nil
+	delete(m.clearedFields, monitor.FieldTLSMinVersion)
+}
+
+// SetTLSServerName sets the "tls_server_name" field.
+func (m *MonitorMutation) SetTLSServerName(s string) {
+	m.tls_server_name = &s
+}
+
+// TLSServerName returns the value of the "tls_server_name" field in the mutation.
+func (m *MonitorMutation) TLSServerName() (r string, exists bool) {
+	v := m.tls_server_name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTLSServerName returns the old "tls_server_name" field's value of the Monitor entity.
+// If the Monitor object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MonitorMutation) OldTLSServerName(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTLSServerName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTLSServerName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTLSServerName: %w", err)
+	}
+	return oldValue.TLSServerName, nil
+}
+
+// ClearTLSServerName clears the value of the "tls_server_name" field.
+func (m *MonitorMutation) ClearTLSServerName() {
+	m.tls_server_name = nil
+	m.clearedFields[monitor.FieldTLSServerName] = struct{}{}
+}
+
+// TLSServerNameCleared returns if the "tls_server_name" field was cleared in this mutation.
+func (m *MonitorMutation) TLSServerNameCleared() bool {
+	_, ok := m.clearedFields[monitor.FieldTLSServerName]
+	return ok
+}
+
+// ResetTLSServerName resets all changes to the "tls_server_name" field.
+func (m *MonitorMutation) ResetTLSServerName() {
+	m.tls_server_name = nil
+	delete(m.clearedFields, monitor.FieldTLSServerName)
+}
+
+// SetCron sets the "cron" field.
+func (m *MonitorMutation) SetCron(s string) {
+	m.cron = &s
+}
+
+// Cron returns the value of the "cron" field in the mutation.
+func (m *MonitorMutation) Cron() (r string, exists bool) {
+	v := m.cron
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCron returns the old "cron" field's value of the Monitor entity.
+// If the Monitor object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MonitorMutation) OldCron(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCron is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCron requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCron: %w", err)
+	}
+	return oldValue.Cron, nil
+}
+
+// ResetCron resets all changes to the "cron" field.
+func (m *MonitorMutation) ResetCron() {
+	m.cron = nil
+}
+
+// SetTimezone sets the "timezone" field.
+func (m *MonitorMutation) SetTimezone(s string) {
+	m.timezone = &s
+}
+
+// Timezone returns the value of the "timezone" field in the mutation.
+func (m *MonitorMutation) Timezone() (r string, exists bool) {
+	v := m.timezone
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTimezone returns the old "timezone" field's value of the Monitor entity.
+// If the Monitor object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MonitorMutation) OldTimezone(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTimezone is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTimezone requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTimezone: %w", err)
+	}
+	return oldValue.Timezone, nil
+}
+
+// ClearTimezone clears the value of the "timezone" field.
+func (m *MonitorMutation) ClearTimezone() {
+	m.timezone = nil
+	m.clearedFields[monitor.FieldTimezone] = struct{}{}
+}
+
+// TimezoneCleared returns if the "timezone" field was cleared in this mutation.
+func (m *MonitorMutation) TimezoneCleared() bool {
+	_, ok := m.clearedFields[monitor.FieldTimezone]
+	return ok
+}
+
+// ResetTimezone resets all changes to the "timezone" field.
+func (m *MonitorMutation) ResetTimezone() {
+	m.timezone = nil
+	delete(m.clearedFields, monitor.FieldTimezone)
+}
+
+// SetJitterSeconds sets the "jitter_seconds" field.
+func (m *MonitorMutation) SetJitterSeconds(i int) {
+	m.jitter_seconds = &i
+	m.addjitter_seconds = nil
+}
+
+// JitterSeconds returns the value of the "jitter_seconds" field in the mutation.
+func (m *MonitorMutation) JitterSeconds() (r int, exists bool) {
+	v := m.jitter_seconds
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldJitterSeconds returns the old "jitter_seconds" field's value of the Monitor entity.
+// If the Monitor object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MonitorMutation) OldJitterSeconds(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldJitterSeconds is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldJitterSeconds requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldJitterSeconds: %w", err)
+	}
+	return oldValue.JitterSeconds, nil
+}
+
+// AddJitterSeconds adds i to the "jitter_seconds" field.
+func (m *MonitorMutation) AddJitterSeconds(i int) {
+	if m.addjitter_seconds != nil {
+		*m.addjitter_seconds += i
+	} else {
+		m.addjitter_seconds = &i
+	}
+}
+
+// AddedJitterSeconds returns the value that was added to the "jitter_seconds" field in this mutation.
+func (m *MonitorMutation) AddedJitterSeconds() (r int, exists bool) {
+	v := m.addjitter_seconds
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearJitterSeconds clears the value of the "jitter_seconds" field.
+func (m *MonitorMutation) ClearJitterSeconds() {
+	m.jitter_seconds = nil
+	m.addjitter_seconds = nil
+	m.clearedFields[monitor.FieldJitterSeconds] = struct{}{}
+}
+
+// JitterSecondsCleared returns if the "jitter_seconds" field was cleared in this mutation.
+func (m *MonitorMutation) JitterSecondsCleared() bool {
+	_, ok := m.clearedFields[monitor.FieldJitterSeconds]
+	return ok
+}
+
+// ResetJitterSeconds resets all changes to the "jitter_seconds" field.
+func (m *MonitorMutation) ResetJitterSeconds() {
+	m.jitter_seconds = nil
+	m.addjitter_seconds = nil
+	delete(m.clearedFields, monitor.FieldJitterSeconds)
+}
+
+// SetDstPolicy sets the "dst_policy" field.
+func (m *MonitorMutation) SetDstPolicy(mp monitor.DstPolicy) {
+	m.dst_policy = &mp
+}
+
+// DstPolicy returns the value of the "dst_policy" field in the mutation.
+func (m *MonitorMutation) DstPolicy() (r monitor.DstPolicy, exists bool) {
+	v := m.dst_policy
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDstPolicy returns the old "dst_policy" field's value of the Monitor entity.
+// If the Monitor object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MonitorMutation) OldDstPolicy(ctx context.Context) (v monitor.DstPolicy, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDstPolicy is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDstPolicy requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDstPolicy: %w", err)
+	}
+	return oldValue.DstPolicy, nil
+}
+
+// ResetDstPolicy resets all changes to the "dst_policy" field.
+func (m *MonitorMutation) ResetDstPolicy() {
+	m.dst_policy = nil
+}
+
+// SetBodySnapshot sets the "body_snapshot" field.
+func (m *MonitorMutation) SetBodySnapshot(ms monitor.BodySnapshot) {
+	m.body_snapshot = &ms
+}
+
+// BodySnapshot returns the value of the "body_snapshot" field in the mutation.
+func (m *MonitorMutation) BodySnapshot() (r monitor.BodySnapshot, exists bool) {
+	v := m.body_snapshot
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBodySnapshot returns the old "body_snapshot" field's value of the Monitor entity.
+// If the Monitor object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MonitorMutation) OldBodySnapshot(ctx context.Context) (v monitor.BodySnapshot, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBodySnapshot is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBodySnapshot requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBodySnapshot: %w", err)
+	}
+	return oldValue.BodySnapshot, nil
+}
+
+// ResetBodySnapshot resets all changes to the "body_snapshot" field.
+func (m *MonitorMutation) ResetBodySnapshot() {
+	m.body_snapshot = nil
+}
+
+// SetContentHash sets the "content_hash" field.
+func (m *MonitorMutation) SetContentHash(mh monitor.ContentHash) {
+	m.content_hash = &mh
+}
+
+// ContentHash returns the value of the "content_hash" field in the mutation.
+func (m *MonitorMutation) ContentHash() (r monitor.ContentHash, exists bool) {
+	v := m.content_hash
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldContentHash returns the old "content_hash" field's value of the Monitor entity.
+// If the Monitor object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MonitorMutation) OldContentHash(ctx context.Context) (v monitor.ContentHash, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldContentHash is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldContentHash requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldContentHash: %w", err)
+	}
+	return oldValue.ContentHash, nil
+}
+
+// ResetContentHash resets all changes to the "content_hash" field.
+func (m *MonitorMutation) ResetContentHash() {
+	m.content_hash = nil
+}
+
+// SetFetchMode sets the "fetch_mode" field.
+func (m *MonitorMutation) SetFetchMode(mm monitor.FetchMode) {
+	m.fetch_mode = &mm
+}
+
+// FetchMode returns the value of the "fetch_mode" field in the mutation.
+func (m *MonitorMutation) FetchMode() (r monitor.FetchMode, exists bool) {
+	v := m.fetch_mode
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFetchMode returns the old "fetch_mode" field's value of the Monitor entity.
+// If the Monitor object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MonitorMutation) OldFetchMode(ctx context.Context) (v monitor.FetchMode, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFetchMode is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFetchMode requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFetchMode: %w", err)
+	}
+	return oldValue.FetchMode, nil
+}
+
+// ResetFetchMode resets all changes to the "fetch_mode" field.
+func (m *MonitorMutation) ResetFetchMode() {
+	m.fetch_mode = nil
+}
+
+// SetHeartbeatToken sets the "heartbeat_token" field.
+func (m *MonitorMutation) SetHeartbeatToken(s string) {
+	m.heartbeat_token = &s
+}
+
+// HeartbeatToken returns the value of the "heartbeat_token" field in the mutation.
+func (m *MonitorMutation) HeartbeatToken() (r string, exists bool) {
+	v := m.heartbeat_token
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldHeartbeatToken returns the old "heartbeat_token" field's value of the Monitor entity.
+// If the Monitor object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MonitorMutation) OldHeartbeatToken(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldHeartbeatToken is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldHeartbeatToken requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldHeartbeatToken: %w", err)
+	}
+	return oldValue.HeartbeatToken, nil
+}
+
+// ClearHeartbeatToken clears the value of the "heartbeat_token" field.
+func (m *MonitorMutation) ClearHeartbeatToken() {
+	m.heartbeat_token = nil
+	m.clearedFields[monitor.FieldHeartbeatToken] = struct{}{}
+}
+
+// HeartbeatTokenCleared returns if the "heartbeat_token" field was cleared in this mutation.
+func (m *MonitorMutation) HeartbeatTokenCleared() bool {
+	_, ok := m.clearedFields[monitor.FieldHeartbeatToken]
+	return ok
+}
+
+// ResetHeartbeatToken resets all changes to the "heartbeat_token" field.
+func (m *MonitorMutation) ResetHeartbeatToken() {
+	m.heartbeat_token = nil
+	delete(m.clearedFields, monitor.FieldHeartbeatToken)
+}
+
+// SetEnabled sets the "enabled" field.
+func (m *MonitorMutation) SetEnabled(b bool) {
+	m.enabled = &b
+}
+
+// Enabled returns the value of the "enabled" field in the mutation.
+func (m *MonitorMutation) Enabled() (r bool, exists bool) {
+	v := m.enabled
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEnabled returns the old "enabled" field's value of the Monitor entity.
+// If the Monitor object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MonitorMutation) OldEnabled(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEnabled is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEnabled requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEnabled: %w", err)
+	}
+	return oldValue.Enabled, nil
+}
+
+// ResetEnabled resets all changes to the "enabled" field.
+func (m *MonitorMutation) ResetEnabled() {
+	m.enabled = nil
+}
+
+// SetStatusPage sets the "status_page" field.
+func (m *MonitorMutation) SetStatusPage(b bool) {
+	m.status_page = &b
+}
+
+// StatusPage returns the value of the "status_page" field in the mutation.
+func (m *MonitorMutation) StatusPage() (r bool, exists bool) {
+	v := m.status_page
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatusPage returns the old "status_page" field's value of the Monitor entity.
+// If the Monitor object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MonitorMutation) OldStatusPage(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatusPage is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatusPage requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatusPage: %w", err)
+	}
+	return oldValue.StatusPage, nil
+}
+
+// ResetStatusPage resets all changes to the "status_page" field.
+func (m *MonitorMutation) ResetStatusPage() {
+	m.status_page = nil
+}
+
+// SetSortIndex sets the "sort_index" field.
+func (m *MonitorMutation) SetSortIndex(i int) {
+	m.sort_index = &i
+	m.addsort_index = nil
+}
+
+// SortIndex returns the value of the "sort_index" field in the mutation.
+func (m *MonitorMutation) SortIndex() (r int, exists bool) {
+	v := m.sort_index
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSortIndex returns the old "sort_index" field's value of the Monitor entity.
+// If the Monitor object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MonitorMutation) OldSortIndex(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSortIndex is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSortIndex requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSortIndex: %w", err)
+	}
+	return oldValue.SortIndex, nil
+}
+
+// AddSortIndex adds i to the "sort_index" field.
+func (m *MonitorMutation) AddSortIndex(i int) {
+	if m.addsort_index != nil {
+		*m.addsort_index += i
+	} else {
+		m.addsort_index = &i
+	}
+}
+
+// AddedSortIndex returns the value that was added to the "sort_index" field in this mutation.
+func (m *MonitorMutation) AddedSortIndex() (r int, exists bool) {
+	v := m.addsort_index
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetSortIndex resets all changes to the "sort_index" field.
+func (m *MonitorMutation) ResetSortIndex() {
+	m.sort_index = nil
+	m.addsort_index = nil
+}
+
+// SetArchivedAt sets the "archived_at" field.
+func (m *MonitorMutation) SetArchivedAt(t time.Time) {
+	m.archived_at = &t
+}
+
+// ArchivedAt returns the value of the "archived_at" field in the mutation.
+func (m *MonitorMutation) ArchivedAt() (r time.Time, exists bool) {
+	v := m.archived_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldArchivedAt returns the old "archived_at" field's value of the Monitor entity.
+// If the Monitor object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MonitorMutation) OldArchivedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldArchivedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldArchivedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldArchivedAt: %w", err)
+	}
+	return oldValue.ArchivedAt, nil
+}
+
+// ClearArchivedAt clears the value of the "archived_at" field.
+func (m *MonitorMutation) ClearArchivedAt() {
+	m.archived_at = nil
+	m.clearedFields[monitor.FieldArchivedAt] = struct{}{}
+}
+
+// ArchivedAtCleared returns if the "archived_at" field was cleared in this mutation.
+func (m *MonitorMutation) ArchivedAtCleared() bool {
+	_, ok := m.clearedFields[monitor.FieldArchivedAt]
+	return ok
+}
+
+// ResetArchivedAt resets all changes to the "archived_at" field.
+func (m *MonitorMutation) ResetArchivedAt() {
+	m.archived_at = nil
+	delete(m.clearedFields, monitor.FieldArchivedAt)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *MonitorMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *MonitorMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Monitor entity.
+// If the Monitor object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MonitorMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *MonitorMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *MonitorMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *MonitorMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the Monitor entity.
+// If the Monitor object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MonitorMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *MonitorMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// AddCheckResultIDs adds the "check_results" edge to the CheckResult entity by ids.
+func (m *MonitorMutation) AddCheckResultIDs(ids ...int) {
+	if m.check_results == nil {
+		m.check_results = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.check_results[ids[i]] = struct{}{}
+	}
+}
+
+// ClearCheckResults clears the "check_results" edge to the CheckResult entity.
+func (m *MonitorMutation) ClearCheckResults() {
+	m.clearedcheck_results = true
+}
+
+// CheckResultsCleared reports if the "check_results" edge to the CheckResult entity was cleared.
+func (m *MonitorMutation) CheckResultsCleared() bool {
+	return m.clearedcheck_results
+}
+
+// RemoveCheckResultIDs removes the "check_results" edge to the CheckResult entity by IDs.
+func (m *MonitorMutation) RemoveCheckResultIDs(ids ...int) {
+	if m.removedcheck_results == nil {
+		m.removedcheck_results = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.check_results, ids[i])
+		m.removedcheck_results[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedCheckResults returns the removed IDs of the "check_results" edge to the CheckResult entity.
+func (m *MonitorMutation) RemovedCheckResultsIDs() (ids []int) {
+	for id := range m.removedcheck_results {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// CheckResultsIDs returns the "check_results" edge IDs in the mutation.
+func (m *MonitorMutation) CheckResultsIDs() (ids []int) {
+	for id := range m.check_results {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetCheckResults resets all changes to the "check_results" edge.
+func (m *MonitorMutation) ResetCheckResults() {
+	m.check_results = nil
+	m.clearedcheck_results = false
+	m.removedcheck_results = nil
+}
+
+// AddNotificationEventIDs adds the "notification_events" edge to the NotificationEvent entity by ids.
+func (m *MonitorMutation) AddNotificationEventIDs(ids ...int) {
+	if m.notification_events == nil {
+		m.notification_events = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.notification_events[ids[i]] = struct{}{}
+	}
+}
+
+// ClearNotificationEvents clears the "notification_events" edge to the NotificationEvent entity.
+func (m *MonitorMutation) ClearNotificationEvents() {
+	m.clearednotification_events = true
+}
+
+// NotificationEventsCleared reports if the "notification_events" edge to the NotificationEvent entity was cleared.
+func (m *MonitorMutation) NotificationEventsCleared() bool {
+	return m.clearednotification_events
+}
+
+// RemoveNotificationEventIDs removes the "notification_events" edge to the NotificationEvent entity by IDs.
+func (m *MonitorMutation) RemoveNotificationEventIDs(ids ...int) {
+	if m.removednotification_events == nil {
+		m.removednotification_events = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.notification_events, ids[i])
+		m.removednotification_events[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedNotificationEvents returns the removed IDs of the "notification_events" edge to the NotificationEvent entity.
+func (m *MonitorMutation) RemovedNotificationEventsIDs() (ids []int) {
+	for id := range m.removednotification_events {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// NotificationEventsIDs returns the "notification_events" edge IDs in the mutation.
+func (m *MonitorMutation) NotificationEventsIDs() (ids []int) {
+	for id := range m.notification_events {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetNotificationEvents resets all changes to the "notification_events" edge.
+func (m *MonitorMutation) ResetNotificationEvents() {
+	m.notification_events = nil
+	m.clearednotification_events = false
+	m.removednotification_events = nil
+}
+
+// SetRuntimeID sets the "runtime" edge to the MonitorRuntime entity by id.
+func (m *MonitorMutation) SetRuntimeID(id int) {
+	m.runtime = &id
+}
+
+// ClearRuntime clears the "runtime" edge to the MonitorRuntime entity.
+func (m *MonitorMutation) ClearRuntime() {
+	m.clearedruntime = true
+}
+
+// RuntimeCleared reports if the "runtime" edge to the MonitorRuntime entity was cleared.
+func (m *MonitorMutation) RuntimeCleared() bool {
+	return m.clearedruntime
+}
+
+// RuntimeID returns the "runtime" edge ID in the mutation.
+func (m *MonitorMutation) RuntimeID() (id int, exists bool) {
+	if m.runtime != nil {
+		return *m.runtime, true
+	}
+	return
+}
+
+// RuntimeIDs returns the "runtime" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// RuntimeID instead. It exists only for internal usage by the builders.
+func (m *MonitorMutation) RuntimeIDs() (ids []int) {
+	if id := m.runtime; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetRuntime resets all changes to the "runtime" edge.
+func (m *MonitorMutation) ResetRuntime() {
+	m.runtime = nil
+	m.clearedruntime = false
+}
+
+// AddVersionIDs adds the "versions" edge to the MonitorVersion entity by ids.
+func (m *MonitorMutation) AddVersionIDs(ids ...int) {
+	if m.versions == nil {
+		m.versions = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.versions[ids[i]] = struct{}{}
+	}
+}
+
+// ClearVersions clears the "versions" edge to the MonitorVersion entity.
+func (m *MonitorMutation) ClearVersions() {
+	m.clearedversions = true
+}
+
+// VersionsCleared reports if the "versions" edge to the MonitorVersion entity was cleared.
+func (m *MonitorMutation) VersionsCleared() bool {
+	return m.clearedversions
+}
+
+// RemoveVersionIDs removes the "versions" edge to the MonitorVersion entity by IDs.
+func (m *MonitorMutation) RemoveVersionIDs(ids ...int) {
+	if m.removedversions == nil {
+		m.removedversions = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.versions, ids[i])
+		m.removedversions[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedVersions returns the removed IDs of the "versions" edge to the MonitorVersion entity.
+func (m *MonitorMutation) RemovedVersionsIDs() (ids []int) {
+	for id := range m.removedversions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// VersionsIDs returns the "versions" edge IDs in the mutation.
+func (m *MonitorMutation) VersionsIDs() (ids []int) {
+	for id := range m.versions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetVersions resets all changes to the "versions" edge.
+func (m *MonitorMutation) ResetVersions() {
+	m.versions = nil
+	m.clearedversions = false
+	m.removedversions = nil
+}
+
+// AddRollupIDs adds the "rollups" edge to the CheckRollup entity by ids.
+func (m *MonitorMutation) AddRollupIDs(ids ...int) {
+	if m.rollups == nil {
+		m.rollups = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.rollups[ids[i]] = struct{}{}
+	}
+}
+
+// ClearRollups clears the "rollups" edge to the CheckRollup entity.
+func (m *MonitorMutation) ClearRollups() {
+	m.clearedrollups = true
+}
+
+// RollupsCleared reports if the "rollups" edge to the CheckRollup entity was cleared.
+func (m *MonitorMutation) RollupsCleared() bool {
+	return m.clearedrollups
+}
+
+// RemoveRollupIDs removes the "rollups" edge to the CheckRollup entity by IDs.
+func (m *MonitorMutation) RemoveRollupIDs(ids ...int) {
+	if m.removedrollups == nil {
+		m.removedrollups = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.rollups, ids[i])
+		m.removedrollups[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedRollups returns the removed IDs of the "rollups" edge to the CheckRollup entity.
+func (m *MonitorMutation) RemovedRollupsIDs() (ids []int) {
+	for id := range m.removedrollups {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// RollupsIDs returns the "rollups" edge IDs in the mutation.
+func (m *MonitorMutation) RollupsIDs() (ids []int) {
+	for id := range m.rollups {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetRollups resets all changes to the "rollups" edge.
+func (m *MonitorMutation) ResetRollups() {
+	m.rollups = nil
+	m.clearedrollups = false
+	m.removedrollups = nil
+}
+
+// ClearHeaderProfile clears the "header_profile" edge to the HeaderProfile entity.
+func (m *MonitorMutation) ClearHeaderProfile() {
+	m.clearedheader_profile = true
+	m.clearedFields[monitor.FieldHeaderProfileID] = struct{}{}
+}
+
+// HeaderProfileCleared reports if the "header_profile" edge to the HeaderProfile entity was cleared.
+func (m *MonitorMutation) HeaderProfileCleared() bool {
+	return m.HeaderProfileIDCleared() || m.clearedheader_profile
+}
+
+// HeaderProfileIDs returns the "header_profile" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// HeaderProfileID instead. It exists only for internal usage by the builders.
+func (m *MonitorMutation) HeaderProfileIDs() (ids []int) {
+	if id := m.header_profile; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetHeaderProfile resets all changes to the "header_profile" edge.
+func (m *MonitorMutation) ResetHeaderProfile() {
+	m.header_profile = nil
+	m.clearedheader_profile = false
+}
+
+// Where appends a list predicates to the MonitorMutation builder.
+func (m *MonitorMutation) Where(ps ...predicate.Monitor) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the MonitorMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *MonitorMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Monitor, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *MonitorMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *MonitorMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Monitor).
+func (m *MonitorMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *MonitorMutation) Fields() []string {
+	fields := make([]string, 0, 51)
+	if m.label != nil {
+		fields = append(fields, monitor.FieldLabel)
+	}
+	if m.method != nil {
+		fields = append(fields, monitor.FieldMethod)
+	}
+	if m.url != nil {
+		fields = append(fields, monitor.FieldURL)
+	}
+	if m.icon_url != nil {
+		fields = append(fields, monitor.FieldIconURL)
+	}
+	if m.body != nil {
+		fields = append(fields, monitor.FieldBody)
+	}
+	if m.headers != nil {
+		fields = append(fields, monitor.FieldHeaders)
+	}
+	if m.user_agent != nil {
+		fields = append(fields, monitor.FieldUserAgent)
+	}
+	if m.header_profile != nil {
+		fields = append(fields, monitor.FieldHeaderProfileID)
+	}
+	if m.auth != nil {
+		fields = append(fields, monitor.FieldAuth)
+	}
+	if m.notification_channels != nil {
+		fields = append(fields, monitor.FieldNotificationChannels)
+	}
+	if m.escalation_channels != nil {
+		fields = append(fields, monitor.FieldEscalationChannels)
+	}
+	if m.tags != nil {
+		fields = append(fields, monitor.FieldTags)
+	}
+	if m.escalation_after_minutes != nil {
+		fields = append(fields, monitor.FieldEscalationAfterMinutes)
+	}
+	if m.selector != nil {
+		fields = append(fields, monitor.FieldSelector)
+	}
+	if m.expected_status != nil {
+		fields = append(fields, monitor.FieldExpectedStatus)
+	}
+	if m.retry_on != nil {
+		fields = append(fields, monitor.FieldRetryOn)
+	}
+	if m.expected_type != nil {
+		fields = append(fields, monitor.FieldExpectedType)
+	}
+	if m.expected_response != nil {
+		fields = append(fields, monitor.FieldExpectedResponse)
+	}
+	if m.must_contain != nil {
+		fields = append(fields, monitor.FieldMustContain)
+	}
+	if m.must_not_contain != nil {
+		fields = append(fields, monitor.FieldMustNotContain)
+	}
+	if m.treat_not_found_as_success != nil {
+		fields = append(fields, monitor.FieldTreatNotFoundAsSuccess)
+	}
+	if m.accept_empty_body != nil {
+		fields = append(fields, monitor.FieldAcceptEmptyBody)
+	}
+	if m.watchdog_minutes != nil {
+		fields = append(fields, monitor.FieldWatchdogMinutes)
+	}
+	if m.header_assertions != nil {
+		fields = append(fields, monitor.FieldHeaderAssertions)
+	}
+	if m.track_header != nil {
+		fields = append(fields, monitor.FieldTrackHeader)
+	}
+	if m.numeric_tolerance != nil {
+		fields = append(fields, monitor.FieldNumericTolerance)
+	}
+	if m.redirect_policy != nil {
+		fields = append(fields, monitor.FieldRedirectPolicy)
+	}
+	if m.max_redirects != nil {
+		fields = append(fields, monitor.FieldMaxRedirects)
+	}
+	if m.max_response_bytes != nil {
+		fields = append(fields, monitor.FieldMaxResponseBytes)
+	}
+	if m.checks_history_limit != nil {
+		fields = append(fields, monitor.FieldChecksHistoryLimit)
+	}
+	if m.cookie_jar != nil {
+		fields = append(fields, monitor.FieldCookieJar)
+	}
+	if m.host_overrides != nil {
+		fields = append(fields, monitor.FieldHostOverrides)
+	}
+	if m.ip_family != nil {
+		fields = append(fields, monitor.FieldIPFamily)
+	}
+	if m.tls_ca_pem != nil {
+		fields = append(fields, monitor.FieldTLSCaPem)
+	}
+	if m.tls_insecure_skip_verify != nil {
+		fields = append(fields, monitor.FieldTLSInsecureSkipVerify)
+	}
+	if m.tls_min_version != nil {
+		fields = append(fields, monitor.FieldTLSMinVersion)
+	}
+	if m.tls_server_name != nil {
+		fields = append(fields, monitor.FieldTLSServerName)
+	}
+	if m.cron != nil {
+		fields = append(fields, monitor.FieldCron)
+	}
+	if m.timezone != nil {
+		fields = append(fields, monitor.FieldTimezone)
+	}
+	if m.jitter_seconds != nil {
+		fields = append(fields, monitor.FieldJitterSeconds)
+	}
+	if m.dst_policy != nil {
+		fields = append(fields, monitor.FieldDstPolicy)
+	}
+	if m.body_snapshot != nil {
+		fields = append(fields, monitor.FieldBodySnapshot)
+	}
+	if m.content_hash != nil {
+		fields = append(fields, monitor.FieldContentHash)
+	}
+	if m.fetch_mode != nil {
+		fields = append(fields, monitor.FieldFetchMode)
+	}
+	if m.heartbeat_token != nil {
+		fields = append(fields, monitor.FieldHeartbeatToken)
+	}
+	if m.enabled != nil {
+		fields = append(fields, monitor.FieldEnabled)
+	}
+	if m.status_page != nil {
+		fields = append(fields, monitor.FieldStatusPage)
+	}
+	if m.sort_index != nil {
+		fields = append(fields, monitor.FieldSortIndex)
+	}
+	if m.archived_at != nil {
+		fields = append(fields, monitor.FieldArchivedAt)
+	}
+	if m.created_at != nil {
+		fields = append(fields, monitor.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, monitor.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *MonitorMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case monitor.FieldLabel:
+		return m.Label()
+	case monitor.FieldMethod:
+		return m.Method()
+	case monitor.FieldURL:
+		return m.URL()
+	case monitor.FieldIconURL:
+		return m.IconURL()
+	case monitor.FieldBody:
+		return m.Body()
+	case monitor.FieldHeaders:
+		return m.Headers()
+	case monitor.FieldUserAgent:
+		return m.UserAgent()
+	case monitor.FieldHeaderProfileID:
+		return m.HeaderProfileID()
+	case monitor.FieldAuth:
+		return m.Auth()
+	case monitor.FieldNotificationChannels:
+		return m.NotificationChannels()
+	case monitor.FieldEscalationChannels:
+		return m.EscalationChannels()
+	case monitor.FieldTags:
+		return m.Tags()
+	case monitor.FieldEscalationAfterMinutes:
+		return m.EscalationAfterMinutes()
+	case monitor.FieldSelector:
+		return m.Selector()
+	case monitor.FieldExpectedStatus:
+		return m.ExpectedStatus()
+	case monitor.FieldRetryOn:
+		return m.RetryOn()
+	case monitor.FieldExpectedType:
+		return m.ExpectedType()
+	case monitor.FieldExpectedResponse:
+		return m.ExpectedResponse()
+	case monitor.FieldMustContain:
+		return m.MustContain()
+	case monitor.FieldMustNotContain:
+		return m.MustNotContain()
+	case monitor.FieldTreatNotFoundAsSuccess:
+		return m.TreatNotFoundAsSuccess()
+	case monitor.FieldAcceptEmptyBody:
+		return m.AcceptEmptyBody()
+	case monitor.FieldWatchdogMinutes:
+		return m.WatchdogMinutes()
+	case monitor.FieldHeaderAssertions:
+		return m.HeaderAssertions()
+	case monitor.FieldTrackHeader:
+		return m.TrackHeader()
+	case monitor.FieldNumericTolerance:
+		return m.NumericTolerance()
+	case monitor.FieldRedirectPolicy:
+		return m.RedirectPolicy()
+	case monitor.FieldMaxRedirects:
+		return m.MaxRedirects()
+	case monitor.FieldMaxResponseBytes:
+		return m.MaxResponseBytes()
+	case monitor.FieldChecksHistoryLimit:
+		return m.ChecksHistoryLimit()
+	case monitor.FieldCookieJar:
+		return m.CookieJar()
+	case monitor.FieldHostOverrides:
+		return m.HostOverrides()
+	case monitor.FieldIPFamily:
+		return m.IPFamily()
+	case monitor.FieldTLSCaPem:
+		return m.TLSCaPem()
+	case monitor.FieldTLSInsecureSkipVerify:
+		return m.TLSInsecureSkipVerify()
+	case monitor.FieldTLSMinVersion:
+		return m.TLSMinVersion()
+	case monitor.FieldTLSServerName:
+		return m.TLSServerName()
+	case monitor.FieldCron:
+		return m.Cron()
+	case monitor.FieldTimezone:
+		return m.Timezone()
+	case monitor.FieldJitterSeconds:
+		return m.JitterSeconds()
+	case monitor.FieldDstPolicy:
+		return m.DstPolicy()
+	case monitor.FieldBodySnapshot:
+		return m.BodySnapshot()
+	case monitor.FieldContentHash:
+		return m.ContentHash()
+	case monitor.FieldFetchMode:
+		return m.FetchMode()
+	case monitor.FieldHeartbeatToken:
+		return m.HeartbeatToken()
+	case monitor.FieldEnabled:
+		return m.Enabled()
+	case monitor.FieldStatusPage:
+		return m.StatusPage()
+	case monitor.FieldSortIndex:
+		return m.SortIndex()
+	case monitor.FieldArchivedAt:
+		return m.ArchivedAt()
+	case monitor.FieldCreatedAt:
+		return m.CreatedAt()
+	case monitor.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *MonitorMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case monitor.FieldLabel:
+		return m.OldLabel(ctx)
+	case monitor.FieldMethod:
+		return m.OldMethod(ctx)
+	case monitor.FieldURL:
+		return m.OldURL(ctx)
+	case monitor.FieldIconURL:
+		return m.OldIconURL(ctx)
+	case monitor.FieldBody:
+		return m.OldBody(ctx)
+	case monitor.FieldHeaders:
+		return m.OldHeaders(ctx)
+	case monitor.FieldUserAgent:
+		return m.OldUserAgent(ctx)
+	case monitor.FieldHeaderProfileID:
+		return m.OldHeaderProfileID(ctx)
+	case monitor.FieldAuth:
+		return m.OldAuth(ctx)
+	case monitor.FieldNotificationChannels:
+		return m.OldNotificationChannels(ctx)
+	case monitor.FieldEscalationChannels:
+		return m.OldEscalationChannels(ctx)
+	case monitor.FieldTags:
+		return m.OldTags(ctx)
+	case monitor.FieldEscalationAfterMinutes:
+		return m.OldEscalationAfterMinutes(ctx)
+	case monitor.FieldSelector:
+		return m.OldSelector(ctx)
+	case monitor.FieldExpectedStatus:
+		return m.OldExpectedStatus(ctx)
+	case monitor.FieldRetryOn:
+		return m.OldRetryOn(ctx)
+	case monitor.FieldExpectedType:
+		return m.OldExpectedType(ctx)
+	case monitor.FieldExpectedResponse:
+		return m.OldExpectedResponse(ctx)
+	case monitor.FieldMustContain:
+		return m.OldMustContain(ctx)
+	case monitor.FieldMustNotContain:
+		return m.OldMustNotContain(ctx)
+	case monitor.FieldTreatNotFoundAsSuccess:
+		return m.OldTreatNotFoundAsSuccess(ctx)
+	case monitor.FieldAcceptEmptyBody:
+		return m.OldAcceptEmptyBody(ctx)
+	case monitor.FieldWatchdogMinutes:
+		return m.OldWatchdogMinutes(ctx)
+	case monitor.FieldHeaderAssertions:
+		return m.OldHeaderAssertions(ctx)
+	case monitor.FieldTrackHeader:
+		return m.OldTrackHeader(ctx)
+	case monitor.FieldNumericTolerance:
+		return m.OldNumericTolerance(ctx)
+	case monitor.FieldRedirectPolicy:
+		return m.OldRedirectPolicy(ctx)
+	case monitor.FieldMaxRedirects:
+		return m.OldMaxRedirects(ctx)
+	case monitor.FieldMaxResponseBytes:
+		return m.OldMaxResponseBytes(ctx)
+	case monitor.FieldChecksHistoryLimit:
+		return m.OldChecksHistoryLimit(ctx)
+	case monitor.FieldCookieJar:
+		return m.OldCookieJar(ctx)
+	case monitor.FieldHostOverrides:
+		return m.OldHostOverrides(ctx)
+	case monitor.FieldIPFamily:
+		return m.OldIPFamily(ctx)
+	case monitor.FieldTLSCaPem:
+		return m.OldTLSCaPem(ctx)
+	case monitor.FieldTLSInsecureSkipVerify:
+		return m.OldTLSInsecureSkipVerify(ctx)
+	case monitor.FieldTLSMinVersion:
+		return m.OldTLSMinVersion(ctx)
+	case monitor.FieldTLSServerName:
+		return m.OldTLSServerName(ctx)
+	case monitor.FieldCron:
+		return m.OldCron(ctx)
+	case monitor.FieldTimezone:
+		return m.OldTimezone(ctx)
+	case monitor.FieldJitterSeconds:
+		return m.OldJitterSeconds(ctx)
+	case monitor.FieldDstPolicy:
+		return m.OldDstPolicy(ctx)
+	case monitor.FieldBodySnapshot:
+		return m.OldBodySnapshot(ctx)
+	case monitor.FieldContentHash:
+		return m.OldContentHash(ctx)
+	case monitor.FieldFetchMode:
+		return m.OldFetchMode(ctx)
+	case monitor.FieldHeartbeatToken:
+		return m.OldHeartbeatToken(ctx)
+	case monitor.FieldEnabled:
+		return m.OldEnabled(ctx)
+	case monitor.FieldStatusPage:
+		return m.OldStatusPage(ctx)
+	case monitor.FieldSortIndex:
+		return m.OldSortIndex(ctx)
+	case monitor.FieldArchivedAt:
+		return m.OldArchivedAt(ctx)
+	case monitor.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case monitor.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Monitor field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *MonitorMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case monitor.FieldLabel:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLabel(v)
+		return nil
+	case monitor.FieldMethod:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMethod(v)
+		return nil
+	case monitor.FieldURL:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetURL(v)
+		return nil
+	case monitor.FieldIconURL:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIconURL(v)
+		return nil
+	case monitor.FieldBody:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBody(v)
+		return nil
+	case monitor.FieldHeaders:
+		v, ok := value.(map[string]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetHeaders(v)
+		return nil
+	case monitor.FieldUserAgent:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserAgent(v)
+		return nil
+	case monitor.FieldHeaderProfileID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetHeaderProfileID(v)
+		return nil
+	case monitor.FieldAuth:
+		v, ok := value.(map[string]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAuth(v)
+		return nil
+	case monitor.FieldNotificationChannels:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNotificationChannels(v)
+		return nil
+	case monitor.FieldEscalationChannels:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEscalationChannels(v)
+		return nil
+	case monitor.FieldTags:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTags(v)
+		return nil
+	case monitor.FieldEscalationAfterMinutes:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEscalationAfterMinutes(v)
+		return nil
+	case monitor.FieldSelector:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSelector(v)
+		return nil
+	case monitor.FieldExpectedStatus:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExpectedStatus(v)
+		return nil
+	case monitor.FieldRetryOn:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRetryOn(v)
+		return nil
+	case monitor.FieldExpectedType:
+		v, ok := value.(monitor.ExpectedType)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExpectedType(v)
+		return nil
+	case monitor.FieldExpectedResponse:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExpectedResponse(v)
+		return nil
+	case monitor.FieldMustContain:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMustContain(v)
+		return nil
+	case monitor.FieldMustNotContain:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMustNotContain(v)
+		return nil
+	case monitor.FieldTreatNotFoundAsSuccess:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTreatNotFoundAsSuccess(v)
+		return nil
+	case monitor.FieldAcceptEmptyBody:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAcceptEmptyBody(v)
+		return nil
+	case monitor.FieldWatchdogMinutes:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetWatchdogMinutes(v)
+		return nil
+	case monitor.FieldHeaderAssertions:
+		v, ok := value.(map[string]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetHeaderAssertions(v)
+		return nil
+	case monitor.FieldTrackHeader:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTrackHeader(v)
+		return nil
+	case monitor.FieldNumericTolerance:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNumericTolerance(v)
+		return nil
+	case monitor.FieldRedirectPolicy:
+		v, ok := value.(monitor.RedirectPolicy)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRedirectPolicy(v)
+		return nil
+	case monitor.FieldMaxRedirects:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMaxRedirects(v)
+		return nil
+	case monitor.FieldMaxResponseBytes:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMaxResponseBytes(v)
+		return nil
+	case monitor.FieldChecksHistoryLimit:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetChecksHistoryLimit(v)
+		return nil
+	case monitor.FieldCookieJar:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCookieJar(v)
+		return nil
+	case monitor.FieldHostOverrides:
+		v, ok := value.(map[string]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetHostOverrides(v)
+		return nil
+	case monitor.FieldIPFamily:
+		v, ok := value.(monitor.IPFamily)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIPFamily(v)
+		return nil
+	case monitor.FieldTLSCaPem:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTLSCaPem(v)
+		return nil
+	case monitor.FieldTLSInsecureSkipVerify:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTLSInsecureSkipVerify(v)
+		return nil
+	case monitor.FieldTLSMinVersion:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTLSMinVersion(v)
+		return nil
+	case monitor.FieldTLSServerName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTLSServerName(v)
+		return nil
+	case monitor.FieldCron:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCron(v)
+		return nil
+	case monitor.FieldTimezone:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTimezone(v)
+		return nil
+	case monitor.FieldJitterSeconds:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetJitterSeconds(v)
+		return nil
+	case monitor.FieldDstPolicy:
+		v, ok := value.(monitor.DstPolicy)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDstPolicy(v)
+		return nil
+	case monitor.FieldBodySnapshot:
+		v, ok := value.(monitor.BodySnapshot)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBodySnapshot(v)
+		return nil
+	case monitor.FieldContentHash:
+		v, ok := value.(monitor.ContentHash)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetContentHash(v)
+		return nil
+	case monitor.FieldFetchMode:
+		v, ok := value.(monitor.FetchMode)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFetchMode(v)
+		return nil
+	case monitor.FieldHeartbeatToken:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetHeartbeatToken(v)
+		return nil
+	case monitor.FieldEnabled:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEnabled(v)
+		return nil
+	case monitor.FieldStatusPage:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatusPage(v)
+		return nil
+	case monitor.FieldSortIndex:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSortIndex(v)
+		return nil
+	case monitor.FieldArchivedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetArchivedAt(v)
+		return nil
+	case monitor.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case monitor.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Monitor field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *MonitorMutation) AddedFields() []string {
+	var fields []string
+	if m.addescalation_after_minutes != nil {
+		fields = append(fields, monitor.FieldEscalationAfterMinutes)
+	}
+	if m.addwatchdog_minutes != nil {
+		fields = append(fields, monitor.FieldWatchdogMinutes)
+	}
+	if m.addnumeric_tolerance != nil {
+		fields = append(fields, monitor.FieldNumericTolerance)
+	}
+	if m.addmax_redirects != nil {
+		fields = append(fields, monitor.FieldMaxRedirects)
+	}
+	if m.addmax_response_bytes != nil {
+		fields = append(fields, monitor.FieldMaxResponseBytes)
+	}
+	if m.addchecks_history_limit != nil {
+		fields = append(fields, monitor.FieldChecksHistoryLimit)
+	}
+	if m.addjitter_seconds != nil {
+		fields = append(fields, monitor.FieldJitterSeconds)
+	}
+	if m.addsort_index != nil {
+		fields = append(fields, monitor.FieldSortIndex)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *MonitorMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case monitor.FieldEscalationAfterMinutes:
+		return m.AddedEscalationAfterMinutes()
+	case monitor.FieldWatchdogMinutes:
+		return m.AddedWatchdogMinutes()
+	case monitor.FieldNumericTolerance:
+		return m.AddedNumericTolerance()
+	case monitor.FieldMaxRedirects:
+		return m.AddedMaxRedirects()
+	case monitor.FieldMaxResponseBytes:
+		return m.AddedMaxResponseBytes()
+	case monitor.FieldChecksHistoryLimit:
+		return m.AddedChecksHistoryLimit()
+	case monitor.FieldJitterSeconds:
+		return m.AddedJitterSeconds()
+	case monitor.FieldSortIndex:
+		return m.AddedSortIndex()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *MonitorMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case monitor.FieldEscalationAfterMinutes:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddEscalationAfterMinutes(v)
+		return nil
+	case monitor.FieldWatchdogMinutes:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddWatchdogMinutes(v)
+		return nil
+	case monitor.FieldNumericTolerance:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddNumericTolerance(v)
+		return nil
+	case monitor.FieldMaxRedirects:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddMaxRedirects(v)
+		return nil
+	case monitor.FieldMaxResponseBytes:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddMaxResponseBytes(v)
+		return nil
+	case monitor.FieldChecksHistoryLimit:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddChecksHistoryLimit(v)
+		return nil
+	case monitor.FieldJitterSeconds:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddJitterSeconds(v)
+		return nil
+	case monitor.FieldSortIndex:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddSortIndex(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Monitor numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *MonitorMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(monitor.FieldLabel) {
+		fields = append(fields, monitor.FieldLabel)
+	}
+	if m.FieldCleared(monitor.FieldIconURL) {
+		fields = append(fields, monitor.FieldIconURL)
+	}
+	if m.FieldCleared(monitor.FieldBody) {
+		fields = append(fields, monitor.FieldBody)
+	}
+	if m.FieldCleared(monitor.FieldHeaders) {
+		fields = append(fields, monitor.FieldHeaders)
+	}
+	if m.FieldCleared(monitor.FieldUserAgent) {
+		fields = append(fields, monitor.FieldUserAgent)
+	}
+	if m.FieldCleared(monitor.FieldHeaderProfileID) {
+		fields = append(fields, monitor.FieldHeaderProfileID)
+	}
+	if m.FieldCleared(monitor.FieldAuth) {
+		fields = append(fields, monitor.FieldAuth)
+	}
+	if m.FieldCleared(monitor.FieldNotificationChannels) {
+		fields = append(fields, monitor.FieldNotificationChannels)
+	}
+	if m.FieldCleared(monitor.FieldEscalationChannels) {
+		fields = append(fields, monitor.FieldEscalationChannels)
+	}
+	if m.FieldCleared(monitor.FieldTags) {
+		fields = append(fields, monitor.FieldTags)
+	}
+	if m.FieldCleared(monitor.FieldEscalationAfterMinutes) {
+		fields = append(fields, monitor.FieldEscalationAfterMinutes)
+	}
+	if m.FieldCleared(monitor.FieldSelector) {
+		fields = append(fields, monitor.FieldSelector)
+	}
+	if m.FieldCleared(monitor.FieldExpectedStatus) {
+		fields = append(fields, monitor.FieldExpectedStatus)
+	}
+	if m.FieldCleared(monitor.FieldRetryOn) {
+		fields = append(fields, monitor.FieldRetryOn)
+	}
+	if m.FieldCleared(monitor.FieldExpectedResponse) {
+		fields = append(fields, monitor.FieldExpectedResponse)
+	}
+	if m.FieldCleared(monitor.FieldMustContain) {
+		fields = append(fields, monitor.FieldMustContain)
+	}
+	if m.FieldCleared(monitor.FieldMustNotContain) {
+		fields = append(fields, monitor.FieldMustNotContain)
+	}
+	if m.FieldCleared(monitor.FieldWatchdogMinutes) {
+		fields = append(fields, monitor.FieldWatchdogMinutes)
+	}
+	if m.FieldCleared(monitor.FieldHeaderAssertions) {
+		fields = append(fields, monitor.FieldHeaderAssertions)
+	}
+	if m.FieldCleared(monitor.FieldTrackHeader) {
+		fields = append(fields, monitor.FieldTrackHeader)
+	}
+	if m.FieldCleared(monitor.FieldNumericTolerance) {
+		fields = append(fields, monitor.FieldNumericTolerance)
+	}
+	if m.FieldCleared(monitor.FieldMaxRedirects) {
+		fields = append(fields, monitor.FieldMaxRedirects)
+	}
+	if m.FieldCleared(monitor.FieldMaxResponseBytes) {
+		fields = append(fields, monitor.FieldMaxResponseBytes)
+	}
+	if m.FieldCleared(monitor.FieldChecksHistoryLimit) {
+		fields = append(fields, monitor.FieldChecksHistoryLimit)
+	}
+	if m.FieldCleared(monitor.FieldHostOverrides) {
+		fields = append(fields, monitor.FieldHostOverrides)
+	}
+	if m.FieldCleared(monitor.FieldTLSCaPem) {
+		fields = append(fields, monitor.FieldTLSCaPem)
+	}
+	if m.FieldCleared(monitor.FieldTLSMinVersion) {
+		fields = append(fields, monitor.FieldTLSMinVersion)
+	}
+	if m.FieldCleared(monitor.FieldTLSServerName) {
+		fields = append(fields, monitor.FieldTLSServerName)
+	}
+	if m.FieldCleared(monitor.FieldTimezone) {
+		fields = append(fields, monitor.FieldTimezone)
+	}
+	if m.FieldCleared(monitor.FieldJitterSeconds) {
+		fields = append(fields, monitor.FieldJitterSeconds)
+	}
+	if m.FieldCleared(monitor.FieldHeartbeatToken) {
+		fields = append(fields, monitor.FieldHeartbeatToken)
+	}
+	if m.FieldCleared(monitor.FieldArchivedAt) {
+		fields = append(fields, monitor.FieldArchivedAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *MonitorMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *MonitorMutation) ClearField(name string) error {
+	switch name {
+	case monitor.FieldLabel:
+		m.ClearLabel()
+		return nil
+	case monitor.FieldIconURL:
+		m.ClearIconURL()
+		return nil
+	case monitor.FieldBody:
+		m.ClearBody()
+		return nil
+	case monitor.FieldHeaders:
+		m.ClearHeaders()
+		return nil
+	case monitor.FieldUserAgent:
+		m.ClearUserAgent()
+		return nil
+	case monitor.FieldHeaderProfileID:
+		m.ClearHeaderProfileID()
+		return nil
+	case monitor.FieldAuth:
+		m.ClearAuth()
+		return nil
+	case monitor.FieldNotificationChannels:
+		m.ClearNotificationChannels()
+		return nil
+	case monitor.FieldEscalationChannels:
+		m.ClearEscalationChannels()
+		return nil
+	case monitor.FieldTags:
+		m.ClearTags()
+		return nil
+	case monitor.FieldEscalationAfterMinutes:
+		m.ClearEscalationAfterMinutes()
+		return nil
+	case monitor.FieldSelector:
+		m.ClearSelector()
+		return nil
+	case monitor.FieldExpectedStatus:
+		m.ClearExpectedStatus()
+		return nil
+	case monitor.FieldRetryOn:
+		m.ClearRetryOn()
+		return nil
+	case monitor.FieldExpectedResponse:
+		m.ClearExpectedResponse()
+		return nil
+	case monitor.FieldMustContain:
+		m.ClearMustContain()
+		return nil
+	case monitor.FieldMustNotContain:
+		m.ClearMustNotContain()
+		return nil
+	case monitor.FieldWatchdogMinutes:
+		m.ClearWatchdogMinutes()
+		return nil
+	case monitor.FieldHeaderAssertions:
+		m.ClearHeaderAssertions()
+		return nil
+	case monitor.FieldTrackHeader:
+		m.ClearTrackHeader()
+		return nil
+	case monitor.FieldNumericTolerance:
+		m.ClearNumericTolerance()
+		return nil
+	case monitor.FieldMaxRedirects:
+		m.ClearMaxRedirects()
+		return nil
+	case monitor.FieldMaxResponseBytes:
+		m.ClearMaxResponseBytes()
+		return nil
+	case monitor.FieldChecksHistoryLimit:
+		m.ClearChecksHistoryLimit()
+		return nil
+	case monitor.FieldHostOverrides:
+		m.ClearHostOverrides()
+		return nil
+	case monitor.FieldTLSCaPem:
+		m.ClearTLSCaPem()
+		return nil
+	case monitor.FieldTLSMinVersion:
+		m.ClearTLSMinVersion()
+		return nil
+	case monitor.FieldTLSServerName:
+		m.ClearTLSServerName()
+		return nil
+	case monitor.FieldTimezone:
+		m.ClearTimezone()
+		return nil
+	case monitor.FieldJitterSeconds:
+		m.ClearJitterSeconds()
+		return nil
+	case monitor.FieldHeartbeatToken:
+		m.ClearHeartbeatToken()
+		return nil
+	case monitor.FieldArchivedAt:
+		m.ClearArchivedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Monitor nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *MonitorMutation) ResetField(name string) error {
+	switch name {
+	case monitor.FieldLabel:
+		m.ResetLabel()
+		return nil
+	case monitor.FieldMethod:
+		m.ResetMethod()
+		return nil
+	case monitor.FieldURL:
+		m.ResetURL()
+		return nil
+	case monitor.FieldIconURL:
+		m.ResetIconURL()
+		return nil
+	case monitor.FieldBody:
+		m.ResetBody()
+		return nil
+	case monitor.FieldHeaders:
+		m.ResetHeaders()
+		return nil
+	case monitor.FieldUserAgent:
+		m.ResetUserAgent()
+		return nil
+	case monitor.FieldHeaderProfileID:
+		m.ResetHeaderProfileID()
+		return nil
+	case monitor.FieldAuth:
+		m.ResetAuth()
+		return nil
+	case monitor.FieldNotificationChannels:
+		m.ResetNotificationChannels()
+		return nil
+	case monitor.FieldEscalationChannels:
+		m.ResetEscalationChannels()
+		return nil
+	case monitor.FieldTags:
+		m.ResetTags()
+		return nil
+	case monitor.FieldEscalationAfterMinutes:
+		m.ResetEscalationAfterMinutes()
+		return nil
+	case monitor.FieldSelector:
+		m.ResetSelector()
+		return nil
+	case monitor.FieldExpectedStatus:
+		m.ResetExpectedStatus()
+		return nil
+	case monitor.FieldRetryOn:
+		m.ResetRetryOn()
+		return nil
+	case monitor.FieldExpectedType:
+		m.ResetExpectedType()
+		return nil
+	case monitor.FieldExpectedResponse:
+		m.ResetExpectedResponse()
+		return nil
+	case monitor.FieldMustContain:
+		m.ResetMustContain()
+		return nil
+	case monitor.FieldMustNotContain:
+		m.ResetMustNotContain()
+		return nil
+	case monitor.FieldTreatNotFoundAsSuccess:
+		m.ResetTreatNotFoundAsSuccess()
+		return nil
+	case monitor.FieldAcceptEmptyBody:
+		m.ResetAcceptEmptyBody()
+		return nil
+	case monitor.FieldWatchdogMinutes:
+		m.ResetWatchdogMinutes()
+		return nil
+	case monitor.FieldHeaderAssertions:
+		m.ResetHeaderAssertions()
+		return nil
+	case monitor.FieldTrackHeader:
+		m.ResetTrackHeader()
+		return nil
+	case monitor.FieldNumericTolerance:
+		m.ResetNumericTolerance()
+		return nil
+	case monitor.FieldRedirectPolicy:
+		m.ResetRedirectPolicy()
+		return nil
+	case monitor.FieldMaxRedirects:
+		m.ResetMaxRedirects()
+		return nil
+	case monitor.FieldMaxResponseBytes:
+		m.ResetMaxResponseBytes()
+		return nil
+	case monitor.FieldChecksHistoryLimit:
+		m.ResetChecksHistoryLimit()
+		return nil
+	case monitor.FieldCookieJar:
+		m.ResetCookieJar()
+		return nil
+	case monitor.FieldHostOverrides:
+		m.ResetHostOverrides()
+		return nil
+	case monitor.FieldIPFamily:
+		m.ResetIPFamily()
+		return nil
+	case monitor.FieldTLSCaPem:
+		m.ResetTLSCaPem()
+		return nil
+	case monitor.FieldTLSInsecureSkipVerify:
+		m.ResetTLSInsecureSkipVerify()
+		return nil
+	case monitor.FieldTLSMinVersion:
+		m.ResetTLSMinVersion()
+		return nil
+	case monitor.FieldTLSServerName:
+		m.ResetTLSServerName()
+		return nil
+	case monitor.FieldCron:
+		m.ResetCron()
+		return nil
+	case monitor.FieldTimezone:
+		m.ResetTimezone()
+		return nil
+	case monitor.FieldJitterSeconds:
+		m.ResetJitterSeconds()
+		return nil
+	case monitor.FieldDstPolicy:
+		m.ResetDstPolicy()
+		return nil
+	case monitor.FieldBodySnapshot:
+		m.ResetBodySnapshot()
+		return nil
+	case monitor.FieldContentHash:
+		m.ResetContentHash()
+		return nil
+	case monitor.FieldFetchMode:
+		m.ResetFetchMode()
+		return nil
+	case monitor.FieldHeartbeatToken:
+		m.ResetHeartbeatToken()
+		return nil
+	case monitor.FieldEnabled:
+		m.ResetEnabled()
+		return nil
+	case monitor.FieldStatusPage:
+		m.ResetStatusPage()
+		return nil
+	case monitor.FieldSortIndex:
+		m.ResetSortIndex()
+		return nil
+	case monitor.FieldArchivedAt:
+		m.ResetArchivedAt()
+		return nil
+	case monitor.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case monitor.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Monitor field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *MonitorMutation) AddedEdges() []string {
+	edges := make([]string, 0, 6)
+	if m.check_results != nil {
+		edges = append(edges, monitor.EdgeCheckResults)
+	}
+	if m.notification_events != nil {
+		edges = append(edges, monitor.EdgeNotificationEvents)
+	}
+	if m.runtime != nil {
+		edges = append(edges, monitor.EdgeRuntime)
+	}
+	if m.versions != nil {
+		edges = append(edges, monitor.EdgeVersions)
+	}
+	if m.rollups != nil {
+		edges = append(edges, monitor.EdgeRollups)
+	}
+	if m.header_profile != nil {
+		edges = append(edges, monitor.EdgeHeaderProfile)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *MonitorMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case monitor.EdgeCheckResults:
+		ids := make([]ent.Value, 0, len(m.check_results))
+		for id := range m.check_results {
+			ids = append(ids, id)
+		}
+		return ids
+	case monitor.EdgeNotificationEvents:
+		ids := make([]ent.Value, 0, len(m.notification_events))
+		for id := range m.notification_events {
+			ids = append(ids, id)
+		}
+		return ids
+	case monitor.EdgeRuntime:
+		if id := m.runtime; id != nil {
+			return []ent.Value{*id}
+		}
+	case monitor.EdgeVersions:
+		ids := make([]ent.Value, 0, len(m.versions))
+		for id := range m.versions {
+			ids = append(ids, id)
+		}
+		return ids
+	case monitor.EdgeRollups:
+		ids := make([]ent.Value, 0, len(m.rollups))
+		for id := range m.rollups {
+			ids = append(ids, id)
+		}
+		return ids
+	case monitor.EdgeHeaderProfile:
+		if id := m.header_profile; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *MonitorMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 6)
+	if m.removedcheck_results != nil {
+		edges = append(edges, monitor.EdgeCheckResults)
+	}
+	if m.removednotification_events != nil {
+		edges = append(edges, monitor.EdgeNotificationEvents)
+	}
+	if m.removedversions != nil {
+		edges = append(edges, monitor.EdgeVersions)
+	}
+	if m.removedrollups != nil {
+		edges = append(edges, monitor.EdgeRollups)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *MonitorMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case monitor.EdgeCheckResults:
+		ids := make([]ent.Value, 0, len(m.removedcheck_results))
+		for id := range m.removedcheck_results {
+			ids = append(ids, id)
+		}
+		return ids
+	case monitor.EdgeNotificationEvents:
+		ids := make([]ent.Value, 0, len(m.removednotification_events))
+		for id := range m.removednotification_events {
+			ids = append(ids, id)
+		}
+		return ids
+	case monitor.EdgeVersions:
+		ids := make([]ent.Value, 0, len(m.removedversions))
+		for id := range m.removedversions {
+			ids = append(ids, id)
+		}
+		return ids
+	case monitor.EdgeRollups:
+		ids := make([]ent.Value, 0, len(m.removedrollups))
+		for id := range m.removedrollups {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *MonitorMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 6)
+	if m.clearedcheck_results {
+		edges = append(edges, monitor.EdgeCheckResults)
+	}
+	if m.clearednotification_events {
+		edges = append(edges, monitor.EdgeNotificationEvents)
+	}
+	if m.clearedruntime {
+		edges = append(edges, monitor.EdgeRuntime)
+	}
+	if m.clearedversions {
+		edges = append(edges, monitor.EdgeVersions)
+	}
+	if m.clearedrollups {
+		edges = append(edges, monitor.EdgeRollups)
+	}
+	if m.clearedheader_profile {
+		edges = append(edges, monitor.EdgeHeaderProfile)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *MonitorMutation) EdgeCleared(name string) bool {
+	switch name {
+	case monitor.EdgeCheckResults:
+		return m.clearedcheck_results
+	case monitor.EdgeNotificationEvents:
+		return m.clearednotification_events
+	case monitor.EdgeRuntime:
+		return m.clearedruntime
+	case monitor.EdgeVersions:
+		return m.clearedversions
+	case monitor.EdgeRollups:
+		return m.clearedrollups
+	case monitor.EdgeHeaderProfile:
+		return m.clearedheader_profile
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *MonitorMutation) ClearEdge(name string) error {
+	switch name {
+	case monitor.EdgeRuntime:
+		m.ClearRuntime()
+		return nil
+	case monitor.EdgeHeaderProfile:
+		m.ClearHeaderProfile()
+		return nil
+	}
+	return fmt.Errorf("unknown Monitor unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *MonitorMutation) ResetEdge(name string) error {
+	switch name {
+	case monitor.EdgeCheckResults:
+		m.ResetCheckResults()
+		return nil
+	case monitor.EdgeNotificationEvents:
+		m.ResetNotificationEvents()
+		return nil
+	case monitor.EdgeRuntime:
+		m.ResetRuntime()
+		return nil
+	case monitor.EdgeVersions:
+		m.ResetVersions()
+		return nil
+	case monitor.EdgeRollups:
+		m.ResetRollups()
+		return nil
+	case monitor.EdgeHeaderProfile:
+		m.ResetHeaderProfile()
+		return nil
+	}
+	return fmt.Errorf("unknown Monitor edge %s", name)
+}
+
+// MonitorRuntimeMutation represents an operation that mutates the MonitorRuntime nodes in the graph.
+type MonitorRuntimeMutation struct {
+	config
+	op                       Op
+	typ                      string
+	id                       *int
+	status                   *monitorruntime.Status
+	check_count              *int64
+	addcheck_count           *int64
+	success_count            *int64
+	addsuccess_count         *int64
+	error_count              *int64
+	adderror_count           *int64
+	retry_count              *int64
+	addretry_count           *int64
+	consecutive_successes    *int64
+	addconsecutive_successes *int64
+	consecutive_errors       *int64
+	addconsecutive_errors    *int64
+	last_check_at            *time.Time
+	last_success_at          *time.Time
+	last_error_at            *time.Time
+	last_status_code         *int
+	addlast_status_code      *int
+	last_duration_ms         *int
+	addlast_duration_ms      *int
+	last_error_message       *string
+	next_run_at              *time.Time
+	failing_since            *time.Time
+	escalated_at             *time.Time
+	acknowledged_at          *time.Time
+	daily_change_counts      *map[string]int
+	last_change_at           *time.Time
+	error_repeating_since    *time.Time
+	expect_change_until      *time.Time
+	watchdog_alerted_at      *time.Time
+	last_ping_at             *time.Time
+	circuit_opened_at        *time.Time
+	cookies                  *[]schema.StoredCookie
+	appendcookies            []schema.StoredCookie
+	claimed_by               *string
+	claimed_until            *time.Time
+	updated_at               *time.Time
+	clearedFields            map[string]struct{}
+	monitor                  *int
+	clearedmonitor           bool
+	done                     bool
+	oldValue                 func(context.Context) (*MonitorRuntime, error)
+	predicates               []predicate.MonitorRuntime
+}
+
+var _ ent.Mutation = (*MonitorRuntimeMutation)(nil)
+
+// monitorruntimeOption allows management of the mutation configuration using functional options.
+type monitorruntimeOption func(*MonitorRuntimeMutation)
+
+// newMonitorRuntimeMutation creates new mutation for the MonitorRuntime entity.
+func newMonitorRuntimeMutation(c config, op Op, opts ...monitorruntimeOption) *MonitorRuntimeMutation {
+	m := &MonitorRuntimeMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeMonitorRuntime,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withMonitorRuntimeID sets the ID field of the mutation.
+func withMonitorRuntimeID(id int) monitorruntimeOption {
+	return func(m *MonitorRuntimeMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *MonitorRuntime
+		)
+		m.oldValue = func(ctx context.Context) (*MonitorRuntime, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().MonitorRuntime.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withMonitorRuntime sets the old MonitorRuntime of the mutation.
+func withMonitorRuntime(node *MonitorRuntime) monitorruntimeOption {
+	return func(m *MonitorRuntimeMutation) {
+		m.oldValue = func(context.Context) (*MonitorRuntime, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m MonitorRuntimeMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m MonitorRuntimeMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *MonitorRuntimeMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *MonitorRuntimeMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().MonitorRuntime.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetStatus sets the "status" field.
+func (m *MonitorRuntimeMutation) SetStatus(value monitorruntime.Status) {
+	m.status = &value
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *MonitorRuntimeMutation) Status() (r monitorruntime.Status, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the MonitorRuntime entity.
+// If the MonitorRuntime object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MonitorRuntimeMutation) OldStatus(ctx context.Context) (v monitorruntime.Status, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *MonitorRuntimeMutation) ResetStatus() {
+	m.status = nil
+}
+
+// SetCheckCount sets the "check_count" field.
+func (m *MonitorRuntimeMutation) SetCheckCount(i int64) {
+	m.check_count = &i
+	m.addcheck_count = nil
+}
+
+// CheckCount returns the value of the "check_count" field in the mutation.
+func (m *MonitorRuntimeMutation) CheckCount() (r int64, exists bool) {
+	v := m.check_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCheckCount returns the old "check_count" field's value of the MonitorRuntime entity.
+// If the MonitorRuntime object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MonitorRuntimeMutation) OldCheckCount(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCheckCount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCheckCount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCheckCount: %w", err)
+	}
+	return oldValue.CheckCount, nil
+}
+
+// AddCheckCount adds i to the "check_count" field.
+func (m *MonitorRuntimeMutation) AddCheckCount(i int64) {
+	if m.addcheck_count != nil {
+		*m.addcheck_count += i
+	} else {
+		m.addcheck_count = &i
+	}
+}
+
+// AddedCheckCount returns the value that was added to the "check_count" field in this mutation.
+func (m *MonitorRuntimeMutation) AddedCheckCount() (r int64, exists bool) {
+	v := m.addcheck_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetCheckCount resets all changes to the "check_count" field.
+func (m *MonitorRuntimeMutation) ResetCheckCount() {
+	m.check_count = nil
+	m.addcheck_count = nil
+}
+
+// SetSuccessCount sets the "success_count" field.
+func (m *MonitorRuntimeMutation) SetSuccessCount(i int64) {
+	m.success_count = &i
+	m.addsuccess_count = nil
+}
+
+// SuccessCount returns the value of the "success_count" field in the mutation.
+func (m *MonitorRuntimeMutation) SuccessCount() (r int64, exists bool) {
+	v := m.success_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSuccessCount returns the old "success_count" field's value of the MonitorRuntime entity.
+// If the MonitorRuntime object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MonitorRuntimeMutation) OldSuccessCount(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSuccessCount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSuccessCount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSuccessCount: %w", err)
+	}
+	return oldValue.SuccessCount, nil
+}
+
+// AddSuccessCount adds i to the "success_count" field.
+func (m *MonitorRuntimeMutation) AddSuccessCount(i int64) {
+	if m.addsuccess_count != nil {
+		*m.addsuccess_count += i
+	} else {
+		m.addsuccess_count = &i
+	}
+}
+
+// AddedSuccessCount returns the value that was added to the "success_count" field in this mutation.
+func (m *MonitorRuntimeMutation) AddedSuccessCount() (r int64, exists bool) {
+	v := m.addsuccess_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetSuccessCount resets all changes to the "success_count" field.
+func (m *MonitorRuntimeMutation) ResetSuccessCount() {
+	m.success_count = nil
+	m.addsuccess_count = nil
+}
+
+// SetErrorCount sets the "error_count" field.
+func (m *MonitorRuntimeMutation) SetErrorCount(i int64) {
+	m.error_count = &i
+	m.adderror_count = nil
+}
+
+// ErrorCount returns the value of the "error_count" field in the mutation.
+func (m *MonitorRuntimeMutation) ErrorCount() (r int64, exists bool) {
+	v := m.error_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldErrorCount returns the old "error_count" field's value of the MonitorRuntime entity.
+// If the MonitorRuntime object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MonitorRuntimeMutation) OldErrorCount(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldErrorCount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldErrorCount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldErrorCount: %w", err)
+	}
+	return oldValue.ErrorCount, nil
+}
+
+// AddErrorCount adds i to the "error_count" field.
+func (m *MonitorRuntimeMutation) AddErrorCount(i int64) {
+	if m.adderror_count != nil {
+		*m.adderror_count += i
+	} else {
+		m.adderror_count = &i
+	}
+}
+
+// AddedErrorCount returns the value that was added to the "error_count" field in this mutation.
+func (m *MonitorRuntimeMutation) AddedErrorCount() (r int64, exists bool) {
+	v := m.adderror_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetErrorCount resets all changes to the "error_count" field.
+func (m *MonitorRuntimeMutation) ResetErrorCount() {
+	m.error_count = nil
+	m.adderror_count = nil
+}
+
+// SetRetryCount sets the "retry_count" field.
+func (m *MonitorRuntimeMutation) SetRetryCount(i int64) {
+	m.retry_count = &i
+	m.addretry_count = nil
+}
+
+// RetryCount returns the value of the "retry_count" field in the mutation.
+func (m *MonitorRuntimeMutation) RetryCount() (r int64, exists bool) {
+	v := m.retry_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRetryCount returns the old "retry_count" field's value of the MonitorRuntime entity.
+// If the MonitorRuntime object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MonitorRuntimeMutation) OldRetryCount(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRetryCount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRetryCount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRetryCount: %w", err)
+	}
+	return oldValue.RetryCount, nil
+}
+
+// AddRetryCount adds i to the "retry_count" field.
+func (m *MonitorRuntimeMutation) AddRetryCount(i int64) {
+	if m.addretry_count != nil {
+		*m.addretry_count += i
+	} else {
+		m.addretry_count = &i
+	}
+}
+
+// AddedRetryCount returns the value that was added to the "retry_count" field in this mutation.
+func (m *MonitorRuntimeMutation) AddedRetryCount() (r int64, exists bool) {
+	v := m.addretry_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetRetryCount resets all changes to the "retry_count" field.
+func (m *MonitorRuntimeMutation) ResetRetryCount() {
+	m.retry_count = nil
+	m.addretry_count = nil
+}
+
+// SetConsecutiveSuccesses sets the "consecutive_successes" field.
+func (m *MonitorRuntimeMutation) SetConsecutiveSuccesses(i int64) {
+	m.consecutive_successes = &i
+	m.addconsecutive_successes = nil
+}
+
+// ConsecutiveSuccesses returns the value of the "consecutive_successes" field in the mutation.
+func (m *MonitorRuntimeMutation) ConsecutiveSuccesses() (r int64, exists bool) {
+	v := m.consecutive_successes
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldConsecutiveSuccesses returns the old "consecutive_successes" field's value of the MonitorRuntime entity.
+// If the MonitorRuntime object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MonitorRuntimeMutation) OldConsecutiveSuccesses(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldConsecutiveSuccesses is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldConsecutiveSuccesses requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldConsecutiveSuccesses: %w", err)
+	}
+	return oldValue.ConsecutiveSuccesses, nil
+}
+
+// AddConsecutiveSuccesses adds i to the "consecutive_successes" field.
+func (m *MonitorRuntimeMutation) AddConsecutiveSuccesses(i int64) {
+	if m.addconsecutive_successes != nil {
+		*m.addconsecutive_successes += i
+	} else {
+		m.addconsecutive_successes = &i
+	}
+}
+
+// AddedConsecutiveSuccesses returns the value that was added to the "consecutive_successes" field in this mutation.
+func (m *MonitorRuntimeMutation) AddedConsecutiveSuccesses() (r int64, exists bool) {
+	v := m.addconsecutive_successes
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetConsecutiveSuccesses resets all changes to the "consecutive_successes" field.
+func (m *MonitorRuntimeMutation) ResetConsecutiveSuccesses() {
+	m.consecutive_successes = nil
+	m.addconsecutive_successes = nil
+}
+
+// SetConsecutiveErrors sets the "consecutive_errors" field.
+func (m *MonitorRuntimeMutation) SetConsecutiveErrors(i int64) {
+	m.consecutive_errors = &i
+	m.addconsecutive_errors = nil
+}
+
+// ConsecutiveErrors returns the value of the "consecutive_errors" field in the mutation.
+func (m *MonitorRuntimeMutation) ConsecutiveErrors() (r int64, exists bool) {
+	v := m.consecutive_errors
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldConsecutiveErrors returns the old "consecutive_errors" field's value of the MonitorRuntime entity.
+// If the MonitorRuntime object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MonitorRuntimeMutation) OldConsecutiveErrors(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldConsecutiveErrors is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldConsecutiveErrors requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldConsecutiveErrors: %w", err)
+	}
+	return oldValue.ConsecutiveErrors, nil
+}
+
+// AddConsecutiveErrors adds i to the "consecutive_errors" field.
+func (m *MonitorRuntimeMutation) AddConsecutiveErrors(i int64) {
+	if m.addconsecutive_errors != nil {
+		*m.addconsecutive_errors += i
+	} else {
+		m.addconsecutive_errors = &i
+	}
+}
+
+// AddedConsecutiveErrors returns the value that was added to the "consecutive_errors" field in this mutation.
+func (m *MonitorRuntimeMutation) AddedConsecutiveErrors() (r int64, exists bool) {
+	v := m.addconsecutive_errors
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetConsecutiveErrors resets all changes to the "consecutive_errors" field.
+func (m *MonitorRuntimeMutation) ResetConsecutiveErrors() {
+	m.consecutive_errors = nil
+	m.addconsecutive_errors = nil
+}
+
+// SetLastCheckAt sets the "last_check_at" field.
+func (m *MonitorRuntimeMutation) SetLastCheckAt(t time.Time) {
+	m.last_check_at = &t
+}
+
+// LastCheckAt returns the value of the "last_check_at" field in the mutation.
+func (m *MonitorRuntimeMutation) LastCheckAt() (r time.Time, exists bool) {
+	v := m.last_check_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLastCheckAt returns the old "last_check_at" field's value of the MonitorRuntime entity.
+// If the MonitorRuntime object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MonitorRuntimeMutation) OldLastCheckAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastCheckAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastCheckAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastCheckAt: %w", err)
+	}
+	return oldValue.LastCheckAt, nil
+}
+
+// ClearLastCheckAt clears the value of the "last_check_at" field.
+func (m *MonitorRuntimeMutation) ClearLastCheckAt() {
+	m.last_check_at = nil
+	m.clearedFields[monitorruntime.FieldLastCheckAt] = struct{}{}
+}
+
+// LastCheckAtCleared returns if the "last_check_at" field was cleared in this mutation.
+func (m *MonitorRuntimeMutation) LastCheckAtCleared() bool {
+	_, ok := m.clearedFields[monitorruntime.FieldLastCheckAt]
+	return ok
+}
+
+// ResetLastCheckAt resets all changes to the "last_check_at" field.
+func (m *MonitorRuntimeMutation) ResetLastCheckAt() {
+	m.last_check_at = nil
+	delete(m.clearedFields, monitorruntime.FieldLastCheckAt)
+}
+
+// SetLastSuccessAt sets the "last_success_at" field.
+func (m *MonitorRuntimeMutation) SetLastSuccessAt(t time.Time) {
+	m.last_success_at = &t
+}
+
+// LastSuccessAt returns the value of the "last_success_at" field in the mutation.
+func (m *MonitorRuntimeMutation) LastSuccessAt() (r time.Time, exists bool) {
+	v := m.last_success_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLastSuccessAt returns the old "last_success_at" field's value of the MonitorRuntime entity.
+// If the MonitorRuntime object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MonitorRuntimeMutation) OldLastSuccessAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastSuccessAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastSuccessAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastSuccessAt: %w", err)
+	}
+	return oldValue.LastSuccessAt, nil
+}
+
+// ClearLastSuccessAt clears the value of the "last_success_at" field.
+func (m *MonitorRuntimeMutation) ClearLastSuccessAt() {
+	m.last_success_at = nil
+	m.clearedFields[monitorruntime.FieldLastSuccessAt] = struct{}{}
+}
+
+// LastSuccessAtCleared returns if the "last_success_at" field was cleared in this mutation.
+func (m *MonitorRuntimeMutation) LastSuccessAtCleared() bool {
+	_, ok := m.clearedFields[monitorruntime.FieldLastSuccessAt]
+	return ok
+}
+
+// ResetLastSuccessAt resets all changes to the "last_success_at" field.
+func (m *MonitorRuntimeMutation) ResetLastSuccessAt() {
+	m.last_success_at = nil
+	delete(m.clearedFields, monitorruntime.FieldLastSuccessAt)
+}
+
+// SetLastErrorAt sets the "last_error_at" field.
+func (m *MonitorRuntimeMutation) SetLastErrorAt(t time.Time) {
+	m.last_error_at = &t
+}
+
+// LastErrorAt returns the value of the "last_error_at" field in the mutation.
+func (m *MonitorRuntimeMutation) LastErrorAt() (r time.Time, exists bool) {
+	v := m.last_error_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLastErrorAt returns the old "last_error_at" field's value of the MonitorRuntime entity.
+// If the MonitorRuntime object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MonitorRuntimeMutation) OldLastErrorAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastErrorAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastErrorAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastErrorAt: %w", err)
+	}
+	return oldValue.LastErrorAt, nil
+}
+
+// ClearLastErrorAt clears the value of the "last_error_at" field.
+func (m *MonitorRuntimeMutation) ClearLastErrorAt() {
+	m.last_error_at = nil
+	m.clearedFields[monitorruntime.FieldLastErrorAt] = struct{}{}
+}
+
+// LastErrorAtCleared returns if the "last_error_at" field was cleared in this mutation.
+func (m *MonitorRuntimeMutation) LastErrorAtCleared() bool {
+	_, ok := m.clearedFields[monitorruntime.FieldLastErrorAt]
+	return ok
+}
+
+// ResetLastErrorAt resets all changes to the "last_error_at" field.
+func (m *MonitorRuntimeMutation) ResetLastErrorAt() {
+	m.last_error_at = nil
+	delete(m.clearedFields, monitorruntime.FieldLastErrorAt)
+}
+
+// SetLastStatusCode sets the "last_status_code" field.
+func (m *MonitorRuntimeMutation) SetLastStatusCode(i int) {
+	m.last_status_code = &i
+	m.addlast_status_code = nil
+}
+
+// LastStatusCode returns the value of the "last_status_code" field in the mutation.
+func (m *MonitorRuntimeMutation) LastStatusCode() (r int, exists bool) {
+	v := m.last_status_code
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLastStatusCode returns the old "last_status_code" field's value of the MonitorRuntime entity.
+// If the MonitorRuntime object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MonitorRuntimeMutation) OldLastStatusCode(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastStatusCode is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastStatusCode requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastStatusCode: %w", err)
+	}
+	return oldValue.LastStatusCode, nil
+}
+
+// AddLastStatusCode adds i to the "last_status_code" field.
+func (m *MonitorRuntimeMutation) AddLastStatusCode(i int) {
+	if m.addlast_status_code != nil {
+		*m.addlast_status_code += i
+	} else {
+		m.addlast_status_code = &i
+	}
+}
+
+// AddedLastStatusCode returns the value that was added to the "last_status_code" field in this mutation.
+func (m *MonitorRuntimeMutation) AddedLastStatusCode() (r int, exists bool) {
+	v := m.addlast_status_code
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearLastStatusCode clears the value of the "last_status_code" field.
+func (m *MonitorRuntimeMutation) ClearLastStatusCode() {
+	m.last_status_code = nil
+	m.addlast_status_code = nil
+	m.clearedFields[monitorruntime.FieldLastStatusCode] = struct{}{}
+}
+
+// LastStatusCodeCleared returns if the "last_status_code" field was cleared in this mutation.
+func (m *MonitorRuntimeMutation) LastStatusCodeCleared() bool {
+	_, ok := m.clearedFields[monitorruntime.FieldLastStatusCode]
+	return ok
+}
+
+// ResetLastStatusCode resets all changes to the "last_status_code" field.
+func (m *MonitorRuntimeMutation) ResetLastStatusCode() {
+	m.last_status_code = nil
+	m.addlast_status_code = nil
+	delete(m.clearedFields, monitorruntime.FieldLastStatusCode)
+}
+
+// SetLastDurationMs sets the "last_duration_ms" field.
+func (m *MonitorRuntimeMutation) SetLastDurationMs(i int) {
+	m.last_duration_ms = &i
+	m.addlast_duration_ms = nil
+}
+
+// LastDurationMs returns the value of the "last_duration_ms" field in the mutation.
+func (m *MonitorRuntimeMutation) LastDurationMs() (r int, exists bool) {
+	v := m.last_duration_ms
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLastDurationMs returns the old "last_duration_ms" field's value of the MonitorRuntime entity.
+// If the MonitorRuntime object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MonitorRuntimeMutation) OldLastDurationMs(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastDurationMs is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastDurationMs requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastDurationMs: %w", err)
+	}
+	return oldValue.LastDurationMs, nil
+}
+
+// AddLastDurationMs adds i to the "last_duration_ms" field.
+func (m *MonitorRuntimeMutation) AddLastDurationMs(i int) {
+	if m.addlast_duration_ms != nil {
+		*m.addlast_duration_ms += i
+	} else {
+		m.addlast_duration_ms = &i
+	}
+}
+
+// AddedLastDurationMs returns the value that was added to the "last_duration_ms" field in this mutation.
+func (m *MonitorRuntimeMutation) AddedLastDurationMs() (r int, exists bool) {
+	v := m.addlast_duration_ms
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearLastDurationMs clears the value of the "last_duration_ms" field.
+func (m *MonitorRuntimeMutation) ClearLastDurationMs() {
+	m.last_duration_ms = nil
+	m.addlast_duration_ms = nil
+	m.clearedFields[monitorruntime.FieldLastDurationMs] = struct{}{}
+}
+
+// LastDurationMsCleared returns if the "last_duration_ms" field was cleared in this mutation.
+func (m *MonitorRuntimeMutation) LastDurationMsCleared() bool {
+	_, ok := m.clearedFields[monitorruntime.FieldLastDurationMs]
+	return ok
+}
+
+// ResetLastDurationMs resets all changes to the "last_duration_ms" field.
+func (m *MonitorRuntimeMutation) ResetLastDurationMs() {
+	m.last_duration_ms = nil
+	m.addlast_duration_ms = nil
+	delete(m.clearedFields, monitorruntime.FieldLastDurationMs)
+}
+
+// SetLastErrorMessage sets the "last_error_message" field.
+func (m *MonitorRuntimeMutation) SetLastErrorMessage(s string) {
+	m.last_error_message = &s
+}
+
+// LastErrorMessage returns the value of the "last_error_message" field in the mutation.
+func (m *MonitorRuntimeMutation) LastErrorMessage() (r string, exists bool) {
+	v := m.last_error_message
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLastErrorMessage returns the old "last_error_message" field's value of the MonitorRuntime entity.
+// If the MonitorRuntime object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MonitorRuntimeMutation) OldLastErrorMessage(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastErrorMessage is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastErrorMessage requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastErrorMessage: %w", err)
+	}
+	return oldValue.LastErrorMessage, nil
+}
+
+// ClearLastErrorMessage clears the value of the "last_error_message" field.
+func (m *MonitorRuntimeMutation) ClearLastErrorMessage() {
+	m.last_error_message = nil
+	m.clearedFields[monitorruntime.FieldLastErrorMessage] = struct{}{}
+}
+
+// LastErrorMessageCleared returns if the "last_error_message" field was cleared in this mutation.
+func (m *MonitorRuntimeMutation) LastErrorMessageCleared() bool {
+	_, ok := m.clearedFields[monitorruntime.FieldLastErrorMessage]
+	return ok
+}
+
+// ResetLastErrorMessage resets all changes to the "last_error_message" field.
+func (m *MonitorRuntimeMutation) ResetLastErrorMessage() {
+	m.last_error_message = nil
+	delete(m.clearedFields, monitorruntime.FieldLastErrorMessage)
+}
+
+// SetNextRunAt sets the "next_run_at" field.
+func (m *MonitorRuntimeMutation) SetNextRunAt(t time.Time) {
+	m.next_run_at = &t
+}
+
+// NextRunAt returns the value of the "next_run_at" field in the mutation.
+func (m *MonitorRuntimeMutation) NextRunAt() (r time.Time, exists bool) {
+	v := m.next_run_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNextRunAt returns the old "next_run_at" field's value of the MonitorRuntime entity.
+// If the MonitorRuntime object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MonitorRuntimeMutation) OldNextRunAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNextRunAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNextRunAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNextRunAt: %w", err)
+	}
+	return oldValue.NextRunAt, nil
+}
+
+// ClearNextRunAt clears the value of the "next_run_at" field.
+func (m *MonitorRuntimeMutation) ClearNextRunAt() {
+	m.next_run_at = nil
+	m.clearedFields[monitorruntime.FieldNextRunAt] = struct{}{}
+}
+
+// NextRunAtCleared returns if the "next_run_at" field was cleared in this mutation.
+func (m *MonitorRuntimeMutation) NextRunAtCleared() bool {
+	_, ok := m.clearedFields[monitorruntime.FieldNextRunAt]
+	return ok
+}
+
+// ResetNextRunAt resets all changes to the "next_run_at" field.
+func (m *MonitorRuntimeMutation) ResetNextRunAt() {
+	m.next_run_at = nil
+	delete(m.clearedFields, monitorruntime.FieldNextRunAt)
+}
+
+// SetFailingSince sets the "failing_since" field.
+func (m *MonitorRuntimeMutation) SetFailingSince(t time.Time) {
+	m.failing_since = &t
+}
+
+// FailingSince returns the value of the "failing_since" field in the mutation.
+func (m *MonitorRuntimeMutation) FailingSince() (r time.Time, exists bool) {
+	v := m.failing_since
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFailingSince returns the old "failing_since" field's value of the MonitorRuntime entity.
+// If the MonitorRuntime object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MonitorRuntimeMutation) OldFailingSince(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFailingSince is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFailingSince requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFailingSince: %w", err)
+	}
+	return oldValue.FailingSince, nil
+}
+
+// ClearFailingSince clears the value of the "failing_since" field.
+func (m *MonitorRuntimeMutation) ClearFailingSince() {
+	m.failing_since = nil
+	m.clearedFields[monitorruntime.FieldFailingSince] = struct{}{}
+}
+
+// FailingSinceCleared returns if the "failing_since" field was cleared in this mutation.
+func (m *MonitorRuntimeMutation) FailingSinceCleared() bool {
+	_, ok := m.clearedFields[monitorruntime.FieldFailingSince]
+	return ok
+}
+
+// ResetFailingSince resets all changes to the "failing_since" field.
+func (m *MonitorRuntimeMutation) ResetFailingSince() {
+	m.failing_since = nil
+	delete(m.clearedFields, monitorruntime.FieldFailingSince)
+}
+
+// SetEscalatedAt sets the "escalated_at" field.
+func (m *MonitorRuntimeMutation) SetEscalatedAt(t time.Time) {
+	m.escalated_at = &t
+}
+
+// EscalatedAt returns the value of the "escalated_at" field in the mutation.
+func (m *MonitorRuntimeMutation) EscalatedAt() (r time.Time, exists bool) {
+	v := m.escalated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEscalatedAt returns the old "escalated_at" field's value of the MonitorRuntime entity.
+// If the MonitorRuntime object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MonitorRuntimeMutation) OldEscalatedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEscalatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEscalatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEscalatedAt: %w", err)
+	}
+	return oldValue.EscalatedAt, nil
+}
+
+// ClearEscalatedAt clears the value of the "escalated_at" field.
+func (m *MonitorRuntimeMutation) ClearEscalatedAt() {
+	m.escalated_at = nil
+	m.clearedFields[monitorruntime.FieldEscalatedAt] = struct{}{}
+}
+
+// EscalatedAtCleared returns if the "escalated_at" field was cleared in this mutation.
+func (m *MonitorRuntimeMutation) EscalatedAtCleared() bool {
+	_, ok := m.clearedFields[monitorruntime.FieldEscalatedAt]
+	return ok
+}
+
+// ResetEscalatedAt resets all changes to the "escalated_at" field.
+func (m *MonitorRuntimeMutation) ResetEscalatedAt() {
+	m.escalated_at = nil
+	delete(m.clearedFields, monitorruntime.FieldEscalatedAt)
+}
+
+// SetAcknowledgedAt sets the "acknowledged_at" field.
+func (m *MonitorRuntimeMutation) SetAcknowledgedAt(t time.Time) {
+	m.acknowledged_at = &t
+}
+
+// AcknowledgedAt returns the value of the "acknowledged_at" field in the mutation.
+func (m *MonitorRuntimeMutation) AcknowledgedAt() (r time.Time, exists bool) {
+	v := m.acknowledged_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAcknowledgedAt returns the old "acknowledged_at" field's value of the MonitorRuntime entity.
+// If the MonitorRuntime object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MonitorRuntimeMutation) OldAcknowledgedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAcknowledgedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAcknowledgedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAcknowledgedAt: %w", err)
+	}
+	return oldValue.AcknowledgedAt, nil
+}
+
+// ClearAcknowledgedAt clears the value of the "acknowledged_at" field.
+func (m *MonitorRuntimeMutation) ClearAcknowledgedAt() {
+	m.acknowledged_at = nil
+	m.clearedFields[monitorruntime.FieldAcknowledgedAt] = struct{}{}
+}
+
+// AcknowledgedAtCleared returns if the "acknowledged_at" field was cleared in this mutation.
+func (m *MonitorRuntimeMutation) AcknowledgedAtCleared() bool {
+	_, ok := m.clearedFields[monitorruntime.FieldAcknowledgedAt]
+	return ok
+}
+
+// ResetAcknowledgedAt resets all changes to the "acknowledged_at" field.
+func (m *MonitorRuntimeMutation) ResetAcknowledgedAt() {
+	m.acknowledged_at = nil
+	delete(m.clearedFields, monitorruntime.FieldAcknowledgedAt)
+}
+
+// SetDailyChangeCounts sets the "daily_change_counts" field.
+func (m *MonitorRuntimeMutation) SetDailyChangeCounts(value map[string]int) {
+	m.daily_change_counts = &value
+}
+
+// DailyChangeCounts returns the value of the "daily_change_counts" field in the mutation.
+func (m *MonitorRuntimeMutation) DailyChangeCounts() (r map[string]int, exists bool) {
+	v := m.daily_change_counts
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDailyChangeCounts returns the old "daily_change_counts" field's value of the MonitorRuntime entity.
+// If the MonitorRuntime object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MonitorRuntimeMutation) OldDailyChangeCounts(ctx context.Context) (v map[string]int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDailyChangeCounts is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDailyChangeCounts requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDailyChangeCounts: %w", err)
+	}
+	return oldValue.DailyChangeCounts, nil
+}
+
+// ClearDailyChangeCounts clears the value of the "daily_change_counts" field.
+func (m *MonitorRuntimeMutation) ClearDailyChangeCounts() {
+	m.daily_change_counts = nil
+	m.clearedFields[monitorruntime.FieldDailyChangeCounts] = struct{}{}
+}
+
+// DailyChangeCountsCleared returns if the "daily_change_counts" field was cleared in this mutation.
+func (m *MonitorRuntimeMutation) DailyChangeCountsCleared() bool {
+	_, ok := m.clearedFields[monitorruntime.FieldDailyChangeCounts]
+	return ok
+}
+
+// ResetDailyChangeCounts resets all changes to the "daily_change_counts" field.
+func (m *MonitorRuntimeMutation) ResetDailyChangeCounts() {
+	m.daily_change_counts = nil
+	delete(m.clearedFields, monitorruntime.FieldDailyChangeCounts)
+}
+
+// SetLastChangeAt sets the "last_change_at" field.
+func (m *MonitorRuntimeMutation) SetLastChangeAt(t time.Time) {
+	m.last_change_at = &t
+}
+
+// LastChangeAt returns the value of the "last_change_at" field in the mutation.
+func (m *MonitorRuntimeMutation) LastChangeAt() (r time.Time, exists bool) {
+	v := m.last_change_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLastChangeAt returns the old "last_change_at" field's value of the MonitorRuntime entity.
+// If the MonitorRuntime object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MonitorRuntimeMutation) OldLastChangeAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastChangeAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastChangeAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastChangeAt: %w", err)
+	}
+	return oldValue.LastChangeAt, nil
+}
+
+// ClearLastChangeAt clears the value of the "last_change_at" field.
+func (m *MonitorRuntimeMutation) ClearLastChangeAt() {
+	m.last_change_at = nil
+	m.clearedFields[monitorruntime.FieldLastChangeAt] = struct{}{}
+}
+
+// LastChangeAtCleared returns if the "last_change_at" field was cleared in this mutation.
+func (m *MonitorRuntimeMutation) LastChangeAtCleared() bool {
+	_, ok := m.clearedFields[monitorruntime.FieldLastChangeAt]
+	return ok
+}
+
+// ResetLastChangeAt resets all changes to the "last_change_at" field.
+func (m *MonitorRuntimeMutation) ResetLastChangeAt() {
+	m.last_change_at = nil
+	delete(m.clearedFields, monitorruntime.FieldLastChangeAt)
+}
+
+// SetErrorRepeatingSince sets the "error_repeating_since" field.
+func (m *MonitorRuntimeMutation) SetErrorRepeatingSince(t time.Time) {
+	m.error_repeating_since = &t
+}
+
+// ErrorRepeatingSince returns the value of the "error_repeating_since" field in the mutation.
+func (m *MonitorRuntimeMutation) ErrorRepeatingSince() (r time.Time, exists bool) {
+	v := m.error_repeating_since
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldErrorRepeatingSince returns the old "error_repeating_since" field's value of the MonitorRuntime entity.
+// If the MonitorRuntime object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MonitorRuntimeMutation) OldErrorRepeatingSince(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldErrorRepeatingSince is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldErrorRepeatingSince requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldErrorRepeatingSince: %w", err)
+	}
+	return oldValue.ErrorRepeatingSince, nil
+}
+
+// ClearErrorRepeatingSince clears the value of the "error_repeating_since" field.
+func (m *MonitorRuntimeMutation) ClearErrorRepeatingSince() {
+	m.error_repeating_since = nil
+	m.clearedFields[monitorruntime.FieldErrorRepeatingSince] = struct{}{}
+}
+
+// ErrorRepeatingSinceCleared returns if the "error_repeating_since" field was cleared in this mutation.
+func (m *MonitorRuntimeMutation) ErrorRepeatingSinceCleared() bool {
+	_, ok := m.clearedFields[monitorruntime.FieldErrorRepeatingSince]
+	return ok
+}
+
+// ResetErrorRepeatingSince resets all changes to the "error_repeating_since" field.
+func (m *MonitorRuntimeMutation) ResetErrorRepeatingSince() {
+	m.error_repeating_since = nil
+	delete(m.clearedFields, monitorruntime.FieldErrorRepeatingSince)
+}
+
+// SetExpectChangeUntil sets the "expect_change_until" field.
+func (m *MonitorRuntimeMutation) SetExpectChangeUntil(t time.Time) {
+	m.expect_change_until = &t
+}
+
+// ExpectChangeUntil returns the value of the "expect_change_until" field in the mutation.
+func (m *MonitorRuntimeMutation) ExpectChangeUntil() (r time.Time, exists bool) {
+	v := m.expect_change_until
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExpectChangeUntil returns the old "expect_change_until" field's value of the MonitorRuntime entity.
+// If the MonitorRuntime object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MonitorRuntimeMutation) OldExpectChangeUntil(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExpectChangeUntil is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExpectChangeUntil requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExpectChangeUntil: %w", err)
+	}
+	return oldValue.ExpectChangeUntil, nil
+}
+
+// ClearExpectChangeUntil clears the value of the "expect_change_until" field.
+func (m *MonitorRuntimeMutation) ClearExpectChangeUntil() {
+	m.expect_change_until = nil
+	m.clearedFields[monitorruntime.FieldExpectChangeUntil] = struct{}{}
+}
+
+// ExpectChangeUntilCleared returns if the "expect_change_until" field was cleared in this mutation.
+func (m *MonitorRuntimeMutation) ExpectChangeUntilCleared() bool {
+	_, ok := m.clearedFields[monitorruntime.FieldExpectChangeUntil]
+	return ok
+}
+
+// ResetExpectChangeUntil resets all changes to the "expect_change_until" field.
+func (m *MonitorRuntimeMutation) ResetExpectChangeUntil() {
+	m.expect_change_until = nil
+	delete(m.clearedFields, monitorruntime.FieldExpectChangeUntil)
+}
+
+// SetWatchdogAlertedAt sets the "watchdog_alerted_at" field.
+func (m *MonitorRuntimeMutation) SetWatchdogAlertedAt(t time.Time) {
+	m.watchdog_alerted_at = &t
+}
+
+// WatchdogAlertedAt returns the value of the "watchdog_alerted_at" field in the mutation.
+func (m *MonitorRuntimeMutation) WatchdogAlertedAt() (r time.Time, exists bool) {
+	v := m.watchdog_alerted_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldWatchdogAlertedAt returns the old "watchdog_alerted_at" field's value of the MonitorRuntime entity.
+// If the MonitorRuntime object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MonitorRuntimeMutation) OldWatchdogAlertedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldWatchdogAlertedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldWatchdogAlertedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldWatchdogAlertedAt: %w", err)
+	}
+	return oldValue.WatchdogAlertedAt, nil
+}
+
+// ClearWatchdogAlertedAt clears the value of the "watchdog_alerted_at" field.
+func (m *MonitorRuntimeMutation) ClearWatchdogAlertedAt() {
+	m.watchdog_alerted_at = nil
+	m.clearedFields[monitorruntime.FieldWatchdogAlertedAt] = struct{}{}
+}
+
+// WatchdogAlertedAtCleared returns if the "watchdog_alerted_at" field was cleared in this mutation.
+func (m *MonitorRuntimeMutation) WatchdogAlertedAtCleared() bool {
+	_, ok := m.clearedFields[monitorruntime.FieldWatchdogAlertedAt]
+	return ok
+}
+
+// ResetWatchdogAlertedAt resets all changes to the "watchdog_alerted_at" field.
+func (m *MonitorRuntimeMutation) ResetWatchdogAlertedAt() {
+	m.watchdog_alerted_at = nil
+	delete(m.clearedFields, monitorruntime.FieldWatchdogAlertedAt)
+}
+
+// SetLastPingAt sets the "last_ping_at" field.
+func (m *MonitorRuntimeMutation) SetLastPingAt(t time.Time) {
+	m.last_ping_at = &t
+}
+
+// LastPingAt returns the value of the "last_ping_at" field in the mutation.
+func (m *MonitorRuntimeMutation) LastPingAt() (r time.Time, exists bool) {
+	v := m.last_ping_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLastPingAt returns the old "last_ping_at" field's value of the MonitorRuntime entity.
+// If the MonitorRuntime object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MonitorRuntimeMutation) OldLastPingAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastPingAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastPingAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastPingAt: %w", err)
+	}
+	return oldValue.LastPingAt, nil
+}
+
+// ClearLastPingAt clears the value of the "last_ping_at" field.
+func (m *MonitorRuntimeMutation) ClearLastPingAt() {
+	m.last_ping_at = nil
+	m.clearedFields[monitorruntime.FieldLastPingAt] = struct{}{}
+}
+
+// LastPingAtCleared returns if the "last_ping_at" field was cleared in this mutation.
+func (m *MonitorRuntimeMutation) LastPingAtCleared() bool {
+	_, ok := m.clearedFields[monitorruntime.FieldLastPingAt]
+	return ok
+}
+
+// ResetLastPingAt resets all changes to the "last_ping_at" field.
+func (m *MonitorRuntimeMutation) ResetLastPingAt() {
+	m.last_ping_at = nil
+	delete(m.clearedFields, monitorruntime.FieldLastPingAt)
+}
+
+// SetCircuitOpenedAt sets the "circuit_opened_at" field.
+func (m *MonitorRuntimeMutation) SetCircuitOpenedAt(t time.Time) {
+	m.circuit_opened_at = &t
+}
+
+// CircuitOpenedAt returns the value of the "circuit_opened_at" field in the mutation.
+func (m *MonitorRuntimeMutation) CircuitOpenedAt() (r time.Time, exists bool) {
+	v := m.circuit_opened_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCircuitOpenedAt returns the old "circuit_opened_at" field's value of the MonitorRuntime entity.
+// If the MonitorRuntime object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MonitorRuntimeMutation) OldCircuitOpenedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCircuitOpenedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCircuitOpenedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCircuitOpenedAt: %w", err)
+	}
+	return oldValue.CircuitOpenedAt, nil
+}
+
+// ClearCircuitOpenedAt clears the value of the "circuit_opened_at" field.
+func (m *MonitorRuntimeMutation) ClearCircuitOpenedAt() {
+	m.circuit_opened_at = nil
+	m.clearedFields[monitorruntime.FieldCircuitOpenedAt] = struct{}{}
+}
+
+// CircuitOpenedAtCleared returns if the "circuit_opened_at" field was cleared in this mutation.
+func (m *MonitorRuntimeMutation) CircuitOpenedAtCleared() bool {
+	_, ok := m.clearedFields[monitorruntime.FieldCircuitOpenedAt]
+	return ok
+}
+
+// ResetCircuitOpenedAt resets all changes to the "circuit_opened_at" field.
+func (m *MonitorRuntimeMutation) ResetCircuitOpenedAt() {
+	m.circuit_opened_at = nil
+	delete(m.clearedFields, monitorruntime.FieldCircuitOpenedAt)
+}
+
+// SetCookies sets the "cookies" field.
+func (m *MonitorRuntimeMutation) SetCookies(sc []schema.StoredCookie) {
+	m.cookies = &sc
+	m.appendcookies = nil
+}
+
+// Cookies returns the value of the "cookies" field in the mutation.
+func (m *MonitorRuntimeMutation) Cookies() (r []schema.StoredCookie, exists bool) {
+	v := m.cookies
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCookies returns the old "cookies" field's value of the MonitorRuntime entity.
+// If the MonitorRuntime object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MonitorRuntimeMutation) OldCookies(ctx context.Context) (v []schema.StoredCookie, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCookies is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCookies requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCookies: %w", err)
+	}
+	return oldValue.Cookies, nil
+}
+
+// AppendCookies adds sc to the "cookies" field.
+func (m *MonitorRuntimeMutation) AppendCookies(sc []schema.StoredCookie) {
+	m.appendcookies = append(m.appendcookies, sc...)
+}
+
+// AppendedCookies returns the list of values that were appended to the "cookies" field in this mutation.
+func (m *MonitorRuntimeMutation) AppendedCookies() ([]schema.StoredCookie, bool) {
+	if len(m.appendcookies) == 0 {
+		return nil, false
+	}
+	return m.appendcookies, true
+}
+
+// ClearCookies clears the value of the "cookies" field.
+func (m *MonitorRuntimeMutation) ClearCookies() {
+	m.cookies = nil
+	m.appendcookies = nil
+	m.clearedFields[monitorruntime.FieldCookies] = struct{}{}
+}
+
+// CookiesCleared returns if the "cookies" field was cleared in this mutation.
+func (m *MonitorRuntimeMutation) CookiesCleared() bool {
+	_, ok := m.clearedFields[monitorruntime.FieldCookies]
+	return ok
+}
+
+// ResetCookies resets all changes to the "cookies" field.
+func (m *MonitorRuntimeMutation) ResetCookies() {
+	m.cookies = nil
+	m.appendcookies = nil
+	delete(m.clearedFields, monitorruntime.FieldCookies)
+}
+
+// SetClaimedBy sets the "claimed_by" field.
+func (m *MonitorRuntimeMutation) SetClaimedBy(s string) {
+	m.claimed_by = &s
+}
+
+// ClaimedBy returns the value of the "claimed_by" field in the mutation.
+func (m *MonitorRuntimeMutation) ClaimedBy() (r string, exists bool) {
+	v := m.claimed_by
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldClaimedBy returns the old "claimed_by" field's value of the MonitorRuntime entity.
+// If the MonitorRuntime object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MonitorRuntimeMutation) OldClaimedBy(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldClaimedBy is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldClaimedBy requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldClaimedBy: %w", err)
+	}
+	return oldValue.ClaimedBy, nil
+}
+
+// ClearClaimedBy clears the value of the "claimed_by" field.
+func (m *MonitorRuntimeMutation) ClearClaimedBy() {
+	m.claimed_by = nil
+	m.clearedFields[monitorruntime.FieldClaimedBy] = struct{}{}
+}
+
+// ClaimedByCleared returns if the "claimed_by" field was cleared in this mutation.
+func (m *MonitorRuntimeMutation) ClaimedByCleared() bool {
+	_, ok := m.clearedFields[monitorruntime.FieldClaimedBy]
+	return ok
+}
+
+// ResetClaimedBy resets all changes to the "claimed_by" field.
+func (m *MonitorRuntimeMutation) ResetClaimedBy() {
+	m.claimed_by = nil
+	delete(m.clearedFields, monitorruntime.FieldClaimedBy)
+}
+
+// SetClaimedUntil sets the "claimed_until" field.
+func (m *MonitorRuntimeMutation) SetClaimedUntil(t time.Time) {
+	m.claimed_until = &t
+}
+
+// ClaimedUntil returns the value of the "claimed_until" field in the mutation.
+func (m *MonitorRuntimeMutation) ClaimedUntil() (r time.Time, exists bool) {
+	v := m.claimed_until
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldClaimedUntil returns the old "claimed_until" field's value of the MonitorRuntime entity.
+// If the MonitorRuntime object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MonitorRuntimeMutation) OldClaimedUntil(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldClaimedUntil is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldClaimedUntil requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldClaimedUntil: %w", err)
+	}
+	return oldValue.ClaimedUntil, nil
+}
+
+// ClearClaimedUntil clears the value of the "claimed_until" field.
+func (m *MonitorRuntimeMutation) ClearClaimedUntil() {
+	m.claimed_until = nil
+	m.clearedFields[monitorruntime.FieldClaimedUntil] = struct{}{}
+}
+
+// ClaimedUntilCleared returns if the "claimed_until" field was cleared in this mutation.
+func (m *MonitorRuntimeMutation) ClaimedUntilCleared() bool {
+	_, ok := m.clearedFields[monitorruntime.FieldClaimedUntil]
+	return ok
+}
+
+// ResetClaimedUntil resets all changes to the "claimed_until" field.
+func (m *MonitorRuntimeMutation) ResetClaimedUntil() {
+	m.claimed_until = nil
+	delete(m.clearedFields, monitorruntime.FieldClaimedUntil)
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *MonitorRuntimeMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *MonitorRuntimeMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the MonitorRuntime entity.
+// If the MonitorRuntime object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MonitorRuntimeMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *MonitorRuntimeMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetMonitorID sets the "monitor" edge to the Monitor entity by id.
+func (m *MonitorRuntimeMutation) SetMonitorID(id int) {
+	m.monitor = &id
+}
+
+// ClearMonitor clears the "monitor" edge to the Monitor entity.
+func (m *MonitorRuntimeMutation) ClearMonitor() {
+	m.clearedmonitor = true
+}
+
+// MonitorCleared reports if the "monitor" edge to the Monitor entity was cleared.
+func (m *MonitorRuntimeMutation) MonitorCleared() bool {
+	return m.clearedmonitor
+}
+
+// MonitorID returns the "monitor" edge ID in the mutation.
+func (m *MonitorRuntimeMutation) MonitorID() (id int, exists bool) {
+	if m.monitor != nil {
+		return *m.monitor, true
+	}
+	return
+}
+
+// MonitorIDs returns the "monitor" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// MonitorID instead. It exists only for internal usage by the builders.
+func (m *MonitorRuntimeMutation) MonitorIDs() (ids []int) {
+	if id := m.monitor; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetMonitor resets all changes to the "monitor" edge.
+func (m *MonitorRuntimeMutation) ResetMonitor() {
+	m.monitor = nil
+	m.clearedmonitor = false
+}
+
+// Where appends a list predicates to the MonitorRuntimeMutation builder.
+func (m *MonitorRuntimeMutation) Where(ps ...predicate.MonitorRuntime) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the MonitorRuntimeMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *MonitorRuntimeMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.MonitorRuntime, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *MonitorRuntimeMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *MonitorRuntimeMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (MonitorRuntime).
+func (m *MonitorRuntimeMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *MonitorRuntimeMutation) Fields() []string {
+	fields := make([]string, 0, 28)
+	if m.status != nil {
+		fields = append(fields, monitorruntime.FieldStatus)
+	}
+	if m.check_count != nil {
+		fields = append(fields, monitorruntime.FieldCheckCount)
+	}
+	if m.success_count != nil {
+		fields = append(fields, monitorruntime.FieldSuccessCount)
+	}
+	if m.error_count != nil {
+		fields = append(fields, monitorruntime.FieldErrorCount)
+	}
+	if m.retry_count != nil {
+		fields = append(fields, monitorruntime.FieldRetryCount)
+	}
+	if m.consecutive_successes != nil {
+		fields = append(fields, monitorruntime.FieldConsecutiveSuccesses)
+	}
+	if m.consecutive_errors != nil {
+		fields = append(fields, monitorruntime.FieldConsecutiveErrors)
+	}
+	if m.last_check_at != nil {
+		fields = append(fields, monitorruntime.FieldLastCheckAt)
+	}
+	if m.last_success_at != nil {
+		fields = append(fields, monitorruntime.FieldLastSuccessAt)
+	}
+	if m.last_error_at != nil {
+		fields = append(fields, monitorruntime.FieldLastErrorAt)
+	}
+	if m.last_status_code != nil {
+		fields = append(fields, monitorruntime.FieldLastStatusCode)
+	}
+	if m.last_duration_ms != nil {
+		fields = append(fields, monitorruntime.FieldLastDurationMs)
+	}
+	if m.last_error_message != nil {
+		fields = append(fields, monitorruntime.FieldLastErrorMessage)
+	}
+	if m.next_run_at != nil {
+		fields = append(fields, monitorruntime.FieldNextRunAt)
+	}
+	if m.failing_since != nil {
+		fields = append(fields, monitorruntime.FieldFailingSince)
+	}
+	if m.escalated_at != nil {
+		fields = append(fields, monitorruntime.FieldEscalatedAt)
+	}
+	if m.acknowledged_at != nil {
+		fields = append(fields, monitorruntime.FieldAcknowledgedAt)
+	}
+	if m.daily_change_counts != nil {
+		fields = append(fields, monitorruntime.FieldDailyChangeCounts)
+	}
+	if m.last_change_at != nil {
+		fields = append(fields, monitorruntime.FieldLastChangeAt)
+	}
+	if m.error_repeating_since != nil {
+		fields = append(fields, monitorruntime.FieldErrorRepeatingSince)
+	}
+	if m.expect_change_until != nil {
+		fields = append(fields, monitorruntime.FieldExpectChangeUntil)
+	}
+	if m.watchdog_alerted_at != nil {
+		fields = append(fields, monitorruntime.FieldWatchdogAlertedAt)
+	}
+	if m.last_ping_at != nil {
+		fields = append(fields, monitorruntime.FieldLastPingAt)
+	}
+	if m.circuit_opened_at != nil {
+		fields = append(fields, monitorruntime.FieldCircuitOpenedAt)
+	}
+	if m.cookies != nil {
+		fields = append(fields, monitorruntime.FieldCookies)
+	}
+	if m.claimed_by != nil {
+		fields = append(fields, monitorruntime.FieldClaimedBy)
+	}
+	if m.claimed_until != nil {
+		fields = append(fields, monitorruntime.FieldClaimedUntil)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, monitorruntime.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *MonitorRuntimeMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case monitorruntime.FieldStatus:
+		return m.Status()
+	case monitorruntime.FieldCheckCount:
+		return m.CheckCount()
+	case monitorruntime.FieldSuccessCount:
+		return m.SuccessCount()
+	case monitorruntime.FieldErrorCount:
+		return m.ErrorCount()
+	case monitorruntime.FieldRetryCount:
+		return m.RetryCount()
+	case monitorruntime.FieldConsecutiveSuccesses:
+		return m.ConsecutiveSuccesses()
+	case monitorruntime.FieldConsecutiveErrors:
+		return m.ConsecutiveErrors()
+	case monitorruntime.FieldLastCheckAt:
+		return m.LastCheckAt()
+	case monitorruntime.FieldLastSuccessAt:
+		return m.LastSuccessAt()
+	case monitorruntime.FieldLastErrorAt:
+		return m.LastErrorAt()
+	case monitorruntime.FieldLastStatusCode:
+		return m.LastStatusCode()
+	case monitorruntime.FieldLastDurationMs:
+		return m.LastDurationMs()
+	case monitorruntime.FieldLastErrorMessage:
+		return m.LastErrorMessage()
+	case monitorruntime.FieldNextRunAt:
+		return m.NextRunAt()
+	case monitorruntime.FieldFailingSince:
+		return m.FailingSince()
+	case monitorruntime.FieldEscalatedAt:
+		return m.EscalatedAt()
+	case monitorruntime.FieldAcknowledgedAt:
+		return m.AcknowledgedAt()
+	case monitorruntime.FieldDailyChangeCounts:
+		return m.DailyChangeCounts()
+	case monitorruntime.FieldLastChangeAt:
+		return m.LastChangeAt()
+	case monitorruntime.FieldErrorRepeatingSince:
+		return m.ErrorRepeatingSince()
+	case monitorruntime.FieldExpectChangeUntil:
+		return m.ExpectChangeUntil()
+	case monitorruntime.FieldWatchdogAlertedAt:
+		return m.WatchdogAlertedAt()
+	case monitorruntime.FieldLastPingAt:
+		return m.LastPingAt()
+	case monitorruntime.FieldCircuitOpenedAt:
+		return m.CircuitOpenedAt()
+	case monitorruntime.FieldCookies:
+		return m.Cookies()
+	case monitorruntime.FieldClaimedBy:
+		return m.ClaimedBy()
+	case monitorruntime.FieldClaimedUntil:
+		return m.ClaimedUntil()
+	case monitorruntime.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *MonitorRuntimeMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case monitorruntime.FieldStatus:
+		return m.OldStatus(ctx)
+	case monitorruntime.FieldCheckCount:
+		return m.OldCheckCount(ctx)
+	case monitorruntime.FieldSuccessCount:
+		return m.OldSuccessCount(ctx)
+	case monitorruntime.FieldErrorCount:
+		return m.OldErrorCount(ctx)
+	case monitorruntime.FieldRetryCount:
+		return m.OldRetryCount(ctx)
+	case monitorruntime.FieldConsecutiveSuccesses:
+		return m.OldConsecutiveSuccesses(ctx)
+	case monitorruntime.FieldConsecutiveErrors:
+		return m.OldConsecutiveErrors(ctx)
+	case monitorruntime.FieldLastCheckAt:
+		return m.OldLastCheckAt(ctx)
+	case monitorruntime.FieldLastSuccessAt:
+		return m.OldLastSuccessAt(ctx)
+	case monitorruntime.FieldLastErrorAt:
+		return m.OldLastErrorAt(ctx)
+	case monitorruntime.FieldLastStatusCode:
+		return m.OldLastStatusCode(ctx)
+	case monitorruntime.FieldLastDurationMs:
+		return m.OldLastDurationMs(ctx)
+	case monitorruntime.FieldLastErrorMessage:
+		return m.OldLastErrorMessage(ctx)
+	case monitorruntime.FieldNextRunAt:
+		return m.OldNextRunAt(ctx)
+	case monitorruntime.FieldFailingSince:
+		return m.OldFailingSince(ctx)
+	case monitorruntime.FieldEscalatedAt:
+		return m.OldEscalatedAt(ctx)
+	case monitorruntime.FieldAcknowledgedAt:
+		return m.OldAcknowledgedAt(ctx)
+	case monitorruntime.FieldDailyChangeCounts:
+		return m.OldDailyChangeCounts(ctx)
+	case monitorruntime.FieldLastChangeAt:
+		return m.OldLastChangeAt(ctx)
+	case monitorruntime.FieldErrorRepeatingSince:
+		return m.OldErrorRepeatingSince(ctx)
+	case monitorruntime.FieldExpectChangeUntil:
+		return m.OldExpectChangeUntil(ctx)
+	case monitorruntime.FieldWatchdogAlertedAt:
+		return m.OldWatchdogAlertedAt(ctx)
+	case monitorruntime.FieldLastPingAt:
+		return m.OldLastPingAt(ctx)
+	case monitorruntime.FieldCircuitOpenedAt:
+		return m.OldCircuitOpenedAt(ctx)
+	case monitorruntime.FieldCookies:
+		return m.OldCookies(ctx)
+	case monitorruntime.FieldClaimedBy:
+		return m.OldClaimedBy(ctx)
+	case monitorruntime.FieldClaimedUntil:
+		return m.OldClaimedUntil(ctx)
+	case monitorruntime.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown MonitorRuntime field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *MonitorRuntimeMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case monitorruntime.FieldStatus:
+		v, ok := value.(monitorruntime.Status)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case monitorruntime.FieldCheckCount:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCheckCount(v)
+		return nil
+	case monitorruntime.FieldSuccessCount:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSuccessCount(v)
+		return nil
+	case monitorruntime.FieldErrorCount:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetErrorCount(v)
+		return nil
+	case monitorruntime.FieldRetryCount:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRetryCount(v)
+		return nil
+	case monitorruntime.FieldConsecutiveSuccesses:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetConsecutiveSuccesses(v)
+		return nil
+	case monitorruntime.FieldConsecutiveErrors:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetConsecutiveErrors(v)
+		return nil
+	case monitorruntime.FieldLastCheckAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastCheckAt(v)
+		return nil
+	case monitorruntime.FieldLastSuccessAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastSuccessAt(v)
+		return nil
+	case monitorruntime.FieldLastErrorAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastErrorAt(v)
+		return nil
+	case monitorruntime.FieldLastStatusCode:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastStatusCode(v)
+		return nil
+	case monitorruntime.FieldLastDurationMs:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastDurationMs(v)
+		return nil
+	case monitorruntime.FieldLastErrorMessage:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastErrorMessage(v)
+		return nil
+	case monitorruntime.FieldNextRunAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNextRunAt(v)
+		return nil
+	case monitorruntime.FieldFailingSince:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFailingSince(v)
+		return nil
+	case monitorruntime.FieldEscalatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEscalatedAt(v)
+		return nil
+	case monitorruntime.FieldAcknowledgedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAcknowledgedAt(v)
+		return nil
+	case monitorruntime.FieldDailyChangeCounts:
+		v, ok := value.(map[string]int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDailyChangeCounts(v)
+		return nil
+	case monitorruntime.FieldLastChangeAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastChangeAt(v)
+		return nil
+	case monitorruntime.FieldErrorRepeatingSince:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetErrorRepeatingSince(v)
+		return nil
+	case monitorruntime.FieldExpectChangeUntil:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExpectChangeUntil(v)
+		return nil
+	case monitorruntime.FieldWatchdogAlertedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetWatchdogAlertedAt(v)
+		return nil
+	case monitorruntime.FieldLastPingAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastPingAt(v)
+		return nil
+	case monitorruntime.FieldCircuitOpenedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCircuitOpenedAt(v)
+		return nil
+	case monitorruntime.FieldCookies:
+		v, ok := value.([]schema.StoredCookie)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCookies(v)
+		return nil
+	case monitorruntime.FieldClaimedBy:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetClaimedBy(v)
+		return nil
+	case monitorruntime.FieldClaimedUntil:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetClaimedUntil(v)
+		return nil
+	case monitorruntime.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown MonitorRuntime field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *MonitorRuntimeMutation) AddedFields() []string {
+	var fields []string
+	if m.addcheck_count != nil {
+		fields = append(fields, monitorruntime.FieldCheckCount)
+	}
+	if m.addsuccess_count != nil {
+		fields = append(fields, monitorruntime.FieldSuccessCount)
+	}
+	if m.adderror_count != nil {
+		fields = append(fields, monitorruntime.FieldErrorCount)
+	}
+	if m.addretry_count != nil {
+		fields = append(fields, monitorruntime.FieldRetryCount)
+	}
+	if m.addconsecutive_successes != nil {
+		fields = append(fields, monitorruntime.FieldConsecutiveSuccesses)
+	}
+	if m.addconsecutive_errors != nil {
+		fields = append(fields, monitorruntime.FieldConsecutiveErrors)
+	}
+	if m.addlast_status_code != nil {
+		fields = append(fields, monitorruntime.FieldLastStatusCode)
+	}
+	if m.addlast_duration_ms != nil {
+		fields = append(fields, monitorruntime.FieldLastDurationMs)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *MonitorRuntimeMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case monitorruntime.FieldCheckCount:
+		return m.AddedCheckCount()
+	case monitorruntime.FieldSuccessCount:
+		return m.AddedSuccessCount()
+	case monitorruntime.FieldErrorCount:
+		return m.AddedErrorCount()
+	case monitorruntime.FieldRetryCount:
+		return m.AddedRetryCount()
+	case monitorruntime.FieldConsecutiveSuccesses:
+		return m.AddedConsecutiveSuccesses()
+	case monitorruntime.FieldConsecutiveErrors:
+		return m.AddedConsecutiveErrors()
+	case monitorruntime.FieldLastStatusCode:
+		return m.AddedLastStatusCode()
+	case monitorruntime.FieldLastDurationMs:
+		return m.AddedLastDurationMs()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *MonitorRuntimeMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case monitorruntime.FieldCheckCount:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddCheckCount(v)
+		return nil
+	case monitorruntime.FieldSuccessCount:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddSuccessCount(v)
+		return nil
+	case monitorruntime.FieldErrorCount:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddErrorCount(v)
+		return nil
+	case monitorruntime.FieldRetryCount:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddRetryCount(v)
+		return nil
+	case monitorruntime.FieldConsecutiveSuccesses:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddConsecutiveSuccesses(v)
+		return nil
+	case monitorruntime.FieldConsecutiveErrors:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddConsecutiveErrors(v)
+		return nil
+	case monitorruntime.FieldLastStatusCode:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddLastStatusCode(v)
+		return nil
+	case monitorruntime.FieldLastDurationMs:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddLastDurationMs(v)
+		return nil
+	}
+	return fmt.Errorf("unknown MonitorRuntime numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *MonitorRuntimeMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(monitorruntime.FieldLastCheckAt) {
+		fields = append(fields, monitorruntime.FieldLastCheckAt)
+	}
+	if m.FieldCleared(monitorruntime.FieldLastSuccessAt) {
+		fields = append(fields, monitorruntime.FieldLastSuccessAt)
+	}
+	if m.FieldCleared(monitorruntime.FieldLastErrorAt) {
+		fields = append(fields, monitorruntime.FieldLastErrorAt)
+	}
+	if m.FieldCleared(monitorruntime.FieldLastStatusCode) {
+		fields = append(fields, monitorruntime.FieldLastStatusCode)
+	}
+	if m.FieldCleared(monitorruntime.FieldLastDurationMs) {
+		fields = append(fields, monitorruntime.FieldLastDurationMs)
+	}
+	if m.FieldCleared(monitorruntime.FieldLastErrorMessage) {
+		fields = append(fields, monitorruntime.FieldLastErrorMessage)
+	}
+	if m.FieldCleared(monitorruntime.FieldNextRunAt) {
+		fields = append(fields, monitorruntime.FieldNextRunAt)
+	}
+	if m.FieldCleared(monitorruntime.FieldFailingSince) {
+		fields = append(fields, monitorruntime.FieldFailingSince)
+	}
+	if m.FieldCleared(monitorruntime.FieldEscalatedAt) {
+		fields = append(fields, monitorruntime.FieldEscalatedAt)
+	}
+	if m.FieldCleared(monitorruntime.FieldAcknowledgedAt) {
+		fields = append(fields, monitorruntime.FieldAcknowledgedAt)
+	}
+	if m.FieldCleared(monitorruntime.FieldDailyChangeCounts) {
+		fields = append(fields, monitorruntime.FieldDailyChangeCounts)
+	}
+	if m.FieldCleared(monitorruntime.FieldLastChangeAt) {
+		fields = append(fields, monitorruntime.FieldLastChangeAt)
+	}
+	if m.FieldCleared(monitorruntime.FieldErrorRepeatingSince) {
+		fields = append(fields, monitorruntime.FieldErrorRepeatingSince)
+	}
+	if m.FieldCleared(monitorruntime.FieldExpectChangeUntil) {
+		fields = append(fields, monitorruntime.FieldExpectChangeUntil)
+	}
+	if m.FieldCleared(monitorruntime.FieldWatchdogAlertedAt) {
+		fields = append(fields, monitorruntime.FieldWatchdogAlertedAt)
+	}
+	if m.FieldCleared(monitorruntime.FieldLastPingAt) {
+		fields = append(fields, monitorruntime.FieldLastPingAt)
+	}
+	if m.FieldCleared(monitorruntime.FieldCircuitOpenedAt) {
+		fields = append(fields, monitorruntime.FieldCircuitOpenedAt)
+	}
+	if m.FieldCleared(monitorruntime.FieldCookies) {
+		fields = append(fields, monitorruntime.FieldCookies)
+	}
+	if m.FieldCleared(monitorruntime.FieldClaimedBy) {
+		fields = append(fields, monitorruntime.FieldClaimedBy)
+	}
+	if m.FieldCleared(monitorruntime.FieldClaimedUntil) {
+		fields = append(fields, monitorruntime.FieldClaimedUntil)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *MonitorRuntimeMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *MonitorRuntimeMutation) ClearField(name string) error {
+	switch name {
+	case monitorruntime.FieldLastCheckAt:
+		m.ClearLastCheckAt()
+		return nil
+	case monitorruntime.FieldLastSuccessAt:
+		m.ClearLastSuccessAt()
+		return nil
+	case monitorruntime.FieldLastErrorAt:
+		m.ClearLastErrorAt()
+		return nil
+	case monitorruntime.FieldLastStatusCode:
+		m.ClearLastStatusCode()
+		return nil
+	case monitorruntime.FieldLastDurationMs:
+		m.ClearLastDurationMs()
+		return nil
+	case monitorruntime.FieldLastErrorMessage:
+		m.ClearLastErrorMessage()
+		return nil
+	case monitorruntime.FieldNextRunAt:
+		m.ClearNextRunAt()
+		return nil
+	case monitorruntime.FieldFailingSince:
+		m.ClearFailingSince()
+		return nil
+	case monitorruntime.FieldEscalatedAt:
+		m.ClearEscalatedAt()
+		return nil
+	case monitorruntime.FieldAcknowledgedAt:
+		m.ClearAcknowledgedAt()
+		return nil
+	case monitorruntime.FieldDailyChangeCounts:
+		m.ClearDailyChangeCounts()
+		return nil
+	case monitorruntime.FieldLastChangeAt:
+		m.ClearLastChangeAt()
+		return nil
+	case monitorruntime.FieldErrorRepeatingSince:
+		m.ClearErrorRepeatingSince()
+		return nil
+	case monitorruntime.FieldExpectChangeUntil:
+		m.ClearExpectChangeUntil()
+		return nil
+	case monitorruntime.FieldWatchdogAlertedAt:
+		m.ClearWatchdogAlertedAt()
+		return nil
+	case monitorruntime.FieldLastPingAt:
+		m.ClearLastPingAt()
+		return nil
+	case monitorruntime.FieldCircuitOpenedAt:
+		m.ClearCircuitOpenedAt()
+		return nil
+	case monitorruntime.FieldCookies:
+		m.ClearCookies()
+		return nil
+	case monitorruntime.FieldClaimedBy:
+		m.ClearClaimedBy()
+		return nil
+	case monitorruntime.FieldClaimedUntil:
+		m.ClearClaimedUntil()
+		return nil
+	}
+	return fmt.Errorf("unknown MonitorRuntime nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *MonitorRuntimeMutation) ResetField(name string) error {
+	switch name {
+	case monitorruntime.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case monitorruntime.FieldCheckCount:
+		m.ResetCheckCount()
+		return nil
+	case monitorruntime.FieldSuccessCount:
+		m.ResetSuccessCount()
+		return nil
+	case monitorruntime.FieldErrorCount:
+		m.ResetErrorCount()
+		return nil
+	case monitorruntime.FieldRetryCount:
+		m.ResetRetryCount()
+		return nil
+	case monitorruntime.FieldConsecutiveSuccesses:
+		m.ResetConsecutiveSuccesses()
+		return nil
+	case monitorruntime.FieldConsecutiveErrors:
+		m.ResetConsecutiveErrors()
+		return nil
+	case monitorruntime.FieldLastCheckAt:
+		m.ResetLastCheckAt()
+		return nil
+	case monitorruntime.FieldLastSuccessAt:
+		m.ResetLastSuccessAt()
+		return nil
+	case monitorruntime.FieldLastErrorAt:
+		m.ResetLastErrorAt()
+		return nil
+	case monitorruntime.FieldLastStatusCode:
+		m.ResetLastStatusCode()
+		return nil
+	case monitorruntime.FieldLastDurationMs:
+		m.ResetLastDurationMs()
+		return nil
+	case monitorruntime.FieldLastErrorMessage:
+		m.ResetLastErrorMessage()
+		return nil
+	case monitorruntime.FieldNextRunAt:
+		m.ResetNextRunAt()
+		return nil
+	case monitorruntime.FieldFailingSince:
+		m.ResetFailingSince()
+		return nil
+	case monitorruntime.FieldEscalatedAt:
+		m.ResetEscalatedAt()
+		return nil
+	case monitorruntime.FieldAcknowledgedAt:
+		m.ResetAcknowledgedAt()
+		return nil
+	case monitorruntime.FieldDailyChangeCounts:
+		m.ResetDailyChangeCounts()
+		return nil
+	case monitorruntime.FieldLastChangeAt:
+		m.ResetLastChangeAt()
+		return nil
+	case monitorruntime.FieldErrorRepeatingSince:
+		m.ResetErrorRepeatingSince()
+		return nil
+	case monitorruntime.FieldExpectChangeUntil:
+		m.ResetExpectChangeUntil()
+		return nil
+	case monitorruntime.FieldWatchdogAlertedAt:
+		m.ResetWatchdogAlertedAt()
+		return nil
+	case monitorruntime.FieldLastPingAt:
+		m.ResetLastPingAt()
+		return nil
+	case monitorruntime.FieldCircuitOpenedAt:
+		m.ResetCircuitOpenedAt()
+		return nil
+	case monitorruntime.FieldCookies:
+		m.ResetCookies()
+		return nil
+	case monitorruntime.FieldClaimedBy:
+		m.ResetClaimedBy()
+		return nil
+	case monitorruntime.FieldClaimedUntil:
+		m.ResetClaimedUntil()
+		return nil
+	case monitorruntime.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown MonitorRuntime field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *MonitorRuntimeMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.monitor != nil {
+		edges = append(edges, monitorruntime.EdgeMonitor)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *MonitorRuntimeMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case monitorruntime.EdgeMonitor:
+		if id := m.monitor; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *MonitorRuntimeMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *MonitorRuntimeMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *MonitorRuntimeMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedmonitor {
+		edges = append(edges, monitorruntime.EdgeMonitor)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *MonitorRuntimeMutation) EdgeCleared(name string) bool {
+	switch name {
+	case monitorruntime.EdgeMonitor:
+		return m.clearedmonitor
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *MonitorRuntimeMutation) ClearEdge(name string) error {
+	switch name {
+	case monitorruntime.EdgeMonitor:
+		m.ClearMonitor()
+		return nil
+	}
+	return fmt.Errorf("unknown MonitorRuntime unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *MonitorRuntimeMutation) ResetEdge(name string) error {
+	switch name {
+	case monitorruntime.EdgeMonitor:
+		m.ResetMonitor()
+		return nil
+	}
+	return fmt.Errorf("unknown MonitorRuntime edge %s", name)
+}
+
+// MonitorVersionMutation represents an operation that mutates the MonitorVersion nodes in the graph.
+type MonitorVersionMutation struct {
+	config
+	op             Op
+	typ            string
+	id             *int
+	version        *int
+	addversion     *int
+	_config        *json.RawMessage
+	append_config  json.RawMessage
+	created_by     *string
+	created_at     *time.Time
+	clearedFields  map[string]struct{}
+	monitor        *int
+	clearedmonitor bool
+	done           bool
+	oldValue       func(context.Context) (*MonitorVersion, error)
+	predicates     []predicate.MonitorVersion
+}
+
+var _ ent.Mutation = (*MonitorVersionMutation)(nil)
+
+// monitorversionOption allows management of the mutation configuration using functional options.
+type monitorversionOption func(*MonitorVersionMutation)
+
+// newMonitorVersionMutation creates new mutation for the MonitorVersion entity.
+func newMonitorVersionMutation(c config, op Op, opts ...monitorversionOption) *MonitorVersionMutation {
+	m := &MonitorVersionMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeMonitorVersion,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withMonitorVersionID sets the ID field of the mutation.
+func withMonitorVersionID(id int) monitorversionOption {
+	return func(m *MonitorVersionMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *MonitorVersion
+		)
+		m.oldValue = func(ctx context.Context) (*MonitorVersion, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().MonitorVersion.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withMonitorVersion sets the old MonitorVersion of the mutation.
+func withMonitorVersion(node *MonitorVersion) monitorversionOption {
+	return func(m *MonitorVersionMutation) {
+		m.oldValue = func(context.Context) (*MonitorVersion, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m MonitorVersionMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m MonitorVersionMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *MonitorVersionMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *MonitorVersionMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().MonitorVersion.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetMonitorID sets the "monitor_id" field.
+func (m *MonitorVersionMutation) SetMonitorID(i int) {
+	m.monitor = &i
+}
+
+// MonitorID returns the value of the "monitor_id" field in the mutation.
+func (m *MonitorVersionMutation) MonitorID() (r int, exists bool) {
+	v := m.monitor
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMonitorID returns the old "monitor_id" field's value of the MonitorVersion entity.
+// If the MonitorVersion object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MonitorVersionMutation) OldMonitorID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMonitorID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMonitorID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMonitorID: %w", err)
+	}
+	return oldValue.MonitorID, nil
+}
+
+// ResetMonitorID resets all changes to the "monitor_id" field.
+func (m *MonitorVersionMutation) ResetMonitorID() {
+	m.monitor = nil
+}
+
+// SetVersion sets the "version" field.
+func (m *MonitorVersionMutation) SetVersion(i int) {
+	m.version = &i
+	m.addversion = nil
+}
+
+// Version returns the value of the "version" field in the mutation.
+func (m *MonitorVersionMutation) Version() (r int, exists bool) {
+	v := m.version
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldVersion returns the old "version" field's value of the MonitorVersion entity.
+// If the MonitorVersion object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MonitorVersionMutation) OldVersion(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldVersion is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldVersion requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldVersion: %w", err)
+	}
+	return oldValue.Version, nil
+}
+
+// AddVersion adds i to the "version" field.
+func (m *MonitorVersionMutation) AddVersion(i int) {
+	if m.addversion != nil {
+		*m.addversion += i
+	} else {
+		m.addversion = &i
+	}
+}
+
+// AddedVersion returns the value that was added to the "version" field in this mutation.
+func (m *MonitorVersionMutation) AddedVersion() (r int, exists bool) {
+	v := m.addversion
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetVersion resets all changes to the "version" field.
+func (m *MonitorVersionMutation) ResetVersion() {
+	m.version = nil
+	m.addversion = nil
+}
+
+// SetConfig sets the "config" field.
+func (m *MonitorVersionMutation) SetConfig(jm json.RawMessage) {
+	m._config = &jm
+	m.append_config = nil
+}
+
+// Config returns the value of the "config" field in the mutation.
+func (m *MonitorVersionMutation) Config() (r json.RawMessage, exists bool) {
+	v := m._config
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldConfig returns the old "config" field's value of the MonitorVersion entity.
+// If the MonitorVersion object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MonitorVersionMutation) OldConfig(ctx context.Context) (v json.RawMessage, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldConfig is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldConfig requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldConfig: %w", err)
+	}
+	return oldValue.Config, nil
 }
 
-// Headers returns the value of the "headers" field in the mutation.
-func (m *MonitorMutation) Headers() (r map[string]string, exists bool) {
-	v := m.headers
+// AppendConfig adds jm to the "config" field.
+func (m *MonitorVersionMutation) AppendConfig(jm json.RawMessage) {
+	m.append_config = append(m.append_config, jm...)
+}
+
+// AppendedConfig returns the list of values that were appended to the "config" field in this mutation.
+func (m *MonitorVersionMutation) AppendedConfig() (json.RawMessage, bool) {
+	if len(m.append_config) == 0 {
+		return nil, false
+	}
+	return m.append_config, true
+}
+
+// ResetConfig resets all changes to the "config" field.
+func (m *MonitorVersionMutation) ResetConfig() {
+	m._config = nil
+	m.append_config = nil
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (m *MonitorVersionMutation) SetCreatedBy(s string) {
+	m.created_by = &s
+}
+
+// CreatedBy returns the value of the "created_by" field in the mutation.
+func (m *MonitorVersionMutation) CreatedBy() (r string, exists bool) {
+	v := m.created_by
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldHeaders returns the old "headers" field's value of the Monitor entity.
-// If the Monitor object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedBy returns the old "created_by" field's value of the MonitorVersion entity.
+// If the MonitorVersion object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MonitorMutation) OldHeaders(ctx context.Context) (v map[string]string, err error) {
+func (m *MonitorVersionMutation) OldCreatedBy(ctx context.Context) (v *string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldHeaders is only allowed on UpdateOne operations")
+		return v, errors.New("OldCreatedBy is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldHeaders requires an ID field in the mutation")
+		return v, errors.New("OldCreatedBy requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldHeaders: %w", err)
+		return v, fmt.Errorf("querying old value for OldCreatedBy: %w", err)
 	}
-	return oldValue.Headers, nil
+	return oldValue.CreatedBy, nil
 }
 
-// ClearHeaders clears the value of the "headers" field.
-func (m *MonitorMutation) ClearHeaders() {
-	m.headers = nil
-	m.clearedFields[monitor.FieldHeaders] = struct{}{}
+// ClearCreatedBy clears the value of the "created_by" field.
+func (m *MonitorVersionMutation) ClearCreatedBy() {
+	m.created_by = nil
+	m.clearedFields[monitorversion.FieldCreatedBy] = struct{}{}
 }
 
-// HeadersCleared returns if the "headers" field was cleared in this mutation.
-func (m *MonitorMutation) HeadersCleared() bool {
-	_, ok := m.clearedFields[monitor.FieldHeaders]
+// CreatedByCleared returns if the "created_by" field was cleared in this mutation.
+func (m *MonitorVersionMutation) CreatedByCleared() bool {
+	_, ok := m.clearedFields[monitorversion.FieldCreatedBy]
+	return ok
+}
+
+// ResetCreatedBy resets all changes to the "created_by" field.
+func (m *MonitorVersionMutation) ResetCreatedBy() {
+	m.created_by = nil
+	delete(m.clearedFields, monitorversion.FieldCreatedBy)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *MonitorVersionMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *MonitorVersionMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the MonitorVersion entity.
+// If the MonitorVersion object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MonitorVersionMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *MonitorVersionMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// ClearMonitor clears the "monitor" edge to the Monitor entity.
+func (m *MonitorVersionMutation) ClearMonitor() {
+	m.clearedmonitor = true
+	m.clearedFields[monitorversion.FieldMonitorID] = struct{}{}
+}
+
+// MonitorCleared reports if the "monitor" edge to the Monitor entity was cleared.
+func (m *MonitorVersionMutation) MonitorCleared() bool {
+	return m.clearedmonitor
+}
+
+// MonitorIDs returns the "monitor" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// MonitorID instead. It exists only for internal usage by the builders.
+func (m *MonitorVersionMutation) MonitorIDs() (ids []int) {
+	if id := m.monitor; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetMonitor resets all changes to the "monitor" edge.
+func (m *MonitorVersionMutation) ResetMonitor() {
+	m.monitor = nil
+	m.clearedmonitor = false
+}
+
+// Where appends a list predicates to the MonitorVersionMutation builder.
+func (m *MonitorVersionMutation) Where(ps ...predicate.MonitorVersion) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the MonitorVersionMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *MonitorVersionMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.MonitorVersion, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *MonitorVersionMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *MonitorVersionMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (MonitorVersion).
+func (m *MonitorVersionMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *MonitorVersionMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m.monitor != nil {
+		fields = append(fields, monitorversion.FieldMonitorID)
+	}
+	if m.version != nil {
+		fields = append(fields, monitorversion.FieldVersion)
+	}
+	if m._config != nil {
+		fields = append(fields, monitorversion.FieldConfig)
+	}
+	if m.created_by != nil {
+		fields = append(fields, monitorversion.FieldCreatedBy)
+	}
+	if m.created_at != nil {
+		fields = append(fields, monitorversion.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *MonitorVersionMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case monitorversion.FieldMonitorID:
+		return m.MonitorID()
+	case monitorversion.FieldVersion:
+		return m.Version()
+	case monitorversion.FieldConfig:
+		return m.Config()
+	case monitorversion.FieldCreatedBy:
+		return m.CreatedBy()
+	case monitorversion.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *MonitorVersionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case monitorversion.FieldMonitorID:
+		return m.OldMonitorID(ctx)
+	case monitorversion.FieldVersion:
+		return m.OldVersion(ctx)
+	case monitorversion.FieldConfig:
+		return m.OldConfig(ctx)
+	case monitorversion.FieldCreatedBy:
+		return m.OldCreatedBy(ctx)
+	case monitorversion.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown MonitorVersion field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *MonitorVersionMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case monitorversion.FieldMonitorID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMonitorID(v)
+		return nil
+	case monitorversion.FieldVersion:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVersion(v)
+		return nil
+	case monitorversion.FieldConfig:
+		v, ok := value.(json.RawMessage)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetConfig(v)
+		return nil
+	case monitorversion.FieldCreatedBy:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedBy(v)
+		return nil
+	case monitorversion.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown MonitorVersion field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *MonitorVersionMutation) AddedFields() []string {
+	var fields []string
+	if m.addversion != nil {
+		fields = append(fields, monitorversion.FieldVersion)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *MonitorVersionMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case monitorversion.FieldVersion:
+		return m.AddedVersion()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *MonitorVersionMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case monitorversion.FieldVersion:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddVersion(v)
+		return nil
+	}
+	return fmt.Errorf("unknown MonitorVersion numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *MonitorVersionMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(monitorversion.FieldCreatedBy) {
+		fields = append(fields, monitorversion.FieldCreatedBy)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *MonitorVersionMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
 	return ok
 }
 
-// ResetHeaders resets all changes to the "headers" field.
-func (m *MonitorMutation) ResetHeaders() {
-	m.headers = nil
-	delete(m.clearedFields, monitor.FieldHeaders)
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *MonitorVersionMutation) ClearField(name string) error {
+	switch name {
+	case monitorversion.FieldCreatedBy:
+		m.ClearCreatedBy()
+		return nil
+	}
+	return fmt.Errorf("unknown MonitorVersion nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *MonitorVersionMutation) ResetField(name string) error {
+	switch name {
+	case monitorversion.FieldMonitorID:
+		m.ResetMonitorID()
+		return nil
+	case monitorversion.FieldVersion:
+		m.ResetVersion()
+		return nil
+	case monitorversion.FieldConfig:
+		m.ResetConfig()
+		return nil
+	case monitorversion.FieldCreatedBy:
+		m.ResetCreatedBy()
+		return nil
+	case monitorversion.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown MonitorVersion field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *MonitorVersionMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.monitor != nil {
+		edges = append(edges, monitorversion.EdgeMonitor)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *MonitorVersionMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case monitorversion.EdgeMonitor:
+		if id := m.monitor; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *MonitorVersionMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *MonitorVersionMutation) RemovedIDs(name string) []ent.Value {
+	return nil
 }
 
-// SetAuth sets the "auth" field.
-func (m *MonitorMutation) SetAuth(value map[string]string) {
-	m.auth = &value
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *MonitorVersionMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedmonitor {
+		edges = append(edges, monitorversion.EdgeMonitor)
+	}
+	return edges
 }
 
-// Auth returns the value of the "auth" field in the mutation.
-func (m *MonitorMutation) Auth() (r map[string]string, exists bool) {
-	v := m.auth
-	if v == nil {
-		return
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *MonitorVersionMutation) EdgeCleared(name string) bool {
+	switch name {
+	case monitorversion.EdgeMonitor:
+		return m.clearedmonitor
 	}
-	return *v, true
+	return false
 }
 
-// OldAuth returns the old "auth" field's value of the Monitor entity.
-// If the Monitor object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MonitorMutation) OldAuth(ctx context.Context) (v map[string]string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldAuth is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldAuth requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldAuth: %w", err)
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *MonitorVersionMutation) ClearEdge(name string) error {
+	switch name {
+	case monitorversion.EdgeMonitor:
+		m.ClearMonitor()
+		return nil
 	}
-	return oldValue.Auth, nil
+	return fmt.Errorf("unknown MonitorVersion unique edge %s", name)
 }
 
-// ClearAuth clears the value of the "auth" field.
-func (m *MonitorMutation) ClearAuth() {
-	m.auth = nil
-	m.clearedFields[monitor.FieldAuth] = struct{}{}
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *MonitorVersionMutation) ResetEdge(name string) error {
+	switch name {
+	case monitorversion.EdgeMonitor:
+		m.ResetMonitor()
+		return nil
+	}
+	return fmt.Errorf("unknown MonitorVersion edge %s", name)
 }
 
-// AuthCleared returns if the "auth" field was cleared in this mutation.
-func (m *MonitorMutation) AuthCleared() bool {
-	_, ok := m.clearedFields[monitor.FieldAuth]
-	return ok
+// NotificationChannelMutation represents an operation that mutates the NotificationChannel nodes in the graph.
+type NotificationChannelMutation struct {
+	config
+	op                         Op
+	typ                        string
+	id                         *int
+	name                       *string
+	kind                       *notificationchannel.Kind
+	bot_token                  *string
+	chat_id                    *string
+	enabled                    *bool
+	created_at                 *time.Time
+	updated_at                 *time.Time
+	clearedFields              map[string]struct{}
+	notification_events        map[int]struct{}
+	removednotification_events map[int]struct{}
+	clearednotification_events bool
+	done                       bool
+	oldValue                   func(context.Context) (*NotificationChannel, error)
+	predicates                 []predicate.NotificationChannel
 }
 
-// ResetAuth resets all changes to the "auth" field.
-func (m *MonitorMutation) ResetAuth() {
-	m.auth = nil
-	delete(m.clearedFields, monitor.FieldAuth)
-}
+var _ ent.Mutation = (*NotificationChannelMutation)(nil)
 
-// SetNotificationChannels sets the "notification_channels" field.
-func (m *MonitorMutation) SetNotificationChannels(s []string) {
-	m.notification_channels = &s
-	m.appendnotification_channels = nil
-}
+// notificationchannelOption allows management of the mutation configuration using functional options.
+type notificationchannelOption func(*NotificationChannelMutation)
 
-// NotificationChannels returns the value of the "notification_channels" field in the mutation.
-func (m *MonitorMutation) NotificationChannels() (r []string, exists bool) {
-	v := m.notification_channels
-	if v == nil {
-		return
+// newNotificationChannelMutation creates new mutation for the NotificationChannel entity.
+func newNotificationChannelMutation(c config, op Op, opts ...notificationchannelOption) *NotificationChannelMutation {
+	m := &NotificationChannelMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeNotificationChannel,
+		clearedFields: make(map[string]struct{}),
 	}
-	return *v, true
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
 }
 
-// OldNotificationChannels returns the old "notification_channels" field's value of the Monitor entity.
-// If the Monitor object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MonitorMutation) OldNotificationChannels(ctx context.Context) (v []string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldNotificationChannels is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldNotificationChannels requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldNotificationChannels: %w", err)
+// withNotificationChannelID sets the ID field of the mutation.
+func withNotificationChannelID(id int) notificationchannelOption {
+	return func(m *NotificationChannelMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *NotificationChannel
+		)
+		m.oldValue = func(ctx context.Context) (*NotificationChannel, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().NotificationChannel.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
 	}
-	return oldValue.NotificationChannels, nil
 }
 
-// AppendNotificationChannels adds s to the "notification_channels" field.
-func (m *MonitorMutation) AppendNotificationChannels(s []string) {
-	m.appendnotification_channels = append(m.appendnotification_channels, s...)
+// withNotificationChannel sets the old NotificationChannel of the mutation.
+func withNotificationChannel(node *NotificationChannel) notificationchannelOption {
+	return func(m *NotificationChannelMutation) {
+		m.oldValue = func(context.Context) (*NotificationChannel, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
 }
 
-// AppendedNotificationChannels returns the list of values that were appended to the "notification_channels" field in this mutation.
-func (m *MonitorMutation) AppendedNotificationChannels() ([]string, bool) {
-	if len(m.appendnotification_channels) == 0 {
-		return nil, false
-	}
-	return m.appendnotification_channels, true
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m NotificationChannelMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
 }
 
-// ClearNotificationChannels clears the value of the "notification_channels" field.
-func (m *MonitorMutation) ClearNotificationChannels() {
-	m.notification_channels = nil
-	m.appendnotification_channels = nil
-	m.clearedFields[monitor.FieldNotificationChannels] = struct{}{}
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m NotificationChannelMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
 }
 
-// NotificationChannelsCleared returns if the "notification_channels" field was cleared in this mutation.
-func (m *MonitorMutation) NotificationChannelsCleared() bool {
-	_, ok := m.clearedFields[monitor.FieldNotificationChannels]
-	return ok
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *NotificationChannelMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
 }
 
-// ResetNotificationChannels resets all changes to the "notification_channels" field.
-func (m *MonitorMutation) ResetNotificationChannels() {
-	m.notification_channels = nil
-	m.appendnotification_channels = nil
-	delete(m.clearedFields, monitor.FieldNotificationChannels)
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *NotificationChannelMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().NotificationChannel.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
 }
 
-// SetSelector sets the "selector" field.
-func (m *MonitorMutation) SetSelector(s string) {
-	m.selector = &s
+// SetName sets the "name" field.
+func (m *NotificationChannelMutation) SetName(s string) {
+	m.name = &s
 }
 
-// Selector returns the value of the "selector" field in the mutation.
-func (m *MonitorMutation) Selector() (r string, exists bool) {
-	v := m.selector
+// Name returns the value of the "name" field in the mutation.
+func (m *NotificationChannelMutation) Name() (r string, exists bool) {
+	v := m.name
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldSelector returns the old "selector" field's value of the Monitor entity.
-// If the Monitor object wasn't provided to the builder, the object is fetched from the database.
+// OldName returns the old "name" field's value of the NotificationChannel entity.
+// If the NotificationChannel object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MonitorMutation) OldSelector(ctx context.Context) (v *string, err error) {
+func (m *NotificationChannelMutation) OldName(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSelector is only allowed on UpdateOne operations")
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSelector requires an ID field in the mutation")
+		return v, errors.New("OldName requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSelector: %w", err)
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
 	}
-	return oldValue.Selector, nil
-}
-
-// ClearSelector clears the value of the "selector" field.
-func (m *MonitorMutation) ClearSelector() {
-	m.selector = nil
-	m.clearedFields[monitor.FieldSelector] = struct{}{}
-}
-
-// SelectorCleared returns if the "selector" field was cleared in this mutation.
-func (m *MonitorMutation) SelectorCleared() bool {
-	_, ok := m.clearedFields[monitor.FieldSelector]
-	return ok
-}
-
-// ResetSelector resets all changes to the "selector" field.
-func (m *MonitorMutation) ResetSelector() {
-	m.selector = nil
-	delete(m.clearedFields, monitor.FieldSelector)
+	return oldValue.Name, nil
 }
 
-// SetExpectedType sets the "expected_type" field.
-func (m *MonitorMutation) SetExpectedType(mt monitor.ExpectedType) {
-	m.expected_type = &mt
+// ResetName resets all changes to the "name" field.
+func (m *NotificationChannelMutation) ResetName() {
+	m.name = nil
 }
 
-// ExpectedType returns the value of the "expected_type" field in the mutation.
-func (m *MonitorMutation) ExpectedType() (r monitor.ExpectedType, exists bool) {
-	v := m.expected_type
+// SetKind sets the "kind" field.
+func (m *NotificationChannelMutation) SetKind(n notificationchannel.Kind) {
+	m.kind = &n
+}
+
+// Kind returns the value of the "kind" field in the mutation.
+func (m *NotificationChannelMutation) Kind() (r notificationchannel.Kind, exists bool) {
+	v := m.kind
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldExpectedType returns the old "expected_type" field's value of the Monitor entity.
-// If the Monitor object wasn't provided to the builder, the object is fetched from the database.
+// OldKind returns the old "kind" field's value of the NotificationChannel entity.
+// If the NotificationChannel object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MonitorMutation) OldExpectedType(ctx context.Context) (v monitor.ExpectedType, err error) {
+func (m *NotificationChannelMutation) OldKind(ctx context.Context) (v notificationchannel.Kind, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldExpectedType is only allowed on UpdateOne operations")
+		return v, errors.New("OldKind is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldExpectedType requires an ID field in the mutation")
+		return v, errors.New("OldKind requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldExpectedType: %w", err)
+		return v, fmt.Errorf("querying old value for OldKind: %w", err)
 	}
-	return oldValue.ExpectedType, nil
+	return oldValue.Kind, nil
 }
 
-// ResetExpectedType resets all changes to the "expected_type" field.
-func (m *MonitorMutation) ResetExpectedType() {
-	m.expected_type = nil
+// ResetKind resets all changes to the "kind" field.
+func (m *NotificationChannelMutation) ResetKind() {
+	m.kind = nil
 }
 
-// SetExpectedResponse sets the "expected_response" field.
-func (m *MonitorMutation) SetExpectedResponse(s string) {
-	m.expected_response = &s
+// SetBotToken sets the "bot_token" field.
+func (m *NotificationChannelMutation) SetBotToken(s string) {
+	m.bot_token = &s
 }
 
-// ExpectedResponse returns the value of the "expected_response" field in the mutation.
-func (m *MonitorMutation) ExpectedResponse() (r string, exists bool) {
-	v := m.expected_response
+// BotToken returns the value of the "bot_token" field in the mutation.
+func (m *NotificationChannelMutation) BotToken() (r string, exists bool) {
+	v := m.bot_token
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldExpectedResponse returns the old "expected_response" field's value of the Monitor entity.
-// If the Monitor object wasn't provided to the builder, the object is fetched from the database.
+// OldBotToken returns the old "bot_token" field's value of the NotificationChannel entity.
+// If the NotificationChannel object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MonitorMutation) OldExpectedResponse(ctx context.Context) (v *string, err error) {
+func (m *NotificationChannelMutation) OldBotToken(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldExpectedResponse is only allowed on UpdateOne operations")
+		return v, errors.New("OldBotToken is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldExpectedResponse requires an ID field in the mutation")
+		return v, errors.New("OldBotToken requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldExpectedResponse: %w", err)
+		return v, fmt.Errorf("querying old value for OldBotToken: %w", err)
 	}
-	return oldValue.ExpectedResponse, nil
-}
-
-// ClearExpectedResponse clears the value of the "expected_response" field.
-func (m *MonitorMutation) ClearExpectedResponse() {
-	m.expected_response = nil
-	m.clearedFields[monitor.FieldExpectedResponse] = struct{}{}
-}
-
-// ExpectedResponseCleared returns if the "expected_response" field was cleared in this mutation.
-func (m *MonitorMutation) ExpectedResponseCleared() bool {
-	_, ok := m.clearedFields[monitor.FieldExpectedResponse]
-	return ok
+	return oldValue.BotToken, nil
 }
 
-// ResetExpectedResponse resets all changes to the "expected_response" field.
-func (m *MonitorMutation) ResetExpectedResponse() {
-	m.expected_response = nil
-	delete(m.clearedFields, monitor.FieldExpectedResponse)
+// ResetBotToken resets all changes to the "bot_token" field.
+func (m *NotificationChannelMutation) ResetBotToken() {
+	m.bot_token = nil
 }
 
-// SetCron sets the "cron" field.
-func (m *MonitorMutation) SetCron(s string) {
-	m.cron = &s
+// SetChatID sets the "chat_id" field.
+func (m *NotificationChannelMutation) SetChatID(s string) {
+	m.chat_id = &s
 }
 
-// Cron returns the value of the "cron" field in the mutation.
-func (m *MonitorMutation) Cron() (r string, exists bool) {
-	v := m.cron
+// ChatID returns the value of the "chat_id" field in the mutation.
+func (m *NotificationChannelMutation) ChatID() (r string, exists bool) {
+	v := m.chat_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCron returns the old "cron" field's value of the Monitor entity.
-// If the Monitor object wasn't provided to the builder, the object is fetched from the database.
+// OldChatID returns the old "chat_id" field's value of the NotificationChannel entity.
+// If the NotificationChannel object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MonitorMutation) OldCron(ctx context.Context) (v string, err error) {
+func (m *NotificationChannelMutation) OldChatID(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCron is only allowed on UpdateOne operations")
+		return v, errors.New("OldChatID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCron requires an ID field in the mutation")
+		return v, errors.New("OldChatID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCron: %w", err)
+		return v, fmt.Errorf("querying old value for OldChatID: %w", err)
 	}
-	return oldValue.Cron, nil
+	return oldValue.ChatID, nil
 }
 
-// ResetCron resets all changes to the "cron" field.
-func (m *MonitorMutation) ResetCron() {
-	m.cron = nil
+// ResetChatID resets all changes to the "chat_id" field.
+func (m *NotificationChannelMutation) ResetChatID() {
+	m.chat_id = nil
 }
 
 // SetEnabled sets the "enabled" field.
-func (m *MonitorMutation) SetEnabled(b bool) {
+func (m *NotificationChannelMutation) SetEnabled(b bool) {
 	m.enabled = &b
 }
 
 // Enabled returns the value of the "enabled" field in the mutation.
-func (m *MonitorMutation) Enabled() (r bool, exists bool) {
+func (m *NotificationChannelMutation) Enabled() (r bool, exists bool) {
 	v := m.enabled
 	if v == nil {
 		return
@@ -1896,10 +11879,10 @@ func (m *MonitorMutation) Enabled() (r bool, exists bool) {
 	return *v, true
 }
 
-// OldEnabled returns the old "enabled" field's value of the Monitor entity.
-// If the Monitor object wasn't provided to the builder, the object is fetched from the database.
+// OldEnabled returns the old "enabled" field's value of the NotificationChannel entity.
+// If the NotificationChannel object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MonitorMutation) OldEnabled(ctx context.Context) (v bool, err error) {
+func (m *NotificationChannelMutation) OldEnabled(ctx context.Context) (v bool, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldEnabled is only allowed on UpdateOne operations")
 	}
@@ -1914,17 +11897,17 @@ func (m *MonitorMutation) OldEnabled(ctx context.Context) (v bool, err error) {
 }
 
 // ResetEnabled resets all changes to the "enabled" field.
-func (m *MonitorMutation) ResetEnabled() {
+func (m *NotificationChannelMutation) ResetEnabled() {
 	m.enabled = nil
 }
 
 // SetCreatedAt sets the "created_at" field.
-func (m *MonitorMutation) SetCreatedAt(t time.Time) {
+func (m *NotificationChannelMutation) SetCreatedAt(t time.Time) {
 	m.created_at = &t
 }
 
 // CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *MonitorMutation) CreatedAt() (r time.Time, exists bool) {
+func (m *NotificationChannelMutation) CreatedAt() (r time.Time, exists bool) {
 	v := m.created_at
 	if v == nil {
 		return
@@ -1932,10 +11915,10 @@ func (m *MonitorMutation) CreatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Monitor entity.
-// If the Monitor object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the NotificationChannel entity.
+// If the NotificationChannel object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MonitorMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *NotificationChannelMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
@@ -1950,17 +11933,17 @@ func (m *MonitorMutation) OldCreatedAt(ctx context.Context) (v time.Time, err er
 }
 
 // ResetCreatedAt resets all changes to the "created_at" field.
-func (m *MonitorMutation) ResetCreatedAt() {
+func (m *NotificationChannelMutation) ResetCreatedAt() {
 	m.created_at = nil
 }
 
 // SetUpdatedAt sets the "updated_at" field.
-func (m *MonitorMutation) SetUpdatedAt(t time.Time) {
+func (m *NotificationChannelMutation) SetUpdatedAt(t time.Time) {
 	m.updated_at = &t
 }
 
 // UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *MonitorMutation) UpdatedAt() (r time.Time, exists bool) {
+func (m *NotificationChannelMutation) UpdatedAt() (r time.Time, exists bool) {
 	v := m.updated_at
 	if v == nil {
 		return
@@ -1968,10 +11951,10 @@ func (m *MonitorMutation) UpdatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the Monitor entity.
-// If the Monitor object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdatedAt returns the old "updated_at" field's value of the NotificationChannel entity.
+// If the NotificationChannel object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MonitorMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *NotificationChannelMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
@@ -1986,66 +11969,12 @@ func (m *MonitorMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err er
 }
 
 // ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *MonitorMutation) ResetUpdatedAt() {
+func (m *NotificationChannelMutation) ResetUpdatedAt() {
 	m.updated_at = nil
 }
 
-// AddCheckResultIDs adds the "check_results" edge to the CheckResult entity by ids.
-func (m *MonitorMutation) AddCheckResultIDs(ids ...int) {
-	if m.check_results == nil {
-		m.check_results = make(map[int]struct{})
-	}
-	for i := range ids {
-		m.check_results[ids[i]] = struct{}{}
-	}
-}
-
-// ClearCheckResults clears the "check_results" edge to the CheckResult entity.
-func (m *MonitorMutation) ClearCheckResults() {
-	m.clearedcheck_results = true
-}
-
-// CheckResultsCleared reports if the "check_results" edge to the CheckResult entity was cleared.
-func (m *MonitorMutation) CheckResultsCleared() bool {
-	return m.clearedcheck_results
-}
-
-// RemoveCheckResultIDs removes the "check_results" edge to the CheckResult entity by IDs.
-func (m *MonitorMutation) RemoveCheckResultIDs(ids ...int) {
-	if m.removedcheck_results == nil {
-		m.removedcheck_results = make(map[int]struct{})
-	}
-	for i := range ids {
-		delete(m.check_results, ids[i])
-		m.removedcheck_results[ids[i]] = struct{}{}
-	}
-}
-
-// RemovedCheckResults returns the removed IDs of the "check_results" edge to the CheckResult entity.
-func (m *MonitorMutation) RemovedCheckResultsIDs() (ids []int) {
-	for id := range m.removedcheck_results {
-		ids = append(ids, id)
-	}
-	return
-}
-
-// CheckResultsIDs returns the "check_results" edge IDs in the mutation.
-func (m *MonitorMutation) CheckResultsIDs() (ids []int) {
-	for id := range m.check_results {
-		ids = append(ids, id)
-	}
-	return
-}
-
-// ResetCheckResults resets all changes to the "check_results" edge.
-func (m *MonitorMutation) ResetCheckResults() {
-	m.check_results = nil
-	m.clearedcheck_results = false
-	m.removedcheck_results = nil
-}
-
 // AddNotificationEventIDs adds the "notification_events" edge to the NotificationEvent entity by ids.
-func (m *MonitorMutation) AddNotificationEventIDs(ids ...int) {
+func (m *NotificationChannelMutation) AddNotificationEventIDs(ids ...int) {
 	if m.notification_events == nil {
 		m.notification_events = make(map[int]struct{})
 	}
@@ -2055,97 +11984,58 @@ func (m *MonitorMutation) AddNotificationEventIDs(ids ...int) {
 }
 
 // ClearNotificationEvents clears the "notification_events" edge to the NotificationEvent entity.
-func (m *MonitorMutation) ClearNotificationEvents() {
+func (m *NotificationChannelMutation) ClearNotificationEvents() {
 	m.clearednotification_events = true
 }
 
-// NotificationEventsCleared reports if the "notification_events" edge to the NotificationEvent entity was cleared.
-func (m *MonitorMutation) NotificationEventsCleared() bool {
-	return m.clearednotification_events
-}
-
-// RemoveNotificationEventIDs removes the "notification_events" edge to the NotificationEvent entity by IDs.
-func (m *MonitorMutation) RemoveNotificationEventIDs(ids ...int) {
-	if m.removednotification_events == nil {
-		m.removednotification_events = make(map[int]struct{})
-	}
-	for i := range ids {
-		delete(m.notification_events, ids[i])
-		m.removednotification_events[ids[i]] = struct{}{}
-	}
-}
-
-// RemovedNotificationEvents returns the removed IDs of the "notification_events" edge to the NotificationEvent entity.
-func (m *MonitorMutation) RemovedNotificationEventsIDs() (ids []int) {
-	for id := range m.removednotification_events {
-		ids = append(ids, id)
-	}
-	return
-}
-
-// NotificationEventsIDs returns the "notification_events" edge IDs in the mutation.
-func (m *MonitorMutation) NotificationEventsIDs() (ids []int) {
-	for id := range m.notification_events {
-		ids = append(ids, id)
-	}
-	return
-}
-
-// ResetNotificationEvents resets all changes to the "notification_events" edge.
-func (m *MonitorMutation) ResetNotificationEvents() {
-	m.notification_events = nil
-	m.clearednotification_events = false
-	m.removednotification_events = nil
-}
-
-// SetRuntimeID sets the "runtime" edge to the MonitorRuntime entity by id.
-func (m *MonitorMutation) SetRuntimeID(id int) {
-	m.runtime = &id
-}
-
-// ClearRuntime clears the "runtime" edge to the MonitorRuntime entity.
-func (m *MonitorMutation) ClearRuntime() {
-	m.clearedruntime = true
+// NotificationEventsCleared reports if the "notification_events" edge to the NotificationEvent entity was cleared.
+func (m *NotificationChannelMutation) NotificationEventsCleared() bool {
+	return m.clearednotification_events
 }
 
-// RuntimeCleared reports if the "runtime" edge to the MonitorRuntime entity was cleared.
-func (m *MonitorMutation) RuntimeCleared() bool {
-	return m.clearedruntime
+// RemoveNotificationEventIDs removes the "notification_events" edge to the NotificationEvent entity by IDs.
+func (m *NotificationChannelMutation) RemoveNotificationEventIDs(ids ...int) {
+	if m.removednotification_events == nil {
+		m.removednotification_events = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.notification_events, ids[i])
+		m.removednotification_events[ids[i]] = struct{}{}
+	}
 }
 
-// RuntimeID returns the "runtime" edge ID in the mutation.
-func (m *MonitorMutation) RuntimeID() (id int, exists bool) {
-	if m.runtime != nil {
-		return *m.runtime, true
+// RemovedNotificationEvents returns the removed IDs of the "notification_events" edge to the NotificationEvent entity.
+func (m *NotificationChannelMutation) RemovedNotificationEventsIDs() (ids []int) {
+	for id := range m.removednotification_events {
+		ids = append(ids, id)
 	}
 	return
 }
 
-// RuntimeIDs returns the "runtime" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// RuntimeID instead. It exists only for internal usage by the builders.
-func (m *MonitorMutation) RuntimeIDs() (ids []int) {
-	if id := m.runtime; id != nil {
-		ids = append(ids, *id)
+// NotificationEventsIDs returns the "notification_events" edge IDs in the mutation.
+func (m *NotificationChannelMutation) NotificationEventsIDs() (ids []int) {
+	for id := range m.notification_events {
+		ids = append(ids, id)
 	}
 	return
 }
 
-// ResetRuntime resets all changes to the "runtime" edge.
-func (m *MonitorMutation) ResetRuntime() {
-	m.runtime = nil
-	m.clearedruntime = false
+// ResetNotificationEvents resets all changes to the "notification_events" edge.
+func (m *NotificationChannelMutation) ResetNotificationEvents() {
+	m.notification_events = nil
+	m.clearednotification_events = false
+	m.removednotification_events = nil
 }
 
-// Where appends a list predicates to the MonitorMutation builder.
-func (m *MonitorMutation) Where(ps ...predicate.Monitor) {
+// Where appends a list predicates to the NotificationChannelMutation builder.
+func (m *NotificationChannelMutation) Where(ps ...predicate.NotificationChannel) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the MonitorMutation builder. Using this method,
+// WhereP appends storage-level predicates to the NotificationChannelMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *MonitorMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Monitor, len(ps))
+func (m *NotificationChannelMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.NotificationChannel, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -2153,69 +12043,45 @@ func (m *MonitorMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *MonitorMutation) Op() Op {
+func (m *NotificationChannelMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *MonitorMutation) SetOp(op Op) {
+func (m *NotificationChannelMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Monitor).
-func (m *MonitorMutation) Type() string {
+// Type returns the node type of this mutation (NotificationChannel).
+func (m *NotificationChannelMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *MonitorMutation) Fields() []string {
-	fields := make([]string, 0, 15)
-	if m.label != nil {
-		fields = append(fields, monitor.FieldLabel)
-	}
-	if m.method != nil {
-		fields = append(fields, monitor.FieldMethod)
-	}
-	if m.url != nil {
-		fields = append(fields, monitor.FieldURL)
-	}
-	if m.icon_url != nil {
-		fields = append(fields, monitor.FieldIconURL)
-	}
-	if m.body != nil {
-		fields = append(fields, monitor.FieldBody)
-	}
-	if m.headers != nil {
-		fields = append(fields, monitor.FieldHeaders)
-	}
-	if m.auth != nil {
-		fields = append(fields, monitor.FieldAuth)
-	}
-	if m.notification_channels != nil {
-		fields = append(fields, monitor.FieldNotificationChannels)
-	}
-	if m.selector != nil {
-		fields = append(fields, monitor.FieldSelector)
+func (m *NotificationChannelMutation) Fields() []string {
+	fields := make([]string, 0, 7)
+	if m.name != nil {
+		fields = append(fields, notificationchannel.FieldName)
 	}
-	if m.expected_type != nil {
-		fields = append(fields, monitor.FieldExpectedType)
+	if m.kind != nil {
+		fields = append(fields, notificationchannel.FieldKind)
 	}
-	if m.expected_response != nil {
-		fields = append(fields, monitor.FieldExpectedResponse)
+	if m.bot_token != nil {
+		fields = append(fields, notificationchannel.FieldBotToken)
 	}
-	if m.cron != nil {
-		fields = append(fields, monitor.FieldCron)
+	if m.chat_id != nil {
+		fields = append(fields, notificationchannel.FieldChatID)
 	}
 	if m.enabled != nil {
-		fields = append(fields, monitor.FieldEnabled)
+		fields = append(fields, notificationchannel.FieldEnabled)
 	}
 	if m.created_at != nil {
-		fields = append(fields, monitor.FieldCreatedAt)
+		fields = append(fields, notificationchannel.FieldCreatedAt)
 	}
 	if m.updated_at != nil {
-		fields = append(fields, monitor.FieldUpdatedAt)
+		fields = append(fields, notificationchannel.FieldUpdatedAt)
 	}
 	return fields
 }
@@ -2223,37 +12089,21 @@ func (m *MonitorMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *MonitorMutation) Field(name string) (ent.Value, bool) {
+func (m *NotificationChannelMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case monitor.FieldLabel:
-		return m.Label()
-	case monitor.FieldMethod:
-		return m.Method()
-	case monitor.FieldURL:
-		return m.URL()
-	case monitor.FieldIconURL:
-		return m.IconURL()
-	case monitor.FieldBody:
-		return m.Body()
-	case monitor.FieldHeaders:
-		return m.Headers()
-	case monitor.FieldAuth:
-		return m.Auth()
-	case monitor.FieldNotificationChannels:
-		return m.NotificationChannels()
-	case monitor.FieldSelector:
-		return m.Selector()
-	case monitor.FieldExpectedType:
-		return m.ExpectedType()
-	case monitor.FieldExpectedResponse:
-		return m.ExpectedResponse()
-	case monitor.FieldCron:
-		return m.Cron()
-	case monitor.FieldEnabled:
+	case notificationchannel.FieldName:
+		return m.Name()
+	case notificationchannel.FieldKind:
+		return m.Kind()
+	case notificationchannel.FieldBotToken:
+		return m.BotToken()
+	case notificationchannel.FieldChatID:
+		return m.ChatID()
+	case notificationchannel.FieldEnabled:
 		return m.Enabled()
-	case monitor.FieldCreatedAt:
+	case notificationchannel.FieldCreatedAt:
 		return m.CreatedAt()
-	case monitor.FieldUpdatedAt:
+	case notificationchannel.FieldUpdatedAt:
 		return m.UpdatedAt()
 	}
 	return nil, false
@@ -2262,1924 +12112,2155 @@ func (m *MonitorMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *MonitorMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *NotificationChannelMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case monitor.FieldLabel:
-		return m.OldLabel(ctx)
-	case monitor.FieldMethod:
-		return m.OldMethod(ctx)
-	case monitor.FieldURL:
-		return m.OldURL(ctx)
-	case monitor.FieldIconURL:
-		return m.OldIconURL(ctx)
-	case monitor.FieldBody:
-		return m.OldBody(ctx)
-	case monitor.FieldHeaders:
-		return m.OldHeaders(ctx)
-	case monitor.FieldAuth:
-		return m.OldAuth(ctx)
-	case monitor.FieldNotificationChannels:
-		return m.OldNotificationChannels(ctx)
-	case monitor.FieldSelector:
-		return m.OldSelector(ctx)
-	case monitor.FieldExpectedType:
-		return m.OldExpectedType(ctx)
-	case monitor.FieldExpectedResponse:
-		return m.OldExpectedResponse(ctx)
-	case monitor.FieldCron:
-		return m.OldCron(ctx)
-	case monitor.FieldEnabled:
+	case notificationchannel.FieldName:
+		return m.OldName(ctx)
+	case notificationchannel.FieldKind:
+		return m.OldKind(ctx)
+	case notificationchannel.FieldBotToken:
+		return m.OldBotToken(ctx)
+	case notificationchannel.FieldChatID:
+		return m.OldChatID(ctx)
+	case notificationchannel.FieldEnabled:
 		return m.OldEnabled(ctx)
-	case monitor.FieldCreatedAt:
+	case notificationchannel.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case monitor.FieldUpdatedAt:
-		return m.OldUpdatedAt(ctx)
-	}
-	return nil, fmt.Errorf("unknown Monitor field %s", name)
-}
-
-// SetField sets the value of a field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *MonitorMutation) SetField(name string, value ent.Value) error {
-	switch name {
-	case monitor.FieldLabel:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetLabel(v)
-		return nil
-	case monitor.FieldMethod:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetMethod(v)
-		return nil
-	case monitor.FieldURL:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetURL(v)
-		return nil
-	case monitor.FieldIconURL:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetIconURL(v)
-		return nil
-	case monitor.FieldBody:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetBody(v)
-		return nil
-	case monitor.FieldHeaders:
-		v, ok := value.(map[string]string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetHeaders(v)
-		return nil
-	case monitor.FieldAuth:
-		v, ok := value.(map[string]string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetAuth(v)
-		return nil
-	case monitor.FieldNotificationChannels:
-		v, ok := value.([]string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetNotificationChannels(v)
-		return nil
-	case monitor.FieldSelector:
+	case notificationchannel.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown NotificationChannel field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *NotificationChannelMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case notificationchannel.FieldName:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetSelector(v)
+		m.SetName(v)
 		return nil
-	case monitor.FieldExpectedType:
-		v, ok := value.(monitor.ExpectedType)
+	case notificationchannel.FieldKind:
+		v, ok := value.(notificationchannel.Kind)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetExpectedType(v)
+		m.SetKind(v)
 		return nil
-	case monitor.FieldExpectedResponse:
+	case notificationchannel.FieldBotToken:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetExpectedResponse(v)
+		m.SetBotToken(v)
 		return nil
-	case monitor.FieldCron:
+	case notificationchannel.FieldChatID:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCron(v)
+		m.SetChatID(v)
 		return nil
-	case monitor.FieldEnabled:
+	case notificationchannel.FieldEnabled:
 		v, ok := value.(bool)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetEnabled(v)
 		return nil
-	case monitor.FieldCreatedAt:
+	case notificationchannel.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreatedAt(v)
 		return nil
-	case monitor.FieldUpdatedAt:
+	case notificationchannel.FieldUpdatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetUpdatedAt(v)
-		return nil
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown NotificationChannel field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *NotificationChannelMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *NotificationChannelMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *NotificationChannelMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown NotificationChannel numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *NotificationChannelMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *NotificationChannelMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *NotificationChannelMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown NotificationChannel nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *NotificationChannelMutation) ResetField(name string) error {
+	switch name {
+	case notificationchannel.FieldName:
+		m.ResetName()
+		return nil
+	case notificationchannel.FieldKind:
+		m.ResetKind()
+		return nil
+	case notificationchannel.FieldBotToken:
+		m.ResetBotToken()
+		return nil
+	case notificationchannel.FieldChatID:
+		m.ResetChatID()
+		return nil
+	case notificationchannel.FieldEnabled:
+		m.ResetEnabled()
+		return nil
+	case notificationchannel.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case notificationchannel.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown NotificationChannel field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *NotificationChannelMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.notification_events != nil {
+		edges = append(edges, notificationchannel.EdgeNotificationEvents)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *NotificationChannelMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case notificationchannel.EdgeNotificationEvents:
+		ids := make([]ent.Value, 0, len(m.notification_events))
+		for id := range m.notification_events {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *NotificationChannelMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.removednotification_events != nil {
+		edges = append(edges, notificationchannel.EdgeNotificationEvents)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *NotificationChannelMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case notificationchannel.EdgeNotificationEvents:
+		ids := make([]ent.Value, 0, len(m.removednotification_events))
+		for id := range m.removednotification_events {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *NotificationChannelMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearednotification_events {
+		edges = append(edges, notificationchannel.EdgeNotificationEvents)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *NotificationChannelMutation) EdgeCleared(name string) bool {
+	switch name {
+	case notificationchannel.EdgeNotificationEvents:
+		return m.clearednotification_events
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *NotificationChannelMutation) ClearEdge(name string) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown NotificationChannel unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *NotificationChannelMutation) ResetEdge(name string) error {
+	switch name {
+	case notificationchannel.EdgeNotificationEvents:
+		m.ResetNotificationEvents()
+		return nil
+	}
+	return fmt.Errorf("unknown NotificationChannel edge %s", name)
+}
+
+// NotificationEventMutation represents an operation that mutates the NotificationEvent nodes in the graph.
+type NotificationEventMutation struct {
+	config
+	op                    Op
+	typ                   string
+	id                    *int
+	status                *string
+	kind                  *notificationevent.Kind
+	escalation_level      *int
+	addescalation_level   *int
+	message               *string
+	sent_at               *time.Time
+	run_id                *string
+	clearedFields         map[string]struct{}
+	monitor               *int
+	clearedmonitor        bool
+	channel               *int
+	clearedchannel        bool
+	escalated_from        *int
+	clearedescalated_from bool
+	escalations           map[int]struct{}
+	removedescalations    map[int]struct{}
+	clearedescalations    bool
+	done                  bool
+	oldValue              func(context.Context) (*NotificationEvent, error)
+	predicates            []predicate.NotificationEvent
+}
+
+var _ ent.Mutation = (*NotificationEventMutation)(nil)
+
+// notificationeventOption allows management of the mutation configuration using functional options.
+type notificationeventOption func(*NotificationEventMutation)
+
+// newNotificationEventMutation creates new mutation for the NotificationEvent entity.
+func newNotificationEventMutation(c config, op Op, opts ...notificationeventOption) *NotificationEventMutation {
+	m := &NotificationEventMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeNotificationEvent,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withNotificationEventID sets the ID field of the mutation.
+func withNotificationEventID(id int) notificationeventOption {
+	return func(m *NotificationEventMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *NotificationEvent
+		)
+		m.oldValue = func(ctx context.Context) (*NotificationEvent, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().NotificationEvent.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withNotificationEvent sets the old NotificationEvent of the mutation.
+func withNotificationEvent(node *NotificationEvent) notificationeventOption {
+	return func(m *NotificationEventMutation) {
+		m.oldValue = func(context.Context) (*NotificationEvent, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m NotificationEventMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m NotificationEventMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *NotificationEventMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *NotificationEventMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().NotificationEvent.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
-	return fmt.Errorf("unknown Monitor field %s", name)
 }
 
-// AddedFields returns all numeric fields that were incremented/decremented during
-// this mutation.
-func (m *MonitorMutation) AddedFields() []string {
-	return nil
+// SetStatus sets the "status" field.
+func (m *NotificationEventMutation) SetStatus(s string) {
+	m.status = &s
 }
 
-// AddedField returns the numeric value that was incremented/decremented on a field
-// with the given name. The second boolean return value indicates that this field
-// was not set, or was not defined in the schema.
-func (m *MonitorMutation) AddedField(name string) (ent.Value, bool) {
-	return nil, false
+// Status returns the value of the "status" field in the mutation.
+func (m *NotificationEventMutation) Status() (r string, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// AddField adds the value to the field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *MonitorMutation) AddField(name string, value ent.Value) error {
-	switch name {
+// OldStatus returns the old "status" field's value of the NotificationEvent entity.
+// If the NotificationEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NotificationEventMutation) OldStatus(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
 	}
-	return fmt.Errorf("unknown Monitor numeric field %s", name)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
 }
 
-// ClearedFields returns all nullable fields that were cleared during this
-// mutation.
-func (m *MonitorMutation) ClearedFields() []string {
-	var fields []string
-	if m.FieldCleared(monitor.FieldLabel) {
-		fields = append(fields, monitor.FieldLabel)
+// ResetStatus resets all changes to the "status" field.
+func (m *NotificationEventMutation) ResetStatus() {
+	m.status = nil
+}
+
+// SetKind sets the "kind" field.
+func (m *NotificationEventMutation) SetKind(n notificationevent.Kind) {
+	m.kind = &n
+}
+
+// Kind returns the value of the "kind" field in the mutation.
+func (m *NotificationEventMutation) Kind() (r notificationevent.Kind, exists bool) {
+	v := m.kind
+	if v == nil {
+		return
 	}
-	if m.FieldCleared(monitor.FieldIconURL) {
-		fields = append(fields, monitor.FieldIconURL)
+	return *v, true
+}
+
+// OldKind returns the old "kind" field's value of the NotificationEvent entity.
+// If the NotificationEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NotificationEventMutation) OldKind(ctx context.Context) (v notificationevent.Kind, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldKind is only allowed on UpdateOne operations")
 	}
-	if m.FieldCleared(monitor.FieldBody) {
-		fields = append(fields, monitor.FieldBody)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldKind requires an ID field in the mutation")
 	}
-	if m.FieldCleared(monitor.FieldHeaders) {
-		fields = append(fields, monitor.FieldHeaders)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldKind: %w", err)
 	}
-	if m.FieldCleared(monitor.FieldAuth) {
-		fields = append(fields, monitor.FieldAuth)
+	return oldValue.Kind, nil
+}
+
+// ResetKind resets all changes to the "kind" field.
+func (m *NotificationEventMutation) ResetKind() {
+	m.kind = nil
+}
+
+// SetEscalationLevel sets the "escalation_level" field.
+func (m *NotificationEventMutation) SetEscalationLevel(i int) {
+	m.escalation_level = &i
+	m.addescalation_level = nil
+}
+
+// EscalationLevel returns the value of the "escalation_level" field in the mutation.
+func (m *NotificationEventMutation) EscalationLevel() (r int, exists bool) {
+	v := m.escalation_level
+	if v == nil {
+		return
 	}
-	if m.FieldCleared(monitor.FieldNotificationChannels) {
-		fields = append(fields, monitor.FieldNotificationChannels)
+	return *v, true
+}
+
+// OldEscalationLevel returns the old "escalation_level" field's value of the NotificationEvent entity.
+// If the NotificationEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NotificationEventMutation) OldEscalationLevel(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEscalationLevel is only allowed on UpdateOne operations")
 	}
-	if m.FieldCleared(monitor.FieldSelector) {
-		fields = append(fields, monitor.FieldSelector)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEscalationLevel requires an ID field in the mutation")
 	}
-	if m.FieldCleared(monitor.FieldExpectedResponse) {
-		fields = append(fields, monitor.FieldExpectedResponse)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEscalationLevel: %w", err)
 	}
-	return fields
+	return oldValue.EscalationLevel, nil
 }
 
-// FieldCleared returns a boolean indicating if a field with the given name was
-// cleared in this mutation.
-func (m *MonitorMutation) FieldCleared(name string) bool {
-	_, ok := m.clearedFields[name]
-	return ok
+// AddEscalationLevel adds i to the "escalation_level" field.
+func (m *NotificationEventMutation) AddEscalationLevel(i int) {
+	if m.addescalation_level != nil {
+		*m.addescalation_level += i
+	} else {
+		m.addescalation_level = &i
+	}
 }
 
-// ClearField clears the value of the field with the given name. It returns an
-// error if the field is not defined in the schema.
-func (m *MonitorMutation) ClearField(name string) error {
-	switch name {
-	case monitor.FieldLabel:
-		m.ClearLabel()
-		return nil
-	case monitor.FieldIconURL:
-		m.ClearIconURL()
-		return nil
-	case monitor.FieldBody:
-		m.ClearBody()
-		return nil
-	case monitor.FieldHeaders:
-		m.ClearHeaders()
-		return nil
-	case monitor.FieldAuth:
-		m.ClearAuth()
-		return nil
-	case monitor.FieldNotificationChannels:
-		m.ClearNotificationChannels()
-		return nil
-	case monitor.FieldSelector:
-		m.ClearSelector()
-		return nil
-	case monitor.FieldExpectedResponse:
-		m.ClearExpectedResponse()
-		return nil
+// AddedEscalationLevel returns the value that was added to the "escalation_level" field in this mutation.
+func (m *NotificationEventMutation) AddedEscalationLevel() (r int, exists bool) {
+	v := m.addescalation_level
+	if v == nil {
+		return
 	}
-	return fmt.Errorf("unknown Monitor nullable field %s", name)
+	return *v, true
 }
 
-// ResetField resets all changes in the mutation for the field with the given name.
-// It returns an error if the field is not defined in the schema.
-func (m *MonitorMutation) ResetField(name string) error {
-	switch name {
-	case monitor.FieldLabel:
-		m.ResetLabel()
-		return nil
-	case monitor.FieldMethod:
-		m.ResetMethod()
-		return nil
-	case monitor.FieldURL:
-		m.ResetURL()
-		return nil
-	case monitor.FieldIconURL:
-		m.ResetIconURL()
-		return nil
-	case monitor.FieldBody:
-		m.ResetBody()
-		return nil
-	case monitor.FieldHeaders:
-		m.ResetHeaders()
-		return nil
-	case monitor.FieldAuth:
-		m.ResetAuth()
-		return nil
-	case monitor.FieldNotificationChannels:
-		m.ResetNotificationChannels()
-		return nil
-	case monitor.FieldSelector:
-		m.ResetSelector()
-		return nil
-	case monitor.FieldExpectedType:
-		m.ResetExpectedType()
-		return nil
-	case monitor.FieldExpectedResponse:
-		m.ResetExpectedResponse()
-		return nil
-	case monitor.FieldCron:
-		m.ResetCron()
-		return nil
-	case monitor.FieldEnabled:
-		m.ResetEnabled()
-		return nil
-	case monitor.FieldCreatedAt:
-		m.ResetCreatedAt()
-		return nil
-	case monitor.FieldUpdatedAt:
-		m.ResetUpdatedAt()
-		return nil
+// ResetEscalationLevel resets all changes to the "escalation_level" field.
+func (m *NotificationEventMutation) ResetEscalationLevel() {
+	m.escalation_level = nil
+	m.addescalation_level = nil
+}
+
+// SetMessage sets the "message" field.
+func (m *NotificationEventMutation) SetMessage(s string) {
+	m.message = &s
+}
+
+// Message returns the value of the "message" field in the mutation.
+func (m *NotificationEventMutation) Message() (r string, exists bool) {
+	v := m.message
+	if v == nil {
+		return
 	}
-	return fmt.Errorf("unknown Monitor field %s", name)
+	return *v, true
 }
 
-// AddedEdges returns all edge names that were set/added in this mutation.
-func (m *MonitorMutation) AddedEdges() []string {
-	edges := make([]string, 0, 3)
-	if m.check_results != nil {
-		edges = append(edges, monitor.EdgeCheckResults)
+// OldMessage returns the old "message" field's value of the NotificationEvent entity.
+// If the NotificationEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NotificationEventMutation) OldMessage(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMessage is only allowed on UpdateOne operations")
 	}
-	if m.notification_events != nil {
-		edges = append(edges, monitor.EdgeNotificationEvents)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMessage requires an ID field in the mutation")
 	}
-	if m.runtime != nil {
-		edges = append(edges, monitor.EdgeRuntime)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMessage: %w", err)
 	}
-	return edges
+	return oldValue.Message, nil
 }
 
-// AddedIDs returns all IDs (to other nodes) that were added for the given edge
-// name in this mutation.
-func (m *MonitorMutation) AddedIDs(name string) []ent.Value {
-	switch name {
-	case monitor.EdgeCheckResults:
-		ids := make([]ent.Value, 0, len(m.check_results))
-		for id := range m.check_results {
-			ids = append(ids, id)
-		}
-		return ids
-	case monitor.EdgeNotificationEvents:
-		ids := make([]ent.Value, 0, len(m.notification_events))
-		for id := range m.notification_events {
-			ids = append(ids, id)
-		}
-		return ids
-	case monitor.EdgeRuntime:
-		if id := m.runtime; id != nil {
-			return []ent.Value{*id}
-		}
+// ClearMessage clears the value of the "message" field.
+func (m *NotificationEventMutation) ClearMessage() {
+	m.message = nil
+	m.clearedFields[notificationevent.FieldMessage] = struct{}{}
+}
+
+// MessageCleared returns if the "message" field was cleared in this mutation.
+func (m *NotificationEventMutation) MessageCleared() bool {
+	_, ok := m.clearedFields[notificationevent.FieldMessage]
+	return ok
+}
+
+// ResetMessage resets all changes to the "message" field.
+func (m *NotificationEventMutation) ResetMessage() {
+	m.message = nil
+	delete(m.clearedFields, notificationevent.FieldMessage)
+}
+
+// SetSentAt sets the "sent_at" field.
+func (m *NotificationEventMutation) SetSentAt(t time.Time) {
+	m.sent_at = &t
+}
+
+// SentAt returns the value of the "sent_at" field in the mutation.
+func (m *NotificationEventMutation) SentAt() (r time.Time, exists bool) {
+	v := m.sent_at
+	if v == nil {
+		return
 	}
-	return nil
+	return *v, true
 }
 
-// RemovedEdges returns all edge names that were removed in this mutation.
-func (m *MonitorMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 3)
-	if m.removedcheck_results != nil {
-		edges = append(edges, monitor.EdgeCheckResults)
+// OldSentAt returns the old "sent_at" field's value of the NotificationEvent entity.
+// If the NotificationEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NotificationEventMutation) OldSentAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSentAt is only allowed on UpdateOne operations")
 	}
-	if m.removednotification_events != nil {
-		edges = append(edges, monitor.EdgeNotificationEvents)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSentAt requires an ID field in the mutation")
 	}
-	return edges
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSentAt: %w", err)
+	}
+	return oldValue.SentAt, nil
 }
 
-// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
-// the given name in this mutation.
-func (m *MonitorMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case monitor.EdgeCheckResults:
-		ids := make([]ent.Value, 0, len(m.removedcheck_results))
-		for id := range m.removedcheck_results {
-			ids = append(ids, id)
-		}
-		return ids
-	case monitor.EdgeNotificationEvents:
-		ids := make([]ent.Value, 0, len(m.removednotification_events))
-		for id := range m.removednotification_events {
-			ids = append(ids, id)
-		}
-		return ids
+// ResetSentAt resets all changes to the "sent_at" field.
+func (m *NotificationEventMutation) ResetSentAt() {
+	m.sent_at = nil
+}
+
+// SetRunID sets the "run_id" field.
+func (m *NotificationEventMutation) SetRunID(s string) {
+	m.run_id = &s
+}
+
+// RunID returns the value of the "run_id" field in the mutation.
+func (m *NotificationEventMutation) RunID() (r string, exists bool) {
+	v := m.run_id
+	if v == nil {
+		return
 	}
-	return nil
+	return *v, true
 }
 
-// ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *MonitorMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 3)
-	if m.clearedcheck_results {
-		edges = append(edges, monitor.EdgeCheckResults)
+// OldRunID returns the old "run_id" field's value of the NotificationEvent entity.
+// If the NotificationEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NotificationEventMutation) OldRunID(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRunID is only allowed on UpdateOne operations")
 	}
-	if m.clearednotification_events {
-		edges = append(edges, monitor.EdgeNotificationEvents)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRunID requires an ID field in the mutation")
 	}
-	if m.clearedruntime {
-		edges = append(edges, monitor.EdgeRuntime)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRunID: %w", err)
 	}
-	return edges
+	return oldValue.RunID, nil
 }
 
-// EdgeCleared returns a boolean which indicates if the edge with the given name
-// was cleared in this mutation.
-func (m *MonitorMutation) EdgeCleared(name string) bool {
-	switch name {
-	case monitor.EdgeCheckResults:
-		return m.clearedcheck_results
-	case monitor.EdgeNotificationEvents:
-		return m.clearednotification_events
-	case monitor.EdgeRuntime:
-		return m.clearedruntime
-	}
-	return false
+// ClearRunID clears the value of the "run_id" field.
+func (m *NotificationEventMutation) ClearRunID() {
+	m.run_id = nil
+	m.clearedFields[notificationevent.FieldRunID] = struct{}{}
 }
 
-// ClearEdge clears the value of the edge with the given name. It returns an error
-// if that edge is not defined in the schema.
-func (m *MonitorMutation) ClearEdge(name string) error {
-	switch name {
-	case monitor.EdgeRuntime:
-		m.ClearRuntime()
-		return nil
+// RunIDCleared returns if the "run_id" field was cleared in this mutation.
+func (m *NotificationEventMutation) RunIDCleared() bool {
+	_, ok := m.clearedFields[notificationevent.FieldRunID]
+	return ok
+}
+
+// ResetRunID resets all changes to the "run_id" field.
+func (m *NotificationEventMutation) ResetRunID() {
+	m.run_id = nil
+	delete(m.clearedFields, notificationevent.FieldRunID)
+}
+
+// SetMonitorID sets the "monitor" edge to the Monitor entity by id.
+func (m *NotificationEventMutation) SetMonitorID(id int) {
+	m.monitor = &id
+}
+
+// ClearMonitor clears the "monitor" edge to the Monitor entity.
+func (m *NotificationEventMutation) ClearMonitor() {
+	m.clearedmonitor = true
+}
+
+// MonitorCleared reports if the "monitor" edge to the Monitor entity was cleared.
+func (m *NotificationEventMutation) MonitorCleared() bool {
+	return m.clearedmonitor
+}
+
+// MonitorID returns the "monitor" edge ID in the mutation.
+func (m *NotificationEventMutation) MonitorID() (id int, exists bool) {
+	if m.monitor != nil {
+		return *m.monitor, true
 	}
-	return fmt.Errorf("unknown Monitor unique edge %s", name)
+	return
 }
 
-// ResetEdge resets all changes to the edge with the given name in this mutation.
-// It returns an error if the edge is not defined in the schema.
-func (m *MonitorMutation) ResetEdge(name string) error {
-	switch name {
-	case monitor.EdgeCheckResults:
-		m.ResetCheckResults()
-		return nil
-	case monitor.EdgeNotificationEvents:
-		m.ResetNotificationEvents()
-		return nil
-	case monitor.EdgeRuntime:
-		m.ResetRuntime()
-		return nil
+// MonitorIDs returns the "monitor" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// MonitorID instead. It exists only for internal usage by the builders.
+func (m *NotificationEventMutation) MonitorIDs() (ids []int) {
+	if id := m.monitor; id != nil {
+		ids = append(ids, *id)
 	}
-	return fmt.Errorf("unknown Monitor edge %s", name)
+	return
 }
 
-// MonitorRuntimeMutation represents an operation that mutates the MonitorRuntime nodes in the graph.
-type MonitorRuntimeMutation struct {
-	config
-	op                       Op
-	typ                      string
-	id                       *int
-	status                   *monitorruntime.Status
-	check_count              *int64
-	addcheck_count           *int64
-	success_count            *int64
-	addsuccess_count         *int64
-	error_count              *int64
-	adderror_count           *int64
-	retry_count              *int64
-	addretry_count           *int64
-	consecutive_successes    *int64
-	addconsecutive_successes *int64
-	consecutive_errors       *int64
-	addconsecutive_errors    *int64
-	last_check_at            *time.Time
-	last_success_at          *time.Time
-	last_error_at            *time.Time
-	last_status_code         *int
-	addlast_status_code      *int
-	last_duration_ms         *int
-	addlast_duration_ms      *int
-	last_error_message       *string
-	next_run_at              *time.Time
-	updated_at               *time.Time
-	clearedFields            map[string]struct{}
-	monitor                  *int
-	clearedmonitor           bool
-	done                     bool
-	oldValue                 func(context.Context) (*MonitorRuntime, error)
-	predicates               []predicate.MonitorRuntime
+// ResetMonitor resets all changes to the "monitor" edge.
+func (m *NotificationEventMutation) ResetMonitor() {
+	m.monitor = nil
+	m.clearedmonitor = false
 }
 
-var _ ent.Mutation = (*MonitorRuntimeMutation)(nil)
+// SetChannelID sets the "channel" edge to the NotificationChannel entity by id.
+func (m *NotificationEventMutation) SetChannelID(id int) {
+	m.channel = &id
+}
 
-// monitorruntimeOption allows management of the mutation configuration using functional options.
-type monitorruntimeOption func(*MonitorRuntimeMutation)
+// ClearChannel clears the "channel" edge to the NotificationChannel entity.
+func (m *NotificationEventMutation) ClearChannel() {
+	m.clearedchannel = true
+}
 
-// newMonitorRuntimeMutation creates new mutation for the MonitorRuntime entity.
-func newMonitorRuntimeMutation(c config, op Op, opts ...monitorruntimeOption) *MonitorRuntimeMutation {
-	m := &MonitorRuntimeMutation{
-		config:        c,
-		op:            op,
-		typ:           TypeMonitorRuntime,
-		clearedFields: make(map[string]struct{}),
-	}
-	for _, opt := range opts {
-		opt(m)
+// ChannelCleared reports if the "channel" edge to the NotificationChannel entity was cleared.
+func (m *NotificationEventMutation) ChannelCleared() bool {
+	return m.clearedchannel
+}
+
+// ChannelID returns the "channel" edge ID in the mutation.
+func (m *NotificationEventMutation) ChannelID() (id int, exists bool) {
+	if m.channel != nil {
+		return *m.channel, true
 	}
-	return m
+	return
 }
 
-// withMonitorRuntimeID sets the ID field of the mutation.
-func withMonitorRuntimeID(id int) monitorruntimeOption {
-	return func(m *MonitorRuntimeMutation) {
-		var (
-			err   error
-			once  sync.Once
-			value *MonitorRuntime
-		)
-		m.oldValue = func(ctx context.Context) (*MonitorRuntime, error) {
-			once.Do(func() {
-				if m.done {
-					err = errors.New("querying old values post mutation is not allowed")
-				} else {
-					value, err = m.Client().MonitorRuntime.Get(ctx, id)
-				}
-			})
-			return value, err
-		}
-		m.id = &id
+// ChannelIDs returns the "channel" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ChannelID instead. It exists only for internal usage by the builders.
+func (m *NotificationEventMutation) ChannelIDs() (ids []int) {
+	if id := m.channel; id != nil {
+		ids = append(ids, *id)
 	}
+	return
 }
 
-// withMonitorRuntime sets the old MonitorRuntime of the mutation.
-func withMonitorRuntime(node *MonitorRuntime) monitorruntimeOption {
-	return func(m *MonitorRuntimeMutation) {
-		m.oldValue = func(context.Context) (*MonitorRuntime, error) {
-			return node, nil
-		}
-		m.id = &node.ID
-	}
+// ResetChannel resets all changes to the "channel" edge.
+func (m *NotificationEventMutation) ResetChannel() {
+	m.channel = nil
+	m.clearedchannel = false
 }
 
-// Client returns a new `ent.Client` from the mutation. If the mutation was
-// executed in a transaction (ent.Tx), a transactional client is returned.
-func (m MonitorRuntimeMutation) Client() *Client {
-	client := &Client{config: m.config}
-	client.init()
-	return client
+// SetEscalatedFromID sets the "escalated_from" edge to the NotificationEvent entity by id.
+func (m *NotificationEventMutation) SetEscalatedFromID(id int) {
+	m.escalated_from = &id
 }
 
-// Tx returns an `ent.Tx` for mutations that were executed in transactions;
-// it returns an error otherwise.
-func (m MonitorRuntimeMutation) Tx() (*Tx, error) {
-	if _, ok := m.driver.(*txDriver); !ok {
-		return nil, errors.New("ent: mutation is not running in a transaction")
-	}
-	tx := &Tx{config: m.config}
-	tx.init()
-	return tx, nil
+// ClearEscalatedFrom clears the "escalated_from" edge to the NotificationEvent entity.
+func (m *NotificationEventMutation) ClearEscalatedFrom() {
+	m.clearedescalated_from = true
 }
 
-// ID returns the ID value in the mutation. Note that the ID is only available
-// if it was provided to the builder or after it was returned from the database.
-func (m *MonitorRuntimeMutation) ID() (id int, exists bool) {
-	if m.id == nil {
-		return
-	}
-	return *m.id, true
+// EscalatedFromCleared reports if the "escalated_from" edge to the NotificationEvent entity was cleared.
+func (m *NotificationEventMutation) EscalatedFromCleared() bool {
+	return m.clearedescalated_from
 }
 
-// IDs queries the database and returns the entity ids that match the mutation's predicate.
-// That means, if the mutation is applied within a transaction with an isolation level such
-// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
-// or updated by the mutation.
-func (m *MonitorRuntimeMutation) IDs(ctx context.Context) ([]int, error) {
-	switch {
-	case m.op.Is(OpUpdateOne | OpDeleteOne):
-		id, exists := m.ID()
-		if exists {
-			return []int{id}, nil
-		}
-		fallthrough
-	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().MonitorRuntime.Query().Where(m.predicates...).IDs(ctx)
-	default:
-		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+// EscalatedFromID returns the "escalated_from" edge ID in the mutation.
+func (m *NotificationEventMutation) EscalatedFromID() (id int, exists bool) {
+	if m.escalated_from != nil {
+		return *m.escalated_from, true
 	}
+	return
 }
 
-// SetStatus sets the "status" field.
-func (m *MonitorRuntimeMutation) SetStatus(value monitorruntime.Status) {
-	m.status = &value
+// EscalatedFromIDs returns the "escalated_from" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// EscalatedFromID instead. It exists only for internal usage by the builders.
+func (m *NotificationEventMutation) EscalatedFromIDs() (ids []int) {
+	if id := m.escalated_from; id != nil {
+		ids = append(ids, *id)
+	}
+	return
 }
 
-// Status returns the value of the "status" field in the mutation.
-func (m *MonitorRuntimeMutation) Status() (r monitorruntime.Status, exists bool) {
-	v := m.status
-	if v == nil {
-		return
-	}
-	return *v, true
+// ResetEscalatedFrom resets all changes to the "escalated_from" edge.
+func (m *NotificationEventMutation) ResetEscalatedFrom() {
+	m.escalated_from = nil
+	m.clearedescalated_from = false
 }
 
-// OldStatus returns the old "status" field's value of the MonitorRuntime entity.
-// If the MonitorRuntime object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MonitorRuntimeMutation) OldStatus(ctx context.Context) (v monitorruntime.Status, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldStatus requires an ID field in the mutation")
+// AddEscalationIDs adds the "escalations" edge to the NotificationEvent entity by ids.
+func (m *NotificationEventMutation) AddEscalationIDs(ids ...int) {
+	if m.escalations == nil {
+		m.escalations = make(map[int]struct{})
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	for i := range ids {
+		m.escalations[ids[i]] = struct{}{}
 	}
-	return oldValue.Status, nil
 }
 
-// ResetStatus resets all changes to the "status" field.
-func (m *MonitorRuntimeMutation) ResetStatus() {
-	m.status = nil
+// ClearEscalations clears the "escalations" edge to the NotificationEvent entity.
+func (m *NotificationEventMutation) ClearEscalations() {
+	m.clearedescalations = true
 }
 
-// SetCheckCount sets the "check_count" field.
-func (m *MonitorRuntimeMutation) SetCheckCount(i int64) {
-	m.check_count = &i
-	m.addcheck_count = nil
+// EscalationsCleared reports if the "escalations" edge to the NotificationEvent entity was cleared.
+func (m *NotificationEventMutation) EscalationsCleared() bool {
+	return m.clearedescalations
 }
 
-// CheckCount returns the value of the "check_count" field in the mutation.
-func (m *MonitorRuntimeMutation) CheckCount() (r int64, exists bool) {
-	v := m.check_count
-	if v == nil {
-		return
+// RemoveEscalationIDs removes the "escalations" edge to the NotificationEvent entity by IDs.
+func (m *NotificationEventMutation) RemoveEscalationIDs(ids ...int) {
+	if m.removedescalations == nil {
+		m.removedescalations = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.escalations, ids[i])
+		m.removedescalations[ids[i]] = struct{}{}
 	}
-	return *v, true
 }
 
-// OldCheckCount returns the old "check_count" field's value of the MonitorRuntime entity.
-// If the MonitorRuntime object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MonitorRuntimeMutation) OldCheckCount(ctx context.Context) (v int64, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCheckCount is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCheckCount requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCheckCount: %w", err)
+// RemovedEscalations returns the removed IDs of the "escalations" edge to the NotificationEvent entity.
+func (m *NotificationEventMutation) RemovedEscalationsIDs() (ids []int) {
+	for id := range m.removedescalations {
+		ids = append(ids, id)
 	}
-	return oldValue.CheckCount, nil
+	return
 }
 
-// AddCheckCount adds i to the "check_count" field.
-func (m *MonitorRuntimeMutation) AddCheckCount(i int64) {
-	if m.addcheck_count != nil {
-		*m.addcheck_count += i
-	} else {
-		m.addcheck_count = &i
+// EscalationsIDs returns the "escalations" edge IDs in the mutation.
+func (m *NotificationEventMutation) EscalationsIDs() (ids []int) {
+	for id := range m.escalations {
+		ids = append(ids, id)
 	}
+	return
 }
 
-// AddedCheckCount returns the value that was added to the "check_count" field in this mutation.
-func (m *MonitorRuntimeMutation) AddedCheckCount() (r int64, exists bool) {
-	v := m.addcheck_count
-	if v == nil {
-		return
+// ResetEscalations resets all changes to the "escalations" edge.
+func (m *NotificationEventMutation) ResetEscalations() {
+	m.escalations = nil
+	m.clearedescalations = false
+	m.removedescalations = nil
+}
+
+// Where appends a list predicates to the NotificationEventMutation builder.
+func (m *NotificationEventMutation) Where(ps ...predicate.NotificationEvent) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the NotificationEventMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *NotificationEventMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.NotificationEvent, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
 	}
-	return *v, true
+	m.Where(p...)
 }
 
-// ResetCheckCount resets all changes to the "check_count" field.
-func (m *MonitorRuntimeMutation) ResetCheckCount() {
-	m.check_count = nil
-	m.addcheck_count = nil
+// Op returns the operation name.
+func (m *NotificationEventMutation) Op() Op {
+	return m.op
 }
 
-// SetSuccessCount sets the "success_count" field.
-func (m *MonitorRuntimeMutation) SetSuccessCount(i int64) {
-	m.success_count = &i
-	m.addsuccess_count = nil
+// SetOp allows setting the mutation operation.
+func (m *NotificationEventMutation) SetOp(op Op) {
+	m.op = op
 }
 
-// SuccessCount returns the value of the "success_count" field in the mutation.
-func (m *MonitorRuntimeMutation) SuccessCount() (r int64, exists bool) {
-	v := m.success_count
-	if v == nil {
-		return
-	}
-	return *v, true
+// Type returns the node type of this mutation (NotificationEvent).
+func (m *NotificationEventMutation) Type() string {
+	return m.typ
 }
 
-// OldSuccessCount returns the old "success_count" field's value of the MonitorRuntime entity.
-// If the MonitorRuntime object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MonitorRuntimeMutation) OldSuccessCount(ctx context.Context) (v int64, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSuccessCount is only allowed on UpdateOne operations")
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *NotificationEventMutation) Fields() []string {
+	fields := make([]string, 0, 6)
+	if m.status != nil {
+		fields = append(fields, notificationevent.FieldStatus)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSuccessCount requires an ID field in the mutation")
+	if m.kind != nil {
+		fields = append(fields, notificationevent.FieldKind)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSuccessCount: %w", err)
+	if m.escalation_level != nil {
+		fields = append(fields, notificationevent.FieldEscalationLevel)
 	}
-	return oldValue.SuccessCount, nil
+	if m.message != nil {
+		fields = append(fields, notificationevent.FieldMessage)
+	}
+	if m.sent_at != nil {
+		fields = append(fields, notificationevent.FieldSentAt)
+	}
+	if m.run_id != nil {
+		fields = append(fields, notificationevent.FieldRunID)
+	}
+	return fields
 }
 
-// AddSuccessCount adds i to the "success_count" field.
-func (m *MonitorRuntimeMutation) AddSuccessCount(i int64) {
-	if m.addsuccess_count != nil {
-		*m.addsuccess_count += i
-	} else {
-		m.addsuccess_count = &i
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *NotificationEventMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case notificationevent.FieldStatus:
+		return m.Status()
+	case notificationevent.FieldKind:
+		return m.Kind()
+	case notificationevent.FieldEscalationLevel:
+		return m.EscalationLevel()
+	case notificationevent.FieldMessage:
+		return m.Message()
+	case notificationevent.FieldSentAt:
+		return m.SentAt()
+	case notificationevent.FieldRunID:
+		return m.RunID()
 	}
+	return nil, false
 }
 
-// AddedSuccessCount returns the value that was added to the "success_count" field in this mutation.
-func (m *MonitorRuntimeMutation) AddedSuccessCount() (r int64, exists bool) {
-	v := m.addsuccess_count
-	if v == nil {
-		return
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *NotificationEventMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case notificationevent.FieldStatus:
+		return m.OldStatus(ctx)
+	case notificationevent.FieldKind:
+		return m.OldKind(ctx)
+	case notificationevent.FieldEscalationLevel:
+		return m.OldEscalationLevel(ctx)
+	case notificationevent.FieldMessage:
+		return m.OldMessage(ctx)
+	case notificationevent.FieldSentAt:
+		return m.OldSentAt(ctx)
+	case notificationevent.FieldRunID:
+		return m.OldRunID(ctx)
 	}
-	return *v, true
-}
-
-// ResetSuccessCount resets all changes to the "success_count" field.
-func (m *MonitorRuntimeMutation) ResetSuccessCount() {
-	m.success_count = nil
-	m.addsuccess_count = nil
+	return nil, fmt.Errorf("unknown NotificationEvent field %s", name)
 }
 
-// SetErrorCount sets the "error_count" field.
-func (m *MonitorRuntimeMutation) SetErrorCount(i int64) {
-	m.error_count = &i
-	m.adderror_count = nil
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *NotificationEventMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case notificationevent.FieldStatus:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case notificationevent.FieldKind:
+		v, ok := value.(notificationevent.Kind)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetKind(v)
+		return nil
+	case notificationevent.FieldEscalationLevel:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEscalationLevel(v)
+		return nil
+	case notificationevent.FieldMessage:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMessage(v)
+		return nil
+	case notificationevent.FieldSentAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSentAt(v)
+		return nil
+	case notificationevent.FieldRunID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRunID(v)
+		return nil
+	}
+	return fmt.Errorf("unknown NotificationEvent field %s", name)
 }
 
-// ErrorCount returns the value of the "error_count" field in the mutation.
-func (m *MonitorRuntimeMutation) ErrorCount() (r int64, exists bool) {
-	v := m.error_count
-	if v == nil {
-		return
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *NotificationEventMutation) AddedFields() []string {
+	var fields []string
+	if m.addescalation_level != nil {
+		fields = append(fields, notificationevent.FieldEscalationLevel)
 	}
-	return *v, true
+	return fields
 }
 
-// OldErrorCount returns the old "error_count" field's value of the MonitorRuntime entity.
-// If the MonitorRuntime object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MonitorRuntimeMutation) OldErrorCount(ctx context.Context) (v int64, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldErrorCount is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldErrorCount requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldErrorCount: %w", err)
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *NotificationEventMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case notificationevent.FieldEscalationLevel:
+		return m.AddedEscalationLevel()
 	}
-	return oldValue.ErrorCount, nil
+	return nil, false
 }
 
-// AddErrorCount adds i to the "error_count" field.
-func (m *MonitorRuntimeMutation) AddErrorCount(i int64) {
-	if m.adderror_count != nil {
-		*m.adderror_count += i
-	} else {
-		m.adderror_count = &i
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *NotificationEventMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case notificationevent.FieldEscalationLevel:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddEscalationLevel(v)
+		return nil
 	}
+	return fmt.Errorf("unknown NotificationEvent numeric field %s", name)
 }
 
-// AddedErrorCount returns the value that was added to the "error_count" field in this mutation.
-func (m *MonitorRuntimeMutation) AddedErrorCount() (r int64, exists bool) {
-	v := m.adderror_count
-	if v == nil {
-		return
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *NotificationEventMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(notificationevent.FieldMessage) {
+		fields = append(fields, notificationevent.FieldMessage)
 	}
-	return *v, true
+	if m.FieldCleared(notificationevent.FieldRunID) {
+		fields = append(fields, notificationevent.FieldRunID)
+	}
+	return fields
 }
 
-// ResetErrorCount resets all changes to the "error_count" field.
-func (m *MonitorRuntimeMutation) ResetErrorCount() {
-	m.error_count = nil
-	m.adderror_count = nil
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *NotificationEventMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
 }
 
-// SetRetryCount sets the "retry_count" field.
-func (m *MonitorRuntimeMutation) SetRetryCount(i int64) {
-	m.retry_count = &i
-	m.addretry_count = nil
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *NotificationEventMutation) ClearField(name string) error {
+	switch name {
+	case notificationevent.FieldMessage:
+		m.ClearMessage()
+		return nil
+	case notificationevent.FieldRunID:
+		m.ClearRunID()
+		return nil
+	}
+	return fmt.Errorf("unknown NotificationEvent nullable field %s", name)
 }
 
-// RetryCount returns the value of the "retry_count" field in the mutation.
-func (m *MonitorRuntimeMutation) RetryCount() (r int64, exists bool) {
-	v := m.retry_count
-	if v == nil {
-		return
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *NotificationEventMutation) ResetField(name string) error {
+	switch name {
+	case notificationevent.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case notificationevent.FieldKind:
+		m.ResetKind()
+		return nil
+	case notificationevent.FieldEscalationLevel:
+		m.ResetEscalationLevel()
+		return nil
+	case notificationevent.FieldMessage:
+		m.ResetMessage()
+		return nil
+	case notificationevent.FieldSentAt:
+		m.ResetSentAt()
+		return nil
+	case notificationevent.FieldRunID:
+		m.ResetRunID()
+		return nil
 	}
-	return *v, true
+	return fmt.Errorf("unknown NotificationEvent field %s", name)
 }
 
-// OldRetryCount returns the old "retry_count" field's value of the MonitorRuntime entity.
-// If the MonitorRuntime object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MonitorRuntimeMutation) OldRetryCount(ctx context.Context) (v int64, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldRetryCount is only allowed on UpdateOne operations")
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *NotificationEventMutation) AddedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.monitor != nil {
+		edges = append(edges, notificationevent.EdgeMonitor)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldRetryCount requires an ID field in the mutation")
+	if m.channel != nil {
+		edges = append(edges, notificationevent.EdgeChannel)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldRetryCount: %w", err)
+	if m.escalated_from != nil {
+		edges = append(edges, notificationevent.EdgeEscalatedFrom)
 	}
-	return oldValue.RetryCount, nil
-}
-
-// AddRetryCount adds i to the "retry_count" field.
-func (m *MonitorRuntimeMutation) AddRetryCount(i int64) {
-	if m.addretry_count != nil {
-		*m.addretry_count += i
-	} else {
-		m.addretry_count = &i
+	if m.escalations != nil {
+		edges = append(edges, notificationevent.EdgeEscalations)
 	}
+	return edges
 }
 
-// AddedRetryCount returns the value that was added to the "retry_count" field in this mutation.
-func (m *MonitorRuntimeMutation) AddedRetryCount() (r int64, exists bool) {
-	v := m.addretry_count
-	if v == nil {
-		return
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *NotificationEventMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case notificationevent.EdgeMonitor:
+		if id := m.monitor; id != nil {
+			return []ent.Value{*id}
+		}
+	case notificationevent.EdgeChannel:
+		if id := m.channel; id != nil {
+			return []ent.Value{*id}
+		}
+	case notificationevent.EdgeEscalatedFrom:
+		if id := m.escalated_from; id != nil {
+			return []ent.Value{*id}
+		}
+	case notificationevent.EdgeEscalations:
+		ids := make([]ent.Value, 0, len(m.escalations))
+		for id := range m.escalations {
+			ids = append(ids, id)
+		}
+		return ids
 	}
-	return *v, true
-}
-
-// ResetRetryCount resets all changes to the "retry_count" field.
-func (m *MonitorRuntimeMutation) ResetRetryCount() {
-	m.retry_count = nil
-	m.addretry_count = nil
+	return nil
 }
 
-// SetConsecutiveSuccesses sets the "consecutive_successes" field.
-func (m *MonitorRuntimeMutation) SetConsecutiveSuccesses(i int64) {
-	m.consecutive_successes = &i
-	m.addconsecutive_successes = nil
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *NotificationEventMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.removedescalations != nil {
+		edges = append(edges, notificationevent.EdgeEscalations)
+	}
+	return edges
 }
 
-// ConsecutiveSuccesses returns the value of the "consecutive_successes" field in the mutation.
-func (m *MonitorRuntimeMutation) ConsecutiveSuccesses() (r int64, exists bool) {
-	v := m.consecutive_successes
-	if v == nil {
-		return
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *NotificationEventMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case notificationevent.EdgeEscalations:
+		ids := make([]ent.Value, 0, len(m.removedescalations))
+		for id := range m.removedescalations {
+			ids = append(ids, id)
+		}
+		return ids
 	}
-	return *v, true
+	return nil
 }
 
-// OldConsecutiveSuccesses returns the old "consecutive_successes" field's value of the MonitorRuntime entity.
-// If the MonitorRuntime object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MonitorRuntimeMutation) OldConsecutiveSuccesses(ctx context.Context) (v int64, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldConsecutiveSuccesses is only allowed on UpdateOne operations")
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *NotificationEventMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.clearedmonitor {
+		edges = append(edges, notificationevent.EdgeMonitor)
+	}
+	if m.clearedchannel {
+		edges = append(edges, notificationevent.EdgeChannel)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldConsecutiveSuccesses requires an ID field in the mutation")
+	if m.clearedescalated_from {
+		edges = append(edges, notificationevent.EdgeEscalatedFrom)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldConsecutiveSuccesses: %w", err)
+	if m.clearedescalations {
+		edges = append(edges, notificationevent.EdgeEscalations)
 	}
-	return oldValue.ConsecutiveSuccesses, nil
+	return edges
 }
 
-// AddConsecutiveSuccesses adds i to the "consecutive_successes" field.
-func (m *MonitorRuntimeMutation) AddConsecutiveSuccesses(i int64) {
-	if m.addconsecutive_successes != nil {
-		*m.addconsecutive_successes += i
-	} else {
-		m.addconsecutive_successes = &i
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *NotificationEventMutation) EdgeCleared(name string) bool {
+	switch name {
+	case notificationevent.EdgeMonitor:
+		return m.clearedmonitor
+	case notificationevent.EdgeChannel:
+		return m.clearedchannel
+	case notificationevent.EdgeEscalatedFrom:
+		return m.clearedescalated_from
+	case notificationevent.EdgeEscalations:
+		return m.clearedescalations
 	}
+	return false
 }
 
-// AddedConsecutiveSuccesses returns the value that was added to the "consecutive_successes" field in this mutation.
-func (m *MonitorRuntimeMutation) AddedConsecutiveSuccesses() (r int64, exists bool) {
-	v := m.addconsecutive_successes
-	if v == nil {
-		return
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *NotificationEventMutation) ClearEdge(name string) error {
+	switch name {
+	case notificationevent.EdgeMonitor:
+		m.ClearMonitor()
+		return nil
+	case notificationevent.EdgeChannel:
+		m.ClearChannel()
+		return nil
+	case notificationevent.EdgeEscalatedFrom:
+		m.ClearEscalatedFrom()
+		return nil
 	}
-	return *v, true
+	return fmt.Errorf("unknown NotificationEvent unique edge %s", name)
 }
 
-// ResetConsecutiveSuccesses resets all changes to the "consecutive_successes" field.
-func (m *MonitorRuntimeMutation) ResetConsecutiveSuccesses() {
-	m.consecutive_successes = nil
-	m.addconsecutive_successes = nil
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *NotificationEventMutation) ResetEdge(name string) error {
+	switch name {
+	case notificationevent.EdgeMonitor:
+		m.ResetMonitor()
+		return nil
+	case notificationevent.EdgeChannel:
+		m.ResetChannel()
+		return nil
+	case notificationevent.EdgeEscalatedFrom:
+		m.ResetEscalatedFrom()
+		return nil
+	case notificationevent.EdgeEscalations:
+		m.ResetEscalations()
+		return nil
+	}
+	return fmt.Errorf("unknown NotificationEvent edge %s", name)
 }
 
-// SetConsecutiveErrors sets the "consecutive_errors" field.
-func (m *MonitorRuntimeMutation) SetConsecutiveErrors(i int64) {
-	m.consecutive_errors = &i
-	m.addconsecutive_errors = nil
+// SelectorPayloadMutation represents an operation that mutates the SelectorPayload nodes in the graph.
+type SelectorPayloadMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	token         *string
+	payload       *[]byte
+	expires_at    *time.Time
+	created_at    *time.Time
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*SelectorPayload, error)
+	predicates    []predicate.SelectorPayload
+}
+
+var _ ent.Mutation = (*SelectorPayloadMutation)(nil)
+
+// selectorpayloadOption allows management of the mutation configuration using functional options.
+type selectorpayloadOption func(*SelectorPayloadMutation)
+
+// newSelectorPayloadMutation creates new mutation for the SelectorPayload entity.
+func newSelectorPayloadMutation(c config, op Op, opts ...selectorpayloadOption) *SelectorPayloadMutation {
+	m := &SelectorPayloadMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeSelectorPayload,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
 }
 
-// ConsecutiveErrors returns the value of the "consecutive_errors" field in the mutation.
-func (m *MonitorRuntimeMutation) ConsecutiveErrors() (r int64, exists bool) {
-	v := m.consecutive_errors
-	if v == nil {
-		return
+// withSelectorPayloadID sets the ID field of the mutation.
+func withSelectorPayloadID(id int) selectorpayloadOption {
+	return func(m *SelectorPayloadMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *SelectorPayload
+		)
+		m.oldValue = func(ctx context.Context) (*SelectorPayload, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().SelectorPayload.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
 	}
-	return *v, true
 }
 
-// OldConsecutiveErrors returns the old "consecutive_errors" field's value of the MonitorRuntime entity.
-// If the MonitorRuntime object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MonitorRuntimeMutation) OldConsecutiveErrors(ctx context.Context) (v int64, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldConsecutiveErrors is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldConsecutiveErrors requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldConsecutiveErrors: %w", err)
+// withSelectorPayload sets the old SelectorPayload of the mutation.
+func withSelectorPayload(node *SelectorPayload) selectorpayloadOption {
+	return func(m *SelectorPayloadMutation) {
+		m.oldValue = func(context.Context) (*SelectorPayload, error) {
+			return node, nil
+		}
+		m.id = &node.ID
 	}
-	return oldValue.ConsecutiveErrors, nil
 }
 
-// AddConsecutiveErrors adds i to the "consecutive_errors" field.
-func (m *MonitorRuntimeMutation) AddConsecutiveErrors(i int64) {
-	if m.addconsecutive_errors != nil {
-		*m.addconsecutive_errors += i
-	} else {
-		m.addconsecutive_errors = &i
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m SelectorPayloadMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m SelectorPayloadMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
 }
 
-// AddedConsecutiveErrors returns the value that was added to the "consecutive_errors" field in this mutation.
-func (m *MonitorRuntimeMutation) AddedConsecutiveErrors() (r int64, exists bool) {
-	v := m.addconsecutive_errors
-	if v == nil {
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *SelectorPayloadMutation) ID() (id int, exists bool) {
+	if m.id == nil {
 		return
 	}
-	return *v, true
+	return *m.id, true
 }
 
-// ResetConsecutiveErrors resets all changes to the "consecutive_errors" field.
-func (m *MonitorRuntimeMutation) ResetConsecutiveErrors() {
-	m.consecutive_errors = nil
-	m.addconsecutive_errors = nil
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *SelectorPayloadMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().SelectorPayload.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
 }
 
-// SetLastCheckAt sets the "last_check_at" field.
-func (m *MonitorRuntimeMutation) SetLastCheckAt(t time.Time) {
-	m.last_check_at = &t
+// SetToken sets the "token" field.
+func (m *SelectorPayloadMutation) SetToken(s string) {
+	m.token = &s
 }
 
-// LastCheckAt returns the value of the "last_check_at" field in the mutation.
-func (m *MonitorRuntimeMutation) LastCheckAt() (r time.Time, exists bool) {
-	v := m.last_check_at
+// Token returns the value of the "token" field in the mutation.
+func (m *SelectorPayloadMutation) Token() (r string, exists bool) {
+	v := m.token
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldLastCheckAt returns the old "last_check_at" field's value of the MonitorRuntime entity.
-// If the MonitorRuntime object wasn't provided to the builder, the object is fetched from the database.
+// OldToken returns the old "token" field's value of the SelectorPayload entity.
+// If the SelectorPayload object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MonitorRuntimeMutation) OldLastCheckAt(ctx context.Context) (v *time.Time, err error) {
+func (m *SelectorPayloadMutation) OldToken(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldLastCheckAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldToken is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldLastCheckAt requires an ID field in the mutation")
+		return v, errors.New("OldToken requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldLastCheckAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldToken: %w", err)
 	}
-	return oldValue.LastCheckAt, nil
-}
-
-// ClearLastCheckAt clears the value of the "last_check_at" field.
-func (m *MonitorRuntimeMutation) ClearLastCheckAt() {
-	m.last_check_at = nil
-	m.clearedFields[monitorruntime.FieldLastCheckAt] = struct{}{}
-}
-
-// LastCheckAtCleared returns if the "last_check_at" field was cleared in this mutation.
-func (m *MonitorRuntimeMutation) LastCheckAtCleared() bool {
-	_, ok := m.clearedFields[monitorruntime.FieldLastCheckAt]
-	return ok
+	return oldValue.Token, nil
 }
 
-// ResetLastCheckAt resets all changes to the "last_check_at" field.
-func (m *MonitorRuntimeMutation) ResetLastCheckAt() {
-	m.last_check_at = nil
-	delete(m.clearedFields, monitorruntime.FieldLastCheckAt)
+// ResetToken resets all changes to the "token" field.
+func (m *SelectorPayloadMutation) ResetToken() {
+	m.token = nil
 }
 
-// SetLastSuccessAt sets the "last_success_at" field.
-func (m *MonitorRuntimeMutation) SetLastSuccessAt(t time.Time) {
-	m.last_success_at = &t
+// SetPayload sets the "payload" field.
+func (m *SelectorPayloadMutation) SetPayload(b []byte) {
+	m.payload = &b
 }
 
-// LastSuccessAt returns the value of the "last_success_at" field in the mutation.
-func (m *MonitorRuntimeMutation) LastSuccessAt() (r time.Time, exists bool) {
-	v := m.last_success_at
+// Payload returns the value of the "payload" field in the mutation.
+func (m *SelectorPayloadMutation) Payload() (r []byte, exists bool) {
+	v := m.payload
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldLastSuccessAt returns the old "last_success_at" field's value of the MonitorRuntime entity.
-// If the MonitorRuntime object wasn't provided to the builder, the object is fetched from the database.
+// OldPayload returns the old "payload" field's value of the SelectorPayload entity.
+// If the SelectorPayload object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MonitorRuntimeMutation) OldLastSuccessAt(ctx context.Context) (v *time.Time, err error) {
+func (m *SelectorPayloadMutation) OldPayload(ctx context.Context) (v []byte, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldLastSuccessAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldPayload is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldLastSuccessAt requires an ID field in the mutation")
+		return v, errors.New("OldPayload requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldLastSuccessAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldPayload: %w", err)
 	}
-	return oldValue.LastSuccessAt, nil
-}
-
-// ClearLastSuccessAt clears the value of the "last_success_at" field.
-func (m *MonitorRuntimeMutation) ClearLastSuccessAt() {
-	m.last_success_at = nil
-	m.clearedFields[monitorruntime.FieldLastSuccessAt] = struct{}{}
-}
-
-// LastSuccessAtCleared returns if the "last_success_at" field was cleared in this mutation.
-func (m *MonitorRuntimeMutation) LastSuccessAtCleared() bool {
-	_, ok := m.clearedFields[monitorruntime.FieldLastSuccessAt]
-	return ok
+	return oldValue.Payload, nil
 }
 
-// ResetLastSuccessAt resets all changes to the "last_success_at" field.
-func (m *MonitorRuntimeMutation) ResetLastSuccessAt() {
-	m.last_success_at = nil
-	delete(m.clearedFields, monitorruntime.FieldLastSuccessAt)
+// ResetPayload resets all changes to the "payload" field.
+func (m *SelectorPayloadMutation) ResetPayload() {
+	m.payload = nil
 }
 
-// SetLastErrorAt sets the "last_error_at" field.
-func (m *MonitorRuntimeMutation) SetLastErrorAt(t time.Time) {
-	m.last_error_at = &t
+// SetExpiresAt sets the "expires_at" field.
+func (m *SelectorPayloadMutation) SetExpiresAt(t time.Time) {
+	m.expires_at = &t
 }
 
-// LastErrorAt returns the value of the "last_error_at" field in the mutation.
-func (m *MonitorRuntimeMutation) LastErrorAt() (r time.Time, exists bool) {
-	v := m.last_error_at
+// ExpiresAt returns the value of the "expires_at" field in the mutation.
+func (m *SelectorPayloadMutation) ExpiresAt() (r time.Time, exists bool) {
+	v := m.expires_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldLastErrorAt returns the old "last_error_at" field's value of the MonitorRuntime entity.
-// If the MonitorRuntime object wasn't provided to the builder, the object is fetched from the database.
+// OldExpiresAt returns the old "expires_at" field's value of the SelectorPayload entity.
+// If the SelectorPayload object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MonitorRuntimeMutation) OldLastErrorAt(ctx context.Context) (v *time.Time, err error) {
+func (m *SelectorPayloadMutation) OldExpiresAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldLastErrorAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldExpiresAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldLastErrorAt requires an ID field in the mutation")
+		return v, errors.New("OldExpiresAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldLastErrorAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldExpiresAt: %w", err)
 	}
-	return oldValue.LastErrorAt, nil
-}
-
-// ClearLastErrorAt clears the value of the "last_error_at" field.
-func (m *MonitorRuntimeMutation) ClearLastErrorAt() {
-	m.last_error_at = nil
-	m.clearedFields[monitorruntime.FieldLastErrorAt] = struct{}{}
+	return oldValue.ExpiresAt, nil
 }
 
-// LastErrorAtCleared returns if the "last_error_at" field was cleared in this mutation.
-func (m *MonitorRuntimeMutation) LastErrorAtCleared() bool {
-	_, ok := m.clearedFields[monitorruntime.FieldLastErrorAt]
-	return ok
-}
-
-// ResetLastErrorAt resets all changes to the "last_error_at" field.
-func (m *MonitorRuntimeMutation) ResetLastErrorAt() {
-	m.last_error_at = nil
-	delete(m.clearedFields, monitorruntime.FieldLastErrorAt)
+// ResetExpiresAt resets all changes to the "expires_at" field.
+func (m *SelectorPayloadMutation) ResetExpiresAt() {
+	m.expires_at = nil
 }
 
-// SetLastStatusCode sets the "last_status_code" field.
-func (m *MonitorRuntimeMutation) SetLastStatusCode(i int) {
-	m.last_status_code = &i
-	m.addlast_status_code = nil
+// SetCreatedAt sets the "created_at" field.
+func (m *SelectorPayloadMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
 }
 
-// LastStatusCode returns the value of the "last_status_code" field in the mutation.
-func (m *MonitorRuntimeMutation) LastStatusCode() (r int, exists bool) {
-	v := m.last_status_code
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *SelectorPayloadMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
-
-// OldLastStatusCode returns the old "last_status_code" field's value of the MonitorRuntime entity.
-// If the MonitorRuntime object wasn't provided to the builder, the object is fetched from the database.
+
+// OldCreatedAt returns the old "created_at" field's value of the SelectorPayload entity.
+// If the SelectorPayload object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MonitorRuntimeMutation) OldLastStatusCode(ctx context.Context) (v *int, err error) {
+func (m *SelectorPayloadMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldLastStatusCode is only allowed on UpdateOne operations")
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldLastStatusCode requires an ID field in the mutation")
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldLastStatusCode: %w", err)
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
 	}
-	return oldValue.LastStatusCode, nil
+	return oldValue.CreatedAt, nil
 }
 
-// AddLastStatusCode adds i to the "last_status_code" field.
-func (m *MonitorRuntimeMutation) AddLastStatusCode(i int) {
-	if m.addlast_status_code != nil {
-		*m.addlast_status_code += i
-	} else {
-		m.addlast_status_code = &i
-	}
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *SelectorPayloadMutation) ResetCreatedAt() {
+	m.created_at = nil
 }
 
-// AddedLastStatusCode returns the value that was added to the "last_status_code" field in this mutation.
-func (m *MonitorRuntimeMutation) AddedLastStatusCode() (r int, exists bool) {
-	v := m.addlast_status_code
-	if v == nil {
-		return
-	}
-	return *v, true
+// Where appends a list predicates to the SelectorPayloadMutation builder.
+func (m *SelectorPayloadMutation) Where(ps ...predicate.SelectorPayload) {
+	m.predicates = append(m.predicates, ps...)
 }
 
-// ClearLastStatusCode clears the value of the "last_status_code" field.
-func (m *MonitorRuntimeMutation) ClearLastStatusCode() {
-	m.last_status_code = nil
-	m.addlast_status_code = nil
-	m.clearedFields[monitorruntime.FieldLastStatusCode] = struct{}{}
+// WhereP appends storage-level predicates to the SelectorPayloadMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *SelectorPayloadMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.SelectorPayload, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
 }
 
-// LastStatusCodeCleared returns if the "last_status_code" field was cleared in this mutation.
-func (m *MonitorRuntimeMutation) LastStatusCodeCleared() bool {
-	_, ok := m.clearedFields[monitorruntime.FieldLastStatusCode]
-	return ok
+// Op returns the operation name.
+func (m *SelectorPayloadMutation) Op() Op {
+	return m.op
 }
 
-// ResetLastStatusCode resets all changes to the "last_status_code" field.
-func (m *MonitorRuntimeMutation) ResetLastStatusCode() {
-	m.last_status_code = nil
-	m.addlast_status_code = nil
-	delete(m.clearedFields, monitorruntime.FieldLastStatusCode)
+// SetOp allows setting the mutation operation.
+func (m *SelectorPayloadMutation) SetOp(op Op) {
+	m.op = op
 }
 
-// SetLastDurationMs sets the "last_duration_ms" field.
-func (m *MonitorRuntimeMutation) SetLastDurationMs(i int) {
-	m.last_duration_ms = &i
-	m.addlast_duration_ms = nil
+// Type returns the node type of this mutation (SelectorPayload).
+func (m *SelectorPayloadMutation) Type() string {
+	return m.typ
 }
 
-// LastDurationMs returns the value of the "last_duration_ms" field in the mutation.
-func (m *MonitorRuntimeMutation) LastDurationMs() (r int, exists bool) {
-	v := m.last_duration_ms
-	if v == nil {
-		return
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *SelectorPayloadMutation) Fields() []string {
+	fields := make([]string, 0, 4)
+	if m.token != nil {
+		fields = append(fields, selectorpayload.FieldToken)
 	}
-	return *v, true
+	if m.payload != nil {
+		fields = append(fields, selectorpayload.FieldPayload)
+	}
+	if m.expires_at != nil {
+		fields = append(fields, selectorpayload.FieldExpiresAt)
+	}
+	if m.created_at != nil {
+		fields = append(fields, selectorpayload.FieldCreatedAt)
+	}
+	return fields
 }
 
-// OldLastDurationMs returns the old "last_duration_ms" field's value of the MonitorRuntime entity.
-// If the MonitorRuntime object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MonitorRuntimeMutation) OldLastDurationMs(ctx context.Context) (v *int, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldLastDurationMs is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldLastDurationMs requires an ID field in the mutation")
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *SelectorPayloadMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case selectorpayload.FieldToken:
+		return m.Token()
+	case selectorpayload.FieldPayload:
+		return m.Payload()
+	case selectorpayload.FieldExpiresAt:
+		return m.ExpiresAt()
+	case selectorpayload.FieldCreatedAt:
+		return m.CreatedAt()
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldLastDurationMs: %w", err)
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *SelectorPayloadMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case selectorpayload.FieldToken:
+		return m.OldToken(ctx)
+	case selectorpayload.FieldPayload:
+		return m.OldPayload(ctx)
+	case selectorpayload.FieldExpiresAt:
+		return m.OldExpiresAt(ctx)
+	case selectorpayload.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
 	}
-	return oldValue.LastDurationMs, nil
+	return nil, fmt.Errorf("unknown SelectorPayload field %s", name)
 }
 
-// AddLastDurationMs adds i to the "last_duration_ms" field.
-func (m *MonitorRuntimeMutation) AddLastDurationMs(i int) {
-	if m.addlast_duration_ms != nil {
-		*m.addlast_duration_ms += i
-	} else {
-		m.addlast_duration_ms = &i
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SelectorPayloadMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case selectorpayload.FieldToken:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetToken(v)
+		return nil
+	case selectorpayload.FieldPayload:
+		v, ok := value.([]byte)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPayload(v)
+		return nil
+	case selectorpayload.FieldExpiresAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExpiresAt(v)
+		return nil
+	case selectorpayload.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
 	}
+	return fmt.Errorf("unknown SelectorPayload field %s", name)
 }
 
-// AddedLastDurationMs returns the value that was added to the "last_duration_ms" field in this mutation.
-func (m *MonitorRuntimeMutation) AddedLastDurationMs() (r int, exists bool) {
-	v := m.addlast_duration_ms
-	if v == nil {
-		return
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *SelectorPayloadMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *SelectorPayloadMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SelectorPayloadMutation) AddField(name string, value ent.Value) error {
+	switch name {
 	}
-	return *v, true
+	return fmt.Errorf("unknown SelectorPayload numeric field %s", name)
 }
 
-// ClearLastDurationMs clears the value of the "last_duration_ms" field.
-func (m *MonitorRuntimeMutation) ClearLastDurationMs() {
-	m.last_duration_ms = nil
-	m.addlast_duration_ms = nil
-	m.clearedFields[monitorruntime.FieldLastDurationMs] = struct{}{}
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *SelectorPayloadMutation) ClearedFields() []string {
+	return nil
 }
 
-// LastDurationMsCleared returns if the "last_duration_ms" field was cleared in this mutation.
-func (m *MonitorRuntimeMutation) LastDurationMsCleared() bool {
-	_, ok := m.clearedFields[monitorruntime.FieldLastDurationMs]
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *SelectorPayloadMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
 	return ok
 }
 
-// ResetLastDurationMs resets all changes to the "last_duration_ms" field.
-func (m *MonitorRuntimeMutation) ResetLastDurationMs() {
-	m.last_duration_ms = nil
-	m.addlast_duration_ms = nil
-	delete(m.clearedFields, monitorruntime.FieldLastDurationMs)
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *SelectorPayloadMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown SelectorPayload nullable field %s", name)
 }
 
-// SetLastErrorMessage sets the "last_error_message" field.
-func (m *MonitorRuntimeMutation) SetLastErrorMessage(s string) {
-	m.last_error_message = &s
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *SelectorPayloadMutation) ResetField(name string) error {
+	switch name {
+	case selectorpayload.FieldToken:
+		m.ResetToken()
+		return nil
+	case selectorpayload.FieldPayload:
+		m.ResetPayload()
+		return nil
+	case selectorpayload.FieldExpiresAt:
+		m.ResetExpiresAt()
+		return nil
+	case selectorpayload.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown SelectorPayload field %s", name)
 }
 
-// LastErrorMessage returns the value of the "last_error_message" field in the mutation.
-func (m *MonitorRuntimeMutation) LastErrorMessage() (r string, exists bool) {
-	v := m.last_error_message
-	if v == nil {
-		return
-	}
-	return *v, true
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *SelectorPayloadMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
 }
 
-// OldLastErrorMessage returns the old "last_error_message" field's value of the MonitorRuntime entity.
-// If the MonitorRuntime object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MonitorRuntimeMutation) OldLastErrorMessage(ctx context.Context) (v *string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldLastErrorMessage is only allowed on UpdateOne operations")
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *SelectorPayloadMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *SelectorPayloadMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *SelectorPayloadMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *SelectorPayloadMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *SelectorPayloadMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *SelectorPayloadMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown SelectorPayload unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *SelectorPayloadMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown SelectorPayload edge %s", name)
+}
+
+// SessionMutation represents an operation that mutates the Session nodes in the graph.
+type SessionMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	token_hash    *string
+	expires_at    *time.Time
+	created_at    *time.Time
+	clearedFields map[string]struct{}
+	user          *int
+	cleareduser   bool
+	done          bool
+	oldValue      func(context.Context) (*Session, error)
+	predicates    []predicate.Session
+}
+
+var _ ent.Mutation = (*SessionMutation)(nil)
+
+// sessionOption allows management of the mutation configuration using functional options.
+type sessionOption func(*SessionMutation)
+
+// newSessionMutation creates new mutation for the Session entity.
+func newSessionMutation(c config, op Op, opts ...sessionOption) *SessionMutation {
+	m := &SessionMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeSession,
+		clearedFields: make(map[string]struct{}),
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldLastErrorMessage requires an ID field in the mutation")
+	for _, opt := range opts {
+		opt(m)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldLastErrorMessage: %w", err)
+	return m
+}
+
+// withSessionID sets the ID field of the mutation.
+func withSessionID(id int) sessionOption {
+	return func(m *SessionMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Session
+		)
+		m.oldValue = func(ctx context.Context) (*Session, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Session.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
 	}
-	return oldValue.LastErrorMessage, nil
 }
 
-// ClearLastErrorMessage clears the value of the "last_error_message" field.
-func (m *MonitorRuntimeMutation) ClearLastErrorMessage() {
-	m.last_error_message = nil
-	m.clearedFields[monitorruntime.FieldLastErrorMessage] = struct{}{}
+// withSession sets the old Session of the mutation.
+func withSession(node *Session) sessionOption {
+	return func(m *SessionMutation) {
+		m.oldValue = func(context.Context) (*Session, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
 }
 
-// LastErrorMessageCleared returns if the "last_error_message" field was cleared in this mutation.
-func (m *MonitorRuntimeMutation) LastErrorMessageCleared() bool {
-	_, ok := m.clearedFields[monitorruntime.FieldLastErrorMessage]
-	return ok
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m SessionMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
 }
 
-// ResetLastErrorMessage resets all changes to the "last_error_message" field.
-func (m *MonitorRuntimeMutation) ResetLastErrorMessage() {
-	m.last_error_message = nil
-	delete(m.clearedFields, monitorruntime.FieldLastErrorMessage)
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m SessionMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
 }
 
-// SetNextRunAt sets the "next_run_at" field.
-func (m *MonitorRuntimeMutation) SetNextRunAt(t time.Time) {
-	m.next_run_at = &t
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *SessionMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
 }
 
-// NextRunAt returns the value of the "next_run_at" field in the mutation.
-func (m *MonitorRuntimeMutation) NextRunAt() (r time.Time, exists bool) {
-	v := m.next_run_at
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *SessionMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Session.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetTokenHash sets the "token_hash" field.
+func (m *SessionMutation) SetTokenHash(s string) {
+	m.token_hash = &s
+}
+
+// TokenHash returns the value of the "token_hash" field in the mutation.
+func (m *SessionMutation) TokenHash() (r string, exists bool) {
+	v := m.token_hash
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldNextRunAt returns the old "next_run_at" field's value of the MonitorRuntime entity.
-// If the MonitorRuntime object wasn't provided to the builder, the object is fetched from the database.
+// OldTokenHash returns the old "token_hash" field's value of the Session entity.
+// If the Session object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MonitorRuntimeMutation) OldNextRunAt(ctx context.Context) (v *time.Time, err error) {
+func (m *SessionMutation) OldTokenHash(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldNextRunAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldTokenHash is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldNextRunAt requires an ID field in the mutation")
+		return v, errors.New("OldTokenHash requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldNextRunAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldTokenHash: %w", err)
 	}
-	return oldValue.NextRunAt, nil
-}
-
-// ClearNextRunAt clears the value of the "next_run_at" field.
-func (m *MonitorRuntimeMutation) ClearNextRunAt() {
-	m.next_run_at = nil
-	m.clearedFields[monitorruntime.FieldNextRunAt] = struct{}{}
-}
-
-// NextRunAtCleared returns if the "next_run_at" field was cleared in this mutation.
-func (m *MonitorRuntimeMutation) NextRunAtCleared() bool {
-	_, ok := m.clearedFields[monitorruntime.FieldNextRunAt]
-	return ok
+	return oldValue.TokenHash, nil
 }
 
-// ResetNextRunAt resets all changes to the "next_run_at" field.
-func (m *MonitorRuntimeMutation) ResetNextRunAt() {
-	m.next_run_at = nil
-	delete(m.clearedFields, monitorruntime.FieldNextRunAt)
+// ResetTokenHash resets all changes to the "token_hash" field.
+func (m *SessionMutation) ResetTokenHash() {
+	m.token_hash = nil
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (m *MonitorRuntimeMutation) SetUpdatedAt(t time.Time) {
-	m.updated_at = &t
+// SetUserID sets the "user_id" field.
+func (m *SessionMutation) SetUserID(i int) {
+	m.user = &i
 }
 
-// UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *MonitorRuntimeMutation) UpdatedAt() (r time.Time, exists bool) {
-	v := m.updated_at
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *SessionMutation) UserID() (r int, exists bool) {
+	v := m.user
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the MonitorRuntime entity.
-// If the MonitorRuntime object wasn't provided to the builder, the object is fetched from the database.
+// OldUserID returns the old "user_id" field's value of the Session entity.
+// If the Session object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MonitorRuntimeMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *SessionMutation) OldUserID(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+		return v, errors.New("OldUserID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
-	}
-	return oldValue.UpdatedAt, nil
-}
-
-// ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *MonitorRuntimeMutation) ResetUpdatedAt() {
-	m.updated_at = nil
-}
-
-// SetMonitorID sets the "monitor" edge to the Monitor entity by id.
-func (m *MonitorRuntimeMutation) SetMonitorID(id int) {
-	m.monitor = &id
-}
-
-// ClearMonitor clears the "monitor" edge to the Monitor entity.
-func (m *MonitorRuntimeMutation) ClearMonitor() {
-	m.clearedmonitor = true
-}
-
-// MonitorCleared reports if the "monitor" edge to the Monitor entity was cleared.
-func (m *MonitorRuntimeMutation) MonitorCleared() bool {
-	return m.clearedmonitor
-}
-
-// MonitorID returns the "monitor" edge ID in the mutation.
-func (m *MonitorRuntimeMutation) MonitorID() (id int, exists bool) {
-	if m.monitor != nil {
-		return *m.monitor, true
-	}
-	return
-}
-
-// MonitorIDs returns the "monitor" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// MonitorID instead. It exists only for internal usage by the builders.
-func (m *MonitorRuntimeMutation) MonitorIDs() (ids []int) {
-	if id := m.monitor; id != nil {
-		ids = append(ids, *id)
-	}
-	return
-}
-
-// ResetMonitor resets all changes to the "monitor" edge.
-func (m *MonitorRuntimeMutation) ResetMonitor() {
-	m.monitor = nil
-	m.clearedmonitor = false
-}
-
-// Where appends a list predicates to the MonitorRuntimeMutation builder.
-func (m *MonitorRuntimeMutation) Where(ps ...predicate.MonitorRuntime) {
-	m.predicates = append(m.predicates, ps...)
-}
-
-// WhereP appends storage-level predicates to the MonitorRuntimeMutation builder. Using this method,
-// users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *MonitorRuntimeMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.MonitorRuntime, len(ps))
-	for i := range ps {
-		p[i] = ps[i]
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
 	}
-	m.Where(p...)
-}
-
-// Op returns the operation name.
-func (m *MonitorRuntimeMutation) Op() Op {
-	return m.op
+	return oldValue.UserID, nil
 }
 
-// SetOp allows setting the mutation operation.
-func (m *MonitorRuntimeMutation) SetOp(op Op) {
-	m.op = op
+// ResetUserID resets all changes to the "user_id" field.
+func (m *SessionMutation) ResetUserID() {
+	m.user = nil
 }
 
-// Type returns the node type of this mutation (MonitorRuntime).
-func (m *MonitorRuntimeMutation) Type() string {
-	return m.typ
+// SetExpiresAt sets the "expires_at" field.
+func (m *SessionMutation) SetExpiresAt(t time.Time) {
+	m.expires_at = &t
 }
 
-// Fields returns all fields that were changed during this mutation. Note that in
-// order to get all numeric fields that were incremented/decremented, call
-// AddedFields().
-func (m *MonitorRuntimeMutation) Fields() []string {
-	fields := make([]string, 0, 15)
-	if m.status != nil {
-		fields = append(fields, monitorruntime.FieldStatus)
-	}
-	if m.check_count != nil {
-		fields = append(fields, monitorruntime.FieldCheckCount)
-	}
-	if m.success_count != nil {
-		fields = append(fields, monitorruntime.FieldSuccessCount)
-	}
-	if m.error_count != nil {
-		fields = append(fields, monitorruntime.FieldErrorCount)
-	}
-	if m.retry_count != nil {
-		fields = append(fields, monitorruntime.FieldRetryCount)
-	}
-	if m.consecutive_successes != nil {
-		fields = append(fields, monitorruntime.FieldConsecutiveSuccesses)
-	}
-	if m.consecutive_errors != nil {
-		fields = append(fields, monitorruntime.FieldConsecutiveErrors)
-	}
-	if m.last_check_at != nil {
-		fields = append(fields, monitorruntime.FieldLastCheckAt)
-	}
-	if m.last_success_at != nil {
-		fields = append(fields, monitorruntime.FieldLastSuccessAt)
-	}
-	if m.last_error_at != nil {
-		fields = append(fields, monitorruntime.FieldLastErrorAt)
-	}
-	if m.last_status_code != nil {
-		fields = append(fields, monitorruntime.FieldLastStatusCode)
-	}
-	if m.last_duration_ms != nil {
-		fields = append(fields, monitorruntime.FieldLastDurationMs)
-	}
-	if m.last_error_message != nil {
-		fields = append(fields, monitorruntime.FieldLastErrorMessage)
-	}
-	if m.next_run_at != nil {
-		fields = append(fields, monitorruntime.FieldNextRunAt)
-	}
-	if m.updated_at != nil {
-		fields = append(fields, monitorruntime.FieldUpdatedAt)
+// ExpiresAt returns the value of the "expires_at" field in the mutation.
+func (m *SessionMutation) ExpiresAt() (r time.Time, exists bool) {
+	v := m.expires_at
+	if v == nil {
+		return
 	}
-	return fields
+	return *v, true
 }
 
-// Field returns the value of a field with the given name. The second boolean
-// return value indicates that this field was not set, or was not defined in the
-// schema.
-func (m *MonitorRuntimeMutation) Field(name string) (ent.Value, bool) {
-	switch name {
-	case monitorruntime.FieldStatus:
-		return m.Status()
-	case monitorruntime.FieldCheckCount:
-		return m.CheckCount()
-	case monitorruntime.FieldSuccessCount:
-		return m.SuccessCount()
-	case monitorruntime.FieldErrorCount:
-		return m.ErrorCount()
-	case monitorruntime.FieldRetryCount:
-		return m.RetryCount()
-	case monitorruntime.FieldConsecutiveSuccesses:
-		return m.ConsecutiveSuccesses()
-	case monitorruntime.FieldConsecutiveErrors:
-		return m.ConsecutiveErrors()
-	case monitorruntime.FieldLastCheckAt:
-		return m.LastCheckAt()
-	case monitorruntime.FieldLastSuccessAt:
-		return m.LastSuccessAt()
-	case monitorruntime.FieldLastErrorAt:
-		return m.LastErrorAt()
-	case monitorruntime.FieldLastStatusCode:
-		return m.LastStatusCode()
-	case monitorruntime.FieldLastDurationMs:
-		return m.LastDurationMs()
-	case monitorruntime.FieldLastErrorMessage:
-		return m.LastErrorMessage()
-	case monitorruntime.FieldNextRunAt:
-		return m.NextRunAt()
-	case monitorruntime.FieldUpdatedAt:
-		return m.UpdatedAt()
+// OldExpiresAt returns the old "expires_at" field's value of the Session entity.
+// If the Session object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SessionMutation) OldExpiresAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExpiresAt is only allowed on UpdateOne operations")
 	}
-	return nil, false
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExpiresAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExpiresAt: %w", err)
+	}
+	return oldValue.ExpiresAt, nil
 }
 
-// OldField returns the old value of the field from the database. An error is
-// returned if the mutation operation is not UpdateOne, or the query to the
-// database failed.
-func (m *MonitorRuntimeMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
-	switch name {
-	case monitorruntime.FieldStatus:
-		return m.OldStatus(ctx)
-	case monitorruntime.FieldCheckCount:
-		return m.OldCheckCount(ctx)
-	case monitorruntime.FieldSuccessCount:
-		return m.OldSuccessCount(ctx)
-	case monitorruntime.FieldErrorCount:
-		return m.OldErrorCount(ctx)
-	case monitorruntime.FieldRetryCount:
-		return m.OldRetryCount(ctx)
-	case monitorruntime.FieldConsecutiveSuccesses:
-		return m.OldConsecutiveSuccesses(ctx)
-	case monitorruntime.FieldConsecutiveErrors:
-		return m.OldConsecutiveErrors(ctx)
-	case monitorruntime.FieldLastCheckAt:
-		return m.OldLastCheckAt(ctx)
-	case monitorruntime.FieldLastSuccessAt:
-		return m.OldLastSuccessAt(ctx)
-	case monitorruntime.FieldLastErrorAt:
-		return m.OldLastErrorAt(ctx)
-	case monitorruntime.FieldLastStatusCode:
-		return m.OldLastStatusCode(ctx)
-	case monitorruntime.FieldLastDurationMs:
-		return m.OldLastDurationMs(ctx)
-	case monitorruntime.FieldLastErrorMessage:
-		return m.OldLastErrorMessage(ctx)
-	case monitorruntime.FieldNextRunAt:
-		return m.OldNextRunAt(ctx)
-	case monitorruntime.FieldUpdatedAt:
-		return m.OldUpdatedAt(ctx)
-	}
-	return nil, fmt.Errorf("unknown MonitorRuntime field %s", name)
+// ResetExpiresAt resets all changes to the "expires_at" field.
+func (m *SessionMutation) ResetExpiresAt() {
+	m.expires_at = nil
 }
 
-// SetField sets the value of a field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *MonitorRuntimeMutation) SetField(name string, value ent.Value) error {
-	switch name {
-	case monitorruntime.FieldStatus:
-		v, ok := value.(monitorruntime.Status)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetStatus(v)
-		return nil
-	case monitorruntime.FieldCheckCount:
-		v, ok := value.(int64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCheckCount(v)
-		return nil
-	case monitorruntime.FieldSuccessCount:
-		v, ok := value.(int64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetSuccessCount(v)
-		return nil
-	case monitorruntime.FieldErrorCount:
-		v, ok := value.(int64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetErrorCount(v)
-		return nil
-	case monitorruntime.FieldRetryCount:
-		v, ok := value.(int64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetRetryCount(v)
-		return nil
-	case monitorruntime.FieldConsecutiveSuccesses:
-		v, ok := value.(int64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetConsecutiveSuccesses(v)
-		return nil
-	case monitorruntime.FieldConsecutiveErrors:
-		v, ok := value.(int64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetConsecutiveErrors(v)
-		return nil
-	case monitorruntime.FieldLastCheckAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetLastCheckAt(v)
-		return nil
-	case monitorruntime.FieldLastSuccessAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetLastSuccessAt(v)
-		return nil
-	case monitorruntime.FieldLastErrorAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetLastErrorAt(v)
-		return nil
-	case monitorruntime.FieldLastStatusCode:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetLastStatusCode(v)
-		return nil
-	case monitorruntime.FieldLastDurationMs:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetLastDurationMs(v)
-		return nil
-	case monitorruntime.FieldLastErrorMessage:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetLastErrorMessage(v)
-		return nil
-	case monitorruntime.FieldNextRunAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetNextRunAt(v)
-		return nil
-	case monitorruntime.FieldUpdatedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetUpdatedAt(v)
-		return nil
+// SetCreatedAt sets the "created_at" field.
+func (m *SessionMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *SessionMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
 	}
-	return fmt.Errorf("unknown MonitorRuntime field %s", name)
+	return *v, true
 }
 
-// AddedFields returns all numeric fields that were incremented/decremented during
-// this mutation.
-func (m *MonitorRuntimeMutation) AddedFields() []string {
-	var fields []string
-	if m.addcheck_count != nil {
-		fields = append(fields, monitorruntime.FieldCheckCount)
+// OldCreatedAt returns the old "created_at" field's value of the Session entity.
+// If the Session object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SessionMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
-	if m.addsuccess_count != nil {
-		fields = append(fields, monitorruntime.FieldSuccessCount)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
 	}
-	if m.adderror_count != nil {
-		fields = append(fields, monitorruntime.FieldErrorCount)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
 	}
-	if m.addretry_count != nil {
-		fields = append(fields, monitorruntime.FieldRetryCount)
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *SessionMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (m *SessionMutation) ClearUser() {
+	m.cleareduser = true
+	m.clearedFields[session.FieldUserID] = struct{}{}
+}
+
+// UserCleared reports if the "user" edge to the User entity was cleared.
+func (m *SessionMutation) UserCleared() bool {
+	return m.cleareduser
+}
+
+// UserIDs returns the "user" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UserID instead. It exists only for internal usage by the builders.
+func (m *SessionMutation) UserIDs() (ids []int) {
+	if id := m.user; id != nil {
+		ids = append(ids, *id)
 	}
-	if m.addconsecutive_successes != nil {
-		fields = append(fields, monitorruntime.FieldConsecutiveSuccesses)
+	return
+}
+
+// ResetUser resets all changes to the "user" edge.
+func (m *SessionMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
+}
+
+// Where appends a list predicates to the SessionMutation builder.
+func (m *SessionMutation) Where(ps ...predicate.Session) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the SessionMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *SessionMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Session, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
 	}
-	if m.addconsecutive_errors != nil {
-		fields = append(fields, monitorruntime.FieldConsecutiveErrors)
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *SessionMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *SessionMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Session).
+func (m *SessionMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *SessionMutation) Fields() []string {
+	fields := make([]string, 0, 4)
+	if m.token_hash != nil {
+		fields = append(fields, session.FieldTokenHash)
 	}
-	if m.addlast_status_code != nil {
-		fields = append(fields, monitorruntime.FieldLastStatusCode)
+	if m.user != nil {
+		fields = append(fields, session.FieldUserID)
 	}
-	if m.addlast_duration_ms != nil {
-		fields = append(fields, monitorruntime.FieldLastDurationMs)
+	if m.expires_at != nil {
+		fields = append(fields, session.FieldExpiresAt)
+	}
+	if m.created_at != nil {
+		fields = append(fields, session.FieldCreatedAt)
 	}
 	return fields
 }
 
-// AddedField returns the numeric value that was incremented/decremented on a field
-// with the given name. The second boolean return value indicates that this field
-// was not set, or was not defined in the schema.
-func (m *MonitorRuntimeMutation) AddedField(name string) (ent.Value, bool) {
-	switch name {
-	case monitorruntime.FieldCheckCount:
-		return m.AddedCheckCount()
-	case monitorruntime.FieldSuccessCount:
-		return m.AddedSuccessCount()
-	case monitorruntime.FieldErrorCount:
-		return m.AddedErrorCount()
-	case monitorruntime.FieldRetryCount:
-		return m.AddedRetryCount()
-	case monitorruntime.FieldConsecutiveSuccesses:
-		return m.AddedConsecutiveSuccesses()
-	case monitorruntime.FieldConsecutiveErrors:
-		return m.AddedConsecutiveErrors()
-	case monitorruntime.FieldLastStatusCode:
-		return m.AddedLastStatusCode()
-	case monitorruntime.FieldLastDurationMs:
-		return m.AddedLastDurationMs()
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *SessionMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case session.FieldTokenHash:
+		return m.TokenHash()
+	case session.FieldUserID:
+		return m.UserID()
+	case session.FieldExpiresAt:
+		return m.ExpiresAt()
+	case session.FieldCreatedAt:
+		return m.CreatedAt()
 	}
 	return nil, false
 }
 
-// AddField adds the value to the field with the given name. It returns an error if
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *SessionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case session.FieldTokenHash:
+		return m.OldTokenHash(ctx)
+	case session.FieldUserID:
+		return m.OldUserID(ctx)
+	case session.FieldExpiresAt:
+		return m.OldExpiresAt(ctx)
+	case session.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Session field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *MonitorRuntimeMutation) AddField(name string, value ent.Value) error {
+func (m *SessionMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case monitorruntime.FieldCheckCount:
-		v, ok := value.(int64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddCheckCount(v)
-		return nil
-	case monitorruntime.FieldSuccessCount:
-		v, ok := value.(int64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddSuccessCount(v)
-		return nil
-	case monitorruntime.FieldErrorCount:
-		v, ok := value.(int64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddErrorCount(v)
-		return nil
-	case monitorruntime.FieldRetryCount:
-		v, ok := value.(int64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddRetryCount(v)
-		return nil
-	case monitorruntime.FieldConsecutiveSuccesses:
-		v, ok := value.(int64)
+	case session.FieldTokenHash:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddConsecutiveSuccesses(v)
+		m.SetTokenHash(v)
 		return nil
-	case monitorruntime.FieldConsecutiveErrors:
-		v, ok := value.(int64)
+	case session.FieldUserID:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddConsecutiveErrors(v)
+		m.SetUserID(v)
 		return nil
-	case monitorruntime.FieldLastStatusCode:
-		v, ok := value.(int)
+	case session.FieldExpiresAt:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddLastStatusCode(v)
+		m.SetExpiresAt(v)
 		return nil
-	case monitorruntime.FieldLastDurationMs:
-		v, ok := value.(int)
+	case session.FieldCreatedAt:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddLastDurationMs(v)
+		m.SetCreatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown MonitorRuntime numeric field %s", name)
+	return fmt.Errorf("unknown Session field %s", name)
 }
 
-// ClearedFields returns all nullable fields that were cleared during this
-// mutation.
-func (m *MonitorRuntimeMutation) ClearedFields() []string {
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *SessionMutation) AddedFields() []string {
 	var fields []string
-	if m.FieldCleared(monitorruntime.FieldLastCheckAt) {
-		fields = append(fields, monitorruntime.FieldLastCheckAt)
-	}
-	if m.FieldCleared(monitorruntime.FieldLastSuccessAt) {
-		fields = append(fields, monitorruntime.FieldLastSuccessAt)
-	}
-	if m.FieldCleared(monitorruntime.FieldLastErrorAt) {
-		fields = append(fields, monitorruntime.FieldLastErrorAt)
-	}
-	if m.FieldCleared(monitorruntime.FieldLastStatusCode) {
-		fields = append(fields, monitorruntime.FieldLastStatusCode)
-	}
-	if m.FieldCleared(monitorruntime.FieldLastDurationMs) {
-		fields = append(fields, monitorruntime.FieldLastDurationMs)
-	}
-	if m.FieldCleared(monitorruntime.FieldLastErrorMessage) {
-		fields = append(fields, monitorruntime.FieldLastErrorMessage)
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *SessionMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
 	}
-	if m.FieldCleared(monitorruntime.FieldNextRunAt) {
-		fields = append(fields, monitorruntime.FieldNextRunAt)
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SessionMutation) AddField(name string, value ent.Value) error {
+	switch name {
 	}
-	return fields
+	return fmt.Errorf("unknown Session numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *SessionMutation) ClearedFields() []string {
+	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *MonitorRuntimeMutation) FieldCleared(name string) bool {
+func (m *SessionMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *MonitorRuntimeMutation) ClearField(name string) error {
-	switch name {
-	case monitorruntime.FieldLastCheckAt:
-		m.ClearLastCheckAt()
-		return nil
-	case monitorruntime.FieldLastSuccessAt:
-		m.ClearLastSuccessAt()
-		return nil
-	case monitorruntime.FieldLastErrorAt:
-		m.ClearLastErrorAt()
-		return nil
-	case monitorruntime.FieldLastStatusCode:
-		m.ClearLastStatusCode()
-		return nil
-	case monitorruntime.FieldLastDurationMs:
-		m.ClearLastDurationMs()
-		return nil
-	case monitorruntime.FieldLastErrorMessage:
-		m.ClearLastErrorMessage()
-		return nil
-	case monitorruntime.FieldNextRunAt:
-		m.ClearNextRunAt()
-		return nil
-	}
-	return fmt.Errorf("unknown MonitorRuntime nullable field %s", name)
+func (m *SessionMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown Session nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *MonitorRuntimeMutation) ResetField(name string) error {
+func (m *SessionMutation) ResetField(name string) error {
 	switch name {
-	case monitorruntime.FieldStatus:
-		m.ResetStatus()
-		return nil
-	case monitorruntime.FieldCheckCount:
-		m.ResetCheckCount()
-		return nil
-	case monitorruntime.FieldSuccessCount:
-		m.ResetSuccessCount()
-		return nil
-	case monitorruntime.FieldErrorCount:
-		m.ResetErrorCount()
-		return nil
-	case monitorruntime.FieldRetryCount:
-		m.ResetRetryCount()
-		return nil
-	case monitorruntime.FieldConsecutiveSuccesses:
-		m.ResetConsecutiveSuccesses()
-		return nil
-	case monitorruntime.FieldConsecutiveErrors:
-		m.ResetConsecutiveErrors()
-		return nil
-	case monitorruntime.FieldLastCheckAt:
-		m.ResetLastCheckAt()
-		return nil
-	case monitorruntime.FieldLastSuccessAt:
-		m.ResetLastSuccessAt()
-		return nil
-	case monitorruntime.FieldLastErrorAt:
-		m.ResetLastErrorAt()
-		return nil
-	case monitorruntime.FieldLastStatusCode:
-		m.ResetLastStatusCode()
-		return nil
-	case monitorruntime.FieldLastDurationMs:
-		m.ResetLastDurationMs()
+	case session.FieldTokenHash:
+		m.ResetTokenHash()
 		return nil
-	case monitorruntime.FieldLastErrorMessage:
-		m.ResetLastErrorMessage()
+	case session.FieldUserID:
+		m.ResetUserID()
 		return nil
-	case monitorruntime.FieldNextRunAt:
-		m.ResetNextRunAt()
+	case session.FieldExpiresAt:
+		m.ResetExpiresAt()
 		return nil
-	case monitorruntime.FieldUpdatedAt:
-		m.ResetUpdatedAt()
+	case session.FieldCreatedAt:
+		m.ResetCreatedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown MonitorRuntime field %s", name)
+	return fmt.Errorf("unknown Session field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *MonitorRuntimeMutation) AddedEdges() []string {
+func (m *SessionMutation) AddedEdges() []string {
 	edges := make([]string, 0, 1)
-	if m.monitor != nil {
-		edges = append(edges, monitorruntime.EdgeMonitor)
+	if m.user != nil {
+		edges = append(edges, session.EdgeUser)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *MonitorRuntimeMutation) AddedIDs(name string) []ent.Value {
+func (m *SessionMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case monitorruntime.EdgeMonitor:
-		if id := m.monitor; id != nil {
+	case session.EdgeUser:
+		if id := m.user; id != nil {
 			return []ent.Value{*id}
 		}
 	}
@@ -4187,91 +14268,119 @@ func (m *MonitorRuntimeMutation) AddedIDs(name string) []ent.Value {
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *MonitorRuntimeMutation) RemovedEdges() []string {
+func (m *SessionMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 1)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *MonitorRuntimeMutation) RemovedIDs(name string) []ent.Value {
+func (m *SessionMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *MonitorRuntimeMutation) ClearedEdges() []string {
+func (m *SessionMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 1)
-	if m.clearedmonitor {
-		edges = append(edges, monitorruntime.EdgeMonitor)
+	if m.cleareduser {
+		edges = append(edges, session.EdgeUser)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *MonitorRuntimeMutation) EdgeCleared(name string) bool {
+func (m *SessionMutation) EdgeCleared(name string) bool {
 	switch name {
-	case monitorruntime.EdgeMonitor:
-		return m.clearedmonitor
+	case session.EdgeUser:
+		return m.cleareduser
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *MonitorRuntimeMutation) ClearEdge(name string) error {
+func (m *SessionMutation) ClearEdge(name string) error {
 	switch name {
-	case monitorruntime.EdgeMonitor:
-		m.ClearMonitor()
+	case session.EdgeUser:
+		m.ClearUser()
 		return nil
 	}
-	return fmt.Errorf("unknown MonitorRuntime unique edge %s", name)
+	return fmt.Errorf("unknown Session unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *MonitorRuntimeMutation) ResetEdge(name string) error {
+func (m *SessionMutation) ResetEdge(name string) error {
 	switch name {
-	case monitorruntime.EdgeMonitor:
-		m.ResetMonitor()
+	case session.EdgeUser:
+		m.ResetUser()
 		return nil
 	}
-	return fmt.Errorf("unknown MonitorRuntime edge %s", name)
+	return fmt.Errorf("unknown Session edge %s", name)
 }
 
-// NotificationChannelMutation represents an operation that mutates the NotificationChannel nodes in the graph.
-type NotificationChannelMutation struct {
+// SystemConfigMutation represents an operation that mutates the SystemConfig nodes in the graph.
+type SystemConfigMutation struct {
 	config
-	op                         Op
-	typ                        string
-	id                         *int
-	name                       *string
-	kind                       *notificationchannel.Kind
-	bot_token                  *string
-	chat_id                    *string
-	enabled                    *bool
-	created_at                 *time.Time
-	updated_at                 *time.Time
-	clearedFields              map[string]struct{}
-	notification_events        map[int]struct{}
-	removednotification_events map[int]struct{}
-	clearednotification_events bool
-	done                       bool
-	oldValue                   func(context.Context) (*NotificationChannel, error)
-	predicates                 []predicate.NotificationChannel
+	op                                 Op
+	typ                                string
+	id                                 *int
+	key                                *string
+	checks_history_limit               *int
+	addchecks_history_limit            *int
+	timezone                           *string
+	paused                             *bool
+	notifications_paused               *bool
+	paused_at                          *time.Time
+	stale_after_days                   *int
+	addstale_after_days                *int
+	stale_notifications                *bool
+	stale_notified_at                  *time.Time
+	schedule_jitter_seconds            *int
+	addschedule_jitter_seconds         *int
+	circuit_breaker_threshold          *int
+	addcircuit_breaker_threshold       *int
+	circuit_breaker_action             *systemconfig.CircuitBreakerAction
+	circuit_breaker_backoff_minutes    *int
+	addcircuit_breaker_backoff_minutes *int
+	catch_up_policy                    *systemconfig.CatchUpPolicy
+	catch_up_max_age_minutes           *int
+	addcatch_up_max_age_minutes        *int
+	default_user_agent                 *string
+	default_headers                    *map[string]string
+	proxy_url                          *string
+	proxy_bypass                       *string
+	request_timeout_seconds            *int
+	addrequest_timeout_seconds         *int
+	max_retries                        *int
+	addmax_retries                     *int
+	retry_backoff_seconds              *int
+	addretry_backoff_seconds           *int
+	tick_interval_seconds              *int
+	addtick_interval_seconds           *int
+	schedule_lookahead_seconds         *int
+	addschedule_lookahead_seconds      *int
+	max_response_body_bytes            *int
+	addmax_response_body_bytes         *int
+	updated_at                         *time.Time
+	clearedFields                      map[string]struct{}
+	done                               bool
+	oldValue                           func(context.Context) (*SystemConfig, error)
+	predicates                         []predicate.SystemConfig
 }
 
-var _ ent.Mutation = (*NotificationChannelMutation)(nil)
+var _ ent.Mutation = (*SystemConfigMutation)(nil)
 
-// notificationchannelOption allows management of the mutation configuration using functional options.
-type notificationchannelOption func(*NotificationChannelMutation)
+// systemconfigOption allows management of the mutation configuration using functional options.
+type systemconfigOption func(*SystemConfigMutation)
 
-// newNotificationChannelMutation creates new mutation for the NotificationChannel entity.
-func newNotificationChannelMutation(c config, op Op, opts ...notificationchannelOption) *NotificationChannelMutation {
-	m := &NotificationChannelMutation{
+// newSystemConfigMutation creates new mutation for the SystemConfig entity.
+func newSystemConfigMutation(c config, op Op, opts ...systemconfigOption) *SystemConfigMutation {
+	m := &SystemConfigMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeNotificationChannel,
+		typ:           TypeSystemConfig,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -4280,20 +14389,20 @@ func newNotificationChannelMutation(c config, op Op, opts ...notificationchannel
 	return m
 }
 
-// withNotificationChannelID sets the ID field of the mutation.
-func withNotificationChannelID(id int) notificationchannelOption {
-	return func(m *NotificationChannelMutation) {
+// withSystemConfigID sets the ID field of the mutation.
+func withSystemConfigID(id int) systemconfigOption {
+	return func(m *SystemConfigMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *NotificationChannel
+			value *SystemConfig
 		)
-		m.oldValue = func(ctx context.Context) (*NotificationChannel, error) {
+		m.oldValue = func(ctx context.Context) (*SystemConfig, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().NotificationChannel.Get(ctx, id)
+					value, err = m.Client().SystemConfig.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -4302,10 +14411,10 @@ func withNotificationChannelID(id int) notificationchannelOption {
 	}
 }
 
-// withNotificationChannel sets the old NotificationChannel of the mutation.
-func withNotificationChannel(node *NotificationChannel) notificationchannelOption {
-	return func(m *NotificationChannelMutation) {
-		m.oldValue = func(context.Context) (*NotificationChannel, error) {
+// withSystemConfig sets the old SystemConfig of the mutation.
+func withSystemConfig(node *SystemConfig) systemconfigOption {
+	return func(m *SystemConfigMutation) {
+		m.oldValue = func(context.Context) (*SystemConfig, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -4314,7 +14423,7 @@ func withNotificationChannel(node *NotificationChannel) notificationchannelOptio
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m NotificationChannelMutation) Client() *Client {
+func (m SystemConfigMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -4322,7 +14431,7 @@ func (m NotificationChannelMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m NotificationChannelMutation) Tx() (*Tx, error) {
+func (m SystemConfigMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -4333,7 +14442,7 @@ func (m NotificationChannelMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *NotificationChannelMutation) ID() (id int, exists bool) {
+func (m *SystemConfigMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -4344,7 +14453,7 @@ func (m *NotificationChannelMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *NotificationChannelMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *SystemConfigMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -4353,960 +14462,1302 @@ func (m *NotificationChannelMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().NotificationChannel.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().SystemConfig.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetName sets the "name" field.
-func (m *NotificationChannelMutation) SetName(s string) {
-	m.name = &s
+// SetKey sets the "key" field.
+func (m *SystemConfigMutation) SetKey(s string) {
+	m.key = &s
 }
 
-// Name returns the value of the "name" field in the mutation.
-func (m *NotificationChannelMutation) Name() (r string, exists bool) {
-	v := m.name
+// Key returns the value of the "key" field in the mutation.
+func (m *SystemConfigMutation) Key() (r string, exists bool) {
+	v := m.key
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldName returns the old "name" field's value of the NotificationChannel entity.
-// If the NotificationChannel object wasn't provided to the builder, the object is fetched from the database.
+// OldKey returns the old "key" field's value of the SystemConfig entity.
+// If the SystemConfig object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *NotificationChannelMutation) OldName(ctx context.Context) (v string, err error) {
+func (m *SystemConfigMutation) OldKey(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldName is only allowed on UpdateOne operations")
+		return v, errors.New("OldKey is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldName requires an ID field in the mutation")
+		return v, errors.New("OldKey requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldName: %w", err)
+		return v, fmt.Errorf("querying old value for OldKey: %w", err)
 	}
-	return oldValue.Name, nil
+	return oldValue.Key, nil
 }
 
-// ResetName resets all changes to the "name" field.
-func (m *NotificationChannelMutation) ResetName() {
-	m.name = nil
+// ResetKey resets all changes to the "key" field.
+func (m *SystemConfigMutation) ResetKey() {
+	m.key = nil
 }
 
-// SetKind sets the "kind" field.
-func (m *NotificationChannelMutation) SetKind(n notificationchannel.Kind) {
-	m.kind = &n
+// SetChecksHistoryLimit sets the "checks_history_limit" field.
+func (m *SystemConfigMutation) SetChecksHistoryLimit(i int) {
+	m.checks_history_limit = &i
+	m.addchecks_history_limit = nil
 }
 
-// Kind returns the value of the "kind" field in the mutation.
-func (m *NotificationChannelMutation) Kind() (r notificationchannel.Kind, exists bool) {
-	v := m.kind
+// ChecksHistoryLimit returns the value of the "checks_history_limit" field in the mutation.
+func (m *SystemConfigMutation) ChecksHistoryLimit() (r int, exists bool) {
+	v := m.checks_history_limit
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldKind returns the old "kind" field's value of the NotificationChannel entity.
-// If the NotificationChannel object wasn't provided to the builder, the object is fetched from the database.
+// OldChecksHistoryLimit returns the old "checks_history_limit" field's value of the SystemConfig entity.
+// If the SystemConfig object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *NotificationChannelMutation) OldKind(ctx context.Context) (v notificationchannel.Kind, err error) {
+func (m *SystemConfigMutation) OldChecksHistoryLimit(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldKind is only allowed on UpdateOne operations")
+		return v, errors.New("OldChecksHistoryLimit is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldKind requires an ID field in the mutation")
+		return v, errors.New("OldChecksHistoryLimit requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldKind: %w", err)
+		return v, fmt.Errorf("querying old value for OldChecksHistoryLimit: %w", err)
 	}
-	return oldValue.Kind, nil
+	return oldValue.ChecksHistoryLimit, nil
 }
 
-// ResetKind resets all changes to the "kind" field.
-func (m *NotificationChannelMutation) ResetKind() {
-	m.kind = nil
+// AddChecksHistoryLimit adds i to the "checks_history_limit" field.
+func (m *SystemConfigMutation) AddChecksHistoryLimit(i int) {
+	if m.addchecks_history_limit != nil {
+		*m.addchecks_history_limit += i
+	} else {
+		m.addchecks_history_limit = &i
+	}
 }
 
-// SetBotToken sets the "bot_token" field.
-func (m *NotificationChannelMutation) SetBotToken(s string) {
-	m.bot_token = &s
+// AddedChecksHistoryLimit returns the value that was added to the "checks_history_limit" field in this mutation.
+func (m *SystemConfigMutation) AddedChecksHistoryLimit() (r int, exists bool) {
+	v := m.addchecks_history_limit
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// BotToken returns the value of the "bot_token" field in the mutation.
-func (m *NotificationChannelMutation) BotToken() (r string, exists bool) {
-	v := m.bot_token
+// ResetChecksHistoryLimit resets all changes to the "checks_history_limit" field.
+func (m *SystemConfigMutation) ResetChecksHistoryLimit() {
+	m.checks_history_limit = nil
+	m.addchecks_history_limit = nil
+}
+
+// SetTimezone sets the "timezone" field.
+func (m *SystemConfigMutation) SetTimezone(s string) {
+	m.timezone = &s
+}
+
+// Timezone returns the value of the "timezone" field in the mutation.
+func (m *SystemConfigMutation) Timezone() (r string, exists bool) {
+	v := m.timezone
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldBotToken returns the old "bot_token" field's value of the NotificationChannel entity.
-// If the NotificationChannel object wasn't provided to the builder, the object is fetched from the database.
+// OldTimezone returns the old "timezone" field's value of the SystemConfig entity.
+// If the SystemConfig object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *NotificationChannelMutation) OldBotToken(ctx context.Context) (v string, err error) {
+func (m *SystemConfigMutation) OldTimezone(ctx context.Context) (v *string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldBotToken is only allowed on UpdateOne operations")
+		return v, errors.New("OldTimezone is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldBotToken requires an ID field in the mutation")
+		return v, errors.New("OldTimezone requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldBotToken: %w", err)
+		return v, fmt.Errorf("querying old value for OldTimezone: %w", err)
 	}
-	return oldValue.BotToken, nil
+	return oldValue.Timezone, nil
 }
 
-// ResetBotToken resets all changes to the "bot_token" field.
-func (m *NotificationChannelMutation) ResetBotToken() {
-	m.bot_token = nil
+// ClearTimezone clears the value of the "timezone" field.
+func (m *SystemConfigMutation) ClearTimezone() {
+	m.timezone = nil
+	m.clearedFields[systemconfig.FieldTimezone] = struct{}{}
 }
 
-// SetChatID sets the "chat_id" field.
-func (m *NotificationChannelMutation) SetChatID(s string) {
-	m.chat_id = &s
+// TimezoneCleared returns if the "timezone" field was cleared in this mutation.
+func (m *SystemConfigMutation) TimezoneCleared() bool {
+	_, ok := m.clearedFields[systemconfig.FieldTimezone]
+	return ok
 }
 
-// ChatID returns the value of the "chat_id" field in the mutation.
-func (m *NotificationChannelMutation) ChatID() (r string, exists bool) {
-	v := m.chat_id
+// ResetTimezone resets all changes to the "timezone" field.
+func (m *SystemConfigMutation) ResetTimezone() {
+	m.timezone = nil
+	delete(m.clearedFields, systemconfig.FieldTimezone)
+}
+
+// SetPaused sets the "paused" field.
+func (m *SystemConfigMutation) SetPaused(b bool) {
+	m.paused = &b
+}
+
+// Paused returns the value of the "paused" field in the mutation.
+func (m *SystemConfigMutation) Paused() (r bool, exists bool) {
+	v := m.paused
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldChatID returns the old "chat_id" field's value of the NotificationChannel entity.
-// If the NotificationChannel object wasn't provided to the builder, the object is fetched from the database.
+// OldPaused returns the old "paused" field's value of the SystemConfig entity.
+// If the SystemConfig object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *NotificationChannelMutation) OldChatID(ctx context.Context) (v string, err error) {
+func (m *SystemConfigMutation) OldPaused(ctx context.Context) (v bool, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldChatID is only allowed on UpdateOne operations")
+		return v, errors.New("OldPaused is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldChatID requires an ID field in the mutation")
+		return v, errors.New("OldPaused requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldChatID: %w", err)
+		return v, fmt.Errorf("querying old value for OldPaused: %w", err)
 	}
-	return oldValue.ChatID, nil
+	return oldValue.Paused, nil
 }
 
-// ResetChatID resets all changes to the "chat_id" field.
-func (m *NotificationChannelMutation) ResetChatID() {
-	m.chat_id = nil
+// ResetPaused resets all changes to the "paused" field.
+func (m *SystemConfigMutation) ResetPaused() {
+	m.paused = nil
 }
 
-// SetEnabled sets the "enabled" field.
-func (m *NotificationChannelMutation) SetEnabled(b bool) {
-	m.enabled = &b
+// SetNotificationsPaused sets the "notifications_paused" field.
+func (m *SystemConfigMutation) SetNotificationsPaused(b bool) {
+	m.notifications_paused = &b
 }
 
-// Enabled returns the value of the "enabled" field in the mutation.
-func (m *NotificationChannelMutation) Enabled() (r bool, exists bool) {
-	v := m.enabled
+// NotificationsPaused returns the value of the "notifications_paused" field in the mutation.
+func (m *SystemConfigMutation) NotificationsPaused() (r bool, exists bool) {
+	v := m.notifications_paused
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldEnabled returns the old "enabled" field's value of the NotificationChannel entity.
-// If the NotificationChannel object wasn't provided to the builder, the object is fetched from the database.
+// OldNotificationsPaused returns the old "notifications_paused" field's value of the SystemConfig entity.
+// If the SystemConfig object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *NotificationChannelMutation) OldEnabled(ctx context.Context) (v bool, err error) {
+func (m *SystemConfigMutation) OldNotificationsPaused(ctx context.Context) (v bool, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldEnabled is only allowed on UpdateOne operations")
+		return v, errors.New("OldNotificationsPaused is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldEnabled requires an ID field in the mutation")
+		return v, errors.New("OldNotificationsPaused requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldEnabled: %w", err)
+		return v, fmt.Errorf("querying old value for OldNotificationsPaused: %w", err)
 	}
-	return oldValue.Enabled, nil
+	return oldValue.NotificationsPaused, nil
 }
 
-// ResetEnabled resets all changes to the "enabled" field.
-func (m *NotificationChannelMutation) ResetEnabled() {
-	m.enabled = nil
+// ResetNotificationsPaused resets all changes to the "notifications_paused" field.
+func (m *SystemConfigMutation) ResetNotificationsPaused() {
+	m.notifications_paused = nil
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *NotificationChannelMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// SetPausedAt sets the "paused_at" field.
+func (m *SystemConfigMutation) SetPausedAt(t time.Time) {
+	m.paused_at = &t
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *NotificationChannelMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
+// PausedAt returns the value of the "paused_at" field in the mutation.
+func (m *SystemConfigMutation) PausedAt() (r time.Time, exists bool) {
+	v := m.paused_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the NotificationChannel entity.
-// If the NotificationChannel object wasn't provided to the builder, the object is fetched from the database.
+// OldPausedAt returns the old "paused_at" field's value of the SystemConfig entity.
+// If the SystemConfig object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SystemConfigMutation) OldPausedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPausedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPausedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPausedAt: %w", err)
+	}
+	return oldValue.PausedAt, nil
+}
+
+// ClearPausedAt clears the value of the "paused_at" field.
+func (m *SystemConfigMutation) ClearPausedAt() {
+	m.paused_at = nil
+	m.clearedFields[systemconfig.FieldPausedAt] = struct{}{}
+}
+
+// PausedAtCleared returns if the "paused_at" field was cleared in this mutation.
+func (m *SystemConfigMutation) PausedAtCleared() bool {
+	_, ok := m.clearedFields[systemconfig.FieldPausedAt]
+	return ok
+}
+
+// ResetPausedAt resets all changes to the "paused_at" field.
+func (m *SystemConfigMutation) ResetPausedAt() {
+	m.paused_at = nil
+	delete(m.clearedFields, systemconfig.FieldPausedAt)
+}
+
+// SetStaleAfterDays sets the "stale_after_days" field.
+func (m *SystemConfigMutation) SetStaleAfterDays(i int) {
+	m.stale_after_days = &i
+	m.addstale_after_days = nil
+}
+
+// StaleAfterDays returns the value of the "stale_after_days" field in the mutation.
+func (m *SystemConfigMutation) StaleAfterDays() (r int, exists bool) {
+	v := m.stale_after_days
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStaleAfterDays returns the old "stale_after_days" field's value of the SystemConfig entity.
+// If the SystemConfig object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SystemConfigMutation) OldStaleAfterDays(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStaleAfterDays is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStaleAfterDays requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStaleAfterDays: %w", err)
+	}
+	return oldValue.StaleAfterDays, nil
+}
+
+// AddStaleAfterDays adds i to the "stale_after_days" field.
+func (m *SystemConfigMutation) AddStaleAfterDays(i int) {
+	if m.addstale_after_days != nil {
+		*m.addstale_after_days += i
+	} else {
+		m.addstale_after_days = &i
+	}
+}
+
+// AddedStaleAfterDays returns the value that was added to the "stale_after_days" field in this mutation.
+func (m *SystemConfigMutation) AddedStaleAfterDays() (r int, exists bool) {
+	v := m.addstale_after_days
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetStaleAfterDays resets all changes to the "stale_after_days" field.
+func (m *SystemConfigMutation) ResetStaleAfterDays() {
+	m.stale_after_days = nil
+	m.addstale_after_days = nil
+}
+
+// SetStaleNotifications sets the "stale_notifications" field.
+func (m *SystemConfigMutation) SetStaleNotifications(b bool) {
+	m.stale_notifications = &b
+}
+
+// StaleNotifications returns the value of the "stale_notifications" field in the mutation.
+func (m *SystemConfigMutation) StaleNotifications() (r bool, exists bool) {
+	v := m.stale_notifications
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStaleNotifications returns the old "stale_notifications" field's value of the SystemConfig entity.
+// If the SystemConfig object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SystemConfigMutation) OldStaleNotifications(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStaleNotifications is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStaleNotifications requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStaleNotifications: %w", err)
+	}
+	return oldValue.StaleNotifications, nil
+}
+
+// ResetStaleNotifications resets all changes to the "stale_notifications" field.
+func (m *SystemConfigMutation) ResetStaleNotifications() {
+	m.stale_notifications = nil
+}
+
+// SetStaleNotifiedAt sets the "stale_notified_at" field.
+func (m *SystemConfigMutation) SetStaleNotifiedAt(t time.Time) {
+	m.stale_notified_at = &t
+}
+
+// StaleNotifiedAt returns the value of the "stale_notified_at" field in the mutation.
+func (m *SystemConfigMutation) StaleNotifiedAt() (r time.Time, exists bool) {
+	v := m.stale_notified_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStaleNotifiedAt returns the old "stale_notified_at" field's value of the SystemConfig entity.
+// If the SystemConfig object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *NotificationChannelMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *SystemConfigMutation) OldStaleNotifiedAt(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldStaleNotifiedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+		return v, errors.New("OldStaleNotifiedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldStaleNotifiedAt: %w", err)
 	}
-	return oldValue.CreatedAt, nil
+	return oldValue.StaleNotifiedAt, nil
 }
 
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *NotificationChannelMutation) ResetCreatedAt() {
-	m.created_at = nil
+// ClearStaleNotifiedAt clears the value of the "stale_notified_at" field.
+func (m *SystemConfigMutation) ClearStaleNotifiedAt() {
+	m.stale_notified_at = nil
+	m.clearedFields[systemconfig.FieldStaleNotifiedAt] = struct{}{}
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (m *NotificationChannelMutation) SetUpdatedAt(t time.Time) {
-	m.updated_at = &t
+// StaleNotifiedAtCleared returns if the "stale_notified_at" field was cleared in this mutation.
+func (m *SystemConfigMutation) StaleNotifiedAtCleared() bool {
+	_, ok := m.clearedFields[systemconfig.FieldStaleNotifiedAt]
+	return ok
 }
 
-// UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *NotificationChannelMutation) UpdatedAt() (r time.Time, exists bool) {
-	v := m.updated_at
+// ResetStaleNotifiedAt resets all changes to the "stale_notified_at" field.
+func (m *SystemConfigMutation) ResetStaleNotifiedAt() {
+	m.stale_notified_at = nil
+	delete(m.clearedFields, systemconfig.FieldStaleNotifiedAt)
+}
+
+// SetScheduleJitterSeconds sets the "schedule_jitter_seconds" field.
+func (m *SystemConfigMutation) SetScheduleJitterSeconds(i int) {
+	m.schedule_jitter_seconds = &i
+	m.addschedule_jitter_seconds = nil
+}
+
+// ScheduleJitterSeconds returns the value of the "schedule_jitter_seconds" field in the mutation.
+func (m *SystemConfigMutation) ScheduleJitterSeconds() (r int, exists bool) {
+	v := m.schedule_jitter_seconds
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the NotificationChannel entity.
-// If the NotificationChannel object wasn't provided to the builder, the object is fetched from the database.
+// OldScheduleJitterSeconds returns the old "schedule_jitter_seconds" field's value of the SystemConfig entity.
+// If the SystemConfig object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *NotificationChannelMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *SystemConfigMutation) OldScheduleJitterSeconds(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldScheduleJitterSeconds is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+		return v, errors.New("OldScheduleJitterSeconds requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldScheduleJitterSeconds: %w", err)
 	}
-	return oldValue.UpdatedAt, nil
+	return oldValue.ScheduleJitterSeconds, nil
 }
 
-// ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *NotificationChannelMutation) ResetUpdatedAt() {
-	m.updated_at = nil
+// AddScheduleJitterSeconds adds i to the "schedule_jitter_seconds" field.
+func (m *SystemConfigMutation) AddScheduleJitterSeconds(i int) {
+	if m.addschedule_jitter_seconds != nil {
+		*m.addschedule_jitter_seconds += i
+	} else {
+		m.addschedule_jitter_seconds = &i
+	}
 }
 
-// AddNotificationEventIDs adds the "notification_events" edge to the NotificationEvent entity by ids.
-func (m *NotificationChannelMutation) AddNotificationEventIDs(ids ...int) {
-	if m.notification_events == nil {
-		m.notification_events = make(map[int]struct{})
-	}
-	for i := range ids {
-		m.notification_events[ids[i]] = struct{}{}
+// AddedScheduleJitterSeconds returns the value that was added to the "schedule_jitter_seconds" field in this mutation.
+func (m *SystemConfigMutation) AddedScheduleJitterSeconds() (r int, exists bool) {
+	v := m.addschedule_jitter_seconds
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// ClearNotificationEvents clears the "notification_events" edge to the NotificationEvent entity.
-func (m *NotificationChannelMutation) ClearNotificationEvents() {
-	m.clearednotification_events = true
+// ResetScheduleJitterSeconds resets all changes to the "schedule_jitter_seconds" field.
+func (m *SystemConfigMutation) ResetScheduleJitterSeconds() {
+	m.schedule_jitter_seconds = nil
+	m.addschedule_jitter_seconds = nil
 }
 
-// NotificationEventsCleared reports if the "notification_events" edge to the NotificationEvent entity was cleared.
-func (m *NotificationChannelMutation) NotificationEventsCleared() bool {
-	return m.clearednotification_events
+// SetCircuitBreakerThreshold sets the "circuit_breaker_threshold" field.
+func (m *SystemConfigMutation) SetCircuitBreakerThreshold(i int) {
+	m.circuit_breaker_threshold = &i
+	m.addcircuit_breaker_threshold = nil
 }
 
-// RemoveNotificationEventIDs removes the "notification_events" edge to the NotificationEvent entity by IDs.
-func (m *NotificationChannelMutation) RemoveNotificationEventIDs(ids ...int) {
-	if m.removednotification_events == nil {
-		m.removednotification_events = make(map[int]struct{})
+// CircuitBreakerThreshold returns the value of the "circuit_breaker_threshold" field in the mutation.
+func (m *SystemConfigMutation) CircuitBreakerThreshold() (r int, exists bool) {
+	v := m.circuit_breaker_threshold
+	if v == nil {
+		return
 	}
-	for i := range ids {
-		delete(m.notification_events, ids[i])
-		m.removednotification_events[ids[i]] = struct{}{}
+	return *v, true
+}
+
+// OldCircuitBreakerThreshold returns the old "circuit_breaker_threshold" field's value of the SystemConfig entity.
+// If the SystemConfig object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SystemConfigMutation) OldCircuitBreakerThreshold(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCircuitBreakerThreshold is only allowed on UpdateOne operations")
 	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCircuitBreakerThreshold requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCircuitBreakerThreshold: %w", err)
+	}
+	return oldValue.CircuitBreakerThreshold, nil
 }
 
-// RemovedNotificationEvents returns the removed IDs of the "notification_events" edge to the NotificationEvent entity.
-func (m *NotificationChannelMutation) RemovedNotificationEventsIDs() (ids []int) {
-	for id := range m.removednotification_events {
-		ids = append(ids, id)
+// AddCircuitBreakerThreshold adds i to the "circuit_breaker_threshold" field.
+func (m *SystemConfigMutation) AddCircuitBreakerThreshold(i int) {
+	if m.addcircuit_breaker_threshold != nil {
+		*m.addcircuit_breaker_threshold += i
+	} else {
+		m.addcircuit_breaker_threshold = &i
 	}
-	return
 }
 
-// NotificationEventsIDs returns the "notification_events" edge IDs in the mutation.
-func (m *NotificationChannelMutation) NotificationEventsIDs() (ids []int) {
-	for id := range m.notification_events {
-		ids = append(ids, id)
+// AddedCircuitBreakerThreshold returns the value that was added to the "circuit_breaker_threshold" field in this mutation.
+func (m *SystemConfigMutation) AddedCircuitBreakerThreshold() (r int, exists bool) {
+	v := m.addcircuit_breaker_threshold
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// ResetNotificationEvents resets all changes to the "notification_events" edge.
-func (m *NotificationChannelMutation) ResetNotificationEvents() {
-	m.notification_events = nil
-	m.clearednotification_events = false
-	m.removednotification_events = nil
+// ResetCircuitBreakerThreshold resets all changes to the "circuit_breaker_threshold" field.
+func (m *SystemConfigMutation) ResetCircuitBreakerThreshold() {
+	m.circuit_breaker_threshold = nil
+	m.addcircuit_breaker_threshold = nil
 }
 
-// Where appends a list predicates to the NotificationChannelMutation builder.
-func (m *NotificationChannelMutation) Where(ps ...predicate.NotificationChannel) {
-	m.predicates = append(m.predicates, ps...)
+// SetCircuitBreakerAction sets the "circuit_breaker_action" field.
+func (m *SystemConfigMutation) SetCircuitBreakerAction(sba systemconfig.CircuitBreakerAction) {
+	m.circuit_breaker_action = &sba
 }
 
-// WhereP appends storage-level predicates to the NotificationChannelMutation builder. Using this method,
-// users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *NotificationChannelMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.NotificationChannel, len(ps))
-	for i := range ps {
-		p[i] = ps[i]
+// CircuitBreakerAction returns the value of the "circuit_breaker_action" field in the mutation.
+func (m *SystemConfigMutation) CircuitBreakerAction() (r systemconfig.CircuitBreakerAction, exists bool) {
+	v := m.circuit_breaker_action
+	if v == nil {
+		return
 	}
-	m.Where(p...)
+	return *v, true
 }
 
-// Op returns the operation name.
-func (m *NotificationChannelMutation) Op() Op {
-	return m.op
+// OldCircuitBreakerAction returns the old "circuit_breaker_action" field's value of the SystemConfig entity.
+// If the SystemConfig object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SystemConfigMutation) OldCircuitBreakerAction(ctx context.Context) (v systemconfig.CircuitBreakerAction, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCircuitBreakerAction is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCircuitBreakerAction requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCircuitBreakerAction: %w", err)
+	}
+	return oldValue.CircuitBreakerAction, nil
 }
 
-// SetOp allows setting the mutation operation.
-func (m *NotificationChannelMutation) SetOp(op Op) {
-	m.op = op
+// ResetCircuitBreakerAction resets all changes to the "circuit_breaker_action" field.
+func (m *SystemConfigMutation) ResetCircuitBreakerAction() {
+	m.circuit_breaker_action = nil
 }
 
-// Type returns the node type of this mutation (NotificationChannel).
-func (m *NotificationChannelMutation) Type() string {
-	return m.typ
+// SetCircuitBreakerBackoffMinutes sets the "circuit_breaker_backoff_minutes" field.
+func (m *SystemConfigMutation) SetCircuitBreakerBackoffMinutes(i int) {
+	m.circuit_breaker_backoff_minutes = &i
+	m.addcircuit_breaker_backoff_minutes = nil
 }
 
-// Fields returns all fields that were changed during this mutation. Note that in
-// order to get all numeric fields that were incremented/decremented, call
-// AddedFields().
-func (m *NotificationChannelMutation) Fields() []string {
-	fields := make([]string, 0, 7)
-	if m.name != nil {
-		fields = append(fields, notificationchannel.FieldName)
-	}
-	if m.kind != nil {
-		fields = append(fields, notificationchannel.FieldKind)
-	}
-	if m.bot_token != nil {
-		fields = append(fields, notificationchannel.FieldBotToken)
-	}
-	if m.chat_id != nil {
-		fields = append(fields, notificationchannel.FieldChatID)
+// CircuitBreakerBackoffMinutes returns the value of the "circuit_breaker_backoff_minutes" field in the mutation.
+func (m *SystemConfigMutation) CircuitBreakerBackoffMinutes() (r int, exists bool) {
+	v := m.circuit_breaker_backoff_minutes
+	if v == nil {
+		return
 	}
-	if m.enabled != nil {
-		fields = append(fields, notificationchannel.FieldEnabled)
+	return *v, true
+}
+
+// OldCircuitBreakerBackoffMinutes returns the old "circuit_breaker_backoff_minutes" field's value of the SystemConfig entity.
+// If the SystemConfig object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SystemConfigMutation) OldCircuitBreakerBackoffMinutes(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCircuitBreakerBackoffMinutes is only allowed on UpdateOne operations")
 	}
-	if m.created_at != nil {
-		fields = append(fields, notificationchannel.FieldCreatedAt)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCircuitBreakerBackoffMinutes requires an ID field in the mutation")
 	}
-	if m.updated_at != nil {
-		fields = append(fields, notificationchannel.FieldUpdatedAt)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCircuitBreakerBackoffMinutes: %w", err)
 	}
-	return fields
+	return oldValue.CircuitBreakerBackoffMinutes, nil
 }
 
-// Field returns the value of a field with the given name. The second boolean
-// return value indicates that this field was not set, or was not defined in the
-// schema.
-func (m *NotificationChannelMutation) Field(name string) (ent.Value, bool) {
-	switch name {
-	case notificationchannel.FieldName:
-		return m.Name()
-	case notificationchannel.FieldKind:
-		return m.Kind()
-	case notificationchannel.FieldBotToken:
-		return m.BotToken()
-	case notificationchannel.FieldChatID:
-		return m.ChatID()
-	case notificationchannel.FieldEnabled:
-		return m.Enabled()
-	case notificationchannel.FieldCreatedAt:
-		return m.CreatedAt()
-	case notificationchannel.FieldUpdatedAt:
-		return m.UpdatedAt()
+// AddCircuitBreakerBackoffMinutes adds i to the "circuit_breaker_backoff_minutes" field.
+func (m *SystemConfigMutation) AddCircuitBreakerBackoffMinutes(i int) {
+	if m.addcircuit_breaker_backoff_minutes != nil {
+		*m.addcircuit_breaker_backoff_minutes += i
+	} else {
+		m.addcircuit_breaker_backoff_minutes = &i
 	}
-	return nil, false
 }
 
-// OldField returns the old value of the field from the database. An error is
-// returned if the mutation operation is not UpdateOne, or the query to the
-// database failed.
-func (m *NotificationChannelMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
-	switch name {
-	case notificationchannel.FieldName:
-		return m.OldName(ctx)
-	case notificationchannel.FieldKind:
-		return m.OldKind(ctx)
-	case notificationchannel.FieldBotToken:
-		return m.OldBotToken(ctx)
-	case notificationchannel.FieldChatID:
-		return m.OldChatID(ctx)
-	case notificationchannel.FieldEnabled:
-		return m.OldEnabled(ctx)
-	case notificationchannel.FieldCreatedAt:
-		return m.OldCreatedAt(ctx)
-	case notificationchannel.FieldUpdatedAt:
-		return m.OldUpdatedAt(ctx)
+// AddedCircuitBreakerBackoffMinutes returns the value that was added to the "circuit_breaker_backoff_minutes" field in this mutation.
+func (m *SystemConfigMutation) AddedCircuitBreakerBackoffMinutes() (r int, exists bool) {
+	v := m.addcircuit_breaker_backoff_minutes
+	if v == nil {
+		return
 	}
-	return nil, fmt.Errorf("unknown NotificationChannel field %s", name)
+	return *v, true
 }
 
-// SetField sets the value of a field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *NotificationChannelMutation) SetField(name string, value ent.Value) error {
-	switch name {
-	case notificationchannel.FieldName:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetName(v)
-		return nil
-	case notificationchannel.FieldKind:
-		v, ok := value.(notificationchannel.Kind)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetKind(v)
-		return nil
-	case notificationchannel.FieldBotToken:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetBotToken(v)
-		return nil
-	case notificationchannel.FieldChatID:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetChatID(v)
-		return nil
-	case notificationchannel.FieldEnabled:
-		v, ok := value.(bool)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetEnabled(v)
-		return nil
-	case notificationchannel.FieldCreatedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCreatedAt(v)
-		return nil
-	case notificationchannel.FieldUpdatedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetUpdatedAt(v)
-		return nil
+// ResetCircuitBreakerBackoffMinutes resets all changes to the "circuit_breaker_backoff_minutes" field.
+func (m *SystemConfigMutation) ResetCircuitBreakerBackoffMinutes() {
+	m.circuit_breaker_backoff_minutes = nil
+	m.addcircuit_breaker_backoff_minutes = nil
+}
+
+// SetCatchUpPolicy sets the "catch_up_policy" field.
+func (m *SystemConfigMutation) SetCatchUpPolicy(sup systemconfig.CatchUpPolicy) {
+	m.catch_up_policy = &sup
+}
+
+// CatchUpPolicy returns the value of the "catch_up_policy" field in the mutation.
+func (m *SystemConfigMutation) CatchUpPolicy() (r systemconfig.CatchUpPolicy, exists bool) {
+	v := m.catch_up_policy
+	if v == nil {
+		return
 	}
-	return fmt.Errorf("unknown NotificationChannel field %s", name)
+	return *v, true
 }
 
-// AddedFields returns all numeric fields that were incremented/decremented during
-// this mutation.
-func (m *NotificationChannelMutation) AddedFields() []string {
-	return nil
+// OldCatchUpPolicy returns the old "catch_up_policy" field's value of the SystemConfig entity.
+// If the SystemConfig object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SystemConfigMutation) OldCatchUpPolicy(ctx context.Context) (v systemconfig.CatchUpPolicy, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCatchUpPolicy is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCatchUpPolicy requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCatchUpPolicy: %w", err)
+	}
+	return oldValue.CatchUpPolicy, nil
 }
 
-// AddedField returns the numeric value that was incremented/decremented on a field
-// with the given name. The second boolean return value indicates that this field
-// was not set, or was not defined in the schema.
-func (m *NotificationChannelMutation) AddedField(name string) (ent.Value, bool) {
-	return nil, false
+// ResetCatchUpPolicy resets all changes to the "catch_up_policy" field.
+func (m *SystemConfigMutation) ResetCatchUpPolicy() {
+	m.catch_up_policy = nil
 }
 
-// AddField adds the value to the field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *NotificationChannelMutation) AddField(name string, value ent.Value) error {
-	switch name {
-	}
-	return fmt.Errorf("unknown NotificationChannel numeric field %s", name)
+// SetCatchUpMaxAgeMinutes sets the "catch_up_max_age_minutes" field.
+func (m *SystemConfigMutation) SetCatchUpMaxAgeMinutes(i int) {
+	m.catch_up_max_age_minutes = &i
+	m.addcatch_up_max_age_minutes = nil
 }
 
-// ClearedFields returns all nullable fields that were cleared during this
-// mutation.
-func (m *NotificationChannelMutation) ClearedFields() []string {
-	return nil
+// CatchUpMaxAgeMinutes returns the value of the "catch_up_max_age_minutes" field in the mutation.
+func (m *SystemConfigMutation) CatchUpMaxAgeMinutes() (r int, exists bool) {
+	v := m.catch_up_max_age_minutes
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// FieldCleared returns a boolean indicating if a field with the given name was
-// cleared in this mutation.
-func (m *NotificationChannelMutation) FieldCleared(name string) bool {
-	_, ok := m.clearedFields[name]
-	return ok
+// OldCatchUpMaxAgeMinutes returns the old "catch_up_max_age_minutes" field's value of the SystemConfig entity.
+// If the SystemConfig object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SystemConfigMutation) OldCatchUpMaxAgeMinutes(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCatchUpMaxAgeMinutes is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCatchUpMaxAgeMinutes requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCatchUpMaxAgeMinutes: %w", err)
+	}
+	return oldValue.CatchUpMaxAgeMinutes, nil
 }
 
-// ClearField clears the value of the field with the given name. It returns an
-// error if the field is not defined in the schema.
-func (m *NotificationChannelMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown NotificationChannel nullable field %s", name)
+// AddCatchUpMaxAgeMinutes adds i to the "catch_up_max_age_minutes" field.
+func (m *SystemConfigMutation) AddCatchUpMaxAgeMinutes(i int) {
+	if m.addcatch_up_max_age_minutes != nil {
+		*m.addcatch_up_max_age_minutes += i
+	} else {
+		m.addcatch_up_max_age_minutes = &i
+	}
 }
 
-// ResetField resets all changes in the mutation for the field with the given name.
-// It returns an error if the field is not defined in the schema.
-func (m *NotificationChannelMutation) ResetField(name string) error {
-	switch name {
-	case notificationchannel.FieldName:
-		m.ResetName()
-		return nil
-	case notificationchannel.FieldKind:
-		m.ResetKind()
-		return nil
-	case notificationchannel.FieldBotToken:
-		m.ResetBotToken()
-		return nil
-	case notificationchannel.FieldChatID:
-		m.ResetChatID()
-		return nil
-	case notificationchannel.FieldEnabled:
-		m.ResetEnabled()
-		return nil
-	case notificationchannel.FieldCreatedAt:
-		m.ResetCreatedAt()
-		return nil
-	case notificationchannel.FieldUpdatedAt:
-		m.ResetUpdatedAt()
-		return nil
+// AddedCatchUpMaxAgeMinutes returns the value that was added to the "catch_up_max_age_minutes" field in this mutation.
+func (m *SystemConfigMutation) AddedCatchUpMaxAgeMinutes() (r int, exists bool) {
+	v := m.addcatch_up_max_age_minutes
+	if v == nil {
+		return
 	}
-	return fmt.Errorf("unknown NotificationChannel field %s", name)
+	return *v, true
 }
 
-// AddedEdges returns all edge names that were set/added in this mutation.
-func (m *NotificationChannelMutation) AddedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.notification_events != nil {
-		edges = append(edges, notificationchannel.EdgeNotificationEvents)
+// ResetCatchUpMaxAgeMinutes resets all changes to the "catch_up_max_age_minutes" field.
+func (m *SystemConfigMutation) ResetCatchUpMaxAgeMinutes() {
+	m.catch_up_max_age_minutes = nil
+	m.addcatch_up_max_age_minutes = nil
+}
+
+// SetDefaultUserAgent sets the "default_user_agent" field.
+func (m *SystemConfigMutation) SetDefaultUserAgent(s string) {
+	m.default_user_agent = &s
+}
+
+// DefaultUserAgent returns the value of the "default_user_agent" field in the mutation.
+func (m *SystemConfigMutation) DefaultUserAgent() (r string, exists bool) {
+	v := m.default_user_agent
+	if v == nil {
+		return
 	}
-	return edges
+	return *v, true
 }
 
-// AddedIDs returns all IDs (to other nodes) that were added for the given edge
-// name in this mutation.
-func (m *NotificationChannelMutation) AddedIDs(name string) []ent.Value {
-	switch name {
-	case notificationchannel.EdgeNotificationEvents:
-		ids := make([]ent.Value, 0, len(m.notification_events))
-		for id := range m.notification_events {
-			ids = append(ids, id)
-		}
-		return ids
+// OldDefaultUserAgent returns the old "default_user_agent" field's value of the SystemConfig entity.
+// If the SystemConfig object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SystemConfigMutation) OldDefaultUserAgent(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDefaultUserAgent is only allowed on UpdateOne operations")
 	}
-	return nil
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDefaultUserAgent requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDefaultUserAgent: %w", err)
+	}
+	return oldValue.DefaultUserAgent, nil
 }
 
-// RemovedEdges returns all edge names that were removed in this mutation.
-func (m *NotificationChannelMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.removednotification_events != nil {
-		edges = append(edges, notificationchannel.EdgeNotificationEvents)
+// ClearDefaultUserAgent clears the value of the "default_user_agent" field.
+func (m *SystemConfigMutation) ClearDefaultUserAgent() {
+	m.default_user_agent = nil
+	m.clearedFields[systemconfig.FieldDefaultUserAgent] = struct{}{}
+}
+
+// DefaultUserAgentCleared returns if the "default_user_agent" field was cleared in this mutation.
+func (m *SystemConfigMutation) DefaultUserAgentCleared() bool {
+	_, ok := m.clearedFields[systemconfig.FieldDefaultUserAgent]
+	return ok
+}
+
+// ResetDefaultUserAgent resets all changes to the "default_user_agent" field.
+func (m *SystemConfigMutation) ResetDefaultUserAgent() {
+	m.default_user_agent = nil
+	delete(m.clearedFields, systemconfig.FieldDefaultUserAgent)
+}
+
+// SetDefaultHeaders sets the "default_headers" field.
+func (m *SystemConfigMutation) SetDefaultHeaders(value map[string]string) {
+	m.default_headers = &value
+}
+
+// DefaultHeaders returns the value of the "default_headers" field in the mutation.
+func (m *SystemConfigMutation) DefaultHeaders() (r map[string]string, exists bool) {
+	v := m.default_headers
+	if v == nil {
+		return
 	}
-	return edges
+	return *v, true
 }
 
-// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
-// the given name in this mutation.
-func (m *NotificationChannelMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case notificationchannel.EdgeNotificationEvents:
-		ids := make([]ent.Value, 0, len(m.removednotification_events))
-		for id := range m.removednotification_events {
-			ids = append(ids, id)
-		}
-		return ids
+// OldDefaultHeaders returns the old "default_headers" field's value of the SystemConfig entity.
+// If the SystemConfig object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SystemConfigMutation) OldDefaultHeaders(ctx context.Context) (v map[string]string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDefaultHeaders is only allowed on UpdateOne operations")
 	}
-	return nil
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDefaultHeaders requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDefaultHeaders: %w", err)
+	}
+	return oldValue.DefaultHeaders, nil
 }
 
-// ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *NotificationChannelMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.clearednotification_events {
-		edges = append(edges, notificationchannel.EdgeNotificationEvents)
+// ClearDefaultHeaders clears the value of the "default_headers" field.
+func (m *SystemConfigMutation) ClearDefaultHeaders() {
+	m.default_headers = nil
+	m.clearedFields[systemconfig.FieldDefaultHeaders] = struct{}{}
+}
+
+// DefaultHeadersCleared returns if the "default_headers" field was cleared in this mutation.
+func (m *SystemConfigMutation) DefaultHeadersCleared() bool {
+	_, ok := m.clearedFields[systemconfig.FieldDefaultHeaders]
+	return ok
+}
+
+// ResetDefaultHeaders resets all changes to the "default_headers" field.
+func (m *SystemConfigMutation) ResetDefaultHeaders() {
+	m.default_headers = nil
+	delete(m.clearedFields, systemconfig.FieldDefaultHeaders)
+}
+
+// SetProxyURL sets the "proxy_url" field.
+func (m *SystemConfigMutation) SetProxyURL(s string) {
+	m.proxy_url = &s
+}
+
+// ProxyURL returns the value of the "proxy_url" field in the mutation.
+func (m *SystemConfigMutation) ProxyURL() (r string, exists bool) {
+	v := m.proxy_url
+	if v == nil {
+		return
 	}
-	return edges
+	return *v, true
 }
 
-// EdgeCleared returns a boolean which indicates if the edge with the given name
-// was cleared in this mutation.
-func (m *NotificationChannelMutation) EdgeCleared(name string) bool {
-	switch name {
-	case notificationchannel.EdgeNotificationEvents:
-		return m.clearednotification_events
+// OldProxyURL returns the old "proxy_url" field's value of the SystemConfig entity.
+// If the SystemConfig object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SystemConfigMutation) OldProxyURL(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldProxyURL is only allowed on UpdateOne operations")
 	}
-	return false
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldProxyURL requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldProxyURL: %w", err)
+	}
+	return oldValue.ProxyURL, nil
 }
 
-// ClearEdge clears the value of the edge with the given name. It returns an error
-// if that edge is not defined in the schema.
-func (m *NotificationChannelMutation) ClearEdge(name string) error {
-	switch name {
+// ClearProxyURL clears the value of the "proxy_url" field.
+func (m *SystemConfigMutation) ClearProxyURL() {
+	m.proxy_url = nil
+	m.clearedFields[systemconfig.FieldProxyURL] = struct{}{}
+}
+
+// ProxyURLCleared returns if the "proxy_url" field was cleared in this mutation.
+func (m *SystemConfigMutation) ProxyURLCleared() bool {
+	_, ok := m.clearedFields[systemconfig.FieldProxyURL]
+	return ok
+}
+
+// ResetProxyURL resets all changes to the "proxy_url" field.
+func (m *SystemConfigMutation) ResetProxyURL() {
+	m.proxy_url = nil
+	delete(m.clearedFields, systemconfig.FieldProxyURL)
+}
+
+// SetProxyBypass sets the "proxy_bypass" field.
+func (m *SystemConfigMutation) SetProxyBypass(s string) {
+	m.proxy_bypass = &s
+}
+
+// ProxyBypass returns the value of the "proxy_bypass" field in the mutation.
+func (m *SystemConfigMutation) ProxyBypass() (r string, exists bool) {
+	v := m.proxy_bypass
+	if v == nil {
+		return
 	}
-	return fmt.Errorf("unknown NotificationChannel unique edge %s", name)
+	return *v, true
 }
 
-// ResetEdge resets all changes to the edge with the given name in this mutation.
-// It returns an error if the edge is not defined in the schema.
-func (m *NotificationChannelMutation) ResetEdge(name string) error {
-	switch name {
-	case notificationchannel.EdgeNotificationEvents:
-		m.ResetNotificationEvents()
-		return nil
+// OldProxyBypass returns the old "proxy_bypass" field's value of the SystemConfig entity.
+// If the SystemConfig object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SystemConfigMutation) OldProxyBypass(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldProxyBypass is only allowed on UpdateOne operations")
 	}
-	return fmt.Errorf("unknown NotificationChannel edge %s", name)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldProxyBypass requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldProxyBypass: %w", err)
+	}
+	return oldValue.ProxyBypass, nil
 }
 
-// NotificationEventMutation represents an operation that mutates the NotificationEvent nodes in the graph.
-type NotificationEventMutation struct {
-	config
-	op             Op
-	typ            string
-	id             *int
-	status         *string
-	message        *string
-	sent_at        *time.Time
-	clearedFields  map[string]struct{}
-	monitor        *int
-	clearedmonitor bool
-	channel        *int
-	clearedchannel bool
-	done           bool
-	oldValue       func(context.Context) (*NotificationEvent, error)
-	predicates     []predicate.NotificationEvent
+// ClearProxyBypass clears the value of the "proxy_bypass" field.
+func (m *SystemConfigMutation) ClearProxyBypass() {
+	m.proxy_bypass = nil
+	m.clearedFields[systemconfig.FieldProxyBypass] = struct{}{}
 }
 
-var _ ent.Mutation = (*NotificationEventMutation)(nil)
+// ProxyBypassCleared returns if the "proxy_bypass" field was cleared in this mutation.
+func (m *SystemConfigMutation) ProxyBypassCleared() bool {
+	_, ok := m.clearedFields[systemconfig.FieldProxyBypass]
+	return ok
+}
 
-// notificationeventOption allows management of the mutation configuration using functional options.
-type notificationeventOption func(*NotificationEventMutation)
+// ResetProxyBypass resets all changes to the "proxy_bypass" field.
+func (m *SystemConfigMutation) ResetProxyBypass() {
+	m.proxy_bypass = nil
+	delete(m.clearedFields, systemconfig.FieldProxyBypass)
+}
 
-// newNotificationEventMutation creates new mutation for the NotificationEvent entity.
-func newNotificationEventMutation(c config, op Op, opts ...notificationeventOption) *NotificationEventMutation {
-	m := &NotificationEventMutation{
-		config:        c,
-		op:            op,
-		typ:           TypeNotificationEvent,
-		clearedFields: make(map[string]struct{}),
+// SetRequestTimeoutSeconds sets the "request_timeout_seconds" field.
+func (m *SystemConfigMutation) SetRequestTimeoutSeconds(i int) {
+	m.request_timeout_seconds = &i
+	m.addrequest_timeout_seconds = nil
+}
+
+// RequestTimeoutSeconds returns the value of the "request_timeout_seconds" field in the mutation.
+func (m *SystemConfigMutation) RequestTimeoutSeconds() (r int, exists bool) {
+	v := m.request_timeout_seconds
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRequestTimeoutSeconds returns the old "request_timeout_seconds" field's value of the SystemConfig entity.
+// If the SystemConfig object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SystemConfigMutation) OldRequestTimeoutSeconds(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRequestTimeoutSeconds is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRequestTimeoutSeconds requires an ID field in the mutation")
 	}
-	for _, opt := range opts {
-		opt(m)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRequestTimeoutSeconds: %w", err)
 	}
-	return m
+	return oldValue.RequestTimeoutSeconds, nil
 }
 
-// withNotificationEventID sets the ID field of the mutation.
-func withNotificationEventID(id int) notificationeventOption {
-	return func(m *NotificationEventMutation) {
-		var (
-			err   error
-			once  sync.Once
-			value *NotificationEvent
-		)
-		m.oldValue = func(ctx context.Context) (*NotificationEvent, error) {
-			once.Do(func() {
-				if m.done {
-					err = errors.New("querying old values post mutation is not allowed")
-				} else {
-					value, err = m.Client().NotificationEvent.Get(ctx, id)
-				}
-			})
-			return value, err
-		}
-		m.id = &id
+// AddRequestTimeoutSeconds adds i to the "request_timeout_seconds" field.
+func (m *SystemConfigMutation) AddRequestTimeoutSeconds(i int) {
+	if m.addrequest_timeout_seconds != nil {
+		*m.addrequest_timeout_seconds += i
+	} else {
+		m.addrequest_timeout_seconds = &i
 	}
 }
 
-// withNotificationEvent sets the old NotificationEvent of the mutation.
-func withNotificationEvent(node *NotificationEvent) notificationeventOption {
-	return func(m *NotificationEventMutation) {
-		m.oldValue = func(context.Context) (*NotificationEvent, error) {
-			return node, nil
-		}
-		m.id = &node.ID
+// AddedRequestTimeoutSeconds returns the value that was added to the "request_timeout_seconds" field in this mutation.
+func (m *SystemConfigMutation) AddedRequestTimeoutSeconds() (r int, exists bool) {
+	v := m.addrequest_timeout_seconds
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// Client returns a new `ent.Client` from the mutation. If the mutation was
-// executed in a transaction (ent.Tx), a transactional client is returned.
-func (m NotificationEventMutation) Client() *Client {
-	client := &Client{config: m.config}
-	client.init()
-	return client
+// ResetRequestTimeoutSeconds resets all changes to the "request_timeout_seconds" field.
+func (m *SystemConfigMutation) ResetRequestTimeoutSeconds() {
+	m.request_timeout_seconds = nil
+	m.addrequest_timeout_seconds = nil
 }
 
-// Tx returns an `ent.Tx` for mutations that were executed in transactions;
-// it returns an error otherwise.
-func (m NotificationEventMutation) Tx() (*Tx, error) {
-	if _, ok := m.driver.(*txDriver); !ok {
-		return nil, errors.New("ent: mutation is not running in a transaction")
-	}
-	tx := &Tx{config: m.config}
-	tx.init()
-	return tx, nil
+// SetMaxRetries sets the "max_retries" field.
+func (m *SystemConfigMutation) SetMaxRetries(i int) {
+	m.max_retries = &i
+	m.addmax_retries = nil
 }
 
-// ID returns the ID value in the mutation. Note that the ID is only available
-// if it was provided to the builder or after it was returned from the database.
-func (m *NotificationEventMutation) ID() (id int, exists bool) {
-	if m.id == nil {
+// MaxRetries returns the value of the "max_retries" field in the mutation.
+func (m *SystemConfigMutation) MaxRetries() (r int, exists bool) {
+	v := m.max_retries
+	if v == nil {
 		return
 	}
-	return *m.id, true
+	return *v, true
 }
 
-// IDs queries the database and returns the entity ids that match the mutation's predicate.
-// That means, if the mutation is applied within a transaction with an isolation level such
-// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
-// or updated by the mutation.
-func (m *NotificationEventMutation) IDs(ctx context.Context) ([]int, error) {
-	switch {
-	case m.op.Is(OpUpdateOne | OpDeleteOne):
-		id, exists := m.ID()
-		if exists {
-			return []int{id}, nil
-		}
-		fallthrough
-	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().NotificationEvent.Query().Where(m.predicates...).IDs(ctx)
-	default:
-		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+// OldMaxRetries returns the old "max_retries" field's value of the SystemConfig entity.
+// If the SystemConfig object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SystemConfigMutation) OldMaxRetries(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMaxRetries is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMaxRetries requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMaxRetries: %w", err)
 	}
+	return oldValue.MaxRetries, nil
 }
 
-// SetStatus sets the "status" field.
-func (m *NotificationEventMutation) SetStatus(s string) {
-	m.status = &s
+// AddMaxRetries adds i to the "max_retries" field.
+func (m *SystemConfigMutation) AddMaxRetries(i int) {
+	if m.addmax_retries != nil {
+		*m.addmax_retries += i
+	} else {
+		m.addmax_retries = &i
+	}
 }
 
-// Status returns the value of the "status" field in the mutation.
-func (m *NotificationEventMutation) Status() (r string, exists bool) {
-	v := m.status
+// AddedMaxRetries returns the value that was added to the "max_retries" field in this mutation.
+func (m *SystemConfigMutation) AddedMaxRetries() (r int, exists bool) {
+	v := m.addmax_retries
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldStatus returns the old "status" field's value of the NotificationEvent entity.
-// If the NotificationEvent object wasn't provided to the builder, the object is fetched from the database.
+// ResetMaxRetries resets all changes to the "max_retries" field.
+func (m *SystemConfigMutation) ResetMaxRetries() {
+	m.max_retries = nil
+	m.addmax_retries = nil
+}
+
+// SetRetryBackoffSeconds sets the "retry_backoff_seconds" field.
+func (m *SystemConfigMutation) SetRetryBackoffSeconds(i int) {
+	m.retry_backoff_seconds = &i
+	m.addretry_backoff_seconds = nil
+}
+
+// RetryBackoffSeconds returns the value of the "retry_backoff_seconds" field in the mutation.
+func (m *SystemConfigMutation) RetryBackoffSeconds() (r int, exists bool) {
+	v := m.retry_backoff_seconds
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRetryBackoffSeconds returns the old "retry_backoff_seconds" field's value of the SystemConfig entity.
+// If the SystemConfig object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *NotificationEventMutation) OldStatus(ctx context.Context) (v string, err error) {
+func (m *SystemConfigMutation) OldRetryBackoffSeconds(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+		return v, errors.New("OldRetryBackoffSeconds is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldStatus requires an ID field in the mutation")
+		return v, errors.New("OldRetryBackoffSeconds requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+		return v, fmt.Errorf("querying old value for OldRetryBackoffSeconds: %w", err)
 	}
-	return oldValue.Status, nil
+	return oldValue.RetryBackoffSeconds, nil
 }
 
-// ResetStatus resets all changes to the "status" field.
-func (m *NotificationEventMutation) ResetStatus() {
-	m.status = nil
+// AddRetryBackoffSeconds adds i to the "retry_backoff_seconds" field.
+func (m *SystemConfigMutation) AddRetryBackoffSeconds(i int) {
+	if m.addretry_backoff_seconds != nil {
+		*m.addretry_backoff_seconds += i
+	} else {
+		m.addretry_backoff_seconds = &i
+	}
 }
 
-// SetMessage sets the "message" field.
-func (m *NotificationEventMutation) SetMessage(s string) {
-	m.message = &s
+// AddedRetryBackoffSeconds returns the value that was added to the "retry_backoff_seconds" field in this mutation.
+func (m *SystemConfigMutation) AddedRetryBackoffSeconds() (r int, exists bool) {
+	v := m.addretry_backoff_seconds
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// Message returns the value of the "message" field in the mutation.
-func (m *NotificationEventMutation) Message() (r string, exists bool) {
-	v := m.message
+// ResetRetryBackoffSeconds resets all changes to the "retry_backoff_seconds" field.
+func (m *SystemConfigMutation) ResetRetryBackoffSeconds() {
+	m.retry_backoff_seconds = nil
+	m.addretry_backoff_seconds = nil
+}
+
+// SetTickIntervalSeconds sets the "tick_interval_seconds" field.
+func (m *SystemConfigMutation) SetTickIntervalSeconds(i int) {
+	m.tick_interval_seconds = &i
+	m.addtick_interval_seconds = nil
+}
+
+// TickIntervalSeconds returns the value of the "tick_interval_seconds" field in the mutation.
+func (m *SystemConfigMutation) TickIntervalSeconds() (r int, exists bool) {
+	v := m.tick_interval_seconds
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldMessage returns the old "message" field's value of the NotificationEvent entity.
-// If the NotificationEvent object wasn't provided to the builder, the object is fetched from the database.
+// OldTickIntervalSeconds returns the old "tick_interval_seconds" field's value of the SystemConfig entity.
+// If the SystemConfig object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *NotificationEventMutation) OldMessage(ctx context.Context) (v *string, err error) {
+func (m *SystemConfigMutation) OldTickIntervalSeconds(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldMessage is only allowed on UpdateOne operations")
+		return v, errors.New("OldTickIntervalSeconds is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldMessage requires an ID field in the mutation")
+		return v, errors.New("OldTickIntervalSeconds requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldMessage: %w", err)
+		return v, fmt.Errorf("querying old value for OldTickIntervalSeconds: %w", err)
 	}
-	return oldValue.Message, nil
+	return oldValue.TickIntervalSeconds, nil
 }
 
-// ClearMessage clears the value of the "message" field.
-func (m *NotificationEventMutation) ClearMessage() {
-	m.message = nil
-	m.clearedFields[notificationevent.FieldMessage] = struct{}{}
+// AddTickIntervalSeconds adds i to the "tick_interval_seconds" field.
+func (m *SystemConfigMutation) AddTickIntervalSeconds(i int) {
+	if m.addtick_interval_seconds != nil {
+		*m.addtick_interval_seconds += i
+	} else {
+		m.addtick_interval_seconds = &i
+	}
 }
 
-// MessageCleared returns if the "message" field was cleared in this mutation.
-func (m *NotificationEventMutation) MessageCleared() bool {
-	_, ok := m.clearedFields[notificationevent.FieldMessage]
-	return ok
+// AddedTickIntervalSeconds returns the value that was added to the "tick_interval_seconds" field in this mutation.
+func (m *SystemConfigMutation) AddedTickIntervalSeconds() (r int, exists bool) {
+	v := m.addtick_interval_seconds
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// ResetMessage resets all changes to the "message" field.
-func (m *NotificationEventMutation) ResetMessage() {
-	m.message = nil
-	delete(m.clearedFields, notificationevent.FieldMessage)
+// ResetTickIntervalSeconds resets all changes to the "tick_interval_seconds" field.
+func (m *SystemConfigMutation) ResetTickIntervalSeconds() {
+	m.tick_interval_seconds = nil
+	m.addtick_interval_seconds = nil
 }
 
-// SetSentAt sets the "sent_at" field.
-func (m *NotificationEventMutation) SetSentAt(t time.Time) {
-	m.sent_at = &t
+// SetScheduleLookaheadSeconds sets the "schedule_lookahead_seconds" field.
+func (m *SystemConfigMutation) SetScheduleLookaheadSeconds(i int) {
+	m.schedule_lookahead_seconds = &i
+	m.addschedule_lookahead_seconds = nil
 }
 
-// SentAt returns the value of the "sent_at" field in the mutation.
-func (m *NotificationEventMutation) SentAt() (r time.Time, exists bool) {
-	v := m.sent_at
+// ScheduleLookaheadSeconds returns the value of the "schedule_lookahead_seconds" field in the mutation.
+func (m *SystemConfigMutation) ScheduleLookaheadSeconds() (r int, exists bool) {
+	v := m.schedule_lookahead_seconds
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldSentAt returns the old "sent_at" field's value of the NotificationEvent entity.
-// If the NotificationEvent object wasn't provided to the builder, the object is fetched from the database.
+// OldScheduleLookaheadSeconds returns the old "schedule_lookahead_seconds" field's value of the SystemConfig entity.
+// If the SystemConfig object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *NotificationEventMutation) OldSentAt(ctx context.Context) (v time.Time, err error) {
+func (m *SystemConfigMutation) OldScheduleLookaheadSeconds(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSentAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldScheduleLookaheadSeconds is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSentAt requires an ID field in the mutation")
+		return v, errors.New("OldScheduleLookaheadSeconds requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSentAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldScheduleLookaheadSeconds: %w", err)
 	}
-	return oldValue.SentAt, nil
+	return oldValue.ScheduleLookaheadSeconds, nil
 }
 
-// ResetSentAt resets all changes to the "sent_at" field.
-func (m *NotificationEventMutation) ResetSentAt() {
-	m.sent_at = nil
+// AddScheduleLookaheadSeconds adds i to the "schedule_lookahead_seconds" field.
+func (m *SystemConfigMutation) AddScheduleLookaheadSeconds(i int) {
+	if m.addschedule_lookahead_seconds != nil {
+		*m.addschedule_lookahead_seconds += i
+	} else {
+		m.addschedule_lookahead_seconds = &i
+	}
 }
 
-// SetMonitorID sets the "monitor" edge to the Monitor entity by id.
-func (m *NotificationEventMutation) SetMonitorID(id int) {
-	m.monitor = &id
+// AddedScheduleLookaheadSeconds returns the value that was added to the "schedule_lookahead_seconds" field in this mutation.
+func (m *SystemConfigMutation) AddedScheduleLookaheadSeconds() (r int, exists bool) {
+	v := m.addschedule_lookahead_seconds
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// ClearMonitor clears the "monitor" edge to the Monitor entity.
-func (m *NotificationEventMutation) ClearMonitor() {
-	m.clearedmonitor = true
+// ResetScheduleLookaheadSeconds resets all changes to the "schedule_lookahead_seconds" field.
+func (m *SystemConfigMutation) ResetScheduleLookaheadSeconds() {
+	m.schedule_lookahead_seconds = nil
+	m.addschedule_lookahead_seconds = nil
 }
 
-// MonitorCleared reports if the "monitor" edge to the Monitor entity was cleared.
-func (m *NotificationEventMutation) MonitorCleared() bool {
-	return m.clearedmonitor
+// SetMaxResponseBodyBytes sets the "max_response_body_bytes" field.
+func (m *SystemConfigMutation) SetMaxResponseBodyBytes(i int) {
+	m.max_response_body_bytes = &i
+	m.addmax_response_body_bytes = nil
 }
 
-// MonitorID returns the "monitor" edge ID in the mutation.
-func (m *NotificationEventMutation) MonitorID() (id int, exists bool) {
-	if m.monitor != nil {
-		return *m.monitor, true
+// MaxResponseBodyBytes returns the value of the "max_response_body_bytes" field in the mutation.
+func (m *SystemConfigMutation) MaxResponseBodyBytes() (r int, exists bool) {
+	v := m.max_response_body_bytes
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// MonitorIDs returns the "monitor" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// MonitorID instead. It exists only for internal usage by the builders.
-func (m *NotificationEventMutation) MonitorIDs() (ids []int) {
-	if id := m.monitor; id != nil {
-		ids = append(ids, *id)
+// OldMaxResponseBodyBytes returns the old "max_response_body_bytes" field's value of the SystemConfig entity.
+// If the SystemConfig object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SystemConfigMutation) OldMaxResponseBodyBytes(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMaxResponseBodyBytes is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMaxResponseBodyBytes requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMaxResponseBodyBytes: %w", err)
+	}
+	return oldValue.MaxResponseBodyBytes, nil
 }
 
-// ResetMonitor resets all changes to the "monitor" edge.
-func (m *NotificationEventMutation) ResetMonitor() {
-	m.monitor = nil
-	m.clearedmonitor = false
+// AddMaxResponseBodyBytes adds i to the "max_response_body_bytes" field.
+func (m *SystemConfigMutation) AddMaxResponseBodyBytes(i int) {
+	if m.addmax_response_body_bytes != nil {
+		*m.addmax_response_body_bytes += i
+	} else {
+		m.addmax_response_body_bytes = &i
+	}
 }
 
-// SetChannelID sets the "channel" edge to the NotificationChannel entity by id.
-func (m *NotificationEventMutation) SetChannelID(id int) {
-	m.channel = &id
+// AddedMaxResponseBodyBytes returns the value that was added to the "max_response_body_bytes" field in this mutation.
+func (m *SystemConfigMutation) AddedMaxResponseBodyBytes() (r int, exists bool) {
+	v := m.addmax_response_body_bytes
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// ClearChannel clears the "channel" edge to the NotificationChannel entity.
-func (m *NotificationEventMutation) ClearChannel() {
-	m.clearedchannel = true
+// ClearMaxResponseBodyBytes clears the value of the "max_response_body_bytes" field.
+func (m *SystemConfigMutation) ClearMaxResponseBodyBytes() {
+	m.max_response_body_bytes = nil
+	m.addmax_response_body_bytes = nil
+	m.clearedFields[systemconfig.FieldMaxResponseBodyBytes] = struct{}{}
 }
 
-// ChannelCleared reports if the "channel" edge to the NotificationChannel entity was cleared.
-func (m *NotificationEventMutation) ChannelCleared() bool {
-	return m.clearedchannel
+// MaxResponseBodyBytesCleared returns if the "max_response_body_bytes" field was cleared in this mutation.
+func (m *SystemConfigMutation) MaxResponseBodyBytesCleared() bool {
+	_, ok := m.clearedFields[systemconfig.FieldMaxResponseBodyBytes]
+	return ok
 }
 
-// ChannelID returns the "channel" edge ID in the mutation.
-func (m *NotificationEventMutation) ChannelID() (id int, exists bool) {
-	if m.channel != nil {
-		return *m.channel, true
+// ResetMaxResponseBodyBytes resets all changes to the "max_response_body_bytes" field.
+func (m *SystemConfigMutation) ResetMaxResponseBodyBytes() {
+	m.max_response_body_bytes = nil
+	m.addmax_response_body_bytes = nil
+	delete(m.clearedFields, systemconfig.FieldMaxResponseBodyBytes)
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *SystemConfigMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *SystemConfigMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// ChannelIDs returns the "channel" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// ChannelID instead. It exists only for internal usage by the builders.
-func (m *NotificationEventMutation) ChannelIDs() (ids []int) {
-	if id := m.channel; id != nil {
-		ids = append(ids, *id)
+// OldUpdatedAt returns the old "updated_at" field's value of the SystemConfig entity.
+// If the SystemConfig object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SystemConfigMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
 }
 
-// ResetChannel resets all changes to the "channel" edge.
-func (m *NotificationEventMutation) ResetChannel() {
-	m.channel = nil
-	m.clearedchannel = false
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *SystemConfigMutation) ResetUpdatedAt() {
+	m.updated_at = nil
 }
 
-// Where appends a list predicates to the NotificationEventMutation builder.
-func (m *NotificationEventMutation) Where(ps ...predicate.NotificationEvent) {
+// Where appends a list predicates to the SystemConfigMutation builder.
+func (m *SystemConfigMutation) Where(ps ...predicate.SystemConfig) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the NotificationEventMutation builder. Using this method,
+// WhereP appends storage-level predicates to the SystemConfigMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *NotificationEventMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.NotificationEvent, len(ps))
+func (m *SystemConfigMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.SystemConfig, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -5314,33 +15765,102 @@ func (m *NotificationEventMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *NotificationEventMutation) Op() Op {
+func (m *SystemConfigMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *NotificationEventMutation) SetOp(op Op) {
+func (m *SystemConfigMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (NotificationEvent).
-func (m *NotificationEventMutation) Type() string {
+// Type returns the node type of this mutation (SystemConfig).
+func (m *SystemConfigMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *NotificationEventMutation) Fields() []string {
-	fields := make([]string, 0, 3)
-	if m.status != nil {
-		fields = append(fields, notificationevent.FieldStatus)
+func (m *SystemConfigMutation) Fields() []string {
+	fields := make([]string, 0, 26)
+	if m.key != nil {
+		fields = append(fields, systemconfig.FieldKey)
 	}
-	if m.message != nil {
-		fields = append(fields, notificationevent.FieldMessage)
+	if m.checks_history_limit != nil {
+		fields = append(fields, systemconfig.FieldChecksHistoryLimit)
 	}
-	if m.sent_at != nil {
-		fields = append(fields, notificationevent.FieldSentAt)
+	if m.timezone != nil {
+		fields = append(fields, systemconfig.FieldTimezone)
+	}
+	if m.paused != nil {
+		fields = append(fields, systemconfig.FieldPaused)
+	}
+	if m.notifications_paused != nil {
+		fields = append(fields, systemconfig.FieldNotificationsPaused)
+	}
+	if m.paused_at != nil {
+		fields = append(fields, systemconfig.FieldPausedAt)
+	}
+	if m.stale_after_days != nil {
+		fields = append(fields, systemconfig.FieldStaleAfterDays)
+	}
+	if m.stale_notifications != nil {
+		fields = append(fields, systemconfig.FieldStaleNotifications)
+	}
+	if m.stale_notified_at != nil {
+		fields = append(fields, systemconfig.FieldStaleNotifiedAt)
+	}
+	if m.schedule_jitter_seconds != nil {
+		fields = append(fields, systemconfig.FieldScheduleJitterSeconds)
+	}
+	if m.circuit_breaker_threshold != nil {
+		fields = append(fields, systemconfig.FieldCircuitBreakerThreshold)
+	}
+	if m.circuit_breaker_action != nil {
+		fields = append(fields, systemconfig.FieldCircuitBreakerAction)
+	}
+	if m.circuit_breaker_backoff_minutes != nil {
+		fields = append(fields, systemconfig.FieldCircuitBreakerBackoffMinutes)
+	}
+	if m.catch_up_policy != nil {
+		fields = append(fields, systemconfig.FieldCatchUpPolicy)
+	}
+	if m.catch_up_max_age_minutes != nil {
+		fields = append(fields, systemconfig.FieldCatchUpMaxAgeMinutes)
+	}
+	if m.default_user_agent != nil {
+		fields = append(fields, systemconfig.FieldDefaultUserAgent)
+	}
+	if m.default_headers != nil {
+		fields = append(fields, systemconfig.FieldDefaultHeaders)
+	}
+	if m.proxy_url != nil {
+		fields = append(fields, systemconfig.FieldProxyURL)
+	}
+	if m.proxy_bypass != nil {
+		fields = append(fields, systemconfig.FieldProxyBypass)
+	}
+	if m.request_timeout_seconds != nil {
+		fields = append(fields, systemconfig.FieldRequestTimeoutSeconds)
+	}
+	if m.max_retries != nil {
+		fields = append(fields, systemconfig.FieldMaxRetries)
+	}
+	if m.retry_backoff_seconds != nil {
+		fields = append(fields, systemconfig.FieldRetryBackoffSeconds)
+	}
+	if m.tick_interval_seconds != nil {
+		fields = append(fields, systemconfig.FieldTickIntervalSeconds)
+	}
+	if m.schedule_lookahead_seconds != nil {
+		fields = append(fields, systemconfig.FieldScheduleLookaheadSeconds)
+	}
+	if m.max_response_body_bytes != nil {
+		fields = append(fields, systemconfig.FieldMaxResponseBodyBytes)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, systemconfig.FieldUpdatedAt)
 	}
 	return fields
 }
@@ -5348,14 +15868,60 @@ func (m *NotificationEventMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *NotificationEventMutation) Field(name string) (ent.Value, bool) {
+func (m *SystemConfigMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case notificationevent.FieldStatus:
-		return m.Status()
-	case notificationevent.FieldMessage:
-		return m.Message()
-	case notificationevent.FieldSentAt:
-		return m.SentAt()
+	case systemconfig.FieldKey:
+		return m.Key()
+	case systemconfig.FieldChecksHistoryLimit:
+		return m.ChecksHistoryLimit()
+	case systemconfig.FieldTimezone:
+		return m.Timezone()
+	case systemconfig.FieldPaused:
+		return m.Paused()
+	case systemconfig.FieldNotificationsPaused:
+		return m.NotificationsPaused()
+	case systemconfig.FieldPausedAt:
+		return m.PausedAt()
+	case systemconfig.FieldStaleAfterDays:
+		return m.StaleAfterDays()
+	case systemconfig.FieldStaleNotifications:
+		return m.StaleNotifications()
+	case systemconfig.FieldStaleNotifiedAt:
+		return m.StaleNotifiedAt()
+	case systemconfig.FieldScheduleJitterSeconds:
+		return m.ScheduleJitterSeconds()
+	case systemconfig.FieldCircuitBreakerThreshold:
+		return m.CircuitBreakerThreshold()
+	case systemconfig.FieldCircuitBreakerAction:
+		return m.CircuitBreakerAction()
+	case systemconfig.FieldCircuitBreakerBackoffMinutes:
+		return m.CircuitBreakerBackoffMinutes()
+	case systemconfig.FieldCatchUpPolicy:
+		return m.CatchUpPolicy()
+	case systemconfig.FieldCatchUpMaxAgeMinutes:
+		return m.CatchUpMaxAgeMinutes()
+	case systemconfig.FieldDefaultUserAgent:
+		return m.DefaultUserAgent()
+	case systemconfig.FieldDefaultHeaders:
+		return m.DefaultHeaders()
+	case systemconfig.FieldProxyURL:
+		return m.ProxyURL()
+	case systemconfig.FieldProxyBypass:
+		return m.ProxyBypass()
+	case systemconfig.FieldRequestTimeoutSeconds:
+		return m.RequestTimeoutSeconds()
+	case systemconfig.FieldMaxRetries:
+		return m.MaxRetries()
+	case systemconfig.FieldRetryBackoffSeconds:
+		return m.RetryBackoffSeconds()
+	case systemconfig.FieldTickIntervalSeconds:
+		return m.TickIntervalSeconds()
+	case systemconfig.FieldScheduleLookaheadSeconds:
+		return m.ScheduleLookaheadSeconds()
+	case systemconfig.FieldMaxResponseBodyBytes:
+		return m.MaxResponseBodyBytes()
+	case systemconfig.FieldUpdatedAt:
+		return m.UpdatedAt()
 	}
 	return nil, false
 }
@@ -5363,235 +15929,659 @@ func (m *NotificationEventMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *NotificationEventMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *SystemConfigMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case notificationevent.FieldStatus:
-		return m.OldStatus(ctx)
-	case notificationevent.FieldMessage:
-		return m.OldMessage(ctx)
-	case notificationevent.FieldSentAt:
-		return m.OldSentAt(ctx)
+	case systemconfig.FieldKey:
+		return m.OldKey(ctx)
+	case systemconfig.FieldChecksHistoryLimit:
+		return m.OldChecksHistoryLimit(ctx)
+	case systemconfig.FieldTimezone:
+		return m.OldTimezone(ctx)
+	case systemconfig.FieldPaused:
+		return m.OldPaused(ctx)
+	case systemconfig.FieldNotificationsPaused:
+		return m.OldNotificationsPaused(ctx)
+	case systemconfig.FieldPausedAt:
+		return m.OldPausedAt(ctx)
+	case systemconfig.FieldStaleAfterDays:
+		return m.OldStaleAfterDays(ctx)
+	case systemconfig.FieldStaleNotifications:
+		return m.OldStaleNotifications(ctx)
+	case systemconfig.FieldStaleNotifiedAt:
+		return m.OldStaleNotifiedAt(ctx)
+	case systemconfig.FieldScheduleJitterSeconds:
+		return m.OldScheduleJitterSeconds(ctx)
+	case systemconfig.FieldCircuitBreakerThreshold:
+		return m.OldCircuitBreakerThreshold(ctx)
+	case systemconfig.FieldCircuitBreakerAction:
+		return m.OldCircuitBreakerAction(ctx)
+	case systemconfig.FieldCircuitBreakerBackoffMinutes:
+		return m.OldCircuitBreakerBackoffMinutes(ctx)
+	case systemconfig.FieldCatchUpPolicy:
+		return m.OldCatchUpPolicy(ctx)
+	case systemconfig.FieldCatchUpMaxAgeMinutes:
+		return m.OldCatchUpMaxAgeMinutes(ctx)
+	case systemconfig.FieldDefaultUserAgent:
+		return m.OldDefaultUserAgent(ctx)
+	case systemconfig.FieldDefaultHeaders:
+		return m.OldDefaultHeaders(ctx)
+	case systemconfig.FieldProxyURL:
+		return m.OldProxyURL(ctx)
+	case systemconfig.FieldProxyBypass:
+		return m.OldProxyBypass(ctx)
+	case systemconfig.FieldRequestTimeoutSeconds:
+		return m.OldRequestTimeoutSeconds(ctx)
+	case systemconfig.FieldMaxRetries:
+		return m.OldMaxRetries(ctx)
+	case systemconfig.FieldRetryBackoffSeconds:
+		return m.OldRetryBackoffSeconds(ctx)
+	case systemconfig.FieldTickIntervalSeconds:
+		return m.OldTickIntervalSeconds(ctx)
+	case systemconfig.FieldScheduleLookaheadSeconds:
+		return m.OldScheduleLookaheadSeconds(ctx)
+	case systemconfig.FieldMaxResponseBodyBytes:
+		return m.OldMaxResponseBodyBytes(ctx)
+	case systemconfig.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown NotificationEvent field %s", name)
+	return nil, fmt.Errorf("unknown SystemConfig field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *NotificationEventMutation) SetField(name string, value ent.Value) error {
+func (m *SystemConfigMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case notificationevent.FieldStatus:
+	case systemconfig.FieldKey:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetStatus(v)
+		m.SetKey(v)
 		return nil
-	case notificationevent.FieldMessage:
+	case systemconfig.FieldChecksHistoryLimit:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetChecksHistoryLimit(v)
+		return nil
+	case systemconfig.FieldTimezone:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetMessage(v)
+		m.SetTimezone(v)
 		return nil
-	case notificationevent.FieldSentAt:
+	case systemconfig.FieldPaused:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPaused(v)
+		return nil
+	case systemconfig.FieldNotificationsPaused:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNotificationsPaused(v)
+		return nil
+	case systemconfig.FieldPausedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetSentAt(v)
+		m.SetPausedAt(v)
+		return nil
+	case systemconfig.FieldStaleAfterDays:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStaleAfterDays(v)
+		return nil
+	case systemconfig.FieldStaleNotifications:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStaleNotifications(v)
+		return nil
+	case systemconfig.FieldStaleNotifiedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStaleNotifiedAt(v)
+		return nil
+	case systemconfig.FieldScheduleJitterSeconds:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetScheduleJitterSeconds(v)
+		return nil
+	case systemconfig.FieldCircuitBreakerThreshold:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCircuitBreakerThreshold(v)
+		return nil
+	case systemconfig.FieldCircuitBreakerAction:
+		v, ok := value.(systemconfig.CircuitBreakerAction)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCircuitBreakerAction(v)
+		return nil
+	case systemconfig.FieldCircuitBreakerBackoffMinutes:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCircuitBreakerBackoffMinutes(v)
+		return nil
+	case systemconfig.FieldCatchUpPolicy:
+		v, ok := value.(systemconfig.CatchUpPolicy)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCatchUpPolicy(v)
+		return nil
+	case systemconfig.FieldCatchUpMaxAgeMinutes:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCatchUpMaxAgeMinutes(v)
+		return nil
+	case systemconfig.FieldDefaultUserAgent:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDefaultUserAgent(v)
+		return nil
+	case systemconfig.FieldDefaultHeaders:
+		v, ok := value.(map[string]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDefaultHeaders(v)
+		return nil
+	case systemconfig.FieldProxyURL:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetProxyURL(v)
+		return nil
+	case systemconfig.FieldProxyBypass:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetProxyBypass(v)
+		return nil
+	case systemconfig.FieldRequestTimeoutSeconds:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRequestTimeoutSeconds(v)
+		return nil
+	case systemconfig.FieldMaxRetries:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMaxRetries(v)
+		return nil
+	case systemconfig.FieldRetryBackoffSeconds:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRetryBackoffSeconds(v)
+		return nil
+	case systemconfig.FieldTickIntervalSeconds:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTickIntervalSeconds(v)
+		return nil
+	case systemconfig.FieldScheduleLookaheadSeconds:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetScheduleLookaheadSeconds(v)
+		return nil
+	case systemconfig.FieldMaxResponseBodyBytes:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMaxResponseBodyBytes(v)
+		return nil
+	case systemconfig.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown NotificationEvent field %s", name)
+	return fmt.Errorf("unknown SystemConfig field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *NotificationEventMutation) AddedFields() []string {
-	return nil
+func (m *SystemConfigMutation) AddedFields() []string {
+	var fields []string
+	if m.addchecks_history_limit != nil {
+		fields = append(fields, systemconfig.FieldChecksHistoryLimit)
+	}
+	if m.addstale_after_days != nil {
+		fields = append(fields, systemconfig.FieldStaleAfterDays)
+	}
+	if m.addschedule_jitter_seconds != nil {
+		fields = append(fields, systemconfig.FieldScheduleJitterSeconds)
+	}
+	if m.addcircuit_breaker_threshold != nil {
+		fields = append(fields, systemconfig.FieldCircuitBreakerThreshold)
+	}
+	if m.addcircuit_breaker_backoff_minutes != nil {
+		fields = append(fields, systemconfig.FieldCircuitBreakerBackoffMinutes)
+	}
+	if m.addcatch_up_max_age_minutes != nil {
+		fields = append(fields, systemconfig.FieldCatchUpMaxAgeMinutes)
+	}
+	if m.addrequest_timeout_seconds != nil {
+		fields = append(fields, systemconfig.FieldRequestTimeoutSeconds)
+	}
+	if m.addmax_retries != nil {
+		fields = append(fields, systemconfig.FieldMaxRetries)
+	}
+	if m.addretry_backoff_seconds != nil {
+		fields = append(fields, systemconfig.FieldRetryBackoffSeconds)
+	}
+	if m.addtick_interval_seconds != nil {
+		fields = append(fields, systemconfig.FieldTickIntervalSeconds)
+	}
+	if m.addschedule_lookahead_seconds != nil {
+		fields = append(fields, systemconfig.FieldScheduleLookaheadSeconds)
+	}
+	if m.addmax_response_body_bytes != nil {
+		fields = append(fields, systemconfig.FieldMaxResponseBodyBytes)
+	}
+	return fields
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *NotificationEventMutation) AddedField(name string) (ent.Value, bool) {
+func (m *SystemConfigMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case systemconfig.FieldChecksHistoryLimit:
+		return m.AddedChecksHistoryLimit()
+	case systemconfig.FieldStaleAfterDays:
+		return m.AddedStaleAfterDays()
+	case systemconfig.FieldScheduleJitterSeconds:
+		return m.AddedScheduleJitterSeconds()
+	case systemconfig.FieldCircuitBreakerThreshold:
+		return m.AddedCircuitBreakerThreshold()
+	case systemconfig.FieldCircuitBreakerBackoffMinutes:
+		return m.AddedCircuitBreakerBackoffMinutes()
+	case systemconfig.FieldCatchUpMaxAgeMinutes:
+		return m.AddedCatchUpMaxAgeMinutes()
+	case systemconfig.FieldRequestTimeoutSeconds:
+		return m.AddedRequestTimeoutSeconds()
+	case systemconfig.FieldMaxRetries:
+		return m.AddedMaxRetries()
+	case systemconfig.FieldRetryBackoffSeconds:
+		return m.AddedRetryBackoffSeconds()
+	case systemconfig.FieldTickIntervalSeconds:
+		return m.AddedTickIntervalSeconds()
+	case systemconfig.FieldScheduleLookaheadSeconds:
+		return m.AddedScheduleLookaheadSeconds()
+	case systemconfig.FieldMaxResponseBodyBytes:
+		return m.AddedMaxResponseBodyBytes()
+	}
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *NotificationEventMutation) AddField(name string, value ent.Value) error {
+func (m *SystemConfigMutation) AddField(name string, value ent.Value) error {
 	switch name {
+	case systemconfig.FieldChecksHistoryLimit:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddChecksHistoryLimit(v)
+		return nil
+	case systemconfig.FieldStaleAfterDays:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddStaleAfterDays(v)
+		return nil
+	case systemconfig.FieldScheduleJitterSeconds:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddScheduleJitterSeconds(v)
+		return nil
+	case systemconfig.FieldCircuitBreakerThreshold:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddCircuitBreakerThreshold(v)
+		return nil
+	case systemconfig.FieldCircuitBreakerBackoffMinutes:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddCircuitBreakerBackoffMinutes(v)
+		return nil
+	case systemconfig.FieldCatchUpMaxAgeMinutes:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddCatchUpMaxAgeMinutes(v)
+		return nil
+	case systemconfig.FieldRequestTimeoutSeconds:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddRequestTimeoutSeconds(v)
+		return nil
+	case systemconfig.FieldMaxRetries:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddMaxRetries(v)
+		return nil
+	case systemconfig.FieldRetryBackoffSeconds:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddRetryBackoffSeconds(v)
+		return nil
+	case systemconfig.FieldTickIntervalSeconds:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTickIntervalSeconds(v)
+		return nil
+	case systemconfig.FieldScheduleLookaheadSeconds:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddScheduleLookaheadSeconds(v)
+		return nil
+	case systemconfig.FieldMaxResponseBodyBytes:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddMaxResponseBodyBytes(v)
+		return nil
 	}
-	return fmt.Errorf("unknown NotificationEvent numeric field %s", name)
+	return fmt.Errorf("unknown SystemConfig numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *NotificationEventMutation) ClearedFields() []string {
+func (m *SystemConfigMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(notificationevent.FieldMessage) {
-		fields = append(fields, notificationevent.FieldMessage)
+	if m.FieldCleared(systemconfig.FieldTimezone) {
+		fields = append(fields, systemconfig.FieldTimezone)
+	}
+	if m.FieldCleared(systemconfig.FieldPausedAt) {
+		fields = append(fields, systemconfig.FieldPausedAt)
+	}
+	if m.FieldCleared(systemconfig.FieldStaleNotifiedAt) {
+		fields = append(fields, systemconfig.FieldStaleNotifiedAt)
+	}
+	if m.FieldCleared(systemconfig.FieldDefaultUserAgent) {
+		fields = append(fields, systemconfig.FieldDefaultUserAgent)
+	}
+	if m.FieldCleared(systemconfig.FieldDefaultHeaders) {
+		fields = append(fields, systemconfig.FieldDefaultHeaders)
+	}
+	if m.FieldCleared(systemconfig.FieldProxyURL) {
+		fields = append(fields, systemconfig.FieldProxyURL)
+	}
+	if m.FieldCleared(systemconfig.FieldProxyBypass) {
+		fields = append(fields, systemconfig.FieldProxyBypass)
+	}
+	if m.FieldCleared(systemconfig.FieldMaxResponseBodyBytes) {
+		fields = append(fields, systemconfig.FieldMaxResponseBodyBytes)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *NotificationEventMutation) FieldCleared(name string) bool {
+func (m *SystemConfigMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *NotificationEventMutation) ClearField(name string) error {
+func (m *SystemConfigMutation) ClearField(name string) error {
 	switch name {
-	case notificationevent.FieldMessage:
-		m.ClearMessage()
+	case systemconfig.FieldTimezone:
+		m.ClearTimezone()
+		return nil
+	case systemconfig.FieldPausedAt:
+		m.ClearPausedAt()
+		return nil
+	case systemconfig.FieldStaleNotifiedAt:
+		m.ClearStaleNotifiedAt()
+		return nil
+	case systemconfig.FieldDefaultUserAgent:
+		m.ClearDefaultUserAgent()
+		return nil
+	case systemconfig.FieldDefaultHeaders:
+		m.ClearDefaultHeaders()
+		return nil
+	case systemconfig.FieldProxyURL:
+		m.ClearProxyURL()
+		return nil
+	case systemconfig.FieldProxyBypass:
+		m.ClearProxyBypass()
+		return nil
+	case systemconfig.FieldMaxResponseBodyBytes:
+		m.ClearMaxResponseBodyBytes()
 		return nil
 	}
-	return fmt.Errorf("unknown NotificationEvent nullable field %s", name)
+	return fmt.Errorf("unknown SystemConfig nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *NotificationEventMutation) ResetField(name string) error {
+func (m *SystemConfigMutation) ResetField(name string) error {
 	switch name {
-	case notificationevent.FieldStatus:
-		m.ResetStatus()
+	case systemconfig.FieldKey:
+		m.ResetKey()
 		return nil
-	case notificationevent.FieldMessage:
-		m.ResetMessage()
+	case systemconfig.FieldChecksHistoryLimit:
+		m.ResetChecksHistoryLimit()
 		return nil
-	case notificationevent.FieldSentAt:
-		m.ResetSentAt()
+	case systemconfig.FieldTimezone:
+		m.ResetTimezone()
+		return nil
+	case systemconfig.FieldPaused:
+		m.ResetPaused()
+		return nil
+	case systemconfig.FieldNotificationsPaused:
+		m.ResetNotificationsPaused()
+		return nil
+	case systemconfig.FieldPausedAt:
+		m.ResetPausedAt()
+		return nil
+	case systemconfig.FieldStaleAfterDays:
+		m.ResetStaleAfterDays()
+		return nil
+	case systemconfig.FieldStaleNotifications:
+		m.ResetStaleNotifications()
+		return nil
+	case systemconfig.FieldStaleNotifiedAt:
+		m.ResetStaleNotifiedAt()
+		return nil
+	case systemconfig.FieldScheduleJitterSeconds:
+		m.ResetScheduleJitterSeconds()
+		return nil
+	case systemconfig.FieldCircuitBreakerThreshold:
+		m.ResetCircuitBreakerThreshold()
+		return nil
+	case systemconfig.FieldCircuitBreakerAction:
+		m.ResetCircuitBreakerAction()
+		return nil
+	case systemconfig.FieldCircuitBreakerBackoffMinutes:
+		m.ResetCircuitBreakerBackoffMinutes()
+		return nil
+	case systemconfig.FieldCatchUpPolicy:
+		m.ResetCatchUpPolicy()
+		return nil
+	case systemconfig.FieldCatchUpMaxAgeMinutes:
+		m.ResetCatchUpMaxAgeMinutes()
+		return nil
+	case systemconfig.FieldDefaultUserAgent:
+		m.ResetDefaultUserAgent()
+		return nil
+	case systemconfig.FieldDefaultHeaders:
+		m.ResetDefaultHeaders()
+		return nil
+	case systemconfig.FieldProxyURL:
+		m.ResetProxyURL()
+		return nil
+	case systemconfig.FieldProxyBypass:
+		m.ResetProxyBypass()
+		return nil
+	case systemconfig.FieldRequestTimeoutSeconds:
+		m.ResetRequestTimeoutSeconds()
+		return nil
+	case systemconfig.FieldMaxRetries:
+		m.ResetMaxRetries()
+		return nil
+	case systemconfig.FieldRetryBackoffSeconds:
+		m.ResetRetryBackoffSeconds()
+		return nil
+	case systemconfig.FieldTickIntervalSeconds:
+		m.ResetTickIntervalSeconds()
+		return nil
+	case systemconfig.FieldScheduleLookaheadSeconds:
+		m.ResetScheduleLookaheadSeconds()
+		return nil
+	case systemconfig.FieldMaxResponseBodyBytes:
+		m.ResetMaxResponseBodyBytes()
+		return nil
+	case systemconfig.FieldUpdatedAt:
+		m.ResetUpdatedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown NotificationEvent field %s", name)
+	return fmt.Errorf("unknown SystemConfig field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *NotificationEventMutation) AddedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.monitor != nil {
-		edges = append(edges, notificationevent.EdgeMonitor)
-	}
-	if m.channel != nil {
-		edges = append(edges, notificationevent.EdgeChannel)
-	}
+func (m *SystemConfigMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *NotificationEventMutation) AddedIDs(name string) []ent.Value {
-	switch name {
-	case notificationevent.EdgeMonitor:
-		if id := m.monitor; id != nil {
-			return []ent.Value{*id}
-		}
-	case notificationevent.EdgeChannel:
-		if id := m.channel; id != nil {
-			return []ent.Value{*id}
-		}
-	}
+func (m *SystemConfigMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *NotificationEventMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 2)
+func (m *SystemConfigMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *NotificationEventMutation) RemovedIDs(name string) []ent.Value {
+func (m *SystemConfigMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *NotificationEventMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.clearedmonitor {
-		edges = append(edges, notificationevent.EdgeMonitor)
-	}
-	if m.clearedchannel {
-		edges = append(edges, notificationevent.EdgeChannel)
-	}
+func (m *SystemConfigMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *NotificationEventMutation) EdgeCleared(name string) bool {
-	switch name {
-	case notificationevent.EdgeMonitor:
-		return m.clearedmonitor
-	case notificationevent.EdgeChannel:
-		return m.clearedchannel
-	}
+func (m *SystemConfigMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *NotificationEventMutation) ClearEdge(name string) error {
-	switch name {
-	case notificationevent.EdgeMonitor:
-		m.ClearMonitor()
-		return nil
-	case notificationevent.EdgeChannel:
-		m.ClearChannel()
-		return nil
-	}
-	return fmt.Errorf("unknown NotificationEvent unique edge %s", name)
+func (m *SystemConfigMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown SystemConfig unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *NotificationEventMutation) ResetEdge(name string) error {
-	switch name {
-	case notificationevent.EdgeMonitor:
-		m.ResetMonitor()
-		return nil
-	case notificationevent.EdgeChannel:
-		m.ResetChannel()
-		return nil
-	}
-	return fmt.Errorf("unknown NotificationEvent edge %s", name)
+func (m *SystemConfigMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown SystemConfig edge %s", name)
 }
 
-// SystemConfigMutation represents an operation that mutates the SystemConfig nodes in the graph.
-type SystemConfigMutation struct {
+// UserMutation represents an operation that mutates the User nodes in the graph.
+type UserMutation struct {
 	config
-	op                      Op
-	typ                     string
-	id                      *int
-	key                     *string
-	checks_history_limit    *int
-	addchecks_history_limit *int
-	timezone                *string
-	updated_at              *time.Time
-	clearedFields           map[string]struct{}
-	done                    bool
-	oldValue                func(context.Context) (*SystemConfig, error)
-	predicates              []predicate.SystemConfig
-}
-
-var _ ent.Mutation = (*SystemConfigMutation)(nil)
-
-// systemconfigOption allows management of the mutation configuration using functional options.
-type systemconfigOption func(*SystemConfigMutation)
-
-// newSystemConfigMutation creates new mutation for the SystemConfig entity.
-func newSystemConfigMutation(c config, op Op, opts ...systemconfigOption) *SystemConfigMutation {
-	m := &SystemConfigMutation{
+	op              Op
+	typ             string
+	id              *int
+	username        *string
+	password_hash   *string
+	role            *user.Role
+	created_at      *time.Time
+	updated_at      *time.Time
+	clearedFields   map[string]struct{}
+	sessions        map[int]struct{}
+	removedsessions map[int]struct{}
+	clearedsessions bool
+	done            bool
+	oldValue        func(context.Context) (*User, error)
+	predicates      []predicate.User
+}
+
+var _ ent.Mutation = (*UserMutation)(nil)
+
+// userOption allows management of the mutation configuration using functional options.
+type userOption func(*UserMutation)
+
+// newUserMutation creates new mutation for the User entity.
+func newUserMutation(c config, op Op, opts ...userOption) *UserMutation {
+	m := &UserMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeSystemConfig,
+		typ:           TypeUser,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -5600,20 +16590,20 @@ func newSystemConfigMutation(c config, op Op, opts ...systemconfigOption) *Syste
 	return m
 }
 
-// withSystemConfigID sets the ID field of the mutation.
-func withSystemConfigID(id int) systemconfigOption {
-	return func(m *SystemConfigMutation) {
+// withUserID sets the ID field of the mutation.
+func withUserID(id int) userOption {
+	return func(m *UserMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *SystemConfig
+			value *User
 		)
-		m.oldValue = func(ctx context.Context) (*SystemConfig, error) {
+		m.oldValue = func(ctx context.Context) (*User, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().SystemConfig.Get(ctx, id)
+					value, err = m.Client().User.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -5622,10 +16612,10 @@ func withSystemConfigID(id int) systemconfigOption {
 	}
 }
 
-// withSystemConfig sets the old SystemConfig of the mutation.
-func withSystemConfig(node *SystemConfig) systemconfigOption {
-	return func(m *SystemConfigMutation) {
-		m.oldValue = func(context.Context) (*SystemConfig, error) {
+// withUser sets the old User of the mutation.
+func withUser(node *User) userOption {
+	return func(m *UserMutation) {
+		m.oldValue = func(context.Context) (*User, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -5634,7 +16624,7 @@ func withSystemConfig(node *SystemConfig) systemconfigOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m SystemConfigMutation) Client() *Client {
+func (m UserMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -5642,7 +16632,7 @@ func (m SystemConfigMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m SystemConfigMutation) Tx() (*Tx, error) {
+func (m UserMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -5653,7 +16643,7 @@ func (m SystemConfigMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *SystemConfigMutation) ID() (id int, exists bool) {
+func (m *UserMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -5664,7 +16654,7 @@ func (m *SystemConfigMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *SystemConfigMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *UserMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -5673,160 +16663,163 @@ func (m *SystemConfigMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().SystemConfig.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().User.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetKey sets the "key" field.
-func (m *SystemConfigMutation) SetKey(s string) {
-	m.key = &s
+// SetUsername sets the "username" field.
+func (m *UserMutation) SetUsername(s string) {
+	m.username = &s
 }
 
-// Key returns the value of the "key" field in the mutation.
-func (m *SystemConfigMutation) Key() (r string, exists bool) {
-	v := m.key
+// Username returns the value of the "username" field in the mutation.
+func (m *UserMutation) Username() (r string, exists bool) {
+	v := m.username
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldKey returns the old "key" field's value of the SystemConfig entity.
-// If the SystemConfig object wasn't provided to the builder, the object is fetched from the database.
+// OldUsername returns the old "username" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SystemConfigMutation) OldKey(ctx context.Context) (v string, err error) {
+func (m *UserMutation) OldUsername(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldKey is only allowed on UpdateOne operations")
+		return v, errors.New("OldUsername is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldKey requires an ID field in the mutation")
+		return v, errors.New("OldUsername requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldKey: %w", err)
+		return v, fmt.Errorf("querying old value for OldUsername: %w", err)
 	}
-	return oldValue.Key, nil
+	return oldValue.Username, nil
 }
 
-// ResetKey resets all changes to the "key" field.
-func (m *SystemConfigMutation) ResetKey() {
-	m.key = nil
+// ResetUsername resets all changes to the "username" field.
+func (m *UserMutation) ResetUsername() {
+	m.username = nil
 }
 
-// SetChecksHistoryLimit sets the "checks_history_limit" field.
-func (m *SystemConfigMutation) SetChecksHistoryLimit(i int) {
-	m.checks_history_limit = &i
-	m.addchecks_history_limit = nil
+// SetPasswordHash sets the "password_hash" field.
+func (m *UserMutation) SetPasswordHash(s string) {
+	m.password_hash = &s
 }
 
-// ChecksHistoryLimit returns the value of the "checks_history_limit" field in the mutation.
-func (m *SystemConfigMutation) ChecksHistoryLimit() (r int, exists bool) {
-	v := m.checks_history_limit
+// PasswordHash returns the value of the "password_hash" field in the mutation.
+func (m *UserMutation) PasswordHash() (r string, exists bool) {
+	v := m.password_hash
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldChecksHistoryLimit returns the old "checks_history_limit" field's value of the SystemConfig entity.
-// If the SystemConfig object wasn't provided to the builder, the object is fetched from the database.
+// OldPasswordHash returns the old "password_hash" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SystemConfigMutation) OldChecksHistoryLimit(ctx context.Context) (v int, err error) {
+func (m *UserMutation) OldPasswordHash(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldChecksHistoryLimit is only allowed on UpdateOne operations")
+		return v, errors.New("OldPasswordHash is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldChecksHistoryLimit requires an ID field in the mutation")
+		return v, errors.New("OldPasswordHash requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldChecksHistoryLimit: %w", err)
+		return v, fmt.Errorf("querying old value for OldPasswordHash: %w", err)
 	}
-	return oldValue.ChecksHistoryLimit, nil
+	return oldValue.PasswordHash, nil
 }
 
-// AddChecksHistoryLimit adds i to the "checks_history_limit" field.
-func (m *SystemConfigMutation) AddChecksHistoryLimit(i int) {
-	if m.addchecks_history_limit != nil {
-		*m.addchecks_history_limit += i
-	} else {
-		m.addchecks_history_limit = &i
-	}
+// ResetPasswordHash resets all changes to the "password_hash" field.
+func (m *UserMutation) ResetPasswordHash() {
+	m.password_hash = nil
 }
 
-// AddedChecksHistoryLimit returns the value that was added to the "checks_history_limit" field in this mutation.
-func (m *SystemConfigMutation) AddedChecksHistoryLimit() (r int, exists bool) {
-	v := m.addchecks_history_limit
+// SetRole sets the "role" field.
+func (m *UserMutation) SetRole(u user.Role) {
+	m.role = &u
+}
+
+// Role returns the value of the "role" field in the mutation.
+func (m *UserMutation) Role() (r user.Role, exists bool) {
+	v := m.role
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetChecksHistoryLimit resets all changes to the "checks_history_limit" field.
-func (m *SystemConfigMutation) ResetChecksHistoryLimit() {
-	m.checks_history_limit = nil
-	m.addchecks_history_limit = nil
+// OldRole returns the old "role" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldRole(ctx context.Context) (v user.Role, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRole is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRole requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRole: %w", err)
+	}
+	return oldValue.Role, nil
 }
 
-// SetTimezone sets the "timezone" field.
-func (m *SystemConfigMutation) SetTimezone(s string) {
-	m.timezone = &s
+// ResetRole resets all changes to the "role" field.
+func (m *UserMutation) ResetRole() {
+	m.role = nil
 }
 
-// Timezone returns the value of the "timezone" field in the mutation.
-func (m *SystemConfigMutation) Timezone() (r string, exists bool) {
-	v := m.timezone
+// SetCreatedAt sets the "created_at" field.
+func (m *UserMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *UserMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldTimezone returns the old "timezone" field's value of the SystemConfig entity.
-// If the SystemConfig object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SystemConfigMutation) OldTimezone(ctx context.Context) (v *string, err error) {
+func (m *UserMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTimezone is only allowed on UpdateOne operations")
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTimezone requires an ID field in the mutation")
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTimezone: %w", err)
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
 	}
-	return oldValue.Timezone, nil
-}
-
-// ClearTimezone clears the value of the "timezone" field.
-func (m *SystemConfigMutation) ClearTimezone() {
-	m.timezone = nil
-	m.clearedFields[systemconfig.FieldTimezone] = struct{}{}
-}
-
-// TimezoneCleared returns if the "timezone" field was cleared in this mutation.
-func (m *SystemConfigMutation) TimezoneCleared() bool {
-	_, ok := m.clearedFields[systemconfig.FieldTimezone]
-	return ok
+	return oldValue.CreatedAt, nil
 }
 
-// ResetTimezone resets all changes to the "timezone" field.
-func (m *SystemConfigMutation) ResetTimezone() {
-	m.timezone = nil
-	delete(m.clearedFields, systemconfig.FieldTimezone)
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *UserMutation) ResetCreatedAt() {
+	m.created_at = nil
 }
 
 // SetUpdatedAt sets the "updated_at" field.
-func (m *SystemConfigMutation) SetUpdatedAt(t time.Time) {
+func (m *UserMutation) SetUpdatedAt(t time.Time) {
 	m.updated_at = &t
 }
 
 // UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *SystemConfigMutation) UpdatedAt() (r time.Time, exists bool) {
+func (m *UserMutation) UpdatedAt() (r time.Time, exists bool) {
 	v := m.updated_at
 	if v == nil {
 		return
@@ -5834,10 +16827,10 @@ func (m *SystemConfigMutation) UpdatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the SystemConfig entity.
-// If the SystemConfig object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdatedAt returns the old "updated_at" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SystemConfigMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *UserMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
@@ -5852,19 +16845,73 @@ func (m *SystemConfigMutation) OldUpdatedAt(ctx context.Context) (v time.Time, e
 }
 
 // ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *SystemConfigMutation) ResetUpdatedAt() {
+func (m *UserMutation) ResetUpdatedAt() {
 	m.updated_at = nil
 }
 
-// Where appends a list predicates to the SystemConfigMutation builder.
-func (m *SystemConfigMutation) Where(ps ...predicate.SystemConfig) {
+// AddSessionIDs adds the "sessions" edge to the Session entity by ids.
+func (m *UserMutation) AddSessionIDs(ids ...int) {
+	if m.sessions == nil {
+		m.sessions = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.sessions[ids[i]] = struct{}{}
+	}
+}
+
+// ClearSessions clears the "sessions" edge to the Session entity.
+func (m *UserMutation) ClearSessions() {
+	m.clearedsessions = true
+}
+
+// SessionsCleared reports if the "sessions" edge to the Session entity was cleared.
+func (m *UserMutation) SessionsCleared() bool {
+	return m.clearedsessions
+}
+
+// RemoveSessionIDs removes the "sessions" edge to the Session entity by IDs.
+func (m *UserMutation) RemoveSessionIDs(ids ...int) {
+	if m.removedsessions == nil {
+		m.removedsessions = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.sessions, ids[i])
+		m.removedsessions[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedSessions returns the removed IDs of the "sessions" edge to the Session entity.
+func (m *UserMutation) RemovedSessionsIDs() (ids []int) {
+	for id := range m.removedsessions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// SessionsIDs returns the "sessions" edge IDs in the mutation.
+func (m *UserMutation) SessionsIDs() (ids []int) {
+	for id := range m.sessions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetSessions resets all changes to the "sessions" edge.
+func (m *UserMutation) ResetSessions() {
+	m.sessions = nil
+	m.clearedsessions = false
+	m.removedsessions = nil
+}
+
+// Where appends a list predicates to the UserMutation builder.
+func (m *UserMutation) Where(ps ...predicate.User) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the SystemConfigMutation builder. Using this method,
+// WhereP appends storage-level predicates to the UserMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *SystemConfigMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.SystemConfig, len(ps))
+func (m *UserMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.User, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -5872,36 +16919,39 @@ func (m *SystemConfigMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *SystemConfigMutation) Op() Op {
+func (m *UserMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *SystemConfigMutation) SetOp(op Op) {
+func (m *UserMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (SystemConfig).
-func (m *SystemConfigMutation) Type() string {
+// Type returns the node type of this mutation (User).
+func (m *UserMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *SystemConfigMutation) Fields() []string {
-	fields := make([]string, 0, 4)
-	if m.key != nil {
-		fields = append(fields, systemconfig.FieldKey)
+func (m *UserMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m.username != nil {
+		fields = append(fields, user.FieldUsername)
 	}
-	if m.checks_history_limit != nil {
-		fields = append(fields, systemconfig.FieldChecksHistoryLimit)
+	if m.password_hash != nil {
+		fields = append(fields, user.FieldPasswordHash)
 	}
-	if m.timezone != nil {
-		fields = append(fields, systemconfig.FieldTimezone)
+	if m.role != nil {
+		fields = append(fields, user.FieldRole)
+	}
+	if m.created_at != nil {
+		fields = append(fields, user.FieldCreatedAt)
 	}
 	if m.updated_at != nil {
-		fields = append(fields, systemconfig.FieldUpdatedAt)
+		fields = append(fields, user.FieldUpdatedAt)
 	}
 	return fields
 }
@@ -5909,15 +16959,17 @@ func (m *SystemConfigMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *SystemConfigMutation) Field(name string) (ent.Value, bool) {
+func (m *UserMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case systemconfig.FieldKey:
-		return m.Key()
-	case systemconfig.FieldChecksHistoryLimit:
-		return m.ChecksHistoryLimit()
-	case systemconfig.FieldTimezone:
-		return m.Timezone()
-	case systemconfig.FieldUpdatedAt:
+	case user.FieldUsername:
+		return m.Username()
+	case user.FieldPasswordHash:
+		return m.PasswordHash()
+	case user.FieldRole:
+		return m.Role()
+	case user.FieldCreatedAt:
+		return m.CreatedAt()
+	case user.FieldUpdatedAt:
 		return m.UpdatedAt()
 	}
 	return nil, false
@@ -5926,47 +16978,56 @@ func (m *SystemConfigMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *SystemConfigMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *UserMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case systemconfig.FieldKey:
-		return m.OldKey(ctx)
-	case systemconfig.FieldChecksHistoryLimit:
-		return m.OldChecksHistoryLimit(ctx)
-	case systemconfig.FieldTimezone:
-		return m.OldTimezone(ctx)
-	case systemconfig.FieldUpdatedAt:
+	case user.FieldUsername:
+		return m.OldUsername(ctx)
+	case user.FieldPasswordHash:
+		return m.OldPasswordHash(ctx)
+	case user.FieldRole:
+		return m.OldRole(ctx)
+	case user.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case user.FieldUpdatedAt:
 		return m.OldUpdatedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown SystemConfig field %s", name)
+	return nil, fmt.Errorf("unknown User field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *SystemConfigMutation) SetField(name string, value ent.Value) error {
+func (m *UserMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case systemconfig.FieldKey:
+	case user.FieldUsername:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetKey(v)
+		m.SetUsername(v)
 		return nil
-	case systemconfig.FieldChecksHistoryLimit:
-		v, ok := value.(int)
+	case user.FieldPasswordHash:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetChecksHistoryLimit(v)
+		m.SetPasswordHash(v)
 		return nil
-	case systemconfig.FieldTimezone:
-		v, ok := value.(string)
+	case user.FieldRole:
+		v, ok := value.(user.Role)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetTimezone(v)
+		m.SetRole(v)
 		return nil
-	case systemconfig.FieldUpdatedAt:
+	case user.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case user.FieldUpdatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
@@ -5974,138 +17035,153 @@ func (m *SystemConfigMutation) SetField(name string, value ent.Value) error {
 		m.SetUpdatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown SystemConfig field %s", name)
+	return fmt.Errorf("unknown User field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *SystemConfigMutation) AddedFields() []string {
-	var fields []string
-	if m.addchecks_history_limit != nil {
-		fields = append(fields, systemconfig.FieldChecksHistoryLimit)
-	}
-	return fields
+func (m *UserMutation) AddedFields() []string {
+	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *SystemConfigMutation) AddedField(name string) (ent.Value, bool) {
-	switch name {
-	case systemconfig.FieldChecksHistoryLimit:
-		return m.AddedChecksHistoryLimit()
-	}
+func (m *UserMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *SystemConfigMutation) AddField(name string, value ent.Value) error {
+func (m *UserMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case systemconfig.FieldChecksHistoryLimit:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddChecksHistoryLimit(v)
-		return nil
 	}
-	return fmt.Errorf("unknown SystemConfig numeric field %s", name)
+	return fmt.Errorf("unknown User numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *SystemConfigMutation) ClearedFields() []string {
-	var fields []string
-	if m.FieldCleared(systemconfig.FieldTimezone) {
-		fields = append(fields, systemconfig.FieldTimezone)
-	}
-	return fields
+func (m *UserMutation) ClearedFields() []string {
+	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *SystemConfigMutation) FieldCleared(name string) bool {
+func (m *UserMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *SystemConfigMutation) ClearField(name string) error {
-	switch name {
-	case systemconfig.FieldTimezone:
-		m.ClearTimezone()
-		return nil
-	}
-	return fmt.Errorf("unknown SystemConfig nullable field %s", name)
+func (m *UserMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown User nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *SystemConfigMutation) ResetField(name string) error {
+func (m *UserMutation) ResetField(name string) error {
 	switch name {
-	case systemconfig.FieldKey:
-		m.ResetKey()
+	case user.FieldUsername:
+		m.ResetUsername()
 		return nil
-	case systemconfig.FieldChecksHistoryLimit:
-		m.ResetChecksHistoryLimit()
+	case user.FieldPasswordHash:
+		m.ResetPasswordHash()
 		return nil
-	case systemconfig.FieldTimezone:
-		m.ResetTimezone()
+	case user.FieldRole:
+		m.ResetRole()
 		return nil
-	case systemconfig.FieldUpdatedAt:
+	case user.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case user.FieldUpdatedAt:
 		m.ResetUpdatedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown SystemConfig field %s", name)
+	return fmt.Errorf("unknown User field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *SystemConfigMutation) AddedEdges() []string {
-	edges := make([]string, 0, 0)
+func (m *UserMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.sessions != nil {
+		edges = append(edges, user.EdgeSessions)
+	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *SystemConfigMutation) AddedIDs(name string) []ent.Value {
+func (m *UserMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case user.EdgeSessions:
+		ids := make([]ent.Value, 0, len(m.sessions))
+		for id := range m.sessions {
+			ids = append(ids, id)
+		}
+		return ids
+	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *SystemConfigMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 0)
+func (m *UserMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.removedsessions != nil {
+		edges = append(edges, user.EdgeSessions)
+	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *SystemConfigMutation) RemovedIDs(name string) []ent.Value {
+func (m *UserMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case user.EdgeSessions:
+		ids := make([]ent.Value, 0, len(m.removedsessions))
+		for id := range m.removedsessions {
+			ids = append(ids, id)
+		}
+		return ids
+	}
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *SystemConfigMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 0)
+func (m *UserMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedsessions {
+		edges = append(edges, user.EdgeSessions)
+	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *SystemConfigMutation) EdgeCleared(name string) bool {
+func (m *UserMutation) EdgeCleared(name string) bool {
+	switch name {
+	case user.EdgeSessions:
+		return m.clearedsessions
+	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *SystemConfigMutation) ClearEdge(name string) error {
-	return fmt.Errorf("unknown SystemConfig unique edge %s", name)
+func (m *UserMutation) ClearEdge(name string) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown User unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *SystemConfigMutation) ResetEdge(name string) error {
-	return fmt.Errorf("unknown SystemConfig edge %s", name)
+func (m *UserMutation) ResetEdge(name string) error {
+	switch name {
+	case user.EdgeSessions:
+		m.ResetSessions()
+		return nil
+	}
+	return fmt.Errorf("unknown User edge %s", name)
 }
@@ -21,15 +21,22 @@ type NotificationEvent struct {
 	ID int `json:"id,omitempty"`
 	// Status holds the value of the "status" field.
 	Status string `json:"status,omitempty"`
+	// Kind holds the value of the "kind" field.
+	Kind notificationevent.Kind `json:"kind,omitempty"`
+	// EscalationLevel holds the value of the "escalation_level" field.
+	EscalationLevel int `json:"escalation_level,omitempty"`
 	// Message holds the value of the "message" field.
 	Message *string `json:"message,omitempty"`
 	// SentAt holds the value of the "sent_at" field.
 	SentAt time.Time `json:"sent_at,omitempty"`
+	// RunID holds the value of the "run_id" field.
+	RunID *string `json:"run_id,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the NotificationEventQuery when eager-loading is set.
 	Edges                                    NotificationEventEdges `json:"edges"`
 	monitor_notification_events              *int
 	notification_channel_notification_events *int
+	notification_event_escalations           *int
 	selectValues                             sql.SelectValues
 }
 
@@ -39,9 +46,13 @@ type NotificationEventEdges struct {
 	Monitor *Monitor `json:"monitor,omitempty"`
 	// Channel holds the value of the channel edge.
 	Channel *NotificationChannel `json:"channel,omitempty"`
+	// EscalatedFrom holds the value of the escalated_from edge.
+	EscalatedFrom *NotificationEvent `json:"escalated_from,omitempty"`
+	// Escalations holds the value of the escalations edge.
+	Escalations []*NotificationEvent `json:"escalations,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [2]bool
+	loadedTypes [4]bool
 }
 
 // MonitorOrErr returns the Monitor value or an error if the edge
@@ -66,14 +77,34 @@ func (e NotificationEventEdges) ChannelOrErr() (*NotificationChannel, error) {
 	return nil, &NotLoadedError{edge: "channel"}
 }
 
+// EscalatedFromOrErr returns the EscalatedFrom value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e NotificationEventEdges) EscalatedFromOrErr() (*NotificationEvent, error) {
+	if e.EscalatedFrom != nil {
+		return e.EscalatedFrom, nil
+	} else if e.loadedTypes[2] {
+		return nil, &NotFoundError{label: notificationevent.Label}
+	}
+	return nil, &NotLoadedError{edge: "escalated_from"}
+}
+
+// EscalationsOrErr returns the Escalations value or an error if the edge
+// was not loaded in eager-loading.
+func (e NotificationEventEdges) EscalationsOrErr() ([]*NotificationEvent, error) {
+	if e.loadedTypes[3] {
+		return e.Escalations, nil
+	}
+	return nil, &NotLoadedError{edge: "escalations"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*NotificationEvent) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case notificationevent.FieldID:
+		case notificationevent.FieldID, notificationevent.FieldEscalationLevel:
 			values[i] = new(sql.NullInt64)
-		case notificationevent.FieldStatus, notificationevent.FieldMessage:
+		case notificationevent.FieldStatus, notificationevent.FieldKind, notificationevent.FieldMessage, notificationevent.FieldRunID:
 			values[i] = new(sql.NullString)
 		case notificationevent.FieldSentAt:
 			values[i] = new(sql.NullTime)
@@ -81,6 +112,8 @@ func (*NotificationEvent) scanValues(columns []string) ([]any, error) {
 			values[i] = new(sql.NullInt64)
 		case notificationevent.ForeignKeys[1]: // notification_channel_notification_events
 			values[i] = new(sql.NullInt64)
+		case notificationevent.ForeignKeys[2]: // notification_event_escalations
+			values[i] = new(sql.NullInt64)
 		default:
 			values[i] = new(sql.UnknownType)
 		}
@@ -108,6 +141,18 @@ func (_m *NotificationEvent) assignValues(columns []string, values []any) error
 			} else if value.Valid {
 				_m.Status = value.String
 			}
+		case notificationevent.FieldKind:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field kind", values[i])
+			} else if value.Valid {
+				_m.Kind = notificationevent.Kind(value.String)
+			}
+		case notificationevent.FieldEscalationLevel:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field escalation_level", values[i])
+			} else if value.Valid {
+				_m.EscalationLevel = int(value.Int64)
+			}
 		case notificationevent.FieldMessage:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field message", values[i])
@@ -121,6 +166,13 @@ func (_m *NotificationEvent) assignValues(columns []string, values []any) error
 			} else if value.Valid {
 				_m.SentAt = value.Time
 			}
+		case notificationevent.FieldRunID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field run_id", values[i])
+			} else if value.Valid {
+				_m.RunID = new(string)
+				*_m.RunID = value.String
+			}
 		case notificationevent.ForeignKeys[0]:
 			if value, ok := values[i].(*sql.NullInt64); !ok {
 				return fmt.Errorf("unexpected type %T for edge-field monitor_notification_events", value)
@@ -135,6 +187,13 @@ func (_m *NotificationEvent) assignValues(columns []string, values []any) error
 				_m.notification_channel_notification_events = new(int)
 				*_m.notification_channel_notification_events = int(value.Int64)
 			}
+		case notificationevent.ForeignKeys[2]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field notification_event_escalations", value)
+			} else if value.Valid {
+				_m.notification_event_escalations = new(int)
+				*_m.notification_event_escalations = int(value.Int64)
+			}
 		default:
 			_m.selectValues.Set(columns[i], values[i])
 		}
@@ -158,6 +217,16 @@ func (_m *NotificationEvent) QueryChannel() *NotificationChannelQuery {
 	return NewNotificationEventClient(_m.config).QueryChannel(_m)
 }
 
+// QueryEscalatedFrom queries the "escalated_from" edge of the NotificationEvent entity.
+func (_m *NotificationEvent) QueryEscalatedFrom() *NotificationEventQuery {
+	return NewNotificationEventClient(_m.config).QueryEscalatedFrom(_m)
+}
+
+// QueryEscalations queries the "escalations" edge of the NotificationEvent entity.
+func (_m *NotificationEvent) QueryEscalations() *NotificationEventQuery {
+	return NewNotificationEventClient(_m.config).QueryEscalations(_m)
+}
+
 // Update returns a builder for updating this NotificationEvent.
 // Note that you need to call NotificationEvent.Unwrap() before calling this method if this NotificationEvent
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -184,6 +253,12 @@ func (_m *NotificationEvent) String() string {
 	builder.WriteString("status=")
 	builder.WriteString(_m.Status)
 	builder.WriteString(", ")
+	builder.WriteString("kind=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Kind))
+	builder.WriteString(", ")
+	builder.WriteString("escalation_level=")
+	builder.WriteString(fmt.Sprintf("%v", _m.EscalationLevel))
+	builder.WriteString(", ")
 	if v := _m.Message; v != nil {
 		builder.WriteString("message=")
 		builder.WriteString(*v)
@@ -191,6 +266,11 @@ func (_m *NotificationEvent) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("sent_at=")
 	builder.WriteString(_m.SentAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := _m.RunID; v != nil {
+		builder.WriteString("run_id=")
+		builder.WriteString(*v)
+	}
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -3,6 +3,7 @@
 package notificationevent
 
 import (
+	"fmt"
 	"time"
 
 	"entgo.io/ent/dialect/sql"
@@ -16,14 +17,24 @@ const (
 	FieldID = "id"
 	// FieldStatus holds the string denoting the status field in the database.
 	FieldStatus = "status"
+	// FieldKind holds the string denoting the kind field in the database.
+	FieldKind = "kind"
+	// FieldEscalationLevel holds the string denoting the escalation_level field in the database.
+	FieldEscalationLevel = "escalation_level"
 	// FieldMessage holds the string denoting the message field in the database.
 	FieldMessage = "message"
 	// FieldSentAt holds the string denoting the sent_at field in the database.
 	FieldSentAt = "sent_at"
+	// FieldRunID holds the string denoting the run_id field in the database.
+	FieldRunID = "run_id"
 	// EdgeMonitor holds the string denoting the monitor edge name in mutations.
 	EdgeMonitor = "monitor"
 	// EdgeChannel holds the string denoting the channel edge name in mutations.
 	EdgeChannel = "channel"
+	// EdgeEscalatedFrom holds the string denoting the escalated_from edge name in mutations.
+	EdgeEscalatedFrom = "escalated_from"
+	// EdgeEscalations holds the string denoting the escalations edge name in mutations.
+	EdgeEscalations = "escalations"
 	// Table holds the table name of the notificationevent in the database.
 	Table = "notification_events"
 	// MonitorTable is the table that holds the monitor relation/edge.
@@ -40,14 +51,25 @@ const (
 	ChannelInverseTable = "notification_channels"
 	// ChannelColumn is the table column denoting the channel relation/edge.
 	ChannelColumn = "notification_channel_notification_events"
+	// EscalatedFromTable is the table that holds the escalated_from relation/edge.
+	EscalatedFromTable = "notification_events"
+	// EscalatedFromColumn is the table column denoting the escalated_from relation/edge.
+	EscalatedFromColumn = "notification_event_escalations"
+	// EscalationsTable is the table that holds the escalations relation/edge.
+	EscalationsTable = "notification_events"
+	// EscalationsColumn is the table column denoting the escalations relation/edge.
+	EscalationsColumn = "notification_event_escalations"
 )
 
 // Columns holds all SQL columns for notificationevent fields.
 var Columns = []string{
 	FieldID,
 	FieldStatus,
+	FieldKind,
+	FieldEscalationLevel,
 	FieldMessage,
 	FieldSentAt,
+	FieldRunID,
 }
 
 // ForeignKeys holds the SQL foreign-keys that are owned by the "notification_events"
@@ -55,6 +77,7 @@ var Columns = []string{
 var ForeignKeys = []string{
 	"monitor_notification_events",
 	"notification_channel_notification_events",
+	"notification_event_escalations",
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -75,10 +98,44 @@ func ValidColumn(column string) bool {
 var (
 	// DefaultStatus holds the default value on creation for the "status" field.
 	DefaultStatus string
+	// DefaultEscalationLevel holds the default value on creation for the "escalation_level" field.
+	DefaultEscalationLevel int
+	// EscalationLevelValidator is a validator for the "escalation_level" field. It is called by the builders before save.
+	EscalationLevelValidator func(int) error
 	// DefaultSentAt holds the default value on creation for the "sent_at" field.
 	DefaultSentAt func() time.Time
 )
 
+// Kind defines the type for the "kind" enum field.
+type Kind string
+
+// KindDiff is the default value of the Kind enum.
+const DefaultKind = KindDiff
+
+// Kind values.
+const (
+	KindDiff           Kind = "diff"
+	KindFailure        Kind = "failure"
+	KindEscalation     Kind = "escalation"
+	KindStale          Kind = "stale"
+	KindWatchdog       Kind = "watchdog"
+	KindCircuitBreaker Kind = "circuit_breaker"
+)
+
+func (k Kind) String() string {
+	return string(k)
+}
+
+// KindValidator is a validator for the "kind" field enum values. It is called by the builders before save.
+func KindValidator(k Kind) error {
+	switch k {
+	case KindDiff, KindFailure, KindEscalation, KindStale, KindWatchdog, KindCircuitBreaker:
+		return nil
+	default:
+		return fmt.Errorf("notificationevent: invalid enum value for kind field: %q", k)
+	}
+}
+
 // OrderOption defines the ordering options for the NotificationEvent queries.
 type OrderOption func(*sql.Selector)
 
@@ -92,6 +149,16 @@ func ByStatus(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldStatus, opts...).ToFunc()
 }
 
+// ByKind orders the results by the kind field.
+func ByKind(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldKind, opts...).ToFunc()
+}
+
+// ByEscalationLevel orders the results by the escalation_level field.
+func ByEscalationLevel(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEscalationLevel, opts...).ToFunc()
+}
+
 // ByMessage orders the results by the message field.
 func ByMessage(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldMessage, opts...).ToFunc()
@@ -102,6 +169,11 @@ func BySentAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldSentAt, opts...).ToFunc()
 }
 
+// ByRunID orders the results by the run_id field.
+func ByRunID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRunID, opts...).ToFunc()
+}
+
 // ByMonitorField orders the results by monitor field.
 func ByMonitorField(field string, opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -115,6 +187,27 @@ func ByChannelField(field string, opts ...sql.OrderTermOption) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newChannelStep(), sql.OrderByField(field, opts...))
 	}
 }
+
+// ByEscalatedFromField orders the results by escalated_from field.
+func ByEscalatedFromField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newEscalatedFromStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByEscalationsCount orders the results by escalations count.
+func ByEscalationsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newEscalationsStep(), opts...)
+	}
+}
+
+// ByEscalations orders the results by escalations terms.
+func ByEscalations(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newEscalationsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newMonitorStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -129,3 +222,17 @@ func newChannelStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.M2O, true, ChannelTable, ChannelColumn),
 	)
 }
+func newEscalatedFromStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(Table, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, EscalatedFromTable, EscalatedFromColumn),
+	)
+}
+func newEscalationsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(Table, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, EscalationsTable, EscalationsColumn),
+	)
+}
@@ -60,6 +60,11 @@ func Status(v string) predicate.NotificationEvent {
 	return predicate.NotificationEvent(sql.FieldEQ(FieldStatus, v))
 }
 
+// EscalationLevel applies equality check predicate on the "escalation_level" field. It's identical to EscalationLevelEQ.
+func EscalationLevel(v int) predicate.NotificationEvent {
+	return predicate.NotificationEvent(sql.FieldEQ(FieldEscalationLevel, v))
+}
+
 // Message applies equality check predicate on the "message" field. It's identical to MessageEQ.
 func Message(v string) predicate.NotificationEvent {
 	return predicate.NotificationEvent(sql.FieldEQ(FieldMessage, v))
@@ -70,6 +75,11 @@ func SentAt(v time.Time) predicate.NotificationEvent {
 	return predicate.NotificationEvent(sql.FieldEQ(FieldSentAt, v))
 }
 
+// RunID applies equality check predicate on the "run_id" field. It's identical to RunIDEQ.
+func RunID(v string) predicate.NotificationEvent {
+	return predicate.NotificationEvent(sql.FieldEQ(FieldRunID, v))
+}
+
 // StatusEQ applies the EQ predicate on the "status" field.
 func StatusEQ(v string) predicate.NotificationEvent {
 	return predicate.NotificationEvent(sql.FieldEQ(FieldStatus, v))
@@ -135,6 +145,66 @@ func StatusContainsFold(v string) predicate.NotificationEvent {
 	return predicate.NotificationEvent(sql.FieldContainsFold(FieldStatus, v))
 }
 
+// KindEQ applies the EQ predicate on the "kind" field.
+func KindEQ(v Kind) predicate.NotificationEvent {
+	return predicate.NotificationEvent(sql.FieldEQ(FieldKind, v))
+}
+
+// KindNEQ applies the NEQ predicate on the "kind" field.
+func KindNEQ(v Kind) predicate.NotificationEvent {
+	return predicate.NotificationEvent(sql.FieldNEQ(FieldKind, v))
+}
+
+// KindIn applies the In predicate on the "kind" field.
+func KindIn(vs ...Kind) predicate.NotificationEvent {
+	return predicate.NotificationEvent(sql.FieldIn(FieldKind, vs...))
+}
+
+// KindNotIn applies the NotIn predicate on the "kind" field.
+func KindNotIn(vs ...Kind) predicate.NotificationEvent {
+	return predicate.NotificationEvent(sql.FieldNotIn(FieldKind, vs...))
+}
+
+// EscalationLevelEQ applies the EQ predicate on the "escalation_level" field.
+func EscalationLevelEQ(v int) predicate.NotificationEvent {
+	return predicate.NotificationEvent(sql.FieldEQ(FieldEscalationLevel, v))
+}
+
+// EscalationLevelNEQ applies the NEQ predicate on the "escalation_level" field.
+func EscalationLevelNEQ(v int) predicate.NotificationEvent {
+	return predicate.NotificationEvent(sql.FieldNEQ(FieldEscalationLevel, v))
+}
+
+// EscalationLevelIn applies the In predicate on the "escalation_level" field.
+func EscalationLevelIn(vs ...int) predicate.NotificationEvent {
+	return predicate.NotificationEvent(sql.FieldIn(FieldEscalationLevel, vs...))
+}
+
+// EscalationLevelNotIn applies the NotIn predicate on the "escalation_level" field.
+func EscalationLevelNotIn(vs ...int) predicate.NotificationEvent {
+	return predicate.NotificationEvent(sql.FieldNotIn(FieldEscalationLevel, vs...))
+}
+
+// EscalationLevelGT applies the GT predicate on the "escalation_level" field.
+func EscalationLevelGT(v int) predicate.NotificationEvent {
+	return predicate.NotificationEvent(sql.FieldGT(FieldEscalationLevel, v))
+}
+
+// EscalationLevelGTE applies the GTE predicate on the "escalation_level" field.
+func EscalationLevelGTE(v int) predicate.NotificationEvent {
+	return predicate.NotificationEvent(sql.FieldGTE(FieldEscalationLevel, v))
+}
+
+// EscalationLevelLT applies the LT predicate on the "escalation_level" field.
+func EscalationLevelLT(v int) predicate.NotificationEvent {
+	return predicate.NotificationEvent(sql.FieldLT(FieldEscalationLevel, v))
+}
+
+// EscalationLevelLTE applies the LTE predicate on the "escalation_level" field.
+func EscalationLevelLTE(v int) predicate.NotificationEvent {
+	return predicate.NotificationEvent(sql.FieldLTE(FieldEscalationLevel, v))
+}
+
 // MessageEQ applies the EQ predicate on the "message" field.
 func MessageEQ(v string) predicate.NotificationEvent {
 	return predicate.NotificationEvent(sql.FieldEQ(FieldMessage, v))
@@ -250,6 +320,81 @@ func SentAtLTE(v time.Time) predicate.NotificationEvent {
 	return predicate.NotificationEvent(sql.FieldLTE(FieldSentAt, v))
 }
 
+// RunIDEQ applies the EQ predicate on the "run_id" field.
+func RunIDEQ(v string) predicate.NotificationEvent {
+	return predicate.NotificationEvent(sql.FieldEQ(FieldRunID, v))
+}
+
+// RunIDNEQ applies the NEQ predicate on the "run_id" field.
+func RunIDNEQ(v string) predicate.NotificationEvent {
+	return predicate.NotificationEvent(sql.FieldNEQ(FieldRunID, v))
+}
+
+// RunIDIn applies the In predicate on the "run_id" field.
+func RunIDIn(vs ...string) predicate.NotificationEvent {
+	return predicate.NotificationEvent(sql.FieldIn(FieldRunID, vs...))
+}
+
+// RunIDNotIn applies the NotIn predicate on the "run_id" field.
+func RunIDNotIn(vs ...string) predicate.NotificationEvent {
+	return predicate.NotificationEvent(sql.FieldNotIn(FieldRunID, vs...))
+}
+
+// RunIDGT applies the GT predicate on the "run_id" field.
+func RunIDGT(v string) predicate.NotificationEvent {
+	return predicate.NotificationEvent(sql.FieldGT(FieldRunID, v))
+}
+
+// RunIDGTE applies the GTE predicate on the "run_id" field.
+func RunIDGTE(v string) predicate.NotificationEvent {
+	return predicate.NotificationEvent(sql.FieldGTE(FieldRunID, v))
+}
+
+// RunIDLT applies the LT predicate on the "run_id" field.
+func RunIDLT(v string) predicate.NotificationEvent {
+	return predicate.NotificationEvent(sql.FieldLT(FieldRunID, v))
+}
+
+// RunIDLTE applies the LTE predicate on the "run_id" field.
+func RunIDLTE(v string) predicate.NotificationEvent {
+	return predicate.NotificationEvent(sql.FieldLTE(FieldRunID, v))
+}
+
+// RunIDContains applies the Contains predicate on the "run_id" field.
+func RunIDContains(v string) predicate.NotificationEvent {
+	return predicate.NotificationEvent(sql.FieldContains(FieldRunID, v))
+}
+
+// RunIDHasPrefix applies the HasPrefix predicate on the "run_id" field.
+func RunIDHasPrefix(v string) predicate.NotificationEvent {
+	return predicate.NotificationEvent(sql.FieldHasPrefix(FieldRunID, v))
+}
+
+// RunIDHasSuffix applies the HasSuffix predicate on the "run_id" field.
+func RunIDHasSuffix(v string) predicate.NotificationEvent {
+	return predicate.NotificationEvent(sql.FieldHasSuffix(FieldRunID, v))
+}
+
+// RunIDIsNil applies the IsNil predicate on the "run_id" field.
+func RunIDIsNil() predicate.NotificationEvent {
+	return predicate.NotificationEvent(sql.FieldIsNull(FieldRunID))
+}
+
+// RunIDNotNil applies the NotNil predicate on the "run_id" field.
+func RunIDNotNil() predicate.NotificationEvent {
+	return predicate.NotificationEvent(sql.FieldNotNull(FieldRunID))
+}
+
+// RunIDEqualFold applies the EqualFold predicate on the "run_id" field.
+func RunIDEqualFold(v string) predicate.NotificationEvent {
+	return predicate.NotificationEvent(sql.FieldEqualFold(FieldRunID, v))
+}
+
+// RunIDContainsFold applies the ContainsFold predicate on the "run_id" field.
+func RunIDContainsFold(v string) predicate.NotificationEvent {
+	return predicate.NotificationEvent(sql.FieldContainsFold(FieldRunID, v))
+}
+
 // HasMonitor applies the HasEdge predicate on the "monitor" edge.
 func HasMonitor() predicate.NotificationEvent {
 	return predicate.NotificationEvent(func(s *sql.Selector) {
@@ -296,6 +441,52 @@ func HasChannelWith(preds ...predicate.NotificationChannel) predicate.Notificati
 	})
 }
 
+// HasEscalatedFrom applies the HasEdge predicate on the "escalated_from" edge.
+func HasEscalatedFrom() predicate.NotificationEvent {
+	return predicate.NotificationEvent(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, EscalatedFromTable, EscalatedFromColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasEscalatedFromWith applies the HasEdge predicate on the "escalated_from" edge with a given conditions (other predicates).
+func HasEscalatedFromWith(preds ...predicate.NotificationEvent) predicate.NotificationEvent {
+	return predicate.NotificationEvent(func(s *sql.Selector) {
+		step := newEscalatedFromStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasEscalations applies the HasEdge predicate on the "escalations" edge.
+func HasEscalations() predicate.NotificationEvent {
+	return predicate.NotificationEvent(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, EscalationsTable, EscalationsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasEscalationsWith applies the HasEdge predicate on the "escalations" edge with a given conditions (other predicates).
+func HasEscalationsWith(preds ...predicate.NotificationEvent) predicate.NotificationEvent {
+	return predicate.NotificationEvent(func(s *sql.Selector) {
+		step := newEscalationsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.NotificationEvent) predicate.NotificationEvent {
 	return predicate.NotificationEvent(sql.AndPredicates(predicates...))
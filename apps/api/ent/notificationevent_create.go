@@ -36,6 +36,34 @@ func (_c *NotificationEventCreate) SetNillableStatus(v *string) *NotificationEve
 	return _c
 }
 
+// SetKind sets the "kind" field.
+func (_c *NotificationEventCreate) SetKind(v notificationevent.Kind) *NotificationEventCreate {
+	_c.mutation.SetKind(v)
+	return _c
+}
+
+// SetNillableKind sets the "kind" field if the given value is not nil.
+func (_c *NotificationEventCreate) SetNillableKind(v *notificationevent.Kind) *NotificationEventCreate {
+	if v != nil {
+		_c.SetKind(*v)
+	}
+	return _c
+}
+
+// SetEscalationLevel sets the "escalation_level" field.
+func (_c *NotificationEventCreate) SetEscalationLevel(v int) *NotificationEventCreate {
+	_c.mutation.SetEscalationLevel(v)
+	return _c
+}
+
+// SetNillableEscalationLevel sets the "escalation_level" field if the given value is not nil.
+func (_c *NotificationEventCreate) SetNillableEscalationLevel(v *int) *NotificationEventCreate {
+	if v != nil {
+		_c.SetEscalationLevel(*v)
+	}
+	return _c
+}
+
 // SetMessage sets the "message" field.
 func (_c *NotificationEventCreate) SetMessage(v string) *NotificationEventCreate {
 	_c.mutation.SetMessage(v)
@@ -64,6 +92,20 @@ func (_c *NotificationEventCreate) SetNillableSentAt(v *time.Time) *Notification
 	return _c
 }
 
+// SetRunID sets the "run_id" field.
+func (_c *NotificationEventCreate) SetRunID(v string) *NotificationEventCreate {
+	_c.mutation.SetRunID(v)
+	return _c
+}
+
+// SetNillableRunID sets the "run_id" field if the given value is not nil.
+func (_c *NotificationEventCreate) SetNillableRunID(v *string) *NotificationEventCreate {
+	if v != nil {
+		_c.SetRunID(*v)
+	}
+	return _c
+}
+
 // SetMonitorID sets the "monitor" edge to the Monitor entity by ID.
 func (_c *NotificationEventCreate) SetMonitorID(id int) *NotificationEventCreate {
 	_c.mutation.SetMonitorID(id)
@@ -86,6 +128,40 @@ func (_c *NotificationEventCreate) SetChannel(v *NotificationChannel) *Notificat
 	return _c.SetChannelID(v.ID)
 }
 
+// SetEscalatedFromID sets the "escalated_from" edge to the NotificationEvent entity by ID.
+func (_c *NotificationEventCreate) SetEscalatedFromID(id int) *NotificationEventCreate {
+	_c.mutation.SetEscalatedFromID(id)
+	return _c
+}
+
+// SetNillableEscalatedFromID sets the "escalated_from" edge to the NotificationEvent entity by ID if the given value is not nil.
+func (_c *NotificationEventCreate) SetNillableEscalatedFromID(id *int) *NotificationEventCreate {
+	if id != nil {
+		_c = _c.SetEscalatedFromID(*id)
+	}
+	return _c
+}
+
+// SetEscalatedFrom sets the "escalated_from" edge to the NotificationEvent entity.
+func (_c *NotificationEventCreate) SetEscalatedFrom(v *NotificationEvent) *NotificationEventCreate {
+	return _c.SetEscalatedFromID(v.ID)
+}
+
+// AddEscalationIDs adds the "escalations" edge to the NotificationEvent entity by IDs.
+func (_c *NotificationEventCreate) AddEscalationIDs(ids ...int) *NotificationEventCreate {
+	_c.mutation.AddEscalationIDs(ids...)
+	return _c
+}
+
+// AddEscalations adds the "escalations" edges to the NotificationEvent entity.
+func (_c *NotificationEventCreate) AddEscalations(v ...*NotificationEvent) *NotificationEventCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddEscalationIDs(ids...)
+}
+
 // Mutation returns the NotificationEventMutation object of the builder.
 func (_c *NotificationEventCreate) Mutation() *NotificationEventMutation {
 	return _c.mutation
@@ -125,6 +201,14 @@ func (_c *NotificationEventCreate) defaults() {
 		v := notificationevent.DefaultStatus
 		_c.mutation.SetStatus(v)
 	}
+	if _, ok := _c.mutation.Kind(); !ok {
+		v := notificationevent.DefaultKind
+		_c.mutation.SetKind(v)
+	}
+	if _, ok := _c.mutation.EscalationLevel(); !ok {
+		v := notificationevent.DefaultEscalationLevel
+		_c.mutation.SetEscalationLevel(v)
+	}
 	if _, ok := _c.mutation.SentAt(); !ok {
 		v := notificationevent.DefaultSentAt()
 		_c.mutation.SetSentAt(v)
@@ -136,6 +220,22 @@ func (_c *NotificationEventCreate) check() error {
 	if _, ok := _c.mutation.Status(); !ok {
 		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "NotificationEvent.status"`)}
 	}
+	if _, ok := _c.mutation.Kind(); !ok {
+		return &ValidationError{Name: "kind", err: errors.New(`ent: missing required field "NotificationEvent.kind"`)}
+	}
+	if v, ok := _c.mutation.Kind(); ok {
+		if err := notificationevent.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`ent: validator failed for field "NotificationEvent.kind": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.EscalationLevel(); !ok {
+		return &ValidationError{Name: "escalation_level", err: errors.New(`ent: missing required field "NotificationEvent.escalation_level"`)}
+	}
+	if v, ok := _c.mutation.EscalationLevel(); ok {
+		if err := notificationevent.EscalationLevelValidator(v); err != nil {
+			return &ValidationError{Name: "escalation_level", err: fmt.Errorf(`ent: validator failed for field "NotificationEvent.escalation_level": %w`, err)}
+		}
+	}
 	if _, ok := _c.mutation.SentAt(); !ok {
 		return &ValidationError{Name: "sent_at", err: errors.New(`ent: missing required field "NotificationEvent.sent_at"`)}
 	}
@@ -175,6 +275,14 @@ func (_c *NotificationEventCreate) createSpec() (*NotificationEvent, *sqlgraph.C
 		_spec.SetField(notificationevent.FieldStatus, field.TypeString, value)
 		_node.Status = value
 	}
+	if value, ok := _c.mutation.Kind(); ok {
+		_spec.SetField(notificationevent.FieldKind, field.TypeEnum, value)
+		_node.Kind = value
+	}
+	if value, ok := _c.mutation.EscalationLevel(); ok {
+		_spec.SetField(notificationevent.FieldEscalationLevel, field.TypeInt, value)
+		_node.EscalationLevel = value
+	}
 	if value, ok := _c.mutation.Message(); ok {
 		_spec.SetField(notificationevent.FieldMessage, field.TypeString, value)
 		_node.Message = &value
@@ -183,6 +291,10 @@ func (_c *NotificationEventCreate) createSpec() (*NotificationEvent, *sqlgraph.C
 		_spec.SetField(notificationevent.FieldSentAt, field.TypeTime, value)
 		_node.SentAt = value
 	}
+	if value, ok := _c.mutation.RunID(); ok {
+		_spec.SetField(notificationevent.FieldRunID, field.TypeString, value)
+		_node.RunID = &value
+	}
 	if nodes := _c.mutation.MonitorIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -217,6 +329,39 @@ func (_c *NotificationEventCreate) createSpec() (*NotificationEvent, *sqlgraph.C
 		_node.notification_channel_notification_events = &nodes[0]
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.EscalatedFromIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   notificationevent.EscalatedFromTable,
+			Columns: []string{notificationevent.EscalatedFromColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notificationevent.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.notification_event_escalations = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.EscalationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   notificationevent.EscalationsTable,
+			Columns: []string{notificationevent.EscalationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notificationevent.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -4,6 +4,7 @@ package ent
 
 import (
 	"context"
+	"database/sql/driver"
 	"fmt"
 	"goanna/apps/api/ent/monitor"
 	"goanna/apps/api/ent/notificationchannel"
@@ -20,13 +21,15 @@ import (
 // NotificationEventQuery is the builder for querying NotificationEvent entities.
 type NotificationEventQuery struct {
 	config
-	ctx         *QueryContext
-	order       []notificationevent.OrderOption
-	inters      []Interceptor
-	predicates  []predicate.NotificationEvent
-	withMonitor *MonitorQuery
-	withChannel *NotificationChannelQuery
-	withFKs     bool
+	ctx               *QueryContext
+	order             []notificationevent.OrderOption
+	inters            []Interceptor
+	predicates        []predicate.NotificationEvent
+	withMonitor       *MonitorQuery
+	withChannel       *NotificationChannelQuery
+	withEscalatedFrom *NotificationEventQuery
+	withEscalations   *NotificationEventQuery
+	withFKs           bool
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -107,6 +110,50 @@ func (_q *NotificationEventQuery) QueryChannel() *NotificationChannelQuery {
 	return query
 }
 
+// QueryEscalatedFrom chains the current query on the "escalated_from" edge.
+func (_q *NotificationEventQuery) QueryEscalatedFrom() *NotificationEventQuery {
+	query := (&NotificationEventClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(notificationevent.Table, notificationevent.FieldID, selector),
+			sqlgraph.To(notificationevent.Table, notificationevent.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, notificationevent.EscalatedFromTable, notificationevent.EscalatedFromColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryEscalations chains the current query on the "escalations" edge.
+func (_q *NotificationEventQuery) QueryEscalations() *NotificationEventQuery {
+	query := (&NotificationEventClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(notificationevent.Table, notificationevent.FieldID, selector),
+			sqlgraph.To(notificationevent.Table, notificationevent.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, notificationevent.EscalationsTable, notificationevent.EscalationsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first NotificationEvent entity from the query.
 // Returns a *NotFoundError when no NotificationEvent was found.
 func (_q *NotificationEventQuery) First(ctx context.Context) (*NotificationEvent, error) {
@@ -294,13 +341,15 @@ func (_q *NotificationEventQuery) Clone() *NotificationEventQuery {
 		return nil
 	}
 	return &NotificationEventQuery{
-		config:      _q.config,
-		ctx:         _q.ctx.Clone(),
-		order:       append([]notificationevent.OrderOption{}, _q.order...),
-		inters:      append([]Interceptor{}, _q.inters...),
-		predicates:  append([]predicate.NotificationEvent{}, _q.predicates...),
-		withMonitor: _q.withMonitor.Clone(),
-		withChannel: _q.withChannel.Clone(),
+		config:            _q.config,
+		ctx:               _q.ctx.Clone(),
+		order:             append([]notificationevent.OrderOption{}, _q.order...),
+		inters:            append([]Interceptor{}, _q.inters...),
+		predicates:        append([]predicate.NotificationEvent{}, _q.predicates...),
+		withMonitor:       _q.withMonitor.Clone(),
+		withChannel:       _q.withChannel.Clone(),
+		withEscalatedFrom: _q.withEscalatedFrom.Clone(),
+		withEscalations:   _q.withEscalations.Clone(),
 		// clone intermediate query.
 		sql:  _q.sql.Clone(),
 		path: _q.path,
@@ -329,6 +378,28 @@ func (_q *NotificationEventQuery) WithChannel(opts ...func(*NotificationChannelQ
 	return _q
 }
 
+// WithEscalatedFrom tells the query-builder to eager-load the nodes that are connected to
+// the "escalated_from" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *NotificationEventQuery) WithEscalatedFrom(opts ...func(*NotificationEventQuery)) *NotificationEventQuery {
+	query := (&NotificationEventClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withEscalatedFrom = query
+	return _q
+}
+
+// WithEscalations tells the query-builder to eager-load the nodes that are connected to
+// the "escalations" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *NotificationEventQuery) WithEscalations(opts ...func(*NotificationEventQuery)) *NotificationEventQuery {
+	query := (&NotificationEventClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withEscalations = query
+	return _q
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -408,12 +479,14 @@ func (_q *NotificationEventQuery) sqlAll(ctx context.Context, hooks ...queryHook
 		nodes       = []*NotificationEvent{}
 		withFKs     = _q.withFKs
 		_spec       = _q.querySpec()
-		loadedTypes = [2]bool{
+		loadedTypes = [4]bool{
 			_q.withMonitor != nil,
 			_q.withChannel != nil,
+			_q.withEscalatedFrom != nil,
+			_q.withEscalations != nil,
 		}
 	)
-	if _q.withMonitor != nil || _q.withChannel != nil {
+	if _q.withMonitor != nil || _q.withChannel != nil || _q.withEscalatedFrom != nil {
 		withFKs = true
 	}
 	if withFKs {
@@ -449,6 +522,19 @@ func (_q *NotificationEventQuery) sqlAll(ctx context.Context, hooks ...queryHook
 			return nil, err
 		}
 	}
+	if query := _q.withEscalatedFrom; query != nil {
+		if err := _q.loadEscalatedFrom(ctx, query, nodes, nil,
+			func(n *NotificationEvent, e *NotificationEvent) { n.Edges.EscalatedFrom = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withEscalations; query != nil {
+		if err := _q.loadEscalations(ctx, query, nodes,
+			func(n *NotificationEvent) { n.Edges.Escalations = []*NotificationEvent{} },
+			func(n *NotificationEvent, e *NotificationEvent) { n.Edges.Escalations = append(n.Edges.Escalations, e) }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -516,6 +602,69 @@ func (_q *NotificationEventQuery) loadChannel(ctx context.Context, query *Notifi
 	}
 	return nil
 }
+func (_q *NotificationEventQuery) loadEscalatedFrom(ctx context.Context, query *NotificationEventQuery, nodes []*NotificationEvent, init func(*NotificationEvent), assign func(*NotificationEvent, *NotificationEvent)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*NotificationEvent)
+	for i := range nodes {
+		if nodes[i].notification_event_escalations == nil {
+			continue
+		}
+		fk := *nodes[i].notification_event_escalations
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(notificationevent.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "notification_event_escalations" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (_q *NotificationEventQuery) loadEscalations(ctx context.Context, query *NotificationEventQuery, nodes []*NotificationEvent, init func(*NotificationEvent), assign func(*NotificationEvent, *NotificationEvent)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*NotificationEvent)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.NotificationEvent(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(notificationevent.EscalationsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.notification_event_escalations
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "notification_event_escalations" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "notification_event_escalations" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (_q *NotificationEventQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := _q.querySpec()
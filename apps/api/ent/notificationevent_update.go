@@ -44,6 +44,41 @@ func (_u *NotificationEventUpdate) SetNillableStatus(v *string) *NotificationEve
 	return _u
 }
 
+// SetKind sets the "kind" field.
+func (_u *NotificationEventUpdate) SetKind(v notificationevent.Kind) *NotificationEventUpdate {
+	_u.mutation.SetKind(v)
+	return _u
+}
+
+// SetNillableKind sets the "kind" field if the given value is not nil.
+func (_u *NotificationEventUpdate) SetNillableKind(v *notificationevent.Kind) *NotificationEventUpdate {
+	if v != nil {
+		_u.SetKind(*v)
+	}
+	return _u
+}
+
+// SetEscalationLevel sets the "escalation_level" field.
+func (_u *NotificationEventUpdate) SetEscalationLevel(v int) *NotificationEventUpdate {
+	_u.mutation.ResetEscalationLevel()
+	_u.mutation.SetEscalationLevel(v)
+	return _u
+}
+
+// SetNillableEscalationLevel sets the "escalation_level" field if the given value is not nil.
+func (_u *NotificationEventUpdate) SetNillableEscalationLevel(v *int) *NotificationEventUpdate {
+	if v != nil {
+		_u.SetEscalationLevel(*v)
+	}
+	return _u
+}
+
+// AddEscalationLevel adds value to the "escalation_level" field.
+func (_u *NotificationEventUpdate) AddEscalationLevel(v int) *NotificationEventUpdate {
+	_u.mutation.AddEscalationLevel(v)
+	return _u
+}
+
 // SetMessage sets the "message" field.
 func (_u *NotificationEventUpdate) SetMessage(v string) *NotificationEventUpdate {
 	_u.mutation.SetMessage(v)
@@ -78,6 +113,26 @@ func (_u *NotificationEventUpdate) SetNillableSentAt(v *time.Time) *Notification
 	return _u
 }
 
+// SetRunID sets the "run_id" field.
+func (_u *NotificationEventUpdate) SetRunID(v string) *NotificationEventUpdate {
+	_u.mutation.SetRunID(v)
+	return _u
+}
+
+// SetNillableRunID sets the "run_id" field if the given value is not nil.
+func (_u *NotificationEventUpdate) SetNillableRunID(v *string) *NotificationEventUpdate {
+	if v != nil {
+		_u.SetRunID(*v)
+	}
+	return _u
+}
+
+// ClearRunID clears the value of the "run_id" field.
+func (_u *NotificationEventUpdate) ClearRunID() *NotificationEventUpdate {
+	_u.mutation.ClearRunID()
+	return _u
+}
+
 // SetMonitorID sets the "monitor" edge to the Monitor entity by ID.
 func (_u *NotificationEventUpdate) SetMonitorID(id int) *NotificationEventUpdate {
 	_u.mutation.SetMonitorID(id)
@@ -100,6 +155,40 @@ func (_u *NotificationEventUpdate) SetChannel(v *NotificationChannel) *Notificat
 	return _u.SetChannelID(v.ID)
 }
 
+// SetEscalatedFromID sets the "escalated_from" edge to the NotificationEvent entity by ID.
+func (_u *NotificationEventUpdate) SetEscalatedFromID(id int) *NotificationEventUpdate {
+	_u.mutation.SetEscalatedFromID(id)
+	return _u
+}
+
+// SetNillableEscalatedFromID sets the "escalated_from" edge to the NotificationEvent entity by ID if the given value is not nil.
+func (_u *NotificationEventUpdate) SetNillableEscalatedFromID(id *int) *NotificationEventUpdate {
+	if id != nil {
+		_u = _u.SetEscalatedFromID(*id)
+	}
+	return _u
+}
+
+// SetEscalatedFrom sets the "escalated_from" edge to the NotificationEvent entity.
+func (_u *NotificationEventUpdate) SetEscalatedFrom(v *NotificationEvent) *NotificationEventUpdate {
+	return _u.SetEscalatedFromID(v.ID)
+}
+
+// AddEscalationIDs adds the "escalations" edge to the NotificationEvent entity by IDs.
+func (_u *NotificationEventUpdate) AddEscalationIDs(ids ...int) *NotificationEventUpdate {
+	_u.mutation.AddEscalationIDs(ids...)
+	return _u
+}
+
+// AddEscalations adds the "escalations" edges to the NotificationEvent entity.
+func (_u *NotificationEventUpdate) AddEscalations(v ...*NotificationEvent) *NotificationEventUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddEscalationIDs(ids...)
+}
+
 // Mutation returns the NotificationEventMutation object of the builder.
 func (_u *NotificationEventUpdate) Mutation() *NotificationEventMutation {
 	return _u.mutation
@@ -117,6 +206,33 @@ func (_u *NotificationEventUpdate) ClearChannel() *NotificationEventUpdate {
 	return _u
 }
 
+// ClearEscalatedFrom clears the "escalated_from" edge to the NotificationEvent entity.
+func (_u *NotificationEventUpdate) ClearEscalatedFrom() *NotificationEventUpdate {
+	_u.mutation.ClearEscalatedFrom()
+	return _u
+}
+
+// ClearEscalations clears all "escalations" edges to the NotificationEvent entity.
+func (_u *NotificationEventUpdate) ClearEscalations() *NotificationEventUpdate {
+	_u.mutation.ClearEscalations()
+	return _u
+}
+
+// RemoveEscalationIDs removes the "escalations" edge to NotificationEvent entities by IDs.
+func (_u *NotificationEventUpdate) RemoveEscalationIDs(ids ...int) *NotificationEventUpdate {
+	_u.mutation.RemoveEscalationIDs(ids...)
+	return _u
+}
+
+// RemoveEscalations removes "escalations" edges to NotificationEvent entities.
+func (_u *NotificationEventUpdate) RemoveEscalations(v ...*NotificationEvent) *NotificationEventUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveEscalationIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (_u *NotificationEventUpdate) Save(ctx context.Context) (int, error) {
 	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
@@ -146,6 +262,16 @@ func (_u *NotificationEventUpdate) ExecX(ctx context.Context) {
 
 // check runs all checks and user-defined validators on the builder.
 func (_u *NotificationEventUpdate) check() error {
+	if v, ok := _u.mutation.Kind(); ok {
+		if err := notificationevent.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`ent: validator failed for field "NotificationEvent.kind": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.EscalationLevel(); ok {
+		if err := notificationevent.EscalationLevelValidator(v); err != nil {
+			return &ValidationError{Name: "escalation_level", err: fmt.Errorf(`ent: validator failed for field "NotificationEvent.escalation_level": %w`, err)}
+		}
+	}
 	if _u.mutation.MonitorCleared() && len(_u.mutation.MonitorIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "NotificationEvent.monitor"`)
 	}
@@ -170,6 +296,15 @@ func (_u *NotificationEventUpdate) sqlSave(ctx context.Context) (_node int, err
 	if value, ok := _u.mutation.Status(); ok {
 		_spec.SetField(notificationevent.FieldStatus, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.Kind(); ok {
+		_spec.SetField(notificationevent.FieldKind, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.EscalationLevel(); ok {
+		_spec.SetField(notificationevent.FieldEscalationLevel, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedEscalationLevel(); ok {
+		_spec.AddField(notificationevent.FieldEscalationLevel, field.TypeInt, value)
+	}
 	if value, ok := _u.mutation.Message(); ok {
 		_spec.SetField(notificationevent.FieldMessage, field.TypeString, value)
 	}
@@ -179,6 +314,12 @@ func (_u *NotificationEventUpdate) sqlSave(ctx context.Context) (_node int, err
 	if value, ok := _u.mutation.SentAt(); ok {
 		_spec.SetField(notificationevent.FieldSentAt, field.TypeTime, value)
 	}
+	if value, ok := _u.mutation.RunID(); ok {
+		_spec.SetField(notificationevent.FieldRunID, field.TypeString, value)
+	}
+	if _u.mutation.RunIDCleared() {
+		_spec.ClearField(notificationevent.FieldRunID, field.TypeString)
+	}
 	if _u.mutation.MonitorCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -237,6 +378,80 @@ func (_u *NotificationEventUpdate) sqlSave(ctx context.Context) (_node int, err
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.EscalatedFromCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   notificationevent.EscalatedFromTable,
+			Columns: []string{notificationevent.EscalatedFromColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notificationevent.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.EscalatedFromIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   notificationevent.EscalatedFromTable,
+			Columns: []string{notificationevent.EscalatedFromColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notificationevent.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.EscalationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   notificationevent.EscalationsTable,
+			Columns: []string{notificationevent.EscalationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notificationevent.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedEscalationsIDs(); len(nodes) > 0 && !_u.mutation.EscalationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   notificationevent.EscalationsTable,
+			Columns: []string{notificationevent.EscalationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notificationevent.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.EscalationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   notificationevent.EscalationsTable,
+			Columns: []string{notificationevent.EscalationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notificationevent.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{notificationevent.Label}
@@ -271,6 +486,41 @@ func (_u *NotificationEventUpdateOne) SetNillableStatus(v *string) *Notification
 	return _u
 }
 
+// SetKind sets the "kind" field.
+func (_u *NotificationEventUpdateOne) SetKind(v notificationevent.Kind) *NotificationEventUpdateOne {
+	_u.mutation.SetKind(v)
+	return _u
+}
+
+// SetNillableKind sets the "kind" field if the given value is not nil.
+func (_u *NotificationEventUpdateOne) SetNillableKind(v *notificationevent.Kind) *NotificationEventUpdateOne {
+	if v != nil {
+		_u.SetKind(*v)
+	}
+	return _u
+}
+
+// SetEscalationLevel sets the "escalation_level" field.
+func (_u *NotificationEventUpdateOne) SetEscalationLevel(v int) *NotificationEventUpdateOne {
+	_u.mutation.ResetEscalationLevel()
+	_u.mutation.SetEscalationLevel(v)
+	return _u
+}
+
+// SetNillableEscalationLevel sets the "escalation_level" field if the given value is not nil.
+func (_u *NotificationEventUpdateOne) SetNillableEscalationLevel(v *int) *NotificationEventUpdateOne {
+	if v != nil {
+		_u.SetEscalationLevel(*v)
+	}
+	return _u
+}
+
+// AddEscalationLevel adds value to the "escalation_level" field.
+func (_u *NotificationEventUpdateOne) AddEscalationLevel(v int) *NotificationEventUpdateOne {
+	_u.mutation.AddEscalationLevel(v)
+	return _u
+}
+
 // SetMessage sets the "message" field.
 func (_u *NotificationEventUpdateOne) SetMessage(v string) *NotificationEventUpdateOne {
 	_u.mutation.SetMessage(v)
@@ -305,6 +555,26 @@ func (_u *NotificationEventUpdateOne) SetNillableSentAt(v *time.Time) *Notificat
 	return _u
 }
 
+// SetRunID sets the "run_id" field.
+func (_u *NotificationEventUpdateOne) SetRunID(v string) *NotificationEventUpdateOne {
+	_u.mutation.SetRunID(v)
+	return _u
+}
+
+// SetNillableRunID sets the "run_id" field if the given value is not nil.
+func (_u *NotificationEventUpdateOne) SetNillableRunID(v *string) *NotificationEventUpdateOne {
+	if v != nil {
+		_u.SetRunID(*v)
+	}
+	return _u
+}
+
+// ClearRunID clears the value of the "run_id" field.
+func (_u *NotificationEventUpdateOne) ClearRunID() *NotificationEventUpdateOne {
+	_u.mutation.ClearRunID()
+	return _u
+}
+
 // SetMonitorID sets the "monitor" edge to the Monitor entity by ID.
 func (_u *NotificationEventUpdateOne) SetMonitorID(id int) *NotificationEventUpdateOne {
 	_u.mutation.SetMonitorID(id)
@@ -327,6 +597,40 @@ func (_u *NotificationEventUpdateOne) SetChannel(v *NotificationChannel) *Notifi
 	return _u.SetChannelID(v.ID)
 }
 
+// SetEscalatedFromID sets the "escalated_from" edge to the NotificationEvent entity by ID.
+func (_u *NotificationEventUpdateOne) SetEscalatedFromID(id int) *NotificationEventUpdateOne {
+	_u.mutation.SetEscalatedFromID(id)
+	return _u
+}
+
+// SetNillableEscalatedFromID sets the "escalated_from" edge to the NotificationEvent entity by ID if the given value is not nil.
+func (_u *NotificationEventUpdateOne) SetNillableEscalatedFromID(id *int) *NotificationEventUpdateOne {
+	if id != nil {
+		_u = _u.SetEscalatedFromID(*id)
+	}
+	return _u
+}
+
+// SetEscalatedFrom sets the "escalated_from" edge to the NotificationEvent entity.
+func (_u *NotificationEventUpdateOne) SetEscalatedFrom(v *NotificationEvent) *NotificationEventUpdateOne {
+	return _u.SetEscalatedFromID(v.ID)
+}
+
+// AddEscalationIDs adds the "escalations" edge to the NotificationEvent entity by IDs.
+func (_u *NotificationEventUpdateOne) AddEscalationIDs(ids ...int) *NotificationEventUpdateOne {
+	_u.mutation.AddEscalationIDs(ids...)
+	return _u
+}
+
+// AddEscalations adds the "escalations" edges to the NotificationEvent entity.
+func (_u *NotificationEventUpdateOne) AddEscalations(v ...*NotificationEvent) *NotificationEventUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddEscalationIDs(ids...)
+}
+
 // Mutation returns the NotificationEventMutation object of the builder.
 func (_u *NotificationEventUpdateOne) Mutation() *NotificationEventMutation {
 	return _u.mutation
@@ -344,6 +648,33 @@ func (_u *NotificationEventUpdateOne) ClearChannel() *NotificationEventUpdateOne
 	return _u
 }
 
+// ClearEscalatedFrom clears the "escalated_from" edge to the NotificationEvent entity.
+func (_u *NotificationEventUpdateOne) ClearEscalatedFrom() *NotificationEventUpdateOne {
+	_u.mutation.ClearEscalatedFrom()
+	return _u
+}
+
+// ClearEscalations clears all "escalations" edges to the NotificationEvent entity.
+func (_u *NotificationEventUpdateOne) ClearEscalations() *NotificationEventUpdateOne {
+	_u.mutation.ClearEscalations()
+	return _u
+}
+
+// RemoveEscalationIDs removes the "escalations" edge to NotificationEvent entities by IDs.
+func (_u *NotificationEventUpdateOne) RemoveEscalationIDs(ids ...int) *NotificationEventUpdateOne {
+	_u.mutation.RemoveEscalationIDs(ids...)
+	return _u
+}
+
+// RemoveEscalations removes "escalations" edges to NotificationEvent entities.
+func (_u *NotificationEventUpdateOne) RemoveEscalations(v ...*NotificationEvent) *NotificationEventUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveEscalationIDs(ids...)
+}
+
 // Where appends a list predicates to the NotificationEventUpdate builder.
 func (_u *NotificationEventUpdateOne) Where(ps ...predicate.NotificationEvent) *NotificationEventUpdateOne {
 	_u.mutation.Where(ps...)
@@ -386,6 +717,16 @@ func (_u *NotificationEventUpdateOne) ExecX(ctx context.Context) {
 
 // check runs all checks and user-defined validators on the builder.
 func (_u *NotificationEventUpdateOne) check() error {
+	if v, ok := _u.mutation.Kind(); ok {
+		if err := notificationevent.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`ent: validator failed for field "NotificationEvent.kind": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.EscalationLevel(); ok {
+		if err := notificationevent.EscalationLevelValidator(v); err != nil {
+			return &ValidationError{Name: "escalation_level", err: fmt.Errorf(`ent: validator failed for field "NotificationEvent.escalation_level": %w`, err)}
+		}
+	}
 	if _u.mutation.MonitorCleared() && len(_u.mutation.MonitorIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "NotificationEvent.monitor"`)
 	}
@@ -427,6 +768,15 @@ func (_u *NotificationEventUpdateOne) sqlSave(ctx context.Context) (_node *Notif
 	if value, ok := _u.mutation.Status(); ok {
 		_spec.SetField(notificationevent.FieldStatus, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.Kind(); ok {
+		_spec.SetField(notificationevent.FieldKind, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.EscalationLevel(); ok {
+		_spec.SetField(notificationevent.FieldEscalationLevel, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedEscalationLevel(); ok {
+		_spec.AddField(notificationevent.FieldEscalationLevel, field.TypeInt, value)
+	}
 	if value, ok := _u.mutation.Message(); ok {
 		_spec.SetField(notificationevent.FieldMessage, field.TypeString, value)
 	}
@@ -436,6 +786,12 @@ func (_u *NotificationEventUpdateOne) sqlSave(ctx context.Context) (_node *Notif
 	if value, ok := _u.mutation.SentAt(); ok {
 		_spec.SetField(notificationevent.FieldSentAt, field.TypeTime, value)
 	}
+	if value, ok := _u.mutation.RunID(); ok {
+		_spec.SetField(notificationevent.FieldRunID, field.TypeString, value)
+	}
+	if _u.mutation.RunIDCleared() {
+		_spec.ClearField(notificationevent.FieldRunID, field.TypeString)
+	}
 	if _u.mutation.MonitorCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -494,6 +850,80 @@ func (_u *NotificationEventUpdateOne) sqlSave(ctx context.Context) (_node *Notif
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.EscalatedFromCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   notificationevent.EscalatedFromTable,
+			Columns: []string{notificationevent.EscalatedFromColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notificationevent.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.EscalatedFromIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   notificationevent.EscalatedFromTable,
+			Columns: []string{notificationevent.EscalatedFromColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notificationevent.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.EscalationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   notificationevent.EscalationsTable,
+			Columns: []string{notificationevent.EscalationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notificationevent.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedEscalationsIDs(); len(nodes) > 0 && !_u.mutation.EscalationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   notificationevent.EscalationsTable,
+			Columns: []string{notificationevent.EscalationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notificationevent.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.EscalationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   notificationevent.EscalationsTable,
+			Columns: []string{notificationevent.EscalationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notificationevent.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &NotificationEvent{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
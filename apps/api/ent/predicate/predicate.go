@@ -9,17 +9,35 @@ import (
 // CheckResult is the predicate function for checkresult builders.
 type CheckResult func(*sql.Selector)
 
+// CheckRollup is the predicate function for checkrollup builders.
+type CheckRollup func(*sql.Selector)
+
+// HeaderProfile is the predicate function for headerprofile builders.
+type HeaderProfile func(*sql.Selector)
+
 // Monitor is the predicate function for monitor builders.
 type Monitor func(*sql.Selector)
 
 // MonitorRuntime is the predicate function for monitorruntime builders.
 type MonitorRuntime func(*sql.Selector)
 
+// MonitorVersion is the predicate function for monitorversion builders.
+type MonitorVersion func(*sql.Selector)
+
 // NotificationChannel is the predicate function for notificationchannel builders.
 type NotificationChannel func(*sql.Selector)
 
 // NotificationEvent is the predicate function for notificationevent builders.
 type NotificationEvent func(*sql.Selector)
 
+// SelectorPayload is the predicate function for selectorpayload builders.
+type SelectorPayload func(*sql.Selector)
+
+// Session is the predicate function for session builders.
+type Session func(*sql.Selector)
+
 // SystemConfig is the predicate function for systemconfig builders.
 type SystemConfig func(*sql.Selector)
+
+// User is the predicate function for user builders.
+type User func(*sql.Selector)
@@ -4,12 +4,18 @@ package ent
 
 import (
 	"goanna/apps/api/ent/checkresult"
+	"goanna/apps/api/ent/checkrollup"
+	"goanna/apps/api/ent/headerprofile"
 	"goanna/apps/api/ent/monitor"
 	"goanna/apps/api/ent/monitorruntime"
+	"goanna/apps/api/ent/monitorversion"
 	"goanna/apps/api/ent/notificationchannel"
 	"goanna/apps/api/ent/notificationevent"
 	"goanna/apps/api/ent/schema"
+	"goanna/apps/api/ent/selectorpayload"
+	"goanna/apps/api/ent/session"
 	"goanna/apps/api/ent/systemconfig"
+	"goanna/apps/api/ent/user"
 	"time"
 )
 
@@ -27,10 +33,62 @@ func init() {
 	checkresultDescDiffChanged := checkresultFields[6].Descriptor()
 	// checkresult.DefaultDiffChanged holds the default value on creation for the diff_changed field.
 	checkresult.DefaultDiffChanged = checkresultDescDiffChanged.Default.(bool)
+	// checkresultDescBodySnapshotTruncated is the schema descriptor for body_snapshot_truncated field.
+	checkresultDescBodySnapshotTruncated := checkresultFields[13].Descriptor()
+	// checkresult.DefaultBodySnapshotTruncated holds the default value on creation for the body_snapshot_truncated field.
+	checkresult.DefaultBodySnapshotTruncated = checkresultDescBodySnapshotTruncated.Default.(bool)
 	// checkresultDescCheckedAt is the schema descriptor for checked_at field.
-	checkresultDescCheckedAt := checkresultFields[10].Descriptor()
+	checkresultDescCheckedAt := checkresultFields[20].Descriptor()
 	// checkresult.DefaultCheckedAt holds the default value on creation for the checked_at field.
 	checkresult.DefaultCheckedAt = checkresultDescCheckedAt.Default.(func() time.Time)
+	checkrollupFields := schema.CheckRollup{}.Fields()
+	_ = checkrollupFields
+	// checkrollupDescChecks is the schema descriptor for checks field.
+	checkrollupDescChecks := checkrollupFields[3].Descriptor()
+	// checkrollup.DefaultChecks holds the default value on creation for the checks field.
+	checkrollup.DefaultChecks = checkrollupDescChecks.Default.(int)
+	// checkrollupDescSuccesses is the schema descriptor for successes field.
+	checkrollupDescSuccesses := checkrollupFields[4].Descriptor()
+	// checkrollup.DefaultSuccesses holds the default value on creation for the successes field.
+	checkrollup.DefaultSuccesses = checkrollupDescSuccesses.Default.(int)
+	// checkrollupDescErrors is the schema descriptor for errors field.
+	checkrollupDescErrors := checkrollupFields[5].Descriptor()
+	// checkrollup.DefaultErrors holds the default value on creation for the errors field.
+	checkrollup.DefaultErrors = checkrollupDescErrors.Default.(int)
+	// checkrollupDescChanges is the schema descriptor for changes field.
+	checkrollupDescChanges := checkrollupFields[6].Descriptor()
+	// checkrollup.DefaultChanges holds the default value on creation for the changes field.
+	checkrollup.DefaultChanges = checkrollupDescChanges.Default.(int)
+	// checkrollupDescLatencySamples is the schema descriptor for latency_samples field.
+	checkrollupDescLatencySamples := checkrollupFields[7].Descriptor()
+	// checkrollup.DefaultLatencySamples holds the default value on creation for the latency_samples field.
+	checkrollup.DefaultLatencySamples = checkrollupDescLatencySamples.Default.(int)
+	// checkrollupDescLatencyTotalMs is the schema descriptor for latency_total_ms field.
+	checkrollupDescLatencyTotalMs := checkrollupFields[8].Descriptor()
+	// checkrollup.DefaultLatencyTotalMs holds the default value on creation for the latency_total_ms field.
+	checkrollup.DefaultLatencyTotalMs = checkrollupDescLatencyTotalMs.Default.(int64)
+	// checkrollupDescUpdatedAt is the schema descriptor for updated_at field.
+	checkrollupDescUpdatedAt := checkrollupFields[11].Descriptor()
+	// checkrollup.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	checkrollup.DefaultUpdatedAt = checkrollupDescUpdatedAt.Default.(func() time.Time)
+	// checkrollup.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	checkrollup.UpdateDefaultUpdatedAt = checkrollupDescUpdatedAt.UpdateDefault.(func() time.Time)
+	headerprofileFields := schema.HeaderProfile{}.Fields()
+	_ = headerprofileFields
+	// headerprofileDescName is the schema descriptor for name field.
+	headerprofileDescName := headerprofileFields[0].Descriptor()
+	// headerprofile.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	headerprofile.NameValidator = headerprofileDescName.Validators[0].(func(string) error)
+	// headerprofileDescCreatedAt is the schema descriptor for created_at field.
+	headerprofileDescCreatedAt := headerprofileFields[2].Descriptor()
+	// headerprofile.DefaultCreatedAt holds the default value on creation for the created_at field.
+	headerprofile.DefaultCreatedAt = headerprofileDescCreatedAt.Default.(func() time.Time)
+	// headerprofileDescUpdatedAt is the schema descriptor for updated_at field.
+	headerprofileDescUpdatedAt := headerprofileFields[3].Descriptor()
+	// headerprofile.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	headerprofile.DefaultUpdatedAt = headerprofileDescUpdatedAt.Default.(func() time.Time)
+	// headerprofile.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	headerprofile.UpdateDefaultUpdatedAt = headerprofileDescUpdatedAt.UpdateDefault.(func() time.Time)
 	monitorFields := schema.Monitor{}.Fields()
 	_ = monitorFields
 	// monitorDescMethod is the schema descriptor for method field.
@@ -41,20 +99,72 @@ func init() {
 	monitorDescURL := monitorFields[2].Descriptor()
 	// monitor.URLValidator is a validator for the "url" field. It is called by the builders before save.
 	monitor.URLValidator = monitorDescURL.Validators[0].(func(string) error)
+	// monitorDescEscalationAfterMinutes is the schema descriptor for escalation_after_minutes field.
+	monitorDescEscalationAfterMinutes := monitorFields[12].Descriptor()
+	// monitor.EscalationAfterMinutesValidator is a validator for the "escalation_after_minutes" field. It is called by the builders before save.
+	monitor.EscalationAfterMinutesValidator = monitorDescEscalationAfterMinutes.Validators[0].(func(int) error)
+	// monitorDescTreatNotFoundAsSuccess is the schema descriptor for treat_not_found_as_success field.
+	monitorDescTreatNotFoundAsSuccess := monitorFields[20].Descriptor()
+	// monitor.DefaultTreatNotFoundAsSuccess holds the default value on creation for the treat_not_found_as_success field.
+	monitor.DefaultTreatNotFoundAsSuccess = monitorDescTreatNotFoundAsSuccess.Default.(bool)
+	// monitorDescAcceptEmptyBody is the schema descriptor for accept_empty_body field.
+	monitorDescAcceptEmptyBody := monitorFields[21].Descriptor()
+	// monitor.DefaultAcceptEmptyBody holds the default value on creation for the accept_empty_body field.
+	monitor.DefaultAcceptEmptyBody = monitorDescAcceptEmptyBody.Default.(bool)
+	// monitorDescWatchdogMinutes is the schema descriptor for watchdog_minutes field.
+	monitorDescWatchdogMinutes := monitorFields[22].Descriptor()
+	// monitor.WatchdogMinutesValidator is a validator for the "watchdog_minutes" field. It is called by the builders before save.
+	monitor.WatchdogMinutesValidator = monitorDescWatchdogMinutes.Validators[0].(func(int) error)
+	// monitorDescNumericTolerance is the schema descriptor for numeric_tolerance field.
+	monitorDescNumericTolerance := monitorFields[25].Descriptor()
+	// monitor.NumericToleranceValidator is a validator for the "numeric_tolerance" field. It is called by the builders before save.
+	monitor.NumericToleranceValidator = monitorDescNumericTolerance.Validators[0].(func(float64) error)
+	// monitorDescMaxRedirects is the schema descriptor for max_redirects field.
+	monitorDescMaxRedirects := monitorFields[27].Descriptor()
+	// monitor.MaxRedirectsValidator is a validator for the "max_redirects" field. It is called by the builders before save.
+	monitor.MaxRedirectsValidator = monitorDescMaxRedirects.Validators[0].(func(int) error)
+	// monitorDescMaxResponseBytes is the schema descriptor for max_response_bytes field.
+	monitorDescMaxResponseBytes := monitorFields[28].Descriptor()
+	// monitor.MaxResponseBytesValidator is a validator for the "max_response_bytes" field. It is called by the builders before save.
+	monitor.MaxResponseBytesValidator = monitorDescMaxResponseBytes.Validators[0].(func(int) error)
+	// monitorDescChecksHistoryLimit is the schema descriptor for checks_history_limit field.
+	monitorDescChecksHistoryLimit := monitorFields[29].Descriptor()
+	// monitor.ChecksHistoryLimitValidator is a validator for the "checks_history_limit" field. It is called by the builders before save.
+	monitor.ChecksHistoryLimitValidator = monitorDescChecksHistoryLimit.Validators[0].(func(int) error)
+	// monitorDescCookieJar is the schema descriptor for cookie_jar field.
+	monitorDescCookieJar := monitorFields[30].Descriptor()
+	// monitor.DefaultCookieJar holds the default value on creation for the cookie_jar field.
+	monitor.DefaultCookieJar = monitorDescCookieJar.Default.(bool)
+	// monitorDescTLSInsecureSkipVerify is the schema descriptor for tls_insecure_skip_verify field.
+	monitorDescTLSInsecureSkipVerify := monitorFields[34].Descriptor()
+	// monitor.DefaultTLSInsecureSkipVerify holds the default value on creation for the tls_insecure_skip_verify field.
+	monitor.DefaultTLSInsecureSkipVerify = monitorDescTLSInsecureSkipVerify.Default.(bool)
 	// monitorDescCron is the schema descriptor for cron field.
-	monitorDescCron := monitorFields[11].Descriptor()
+	monitorDescCron := monitorFields[37].Descriptor()
 	// monitor.CronValidator is a validator for the "cron" field. It is called by the builders before save.
 	monitor.CronValidator = monitorDescCron.Validators[0].(func(string) error)
+	// monitorDescJitterSeconds is the schema descriptor for jitter_seconds field.
+	monitorDescJitterSeconds := monitorFields[39].Descriptor()
+	// monitor.JitterSecondsValidator is a validator for the "jitter_seconds" field. It is called by the builders before save.
+	monitor.JitterSecondsValidator = monitorDescJitterSeconds.Validators[0].(func(int) error)
 	// monitorDescEnabled is the schema descriptor for enabled field.
-	monitorDescEnabled := monitorFields[12].Descriptor()
+	monitorDescEnabled := monitorFields[45].Descriptor()
 	// monitor.DefaultEnabled holds the default value on creation for the enabled field.
 	monitor.DefaultEnabled = monitorDescEnabled.Default.(bool)
+	// monitorDescStatusPage is the schema descriptor for status_page field.
+	monitorDescStatusPage := monitorFields[46].Descriptor()
+	// monitor.DefaultStatusPage holds the default value on creation for the status_page field.
+	monitor.DefaultStatusPage = monitorDescStatusPage.Default.(bool)
+	// monitorDescSortIndex is the schema descriptor for sort_index field.
+	monitorDescSortIndex := monitorFields[47].Descriptor()
+	// monitor.DefaultSortIndex holds the default value on creation for the sort_index field.
+	monitor.DefaultSortIndex = monitorDescSortIndex.Default.(int)
 	// monitorDescCreatedAt is the schema descriptor for created_at field.
-	monitorDescCreatedAt := monitorFields[13].Descriptor()
+	monitorDescCreatedAt := monitorFields[49].Descriptor()
 	// monitor.DefaultCreatedAt holds the default value on creation for the created_at field.
 	monitor.DefaultCreatedAt = monitorDescCreatedAt.Default.(func() time.Time)
 	// monitorDescUpdatedAt is the schema descriptor for updated_at field.
-	monitorDescUpdatedAt := monitorFields[14].Descriptor()
+	monitorDescUpdatedAt := monitorFields[50].Descriptor()
 	// monitor.DefaultUpdatedAt holds the default value on creation for the updated_at field.
 	monitor.DefaultUpdatedAt = monitorDescUpdatedAt.Default.(func() time.Time)
 	// monitor.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
@@ -86,11 +196,21 @@ func init() {
 	// monitorruntime.DefaultConsecutiveErrors holds the default value on creation for the consecutive_errors field.
 	monitorruntime.DefaultConsecutiveErrors = monitorruntimeDescConsecutiveErrors.Default.(int64)
 	// monitorruntimeDescUpdatedAt is the schema descriptor for updated_at field.
-	monitorruntimeDescUpdatedAt := monitorruntimeFields[14].Descriptor()
+	monitorruntimeDescUpdatedAt := monitorruntimeFields[27].Descriptor()
 	// monitorruntime.DefaultUpdatedAt holds the default value on creation for the updated_at field.
 	monitorruntime.DefaultUpdatedAt = monitorruntimeDescUpdatedAt.Default.(func() time.Time)
 	// monitorruntime.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
 	monitorruntime.UpdateDefaultUpdatedAt = monitorruntimeDescUpdatedAt.UpdateDefault.(func() time.Time)
+	monitorversionFields := schema.MonitorVersion{}.Fields()
+	_ = monitorversionFields
+	// monitorversionDescVersion is the schema descriptor for version field.
+	monitorversionDescVersion := monitorversionFields[1].Descriptor()
+	// monitorversion.VersionValidator is a validator for the "version" field. It is called by the builders before save.
+	monitorversion.VersionValidator = monitorversionDescVersion.Validators[0].(func(int) error)
+	// monitorversionDescCreatedAt is the schema descriptor for created_at field.
+	monitorversionDescCreatedAt := monitorversionFields[4].Descriptor()
+	// monitorversion.DefaultCreatedAt holds the default value on creation for the created_at field.
+	monitorversion.DefaultCreatedAt = monitorversionDescCreatedAt.Default.(func() time.Time)
 	notificationchannelFields := schema.NotificationChannel{}.Fields()
 	_ = notificationchannelFields
 	// notificationchannelDescName is the schema descriptor for name field.
@@ -125,10 +245,36 @@ func init() {
 	notificationeventDescStatus := notificationeventFields[0].Descriptor()
 	// notificationevent.DefaultStatus holds the default value on creation for the status field.
 	notificationevent.DefaultStatus = notificationeventDescStatus.Default.(string)
+	// notificationeventDescEscalationLevel is the schema descriptor for escalation_level field.
+	notificationeventDescEscalationLevel := notificationeventFields[2].Descriptor()
+	// notificationevent.DefaultEscalationLevel holds the default value on creation for the escalation_level field.
+	notificationevent.DefaultEscalationLevel = notificationeventDescEscalationLevel.Default.(int)
+	// notificationevent.EscalationLevelValidator is a validator for the "escalation_level" field. It is called by the builders before save.
+	notificationevent.EscalationLevelValidator = notificationeventDescEscalationLevel.Validators[0].(func(int) error)
 	// notificationeventDescSentAt is the schema descriptor for sent_at field.
-	notificationeventDescSentAt := notificationeventFields[2].Descriptor()
+	notificationeventDescSentAt := notificationeventFields[4].Descriptor()
 	// notificationevent.DefaultSentAt holds the default value on creation for the sent_at field.
 	notificationevent.DefaultSentAt = notificationeventDescSentAt.Default.(func() time.Time)
+	selectorpayloadFields := schema.SelectorPayload{}.Fields()
+	_ = selectorpayloadFields
+	// selectorpayloadDescToken is the schema descriptor for token field.
+	selectorpayloadDescToken := selectorpayloadFields[0].Descriptor()
+	// selectorpayload.TokenValidator is a validator for the "token" field. It is called by the builders before save.
+	selectorpayload.TokenValidator = selectorpayloadDescToken.Validators[0].(func(string) error)
+	// selectorpayloadDescCreatedAt is the schema descriptor for created_at field.
+	selectorpayloadDescCreatedAt := selectorpayloadFields[3].Descriptor()
+	// selectorpayload.DefaultCreatedAt holds the default value on creation for the created_at field.
+	selectorpayload.DefaultCreatedAt = selectorpayloadDescCreatedAt.Default.(func() time.Time)
+	sessionFields := schema.Session{}.Fields()
+	_ = sessionFields
+	// sessionDescTokenHash is the schema descriptor for token_hash field.
+	sessionDescTokenHash := sessionFields[0].Descriptor()
+	// session.TokenHashValidator is a validator for the "token_hash" field. It is called by the builders before save.
+	session.TokenHashValidator = sessionDescTokenHash.Validators[0].(func(string) error)
+	// sessionDescCreatedAt is the schema descriptor for created_at field.
+	sessionDescCreatedAt := sessionFields[3].Descriptor()
+	// session.DefaultCreatedAt holds the default value on creation for the created_at field.
+	session.DefaultCreatedAt = sessionDescCreatedAt.Default.(func() time.Time)
 	systemconfigFields := schema.SystemConfig{}.Fields()
 	_ = systemconfigFields
 	// systemconfigDescKey is the schema descriptor for key field.
@@ -141,10 +287,102 @@ func init() {
 	systemconfig.DefaultChecksHistoryLimit = systemconfigDescChecksHistoryLimit.Default.(int)
 	// systemconfig.ChecksHistoryLimitValidator is a validator for the "checks_history_limit" field. It is called by the builders before save.
 	systemconfig.ChecksHistoryLimitValidator = systemconfigDescChecksHistoryLimit.Validators[0].(func(int) error)
+	// systemconfigDescPaused is the schema descriptor for paused field.
+	systemconfigDescPaused := systemconfigFields[3].Descriptor()
+	// systemconfig.DefaultPaused holds the default value on creation for the paused field.
+	systemconfig.DefaultPaused = systemconfigDescPaused.Default.(bool)
+	// systemconfigDescNotificationsPaused is the schema descriptor for notifications_paused field.
+	systemconfigDescNotificationsPaused := systemconfigFields[4].Descriptor()
+	// systemconfig.DefaultNotificationsPaused holds the default value on creation for the notifications_paused field.
+	systemconfig.DefaultNotificationsPaused = systemconfigDescNotificationsPaused.Default.(bool)
+	// systemconfigDescStaleAfterDays is the schema descriptor for stale_after_days field.
+	systemconfigDescStaleAfterDays := systemconfigFields[6].Descriptor()
+	// systemconfig.DefaultStaleAfterDays holds the default value on creation for the stale_after_days field.
+	systemconfig.DefaultStaleAfterDays = systemconfigDescStaleAfterDays.Default.(int)
+	// systemconfig.StaleAfterDaysValidator is a validator for the "stale_after_days" field. It is called by the builders before save.
+	systemconfig.StaleAfterDaysValidator = systemconfigDescStaleAfterDays.Validators[0].(func(int) error)
+	// systemconfigDescStaleNotifications is the schema descriptor for stale_notifications field.
+	systemconfigDescStaleNotifications := systemconfigFields[7].Descriptor()
+	// systemconfig.DefaultStaleNotifications holds the default value on creation for the stale_notifications field.
+	systemconfig.DefaultStaleNotifications = systemconfigDescStaleNotifications.Default.(bool)
+	// systemconfigDescScheduleJitterSeconds is the schema descriptor for schedule_jitter_seconds field.
+	systemconfigDescScheduleJitterSeconds := systemconfigFields[9].Descriptor()
+	// systemconfig.DefaultScheduleJitterSeconds holds the default value on creation for the schedule_jitter_seconds field.
+	systemconfig.DefaultScheduleJitterSeconds = systemconfigDescScheduleJitterSeconds.Default.(int)
+	// systemconfig.ScheduleJitterSecondsValidator is a validator for the "schedule_jitter_seconds" field. It is called by the builders before save.
+	systemconfig.ScheduleJitterSecondsValidator = systemconfigDescScheduleJitterSeconds.Validators[0].(func(int) error)
+	// systemconfigDescCircuitBreakerThreshold is the schema descriptor for circuit_breaker_threshold field.
+	systemconfigDescCircuitBreakerThreshold := systemconfigFields[10].Descriptor()
+	// systemconfig.DefaultCircuitBreakerThreshold holds the default value on creation for the circuit_breaker_threshold field.
+	systemconfig.DefaultCircuitBreakerThreshold = systemconfigDescCircuitBreakerThreshold.Default.(int)
+	// systemconfig.CircuitBreakerThresholdValidator is a validator for the "circuit_breaker_threshold" field. It is called by the builders before save.
+	systemconfig.CircuitBreakerThresholdValidator = systemconfigDescCircuitBreakerThreshold.Validators[0].(func(int) error)
+	// systemconfigDescCircuitBreakerBackoffMinutes is the schema descriptor for circuit_breaker_backoff_minutes field.
+	systemconfigDescCircuitBreakerBackoffMinutes := systemconfigFields[12].Descriptor()
+	// systemconfig.DefaultCircuitBreakerBackoffMinutes holds the default value on creation for the circuit_breaker_backoff_minutes field.
+	systemconfig.DefaultCircuitBreakerBackoffMinutes = systemconfigDescCircuitBreakerBackoffMinutes.Default.(int)
+	// systemconfig.CircuitBreakerBackoffMinutesValidator is a validator for the "circuit_breaker_backoff_minutes" field. It is called by the builders before save.
+	systemconfig.CircuitBreakerBackoffMinutesValidator = systemconfigDescCircuitBreakerBackoffMinutes.Validators[0].(func(int) error)
+	// systemconfigDescCatchUpMaxAgeMinutes is the schema descriptor for catch_up_max_age_minutes field.
+	systemconfigDescCatchUpMaxAgeMinutes := systemconfigFields[14].Descriptor()
+	// systemconfig.DefaultCatchUpMaxAgeMinutes holds the default value on creation for the catch_up_max_age_minutes field.
+	systemconfig.DefaultCatchUpMaxAgeMinutes = systemconfigDescCatchUpMaxAgeMinutes.Default.(int)
+	// systemconfig.CatchUpMaxAgeMinutesValidator is a validator for the "catch_up_max_age_minutes" field. It is called by the builders before save.
+	systemconfig.CatchUpMaxAgeMinutesValidator = systemconfigDescCatchUpMaxAgeMinutes.Validators[0].(func(int) error)
+	// systemconfigDescRequestTimeoutSeconds is the schema descriptor for request_timeout_seconds field.
+	systemconfigDescRequestTimeoutSeconds := systemconfigFields[19].Descriptor()
+	// systemconfig.DefaultRequestTimeoutSeconds holds the default value on creation for the request_timeout_seconds field.
+	systemconfig.DefaultRequestTimeoutSeconds = systemconfigDescRequestTimeoutSeconds.Default.(int)
+	// systemconfig.RequestTimeoutSecondsValidator is a validator for the "request_timeout_seconds" field. It is called by the builders before save.
+	systemconfig.RequestTimeoutSecondsValidator = systemconfigDescRequestTimeoutSeconds.Validators[0].(func(int) error)
+	// systemconfigDescMaxRetries is the schema descriptor for max_retries field.
+	systemconfigDescMaxRetries := systemconfigFields[20].Descriptor()
+	// systemconfig.DefaultMaxRetries holds the default value on creation for the max_retries field.
+	systemconfig.DefaultMaxRetries = systemconfigDescMaxRetries.Default.(int)
+	// systemconfig.MaxRetriesValidator is a validator for the "max_retries" field. It is called by the builders before save.
+	systemconfig.MaxRetriesValidator = systemconfigDescMaxRetries.Validators[0].(func(int) error)
+	// systemconfigDescRetryBackoffSeconds is the schema descriptor for retry_backoff_seconds field.
+	systemconfigDescRetryBackoffSeconds := systemconfigFields[21].Descriptor()
+	// systemconfig.DefaultRetryBackoffSeconds holds the default value on creation for the retry_backoff_seconds field.
+	systemconfig.DefaultRetryBackoffSeconds = systemconfigDescRetryBackoffSeconds.Default.(int)
+	// systemconfig.RetryBackoffSecondsValidator is a validator for the "retry_backoff_seconds" field. It is called by the builders before save.
+	systemconfig.RetryBackoffSecondsValidator = systemconfigDescRetryBackoffSeconds.Validators[0].(func(int) error)
+	// systemconfigDescTickIntervalSeconds is the schema descriptor for tick_interval_seconds field.
+	systemconfigDescTickIntervalSeconds := systemconfigFields[22].Descriptor()
+	// systemconfig.DefaultTickIntervalSeconds holds the default value on creation for the tick_interval_seconds field.
+	systemconfig.DefaultTickIntervalSeconds = systemconfigDescTickIntervalSeconds.Default.(int)
+	// systemconfig.TickIntervalSecondsValidator is a validator for the "tick_interval_seconds" field. It is called by the builders before save.
+	systemconfig.TickIntervalSecondsValidator = systemconfigDescTickIntervalSeconds.Validators[0].(func(int) error)
+	// systemconfigDescScheduleLookaheadSeconds is the schema descriptor for schedule_lookahead_seconds field.
+	systemconfigDescScheduleLookaheadSeconds := systemconfigFields[23].Descriptor()
+	// systemconfig.DefaultScheduleLookaheadSeconds holds the default value on creation for the schedule_lookahead_seconds field.
+	systemconfig.DefaultScheduleLookaheadSeconds = systemconfigDescScheduleLookaheadSeconds.Default.(int)
+	// systemconfig.ScheduleLookaheadSecondsValidator is a validator for the "schedule_lookahead_seconds" field. It is called by the builders before save.
+	systemconfig.ScheduleLookaheadSecondsValidator = systemconfigDescScheduleLookaheadSeconds.Validators[0].(func(int) error)
+	// systemconfigDescMaxResponseBodyBytes is the schema descriptor for max_response_body_bytes field.
+	systemconfigDescMaxResponseBodyBytes := systemconfigFields[24].Descriptor()
+	// systemconfig.MaxResponseBodyBytesValidator is a validator for the "max_response_body_bytes" field. It is called by the builders before save.
+	systemconfig.MaxResponseBodyBytesValidator = systemconfigDescMaxResponseBodyBytes.Validators[0].(func(int) error)
 	// systemconfigDescUpdatedAt is the schema descriptor for updated_at field.
-	systemconfigDescUpdatedAt := systemconfigFields[3].Descriptor()
+	systemconfigDescUpdatedAt := systemconfigFields[25].Descriptor()
 	// systemconfig.DefaultUpdatedAt holds the default value on creation for the updated_at field.
 	systemconfig.DefaultUpdatedAt = systemconfigDescUpdatedAt.Default.(func() time.Time)
 	// systemconfig.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
 	systemconfig.UpdateDefaultUpdatedAt = systemconfigDescUpdatedAt.UpdateDefault.(func() time.Time)
+	userFields := schema.User{}.Fields()
+	_ = userFields
+	// userDescUsername is the schema descriptor for username field.
+	userDescUsername := userFields[0].Descriptor()
+	// user.UsernameValidator is a validator for the "username" field. It is called by the builders before save.
+	user.UsernameValidator = userDescUsername.Validators[0].(func(string) error)
+	// userDescCreatedAt is the schema descriptor for created_at field.
+	userDescCreatedAt := userFields[3].Descriptor()
+	// user.DefaultCreatedAt holds the default value on creation for the created_at field.
+	user.DefaultCreatedAt = userDescCreatedAt.Default.(func() time.Time)
+	// userDescUpdatedAt is the schema descriptor for updated_at field.
+	userDescUpdatedAt := userFields[4].Descriptor()
+	// user.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	user.DefaultUpdatedAt = userDescUpdatedAt.Default.(func() time.Time)
+	// user.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	user.UpdateDefaultUpdatedAt = userDescUpdatedAt.UpdateDefault.(func() time.Time)
 }
@@ -4,8 +4,10 @@ import (
 	"time"
 
 	"entgo.io/ent"
+	"entgo.io/ent/dialect"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
 )
 
 // CheckResult holds the schema definition for the CheckResult entity.
@@ -24,13 +26,13 @@ func (CheckResult) Fields() []ent.Field {
 		field.Int("response_time_ms").
 			Optional().
 			Nillable(),
-		field.String("error_message").
+		field.Text("error_message").
 			Optional().
 			Nillable(),
 		field.String("selection_type").
 			Optional().
 			Nillable(),
-		field.String("selection_value").
+		field.Text("selection_value").
 			Optional().
 			Nillable(),
 		field.Bool("diff_changed").
@@ -38,14 +40,48 @@ func (CheckResult) Fields() []ent.Field {
 		field.String("diff_kind").
 			Optional().
 			Nillable(),
-		field.String("diff_summary").
+		field.Text("diff_summary").
 			Optional().
 			Nillable(),
-		field.String("diff_details").
+		field.Text("diff_details").
+			Optional().
+			Nillable(),
+		// Snapshots can exceed MySQL's 64 KiB BLOB, so request the widest type.
+		field.Bytes("body_snapshot").
+			SchemaType(map[string]string{dialect.MySQL: "longblob"}).
+			Optional().
+			Nillable(),
+		field.String("body_snapshot_encoding").
+			Optional().
+			Nillable(),
+		field.Int("body_size").
+			Optional().
+			Nillable(),
+		field.Bool("body_snapshot_truncated").
+			Default(false),
+		field.String("body_hash").
+			Optional().
+			Nillable(),
+		field.Text("tracked_header_value").
+			Optional().
+			Nillable(),
+		field.JSON("redirect_chain", []string{}).
+			Optional(),
+		field.Float("body_read_ms").
+			Optional().
+			Nillable(),
+		field.Float("selector_ms").
+			Optional().
+			Nillable(),
+		field.Float("diff_ms").
 			Optional().
 			Nillable(),
 		field.Time("checked_at").
 			Default(time.Now),
+		// monitor_id keeps the column name the monitor edge had before it
+		// was exposed as a field.
+		field.Int("monitor_id").
+			StorageKey("monitor_check_results"),
 	}
 }
 
@@ -54,7 +90,17 @@ func (CheckResult) Edges() []ent.Edge {
 	return []ent.Edge{
 		edge.From("monitor", Monitor.Type).
 			Ref("check_results").
+			Field("monitor_id").
 			Unique().
 			Required(),
 	}
 }
+
+// Indexes of the CheckResult.
+func (CheckResult) Indexes() []ent.Index {
+	return []ent.Index{
+		// Check lists, history pruning and the lookups of a monitor's
+		// previous selection, body or hash read its checks newest first.
+		index.Fields("monitor_id", "checked_at"),
+	}
+}
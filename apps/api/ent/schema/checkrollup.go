@@ -0,0 +1,66 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// CheckRollup aggregates a monitor's checks over one hour or one UTC day, so
+// long-term trends survive pruning of the raw check history.
+type CheckRollup struct {
+	ent.Schema
+}
+
+// Fields of the CheckRollup.
+func (CheckRollup) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("monitor_id"),
+		field.Enum("period").
+			Values("hour", "day"),
+		field.Time("bucket_start"),
+		field.Int("checks").
+			Default(0),
+		field.Int("successes").
+			Default(0),
+		field.Int("errors").
+			Default(0),
+		field.Int("changes").
+			Default(0),
+		field.Int("latency_samples").
+			Default(0),
+		field.Int64("latency_total_ms").
+			Default(0),
+		field.Int("latency_max_ms").
+			Optional().
+			Nillable(),
+		// latency_histogram counts response times by bucket upper bound in
+		// milliseconds, so percentiles can be estimated after merging buckets.
+		field.JSON("latency_histogram", map[string]int{}).
+			Optional(),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+// Edges of the CheckRollup.
+func (CheckRollup) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("monitor", Monitor.Type).
+			Ref("rollups").
+			Field("monitor_id").
+			Unique().
+			Required(),
+	}
+}
+
+// Indexes of the CheckRollup.
+func (CheckRollup) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("monitor_id", "period", "bucket_start").Unique(),
+	}
+}
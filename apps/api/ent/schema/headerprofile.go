@@ -0,0 +1,38 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// HeaderProfile is a named set of request headers monitors can share.
+type HeaderProfile struct {
+	ent.Schema
+}
+
+// Fields of the HeaderProfile.
+func (HeaderProfile) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name").
+			NotEmpty().
+			Unique(),
+		field.JSON("headers", map[string]string{}).
+			Optional(),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+// Edges of the HeaderProfile.
+func (HeaderProfile) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("monitors", Monitor.Type),
+	}
+}
@@ -21,33 +21,141 @@ func (Monitor) Fields() []ent.Field {
 			Nillable(),
 		field.String("method").
 			Default("GET"),
-		field.String("url").
+		field.Text("url").
 			NotEmpty(),
-		field.String("icon_url").
+		field.Text("icon_url").
 			Optional().
 			Nillable(),
-		field.String("body").
+		field.Text("body").
 			Optional().
 			Nillable(),
 		field.JSON("headers", map[string]string{}).
 			Optional(),
+		field.String("user_agent").
+			Optional().
+			Nillable(),
+		field.Int("header_profile_id").
+			Optional().
+			Nillable(),
 		field.JSON("auth", map[string]string{}).
 			Optional(),
 		field.JSON("notification_channels", []string{}).
 			Optional(),
-		field.String("selector").
+		field.JSON("escalation_channels", []string{}).
+			Optional(),
+		field.JSON("tags", []string{}).
+			Optional(),
+		field.Int("escalation_after_minutes").
+			Optional().
+			Nillable().
+			Positive(),
+		field.Text("selector").
+			Optional().
+			Nillable(),
+		field.String("expected_status").
+			Optional().
+			Nillable(),
+		field.String("retry_on").
 			Optional().
 			Nillable(),
 		field.Enum("expected_type").
-			Values("json", "html", "text").
+			Values("json", "html", "text", "feed", "sitemap").
 			Default("json"),
-		field.String("expected_response").
+		field.Text("expected_response").
+			Optional().
+			Nillable(),
+		field.JSON("must_contain", []string{}).
+			Optional(),
+		field.JSON("must_not_contain", []string{}).
+			Optional(),
+		field.Bool("treat_not_found_as_success").
+			Default(false),
+		field.Bool("accept_empty_body").
+			Default(false),
+		field.Int("watchdog_minutes").
+			Optional().
+			Nillable().
+			Positive(),
+		field.JSON("header_assertions", map[string]string{}).
+			Optional(),
+		field.String("track_header").
+			Optional().
+			Nillable(),
+		field.Float("numeric_tolerance").
+			Optional().
+			Nillable().
+			Min(0),
+		field.Enum("redirect_policy").
+			Values("follow", "none", "record").
+			Default("follow"),
+		field.Int("max_redirects").
+			Range(1, 20).
+			Optional().
+			Nillable(),
+		field.Int("max_response_bytes").
+			Positive().
+			Optional().
+			Nillable(),
+		field.Int("checks_history_limit").
+			Min(10).
+			Optional().
+			Nillable(),
+		field.Bool("cookie_jar").
+			Default(false),
+		field.JSON("host_overrides", map[string]string{}).
+			Optional(),
+		field.Enum("ip_family").
+			Values("any", "ipv4", "ipv6").
+			Default("any"),
+		field.Text("tls_ca_pem").
+			Optional().
+			Nillable(),
+		field.Bool("tls_insecure_skip_verify").
+			Default(false),
+		field.String("tls_min_version").
+			Optional().
+			Nillable(),
+		field.String("tls_server_name").
 			Optional().
 			Nillable(),
 		field.String("cron").
 			NotEmpty(),
+		field.String("timezone").
+			Optional().
+			Nillable(),
+		field.Int("jitter_seconds").
+			Optional().
+			Nillable().
+			Range(0, 3600),
+		field.Enum("dst_policy").
+			Values("skip", "next_valid", "run_twice").
+			Default("next_valid"),
+		field.Enum("body_snapshot").
+			Values("off", "raw", "gzip").
+			Default("off"),
+		field.Enum("content_hash").
+			Values("off", "raw", "normalized").
+			Default("off"),
+		field.Enum("fetch_mode").
+			Values("http", "rendered", "heartbeat").
+			Default("http"),
+		field.String("heartbeat_token").
+			Optional().
+			Nillable().
+			Unique(),
 		field.Bool("enabled").
 			Default(true),
+		// status_page lists the monitor on the public status page.
+		field.Bool("status_page").
+			Default(false),
+		// sort_index orders monitors in listings, lowest first.
+		field.Int("sort_index").
+			Default(0),
+		// archived_at is set while the monitor is archived: it is hidden from
+		// listings and not scheduled, but its history is kept.
+		field.Time("archived_at").
+			Optional().
+			Nillable(),
 		field.Time("created_at").
 			Default(time.Now).
 			Immutable(),
@@ -64,5 +172,11 @@ func (Monitor) Edges() []ent.Edge {
 		edge.To("notification_events", NotificationEvent.Type),
 		edge.To("runtime", MonitorRuntime.Type).
 			Unique(),
+		edge.To("versions", MonitorVersion.Type),
+		edge.To("rollups", CheckRollup.Type),
+		edge.From("header_profile", HeaderProfile.Type).
+			Ref("monitors").
+			Field("header_profile_id").
+			Unique(),
 	}
 }
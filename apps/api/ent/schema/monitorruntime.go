@@ -8,6 +8,18 @@ import (
 	"entgo.io/ent/schema/field"
 )
 
+// StoredCookie is a cookie kept between checks of a monitor with a cookie
+// jar.
+type StoredCookie struct {
+	Name     string     `json:"name"`
+	Value    string     `json:"value"`
+	Domain   string     `json:"domain"`
+	Path     string     `json:"path"`
+	Expires  *time.Time `json:"expires,omitempty"`
+	Secure   bool       `json:"secure,omitempty"`
+	HTTPOnly bool       `json:"httpOnly,omitempty"`
+}
+
 // MonitorRuntime holds mutable state for each monitor.
 type MonitorRuntime struct {
 	ent.Schema
@@ -46,12 +58,49 @@ func (MonitorRuntime) Fields() []ent.Field {
 		field.Int("last_duration_ms").
 			Optional().
 			Nillable(),
-		field.String("last_error_message").
+		field.Text("last_error_message").
 			Optional().
 			Nillable(),
 		field.Time("next_run_at").
 			Optional().
 			Nillable(),
+		field.Time("failing_since").
+			Optional().
+			Nillable(),
+		field.Time("escalated_at").
+			Optional().
+			Nillable(),
+		field.Time("acknowledged_at").
+			Optional().
+			Nillable(),
+		field.JSON("daily_change_counts", map[string]int{}).
+			Optional(),
+		field.Time("last_change_at").
+			Optional().
+			Nillable(),
+		field.Time("error_repeating_since").
+			Optional().
+			Nillable(),
+		field.Time("expect_change_until").
+			Optional().
+			Nillable(),
+		field.Time("watchdog_alerted_at").
+			Optional().
+			Nillable(),
+		field.Time("last_ping_at").
+			Optional().
+			Nillable(),
+		field.Time("circuit_opened_at").
+			Optional().
+			Nillable(),
+		field.JSON("cookies", []StoredCookie{}).
+			Optional(),
+		field.String("claimed_by").
+			Optional().
+			Nillable(),
+		field.Time("claimed_until").
+			Optional().
+			Nillable(),
 		field.Time("updated_at").
 			Default(time.Now).
 			UpdateDefault(time.Now),
@@ -0,0 +1,52 @@
+package schema
+
+import (
+	"encoding/json"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// MonitorVersion is a snapshot of a monitor's configuration, recorded each
+// time it is created or changed so edits can be rolled back.
+type MonitorVersion struct {
+	ent.Schema
+}
+
+// Fields of the MonitorVersion.
+func (MonitorVersion) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("monitor_id"),
+		field.Int("version").
+			Positive(),
+		// config holds the monitor in the create request shape.
+		field.JSON("config", json.RawMessage{}),
+		field.String("created_by").
+			Optional().
+			Nillable(),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Edges of the MonitorVersion.
+func (MonitorVersion) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("monitor", Monitor.Type).
+			Ref("versions").
+			Field("monitor_id").
+			Unique().
+			Required(),
+	}
+}
+
+// Indexes of the MonitorVersion.
+func (MonitorVersion) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("monitor_id", "version").Unique(),
+	}
+}
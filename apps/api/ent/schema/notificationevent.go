@@ -18,11 +18,22 @@ func (NotificationEvent) Fields() []ent.Field {
 	return []ent.Field{
 		field.String("status").
 			Default("pending"),
-		field.String("message").
+		field.Enum("kind").
+			Values("diff", "failure", "escalation", "stale", "watchdog", "circuit_breaker").
+			Default("diff"),
+		field.Int("escalation_level").
+			Default(0).
+			NonNegative(),
+		field.Text("message").
 			Optional().
 			Nillable(),
 		field.Time("sent_at").
 			Default(time.Now),
+		// run_id ties the event to the worker logs of the check run that
+		// sent it; housekeeping notifications have none.
+		field.String("run_id").
+			Optional().
+			Nillable(),
 	}
 }
 
@@ -37,5 +48,8 @@ func (NotificationEvent) Edges() []ent.Edge {
 			Ref("notification_events").
 			Unique().
 			Required(),
+		edge.To("escalations", NotificationEvent.Type).
+			From("escalated_from").
+			Unique(),
 	}
 }
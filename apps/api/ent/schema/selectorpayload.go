@@ -0,0 +1,39 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// SelectorPayload keeps a monitor test's JSON response for a short while, so
+// selectors can be previewed against it by token from any API replica.
+type SelectorPayload struct {
+	ent.Schema
+}
+
+// Fields of the SelectorPayload.
+func (SelectorPayload) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("token").
+			NotEmpty().
+			Unique().
+			Sensitive(),
+		field.Bytes("payload").
+			SchemaType(map[string]string{dialect.MySQL: "longblob"}),
+		field.Time("expires_at"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Indexes of the SelectorPayload.
+func (SelectorPayload) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("expires_at"),
+	}
+}
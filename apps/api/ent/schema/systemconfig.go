@@ -24,6 +24,69 @@ func (SystemConfig) Fields() []ent.Field {
 		field.String("timezone").
 			Optional().
 			Nillable(),
+		field.Bool("paused").
+			Default(false),
+		field.Bool("notifications_paused").
+			Default(false),
+		field.Time("paused_at").
+			Optional().
+			Nillable(),
+		field.Int("stale_after_days").
+			Positive().
+			Default(14),
+		field.Bool("stale_notifications").
+			Default(false),
+		field.Time("stale_notified_at").
+			Optional().
+			Nillable(),
+		field.Int("schedule_jitter_seconds").
+			Range(0, 3600).
+			Default(0),
+		field.Int("circuit_breaker_threshold").
+			Range(0, 1000).
+			Default(0),
+		field.Enum("circuit_breaker_action").
+			Values("backoff", "suspend").
+			Default("backoff"),
+		field.Int("circuit_breaker_backoff_minutes").
+			Range(1, 10080).
+			Default(60),
+		field.Enum("catch_up_policy").
+			Values("run_all_missed", "run_latest_only", "skip").
+			Default("run_latest_only"),
+		field.Int("catch_up_max_age_minutes").
+			Range(0, 525600).
+			Default(0),
+		field.String("default_user_agent").
+			Optional().
+			Nillable(),
+		field.JSON("default_headers", map[string]string{}).
+			Optional(),
+		field.String("proxy_url").
+			Optional().
+			Nillable(),
+		field.String("proxy_bypass").
+			Optional().
+			Nillable(),
+		field.Int("request_timeout_seconds").
+			Range(1, 300).
+			Default(15),
+		field.Int("max_retries").
+			Range(0, 10).
+			Default(2),
+		field.Int("retry_backoff_seconds").
+			Range(0, 300).
+			Default(1),
+		field.Int("tick_interval_seconds").
+			Range(10, 3600).
+			Default(60),
+		field.Int("schedule_lookahead_seconds").
+			Range(0, 60).
+			Default(0),
+		field.Int("max_response_body_bytes").
+			Positive().
+			Optional().
+			Nillable(),
 		field.Time("updated_at").
 			Default(time.Now).
 			UpdateDefault(time.Now),
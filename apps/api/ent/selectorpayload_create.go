@@ -0,0 +1,246 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"goanna/apps/api/ent/selectorpayload"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// SelectorPayloadCreate is the builder for creating a SelectorPayload entity.
+type SelectorPayloadCreate struct {
+	config
+	mutation *SelectorPayloadMutation
+	hooks    []Hook
+}
+
+// SetToken sets the "token" field.
+func (_c *SelectorPayloadCreate) SetToken(v string) *SelectorPayloadCreate {
+	_c.mutation.SetToken(v)
+	return _c
+}
+
+// SetPayload sets the "payload" field.
+func (_c *SelectorPayloadCreate) SetPayload(v []byte) *SelectorPayloadCreate {
+	_c.mutation.SetPayload(v)
+	return _c
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_c *SelectorPayloadCreate) SetExpiresAt(v time.Time) *SelectorPayloadCreate {
+	_c.mutation.SetExpiresAt(v)
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *SelectorPayloadCreate) SetCreatedAt(v time.Time) *SelectorPayloadCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *SelectorPayloadCreate) SetNillableCreatedAt(v *time.Time) *SelectorPayloadCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// Mutation returns the SelectorPayloadMutation object of the builder.
+func (_c *SelectorPayloadCreate) Mutation() *SelectorPayloadMutation {
+	return _c.mutation
+}
+
+// Save creates the SelectorPayload in the database.
+func (_c *SelectorPayloadCreate) Save(ctx context.Context) (*SelectorPayload, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *SelectorPayloadCreate) SaveX(ctx context.Context) *SelectorPayload {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *SelectorPayloadCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *SelectorPayloadCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *SelectorPayloadCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := selectorpayload.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *SelectorPayloadCreate) check() error {
+	if _, ok := _c.mutation.Token(); !ok {
+		return &ValidationError{Name: "token", err: errors.New(`ent: missing required field "SelectorPayload.token"`)}
+	}
+	if v, ok := _c.mutation.Token(); ok {
+		if err := selectorpayload.TokenValidator(v); err != nil {
+			return &ValidationError{Name: "token", err: fmt.Errorf(`ent: validator failed for field "SelectorPayload.token": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Payload(); !ok {
+		return &ValidationError{Name: "payload", err: errors.New(`ent: missing required field "SelectorPayload.payload"`)}
+	}
+	if _, ok := _c.mutation.ExpiresAt(); !ok {
+		return &ValidationError{Name: "expires_at", err: errors.New(`ent: missing required field "SelectorPayload.expires_at"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "SelectorPayload.created_at"`)}
+	}
+	return nil
+}
+
+func (_c *SelectorPayloadCreate) sqlSave(ctx context.Context) (*SelectorPayload, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *SelectorPayloadCreate) createSpec() (*SelectorPayload, *sqlgraph.CreateSpec) {
+	var (
+		_node = &SelectorPayload{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(selectorpayload.Table, sqlgraph.NewFieldSpec(selectorpayload.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.Token(); ok {
+		_spec.SetField(selectorpayload.FieldToken, field.TypeString, value)
+		_node.Token = value
+	}
+	if value, ok := _c.mutation.Payload(); ok {
+		_spec.SetField(selectorpayload.FieldPayload, field.TypeBytes, value)
+		_node.Payload = value
+	}
+	if value, ok := _c.mutation.ExpiresAt(); ok {
+		_spec.SetField(selectorpayload.FieldExpiresAt, field.TypeTime, value)
+		_node.ExpiresAt = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(selectorpayload.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// SelectorPayloadCreateBulk is the builder for creating many SelectorPayload entities in bulk.
+type SelectorPayloadCreateBulk struct {
+	config
+	err      error
+	builders []*SelectorPayloadCreate
+}
+
+// Save creates the SelectorPayload entities in the database.
+func (_c *SelectorPayloadCreateBulk) Save(ctx context.Context) ([]*SelectorPayload, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*SelectorPayload, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*SelectorPayloadMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *SelectorPayloadCreateBulk) SaveX(ctx context.Context) []*SelectorPayload {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *SelectorPayloadCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *SelectorPayloadCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"goanna/apps/api/ent/predicate"
+	"goanna/apps/api/ent/selectorpayload"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// SelectorPayloadDelete is the builder for deleting a SelectorPayload entity.
+type SelectorPayloadDelete struct {
+	config
+	hooks    []Hook
+	mutation *SelectorPayloadMutation
+}
+
+// Where appends a list predicates to the SelectorPayloadDelete builder.
+func (_d *SelectorPayloadDelete) Where(ps ...predicate.SelectorPayload) *SelectorPayloadDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *SelectorPayloadDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *SelectorPayloadDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *SelectorPayloadDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(selectorpayload.Table, sqlgraph.NewFieldSpec(selectorpayload.FieldID, field.TypeInt))
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// SelectorPayloadDeleteOne is the builder for deleting a single SelectorPayload entity.
+type SelectorPayloadDeleteOne struct {
+	_d *SelectorPayloadDelete
+}
+
+// Where appends a list predicates to the SelectorPayloadDelete builder.
+func (_d *SelectorPayloadDeleteOne) Where(ps ...predicate.SelectorPayload) *SelectorPayloadDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *SelectorPayloadDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{selectorpayload.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *SelectorPayloadDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,288 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"goanna/apps/api/ent/predicate"
+	"goanna/apps/api/ent/selectorpayload"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// SelectorPayloadUpdate is the builder for updating SelectorPayload entities.
+type SelectorPayloadUpdate struct {
+	config
+	hooks    []Hook
+	mutation *SelectorPayloadMutation
+}
+
+// Where appends a list predicates to the SelectorPayloadUpdate builder.
+func (_u *SelectorPayloadUpdate) Where(ps ...predicate.SelectorPayload) *SelectorPayloadUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetToken sets the "token" field.
+func (_u *SelectorPayloadUpdate) SetToken(v string) *SelectorPayloadUpdate {
+	_u.mutation.SetToken(v)
+	return _u
+}
+
+// SetNillableToken sets the "token" field if the given value is not nil.
+func (_u *SelectorPayloadUpdate) SetNillableToken(v *string) *SelectorPayloadUpdate {
+	if v != nil {
+		_u.SetToken(*v)
+	}
+	return _u
+}
+
+// SetPayload sets the "payload" field.
+func (_u *SelectorPayloadUpdate) SetPayload(v []byte) *SelectorPayloadUpdate {
+	_u.mutation.SetPayload(v)
+	return _u
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_u *SelectorPayloadUpdate) SetExpiresAt(v time.Time) *SelectorPayloadUpdate {
+	_u.mutation.SetExpiresAt(v)
+	return _u
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (_u *SelectorPayloadUpdate) SetNillableExpiresAt(v *time.Time) *SelectorPayloadUpdate {
+	if v != nil {
+		_u.SetExpiresAt(*v)
+	}
+	return _u
+}
+
+// Mutation returns the SelectorPayloadMutation object of the builder.
+func (_u *SelectorPayloadUpdate) Mutation() *SelectorPayloadMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *SelectorPayloadUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *SelectorPayloadUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *SelectorPayloadUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *SelectorPayloadUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *SelectorPayloadUpdate) check() error {
+	if v, ok := _u.mutation.Token(); ok {
+		if err := selectorpayload.TokenValidator(v); err != nil {
+			return &ValidationError{Name: "token", err: fmt.Errorf(`ent: validator failed for field "SelectorPayload.token": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *SelectorPayloadUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(selectorpayload.Table, selectorpayload.Columns, sqlgraph.NewFieldSpec(selectorpayload.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Token(); ok {
+		_spec.SetField(selectorpayload.FieldToken, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Payload(); ok {
+		_spec.SetField(selectorpayload.FieldPayload, field.TypeBytes, value)
+	}
+	if value, ok := _u.mutation.ExpiresAt(); ok {
+		_spec.SetField(selectorpayload.FieldExpiresAt, field.TypeTime, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{selectorpayload.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// SelectorPayloadUpdateOne is the builder for updating a single SelectorPayload entity.
+type SelectorPayloadUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *SelectorPayloadMutation
+}
+
+// SetToken sets the "token" field.
+func (_u *SelectorPayloadUpdateOne) SetToken(v string) *SelectorPayloadUpdateOne {
+	_u.mutation.SetToken(v)
+	return _u
+}
+
+// SetNillableToken sets the "token" field if the given value is not nil.
+func (_u *SelectorPayloadUpdateOne) SetNillableToken(v *string) *SelectorPayloadUpdateOne {
+	if v != nil {
+		_u.SetToken(*v)
+	}
+	return _u
+}
+
+// SetPayload sets the "payload" field.
+func (_u *SelectorPayloadUpdateOne) SetPayload(v []byte) *SelectorPayloadUpdateOne {
+	_u.mutation.SetPayload(v)
+	return _u
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_u *SelectorPayloadUpdateOne) SetExpiresAt(v time.Time) *SelectorPayloadUpdateOne {
+	_u.mutation.SetExpiresAt(v)
+	return _u
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (_u *SelectorPayloadUpdateOne) SetNillableExpiresAt(v *time.Time) *SelectorPayloadUpdateOne {
+	if v != nil {
+		_u.SetExpiresAt(*v)
+	}
+	return _u
+}
+
+// Mutation returns the SelectorPayloadMutation object of the builder.
+func (_u *SelectorPayloadUpdateOne) Mutation() *SelectorPayloadMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the SelectorPayloadUpdate builder.
+func (_u *SelectorPayloadUpdateOne) Where(ps ...predicate.SelectorPayload) *SelectorPayloadUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *SelectorPayloadUpdateOne) Select(field string, fields ...string) *SelectorPayloadUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated SelectorPayload entity.
+func (_u *SelectorPayloadUpdateOne) Save(ctx context.Context) (*SelectorPayload, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *SelectorPayloadUpdateOne) SaveX(ctx context.Context) *SelectorPayload {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *SelectorPayloadUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *SelectorPayloadUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *SelectorPayloadUpdateOne) check() error {
+	if v, ok := _u.mutation.Token(); ok {
+		if err := selectorpayload.TokenValidator(v); err != nil {
+			return &ValidationError{Name: "token", err: fmt.Errorf(`ent: validator failed for field "SelectorPayload.token": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *SelectorPayloadUpdateOne) sqlSave(ctx context.Context) (_node *SelectorPayload, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(selectorpayload.Table, selectorpayload.Columns, sqlgraph.NewFieldSpec(selectorpayload.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "SelectorPayload.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, selectorpayload.FieldID)
+		for _, f := range fields {
+			if !selectorpayload.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != selectorpayload.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Token(); ok {
+		_spec.SetField(selectorpayload.FieldToken, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Payload(); ok {
+		_spec.SetField(selectorpayload.FieldPayload, field.TypeBytes, value)
+	}
+	if value, ok := _u.mutation.ExpiresAt(); ok {
+		_spec.SetField(selectorpayload.FieldExpiresAt, field.TypeTime, value)
+	}
+	_node = &SelectorPayload{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{selectorpayload.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
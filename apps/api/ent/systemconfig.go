@@ -3,6 +3,7 @@
 package ent
 
 import (
+	"encoding/json"
 	"fmt"
 	"goanna/apps/api/ent/systemconfig"
 	"strings"
@@ -23,6 +24,50 @@ type SystemConfig struct {
 	ChecksHistoryLimit int `json:"checks_history_limit,omitempty"`
 	// Timezone holds the value of the "timezone" field.
 	Timezone *string `json:"timezone,omitempty"`
+	// Paused holds the value of the "paused" field.
+	Paused bool `json:"paused,omitempty"`
+	// NotificationsPaused holds the value of the "notifications_paused" field.
+	NotificationsPaused bool `json:"notifications_paused,omitempty"`
+	// PausedAt holds the value of the "paused_at" field.
+	PausedAt *time.Time `json:"paused_at,omitempty"`
+	// StaleAfterDays holds the value of the "stale_after_days" field.
+	StaleAfterDays int `json:"stale_after_days,omitempty"`
+	// StaleNotifications holds the value of the "stale_notifications" field.
+	StaleNotifications bool `json:"stale_notifications,omitempty"`
+	// StaleNotifiedAt holds the value of the "stale_notified_at" field.
+	StaleNotifiedAt *time.Time `json:"stale_notified_at,omitempty"`
+	// ScheduleJitterSeconds holds the value of the "schedule_jitter_seconds" field.
+	ScheduleJitterSeconds int `json:"schedule_jitter_seconds,omitempty"`
+	// CircuitBreakerThreshold holds the value of the "circuit_breaker_threshold" field.
+	CircuitBreakerThreshold int `json:"circuit_breaker_threshold,omitempty"`
+	// CircuitBreakerAction holds the value of the "circuit_breaker_action" field.
+	CircuitBreakerAction systemconfig.CircuitBreakerAction `json:"circuit_breaker_action,omitempty"`
+	// CircuitBreakerBackoffMinutes holds the value of the "circuit_breaker_backoff_minutes" field.
+	CircuitBreakerBackoffMinutes int `json:"circuit_breaker_backoff_minutes,omitempty"`
+	// CatchUpPolicy holds the value of the "catch_up_policy" field.
+	CatchUpPolicy systemconfig.CatchUpPolicy `json:"catch_up_policy,omitempty"`
+	// CatchUpMaxAgeMinutes holds the value of the "catch_up_max_age_minutes" field.
+	CatchUpMaxAgeMinutes int `json:"catch_up_max_age_minutes,omitempty"`
+	// DefaultUserAgent holds the value of the "default_user_agent" field.
+	DefaultUserAgent *string `json:"default_user_agent,omitempty"`
+	// DefaultHeaders holds the value of the "default_headers" field.
+	DefaultHeaders map[string]string `json:"default_headers,omitempty"`
+	// ProxyURL holds the value of the "proxy_url" field.
+	ProxyURL *string `json:"proxy_url,omitempty"`
+	// ProxyBypass holds the value of the "proxy_bypass" field.
+	ProxyBypass *string `json:"proxy_bypass,omitempty"`
+	// RequestTimeoutSeconds holds the value of the "request_timeout_seconds" field.
+	RequestTimeoutSeconds int `json:"request_timeout_seconds,omitempty"`
+	// MaxRetries holds the value of the "max_retries" field.
+	MaxRetries int `json:"max_retries,omitempty"`
+	// RetryBackoffSeconds holds the value of the "retry_backoff_seconds" field.
+	RetryBackoffSeconds int `json:"retry_backoff_seconds,omitempty"`
+	// TickIntervalSeconds holds the value of the "tick_interval_seconds" field.
+	TickIntervalSeconds int `json:"tick_interval_seconds,omitempty"`
+	// ScheduleLookaheadSeconds holds the value of the "schedule_lookahead_seconds" field.
+	ScheduleLookaheadSeconds int `json:"schedule_lookahead_seconds,omitempty"`
+	// MaxResponseBodyBytes holds the value of the "max_response_body_bytes" field.
+	MaxResponseBodyBytes *int `json:"max_response_body_bytes,omitempty"`
 	// UpdatedAt holds the value of the "updated_at" field.
 	UpdatedAt    time.Time `json:"updated_at,omitempty"`
 	selectValues sql.SelectValues
@@ -33,11 +78,15 @@ func (*SystemConfig) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case systemconfig.FieldID, systemconfig.FieldChecksHistoryLimit:
+		case systemconfig.FieldDefaultHeaders:
+			values[i] = new([]byte)
+		case systemconfig.FieldPaused, systemconfig.FieldNotificationsPaused, systemconfig.FieldStaleNotifications:
+			values[i] = new(sql.NullBool)
+		case systemconfig.FieldID, systemconfig.FieldChecksHistoryLimit, systemconfig.FieldStaleAfterDays, systemconfig.FieldScheduleJitterSeconds, systemconfig.FieldCircuitBreakerThreshold, systemconfig.FieldCircuitBreakerBackoffMinutes, systemconfig.FieldCatchUpMaxAgeMinutes, systemconfig.FieldRequestTimeoutSeconds, systemconfig.FieldMaxRetries, systemconfig.FieldRetryBackoffSeconds, systemconfig.FieldTickIntervalSeconds, systemconfig.FieldScheduleLookaheadSeconds, systemconfig.FieldMaxResponseBodyBytes:
 			values[i] = new(sql.NullInt64)
-		case systemconfig.FieldKey, systemconfig.FieldTimezone:
+		case systemconfig.FieldKey, systemconfig.FieldTimezone, systemconfig.FieldCircuitBreakerAction, systemconfig.FieldCatchUpPolicy, systemconfig.FieldDefaultUserAgent, systemconfig.FieldProxyURL, systemconfig.FieldProxyBypass:
 			values[i] = new(sql.NullString)
-		case systemconfig.FieldUpdatedAt:
+		case systemconfig.FieldPausedAt, systemconfig.FieldStaleNotifiedAt, systemconfig.FieldUpdatedAt:
 			values[i] = new(sql.NullTime)
 		default:
 			values[i] = new(sql.UnknownType)
@@ -79,6 +128,146 @@ func (_m *SystemConfig) assignValues(columns []string, values []any) error {
 				_m.Timezone = new(string)
 				*_m.Timezone = value.String
 			}
+		case systemconfig.FieldPaused:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field paused", values[i])
+			} else if value.Valid {
+				_m.Paused = value.Bool
+			}
+		case systemconfig.FieldNotificationsPaused:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field notifications_paused", values[i])
+			} else if value.Valid {
+				_m.NotificationsPaused = value.Bool
+			}
+		case systemconfig.FieldPausedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field paused_at", values[i])
+			} else if value.Valid {
+				_m.PausedAt = new(time.Time)
+				*_m.PausedAt = value.Time
+			}
+		case systemconfig.FieldStaleAfterDays:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field stale_after_days", values[i])
+			} else if value.Valid {
+				_m.StaleAfterDays = int(value.Int64)
+			}
+		case systemconfig.FieldStaleNotifications:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field stale_notifications", values[i])
+			} else if value.Valid {
+				_m.StaleNotifications = value.Bool
+			}
+		case systemconfig.FieldStaleNotifiedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field stale_notified_at", values[i])
+			} else if value.Valid {
+				_m.StaleNotifiedAt = new(time.Time)
+				*_m.StaleNotifiedAt = value.Time
+			}
+		case systemconfig.FieldScheduleJitterSeconds:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field schedule_jitter_seconds", values[i])
+			} else if value.Valid {
+				_m.ScheduleJitterSeconds = int(value.Int64)
+			}
+		case systemconfig.FieldCircuitBreakerThreshold:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field circuit_breaker_threshold", values[i])
+			} else if value.Valid {
+				_m.CircuitBreakerThreshold = int(value.Int64)
+			}
+		case systemconfig.FieldCircuitBreakerAction:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field circuit_breaker_action", values[i])
+			} else if value.Valid {
+				_m.CircuitBreakerAction = systemconfig.CircuitBreakerAction(value.String)
+			}
+		case systemconfig.FieldCircuitBreakerBackoffMinutes:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field circuit_breaker_backoff_minutes", values[i])
+			} else if value.Valid {
+				_m.CircuitBreakerBackoffMinutes = int(value.Int64)
+			}
+		case systemconfig.FieldCatchUpPolicy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field catch_up_policy", values[i])
+			} else if value.Valid {
+				_m.CatchUpPolicy = systemconfig.CatchUpPolicy(value.String)
+			}
+		case systemconfig.FieldCatchUpMaxAgeMinutes:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field catch_up_max_age_minutes", values[i])
+			} else if value.Valid {
+				_m.CatchUpMaxAgeMinutes = int(value.Int64)
+			}
+		case systemconfig.FieldDefaultUserAgent:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field default_user_agent", values[i])
+			} else if value.Valid {
+				_m.DefaultUserAgent = new(string)
+				*_m.DefaultUserAgent = value.String
+			}
+		case systemconfig.FieldDefaultHeaders:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field default_headers", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.DefaultHeaders); err != nil {
+					return fmt.Errorf("unmarshal field default_headers: %w", err)
+				}
+			}
+		case systemconfig.FieldProxyURL:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field proxy_url", values[i])
+			} else if value.Valid {
+				_m.ProxyURL = new(string)
+				*_m.ProxyURL = value.String
+			}
+		case systemconfig.FieldProxyBypass:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field proxy_bypass", values[i])
+			} else if value.Valid {
+				_m.ProxyBypass = new(string)
+				*_m.ProxyBypass = value.String
+			}
+		case systemconfig.FieldRequestTimeoutSeconds:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field request_timeout_seconds", values[i])
+			} else if value.Valid {
+				_m.RequestTimeoutSeconds = int(value.Int64)
+			}
+		case systemconfig.FieldMaxRetries:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field max_retries", values[i])
+			} else if value.Valid {
+				_m.MaxRetries = int(value.Int64)
+			}
+		case systemconfig.FieldRetryBackoffSeconds:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field retry_backoff_seconds", values[i])
+			} else if value.Valid {
+				_m.RetryBackoffSeconds = int(value.Int64)
+			}
+		case systemconfig.FieldTickIntervalSeconds:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field tick_interval_seconds", values[i])
+			} else if value.Valid {
+				_m.TickIntervalSeconds = int(value.Int64)
+			}
+		case systemconfig.FieldScheduleLookaheadSeconds:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field schedule_lookahead_seconds", values[i])
+			} else if value.Valid {
+				_m.ScheduleLookaheadSeconds = int(value.Int64)
+			}
+		case systemconfig.FieldMaxResponseBodyBytes:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field max_response_body_bytes", values[i])
+			} else if value.Valid {
+				_m.MaxResponseBodyBytes = new(int)
+				*_m.MaxResponseBodyBytes = int(value.Int64)
+			}
 		case systemconfig.FieldUpdatedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
@@ -132,6 +321,84 @@ func (_m *SystemConfig) String() string {
 		builder.WriteString(*v)
 	}
 	builder.WriteString(", ")
+	builder.WriteString("paused=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Paused))
+	builder.WriteString(", ")
+	builder.WriteString("notifications_paused=")
+	builder.WriteString(fmt.Sprintf("%v", _m.NotificationsPaused))
+	builder.WriteString(", ")
+	if v := _m.PausedAt; v != nil {
+		builder.WriteString("paused_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("stale_after_days=")
+	builder.WriteString(fmt.Sprintf("%v", _m.StaleAfterDays))
+	builder.WriteString(", ")
+	builder.WriteString("stale_notifications=")
+	builder.WriteString(fmt.Sprintf("%v", _m.StaleNotifications))
+	builder.WriteString(", ")
+	if v := _m.StaleNotifiedAt; v != nil {
+		builder.WriteString("stale_notified_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("schedule_jitter_seconds=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ScheduleJitterSeconds))
+	builder.WriteString(", ")
+	builder.WriteString("circuit_breaker_threshold=")
+	builder.WriteString(fmt.Sprintf("%v", _m.CircuitBreakerThreshold))
+	builder.WriteString(", ")
+	builder.WriteString("circuit_breaker_action=")
+	builder.WriteString(fmt.Sprintf("%v", _m.CircuitBreakerAction))
+	builder.WriteString(", ")
+	builder.WriteString("circuit_breaker_backoff_minutes=")
+	builder.WriteString(fmt.Sprintf("%v", _m.CircuitBreakerBackoffMinutes))
+	builder.WriteString(", ")
+	builder.WriteString("catch_up_policy=")
+	builder.WriteString(fmt.Sprintf("%v", _m.CatchUpPolicy))
+	builder.WriteString(", ")
+	builder.WriteString("catch_up_max_age_minutes=")
+	builder.WriteString(fmt.Sprintf("%v", _m.CatchUpMaxAgeMinutes))
+	builder.WriteString(", ")
+	if v := _m.DefaultUserAgent; v != nil {
+		builder.WriteString("default_user_agent=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("default_headers=")
+	builder.WriteString(fmt.Sprintf("%v", _m.DefaultHeaders))
+	builder.WriteString(", ")
+	if v := _m.ProxyURL; v != nil {
+		builder.WriteString("proxy_url=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := _m.ProxyBypass; v != nil {
+		builder.WriteString("proxy_bypass=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("request_timeout_seconds=")
+	builder.WriteString(fmt.Sprintf("%v", _m.RequestTimeoutSeconds))
+	builder.WriteString(", ")
+	builder.WriteString("max_retries=")
+	builder.WriteString(fmt.Sprintf("%v", _m.MaxRetries))
+	builder.WriteString(", ")
+	builder.WriteString("retry_backoff_seconds=")
+	builder.WriteString(fmt.Sprintf("%v", _m.RetryBackoffSeconds))
+	builder.WriteString(", ")
+	builder.WriteString("tick_interval_seconds=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TickIntervalSeconds))
+	builder.WriteString(", ")
+	builder.WriteString("schedule_lookahead_seconds=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ScheduleLookaheadSeconds))
+	builder.WriteString(", ")
+	if v := _m.MaxResponseBodyBytes; v != nil {
+		builder.WriteString("max_response_body_bytes=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
 	builder.WriteString("updated_at=")
 	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
 	builder.WriteByte(')')
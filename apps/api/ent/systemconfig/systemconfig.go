@@ -3,6 +3,7 @@
 package systemconfig
 
 import (
+	"fmt"
 	"time"
 
 	"entgo.io/ent/dialect/sql"
@@ -19,6 +20,50 @@ const (
 	FieldChecksHistoryLimit = "checks_history_limit"
 	// FieldTimezone holds the string denoting the timezone field in the database.
 	FieldTimezone = "timezone"
+	// FieldPaused holds the string denoting the paused field in the database.
+	FieldPaused = "paused"
+	// FieldNotificationsPaused holds the string denoting the notifications_paused field in the database.
+	FieldNotificationsPaused = "notifications_paused"
+	// FieldPausedAt holds the string denoting the paused_at field in the database.
+	FieldPausedAt = "paused_at"
+	// FieldStaleAfterDays holds the string denoting the stale_after_days field in the database.
+	FieldStaleAfterDays = "stale_after_days"
+	// FieldStaleNotifications holds the string denoting the stale_notifications field in the database.
+	FieldStaleNotifications = "stale_notifications"
+	// FieldStaleNotifiedAt holds the string denoting the stale_notified_at field in the database.
+	FieldStaleNotifiedAt = "stale_notified_at"
+	// FieldScheduleJitterSeconds holds the string denoting the schedule_jitter_seconds field in the database.
+	FieldScheduleJitterSeconds = "schedule_jitter_seconds"
+	// FieldCircuitBreakerThreshold holds the string denoting the circuit_breaker_threshold field in the database.
+	FieldCircuitBreakerThreshold = "circuit_breaker_threshold"
+	// FieldCircuitBreakerAction holds the string denoting the circuit_breaker_action field in the database.
+	FieldCircuitBreakerAction = "circuit_breaker_action"
+	// FieldCircuitBreakerBackoffMinutes holds the string denoting the circuit_breaker_backoff_minutes field in the database.
+	FieldCircuitBreakerBackoffMinutes = "circuit_breaker_backoff_minutes"
+	// FieldCatchUpPolicy holds the string denoting the catch_up_policy field in the database.
+	FieldCatchUpPolicy = "catch_up_policy"
+	// FieldCatchUpMaxAgeMinutes holds the string denoting the catch_up_max_age_minutes field in the database.
+	FieldCatchUpMaxAgeMinutes = "catch_up_max_age_minutes"
+	// FieldDefaultUserAgent holds the string denoting the default_user_agent field in the database.
+	FieldDefaultUserAgent = "default_user_agent"
+	// FieldDefaultHeaders holds the string denoting the default_headers field in the database.
+	FieldDefaultHeaders = "default_headers"
+	// FieldProxyURL holds the string denoting the proxy_url field in the database.
+	FieldProxyURL = "proxy_url"
+	// FieldProxyBypass holds the string denoting the proxy_bypass field in the database.
+	FieldProxyBypass = "proxy_bypass"
+	// FieldRequestTimeoutSeconds holds the string denoting the request_timeout_seconds field in the database.
+	FieldRequestTimeoutSeconds = "request_timeout_seconds"
+	// FieldMaxRetries holds the string denoting the max_retries field in the database.
+	FieldMaxRetries = "max_retries"
+	// FieldRetryBackoffSeconds holds the string denoting the retry_backoff_seconds field in the database.
+	FieldRetryBackoffSeconds = "retry_backoff_seconds"
+	// FieldTickIntervalSeconds holds the string denoting the tick_interval_seconds field in the database.
+	FieldTickIntervalSeconds = "tick_interval_seconds"
+	// FieldScheduleLookaheadSeconds holds the string denoting the schedule_lookahead_seconds field in the database.
+	FieldScheduleLookaheadSeconds = "schedule_lookahead_seconds"
+	// FieldMaxResponseBodyBytes holds the string denoting the max_response_body_bytes field in the database.
+	FieldMaxResponseBodyBytes = "max_response_body_bytes"
 	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
 	FieldUpdatedAt = "updated_at"
 	// Table holds the table name of the systemconfig in the database.
@@ -31,6 +76,28 @@ var Columns = []string{
 	FieldKey,
 	FieldChecksHistoryLimit,
 	FieldTimezone,
+	FieldPaused,
+	FieldNotificationsPaused,
+	FieldPausedAt,
+	FieldStaleAfterDays,
+	FieldStaleNotifications,
+	FieldStaleNotifiedAt,
+	FieldScheduleJitterSeconds,
+	FieldCircuitBreakerThreshold,
+	FieldCircuitBreakerAction,
+	FieldCircuitBreakerBackoffMinutes,
+	FieldCatchUpPolicy,
+	FieldCatchUpMaxAgeMinutes,
+	FieldDefaultUserAgent,
+	FieldDefaultHeaders,
+	FieldProxyURL,
+	FieldProxyBypass,
+	FieldRequestTimeoutSeconds,
+	FieldMaxRetries,
+	FieldRetryBackoffSeconds,
+	FieldTickIntervalSeconds,
+	FieldScheduleLookaheadSeconds,
+	FieldMaxResponseBodyBytes,
 	FieldUpdatedAt,
 }
 
@@ -51,12 +118,113 @@ var (
 	DefaultChecksHistoryLimit int
 	// ChecksHistoryLimitValidator is a validator for the "checks_history_limit" field. It is called by the builders before save.
 	ChecksHistoryLimitValidator func(int) error
+	// DefaultPaused holds the default value on creation for the "paused" field.
+	DefaultPaused bool
+	// DefaultNotificationsPaused holds the default value on creation for the "notifications_paused" field.
+	DefaultNotificationsPaused bool
+	// DefaultStaleAfterDays holds the default value on creation for the "stale_after_days" field.
+	DefaultStaleAfterDays int
+	// StaleAfterDaysValidator is a validator for the "stale_after_days" field. It is called by the builders before save.
+	StaleAfterDaysValidator func(int) error
+	// DefaultStaleNotifications holds the default value on creation for the "stale_notifications" field.
+	DefaultStaleNotifications bool
+	// DefaultScheduleJitterSeconds holds the default value on creation for the "schedule_jitter_seconds" field.
+	DefaultScheduleJitterSeconds int
+	// ScheduleJitterSecondsValidator is a validator for the "schedule_jitter_seconds" field. It is called by the builders before save.
+	ScheduleJitterSecondsValidator func(int) error
+	// DefaultCircuitBreakerThreshold holds the default value on creation for the "circuit_breaker_threshold" field.
+	DefaultCircuitBreakerThreshold int
+	// CircuitBreakerThresholdValidator is a validator for the "circuit_breaker_threshold" field. It is called by the builders before save.
+	CircuitBreakerThresholdValidator func(int) error
+	// DefaultCircuitBreakerBackoffMinutes holds the default value on creation for the "circuit_breaker_backoff_minutes" field.
+	DefaultCircuitBreakerBackoffMinutes int
+	// CircuitBreakerBackoffMinutesValidator is a validator for the "circuit_breaker_backoff_minutes" field. It is called by the builders before save.
+	CircuitBreakerBackoffMinutesValidator func(int) error
+	// DefaultCatchUpMaxAgeMinutes holds the default value on creation for the "catch_up_max_age_minutes" field.
+	DefaultCatchUpMaxAgeMinutes int
+	// CatchUpMaxAgeMinutesValidator is a validator for the "catch_up_max_age_minutes" field. It is called by the builders before save.
+	CatchUpMaxAgeMinutesValidator func(int) error
+	// DefaultRequestTimeoutSeconds holds the default value on creation for the "request_timeout_seconds" field.
+	DefaultRequestTimeoutSeconds int
+	// RequestTimeoutSecondsValidator is a validator for the "request_timeout_seconds" field. It is called by the builders before save.
+	RequestTimeoutSecondsValidator func(int) error
+	// DefaultMaxRetries holds the default value on creation for the "max_retries" field.
+	DefaultMaxRetries int
+	// MaxRetriesValidator is a validator for the "max_retries" field. It is called by the builders before save.
+	MaxRetriesValidator func(int) error
+	// DefaultRetryBackoffSeconds holds the default value on creation for the "retry_backoff_seconds" field.
+	DefaultRetryBackoffSeconds int
+	// RetryBackoffSecondsValidator is a validator for the "retry_backoff_seconds" field. It is called by the builders before save.
+	RetryBackoffSecondsValidator func(int) error
+	// DefaultTickIntervalSeconds holds the default value on creation for the "tick_interval_seconds" field.
+	DefaultTickIntervalSeconds int
+	// TickIntervalSecondsValidator is a validator for the "tick_interval_seconds" field. It is called by the builders before save.
+	TickIntervalSecondsValidator func(int) error
+	// DefaultScheduleLookaheadSeconds holds the default value on creation for the "schedule_lookahead_seconds" field.
+	DefaultScheduleLookaheadSeconds int
+	// ScheduleLookaheadSecondsValidator is a validator for the "schedule_lookahead_seconds" field. It is called by the builders before save.
+	ScheduleLookaheadSecondsValidator func(int) error
+	// MaxResponseBodyBytesValidator is a validator for the "max_response_body_bytes" field. It is called by the builders before save.
+	MaxResponseBodyBytesValidator func(int) error
 	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
 	DefaultUpdatedAt func() time.Time
 	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
 	UpdateDefaultUpdatedAt func() time.Time
 )
 
+// CircuitBreakerAction defines the type for the "circuit_breaker_action" enum field.
+type CircuitBreakerAction string
+
+// CircuitBreakerActionBackoff is the default value of the CircuitBreakerAction enum.
+const DefaultCircuitBreakerAction = CircuitBreakerActionBackoff
+
+// CircuitBreakerAction values.
+const (
+	CircuitBreakerActionBackoff CircuitBreakerAction = "backoff"
+	CircuitBreakerActionSuspend CircuitBreakerAction = "suspend"
+)
+
+func (cba CircuitBreakerAction) String() string {
+	return string(cba)
+}
+
+// CircuitBreakerActionValidator is a validator for the "circuit_breaker_action" field enum values. It is called by the builders before save.
+func CircuitBreakerActionValidator(cba CircuitBreakerAction) error {
+	switch cba {
+	case CircuitBreakerActionBackoff, CircuitBreakerActionSuspend:
+		return nil
+	default:
+		return fmt.Errorf("systemconfig: invalid enum value for circuit_breaker_action field: %q", cba)
+	}
+}
+
+// CatchUpPolicy defines the type for the "catch_up_policy" enum field.
+type CatchUpPolicy string
+
+// CatchUpPolicyRunLatestOnly is the default value of the CatchUpPolicy enum.
+const DefaultCatchUpPolicy = CatchUpPolicyRunLatestOnly
+
+// CatchUpPolicy values.
+const (
+	CatchUpPolicyRunAllMissed  CatchUpPolicy = "run_all_missed"
+	CatchUpPolicyRunLatestOnly CatchUpPolicy = "run_latest_only"
+	CatchUpPolicySkip          CatchUpPolicy = "skip"
+)
+
+func (cup CatchUpPolicy) String() string {
+	return string(cup)
+}
+
+// CatchUpPolicyValidator is a validator for the "catch_up_policy" field enum values. It is called by the builders before save.
+func CatchUpPolicyValidator(cup CatchUpPolicy) error {
+	switch cup {
+	case CatchUpPolicyRunAllMissed, CatchUpPolicyRunLatestOnly, CatchUpPolicySkip:
+		return nil
+	default:
+		return fmt.Errorf("systemconfig: invalid enum value for catch_up_policy field: %q", cup)
+	}
+}
+
 // OrderOption defines the ordering options for the SystemConfig queries.
 type OrderOption func(*sql.Selector)
 
@@ -80,6 +248,111 @@ func ByTimezone(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldTimezone, opts...).ToFunc()
 }
 
+// ByPaused orders the results by the paused field.
+func ByPaused(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPaused, opts...).ToFunc()
+}
+
+// ByNotificationsPaused orders the results by the notifications_paused field.
+func ByNotificationsPaused(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldNotificationsPaused, opts...).ToFunc()
+}
+
+// ByPausedAt orders the results by the paused_at field.
+func ByPausedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPausedAt, opts...).ToFunc()
+}
+
+// ByStaleAfterDays orders the results by the stale_after_days field.
+func ByStaleAfterDays(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStaleAfterDays, opts...).ToFunc()
+}
+
+// ByStaleNotifications orders the results by the stale_notifications field.
+func ByStaleNotifications(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStaleNotifications, opts...).ToFunc()
+}
+
+// ByStaleNotifiedAt orders the results by the stale_notified_at field.
+func ByStaleNotifiedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStaleNotifiedAt, opts...).ToFunc()
+}
+
+// ByScheduleJitterSeconds orders the results by the schedule_jitter_seconds field.
+func ByScheduleJitterSeconds(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldScheduleJitterSeconds, opts...).ToFunc()
+}
+
+// ByCircuitBreakerThreshold orders the results by the circuit_breaker_threshold field.
+func ByCircuitBreakerThreshold(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCircuitBreakerThreshold, opts...).ToFunc()
+}
+
+// ByCircuitBreakerAction orders the results by the circuit_breaker_action field.
+func ByCircuitBreakerAction(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCircuitBreakerAction, opts...).ToFunc()
+}
+
+// ByCircuitBreakerBackoffMinutes orders the results by the circuit_breaker_backoff_minutes field.
+func ByCircuitBreakerBackoffMinutes(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCircuitBreakerBackoffMinutes, opts...).ToFunc()
+}
+
+// ByCatchUpPolicy orders the results by the catch_up_policy field.
+func ByCatchUpPolicy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCatchUpPolicy, opts...).ToFunc()
+}
+
+// ByCatchUpMaxAgeMinutes orders the results by the catch_up_max_age_minutes field.
+func ByCatchUpMaxAgeMinutes(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCatchUpMaxAgeMinutes, opts...).ToFunc()
+}
+
+// ByDefaultUserAgent orders the results by the default_user_agent field.
+func ByDefaultUserAgent(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDefaultUserAgent, opts...).ToFunc()
+}
+
+// ByProxyURL orders the results by the proxy_url field.
+func ByProxyURL(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldProxyURL, opts...).ToFunc()
+}
+
+// ByProxyBypass orders the results by the proxy_bypass field.
+func ByProxyBypass(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldProxyBypass, opts...).ToFunc()
+}
+
+// ByRequestTimeoutSeconds orders the results by the request_timeout_seconds field.
+func ByRequestTimeoutSeconds(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRequestTimeoutSeconds, opts...).ToFunc()
+}
+
+// ByMaxRetries orders the results by the max_retries field.
+func ByMaxRetries(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMaxRetries, opts...).ToFunc()
+}
+
+// ByRetryBackoffSeconds orders the results by the retry_backoff_seconds field.
+func ByRetryBackoffSeconds(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRetryBackoffSeconds, opts...).ToFunc()
+}
+
+// ByTickIntervalSeconds orders the results by the tick_interval_seconds field.
+func ByTickIntervalSeconds(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTickIntervalSeconds, opts...).ToFunc()
+}
+
+// ByScheduleLookaheadSeconds orders the results by the schedule_lookahead_seconds field.
+func ByScheduleLookaheadSeconds(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldScheduleLookaheadSeconds, opts...).ToFunc()
+}
+
+// ByMaxResponseBodyBytes orders the results by the max_response_body_bytes field.
+func ByMaxResponseBodyBytes(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMaxResponseBodyBytes, opts...).ToFunc()
+}
+
 // ByUpdatedAt orders the results by the updated_at field.
 func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
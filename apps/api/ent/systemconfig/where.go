@@ -69,6 +69,101 @@ func Timezone(v string) predicate.SystemConfig {
 	return predicate.SystemConfig(sql.FieldEQ(FieldTimezone, v))
 }
 
+// Paused applies equality check predicate on the "paused" field. It's identical to PausedEQ.
+func Paused(v bool) predicate.SystemConfig {
+	return predicate.SystemConfig(sql.FieldEQ(FieldPaused, v))
+}
+
+// NotificationsPaused applies equality check predicate on the "notifications_paused" field. It's identical to NotificationsPausedEQ.
+func NotificationsPaused(v bool) predicate.SystemConfig {
+	return predicate.SystemConfig(sql.FieldEQ(FieldNotificationsPaused, v))
+}
+
+// PausedAt applies equality check predicate on the "paused_at" field. It's identical to PausedAtEQ.
+func PausedAt(v time.Time) predicate.SystemConfig {
+	return predicate.SystemConfig(sql.FieldEQ(FieldPausedAt, v))
+}
+
+// StaleAfterDays applies equality check predicate on the "stale_after_days" field. It's identical to StaleAfterDaysEQ.
+func StaleAfterDays(v int) predicate.SystemConfig {
+	return predicate.SystemConfig(sql.FieldEQ(FieldStaleAfterDays, v))
+}
+
+// StaleNotifications applies equality check predicate on the "stale_notifications" field. It's identical to StaleNotificationsEQ.
+func StaleNotifications(v bool) predicate.SystemConfig {
+	return predicate.SystemConfig(sql.FieldEQ(FieldStaleNotifications, v))
+}
+
+// StaleNotifiedAt applies equality check predicate on the "stale_notified_at" field. It's identical to StaleNotifiedAtEQ.
+func StaleNotifiedAt(v time.Time) predicate.SystemConfig {
+	return predicate.SystemConfig(sql.FieldEQ(FieldStaleNotifiedAt, v))
+}
+
+// ScheduleJitterSeconds applies equality check predicate on the "schedule_jitter_seconds" field. It's identical to ScheduleJitterSecondsEQ.
+func ScheduleJitterSeconds(v int) predicate.SystemConfig {
+	return predicate.SystemConfig(sql.FieldEQ(FieldScheduleJitterSeconds, v))
+}
+
+// CircuitBreakerThreshold applies equality check predicate on the "circuit_breaker_threshold" field. It's identical to CircuitBreakerThresholdEQ.
+func CircuitBreakerThreshold(v int) predicate.SystemConfig {
+	return predicate.SystemConfig(sql.FieldEQ(FieldCircuitBreakerThreshold, v))
+}
+
+// CircuitBreakerBackoffMinutes applies equality check predicate on the "circuit_breaker_backoff_minutes" field. It's identical to CircuitBreakerBackoffMinutesEQ.
+func CircuitBreakerBackoffMinutes(v int) predicate.SystemConfig {
+	return predicate.SystemConfig(sql.FieldEQ(FieldCircuitBreakerBackoffMinutes, v))
+}
+
+// CatchUpMaxAgeMinutes applies equality check predicate on the "catch_up_max_age_minutes" field. It's identical to CatchUpMaxAgeMinutesEQ.
+func CatchUpMaxAgeMinutes(v int) predicate.SystemConfig {
+	return predicate.SystemConfig(sql.FieldEQ(FieldCatchUpMaxAgeMinutes, v))
+}
+
+// DefaultUserAgent applies equality check predicate on the "default_user_agent" field. It's identical to DefaultUserAgentEQ.
+func DefaultUserAgent(v string) predicate.SystemConfig {
+	return predicate.SystemConfig(sql.FieldEQ(FieldDefaultUserAgent, v))
+}
+
+// ProxyURL applies equality check predicate on the "proxy_url" field. It's identical to ProxyURLEQ.
+func ProxyURL(v string) predicate.SystemConfig {
+	return predicate.SystemConfig(sql.FieldEQ(FieldProxyURL, v))
+}
+
+// ProxyBypass applies equality check predicate on the "proxy_bypass" field. It's identical to ProxyBypassEQ.
+func ProxyBypass(v string) predicate.SystemConfig {
+	return predicate.SystemConfig(sql.FieldEQ(FieldProxyBypass, v))
+}
+
+// RequestTimeoutSeconds applies equality check predicate on the "request_timeout_seconds" field. It's identical to RequestTimeoutSecondsEQ.
+func RequestTimeoutSeconds(v int) predicate.SystemConfig {
+	return predicate.SystemConfig(sql.FieldEQ(FieldRequestTimeoutSeconds, v))
+}
+
+// MaxRetries applies equality check predicate on the "max_retries" field. It's identical to MaxRetriesEQ.
+func MaxRetries(v int) predicate.SystemConfig {
+	return predicate.SystemConfig(sql.FieldEQ(FieldMaxRetries, v))
+}
+
+// RetryBackoffSeconds applies equality check predicate on the "retry_backoff_seconds" field. It's identical to RetryBackoffSecondsEQ.
+func RetryBackoffSeconds(v int) predicate.SystemConfig {
+	return predicate.SystemConfig(sql.FieldEQ(FieldRetryBackoffSeconds, v))
+}
+
+// TickIntervalSeconds applies equality check predicate on the "tick_interval_seconds" field. It's identical to TickIntervalSecondsEQ.
+func TickIntervalSeconds(v int) predicate.SystemConfig {
+	return predicate.SystemConfig(sql.FieldEQ(FieldTickIntervalSeconds, v))
+}
+
+// ScheduleLookaheadSeconds applies equality check predicate on the "schedule_lookahead_seconds" field. It's identical to ScheduleLookaheadSecondsEQ.
+func ScheduleLookaheadSeconds(v int) predicate.SystemConfig {
+	return predicate.SystemConfig(sql.FieldEQ(FieldScheduleLookaheadSeconds, v))
+}
+
+// MaxResponseBodyBytes applies equality check predicate on the "max_response_body_bytes" field. It's identical to MaxResponseBodyBytesEQ.
+func MaxResponseBodyBytes(v int) predicate.SystemConfig {
+	return predicate.SystemConfig(sql.FieldEQ(FieldMaxResponseBodyBytes, v))
+}
+
 // UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
 func UpdatedAt(v time.Time) predicate.SystemConfig {
 	return predicate.SystemConfig(sql.FieldEQ(FieldUpdatedAt, v))
@@ -254,6 +349,861 @@ func TimezoneContainsFold(v string) predicate.SystemConfig {
 	return predicate.SystemConfig(sql.FieldContainsFold(FieldTimezone, v))
 }
 
+// PausedEQ applies the EQ predicate on the "paused" field.
+func PausedEQ(v bool) predicate.SystemConfig {
+	return predicate.SystemConfig(sql.FieldEQ(FieldPaused, v))
+}
+
+// PausedNEQ applies the NEQ predicate on the "paused" field.
+func PausedNEQ(v bool) predicate.SystemConfig {
+	return predicate.SystemConfig(sql.FieldNEQ(FieldPaused, v))
+}
+
+// NotificationsPausedEQ applies the EQ predicate on the "notifications_paused" field.
+func NotificationsPausedEQ(v bool) predicate.SystemConfig {
+	return predicate.SystemConfig(sql.FieldEQ(FieldNotificationsPaused, v))
+}
+
+// NotificationsPausedNEQ applies the NEQ predicate on the "notifications_paused" field.
+func NotificationsPausedNEQ(v bool) predicate.SystemConfig {
+	return predicate.SystemConfig(sql.FieldNEQ(FieldNotificationsPaused, v))
+}
+
+// PausedAtEQ applies the EQ predicate on the "paused_at" field.
+func PausedAtEQ(v time.Time) predicate.SystemConfig {
+	return predicate.SystemConfig(sql.FieldEQ(FieldPausedAt, v))
+}
+
+// PausedAtNEQ applies the NEQ predicate on the "paused_at" field.
+func PausedAtNEQ(v time.Time) predicate.SystemConfig {
+	return predicate.SystemConfig(sql.FieldNEQ(FieldPausedAt, v))
+}
+
+// PausedAtIn applies the In predicate on the "paused_at" field.
+func PausedAtIn(vs ...time.Time) predicate.SystemConfig {
+	return predicate.SystemConfig(sql.FieldIn(FieldPausedAt, vs...))
+}
+
+// PausedAtNotIn applies the NotIn predicate on the "paused_at" field.
+func PausedAtNotIn(vs ...time.Time) predicate.SystemConfig {
+	return predicate.SystemConfig(sql.FieldNotIn(FieldPausedAt, vs...))
+}
+
+// PausedAtGT applies the GT predicate on the "paused_at" field.
+func PausedAtGT(v time.Time) predicate.SystemConfig {
+	return predicate.SystemConfig(sql.FieldGT(FieldPausedAt, v))
+}
+
+// PausedAtGTE applies the GTE predicate on the "paused_at" field.
+func PausedAtGTE(v time.Time) predicate.SystemConfig {
+	return predicate.SystemConfig(sql.FieldGTE(FieldPausedAt, v))
+}
+
+// PausedAtLT applies the LT predicate on the "paused_at" field.
+func PausedAtLT(v time.Time) predicate.SystemConfig {
+	return predicate.SystemConfig(sql.FieldLT(FieldPausedAt, v))
+}
+
+// PausedAtLTE applies the LTE predicate on the "paused_at" field.
+func PausedAtLTE(v time.Time) predicate.SystemConfig {
+	return predicate.SystemConfig(sql.FieldLTE(FieldPausedAt, v))
+}
+
+// PausedAtIsNil applies the IsNil predicate on the "paused_at" field.
+func PausedAtIsNil() predicate.SystemConfig {
+	return predicate.SystemConfig(sql.FieldIsNull(FieldPausedAt))
+}
+
+// PausedAtNotNil applies the NotNil predicate on the "paused_at" field.
+func PausedAtNotNil() predicate.SystemConfig {
+	return predicate.SystemConfig(sql.FieldNotNull(FieldPausedAt))
+}
+
+// StaleAfterDaysEQ applies the EQ predicate on the "stale_after_days" field.
+func StaleAfterDaysEQ(v int) predicate.SystemConfig {
+	return predicate.SystemConfig(sql.FieldEQ(FieldStaleAfterDays, v))
+}
+
+// StaleAfterDaysNEQ applies the NEQ predicate on the "stale_after_days" field.
+func StaleAfterDaysNEQ(v int) predicate.SystemConfig {
+	return predicate.SystemConfig(sql.FieldNEQ(FieldStaleAfterDays, v))
+}
+
+// StaleAfterDaysIn applies the In predicate on the "stale_after_days" field.
+func StaleAfterDaysIn(vs ...int) predicate.SystemConfig {
+	return predicate.SystemConfig(sql.FieldIn(FieldStaleAfterDays, vs...))
+}
+
+// StaleAfterDaysNotIn applies the NotIn predicate on the "stale_after_days" field.
+func StaleAfterDaysNotIn(vs ...int) predicate.SystemConfig {
+	return predicate.SystemConfig(sql.FieldNotIn(FieldStaleAfterDays, vs...))
+}
+
+// StaleAfterDaysGT applies the GT predicate on the "stale_after_days" field.
+func StaleAfterDaysGT(v int) predicate.SystemConfig {
+	return predicate.SystemConfig(sql.FieldGT(FieldStaleAfterDays, v))
+}
+
+// StaleAfterDaysGTE applies the GTE predicate on the "stale_after_days" field.
+func StaleAfterDaysGTE(v int) predicate.SystemConfig {
+	return predicate.SystemConfig(sql.FieldGTE(FieldStaleAfterDays, v))
+}
+
+// StaleAfterDaysLT applies the LT predicate on the "stale_after_days" field.
+func StaleAfterDaysLT(v int) predicate.SystemConfig {
+	return predicate.SystemConfig(sql.FieldLT(FieldStaleAfterDays, v))
+}
+
+// StaleAfterDaysLTE applies the LTE predicate on the "stale_after_days" field.
+func StaleAfterDaysLTE(v int) predicate.SystemConfig {
+	return predicate.SystemConfig(sql.FieldLTE(FieldStaleAfterDays, v))
+}
+
+// StaleNotificationsEQ applies the EQ predicate on the "stale_notifications" field.
+func StaleNotificationsEQ(v bool) predicate.SystemConfig {
+	return predicate.SystemConfig(sql.FieldEQ(FieldStaleNotifications, v))
+}
+
+// StaleNotificationsNEQ applies the NEQ predicate on the "stale_notifications" field.
+func StaleNotificationsNEQ(v bool) predicate.SystemConfig {
+	return predicate.SystemConfig(sql.FieldNEQ(FieldStaleNotifications, v))
+}
+
+// StaleNotifiedAtEQ applies the EQ predicate on the "stale_notified_at" field.
+func StaleNotifiedAtEQ(v time.Time) predicate.SystemConfig {
+	return predicate.SystemConfig(sql.FieldEQ(FieldStaleNotifiedAt, v))
+}
+
+// StaleNotifiedAtNEQ applies the NEQ predicate on the "stale_notified_at" field.
+func StaleNotifiedAtNEQ(v time.Time) predicate.SystemConfig {
+	return predicate.SystemConfig(sql.FieldNEQ(FieldStaleNotifiedAt, v))
+}
+
+// StaleNotifiedAtIn applies the In predicate on the "stale_notified_at" field.
+func StaleNotifiedAtIn(vs ...time.Time) predicate.SystemConfig {
+	return predicate.SystemConfig(sql.FieldIn(FieldStaleNotifiedAt, vs...))
+}
+
+// StaleNotifiedAtNotIn applies the NotIn predicate on the "stale_notified_at" field.
+func StaleNotifiedAtNotIn(vs ...time.Time) predicate.SystemConfig {
+	return predicate.SystemConfig(sql.FieldNotIn(FieldStaleNotifiedAt, vs...))
+}
+
+// StaleNotifiedAtGT applies the GT predicate on the "stale_notified_at" field.
+func StaleNotifiedAtGT(v time.Time) predicate.SystemConfig {
+	return predicate.SystemConfig(sql.FieldGT(FieldStaleNotifiedAt, v))
+}
+
+// StaleNotifiedAtGTE applies the GTE predicate on the "stale_notified_at" field.
+func StaleNotifiedAtGTE(v time.Time) predicate.SystemConfig {
+	return predicate.SystemConfig(sql.FieldGTE(FieldStaleNotifiedAt, v))
+}
+
+// StaleNotifiedAtLT applies the LT predicate on the "stale_notified_at" field.
+func StaleNotifiedAtLT(v time.Time) predicate.SystemConfig {
+	return predicate.SystemConfig(sql.FieldLT(FieldStaleNotifiedAt, v))
+}
+
+// StaleNotifiedAtLTE applies the LTE predicate on the "stale_notified_at" field.
+func StaleNotifiedAtLTE(v time.Time) predicate.SystemConfig {
+	return predicate.SystemConfig(sql.FieldLTE(FieldStaleNotifiedAt, v))
+}
+
+// StaleNotifiedAtIsNil applies the IsNil predicate on the "stale_notified_at" field.
+func StaleNotifiedAtIsNil() predicate.SystemConfig {
+	return predicate.SystemConfig(sql.FieldIsNull(FieldStaleNotifiedAt))
+}
+
+// StaleNotifiedAtNotNil applies the NotNil predicate on the "stale_notified_at" field.
+func StaleNotifiedAtNotNil() predicate.SystemConfig {
+	return predicate.SystemConfig(sql.FieldNotNull(FieldStaleNotifiedAt))
+}
+
+// ScheduleJitterSecondsEQ applies the EQ predicate on the "schedule_jitter_seconds" field.
+func ScheduleJitterSecondsEQ(v int) predicate.SystemConfig {
+	return predicate.SystemConfig(sql.FieldEQ(FieldScheduleJitterSeconds, v))
+}
+
+// ScheduleJitterSecondsNEQ applies the NEQ predicate on the "schedule_jitter_seconds" field.
+func ScheduleJitterSecondsNEQ(v int) predicate.SystemConfig {
+	return predicate.SystemConfig(sql.FieldNEQ(FieldScheduleJitterSeconds, v))
+}
+
+// ScheduleJitterSecondsIn applies the In predicate on the "schedule_jitter_seconds" field.
+func ScheduleJitterSecondsIn(vs ...int) predicate.SystemConfig {
+	return predicate.SystemConfig(sql.FieldIn(FieldScheduleJitterSeconds, vs...))
+}
+
+// ScheduleJitterSecondsNotIn applies the NotIn predicate on the "schedule_jitter_seconds" field.
+func ScheduleJitterSecondsNotIn(vs ...int) predicate.SystemConfig {
+	return predicate.SystemConfig(sql.FieldNotIn(FieldScheduleJitterSeconds, vs...))
+}
+
+// ScheduleJitterSecondsGT applies the GT predicate on the "schedule_jitter_seconds" field.
+func ScheduleJitterSecondsGT(v int) predicate.SystemConfig {
+	return predicate.SystemConfig(sql.FieldGT(FieldScheduleJitterSeconds, v))
+}
+
+// ScheduleJitterSecondsGTE applies the GTE predicate on the "schedule_jitter_seconds" field.
+func ScheduleJitterSecondsGTE(v int) predicate.SystemConfig {
+	return predicate.SystemConfig(sql.FieldGTE(FieldScheduleJitterSeconds, v))
+}
+
+// ScheduleJitterSecondsLT applies the LT predicate on the "schedule_jitter_seconds" field.
+func ScheduleJitterSecondsLT(v int) predicate.SystemConfig {
+	return predicate.SystemConfig(sql.FieldLT(FieldScheduleJitterSeconds, v))
+}
+
+// ScheduleJitterSecondsLTE applies the LTE predicate on the "schedule_jitter_seconds" field.
+func ScheduleJitterSecondsLTE(v int) predicate.SystemConfig {
+	return predicate.SystemConfig(sql.FieldLTE(FieldScheduleJitterSeconds, v))
+}
+
+// CircuitBreakerThresholdEQ applies the EQ predicate on the "circuit_breaker_threshold" field.
+func CircuitBreakerThresholdEQ(v int) predicate.SystemConfig {
+	return predicate.SystemConfig(sql.FieldEQ(FieldCircuitBreakerThreshold, v))
+}
+
+// CircuitBreakerThresholdNEQ applies the NEQ predicate on the "circuit_breaker_threshold" field.
+func CircuitBreakerThresholdNEQ(v int) predicate.SystemConfig {
+	return predicate.SystemConfig(sql.FieldNEQ(FieldCircuitBreakerThreshold, v))
+}
+
+// CircuitBreakerThresholdIn applies the In predicate on the "circuit_breaker_threshold" field.
+func CircuitBreakerThresholdIn(vs ...int) predicate.SystemConfig {
+	return predicate.SystemConfig(sql.FieldIn(FieldCircuitBreakerThreshold, vs...))
+}
+
+// CircuitBreakerThresholdNotIn applies the NotIn predicate on the "circuit_breaker_threshold" field.
+func CircuitBreakerThresholdNotIn(vs ...int) predicate.SystemConfig {
+	return predicate.SystemConfig(sql.FieldNotIn(FieldCircuitBreakerThreshold, vs...))
+}
+
+// CircuitBreakerThresholdGT applies the GT predicate on the "circuit_breaker_threshold" field.
+func CircuitBreakerThresholdGT(v int) predicate.SystemConfig {
+	return predicate.SystemConfig(sql.FieldGT(FieldCircuitBreakerThreshold, v))
+}
+
+// CircuitBreakerThresholdGTE applies the GTE predicate on the "circuit_breaker_threshold" field.
+func CircuitBreakerThresholdGTE(v int) predicate.SystemConfig {
+	return predicate.SystemConfig(sql.FieldGTE(FieldCircuitBreakerThreshold, v))
+}
+
+// CircuitBreakerThresholdLT applies the LT predicate on the "circuit_breaker_threshold" field.
+func CircuitBreakerThresholdLT(v int) predicate.SystemConfig {
+	return predicate.SystemConfig(sql.FieldLT(FieldCircuitBreakerThreshold, v))
+}
+
+// CircuitBreakerThresholdLTE applies the LTE predicate on the "circuit_breaker_threshold" field.
+func CircuitBreakerThresholdLTE(v int) predicate.SystemConfig {
+	return predicate.SystemConfig(sql.FieldLTE(FieldCircuitBreakerThreshold, v))
+}
+
+// CircuitBreakerActionEQ applies the EQ predicate on the "circuit_breaker_action" field.
+func CircuitBreakerActionEQ(v CircuitBreakerAction) predicate.SystemConfig {
+	return predicate.SystemConfig(sql.FieldEQ(FieldCircuitBreakerAction, v))
+}
+
+// CircuitBreakerActionNEQ applies the NEQ predicate on the "circuit_breaker_action" field.
+func CircuitBreakerActionNEQ(v CircuitBreakerAction) predicate.SystemConfig {
+	return predicate.SystemConfig(sql.FieldNEQ(FieldCircuitBreakerAction, v))
+}
+
+// CircuitBreakerActionIn applies the In predicate on the "circuit_breaker_action" field.
+func CircuitBreakerActionIn(vs ...CircuitBreakerAction) predicate.SystemConfig {
+	return predicate.SystemConfig(sql.FieldIn(FieldCircuitBreakerAction, vs...))
+}
+
+// CircuitBreakerActionNotIn applies the NotIn predicate on the "circuit_breaker_action" field.
+func CircuitBreakerActionNotIn(vs ...CircuitBreakerAction) predicate.SystemConfig {
+	return predicate.SystemConfig(sql.FieldNotIn(FieldCircuitBreakerAction, vs...))
+}
+
+// CircuitBreakerBackoffMinutesEQ applies the EQ predicate on the "circuit_breaker_backoff_minutes" field.
+func CircuitBreakerBackoffMinutesEQ(v int) predicate.SystemConfig {
+	return predicate.SystemConfig(sql.FieldEQ(FieldCircuitBreakerBackoffMinutes, v))
+}
+
+// CircuitBreakerBackoffMinutesNEQ applies the NEQ predicate on the "circuit_breaker_backoff_minutes" field.
+func CircuitBreakerBackoffMinutesNEQ(v int) predicate.SystemConfig {
+	return predicate.SystemConfig(sql.FieldNEQ(FieldCircuitBreakerBackoffMinutes, v))
+}
+
+// CircuitBreakerBackoffMinutesIn applies the In predicate on the "circuit_breaker_backoff_minutes" field.
+func CircuitBreakerBackoffMinutesIn(vs ...int) predicate.SystemConfig {
+	return predicate.SystemConfig(sql.FieldIn(FieldCircuitBreakerBackoffMinutes, vs...))
+}
+
+// CircuitBreakerBackoffMinutesNotIn applies the NotIn predicate on the "circuit_breaker_backoff_minutes" field.
+func CircuitBreakerBackoffMinutesNotIn(vs ...int) predicate.SystemConfig {
+	return predicate.SystemConfig(sql.FieldNotIn(FieldCircuitBreakerBackoffMinutes, vs...))
+}
+
+// CircuitBreakerBackoffMinutesGT applies the GT predicate on the "circuit_breaker_backoff_minutes" field.
+func CircuitBreakerBackoffMinutesGT(v int) predicate.SystemConfig {
+	return predicate.SystemConfig(sql.FieldGT(FieldCircuitBreakerBackoffMinutes, v))
+}
+
+// CircuitBreakerBackoffMinutesGTE applies the GTE predicate on the "circuit_breaker_backoff_minutes" field.
+func CircuitBreakerBackoffMinutesGTE(v int) predicate.SystemConfig {
+	return predicate.SystemConfig(sql.FieldGTE(FieldCircuitBreakerBackoffMinutes, v))
+}
+
+// CircuitBreakerBackoffMinutesLT applies the LT predicate on the "circuit_breaker_backoff_minutes" field.
+func CircuitBreakerBackoffMinutesLT(v int) predicate.SystemConfig {
+	return predicate.SystemConfig(sql.FieldLT(FieldCircuitBreakerBackoffMinutes, v))
+}
+
+// CircuitBreakerBackoffMinutesLTE applies the LTE predicate on the "circuit_breaker_backoff_minutes" field.
+func CircuitBreakerBackoffMinutesLTE(v int) predicate.SystemConfig {
+	return predicate.SystemConfig(sql.FieldLTE(FieldCircuitBreakerBackoffMinutes, v))
+}
+
+// CatchUpPolicyEQ applies the EQ predicate on the "catch_up_policy" field.
+func CatchUpPolicyEQ(v CatchUpPolicy) predicate.SystemConfig {
+	return predicate.SystemConfig(sql.FieldEQ(FieldCatchUpPolicy, v))
+}
+
+// CatchUpPolicyNEQ applies the NEQ predicate on the "catch_up_policy" field.
+func CatchUpPolicyNEQ(v CatchUpPolicy) predicate.SystemConfig {
+	return predicate.SystemConfig(sql.FieldNEQ(FieldCatchUpPolicy, v))
+}
+
+// CatchUpPolicyIn applies the In predicate on the "catch_up_policy" field.
+func CatchUpPolicyIn(vs ...CatchUpPolicy) predicate.SystemConfig {
+	return predicate.SystemConfig(sql.FieldIn(FieldCatchUpPolicy, vs...))
+}
+
+// CatchUpPolicyNotIn applies the NotIn predicate on the "catch_up_policy" field.
+func CatchUpPolicyNotIn(vs ...CatchUpPolicy) predicate.SystemConfig {
+	return predicate.SystemConfig(sql.FieldNotIn(FieldCatchUpPolicy, vs...))
+}
+
+// CatchUpMaxAgeMinutesEQ applies the EQ predicate on the "catch_up_max_age_minutes" field.
+func CatchUpMaxAgeMinutesEQ(v int) predicate.SystemConfig {
+	return predicate.SystemConfig(sql.FieldEQ(FieldCatchUpMaxAgeMinutes, v))
+}
+
+// CatchUpMaxAgeMinutesNEQ applies the NEQ predicate on the "catch_up_max_age_minutes" field.
+func CatchUpMaxAgeMinutesNEQ(v int) predicate.SystemConfig {
+	return predicate.SystemConfig(sql.FieldNEQ(FieldCatchUpMaxAgeMinutes, v))
+}
+
+// CatchUpMaxAgeMinutesIn applies the In predicate on the "catch_up_max_age_minutes" field.
+func CatchUpMaxAgeMinutesIn(vs ...int) predicate.SystemConfig {
+	return predicate.SystemConfig(sql.FieldIn(FieldCatchUpMaxAgeMinutes, vs...))
+}
+
+// CatchUpMaxAgeMinutesNotIn applies the NotIn predicate on the "catch_up_max_age_minutes" field.
+func CatchUpMaxAgeMinutesNotIn(vs ...int) predicate.SystemConfig {
+	return predicate.SystemConfig(sql.FieldNotIn(FieldCatchUpMaxAgeMinutes, vs...))
+}
+
+// CatchUpMaxAgeMinutesGT applies the GT predicate on the "catch_up_max_age_minutes" field.
+func CatchUpMaxAgeMinutesGT(v int) predicate.SystemConfig {
+	return predicate.SystemConfig(sql.FieldGT(FieldCatchUpMaxAgeMinutes, v))
+}
+
+// CatchUpMaxAgeMinutesGTE applies the GTE predicate on the "catch_up_max_age_minutes" field.
+func CatchUpMaxAgeMinutesGTE(v int) predicate.SystemConfig {
+	return predicate.SystemConfig(sql.FieldGTE(FieldCatchUpMaxAgeMinutes, v))
+}
+
+// CatchUpMaxAgeMinutesLT applies the LT predicate on the "catch_up_max_age_minutes" field.
+func CatchUpMaxAgeMinutesLT(v int) predicate.SystemConfig {
+	return predicate.SystemConfig(sql.FieldLT(FieldCatchUpMaxAgeMinutes, v))
+}
+
+// CatchUpMaxAgeMinutesLTE applies the LTE predicate on the "catch_up_max_age_minutes" field.
+func CatchUpMaxAgeMinutesLTE(v int) predicate.SystemConfig {
+	return predicate.SystemConfig(sql.FieldLTE(FieldCatchUpMaxAgeMinutes, v))
+}
+
+// DefaultUserAgentEQ applies the EQ predicate on the "default_user_agent" field.
+func DefaultUserAgentEQ(v string) predicate.SystemConfig {
+	return predicate.SystemConfig(sql.FieldEQ(FieldDefaultUserAgent, v))
+}
+
+// DefaultUserAgentNEQ applies the NEQ predicate on the "default_user_agent" field.
+func DefaultUserAgentNEQ(v string) predicate.SystemConfig {
+	return predicate.SystemConfig(sql.FieldNEQ(FieldDefaultUserAgent, v))
+}
+
+// DefaultUserAgentIn applies the In predicate on the "default_user_agent" field.
+func DefaultUserAgentIn(vs ...string) predicate.SystemConfig {
+	return predicate.SystemConfig(sql.FieldIn(FieldDefaultUserAgent, vs...))
+}
+
+// DefaultUserAgentNotIn applies the NotIn predicate on the "default_user_agent" field.
+func DefaultUserAgentNotIn(vs ...string) predicate.SystemConfig {
+	return predicate.SystemConfig(sql.FieldNotIn(FieldDefaultUserAgent, vs...))
+}
+
+// DefaultUserAgentGT applies the GT predicate on the "default_user_agent" field.
+func DefaultUserAgentGT(v string) predicate.SystemConfig {
+	return predicate.SystemConfig(sql.FieldGT(FieldDefaultUserAgent, v))
+}
+
+// DefaultUserAgentGTE applies the GTE predicate on the "default_user_agent" field.
+func DefaultUserAgentGTE(v string) predicate.SystemConfig {
+	return predicate.SystemConfig(sql.FieldGTE(FieldDefaultUserAgent, v))
+}
+
+// DefaultUserAgentLT applies the LT predicate on the "default_user_agent" field.
+func DefaultUserAgentLT(v string) predicate.SystemConfig {
+	return predicate.SystemConfig(sql.FieldLT(FieldDefaultUserAgent, v))
+}
+
+// DefaultUserAgentLTE applies the LTE predicate on the "default_user_agent" field.
+func DefaultUserAgentLTE(v string) predicate.SystemConfig {
+	return predicate.SystemConfig(sql.FieldLTE(FieldDefaultUserAgent, v))
+}
+
+// DefaultUserAgentContains applies the Contains predicate on the "default_user_agent" field.
+func DefaultUserAgentContains(v string) predicate.SystemConfig {
+	return predicate.SystemConfig(sql.FieldContains(FieldDefaultUserAgent, v))
+}
+
+// DefaultUserAgentHasPrefix applies the HasPrefix predicate on the "default_user_agent" field.
+func DefaultUserAgentHasPrefix(v string) predicate.SystemConfig {
+	return predicate.SystemConfig(sql.FieldHasPrefix(FieldDefaultUserAgent, v))
+}
+
+// DefaultUserAgentHasSuffix applies the HasSuffix predicate on the "default_user_agent" field.
+func DefaultUserAgentHasSuffix(v string) predicate.SystemConfig {
+	return predicate.SystemConfig(sql.FieldHasSuffix(FieldDefaultUserAgent, v))
+}
+
+// DefaultUserAgentIsNil applies the IsNil predicate on the "default_user_agent" field.
+func DefaultUserAgentIsNil() predicate.SystemConfig {
+	return predicate.SystemConfig(sql.FieldIsNull(FieldDefaultUserAgent))
+}
+
+// DefaultUserAgentNotNil applies the NotNil predicate on the "default_user_agent" field.
+func DefaultUserAgentNotNil() predicate.SystemConfig {
+	return predicate.SystemConfig(sql.FieldNotNull(FieldDefaultUserAgent))
+}
+
+// DefaultUserAgentEqualFold applies the EqualFold predicate on the "default_user_agent" field.
+func DefaultUserAgentEqualFold(v string) predicate.SystemConfig {
+	return predicate.SystemConfig(sql.FieldEqualFold(FieldDefaultUserAgent, v))
+}
+
+// DefaultUserAgentContainsFold applies the ContainsFold predicate on the "default_user_agent" field.
+func DefaultUserAgentContainsFold(v string) predicate.SystemConfig {
+	return predicate.SystemConfig(sql.FieldContainsFold(FieldDefaultUserAgent, v))
+}
+
+// DefaultHeadersIsNil applies the IsNil predicate on the "default_headers" field.
+func DefaultHeadersIsNil() predicate.SystemConfig {
+	return predicate.SystemConfig(sql.FieldIsNull(FieldDefaultHeaders))
+}
+
+// DefaultHeadersNotNil applies the NotNil predicate on the "default_headers" field.
+func DefaultHeadersNotNil() predicate.SystemConfig {
+	return predicate.SystemConfig(sql.FieldNotNull(FieldDefaultHeaders))
+}
+
+// ProxyURLEQ applies the EQ predicate on the "proxy_url" field.
+func ProxyURLEQ(v string) predicate.SystemConfig {
+	return predicate.SystemConfig(sql.FieldEQ(FieldProxyURL, v))
+}
+
+// ProxyURLNEQ applies the NEQ predicate on the "proxy_url" field.
+func ProxyURLNEQ(v string) predicate.SystemConfig {
+	return predicate.SystemConfig(sql.FieldNEQ(FieldProxyURL, v))
+}
+
+// ProxyURLIn applies the In predicate on the "proxy_url" field.
+func ProxyURLIn(vs ...string) predicate.SystemConfig {
+	return predicate.SystemConfig(sql.FieldIn(FieldProxyURL, vs...))
+}
+
+// ProxyURLNotIn applies the NotIn predicate on the "proxy_url" field.
+func ProxyURLNotIn(vs ...string) predicate.SystemConfig {
+	return predicate.SystemConfig(sql.FieldNotIn(FieldProxyURL, vs...))
+}
+
+// ProxyURLGT applies the GT predicate on the "proxy_url" field.
+func ProxyURLGT(v string) predicate.SystemConfig {
+	return predicate.SystemConfig(sql.FieldGT(FieldProxyURL, v))
+}
+
+// ProxyURLGTE applies the GTE predicate on the "proxy_url" field.
+func ProxyURLGTE(v string) predicate.SystemConfig {
+	return predicate.SystemConfig(sql.FieldGTE(FieldProxyURL, v))
+}
+
+// ProxyURLLT applies the LT predicate on the "proxy_url" field.
+func ProxyURLLT(v string) predicate.SystemConfig {
+	return predicate.SystemConfig(sql.FieldLT(FieldProxyURL, v))
+}
+
+// ProxyURLLTE applies the LTE predicate on the "proxy_url" field.
+func ProxyURLLTE(v string) predicate.SystemConfig {
+	return predicate.SystemConfig(sql.FieldLTE(FieldProxyURL, v))
+}
+
+// ProxyURLContains applies the Contains predicate on the "proxy_url" field.
+func ProxyURLContains(v string) predicate.SystemConfig {
+	return predicate.SystemConfig(sql.FieldContains(FieldProxyURL, v))
+}
+
+// ProxyURLHasPrefix applies the HasPrefix predicate on the "proxy_url" field.
+func ProxyURLHasPrefix(v string) predicate.SystemConfig {
+	return predicate.SystemConfig(sql.FieldHasPrefix(FieldProxyURL, v))
+}
+
+// ProxyURLHasSuffix applies the HasSuffix predicate on the "proxy_url" field.
+func ProxyURLHasSuffix(v string) predicate.SystemConfig {
+	return predicate.SystemConfig(sql.FieldHasSuffix(FieldProxyURL, v))
+}
+
+// ProxyURLIsNil applies the IsNil predicate on the "proxy_url" field.
+func ProxyURLIsNil() predicate.SystemConfig {
+	return predicate.SystemConfig(sql.FieldIsNull(FieldProxyURL))
+}
+
+// ProxyURLNotNil applies the NotNil predicate on the "proxy_url" field.
+func ProxyURLNotNil() predicate.SystemConfig {
+	return predicate.SystemConfig(sql.FieldNotNull(FieldProxyURL))
+}
+
+// ProxyURLEqualFold applies the EqualFold predicate on the "proxy_url" field.
+func ProxyURLEqualFold(v string) predicate.SystemConfig {
+	return predicate.SystemConfig(sql.FieldEqualFold(FieldProxyURL, v))
+}
+
+// ProxyURLContainsFold applies the ContainsFold predicate on the "proxy_url" field.
+func ProxyURLContainsFold(v string) predicate.SystemConfig {
+	return predicate.SystemConfig(sql.FieldContainsFold(FieldProxyURL, v))
+}
+
+// ProxyBypassEQ applies the EQ predicate on the "proxy_bypass" field.
+func ProxyBypassEQ(v string) predicate.SystemConfig {
+	return predicate.SystemConfig(sql.FieldEQ(FieldProxyBypass, v))
+}
+
+// ProxyBypassNEQ applies the NEQ predicate on the "proxy_bypass" field.
+func ProxyBypassNEQ(v string) predicate.SystemConfig {
+	return predicate.SystemConfig(sql.FieldNEQ(FieldProxyBypass, v))
+}
+
+// ProxyBypassIn applies the In predicate on the "proxy_bypass" field.
+func ProxyBypassIn(vs ...string) predicate.SystemConfig {
+	return predicate.SystemConfig(sql.FieldIn(FieldProxyBypass, vs...))
+}
+
+// ProxyBypassNotIn applies the NotIn predicate on the "proxy_bypass" field.
+func ProxyBypassNotIn(vs ...string) predicate.SystemConfig {
+	return predicate.SystemConfig(sql.FieldNotIn(FieldProxyBypass, vs...))
+}
+
+// ProxyBypassGT applies the GT predicate on the "proxy_bypass" field.
+func ProxyBypassGT(v string) predicate.SystemConfig {
+	return predicate.SystemConfig(sql.FieldGT(FieldProxyBypass, v))
+}
+
+// ProxyBypassGTE applies the GTE predicate on the "proxy_bypass" field.
+func ProxyBypassGTE(v string) predicate.SystemConfig {
+	return predicate.SystemConfig(sql.FieldGTE(FieldProxyBypass, v))
+}
+
+// ProxyBypassLT applies the LT predicate on the "proxy_bypass" field.
+func ProxyBypassLT(v string) predicate.SystemConfig {
+	return predicate.SystemConfig(sql.FieldLT(FieldProxyBypass, v))
+}
+
+// ProxyBypassLTE applies the LTE predicate on the "proxy_bypass" field.
+func ProxyBypassLTE(v string) predicate.SystemConfig {
+	return predicate.SystemConfig(sql.FieldLTE(FieldProxyBypass, v))
+}
+
+// ProxyBypassContains applies the Contains predicate on the "proxy_bypass" field.
+func ProxyBypassContains(v string) predicate.SystemConfig {
+	return predicate.SystemConfig(sql.FieldContains(FieldProxyBypass, v))
+}
+
+// ProxyBypassHasPrefix applies the HasPrefix predicate on the "proxy_bypass" field.
+func ProxyBypassHasPrefix(v string) predicate.SystemConfig {
+	return predicate.SystemConfig(sql.FieldHasPrefix(FieldProxyBypass, v))
+}
+
+// ProxyBypassHasSuffix applies the HasSuffix predicate on the "proxy_bypass" field.
+func ProxyBypassHasSuffix(v string) predicate.SystemConfig {
+	return predicate.SystemConfig(sql.FieldHasSuffix(FieldProxyBypass, v))
+}
+
+// ProxyBypassIsNil applies the IsNil predicate on the "proxy_bypass" field.
+func ProxyBypassIsNil() predicate.SystemConfig {
+	return predicate.SystemConfig(sql.FieldIsNull(FieldProxyBypass))
+}
+
+// ProxyBypassNotNil applies the NotNil predicate on the "proxy_bypass" field.
+func ProxyBypassNotNil() predicate.SystemConfig {
+	return predicate.SystemConfig(sql.FieldNotNull(FieldProxyBypass))
+}
+
+// ProxyBypassEqualFold applies the EqualFold predicate on the "proxy_bypass" field.
+func ProxyBypassEqualFold(v string) predicate.SystemConfig {
+	return predicate.SystemConfig(sql.FieldEqualFold(FieldProxyBypass, v))
+}
+
+// ProxyBypassContainsFold applies the ContainsFold predicate on the "proxy_bypass" field.
+func ProxyBypassContainsFold(v string) predicate.SystemConfig {
+	return predicate.SystemConfig(sql.FieldContainsFold(FieldProxyBypass, v))
+}
+
+// RequestTimeoutSecondsEQ applies the EQ predicate on the "request_timeout_seconds" field.
+func RequestTimeoutSecondsEQ(v int) predicate.SystemConfig {
+	return predicate.SystemConfig(sql.FieldEQ(FieldRequestTimeoutSeconds, v))
+}
+
+// RequestTimeoutSecondsNEQ applies the NEQ predicate on the "request_timeout_seconds" field.
+func RequestTimeoutSecondsNEQ(v int) predicate.SystemConfig {
+	return predicate.SystemConfig(sql.FieldNEQ(FieldRequestTimeoutSeconds, v))
+}
+
+// RequestTimeoutSecondsIn applies the In predicate on the "request_timeout_seconds" field.
+func RequestTimeoutSecondsIn(vs ...int) predicate.SystemConfig {
+	return predicate.SystemConfig(sql.FieldIn(FieldRequestTimeoutSeconds, vs...))
+}
+
+// RequestTimeoutSecondsNotIn applies the NotIn predicate on the "request_timeout_seconds" field.
+func RequestTimeoutSecondsNotIn(vs ...int) predicate.SystemConfig {
+	return predicate.SystemConfig(sql.FieldNotIn(FieldRequestTimeoutSeconds, vs...))
+}
+
+// RequestTimeoutSecondsGT applies the GT predicate on the "request_timeout_seconds" field.
+func RequestTimeoutSecondsGT(v int) predicate.SystemConfig {
+	return predicate.SystemConfig(sql.FieldGT(FieldRequestTimeoutSeconds, v))
+}
+
+// RequestTimeoutSecondsGTE applies the GTE predicate on the "request_timeout_seconds" field.
+func RequestTimeoutSecondsGTE(v int) predicate.SystemConfig {
+	return predicate.SystemConfig(sql.FieldGTE(FieldRequestTimeoutSeconds, v))
+}
+
+// RequestTimeoutSecondsLT applies the LT predicate on the "request_timeout_seconds" field.
+func RequestTimeoutSecondsLT(v int) predicate.SystemConfig {
+	return predicate.SystemConfig(sql.FieldLT(FieldRequestTimeoutSeconds, v))
+}
+
+// RequestTimeoutSecondsLTE applies the LTE predicate on the "request_timeout_seconds" field.
+func RequestTimeoutSecondsLTE(v int) predicate.SystemConfig {
+	return predicate.SystemConfig(sql.FieldLTE(FieldRequestTimeoutSeconds, v))
+}
+
+// MaxRetriesEQ applies the EQ predicate on the "max_retries" field.
+func MaxRetriesEQ(v int) predicate.SystemConfig {
+	return predicate.SystemConfig(sql.FieldEQ(FieldMaxRetries, v))
+}
+
+// MaxRetriesNEQ applies the NEQ predicate on the "max_retries" field.
+func MaxRetriesNEQ(v int) predicate.SystemConfig {
+	return predicate.SystemConfig(sql.FieldNEQ(FieldMaxRetries, v))
+}
+
+// MaxRetriesIn applies the In predicate on the "max_retries" field.
+func MaxRetriesIn(vs ...int) predicate.SystemConfig {
+	return predicate.SystemConfig(sql.FieldIn(FieldMaxRetries, vs...))
+}
+
+// MaxRetriesNotIn applies the NotIn predicate on the "max_retries" field.
+func MaxRetriesNotIn(vs ...int) predicate.SystemConfig {
+	return predicate.SystemConfig(sql.FieldNotIn(FieldMaxRetries, vs...))
+}
+
+// MaxRetriesGT applies the GT predicate on the "max_retries" field.
+func MaxRetriesGT(v int) predicate.SystemConfig {
+	return predicate.SystemConfig(sql.FieldGT(FieldMaxRetries, v))
+}
+
+// MaxRetriesGTE applies the GTE predicate on the "max_retries" field.
+func MaxRetriesGTE(v int) predicate.SystemConfig {
+	return predicate.SystemConfig(sql.FieldGTE(FieldMaxRetries, v))
+}
+
+// MaxRetriesLT applies the LT predicate on the "max_retries" field.
+func MaxRetriesLT(v int) predicate.SystemConfig {
+	return predicate.SystemConfig(sql.FieldLT(FieldMaxRetries, v))
+}
+
+// MaxRetriesLTE applies the LTE predicate on the "max_retries" field.
+func MaxRetriesLTE(v int) predicate.SystemConfig {
+	return predicate.SystemConfig(sql.FieldLTE(FieldMaxRetries, v))
+}
+
+// RetryBackoffSecondsEQ applies the EQ predicate on the "retry_backoff_seconds" field.
+func RetryBackoffSecondsEQ(v int) predicate.SystemConfig {
+	return predicate.SystemConfig(sql.FieldEQ(FieldRetryBackoffSeconds, v))
+}
+
+// RetryBackoffSecondsNEQ applies the NEQ predicate on the "retry_backoff_seconds" field.
+func RetryBackoffSecondsNEQ(v int) predicate.SystemConfig {
+	return predicate.SystemConfig(sql.FieldNEQ(FieldRetryBackoffSeconds, v))
+}
+
+// RetryBackoffSecondsIn applies the In predicate on the "retry_backoff_seconds" field.
+func RetryBackoffSecondsIn(vs ...int) predicate.SystemConfig {
+	return predicate.SystemConfig(sql.FieldIn(FieldRetryBackoffSeconds, vs...))
+}
+
+// RetryBackoffSecondsNotIn applies the NotIn predicate on the "retry_backoff_seconds" field.
+func RetryBackoffSecondsNotIn(vs ...int) predicate.SystemConfig {
+	return predicate.SystemConfig(sql.FieldNotIn(FieldRetryBackoffSeconds, vs...))
+}
+
+// RetryBackoffSecondsGT applies the GT predicate on the "retry_backoff_seconds" field.
+func RetryBackoffSecondsGT(v int) predicate.SystemConfig {
+	return predicate.SystemConfig(sql.FieldGT(FieldRetryBackoffSeconds, v))
+}
+
+// RetryBackoffSecondsGTE applies the GTE predicate on the "retry_backoff_seconds" field.
+func RetryBackoffSecondsGTE(v int) predicate.SystemConfig {
+	return predicate.SystemConfig(sql.FieldGTE(FieldRetryBackoffSeconds, v))
+}
+
+// RetryBackoffSecondsLT applies the LT predicate on the "retry_backoff_seconds" field.
+func RetryBackoffSecondsLT(v int) predicate.SystemConfig {
+	return predicate.SystemConfig(sql.FieldLT(FieldRetryBackoffSeconds, v))
+}
+
+// RetryBackoffSecondsLTE applies the LTE predicate on the "retry_backoff_seconds" field.
+func RetryBackoffSecondsLTE(v int) predicate.SystemConfig {
+	return predicate.SystemConfig(sql.FieldLTE(FieldRetryBackoffSeconds, v))
+}
+
+// TickIntervalSecondsEQ applies the EQ predicate on the "tick_interval_seconds" field.
+func TickIntervalSecondsEQ(v int) predicate.SystemConfig {
+	return predicate.SystemConfig(sql.FieldEQ(FieldTickIntervalSeconds, v))
+}
+
+// TickIntervalSecondsNEQ applies the NEQ predicate on the "tick_interval_seconds" field.
+func TickIntervalSecondsNEQ(v int) predicate.SystemConfig {
+	return predicate.SystemConfig(sql.FieldNEQ(FieldTickIntervalSeconds, v))
+}
+
+// TickIntervalSecondsIn applies the In predicate on the "tick_interval_seconds" field.
+func TickIntervalSecondsIn(vs ...int) predicate.SystemConfig {
+	return predicate.SystemConfig(sql.FieldIn(FieldTickIntervalSeconds, vs...))
+}
+
+// TickIntervalSecondsNotIn applies the NotIn predicate on the "tick_interval_seconds" field.
+func TickIntervalSecondsNotIn(vs ...int) predicate.SystemConfig {
+	return predicate.SystemConfig(sql.FieldNotIn(FieldTickIntervalSeconds, vs...))
+}
+
+// TickIntervalSecondsGT applies the GT predicate on the "tick_interval_seconds" field.
+func TickIntervalSecondsGT(v int) predicate.SystemConfig {
+	return predicate.SystemConfig(sql.FieldGT(FieldTickIntervalSeconds, v))
+}
+
+// TickIntervalSecondsGTE applies the GTE predicate on the "tick_interval_seconds" field.
+func TickIntervalSecondsGTE(v int) predicate.SystemConfig {
+	return predicate.SystemConfig(sql.FieldGTE(FieldTickIntervalSeconds, v))
+}
+
+// TickIntervalSecondsLT applies the LT predicate on the "tick_interval_seconds" field.
+func TickIntervalSecondsLT(v int) predicate.SystemConfig {
+	return predicate.SystemConfig(sql.FieldLT(FieldTickIntervalSeconds, v))
+}
+
+// TickIntervalSecondsLTE applies the LTE predicate on the "tick_interval_seconds" field.
+func TickIntervalSecondsLTE(v int) predicate.SystemConfig {
+	return predicate.SystemConfig(sql.FieldLTE(FieldTickIntervalSeconds, v))
+}
+
+// ScheduleLookaheadSecondsEQ applies the EQ predicate on the "schedule_lookahead_seconds" field.
+func ScheduleLookaheadSecondsEQ(v int) predicate.SystemConfig {
+	return predicate.SystemConfig(sql.FieldEQ(FieldScheduleLookaheadSeconds, v))
+}
+
+// ScheduleLookaheadSecondsNEQ applies the NEQ predicate on the "schedule_lookahead_seconds" field.
+func ScheduleLookaheadSecondsNEQ(v int) predicate.SystemConfig {
+	return predicate.SystemConfig(sql.FieldNEQ(FieldScheduleLookaheadSeconds, v))
+}
+
+// ScheduleLookaheadSecondsIn applies the In predicate on the "schedule_lookahead_seconds" field.
+func ScheduleLookaheadSecondsIn(vs ...int) predicate.SystemConfig {
+	return predicate.SystemConfig(sql.FieldIn(FieldScheduleLookaheadSeconds, vs...))
+}
+
+// ScheduleLookaheadSecondsNotIn applies the NotIn predicate on the "schedule_lookahead_seconds" field.
+func ScheduleLookaheadSecondsNotIn(vs ...int) predicate.SystemConfig {
+	return predicate.SystemConfig(sql.FieldNotIn(FieldScheduleLookaheadSeconds, vs...))
+}
+
+// ScheduleLookaheadSecondsGT applies the GT predicate on the "schedule_lookahead_seconds" field.
+func ScheduleLookaheadSecondsGT(v int) predicate.SystemConfig {
+	return predicate.SystemConfig(sql.FieldGT(FieldScheduleLookaheadSeconds, v))
+}
+
+// ScheduleLookaheadSecondsGTE applies the GTE predicate on the "schedule_lookahead_seconds" field.
+func ScheduleLookaheadSecondsGTE(v int) predicate.SystemConfig {
+	return predicate.SystemConfig(sql.FieldGTE(FieldScheduleLookaheadSeconds, v))
+}
+
+// ScheduleLookaheadSecondsLT applies the LT predicate on the "schedule_lookahead_seconds" field.
+func ScheduleLookaheadSecondsLT(v int) predicate.SystemConfig {
+	return predicate.SystemConfig(sql.FieldLT(FieldScheduleLookaheadSeconds, v))
+}
+
+// ScheduleLookaheadSecondsLTE applies the LTE predicate on the "schedule_lookahead_seconds" field.
+func ScheduleLookaheadSecondsLTE(v int) predicate.SystemConfig {
+	return predicate.SystemConfig(sql.FieldLTE(FieldScheduleLookaheadSeconds, v))
+}
+
+// MaxResponseBodyBytesEQ applies the EQ predicate on the "max_response_body_bytes" field.
+func MaxResponseBodyBytesEQ(v int) predicate.SystemConfig {
+	return predicate.SystemConfig(sql.FieldEQ(FieldMaxResponseBodyBytes, v))
+}
+
+// MaxResponseBodyBytesNEQ applies the NEQ predicate on the "max_response_body_bytes" field.
+func MaxResponseBodyBytesNEQ(v int) predicate.SystemConfig {
+	return predicate.SystemConfig(sql.FieldNEQ(FieldMaxResponseBodyBytes, v))
+}
+
+// MaxResponseBodyBytesIn applies the In predicate on the "max_response_body_bytes" field.
+func MaxResponseBodyBytesIn(vs ...int) predicate.SystemConfig {
+	return predicate.SystemConfig(sql.FieldIn(FieldMaxResponseBodyBytes, vs...))
+}
+
+// MaxResponseBodyBytesNotIn applies the NotIn predicate on the "max_response_body_bytes" field.
+func MaxResponseBodyBytesNotIn(vs ...int) predicate.SystemConfig {
+	return predicate.SystemConfig(sql.FieldNotIn(FieldMaxResponseBodyBytes, vs...))
+}
+
+// MaxResponseBodyBytesGT applies the GT predicate on the "max_response_body_bytes" field.
+func MaxResponseBodyBytesGT(v int) predicate.SystemConfig {
+	return predicate.SystemConfig(sql.FieldGT(FieldMaxResponseBodyBytes, v))
+}
+
+// MaxResponseBodyBytesGTE applies the GTE predicate on the "max_response_body_bytes" field.
+func MaxResponseBodyBytesGTE(v int) predicate.SystemConfig {
+	return predicate.SystemConfig(sql.FieldGTE(FieldMaxResponseBodyBytes, v))
+}
+
+// MaxResponseBodyBytesLT applies the LT predicate on the "max_response_body_bytes" field.
+func MaxResponseBodyBytesLT(v int) predicate.SystemConfig {
+	return predicate.SystemConfig(sql.FieldLT(FieldMaxResponseBodyBytes, v))
+}
+
+// MaxResponseBodyBytesLTE applies the LTE predicate on the "max_response_body_bytes" field.
+func MaxResponseBodyBytesLTE(v int) predicate.SystemConfig {
+	return predicate.SystemConfig(sql.FieldLTE(FieldMaxResponseBodyBytes, v))
+}
+
+// MaxResponseBodyBytesIsNil applies the IsNil predicate on the "max_response_body_bytes" field.
+func MaxResponseBodyBytesIsNil() predicate.SystemConfig {
+	return predicate.SystemConfig(sql.FieldIsNull(FieldMaxResponseBodyBytes))
+}
+
+// MaxResponseBodyBytesNotNil applies the NotNil predicate on the "max_response_body_bytes" field.
+func MaxResponseBodyBytesNotNil() predicate.SystemConfig {
+	return predicate.SystemConfig(sql.FieldNotNull(FieldMaxResponseBodyBytes))
+}
+
 // UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
 func UpdatedAtEQ(v time.Time) predicate.SystemConfig {
 	return predicate.SystemConfig(sql.FieldEQ(FieldUpdatedAt, v))
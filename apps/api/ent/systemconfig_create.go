@@ -62,6 +62,306 @@ func (_c *SystemConfigCreate) SetNillableTimezone(v *string) *SystemConfigCreate
 	return _c
 }
 
+// SetPaused sets the "paused" field.
+func (_c *SystemConfigCreate) SetPaused(v bool) *SystemConfigCreate {
+	_c.mutation.SetPaused(v)
+	return _c
+}
+
+// SetNillablePaused sets the "paused" field if the given value is not nil.
+func (_c *SystemConfigCreate) SetNillablePaused(v *bool) *SystemConfigCreate {
+	if v != nil {
+		_c.SetPaused(*v)
+	}
+	return _c
+}
+
+// SetNotificationsPaused sets the "notifications_paused" field.
+func (_c *SystemConfigCreate) SetNotificationsPaused(v bool) *SystemConfigCreate {
+	_c.mutation.SetNotificationsPaused(v)
+	return _c
+}
+
+// SetNillableNotificationsPaused sets the "notifications_paused" field if the given value is not nil.
+func (_c *SystemConfigCreate) SetNillableNotificationsPaused(v *bool) *SystemConfigCreate {
+	if v != nil {
+		_c.SetNotificationsPaused(*v)
+	}
+	return _c
+}
+
+// SetPausedAt sets the "paused_at" field.
+func (_c *SystemConfigCreate) SetPausedAt(v time.Time) *SystemConfigCreate {
+	_c.mutation.SetPausedAt(v)
+	return _c
+}
+
+// SetNillablePausedAt sets the "paused_at" field if the given value is not nil.
+func (_c *SystemConfigCreate) SetNillablePausedAt(v *time.Time) *SystemConfigCreate {
+	if v != nil {
+		_c.SetPausedAt(*v)
+	}
+	return _c
+}
+
+// SetStaleAfterDays sets the "stale_after_days" field.
+func (_c *SystemConfigCreate) SetStaleAfterDays(v int) *SystemConfigCreate {
+	_c.mutation.SetStaleAfterDays(v)
+	return _c
+}
+
+// SetNillableStaleAfterDays sets the "stale_after_days" field if the given value is not nil.
+func (_c *SystemConfigCreate) SetNillableStaleAfterDays(v *int) *SystemConfigCreate {
+	if v != nil {
+		_c.SetStaleAfterDays(*v)
+	}
+	return _c
+}
+
+// SetStaleNotifications sets the "stale_notifications" field.
+func (_c *SystemConfigCreate) SetStaleNotifications(v bool) *SystemConfigCreate {
+	_c.mutation.SetStaleNotifications(v)
+	return _c
+}
+
+// SetNillableStaleNotifications sets the "stale_notifications" field if the given value is not nil.
+func (_c *SystemConfigCreate) SetNillableStaleNotifications(v *bool) *SystemConfigCreate {
+	if v != nil {
+		_c.SetStaleNotifications(*v)
+	}
+	return _c
+}
+
+// SetStaleNotifiedAt sets the "stale_notified_at" field.
+func (_c *SystemConfigCreate) SetStaleNotifiedAt(v time.Time) *SystemConfigCreate {
+	_c.mutation.SetStaleNotifiedAt(v)
+	return _c
+}
+
+// SetNillableStaleNotifiedAt sets the "stale_notified_at" field if the given value is not nil.
+func (_c *SystemConfigCreate) SetNillableStaleNotifiedAt(v *time.Time) *SystemConfigCreate {
+	if v != nil {
+		_c.SetStaleNotifiedAt(*v)
+	}
+	return _c
+}
+
+// SetScheduleJitterSeconds sets the "schedule_jitter_seconds" field.
+func (_c *SystemConfigCreate) SetScheduleJitterSeconds(v int) *SystemConfigCreate {
+	_c.mutation.SetScheduleJitterSeconds(v)
+	return _c
+}
+
+// SetNillableScheduleJitterSeconds sets the "schedule_jitter_seconds" field if the given value is not nil.
+func (_c *SystemConfigCreate) SetNillableScheduleJitterSeconds(v *int) *SystemConfigCreate {
+	if v != nil {
+		_c.SetScheduleJitterSeconds(*v)
+	}
+	return _c
+}
+
+// SetCircuitBreakerThreshold sets the "circuit_breaker_threshold" field.
+func (_c *SystemConfigCreate) SetCircuitBreakerThreshold(v int) *SystemConfigCreate {
+	_c.mutation.SetCircuitBreakerThreshold(v)
+	return _c
+}
+
+// SetNillableCircuitBreakerThreshold sets the "circuit_breaker_threshold" field if the given value is not nil.
+func (_c *SystemConfigCreate) SetNillableCircuitBreakerThreshold(v *int) *SystemConfigCreate {
+	if v != nil {
+		_c.SetCircuitBreakerThreshold(*v)
+	}
+	return _c
+}
+
+// SetCircuitBreakerAction sets the "circuit_breaker_action" field.
+func (_c *SystemConfigCreate) SetCircuitBreakerAction(v systemconfig.CircuitBreakerAction) *SystemConfigCreate {
+	_c.mutation.SetCircuitBreakerAction(v)
+	return _c
+}
+
+// SetNillableCircuitBreakerAction sets the "circuit_breaker_action" field if the given value is not nil.
+func (_c *SystemConfigCreate) SetNillableCircuitBreakerAction(v *systemconfig.CircuitBreakerAction) *SystemConfigCreate {
+	if v != nil {
+		_c.SetCircuitBreakerAction(*v)
+	}
+	return _c
+}
+
+// SetCircuitBreakerBackoffMinutes sets the "circuit_breaker_backoff_minutes" field.
+func (_c *SystemConfigCreate) SetCircuitBreakerBackoffMinutes(v int) *SystemConfigCreate {
+	_c.mutation.SetCircuitBreakerBackoffMinutes(v)
+	return _c
+}
+
+// SetNillableCircuitBreakerBackoffMinutes sets the "circuit_breaker_backoff_minutes" field if the given value is not nil.
+func (_c *SystemConfigCreate) SetNillableCircuitBreakerBackoffMinutes(v *int) *SystemConfigCreate {
+	if v != nil {
+		_c.SetCircuitBreakerBackoffMinutes(*v)
+	}
+	return _c
+}
+
+// SetCatchUpPolicy sets the "catch_up_policy" field.
+func (_c *SystemConfigCreate) SetCatchUpPolicy(v systemconfig.CatchUpPolicy) *SystemConfigCreate {
+	_c.mutation.SetCatchUpPolicy(v)
+	return _c
+}
+
+// SetNillableCatchUpPolicy sets the "catch_up_policy" field if the given value is not nil.
+func (_c *SystemConfigCreate) SetNillableCatchUpPolicy(v *systemconfig.CatchUpPolicy) *SystemConfigCreate {
+	if v != nil {
+		_c.SetCatchUpPolicy(*v)
+	}
+	return _c
+}
+
+// SetCatchUpMaxAgeMinutes sets the "catch_up_max_age_minutes" field.
+func (_c *SystemConfigCreate) SetCatchUpMaxAgeMinutes(v int) *SystemConfigCreate {
+	_c.mutation.SetCatchUpMaxAgeMinutes(v)
+	return _c
+}
+
+// SetNillableCatchUpMaxAgeMinutes sets the "catch_up_max_age_minutes" field if the given value is not nil.
+func (_c *SystemConfigCreate) SetNillableCatchUpMaxAgeMinutes(v *int) *SystemConfigCreate {
+	if v != nil {
+		_c.SetCatchUpMaxAgeMinutes(*v)
+	}
+	return _c
+}
+
+// SetDefaultUserAgent sets the "default_user_agent" field.
+func (_c *SystemConfigCreate) SetDefaultUserAgent(v string) *SystemConfigCreate {
+	_c.mutation.SetDefaultUserAgent(v)
+	return _c
+}
+
+// SetNillableDefaultUserAgent sets the "default_user_agent" field if the given value is not nil.
+func (_c *SystemConfigCreate) SetNillableDefaultUserAgent(v *string) *SystemConfigCreate {
+	if v != nil {
+		_c.SetDefaultUserAgent(*v)
+	}
+	return _c
+}
+
+// SetDefaultHeaders sets the "default_headers" field.
+func (_c *SystemConfigCreate) SetDefaultHeaders(v map[string]string) *SystemConfigCreate {
+	_c.mutation.SetDefaultHeaders(v)
+	return _c
+}
+
+// SetProxyURL sets the "proxy_url" field.
+func (_c *SystemConfigCreate) SetProxyURL(v string) *SystemConfigCreate {
+	_c.mutation.SetProxyURL(v)
+	return _c
+}
+
+// SetNillableProxyURL sets the "proxy_url" field if the given value is not nil.
+func (_c *SystemConfigCreate) SetNillableProxyURL(v *string) *SystemConfigCreate {
+	if v != nil {
+		_c.SetProxyURL(*v)
+	}
+	return _c
+}
+
+// SetProxyBypass sets the "proxy_bypass" field.
+func (_c *SystemConfigCreate) SetProxyBypass(v string) *SystemConfigCreate {
+	_c.mutation.SetProxyBypass(v)
+	return _c
+}
+
+// SetNillableProxyBypass sets the "proxy_bypass" field if the given value is not nil.
+func (_c *SystemConfigCreate) SetNillableProxyBypass(v *string) *SystemConfigCreate {
+	if v != nil {
+		_c.SetProxyBypass(*v)
+	}
+	return _c
+}
+
+// SetRequestTimeoutSeconds sets the "request_timeout_seconds" field.
+func (_c *SystemConfigCreate) SetRequestTimeoutSeconds(v int) *SystemConfigCreate {
+	_c.mutation.SetRequestTimeoutSeconds(v)
+	return _c
+}
+
+// SetNillableRequestTimeoutSeconds sets the "request_timeout_seconds" field if the given value is not nil.
+func (_c *SystemConfigCreate) SetNillableRequestTimeoutSeconds(v *int) *SystemConfigCreate {
+	if v != nil {
+		_c.SetRequestTimeoutSeconds(*v)
+	}
+	return _c
+}
+
+// SetMaxRetries sets the "max_retries" field.
+func (_c *SystemConfigCreate) SetMaxRetries(v int) *SystemConfigCreate {
+	_c.mutation.SetMaxRetries(v)
+	return _c
+}
+
+// SetNillableMaxRetries sets the "max_retries" field if the given value is not nil.
+func (_c *SystemConfigCreate) SetNillableMaxRetries(v *int) *SystemConfigCreate {
+	if v != nil {
+		_c.SetMaxRetries(*v)
+	}
+	return _c
+}
+
+// SetRetryBackoffSeconds sets the "retry_backoff_seconds" field.
+func (_c *SystemConfigCreate) SetRetryBackoffSeconds(v int) *SystemConfigCreate {
+	_c.mutation.SetRetryBackoffSeconds(v)
+	return _c
+}
+
+// SetNillableRetryBackoffSeconds sets the "retry_backoff_seconds" field if the given value is not nil.
+func (_c *SystemConfigCreate) SetNillableRetryBackoffSeconds(v *int) *SystemConfigCreate {
+	if v != nil {
+		_c.SetRetryBackoffSeconds(*v)
+	}
+	return _c
+}
+
+// SetTickIntervalSeconds sets the "tick_interval_seconds" field.
+func (_c *SystemConfigCreate) SetTickIntervalSeconds(v int) *SystemConfigCreate {
+	_c.mutation.SetTickIntervalSeconds(v)
+	return _c
+}
+
+// SetNillableTickIntervalSeconds sets the "tick_interval_seconds" field if the given value is not nil.
+func (_c *SystemConfigCreate) SetNillableTickIntervalSeconds(v *int) *SystemConfigCreate {
+	if v != nil {
+		_c.SetTickIntervalSeconds(*v)
+	}
+	return _c
+}
+
+// SetScheduleLookaheadSeconds sets the "schedule_lookahead_seconds" field.
+func (_c *SystemConfigCreate) SetScheduleLookaheadSeconds(v int) *SystemConfigCreate {
+	_c.mutation.SetScheduleLookaheadSeconds(v)
+	return _c
+}
+
+// SetNillableScheduleLookaheadSeconds sets the "schedule_lookahead_seconds" field if the given value is not nil.
+func (_c *SystemConfigCreate) SetNillableScheduleLookaheadSeconds(v *int) *SystemConfigCreate {
+	if v != nil {
+		_c.SetScheduleLookaheadSeconds(*v)
+	}
+	return _c
+}
+
+// SetMaxResponseBodyBytes sets the "max_response_body_bytes" field.
+func (_c *SystemConfigCreate) SetMaxResponseBodyBytes(v int) *SystemConfigCreate {
+	_c.mutation.SetMaxResponseBodyBytes(v)
+	return _c
+}
+
+// SetNillableMaxResponseBodyBytes sets the "max_response_body_bytes" field if the given value is not nil.
+func (_c *SystemConfigCreate) SetNillableMaxResponseBodyBytes(v *int) *SystemConfigCreate {
+	if v != nil {
+		_c.SetMaxResponseBodyBytes(*v)
+	}
+	return _c
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (_c *SystemConfigCreate) SetUpdatedAt(v time.Time) *SystemConfigCreate {
 	_c.mutation.SetUpdatedAt(v)
@@ -119,6 +419,66 @@ func (_c *SystemConfigCreate) defaults() {
 		v := systemconfig.DefaultChecksHistoryLimit
 		_c.mutation.SetChecksHistoryLimit(v)
 	}
+	if _, ok := _c.mutation.Paused(); !ok {
+		v := systemconfig.DefaultPaused
+		_c.mutation.SetPaused(v)
+	}
+	if _, ok := _c.mutation.NotificationsPaused(); !ok {
+		v := systemconfig.DefaultNotificationsPaused
+		_c.mutation.SetNotificationsPaused(v)
+	}
+	if _, ok := _c.mutation.StaleAfterDays(); !ok {
+		v := systemconfig.DefaultStaleAfterDays
+		_c.mutation.SetStaleAfterDays(v)
+	}
+	if _, ok := _c.mutation.StaleNotifications(); !ok {
+		v := systemconfig.DefaultStaleNotifications
+		_c.mutation.SetStaleNotifications(v)
+	}
+	if _, ok := _c.mutation.ScheduleJitterSeconds(); !ok {
+		v := systemconfig.DefaultScheduleJitterSeconds
+		_c.mutation.SetScheduleJitterSeconds(v)
+	}
+	if _, ok := _c.mutation.CircuitBreakerThreshold(); !ok {
+		v := systemconfig.DefaultCircuitBreakerThreshold
+		_c.mutation.SetCircuitBreakerThreshold(v)
+	}
+	if _, ok := _c.mutation.CircuitBreakerAction(); !ok {
+		v := systemconfig.DefaultCircuitBreakerAction
+		_c.mutation.SetCircuitBreakerAction(v)
+	}
+	if _, ok := _c.mutation.CircuitBreakerBackoffMinutes(); !ok {
+		v := systemconfig.DefaultCircuitBreakerBackoffMinutes
+		_c.mutation.SetCircuitBreakerBackoffMinutes(v)
+	}
+	if _, ok := _c.mutation.CatchUpPolicy(); !ok {
+		v := systemconfig.DefaultCatchUpPolicy
+		_c.mutation.SetCatchUpPolicy(v)
+	}
+	if _, ok := _c.mutation.CatchUpMaxAgeMinutes(); !ok {
+		v := systemconfig.DefaultCatchUpMaxAgeMinutes
+		_c.mutation.SetCatchUpMaxAgeMinutes(v)
+	}
+	if _, ok := _c.mutation.RequestTimeoutSeconds(); !ok {
+		v := systemconfig.DefaultRequestTimeoutSeconds
+		_c.mutation.SetRequestTimeoutSeconds(v)
+	}
+	if _, ok := _c.mutation.MaxRetries(); !ok {
+		v := systemconfig.DefaultMaxRetries
+		_c.mutation.SetMaxRetries(v)
+	}
+	if _, ok := _c.mutation.RetryBackoffSeconds(); !ok {
+		v := systemconfig.DefaultRetryBackoffSeconds
+		_c.mutation.SetRetryBackoffSeconds(v)
+	}
+	if _, ok := _c.mutation.TickIntervalSeconds(); !ok {
+		v := systemconfig.DefaultTickIntervalSeconds
+		_c.mutation.SetTickIntervalSeconds(v)
+	}
+	if _, ok := _c.mutation.ScheduleLookaheadSeconds(); !ok {
+		v := systemconfig.DefaultScheduleLookaheadSeconds
+		_c.mutation.SetScheduleLookaheadSeconds(v)
+	}
 	if _, ok := _c.mutation.UpdatedAt(); !ok {
 		v := systemconfig.DefaultUpdatedAt()
 		_c.mutation.SetUpdatedAt(v)
@@ -138,6 +498,116 @@ func (_c *SystemConfigCreate) check() error {
 			return &ValidationError{Name: "checks_history_limit", err: fmt.Errorf(`ent: validator failed for field "SystemConfig.checks_history_limit": %w`, err)}
 		}
 	}
+	if _, ok := _c.mutation.Paused(); !ok {
+		return &ValidationError{Name: "paused", err: errors.New(`ent: missing required field "SystemConfig.paused"`)}
+	}
+	if _, ok := _c.mutation.NotificationsPaused(); !ok {
+		return &ValidationError{Name: "notifications_paused", err: errors.New(`ent: missing required field "SystemConfig.notifications_paused"`)}
+	}
+	if _, ok := _c.mutation.StaleAfterDays(); !ok {
+		return &ValidationError{Name: "stale_after_days", err: errors.New(`ent: missing required field "SystemConfig.stale_after_days"`)}
+	}
+	if v, ok := _c.mutation.StaleAfterDays(); ok {
+		if err := systemconfig.StaleAfterDaysValidator(v); err != nil {
+			return &ValidationError{Name: "stale_after_days", err: fmt.Errorf(`ent: validator failed for field "SystemConfig.stale_after_days": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.StaleNotifications(); !ok {
+		return &ValidationError{Name: "stale_notifications", err: errors.New(`ent: missing required field "SystemConfig.stale_notifications"`)}
+	}
+	if _, ok := _c.mutation.ScheduleJitterSeconds(); !ok {
+		return &ValidationError{Name: "schedule_jitter_seconds", err: errors.New(`ent: missing required field "SystemConfig.schedule_jitter_seconds"`)}
+	}
+	if v, ok := _c.mutation.ScheduleJitterSeconds(); ok {
+		if err := systemconfig.ScheduleJitterSecondsValidator(v); err != nil {
+			return &ValidationError{Name: "schedule_jitter_seconds", err: fmt.Errorf(`ent: validator failed for field "SystemConfig.schedule_jitter_seconds": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.CircuitBreakerThreshold(); !ok {
+		return &ValidationError{Name: "circuit_breaker_threshold", err: errors.New(`ent: missing required field "SystemConfig.circuit_breaker_threshold"`)}
+	}
+	if v, ok := _c.mutation.CircuitBreakerThreshold(); ok {
+		if err := systemconfig.CircuitBreakerThresholdValidator(v); err != nil {
+			return &ValidationError{Name: "circuit_breaker_threshold", err: fmt.Errorf(`ent: validator failed for field "SystemConfig.circuit_breaker_threshold": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.CircuitBreakerAction(); !ok {
+		return &ValidationError{Name: "circuit_breaker_action", err: errors.New(`ent: missing required field "SystemConfig.circuit_breaker_action"`)}
+	}
+	if v, ok := _c.mutation.CircuitBreakerAction(); ok {
+		if err := systemconfig.CircuitBreakerActionValidator(v); err != nil {
+			return &ValidationError{Name: "circuit_breaker_action", err: fmt.Errorf(`ent: validator failed for field "SystemConfig.circuit_breaker_action": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.CircuitBreakerBackoffMinutes(); !ok {
+		return &ValidationError{Name: "circuit_breaker_backoff_minutes", err: errors.New(`ent: missing required field "SystemConfig.circuit_breaker_backoff_minutes"`)}
+	}
+	if v, ok := _c.mutation.CircuitBreakerBackoffMinutes(); ok {
+		if err := systemconfig.CircuitBreakerBackoffMinutesValidator(v); err != nil {
+			return &ValidationError{Name: "circuit_breaker_backoff_minutes", err: fmt.Errorf(`ent: validator failed for field "SystemConfig.circuit_breaker_backoff_minutes": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.CatchUpPolicy(); !ok {
+		return &ValidationError{Name: "catch_up_policy", err: errors.New(`ent: missing required field "SystemConfig.catch_up_policy"`)}
+	}
+	if v, ok := _c.mutation.CatchUpPolicy(); ok {
+		if err := systemconfig.CatchUpPolicyValidator(v); err != nil {
+			return &ValidationError{Name: "catch_up_policy", err: fmt.Errorf(`ent: validator failed for field "SystemConfig.catch_up_policy": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.CatchUpMaxAgeMinutes(); !ok {
+		return &ValidationError{Name: "catch_up_max_age_minutes", err: errors.New(`ent: missing required field "SystemConfig.catch_up_max_age_minutes"`)}
+	}
+	if v, ok := _c.mutation.CatchUpMaxAgeMinutes(); ok {
+		if err := systemconfig.CatchUpMaxAgeMinutesValidator(v); err != nil {
+			return &ValidationError{Name: "catch_up_max_age_minutes", err: fmt.Errorf(`ent: validator failed for field "SystemConfig.catch_up_max_age_minutes": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.RequestTimeoutSeconds(); !ok {
+		return &ValidationError{Name: "request_timeout_seconds", err: errors.New(`ent: missing required field "SystemConfig.request_timeout_seconds"`)}
+	}
+	if v, ok := _c.mutation.RequestTimeoutSeconds(); ok {
+		if err := systemconfig.RequestTimeoutSecondsValidator(v); err != nil {
+			return &ValidationError{Name: "request_timeout_seconds", err: fmt.Errorf(`ent: validator failed for field "SystemConfig.request_timeout_seconds": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.MaxRetries(); !ok {
+		return &ValidationError{Name: "max_retries", err: errors.New(`ent: missing required field "SystemConfig.max_retries"`)}
+	}
+	if v, ok := _c.mutation.MaxRetries(); ok {
+		if err := systemconfig.MaxRetriesValidator(v); err != nil {
+			return &ValidationError{Name: "max_retries", err: fmt.Errorf(`ent: validator failed for field "SystemConfig.max_retries": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.RetryBackoffSeconds(); !ok {
+		return &ValidationError{Name: "retry_backoff_seconds", err: errors.New(`ent: missing required field "SystemConfig.retry_backoff_seconds"`)}
+	}
+	if v, ok := _c.mutation.RetryBackoffSeconds(); ok {
+		if err := systemconfig.RetryBackoffSecondsValidator(v); err != nil {
+			return &ValidationError{Name: "retry_backoff_seconds", err: fmt.Errorf(`ent: validator failed for field "SystemConfig.retry_backoff_seconds": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.TickIntervalSeconds(); !ok {
+		return &ValidationError{Name: "tick_interval_seconds", err: errors.New(`ent: missing required field "SystemConfig.tick_interval_seconds"`)}
+	}
+	if v, ok := _c.mutation.TickIntervalSeconds(); ok {
+		if err := systemconfig.TickIntervalSecondsValidator(v); err != nil {
+			return &ValidationError{Name: "tick_interval_seconds", err: fmt.Errorf(`ent: validator failed for field "SystemConfig.tick_interval_seconds": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.ScheduleLookaheadSeconds(); !ok {
+		return &ValidationError{Name: "schedule_lookahead_seconds", err: errors.New(`ent: missing required field "SystemConfig.schedule_lookahead_seconds"`)}
+	}
+	if v, ok := _c.mutation.ScheduleLookaheadSeconds(); ok {
+		if err := systemconfig.ScheduleLookaheadSecondsValidator(v); err != nil {
+			return &ValidationError{Name: "schedule_lookahead_seconds", err: fmt.Errorf(`ent: validator failed for field "SystemConfig.schedule_lookahead_seconds": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.MaxResponseBodyBytes(); ok {
+		if err := systemconfig.MaxResponseBodyBytesValidator(v); err != nil {
+			return &ValidationError{Name: "max_response_body_bytes", err: fmt.Errorf(`ent: validator failed for field "SystemConfig.max_response_body_bytes": %w`, err)}
+		}
+	}
 	if _, ok := _c.mutation.UpdatedAt(); !ok {
 		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "SystemConfig.updated_at"`)}
 	}
@@ -179,6 +649,94 @@ func (_c *SystemConfigCreate) createSpec() (*SystemConfig, *sqlgraph.CreateSpec)
 		_spec.SetField(systemconfig.FieldTimezone, field.TypeString, value)
 		_node.Timezone = &value
 	}
+	if value, ok := _c.mutation.Paused(); ok {
+		_spec.SetField(systemconfig.FieldPaused, field.TypeBool, value)
+		_node.Paused = value
+	}
+	if value, ok := _c.mutation.NotificationsPaused(); ok {
+		_spec.SetField(systemconfig.FieldNotificationsPaused, field.TypeBool, value)
+		_node.NotificationsPaused = value
+	}
+	if value, ok := _c.mutation.PausedAt(); ok {
+		_spec.SetField(systemconfig.FieldPausedAt, field.TypeTime, value)
+		_node.PausedAt = &value
+	}
+	if value, ok := _c.mutation.StaleAfterDays(); ok {
+		_spec.SetField(systemconfig.FieldStaleAfterDays, field.TypeInt, value)
+		_node.StaleAfterDays = value
+	}
+	if value, ok := _c.mutation.StaleNotifications(); ok {
+		_spec.SetField(systemconfig.FieldStaleNotifications, field.TypeBool, value)
+		_node.StaleNotifications = value
+	}
+	if value, ok := _c.mutation.StaleNotifiedAt(); ok {
+		_spec.SetField(systemconfig.FieldStaleNotifiedAt, field.TypeTime, value)
+		_node.StaleNotifiedAt = &value
+	}
+	if value, ok := _c.mutation.ScheduleJitterSeconds(); ok {
+		_spec.SetField(systemconfig.FieldScheduleJitterSeconds, field.TypeInt, value)
+		_node.ScheduleJitterSeconds = value
+	}
+	if value, ok := _c.mutation.CircuitBreakerThreshold(); ok {
+		_spec.SetField(systemconfig.FieldCircuitBreakerThreshold, field.TypeInt, value)
+		_node.CircuitBreakerThreshold = value
+	}
+	if value, ok := _c.mutation.CircuitBreakerAction(); ok {
+		_spec.SetField(systemconfig.FieldCircuitBreakerAction, field.TypeEnum, value)
+		_node.CircuitBreakerAction = value
+	}
+	if value, ok := _c.mutation.CircuitBreakerBackoffMinutes(); ok {
+		_spec.SetField(systemconfig.FieldCircuitBreakerBackoffMinutes, field.TypeInt, value)
+		_node.CircuitBreakerBackoffMinutes = value
+	}
+	if value, ok := _c.mutation.CatchUpPolicy(); ok {
+		_spec.SetField(systemconfig.FieldCatchUpPolicy, field.TypeEnum, value)
+		_node.CatchUpPolicy = value
+	}
+	if value, ok := _c.mutation.CatchUpMaxAgeMinutes(); ok {
+		_spec.SetField(systemconfig.FieldCatchUpMaxAgeMinutes, field.TypeInt, value)
+		_node.CatchUpMaxAgeMinutes = value
+	}
+	if value, ok := _c.mutation.DefaultUserAgent(); ok {
+		_spec.SetField(systemconfig.FieldDefaultUserAgent, field.TypeString, value)
+		_node.DefaultUserAgent = &value
+	}
+	if value, ok := _c.mutation.DefaultHeaders(); ok {
+		_spec.SetField(systemconfig.FieldDefaultHeaders, field.TypeJSON, value)
+		_node.DefaultHeaders = value
+	}
+	if value, ok := _c.mutation.ProxyURL(); ok {
+		_spec.SetField(systemconfig.FieldProxyURL, field.TypeString, value)
+		_node.ProxyURL = &value
+	}
+	if value, ok := _c.mutation.ProxyBypass(); ok {
+		_spec.SetField(systemconfig.FieldProxyBypass, field.TypeString, value)
+		_node.ProxyBypass = &value
+	}
+	if value, ok := _c.mutation.RequestTimeoutSeconds(); ok {
+		_spec.SetField(systemconfig.FieldRequestTimeoutSeconds, field.TypeInt, value)
+		_node.RequestTimeoutSeconds = value
+	}
+	if value, ok := _c.mutation.MaxRetries(); ok {
+		_spec.SetField(systemconfig.FieldMaxRetries, field.TypeInt, value)
+		_node.MaxRetries = value
+	}
+	if value, ok := _c.mutation.RetryBackoffSeconds(); ok {
+		_spec.SetField(systemconfig.FieldRetryBackoffSeconds, field.TypeInt, value)
+		_node.RetryBackoffSeconds = value
+	}
+	if value, ok := _c.mutation.TickIntervalSeconds(); ok {
+		_spec.SetField(systemconfig.FieldTickIntervalSeconds, field.TypeInt, value)
+		_node.TickIntervalSeconds = value
+	}
+	if value, ok := _c.mutation.ScheduleLookaheadSeconds(); ok {
+		_spec.SetField(systemconfig.FieldScheduleLookaheadSeconds, field.TypeInt, value)
+		_node.ScheduleLookaheadSeconds = value
+	}
+	if value, ok := _c.mutation.MaxResponseBodyBytes(); ok {
+		_spec.SetField(systemconfig.FieldMaxResponseBodyBytes, field.TypeInt, value)
+		_node.MaxResponseBodyBytes = &value
+	}
 	if value, ok := _c.mutation.UpdatedAt(); ok {
 		_spec.SetField(systemconfig.FieldUpdatedAt, field.TypeTime, value)
 		_node.UpdatedAt = value
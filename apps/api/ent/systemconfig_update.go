@@ -83,165 +83,1188 @@ func (_u *SystemConfigUpdate) ClearTimezone() *SystemConfigUpdate {
 	return _u
 }
 
+// SetPaused sets the "paused" field.
+func (_u *SystemConfigUpdate) SetPaused(v bool) *SystemConfigUpdate {
+	_u.mutation.SetPaused(v)
+	return _u
+}
+
+// SetNillablePaused sets the "paused" field if the given value is not nil.
+func (_u *SystemConfigUpdate) SetNillablePaused(v *bool) *SystemConfigUpdate {
+	if v != nil {
+		_u.SetPaused(*v)
+	}
+	return _u
+}
+
+// SetNotificationsPaused sets the "notifications_paused" field.
+func (_u *SystemConfigUpdate) SetNotificationsPaused(v bool) *SystemConfigUpdate {
+	_u.mutation.SetNotificationsPaused(v)
+	return _u
+}
+
+// SetNillableNotificationsPaused sets the "notifications_paused" field if the given value is not nil.
+func (_u *SystemConfigUpdate) SetNillableNotificationsPaused(v *bool) *SystemConfigUpdate {
+	if v != nil {
+		_u.SetNotificationsPaused(*v)
+	}
+	return _u
+}
+
+// SetPausedAt sets the "paused_at" field.
+func (_u *SystemConfigUpdate) SetPausedAt(v time.Time) *SystemConfigUpdate {
+	_u.mutation.SetPausedAt(v)
+	return _u
+}
+
+// SetNillablePausedAt sets the "paused_at" field if the given value is not nil.
+func (_u *SystemConfigUpdate) SetNillablePausedAt(v *time.Time) *SystemConfigUpdate {
+	if v != nil {
+		_u.SetPausedAt(*v)
+	}
+	return _u
+}
+
+// ClearPausedAt clears the value of the "paused_at" field.
+func (_u *SystemConfigUpdate) ClearPausedAt() *SystemConfigUpdate {
+	_u.mutation.ClearPausedAt()
+	return _u
+}
+
+// SetStaleAfterDays sets the "stale_after_days" field.
+func (_u *SystemConfigUpdate) SetStaleAfterDays(v int) *SystemConfigUpdate {
+	_u.mutation.ResetStaleAfterDays()
+	_u.mutation.SetStaleAfterDays(v)
+	return _u
+}
+
+// SetNillableStaleAfterDays sets the "stale_after_days" field if the given value is not nil.
+func (_u *SystemConfigUpdate) SetNillableStaleAfterDays(v *int) *SystemConfigUpdate {
+	if v != nil {
+		_u.SetStaleAfterDays(*v)
+	}
+	return _u
+}
+
+// AddStaleAfterDays adds value to the "stale_after_days" field.
+func (_u *SystemConfigUpdate) AddStaleAfterDays(v int) *SystemConfigUpdate {
+	_u.mutation.AddStaleAfterDays(v)
+	return _u
+}
+
+// SetStaleNotifications sets the "stale_notifications" field.
+func (_u *SystemConfigUpdate) SetStaleNotifications(v bool) *SystemConfigUpdate {
+	_u.mutation.SetStaleNotifications(v)
+	return _u
+}
+
+// SetNillableStaleNotifications sets the "stale_notifications" field if the given value is not nil.
+func (_u *SystemConfigUpdate) SetNillableStaleNotifications(v *bool) *SystemConfigUpdate {
+	if v != nil {
+		_u.SetStaleNotifications(*v)
+	}
+	return _u
+}
+
+// SetStaleNotifiedAt sets the "stale_notified_at" field.
+func (_u *SystemConfigUpdate) SetStaleNotifiedAt(v time.Time) *SystemConfigUpdate {
+	_u.mutation.SetStaleNotifiedAt(v)
+	return _u
+}
+
+// SetNillableStaleNotifiedAt sets the "stale_notified_at" field if the given value is not nil.
+func (_u *SystemConfigUpdate) SetNillableStaleNotifiedAt(v *time.Time) *SystemConfigUpdate {
+	if v != nil {
+		_u.SetStaleNotifiedAt(*v)
+	}
+	return _u
+}
+
+// ClearStaleNotifiedAt clears the value of the "stale_notified_at" field.
+func (_u *SystemConfigUpdate) ClearStaleNotifiedAt() *SystemConfigUpdate {
+	_u.mutation.ClearStaleNotifiedAt()
+	return _u
+}
+
+// SetScheduleJitterSeconds sets the "schedule_jitter_seconds" field.
+func (_u *SystemConfigUpdate) SetScheduleJitterSeconds(v int) *SystemConfigUpdate {
+	_u.mutation.ResetScheduleJitterSeconds()
+	_u.mutation.SetScheduleJitterSeconds(v)
+	return _u
+}
+
+// SetNillableScheduleJitterSeconds sets the "schedule_jitter_seconds" field if the given value is not nil.
+func (_u *SystemConfigUpdate) SetNillableScheduleJitterSeconds(v *int) *SystemConfigUpdate {
+	if v != nil {
+		_u.SetScheduleJitterSeconds(*v)
+	}
+	return _u
+}
+
+// AddScheduleJitterSeconds adds value to the "schedule_jitter_seconds" field.
+func (_u *SystemConfigUpdate) AddScheduleJitterSeconds(v int) *SystemConfigUpdate {
+	_u.mutation.AddScheduleJitterSeconds(v)
+	return _u
+}
+
+// SetCircuitBreakerThreshold sets the "circuit_breaker_threshold" field.
+func (_u *SystemConfigUpdate) SetCircuitBreakerThreshold(v int) *SystemConfigUpdate {
+	_u.mutation.ResetCircuitBreakerThreshold()
+	_u.mutation.SetCircuitBreakerThreshold(v)
+	return _u
+}
+
+// SetNillableCircuitBreakerThreshold sets the "circuit_breaker_threshold" field if the given value is not nil.
+func (_u *SystemConfigUpdate) SetNillableCircuitBreakerThreshold(v *int) *SystemConfigUpdate {
+	if v != nil {
+		_u.SetCircuitBreakerThreshold(*v)
+	}
+	return _u
+}
+
+// AddCircuitBreakerThreshold adds value to the "circuit_breaker_threshold" field.
+func (_u *SystemConfigUpdate) AddCircuitBreakerThreshold(v int) *SystemConfigUpdate {
+	_u.mutation.AddCircuitBreakerThreshold(v)
+	return _u
+}
+
+// SetCircuitBreakerAction sets the "circuit_breaker_action" field.
+func (_u *SystemConfigUpdate) SetCircuitBreakerAction(v systemconfig.CircuitBreakerAction) *SystemConfigUpdate {
+	_u.mutation.SetCircuitBreakerAction(v)
+	return _u
+}
+
+// SetNillableCircuitBreakerAction sets the "circuit_breaker_action" field if the given value is not nil.
+func (_u *SystemConfigUpdate) SetNillableCircuitBreakerAction(v *systemconfig.CircuitBreakerAction) *SystemConfigUpdate {
+	if v != nil {
+		_u.SetCircuitBreakerAction(*v)
+	}
+	return _u
+}
+
+// SetCircuitBreakerBackoffMinutes sets the "circuit_breaker_backoff_minutes" field.
+func (_u *SystemConfigUpdate) SetCircuitBreakerBackoffMinutes(v int) *SystemConfigUpdate {
+	_u.mutation.ResetCircuitBreakerBackoffMinutes()
+	_u.mutation.SetCircuitBreakerBackoffMinutes(v)
+	return _u
+}
+
+// SetNillableCircuitBreakerBackoffMinutes sets the "circuit_breaker_backoff_minutes" field if the given value is not nil.
+func (_u *SystemConfigUpdate) SetNillableCircuitBreakerBackoffMinutes(v *int) *SystemConfigUpdate {
+	if v != nil {
+		_u.SetCircuitBreakerBackoffMinutes(*v)
+	}
+	return _u
+}
+
+// AddCircuitBreakerBackoffMinutes adds value to the "circuit_breaker_backoff_minutes" field.
+func (_u *SystemConfigUpdate) AddCircuitBreakerBackoffMinutes(v int) *SystemConfigUpdate {
+	_u.mutation.AddCircuitBreakerBackoffMinutes(v)
+	return _u
+}
+
+// SetCatchUpPolicy sets the "catch_up_policy" field.
+func (_u *SystemConfigUpdate) SetCatchUpPolicy(v systemconfig.CatchUpPolicy) *SystemConfigUpdate {
+	_u.mutation.SetCatchUpPolicy(v)
+	return _u
+}
+
+// SetNillableCatchUpPolicy sets the "catch_up_policy" field if the given value is not nil.
+func (_u *SystemConfigUpdate) SetNillableCatchUpPolicy(v *systemconfig.CatchUpPolicy) *SystemConfigUpdate {
+	if v != nil {
+		_u.SetCatchUpPolicy(*v)
+	}
+	return _u
+}
+
+// SetCatchUpMaxAgeMinutes sets the "catch_up_max_age_minutes" field.
+func (_u *SystemConfigUpdate) SetCatchUpMaxAgeMinutes(v int) *SystemConfigUpdate {
+	_u.mutation.ResetCatchUpMaxAgeMinutes()
+	_u.mutation.SetCatchUpMaxAgeMinutes(v)
+	return _u
+}
+
+// SetNillableCatchUpMaxAgeMinutes sets the "catch_up_max_age_minutes" field if the given value is not nil.
+func (_u *SystemConfigUpdate) SetNillableCatchUpMaxAgeMinutes(v *int) *SystemConfigUpdate {
+	if v != nil {
+		_u.SetCatchUpMaxAgeMinutes(*v)
+	}
+	return _u
+}
+
+// AddCatchUpMaxAgeMinutes adds value to the "catch_up_max_age_minutes" field.
+func (_u *SystemConfigUpdate) AddCatchUpMaxAgeMinutes(v int) *SystemConfigUpdate {
+	_u.mutation.AddCatchUpMaxAgeMinutes(v)
+	return _u
+}
+
+// SetDefaultUserAgent sets the "default_user_agent" field.
+func (_u *SystemConfigUpdate) SetDefaultUserAgent(v string) *SystemConfigUpdate {
+	_u.mutation.SetDefaultUserAgent(v)
+	return _u
+}
+
+// SetNillableDefaultUserAgent sets the "default_user_agent" field if the given value is not nil.
+func (_u *SystemConfigUpdate) SetNillableDefaultUserAgent(v *string) *SystemConfigUpdate {
+	if v != nil {
+		_u.SetDefaultUserAgent(*v)
+	}
+	return _u
+}
+
+// ClearDefaultUserAgent clears the value of the "default_user_agent" field.
+func (_u *SystemConfigUpdate) ClearDefaultUserAgent() *SystemConfigUpdate {
+	_u.mutation.ClearDefaultUserAgent()
+	return _u
+}
+
+// SetDefaultHeaders sets the "default_headers" field.
+func (_u *SystemConfigUpdate) SetDefaultHeaders(v map[string]string) *SystemConfigUpdate {
+	_u.mutation.SetDefaultHeaders(v)
+	return _u
+}
+
+// ClearDefaultHeaders clears the value of the "default_headers" field.
+func (_u *SystemConfigUpdate) ClearDefaultHeaders() *SystemConfigUpdate {
+	_u.mutation.ClearDefaultHeaders()
+	return _u
+}
+
+// SetProxyURL sets the "proxy_url" field.
+func (_u *SystemConfigUpdate) SetProxyURL(v string) *SystemConfigUpdate {
+	_u.mutation.SetProxyURL(v)
+	return _u
+}
+
+// SetNillableProxyURL sets the "proxy_url" field if the given value is not nil.
+func (_u *SystemConfigUpdate) SetNillableProxyURL(v *string) *SystemConfigUpdate {
+	if v != nil {
+		_u.SetProxyURL(*v)
+	}
+	return _u
+}
+
+// ClearProxyURL clears the value of the "proxy_url" field.
+func (_u *SystemConfigUpdate) ClearProxyURL() *SystemConfigUpdate {
+	_u.mutation.ClearProxyURL()
+	return _u
+}
+
+// SetProxyBypass sets the "proxy_bypass" field.
+func (_u *SystemConfigUpdate) SetProxyBypass(v string) *SystemConfigUpdate {
+	_u.mutation.SetProxyBypass(v)
+	return _u
+}
+
+// SetNillableProxyBypass sets the "proxy_bypass" field if the given value is not nil.
+func (_u *SystemConfigUpdate) SetNillableProxyBypass(v *string) *SystemConfigUpdate {
+	if v != nil {
+		_u.SetProxyBypass(*v)
+	}
+	return _u
+}
+
+// ClearProxyBypass clears the value of the "proxy_bypass" field.
+func (_u *SystemConfigUpdate) ClearProxyBypass() *SystemConfigUpdate {
+	_u.mutation.ClearProxyBypass()
+	return _u
+}
+
+// SetRequestTimeoutSeconds sets the "request_timeout_seconds" field.
+func (_u *SystemConfigUpdate) SetRequestTimeoutSeconds(v int) *SystemConfigUpdate {
+	_u.mutation.ResetRequestTimeoutSeconds()
+	_u.mutation.SetRequestTimeoutSeconds(v)
+	return _u
+}
+
+// SetNillableRequestTimeoutSeconds sets the "request_timeout_seconds" field if the given value is not nil.
+func (_u *SystemConfigUpdate) SetNillableRequestTimeoutSeconds(v *int) *SystemConfigUpdate {
+	if v != nil {
+		_u.SetRequestTimeoutSeconds(*v)
+	}
+	return _u
+}
+
+// AddRequestTimeoutSeconds adds value to the "request_timeout_seconds" field.
+func (_u *SystemConfigUpdate) AddRequestTimeoutSeconds(v int) *SystemConfigUpdate {
+	_u.mutation.AddRequestTimeoutSeconds(v)
+	return _u
+}
+
+// SetMaxRetries sets the "max_retries" field.
+func (_u *SystemConfigUpdate) SetMaxRetries(v int) *SystemConfigUpdate {
+	_u.mutation.ResetMaxRetries()
+	_u.mutation.SetMaxRetries(v)
+	return _u
+}
+
+// SetNillableMaxRetries sets the "max_retries" field if the given value is not nil.
+func (_u *SystemConfigUpdate) SetNillableMaxRetries(v *int) *SystemConfigUpdate {
+	if v != nil {
+		_u.SetMaxRetries(*v)
+	}
+	return _u
+}
+
+// AddMaxRetries adds value to the "max_retries" field.
+func (_u *SystemConfigUpdate) AddMaxRetries(v int) *SystemConfigUpdate {
+	_u.mutation.AddMaxRetries(v)
+	return _u
+}
+
+// SetRetryBackoffSeconds sets the "retry_backoff_seconds" field.
+func (_u *SystemConfigUpdate) SetRetryBackoffSeconds(v int) *SystemConfigUpdate {
+	_u.mutation.ResetRetryBackoffSeconds()
+	_u.mutation.SetRetryBackoffSeconds(v)
+	return _u
+}
+
+// SetNillableRetryBackoffSeconds sets the "retry_backoff_seconds" field if the given value is not nil.
+func (_u *SystemConfigUpdate) SetNillableRetryBackoffSeconds(v *int) *SystemConfigUpdate {
+	if v != nil {
+		_u.SetRetryBackoffSeconds(*v)
+	}
+	return _u
+}
+
+// AddRetryBackoffSeconds adds value to the "retry_backoff_seconds" field.
+func (_u *SystemConfigUpdate) AddRetryBackoffSeconds(v int) *SystemConfigUpdate {
+	_u.mutation.AddRetryBackoffSeconds(v)
+	return _u
+}
+
+// SetTickIntervalSeconds sets the "tick_interval_seconds" field.
+func (_u *SystemConfigUpdate) SetTickIntervalSeconds(v int) *SystemConfigUpdate {
+	_u.mutation.ResetTickIntervalSeconds()
+	_u.mutation.SetTickIntervalSeconds(v)
+	return _u
+}
+
+// SetNillableTickIntervalSeconds sets the "tick_interval_seconds" field if the given value is not nil.
+func (_u *SystemConfigUpdate) SetNillableTickIntervalSeconds(v *int) *SystemConfigUpdate {
+	if v != nil {
+		_u.SetTickIntervalSeconds(*v)
+	}
+	return _u
+}
+
+// AddTickIntervalSeconds adds value to the "tick_interval_seconds" field.
+func (_u *SystemConfigUpdate) AddTickIntervalSeconds(v int) *SystemConfigUpdate {
+	_u.mutation.AddTickIntervalSeconds(v)
+	return _u
+}
+
+// SetScheduleLookaheadSeconds sets the "schedule_lookahead_seconds" field.
+func (_u *SystemConfigUpdate) SetScheduleLookaheadSeconds(v int) *SystemConfigUpdate {
+	_u.mutation.ResetScheduleLookaheadSeconds()
+	_u.mutation.SetScheduleLookaheadSeconds(v)
+	return _u
+}
+
+// SetNillableScheduleLookaheadSeconds sets the "schedule_lookahead_seconds" field if the given value is not nil.
+func (_u *SystemConfigUpdate) SetNillableScheduleLookaheadSeconds(v *int) *SystemConfigUpdate {
+	if v != nil {
+		_u.SetScheduleLookaheadSeconds(*v)
+	}
+	return _u
+}
+
+// AddScheduleLookaheadSeconds adds value to the "schedule_lookahead_seconds" field.
+func (_u *SystemConfigUpdate) AddScheduleLookaheadSeconds(v int) *SystemConfigUpdate {
+	_u.mutation.AddScheduleLookaheadSeconds(v)
+	return _u
+}
+
+// SetMaxResponseBodyBytes sets the "max_response_body_bytes" field.
+func (_u *SystemConfigUpdate) SetMaxResponseBodyBytes(v int) *SystemConfigUpdate {
+	_u.mutation.ResetMaxResponseBodyBytes()
+	_u.mutation.SetMaxResponseBodyBytes(v)
+	return _u
+}
+
+// SetNillableMaxResponseBodyBytes sets the "max_response_body_bytes" field if the given value is not nil.
+func (_u *SystemConfigUpdate) SetNillableMaxResponseBodyBytes(v *int) *SystemConfigUpdate {
+	if v != nil {
+		_u.SetMaxResponseBodyBytes(*v)
+	}
+	return _u
+}
+
+// AddMaxResponseBodyBytes adds value to the "max_response_body_bytes" field.
+func (_u *SystemConfigUpdate) AddMaxResponseBodyBytes(v int) *SystemConfigUpdate {
+	_u.mutation.AddMaxResponseBodyBytes(v)
+	return _u
+}
+
+// ClearMaxResponseBodyBytes clears the value of the "max_response_body_bytes" field.
+func (_u *SystemConfigUpdate) ClearMaxResponseBodyBytes() *SystemConfigUpdate {
+	_u.mutation.ClearMaxResponseBodyBytes()
+	return _u
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (_u *SystemConfigUpdate) SetUpdatedAt(v time.Time) *SystemConfigUpdate {
 	_u.mutation.SetUpdatedAt(v)
 	return _u
 }
 
-// Mutation returns the SystemConfigMutation object of the builder.
-func (_u *SystemConfigUpdate) Mutation() *SystemConfigMutation {
-	return _u.mutation
+// Mutation returns the SystemConfigMutation object of the builder.
+func (_u *SystemConfigUpdate) Mutation() *SystemConfigMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *SystemConfigUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *SystemConfigUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *SystemConfigUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *SystemConfigUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *SystemConfigUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := systemconfig.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *SystemConfigUpdate) check() error {
+	if v, ok := _u.mutation.ChecksHistoryLimit(); ok {
+		if err := systemconfig.ChecksHistoryLimitValidator(v); err != nil {
+			return &ValidationError{Name: "checks_history_limit", err: fmt.Errorf(`ent: validator failed for field "SystemConfig.checks_history_limit": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.StaleAfterDays(); ok {
+		if err := systemconfig.StaleAfterDaysValidator(v); err != nil {
+			return &ValidationError{Name: "stale_after_days", err: fmt.Errorf(`ent: validator failed for field "SystemConfig.stale_after_days": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ScheduleJitterSeconds(); ok {
+		if err := systemconfig.ScheduleJitterSecondsValidator(v); err != nil {
+			return &ValidationError{Name: "schedule_jitter_seconds", err: fmt.Errorf(`ent: validator failed for field "SystemConfig.schedule_jitter_seconds": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.CircuitBreakerThreshold(); ok {
+		if err := systemconfig.CircuitBreakerThresholdValidator(v); err != nil {
+			return &ValidationError{Name: "circuit_breaker_threshold", err: fmt.Errorf(`ent: validator failed for field "SystemConfig.circuit_breaker_threshold": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.CircuitBreakerAction(); ok {
+		if err := systemconfig.CircuitBreakerActionValidator(v); err != nil {
+			return &ValidationError{Name: "circuit_breaker_action", err: fmt.Errorf(`ent: validator failed for field "SystemConfig.circuit_breaker_action": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.CircuitBreakerBackoffMinutes(); ok {
+		if err := systemconfig.CircuitBreakerBackoffMinutesValidator(v); err != nil {
+			return &ValidationError{Name: "circuit_breaker_backoff_minutes", err: fmt.Errorf(`ent: validator failed for field "SystemConfig.circuit_breaker_backoff_minutes": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.CatchUpPolicy(); ok {
+		if err := systemconfig.CatchUpPolicyValidator(v); err != nil {
+			return &ValidationError{Name: "catch_up_policy", err: fmt.Errorf(`ent: validator failed for field "SystemConfig.catch_up_policy": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.CatchUpMaxAgeMinutes(); ok {
+		if err := systemconfig.CatchUpMaxAgeMinutesValidator(v); err != nil {
+			return &ValidationError{Name: "catch_up_max_age_minutes", err: fmt.Errorf(`ent: validator failed for field "SystemConfig.catch_up_max_age_minutes": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.RequestTimeoutSeconds(); ok {
+		if err := systemconfig.RequestTimeoutSecondsValidator(v); err != nil {
+			return &ValidationError{Name: "request_timeout_seconds", err: fmt.Errorf(`ent: validator failed for field "SystemConfig.request_timeout_seconds": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.MaxRetries(); ok {
+		if err := systemconfig.MaxRetriesValidator(v); err != nil {
+			return &ValidationError{Name: "max_retries", err: fmt.Errorf(`ent: validator failed for field "SystemConfig.max_retries": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.RetryBackoffSeconds(); ok {
+		if err := systemconfig.RetryBackoffSecondsValidator(v); err != nil {
+			return &ValidationError{Name: "retry_backoff_seconds", err: fmt.Errorf(`ent: validator failed for field "SystemConfig.retry_backoff_seconds": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.TickIntervalSeconds(); ok {
+		if err := systemconfig.TickIntervalSecondsValidator(v); err != nil {
+			return &ValidationError{Name: "tick_interval_seconds", err: fmt.Errorf(`ent: validator failed for field "SystemConfig.tick_interval_seconds": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ScheduleLookaheadSeconds(); ok {
+		if err := systemconfig.ScheduleLookaheadSecondsValidator(v); err != nil {
+			return &ValidationError{Name: "schedule_lookahead_seconds", err: fmt.Errorf(`ent: validator failed for field "SystemConfig.schedule_lookahead_seconds": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.MaxResponseBodyBytes(); ok {
+		if err := systemconfig.MaxResponseBodyBytesValidator(v); err != nil {
+			return &ValidationError{Name: "max_response_body_bytes", err: fmt.Errorf(`ent: validator failed for field "SystemConfig.max_response_body_bytes": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *SystemConfigUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(systemconfig.Table, systemconfig.Columns, sqlgraph.NewFieldSpec(systemconfig.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Key(); ok {
+		_spec.SetField(systemconfig.FieldKey, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ChecksHistoryLimit(); ok {
+		_spec.SetField(systemconfig.FieldChecksHistoryLimit, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedChecksHistoryLimit(); ok {
+		_spec.AddField(systemconfig.FieldChecksHistoryLimit, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Timezone(); ok {
+		_spec.SetField(systemconfig.FieldTimezone, field.TypeString, value)
+	}
+	if _u.mutation.TimezoneCleared() {
+		_spec.ClearField(systemconfig.FieldTimezone, field.TypeString)
+	}
+	if value, ok := _u.mutation.Paused(); ok {
+		_spec.SetField(systemconfig.FieldPaused, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.NotificationsPaused(); ok {
+		_spec.SetField(systemconfig.FieldNotificationsPaused, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.PausedAt(); ok {
+		_spec.SetField(systemconfig.FieldPausedAt, field.TypeTime, value)
+	}
+	if _u.mutation.PausedAtCleared() {
+		_spec.ClearField(systemconfig.FieldPausedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.StaleAfterDays(); ok {
+		_spec.SetField(systemconfig.FieldStaleAfterDays, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedStaleAfterDays(); ok {
+		_spec.AddField(systemconfig.FieldStaleAfterDays, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.StaleNotifications(); ok {
+		_spec.SetField(systemconfig.FieldStaleNotifications, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.StaleNotifiedAt(); ok {
+		_spec.SetField(systemconfig.FieldStaleNotifiedAt, field.TypeTime, value)
+	}
+	if _u.mutation.StaleNotifiedAtCleared() {
+		_spec.ClearField(systemconfig.FieldStaleNotifiedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.ScheduleJitterSeconds(); ok {
+		_spec.SetField(systemconfig.FieldScheduleJitterSeconds, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedScheduleJitterSeconds(); ok {
+		_spec.AddField(systemconfig.FieldScheduleJitterSeconds, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.CircuitBreakerThreshold(); ok {
+		_spec.SetField(systemconfig.FieldCircuitBreakerThreshold, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedCircuitBreakerThreshold(); ok {
+		_spec.AddField(systemconfig.FieldCircuitBreakerThreshold, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.CircuitBreakerAction(); ok {
+		_spec.SetField(systemconfig.FieldCircuitBreakerAction, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.CircuitBreakerBackoffMinutes(); ok {
+		_spec.SetField(systemconfig.FieldCircuitBreakerBackoffMinutes, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedCircuitBreakerBackoffMinutes(); ok {
+		_spec.AddField(systemconfig.FieldCircuitBreakerBackoffMinutes, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.CatchUpPolicy(); ok {
+		_spec.SetField(systemconfig.FieldCatchUpPolicy, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.CatchUpMaxAgeMinutes(); ok {
+		_spec.SetField(systemconfig.FieldCatchUpMaxAgeMinutes, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedCatchUpMaxAgeMinutes(); ok {
+		_spec.AddField(systemconfig.FieldCatchUpMaxAgeMinutes, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.DefaultUserAgent(); ok {
+		_spec.SetField(systemconfig.FieldDefaultUserAgent, field.TypeString, value)
+	}
+	if _u.mutation.DefaultUserAgentCleared() {
+		_spec.ClearField(systemconfig.FieldDefaultUserAgent, field.TypeString)
+	}
+	if value, ok := _u.mutation.DefaultHeaders(); ok {
+		_spec.SetField(systemconfig.FieldDefaultHeaders, field.TypeJSON, value)
+	}
+	if _u.mutation.DefaultHeadersCleared() {
+		_spec.ClearField(systemconfig.FieldDefaultHeaders, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.ProxyURL(); ok {
+		_spec.SetField(systemconfig.FieldProxyURL, field.TypeString, value)
+	}
+	if _u.mutation.ProxyURLCleared() {
+		_spec.ClearField(systemconfig.FieldProxyURL, field.TypeString)
+	}
+	if value, ok := _u.mutation.ProxyBypass(); ok {
+		_spec.SetField(systemconfig.FieldProxyBypass, field.TypeString, value)
+	}
+	if _u.mutation.ProxyBypassCleared() {
+		_spec.ClearField(systemconfig.FieldProxyBypass, field.TypeString)
+	}
+	if value, ok := _u.mutation.RequestTimeoutSeconds(); ok {
+		_spec.SetField(systemconfig.FieldRequestTimeoutSeconds, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedRequestTimeoutSeconds(); ok {
+		_spec.AddField(systemconfig.FieldRequestTimeoutSeconds, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.MaxRetries(); ok {
+		_spec.SetField(systemconfig.FieldMaxRetries, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedMaxRetries(); ok {
+		_spec.AddField(systemconfig.FieldMaxRetries, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.RetryBackoffSeconds(); ok {
+		_spec.SetField(systemconfig.FieldRetryBackoffSeconds, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedRetryBackoffSeconds(); ok {
+		_spec.AddField(systemconfig.FieldRetryBackoffSeconds, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.TickIntervalSeconds(); ok {
+		_spec.SetField(systemconfig.FieldTickIntervalSeconds, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedTickIntervalSeconds(); ok {
+		_spec.AddField(systemconfig.FieldTickIntervalSeconds, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.ScheduleLookaheadSeconds(); ok {
+		_spec.SetField(systemconfig.FieldScheduleLookaheadSeconds, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedScheduleLookaheadSeconds(); ok {
+		_spec.AddField(systemconfig.FieldScheduleLookaheadSeconds, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.MaxResponseBodyBytes(); ok {
+		_spec.SetField(systemconfig.FieldMaxResponseBodyBytes, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedMaxResponseBodyBytes(); ok {
+		_spec.AddField(systemconfig.FieldMaxResponseBodyBytes, field.TypeInt, value)
+	}
+	if _u.mutation.MaxResponseBodyBytesCleared() {
+		_spec.ClearField(systemconfig.FieldMaxResponseBodyBytes, field.TypeInt)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(systemconfig.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{systemconfig.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// SystemConfigUpdateOne is the builder for updating a single SystemConfig entity.
+type SystemConfigUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *SystemConfigMutation
+}
+
+// SetKey sets the "key" field.
+func (_u *SystemConfigUpdateOne) SetKey(v string) *SystemConfigUpdateOne {
+	_u.mutation.SetKey(v)
+	return _u
+}
+
+// SetNillableKey sets the "key" field if the given value is not nil.
+func (_u *SystemConfigUpdateOne) SetNillableKey(v *string) *SystemConfigUpdateOne {
+	if v != nil {
+		_u.SetKey(*v)
+	}
+	return _u
+}
+
+// SetChecksHistoryLimit sets the "checks_history_limit" field.
+func (_u *SystemConfigUpdateOne) SetChecksHistoryLimit(v int) *SystemConfigUpdateOne {
+	_u.mutation.ResetChecksHistoryLimit()
+	_u.mutation.SetChecksHistoryLimit(v)
+	return _u
+}
+
+// SetNillableChecksHistoryLimit sets the "checks_history_limit" field if the given value is not nil.
+func (_u *SystemConfigUpdateOne) SetNillableChecksHistoryLimit(v *int) *SystemConfigUpdateOne {
+	if v != nil {
+		_u.SetChecksHistoryLimit(*v)
+	}
+	return _u
+}
+
+// AddChecksHistoryLimit adds value to the "checks_history_limit" field.
+func (_u *SystemConfigUpdateOne) AddChecksHistoryLimit(v int) *SystemConfigUpdateOne {
+	_u.mutation.AddChecksHistoryLimit(v)
+	return _u
+}
+
+// SetTimezone sets the "timezone" field.
+func (_u *SystemConfigUpdateOne) SetTimezone(v string) *SystemConfigUpdateOne {
+	_u.mutation.SetTimezone(v)
+	return _u
+}
+
+// SetNillableTimezone sets the "timezone" field if the given value is not nil.
+func (_u *SystemConfigUpdateOne) SetNillableTimezone(v *string) *SystemConfigUpdateOne {
+	if v != nil {
+		_u.SetTimezone(*v)
+	}
+	return _u
+}
+
+// ClearTimezone clears the value of the "timezone" field.
+func (_u *SystemConfigUpdateOne) ClearTimezone() *SystemConfigUpdateOne {
+	_u.mutation.ClearTimezone()
+	return _u
+}
+
+// SetPaused sets the "paused" field.
+func (_u *SystemConfigUpdateOne) SetPaused(v bool) *SystemConfigUpdateOne {
+	_u.mutation.SetPaused(v)
+	return _u
+}
+
+// SetNillablePaused sets the "paused" field if the given value is not nil.
+func (_u *SystemConfigUpdateOne) SetNillablePaused(v *bool) *SystemConfigUpdateOne {
+	if v != nil {
+		_u.SetPaused(*v)
+	}
+	return _u
+}
+
+// SetNotificationsPaused sets the "notifications_paused" field.
+func (_u *SystemConfigUpdateOne) SetNotificationsPaused(v bool) *SystemConfigUpdateOne {
+	_u.mutation.SetNotificationsPaused(v)
+	return _u
+}
+
+// SetNillableNotificationsPaused sets the "notifications_paused" field if the given value is not nil.
+func (_u *SystemConfigUpdateOne) SetNillableNotificationsPaused(v *bool) *SystemConfigUpdateOne {
+	if v != nil {
+		_u.SetNotificationsPaused(*v)
+	}
+	return _u
+}
+
+// SetPausedAt sets the "paused_at" field.
+func (_u *SystemConfigUpdateOne) SetPausedAt(v time.Time) *SystemConfigUpdateOne {
+	_u.mutation.SetPausedAt(v)
+	return _u
+}
+
+// SetNillablePausedAt sets the "paused_at" field if the given value is not nil.
+func (_u *SystemConfigUpdateOne) SetNillablePausedAt(v *time.Time) *SystemConfigUpdateOne {
+	if v != nil {
+		_u.SetPausedAt(*v)
+	}
+	return _u
+}
+
+// ClearPausedAt clears the value of the "paused_at" field.
+func (_u *SystemConfigUpdateOne) ClearPausedAt() *SystemConfigUpdateOne {
+	_u.mutation.ClearPausedAt()
+	return _u
+}
+
+// SetStaleAfterDays sets the "stale_after_days" field.
+func (_u *SystemConfigUpdateOne) SetStaleAfterDays(v int) *SystemConfigUpdateOne {
+	_u.mutation.ResetStaleAfterDays()
+	_u.mutation.SetStaleAfterDays(v)
+	return _u
+}
+
+// SetNillableStaleAfterDays sets the "stale_after_days" field if the given value is not nil.
+func (_u *SystemConfigUpdateOne) SetNillableStaleAfterDays(v *int) *SystemConfigUpdateOne {
+	if v != nil {
+		_u.SetStaleAfterDays(*v)
+	}
+	return _u
+}
+
+// AddStaleAfterDays adds value to the "stale_after_days" field.
+func (_u *SystemConfigUpdateOne) AddStaleAfterDays(v int) *SystemConfigUpdateOne {
+	_u.mutation.AddStaleAfterDays(v)
+	return _u
+}
+
+// SetStaleNotifications sets the "stale_notifications" field.
+func (_u *SystemConfigUpdateOne) SetStaleNotifications(v bool) *SystemConfigUpdateOne {
+	_u.mutation.SetStaleNotifications(v)
+	return _u
+}
+
+// SetNillableStaleNotifications sets the "stale_notifications" field if the given value is not nil.
+func (_u *SystemConfigUpdateOne) SetNillableStaleNotifications(v *bool) *SystemConfigUpdateOne {
+	if v != nil {
+		_u.SetStaleNotifications(*v)
+	}
+	return _u
+}
+
+// SetStaleNotifiedAt sets the "stale_notified_at" field.
+func (_u *SystemConfigUpdateOne) SetStaleNotifiedAt(v time.Time) *SystemConfigUpdateOne {
+	_u.mutation.SetStaleNotifiedAt(v)
+	return _u
+}
+
+// SetNillableStaleNotifiedAt sets the "stale_notified_at" field if the given value is not nil.
+func (_u *SystemConfigUpdateOne) SetNillableStaleNotifiedAt(v *time.Time) *SystemConfigUpdateOne {
+	if v != nil {
+		_u.SetStaleNotifiedAt(*v)
+	}
+	return _u
+}
+
+// ClearStaleNotifiedAt clears the value of the "stale_notified_at" field.
+func (_u *SystemConfigUpdateOne) ClearStaleNotifiedAt() *SystemConfigUpdateOne {
+	_u.mutation.ClearStaleNotifiedAt()
+	return _u
+}
+
+// SetScheduleJitterSeconds sets the "schedule_jitter_seconds" field.
+func (_u *SystemConfigUpdateOne) SetScheduleJitterSeconds(v int) *SystemConfigUpdateOne {
+	_u.mutation.ResetScheduleJitterSeconds()
+	_u.mutation.SetScheduleJitterSeconds(v)
+	return _u
+}
+
+// SetNillableScheduleJitterSeconds sets the "schedule_jitter_seconds" field if the given value is not nil.
+func (_u *SystemConfigUpdateOne) SetNillableScheduleJitterSeconds(v *int) *SystemConfigUpdateOne {
+	if v != nil {
+		_u.SetScheduleJitterSeconds(*v)
+	}
+	return _u
+}
+
+// AddScheduleJitterSeconds adds value to the "schedule_jitter_seconds" field.
+func (_u *SystemConfigUpdateOne) AddScheduleJitterSeconds(v int) *SystemConfigUpdateOne {
+	_u.mutation.AddScheduleJitterSeconds(v)
+	return _u
+}
+
+// SetCircuitBreakerThreshold sets the "circuit_breaker_threshold" field.
+func (_u *SystemConfigUpdateOne) SetCircuitBreakerThreshold(v int) *SystemConfigUpdateOne {
+	_u.mutation.ResetCircuitBreakerThreshold()
+	_u.mutation.SetCircuitBreakerThreshold(v)
+	return _u
+}
+
+// SetNillableCircuitBreakerThreshold sets the "circuit_breaker_threshold" field if the given value is not nil.
+func (_u *SystemConfigUpdateOne) SetNillableCircuitBreakerThreshold(v *int) *SystemConfigUpdateOne {
+	if v != nil {
+		_u.SetCircuitBreakerThreshold(*v)
+	}
+	return _u
+}
+
+// AddCircuitBreakerThreshold adds value to the "circuit_breaker_threshold" field.
+func (_u *SystemConfigUpdateOne) AddCircuitBreakerThreshold(v int) *SystemConfigUpdateOne {
+	_u.mutation.AddCircuitBreakerThreshold(v)
+	return _u
 }
 
-// Save executes the query and returns the number of nodes affected by the update operation.
-func (_u *SystemConfigUpdate) Save(ctx context.Context) (int, error) {
-	_u.defaults()
-	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+// SetCircuitBreakerAction sets the "circuit_breaker_action" field.
+func (_u *SystemConfigUpdateOne) SetCircuitBreakerAction(v systemconfig.CircuitBreakerAction) *SystemConfigUpdateOne {
+	_u.mutation.SetCircuitBreakerAction(v)
+	return _u
 }
 
-// SaveX is like Save, but panics if an error occurs.
-func (_u *SystemConfigUpdate) SaveX(ctx context.Context) int {
-	affected, err := _u.Save(ctx)
-	if err != nil {
-		panic(err)
+// SetNillableCircuitBreakerAction sets the "circuit_breaker_action" field if the given value is not nil.
+func (_u *SystemConfigUpdateOne) SetNillableCircuitBreakerAction(v *systemconfig.CircuitBreakerAction) *SystemConfigUpdateOne {
+	if v != nil {
+		_u.SetCircuitBreakerAction(*v)
 	}
-	return affected
+	return _u
 }
 
-// Exec executes the query.
-func (_u *SystemConfigUpdate) Exec(ctx context.Context) error {
-	_, err := _u.Save(ctx)
-	return err
+// SetCircuitBreakerBackoffMinutes sets the "circuit_breaker_backoff_minutes" field.
+func (_u *SystemConfigUpdateOne) SetCircuitBreakerBackoffMinutes(v int) *SystemConfigUpdateOne {
+	_u.mutation.ResetCircuitBreakerBackoffMinutes()
+	_u.mutation.SetCircuitBreakerBackoffMinutes(v)
+	return _u
 }
 
-// ExecX is like Exec, but panics if an error occurs.
-func (_u *SystemConfigUpdate) ExecX(ctx context.Context) {
-	if err := _u.Exec(ctx); err != nil {
-		panic(err)
+// SetNillableCircuitBreakerBackoffMinutes sets the "circuit_breaker_backoff_minutes" field if the given value is not nil.
+func (_u *SystemConfigUpdateOne) SetNillableCircuitBreakerBackoffMinutes(v *int) *SystemConfigUpdateOne {
+	if v != nil {
+		_u.SetCircuitBreakerBackoffMinutes(*v)
 	}
+	return _u
 }
 
-// defaults sets the default values of the builder before save.
-func (_u *SystemConfigUpdate) defaults() {
-	if _, ok := _u.mutation.UpdatedAt(); !ok {
-		v := systemconfig.UpdateDefaultUpdatedAt()
-		_u.mutation.SetUpdatedAt(v)
-	}
+// AddCircuitBreakerBackoffMinutes adds value to the "circuit_breaker_backoff_minutes" field.
+func (_u *SystemConfigUpdateOne) AddCircuitBreakerBackoffMinutes(v int) *SystemConfigUpdateOne {
+	_u.mutation.AddCircuitBreakerBackoffMinutes(v)
+	return _u
 }
 
-// check runs all checks and user-defined validators on the builder.
-func (_u *SystemConfigUpdate) check() error {
-	if v, ok := _u.mutation.ChecksHistoryLimit(); ok {
-		if err := systemconfig.ChecksHistoryLimitValidator(v); err != nil {
-			return &ValidationError{Name: "checks_history_limit", err: fmt.Errorf(`ent: validator failed for field "SystemConfig.checks_history_limit": %w`, err)}
-		}
-	}
-	return nil
+// SetCatchUpPolicy sets the "catch_up_policy" field.
+func (_u *SystemConfigUpdateOne) SetCatchUpPolicy(v systemconfig.CatchUpPolicy) *SystemConfigUpdateOne {
+	_u.mutation.SetCatchUpPolicy(v)
+	return _u
 }
 
-func (_u *SystemConfigUpdate) sqlSave(ctx context.Context) (_node int, err error) {
-	if err := _u.check(); err != nil {
-		return _node, err
-	}
-	_spec := sqlgraph.NewUpdateSpec(systemconfig.Table, systemconfig.Columns, sqlgraph.NewFieldSpec(systemconfig.FieldID, field.TypeInt))
-	if ps := _u.mutation.predicates; len(ps) > 0 {
-		_spec.Predicate = func(selector *sql.Selector) {
-			for i := range ps {
-				ps[i](selector)
-			}
-		}
+// SetNillableCatchUpPolicy sets the "catch_up_policy" field if the given value is not nil.
+func (_u *SystemConfigUpdateOne) SetNillableCatchUpPolicy(v *systemconfig.CatchUpPolicy) *SystemConfigUpdateOne {
+	if v != nil {
+		_u.SetCatchUpPolicy(*v)
 	}
-	if value, ok := _u.mutation.Key(); ok {
-		_spec.SetField(systemconfig.FieldKey, field.TypeString, value)
+	return _u
+}
+
+// SetCatchUpMaxAgeMinutes sets the "catch_up_max_age_minutes" field.
+func (_u *SystemConfigUpdateOne) SetCatchUpMaxAgeMinutes(v int) *SystemConfigUpdateOne {
+	_u.mutation.ResetCatchUpMaxAgeMinutes()
+	_u.mutation.SetCatchUpMaxAgeMinutes(v)
+	return _u
+}
+
+// SetNillableCatchUpMaxAgeMinutes sets the "catch_up_max_age_minutes" field if the given value is not nil.
+func (_u *SystemConfigUpdateOne) SetNillableCatchUpMaxAgeMinutes(v *int) *SystemConfigUpdateOne {
+	if v != nil {
+		_u.SetCatchUpMaxAgeMinutes(*v)
 	}
-	if value, ok := _u.mutation.ChecksHistoryLimit(); ok {
-		_spec.SetField(systemconfig.FieldChecksHistoryLimit, field.TypeInt, value)
+	return _u
+}
+
+// AddCatchUpMaxAgeMinutes adds value to the "catch_up_max_age_minutes" field.
+func (_u *SystemConfigUpdateOne) AddCatchUpMaxAgeMinutes(v int) *SystemConfigUpdateOne {
+	_u.mutation.AddCatchUpMaxAgeMinutes(v)
+	return _u
+}
+
+// SetDefaultUserAgent sets the "default_user_agent" field.
+func (_u *SystemConfigUpdateOne) SetDefaultUserAgent(v string) *SystemConfigUpdateOne {
+	_u.mutation.SetDefaultUserAgent(v)
+	return _u
+}
+
+// SetNillableDefaultUserAgent sets the "default_user_agent" field if the given value is not nil.
+func (_u *SystemConfigUpdateOne) SetNillableDefaultUserAgent(v *string) *SystemConfigUpdateOne {
+	if v != nil {
+		_u.SetDefaultUserAgent(*v)
 	}
-	if value, ok := _u.mutation.AddedChecksHistoryLimit(); ok {
-		_spec.AddField(systemconfig.FieldChecksHistoryLimit, field.TypeInt, value)
+	return _u
+}
+
+// ClearDefaultUserAgent clears the value of the "default_user_agent" field.
+func (_u *SystemConfigUpdateOne) ClearDefaultUserAgent() *SystemConfigUpdateOne {
+	_u.mutation.ClearDefaultUserAgent()
+	return _u
+}
+
+// SetDefaultHeaders sets the "default_headers" field.
+func (_u *SystemConfigUpdateOne) SetDefaultHeaders(v map[string]string) *SystemConfigUpdateOne {
+	_u.mutation.SetDefaultHeaders(v)
+	return _u
+}
+
+// ClearDefaultHeaders clears the value of the "default_headers" field.
+func (_u *SystemConfigUpdateOne) ClearDefaultHeaders() *SystemConfigUpdateOne {
+	_u.mutation.ClearDefaultHeaders()
+	return _u
+}
+
+// SetProxyURL sets the "proxy_url" field.
+func (_u *SystemConfigUpdateOne) SetProxyURL(v string) *SystemConfigUpdateOne {
+	_u.mutation.SetProxyURL(v)
+	return _u
+}
+
+// SetNillableProxyURL sets the "proxy_url" field if the given value is not nil.
+func (_u *SystemConfigUpdateOne) SetNillableProxyURL(v *string) *SystemConfigUpdateOne {
+	if v != nil {
+		_u.SetProxyURL(*v)
 	}
-	if value, ok := _u.mutation.Timezone(); ok {
-		_spec.SetField(systemconfig.FieldTimezone, field.TypeString, value)
+	return _u
+}
+
+// ClearProxyURL clears the value of the "proxy_url" field.
+func (_u *SystemConfigUpdateOne) ClearProxyURL() *SystemConfigUpdateOne {
+	_u.mutation.ClearProxyURL()
+	return _u
+}
+
+// SetProxyBypass sets the "proxy_bypass" field.
+func (_u *SystemConfigUpdateOne) SetProxyBypass(v string) *SystemConfigUpdateOne {
+	_u.mutation.SetProxyBypass(v)
+	return _u
+}
+
+// SetNillableProxyBypass sets the "proxy_bypass" field if the given value is not nil.
+func (_u *SystemConfigUpdateOne) SetNillableProxyBypass(v *string) *SystemConfigUpdateOne {
+	if v != nil {
+		_u.SetProxyBypass(*v)
 	}
-	if _u.mutation.TimezoneCleared() {
-		_spec.ClearField(systemconfig.FieldTimezone, field.TypeString)
+	return _u
+}
+
+// ClearProxyBypass clears the value of the "proxy_bypass" field.
+func (_u *SystemConfigUpdateOne) ClearProxyBypass() *SystemConfigUpdateOne {
+	_u.mutation.ClearProxyBypass()
+	return _u
+}
+
+// SetRequestTimeoutSeconds sets the "request_timeout_seconds" field.
+func (_u *SystemConfigUpdateOne) SetRequestTimeoutSeconds(v int) *SystemConfigUpdateOne {
+	_u.mutation.ResetRequestTimeoutSeconds()
+	_u.mutation.SetRequestTimeoutSeconds(v)
+	return _u
+}
+
+// SetNillableRequestTimeoutSeconds sets the "request_timeout_seconds" field if the given value is not nil.
+func (_u *SystemConfigUpdateOne) SetNillableRequestTimeoutSeconds(v *int) *SystemConfigUpdateOne {
+	if v != nil {
+		_u.SetRequestTimeoutSeconds(*v)
 	}
-	if value, ok := _u.mutation.UpdatedAt(); ok {
-		_spec.SetField(systemconfig.FieldUpdatedAt, field.TypeTime, value)
+	return _u
+}
+
+// AddRequestTimeoutSeconds adds value to the "request_timeout_seconds" field.
+func (_u *SystemConfigUpdateOne) AddRequestTimeoutSeconds(v int) *SystemConfigUpdateOne {
+	_u.mutation.AddRequestTimeoutSeconds(v)
+	return _u
+}
+
+// SetMaxRetries sets the "max_retries" field.
+func (_u *SystemConfigUpdateOne) SetMaxRetries(v int) *SystemConfigUpdateOne {
+	_u.mutation.ResetMaxRetries()
+	_u.mutation.SetMaxRetries(v)
+	return _u
+}
+
+// SetNillableMaxRetries sets the "max_retries" field if the given value is not nil.
+func (_u *SystemConfigUpdateOne) SetNillableMaxRetries(v *int) *SystemConfigUpdateOne {
+	if v != nil {
+		_u.SetMaxRetries(*v)
 	}
-	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
-		if _, ok := err.(*sqlgraph.NotFoundError); ok {
-			err = &NotFoundError{systemconfig.Label}
-		} else if sqlgraph.IsConstraintError(err) {
-			err = &ConstraintError{msg: err.Error(), wrap: err}
-		}
-		return 0, err
+	return _u
+}
+
+// AddMaxRetries adds value to the "max_retries" field.
+func (_u *SystemConfigUpdateOne) AddMaxRetries(v int) *SystemConfigUpdateOne {
+	_u.mutation.AddMaxRetries(v)
+	return _u
+}
+
+// SetRetryBackoffSeconds sets the "retry_backoff_seconds" field.
+func (_u *SystemConfigUpdateOne) SetRetryBackoffSeconds(v int) *SystemConfigUpdateOne {
+	_u.mutation.ResetRetryBackoffSeconds()
+	_u.mutation.SetRetryBackoffSeconds(v)
+	return _u
+}
+
+// SetNillableRetryBackoffSeconds sets the "retry_backoff_seconds" field if the given value is not nil.
+func (_u *SystemConfigUpdateOne) SetNillableRetryBackoffSeconds(v *int) *SystemConfigUpdateOne {
+	if v != nil {
+		_u.SetRetryBackoffSeconds(*v)
 	}
-	_u.mutation.done = true
-	return _node, nil
+	return _u
 }
 
-// SystemConfigUpdateOne is the builder for updating a single SystemConfig entity.
-type SystemConfigUpdateOne struct {
-	config
-	fields   []string
-	hooks    []Hook
-	mutation *SystemConfigMutation
+// AddRetryBackoffSeconds adds value to the "retry_backoff_seconds" field.
+func (_u *SystemConfigUpdateOne) AddRetryBackoffSeconds(v int) *SystemConfigUpdateOne {
+	_u.mutation.AddRetryBackoffSeconds(v)
+	return _u
 }
 
-// SetKey sets the "key" field.
-func (_u *SystemConfigUpdateOne) SetKey(v string) *SystemConfigUpdateOne {
-	_u.mutation.SetKey(v)
+// SetTickIntervalSeconds sets the "tick_interval_seconds" field.
+func (_u *SystemConfigUpdateOne) SetTickIntervalSeconds(v int) *SystemConfigUpdateOne {
+	_u.mutation.ResetTickIntervalSeconds()
+	_u.mutation.SetTickIntervalSeconds(v)
 	return _u
 }
 
-// SetNillableKey sets the "key" field if the given value is not nil.
-func (_u *SystemConfigUpdateOne) SetNillableKey(v *string) *SystemConfigUpdateOne {
+// SetNillableTickIntervalSeconds sets the "tick_interval_seconds" field if the given value is not nil.
+func (_u *SystemConfigUpdateOne) SetNillableTickIntervalSeconds(v *int) *SystemConfigUpdateOne {
 	if v != nil {
-		_u.SetKey(*v)
+		_u.SetTickIntervalSeconds(*v)
 	}
 	return _u
 }
 
-// SetChecksHistoryLimit sets the "checks_history_limit" field.
-func (_u *SystemConfigUpdateOne) SetChecksHistoryLimit(v int) *SystemConfigUpdateOne {
-	_u.mutation.ResetChecksHistoryLimit()
-	_u.mutation.SetChecksHistoryLimit(v)
+// AddTickIntervalSeconds adds value to the "tick_interval_seconds" field.
+func (_u *SystemConfigUpdateOne) AddTickIntervalSeconds(v int) *SystemConfigUpdateOne {
+	_u.mutation.AddTickIntervalSeconds(v)
 	return _u
 }
 
-// SetNillableChecksHistoryLimit sets the "checks_history_limit" field if the given value is not nil.
-func (_u *SystemConfigUpdateOne) SetNillableChecksHistoryLimit(v *int) *SystemConfigUpdateOne {
+// SetScheduleLookaheadSeconds sets the "schedule_lookahead_seconds" field.
+func (_u *SystemConfigUpdateOne) SetScheduleLookaheadSeconds(v int) *SystemConfigUpdateOne {
+	_u.mutation.ResetScheduleLookaheadSeconds()
+	_u.mutation.SetScheduleLookaheadSeconds(v)
+	return _u
+}
+
+// SetNillableScheduleLookaheadSeconds sets the "schedule_lookahead_seconds" field if the given value is not nil.
+func (_u *SystemConfigUpdateOne) SetNillableScheduleLookaheadSeconds(v *int) *SystemConfigUpdateOne {
 	if v != nil {
-		_u.SetChecksHistoryLimit(*v)
+		_u.SetScheduleLookaheadSeconds(*v)
 	}
 	return _u
 }
 
-// AddChecksHistoryLimit adds value to the "checks_history_limit" field.
-func (_u *SystemConfigUpdateOne) AddChecksHistoryLimit(v int) *SystemConfigUpdateOne {
-	_u.mutation.AddChecksHistoryLimit(v)
+// AddScheduleLookaheadSeconds adds value to the "schedule_lookahead_seconds" field.
+func (_u *SystemConfigUpdateOne) AddScheduleLookaheadSeconds(v int) *SystemConfigUpdateOne {
+	_u.mutation.AddScheduleLookaheadSeconds(v)
 	return _u
 }
 
-// SetTimezone sets the "timezone" field.
-func (_u *SystemConfigUpdateOne) SetTimezone(v string) *SystemConfigUpdateOne {
-	_u.mutation.SetTimezone(v)
+// SetMaxResponseBodyBytes sets the "max_response_body_bytes" field.
+func (_u *SystemConfigUpdateOne) SetMaxResponseBodyBytes(v int) *SystemConfigUpdateOne {
+	_u.mutation.ResetMaxResponseBodyBytes()
+	_u.mutation.SetMaxResponseBodyBytes(v)
 	return _u
 }
 
-// SetNillableTimezone sets the "timezone" field if the given value is not nil.
-func (_u *SystemConfigUpdateOne) SetNillableTimezone(v *string) *SystemConfigUpdateOne {
+// SetNillableMaxResponseBodyBytes sets the "max_response_body_bytes" field if the given value is not nil.
+func (_u *SystemConfigUpdateOne) SetNillableMaxResponseBodyBytes(v *int) *SystemConfigUpdateOne {
 	if v != nil {
-		_u.SetTimezone(*v)
+		_u.SetMaxResponseBodyBytes(*v)
 	}
 	return _u
 }
 
-// ClearTimezone clears the value of the "timezone" field.
-func (_u *SystemConfigUpdateOne) ClearTimezone() *SystemConfigUpdateOne {
-	_u.mutation.ClearTimezone()
+// AddMaxResponseBodyBytes adds value to the "max_response_body_bytes" field.
+func (_u *SystemConfigUpdateOne) AddMaxResponseBodyBytes(v int) *SystemConfigUpdateOne {
+	_u.mutation.AddMaxResponseBodyBytes(v)
+	return _u
+}
+
+// ClearMaxResponseBodyBytes clears the value of the "max_response_body_bytes" field.
+func (_u *SystemConfigUpdateOne) ClearMaxResponseBodyBytes() *SystemConfigUpdateOne {
+	_u.mutation.ClearMaxResponseBodyBytes()
 	return _u
 }
 
@@ -312,6 +1335,71 @@ func (_u *SystemConfigUpdateOne) check() error {
 			return &ValidationError{Name: "checks_history_limit", err: fmt.Errorf(`ent: validator failed for field "SystemConfig.checks_history_limit": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.StaleAfterDays(); ok {
+		if err := systemconfig.StaleAfterDaysValidator(v); err != nil {
+			return &ValidationError{Name: "stale_after_days", err: fmt.Errorf(`ent: validator failed for field "SystemConfig.stale_after_days": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ScheduleJitterSeconds(); ok {
+		if err := systemconfig.ScheduleJitterSecondsValidator(v); err != nil {
+			return &ValidationError{Name: "schedule_jitter_seconds", err: fmt.Errorf(`ent: validator failed for field "SystemConfig.schedule_jitter_seconds": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.CircuitBreakerThreshold(); ok {
+		if err := systemconfig.CircuitBreakerThresholdValidator(v); err != nil {
+			return &ValidationError{Name: "circuit_breaker_threshold", err: fmt.Errorf(`ent: validator failed for field "SystemConfig.circuit_breaker_threshold": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.CircuitBreakerAction(); ok {
+		if err := systemconfig.CircuitBreakerActionValidator(v); err != nil {
+			return &ValidationError{Name: "circuit_breaker_action", err: fmt.Errorf(`ent: validator failed for field "SystemConfig.circuit_breaker_action": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.CircuitBreakerBackoffMinutes(); ok {
+		if err := systemconfig.CircuitBreakerBackoffMinutesValidator(v); err != nil {
+			return &ValidationError{Name: "circuit_breaker_backoff_minutes", err: fmt.Errorf(`ent: validator failed for field "SystemConfig.circuit_breaker_backoff_minutes": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.CatchUpPolicy(); ok {
+		if err := systemconfig.CatchUpPolicyValidator(v); err != nil {
+			return &ValidationError{Name: "catch_up_policy", err: fmt.Errorf(`ent: validator failed for field "SystemConfig.catch_up_policy": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.CatchUpMaxAgeMinutes(); ok {
+		if err := systemconfig.CatchUpMaxAgeMinutesValidator(v); err != nil {
+			return &ValidationError{Name: "catch_up_max_age_minutes", err: fmt.Errorf(`ent: validator failed for field "SystemConfig.catch_up_max_age_minutes": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.RequestTimeoutSeconds(); ok {
+		if err := systemconfig.RequestTimeoutSecondsValidator(v); err != nil {
+			return &ValidationError{Name: "request_timeout_seconds", err: fmt.Errorf(`ent: validator failed for field "SystemConfig.request_timeout_seconds": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.MaxRetries(); ok {
+		if err := systemconfig.MaxRetriesValidator(v); err != nil {
+			return &ValidationError{Name: "max_retries", err: fmt.Errorf(`ent: validator failed for field "SystemConfig.max_retries": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.RetryBackoffSeconds(); ok {
+		if err := systemconfig.RetryBackoffSecondsValidator(v); err != nil {
+			return &ValidationError{Name: "retry_backoff_seconds", err: fmt.Errorf(`ent: validator failed for field "SystemConfig.retry_backoff_seconds": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.TickIntervalSeconds(); ok {
+		if err := systemconfig.TickIntervalSecondsValidator(v); err != nil {
+			return &ValidationError{Name: "tick_interval_seconds", err: fmt.Errorf(`ent: validator failed for field "SystemConfig.tick_interval_seconds": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ScheduleLookaheadSeconds(); ok {
+		if err := systemconfig.ScheduleLookaheadSecondsValidator(v); err != nil {
+			return &ValidationError{Name: "schedule_lookahead_seconds", err: fmt.Errorf(`ent: validator failed for field "SystemConfig.schedule_lookahead_seconds": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.MaxResponseBodyBytes(); ok {
+		if err := systemconfig.MaxResponseBodyBytesValidator(v); err != nil {
+			return &ValidationError{Name: "max_response_body_bytes", err: fmt.Errorf(`ent: validator failed for field "SystemConfig.max_response_body_bytes": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -359,6 +1447,126 @@ func (_u *SystemConfigUpdateOne) sqlSave(ctx context.Context) (_node *SystemConf
 	if _u.mutation.TimezoneCleared() {
 		_spec.ClearField(systemconfig.FieldTimezone, field.TypeString)
 	}
+	if value, ok := _u.mutation.Paused(); ok {
+		_spec.SetField(systemconfig.FieldPaused, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.NotificationsPaused(); ok {
+		_spec.SetField(systemconfig.FieldNotificationsPaused, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.PausedAt(); ok {
+		_spec.SetField(systemconfig.FieldPausedAt, field.TypeTime, value)
+	}
+	if _u.mutation.PausedAtCleared() {
+		_spec.ClearField(systemconfig.FieldPausedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.StaleAfterDays(); ok {
+		_spec.SetField(systemconfig.FieldStaleAfterDays, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedStaleAfterDays(); ok {
+		_spec.AddField(systemconfig.FieldStaleAfterDays, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.StaleNotifications(); ok {
+		_spec.SetField(systemconfig.FieldStaleNotifications, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.StaleNotifiedAt(); ok {
+		_spec.SetField(systemconfig.FieldStaleNotifiedAt, field.TypeTime, value)
+	}
+	if _u.mutation.StaleNotifiedAtCleared() {
+		_spec.ClearField(systemconfig.FieldStaleNotifiedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.ScheduleJitterSeconds(); ok {
+		_spec.SetField(systemconfig.FieldScheduleJitterSeconds, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedScheduleJitterSeconds(); ok {
+		_spec.AddField(systemconfig.FieldScheduleJitterSeconds, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.CircuitBreakerThreshold(); ok {
+		_spec.SetField(systemconfig.FieldCircuitBreakerThreshold, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedCircuitBreakerThreshold(); ok {
+		_spec.AddField(systemconfig.FieldCircuitBreakerThreshold, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.CircuitBreakerAction(); ok {
+		_spec.SetField(systemconfig.FieldCircuitBreakerAction, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.CircuitBreakerBackoffMinutes(); ok {
+		_spec.SetField(systemconfig.FieldCircuitBreakerBackoffMinutes, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedCircuitBreakerBackoffMinutes(); ok {
+		_spec.AddField(systemconfig.FieldCircuitBreakerBackoffMinutes, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.CatchUpPolicy(); ok {
+		_spec.SetField(systemconfig.FieldCatchUpPolicy, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.CatchUpMaxAgeMinutes(); ok {
+		_spec.SetField(systemconfig.FieldCatchUpMaxAgeMinutes, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedCatchUpMaxAgeMinutes(); ok {
+		_spec.AddField(systemconfig.FieldCatchUpMaxAgeMinutes, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.DefaultUserAgent(); ok {
+		_spec.SetField(systemconfig.FieldDefaultUserAgent, field.TypeString, value)
+	}
+	if _u.mutation.DefaultUserAgentCleared() {
+		_spec.ClearField(systemconfig.FieldDefaultUserAgent, field.TypeString)
+	}
+	if value, ok := _u.mutation.DefaultHeaders(); ok {
+		_spec.SetField(systemconfig.FieldDefaultHeaders, field.TypeJSON, value)
+	}
+	if _u.mutation.DefaultHeadersCleared() {
+		_spec.ClearField(systemconfig.FieldDefaultHeaders, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.ProxyURL(); ok {
+		_spec.SetField(systemconfig.FieldProxyURL, field.TypeString, value)
+	}
+	if _u.mutation.ProxyURLCleared() {
+		_spec.ClearField(systemconfig.FieldProxyURL, field.TypeString)
+	}
+	if value, ok := _u.mutation.ProxyBypass(); ok {
+		_spec.SetField(systemconfig.FieldProxyBypass, field.TypeString, value)
+	}
+	if _u.mutation.ProxyBypassCleared() {
+		_spec.ClearField(systemconfig.FieldProxyBypass, field.TypeString)
+	}
+	if value, ok := _u.mutation.RequestTimeoutSeconds(); ok {
+		_spec.SetField(systemconfig.FieldRequestTimeoutSeconds, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedRequestTimeoutSeconds(); ok {
+		_spec.AddField(systemconfig.FieldRequestTimeoutSeconds, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.MaxRetries(); ok {
+		_spec.SetField(systemconfig.FieldMaxRetries, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedMaxRetries(); ok {
+		_spec.AddField(systemconfig.FieldMaxRetries, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.RetryBackoffSeconds(); ok {
+		_spec.SetField(systemconfig.FieldRetryBackoffSeconds, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedRetryBackoffSeconds(); ok {
+		_spec.AddField(systemconfig.FieldRetryBackoffSeconds, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.TickIntervalSeconds(); ok {
+		_spec.SetField(systemconfig.FieldTickIntervalSeconds, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedTickIntervalSeconds(); ok {
+		_spec.AddField(systemconfig.FieldTickIntervalSeconds, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.ScheduleLookaheadSeconds(); ok {
+		_spec.SetField(systemconfig.FieldScheduleLookaheadSeconds, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedScheduleLookaheadSeconds(); ok {
+		_spec.AddField(systemconfig.FieldScheduleLookaheadSeconds, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.MaxResponseBodyBytes(); ok {
+		_spec.SetField(systemconfig.FieldMaxResponseBodyBytes, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedMaxResponseBodyBytes(); ok {
+		_spec.AddField(systemconfig.FieldMaxResponseBodyBytes, field.TypeInt, value)
+	}
+	if _u.mutation.MaxResponseBodyBytesCleared() {
+		_spec.ClearField(systemconfig.FieldMaxResponseBodyBytes, field.TypeInt)
+	}
 	if value, ok := _u.mutation.UpdatedAt(); ok {
 		_spec.SetField(systemconfig.FieldUpdatedAt, field.TypeTime, value)
 	}
@@ -14,16 +14,28 @@ type Tx struct {
 	config
 	// CheckResult is the client for interacting with the CheckResult builders.
 	CheckResult *CheckResultClient
+	// CheckRollup is the client for interacting with the CheckRollup builders.
+	CheckRollup *CheckRollupClient
+	// HeaderProfile is the client for interacting with the HeaderProfile builders.
+	HeaderProfile *HeaderProfileClient
 	// Monitor is the client for interacting with the Monitor builders.
 	Monitor *MonitorClient
 	// MonitorRuntime is the client for interacting with the MonitorRuntime builders.
 	MonitorRuntime *MonitorRuntimeClient
+	// MonitorVersion is the client for interacting with the MonitorVersion builders.
+	MonitorVersion *MonitorVersionClient
 	// NotificationChannel is the client for interacting with the NotificationChannel builders.
 	NotificationChannel *NotificationChannelClient
 	// NotificationEvent is the client for interacting with the NotificationEvent builders.
 	NotificationEvent *NotificationEventClient
+	// SelectorPayload is the client for interacting with the SelectorPayload builders.
+	SelectorPayload *SelectorPayloadClient
+	// Session is the client for interacting with the Session builders.
+	Session *SessionClient
 	// SystemConfig is the client for interacting with the SystemConfig builders.
 	SystemConfig *SystemConfigClient
+	// User is the client for interacting with the User builders.
+	User *UserClient
 
 	// lazily loaded.
 	client     *Client
@@ -156,11 +168,17 @@ func (tx *Tx) Client() *Client {
 
 func (tx *Tx) init() {
 	tx.CheckResult = NewCheckResultClient(tx.config)
+	tx.CheckRollup = NewCheckRollupClient(tx.config)
+	tx.HeaderProfile = NewHeaderProfileClient(tx.config)
 	tx.Monitor = NewMonitorClient(tx.config)
 	tx.MonitorRuntime = NewMonitorRuntimeClient(tx.config)
+	tx.MonitorVersion = NewMonitorVersionClient(tx.config)
 	tx.NotificationChannel = NewNotificationChannelClient(tx.config)
 	tx.NotificationEvent = NewNotificationEventClient(tx.config)
+	tx.SelectorPayload = NewSelectorPayloadClient(tx.config)
+	tx.Session = NewSessionClient(tx.config)
 	tx.SystemConfig = NewSystemConfigClient(tx.config)
+	tx.User = NewUserClient(tx.config)
 }
 
 // txDriver wraps the given dialect.Tx with a nop dialect.Driver implementation.
@@ -0,0 +1,170 @@
+// Package backup takes consistent snapshots of the SQLite database and
+// restores them before the server opens it.
+package backup
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+)
+
+const (
+	// DefaultInterval is how often scheduled backups run.
+	DefaultInterval = 24 * time.Hour
+	// DefaultKeep is how many scheduled backups are kept in the directory.
+	DefaultKeep = 7
+
+	filePrefix = "goanna-"
+	fileSuffix = ".db"
+	fileLayout = "20060102T150405Z"
+)
+
+// sqliteHeader starts every SQLite database file.
+var sqliteHeader = []byte("SQLite format 3\x00")
+
+// ErrNotSQLite is returned when a restore source is not a SQLite database.
+var ErrNotSQLite = errors.New("backup is not a SQLite database")
+
+// Snapshotter writes consistent copies of a SQLite database while it stays
+// in use.
+type Snapshotter struct {
+	db *sql.DB
+}
+
+// New returns a Snapshotter for db, which must be a SQLite connection pool.
+func New(db *sql.DB) *Snapshotter {
+	return &Snapshotter{db: db}
+}
+
+// WriteFile snapshots the database to path with VACUUM INTO. SQLite refuses
+// to overwrite, so path must not exist yet.
+func (s *Snapshotter) WriteFile(ctx context.Context, path string) error {
+	if _, err := s.db.ExecContext(ctx, "VACUUM INTO ?", path); err != nil {
+		return fmt.Errorf("vacuum into %s: %w", path, err)
+	}
+	return nil
+}
+
+// FileName names a backup taken at t; names sort by time.
+func FileName(t time.Time) string {
+	return filePrefix + t.UTC().Format(fileLayout) + fileSuffix
+}
+
+// RunScheduled writes a backup to dir every interval until ctx is done,
+// keeping the newest keep files.
+func (s *Snapshotter) RunScheduled(ctx context.Context, dir string, interval time.Duration, keep int, logger *slog.Logger) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			path, err := s.writeScheduled(ctx, dir, now, keep)
+			if err != nil {
+				logger.Error("scheduled backup failed", "error", err)
+				continue
+			}
+			logger.Info("wrote scheduled backup", "path", path)
+		}
+	}
+}
+
+func (s *Snapshotter) writeScheduled(ctx context.Context, dir string, now time.Time, keep int) (string, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return "", err
+	}
+
+	path := filepath.Join(dir, FileName(now))
+	if err := s.WriteFile(ctx, path); err != nil {
+		return "", err
+	}
+	if err := Prune(dir, keep); err != nil {
+		return path, fmt.Errorf("prune %s: %w", dir, err)
+	}
+
+	return path, nil
+}
+
+// Prune removes all but the newest keep backups in dir. Other files are left
+// alone.
+func Prune(dir string, keep int) error {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return err
+	}
+
+	names := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.Type().IsRegular() && strings.HasPrefix(name, filePrefix) && strings.HasSuffix(name, fileSuffix) {
+			names = append(names, name)
+		}
+	}
+	if len(names) <= keep {
+		return nil
+	}
+
+	slices.Sort(names)
+	for _, name := range names[:len(names)-keep] {
+		if err := os.Remove(filepath.Join(dir, name)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Restore replaces the database file at dbPath with the backup at from. It
+// must run before the database is opened. Leftover WAL files belong to the
+// replaced database and are removed.
+func Restore(from string, dbPath string) error {
+	source, err := os.Open(from)
+	if err != nil {
+		return err
+	}
+	defer source.Close()
+
+	header := make([]byte, len(sqliteHeader))
+	if _, err := io.ReadFull(source, header); err != nil || !bytes.Equal(header, sqliteHeader) {
+		return ErrNotSQLite
+	}
+	if _, err := source.Seek(0, io.SeekStart); err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(dbPath), 0o755); err != nil {
+		return err
+	}
+	staging, err := os.CreateTemp(filepath.Dir(dbPath), filepath.Base(dbPath)+".restore-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(staging.Name())
+
+	if _, err := io.Copy(staging, source); err != nil {
+		_ = staging.Close()
+		return err
+	}
+	if err := staging.Close(); err != nil {
+		return err
+	}
+
+	for _, suffix := range []string{"-wal", "-shm"} {
+		if err := os.Remove(dbPath + suffix); err != nil && !errors.Is(err, os.ErrNotExist) {
+			return err
+		}
+	}
+
+	return os.Rename(staging.Name(), dbPath)
+}
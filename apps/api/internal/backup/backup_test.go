@@ -0,0 +1,103 @@
+package backup
+
+import (
+	"database/sql"
+	"errors"
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+	"time"
+
+	_ "github.com/mattn/go-sqlite3"
+)
+
+func TestSnapshotAndRestore(t *testing.T) {
+	dir := t.TempDir()
+	db, err := sql.Open("sqlite3", "file:"+filepath.Join(dir, "live.db")+"?_journal_mode=WAL")
+	if err != nil {
+		t.Fatalf("expected database to open: %v", err)
+	}
+	defer db.Close()
+	if _, err := db.Exec("CREATE TABLE monitors (url TEXT); INSERT INTO monitors VALUES ('https://example.com')"); err != nil {
+		t.Fatalf("expected seed to apply: %v", err)
+	}
+
+	snapshot := filepath.Join(dir, FileName(time.Now()))
+	if err := New(db).WriteFile(t.Context(), snapshot); err != nil {
+		t.Fatalf("expected snapshot to be written: %v", err)
+	}
+
+	restored := filepath.Join(dir, "restored", "goanna.db")
+	if err := os.MkdirAll(filepath.Dir(restored), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(restored+"-wal", []byte("stale"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if err := Restore(snapshot, restored); err != nil {
+		t.Fatalf("expected restore to succeed: %v", err)
+	}
+	if _, err := os.Stat(restored + "-wal"); !errors.Is(err, os.ErrNotExist) {
+		t.Fatalf("expected the old WAL file to be removed, got %v", err)
+	}
+
+	restoredDB, err := sql.Open("sqlite3", "file:"+restored)
+	if err != nil {
+		t.Fatalf("expected restored database to open: %v", err)
+	}
+	defer restoredDB.Close()
+	var url string
+	if err := restoredDB.QueryRow("SELECT url FROM monitors").Scan(&url); err != nil || url != "https://example.com" {
+		t.Fatalf("expected restored row, got %q (%v)", url, err)
+	}
+}
+
+func TestRestoreRejectsNonSQLiteFiles(t *testing.T) {
+	dir := t.TempDir()
+	source := filepath.Join(dir, "notes.txt")
+	if err := os.WriteFile(source, []byte("not a database"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	target := filepath.Join(dir, "goanna.db")
+	if err := os.WriteFile(target, []byte("keep me"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := Restore(source, target); !errors.Is(err, ErrNotSQLite) {
+		t.Fatalf("expected ErrNotSQLite, got %v", err)
+	}
+	if content, _ := os.ReadFile(target); string(content) != "keep me" {
+		t.Fatalf("expected the database to be left alone, got %q", content)
+	}
+}
+
+func TestPruneKeepsNewestBackups(t *testing.T) {
+	dir := t.TempDir()
+	start := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	for day := range 5 {
+		if err := os.WriteFile(filepath.Join(dir, FileName(start.AddDate(0, 0, day))), nil, 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := os.WriteFile(filepath.Join(dir, "other.db"), nil, 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := Prune(dir, 2); err != nil {
+		t.Fatalf("expected prune to succeed: %v", err)
+	}
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	names := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		names = append(names, entry.Name())
+	}
+	want := []string{FileName(start.AddDate(0, 0, 3)), FileName(start.AddDate(0, 0, 4)), "other.db"}
+	if !slices.Equal(names, want) {
+		t.Fatalf("expected %v, got %v", want, names)
+	}
+}
@@ -0,0 +1,148 @@
+// Package errorreport sends panics and error logs to Sentry, so failures in
+// background work show up without anyone reading the logs.
+//
+// Until Init has run every function here only passes through: panics keep
+// unwinding and log records reach the wrapped handler alone.
+package errorreport
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/getsentry/sentry-go"
+)
+
+// flushTimeout bounds how long a panic waits for its report to be sent
+// before the process dies.
+const flushTimeout = 2 * time.Second
+
+// Init starts reporting to the Sentry project of dsn.
+func Init(dsn string, environment string) error {
+	return sentry.Init(sentry.ClientOptions{
+		Dsn:         dsn,
+		Environment: environment,
+	})
+}
+
+// Flush waits up to timeout for queued reports to be sent.
+func Flush(timeout time.Duration) {
+	sentry.Flush(timeout)
+}
+
+func enabled() bool {
+	return sentry.CurrentHub().Client() != nil
+}
+
+// Recover reports a panic with tags and panics again, keeping the default
+// crash behavior. It must be deferred directly.
+func Recover(tags map[string]string) {
+	if !enabled() {
+		return
+	}
+	if recovered := recover(); recovered != nil {
+		hub := sentry.CurrentHub().Clone()
+		hub.Scope().SetTags(tags)
+		hub.Recover(recovered)
+		hub.Flush(flushTimeout)
+		panic(recovered)
+	}
+}
+
+// Middleware reports panics in handlers before net/http logs them and drops
+// the connection.
+func Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !enabled() {
+			next.ServeHTTP(w, r)
+			return
+		}
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				if recovered != http.ErrAbortHandler {
+					hub := sentry.CurrentHub().Clone()
+					hub.Scope().SetRequest(r)
+					hub.Scope().SetTag("pattern", r.Pattern)
+					hub.RecoverWithContext(r.Context(), recovered)
+				}
+				panic(recovered)
+			}
+		}()
+		next.ServeHTTP(w, r)
+	})
+}
+
+// Handler passes records on to next and reports those at error level or
+// above. String and number attributes such as monitor_id and run_id become
+// tags, and reports are grouped by message and monitor, so a failure that
+// repeats on every check becomes one issue with a count.
+func Handler(next slog.Handler) slog.Handler {
+	return &handler{next: next}
+}
+
+type handler struct {
+	next  slog.Handler
+	attrs []slog.Attr
+}
+
+func (h *handler) Enabled(ctx context.Context, level slog.Level) bool {
+	return level >= slog.LevelError || h.next.Enabled(ctx, level)
+}
+
+func (h *handler) Handle(ctx context.Context, record slog.Record) error {
+	if record.Level >= slog.LevelError && enabled() {
+		report(record, h.attrs)
+	}
+	if !h.next.Enabled(ctx, record.Level) {
+		return nil
+	}
+	return h.next.Handle(ctx, record)
+}
+
+func (h *handler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	return &handler{next: h.next.WithAttrs(attrs), attrs: append(h.attrs[:len(h.attrs):len(h.attrs)], attrs...)}
+}
+
+func (h *handler) WithGroup(name string) slog.Handler {
+	return &handler{next: h.next.WithGroup(name), attrs: h.attrs}
+}
+
+func report(record slog.Record, attrs []slog.Attr) {
+	event := sentry.NewEvent()
+	event.Level = sentry.LevelError
+	event.Message = record.Message
+	event.Timestamp = record.Time
+	event.Fingerprint = []string{record.Message}
+
+	extra := sentry.Context{}
+	add := func(attr slog.Attr) bool {
+		value := attr.Value.Resolve()
+		switch {
+		case attr.Key == "error":
+			if err, ok := value.Any().(error); ok {
+				event.Exception = []sentry.Exception{{Type: fmt.Sprintf("%T", err), Value: err.Error()}}
+				return true
+			}
+			extra[attr.Key] = value.String()
+		case value.Kind() == slog.KindString || value.Kind() == slog.KindInt64 || value.Kind() == slog.KindUint64:
+			event.Tags[attr.Key] = value.String()
+		default:
+			extra[attr.Key] = value.String()
+		}
+		return true
+	}
+	for _, attr := range attrs {
+		add(attr)
+	}
+	record.Attrs(add)
+
+	if monitorID, ok := event.Tags["monitor_id"]; ok {
+		event.Fingerprint = append(event.Fingerprint, monitorID)
+	}
+	if len(extra) > 0 {
+		event.Contexts["log"] = extra
+	}
+	sentry.CurrentHub().CaptureEvent(event)
+}
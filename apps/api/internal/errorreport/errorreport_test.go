@@ -0,0 +1,74 @@
+package errorreport
+
+import (
+	"errors"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/getsentry/sentry-go"
+)
+
+func captureReports(t *testing.T) *sentry.MockTransport {
+	t.Helper()
+	transport := &sentry.MockTransport{}
+	if err := sentry.Init(sentry.ClientOptions{Dsn: "https://key@sentry.example.com/1", Transport: transport}); err != nil {
+		t.Fatalf("init sentry: %v", err)
+	}
+	t.Cleanup(func() { sentry.CurrentHub().BindClient(nil) })
+	return transport
+}
+
+func TestHandlerReportsErrorLogs(t *testing.T) {
+	logger := slog.New(Handler(slog.NewTextHandler(io.Discard, nil)))
+	logger.Error("failed running monitor", "error", errors.New("before init"))
+
+	transport := captureReports(t)
+	worker := logger.With("component", "worker")
+	worker.Info("check finished", "monitor_id", 4)
+	worker.With("monitor_id", 4, "run_id", "3f2a").Error("failed notifying monitor", "error", errors.New("telegram: 429 too many requests"), "attempts", []int{1, 2})
+
+	events := transport.Events()
+	if len(events) != 1 {
+		t.Fatalf("expected only the error after init to be reported, got %d", len(events))
+	}
+	event := events[0]
+	if event.Message != "failed notifying monitor" || event.Tags["component"] != "worker" || event.Tags["monitor_id"] != "4" || event.Tags["run_id"] != "3f2a" {
+		t.Fatalf("expected the message and monitor tags, got %q %v", event.Message, event.Tags)
+	}
+	if len(event.Exception) != 1 || event.Exception[0].Value != "telegram: 429 too many requests" {
+		t.Fatalf("expected the error as the exception, got %+v", event.Exception)
+	}
+	if len(event.Fingerprint) != 2 || event.Fingerprint[1] != "4" {
+		t.Fatalf("expected reports grouped by message and monitor, got %v", event.Fingerprint)
+	}
+	if event.Contexts["log"]["attempts"] != "[1 2]" {
+		t.Fatalf("expected other attributes as context, got %v", event.Contexts["log"])
+	}
+}
+
+func TestMiddlewareReportsPanics(t *testing.T) {
+	transport := captureReports(t)
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /v1/monitors/{monitorId}", func(w http.ResponseWriter, r *http.Request) {
+		panic("nil runtime")
+	})
+	handler := Middleware(mux)
+
+	func() {
+		defer func() {
+			if recovered := recover(); recovered != "nil runtime" {
+				t.Fatalf("expected the panic to continue, got %v", recovered)
+			}
+		}()
+		handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/v1/monitors/4", nil))
+	}()
+
+	events := transport.Events()
+	if len(events) != 1 || events[0].Tags["pattern"] != "GET /v1/monitors/{monitorId}" || events[0].Request == nil {
+		t.Fatalf("expected the panic to be reported with its request, got %+v", events)
+	}
+}
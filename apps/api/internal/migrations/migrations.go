@@ -0,0 +1,326 @@
+// Package migrations applies the versioned schema migrations embedded for
+// each database dialect and records which ones ran.
+//
+// Files use the golang-migrate layout Atlas generates, one
+// <version>_<name>.up.sql and .down.sql pair per migration, with an
+// atlas.sum guarding against edited files. Run generate.go to add one after
+// changing the Ent schema.
+package migrations
+
+import (
+	"context"
+	"database/sql"
+	"embed"
+	"errors"
+	"fmt"
+	"io/fs"
+	"path"
+	"slices"
+	"strings"
+	"time"
+
+	"ariga.io/atlas/sql/migrate"
+)
+
+// revisionTable records applied migrations.
+const revisionTable = "goanna_schema_revisions"
+
+// legacyTable exists in databases created by automatic migrations before
+// versioned migrations were introduced.
+const legacyTable = "monitors"
+
+//go:embed sqlite mysql
+var files embed.FS
+
+// ErrChecksum is returned when migration files do not match atlas.sum.
+var ErrChecksum = errors.New("migration files do not match atlas.sum")
+
+// Migration is one versioned schema change.
+type Migration struct {
+	Version string
+	Name    string
+	up      string
+	down    string
+}
+
+// State is a migration and when it was applied, if it was.
+type State struct {
+	Migration
+	AppliedAt *time.Time
+}
+
+// Migrator applies migrations to one database.
+type Migrator struct {
+	db         *sql.DB
+	dialect    string
+	migrations []Migration
+}
+
+// New loads the migrations for dialect ("sqlite3" or "mysql").
+func New(db *sql.DB, dialect string) (*Migrator, error) {
+	dir := "sqlite"
+	if dialect == "mysql" {
+		dir = "mysql"
+	}
+
+	migrations, err := load(dir)
+	if err != nil {
+		return nil, err
+	}
+	return &Migrator{db: db, dialect: dialect, migrations: migrations}, nil
+}
+
+func load(dir string) ([]Migration, error) {
+	sub, err := fs.Sub(files, dir)
+	if err != nil {
+		return nil, err
+	}
+	names, err := fs.Glob(sub, "*.sql")
+	if err != nil {
+		return nil, err
+	}
+	slices.Sort(names)
+
+	localFiles := make([]migrate.File, 0, len(names))
+	byVersion := map[string]*Migration{}
+	var migrations []*Migration
+	for _, name := range names {
+		content, err := fs.ReadFile(sub, name)
+		if err != nil {
+			return nil, err
+		}
+		localFiles = append(localFiles, migrate.NewLocalFile(name, content))
+
+		base, direction, ok := strings.Cut(strings.TrimSuffix(name, ".sql"), ".")
+		version, label, _ := strings.Cut(base, "_")
+		if !ok || (direction != "up" && direction != "down") {
+			return nil, fmt.Errorf("unexpected migration file %s", path.Join(dir, name))
+		}
+
+		migration, exists := byVersion[version]
+		if !exists {
+			migration = &Migration{Version: version, Name: label}
+			byVersion[version] = migration
+			migrations = append(migrations, migration)
+		}
+		if direction == "up" {
+			migration.up = string(content)
+		} else {
+			migration.down = string(content)
+		}
+	}
+
+	sumFile, err := fs.ReadFile(sub, migrate.HashFileName)
+	if err != nil {
+		return nil, fmt.Errorf("read %s: %w", path.Join(dir, migrate.HashFileName), err)
+	}
+	var expected migrate.HashFile
+	if err := expected.UnmarshalText(sumFile); err != nil {
+		return nil, err
+	}
+	actual, err := migrate.NewHashFile(localFiles)
+	if err != nil {
+		return nil, err
+	}
+	if actual.Sum() != expected.Sum() {
+		return nil, fmt.Errorf("%s: %w", dir, ErrChecksum)
+	}
+
+	loaded := make([]Migration, len(migrations))
+	for i, migration := range migrations {
+		loaded[i] = *migration
+	}
+	return loaded, nil
+}
+
+// Status lists every migration, oldest first, with when it was applied.
+func (m *Migrator) Status(ctx context.Context) ([]State, error) {
+	applied, err := m.applied(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	states := make([]State, len(m.migrations))
+	for i, migration := range m.migrations {
+		states[i] = State{Migration: migration}
+		if appliedAt, ok := applied[migration.Version]; ok {
+			states[i].AppliedAt = &appliedAt
+		}
+	}
+	return states, nil
+}
+
+// Pending returns the migrations that have not been applied, oldest first.
+func (m *Migrator) Pending(ctx context.Context) ([]Migration, error) {
+	states, err := m.Status(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var pending []Migration
+	for _, state := range states {
+		if state.AppliedAt == nil {
+			pending = append(pending, state.Migration)
+		}
+	}
+	return pending, nil
+}
+
+// Up applies up to steps pending migrations, or all of them when steps is
+// zero, and returns the ones it applied.
+//
+// The baseline is the schema of the last release before this package existed.
+// A database created by that release's automatic migrations already matches
+// it, so the baseline is recorded without running and the migrations after it
+// bring the database up to date.
+func (m *Migrator) Up(ctx context.Context, steps int) ([]Migration, error) {
+	if err := m.ensureRevisionTable(ctx); err != nil {
+		return nil, err
+	}
+	pending, err := m.Pending(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if steps > 0 && len(pending) > steps {
+		pending = pending[:steps]
+	}
+
+	for i, migration := range pending {
+		if i == 0 && migration.Version == m.migrations[0].Version {
+			legacy, err := m.tableExists(ctx, legacyTable)
+			if err != nil {
+				return nil, err
+			}
+			if legacy {
+				if err := m.record(ctx, m.db, migration); err != nil {
+					return nil, err
+				}
+				continue
+			}
+		}
+		if err := m.apply(ctx, migration, migration.up, func(tx *sql.Tx) error {
+			return m.record(ctx, tx, migration)
+		}); err != nil {
+			return pending[:i], err
+		}
+	}
+
+	return pending, nil
+}
+
+// Down reverts up to steps applied migrations, newest first (one when steps
+// is zero), and returns the ones it reverted.
+func (m *Migrator) Down(ctx context.Context, steps int) ([]Migration, error) {
+	if steps <= 0 {
+		steps = 1
+	}
+	states, err := m.Status(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var reverted []Migration
+	for i := len(states) - 1; i >= 0 && len(reverted) < steps; i-- {
+		migration := states[i].Migration
+		if states[i].AppliedAt == nil {
+			continue
+		}
+		if strings.TrimSpace(migration.down) == "" {
+			return reverted, fmt.Errorf("migration %s has no down migration", migration.Version)
+		}
+		if err := m.apply(ctx, migration, migration.down, func(tx *sql.Tx) error {
+			_, err := tx.ExecContext(ctx, "DELETE FROM "+revisionTable+" WHERE version = ?", migration.Version)
+			return err
+		}); err != nil {
+			return reverted, err
+		}
+		reverted = append(reverted, migration)
+	}
+
+	return reverted, nil
+}
+
+// apply runs script and then bookkeeping in one transaction. MySQL commits
+// DDL implicitly, so a failing MySQL migration can leave earlier statements
+// applied.
+func (m *Migrator) apply(ctx context.Context, migration Migration, script string, bookkeeping func(*sql.Tx) error) error {
+	stmts, err := migrate.Stmts(script)
+	if err != nil {
+		return fmt.Errorf("migration %s: %w", migration.Version, err)
+	}
+
+	tx, err := m.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	for _, stmt := range stmts {
+		if _, err := tx.ExecContext(ctx, stmt.Text); err != nil {
+			return fmt.Errorf("migration %s: %w", migration.Version, err)
+		}
+	}
+	if err := bookkeeping(tx); err != nil {
+		return fmt.Errorf("migration %s: %w", migration.Version, err)
+	}
+
+	return tx.Commit()
+}
+
+type execer interface {
+	ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error)
+}
+
+func (m *Migrator) record(ctx context.Context, db execer, migration Migration) error {
+	_, err := db.ExecContext(
+		ctx,
+		"INSERT INTO "+revisionTable+" (version, name, applied_at) VALUES (?, ?, ?)",
+		migration.Version,
+		migration.Name,
+		time.Now().UTC(),
+	)
+	return err
+}
+
+func (m *Migrator) ensureRevisionTable(ctx context.Context) error {
+	_, err := m.db.ExecContext(ctx, "CREATE TABLE IF NOT EXISTS "+revisionTable+
+		" (version VARCHAR(32) NOT NULL PRIMARY KEY, name VARCHAR(255) NOT NULL, applied_at DATETIME NOT NULL)")
+	return err
+}
+
+func (m *Migrator) applied(ctx context.Context) (map[string]time.Time, error) {
+	exists, err := m.tableExists(ctx, revisionTable)
+	if err != nil || !exists {
+		return map[string]time.Time{}, err
+	}
+
+	rows, err := m.db.QueryContext(ctx, "SELECT version, applied_at FROM "+revisionTable)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	applied := map[string]time.Time{}
+	for rows.Next() {
+		var version string
+		var appliedAt time.Time
+		if err := rows.Scan(&version, &appliedAt); err != nil {
+			return nil, err
+		}
+		applied[version] = appliedAt
+	}
+	return applied, rows.Err()
+}
+
+func (m *Migrator) tableExists(ctx context.Context, table string) (bool, error) {
+	query := "SELECT COUNT(*) FROM sqlite_master WHERE type = 'table' AND name = ?"
+	if m.dialect == "mysql" {
+		query = "SELECT COUNT(*) FROM information_schema.tables WHERE table_schema = DATABASE() AND table_name = ?"
+	}
+
+	var count int
+	if err := m.db.QueryRowContext(ctx, query, table).Scan(&count); err != nil {
+		return false, err
+	}
+	return count > 0, nil
+}
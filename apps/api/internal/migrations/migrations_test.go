@@ -0,0 +1,140 @@
+package migrations
+
+import (
+	"bytes"
+	"database/sql"
+	"path/filepath"
+	"testing"
+
+	"goanna/apps/api/ent"
+
+	"ariga.io/atlas/sql/migrate"
+	entsql "entgo.io/ent/dialect/sql"
+	_ "github.com/mattn/go-sqlite3"
+)
+
+func openTestDatabase(t *testing.T) (*sql.DB, *ent.Client) {
+	t.Helper()
+
+	db, err := sql.Open("sqlite3", "file:"+filepath.Join(t.TempDir(), "goanna.db")+"?_fk=1")
+	if err != nil {
+		t.Fatalf("expected database to open: %v", err)
+	}
+	t.Cleanup(func() { _ = db.Close() })
+	return db, ent.NewClient(ent.Driver(entsql.OpenDB("sqlite3", db)))
+}
+
+func TestMigrationsMatchEntSchema(t *testing.T) {
+	db, client := openTestDatabase(t)
+	migrator, err := New(db, "sqlite3")
+	if err != nil {
+		t.Fatalf("expected migrations to load: %v", err)
+	}
+
+	applied, err := migrator.Up(t.Context(), 0)
+	if err != nil || len(applied) == 0 {
+		t.Fatalf("expected migrations to apply, got %d (%v)", len(applied), err)
+	}
+	if pending, err := migrator.Pending(t.Context()); err != nil || len(pending) != 0 {
+		t.Fatalf("expected nothing pending, got %d (%v)", len(pending), err)
+	}
+
+	// A diff here means the Ent schema changed without a migration; run
+	// generate.go for both dialects.
+	var diff bytes.Buffer
+	if err := client.Schema.WriteTo(t.Context(), &diff); err != nil {
+		t.Fatalf("expected schema diff: %v", err)
+	}
+	if changes := bytes.TrimSpace(diff.Bytes()); len(changes) > 0 {
+		t.Fatalf("expected migrations to produce the Ent schema, missing:\n%s", changes)
+	}
+
+	if _, err := client.Monitor.Create().SetURL("https://example.com").SetCron("* * * * *").Save(t.Context()); err != nil {
+		t.Fatalf("expected monitor to save: %v", err)
+	}
+}
+
+func TestMigrateDown(t *testing.T) {
+	db, _ := openTestDatabase(t)
+	migrator, err := New(db, "sqlite3")
+	if err != nil {
+		t.Fatalf("expected migrations to load: %v", err)
+	}
+	if _, err := migrator.Up(t.Context(), 0); err != nil {
+		t.Fatalf("expected migrations to apply: %v", err)
+	}
+
+	reverted, err := migrator.Down(t.Context(), len(migrator.migrations))
+	if err != nil || len(reverted) != len(migrator.migrations) {
+		t.Fatalf("expected every migration reverted, got %d (%v)", len(reverted), err)
+	}
+	if exists, _ := migrator.tableExists(t.Context(), legacyTable); exists {
+		t.Fatal("expected tables to be dropped")
+	}
+
+	states, err := migrator.Status(t.Context())
+	if err != nil {
+		t.Fatalf("expected status: %v", err)
+	}
+	for _, state := range states {
+		if state.AppliedAt != nil {
+			t.Fatalf("expected %s to be pending after down", state.Version)
+		}
+	}
+	if applied, err := migrator.Up(t.Context(), 1); err != nil || len(applied) != 1 {
+		t.Fatalf("expected one migration to reapply, got %d (%v)", len(applied), err)
+	}
+}
+
+func TestUpAdoptsAutoMigratedDatabase(t *testing.T) {
+	db, client := openTestDatabase(t)
+	migrator, err := New(db, "sqlite3")
+	if err != nil {
+		t.Fatalf("expected migrations to load: %v", err)
+	}
+
+	// The last release before versioned migrations created the baseline
+	// schema without recording it.
+	stmts, err := migrate.Stmts(migrator.migrations[0].up)
+	if err != nil {
+		t.Fatalf("expected baseline to parse: %v", err)
+	}
+	for _, stmt := range stmts {
+		if _, err := db.ExecContext(t.Context(), stmt.Text); err != nil {
+			t.Fatalf("expected baseline statement to run: %v", err)
+		}
+	}
+	if _, err := db.ExecContext(t.Context(), "INSERT INTO `monitors` (`url`, `cron`, `created_at`, `updated_at`) VALUES ('https://example.com', '* * * * *', datetime('now'), datetime('now'))"); err != nil {
+		t.Fatalf("expected released monitor to insert: %v", err)
+	}
+
+	applied, err := migrator.Up(t.Context(), 0)
+	if err != nil || len(applied) != len(migrator.migrations) {
+		t.Fatalf("expected baseline to be recorded and later migrations applied, got %d (%v)", len(applied), err)
+	}
+
+	var diff bytes.Buffer
+	if err := client.Schema.WriteTo(t.Context(), &diff); err != nil {
+		t.Fatalf("expected schema diff: %v", err)
+	}
+	if changes := bytes.TrimSpace(diff.Bytes()); len(changes) > 0 {
+		t.Fatalf("expected upgrade to produce the Ent schema, missing:\n%s", changes)
+	}
+
+	row, err := client.Monitor.Query().Only(t.Context())
+	if err != nil {
+		t.Fatalf("expected released monitor to load: %v", err)
+	}
+	if row.URL != "https://example.com" || row.FetchMode != "http" || row.UserAgent != nil {
+		t.Fatalf("expected released monitor with defaults, got %+v", row)
+	}
+	if _, err := client.User.Query().Count(t.Context()); err != nil {
+		t.Fatalf("expected users table: %v", err)
+	}
+	if _, err := client.Session.Query().Count(t.Context()); err != nil {
+		t.Fatalf("expected sessions table: %v", err)
+	}
+	if _, err := client.HeaderProfile.Query().Count(t.Context()); err != nil {
+		t.Fatalf("expected header_profiles table: %v", err)
+	}
+}
@@ -0,0 +1,96 @@
+-- Create "monitors" table
+CREATE TABLE `monitors` (
+  `id` bigint NOT NULL AUTO_INCREMENT,
+  `label` varchar(255) NULL,
+  `method` varchar(255) NOT NULL DEFAULT 'GET',
+  `url` varchar(255) NOT NULL,
+  `icon_url` varchar(255) NULL,
+  `body` varchar(255) NULL,
+  `headers` json NULL,
+  `auth` json NULL,
+  `notification_channels` json NULL,
+  `selector` varchar(255) NULL,
+  `expected_type` enum('json','html','text') NOT NULL DEFAULT 'json',
+  `expected_response` varchar(255) NULL,
+  `cron` varchar(255) NOT NULL,
+  `enabled` bool NOT NULL DEFAULT true,
+  `created_at` timestamp NULL,
+  `updated_at` timestamp NULL,
+  PRIMARY KEY (`id`)
+) CHARSET utf8mb4 COLLATE utf8mb4_bin;
+-- Create "check_results" table
+CREATE TABLE `check_results` (
+  `id` bigint NOT NULL AUTO_INCREMENT,
+  `status` varchar(255) NOT NULL DEFAULT 'unknown',
+  `status_code` bigint NULL,
+  `response_time_ms` bigint NULL,
+  `error_message` varchar(255) NULL,
+  `selection_type` varchar(255) NULL,
+  `selection_value` varchar(255) NULL,
+  `diff_changed` bool NOT NULL DEFAULT false,
+  `diff_kind` varchar(255) NULL,
+  `diff_summary` varchar(255) NULL,
+  `diff_details` varchar(255) NULL,
+  `checked_at` timestamp NULL,
+  `monitor_check_results` bigint NOT NULL,
+  PRIMARY KEY (`id`),
+  CONSTRAINT `check_results_monitors_check_results` FOREIGN KEY (`monitor_check_results`) REFERENCES `monitors` (`id`) ON DELETE NO ACTION
+) CHARSET utf8mb4 COLLATE utf8mb4_bin;
+-- Create "monitor_runtimes" table
+CREATE TABLE `monitor_runtimes` (
+  `id` bigint NOT NULL AUTO_INCREMENT,
+  `status` enum('pending','ok','error','retrying','disabled') NOT NULL DEFAULT 'pending',
+  `check_count` bigint NOT NULL DEFAULT 0,
+  `success_count` bigint NOT NULL DEFAULT 0,
+  `error_count` bigint NOT NULL DEFAULT 0,
+  `retry_count` bigint NOT NULL DEFAULT 0,
+  `consecutive_successes` bigint NOT NULL DEFAULT 0,
+  `consecutive_errors` bigint NOT NULL DEFAULT 0,
+  `last_check_at` timestamp NULL,
+  `last_success_at` timestamp NULL,
+  `last_error_at` timestamp NULL,
+  `last_status_code` bigint NULL,
+  `last_duration_ms` bigint NULL,
+  `last_error_message` varchar(255) NULL,
+  `next_run_at` timestamp NULL,
+  `updated_at` timestamp NULL,
+  `monitor_runtime` bigint NOT NULL,
+  PRIMARY KEY (`id`),
+  UNIQUE INDEX `monitor_runtime` (`monitor_runtime`),
+  CONSTRAINT `monitor_runtimes_monitors_runtime` FOREIGN KEY (`monitor_runtime`) REFERENCES `monitors` (`id`) ON DELETE NO ACTION
+) CHARSET utf8mb4 COLLATE utf8mb4_bin;
+-- Create "notification_channels" table
+CREATE TABLE `notification_channels` (
+  `id` bigint NOT NULL AUTO_INCREMENT,
+  `name` varchar(255) NOT NULL DEFAULT 'Telegram',
+  `kind` enum('telegram') NOT NULL DEFAULT 'telegram',
+  `bot_token` varchar(255) NOT NULL,
+  `chat_id` varchar(255) NOT NULL,
+  `enabled` bool NOT NULL DEFAULT true,
+  `created_at` timestamp NULL,
+  `updated_at` timestamp NULL,
+  PRIMARY KEY (`id`),
+  UNIQUE INDEX `notificationchannel_kind` (`kind`)
+) CHARSET utf8mb4 COLLATE utf8mb4_bin;
+-- Create "notification_events" table
+CREATE TABLE `notification_events` (
+  `id` bigint NOT NULL AUTO_INCREMENT,
+  `status` varchar(255) NOT NULL DEFAULT 'pending',
+  `message` varchar(255) NULL,
+  `sent_at` timestamp NULL,
+  `monitor_notification_events` bigint NOT NULL,
+  `notification_channel_notification_events` bigint NOT NULL,
+  PRIMARY KEY (`id`),
+  CONSTRAINT `notification_events_monitors_notification_events` FOREIGN KEY (`monitor_notification_events`) REFERENCES `monitors` (`id`) ON DELETE NO ACTION,
+  CONSTRAINT `notification_events_notification_channels_notification_events` FOREIGN KEY (`notification_channel_notification_events`) REFERENCES `notification_channels` (`id`) ON DELETE NO ACTION
+) CHARSET utf8mb4 COLLATE utf8mb4_bin;
+-- Create "system_configs" table
+CREATE TABLE `system_configs` (
+  `id` bigint NOT NULL AUTO_INCREMENT,
+  `key` varchar(255) NOT NULL DEFAULT 'global',
+  `checks_history_limit` bigint NOT NULL DEFAULT 200,
+  `timezone` varchar(255) NULL,
+  `updated_at` timestamp NULL,
+  PRIMARY KEY (`id`),
+  UNIQUE INDEX `systemconfig_key` (`key`)
+) CHARSET utf8mb4 COLLATE utf8mb4_bin;
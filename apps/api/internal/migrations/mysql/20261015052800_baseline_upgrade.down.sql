@@ -0,0 +1,18 @@
+-- reverse: create "monitor_versions" table
+DROP TABLE `monitor_versions`;
+-- reverse: create "sessions" table
+DROP TABLE `sessions`;
+-- reverse: create "users" table
+DROP TABLE `users`;
+-- reverse: create "header_profiles" table
+DROP TABLE `header_profiles`;
+-- reverse: modify "system_configs" table
+ALTER TABLE `system_configs` DROP COLUMN `catch_up_max_age_minutes`, DROP COLUMN `catch_up_policy`, DROP COLUMN `circuit_breaker_backoff_minutes`, DROP COLUMN `circuit_breaker_action`, DROP COLUMN `circuit_breaker_threshold`, DROP COLUMN `schedule_jitter_seconds`, DROP COLUMN `stale_notified_at`, DROP COLUMN `stale_notifications`, DROP COLUMN `stale_after_days`, DROP COLUMN `paused_at`, DROP COLUMN `notifications_paused`, DROP COLUMN `paused`;
+-- reverse: modify "notification_events" table
+ALTER TABLE `notification_events` DROP FOREIGN KEY `notification_events_notification_events_escalations`, DROP COLUMN `notification_event_escalations`, MODIFY COLUMN `message` varchar(255) NULL, DROP COLUMN `escalation_level`, DROP COLUMN `kind`;
+-- reverse: modify "monitor_runtimes" table
+ALTER TABLE `monitor_runtimes` DROP COLUMN `claimed_until`, DROP COLUMN `claimed_by`, DROP COLUMN `cookies`, DROP COLUMN `circuit_opened_at`, DROP COLUMN `last_ping_at`, DROP COLUMN `watchdog_alerted_at`, DROP COLUMN `expect_change_until`, DROP COLUMN `error_repeating_since`, DROP COLUMN `last_change_at`, DROP COLUMN `daily_change_counts`, DROP COLUMN `acknowledged_at`, DROP COLUMN `escalated_at`, DROP COLUMN `failing_since`, MODIFY COLUMN `last_error_message` varchar(255) NULL;
+-- reverse: modify "check_results" table
+ALTER TABLE `check_results` DROP COLUMN `diff_ms`, DROP COLUMN `selector_ms`, DROP COLUMN `body_read_ms`, DROP COLUMN `redirect_chain`, DROP COLUMN `tracked_header_value`, DROP COLUMN `body_hash`, DROP COLUMN `body_snapshot_truncated`, DROP COLUMN `body_size`, DROP COLUMN `body_snapshot_encoding`, DROP COLUMN `body_snapshot`, MODIFY COLUMN `diff_details` varchar(255) NULL, MODIFY COLUMN `diff_summary` varchar(255) NULL, MODIFY COLUMN `selection_value` varchar(255) NULL, MODIFY COLUMN `error_message` varchar(255) NULL;
+-- reverse: modify "monitors" table
+ALTER TABLE `monitors` DROP FOREIGN KEY `monitors_header_profiles_monitors`, DROP INDEX `heartbeat_token`, DROP COLUMN `header_profile_id`, DROP COLUMN `archived_at`, DROP COLUMN `sort_index`, DROP COLUMN `status_page`, DROP COLUMN `heartbeat_token`, DROP COLUMN `fetch_mode`, DROP COLUMN `content_hash`, DROP COLUMN `body_snapshot`, DROP COLUMN `dst_policy`, DROP COLUMN `jitter_seconds`, DROP COLUMN `timezone`, DROP COLUMN `tls_server_name`, DROP COLUMN `tls_min_version`, DROP COLUMN `tls_insecure_skip_verify`, DROP COLUMN `tls_ca_pem`, DROP COLUMN `ip_family`, DROP COLUMN `host_overrides`, DROP COLUMN `cookie_jar`, DROP COLUMN `max_response_bytes`, DROP COLUMN `max_redirects`, DROP COLUMN `redirect_policy`, DROP COLUMN `numeric_tolerance`, DROP COLUMN `track_header`, DROP COLUMN `header_assertions`, DROP COLUMN `watchdog_minutes`, DROP COLUMN `accept_empty_body`, DROP COLUMN `treat_not_found_as_success`, DROP COLUMN `must_not_contain`, DROP COLUMN `must_contain`, MODIFY COLUMN `expected_response` varchar(255) NULL, MODIFY COLUMN `expected_type` enum('json','html','text') NOT NULL DEFAULT 'json', DROP COLUMN `retry_on`, DROP COLUMN `expected_status`, MODIFY COLUMN `selector` varchar(255) NULL, DROP COLUMN `escalation_after_minutes`, DROP COLUMN `tags`, DROP COLUMN `escalation_channels`, DROP COLUMN `user_agent`, MODIFY COLUMN `body` varchar(255) NULL, MODIFY COLUMN `icon_url` varchar(255) NULL, MODIFY COLUMN `url` varchar(255) NOT NULL;
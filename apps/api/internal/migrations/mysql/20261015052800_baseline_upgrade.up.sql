@@ -0,0 +1,18 @@
+-- create "header_profiles" table
+CREATE TABLE `header_profiles` (`id` bigint NOT NULL AUTO_INCREMENT, `name` varchar(255) NOT NULL, `headers` json NULL, `created_at` timestamp NULL, `updated_at` timestamp NULL, PRIMARY KEY (`id`), UNIQUE INDEX `name` (`name`)) CHARSET utf8mb4 COLLATE utf8mb4_bin;
+-- create "users" table
+CREATE TABLE `users` (`id` bigint NOT NULL AUTO_INCREMENT, `username` varchar(255) NOT NULL, `password_hash` varchar(255) NOT NULL, `role` enum('admin','viewer') NOT NULL DEFAULT 'viewer', `created_at` timestamp NULL, `updated_at` timestamp NULL, PRIMARY KEY (`id`), UNIQUE INDEX `username` (`username`)) CHARSET utf8mb4 COLLATE utf8mb4_bin;
+-- create "sessions" table
+CREATE TABLE `sessions` (`id` bigint NOT NULL AUTO_INCREMENT, `token_hash` varchar(255) NOT NULL, `expires_at` timestamp NULL, `created_at` timestamp NULL, `user_id` bigint NOT NULL, PRIMARY KEY (`id`), UNIQUE INDEX `token_hash` (`token_hash`), CONSTRAINT `sessions_users_sessions` FOREIGN KEY (`user_id`) REFERENCES `users` (`id`) ON DELETE NO ACTION) CHARSET utf8mb4 COLLATE utf8mb4_bin;
+-- create "monitor_versions" table
+CREATE TABLE `monitor_versions` (`id` bigint NOT NULL AUTO_INCREMENT, `version` bigint NOT NULL, `config` json NOT NULL, `created_by` varchar(255) NULL, `created_at` timestamp NULL, `monitor_id` bigint NOT NULL, PRIMARY KEY (`id`), UNIQUE INDEX `monitorversion_monitor_id_version` (`monitor_id`, `version`), CONSTRAINT `monitor_versions_monitors_versions` FOREIGN KEY (`monitor_id`) REFERENCES `monitors` (`id`) ON DELETE NO ACTION) CHARSET utf8mb4 COLLATE utf8mb4_bin;
+-- modify "monitors" table
+ALTER TABLE `monitors` MODIFY COLUMN `url` longtext NOT NULL, MODIFY COLUMN `icon_url` longtext NULL, MODIFY COLUMN `body` longtext NULL, ADD COLUMN `user_agent` varchar(255) NULL, ADD COLUMN `escalation_channels` json NULL, ADD COLUMN `tags` json NULL, ADD COLUMN `escalation_after_minutes` bigint NULL, MODIFY COLUMN `selector` longtext NULL, ADD COLUMN `expected_status` varchar(255) NULL, ADD COLUMN `retry_on` varchar(255) NULL, MODIFY COLUMN `expected_type` enum('json','html','text','feed','sitemap') NOT NULL DEFAULT 'json', MODIFY COLUMN `expected_response` longtext NULL, ADD COLUMN `must_contain` json NULL, ADD COLUMN `must_not_contain` json NULL, ADD COLUMN `treat_not_found_as_success` bool NOT NULL DEFAULT false, ADD COLUMN `accept_empty_body` bool NOT NULL DEFAULT false, ADD COLUMN `watchdog_minutes` bigint NULL, ADD COLUMN `header_assertions` json NULL, ADD COLUMN `track_header` varchar(255) NULL, ADD COLUMN `numeric_tolerance` double NULL, ADD COLUMN `redirect_policy` enum('follow','none','record') NOT NULL DEFAULT 'follow', ADD COLUMN `max_redirects` bigint NULL, ADD COLUMN `max_response_bytes` bigint NULL, ADD COLUMN `cookie_jar` bool NOT NULL DEFAULT false, ADD COLUMN `host_overrides` json NULL, ADD COLUMN `ip_family` enum('any','ipv4','ipv6') NOT NULL DEFAULT 'any', ADD COLUMN `tls_ca_pem` longtext NULL, ADD COLUMN `tls_insecure_skip_verify` bool NOT NULL DEFAULT false, ADD COLUMN `tls_min_version` varchar(255) NULL, ADD COLUMN `tls_server_name` varchar(255) NULL, ADD COLUMN `timezone` varchar(255) NULL, ADD COLUMN `jitter_seconds` bigint NULL, ADD COLUMN `dst_policy` enum('skip','next_valid','run_twice') NOT NULL DEFAULT 'next_valid', ADD COLUMN `body_snapshot` enum('off','raw','gzip') NOT NULL DEFAULT 'off', ADD COLUMN `content_hash` enum('off','raw','normalized') NOT NULL DEFAULT 'off', ADD COLUMN `fetch_mode` enum('http','rendered','heartbeat') NOT NULL DEFAULT 'http', ADD COLUMN `heartbeat_token` varchar(255) NULL, ADD COLUMN `status_page` bool NOT NULL DEFAULT false, ADD COLUMN `sort_index` bigint NOT NULL DEFAULT 0, ADD COLUMN `archived_at` timestamp NULL, ADD COLUMN `header_profile_id` bigint NULL, ADD UNIQUE INDEX `heartbeat_token` (`heartbeat_token`), ADD CONSTRAINT `monitors_header_profiles_monitors` FOREIGN KEY (`header_profile_id`) REFERENCES `header_profiles` (`id`) ON DELETE SET NULL;
+-- modify "check_results" table
+ALTER TABLE `check_results` MODIFY COLUMN `error_message` longtext NULL, MODIFY COLUMN `selection_value` longtext NULL, MODIFY COLUMN `diff_summary` longtext NULL, MODIFY COLUMN `diff_details` longtext NULL, ADD COLUMN `body_snapshot` longblob NULL, ADD COLUMN `body_snapshot_encoding` varchar(255) NULL, ADD COLUMN `body_size` bigint NULL, ADD COLUMN `body_snapshot_truncated` bool NOT NULL DEFAULT false, ADD COLUMN `body_hash` varchar(255) NULL, ADD COLUMN `tracked_header_value` longtext NULL, ADD COLUMN `redirect_chain` json NULL, ADD COLUMN `body_read_ms` double NULL, ADD COLUMN `selector_ms` double NULL, ADD COLUMN `diff_ms` double NULL;
+-- modify "monitor_runtimes" table
+ALTER TABLE `monitor_runtimes` MODIFY COLUMN `last_error_message` longtext NULL, ADD COLUMN `failing_since` timestamp NULL, ADD COLUMN `escalated_at` timestamp NULL, ADD COLUMN `acknowledged_at` timestamp NULL, ADD COLUMN `daily_change_counts` json NULL, ADD COLUMN `last_change_at` timestamp NULL, ADD COLUMN `error_repeating_since` timestamp NULL, ADD COLUMN `expect_change_until` timestamp NULL, ADD COLUMN `watchdog_alerted_at` timestamp NULL, ADD COLUMN `last_ping_at` timestamp NULL, ADD COLUMN `circuit_opened_at` timestamp NULL, ADD COLUMN `cookies` json NULL, ADD COLUMN `claimed_by` varchar(255) NULL, ADD COLUMN `claimed_until` timestamp NULL;
+-- modify "notification_events" table
+ALTER TABLE `notification_events` ADD COLUMN `kind` enum('diff','failure','escalation','stale','watchdog','circuit_breaker') NOT NULL DEFAULT 'diff', ADD COLUMN `escalation_level` bigint NOT NULL DEFAULT 0, MODIFY COLUMN `message` longtext NULL, ADD COLUMN `notification_event_escalations` bigint NULL, ADD CONSTRAINT `notification_events_notification_events_escalations` FOREIGN KEY (`notification_event_escalations`) REFERENCES `notification_events` (`id`) ON DELETE SET NULL;
+-- modify "system_configs" table
+ALTER TABLE `system_configs` ADD COLUMN `paused` bool NOT NULL DEFAULT false, ADD COLUMN `notifications_paused` bool NOT NULL DEFAULT false, ADD COLUMN `paused_at` timestamp NULL, ADD COLUMN `stale_after_days` bigint NOT NULL DEFAULT 14, ADD COLUMN `stale_notifications` bool NOT NULL DEFAULT false, ADD COLUMN `stale_notified_at` timestamp NULL, ADD COLUMN `schedule_jitter_seconds` bigint NOT NULL DEFAULT 0, ADD COLUMN `circuit_breaker_threshold` bigint NOT NULL DEFAULT 0, ADD COLUMN `circuit_breaker_action` enum('backoff','suspend') NOT NULL DEFAULT 'backoff', ADD COLUMN `circuit_breaker_backoff_minutes` bigint NOT NULL DEFAULT 60, ADD COLUMN `catch_up_policy` enum('run_all_missed','run_latest_only','skip') NOT NULL DEFAULT 'run_latest_only', ADD COLUMN `catch_up_max_age_minutes` bigint NOT NULL DEFAULT 0;
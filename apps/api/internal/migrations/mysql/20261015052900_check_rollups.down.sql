@@ -0,0 +1,2 @@
+-- reverse: create "check_rollups" table
+DROP TABLE `check_rollups`;
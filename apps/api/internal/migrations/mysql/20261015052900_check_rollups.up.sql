@@ -0,0 +1,2 @@
+-- create "check_rollups" table
+CREATE TABLE `check_rollups` (`id` bigint NOT NULL AUTO_INCREMENT, `period` enum('hour','day') NOT NULL, `bucket_start` timestamp NULL, `checks` bigint NOT NULL DEFAULT 0, `successes` bigint NOT NULL DEFAULT 0, `errors` bigint NOT NULL DEFAULT 0, `changes` bigint NOT NULL DEFAULT 0, `latency_samples` bigint NOT NULL DEFAULT 0, `latency_total_ms` bigint NOT NULL DEFAULT 0, `latency_max_ms` bigint NULL, `latency_histogram` json NULL, `updated_at` timestamp NULL, `monitor_id` bigint NOT NULL, PRIMARY KEY (`id`), UNIQUE INDEX `checkrollup_monitor_id_period_bucket_start` (`monitor_id`, `period`, `bucket_start`), CONSTRAINT `check_rollups_monitors_rollups` FOREIGN KEY (`monitor_id`) REFERENCES `monitors` (`id`) ON DELETE NO ACTION) CHARSET utf8mb4 COLLATE utf8mb4_bin;
@@ -0,0 +1,2 @@
+-- reverse: modify "system_configs" table
+ALTER TABLE `system_configs` DROP COLUMN `default_headers`, DROP COLUMN `default_user_agent`;
@@ -0,0 +1,2 @@
+-- modify "system_configs" table
+ALTER TABLE `system_configs` ADD COLUMN `default_user_agent` varchar(255) NULL, ADD COLUMN `default_headers` json NULL;
@@ -0,0 +1,2 @@
+-- reverse: modify "system_configs" table
+ALTER TABLE `system_configs` DROP COLUMN `proxy_bypass`, DROP COLUMN `proxy_url`;
@@ -0,0 +1,2 @@
+-- modify "system_configs" table
+ALTER TABLE `system_configs` ADD COLUMN `proxy_url` varchar(255) NULL, ADD COLUMN `proxy_bypass` varchar(255) NULL;
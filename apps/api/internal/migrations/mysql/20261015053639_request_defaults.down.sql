@@ -0,0 +1,2 @@
+-- reverse: modify "system_configs" table
+ALTER TABLE `system_configs` DROP COLUMN `retry_backoff_seconds`, DROP COLUMN `max_retries`, DROP COLUMN `request_timeout_seconds`;
@@ -0,0 +1,2 @@
+-- modify "system_configs" table
+ALTER TABLE `system_configs` ADD COLUMN `request_timeout_seconds` bigint NOT NULL DEFAULT 15, ADD COLUMN `max_retries` bigint NOT NULL DEFAULT 2, ADD COLUMN `retry_backoff_seconds` bigint NOT NULL DEFAULT 1;
@@ -0,0 +1,2 @@
+-- reverse: modify "system_configs" table
+ALTER TABLE `system_configs` DROP COLUMN `schedule_lookahead_seconds`, DROP COLUMN `tick_interval_seconds`;
@@ -0,0 +1,2 @@
+-- modify "system_configs" table
+ALTER TABLE `system_configs` ADD COLUMN `tick_interval_seconds` bigint NOT NULL DEFAULT 60, ADD COLUMN `schedule_lookahead_seconds` bigint NOT NULL DEFAULT 0;
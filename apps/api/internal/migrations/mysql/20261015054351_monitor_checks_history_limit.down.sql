@@ -0,0 +1,2 @@
+-- reverse: modify "monitors" table
+ALTER TABLE `monitors` DROP COLUMN `checks_history_limit`;
@@ -0,0 +1,2 @@
+-- modify "monitors" table
+ALTER TABLE `monitors` ADD COLUMN `checks_history_limit` bigint NULL;
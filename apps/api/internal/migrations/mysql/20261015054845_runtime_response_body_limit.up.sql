@@ -0,0 +1,2 @@
+-- modify "system_configs" table
+ALTER TABLE `system_configs` ADD COLUMN `max_response_body_bytes` bigint NULL;
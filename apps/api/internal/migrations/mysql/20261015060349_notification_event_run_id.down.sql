@@ -0,0 +1,2 @@
+-- reverse: modify "notification_events" table
+ALTER TABLE `notification_events` DROP COLUMN `run_id`;
@@ -0,0 +1,2 @@
+-- modify "notification_events" table
+ALTER TABLE `notification_events` ADD COLUMN `run_id` varchar(255) NULL;
@@ -0,0 +1,2 @@
+-- reverse: Create "selector_payloads" table
+DROP TABLE `selector_payloads`;
@@ -0,0 +1,11 @@
+-- Create "selector_payloads" table
+CREATE TABLE `selector_payloads` (
+  `id` bigint NOT NULL AUTO_INCREMENT,
+  `token` varchar(255) NOT NULL,
+  `payload` longblob NOT NULL,
+  `expires_at` timestamp NULL,
+  `created_at` timestamp NULL,
+  PRIMARY KEY (`id`),
+  UNIQUE INDEX `token` (`token`),
+  INDEX `selectorpayload_expires_at` (`expires_at`)
+) CHARSET utf8mb4 COLLATE utf8mb4_bin;
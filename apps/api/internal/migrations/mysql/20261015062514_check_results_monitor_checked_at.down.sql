@@ -0,0 +1,2 @@
+-- reverse: Modify "check_results" table
+ALTER TABLE `check_results` DROP INDEX `checkresult_monitor_check_results_checked_at`;
@@ -0,0 +1,2 @@
+-- Modify "check_results" table
+ALTER TABLE `check_results` ADD INDEX `checkresult_monitor_check_results_checked_at` (`monitor_check_results`, `checked_at`);
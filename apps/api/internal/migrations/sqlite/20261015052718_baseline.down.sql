@@ -0,0 +1,12 @@
+-- reverse: Create "system_configs" table
+DROP TABLE `system_configs`;
+-- reverse: Create "notification_events" table
+DROP TABLE `notification_events`;
+-- reverse: Create "notification_channels" table
+DROP TABLE `notification_channels`;
+-- reverse: Create "monitor_runtimes" table
+DROP TABLE `monitor_runtimes`;
+-- reverse: Create "check_results" table
+DROP TABLE `check_results`;
+-- reverse: Create "monitors" table
+DROP TABLE `monitors`;
@@ -0,0 +1,93 @@
+-- Create "monitors" table
+CREATE TABLE `monitors` (
+  `id` integer NOT NULL PRIMARY KEY AUTOINCREMENT,
+  `label` text NULL,
+  `method` text NOT NULL DEFAULT ('GET'),
+  `url` text NOT NULL,
+  `icon_url` text NULL,
+  `body` text NULL,
+  `headers` json NULL,
+  `auth` json NULL,
+  `notification_channels` json NULL,
+  `selector` text NULL,
+  `expected_type` text NOT NULL DEFAULT ('json'),
+  `expected_response` text NULL,
+  `cron` text NOT NULL,
+  `enabled` bool NOT NULL DEFAULT (true),
+  `created_at` datetime NOT NULL,
+  `updated_at` datetime NOT NULL
+);
+-- Create "check_results" table
+CREATE TABLE `check_results` (
+  `id` integer NOT NULL PRIMARY KEY AUTOINCREMENT,
+  `status` text NOT NULL DEFAULT ('unknown'),
+  `status_code` integer NULL,
+  `response_time_ms` integer NULL,
+  `error_message` text NULL,
+  `selection_type` text NULL,
+  `selection_value` text NULL,
+  `diff_changed` bool NOT NULL DEFAULT (false),
+  `diff_kind` text NULL,
+  `diff_summary` text NULL,
+  `diff_details` text NULL,
+  `checked_at` datetime NOT NULL,
+  `monitor_check_results` integer NOT NULL,
+  CONSTRAINT `check_results_monitors_check_results` FOREIGN KEY (`monitor_check_results`) REFERENCES `monitors` (`id`) ON DELETE NO ACTION
+);
+-- Create "monitor_runtimes" table
+CREATE TABLE `monitor_runtimes` (
+  `id` integer NOT NULL PRIMARY KEY AUTOINCREMENT,
+  `status` text NOT NULL DEFAULT ('pending'),
+  `check_count` integer NOT NULL DEFAULT (0),
+  `success_count` integer NOT NULL DEFAULT (0),
+  `error_count` integer NOT NULL DEFAULT (0),
+  `retry_count` integer NOT NULL DEFAULT (0),
+  `consecutive_successes` integer NOT NULL DEFAULT (0),
+  `consecutive_errors` integer NOT NULL DEFAULT (0),
+  `last_check_at` datetime NULL,
+  `last_success_at` datetime NULL,
+  `last_error_at` datetime NULL,
+  `last_status_code` integer NULL,
+  `last_duration_ms` integer NULL,
+  `last_error_message` text NULL,
+  `next_run_at` datetime NULL,
+  `updated_at` datetime NOT NULL,
+  `monitor_runtime` integer NOT NULL,
+  CONSTRAINT `monitor_runtimes_monitors_runtime` FOREIGN KEY (`monitor_runtime`) REFERENCES `monitors` (`id`) ON DELETE NO ACTION
+);
+-- Create index "monitor_runtimes_monitor_runtime_key" to table: "monitor_runtimes"
+CREATE UNIQUE INDEX `monitor_runtimes_monitor_runtime_key` ON `monitor_runtimes` (`monitor_runtime`);
+-- Create "notification_channels" table
+CREATE TABLE `notification_channels` (
+  `id` integer NOT NULL PRIMARY KEY AUTOINCREMENT,
+  `name` text NOT NULL DEFAULT ('Telegram'),
+  `kind` text NOT NULL DEFAULT ('telegram'),
+  `bot_token` text NOT NULL,
+  `chat_id` text NOT NULL,
+  `enabled` bool NOT NULL DEFAULT (true),
+  `created_at` datetime NOT NULL,
+  `updated_at` datetime NOT NULL
+);
+-- Create index "notificationchannel_kind" to table: "notification_channels"
+CREATE UNIQUE INDEX `notificationchannel_kind` ON `notification_channels` (`kind`);
+-- Create "notification_events" table
+CREATE TABLE `notification_events` (
+  `id` integer NOT NULL PRIMARY KEY AUTOINCREMENT,
+  `status` text NOT NULL DEFAULT ('pending'),
+  `message` text NULL,
+  `sent_at` datetime NOT NULL,
+  `monitor_notification_events` integer NOT NULL,
+  `notification_channel_notification_events` integer NOT NULL,
+  CONSTRAINT `notification_events_monitors_notification_events` FOREIGN KEY (`monitor_notification_events`) REFERENCES `monitors` (`id`) ON DELETE NO ACTION,
+  CONSTRAINT `notification_events_notification_channels_notification_events` FOREIGN KEY (`notification_channel_notification_events`) REFERENCES `notification_channels` (`id`) ON DELETE NO ACTION
+);
+-- Create "system_configs" table
+CREATE TABLE `system_configs` (
+  `id` integer NOT NULL PRIMARY KEY AUTOINCREMENT,
+  `key` text NOT NULL DEFAULT ('global'),
+  `checks_history_limit` integer NOT NULL DEFAULT (200),
+  `timezone` text NULL,
+  `updated_at` datetime NOT NULL
+);
+-- Create index "systemconfig_key" to table: "system_configs"
+CREATE UNIQUE INDEX `systemconfig_key` ON `system_configs` (`key`);
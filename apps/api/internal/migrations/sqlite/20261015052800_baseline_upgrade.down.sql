@@ -0,0 +1,156 @@
+-- reverse: add column "catch_up_max_age_minutes" to table: "system_configs"
+ALTER TABLE `system_configs` DROP COLUMN `catch_up_max_age_minutes`;
+-- reverse: add column "catch_up_policy" to table: "system_configs"
+ALTER TABLE `system_configs` DROP COLUMN `catch_up_policy`;
+-- reverse: add column "circuit_breaker_backoff_minutes" to table: "system_configs"
+ALTER TABLE `system_configs` DROP COLUMN `circuit_breaker_backoff_minutes`;
+-- reverse: add column "circuit_breaker_action" to table: "system_configs"
+ALTER TABLE `system_configs` DROP COLUMN `circuit_breaker_action`;
+-- reverse: add column "circuit_breaker_threshold" to table: "system_configs"
+ALTER TABLE `system_configs` DROP COLUMN `circuit_breaker_threshold`;
+-- reverse: add column "schedule_jitter_seconds" to table: "system_configs"
+ALTER TABLE `system_configs` DROP COLUMN `schedule_jitter_seconds`;
+-- reverse: add column "stale_notified_at" to table: "system_configs"
+ALTER TABLE `system_configs` DROP COLUMN `stale_notified_at`;
+-- reverse: add column "stale_notifications" to table: "system_configs"
+ALTER TABLE `system_configs` DROP COLUMN `stale_notifications`;
+-- reverse: add column "stale_after_days" to table: "system_configs"
+ALTER TABLE `system_configs` DROP COLUMN `stale_after_days`;
+-- reverse: add column "paused_at" to table: "system_configs"
+ALTER TABLE `system_configs` DROP COLUMN `paused_at`;
+-- reverse: add column "notifications_paused" to table: "system_configs"
+ALTER TABLE `system_configs` DROP COLUMN `notifications_paused`;
+-- reverse: add column "paused" to table: "system_configs"
+ALTER TABLE `system_configs` DROP COLUMN `paused`;
+-- reverse: add column "notification_event_escalations" to table: "notification_events"
+ALTER TABLE `notification_events` DROP COLUMN `notification_event_escalations`;
+-- reverse: add column "escalation_level" to table: "notification_events"
+ALTER TABLE `notification_events` DROP COLUMN `escalation_level`;
+-- reverse: add column "kind" to table: "notification_events"
+ALTER TABLE `notification_events` DROP COLUMN `kind`;
+-- reverse: add column "claimed_until" to table: "monitor_runtimes"
+ALTER TABLE `monitor_runtimes` DROP COLUMN `claimed_until`;
+-- reverse: add column "claimed_by" to table: "monitor_runtimes"
+ALTER TABLE `monitor_runtimes` DROP COLUMN `claimed_by`;
+-- reverse: add column "cookies" to table: "monitor_runtimes"
+ALTER TABLE `monitor_runtimes` DROP COLUMN `cookies`;
+-- reverse: add column "circuit_opened_at" to table: "monitor_runtimes"
+ALTER TABLE `monitor_runtimes` DROP COLUMN `circuit_opened_at`;
+-- reverse: add column "last_ping_at" to table: "monitor_runtimes"
+ALTER TABLE `monitor_runtimes` DROP COLUMN `last_ping_at`;
+-- reverse: add column "watchdog_alerted_at" to table: "monitor_runtimes"
+ALTER TABLE `monitor_runtimes` DROP COLUMN `watchdog_alerted_at`;
+-- reverse: add column "expect_change_until" to table: "monitor_runtimes"
+ALTER TABLE `monitor_runtimes` DROP COLUMN `expect_change_until`;
+-- reverse: add column "error_repeating_since" to table: "monitor_runtimes"
+ALTER TABLE `monitor_runtimes` DROP COLUMN `error_repeating_since`;
+-- reverse: add column "last_change_at" to table: "monitor_runtimes"
+ALTER TABLE `monitor_runtimes` DROP COLUMN `last_change_at`;
+-- reverse: add column "daily_change_counts" to table: "monitor_runtimes"
+ALTER TABLE `monitor_runtimes` DROP COLUMN `daily_change_counts`;
+-- reverse: add column "acknowledged_at" to table: "monitor_runtimes"
+ALTER TABLE `monitor_runtimes` DROP COLUMN `acknowledged_at`;
+-- reverse: add column "escalated_at" to table: "monitor_runtimes"
+ALTER TABLE `monitor_runtimes` DROP COLUMN `escalated_at`;
+-- reverse: add column "failing_since" to table: "monitor_runtimes"
+ALTER TABLE `monitor_runtimes` DROP COLUMN `failing_since`;
+-- reverse: add column "diff_ms" to table: "check_results"
+ALTER TABLE `check_results` DROP COLUMN `diff_ms`;
+-- reverse: add column "selector_ms" to table: "check_results"
+ALTER TABLE `check_results` DROP COLUMN `selector_ms`;
+-- reverse: add column "body_read_ms" to table: "check_results"
+ALTER TABLE `check_results` DROP COLUMN `body_read_ms`;
+-- reverse: add column "redirect_chain" to table: "check_results"
+ALTER TABLE `check_results` DROP COLUMN `redirect_chain`;
+-- reverse: add column "tracked_header_value" to table: "check_results"
+ALTER TABLE `check_results` DROP COLUMN `tracked_header_value`;
+-- reverse: add column "body_hash" to table: "check_results"
+ALTER TABLE `check_results` DROP COLUMN `body_hash`;
+-- reverse: add column "body_snapshot_truncated" to table: "check_results"
+ALTER TABLE `check_results` DROP COLUMN `body_snapshot_truncated`;
+-- reverse: add column "body_size" to table: "check_results"
+ALTER TABLE `check_results` DROP COLUMN `body_size`;
+-- reverse: add column "body_snapshot_encoding" to table: "check_results"
+ALTER TABLE `check_results` DROP COLUMN `body_snapshot_encoding`;
+-- reverse: add column "body_snapshot" to table: "check_results"
+ALTER TABLE `check_results` DROP COLUMN `body_snapshot`;
+-- reverse: create index "monitors_heartbeat_token_key" to table: "monitors"
+DROP INDEX `monitors_heartbeat_token_key`;
+-- reverse: add column "header_profile_id" to table: "monitors"
+ALTER TABLE `monitors` DROP COLUMN `header_profile_id`;
+-- reverse: add column "archived_at" to table: "monitors"
+ALTER TABLE `monitors` DROP COLUMN `archived_at`;
+-- reverse: add column "sort_index" to table: "monitors"
+ALTER TABLE `monitors` DROP COLUMN `sort_index`;
+-- reverse: add column "status_page" to table: "monitors"
+ALTER TABLE `monitors` DROP COLUMN `status_page`;
+-- reverse: add column "heartbeat_token" to table: "monitors"
+ALTER TABLE `monitors` DROP COLUMN `heartbeat_token`;
+-- reverse: add column "fetch_mode" to table: "monitors"
+ALTER TABLE `monitors` DROP COLUMN `fetch_mode`;
+-- reverse: add column "content_hash" to table: "monitors"
+ALTER TABLE `monitors` DROP COLUMN `content_hash`;
+-- reverse: add column "body_snapshot" to table: "monitors"
+ALTER TABLE `monitors` DROP COLUMN `body_snapshot`;
+-- reverse: add column "dst_policy" to table: "monitors"
+ALTER TABLE `monitors` DROP COLUMN `dst_policy`;
+-- reverse: add column "jitter_seconds" to table: "monitors"
+ALTER TABLE `monitors` DROP COLUMN `jitter_seconds`;
+-- reverse: add column "timezone" to table: "monitors"
+ALTER TABLE `monitors` DROP COLUMN `timezone`;
+-- reverse: add column "tls_server_name" to table: "monitors"
+ALTER TABLE `monitors` DROP COLUMN `tls_server_name`;
+-- reverse: add column "tls_min_version" to table: "monitors"
+ALTER TABLE `monitors` DROP COLUMN `tls_min_version`;
+-- reverse: add column "tls_insecure_skip_verify" to table: "monitors"
+ALTER TABLE `monitors` DROP COLUMN `tls_insecure_skip_verify`;
+-- reverse: add column "tls_ca_pem" to table: "monitors"
+ALTER TABLE `monitors` DROP COLUMN `tls_ca_pem`;
+-- reverse: add column "ip_family" to table: "monitors"
+ALTER TABLE `monitors` DROP COLUMN `ip_family`;
+-- reverse: add column "host_overrides" to table: "monitors"
+ALTER TABLE `monitors` DROP COLUMN `host_overrides`;
+-- reverse: add column "cookie_jar" to table: "monitors"
+ALTER TABLE `monitors` DROP COLUMN `cookie_jar`;
+-- reverse: add column "max_response_bytes" to table: "monitors"
+ALTER TABLE `monitors` DROP COLUMN `max_response_bytes`;
+-- reverse: add column "max_redirects" to table: "monitors"
+ALTER TABLE `monitors` DROP COLUMN `max_redirects`;
+-- reverse: add column "redirect_policy" to table: "monitors"
+ALTER TABLE `monitors` DROP COLUMN `redirect_policy`;
+-- reverse: add column "numeric_tolerance" to table: "monitors"
+ALTER TABLE `monitors` DROP COLUMN `numeric_tolerance`;
+-- reverse: add column "track_header" to table: "monitors"
+ALTER TABLE `monitors` DROP COLUMN `track_header`;
+-- reverse: add column "header_assertions" to table: "monitors"
+ALTER TABLE `monitors` DROP COLUMN `header_assertions`;
+-- reverse: add column "watchdog_minutes" to table: "monitors"
+ALTER TABLE `monitors` DROP COLUMN `watchdog_minutes`;
+-- reverse: add column "accept_empty_body" to table: "monitors"
+ALTER TABLE `monitors` DROP COLUMN `accept_empty_body`;
+-- reverse: add column "treat_not_found_as_success" to table: "monitors"
+ALTER TABLE `monitors` DROP COLUMN `treat_not_found_as_success`;
+-- reverse: add column "must_not_contain" to table: "monitors"
+ALTER TABLE `monitors` DROP COLUMN `must_not_contain`;
+-- reverse: add column "must_contain" to table: "monitors"
+ALTER TABLE `monitors` DROP COLUMN `must_contain`;
+-- reverse: add column "retry_on" to table: "monitors"
+ALTER TABLE `monitors` DROP COLUMN `retry_on`;
+-- reverse: add column "expected_status" to table: "monitors"
+ALTER TABLE `monitors` DROP COLUMN `expected_status`;
+-- reverse: add column "escalation_after_minutes" to table: "monitors"
+ALTER TABLE `monitors` DROP COLUMN `escalation_after_minutes`;
+-- reverse: add column "tags" to table: "monitors"
+ALTER TABLE `monitors` DROP COLUMN `tags`;
+-- reverse: add column "escalation_channels" to table: "monitors"
+ALTER TABLE `monitors` DROP COLUMN `escalation_channels`;
+-- reverse: add column "user_agent" to table: "monitors"
+ALTER TABLE `monitors` DROP COLUMN `user_agent`;
+-- reverse: create "monitor_versions" table
+DROP TABLE `monitor_versions`;
+-- reverse: create "sessions" table
+DROP TABLE `sessions`;
+-- reverse: create "users" table
+DROP TABLE `users`;
+-- reverse: create "header_profiles" table
+DROP TABLE `header_profiles`;
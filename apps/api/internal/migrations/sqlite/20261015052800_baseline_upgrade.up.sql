@@ -0,0 +1,164 @@
+-- create "header_profiles" table
+CREATE TABLE `header_profiles` (`id` integer NOT NULL PRIMARY KEY AUTOINCREMENT, `name` text NOT NULL, `headers` json NULL, `created_at` datetime NOT NULL, `updated_at` datetime NOT NULL);
+-- create index "header_profiles_name_key" to table: "header_profiles"
+CREATE UNIQUE INDEX `header_profiles_name_key` ON `header_profiles` (`name`);
+-- create "users" table
+CREATE TABLE `users` (`id` integer NOT NULL PRIMARY KEY AUTOINCREMENT, `username` text NOT NULL, `password_hash` text NOT NULL, `role` text NOT NULL DEFAULT ('viewer'), `created_at` datetime NOT NULL, `updated_at` datetime NOT NULL);
+-- create index "users_username_key" to table: "users"
+CREATE UNIQUE INDEX `users_username_key` ON `users` (`username`);
+-- create "sessions" table
+CREATE TABLE `sessions` (`id` integer NOT NULL PRIMARY KEY AUTOINCREMENT, `token_hash` text NOT NULL, `expires_at` datetime NOT NULL, `created_at` datetime NOT NULL, `user_id` integer NOT NULL, CONSTRAINT `sessions_users_sessions` FOREIGN KEY (`user_id`) REFERENCES `users` (`id`) ON DELETE NO ACTION);
+-- create index "sessions_token_hash_key" to table: "sessions"
+CREATE UNIQUE INDEX `sessions_token_hash_key` ON `sessions` (`token_hash`);
+-- create "monitor_versions" table
+CREATE TABLE `monitor_versions` (`id` integer NOT NULL PRIMARY KEY AUTOINCREMENT, `version` integer NOT NULL, `config` json NOT NULL, `created_by` text NULL, `created_at` datetime NOT NULL, `monitor_id` integer NOT NULL, CONSTRAINT `monitor_versions_monitors_versions` FOREIGN KEY (`monitor_id`) REFERENCES `monitors` (`id`) ON DELETE NO ACTION);
+-- create index "monitorversion_monitor_id_version" to table: "monitor_versions"
+CREATE UNIQUE INDEX `monitorversion_monitor_id_version` ON `monitor_versions` (`monitor_id`, `version`);
+-- add column "user_agent" to table: "monitors"
+ALTER TABLE `monitors` ADD COLUMN `user_agent` text NULL;
+-- add column "escalation_channels" to table: "monitors"
+ALTER TABLE `monitors` ADD COLUMN `escalation_channels` json NULL;
+-- add column "tags" to table: "monitors"
+ALTER TABLE `monitors` ADD COLUMN `tags` json NULL;
+-- add column "escalation_after_minutes" to table: "monitors"
+ALTER TABLE `monitors` ADD COLUMN `escalation_after_minutes` integer NULL;
+-- add column "expected_status" to table: "monitors"
+ALTER TABLE `monitors` ADD COLUMN `expected_status` text NULL;
+-- add column "retry_on" to table: "monitors"
+ALTER TABLE `monitors` ADD COLUMN `retry_on` text NULL;
+-- add column "must_contain" to table: "monitors"
+ALTER TABLE `monitors` ADD COLUMN `must_contain` json NULL;
+-- add column "must_not_contain" to table: "monitors"
+ALTER TABLE `monitors` ADD COLUMN `must_not_contain` json NULL;
+-- add column "treat_not_found_as_success" to table: "monitors"
+ALTER TABLE `monitors` ADD COLUMN `treat_not_found_as_success` bool NOT NULL DEFAULT (false);
+-- add column "accept_empty_body" to table: "monitors"
+ALTER TABLE `monitors` ADD COLUMN `accept_empty_body` bool NOT NULL DEFAULT (false);
+-- add column "watchdog_minutes" to table: "monitors"
+ALTER TABLE `monitors` ADD COLUMN `watchdog_minutes` integer NULL;
+-- add column "header_assertions" to table: "monitors"
+ALTER TABLE `monitors` ADD COLUMN `header_assertions` json NULL;
+-- add column "track_header" to table: "monitors"
+ALTER TABLE `monitors` ADD COLUMN `track_header` text NULL;
+-- add column "numeric_tolerance" to table: "monitors"
+ALTER TABLE `monitors` ADD COLUMN `numeric_tolerance` real NULL;
+-- add column "redirect_policy" to table: "monitors"
+ALTER TABLE `monitors` ADD COLUMN `redirect_policy` text NOT NULL DEFAULT ('follow');
+-- add column "max_redirects" to table: "monitors"
+ALTER TABLE `monitors` ADD COLUMN `max_redirects` integer NULL;
+-- add column "max_response_bytes" to table: "monitors"
+ALTER TABLE `monitors` ADD COLUMN `max_response_bytes` integer NULL;
+-- add column "cookie_jar" to table: "monitors"
+ALTER TABLE `monitors` ADD COLUMN `cookie_jar` bool NOT NULL DEFAULT (false);
+-- add column "host_overrides" to table: "monitors"
+ALTER TABLE `monitors` ADD COLUMN `host_overrides` json NULL;
+-- add column "ip_family" to table: "monitors"
+ALTER TABLE `monitors` ADD COLUMN `ip_family` text NOT NULL DEFAULT ('any');
+-- add column "tls_ca_pem" to table: "monitors"
+ALTER TABLE `monitors` ADD COLUMN `tls_ca_pem` text NULL;
+-- add column "tls_insecure_skip_verify" to table: "monitors"
+ALTER TABLE `monitors` ADD COLUMN `tls_insecure_skip_verify` bool NOT NULL DEFAULT (false);
+-- add column "tls_min_version" to table: "monitors"
+ALTER TABLE `monitors` ADD COLUMN `tls_min_version` text NULL;
+-- add column "tls_server_name" to table: "monitors"
+ALTER TABLE `monitors` ADD COLUMN `tls_server_name` text NULL;
+-- add column "timezone" to table: "monitors"
+ALTER TABLE `monitors` ADD COLUMN `timezone` text NULL;
+-- add column "jitter_seconds" to table: "monitors"
+ALTER TABLE `monitors` ADD COLUMN `jitter_seconds` integer NULL;
+-- add column "dst_policy" to table: "monitors"
+ALTER TABLE `monitors` ADD COLUMN `dst_policy` text NOT NULL DEFAULT ('next_valid');
+-- add column "body_snapshot" to table: "monitors"
+ALTER TABLE `monitors` ADD COLUMN `body_snapshot` text NOT NULL DEFAULT ('off');
+-- add column "content_hash" to table: "monitors"
+ALTER TABLE `monitors` ADD COLUMN `content_hash` text NOT NULL DEFAULT ('off');
+-- add column "fetch_mode" to table: "monitors"
+ALTER TABLE `monitors` ADD COLUMN `fetch_mode` text NOT NULL DEFAULT ('http');
+-- add column "heartbeat_token" to table: "monitors"
+ALTER TABLE `monitors` ADD COLUMN `heartbeat_token` text NULL;
+-- add column "status_page" to table: "monitors"
+ALTER TABLE `monitors` ADD COLUMN `status_page` bool NOT NULL DEFAULT (false);
+-- add column "sort_index" to table: "monitors"
+ALTER TABLE `monitors` ADD COLUMN `sort_index` integer NOT NULL DEFAULT (0);
+-- add column "archived_at" to table: "monitors"
+ALTER TABLE `monitors` ADD COLUMN `archived_at` datetime NULL;
+-- add column "header_profile_id" to table: "monitors"
+ALTER TABLE `monitors` ADD COLUMN `header_profile_id` integer NULL CONSTRAINT `monitors_header_profiles_monitors` REFERENCES `header_profiles` (`id`) ON DELETE SET NULL;
+-- create index "monitors_heartbeat_token_key" to table: "monitors"
+CREATE UNIQUE INDEX `monitors_heartbeat_token_key` ON `monitors` (`heartbeat_token`);
+-- add column "body_snapshot" to table: "check_results"
+ALTER TABLE `check_results` ADD COLUMN `body_snapshot` blob NULL;
+-- add column "body_snapshot_encoding" to table: "check_results"
+ALTER TABLE `check_results` ADD COLUMN `body_snapshot_encoding` text NULL;
+-- add column "body_size" to table: "check_results"
+ALTER TABLE `check_results` ADD COLUMN `body_size` integer NULL;
+-- add column "body_snapshot_truncated" to table: "check_results"
+ALTER TABLE `check_results` ADD COLUMN `body_snapshot_truncated` bool NOT NULL DEFAULT (false);
+-- add column "body_hash" to table: "check_results"
+ALTER TABLE `check_results` ADD COLUMN `body_hash` text NULL;
+-- add column "tracked_header_value" to table: "check_results"
+ALTER TABLE `check_results` ADD COLUMN `tracked_header_value` text NULL;
+-- add column "redirect_chain" to table: "check_results"
+ALTER TABLE `check_results` ADD COLUMN `redirect_chain` json NULL;
+-- add column "body_read_ms" to table: "check_results"
+ALTER TABLE `check_results` ADD COLUMN `body_read_ms` real NULL;
+-- add column "selector_ms" to table: "check_results"
+ALTER TABLE `check_results` ADD COLUMN `selector_ms` real NULL;
+-- add column "diff_ms" to table: "check_results"
+ALTER TABLE `check_results` ADD COLUMN `diff_ms` real NULL;
+-- add column "failing_since" to table: "monitor_runtimes"
+ALTER TABLE `monitor_runtimes` ADD COLUMN `failing_since` datetime NULL;
+-- add column "escalated_at" to table: "monitor_runtimes"
+ALTER TABLE `monitor_runtimes` ADD COLUMN `escalated_at` datetime NULL;
+-- add column "acknowledged_at" to table: "monitor_runtimes"
+ALTER TABLE `monitor_runtimes` ADD COLUMN `acknowledged_at` datetime NULL;
+-- add column "daily_change_counts" to table: "monitor_runtimes"
+ALTER TABLE `monitor_runtimes` ADD COLUMN `daily_change_counts` json NULL;
+-- add column "last_change_at" to table: "monitor_runtimes"
+ALTER TABLE `monitor_runtimes` ADD COLUMN `last_change_at` datetime NULL;
+-- add column "error_repeating_since" to table: "monitor_runtimes"
+ALTER TABLE `monitor_runtimes` ADD COLUMN `error_repeating_since` datetime NULL;
+-- add column "expect_change_until" to table: "monitor_runtimes"
+ALTER TABLE `monitor_runtimes` ADD COLUMN `expect_change_until` datetime NULL;
+-- add column "watchdog_alerted_at" to table: "monitor_runtimes"
+ALTER TABLE `monitor_runtimes` ADD COLUMN `watchdog_alerted_at` datetime NULL;
+-- add column "last_ping_at" to table: "monitor_runtimes"
+ALTER TABLE `monitor_runtimes` ADD COLUMN `last_ping_at` datetime NULL;
+-- add column "circuit_opened_at" to table: "monitor_runtimes"
+ALTER TABLE `monitor_runtimes` ADD COLUMN `circuit_opened_at` datetime NULL;
+-- add column "cookies" to table: "monitor_runtimes"
+ALTER TABLE `monitor_runtimes` ADD COLUMN `cookies` json NULL;
+-- add column "claimed_by" to table: "monitor_runtimes"
+ALTER TABLE `monitor_runtimes` ADD COLUMN `claimed_by` text NULL;
+-- add column "claimed_until" to table: "monitor_runtimes"
+ALTER TABLE `monitor_runtimes` ADD COLUMN `claimed_until` datetime NULL;
+-- add column "kind" to table: "notification_events"
+ALTER TABLE `notification_events` ADD COLUMN `kind` text NOT NULL DEFAULT ('diff');
+-- add column "escalation_level" to table: "notification_events"
+ALTER TABLE `notification_events` ADD COLUMN `escalation_level` integer NOT NULL DEFAULT (0);
+-- add column "notification_event_escalations" to table: "notification_events"
+ALTER TABLE `notification_events` ADD COLUMN `notification_event_escalations` integer NULL CONSTRAINT `notification_events_notification_events_escalations` REFERENCES `notification_events` (`id`) ON DELETE SET NULL;
+-- add column "paused" to table: "system_configs"
+ALTER TABLE `system_configs` ADD COLUMN `paused` bool NOT NULL DEFAULT (false);
+-- add column "notifications_paused" to table: "system_configs"
+ALTER TABLE `system_configs` ADD COLUMN `notifications_paused` bool NOT NULL DEFAULT (false);
+-- add column "paused_at" to table: "system_configs"
+ALTER TABLE `system_configs` ADD COLUMN `paused_at` datetime NULL;
+-- add column "stale_after_days" to table: "system_configs"
+ALTER TABLE `system_configs` ADD COLUMN `stale_after_days` integer NOT NULL DEFAULT (14);
+-- add column "stale_notifications" to table: "system_configs"
+ALTER TABLE `system_configs` ADD COLUMN `stale_notifications` bool NOT NULL DEFAULT (false);
+-- add column "stale_notified_at" to table: "system_configs"
+ALTER TABLE `system_configs` ADD COLUMN `stale_notified_at` datetime NULL;
+-- add column "schedule_jitter_seconds" to table: "system_configs"
+ALTER TABLE `system_configs` ADD COLUMN `schedule_jitter_seconds` integer NOT NULL DEFAULT (0);
+-- add column "circuit_breaker_threshold" to table: "system_configs"
+ALTER TABLE `system_configs` ADD COLUMN `circuit_breaker_threshold` integer NOT NULL DEFAULT (0);
+-- add column "circuit_breaker_action" to table: "system_configs"
+ALTER TABLE `system_configs` ADD COLUMN `circuit_breaker_action` text NOT NULL DEFAULT ('backoff');
+-- add column "circuit_breaker_backoff_minutes" to table: "system_configs"
+ALTER TABLE `system_configs` ADD COLUMN `circuit_breaker_backoff_minutes` integer NOT NULL DEFAULT (60);
+-- add column "catch_up_policy" to table: "system_configs"
+ALTER TABLE `system_configs` ADD COLUMN `catch_up_policy` text NOT NULL DEFAULT ('run_latest_only');
+-- add column "catch_up_max_age_minutes" to table: "system_configs"
+ALTER TABLE `system_configs` ADD COLUMN `catch_up_max_age_minutes` integer NOT NULL DEFAULT (0);
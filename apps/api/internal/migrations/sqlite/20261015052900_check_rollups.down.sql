@@ -0,0 +1,4 @@
+-- reverse: create index "checkrollup_monitor_id_period_bucket_start" to table: "check_rollups"
+DROP INDEX `checkrollup_monitor_id_period_bucket_start`;
+-- reverse: create "check_rollups" table
+DROP TABLE `check_rollups`;
@@ -0,0 +1,4 @@
+-- create "check_rollups" table
+CREATE TABLE `check_rollups` (`id` integer NOT NULL PRIMARY KEY AUTOINCREMENT, `period` text NOT NULL, `bucket_start` datetime NOT NULL, `checks` integer NOT NULL DEFAULT (0), `successes` integer NOT NULL DEFAULT (0), `errors` integer NOT NULL DEFAULT (0), `changes` integer NOT NULL DEFAULT (0), `latency_samples` integer NOT NULL DEFAULT (0), `latency_total_ms` integer NOT NULL DEFAULT (0), `latency_max_ms` integer NULL, `latency_histogram` json NULL, `updated_at` datetime NOT NULL, `monitor_id` integer NOT NULL, CONSTRAINT `check_rollups_monitors_rollups` FOREIGN KEY (`monitor_id`) REFERENCES `monitors` (`id`) ON DELETE NO ACTION);
+-- create index "checkrollup_monitor_id_period_bucket_start" to table: "check_rollups"
+CREATE UNIQUE INDEX `checkrollup_monitor_id_period_bucket_start` ON `check_rollups` (`monitor_id`, `period`, `bucket_start`);
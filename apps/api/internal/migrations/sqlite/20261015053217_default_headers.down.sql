@@ -0,0 +1,4 @@
+-- reverse: add column "default_headers" to table: "system_configs"
+ALTER TABLE `system_configs` DROP COLUMN `default_headers`;
+-- reverse: add column "default_user_agent" to table: "system_configs"
+ALTER TABLE `system_configs` DROP COLUMN `default_user_agent`;
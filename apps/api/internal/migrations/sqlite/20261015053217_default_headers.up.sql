@@ -0,0 +1,4 @@
+-- add column "default_user_agent" to table: "system_configs"
+ALTER TABLE `system_configs` ADD COLUMN `default_user_agent` text NULL;
+-- add column "default_headers" to table: "system_configs"
+ALTER TABLE `system_configs` ADD COLUMN `default_headers` json NULL;
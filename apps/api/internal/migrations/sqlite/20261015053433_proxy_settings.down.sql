@@ -0,0 +1,4 @@
+-- reverse: add column "proxy_bypass" to table: "system_configs"
+ALTER TABLE `system_configs` DROP COLUMN `proxy_bypass`;
+-- reverse: add column "proxy_url" to table: "system_configs"
+ALTER TABLE `system_configs` DROP COLUMN `proxy_url`;
@@ -0,0 +1,4 @@
+-- add column "proxy_url" to table: "system_configs"
+ALTER TABLE `system_configs` ADD COLUMN `proxy_url` text NULL;
+-- add column "proxy_bypass" to table: "system_configs"
+ALTER TABLE `system_configs` ADD COLUMN `proxy_bypass` text NULL;
@@ -0,0 +1,6 @@
+-- reverse: add column "retry_backoff_seconds" to table: "system_configs"
+ALTER TABLE `system_configs` DROP COLUMN `retry_backoff_seconds`;
+-- reverse: add column "max_retries" to table: "system_configs"
+ALTER TABLE `system_configs` DROP COLUMN `max_retries`;
+-- reverse: add column "request_timeout_seconds" to table: "system_configs"
+ALTER TABLE `system_configs` DROP COLUMN `request_timeout_seconds`;
@@ -0,0 +1,6 @@
+-- add column "request_timeout_seconds" to table: "system_configs"
+ALTER TABLE `system_configs` ADD COLUMN `request_timeout_seconds` integer NOT NULL DEFAULT (15);
+-- add column "max_retries" to table: "system_configs"
+ALTER TABLE `system_configs` ADD COLUMN `max_retries` integer NOT NULL DEFAULT (2);
+-- add column "retry_backoff_seconds" to table: "system_configs"
+ALTER TABLE `system_configs` ADD COLUMN `retry_backoff_seconds` integer NOT NULL DEFAULT (1);
@@ -0,0 +1,4 @@
+-- reverse: add column "schedule_lookahead_seconds" to table: "system_configs"
+ALTER TABLE `system_configs` DROP COLUMN `schedule_lookahead_seconds`;
+-- reverse: add column "tick_interval_seconds" to table: "system_configs"
+ALTER TABLE `system_configs` DROP COLUMN `tick_interval_seconds`;
@@ -0,0 +1,4 @@
+-- add column "tick_interval_seconds" to table: "system_configs"
+ALTER TABLE `system_configs` ADD COLUMN `tick_interval_seconds` integer NOT NULL DEFAULT (60);
+-- add column "schedule_lookahead_seconds" to table: "system_configs"
+ALTER TABLE `system_configs` ADD COLUMN `schedule_lookahead_seconds` integer NOT NULL DEFAULT (0);
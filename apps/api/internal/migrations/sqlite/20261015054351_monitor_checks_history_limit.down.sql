@@ -0,0 +1,2 @@
+-- reverse: add column "checks_history_limit" to table: "monitors"
+ALTER TABLE `monitors` DROP COLUMN `checks_history_limit`;
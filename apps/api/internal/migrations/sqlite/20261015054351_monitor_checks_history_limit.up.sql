@@ -0,0 +1,2 @@
+-- add column "checks_history_limit" to table: "monitors"
+ALTER TABLE `monitors` ADD COLUMN `checks_history_limit` integer NULL;
@@ -0,0 +1,2 @@
+-- reverse: add column "max_response_body_bytes" to table: "system_configs"
+ALTER TABLE `system_configs` DROP COLUMN `max_response_body_bytes`;
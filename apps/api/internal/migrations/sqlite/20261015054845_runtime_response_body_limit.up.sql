@@ -0,0 +1,2 @@
+-- add column "max_response_body_bytes" to table: "system_configs"
+ALTER TABLE `system_configs` ADD COLUMN `max_response_body_bytes` integer NULL;
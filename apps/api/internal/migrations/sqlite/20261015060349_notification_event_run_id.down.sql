@@ -0,0 +1,2 @@
+-- reverse: add column "run_id" to table: "notification_events"
+ALTER TABLE `notification_events` DROP COLUMN `run_id`;
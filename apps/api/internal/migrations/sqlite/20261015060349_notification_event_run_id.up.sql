@@ -0,0 +1,2 @@
+-- add column "run_id" to table: "notification_events"
+ALTER TABLE `notification_events` ADD COLUMN `run_id` text NULL;
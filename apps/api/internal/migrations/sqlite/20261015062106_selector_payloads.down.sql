@@ -0,0 +1,6 @@
+-- reverse: create index "selectorpayload_expires_at" to table: "selector_payloads"
+DROP INDEX `selectorpayload_expires_at`;
+-- reverse: create index "selector_payloads_token_key" to table: "selector_payloads"
+DROP INDEX `selector_payloads_token_key`;
+-- reverse: create "selector_payloads" table
+DROP TABLE `selector_payloads`;
@@ -0,0 +1,6 @@
+-- create "selector_payloads" table
+CREATE TABLE `selector_payloads` (`id` integer NOT NULL PRIMARY KEY AUTOINCREMENT, `token` text NOT NULL, `payload` blob NOT NULL, `expires_at` datetime NOT NULL, `created_at` datetime NOT NULL);
+-- create index "selector_payloads_token_key" to table: "selector_payloads"
+CREATE UNIQUE INDEX `selector_payloads_token_key` ON `selector_payloads` (`token`);
+-- create index "selectorpayload_expires_at" to table: "selector_payloads"
+CREATE INDEX `selectorpayload_expires_at` ON `selector_payloads` (`expires_at`);
@@ -0,0 +1,2 @@
+-- reverse: create index "checkresult_monitor_check_results_checked_at" to table: "check_results"
+DROP INDEX `checkresult_monitor_check_results_checked_at`;
@@ -0,0 +1,2 @@
+-- create index "checkresult_monitor_check_results_checked_at" to table: "check_results"
+CREATE INDEX `checkresult_monitor_check_results_checked_at` ON `check_results` (`monitor_check_results`, `checked_at`);
@@ -0,0 +1,66 @@
+// Package requestid tags API requests and worker runs with IDs that tie their
+// log lines, error responses and notification events together.
+package requestid
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"net/http"
+)
+
+// Header carries a request's ID, both from callers and in responses.
+const Header = "X-Request-ID"
+
+const (
+	idBytes     = 8
+	maxIDLength = 128
+)
+
+type contextKey struct{}
+
+// New returns a random ID.
+func New() string {
+	buffer := make([]byte, idBytes)
+	_, _ = rand.Read(buffer)
+	return hex.EncodeToString(buffer)
+}
+
+// NewContext returns a copy of ctx carrying id.
+func NewContext(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, contextKey{}, id)
+}
+
+// FromContext returns the request ID carried by ctx, or "" when there is none.
+func FromContext(ctx context.Context) string {
+	id, _ := ctx.Value(contextKey{}).(string)
+	return id
+}
+
+// Middleware gives every request an ID: the caller's X-Request-ID when it is
+// usable, a new one otherwise. The ID is set on the response header and the
+// request context.
+func Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		id := r.Header.Get(Header)
+		if !valid(id) {
+			id = New()
+		}
+		w.Header().Set(Header, id)
+		next.ServeHTTP(w, r.WithContext(NewContext(r.Context(), id)))
+	})
+}
+
+// valid accepts IDs of printable ASCII without spaces, so a caller's ID can
+// not break up log lines.
+func valid(id string) bool {
+	if id == "" || len(id) > maxIDLength {
+		return false
+	}
+	for i := 0; i < len(id); i++ {
+		if id[i] <= ' ' || id[i] > '~' {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,41 @@
+package requestid
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestMiddleware(t *testing.T) {
+	var seen string
+	handler := Middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		seen = FromContext(r.Context())
+	}))
+
+	cases := map[string]struct {
+		header string
+		keep   bool
+	}{
+		"caller id":  {"edge-7f3a", true},
+		"missing":    {"", false},
+		"whitespace": {"edge 7f3a", false},
+		"too long":   {strings.Repeat("a", maxIDLength+1), false},
+	}
+	for name, tc := range cases {
+		req := httptest.NewRequest(http.MethodGet, "/v1/monitors", nil)
+		if tc.header != "" {
+			req.Header.Set(Header, tc.header)
+		}
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+
+		got := rec.Header().Get(Header)
+		if got == "" || got != seen {
+			t.Fatalf("%s: expected the response header to match the context, got %q and %q", name, got, seen)
+		}
+		if (got == tc.header) != tc.keep {
+			t.Fatalf("%s: expected keep=%v, got %q", name, tc.keep, got)
+		}
+	}
+}
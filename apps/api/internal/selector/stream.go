@@ -0,0 +1,179 @@
+package selector
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// ErrNotStreamable is returned by SelectJSONStream for selectors that need
+// the whole document, such as gjson wildcards, queries and modifiers.
+var ErrNotStreamable = errors.New("selector cannot be evaluated while streaming")
+
+// StreamableJSON reports whether SelectJSONStream can evaluate selector: a
+// non-empty path of dot-separated object keys and array indexes.
+func StreamableJSON(selector string) bool {
+	_, ok := parseStreamPath(strings.TrimSpace(selector))
+	return ok
+}
+
+// SelectJSONStream evaluates selector while tokenizing the JSON read from r,
+// so only the selected value is held in memory. It returns the same Selection
+// as SelectJSON and still reads the rest of the document so invalid JSON is
+// reported either way.
+func SelectJSONStream(r io.Reader, selector string) (Selection, error) {
+	path, ok := parseStreamPath(strings.TrimSpace(selector))
+	if !ok {
+		return Selection{}, ErrNotStreamable
+	}
+
+	tokens := &jsonTokens{decoder: json.NewDecoder(r)}
+	raw, found, err := tokens.selectPath(path)
+	if err != nil {
+		return Selection{}, fmt.Errorf("invalid JSON payload")
+	}
+	if err := tokens.drain(); err != nil {
+		return Selection{}, fmt.Errorf("invalid JSON payload")
+	}
+	if !found {
+		return Selection{Exists: false, Type: "none"}, nil
+	}
+
+	return SelectJSON(raw, "")
+}
+
+// parseStreamPath splits a gjson path on unescaped dots. Paths using any gjson
+// syntax beyond plain keys and indexes are rejected.
+func parseStreamPath(selector string) ([]string, bool) {
+	if selector == "" {
+		return nil, false
+	}
+
+	var path []string
+	var component strings.Builder
+	for i := 0; i < len(selector); i++ {
+		char := selector[i]
+		switch {
+		case char == '\\' && i+1 < len(selector):
+			i++
+			component.WriteByte(selector[i])
+		case char == '.':
+			if component.Len() == 0 {
+				return nil, false
+			}
+			path = append(path, component.String())
+			component.Reset()
+		case strings.IndexByte(`\*?#@|!=<>%[]{}(),`, char) >= 0:
+			return nil, false
+		default:
+			component.WriteByte(char)
+		}
+	}
+	if component.Len() == 0 {
+		return nil, false
+	}
+
+	return append(path, component.String()), true
+}
+
+// jsonTokens tracks container depth across decoder.Token calls, since the
+// decoder reports a bare io.EOF for documents truncated inside a container.
+type jsonTokens struct {
+	decoder *json.Decoder
+	depth   int
+}
+
+func (t *jsonTokens) next() (json.Token, error) {
+	token, err := t.decoder.Token()
+	if err != nil {
+		return nil, err
+	}
+	if delim, ok := token.(json.Delim); ok {
+		switch delim {
+		case '{', '[':
+			t.depth++
+		case '}', ']':
+			t.depth--
+		}
+	}
+	return token, nil
+}
+
+// selectPath descends into the value at the decoder's position following
+// path. Like gjson it stops at the first matching key; the caller drains
+// whatever is left of the document.
+func (t *jsonTokens) selectPath(path []string) (json.RawMessage, bool, error) {
+	if len(path) == 0 {
+		var raw json.RawMessage
+		if err := t.decoder.Decode(&raw); err != nil {
+			return nil, false, err
+		}
+		return raw, true, nil
+	}
+
+	token, err := t.next()
+	if err != nil {
+		return nil, false, err
+	}
+	delim, ok := token.(json.Delim)
+	if !ok {
+		return nil, false, nil
+	}
+
+	switch delim {
+	case '{':
+		for t.decoder.More() {
+			keyToken, err := t.next()
+			if err != nil {
+				return nil, false, err
+			}
+			if key, ok := keyToken.(string); ok && key == path[0] {
+				return t.selectPath(path[1:])
+			}
+			if err := t.skipValue(); err != nil {
+				return nil, false, err
+			}
+		}
+	case '[':
+		index, err := strconv.Atoi(path[0])
+		if err != nil || index < 0 {
+			return nil, false, nil
+		}
+		for i := 0; t.decoder.More(); i++ {
+			if i == index {
+				return t.selectPath(path[1:])
+			}
+			if err := t.skipValue(); err != nil {
+				return nil, false, err
+			}
+		}
+	}
+
+	return nil, false, nil
+}
+
+func (t *jsonTokens) skipValue() error {
+	depth := t.depth
+	for {
+		if _, err := t.next(); err != nil {
+			return err
+		}
+		if t.depth == depth {
+			return nil
+		}
+	}
+}
+
+func (t *jsonTokens) drain() error {
+	for {
+		if _, err := t.next(); err != nil {
+			if errors.Is(err, io.EOF) && t.depth == 0 {
+				return nil
+			}
+			return err
+		}
+	}
+}
@@ -0,0 +1,62 @@
+package selector
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestSelectJSONStreamMatchesSelectJSON(t *testing.T) {
+	payload := `{
+		"status": "online",
+		"count": 3,
+		"meta": {"region": "eu", "tags": ["a", "b"], "empty": null},
+		"items": [{"id": 1, "price": 10.50}, {"id": 2, "price": 12}],
+		"dotted.key": true,
+		"status": "duplicate"
+	}`
+
+	selectors := []string{
+		"status",
+		"count",
+		"meta",
+		"meta.region",
+		"meta.tags.1",
+		"meta.empty",
+		"items.1.price",
+		"items.0",
+		`dotted\.key`,
+		"missing",
+		"items.5",
+		"status.nested",
+		"meta.region.deeper",
+	}
+	for _, selector := range selectors {
+		t.Run(selector, func(t *testing.T) {
+			expected, err := SelectJSON([]byte(payload), selector)
+			if err != nil {
+				t.Fatalf("expected buffered selection: %v", err)
+			}
+			streamed, err := SelectJSONStream(strings.NewReader(payload), selector)
+			if err != nil {
+				t.Fatalf("expected streamed selection: %v", err)
+			}
+			if streamed != expected {
+				t.Fatalf("expected %#v, got %#v", expected, streamed)
+			}
+		})
+	}
+}
+
+func TestSelectJSONStreamRejectsInvalidJSONAndComplexSelectors(t *testing.T) {
+	for _, payload := range []string{``, `{"status": "online"`, `{"status": }`, `{"a": [1, 2}`} {
+		if _, err := SelectJSONStream(strings.NewReader(payload), "status"); err == nil {
+			t.Fatalf("expected invalid JSON %q to fail", payload)
+		}
+	}
+
+	for _, selector := range []string{"", "items.#", "items.#.id", "items.*", "@reverse", "a|b", "a..b"} {
+		if StreamableJSON(selector) {
+			t.Fatalf("expected selector %q to need buffering", selector)
+		}
+	}
+}
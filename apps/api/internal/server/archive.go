@@ -0,0 +1,163 @@
+package server
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"goanna/apps/api/ent"
+	"goanna/apps/api/ent/monitor"
+	"goanna/apps/api/ent/monitorruntime"
+)
+
+// handleArchiveMonitor is DELETE /v1/monitors/{monitorId}. It archives the
+// monitor instead of destroying its history; archiving twice is a no-op.
+func (s *Server) handleArchiveMonitor(w http.ResponseWriter, r *http.Request) {
+	monitorID, err := parseMonitorID(r.PathValue("monitorId"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "monitorId must be a positive integer")
+		return
+	}
+
+	existing, err := s.db.Monitor.Get(r.Context(), monitorID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			writeError(w, http.StatusNotFound, "monitor not found")
+			return
+		}
+		writeError(w, http.StatusInternalServerError, "failed to load monitor")
+		return
+	}
+	if existing.ArchivedAt != nil {
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+
+	tx, err := s.db.Tx(r.Context())
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "failed to archive monitor")
+		return
+	}
+	if err := archiveMonitors(r.Context(), tx, time.Now().UTC(), monitorID); err != nil {
+		_ = tx.Rollback()
+		writeError(w, http.StatusInternalServerError, "failed to archive monitor")
+		return
+	}
+	if err := tx.Commit(); err != nil {
+		writeError(w, http.StatusInternalServerError, "failed to archive monitor")
+		return
+	}
+	s.scheduleChanges.Publish()
+	s.publishMonitorsUpdated(r.Context(), monitorArchived, []int{monitorID})
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// handleRestoreMonitor brings an archived monitor back, rescheduling it when
+// it is enabled.
+func (s *Server) handleRestoreMonitor(w http.ResponseWriter, r *http.Request) {
+	monitorID, err := parseMonitorID(r.PathValue("monitorId"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "monitorId must be a positive integer")
+		return
+	}
+
+	existing, err := s.db.Monitor.Get(r.Context(), monitorID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			writeError(w, http.StatusNotFound, "monitor not found")
+			return
+		}
+		writeError(w, http.StatusInternalServerError, "failed to load monitor")
+		return
+	}
+	if existing.ArchivedAt == nil {
+		writeError(w, http.StatusConflict, "monitor is not archived")
+		return
+	}
+
+	config, err := s.ensureGlobalSystemConfig(r.Context())
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "failed to load runtime settings")
+		return
+	}
+
+	tx, err := s.db.Tx(r.Context())
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "failed to restore monitor")
+		return
+	}
+	if err := restoreMonitors(r.Context(), tx, []int{monitorID}, runtimeCronLocation(config.Timezone)); err != nil {
+		_ = tx.Rollback()
+		writeError(w, http.StatusInternalServerError, "failed to restore monitor")
+		return
+	}
+	if err := tx.Commit(); err != nil {
+		writeError(w, http.StatusInternalServerError, "failed to restore monitor")
+		return
+	}
+	s.scheduleChanges.Publish()
+
+	restored, err := s.db.Monitor.Query().
+		Where(monitor.IDEQ(monitorID)).
+		WithRuntime().
+		Only(r.Context())
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "failed to load monitor")
+		return
+	}
+	channelStates := s.loadNotificationChannelStates(r.Context())
+	mapped := s.mapMonitor(
+		restored,
+		restored.Edges.Runtime,
+		buildMonitorNotificationIssues(restored.NotificationChannels, channelStates),
+	)
+	s.publishMonitorUpdated(restored.ID, monitorRestored, &mapped)
+
+	writeJSON(w, http.StatusOK, mapped)
+}
+
+// archiveMonitors marks monitors archived and stops their schedule. Checks,
+// notification events and versions are kept.
+func archiveMonitors(ctx context.Context, tx *ent.Tx, now time.Time, monitorIDs ...int) error {
+	if err := tx.Monitor.Update().
+		Where(monitor.IDIn(monitorIDs...), monitor.ArchivedAtIsNil()).
+		SetArchivedAt(now).
+		Exec(ctx); err != nil {
+		return err
+	}
+
+	return tx.MonitorRuntime.Update().
+		Where(monitorruntime.HasMonitorWith(monitor.IDIn(monitorIDs...))).
+		SetStatus(monitorruntime.StatusDisabled).
+		ClearNextRunAt().
+		Exec(ctx)
+}
+
+// restoreMonitors unarchives monitors, scheduling the enabled ones again the
+// way enabling them would. Monitors that are not archived are left untouched.
+func restoreMonitors(ctx context.Context, tx *ent.Tx, monitorIDs []int, cronLocation *time.Location) error {
+	rows, err := tx.Monitor.Query().
+		Where(monitor.IDIn(monitorIDs...), monitor.ArchivedAtNotNil()).
+		WithRuntime().
+		All(ctx)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now().UTC()
+	for _, row := range rows {
+		restored, err := tx.Monitor.UpdateOneID(row.ID).ClearArchivedAt().Save(ctx)
+		if err != nil {
+			return err
+		}
+		if !restored.Enabled {
+			continue
+		}
+		if err := scheduleMonitorRuntime(ctx, tx, restored, row.Edges.Runtime, now, cronLocation); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,97 @@
+package server
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"goanna/apps/api/ent/enttest"
+	"goanna/apps/api/ent/monitorruntime"
+
+	_ "github.com/mattn/go-sqlite3"
+)
+
+func TestArchiveAndRestoreMonitor(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:monitor-archive?mode=memory&cache=shared&_fk=1")
+	defer client.Close()
+
+	mux := http.NewServeMux()
+	New(client).RegisterRoutes(mux)
+
+	send := func(method, path string) *httptest.ResponseRecorder {
+		rec := httptest.NewRecorder()
+		mux.ServeHTTP(rec, httptest.NewRequest(method, path, nil))
+		return rec
+	}
+	listed := func(query string) []monitorResponse {
+		rec := send(http.MethodGet, "/v1/monitors"+query)
+		var monitors []monitorResponse
+		if err := json.Unmarshal(rec.Body.Bytes(), &monitors); err != nil {
+			t.Fatalf("expected monitor list, got %s", rec.Body.String())
+		}
+		return monitors
+	}
+
+	rec := httptest.NewRecorder()
+	mux.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/v1/monitors", strings.NewReader(`{"url":"https://example.com","cron":"*/5 * * * *"}`)))
+	var created monitorTriggerResponse
+	if err := json.Unmarshal(rec.Body.Bytes(), &created); err != nil || rec.Code != http.StatusCreated {
+		t.Fatalf("expected monitor to be created, got %d: %s", rec.Code, rec.Body.String())
+	}
+	monitorID := int(created.Monitor.ID)
+	if _, err := client.CheckResult.Create().
+		SetMonitorID(monitorID).
+		SetStatus("ok").
+		Save(t.Context()); err != nil {
+		t.Fatalf("expected check to save: %v", err)
+	}
+
+	if rec := send(http.MethodDelete, fmt.Sprintf("/v1/monitors/%d", monitorID)); rec.Code != http.StatusNoContent {
+		t.Fatalf("expected 204, got %d: %s", rec.Code, rec.Body.String())
+	}
+	if monitors := listed(""); len(monitors) != 0 {
+		t.Fatalf("expected archived monitor to be hidden, got %+v", monitors)
+	}
+	archived := listed("?archived=true")
+	if len(archived) != 1 || archived[0].ArchivedAt == nil {
+		t.Fatalf("expected archived monitor to be listed on request, got %+v", archived)
+	}
+	runtime, err := client.MonitorRuntime.Query().Only(t.Context())
+	if err != nil || runtime.Status != monitorruntime.StatusDisabled || runtime.NextRunAt != nil {
+		t.Fatalf("expected archived monitor to be unscheduled, got %+v (%v)", runtime, err)
+	}
+	if checks, _ := client.CheckResult.Query().Count(t.Context()); checks != 1 {
+		t.Fatalf("expected history to be kept, got %d checks", checks)
+	}
+	if rec := send(http.MethodPost, fmt.Sprintf("/v1/monitors/%d/trigger", monitorID)); rec.Code != http.StatusConflict {
+		t.Fatalf("expected archived monitor trigger to conflict, got %d", rec.Code)
+	}
+
+	rec = send(http.MethodPost, fmt.Sprintf("/v1/monitors/%d/restore", monitorID))
+	var restored monitorResponse
+	if err := json.Unmarshal(rec.Body.Bytes(), &restored); err != nil || rec.Code != http.StatusOK {
+		t.Fatalf("expected 200, got %d: %s", rec.Code, rec.Body.String())
+	}
+	if restored.ArchivedAt != nil || restored.Status != "pending" || restored.NextRunAt == nil {
+		t.Fatalf("expected restored monitor to be scheduled again, got %+v", restored)
+	}
+	if rec := send(http.MethodPost, fmt.Sprintf("/v1/monitors/%d/restore", monitorID)); rec.Code != http.StatusConflict {
+		t.Fatalf("expected restoring an active monitor to conflict, got %d", rec.Code)
+	}
+
+	if rec := send(http.MethodDelete, fmt.Sprintf("/v1/monitors/%d/permanent", monitorID)); rec.Code != http.StatusNoContent {
+		t.Fatalf("expected 204, got %d: %s", rec.Code, rec.Body.String())
+	}
+	if count, _ := client.Monitor.Query().Count(t.Context()); count != 0 {
+		t.Fatalf("expected monitor to be deleted, got %d", count)
+	}
+	if checks, _ := client.CheckResult.Query().Count(t.Context()); checks != 0 {
+		t.Fatalf("expected history to be deleted, got %d checks", checks)
+	}
+	if rec := send(http.MethodPost, fmt.Sprintf("/v1/monitors/%d/restore", monitorID)); rec.Code != http.StatusNotFound {
+		t.Fatalf("expected 404, got %d", rec.Code)
+	}
+}
@@ -0,0 +1,239 @@
+package server
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestNormalizeMonitorRequestKeywords(t *testing.T) {
+	input, err := normalizeMonitorRequest(createMonitorRequest{
+		URL:            "https://example.com/product",
+		Cron:           "*/5 * * * *",
+		ExpectedType:   "html",
+		MustContain:    []string{" In stock ", ""},
+		MustNotContain: []string{"/error|unavailable/i"},
+	})
+	if err != nil {
+		t.Fatalf("expected keywords to normalize: %v", err)
+	}
+	if !reflect.DeepEqual(input.mustContain, []string{"In stock"}) || !reflect.DeepEqual(input.mustNotContain, []string{"/error|unavailable/i"}) {
+		t.Fatalf("unexpected keywords %#v %#v", input.mustContain, input.mustNotContain)
+	}
+
+	if _, err := normalizeMonitorRequest(createMonitorRequest{
+		URL:          "https://example.com/product",
+		Cron:         "*/5 * * * *",
+		ExpectedType: "html",
+		MustContain:  []string{"/(unclosed/"},
+	}); err == nil {
+		t.Fatal("expected invalid keyword regex to fail")
+	}
+
+	if _, err := normalizeMonitorRequest(createMonitorRequest{
+		URL:          "https://example.com/api",
+		Cron:         "*/5 * * * *",
+		ExpectedType: "json",
+		MustContain:  []string{"ok"},
+	}); err == nil {
+		t.Fatal("expected keywords on json monitor to fail")
+	}
+}
+
+func TestNormalizeMonitorRequestHeaderAssertions(t *testing.T) {
+	trackHeader := " x-version "
+	input, err := normalizeMonitorRequest(createMonitorRequest{
+		URL:              "https://example.com/api",
+		Cron:             "*/5 * * * *",
+		HeaderAssertions: map[string]string{"content-type": " application/json ", "etag": ""},
+		TrackHeader:      &trackHeader,
+	})
+	if err != nil {
+		t.Fatalf("expected header assertions to normalize: %v", err)
+	}
+	expected := map[string]string{"Content-Type": "application/json", "Etag": ""}
+	if !reflect.DeepEqual(input.headerAssertions, expected) {
+		t.Fatalf("expected canonical header assertions %#v, got %#v", expected, input.headerAssertions)
+	}
+	if input.trackHeader == nil || *input.trackHeader != "X-Version" {
+		t.Fatalf("expected canonical tracked header, got %v", input.trackHeader)
+	}
+
+	if _, err := normalizeMonitorRequest(createMonitorRequest{
+		URL:              "https://example.com/api",
+		Cron:             "*/5 * * * *",
+		HeaderAssertions: map[string]string{"X-Version": "/(/"},
+	}); err == nil {
+		t.Fatal("expected invalid header regex to fail")
+	}
+}
+
+func TestNormalizeMonitorRequestExpectedStatus(t *testing.T) {
+	expectedStatus := " 200,3xx "
+	input, err := normalizeMonitorRequest(createMonitorRequest{
+		URL:            "https://example.com/old",
+		Cron:           "*/5 * * * *",
+		ExpectedStatus: &expectedStatus,
+	})
+	if err != nil {
+		t.Fatalf("expected status list to normalize: %v", err)
+	}
+	if input.expectedStatus == nil || *input.expectedStatus != "200,3xx" {
+		t.Fatalf("expected trimmed expectedStatus, got %v", input.expectedStatus)
+	}
+
+	invalid := "teapot"
+	if _, err := normalizeMonitorRequest(createMonitorRequest{
+		URL:            "https://example.com/old",
+		Cron:           "*/5 * * * *",
+		ExpectedStatus: &invalid,
+	}); err == nil {
+		t.Fatal("expected invalid expectedStatus to fail")
+	}
+}
+
+func TestNormalizeMonitorRequestTimezone(t *testing.T) {
+	timezone := " Europe/Berlin "
+	input, err := normalizeMonitorRequest(createMonitorRequest{
+		URL:      "https://example.com/old",
+		Cron:     "0 9 * * *",
+		Timezone: &timezone,
+	})
+	if err != nil {
+		t.Fatalf("expected timezone to normalize: %v", err)
+	}
+	if input.timezone == nil || *input.timezone != "Europe/Berlin" {
+		t.Fatalf("expected trimmed timezone, got %v", input.timezone)
+	}
+
+	invalid := "Mars/Olympus"
+	if _, err := normalizeMonitorRequest(createMonitorRequest{
+		URL:      "https://example.com/old",
+		Cron:     "0 9 * * *",
+		Timezone: &invalid,
+	}); err == nil {
+		t.Fatal("expected invalid timezone to fail")
+	}
+}
+
+func TestNormalizeMonitorRequestTLSSettings(t *testing.T) {
+	minVersion := " 1.2 "
+	serverName := " internal.example "
+	insecure := true
+	input, err := normalizeMonitorRequest(createMonitorRequest{
+		URL:                   "https://10.0.0.5/health",
+		Cron:                  "*/5 * * * *",
+		TLSMinVersion:         &minVersion,
+		TLSServerName:         &serverName,
+		TLSInsecureSkipVerify: &insecure,
+	})
+	if err != nil {
+		t.Fatalf("expected TLS settings to normalize: %v", err)
+	}
+	if input.tlsMinVersion == nil || *input.tlsMinVersion != "1.2" {
+		t.Fatalf("expected trimmed tlsMinVersion, got %v", input.tlsMinVersion)
+	}
+	if input.tlsServerName == nil || *input.tlsServerName != "internal.example" {
+		t.Fatalf("expected trimmed tlsServerName, got %v", input.tlsServerName)
+	}
+	if !input.tlsInsecureSkipVerify {
+		t.Fatal("expected tlsInsecureSkipVerify to be kept")
+	}
+
+	invalidVersion := "1.4"
+	if _, err := normalizeMonitorRequest(createMonitorRequest{
+		URL:           "https://10.0.0.5/health",
+		Cron:          "*/5 * * * *",
+		TLSMinVersion: &invalidVersion,
+	}); err == nil {
+		t.Fatal("expected invalid tlsMinVersion to fail")
+	}
+
+	invalidCA := "not a certificate"
+	if _, err := normalizeMonitorRequest(createMonitorRequest{
+		URL:      "https://10.0.0.5/health",
+		Cron:     "*/5 * * * *",
+		TLSCAPEM: &invalidCA,
+	}); err == nil {
+		t.Fatal("expected invalid tlsCaPem to fail")
+	}
+}
+
+func TestNormalizeMonitorRequestRedirectPolicy(t *testing.T) {
+	input, err := normalizeMonitorRequest(createMonitorRequest{
+		URL:  "https://example.com/old",
+		Cron: "*/5 * * * *",
+	})
+	if err != nil {
+		t.Fatalf("expected default redirect policy: %v", err)
+	}
+	if input.redirectPolicy != "follow" {
+		t.Fatalf("expected follow redirect policy by default, got %q", input.redirectPolicy)
+	}
+
+	if _, err := normalizeMonitorRequest(createMonitorRequest{
+		URL:            "https://example.com/old",
+		Cron:           "*/5 * * * *",
+		RedirectPolicy: "sometimes",
+	}); err == nil {
+		t.Fatal("expected unknown redirectPolicy to fail")
+	}
+
+	tooMany := maxRedirectHops + 1
+	if _, err := normalizeMonitorRequest(createMonitorRequest{
+		URL:          "https://example.com/old",
+		Cron:         "*/5 * * * *",
+		MaxRedirects: &tooMany,
+	}); err == nil {
+		t.Fatal("expected out of range maxRedirects to fail")
+	}
+}
+
+func TestNormalizeMonitorRequestRenderedFetchMode(t *testing.T) {
+	input, err := normalizeMonitorRequest(createMonitorRequest{
+		URL:          "https://example.com/app",
+		Cron:         "*/5 * * * *",
+		ExpectedType: "html",
+		FetchMode:    "rendered",
+	})
+	if err != nil {
+		t.Fatalf("expected rendered monitor to normalize: %v", err)
+	}
+	if input.fetchMode != "rendered" {
+		t.Fatalf("expected rendered fetch mode, got %q", input.fetchMode)
+	}
+
+	invalid := []createMonitorRequest{
+		{URL: "https://example.com/app", Cron: "*/5 * * * *", ExpectedType: "html", FetchMode: "browser"},
+		{URL: "https://example.com/app", Cron: "*/5 * * * *", ExpectedType: "json", FetchMode: "rendered"},
+		{URL: "https://example.com/app", Cron: "*/5 * * * *", ExpectedType: "html", FetchMode: "rendered", Method: "POST"},
+		{URL: "https://example.com/app", Cron: "*/5 * * * *", ExpectedType: "html", FetchMode: "rendered", Headers: map[string]string{"X-Token": "secret"}},
+		{URL: "file:///etc/passwd", Cron: "*/5 * * * *", ExpectedType: "text", FetchMode: "rendered"},
+		{URL: "--renderer-cmd-prefix=touch /tmp/pwned", Cron: "*/5 * * * *", ExpectedType: "html", FetchMode: "rendered"},
+	}
+	for _, req := range invalid {
+		if _, err := normalizeMonitorRequest(req); err == nil {
+			t.Fatalf("expected %#v to be rejected", req)
+		}
+	}
+}
+
+func TestNormalizeMonitorRequestEnvReferences(t *testing.T) {
+	if _, err := normalizeMonitorRequest(createMonitorRequest{
+		URL:     "https://example.com/prices",
+		Cron:    "*/5 * * * *",
+		Headers: map[string]string{"X-Api-Key": "${ENV:GOANNA_SECRET_PRICES_KEY}"},
+		Auth:    map[string]string{"type": "bearer", "token": "${ENV:GOANNA_SECRET_PRICES_TOKEN}"},
+	}); err != nil {
+		t.Fatalf("expected prefixed env references to be accepted: %v", err)
+	}
+
+	invalid := []createMonitorRequest{
+		{URL: "https://example.com/prices", Cron: "*/5 * * * *", Headers: map[string]string{"X-Api-Key": "${ENV:GOANNA_DSN}"}},
+		{URL: "https://example.com/prices", Cron: "*/5 * * * *", Auth: map[string]string{"type": "bearer", "token": "${ENV:AWS_SECRET_ACCESS_KEY}"}},
+	}
+	for _, req := range invalid {
+		if _, err := normalizeMonitorRequest(req); err == nil {
+			t.Fatalf("expected %#v to be rejected", req)
+		}
+	}
+}
@@ -0,0 +1,347 @@
+package server
+
+import (
+	"context"
+	"crypto/pbkdf2"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"goanna/apps/api/ent"
+	"goanna/apps/api/ent/session"
+	"goanna/apps/api/ent/user"
+)
+
+const (
+	passwordHashIterations = 600_000
+	passwordHashPrefix     = "pbkdf2-sha256"
+)
+
+// dummyPasswordHash is verified against when a sign-in names an unknown
+// user, so unknown usernames take as long to reject as wrong passwords.
+var dummyPasswordHash = fmt.Sprintf(
+	"%s$%d$%s$%s",
+	passwordHashPrefix,
+	passwordHashIterations,
+	"bAWbbCNfL+caXUj4Sq4FAw",
+	"wxM5GapHIN9+HsX/ddN8wjCMrhO3ngYw1Zto/8EQVYk",
+)
+
+type currentUserKey struct{}
+
+type loginRequest struct {
+	Username string `json:"username"`
+	Password string `json:"password"`
+}
+
+type loginResponse struct {
+	Token     string       `json:"token"`
+	ExpiresAt time.Time    `json:"expiresAt"`
+	User      userResponse `json:"user"`
+}
+
+type authStatusResponse struct {
+	AuthRequired bool          `json:"authRequired"`
+	User         *userResponse `json:"user,omitempty"`
+}
+
+type changePasswordRequest struct {
+	CurrentPassword string `json:"currentPassword"`
+	NewPassword     string `json:"newPassword"`
+}
+
+// authorize wraps a route so it only runs for a signed-in user holding role;
+// admins pass every check. The API stays open until the first user is
+// created, so existing single-user installs keep working.
+func (s *Server) authorize(role user.Role, next http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		required, err := s.authRequired(r.Context())
+		if err != nil {
+			writeError(w, http.StatusInternalServerError, "failed to load users")
+			return
+		}
+		if !required {
+			next(w, r)
+			return
+		}
+
+		account, _, err := s.sessionUser(r)
+		if err != nil {
+			w.Header().Set("WWW-Authenticate", "Bearer")
+			writeError(w, http.StatusUnauthorized, "authentication required")
+			return
+		}
+		if role == user.RoleAdmin && account.Role != user.RoleAdmin {
+			writeError(w, http.StatusForbidden, "admin role required")
+			return
+		}
+
+		next(w, r.WithContext(context.WithValue(r.Context(), currentUserKey{}, account)))
+	}
+}
+
+func (s *Server) authRequired(ctx context.Context) (bool, error) {
+	return s.db.User.Query().Exist(ctx)
+}
+
+// currentUser returns the user authorize signed in, or nil while the API is
+// open.
+func currentUser(ctx context.Context) *ent.User {
+	account, _ := ctx.Value(currentUserKey{}).(*ent.User)
+	return account
+}
+
+// sessionUser resolves the request's bearer token, or the WebSocket ticket
+// withWebSocketTicket accepted, to an unexpired session and its user.
+func (s *Server) sessionUser(r *http.Request) (*ent.User, *ent.Session, error) {
+	if row, ok := r.Context().Value(webSocketTicketKey{}).(*ent.Session); ok {
+		return row.Edges.User, row, nil
+	}
+	token, ok := bearerToken(r)
+	if !ok {
+		return nil, nil, errors.New("missing bearer token")
+	}
+
+	row, err := s.db.Session.Query().
+		Where(
+			session.TokenHashEQ(hashSessionToken(token)),
+			session.ExpiresAtGT(time.Now().UTC()),
+		).
+		WithUser().
+		Only(r.Context())
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return row.Edges.User, row, nil
+}
+
+func bearerToken(r *http.Request) (string, bool) {
+	scheme, token, ok := strings.Cut(r.Header.Get("Authorization"), " ")
+	if !ok || !strings.EqualFold(scheme, "Bearer") {
+		return "", false
+	}
+	token = strings.TrimSpace(token)
+	return token, token != ""
+}
+
+// handleLogin exchanges a username and password for a bearer token. Failed
+// attempts are throttled per username and client address.
+func (s *Server) handleLogin(w http.ResponseWriter, r *http.Request) {
+	var req loginRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid JSON body")
+		return
+	}
+
+	username := normalizeUsername(req.Username)
+	now := time.Now().UTC()
+	if wait := s.loginThrottle.retryAfter(r, username, now); wait > 0 {
+		w.Header().Set("Retry-After", strconv.Itoa(int(wait.Round(time.Second)/time.Second)))
+		writeError(w, http.StatusTooManyRequests, "too many failed sign-ins, try again later")
+		return
+	}
+
+	account, err := s.db.User.Query().
+		Where(user.UsernameEQ(username)).
+		Only(r.Context())
+	if err != nil && !ent.IsNotFound(err) {
+		writeError(w, http.StatusInternalServerError, "failed to load user")
+		return
+	}
+	passwordHash := dummyPasswordHash
+	if account != nil {
+		passwordHash = account.PasswordHash
+	}
+	if !verifyPassword(passwordHash, req.Password) || account == nil {
+		s.loginThrottle.fail(r, username, now)
+		writeError(w, http.StatusUnauthorized, "invalid username or password")
+		return
+	}
+	s.loginThrottle.succeed(username)
+
+	if _, err := s.db.Session.Delete().
+		Where(session.UserIDEQ(account.ID), session.ExpiresAtLTE(now)).
+		Exec(r.Context()); err != nil {
+		writeError(w, http.StatusInternalServerError, "failed to sign in")
+		return
+	}
+
+	token, err := newSessionToken()
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "failed to sign in")
+		return
+	}
+	created, err := s.db.Session.Create().
+		SetTokenHash(hashSessionToken(token)).
+		SetUserID(account.ID).
+		SetExpiresAt(now.Add(s.sessionTTL)).
+		Save(r.Context())
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "failed to sign in")
+		return
+	}
+
+	writeJSON(w, http.StatusOK, loginResponse{
+		Token:     token,
+		ExpiresAt: created.ExpiresAt.UTC(),
+		User:      mapUser(account),
+	})
+}
+
+// handleLogout revokes the bearer token the request was made with.
+func (s *Server) handleLogout(w http.ResponseWriter, r *http.Request) {
+	if token, ok := bearerToken(r); ok {
+		if _, err := s.db.Session.Delete().
+			Where(session.TokenHashEQ(hashSessionToken(token))).
+			Exec(r.Context()); err != nil {
+			writeError(w, http.StatusInternalServerError, "failed to sign out")
+			return
+		}
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// handleGetAuthStatus tells clients whether they need to sign in and, when a
+// valid token is sent, who they are signed in as.
+func (s *Server) handleGetAuthStatus(w http.ResponseWriter, r *http.Request) {
+	required, err := s.authRequired(r.Context())
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "failed to load users")
+		return
+	}
+
+	response := authStatusResponse{AuthRequired: required}
+	if required {
+		if account, _, err := s.sessionUser(r); err == nil {
+			mapped := mapUser(account)
+			response.User = &mapped
+		}
+	}
+
+	writeJSON(w, http.StatusOK, response)
+}
+
+// handleChangePassword lets a signed-in user change their own password. Their
+// other sessions are revoked.
+func (s *Server) handleChangePassword(w http.ResponseWriter, r *http.Request) {
+	account, current, err := s.sessionUser(r)
+	if err != nil {
+		writeError(w, http.StatusUnauthorized, "authentication required")
+		return
+	}
+
+	var req changePasswordRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid JSON body")
+		return
+	}
+	if !verifyPassword(account.PasswordHash, req.CurrentPassword) {
+		writeError(w, http.StatusBadRequest, "currentPassword is incorrect")
+		return
+	}
+	if err := validatePassword(req.NewPassword); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	passwordHash, err := hashPassword(req.NewPassword)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "failed to change password")
+		return
+	}
+
+	tx, err := s.db.Tx(r.Context())
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "failed to change password")
+		return
+	}
+	if err := tx.User.UpdateOneID(account.ID).SetPasswordHash(passwordHash).Exec(r.Context()); err != nil {
+		_ = tx.Rollback()
+		writeError(w, http.StatusInternalServerError, "failed to change password")
+		return
+	}
+	if _, err := tx.Session.Delete().
+		Where(session.UserIDEQ(account.ID), session.IDNEQ(current.ID)).
+		Exec(r.Context()); err != nil {
+		_ = tx.Rollback()
+		writeError(w, http.StatusInternalServerError, "failed to change password")
+		return
+	}
+	if err := tx.Commit(); err != nil {
+		writeError(w, http.StatusInternalServerError, "failed to change password")
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func newSessionToken() (string, error) {
+	token := make([]byte, 32)
+	if _, err := rand.Read(token); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(token), nil
+}
+
+func hashSessionToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+// hashPassword derives a salted PBKDF2-SHA256 hash, stored with its
+// iteration count so the cost can be raised later.
+func hashPassword(password string) (string, error) {
+	salt := make([]byte, 16)
+	if _, err := rand.Read(salt); err != nil {
+		return "", err
+	}
+
+	key, err := pbkdf2.Key(sha256.New, password, salt, passwordHashIterations, sha256.Size)
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf(
+		"%s$%d$%s$%s",
+		passwordHashPrefix,
+		passwordHashIterations,
+		base64.RawStdEncoding.EncodeToString(salt),
+		base64.RawStdEncoding.EncodeToString(key),
+	), nil
+}
+
+func verifyPassword(encoded string, password string) bool {
+	parts := strings.Split(encoded, "$")
+	if len(parts) != 4 || parts[0] != passwordHashPrefix {
+		return false
+	}
+	iterations, err := strconv.Atoi(parts[1])
+	if err != nil || iterations <= 0 {
+		return false
+	}
+	salt, err := base64.RawStdEncoding.DecodeString(parts[2])
+	if err != nil {
+		return false
+	}
+	expected, err := base64.RawStdEncoding.DecodeString(parts[3])
+	if err != nil {
+		return false
+	}
+
+	key, err := pbkdf2.Key(sha256.New, password, salt, iterations, len(expected))
+	if err != nil {
+		return false
+	}
+	return subtle.ConstantTimeCompare(key, expected) == 1
+}
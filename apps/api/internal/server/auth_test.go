@@ -0,0 +1,200 @@
+package server
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"goanna/apps/api/ent/enttest"
+
+	_ "github.com/mattn/go-sqlite3"
+)
+
+func TestAuthorizeEnforcesRolesOnceUsersExist(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:auth-roles?mode=memory&cache=shared&_fk=1")
+	defer client.Close()
+
+	mux := http.NewServeMux()
+	New(client).RegisterRoutes(mux)
+
+	do := func(method string, path string, token string, body string) *httptest.ResponseRecorder {
+		t.Helper()
+
+		req := httptest.NewRequest(method, path, strings.NewReader(body))
+		if token != "" {
+			req.Header.Set("Authorization", "Bearer "+token)
+		}
+		rec := httptest.NewRecorder()
+		mux.ServeHTTP(rec, req)
+		return rec
+	}
+	login := func(username string, password string) string {
+		t.Helper()
+
+		rec := do(http.MethodPost, "/v1/auth/login", "", fmt.Sprintf(`{"username":%q,"password":%q}`, username, password))
+		if rec.Code != http.StatusOK {
+			t.Fatalf("expected %s to sign in, got %d: %s", username, rec.Code, rec.Body.String())
+		}
+		var response loginResponse
+		if err := json.Unmarshal(rec.Body.Bytes(), &response); err != nil {
+			t.Fatalf("expected login JSON: %v", err)
+		}
+		return response.Token
+	}
+
+	if rec := do(http.MethodGet, "/v1/monitors", "", ""); rec.Code != http.StatusOK {
+		t.Fatalf("expected the API to stay open without users, got %d", rec.Code)
+	}
+	if rec := do(http.MethodPost, "/v1/users", "", `{"username":"viewer","password":"password1","role":"viewer"}`); rec.Code != http.StatusBadRequest {
+		t.Fatalf("expected the first user to have to be an admin, got %d", rec.Code)
+	}
+	if rec := do(http.MethodPost, "/v1/users", "", `{"username":"Admin","password":"password1"}`); rec.Code != http.StatusCreated ||
+		!strings.Contains(rec.Body.String(), `"role":"admin"`) || !strings.Contains(rec.Body.String(), `"username":"admin"`) {
+		t.Fatalf("expected the first user to be created as admin, got %d: %s", rec.Code, rec.Body.String())
+	}
+
+	if rec := do(http.MethodGet, "/v1/monitors", "", ""); rec.Code != http.StatusUnauthorized {
+		t.Fatalf("expected sign-in to be required, got %d", rec.Code)
+	}
+	if rec := do(http.MethodPost, "/v1/auth/login", "", `{"username":"admin","password":"wrong-password"}`); rec.Code != http.StatusUnauthorized {
+		t.Fatalf("expected a wrong password to be rejected, got %d", rec.Code)
+	}
+
+	adminToken := login("admin", "password1")
+	if rec := do(http.MethodPost, "/v1/users", adminToken, `{"username":"viewer","password":"password2"}`); rec.Code != http.StatusCreated ||
+		!strings.Contains(rec.Body.String(), `"role":"viewer"`) {
+		t.Fatalf("expected the admin to create a viewer, got %d: %s", rec.Code, rec.Body.String())
+	}
+
+	viewerToken := login("viewer", "password2")
+	if rec := do(http.MethodGet, "/v1/monitors", viewerToken, ""); rec.Code != http.StatusOK {
+		t.Fatalf("expected the viewer to read monitors, got %d", rec.Code)
+	}
+	if rec := do(http.MethodPost, "/v1/monitors", viewerToken, `{"url":"https://example.com","cron":"* * * * *"}`); rec.Code != http.StatusForbidden {
+		t.Fatalf("expected the viewer to be unable to create monitors, got %d", rec.Code)
+	}
+	if rec := do(http.MethodGet, "/v1/settings/notifications/telegram", viewerToken, ""); rec.Code != http.StatusForbidden {
+		t.Fatalf("expected the viewer to be unable to read secrets, got %d", rec.Code)
+	}
+	if rec := do(http.MethodPost, "/v1/monitors", adminToken, `{"url":"https://example.com","cron":"* * * * *"}`); rec.Code != http.StatusCreated {
+		t.Fatalf("expected the admin to create monitors, got %d: %s", rec.Code, rec.Body.String())
+	}
+
+	rec := do(http.MethodGet, "/v1/auth/status", viewerToken, "")
+	if rec.Code != http.StatusOK || !strings.Contains(rec.Body.String(), `"authRequired":true`) || !strings.Contains(rec.Body.String(), `"username":"viewer"`) {
+		t.Fatalf("expected the status to name the viewer, got %d: %s", rec.Code, rec.Body.String())
+	}
+
+	rec = do(http.MethodGet, "/v1/auth/status", adminToken, "")
+	var status authStatusResponse
+	if err := json.Unmarshal(rec.Body.Bytes(), &status); err != nil || status.User == nil {
+		t.Fatalf("expected the admin status, got %s", rec.Body.String())
+	}
+	if rec := do(http.MethodDelete, fmt.Sprintf("/v1/users/%d", status.User.ID), adminToken, ""); rec.Code != http.StatusConflict {
+		t.Fatalf("expected the last admin to be kept, got %d", rec.Code)
+	}
+
+	if rec := do(http.MethodPost, "/v1/auth/logout", viewerToken, ""); rec.Code != http.StatusNoContent {
+		t.Fatalf("expected logout to succeed, got %d", rec.Code)
+	}
+	if rec := do(http.MethodGet, "/v1/monitors", viewerToken, ""); rec.Code != http.StatusUnauthorized {
+		t.Fatalf("expected the revoked token to be rejected, got %d", rec.Code)
+	}
+}
+
+func TestVerifyPassword(t *testing.T) {
+	encoded, err := hashPassword("correct horse")
+	if err != nil {
+		t.Fatalf("expected password to hash: %v", err)
+	}
+	if !verifyPassword(encoded, "correct horse") {
+		t.Fatal("expected the password to verify")
+	}
+	if verifyPassword(encoded, "battery staple") || verifyPassword("plain", "plain") {
+		t.Fatal("expected other passwords and malformed hashes to be rejected")
+	}
+}
+
+func TestLoginThrottlesFailedAttempts(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:auth-throttle?mode=memory&cache=shared&_fk=1")
+	defer client.Close()
+
+	srv := New(client)
+	mux := http.NewServeMux()
+	srv.RegisterRoutes(mux)
+
+	login := func(body string) *httptest.ResponseRecorder {
+		t.Helper()
+
+		req := httptest.NewRequest(http.MethodPost, "/v1/auth/login", strings.NewReader(body))
+		req.RemoteAddr = "203.0.113.7:41000"
+		rec := httptest.NewRecorder()
+		mux.ServeHTTP(rec, req)
+		return rec
+	}
+
+	rec := httptest.NewRecorder()
+	mux.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/v1/users", strings.NewReader(`{"username":"admin","password":"password1"}`)))
+	if rec.Code != http.StatusCreated {
+		t.Fatalf("expected the admin to be created, got %d: %s", rec.Code, rec.Body.String())
+	}
+
+	if rec := login(`{"username":"ghost","password":"password1"}`); rec.Code != http.StatusUnauthorized {
+		t.Fatalf("expected an unknown user to be rejected, got %d", rec.Code)
+	}
+	if entry := srv.loginThrottle.failures["user:ghost"]; entry.count != 1 {
+		t.Fatalf("expected the unknown user's attempt to count as a failure, got %+v", entry)
+	}
+
+	req := httptest.NewRequest(http.MethodPost, "/v1/auth/login", nil)
+	req.RemoteAddr = "203.0.113.7:41000"
+	for range maxLoginFailuresPerUser {
+		srv.loginThrottle.fail(req, "admin", time.Now().UTC())
+	}
+	rec = login(`{"username":"Admin","password":"password1"}`)
+	if rec.Code != http.StatusTooManyRequests || rec.Header().Get("Retry-After") == "" {
+		t.Fatalf("expected a throttled user to get 429 with Retry-After, got %d: %s", rec.Code, rec.Body.String())
+	}
+}
+
+func TestLoginThrottleWindows(t *testing.T) {
+	throttle := newLoginThrottle()
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	req := httptest.NewRequest(http.MethodPost, "/v1/auth/login", nil)
+	req.RemoteAddr = "198.51.100.4:5000"
+
+	for range maxLoginFailuresPerUser - 1 {
+		throttle.fail(req, "admin", now)
+	}
+	if wait := throttle.retryAfter(req, "admin", now); wait != 0 {
+		t.Fatalf("expected sign-ins below the limit to be allowed, got %s", wait)
+	}
+	throttle.fail(req, "admin", now.Add(time.Minute))
+	if wait := throttle.retryAfter(req, "admin", now.Add(time.Minute)); wait != loginFailureWindow-time.Minute {
+		t.Fatalf("expected to wait for the rest of the window, got %s", wait)
+	}
+	if wait := throttle.retryAfter(req, "admin", now.Add(loginFailureWindow)); wait != 0 {
+		t.Fatalf("expected the throttle to lift after the window, got %s", wait)
+	}
+
+	throttle.succeed("admin")
+	if wait := throttle.retryAfter(req, "admin", now.Add(time.Minute)); wait != 0 {
+		t.Fatalf("expected a successful sign-in to clear the user's failures, got %s", wait)
+	}
+
+	for i := range maxLoginFailuresPerAddress {
+		throttle.fail(req, fmt.Sprintf("user%d", i), now)
+	}
+	if wait := throttle.retryAfter(req, "someone-else", now); wait == 0 {
+		t.Fatal("expected an address with too many failures to be throttled for every username")
+	}
+	other := httptest.NewRequest(http.MethodPost, "/v1/auth/login", nil)
+	other.RemoteAddr = "198.51.100.5:5000"
+	if wait := throttle.retryAfter(other, "someone-else", now); wait != 0 {
+		t.Fatalf("expected other addresses to be unaffected, got %s", wait)
+	}
+}
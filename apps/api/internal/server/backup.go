@@ -0,0 +1,54 @@
+package server
+
+import (
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"time"
+
+	"goanna/apps/api/internal/backup"
+)
+
+// handleBackupDatabase is POST /v1/system/backup. It snapshots the SQLite
+// database with VACUUM INTO and streams the file, which can be passed to
+// -restore-from on startup.
+func (s *Server) handleBackupDatabase(w http.ResponseWriter, r *http.Request) {
+	if s.backups == nil {
+		writeError(w, http.StatusNotImplemented, "backups are only available for SQLite databases")
+		return
+	}
+
+	dir, err := os.MkdirTemp("", "goanna-backup-")
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "failed to create backup")
+		return
+	}
+	defer os.RemoveAll(dir)
+
+	name := backup.FileName(time.Now())
+	path := filepath.Join(dir, name)
+	if err := s.backups.WriteFile(r.Context(), path); err != nil {
+		writeError(w, http.StatusInternalServerError, "failed to create backup")
+		return
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "failed to read backup")
+		return
+	}
+	defer file.Close()
+	info, err := file.Stat()
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "failed to read backup")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/vnd.sqlite3")
+	w.Header().Set("Content-Disposition", `attachment; filename="`+name+`"`)
+	w.Header().Set("Content-Length", strconv.FormatInt(info.Size(), 10))
+	w.WriteHeader(http.StatusOK)
+	_, _ = io.Copy(w, file)
+}
@@ -0,0 +1,52 @@
+package server
+
+import (
+	"bytes"
+	"database/sql"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"goanna/apps/api/ent"
+	"goanna/apps/api/internal/backup"
+
+	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
+	_ "github.com/mattn/go-sqlite3"
+)
+
+func TestHandleBackupDatabase(t *testing.T) {
+	db, err := sql.Open("sqlite3", "file:database-backup?mode=memory&cache=shared&_fk=1")
+	if err != nil {
+		t.Fatalf("expected database to open: %v", err)
+	}
+	client := ent.NewClient(ent.Driver(entsql.OpenDB(dialect.SQLite, db)))
+	defer client.Close()
+	if err := client.Schema.Create(t.Context()); err != nil {
+		t.Fatalf("expected migrations to apply: %v", err)
+	}
+
+	mux := http.NewServeMux()
+	NewWithConfig(client, Config{Backups: backup.New(db)}).RegisterRoutes(mux)
+
+	rec := httptest.NewRecorder()
+	mux.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/v1/system/backup", nil))
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected 200, got %d: %s", rec.Code, rec.Body.String())
+	}
+	if !bytes.HasPrefix(rec.Body.Bytes(), []byte("SQLite format 3\x00")) {
+		t.Fatalf("expected a SQLite file, got %d bytes", rec.Body.Len())
+	}
+	if disposition := rec.Header().Get("Content-Disposition"); !strings.Contains(disposition, `filename="goanna-`) {
+		t.Fatalf("expected a backup file name, got %q", disposition)
+	}
+
+	withoutBackups := http.NewServeMux()
+	New(client).RegisterRoutes(withoutBackups)
+	rec = httptest.NewRecorder()
+	withoutBackups.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/v1/system/backup", nil))
+	if rec.Code != http.StatusNotImplemented {
+		t.Fatalf("expected 501 without a SQLite database, got %d", rec.Code)
+	}
+}
@@ -0,0 +1,257 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"html"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"goanna/apps/api/ent"
+	"goanna/apps/api/ent/checkresult"
+	"goanna/apps/api/ent/monitor"
+	"goanna/apps/api/ent/user"
+)
+
+const (
+	// badgeCharWidth approximates the advance of an 11px Verdana glyph,
+	// which is close enough to size shields-style badges.
+	badgeCharWidth     = 7
+	badgeTextPadding   = 10
+	maxBadgeTextLength = 40
+)
+
+// Badge colours, matching the shields.io palette.
+const (
+	badgeGreen  = "#4c1"
+	badgeYellow = "#dfb317"
+	badgeOrange = "#fe7d37"
+	badgeRed    = "#e05d44"
+	badgeBlue   = "#007ec6"
+	badgeGrey   = "#9f9f9f"
+)
+
+type badge struct {
+	label   string
+	message string
+	color   string
+}
+
+// handleMonitorBadge renders a shields-style SVG badge for a monitor. The
+// type query parameter picks status (default), uptime, value or latency.
+// Enabled, unarchived monitors on the status page are public so badges can be
+// embedded in READMEs; others, and value badges, which show extracted page
+// content, need the usual authentication.
+func (s *Server) handleMonitorBadge(w http.ResponseWriter, r *http.Request) {
+	monitorID, err := parseMonitorID(r.PathValue("monitorId"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	row, err := s.db.Monitor.Query().
+		Where(monitor.IDEQ(monitorID)).
+		WithRuntime().
+		Only(r.Context())
+	if err != nil && !ent.IsNotFound(err) {
+		writeError(w, http.StatusInternalServerError, "failed to load monitor")
+		return
+	}
+
+	render := func(w http.ResponseWriter, r *http.Request) {
+		if row == nil {
+			writeError(w, http.StatusNotFound, "monitor not found")
+			return
+		}
+		s.writeMonitorBadge(w, r, row)
+	}
+	if row == nil || !publicBadge(row, r) {
+		// Unknown monitors go through authorize too, so anonymous callers
+		// cannot probe which private monitors exist.
+		s.authorize(user.RoleViewer, render)(w, r)
+		return
+	}
+	render(w, r)
+}
+
+// publicBadge reports whether the badge requested by r can be served without
+// authentication. It mirrors the filters of the status page, and keeps value
+// badges private because the extracted value is not part of the status page.
+func publicBadge(row *ent.Monitor, r *http.Request) bool {
+	if !row.StatusPage || !row.Enabled || row.ArchivedAt != nil {
+		return false
+	}
+	return strings.ToLower(strings.TrimSpace(r.URL.Query().Get("type"))) != "value"
+}
+
+func (s *Server) writeMonitorBadge(w http.ResponseWriter, r *http.Request, row *ent.Monitor) {
+	query := r.URL.Query()
+
+	kind := strings.ToLower(strings.TrimSpace(query.Get("type")))
+	var (
+		result badge
+		err    error
+	)
+	switch kind {
+	case "", "status":
+		result = statusBadge(s.mapMonitor(row, row.Edges.Runtime, nil).Status)
+	case "uptime":
+		window := strings.TrimSpace(query.Get("window"))
+		if window == "" {
+			window = "24h"
+		}
+		if _, ok := statsWindows[window]; !ok {
+			writeError(w, http.StatusBadRequest, "window must be one of: 24h, 7d, 30d")
+			return
+		}
+		var uptime uptimeStatsResponse
+		uptime, err = s.loadUptimeStats(r.Context(), row.ID, window, time.Now().UTC())
+		result = uptimeBadge(window, uptime.UptimePercent)
+	case "value":
+		var value *string
+		value, err = s.latestSelectionValue(r.Context(), row.ID)
+		result = valueBadge(value)
+	case "latency":
+		var durationMs *int
+		if row.Edges.Runtime != nil {
+			durationMs = row.Edges.Runtime.LastDurationMs
+		}
+		result = latencyBadge(durationMs)
+	default:
+		writeError(w, http.StatusBadRequest, "type must be one of: status, uptime, value, latency")
+		return
+	}
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "failed to load monitor badge")
+		return
+	}
+
+	if label := strings.TrimSpace(query.Get("label")); label != "" {
+		result.label = label
+	}
+
+	w.Header().Set("Content-Type", "image/svg+xml")
+	// Image proxies such as GitHub's cache aggressively unless told not to.
+	w.Header().Set("Cache-Control", "no-cache, max-age=0")
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write([]byte(renderBadge(result)))
+}
+
+func (s *Server) latestSelectionValue(ctx context.Context, monitorID int) (*string, error) {
+	row, err := s.db.CheckResult.Query().
+		Where(
+			checkresult.MonitorIDEQ(monitorID),
+			checkresult.SelectionValueNotNil(),
+		).
+		Order(ent.Desc(checkresult.FieldCheckedAt), ent.Desc(checkresult.FieldID)).
+		First(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return row.SelectionValue, nil
+}
+
+func statusBadge(status string) badge {
+	switch status {
+	case "ok":
+		return badge{label: "status", message: "up", color: badgeGreen}
+	case "error":
+		return badge{label: "status", message: "down", color: badgeRed}
+	case "retrying":
+		return badge{label: "status", message: "retrying", color: badgeYellow}
+	case "disabled":
+		return badge{label: "status", message: "paused", color: badgeGrey}
+	default:
+		return badge{label: "status", message: "pending", color: badgeGrey}
+	}
+}
+
+func uptimeBadge(window string, uptime *float64) badge {
+	result := badge{label: "uptime " + window, message: "no data", color: badgeGrey}
+	if uptime == nil {
+		return result
+	}
+
+	result.message = strconv.FormatFloat(*uptime, 'f', -1, 64) + "%"
+	switch {
+	case *uptime >= 99:
+		result.color = badgeGreen
+	case *uptime >= 95:
+		result.color = badgeYellow
+	default:
+		result.color = badgeRed
+	}
+	return result
+}
+
+func valueBadge(value *string) badge {
+	if value == nil || strings.TrimSpace(*value) == "" {
+		return badge{label: "value", message: "no data", color: badgeGrey}
+	}
+	return badge{label: "value", message: strings.TrimSpace(*value), color: badgeBlue}
+}
+
+func latencyBadge(durationMs *int) badge {
+	result := badge{label: "response time", message: "no data", color: badgeGrey}
+	if durationMs == nil {
+		return result
+	}
+
+	result.message = strconv.Itoa(*durationMs) + "ms"
+	switch {
+	case *durationMs < 500:
+		result.color = badgeGreen
+	case *durationMs < 1500:
+		result.color = badgeYellow
+	default:
+		result.color = badgeOrange
+	}
+	return result
+}
+
+// renderBadge draws b in the flat shields.io style. Text is collapsed onto
+// one line and cut to maxBadgeTextLength characters.
+func renderBadge(b badge) string {
+	label := badgeText(b.label)
+	message := badgeText(b.message)
+	labelWidth := utf8.RuneCountInString(label)*badgeCharWidth + badgeTextPadding
+	messageWidth := utf8.RuneCountInString(message)*badgeCharWidth + badgeTextPadding
+	width := labelWidth + messageWidth
+	title := html.EscapeString(label + ": " + message)
+
+	var svg strings.Builder
+	fmt.Fprintf(&svg, `<svg xmlns="http://www.w3.org/2000/svg" width="%d" height="20" role="img" aria-label="%s">`, width, title)
+	fmt.Fprintf(&svg, `<title>%s</title>`, title)
+	svg.WriteString(`<linearGradient id="s" x2="0" y2="100%"><stop offset="0" stop-color="#bbb" stop-opacity=".1"/><stop offset="1" stop-opacity=".1"/></linearGradient>`)
+	fmt.Fprintf(&svg, `<clipPath id="r"><rect width="%d" height="20" rx="3" fill="#fff"/></clipPath>`, width)
+	svg.WriteString(`<g clip-path="url(#r)">`)
+	fmt.Fprintf(&svg, `<rect width="%d" height="20" fill="#555"/>`, labelWidth)
+	fmt.Fprintf(&svg, `<rect x="%d" width="%d" height="20" fill="%s"/>`, labelWidth, messageWidth, b.color)
+	fmt.Fprintf(&svg, `<rect width="%d" height="20" fill="url(#s)"/>`, width)
+	svg.WriteString(`</g>`)
+	svg.WriteString(`<g fill="#fff" text-anchor="middle" font-family="Verdana,Geneva,DejaVu Sans,sans-serif" font-size="11">`)
+	writeBadgeText(&svg, labelWidth/2, html.EscapeString(label))
+	writeBadgeText(&svg, labelWidth+messageWidth/2, html.EscapeString(message))
+	svg.WriteString(`</g></svg>`)
+	return svg.String()
+}
+
+func writeBadgeText(svg *strings.Builder, x int, text string) {
+	fmt.Fprintf(svg, `<text x="%d" y="15" fill="#010101" fill-opacity=".3">%s</text>`, x, text)
+	fmt.Fprintf(svg, `<text x="%d" y="14">%s</text>`, x, text)
+}
+
+func badgeText(raw string) string {
+	text := strings.Join(strings.Fields(raw), " ")
+	if utf8.RuneCountInString(text) <= maxBadgeTextLength {
+		return text
+	}
+	runes := []rune(text)
+	return string(runes[:maxBadgeTextLength-1]) + "…"
+}
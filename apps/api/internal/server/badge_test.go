@@ -0,0 +1,172 @@
+package server
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"goanna/apps/api/ent"
+	"goanna/apps/api/ent/enttest"
+	"goanna/apps/api/ent/monitorruntime"
+	"goanna/apps/api/ent/user"
+
+	_ "github.com/mattn/go-sqlite3"
+)
+
+func TestHandleMonitorBadge(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:monitor-badge?mode=memory&cache=shared&_fk=1")
+	defer client.Close()
+
+	public, err := client.Monitor.Create().
+		SetURL("https://example.com/price").
+		SetCron("*/5 * * * *").
+		SetStatusPage(true).
+		Save(t.Context())
+	if err != nil {
+		t.Fatalf("expected monitor to save: %v", err)
+	}
+	if _, err := client.MonitorRuntime.Create().
+		SetMonitor(public).
+		SetStatus(monitorruntime.StatusOk).
+		SetLastDurationMs(1200).
+		Save(t.Context()); err != nil {
+		t.Fatalf("expected runtime to save: %v", err)
+	}
+	if _, err := client.CheckResult.Create().
+		SetMonitor(public).
+		SetStatus("ok").
+		SetSelectionType("text").
+		SetSelectionValue("<$42>").
+		SetCheckedAt(time.Now().UTC().Add(-time.Hour)).
+		Save(t.Context()); err != nil {
+		t.Fatalf("expected check to save: %v", err)
+	}
+	private, err := client.Monitor.Create().
+		SetURL("https://example.com/private").
+		SetCron("*/5 * * * *").
+		Save(t.Context())
+	if err != nil {
+		t.Fatalf("expected monitor to save: %v", err)
+	}
+	disabled, err := client.Monitor.Create().
+		SetURL("https://example.com/disabled").
+		SetCron("*/5 * * * *").
+		SetStatusPage(true).
+		SetEnabled(false).
+		Save(t.Context())
+	if err != nil {
+		t.Fatalf("expected monitor to save: %v", err)
+	}
+	archived, err := client.Monitor.Create().
+		SetURL("https://example.com/archived").
+		SetCron("*/5 * * * *").
+		SetStatusPage(true).
+		SetArchivedAt(time.Now().UTC()).
+		Save(t.Context())
+	if err != nil {
+		t.Fatalf("expected monitor to save: %v", err)
+	}
+	passwordHash, err := hashPassword("password1")
+	if err != nil {
+		t.Fatalf("expected password to hash: %v", err)
+	}
+	if _, err := client.User.Create().
+		SetUsername("admin").
+		SetPasswordHash(passwordHash).
+		SetRole(user.RoleAdmin).
+		Save(t.Context()); err != nil {
+		t.Fatalf("expected user to save: %v", err)
+	}
+
+	mux := http.NewServeMux()
+	New(client).RegisterRoutes(mux)
+
+	token := ""
+	get := func(path string) *httptest.ResponseRecorder {
+		t.Helper()
+		req := httptest.NewRequest(http.MethodGet, path, nil)
+		if token != "" {
+			req.Header.Set("Authorization", "Bearer "+token)
+		}
+		rec := httptest.NewRecorder()
+		mux.ServeHTTP(rec, req)
+		return rec
+	}
+
+	cases := []struct {
+		query string
+		want  []string
+	}{
+		{"", []string{">status<", ">up<", badgeGreen}},
+		{"?type=uptime&window=7d", []string{">uptime 7d<", ">100%<"}},
+		{"?type=latency", []string{">response time<", ">1200ms<", badgeYellow}},
+	}
+	for _, tc := range cases {
+		rec := get(fmt.Sprintf("/v1/monitors/%d/badge.svg%s", public.ID, tc.query))
+		if rec.Code != http.StatusOK {
+			t.Fatalf("%q: expected 200, got %d: %s", tc.query, rec.Code, rec.Body.String())
+		}
+		if got := rec.Header().Get("Content-Type"); got != "image/svg+xml" {
+			t.Fatalf("%q: expected SVG content type, got %q", tc.query, got)
+		}
+		for _, want := range tc.want {
+			if !strings.Contains(rec.Body.String(), want) {
+				t.Fatalf("%q: expected badge to contain %q, got %s", tc.query, want, rec.Body.String())
+			}
+		}
+	}
+
+	if rec := get(fmt.Sprintf("/v1/monitors/%d/badge.svg?type=size", public.ID)); rec.Code != http.StatusBadRequest {
+		t.Fatalf("expected 400 for unsupported type, got %d", rec.Code)
+	}
+	if rec := get(fmt.Sprintf("/v1/monitors/%d/badge.svg", private.ID)); rec.Code != http.StatusUnauthorized {
+		t.Fatalf("expected 401 for private monitor, got %d", rec.Code)
+	}
+	if rec := get("/v1/monitors/999/badge.svg"); rec.Code != http.StatusUnauthorized {
+		t.Fatalf("expected 401 for unknown monitor, got %d", rec.Code)
+	}
+	for _, row := range []*ent.Monitor{disabled, archived} {
+		if rec := get(fmt.Sprintf("/v1/monitors/%d/badge.svg", row.ID)); rec.Code != http.StatusUnauthorized {
+			t.Fatalf("expected 401 for %s, got %d", row.URL, rec.Code)
+		}
+	}
+	valuePath := fmt.Sprintf("/v1/monitors/%d/badge.svg?type=value&label=price", public.ID)
+	if rec := get(valuePath); rec.Code != http.StatusUnauthorized {
+		t.Fatalf("expected 401 for an anonymous value badge, got %d", rec.Code)
+	}
+
+	login := httptest.NewRecorder()
+	mux.ServeHTTP(login, httptest.NewRequest(http.MethodPost, "/v1/auth/login", strings.NewReader(`{"username":"admin","password":"password1"}`)))
+	var response loginResponse
+	if err := json.Unmarshal(login.Body.Bytes(), &response); err != nil || response.Token == "" {
+		t.Fatalf("expected to sign in, got %d: %s", login.Code, login.Body.String())
+	}
+	token = response.Token
+
+	rec := get(valuePath)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected signed-in value badge, got %d: %s", rec.Code, rec.Body.String())
+	}
+	for _, want := range []string{">price<", ">&lt;$42&gt;<", badgeBlue} {
+		if !strings.Contains(rec.Body.String(), want) {
+			t.Fatalf("expected value badge to contain %q, got %s", want, rec.Body.String())
+		}
+	}
+	if rec := get(fmt.Sprintf("/v1/monitors/%d/badge.svg", archived.ID)); rec.Code != http.StatusOK {
+		t.Fatalf("expected signed-in badge for archived monitor, got %d", rec.Code)
+	}
+}
+
+func TestBadgeText(t *testing.T) {
+	if got := badgeText("a\n  b"); got != "a b" {
+		t.Fatalf("expected whitespace collapsed, got %q", got)
+	}
+	long := badgeText(strings.Repeat("x", 60))
+	if len([]rune(long)) != maxBadgeTextLength || !strings.HasSuffix(long, "…") {
+		t.Fatalf("expected truncated text, got %q", long)
+	}
+}
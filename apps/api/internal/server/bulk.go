@@ -0,0 +1,299 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	"goanna/apps/api/ent"
+	"goanna/apps/api/ent/monitor"
+	"goanna/apps/api/ent/monitorruntime"
+	"goanna/apps/api/internal/worker"
+)
+
+const maxBulkMonitors = 500
+
+var bulkMonitorActions = []string{"enable", "disable", "archive", "restore", "delete", "trigger"}
+
+type bulkMonitorRequest struct {
+	Action     string `json:"action"`
+	MonitorIDs []int  `json:"monitorIds"`
+	Tag        string `json:"tag"`
+}
+
+type bulkMonitorResponse struct {
+	Action     string                      `json:"action"`
+	MonitorIDs []int                       `json:"monitorIds"`
+	Results    []bulkMonitorResultResponse `json:"results,omitempty"`
+}
+
+type bulkMonitorResultResponse struct {
+	MonitorID int     `json:"monitorId"`
+	Status    *string `json:"status,omitempty"`
+	Error     *string `json:"error,omitempty"`
+}
+
+// handleBulkMonitors applies one action to monitors selected by ID or tag.
+// Enable, disable, archive, restore and delete run in a single transaction;
+// delete removes monitors permanently, like DELETE .../permanent. Trigger runs
+// the checks one after another and reports each outcome, since checks cannot
+// be rolled back.
+func (s *Server) handleBulkMonitors(w http.ResponseWriter, r *http.Request) {
+	var req bulkMonitorRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid JSON body")
+		return
+	}
+
+	action := strings.ToLower(strings.TrimSpace(req.Action))
+	if !slices.Contains(bulkMonitorActions, action) {
+		writeError(w, http.StatusBadRequest, "action must be one of: "+strings.Join(bulkMonitorActions, ", "))
+		return
+	}
+
+	monitorIDs, status, err := s.resolveBulkMonitors(r.Context(), req)
+	if err != nil {
+		writeError(w, status, err.Error())
+		return
+	}
+
+	response := bulkMonitorResponse{Action: action, MonitorIDs: monitorIDs}
+	if action == "trigger" {
+		response.Results = s.triggerMonitors(r.Context(), monitorIDs)
+		s.scheduleChanges.Publish()
+		writeJSON(w, http.StatusOK, response)
+		return
+	}
+
+	if err := s.applyBulkMonitorAction(r.Context(), action, monitorIDs); err != nil {
+		writeError(w, http.StatusInternalServerError, fmt.Sprintf("failed to %s monitors", action))
+		return
+	}
+	s.scheduleChanges.Publish()
+	s.publishBulkMonitorUpdates(r.Context(), action, monitorIDs)
+
+	writeJSON(w, http.StatusOK, response)
+}
+
+// resolveBulkMonitors returns the sorted IDs named by exactly one of
+// monitorIds or tag, failing with the HTTP status to respond with.
+func (s *Server) resolveBulkMonitors(ctx context.Context, req bulkMonitorRequest) ([]int, int, error) {
+	tag := strings.ToLower(strings.TrimSpace(req.Tag))
+	if (len(req.MonitorIDs) == 0) == (tag == "") {
+		return nil, http.StatusBadRequest, errors.New("provide either monitorIds or tag")
+	}
+
+	if tag != "" {
+		rows, err := s.db.Monitor.Query().
+			Select(monitor.FieldTags).
+			Order(ent.Asc(monitor.FieldID)).
+			All(ctx)
+		if err != nil {
+			return nil, http.StatusInternalServerError, errors.New("failed to load monitors")
+		}
+
+		monitorIDs := make([]int, 0)
+		for _, row := range rows {
+			if hasAllTags(row, []string{tag}) {
+				monitorIDs = append(monitorIDs, row.ID)
+			}
+		}
+		if len(monitorIDs) == 0 {
+			return nil, http.StatusNotFound, fmt.Errorf("no monitors have tag %q", tag)
+		}
+		if len(monitorIDs) > maxBulkMonitors {
+			return nil, http.StatusBadRequest, fmt.Errorf("at most %d monitors can be changed at once", maxBulkMonitors)
+		}
+		return monitorIDs, 0, nil
+	}
+
+	monitorIDs := slices.Clone(req.MonitorIDs)
+	slices.Sort(monitorIDs)
+	monitorIDs = slices.Compact(monitorIDs)
+	if len(monitorIDs) > maxBulkMonitors {
+		return nil, http.StatusBadRequest, fmt.Errorf("at most %d monitors can be changed at once", maxBulkMonitors)
+	}
+	if monitorIDs[0] <= 0 {
+		return nil, http.StatusBadRequest, errors.New("monitorIds must be positive integers")
+	}
+
+	found, err := s.db.Monitor.Query().
+		Where(monitor.IDIn(monitorIDs...)).
+		IDs(ctx)
+	if err != nil {
+		return nil, http.StatusInternalServerError, errors.New("failed to load monitors")
+	}
+	if len(found) != len(monitorIDs) {
+		missing := make([]string, 0)
+		for _, monitorID := range monitorIDs {
+			if !slices.Contains(found, monitorID) {
+				missing = append(missing, fmt.Sprint(monitorID))
+			}
+		}
+		return nil, http.StatusNotFound, fmt.Errorf("monitors not found: %s", strings.Join(missing, ", "))
+	}
+
+	return monitorIDs, 0, nil
+}
+
+func (s *Server) applyBulkMonitorAction(ctx context.Context, action string, monitorIDs []int) error {
+	config, err := s.ensureGlobalSystemConfig(ctx)
+	if err != nil {
+		return err
+	}
+
+	tx, err := s.db.Tx(ctx)
+	if err != nil {
+		return err
+	}
+
+	switch action {
+	case "delete":
+		_, err = deleteMonitors(ctx, tx, monitorIDs...)
+	case "archive":
+		err = archiveMonitors(ctx, tx, time.Now().UTC(), monitorIDs...)
+	case "restore":
+		err = restoreMonitors(ctx, tx, monitorIDs, runtimeCronLocation(config.Timezone))
+	case "enable", "disable":
+		err = setMonitorsEnabled(ctx, tx, monitorIDs, action == "enable", runtimeCronLocation(config.Timezone))
+	}
+	if err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// setMonitorsEnabled enables or disables monitors the way updating each one
+// would, leaving monitors already in the requested state untouched.
+func setMonitorsEnabled(ctx context.Context, tx *ent.Tx, monitorIDs []int, enabled bool, cronLocation *time.Location) error {
+	rows, err := tx.Monitor.Query().
+		Where(monitor.IDIn(monitorIDs...), monitor.EnabledEQ(!enabled)).
+		WithRuntime().
+		All(ctx)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now().UTC()
+
+	for _, row := range rows {
+		if _, err := tx.Monitor.UpdateOneID(row.ID).SetEnabled(enabled).Save(ctx); err != nil {
+			return err
+		}
+
+		runtime := row.Edges.Runtime
+		if !enabled {
+			if runtime != nil {
+				if _, err := tx.MonitorRuntime.UpdateOneID(runtime.ID).
+					SetStatus(monitorruntime.StatusDisabled).
+					ClearNextRunAt().
+					Save(ctx); err != nil {
+					return err
+				}
+			}
+			continue
+		}
+
+		if row.ArchivedAt != nil {
+			// Archived monitors stay unscheduled until they are restored.
+			continue
+		}
+		if err := scheduleMonitorRuntime(ctx, tx, row, runtime, now, cronLocation); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// scheduleMonitorRuntime marks an enabled monitor's runtime pending with its
+// next cron run, creating the runtime when it is missing.
+func scheduleMonitorRuntime(ctx context.Context, tx *ent.Tx, row *ent.Monitor, runtime *ent.MonitorRuntime, now time.Time, cronLocation *time.Location) error {
+	nextRun, err := nextRunFromCron(row.Cron, row.DstPolicy.String(), now, worker.MonitorLocation(row, cronLocation))
+	if err != nil {
+		return err
+	}
+	if runtime == nil {
+		_, err := tx.MonitorRuntime.Create().
+			SetMonitorID(row.ID).
+			SetStatus(monitorruntime.StatusPending).
+			SetNextRunAt(nextRun).
+			Save(ctx)
+		return err
+	}
+
+	update := tx.MonitorRuntime.UpdateOneID(runtime.ID).
+		SetStatus(monitorruntime.StatusPending).
+		SetNextRunAt(nextRun).
+		ClearCircuitOpenedAt()
+	if runtime.CircuitOpenedAt != nil {
+		update = update.SetConsecutiveErrors(0)
+	}
+	_, err = update.Save(ctx)
+	return err
+}
+
+// publishBulkMonitorUpdates sends a monitor.updated event for each monitor a
+// bulk action changed. Check results of triggered monitors are published by
+// the worker instead.
+func (s *Server) publishBulkMonitorUpdates(ctx context.Context, action string, monitorIDs []int) {
+	switch action {
+	case "delete":
+		s.publishMonitorsUpdated(ctx, monitorDeleted, monitorIDs)
+	case "archive":
+		s.publishMonitorsUpdated(ctx, monitorArchived, monitorIDs)
+	case "restore":
+		s.publishMonitorsUpdated(ctx, monitorRestored, monitorIDs)
+	default:
+		s.publishMonitorsUpdated(ctx, monitorUpdated, monitorIDs)
+	}
+}
+
+// publishMonitorsUpdated sends a monitor.updated event with action for each
+// monitor, reloading them unless they were deleted.
+func (s *Server) publishMonitorsUpdated(ctx context.Context, action string, monitorIDs []int) {
+	if action == monitorDeleted {
+		for _, monitorID := range monitorIDs {
+			s.publishMonitorUpdated(monitorID, monitorDeleted, nil)
+		}
+		return
+	}
+
+	rows, err := s.db.Monitor.Query().
+		Where(monitor.IDIn(monitorIDs...)).
+		WithRuntime().
+		All(ctx)
+	if err != nil {
+		return
+	}
+	channelStates := s.loadNotificationChannelStates(ctx)
+	for _, row := range rows {
+		mapped := s.mapMonitor(row, row.Edges.Runtime, buildMonitorNotificationIssues(row.NotificationChannels, channelStates))
+		s.publishMonitorUpdated(row.ID, action, &mapped)
+	}
+}
+
+func (s *Server) triggerMonitors(ctx context.Context, monitorIDs []int) []bulkMonitorResultResponse {
+	results := make([]bulkMonitorResultResponse, 0, len(monitorIDs))
+	for _, monitorID := range monitorIDs {
+		result := bulkMonitorResultResponse{MonitorID: monitorID}
+
+		triggered, err := s.triggerWorker.TriggerMonitorNow(ctx, monitorID)
+		switch {
+		case err != nil:
+			message := err.Error()
+			result.Error = &message
+		case triggered.Check != nil:
+			result.Status = &triggered.Check.Status
+		}
+		results = append(results, result)
+	}
+	return results
+}
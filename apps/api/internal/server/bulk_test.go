@@ -0,0 +1,102 @@
+package server
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"goanna/apps/api/ent/enttest"
+	"goanna/apps/api/ent/monitorruntime"
+
+	_ "github.com/mattn/go-sqlite3"
+)
+
+func TestHandleBulkMonitors(t *testing.T) {
+	target := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(`{"status":"ok"}`))
+	}))
+	defer target.Close()
+
+	client := enttest.Open(t, "sqlite3", "file:monitor-bulk?mode=memory&cache=shared&_fk=1")
+	defer client.Close()
+
+	seed := func(tags ...string) int {
+		row, err := client.Monitor.Create().
+			SetURL(target.URL).
+			SetCron("*/5 * * * *").
+			SetTags(tags).
+			Save(t.Context())
+		if err != nil {
+			t.Fatalf("expected monitor to save: %v", err)
+		}
+		if _, err := client.MonitorRuntime.Create().SetMonitor(row).SetStatus(monitorruntime.StatusOk).Save(t.Context()); err != nil {
+			t.Fatalf("expected runtime to save: %v", err)
+		}
+		return row.ID
+	}
+	first := seed("shop")
+	second := seed("shop")
+	other := seed("blog")
+
+	mux := http.NewServeMux()
+	New(client).RegisterRoutes(mux)
+
+	send := func(body string) *httptest.ResponseRecorder {
+		rec := httptest.NewRecorder()
+		mux.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/v1/monitors/bulk", strings.NewReader(body)))
+		return rec
+	}
+
+	if rec := send(`{"action":"disable","tag":"shop"}`); rec.Code != http.StatusOK {
+		t.Fatalf("expected 200, got %d: %s", rec.Code, rec.Body.String())
+	}
+	disabled, err := client.MonitorRuntime.Query().Where(monitorruntime.StatusEQ(monitorruntime.StatusDisabled)).Count(t.Context())
+	if err != nil || disabled != 2 {
+		t.Fatalf("expected both shop monitors disabled, got %d (%v)", disabled, err)
+	}
+
+	if rec := send(fmt.Sprintf(`{"action":"enable","monitorIds":[%d,%d]}`, first, second)); rec.Code != http.StatusOK {
+		t.Fatalf("expected 200, got %d: %s", rec.Code, rec.Body.String())
+	}
+	enabled, err := client.Monitor.Query().All(t.Context())
+	if err != nil {
+		t.Fatalf("expected monitors to load: %v", err)
+	}
+	for _, row := range enabled {
+		if !row.Enabled {
+			t.Fatalf("expected monitor %d to be enabled", row.ID)
+		}
+	}
+
+	rec := send(fmt.Sprintf(`{"action":"trigger","monitorIds":[%d]}`, other))
+	var triggered bulkMonitorResponse
+	if err := json.Unmarshal(rec.Body.Bytes(), &triggered); err != nil || rec.Code != http.StatusOK {
+		t.Fatalf("expected trigger response, got %d %s", rec.Code, rec.Body.String())
+	}
+	if len(triggered.Results) != 1 || triggered.Results[0].Status == nil || *triggered.Results[0].Status != "ok" {
+		t.Fatalf("expected one ok check, got %+v", triggered.Results)
+	}
+
+	if rec := send(fmt.Sprintf(`{"action":"delete","monitorIds":[%d,%d,999]}`, first, second)); rec.Code != http.StatusNotFound {
+		t.Fatalf("expected 404 when an ID is unknown, got %d", rec.Code)
+	}
+	if count, _ := client.Monitor.Query().Count(t.Context()); count != 3 {
+		t.Fatalf("expected nothing deleted after a failed selection, got %d monitors", count)
+	}
+
+	if rec := send(fmt.Sprintf(`{"action":"delete","monitorIds":[%d,%d]}`, first, second)); rec.Code != http.StatusOK {
+		t.Fatalf("expected 200, got %d: %s", rec.Code, rec.Body.String())
+	}
+	if count, _ := client.Monitor.Query().Count(t.Context()); count != 1 {
+		t.Fatalf("expected one monitor left, got %d", count)
+	}
+
+	for _, body := range []string{`{"action":"pause","tag":"blog"}`, `{"action":"delete"}`, `{"action":"delete","tag":"blog","monitorIds":[3]}`} {
+		if rec := send(body); rec.Code != http.StatusBadRequest {
+			t.Fatalf("%s: expected 400, got %d", body, rec.Code)
+		}
+	}
+}
@@ -0,0 +1,491 @@
+package server
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"goanna/apps/api/ent"
+	"goanna/apps/api/ent/checkresult"
+	"goanna/apps/api/ent/monitor"
+	"goanna/apps/api/ent/predicate"
+	"goanna/apps/api/internal/worker"
+)
+
+// nextCursorHeader carries the cursor for the next page of checks, keeping
+// the response body a plain array for existing clients.
+const nextCursorHeader = "X-Next-Cursor"
+
+// checksCursor points at the last check of a page in the checked_at desc, id
+// desc order used to list checks.
+type checksCursor struct {
+	checkedAt time.Time
+	id        int
+}
+
+func encodeChecksCursor(row *ent.CheckResult) string {
+	raw := fmt.Sprintf("%d:%d", row.CheckedAt.UnixNano(), row.ID)
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+func decodeChecksCursor(value string) (checksCursor, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(value)
+	if err != nil {
+		return checksCursor{}, err
+	}
+
+	nanosValue, idValue, ok := strings.Cut(string(raw), ":")
+	if !ok {
+		return checksCursor{}, errors.New("malformed cursor")
+	}
+	nanos, err := strconv.ParseInt(nanosValue, 10, 64)
+	if err != nil {
+		return checksCursor{}, err
+	}
+	id, err := strconv.Atoi(idValue)
+	if err != nil || id <= 0 {
+		return checksCursor{}, errors.New("malformed cursor")
+	}
+
+	return checksCursor{checkedAt: time.Unix(0, nanos).UTC(), id: id}, nil
+}
+
+// predicate matches the checks listed after the cursor.
+func (c checksCursor) predicate() predicate.CheckResult {
+	return checkresult.Or(
+		checkresult.CheckedAtLT(c.checkedAt),
+		checkresult.And(
+			checkresult.CheckedAtEQ(c.checkedAt),
+			checkresult.IDLT(c.id),
+		),
+	)
+}
+
+var monitorCheckStatuses = []string{"ok", "error", "retrying", "pending", "unknown"}
+
+// parseMonitorChecksFilters reads the optional status, diffChanged, from, to
+// and minDuration query parameters of the checks list.
+func parseMonitorChecksFilters(r *http.Request) ([]predicate.CheckResult, error) {
+	query := r.URL.Query()
+	filters := make([]predicate.CheckResult, 0)
+
+	if raw := strings.TrimSpace(query.Get("status")); raw != "" {
+		statuses := make([]string, 0)
+		for _, part := range strings.Split(raw, ",") {
+			status := strings.ToLower(strings.TrimSpace(part))
+			if status == "" {
+				continue
+			}
+			if !slices.Contains(monitorCheckStatuses, status) {
+				return nil, fmt.Errorf("status must be one of: %s", strings.Join(monitorCheckStatuses, ", "))
+			}
+			statuses = append(statuses, status)
+		}
+		if len(statuses) > 0 {
+			filters = append(filters, checkresult.StatusIn(statuses...))
+		}
+	}
+
+	if raw := strings.TrimSpace(query.Get("diffChanged")); raw != "" {
+		changed, err := strconv.ParseBool(raw)
+		if err != nil {
+			return nil, errors.New("diffChanged must be true or false")
+		}
+		filters = append(filters, checkresult.DiffChangedEQ(changed))
+	}
+
+	rangeFilters, err := parseCheckedAtFilters(r)
+	if err != nil {
+		return nil, err
+	}
+	filters = append(filters, rangeFilters...)
+
+	if raw := strings.TrimSpace(query.Get("minDuration")); raw != "" {
+		minDuration, err := strconv.Atoi(raw)
+		if err != nil || minDuration < 0 {
+			return nil, errors.New("minDuration must be a non-negative number of milliseconds")
+		}
+		filters = append(filters, checkresult.ResponseTimeMsGTE(minDuration))
+	}
+
+	return filters, nil
+}
+
+// parseCheckedAtFilters reads the inclusive from and to RFC3339 bounds on
+// checked_at.
+func parseCheckedAtFilters(r *http.Request) ([]predicate.CheckResult, error) {
+	query := r.URL.Query()
+	filters := make([]predicate.CheckResult, 0, 2)
+
+	for _, bound := range []struct {
+		key       string
+		predicate func(time.Time) predicate.CheckResult
+	}{
+		{key: "from", predicate: checkresult.CheckedAtGTE},
+		{key: "to", predicate: checkresult.CheckedAtLTE},
+	} {
+		raw := strings.TrimSpace(query.Get(bound.key))
+		if raw == "" {
+			continue
+		}
+		parsed, err := time.Parse(time.RFC3339, raw)
+		if err != nil {
+			return nil, fmt.Errorf("%s must be an RFC3339 timestamp", bound.key)
+		}
+		filters = append(filters, bound.predicate(parsed.UTC()))
+	}
+
+	return filters, nil
+}
+
+type monitorCheckDiffResponse struct {
+	From    monitorCheckResponse `json:"from"`
+	To      monitorCheckResponse `json:"to"`
+	Kind    string               `json:"kind"`
+	Changed bool                 `json:"changed"`
+	Summary string               `json:"summary"`
+	Details map[string]any       `json:"details"`
+}
+
+type monitorCheckBodyResponse struct {
+	CheckID   int64  `json:"checkId"`
+	Size      int    `json:"size"`
+	Truncated bool   `json:"truncated"`
+	Body      string `json:"body"`
+}
+
+// monitorCheckDetailResponse is a check with its stored strings untruncated.
+// DiffDetailsParsed holds diffDetails decoded as JSON when it is valid JSON.
+type monitorCheckDetailResponse struct {
+	monitorCheckResponse
+	DiffDetailsParsed json.RawMessage `json:"diffDetailsParsed,omitempty"`
+}
+
+type deleteMonitorChecksResponse struct {
+	Deleted int `json:"deleted"`
+}
+
+type monitorCheckNeighborsResponse struct {
+	Check          monitorCheckResponse  `json:"check"`
+	PreviousChange *monitorCheckResponse `json:"previousChange,omitempty"`
+	NextChange     *monitorCheckResponse `json:"nextChange,omitempty"`
+}
+
+func (s *Server) handleDiffMonitorChecks(w http.ResponseWriter, r *http.Request) {
+	monitorID, err := parseMonitorID(r.PathValue("monitorId"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "monitorId must be a positive integer")
+		return
+	}
+
+	fromID, err := parsePositiveQueryInt(r, "from")
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	toID, err := parsePositiveQueryInt(r, "to")
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	row, err := s.db.Monitor.Get(r.Context(), monitorID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			writeError(w, http.StatusNotFound, "monitor not found")
+			return
+		}
+		writeError(w, http.StatusInternalServerError, "failed to query monitor")
+		return
+	}
+
+	fromCheck, err := s.loadMonitorCheck(r, monitorID, fromID)
+	if err != nil {
+		writeMonitorCheckLoadError(w, err)
+		return
+	}
+	toCheck, err := s.loadMonitorCheck(r, monitorID, toID)
+	if err != nil {
+		writeMonitorCheckLoadError(w, err)
+		return
+	}
+
+	diff := worker.DiffCheckResults(row, fromCheck, toCheck)
+	if diff == nil {
+		writeError(w, http.StatusBadRequest, "both checks must have a stored selection, body, or content hash to compare")
+		return
+	}
+
+	details := diff.Details
+	if details == nil {
+		details = map[string]any{}
+	}
+
+	writeJSON(w, http.StatusOK, monitorCheckDiffResponse{
+		From:    mapMonitorCheck(fromCheck),
+		To:      mapMonitorCheck(toCheck),
+		Kind:    diff.Kind,
+		Changed: diff.Changed,
+		Summary: diff.Summary,
+		Details: details,
+	})
+}
+
+// handleGetMonitorCheck returns one check in full, without the
+// maxResponseStringBytes limit applied to check lists.
+func (s *Server) handleGetMonitorCheck(w http.ResponseWriter, r *http.Request) {
+	monitorID, err := parseMonitorID(r.PathValue("monitorId"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "monitorId must be a positive integer")
+		return
+	}
+
+	checkID, err := parseCheckID(r.PathValue("checkId"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	check, err := s.loadMonitorCheck(r, monitorID, checkID)
+	if err != nil {
+		writeMonitorCheckLoadError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, mapMonitorCheckDetail(check))
+}
+
+// handleDeleteMonitorChecks purges a monitor's checks, optionally only those
+// checked between the from and to query parameters. Lifetime counters on the
+// monitor are kept.
+func (s *Server) handleDeleteMonitorChecks(w http.ResponseWriter, r *http.Request) {
+	monitorID, err := parseMonitorID(r.PathValue("monitorId"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "monitorId must be a positive integer")
+		return
+	}
+
+	filters, err := parseCheckedAtFilters(r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	exists, err := s.db.Monitor.Query().Where(monitor.IDEQ(monitorID)).Exist(r.Context())
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "failed to query monitor")
+		return
+	}
+	if !exists {
+		writeError(w, http.StatusNotFound, "monitor not found")
+		return
+	}
+
+	deleted, err := s.db.CheckResult.Delete().
+		Where(checkresult.MonitorIDEQ(monitorID)).
+		Where(filters...).
+		Exec(r.Context())
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "failed to delete checks")
+		return
+	}
+
+	writeJSON(w, http.StatusOK, deleteMonitorChecksResponse{Deleted: deleted})
+}
+
+func (s *Server) handleDeleteMonitorCheck(w http.ResponseWriter, r *http.Request) {
+	monitorID, err := parseMonitorID(r.PathValue("monitorId"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "monitorId must be a positive integer")
+		return
+	}
+
+	checkID, err := parseCheckID(r.PathValue("checkId"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	deleted, err := s.db.CheckResult.Delete().
+		Where(
+			checkresult.IDEQ(checkID),
+			checkresult.MonitorIDEQ(monitorID),
+		).
+		Exec(r.Context())
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "failed to delete check")
+		return
+	}
+	if deleted == 0 {
+		writeError(w, http.StatusNotFound, "check not found")
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) handleGetMonitorCheckBody(w http.ResponseWriter, r *http.Request) {
+	monitorID, err := parseMonitorID(r.PathValue("monitorId"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "monitorId must be a positive integer")
+		return
+	}
+
+	checkID, err := parseCheckID(r.PathValue("checkId"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	check, err := s.loadMonitorCheck(r, monitorID, checkID)
+	if err != nil {
+		writeMonitorCheckLoadError(w, err)
+		return
+	}
+
+	body, err := worker.DecodeBodySnapshot(check)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "failed to decode check body")
+		return
+	}
+	if body == nil {
+		writeError(w, http.StatusNotFound, "check has no stored body")
+		return
+	}
+
+	size := len(body)
+	if check.BodySize != nil {
+		size = *check.BodySize
+	}
+
+	writeJSON(w, http.StatusOK, monitorCheckBodyResponse{
+		CheckID:   int64(check.ID),
+		Size:      size,
+		Truncated: check.BodySnapshotTruncated,
+		Body:      string(body),
+	})
+}
+
+func (s *Server) handleMonitorCheckNeighbors(w http.ResponseWriter, r *http.Request) {
+	monitorID, err := parseMonitorID(r.PathValue("monitorId"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "monitorId must be a positive integer")
+		return
+	}
+
+	checkID, err := parseCheckID(r.PathValue("checkId"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	check, err := s.loadMonitorCheck(r, monitorID, checkID)
+	if err != nil {
+		writeMonitorCheckLoadError(w, err)
+		return
+	}
+
+	previous, err := s.db.CheckResult.Query().
+		Where(
+			checkresult.MonitorIDEQ(monitorID),
+			checkresult.DiffChangedEQ(true),
+			checkresult.Or(
+				checkresult.CheckedAtLT(check.CheckedAt),
+				checkresult.And(checkresult.CheckedAtEQ(check.CheckedAt), checkresult.IDLT(check.ID)),
+			),
+		).
+		Order(ent.Desc(checkresult.FieldCheckedAt), ent.Desc(checkresult.FieldID)).
+		First(r.Context())
+	if err != nil && !ent.IsNotFound(err) {
+		writeError(w, http.StatusInternalServerError, "failed to load neighboring checks")
+		return
+	}
+
+	next, err := s.db.CheckResult.Query().
+		Where(
+			checkresult.MonitorIDEQ(monitorID),
+			checkresult.DiffChangedEQ(true),
+			checkresult.Or(
+				checkresult.CheckedAtGT(check.CheckedAt),
+				checkresult.And(checkresult.CheckedAtEQ(check.CheckedAt), checkresult.IDGT(check.ID)),
+			),
+		).
+		Order(ent.Asc(checkresult.FieldCheckedAt), ent.Asc(checkresult.FieldID)).
+		First(r.Context())
+	if err != nil && !ent.IsNotFound(err) {
+		writeError(w, http.StatusInternalServerError, "failed to load neighboring checks")
+		return
+	}
+
+	response := monitorCheckNeighborsResponse{Check: mapMonitorCheck(check)}
+	if previous != nil {
+		mapped := mapMonitorCheck(previous)
+		response.PreviousChange = &mapped
+	}
+	if next != nil {
+		mapped := mapMonitorCheck(next)
+		response.NextChange = &mapped
+	}
+
+	writeJSON(w, http.StatusOK, response)
+}
+
+func mapMonitorCheckDetail(row *ent.CheckResult) monitorCheckDetailResponse {
+	mapped := mapMonitorCheck(row)
+	mapped.ErrorMessage = row.ErrorMessage
+	mapped.SelectionValue = row.SelectionValue
+	mapped.DiffSummary = row.DiffSummary
+	mapped.DiffDetails = row.DiffDetails
+
+	response := monitorCheckDetailResponse{monitorCheckResponse: mapped}
+	if row.DiffDetails != nil && json.Valid([]byte(*row.DiffDetails)) {
+		response.DiffDetailsParsed = json.RawMessage(*row.DiffDetails)
+	}
+	return response
+}
+
+func (s *Server) loadMonitorCheck(r *http.Request, monitorID int, checkID int) (*ent.CheckResult, error) {
+	return s.db.CheckResult.Query().
+		Where(
+			checkresult.IDEQ(checkID),
+			checkresult.MonitorIDEQ(monitorID),
+		).
+		Only(r.Context())
+}
+
+func writeMonitorCheckLoadError(w http.ResponseWriter, err error) {
+	if ent.IsNotFound(err) {
+		writeError(w, http.StatusNotFound, "check not found")
+		return
+	}
+	writeError(w, http.StatusInternalServerError, "failed to load check")
+}
+
+func parseCheckID(raw string) (int, error) {
+	checkID, err := strconv.Atoi(strings.TrimSpace(raw))
+	if err != nil || checkID <= 0 {
+		return 0, errors.New("checkId must be a positive integer")
+	}
+
+	return checkID, nil
+}
+
+func parsePositiveQueryInt(r *http.Request, key string) (int, error) {
+	raw := strings.TrimSpace(r.URL.Query().Get(key))
+	if raw == "" {
+		return 0, fmt.Errorf("%s is required", key)
+	}
+
+	parsed, err := strconv.Atoi(raw)
+	if err != nil || parsed <= 0 {
+		return 0, fmt.Errorf("%s must be a positive integer", key)
+	}
+
+	return parsed, nil
+}
@@ -0,0 +1,549 @@
+package server
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"goanna/apps/api/ent"
+	"goanna/apps/api/ent/enttest"
+
+	_ "github.com/mattn/go-sqlite3"
+)
+
+func seedMonitorCheck(t *testing.T, client *ent.Client, monitorID int, selectionType string, selectionValue string, checkedAt time.Time) *ent.CheckResult {
+	t.Helper()
+
+	check, err := client.CheckResult.Create().
+		SetMonitorID(monitorID).
+		SetStatus("ok").
+		SetSelectionType(selectionType).
+		SetSelectionValue(selectionValue).
+		SetCheckedAt(checkedAt).
+		Save(t.Context())
+	if err != nil {
+		t.Fatalf("expected check to save: %v", err)
+	}
+
+	return check
+}
+
+func TestHandleDiffMonitorChecksComparesStoredSelections(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:checks-diff?mode=memory&cache=shared&_fk=1")
+	defer client.Close()
+
+	row, err := client.Monitor.Create().
+		SetURL("https://example.com/rates").
+		SetCron("*/5 * * * *").
+		Save(t.Context())
+	if err != nil {
+		t.Fatalf("expected monitor to save: %v", err)
+	}
+
+	base := time.Date(2026, time.March, 1, 12, 0, 0, 0, time.UTC)
+	first := seedMonitorCheck(t, client, row.ID, "number", "10", base)
+	seedMonitorCheck(t, client, row.ID, "number", "11", base.Add(time.Minute))
+	last := seedMonitorCheck(t, client, row.ID, "number", "14", base.Add(2*time.Minute))
+
+	mux := http.NewServeMux()
+	New(client).RegisterRoutes(mux)
+
+	req := httptest.NewRequest(
+		http.MethodGet,
+		fmt.Sprintf("/v1/monitors/%d/checks/diff?from=%d&to=%d", row.ID, first.ID, last.ID),
+		nil,
+	)
+	recorder := httptest.NewRecorder()
+	mux.ServeHTTP(recorder, req)
+
+	if recorder.Code != http.StatusOK {
+		t.Fatalf("expected status 200, got %d: %s", recorder.Code, recorder.Body.String())
+	}
+
+	var response monitorCheckDiffResponse
+	if err := json.NewDecoder(recorder.Body).Decode(&response); err != nil {
+		t.Fatalf("expected JSON response, got %v", err)
+	}
+	if response.Kind != "number" || !response.Changed {
+		t.Fatalf("expected changed number diff, got kind=%q changed=%t", response.Kind, response.Changed)
+	}
+	if response.Details["delta"] != 4.0 {
+		t.Fatalf("expected delta 4 across the gap, got %#v", response.Details["delta"])
+	}
+	if response.From.ID != int64(first.ID) || response.To.ID != int64(last.ID) {
+		t.Fatalf("expected from/to checks %d/%d, got %d/%d", first.ID, last.ID, response.From.ID, response.To.ID)
+	}
+}
+
+func TestHandleDiffMonitorChecksRejectsChecksFromOtherMonitor(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:checks-diff-other?mode=memory&cache=shared&_fk=1")
+	defer client.Close()
+
+	first, err := client.Monitor.Create().SetURL("https://example.com/a").SetCron("*/5 * * * *").Save(t.Context())
+	if err != nil {
+		t.Fatalf("expected monitor to save: %v", err)
+	}
+	second, err := client.Monitor.Create().SetURL("https://example.com/b").SetCron("*/5 * * * *").Save(t.Context())
+	if err != nil {
+		t.Fatalf("expected monitor to save: %v", err)
+	}
+
+	now := time.Now().UTC()
+	own := seedMonitorCheck(t, client, first.ID, "string", "a", now)
+	foreign := seedMonitorCheck(t, client, second.ID, "string", "b", now)
+
+	mux := http.NewServeMux()
+	New(client).RegisterRoutes(mux)
+
+	req := httptest.NewRequest(
+		http.MethodGet,
+		fmt.Sprintf("/v1/monitors/%d/checks/diff?from=%d&to=%d", first.ID, own.ID, foreign.ID),
+		nil,
+	)
+	recorder := httptest.NewRecorder()
+	mux.ServeHTTP(recorder, req)
+
+	if recorder.Code != http.StatusNotFound {
+		t.Fatalf("expected status 404, got %d", recorder.Code)
+	}
+}
+
+func TestHandleGetMonitorCheckBodyReturnsStoredBody(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:checks-body?mode=memory&cache=shared&_fk=1")
+	defer client.Close()
+
+	row, err := client.Monitor.Create().
+		SetURL("https://example.com/page").
+		SetCron("*/5 * * * *").
+		Save(t.Context())
+	if err != nil {
+		t.Fatalf("expected monitor to save: %v", err)
+	}
+
+	withBody, err := client.CheckResult.Create().
+		SetMonitorID(row.ID).
+		SetStatus("ok").
+		SetBodySnapshot([]byte("<p>hello</p>")).
+		SetBodySnapshotEncoding("identity").
+		SetBodySize(12).
+		Save(t.Context())
+	if err != nil {
+		t.Fatalf("expected check to save: %v", err)
+	}
+	withoutBody := seedMonitorCheck(t, client, row.ID, "string", "a", time.Now().UTC())
+
+	mux := http.NewServeMux()
+	New(client).RegisterRoutes(mux)
+
+	req := httptest.NewRequest(http.MethodGet, fmt.Sprintf("/v1/monitors/%d/checks/%d/body", row.ID, withBody.ID), nil)
+	recorder := httptest.NewRecorder()
+	mux.ServeHTTP(recorder, req)
+
+	if recorder.Code != http.StatusOK {
+		t.Fatalf("expected status 200, got %d: %s", recorder.Code, recorder.Body.String())
+	}
+
+	var response monitorCheckBodyResponse
+	if err := json.NewDecoder(recorder.Body).Decode(&response); err != nil {
+		t.Fatalf("expected JSON response, got %v", err)
+	}
+	if response.Body != "<p>hello</p>" || response.Size != 12 || response.Truncated {
+		t.Fatalf("unexpected body response %#v", response)
+	}
+
+	req = httptest.NewRequest(http.MethodGet, fmt.Sprintf("/v1/monitors/%d/checks/%d/body", row.ID, withoutBody.ID), nil)
+	recorder = httptest.NewRecorder()
+	mux.ServeHTTP(recorder, req)
+
+	if recorder.Code != http.StatusNotFound {
+		t.Fatalf("expected status 404 for check without body, got %d", recorder.Code)
+	}
+}
+
+func TestHandleGetMonitorCheckReturnsUntruncatedRecord(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:checks-detail?mode=memory&cache=shared&_fk=1")
+	defer client.Close()
+
+	row, err := client.Monitor.Create().
+		SetURL("https://example.com/page").
+		SetCron("*/5 * * * *").
+		Save(t.Context())
+	if err != nil {
+		t.Fatalf("expected monitor to save: %v", err)
+	}
+
+	longValue := strings.Repeat("x", maxResponseStringBytes+100)
+	details := `{"added":["` + longValue + `"]}`
+	check, err := client.CheckResult.Create().
+		SetMonitorID(row.ID).
+		SetStatus("ok").
+		SetSelectionType("string").
+		SetSelectionValue(longValue).
+		SetDiffChanged(true).
+		SetDiffDetails(details).
+		Save(t.Context())
+	if err != nil {
+		t.Fatalf("expected check to save: %v", err)
+	}
+
+	mux := http.NewServeMux()
+	New(client).RegisterRoutes(mux)
+
+	req := httptest.NewRequest(http.MethodGet, fmt.Sprintf("/v1/monitors/%d/checks/%d", row.ID, check.ID), nil)
+	recorder := httptest.NewRecorder()
+	mux.ServeHTTP(recorder, req)
+	if recorder.Code != http.StatusOK {
+		t.Fatalf("expected status 200, got %d: %s", recorder.Code, recorder.Body.String())
+	}
+
+	var response struct {
+		SelectionValue    string `json:"selectionValue"`
+		DiffDetails       string `json:"diffDetails"`
+		DiffDetailsParsed struct {
+			Added []string `json:"added"`
+		} `json:"diffDetailsParsed"`
+	}
+	if err := json.NewDecoder(recorder.Body).Decode(&response); err != nil {
+		t.Fatalf("expected JSON response, got %v", err)
+	}
+	if response.SelectionValue != longValue || response.DiffDetails != details {
+		t.Fatalf("expected untruncated values, got %d and %d bytes", len(response.SelectionValue), len(response.DiffDetails))
+	}
+	if len(response.DiffDetailsParsed.Added) != 1 || response.DiffDetailsParsed.Added[0] != longValue {
+		t.Fatalf("expected parsed diff details, got %#v", response.DiffDetailsParsed)
+	}
+
+	req = httptest.NewRequest(http.MethodGet, fmt.Sprintf("/v1/monitors/%d/checks/%d", row.ID+1, check.ID), nil)
+	recorder = httptest.NewRecorder()
+	mux.ServeHTTP(recorder, req)
+	if recorder.Code != http.StatusNotFound {
+		t.Fatalf("expected status 404 for another monitor's check, got %d", recorder.Code)
+	}
+}
+
+func TestHandleMonitorCheckNeighborsSkipsUnchangedChecks(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:checks-neighbors?mode=memory&cache=shared&_fk=1")
+	defer client.Close()
+
+	row, err := client.Monitor.Create().
+		SetURL("https://example.com/rates").
+		SetCron("*/5 * * * *").
+		Save(t.Context())
+	if err != nil {
+		t.Fatalf("expected monitor to save: %v", err)
+	}
+
+	base := time.Date(2026, time.March, 1, 12, 0, 0, 0, time.UTC)
+	checks := make([]*ent.CheckResult, 0, 5)
+	for index, changed := range []bool{true, false, false, false, true} {
+		check, err := client.CheckResult.Create().
+			SetMonitorID(row.ID).
+			SetStatus("ok").
+			SetDiffChanged(changed).
+			SetCheckedAt(base.Add(time.Duration(index) * time.Minute)).
+			Save(t.Context())
+		if err != nil {
+			t.Fatalf("expected check to save: %v", err)
+		}
+		checks = append(checks, check)
+	}
+
+	mux := http.NewServeMux()
+	New(client).RegisterRoutes(mux)
+
+	req := httptest.NewRequest(
+		http.MethodGet,
+		fmt.Sprintf("/v1/monitors/%d/checks/%d/neighbors", row.ID, checks[2].ID),
+		nil,
+	)
+	recorder := httptest.NewRecorder()
+	mux.ServeHTTP(recorder, req)
+
+	if recorder.Code != http.StatusOK {
+		t.Fatalf("expected status 200, got %d: %s", recorder.Code, recorder.Body.String())
+	}
+
+	var response monitorCheckNeighborsResponse
+	if err := json.NewDecoder(recorder.Body).Decode(&response); err != nil {
+		t.Fatalf("expected JSON response, got %v", err)
+	}
+	if response.PreviousChange == nil || response.PreviousChange.ID != int64(checks[0].ID) {
+		t.Fatalf("expected previous change %d, got %#v", checks[0].ID, response.PreviousChange)
+	}
+	if response.NextChange == nil || response.NextChange.ID != int64(checks[4].ID) {
+		t.Fatalf("expected next change %d, got %#v", checks[4].ID, response.NextChange)
+	}
+
+	req = httptest.NewRequest(
+		http.MethodGet,
+		fmt.Sprintf("/v1/monitors/%d/checks/%d/neighbors", row.ID, checks[4].ID),
+		nil,
+	)
+	recorder = httptest.NewRecorder()
+	mux.ServeHTTP(recorder, req)
+
+	var latest monitorCheckNeighborsResponse
+	if err := json.NewDecoder(recorder.Body).Decode(&latest); err != nil {
+		t.Fatalf("expected JSON response, got %v", err)
+	}
+	if latest.NextChange != nil {
+		t.Fatalf("expected no next change after the latest check, got %#v", latest.NextChange)
+	}
+	if latest.Check.HasBody {
+		t.Fatal("expected check without stored body to report hasBody=false")
+	}
+}
+
+func TestHandleRunMonitorReturnsCheck(t *testing.T) {
+	target := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"status":"up"}`))
+	}))
+	defer target.Close()
+
+	client := enttest.Open(t, "sqlite3", "file:monitor-run-now?mode=memory&cache=shared&_fk=1")
+	defer client.Close()
+
+	row, err := client.Monitor.Create().
+		SetURL(target.URL).
+		SetSelector("status").
+		SetCron("*/5 * * * *").
+		Save(t.Context())
+	if err != nil {
+		t.Fatalf("expected monitor to save: %v", err)
+	}
+
+	mux := http.NewServeMux()
+	New(client).RegisterRoutes(mux)
+
+	req := httptest.NewRequest(http.MethodPost, fmt.Sprintf("/v1/monitors/%d/run", row.ID), nil)
+	rec := httptest.NewRecorder()
+	mux.ServeHTTP(rec, req)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected 200, got %d: %s", rec.Code, rec.Body.String())
+	}
+
+	var check monitorCheckResponse
+	if err := json.Unmarshal(rec.Body.Bytes(), &check); err != nil {
+		t.Fatalf("expected check JSON: %v", err)
+	}
+	stored, err := client.CheckResult.Query().Only(t.Context())
+	if err != nil {
+		t.Fatalf("expected one stored check: %v", err)
+	}
+	if check.ID != int64(stored.ID) || check.Status != "ok" {
+		t.Fatalf("expected stored ok check %d, got %+v", stored.ID, check)
+	}
+
+	req = httptest.NewRequest(http.MethodPost, "/v1/monitors/999/run", nil)
+	rec = httptest.NewRecorder()
+	mux.ServeHTTP(rec, req)
+	if rec.Code != http.StatusNotFound {
+		t.Fatalf("expected 404 for missing monitor, got %d", rec.Code)
+	}
+}
+
+func TestHandleListMonitorChecksPagesWithCursor(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:checks-cursor?mode=memory&cache=shared&_fk=1")
+	defer client.Close()
+
+	row, err := client.Monitor.Create().
+		SetURL("https://example.com/rates").
+		SetCron("*/5 * * * *").
+		Save(t.Context())
+	if err != nil {
+		t.Fatalf("expected monitor to save: %v", err)
+	}
+
+	base := time.Date(2026, time.March, 1, 12, 0, 0, 123456789, time.UTC)
+	var want []int64
+	for index, offset := range []time.Duration{0, time.Minute, time.Minute, 2 * time.Minute, 3 * time.Minute} {
+		check := seedMonitorCheck(t, client, row.ID, "number", fmt.Sprint(index), base.Add(offset))
+		want = append([]int64{int64(check.ID)}, want...)
+	}
+
+	mux := http.NewServeMux()
+	New(client).RegisterRoutes(mux)
+
+	var got []int64
+	cursor := ""
+	for page := 0; page < 5; page++ {
+		path := fmt.Sprintf("/v1/monitors/%d/checks?limit=2", row.ID)
+		if cursor != "" {
+			path += "&cursor=" + cursor
+		}
+		recorder := httptest.NewRecorder()
+		mux.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, path, nil))
+		if recorder.Code != http.StatusOK {
+			t.Fatalf("expected 200, got %d: %s", recorder.Code, recorder.Body.String())
+		}
+
+		var checks []monitorCheckResponse
+		if err := json.Unmarshal(recorder.Body.Bytes(), &checks); err != nil {
+			t.Fatalf("expected checks JSON: %v", err)
+		}
+		for _, check := range checks {
+			got = append(got, check.ID)
+		}
+
+		cursor = recorder.Header().Get(nextCursorHeader)
+		if cursor == "" {
+			break
+		}
+	}
+
+	if fmt.Sprint(got) != fmt.Sprint(want) {
+		t.Fatalf("expected checks %v across pages, got %v", want, got)
+	}
+
+	recorder := httptest.NewRecorder()
+	mux.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, fmt.Sprintf("/v1/monitors/%d/checks?cursor=nope", row.ID), nil))
+	if recorder.Code != http.StatusBadRequest {
+		t.Fatalf("expected 400 for invalid cursor, got %d", recorder.Code)
+	}
+}
+
+func TestHandleListMonitorChecksAppliesFilters(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:checks-filters?mode=memory&cache=shared&_fk=1")
+	defer client.Close()
+
+	row, err := client.Monitor.Create().
+		SetURL("https://example.com/rates").
+		SetCron("*/5 * * * *").
+		Save(t.Context())
+	if err != nil {
+		t.Fatalf("expected monitor to save: %v", err)
+	}
+
+	base := time.Date(2026, time.March, 1, 12, 0, 0, 0, time.UTC)
+	seed := func(status string, changed bool, durationMs int, offset time.Duration) int64 {
+		check, err := client.CheckResult.Create().
+			SetMonitorID(row.ID).
+			SetStatus(status).
+			SetDiffChanged(changed).
+			SetResponseTimeMs(durationMs).
+			SetCheckedAt(base.Add(offset)).
+			Save(t.Context())
+		if err != nil {
+			t.Fatalf("expected check to save: %v", err)
+		}
+		return int64(check.ID)
+	}
+	slowError := seed("error", false, 900, 0)
+	changed := seed("ok", true, 100, time.Minute)
+	seed("ok", false, 100, 2*time.Minute)
+	lateError := seed("error", false, 50, 3*time.Minute)
+
+	mux := http.NewServeMux()
+	New(client).RegisterRoutes(mux)
+
+	list := func(query string) (int, []int64) {
+		recorder := httptest.NewRecorder()
+		mux.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, fmt.Sprintf("/v1/monitors/%d/checks?%s", row.ID, query), nil))
+		var checks []monitorCheckResponse
+		_ = json.Unmarshal(recorder.Body.Bytes(), &checks)
+		ids := make([]int64, 0, len(checks))
+		for _, check := range checks {
+			ids = append(ids, check.ID)
+		}
+		return recorder.Code, ids
+	}
+
+	cases := []struct {
+		query string
+		want  []int64
+	}{
+		{query: "status=error", want: []int64{lateError, slowError}},
+		{query: "diffChanged=true", want: []int64{changed}},
+		{query: "status=error&minDuration=500", want: []int64{slowError}},
+		{query: "from=2026-03-01T12:01:00Z&to=2026-03-01T12:01:00Z", want: []int64{changed}},
+	}
+	for _, tc := range cases {
+		code, ids := list(tc.query)
+		if code != http.StatusOK || fmt.Sprint(ids) != fmt.Sprint(tc.want) {
+			t.Fatalf("%s: expected %v, got %d %v", tc.query, tc.want, code, ids)
+		}
+	}
+
+	for _, query := range []string{"status=broken", "diffChanged=maybe", "from=yesterday", "minDuration=-1"} {
+		if code, _ := list(query); code != http.StatusBadRequest {
+			t.Fatalf("%s: expected 400, got %d", query, code)
+		}
+	}
+}
+
+func TestHandleDeleteMonitorChecks(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:checks-delete?mode=memory&cache=shared&_fk=1")
+	defer client.Close()
+
+	row, err := client.Monitor.Create().
+		SetURL("https://example.com/page").
+		SetCron("*/5 * * * *").
+		Save(t.Context())
+	if err != nil {
+		t.Fatalf("expected monitor to save: %v", err)
+	}
+	other, err := client.Monitor.Create().
+		SetURL("https://example.com/other").
+		SetCron("*/5 * * * *").
+		Save(t.Context())
+	if err != nil {
+		t.Fatalf("expected monitor to save: %v", err)
+	}
+
+	base := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	checks := make([]*ent.CheckResult, 0, 4)
+	for i := range 4 {
+		checks = append(checks, seedMonitorCheck(t, client, row.ID, "string", "a", base.AddDate(0, 0, i)))
+	}
+	otherCheck := seedMonitorCheck(t, client, other.ID, "string", "a", base.AddDate(0, 0, 1))
+
+	mux := http.NewServeMux()
+	New(client).RegisterRoutes(mux)
+
+	do := func(path string) *httptest.ResponseRecorder {
+		t.Helper()
+		recorder := httptest.NewRecorder()
+		mux.ServeHTTP(recorder, httptest.NewRequest(http.MethodDelete, path, nil))
+		return recorder
+	}
+
+	recorder := do(fmt.Sprintf("/v1/monitors/%d/checks/%d", row.ID, otherCheck.ID))
+	if recorder.Code != http.StatusNotFound {
+		t.Fatalf("expected 404 for another monitor's check, got %d", recorder.Code)
+	}
+	recorder = do(fmt.Sprintf("/v1/monitors/%d/checks/%d", row.ID, checks[0].ID))
+	if recorder.Code != http.StatusNoContent {
+		t.Fatalf("expected 204, got %d: %s", recorder.Code, recorder.Body.String())
+	}
+
+	recorder = do(fmt.Sprintf("/v1/monitors/%d/checks?from=2026-03-02T00:00:00Z&to=2026-03-03T23:59:59Z", row.ID))
+	if recorder.Code != http.StatusOK {
+		t.Fatalf("expected 200, got %d: %s", recorder.Code, recorder.Body.String())
+	}
+	var response deleteMonitorChecksResponse
+	if err := json.NewDecoder(recorder.Body).Decode(&response); err != nil || response.Deleted != 2 {
+		t.Fatalf("expected 2 checks deleted in range, got %#v (%v)", response, err)
+	}
+
+	remaining, err := client.CheckResult.Query().IDs(t.Context())
+	if err != nil || len(remaining) != 2 {
+		t.Fatalf("expected the last check and the other monitor's check to remain, got %v (%v)", remaining, err)
+	}
+
+	if recorder := do(fmt.Sprintf("/v1/monitors/%d/checks?from=yesterday", row.ID)); recorder.Code != http.StatusBadRequest {
+		t.Fatalf("expected 400 for invalid from, got %d", recorder.Code)
+	}
+
+	recorder = do(fmt.Sprintf("/v1/monitors/%d/checks", row.ID))
+	if recorder.Code != http.StatusOK {
+		t.Fatalf("expected 200, got %d: %s", recorder.Code, recorder.Body.String())
+	}
+	if count, err := client.CheckResult.Query().Count(t.Context()); err != nil || count != 1 {
+		t.Fatalf("expected only the other monitor's check to remain, got %d (%v)", count, err)
+	}
+}
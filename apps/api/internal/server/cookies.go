@@ -0,0 +1,194 @@
+package server
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"goanna/apps/api/ent"
+	"goanna/apps/api/ent/monitor"
+	"goanna/apps/api/ent/schema"
+)
+
+const maxMonitorCookies = 50
+
+type monitorCookie struct {
+	Name     string     `json:"name"`
+	Value    string     `json:"value"`
+	Domain   string     `json:"domain"`
+	Path     string     `json:"path"`
+	Expires  *time.Time `json:"expires,omitempty"`
+	Secure   bool       `json:"secure"`
+	HTTPOnly bool       `json:"httpOnly"`
+}
+
+type monitorCookiesRequest struct {
+	Cookies []monitorCookie `json:"cookies"`
+}
+
+type monitorCookiesResponse struct {
+	Cookies []monitorCookie `json:"cookies"`
+}
+
+// handleGetMonitorCookies returns the cookies a monitor with a cookie jar
+// sends on its next check.
+func (s *Server) handleGetMonitorCookies(w http.ResponseWriter, r *http.Request) {
+	row, ok := s.loadCookieJarMonitor(w, r)
+	if !ok {
+		return
+	}
+
+	writeJSON(w, http.StatusOK, mapMonitorCookies(row.Edges.Runtime.Cookies))
+}
+
+// handleReplaceMonitorCookies seeds a monitor's cookie jar, replacing the
+// cookies kept from previous checks. Cookies without a domain apply to the
+// monitor URL's host.
+func (s *Server) handleReplaceMonitorCookies(w http.ResponseWriter, r *http.Request) {
+	row, ok := s.loadCookieJarMonitor(w, r)
+	if !ok {
+		return
+	}
+
+	var req monitorCookiesRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid JSON body")
+		return
+	}
+
+	defaultDomain := ""
+	if parsed, err := url.Parse(row.URL); err == nil {
+		defaultDomain = parsed.Hostname()
+	}
+	cookies, err := normalizeMonitorCookies(req.Cookies, defaultDomain)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	runtime, err := s.db.MonitorRuntime.UpdateOneID(row.Edges.Runtime.ID).
+		SetCookies(cookies).
+		Save(r.Context())
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "failed to save monitor cookies")
+		return
+	}
+
+	writeJSON(w, http.StatusOK, mapMonitorCookies(runtime.Cookies))
+}
+
+func (s *Server) handleClearMonitorCookies(w http.ResponseWriter, r *http.Request) {
+	row, ok := s.loadCookieJarMonitor(w, r)
+	if !ok {
+		return
+	}
+
+	if _, err := s.db.MonitorRuntime.UpdateOneID(row.Edges.Runtime.ID).
+		ClearCookies().
+		Save(r.Context()); err != nil {
+		writeError(w, http.StatusInternalServerError, "failed to clear monitor cookies")
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) loadCookieJarMonitor(w http.ResponseWriter, r *http.Request) (*ent.Monitor, bool) {
+	monitorID, err := parseMonitorID(r.PathValue("monitorId"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "monitorId must be a positive integer")
+		return nil, false
+	}
+
+	row, err := s.db.Monitor.Query().
+		Where(monitor.IDEQ(monitorID)).
+		WithRuntime().
+		Only(r.Context())
+	if err != nil {
+		if ent.IsNotFound(err) {
+			writeError(w, http.StatusNotFound, "monitor not found")
+			return nil, false
+		}
+		writeError(w, http.StatusInternalServerError, "failed to load monitor")
+		return nil, false
+	}
+	if !row.CookieJar {
+		writeError(w, http.StatusConflict, "monitor does not have cookieJar enabled")
+		return nil, false
+	}
+	if row.Edges.Runtime == nil {
+		writeError(w, http.StatusConflict, "monitor has not been initialized")
+		return nil, false
+	}
+
+	return row, true
+}
+
+func normalizeMonitorCookies(raw []monitorCookie, defaultDomain string) ([]schema.StoredCookie, error) {
+	if len(raw) > maxMonitorCookies {
+		return nil, fmt.Errorf("at most %d cookies are allowed", maxMonitorCookies)
+	}
+
+	cookies := make([]schema.StoredCookie, 0, len(raw))
+	for _, cookie := range raw {
+		name := strings.TrimSpace(cookie.Name)
+		if name == "" || strings.ContainsAny(name, "=;, \t\r\n") {
+			return nil, fmt.Errorf("invalid cookie name %q", cookie.Name)
+		}
+		if strings.ContainsAny(cookie.Value, ";\r\n") {
+			return nil, fmt.Errorf("cookie %q has an invalid value", name)
+		}
+
+		domain := strings.TrimPrefix(strings.ToLower(strings.TrimSpace(cookie.Domain)), ".")
+		if domain == "" {
+			domain = strings.ToLower(defaultDomain)
+		}
+		if domain == "" || strings.ContainsAny(domain, "/: ") {
+			return nil, fmt.Errorf("cookie %q needs a valid domain", name)
+		}
+
+		path := strings.TrimSpace(cookie.Path)
+		if path == "" {
+			path = "/"
+		}
+		if !strings.HasPrefix(path, "/") {
+			return nil, errors.New("cookie path must start with /")
+		}
+
+		stored := schema.StoredCookie{
+			Name:     name,
+			Value:    cookie.Value,
+			Domain:   domain,
+			Path:     path,
+			Secure:   cookie.Secure,
+			HTTPOnly: cookie.HTTPOnly,
+		}
+		if cookie.Expires != nil {
+			expires := cookie.Expires.UTC()
+			stored.Expires = &expires
+		}
+		cookies = append(cookies, stored)
+	}
+
+	return cookies, nil
+}
+
+func mapMonitorCookies(stored []schema.StoredCookie) monitorCookiesResponse {
+	cookies := make([]monitorCookie, 0, len(stored))
+	for _, cookie := range stored {
+		cookies = append(cookies, monitorCookie{
+			Name:     cookie.Name,
+			Value:    cookie.Value,
+			Domain:   cookie.Domain,
+			Path:     cookie.Path,
+			Expires:  cookie.Expires,
+			Secure:   cookie.Secure,
+			HTTPOnly: cookie.HTTPOnly,
+		})
+	}
+	return monitorCookiesResponse{Cookies: cookies}
+}
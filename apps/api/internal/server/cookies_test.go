@@ -0,0 +1,85 @@
+package server
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"goanna/apps/api/ent/enttest"
+	"goanna/apps/api/ent/monitorruntime"
+
+	_ "github.com/mattn/go-sqlite3"
+)
+
+func TestMonitorCookiesLifecycle(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:monitor-cookies?mode=memory&cache=shared&_fk=1")
+	defer client.Close()
+
+	mux := http.NewServeMux()
+	New(client).RegisterRoutes(mux)
+
+	send := func(method string, path string, body string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(method, path, strings.NewReader(body))
+		rec := httptest.NewRecorder()
+		mux.ServeHTTP(rec, req)
+		return rec
+	}
+
+	row, err := client.Monitor.Create().
+		SetURL("https://app.example.com/dashboard").
+		SetCron("*/5 * * * *").
+		Save(t.Context())
+	if err != nil {
+		t.Fatalf("expected monitor to save: %v", err)
+	}
+	if _, err := client.MonitorRuntime.Create().
+		SetMonitor(row).
+		SetStatus(monitorruntime.StatusOk).
+		Save(t.Context()); err != nil {
+		t.Fatalf("expected runtime to save: %v", err)
+	}
+
+	cookiesPath := fmt.Sprintf("/v1/monitors/%d/cookies", row.ID)
+	if rec := send(http.MethodGet, cookiesPath, ""); rec.Code != http.StatusConflict {
+		t.Fatalf("expected 409 without cookieJar, got %d", rec.Code)
+	}
+
+	if _, err := row.Update().SetCookieJar(true).Save(t.Context()); err != nil {
+		t.Fatalf("expected monitor to update: %v", err)
+	}
+
+	if rec := send(http.MethodPut, cookiesPath, `{"cookies":[{"name":"bad name","value":"x"}]}`); rec.Code != http.StatusBadRequest {
+		t.Fatalf("expected 400 for invalid cookie name, got %d", rec.Code)
+	}
+
+	rec := send(http.MethodPut, cookiesPath, `{"cookies":[{"name":"session","value":"abc","secure":true}]}`)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected 200, got %d: %s", rec.Code, rec.Body.String())
+	}
+
+	rec = send(http.MethodGet, cookiesPath, "")
+	var response monitorCookiesResponse
+	if err := json.Unmarshal(rec.Body.Bytes(), &response); err != nil {
+		t.Fatalf("expected cookies JSON: %v", err)
+	}
+	if len(response.Cookies) != 1 {
+		t.Fatalf("expected one cookie, got %+v", response.Cookies)
+	}
+	if cookie := response.Cookies[0]; cookie.Domain != "app.example.com" || cookie.Path != "/" || !cookie.Secure {
+		t.Fatalf("expected cookie to default to the monitor host, got %+v", cookie)
+	}
+
+	if rec := send(http.MethodDelete, cookiesPath, ""); rec.Code != http.StatusNoContent {
+		t.Fatalf("expected 204, got %d", rec.Code)
+	}
+	runtime, err := client.MonitorRuntime.Query().Only(t.Context())
+	if err != nil {
+		t.Fatalf("expected runtime to load: %v", err)
+	}
+	if len(runtime.Cookies) != 0 {
+		t.Fatalf("expected cookies to be cleared, got %+v", runtime.Cookies)
+	}
+}
@@ -0,0 +1,99 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	selectorutil "goanna/apps/api/internal/selector"
+	"goanna/apps/api/internal/worker"
+)
+
+type diffPreviewRequest struct {
+	PreviousJSON  string  `json:"previousJson"`
+	PreviousToken *string `json:"previousToken"`
+	CurrentJSON   string  `json:"currentJson"`
+	CurrentToken  *string `json:"currentToken"`
+	Selector      *string `json:"selector"`
+}
+
+type diffPreviewResponse struct {
+	Kind    string         `json:"kind"`
+	Changed bool           `json:"changed"`
+	Summary string         `json:"summary"`
+	Details map[string]any `json:"details"`
+}
+
+// handlePreviewDiff applies a selector to two payloads and reports the diff a
+// JSON monitor would record going from the first to the second, so the
+// notification for a change can be checked before the change happens.
+func (s *Server) handlePreviewDiff(w http.ResponseWriter, r *http.Request) {
+	var req diffPreviewRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid JSON body")
+		return
+	}
+
+	selectorPath := ""
+	if req.Selector != nil {
+		selectorPath = strings.TrimSpace(*req.Selector)
+	}
+
+	previous, err := s.selectDiffPreviewPayload(r.Context(), "previous", req.PreviousJSON, req.PreviousToken, selectorPath)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	current, err := s.selectDiffPreviewPayload(r.Context(), "current", req.CurrentJSON, req.CurrentToken, selectorPath)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	diff := worker.DiffSelections(previous, current)
+	if diff == nil {
+		writeError(w, http.StatusBadRequest, "selector matches nothing in the current payload")
+		return
+	}
+
+	details := diff.Details
+	if details == nil {
+		details = map[string]any{}
+	}
+
+	writeJSON(w, http.StatusOK, diffPreviewResponse{
+		Kind:    diff.Kind,
+		Changed: diff.Changed,
+		Summary: diff.Summary,
+		Details: details,
+	})
+}
+
+// selectDiffPreviewPayload evaluates selectorPath against a payload given
+// inline or as a token from a monitor test. name prefixes the request fields
+// in errors.
+func (s *Server) selectDiffPreviewPayload(ctx context.Context, name string, value string, token *string, selectorPath string) (selectorutil.Selection, error) {
+	var payload []byte
+	if token := normalizeOptionalString(token); token != nil {
+		cachedPayload, ok := s.loadSelectorPayload(ctx, *token)
+		if !ok {
+			return selectorutil.Selection{}, fmt.Errorf("%s payload unavailable, run test again or increase GOANNA_MAX_RESPONSE_BODY_BYTES", name)
+		}
+		payload = cachedPayload
+	} else {
+		value = strings.TrimSpace(value)
+		if value == "" {
+			return selectorutil.Selection{}, errors.New(name + "Json or " + name + "Token is required")
+		}
+		payload = []byte(value)
+	}
+
+	selection, err := selectorutil.SelectJSON(payload, selectorPath)
+	if err != nil {
+		return selectorutil.Selection{}, errors.New(name + "Json must be valid JSON")
+	}
+	return selection, nil
+}
@@ -0,0 +1,66 @@
+package server
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"goanna/apps/api/ent/enttest"
+
+	_ "github.com/mattn/go-sqlite3"
+)
+
+func TestHandlePreviewDiffReportsSelectionChange(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:diff-preview?mode=memory&cache=shared&_fk=1")
+	defer client.Close()
+
+	server := New(client)
+	token := server.storeSelectorPayload(t.Context(), []byte(`{"data":{"price":12}}`), DefaultMaxSelectorPayloadBytes)
+
+	body := `{"previousJson":"{\"data\":{\"price\":10}}","currentToken":"` + token + `","selector":"data.price"}`
+	req := httptest.NewRequest(http.MethodPost, "/v1/diff/preview", strings.NewReader(body))
+	recorder := httptest.NewRecorder()
+	server.handlePreviewDiff(recorder, req)
+
+	if recorder.Code != http.StatusOK {
+		t.Fatalf("expected status 200, got %d: %s", recorder.Code, recorder.Body.String())
+	}
+
+	var response diffPreviewResponse
+	if err := json.Unmarshal(recorder.Body.Bytes(), &response); err != nil {
+		t.Fatalf("expected JSON response: %v", err)
+	}
+	if response.Kind != "number" || !response.Changed {
+		t.Fatalf("expected a changed number diff, got %+v", response)
+	}
+	if response.Details["old"] != 10.0 || response.Details["new"] != 12.0 || response.Details["delta"] != 2.0 {
+		t.Fatalf("expected old and new values in details, got %#v", response.Details)
+	}
+}
+
+func TestHandlePreviewDiffRejectsInvalidPayloads(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:diff-preview-invalid?mode=memory&cache=shared&_fk=1")
+	defer client.Close()
+
+	server := New(client)
+
+	cases := map[string]string{
+		"missing previous":   `{"currentJson":"{}"}`,
+		"invalid current":    `{"previousJson":"{}","currentJson":"{"}`,
+		"expired token":      `{"previousToken":"missing","currentJson":"{}"}`,
+		"selector not found": `{"previousJson":"{\"a\":1}","currentJson":"{}","selector":"a"}`,
+	}
+	for name, body := range cases {
+		t.Run(name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, "/v1/diff/preview", strings.NewReader(body))
+			recorder := httptest.NewRecorder()
+			server.handlePreviewDiff(recorder, req)
+
+			if recorder.Code != http.StatusBadRequest {
+				t.Fatalf("expected status 400, got %d: %s", recorder.Code, recorder.Body.String())
+			}
+		})
+	}
+}
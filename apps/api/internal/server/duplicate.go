@@ -0,0 +1,118 @@
+package server
+
+import (
+	"net/http"
+	"time"
+
+	"goanna/apps/api/ent"
+)
+
+const duplicateLabelSuffix = " (copy)"
+
+// handleDuplicateMonitor copies a monitor's configuration into a new,
+// disabled monitor without its checks or runtime state. Heartbeat monitors
+// get a fresh ping token.
+func (s *Server) handleDuplicateMonitor(w http.ResponseWriter, r *http.Request) {
+	monitorID, err := parseMonitorID(r.PathValue("monitorId"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "monitorId must be a positive integer")
+		return
+	}
+
+	row, err := s.db.Monitor.Get(r.Context(), monitorID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			writeError(w, http.StatusNotFound, "monitor not found")
+			return
+		}
+		writeError(w, http.StatusInternalServerError, "failed to load monitor")
+		return
+	}
+
+	config, err := s.ensureGlobalSystemConfig(r.Context())
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "failed to load runtime settings")
+		return
+	}
+
+	created, runtime, err := createMonitorWithRuntime(
+		r.Context(),
+		s.db,
+		duplicateMonitorInput(row),
+		time.Now().UTC(),
+		runtimeCronLocation(config.Timezone),
+	)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	s.scheduleChanges.Publish()
+
+	channelStates := s.loadNotificationChannelStates(r.Context())
+	mapped := s.mapMonitor(
+		created,
+		runtime,
+		buildMonitorNotificationIssues(created.NotificationChannels, channelStates),
+	)
+	s.publishMonitorUpdated(created.ID, monitorCreated, &mapped)
+
+	writeJSON(w, http.StatusCreated, mapped)
+}
+
+// duplicateMonitorInput returns row's stored configuration as a disabled
+// monitor labelled as a copy, kept off the status page.
+func duplicateMonitorInput(row *ent.Monitor) normalizedMonitorRequest {
+	label := row.URL
+	if row.Label != nil {
+		label = *row.Label
+	}
+	label += duplicateLabelSuffix
+
+	return normalizedMonitorRequest{
+		label:                  &label,
+		method:                 row.Method,
+		url:                    row.URL,
+		iconURL:                resolveMonitorIconURL(row),
+		body:                   row.Body,
+		headers:                row.Headers,
+		userAgent:              row.UserAgent,
+		headerProfileID:        row.HeaderProfileID,
+		auth:                   row.Auth,
+		notificationChannels:   row.NotificationChannels,
+		escalationChannels:     row.EscalationChannels,
+		escalationAfterMinutes: row.EscalationAfterMinutes,
+		tags:                   row.Tags,
+		selector:               row.Selector,
+		expectedStatus:         row.ExpectedStatus,
+		retryOn:                row.RetryOn,
+		expectedType:           row.ExpectedType.String(),
+		expectedResponse:       row.ExpectedResponse,
+		mustContain:            row.MustContain,
+		mustNotContain:         row.MustNotContain,
+		treatNotFoundAsSuccess: row.TreatNotFoundAsSuccess,
+		acceptEmptyBody:        row.AcceptEmptyBody,
+		watchdogMinutes:        row.WatchdogMinutes,
+		headerAssertions:       row.HeaderAssertions,
+		trackHeader:            row.TrackHeader,
+		numericTolerance:       row.NumericTolerance,
+		hostOverrides:          row.HostOverrides,
+		ipFamily:               row.IPFamily.String(),
+		redirectPolicy:         row.RedirectPolicy.String(),
+		maxRedirects:           row.MaxRedirects,
+		maxResponseBytes:       row.MaxResponseBytes,
+		checksHistoryLimit:     row.ChecksHistoryLimit,
+		cookieJar:              row.CookieJar,
+		tlsCAPEM:               row.TLSCaPem,
+		tlsInsecureSkipVerify:  row.TLSInsecureSkipVerify,
+		tlsMinVersion:          row.TLSMinVersion,
+		tlsServerName:          row.TLSServerName,
+		cronExpr:               row.Cron,
+		timezone:               row.Timezone,
+		jitterSeconds:          row.JitterSeconds,
+		dstPolicy:              row.DstPolicy.String(),
+		bodySnapshot:           row.BodySnapshot.String(),
+		contentHash:            row.ContentHash.String(),
+		fetchMode:              row.FetchMode.String(),
+		enabled:                false,
+	}
+}
@@ -0,0 +1,68 @@
+package server
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"goanna/apps/api/ent/enttest"
+	"goanna/apps/api/ent/monitor"
+
+	_ "github.com/mattn/go-sqlite3"
+)
+
+func TestHandleDuplicateMonitorCopiesConfiguration(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:monitor-duplicate?mode=memory&cache=shared&_fk=1")
+	defer client.Close()
+
+	row, err := client.Monitor.Create().
+		SetLabel("Shop prices").
+		SetURL("https://staging.example.com/prices").
+		SetCron("*/5 * * * *").
+		SetSelector("price").
+		SetHeaders(map[string]string{"X-Env": "staging"}).
+		SetTags([]string{"shop"}).
+		SetRedirectPolicy(monitor.RedirectPolicyRecord).
+		SetChecksHistoryLimit(5000).
+		Save(t.Context())
+	if err != nil {
+		t.Fatalf("expected monitor to save: %v", err)
+	}
+	seedMonitorCheck(t, client, row.ID, "number", "10", time.Now().UTC())
+
+	mux := http.NewServeMux()
+	New(client).RegisterRoutes(mux)
+
+	rec := httptest.NewRecorder()
+	mux.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, fmt.Sprintf("/v1/monitors/%d/duplicate", row.ID), nil))
+	if rec.Code != http.StatusCreated {
+		t.Fatalf("expected 201, got %d: %s", rec.Code, rec.Body.String())
+	}
+
+	var copied monitorResponse
+	if err := json.Unmarshal(rec.Body.Bytes(), &copied); err != nil {
+		t.Fatalf("expected monitor JSON: %v", err)
+	}
+	if copied.ID == int64(row.ID) || copied.Label == nil || *copied.Label != "Shop prices (copy)" || copied.Enabled {
+		t.Fatalf("expected a disabled copy, got %+v", copied)
+	}
+	if copied.URL != row.URL || copied.Selector == nil || *copied.Selector != "price" ||
+		copied.Headers["X-Env"] != "staging" || copied.RedirectPolicy != "record" || len(copied.Tags) != 1 ||
+		copied.ChecksHistoryLimit == nil || *copied.ChecksHistoryLimit != 5000 {
+		t.Fatalf("expected configuration to be copied, got %+v", copied)
+	}
+
+	checks, err := client.CheckResult.Query().Count(t.Context())
+	if err != nil || checks != 1 {
+		t.Fatalf("expected history to stay with the original, got %d checks (%v)", checks, err)
+	}
+
+	rec = httptest.NewRecorder()
+	mux.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/v1/monitors/999/duplicate", nil))
+	if rec.Code != http.StatusNotFound {
+		t.Fatalf("expected 404, got %d", rec.Code)
+	}
+}
@@ -0,0 +1,88 @@
+package server
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"goanna/apps/api/ent/enttest"
+	"goanna/apps/api/ent/monitorruntime"
+
+	_ "github.com/mattn/go-sqlite3"
+)
+
+func TestNormalizeEscalationPolicy(t *testing.T) {
+	afterMinutes := 10
+	channels, normalizedAfter, err := normalizeEscalationPolicy([]string{" Telegram "}, &afterMinutes)
+	if err != nil {
+		t.Fatalf("expected policy to normalize: %v", err)
+	}
+	if len(channels) != 1 || channels[0] != "telegram" || normalizedAfter == nil || *normalizedAfter != 10 {
+		t.Fatalf("unexpected policy channels=%#v after=%v", channels, normalizedAfter)
+	}
+
+	if _, _, err := normalizeEscalationPolicy([]string{"telegram"}, nil); err == nil {
+		t.Fatal("expected channels without escalationAfterMinutes to fail")
+	}
+	if _, _, err := normalizeEscalationPolicy(nil, &afterMinutes); err == nil {
+		t.Fatal("expected escalationAfterMinutes without channels to fail")
+	}
+
+	zero := 0
+	if _, _, err := normalizeEscalationPolicy([]string{"telegram"}, &zero); err == nil {
+		t.Fatal("expected non-positive escalationAfterMinutes to fail")
+	}
+}
+
+func TestHandleAcknowledgeMonitor(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:monitor-acknowledge?mode=memory&cache=shared&_fk=1")
+	defer client.Close()
+
+	row, err := client.Monitor.Create().
+		SetURL("https://example.com/status").
+		SetCron("*/5 * * * *").
+		Save(t.Context())
+	if err != nil {
+		t.Fatalf("expected monitor to save: %v", err)
+	}
+	runtime, err := client.MonitorRuntime.Create().
+		SetMonitor(row).
+		SetStatus(monitorruntime.StatusOk).
+		Save(t.Context())
+	if err != nil {
+		t.Fatalf("expected runtime to save: %v", err)
+	}
+
+	mux := http.NewServeMux()
+	New(client).RegisterRoutes(mux)
+	path := fmt.Sprintf("/v1/monitors/%d/acknowledge", row.ID)
+
+	recorder := httptest.NewRecorder()
+	mux.ServeHTTP(recorder, httptest.NewRequest(http.MethodPost, path, nil))
+	if recorder.Code != http.StatusConflict {
+		t.Fatalf("expected status 409 for healthy monitor, got %d", recorder.Code)
+	}
+
+	if _, err := client.MonitorRuntime.UpdateOneID(runtime.ID).
+		SetStatus(monitorruntime.StatusError).
+		SetFailingSince(time.Now().UTC().Add(-time.Hour)).
+		Save(t.Context()); err != nil {
+		t.Fatalf("expected runtime to update: %v", err)
+	}
+
+	recorder = httptest.NewRecorder()
+	mux.ServeHTTP(recorder, httptest.NewRequest(http.MethodPost, path, nil))
+	if recorder.Code != http.StatusOK {
+		t.Fatalf("expected status 200, got %d: %s", recorder.Code, recorder.Body.String())
+	}
+
+	updated, err := client.MonitorRuntime.Get(t.Context(), runtime.ID)
+	if err != nil {
+		t.Fatalf("expected runtime to load: %v", err)
+	}
+	if updated.AcknowledgedAt == nil {
+		t.Fatal("expected acknowledgedAt to be set")
+	}
+}
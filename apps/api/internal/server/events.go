@@ -0,0 +1,191 @@
+package server
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"goanna/apps/api/ent"
+	"goanna/apps/api/internal/worker"
+
+	"golang.org/x/net/websocket"
+)
+
+const (
+	eventsBufferSize = 64
+	// maxWebSocketMessageBytes bounds the subscription messages a client may
+	// send.
+	maxWebSocketMessageBytes = 64 * 1024
+)
+
+// Monitor event actions sent in monitor.updated events.
+const (
+	monitorCreated  = "created"
+	monitorUpdated  = "updated"
+	monitorDeleted  = "deleted"
+	monitorArchived = "archived"
+	monitorRestored = "restored"
+)
+
+type monitorUpdatedData struct {
+	Action  string           `json:"action"`
+	Monitor *monitorResponse `json:"monitor,omitempty"`
+}
+
+// webSocketMessage is sent by clients to choose the monitors they receive
+// events for. An empty monitorIds list subscribes to every monitor.
+type webSocketMessage struct {
+	Type       string `json:"type"`
+	MonitorIDs []int  `json:"monitorIds"`
+}
+
+type webSocketSubscribedResponse struct {
+	Type       string `json:"type"`
+	MonitorIDs []int  `json:"monitorIds"`
+}
+
+type webSocketErrorResponse struct {
+	Type  string `json:"type"`
+	Error string `json:"error"`
+}
+
+// publishMonitorUpdated tells live clients a monitor was created, changed or
+// deleted. mapped is the monitor as the API now returns it, nil on delete.
+func (s *Server) publishMonitorUpdated(monitorID int, action string, mapped *monitorResponse) {
+	s.events.Publish(worker.Event{
+		Type:      worker.EventMonitorUpdated,
+		MonitorID: monitorID,
+		At:        time.Now().UTC(),
+		Data:      monitorUpdatedData{Action: action, Monitor: mapped},
+	})
+}
+
+// handleWebSocket streams check.completed, monitor.updated and
+// notification.sent events. Clients start subscribed to the monitors named
+// by repeated monitorId query parameters, or to all monitors, and send
+// {"type":"subscribe","monitorIds":[...]} to change that. Streams opened with
+// a session end when it expires or is revoked.
+func (s *Server) handleWebSocket(w http.ResponseWriter, r *http.Request) {
+	monitorIDs, err := parseWebSocketMonitorIDs(r.URL.Query()["monitorId"])
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	// signedIn stays nil while the API is open to everyone.
+	_, signedIn, _ := s.sessionUser(r)
+
+	server := websocket.Server{
+		// Browsers on any origin may connect, matching the API's CORS policy;
+		// access is controlled by the session instead.
+		Handshake: func(*websocket.Config, *http.Request) error { return nil },
+		Handler: func(conn *websocket.Conn) {
+			s.streamEvents(conn, monitorIDs, signedIn)
+		},
+	}
+	server.ServeHTTP(w, r)
+}
+
+func (s *Server) streamEvents(conn *websocket.Conn, monitorIDs []int, signedIn *ent.Session) {
+	defer conn.Close()
+	conn.MaxPayloadBytes = maxWebSocketMessageBytes
+
+	// Sessions are checked again before every message, so events stop as
+	// soon as the session is revoked, and the timer closes idle streams
+	// once it expires.
+	var expired <-chan time.Time
+	if signedIn != nil {
+		timer := time.NewTimer(time.Until(signedIn.ExpiresAt))
+		defer timer.Stop()
+		expired = timer.C
+	}
+	send := func(message any) bool {
+		if signedIn != nil && !s.sessionActive(conn.Request().Context(), signedIn.ID) {
+			return false
+		}
+		return websocket.JSON.Send(conn, message) == nil
+	}
+
+	events, unsubscribe := s.events.Subscribe(eventsBufferSize)
+	defer unsubscribe()
+
+	subscriptions := make(chan []int)
+	closed := make(chan struct{})
+	go func() {
+		defer close(closed)
+		for {
+			var message webSocketMessage
+			if err := websocket.JSON.Receive(conn, &message); err != nil {
+				var syntaxErr *json.SyntaxError
+				if errors.As(err, &syntaxErr) {
+					_ = websocket.JSON.Send(conn, webSocketErrorResponse{Type: "error", Error: "messages must be JSON"})
+					continue
+				}
+				return
+			}
+			if message.Type != "subscribe" {
+				_ = websocket.JSON.Send(conn, webSocketErrorResponse{Type: "error", Error: "type must be subscribe"})
+				continue
+			}
+
+			select {
+			case subscriptions <- normalizeWebSocketMonitorIDs(message.MonitorIDs):
+			case <-conn.Request().Context().Done():
+				return
+			}
+		}
+	}()
+
+	if !send(webSocketSubscribedResponse{Type: "subscribed", MonitorIDs: monitorIDs}) {
+		return
+	}
+
+	for {
+		select {
+		case <-closed:
+			return
+		case <-expired:
+			return
+		case monitorIDs = <-subscriptions:
+			if !send(webSocketSubscribedResponse{Type: "subscribed", MonitorIDs: monitorIDs}) {
+				return
+			}
+		case event := <-events:
+			if len(monitorIDs) > 0 && !slices.Contains(monitorIDs, event.MonitorID) {
+				continue
+			}
+			if !send(event) {
+				return
+			}
+		}
+	}
+}
+
+func parseWebSocketMonitorIDs(raw []string) ([]int, error) {
+	monitorIDs := make([]int, 0, len(raw))
+	for _, value := range raw {
+		for _, part := range strings.Split(value, ",") {
+			monitorID, err := strconv.Atoi(strings.TrimSpace(part))
+			if err != nil || monitorID <= 0 {
+				return nil, fmt.Errorf("monitorId must be a positive integer, got %q", part)
+			}
+			monitorIDs = append(monitorIDs, monitorID)
+		}
+	}
+	return normalizeWebSocketMonitorIDs(monitorIDs), nil
+}
+
+func normalizeWebSocketMonitorIDs(monitorIDs []int) []int {
+	normalized := make([]int, 0, len(monitorIDs))
+	for _, monitorID := range monitorIDs {
+		if monitorID > 0 {
+			normalized = append(normalized, monitorID)
+		}
+	}
+	slices.Sort(normalized)
+	return slices.Compact(normalized)
+}
@@ -0,0 +1,198 @@
+package server
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"goanna/apps/api/ent/enttest"
+	"goanna/apps/api/ent/session"
+	"goanna/apps/api/ent/user"
+	"goanna/apps/api/internal/worker"
+
+	_ "github.com/mattn/go-sqlite3"
+	"golang.org/x/net/websocket"
+)
+
+type receivedEvent struct {
+	Type       string          `json:"type"`
+	MonitorID  int             `json:"monitorId"`
+	MonitorIDs []int           `json:"monitorIds"`
+	Data       json.RawMessage `json:"data"`
+}
+
+func TestWebSocketStreamsSubscribedMonitorEvents(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:events-websocket?mode=memory&cache=shared&_fk=1")
+	defer client.Close()
+
+	target := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"price":10}`))
+	}))
+	defer target.Close()
+
+	mux := http.NewServeMux()
+	NewWithConfig(client, Config{Worker: worker.Config{Events: worker.NewEvents()}}).RegisterRoutes(mux)
+	api := httptest.NewServer(mux)
+	defer api.Close()
+
+	conn, err := websocket.Dial("ws"+strings.TrimPrefix(api.URL, "http")+"/v1/ws", "", api.URL)
+	if err != nil {
+		t.Fatalf("expected websocket to connect: %v", err)
+	}
+	defer conn.Close()
+
+	receive := func() receivedEvent {
+		t.Helper()
+
+		_ = conn.SetReadDeadline(time.Now().Add(5 * time.Second))
+		var event receivedEvent
+		if err := websocket.JSON.Receive(conn, &event); err != nil {
+			t.Fatalf("expected an event: %v", err)
+		}
+		return event
+	}
+	post := func(path string, body string) *http.Response {
+		t.Helper()
+
+		response, err := http.Post(api.URL+path, "application/json", strings.NewReader(body))
+		if err != nil {
+			t.Fatalf("expected POST %s to succeed: %v", path, err)
+		}
+		defer response.Body.Close()
+		return response
+	}
+
+	if event := receive(); event.Type != "subscribed" || len(event.MonitorIDs) != 0 {
+		t.Fatalf("expected to start subscribed to every monitor, got %+v", event)
+	}
+
+	post("/v1/monitors", fmt.Sprintf(`{"url":%q,"cron":"0 * * * *","selector":"price"}`, target.URL))
+	created := receive()
+	if created.Type != worker.EventMonitorUpdated || created.MonitorID == 0 || !strings.Contains(string(created.Data), `"action":"created"`) {
+		t.Fatalf("expected a monitor.updated event, got %+v (%s)", created, created.Data)
+	}
+
+	if err := websocket.JSON.Send(conn, webSocketMessage{Type: "subscribe", MonitorIDs: []int{created.MonitorID + 1}}); err != nil {
+		t.Fatalf("expected subscribe to send: %v", err)
+	}
+	if event := receive(); event.Type != "subscribed" || len(event.MonitorIDs) != 1 {
+		t.Fatalf("expected the subscription to be acknowledged, got %+v", event)
+	}
+	post(fmt.Sprintf("/v1/monitors/%d/trigger", created.MonitorID), "")
+
+	if err := websocket.JSON.Send(conn, webSocketMessage{Type: "subscribe", MonitorIDs: []int{created.MonitorID}}); err != nil {
+		t.Fatalf("expected subscribe to send: %v", err)
+	}
+	if event := receive(); event.Type != "subscribed" {
+		t.Fatalf("expected events for other monitors to be filtered out, got %+v", event)
+	}
+	post(fmt.Sprintf("/v1/monitors/%d/trigger", created.MonitorID), "")
+
+	completed := receive()
+	if completed.Type != worker.EventCheckCompleted || completed.MonitorID != created.MonitorID {
+		t.Fatalf("expected a check.completed event, got %+v", completed)
+	}
+	var data worker.CheckCompletedData
+	if err := json.Unmarshal(completed.Data, &data); err != nil || data.CheckID == 0 || data.Status != "ok" || data.MonitorStatus != "ok" {
+		t.Fatalf("expected the check to be described, got %s (%v)", completed.Data, err)
+	}
+}
+
+func TestWebSocketSignsInWithTicketAndEndsWithSession(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:events-websocket-ticket?mode=memory&cache=shared&_fk=1")
+	defer client.Close()
+
+	passwordHash, err := hashPassword("password1")
+	if err != nil {
+		t.Fatalf("expected password to hash: %v", err)
+	}
+	if _, err := client.User.Create().
+		SetUsername("admin").
+		SetPasswordHash(passwordHash).
+		SetRole(user.RoleAdmin).
+		Save(t.Context()); err != nil {
+		t.Fatalf("expected user to save: %v", err)
+	}
+
+	mux := http.NewServeMux()
+	NewWithConfig(client, Config{Worker: worker.Config{Events: worker.NewEvents()}}).RegisterRoutes(mux)
+	api := httptest.NewServer(mux)
+	defer api.Close()
+
+	post := func(path string, token string, body string) *http.Response {
+		t.Helper()
+
+		req, _ := http.NewRequest(http.MethodPost, api.URL+path, strings.NewReader(body))
+		if token != "" {
+			req.Header.Set("Authorization", "Bearer "+token)
+		}
+		response, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatalf("expected POST %s to succeed: %v", path, err)
+		}
+		return response
+	}
+	login := func() string {
+		t.Helper()
+
+		response := post("/v1/auth/login", "", `{"username":"admin","password":"password1"}`)
+		defer response.Body.Close()
+		var body loginResponse
+		if err := json.NewDecoder(response.Body).Decode(&body); err != nil || body.Token == "" {
+			t.Fatalf("expected to sign in, got %d", response.StatusCode)
+		}
+		return body.Token
+	}
+	dial := func(query string) (*websocket.Conn, error) {
+		return websocket.Dial("ws"+strings.TrimPrefix(api.URL, "http")+"/v1/ws"+query, "", api.URL)
+	}
+
+	streamToken := login()
+	adminToken := login()
+
+	response := post("/v1/ws/ticket", streamToken, "")
+	var ticket webSocketTicketResponse
+	if err := json.NewDecoder(response.Body).Decode(&ticket); err != nil || response.StatusCode != http.StatusCreated || ticket.Ticket == "" {
+		t.Fatalf("expected a ticket, got %d", response.StatusCode)
+	}
+	response.Body.Close()
+
+	if _, err := dial("?token=" + streamToken); err == nil {
+		t.Fatal("expected session tokens in the URL to be rejected")
+	}
+	if _, err := dial("?ticket=" + ticket.Ticket + "x"); err == nil {
+		t.Fatal("expected a tampered ticket to be rejected")
+	}
+	sessionRow, err := client.Session.Query().Where(session.TokenHashEQ(hashSessionToken(streamToken))).Only(t.Context())
+	if err != nil {
+		t.Fatalf("expected the session to load: %v", err)
+	}
+	if _, err := dial("?ticket=" + webSocketTicket(sessionRow, time.Now().Add(-time.Second))); err == nil {
+		t.Fatal("expected an expired ticket to be rejected")
+	}
+
+	conn, err := dial("?ticket=" + ticket.Ticket)
+	if err != nil {
+		t.Fatalf("expected the ticket to open the websocket: %v", err)
+	}
+	defer conn.Close()
+
+	_ = conn.SetReadDeadline(time.Now().Add(5 * time.Second))
+	var event receivedEvent
+	if err := websocket.JSON.Receive(conn, &event); err != nil || event.Type != "subscribed" {
+		t.Fatalf("expected the stream to start, got %+v (%v)", event, err)
+	}
+
+	post("/v1/auth/logout", streamToken, "").Body.Close()
+	post("/v1/monitors", adminToken, `{"url":"https://example.com","cron":"0 * * * *"}`).Body.Close()
+
+	_ = conn.SetReadDeadline(time.Now().Add(5 * time.Second))
+	if err := websocket.JSON.Receive(conn, &event); err == nil {
+		t.Fatalf("expected the stream to close after logout, got %+v", event)
+	}
+}
@@ -0,0 +1,101 @@
+package server
+
+import (
+	"net/http"
+	"strings"
+	"time"
+
+	"goanna/apps/api/ent"
+	"goanna/apps/api/ent/monitor"
+)
+
+const maxExpectChangeWindow = 30 * 24 * time.Hour
+
+// handleExpectMonitorChange pre-authorizes the next change of a monitor: the
+// first diff detected before until is recorded as the new baseline without
+// alerting.
+func (s *Server) handleExpectMonitorChange(w http.ResponseWriter, r *http.Request) {
+	monitorID, err := parseMonitorID(r.PathValue("monitorId"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "monitorId must be a positive integer")
+		return
+	}
+
+	rawUntil := strings.TrimSpace(r.URL.Query().Get("until"))
+	if rawUntil == "" {
+		writeError(w, http.StatusBadRequest, "until is required")
+		return
+	}
+	until, err := time.Parse(time.RFC3339, rawUntil)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "until must be an RFC3339 timestamp")
+		return
+	}
+	until = until.UTC()
+
+	now := time.Now().UTC()
+	if !until.After(now) {
+		writeError(w, http.StatusBadRequest, "until must be in the future")
+		return
+	}
+	if until.Sub(now) > maxExpectChangeWindow {
+		writeError(w, http.StatusBadRequest, "until must be within 30 days")
+		return
+	}
+
+	s.updateExpectChangeWindow(w, r, monitorID, &until)
+}
+
+func (s *Server) handleCancelExpectMonitorChange(w http.ResponseWriter, r *http.Request) {
+	monitorID, err := parseMonitorID(r.PathValue("monitorId"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "monitorId must be a positive integer")
+		return
+	}
+
+	s.updateExpectChangeWindow(w, r, monitorID, nil)
+}
+
+func (s *Server) updateExpectChangeWindow(w http.ResponseWriter, r *http.Request, monitorID int, until *time.Time) {
+	row, err := s.db.Monitor.Query().
+		Where(monitor.IDEQ(monitorID)).
+		WithRuntime().
+		Only(r.Context())
+	if err != nil {
+		if ent.IsNotFound(err) {
+			writeError(w, http.StatusNotFound, "monitor not found")
+			return
+		}
+		writeError(w, http.StatusInternalServerError, "failed to load monitor")
+		return
+	}
+
+	runtime := row.Edges.Runtime
+	if runtime == nil {
+		writeError(w, http.StatusConflict, "monitor has not been initialized")
+		return
+	}
+
+	update := s.db.MonitorRuntime.UpdateOneID(runtime.ID)
+	if until != nil {
+		update = update.SetExpectChangeUntil(*until)
+	} else {
+		update = update.ClearExpectChangeUntil()
+	}
+
+	runtime, err = update.Save(r.Context())
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "failed to update expected change window")
+		return
+	}
+
+	channelStates := s.loadNotificationChannelStates(r.Context())
+	mapped := s.mapMonitor(
+		row,
+		runtime,
+		buildMonitorNotificationIssues(row.NotificationChannels, channelStates),
+	)
+	s.publishMonitorUpdated(row.ID, monitorUpdated, &mapped)
+
+	writeJSON(w, http.StatusOK, mapped)
+}
@@ -0,0 +1,79 @@
+package server
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"goanna/apps/api/ent/enttest"
+	"goanna/apps/api/ent/monitorruntime"
+
+	_ "github.com/mattn/go-sqlite3"
+)
+
+func TestHandleExpectMonitorChange(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:monitor-expect-change?mode=memory&cache=shared&_fk=1")
+	defer client.Close()
+
+	row, err := client.Monitor.Create().
+		SetURL("https://example.com/release-notes").
+		SetCron("*/5 * * * *").
+		Save(t.Context())
+	if err != nil {
+		t.Fatalf("expected monitor to save: %v", err)
+	}
+	if _, err := client.MonitorRuntime.Create().
+		SetMonitor(row).
+		SetStatus(monitorruntime.StatusOk).
+		Save(t.Context()); err != nil {
+		t.Fatalf("expected runtime to save: %v", err)
+	}
+
+	mux := http.NewServeMux()
+	New(client).RegisterRoutes(mux)
+	path := fmt.Sprintf("/v1/monitors/%d/expect-change", row.ID)
+
+	until := time.Now().UTC().Add(2 * time.Hour).Truncate(time.Second)
+	req := httptest.NewRequest(http.MethodPost, path+"?until="+url.QueryEscape(until.Format(time.RFC3339)), nil)
+	rec := httptest.NewRecorder()
+	mux.ServeHTTP(rec, req)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected 200, got %d: %s", rec.Code, rec.Body.String())
+	}
+
+	var response monitorResponse
+	if err := json.Unmarshal(rec.Body.Bytes(), &response); err != nil {
+		t.Fatalf("expected monitor JSON: %v", err)
+	}
+	if response.ExpectChangeUntil == nil || !response.ExpectChangeUntil.Equal(until) {
+		t.Fatalf("expected expectChangeUntil %s, got %v", until, response.ExpectChangeUntil)
+	}
+
+	for _, rawUntil := range []string{"", "tomorrow", time.Now().UTC().Add(-time.Hour).Format(time.RFC3339), time.Now().UTC().Add(40 * 24 * time.Hour).Format(time.RFC3339)} {
+		req = httptest.NewRequest(http.MethodPost, path+"?until="+url.QueryEscape(rawUntil), nil)
+		rec = httptest.NewRecorder()
+		mux.ServeHTTP(rec, req)
+		if rec.Code != http.StatusBadRequest {
+			t.Fatalf("expected 400 for until=%q, got %d", rawUntil, rec.Code)
+		}
+	}
+
+	req = httptest.NewRequest(http.MethodDelete, path, nil)
+	rec = httptest.NewRecorder()
+	mux.ServeHTTP(rec, req)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected 200, got %d: %s", rec.Code, rec.Body.String())
+	}
+
+	var cleared monitorResponse
+	if err := json.Unmarshal(rec.Body.Bytes(), &cleared); err != nil {
+		t.Fatalf("expected monitor JSON: %v", err)
+	}
+	if cleared.ExpectChangeUntil != nil {
+		t.Fatalf("expected window to be cleared, got %v", cleared.ExpectChangeUntil)
+	}
+}
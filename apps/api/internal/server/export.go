@@ -0,0 +1,342 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"goanna/apps/api/ent"
+	"goanna/apps/api/ent/monitor"
+	"goanna/apps/api/internal/worker"
+
+	"gopkg.in/yaml.v3"
+)
+
+const monitorExportVersion = 1
+
+// sensitiveHeaderNames are request headers whose values stripSecrets removes
+// from an export, in addition to any header naming a token, key or secret.
+var sensitiveHeaderNames = []string{"Authorization", "Proxy-Authorization", "Cookie"}
+
+// monitorExportDocument is the file format shared by export and import. Each
+// monitor uses the create request shape, so an exported file can be edited
+// by hand and imported into any instance.
+type monitorExportDocument struct {
+	Version    int                    `json:"version"`
+	ExportedAt *time.Time             `json:"exportedAt,omitempty"`
+	Monitors   []createMonitorRequest `json:"monitors"`
+}
+
+// handleExportMonitors writes every monitor's configuration, optionally
+// limited by tag, as JSON or YAML. Runtime state and check history are not
+// exported. stripSecrets=true drops auth credentials and credential headers.
+func (s *Server) handleExportMonitors(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+
+	format, err := parseExportFormat(query)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	stripSecrets, err := parseExportFlag(query, "stripSecrets")
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	document, err := s.exportMonitors(r.Context(), parseMonitorTagFilter(r), stripSecrets)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "failed to load monitors")
+		return
+	}
+
+	writeExportDocument(w, document, format, "goanna-monitors")
+}
+
+// ExportMonitors encodes the monitors having every tag in tags as a JSON or
+// YAML export document, as GET /v1/monitors/export does.
+func (s *Server) ExportMonitors(ctx context.Context, format string, tags []string, stripSecrets bool) ([]byte, error) {
+	if format != "json" && format != "yaml" {
+		return nil, errors.New("format must be json or yaml")
+	}
+	document, err := s.exportMonitors(ctx, normalizeTagFilter(tags), stripSecrets)
+	if err != nil {
+		return nil, err
+	}
+	return encodeExportDocument(document, format)
+}
+
+func (s *Server) exportMonitors(ctx context.Context, tags []string, stripSecrets bool) (monitorExportDocument, error) {
+	// Export in display order, so an import recreates it. Archived monitors
+	// are left out.
+	rows, err := s.db.Monitor.Query().
+		Where(monitor.ArchivedAtIsNil()).
+		Order(ent.Asc(monitor.FieldSortIndex), ent.Asc(monitor.FieldID)).
+		All(ctx)
+	if err != nil {
+		return monitorExportDocument{}, err
+	}
+
+	exportedAt := time.Now().UTC()
+	document := monitorExportDocument{
+		Version:    monitorExportVersion,
+		ExportedAt: &exportedAt,
+		Monitors:   make([]createMonitorRequest, 0, len(rows)),
+	}
+	for _, row := range rows {
+		if !hasAllTags(row, tags) {
+			continue
+		}
+		exported := exportMonitorRequest(row)
+		if stripSecrets {
+			stripMonitorSecrets(&exported)
+		}
+		document.Monitors = append(document.Monitors, exported)
+	}
+	return document, nil
+}
+
+// parseExportFormat reads the format query parameter, json by default.
+func parseExportFormat(query url.Values) (string, error) {
+	format := strings.ToLower(strings.TrimSpace(query.Get("format")))
+	if format == "" {
+		format = "json"
+	}
+	if format != "json" && format != "yaml" {
+		return "", errors.New("format must be json or yaml")
+	}
+	return format, nil
+}
+
+// parseExportFlag reads an optional boolean query parameter.
+func parseExportFlag(query url.Values, name string) (bool, error) {
+	raw := strings.TrimSpace(query.Get(name))
+	if raw == "" {
+		return false, nil
+	}
+	parsed, err := strconv.ParseBool(raw)
+	if err != nil {
+		return false, errors.New(name + " must be true or false")
+	}
+	return parsed, nil
+}
+
+// writeExportDocument sends document as a file download named after
+// filename and the format.
+func writeExportDocument(w http.ResponseWriter, document any, format string, filename string) {
+	payload, err := encodeExportDocument(document, format)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "failed to encode export")
+		return
+	}
+
+	contentType := "application/json"
+	if format == "yaml" {
+		contentType = "application/yaml"
+	}
+	w.Header().Set("Content-Type", contentType)
+	w.Header().Set("Content-Disposition", `attachment; filename="`+filename+`.`+format+`"`)
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write(payload)
+}
+
+// exportMonitorRequest returns row's stored configuration as a create
+// request.
+func exportMonitorRequest(row *ent.Monitor) createMonitorRequest {
+	treatNotFoundAsSuccess := row.TreatNotFoundAsSuccess
+	acceptEmptyBody := row.AcceptEmptyBody
+	cookieJar := row.CookieJar
+	tlsInsecureSkipVerify := row.TLSInsecureSkipVerify
+	enabled := row.Enabled
+	statusPage := row.StatusPage
+
+	return createMonitorRequest{
+		Label:                  row.Label,
+		Method:                 row.Method,
+		URL:                    row.URL,
+		IconURL:                row.IconURL,
+		Body:                   row.Body,
+		Headers:                row.Headers,
+		UserAgent:              row.UserAgent,
+		HeaderProfileID:        row.HeaderProfileID,
+		Auth:                   row.Auth,
+		NotificationChannels:   row.NotificationChannels,
+		EscalationChannels:     row.EscalationChannels,
+		EscalationAfterMinutes: row.EscalationAfterMinutes,
+		Tags:                   row.Tags,
+		Selector:               row.Selector,
+		ExpectedStatus:         row.ExpectedStatus,
+		RetryOn:                row.RetryOn,
+		ExpectedType:           row.ExpectedType.String(),
+		ExpectedResponse:       row.ExpectedResponse,
+		MustContain:            row.MustContain,
+		MustNotContain:         row.MustNotContain,
+		TreatNotFoundAsSuccess: &treatNotFoundAsSuccess,
+		AcceptEmptyBody:        &acceptEmptyBody,
+		WatchdogMinutes:        row.WatchdogMinutes,
+		HeaderAssertions:       row.HeaderAssertions,
+		TrackHeader:            row.TrackHeader,
+		NumericTolerance:       row.NumericTolerance,
+		HostOverrides:          row.HostOverrides,
+		IPFamily:               row.IPFamily.String(),
+		RedirectPolicy:         row.RedirectPolicy.String(),
+		MaxRedirects:           row.MaxRedirects,
+		MaxResponseBytes:       row.MaxResponseBytes,
+		ChecksHistoryLimit:     row.ChecksHistoryLimit,
+		CookieJar:              &cookieJar,
+		TLSCAPEM:               row.TLSCaPem,
+		TLSInsecureSkipVerify:  &tlsInsecureSkipVerify,
+		TLSMinVersion:          row.TLSMinVersion,
+		TLSServerName:          row.TLSServerName,
+		Cron:                   row.Cron,
+		Timezone:               row.Timezone,
+		JitterSeconds:          row.JitterSeconds,
+		DSTPolicy:              row.DstPolicy.String(),
+		BodySnapshot:           row.BodySnapshot.String(),
+		ContentHash:            row.ContentHash.String(),
+		FetchMode:              row.FetchMode.String(),
+		Enabled:                &enabled,
+		StatusPage:             &statusPage,
+	}
+}
+
+// stripMonitorSecrets removes auth credentials and credential headers,
+// keeping the auth type and names so the secrets can be filled in again.
+// Values referencing ${ENV:NAME} hold no secret and are kept.
+func stripMonitorSecrets(req *createMonitorRequest) {
+	if len(req.Auth) > 0 {
+		auth := make(map[string]string, len(req.Auth))
+		for key, value := range req.Auth {
+			if slices.Contains(publicAuthKeys, key) || worker.HasEnvReference(value) {
+				auth[key] = value
+			}
+		}
+		req.Auth = auth
+	}
+
+	if len(req.Headers) > 0 {
+		headers := make(map[string]string, len(req.Headers))
+		for name, value := range req.Headers {
+			if !isSensitiveHeader(name) || worker.HasEnvReference(value) {
+				headers[name] = value
+			}
+		}
+		req.Headers = headers
+	}
+}
+
+func isSensitiveHeader(name string) bool {
+	canonical := http.CanonicalHeaderKey(strings.TrimSpace(name))
+	if slices.Contains(sensitiveHeaderNames, canonical) {
+		return true
+	}
+
+	lower := strings.ToLower(canonical)
+	return strings.Contains(lower, "token") || strings.Contains(lower, "secret") || strings.Contains(lower, "key")
+}
+
+// encodeExportDocument renders document without null or empty fields, so
+// exports stay small and diff cleanly under version control.
+func encodeExportDocument(document any, format string) ([]byte, error) {
+	encoded, err := json.Marshal(document)
+	if err != nil {
+		return nil, err
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(encoded))
+	decoder.UseNumber()
+	var tree any
+	if err := decoder.Decode(&tree); err != nil {
+		return nil, err
+	}
+	tree = pruneExportValue(tree)
+
+	if format == "yaml" {
+		return yaml.Marshal(tree)
+	}
+	return json.MarshalIndent(tree, "", "  ")
+}
+
+// pruneExportValue drops nulls and empty collections from decoded JSON and
+// turns json.Number back into integers or floats for the YAML encoder.
+func pruneExportValue(value any) any {
+	switch typed := value.(type) {
+	case map[string]any:
+		for key, child := range typed {
+			pruned := pruneExportValue(child)
+			if isEmptyExportValue(pruned) {
+				delete(typed, key)
+				continue
+			}
+			typed[key] = pruned
+		}
+		return typed
+	case []any:
+		for i, child := range typed {
+			typed[i] = pruneExportValue(child)
+		}
+		return typed
+	case json.Number:
+		if integer, err := typed.Int64(); err == nil {
+			return integer
+		}
+		float, _ := typed.Float64()
+		return float
+	default:
+		return value
+	}
+}
+
+func isEmptyExportValue(value any) bool {
+	switch typed := value.(type) {
+	case nil:
+		return true
+	case map[string]any:
+		return len(typed) == 0
+	case []any:
+		return len(typed) == 0
+	default:
+		return false
+	}
+}
+
+// decodeMonitorExport parses a JSON or YAML monitor export document.
+func decodeMonitorExport(payload []byte) (monitorExportDocument, error) {
+	var document monitorExportDocument
+	if err := decodeExportDocument(payload, &document); err != nil {
+		return monitorExportDocument{}, err
+	}
+	if document.Version != monitorExportVersion {
+		return monitorExportDocument{}, errors.New("import version must be " + strconv.Itoa(monitorExportVersion))
+	}
+
+	return document, nil
+}
+
+// decodeExportDocument parses a JSON or YAML export into document. JSON is
+// valid YAML, so both go through the YAML decoder and then the JSON field
+// names.
+func decodeExportDocument(payload []byte, document any) error {
+	var tree any
+	if err := yaml.Unmarshal(payload, &tree); err != nil {
+		return errors.New("import must be a JSON or YAML export document")
+	}
+
+	encoded, err := json.Marshal(tree)
+	if err != nil {
+		return errors.New("import must be a JSON or YAML export document")
+	}
+
+	if err := json.Unmarshal(encoded, document); err != nil {
+		return errors.New("import does not match the export format")
+	}
+	return nil
+}
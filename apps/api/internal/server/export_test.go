@@ -0,0 +1,126 @@
+package server
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"goanna/apps/api/ent/enttest"
+	"goanna/apps/api/ent/monitor"
+
+	_ "github.com/mattn/go-sqlite3"
+)
+
+func TestExportAndImportMonitors(t *testing.T) {
+	source := enttest.Open(t, "sqlite3", "file:monitor-export-source?mode=memory&cache=shared&_fk=1")
+	defer source.Close()
+
+	if _, err := source.Monitor.Create().
+		SetLabel("Prices").
+		SetURL("https://example.com/prices.json").
+		SetCron("*/5 * * * *").
+		SetSelector("data.price").
+		SetHeaders(map[string]string{"Accept": "application/json", "X-Api-Key": "secret", "X-Client-Token": "${ENV:GOANNA_SECRET_PRICES_TOKEN}"}).
+		SetAuth(map[string]string{"type": "bearer", "token": "secret"}).
+		SetTags([]string{"shop"}).
+		SetMaxResponseBytes(4096).
+		Save(t.Context()); err != nil {
+		t.Fatalf("expected monitor to save: %v", err)
+	}
+	if _, err := source.Monitor.Create().
+		SetURL("https://example.com/status").
+		SetCron("0 * * * *").
+		SetExpectedType(monitor.ExpectedTypeText).
+		SetEnabled(false).
+		Save(t.Context()); err != nil {
+		t.Fatalf("expected monitor to save: %v", err)
+	}
+
+	sourceMux := http.NewServeMux()
+	New(source).RegisterRoutes(sourceMux)
+
+	rec := httptest.NewRecorder()
+	sourceMux.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/v1/monitors/export?format=yaml&stripSecrets=true", nil))
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected 200, got %d: %s", rec.Code, rec.Body.String())
+	}
+	if contentType := rec.Header().Get("Content-Type"); contentType != "application/yaml" {
+		t.Fatalf("expected YAML content type, got %q", contentType)
+	}
+	exported := rec.Body.String()
+	if strings.Contains(exported, "secret") || !strings.Contains(exported, "type: bearer") {
+		t.Fatalf("expected credentials to be stripped, got:\n%s", exported)
+	}
+	if !strings.Contains(exported, "${ENV:GOANNA_SECRET_PRICES_TOKEN}") {
+		t.Fatalf("expected environment references to be kept, got:\n%s", exported)
+	}
+	if !strings.Contains(exported, "maxResponseBytes: 4096") || strings.Contains(exported, "null") {
+		t.Fatalf("expected a compact export, got:\n%s", exported)
+	}
+
+	target := enttest.Open(t, "sqlite3", "file:monitor-export-target?mode=memory&cache=shared&_fk=1")
+	defer target.Close()
+
+	if _, err := target.Monitor.Create().
+		SetLabel("Prices").
+		SetURL("https://old.example.com/prices.json").
+		SetCron("0 0 * * *").
+		Save(t.Context()); err != nil {
+		t.Fatalf("expected monitor to save: %v", err)
+	}
+
+	targetMux := http.NewServeMux()
+	New(target).RegisterRoutes(targetMux)
+
+	importDocument := func(conflict string) importMonitorsResponse {
+		t.Helper()
+
+		rec := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodPost, "/v1/monitors/import?conflict="+conflict, strings.NewReader(exported))
+		req.Header.Set("Content-Type", "application/yaml")
+		targetMux.ServeHTTP(rec, req)
+		if rec.Code != http.StatusOK {
+			t.Fatalf("expected 200 importing with %s, got %d: %s", conflict, rec.Code, rec.Body.String())
+		}
+
+		var response importMonitorsResponse
+		if err := json.Unmarshal(rec.Body.Bytes(), &response); err != nil {
+			t.Fatalf("expected import JSON: %v", err)
+		}
+		return response
+	}
+
+	skipped := importDocument("skip")
+	if len(skipped.Created) != 1 || len(skipped.Updated) != 0 || len(skipped.Skipped) != 1 || skipped.Skipped[0].Index != 0 {
+		t.Fatalf("expected the labelled monitor to be skipped, got %+v", skipped)
+	}
+	if created := skipped.Created[0]; created.Enabled || created.ExpectedType != "text" {
+		t.Fatalf("expected the unlabelled monitor to be created as exported, got %+v", created)
+	}
+
+	updated := importDocument("update")
+	if len(updated.Created) != 0 || len(updated.Updated) != 2 || len(updated.Skipped) != 0 {
+		t.Fatalf("expected both monitors to be updated, got %+v", updated)
+	}
+	prices := updated.Updated[0]
+	if prices.URL != "https://example.com/prices.json" || prices.Selector == nil || *prices.Selector != "data.price" ||
+		prices.Headers["Accept"] != "application/json" || prices.Headers["X-Api-Key"] != "" {
+		t.Fatalf("expected the existing monitor to take the exported configuration, got %+v", prices)
+	}
+
+	created := importDocument("create")
+	if len(created.Created) != 2 {
+		t.Fatalf("expected conflicting monitors to be created again, got %+v", created)
+	}
+	if count := target.Monitor.Query().CountX(t.Context()); count != 4 {
+		t.Fatalf("expected 4 monitors after importing, got %d", count)
+	}
+
+	rec = httptest.NewRecorder()
+	targetMux.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/v1/monitors/import", strings.NewReader(`{"version":1,"monitors":[{"url":"https://example.com"}]}`)))
+	if rec.Code != http.StatusBadRequest || !strings.Contains(rec.Body.String(), "monitors[0]") {
+		t.Fatalf("expected invalid monitors to be rejected, got %d: %s", rec.Code, rec.Body.String())
+	}
+}
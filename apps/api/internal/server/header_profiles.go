@@ -0,0 +1,249 @@
+package server
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"goanna/apps/api/ent"
+	"goanna/apps/api/ent/headerprofile"
+	"goanna/apps/api/ent/monitor"
+)
+
+const (
+	maxHeaderProfileNameLength = 100
+	maxUserAgentLength         = 512
+)
+
+type headerProfileRequest struct {
+	Name    string            `json:"name"`
+	Headers map[string]string `json:"headers"`
+}
+
+type headerProfileResponse struct {
+	ID           int64     `json:"id"`
+	Name         string    `json:"name"`
+	Headers      kvMap     `json:"headers"`
+	MonitorCount int       `json:"monitorCount"`
+	CreatedAt    time.Time `json:"createdAt"`
+	UpdatedAt    time.Time `json:"updatedAt"`
+}
+
+func (s *Server) handleListHeaderProfiles(w http.ResponseWriter, r *http.Request) {
+	profiles, err := s.db.HeaderProfile.Query().
+		WithMonitors(func(query *ent.MonitorQuery) {
+			query.Select(monitor.FieldHeaderProfileID)
+		}).
+		Order(ent.Asc(headerprofile.FieldName)).
+		All(r.Context())
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "failed to load header profiles")
+		return
+	}
+
+	response := make([]headerProfileResponse, 0, len(profiles))
+	for _, profile := range profiles {
+		response = append(response, mapHeaderProfile(profile, len(profile.Edges.Monitors)))
+	}
+	writeJSON(w, http.StatusOK, response)
+}
+
+func (s *Server) handleCreateHeaderProfile(w http.ResponseWriter, r *http.Request) {
+	var req headerProfileRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid JSON body")
+		return
+	}
+
+	name, headers, err := normalizeHeaderProfileRequest(req)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	profile, err := s.db.HeaderProfile.Create().
+		SetName(name).
+		SetHeaders(headers).
+		Save(r.Context())
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			writeError(w, http.StatusConflict, "a header profile with this name already exists")
+			return
+		}
+		writeError(w, http.StatusInternalServerError, "failed to create header profile")
+		return
+	}
+
+	writeJSON(w, http.StatusCreated, mapHeaderProfile(profile, 0))
+}
+
+func (s *Server) handleUpdateHeaderProfile(w http.ResponseWriter, r *http.Request) {
+	profileID, err := parseHeaderProfileID(r.PathValue("profileId"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	var req headerProfileRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid JSON body")
+		return
+	}
+
+	name, headers, err := normalizeHeaderProfileRequest(req)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	profile, err := s.db.HeaderProfile.UpdateOneID(profileID).
+		SetName(name).
+		SetHeaders(headers).
+		Save(r.Context())
+	if err != nil {
+		if ent.IsNotFound(err) {
+			writeError(w, http.StatusNotFound, "header profile not found")
+			return
+		}
+		if ent.IsConstraintError(err) {
+			writeError(w, http.StatusConflict, "a header profile with this name already exists")
+			return
+		}
+		writeError(w, http.StatusInternalServerError, "failed to update header profile")
+		return
+	}
+
+	monitorCount, err := profile.QueryMonitors().Count(r.Context())
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "failed to load header profile")
+		return
+	}
+
+	writeJSON(w, http.StatusOK, mapHeaderProfile(profile, monitorCount))
+}
+
+// handleDeleteHeaderProfile refuses to delete profiles still referenced by
+// monitors, since those monitors would silently stop sending its headers.
+func (s *Server) handleDeleteHeaderProfile(w http.ResponseWriter, r *http.Request) {
+	profileID, err := parseHeaderProfileID(r.PathValue("profileId"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	monitorCount, err := s.db.Monitor.Query().
+		Where(monitor.HeaderProfileIDEQ(profileID)).
+		Count(r.Context())
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "failed to delete header profile")
+		return
+	}
+	if monitorCount > 0 {
+		writeError(w, http.StatusConflict, fmt.Sprintf("header profile is used by %d monitors", monitorCount))
+		return
+	}
+
+	if err := s.db.HeaderProfile.DeleteOneID(profileID).Exec(r.Context()); err != nil {
+		if ent.IsNotFound(err) {
+			writeError(w, http.StatusNotFound, "header profile not found")
+			return
+		}
+		writeError(w, http.StatusInternalServerError, "failed to delete header profile")
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// requireHeaderProfile writes a 400 response when a monitor references a
+// header profile that does not exist.
+func (s *Server) requireHeaderProfile(w http.ResponseWriter, r *http.Request, profileID *int) bool {
+	if profileID == nil {
+		return true
+	}
+
+	exists, err := s.db.HeaderProfile.Query().
+		Where(headerprofile.IDEQ(*profileID)).
+		Exist(r.Context())
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "failed to load header profile")
+		return false
+	}
+	if !exists {
+		writeError(w, http.StatusBadRequest, "headerProfileId does not exist")
+		return false
+	}
+	return true
+}
+
+func parseHeaderProfileID(raw string) (int, error) {
+	profileID, err := strconv.Atoi(strings.TrimSpace(raw))
+	if err != nil || profileID <= 0 {
+		return 0, errors.New("profileId must be a positive integer")
+	}
+
+	return profileID, nil
+}
+
+func normalizeHeaderProfileRequest(req headerProfileRequest) (string, map[string]string, error) {
+	name := strings.TrimSpace(req.Name)
+	if name == "" {
+		return "", nil, errors.New("name is required")
+	}
+	if len(name) > maxHeaderProfileNameLength {
+		return "", nil, fmt.Errorf("name must be at most %d characters", maxHeaderProfileNameLength)
+	}
+
+	headers, err := normalizeHeaderMap(req.Headers)
+	if err != nil {
+		return "", nil, err
+	}
+
+	return name, headers, nil
+}
+
+// normalizeHeaderMap trims header names and rejects names and values that
+// cannot be sent.
+func normalizeHeaderMap(raw map[string]string) (map[string]string, error) {
+	headers := make(map[string]string, len(raw))
+	for rawKey, value := range raw {
+		key := strings.TrimSpace(rawKey)
+		if key == "" || strings.ContainsAny(key, " :\r\n\t") {
+			return nil, fmt.Errorf("invalid header name %q", rawKey)
+		}
+		if strings.ContainsAny(value, "\r\n") {
+			return nil, fmt.Errorf("header %q must not contain line breaks", key)
+		}
+		headers[key] = value
+	}
+	return headers, nil
+}
+
+func normalizeUserAgent(raw *string) (*string, error) {
+	userAgent := normalizeOptionalString(raw)
+	if userAgent == nil {
+		return nil, nil
+	}
+	if len(*userAgent) > maxUserAgentLength {
+		return nil, fmt.Errorf("userAgent must be at most %d characters", maxUserAgentLength)
+	}
+	if strings.ContainsAny(*userAgent, "\r\n") {
+		return nil, errors.New("userAgent must not contain line breaks")
+	}
+	return userAgent, nil
+}
+
+func mapHeaderProfile(profile *ent.HeaderProfile, monitorCount int) headerProfileResponse {
+	return headerProfileResponse{
+		ID:           int64(profile.ID),
+		Name:         profile.Name,
+		Headers:      kvMap(profile.Headers),
+		MonitorCount: monitorCount,
+		CreatedAt:    profile.CreatedAt,
+		UpdatedAt:    profile.UpdatedAt,
+	}
+}
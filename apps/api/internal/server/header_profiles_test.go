@@ -0,0 +1,128 @@
+package server
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"goanna/apps/api/ent/enttest"
+
+	_ "github.com/mattn/go-sqlite3"
+)
+
+func TestHeaderProfilesLifecycle(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:header-profiles?mode=memory&cache=shared&_fk=1")
+	defer client.Close()
+
+	mux := http.NewServeMux()
+	New(client).RegisterRoutes(mux)
+
+	send := func(method string, path string, body string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(method, path, strings.NewReader(body))
+		rec := httptest.NewRecorder()
+		mux.ServeHTTP(rec, req)
+		return rec
+	}
+
+	rec := send(http.MethodPost, "/v1/header-profiles", `{"name":" Browser ","headers":{"Accept-Language":"en-US"}}`)
+	if rec.Code != http.StatusCreated {
+		t.Fatalf("expected 201, got %d: %s", rec.Code, rec.Body.String())
+	}
+	var profile headerProfileResponse
+	if err := json.Unmarshal(rec.Body.Bytes(), &profile); err != nil {
+		t.Fatalf("expected profile JSON: %v", err)
+	}
+	if profile.Name != "Browser" || profile.Headers["Accept-Language"] != "en-US" {
+		t.Fatalf("unexpected profile %+v", profile)
+	}
+
+	if rec := send(http.MethodPost, "/v1/header-profiles", `{"name":"Browser"}`); rec.Code != http.StatusConflict {
+		t.Fatalf("expected 409 for duplicate name, got %d", rec.Code)
+	}
+	if rec := send(http.MethodPost, "/v1/header-profiles", `{"name":"Bad","headers":{"X-Test":"a\r\nb"}}`); rec.Code != http.StatusBadRequest {
+		t.Fatalf("expected 400 for header with line break, got %d", rec.Code)
+	}
+
+	monitorBody := fmt.Sprintf(`{"url":"https://example.com","cron":"*/5 * * * *","expectedType":"json","selector":"status","userAgent":"goanna/1.0","headerProfileId":%d}`, profile.ID)
+	rec = send(http.MethodPost, "/v1/monitors", monitorBody)
+	if rec.Code != http.StatusCreated {
+		t.Fatalf("expected 201, got %d: %s", rec.Code, rec.Body.String())
+	}
+	var created monitorTriggerResponse
+	if err := json.Unmarshal(rec.Body.Bytes(), &created); err != nil {
+		t.Fatalf("expected monitor JSON: %v", err)
+	}
+	if created.Monitor.UserAgent == nil || *created.Monitor.UserAgent != "goanna/1.0" ||
+		created.Monitor.HeaderProfileID == nil || int64(*created.Monitor.HeaderProfileID) != profile.ID {
+		t.Fatalf("unexpected monitor %+v", created.Monitor)
+	}
+
+	if rec := send(http.MethodPost, "/v1/monitors", `{"url":"https://example.com","cron":"*/5 * * * *","expectedType":"json","selector":"status","headerProfileId":999}`); rec.Code != http.StatusBadRequest {
+		t.Fatalf("expected 400 for unknown header profile, got %d", rec.Code)
+	}
+
+	rec = send(http.MethodGet, "/v1/header-profiles", "")
+	var profiles []headerProfileResponse
+	if err := json.Unmarshal(rec.Body.Bytes(), &profiles); err != nil {
+		t.Fatalf("expected profiles JSON: %v", err)
+	}
+	if len(profiles) != 1 || profiles[0].MonitorCount != 1 {
+		t.Fatalf("expected one profile used by one monitor, got %+v", profiles)
+	}
+
+	profilePath := fmt.Sprintf("/v1/header-profiles/%d", profile.ID)
+	if rec := send(http.MethodDelete, profilePath, ""); rec.Code != http.StatusConflict {
+		t.Fatalf("expected 409 while in use, got %d", rec.Code)
+	}
+
+	if rec := send(http.MethodDelete, fmt.Sprintf("/v1/monitors/%d/permanent", created.Monitor.ID), ""); rec.Code != http.StatusNoContent {
+		t.Fatalf("expected monitor delete, got %d", rec.Code)
+	}
+	if rec := send(http.MethodDelete, profilePath, ""); rec.Code != http.StatusNoContent {
+		t.Fatalf("expected 204, got %d: %s", rec.Code, rec.Body.String())
+	}
+}
+
+func TestTestMonitorURLAppliesHeaderProfile(t *testing.T) {
+	var gotUserAgent, gotLanguage, gotTeam string
+	target := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotUserAgent = r.UserAgent()
+		gotLanguage = r.Header.Get("Accept-Language")
+		gotTeam = r.Header.Get("X-Team")
+		_, _ = w.Write([]byte(`{}`))
+	}))
+	defer target.Close()
+
+	client := enttest.Open(t, "sqlite3", "file:header-profiles-test-url?mode=memory&cache=shared&_fk=1")
+	defer client.Close()
+
+	profile, err := client.HeaderProfile.Create().
+		SetName("Browser").
+		SetHeaders(map[string]string{"User-Agent": "Mozilla/5.0", "Accept-Language": "de-DE"}).
+		Save(t.Context())
+	if err != nil {
+		t.Fatalf("expected profile to save: %v", err)
+	}
+	if _, err := client.SystemConfig.Create().
+		SetDefaultHeaders(map[string]string{"Accept-Language": "en-US", "X-Team": "ops"}).
+		Save(t.Context()); err != nil {
+		t.Fatalf("expected system config to save: %v", err)
+	}
+
+	mux := http.NewServeMux()
+	New(client).RegisterRoutes(mux)
+
+	body := fmt.Sprintf(`{"url":%q,"userAgent":"goanna-test","headerProfileId":%d}`, target.URL, profile.ID)
+	req := httptest.NewRequest(http.MethodPost, "/v1/monitors/test", strings.NewReader(body))
+	rec := httptest.NewRecorder()
+	mux.ServeHTTP(rec, req)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected 200, got %d: %s", rec.Code, rec.Body.String())
+	}
+	if gotUserAgent != "goanna-test" || gotLanguage != "de-DE" || gotTeam != "ops" {
+		t.Fatalf("expected default and profile headers with monitor User-Agent, got %q %q %q", gotUserAgent, gotLanguage, gotTeam)
+	}
+}
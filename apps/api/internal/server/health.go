@@ -0,0 +1,166 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"goanna/apps/api/internal/worker"
+)
+
+// healthCheckTimeout bounds each dependency check so a wedged database
+// cannot hang the health endpoint.
+const healthCheckTimeout = 5 * time.Second
+
+// Component states reported by the detailed health check. unknown means the
+// component could not be checked and does not make the API unhealthy.
+const (
+	healthOK      = "ok"
+	healthError   = "error"
+	healthUnknown = "unknown"
+)
+
+type healthComponentResponse struct {
+	Status     string     `json:"status"`
+	Message    string     `json:"message,omitempty"`
+	LastTickAt *time.Time `json:"lastTickAt,omitempty"`
+}
+
+type healthDetailsResponse struct {
+	Status     string                  `json:"status"`
+	Paused     bool                    `json:"paused"`
+	CheckedAt  time.Time               `json:"checkedAt"`
+	Database   healthComponentResponse `json:"database"`
+	Worker     healthComponentResponse `json:"worker"`
+	Migrations healthComponentResponse `json:"migrations"`
+}
+
+type readinessResponse struct {
+	Ready   bool     `json:"ready"`
+	Reasons []string `json:"reasons,omitempty"`
+}
+
+// handleReadiness answers 200 only once the schema is migrated and the
+// worker has completed its first pass, so orchestrators hold traffic back
+// from an instance that is still starting. Unlike /v1/health/details it does
+// not fail on a stale worker; that is for liveness probes and alerts.
+func (s *Server) handleReadiness(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(r.Context(), healthCheckTimeout)
+	defer cancel()
+
+	detailed := s.healthErrorsVisible(r)
+	response := readinessResponse{Ready: true}
+	if _, err := s.ensureGlobalSystemConfig(ctx); err != nil {
+		response.Reasons = append(response.Reasons, healthErrorMessage("database is unavailable", err, detailed))
+	} else if migrations := s.migrationHealth(ctx, detailed); migrations.Status == healthError {
+		response.Reasons = append(response.Reasons, migrations.Message)
+	}
+	if s.liveness != nil {
+		if _, ok := s.liveness.LastTick(); !ok {
+			response.Reasons = append(response.Reasons, "scheduler has not run yet")
+		}
+	}
+
+	statusCode := http.StatusOK
+	if len(response.Reasons) > 0 {
+		response.Ready = false
+		statusCode = http.StatusServiceUnavailable
+	}
+	writeJSON(w, statusCode, response)
+}
+
+// handleHealthDetails checks the database, the scheduler's last pass and the
+// schema, answering 503 with the failing components when any is unhealthy.
+// /healthz stays a cheap liveness probe. The endpoint is public, so the
+// underlying errors are only included for callers who could sign in.
+func (s *Server) handleHealthDetails(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(r.Context(), healthCheckTimeout)
+	defer cancel()
+
+	detailed := s.healthErrorsVisible(r)
+	now := time.Now().UTC()
+	response := healthDetailsResponse{
+		Status:     "ok",
+		CheckedAt:  now,
+		Database:   healthComponentResponse{Status: healthOK},
+		Migrations: healthComponentResponse{Status: healthUnknown},
+		Worker:     workerHealth(s.liveness, now),
+	}
+
+	config, err := s.ensureGlobalSystemConfig(ctx)
+	if err != nil {
+		response.Database = healthComponentResponse{Status: healthError, Message: healthErrorMessage("database is unavailable", err, detailed)}
+	} else {
+		response.Paused = config.Paused
+		response.Migrations = s.migrationHealth(ctx, detailed)
+	}
+
+	statusCode := http.StatusOK
+	for _, component := range []healthComponentResponse{response.Database, response.Worker, response.Migrations} {
+		if component.Status == healthError {
+			response.Status = "degraded"
+			statusCode = http.StatusServiceUnavailable
+		}
+	}
+
+	writeJSON(w, statusCode, response)
+}
+
+// migrationHealth reports whether migrations of this build have not been
+// applied, which happens when startup migrations did not run. It reads the
+// migrator's revision table rather than diffing the live schema.
+func (s *Server) migrationHealth(ctx context.Context, detailed bool) healthComponentResponse {
+	if s.migrations == nil {
+		return healthComponentResponse{Status: healthUnknown, Message: "schema migrations are not tracked"}
+	}
+
+	pending, err := s.migrations.Pending(ctx)
+	if err != nil {
+		return healthComponentResponse{Status: healthError, Message: healthErrorMessage("failed to check schema migrations", err, detailed)}
+	}
+	if len(pending) > 0 {
+		return healthComponentResponse{Status: healthError, Message: fmt.Sprintf("schema migrations are pending (%d)", len(pending))}
+	}
+	return healthComponentResponse{Status: healthOK}
+}
+
+// healthErrorsVisible reports whether r may see the errors behind failing
+// health checks: while the API is open, or with a valid session. Anything
+// else, including a database too broken to tell, gets generic messages.
+func (s *Server) healthErrorsVisible(r *http.Request) bool {
+	required, err := s.authRequired(r.Context())
+	if err != nil {
+		return false
+	}
+	if !required {
+		return true
+	}
+	_, _, err = s.sessionUser(r)
+	return err == nil
+}
+
+func healthErrorMessage(message string, err error, detailed bool) string {
+	if !detailed {
+		return message
+	}
+	return message + ": " + err.Error()
+}
+
+func workerHealth(liveness *worker.Liveness, now time.Time) healthComponentResponse {
+	if liveness == nil {
+		return healthComponentResponse{Status: healthUnknown, Message: "worker liveness is not tracked"}
+	}
+
+	lastTick, ok := liveness.LastTick()
+	if !ok {
+		return healthComponentResponse{Status: healthError, Message: "scheduler has not run yet"}
+	}
+
+	response := healthComponentResponse{Status: healthOK, LastTickAt: &lastTick}
+	if age := now.Sub(lastTick); age > liveness.StaleAfter() {
+		response.Status = healthError
+		response.Message = "scheduler has not run for " + age.Round(time.Second).String()
+	}
+	return response
+}
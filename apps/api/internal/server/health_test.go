@@ -0,0 +1,181 @@
+package server
+
+import (
+	"database/sql"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"goanna/apps/api/ent"
+	"goanna/apps/api/ent/enttest"
+	"goanna/apps/api/ent/user"
+	"goanna/apps/api/internal/migrations"
+	"goanna/apps/api/internal/worker"
+
+	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
+	_ "github.com/mattn/go-sqlite3"
+)
+
+// openMigratedDatabase applies the schema migrations to a fresh in-memory
+// database, as startup does.
+func openMigratedDatabase(t *testing.T, name string) (*sql.DB, *ent.Client, *migrations.Migrator) {
+	t.Helper()
+
+	db, err := sql.Open("sqlite3", "file:"+name+"?mode=memory&cache=shared&_fk=1")
+	if err != nil {
+		t.Fatalf("expected database to open: %v", err)
+	}
+	migrator, err := migrations.New(db, dialect.SQLite)
+	if err != nil {
+		t.Fatalf("expected migrations to load: %v", err)
+	}
+	if _, err := migrator.Up(t.Context(), 0); err != nil {
+		t.Fatalf("expected migrations to apply: %v", err)
+	}
+	return db, ent.NewClient(ent.Driver(entsql.OpenDB(dialect.SQLite, db))), migrator
+}
+
+func TestHandleHealthDetails(t *testing.T) {
+	_, client, migrator := openMigratedDatabase(t, "health-details")
+	defer client.Close()
+
+	liveness := worker.NewLiveness()
+	mux := http.NewServeMux()
+	NewWithConfig(client, Config{Worker: worker.Config{Liveness: liveness}, Migrations: migrator}).RegisterRoutes(mux)
+
+	get := func() (int, healthDetailsResponse) {
+		t.Helper()
+		rec := httptest.NewRecorder()
+		mux.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/v1/health/details", nil))
+		var response healthDetailsResponse
+		if err := json.Unmarshal(rec.Body.Bytes(), &response); err != nil {
+			t.Fatalf("expected health JSON: %v", err)
+		}
+		return rec.Code, response
+	}
+
+	liveness.RecordTick(time.Now().UTC())
+	code, response := get()
+	if code != http.StatusOK || response.Status != "ok" {
+		t.Fatalf("expected healthy response, got %d %#v", code, response)
+	}
+	if response.Database.Status != healthOK || response.Migrations.Status != healthOK || response.Worker.LastTickAt == nil {
+		t.Fatalf("unexpected components %#v", response)
+	}
+
+	liveness.RecordTick(time.Now().UTC().Add(-liveness.StaleAfter() - time.Minute))
+	code, response = get()
+	if code != http.StatusServiceUnavailable || response.Status != "degraded" || response.Worker.Status != healthError {
+		t.Fatalf("expected stale worker to degrade health, got %d %#v", code, response)
+	}
+	if response.Database.Status != healthOK {
+		t.Fatalf("expected database to stay healthy, got %#v", response.Database)
+	}
+}
+
+func TestHandleHealthDetailsReportsPendingMigrations(t *testing.T) {
+	db, client, migrator := openMigratedDatabase(t, "health-pending")
+	defer client.Close()
+
+	// Simulate a build whose newest migration was never applied.
+	if _, err := db.Exec("DELETE FROM goanna_schema_revisions WHERE version = (SELECT MAX(version) FROM goanna_schema_revisions)"); err != nil {
+		t.Fatalf("expected revision to delete: %v", err)
+	}
+
+	mux := http.NewServeMux()
+	NewWithConfig(client, Config{Migrations: migrator}).RegisterRoutes(mux)
+
+	rec := httptest.NewRecorder()
+	mux.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/v1/health/details", nil))
+	if rec.Code != http.StatusServiceUnavailable {
+		t.Fatalf("expected 503, got %d: %s", rec.Code, rec.Body.String())
+	}
+
+	var response healthDetailsResponse
+	if err := json.Unmarshal(rec.Body.Bytes(), &response); err != nil {
+		t.Fatalf("expected health JSON: %v", err)
+	}
+	if response.Database.Status != healthOK || response.Migrations.Status != healthError || response.Worker.Status != healthUnknown {
+		t.Fatalf("expected pending migrations to degrade health, got %#v", response)
+	}
+	if response.Migrations.Message != "schema migrations are pending (1)" {
+		t.Fatalf("expected the pending count, got %q", response.Migrations.Message)
+	}
+}
+
+func TestHandleHealthDetailsHidesErrorsFromAnonymousCallers(t *testing.T) {
+	db, client, migrator := openMigratedDatabase(t, "health-anonymous")
+	defer client.Close()
+
+	mux := http.NewServeMux()
+	NewWithConfig(client, Config{Migrations: migrator}).RegisterRoutes(mux)
+
+	get := func(path string) string {
+		t.Helper()
+		rec := httptest.NewRecorder()
+		mux.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, path, nil))
+		return rec.Body.String()
+	}
+
+	// With the revision table gone the migration check fails; while the API
+	// is open the caller may see why.
+	if _, err := db.Exec("ALTER TABLE goanna_schema_revisions RENAME TO renamed_revisions"); err != nil {
+		t.Fatalf("expected revision table to rename: %v", err)
+	}
+	if _, err := db.Exec("CREATE TABLE goanna_schema_revisions (version TEXT)"); err != nil {
+		t.Fatalf("expected broken revision table to create: %v", err)
+	}
+	if body := get("/v1/health/details"); !strings.Contains(body, "failed to check schema migrations: ") || !strings.Contains(body, "no such column") {
+		t.Fatalf("expected the error while the API is open, got %s", body)
+	}
+
+	if _, err := client.User.Create().
+		SetUsername("admin").
+		SetPasswordHash("unused").
+		SetRole(user.RoleAdmin).
+		Save(t.Context()); err != nil {
+		t.Fatalf("expected user to save: %v", err)
+	}
+	for _, path := range []string{"/v1/health/details", "/readyz"} {
+		body := get(path)
+		if !strings.Contains(body, `"failed to check schema migrations"`) || strings.Contains(body, "no such column") {
+			t.Fatalf("expected %s to hide the error from anonymous callers, got %s", path, body)
+		}
+	}
+}
+
+func TestHandleReadinessWaitsForFirstTick(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:health-readiness?mode=memory&cache=shared&_fk=1")
+	defer client.Close()
+
+	liveness := worker.NewLiveness()
+	mux := http.NewServeMux()
+	NewWithConfig(client, Config{Worker: worker.Config{Liveness: liveness}}).RegisterRoutes(mux)
+
+	get := func() (int, readinessResponse) {
+		t.Helper()
+		rec := httptest.NewRecorder()
+		mux.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/readyz", nil))
+		var response readinessResponse
+		if err := json.Unmarshal(rec.Body.Bytes(), &response); err != nil {
+			t.Fatalf("expected readiness JSON: %v", err)
+		}
+		return rec.Code, response
+	}
+
+	code, response := get()
+	if code != http.StatusServiceUnavailable || response.Ready || len(response.Reasons) != 1 {
+		t.Fatalf("expected not ready before the first tick, got %d %#v", code, response)
+	}
+
+	// A stale worker is a liveness problem; the instance stays ready.
+	liveness.RecordTick(time.Now().UTC().Add(-time.Hour))
+	code, response = get()
+	if code != http.StatusOK || !response.Ready {
+		t.Fatalf("expected ready after the first tick, got %d %#v", code, response)
+	}
+}
@@ -0,0 +1,61 @@
+package server
+
+import (
+	"net/http"
+	"strings"
+	"time"
+
+	"goanna/apps/api/ent"
+	"goanna/apps/api/ent/monitor"
+)
+
+type heartbeatPingResponse struct {
+	MonitorID  int64     `json:"monitorId"`
+	ReceivedAt time.Time `json:"receivedAt"`
+}
+
+// handleHeartbeatPing records a ping from an external job. The worker alerts
+// when no ping arrives within a heartbeat monitor's cron window.
+func (s *Server) handleHeartbeatPing(w http.ResponseWriter, r *http.Request) {
+	token := strings.TrimSpace(r.PathValue("token"))
+	if token == "" {
+		writeError(w, http.StatusNotFound, "heartbeat not found")
+		return
+	}
+
+	row, err := s.db.Monitor.Query().
+		Where(
+			monitor.HeartbeatTokenEQ(token),
+			monitor.FetchModeEQ(monitor.FetchModeHeartbeat),
+			monitor.ArchivedAtIsNil(),
+		).
+		WithRuntime().
+		Only(r.Context())
+	if err != nil {
+		if ent.IsNotFound(err) {
+			writeError(w, http.StatusNotFound, "heartbeat not found")
+			return
+		}
+		writeError(w, http.StatusInternalServerError, "failed to load monitor")
+		return
+	}
+
+	runtime := row.Edges.Runtime
+	if runtime == nil {
+		writeError(w, http.StatusConflict, "monitor runtime is not initialized")
+		return
+	}
+
+	now := time.Now().UTC()
+	if _, err := s.db.MonitorRuntime.UpdateOneID(runtime.ID).
+		SetLastPingAt(now).
+		Save(r.Context()); err != nil {
+		writeError(w, http.StatusInternalServerError, "failed to record heartbeat")
+		return
+	}
+
+	writeJSON(w, http.StatusOK, heartbeatPingResponse{
+		MonitorID:  int64(row.ID),
+		ReceivedAt: now,
+	})
+}
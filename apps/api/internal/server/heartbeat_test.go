@@ -0,0 +1,63 @@
+package server
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"goanna/apps/api/ent/enttest"
+	"goanna/apps/api/ent/monitor"
+
+	_ "github.com/mattn/go-sqlite3"
+)
+
+func TestHeartbeatMonitorPing(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:monitor-heartbeat?mode=memory&cache=shared&_fk=1")
+	defer client.Close()
+
+	mux := http.NewServeMux()
+	New(client).RegisterRoutes(mux)
+
+	req := httptest.NewRequest(http.MethodPost, "/v1/monitors", strings.NewReader(`{"label":"nightly backup","cron":"0 3 * * *","fetchMode":"heartbeat"}`))
+	rec := httptest.NewRecorder()
+	mux.ServeHTTP(rec, req)
+	if rec.Code != http.StatusCreated {
+		t.Fatalf("expected 201, got %d: %s", rec.Code, rec.Body.String())
+	}
+
+	var response monitorTriggerResponse
+	if err := json.Unmarshal(rec.Body.Bytes(), &response); err != nil {
+		t.Fatalf("expected monitor JSON: %v", err)
+	}
+	created := response.Monitor
+	if created.HeartbeatURL == nil || !strings.HasPrefix(*created.HeartbeatURL, "/v1/heartbeats/") {
+		t.Fatalf("expected heartbeat ping path, got %v", created.HeartbeatURL)
+	}
+	if created.URL != *created.HeartbeatURL {
+		t.Fatalf("expected monitor URL to be the ping path, got %q", created.URL)
+	}
+
+	req = httptest.NewRequest(http.MethodGet, *created.HeartbeatURL, nil)
+	rec = httptest.NewRecorder()
+	mux.ServeHTTP(rec, req)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected 200, got %d: %s", rec.Code, rec.Body.String())
+	}
+
+	row, err := client.Monitor.Query().Where(monitor.IDEQ(int(created.ID))).WithRuntime().Only(t.Context())
+	if err != nil {
+		t.Fatalf("expected monitor to load: %v", err)
+	}
+	if row.Edges.Runtime == nil || row.Edges.Runtime.LastPingAt == nil {
+		t.Fatal("expected ping to be recorded on the runtime")
+	}
+
+	req = httptest.NewRequest(http.MethodPost, "/v1/heartbeats/unknown", nil)
+	rec = httptest.NewRecorder()
+	mux.ServeHTTP(rec, req)
+	if rec.Code != http.StatusNotFound {
+		t.Fatalf("expected 404 for unknown token, got %d", rec.Code)
+	}
+}
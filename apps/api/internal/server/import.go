@@ -0,0 +1,389 @@
+package server
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	neturl "net/url"
+	"path"
+	"slices"
+	"strings"
+	"time"
+
+	"goanna/apps/api/ent"
+	"goanna/apps/api/ent/headerprofile"
+	"goanna/apps/api/ent/monitor"
+
+	"github.com/robfig/cron/v3"
+)
+
+const (
+	defaultImportCron   = "0 * * * *"
+	maxImportBodyBytes  = 1024 * 1024
+	maxImportedMonitors = 500
+)
+
+type importMonitorURLsResponse struct {
+	Created []monitorResponse          `json:"created"`
+	Skipped []importSkippedURLResponse `json:"skipped"`
+}
+
+type importSkippedURLResponse struct {
+	Line   int    `json:"line"`
+	URL    string `json:"url"`
+	Reason string `json:"reason"`
+}
+
+// handleImportMonitorURLs creates one basic monitor per line of a plain-text
+// watchlist. Blank lines and lines starting with # are ignored.
+func (s *Server) handleImportMonitorURLs(w http.ResponseWriter, r *http.Request) {
+	payload, err := io.ReadAll(io.LimitReader(r.Body, maxImportBodyBytes+1))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "failed to read request body")
+		return
+	}
+	if len(payload) > maxImportBodyBytes {
+		writeError(w, http.StatusRequestEntityTooLarge, "watchlist is too large")
+		return
+	}
+
+	query := r.URL.Query()
+	cronExpr := strings.TrimSpace(query.Get("cron"))
+	if cronExpr == "" {
+		cronExpr = defaultImportCron
+	}
+	if _, err := cron.ParseStandard(cronExpr); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid cron expression")
+		return
+	}
+	tags, err := normalizeMonitorTags(query["tag"])
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	existing, err := s.db.Monitor.Query().Select(monitor.FieldURL).Strings(r.Context())
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "failed to load monitors")
+		return
+	}
+	seen := make(map[string]struct{}, len(existing))
+	for _, url := range existing {
+		seen[url] = struct{}{}
+	}
+
+	config, err := s.ensureGlobalSystemConfig(r.Context())
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "failed to load runtime settings")
+		return
+	}
+	cronLocation := runtimeCronLocation(config.Timezone)
+	now := time.Now().UTC()
+
+	inputs := make([]normalizedMonitorRequest, 0)
+	skipped := make([]importSkippedURLResponse, 0)
+	scanner := bufio.NewScanner(bytes.NewReader(payload))
+	lineNumber := 0
+	for scanner.Scan() {
+		lineNumber++
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		skip := func(reason string) {
+			skipped = append(skipped, importSkippedURLResponse{Line: lineNumber, URL: line, Reason: reason})
+		}
+
+		if !isImportableURL(line) {
+			skip("not an http(s) URL")
+			continue
+		}
+		if _, ok := seen[line]; ok {
+			skip("already monitored")
+			continue
+		}
+		if len(inputs) == maxImportedMonitors {
+			skip("import limit reached")
+			continue
+		}
+
+		input, err := normalizeMonitorRequest(createMonitorRequest{
+			URL:          line,
+			Cron:         cronExpr,
+			ExpectedType: detectExpectedTypeFromURL(line),
+			Tags:         tags,
+		})
+		if err != nil {
+			writeError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		seen[line] = struct{}{}
+		inputs = append(inputs, input)
+	}
+	if err := scanner.Err(); err != nil {
+		writeError(w, http.StatusBadRequest, "watchlist lines must be shorter than 64KiB")
+		return
+	}
+
+	channelStates := s.loadNotificationChannelStates(r.Context())
+	tx, err := s.db.Tx(r.Context())
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "failed to import monitors")
+		return
+	}
+
+	created := make([]monitorResponse, 0, len(inputs))
+	for _, input := range inputs {
+		row, runtime, err := createMonitorWithRuntime(r.Context(), tx.Client(), input, now, cronLocation)
+		if err != nil {
+			_ = tx.Rollback()
+			writeError(w, http.StatusInternalServerError, "failed to import monitors")
+			return
+		}
+		created = append(created, s.mapMonitor(
+			row,
+			runtime,
+			buildMonitorNotificationIssues(row.NotificationChannels, channelStates),
+		))
+	}
+
+	if err := tx.Commit(); err != nil {
+		writeError(w, http.StatusInternalServerError, "failed to import monitors")
+		return
+	}
+	s.scheduleChanges.Publish()
+	for i := range created {
+		s.publishMonitorUpdated(int(created[i].ID), monitorCreated, &created[i])
+	}
+
+	writeJSON(w, http.StatusOK, importMonitorURLsResponse{
+		Created: created,
+		Skipped: skipped,
+	})
+}
+
+func isImportableURL(raw string) bool {
+	parsed, err := neturl.Parse(raw)
+	if err != nil {
+		return false
+	}
+	return (parsed.Scheme == "http" || parsed.Scheme == "https") && parsed.Host != ""
+}
+
+// detectExpectedTypeFromURL guesses a monitor's expectedType from the shape of
+// its URL so imported pages start with a sensible parser.
+func detectExpectedTypeFromURL(raw string) string {
+	parsed, err := neturl.Parse(raw)
+	if err != nil {
+		return monitor.ExpectedTypeHTML.String()
+	}
+
+	host := strings.ToLower(parsed.Hostname())
+	urlPath := strings.ToLower(parsed.Path)
+	if strings.HasPrefix(path.Base(urlPath), "sitemap") && strings.Contains(urlPath, ".xml") {
+		return monitor.ExpectedTypeSitemap.String()
+	}
+	switch path.Ext(urlPath) {
+	case ".json":
+		return monitor.ExpectedTypeJSON.String()
+	case ".txt", ".csv", ".md":
+		return monitor.ExpectedTypeText.String()
+	case ".rss", ".atom":
+		return monitor.ExpectedTypeFeed.String()
+	}
+	if strings.HasPrefix(host, "api.") || strings.Contains(urlPath, "/api/") {
+		return monitor.ExpectedTypeJSON.String()
+	}
+
+	return monitor.ExpectedTypeHTML.String()
+}
+
+var importConflictModes = []string{"skip", "update", "create"}
+
+type importMonitorsResponse struct {
+	Created []monitorResponse              `json:"created"`
+	Updated []monitorResponse              `json:"updated"`
+	Skipped []importSkippedMonitorResponse `json:"skipped"`
+}
+
+type importSkippedMonitorResponse struct {
+	Index     int    `json:"index"`
+	MonitorID int    `json:"monitorId"`
+	Reason    string `json:"reason"`
+}
+
+// handleImportMonitors applies an export document. A monitor conflicts with
+// an existing one that has the same label, or the same URL when unlabelled;
+// conflict=skip (the default) leaves it alone, update overwrites its
+// configuration and create adds another monitor. Every entry is validated
+// before anything is saved, and the import is applied in one transaction.
+func (s *Server) handleImportMonitors(w http.ResponseWriter, r *http.Request) {
+	conflict := strings.ToLower(strings.TrimSpace(r.URL.Query().Get("conflict")))
+	if conflict == "" {
+		conflict = "skip"
+	}
+	if !slices.Contains(importConflictModes, conflict) {
+		writeError(w, http.StatusBadRequest, "conflict must be one of: "+strings.Join(importConflictModes, ", "))
+		return
+	}
+
+	payload, err := io.ReadAll(io.LimitReader(r.Body, maxImportBodyBytes+1))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "failed to read request body")
+		return
+	}
+	if len(payload) > maxImportBodyBytes {
+		writeError(w, http.StatusRequestEntityTooLarge, "import is too large")
+		return
+	}
+
+	response, status, err := s.importMonitors(r.Context(), payload, conflict)
+	if err != nil {
+		writeError(w, status, err.Error())
+		return
+	}
+
+	writeJSON(w, http.StatusOK, response)
+}
+
+// ImportMonitors applies an export document like POST /v1/monitors/import
+// and returns how many monitors were created, updated and skipped.
+func (s *Server) ImportMonitors(ctx context.Context, payload []byte, conflict string) (created, updated, skipped int, err error) {
+	if !slices.Contains(importConflictModes, conflict) {
+		return 0, 0, 0, errors.New("conflict must be one of: " + strings.Join(importConflictModes, ", "))
+	}
+	response, _, err := s.importMonitors(ctx, payload, conflict)
+	if err != nil {
+		return 0, 0, 0, err
+	}
+	return len(response.Created), len(response.Updated), len(response.Skipped), nil
+}
+
+// importMonitors applies payload, failing with the HTTP status to respond
+// with.
+func (s *Server) importMonitors(ctx context.Context, payload []byte, conflict string) (importMonitorsResponse, int, error) {
+	document, err := decodeMonitorExport(payload)
+	if err != nil {
+		return importMonitorsResponse{}, http.StatusBadRequest, err
+	}
+	if len(document.Monitors) > maxImportedMonitors {
+		return importMonitorsResponse{}, http.StatusBadRequest, fmt.Errorf("at most %d monitors can be imported at once", maxImportedMonitors)
+	}
+
+	inputs := make([]normalizedMonitorRequest, 0, len(document.Monitors))
+	for i, req := range document.Monitors {
+		input, err := normalizeMonitorRequest(req)
+		if err == nil {
+			input.auth, err = unmaskMonitorAuth(input.auth, nil)
+		}
+		if err != nil {
+			return importMonitorsResponse{}, http.StatusBadRequest, fmt.Errorf("monitors[%d]: %v", i, err)
+		}
+		if input.headerProfileID != nil {
+			exists, err := s.db.HeaderProfile.Query().
+				Where(headerprofile.IDEQ(*input.headerProfileID)).
+				Exist(ctx)
+			if err != nil {
+				return importMonitorsResponse{}, http.StatusInternalServerError, errors.New("failed to load header profile")
+			}
+			if !exists {
+				return importMonitorsResponse{}, http.StatusBadRequest, fmt.Errorf("monitors[%d]: headerProfileId does not exist", i)
+			}
+		}
+		inputs = append(inputs, input)
+	}
+
+	existing, err := s.db.Monitor.Query().
+		Order(ent.Asc(monitor.FieldID)).
+		WithRuntime().
+		All(ctx)
+	if err != nil {
+		return importMonitorsResponse{}, http.StatusInternalServerError, errors.New("failed to load monitors")
+	}
+	byKey := make(map[string]*ent.Monitor, len(existing))
+	for _, row := range existing {
+		key := monitorImportKey(row.Label, row.URL)
+		if _, ok := byKey[key]; !ok {
+			byKey[key] = row
+		}
+	}
+
+	config, err := s.ensureGlobalSystemConfig(ctx)
+	if err != nil {
+		return importMonitorsResponse{}, http.StatusInternalServerError, errors.New("failed to load runtime settings")
+	}
+	cronLocation := runtimeCronLocation(config.Timezone)
+	now := time.Now().UTC()
+
+	channelStates := s.loadNotificationChannelStates(ctx)
+	tx, err := s.db.Tx(ctx)
+	if err != nil {
+		return importMonitorsResponse{}, http.StatusInternalServerError, errors.New("failed to import monitors")
+	}
+
+	response := importMonitorsResponse{
+		Created: make([]monitorResponse, 0),
+		Updated: make([]monitorResponse, 0),
+		Skipped: make([]importSkippedMonitorResponse, 0),
+	}
+	for i, input := range inputs {
+		match := byKey[monitorImportKey(input.label, input.url)]
+		if match != nil && conflict == "skip" {
+			response.Skipped = append(response.Skipped, importSkippedMonitorResponse{
+				Index:     i,
+				MonitorID: match.ID,
+				Reason:    "already exists",
+			})
+			continue
+		}
+
+		var (
+			row     *ent.Monitor
+			runtime *ent.MonitorRuntime
+		)
+		if match != nil && conflict == "update" {
+			row, runtime, err = updateMonitorWithRuntime(ctx, tx.Client(), match, input, now, cronLocation)
+		} else {
+			row, runtime, err = createMonitorWithRuntime(ctx, tx.Client(), input, now, cronLocation)
+		}
+		if err != nil {
+			_ = tx.Rollback()
+			return importMonitorsResponse{}, http.StatusInternalServerError, fmt.Errorf("monitors[%d]: %v", i, err)
+		}
+
+		mapped := s.mapMonitor(row, runtime, buildMonitorNotificationIssues(row.NotificationChannels, channelStates))
+		if match != nil && conflict == "update" {
+			response.Updated = append(response.Updated, mapped)
+		} else {
+			response.Created = append(response.Created, mapped)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return importMonitorsResponse{}, http.StatusInternalServerError, errors.New("failed to import monitors")
+	}
+	s.scheduleChanges.Publish()
+	for i := range response.Created {
+		s.publishMonitorUpdated(int(response.Created[i].ID), monitorCreated, &response.Created[i])
+	}
+	for i := range response.Updated {
+		s.publishMonitorUpdated(int(response.Updated[i].ID), monitorUpdated, &response.Updated[i])
+	}
+
+	return response, http.StatusOK, nil
+}
+
+// monitorImportKey identifies a monitor across instances by its label, or
+// by its URL when it has none.
+func monitorImportKey(label *string, url string) string {
+	if label != nil && strings.TrimSpace(*label) != "" {
+		return "label:" + strings.TrimSpace(*label)
+	}
+	return "url:" + url
+}
@@ -0,0 +1,363 @@
+package server
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	neturl "net/url"
+	"strings"
+	"unicode"
+
+	"goanna/apps/api/ent/monitor"
+)
+
+// monitorDraftResponse is a monitor configuration read from another format.
+// It is not saved; it uses the field names of createMonitorRequest so it can
+// be completed with a cron and sent to POST /v1/monitors.
+type monitorDraftResponse struct {
+	Method       string            `json:"method"`
+	URL          string            `json:"url"`
+	Body         *string           `json:"body,omitempty"`
+	Headers      map[string]string `json:"headers,omitempty"`
+	UserAgent    *string           `json:"userAgent,omitempty"`
+	Auth         map[string]string `json:"auth,omitempty"`
+	ExpectedType string            `json:"expectedType"`
+}
+
+// curlValueOptions are the curl options read into the draft that take a
+// value, keyed by their short and long names.
+var curlValueOptions = map[string]string{
+	"-X":               "request",
+	"--request":        "request",
+	"-H":               "header",
+	"--header":         "header",
+	"-d":               "data",
+	"--data":           "data",
+	"--data-ascii":     "data",
+	"--data-binary":    "data",
+	"--data-raw":       "data-raw",
+	"--data-urlencode": "data-urlencode",
+	"--json":           "json",
+	"-u":               "user",
+	"--user":           "user",
+	"-A":               "user-agent",
+	"--user-agent":     "user-agent",
+	"-b":               "cookie",
+	"--cookie":         "cookie",
+	"-e":               "referer",
+	"--referer":        "referer",
+	"--url":            "url",
+	"-F":               "form",
+	"--form":           "form",
+	"--form-string":    "form",
+}
+
+// curlIgnoredValueOptions take a value that does not map onto a monitor, so
+// the value is skipped rather than read as the URL.
+var curlIgnoredValueOptions = map[string]struct{}{
+	"-o": {}, "--output": {}, "-m": {}, "--max-time": {}, "--connect-timeout": {},
+	"-w": {}, "--write-out": {}, "--retry": {}, "-x": {}, "--proxy": {}, "-r": {}, "--range": {},
+	"-c": {}, "--cookie-jar": {}, "--cacert": {}, "--cert": {}, "-E": {}, "--key": {},
+	"--resolve": {}, "--connect-to": {}, "--max-redirs": {}, "-T": {}, "--upload-file": {},
+}
+
+// handleImportMonitorCurl reads a pasted curl command into a monitor draft
+// with its method, URL, headers, body and basic auth. Nothing is saved.
+func (s *Server) handleImportMonitorCurl(w http.ResponseWriter, r *http.Request) {
+	payload, err := io.ReadAll(io.LimitReader(r.Body, maxImportBodyBytes+1))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "failed to read request body")
+		return
+	}
+	if len(payload) > maxImportBodyBytes {
+		writeError(w, http.StatusRequestEntityTooLarge, "curl command is too large")
+		return
+	}
+
+	draft, err := parseCurlCommand(string(payload))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	writeJSON(w, http.StatusOK, draft)
+}
+
+// parseCurlCommand reads the request a curl command would send. Options that
+// do not change the request, such as -s or -L, are ignored.
+func parseCurlCommand(command string) (monitorDraftResponse, error) {
+	args, err := splitShellWords(command)
+	if err != nil {
+		return monitorDraftResponse{}, err
+	}
+	if len(args) == 0 || args[0] != "curl" {
+		return monitorDraftResponse{}, errors.New("command must start with curl")
+	}
+
+	var (
+		method   string
+		rawURL   string
+		data     []string
+		getQuery bool
+		jsonBody bool
+		headers  = map[string]string{}
+		draft    monitorDraftResponse
+	)
+	for i := 1; i < len(args); i++ {
+		arg := args[i]
+		if !strings.HasPrefix(arg, "-") || arg == "-" {
+			if rawURL == "" {
+				rawURL = arg
+			}
+			continue
+		}
+
+		name, value, hasValue := arg, "", false
+		if !strings.HasPrefix(arg, "--") && len(arg) > 2 {
+			if _, ok := curlValueOptions[arg[:2]]; ok {
+				name, value, hasValue = arg[:2], arg[2:], true
+			}
+		}
+
+		option, takesValue := curlValueOptions[name]
+		if _, ignored := curlIgnoredValueOptions[name]; ignored {
+			i++
+			continue
+		}
+		if !takesValue {
+			switch name {
+			case "-I", "--head":
+				method = http.MethodHead
+			case "-G", "--get":
+				getQuery = true
+			}
+			continue
+		}
+		if !hasValue {
+			i++
+			if i >= len(args) {
+				return monitorDraftResponse{}, errors.New("curl option " + name + " needs a value")
+			}
+			value = args[i]
+		}
+
+		switch option {
+		case "request":
+			method = strings.ToUpper(strings.TrimSpace(value))
+		case "header":
+			key, headerValue, ok := strings.Cut(value, ":")
+			key, headerValue = strings.TrimSpace(key), strings.TrimSpace(headerValue)
+			if ok && key != "" && headerValue != "" {
+				headers[key] = headerValue
+			}
+		case "data", "json":
+			if strings.HasPrefix(value, "@") {
+				return monitorDraftResponse{}, errors.New("request bodies read from files are not supported")
+			}
+			data = append(data, value)
+			jsonBody = jsonBody || option == "json"
+		case "data-raw":
+			data = append(data, value)
+		case "data-urlencode":
+			data = append(data, encodeCurlURLData(value))
+		case "user":
+			username, password, _ := strings.Cut(value, ":")
+			draft.Auth = map[string]string{"type": "basic", "username": username, "password": password}
+		case "user-agent":
+			draft.UserAgent = &value
+		case "cookie":
+			// Without "=" the value names a cookie file.
+			if strings.Contains(value, "=") {
+				headers["Cookie"] = value
+			}
+		case "referer":
+			headers["Referer"] = value
+		case "url":
+			rawURL = value
+		case "form":
+			return monitorDraftResponse{}, errors.New("multipart form bodies are not supported")
+		}
+	}
+
+	rawURL = strings.TrimSpace(rawURL)
+	if rawURL != "" && !strings.Contains(rawURL, "://") {
+		rawURL = "http://" + rawURL
+	}
+	if !isImportableURL(rawURL) {
+		return monitorDraftResponse{}, errors.New("curl command has no http(s) URL")
+	}
+
+	if len(data) > 0 {
+		joined := strings.Join(data, "&")
+		if getQuery {
+			separator := "?"
+			if strings.Contains(rawURL, "?") {
+				separator = "&"
+			}
+			rawURL += separator + joined
+		} else {
+			draft.Body = &joined
+			if method == "" {
+				method = http.MethodPost
+			}
+			if jsonBody {
+				setDefaultHeader(headers, "Content-Type", "application/json")
+				setDefaultHeader(headers, "Accept", "application/json")
+			} else {
+				setDefaultHeader(headers, "Content-Type", "application/x-www-form-urlencoded")
+			}
+		}
+	}
+	if method == "" {
+		method = http.MethodGet
+	}
+
+	if auth := moveBearerAuth(headers); auth != nil {
+		draft.Auth = auth
+	}
+
+	draft.Method = method
+	draft.URL = rawURL
+	draft.ExpectedType = detectExpectedTypeFromURL(rawURL)
+	for key, value := range headers {
+		if strings.EqualFold(key, "Accept") && strings.Contains(strings.ToLower(value), "json") {
+			draft.ExpectedType = monitor.ExpectedTypeJSON.String()
+		}
+	}
+	if len(headers) > 0 {
+		draft.Headers = headers
+	}
+	return draft, nil
+}
+
+// moveBearerAuth removes a bearer Authorization header from headers and
+// returns it as monitor auth, so the token is stored and masked as a secret.
+func moveBearerAuth(headers map[string]string) map[string]string {
+	for key, value := range headers {
+		if !strings.EqualFold(key, "Authorization") {
+			continue
+		}
+		if scheme, token, ok := strings.Cut(value, " "); ok && strings.EqualFold(scheme, "Bearer") {
+			delete(headers, key)
+			return map[string]string{"type": "bearer", "token": strings.TrimSpace(token)}
+		}
+	}
+	return nil
+}
+
+// encodeCurlURLData encodes a --data-urlencode value the way curl does:
+// "name=content" encodes content, anything else is encoded whole.
+func encodeCurlURLData(value string) string {
+	name, content, ok := strings.Cut(value, "=")
+	if !ok {
+		return neturl.QueryEscape(value)
+	}
+	if name == "" {
+		return neturl.QueryEscape(content)
+	}
+	return name + "=" + neturl.QueryEscape(content)
+}
+
+// setDefaultHeader sets key unless headers already has it in any case.
+func setDefaultHeader(headers map[string]string, key string, value string) {
+	for existing := range headers {
+		if strings.EqualFold(existing, key) {
+			return
+		}
+	}
+	headers[key] = value
+}
+
+// splitShellWords splits a POSIX shell command line into words, following
+// single quotes, double quotes, $'...' strings, backslash escapes and line
+// continuations, as browsers use when copying a request as curl.
+func splitShellWords(command string) ([]string, error) {
+	var (
+		words   []string
+		current strings.Builder
+		inWord  bool
+	)
+	runes := []rune(command)
+	for i := 0; i < len(runes); i++ {
+		c := runes[i]
+		switch {
+		case c == '\\' && i+1 < len(runes) && (runes[i+1] == '\n' || runes[i+1] == '\r'):
+			i++
+			if runes[i] == '\r' && i+1 < len(runes) && runes[i+1] == '\n' {
+				i++
+			}
+		case unicode.IsSpace(c):
+			if inWord {
+				words = append(words, current.String())
+				current.Reset()
+				inWord = false
+			}
+		case c == '\'':
+			inWord = true
+			end := i + 1
+			for end < len(runes) && runes[end] != '\'' {
+				end++
+			}
+			if end >= len(runes) {
+				return nil, errors.New("unterminated single quote in command")
+			}
+			current.WriteString(string(runes[i+1 : end]))
+			i = end
+		case c == '$' && i+1 < len(runes) && runes[i+1] == '\'':
+			inWord = true
+			i += 2
+			for ; i < len(runes) && runes[i] != '\''; i++ {
+				if runes[i] == '\\' && i+1 < len(runes) {
+					i++
+					current.WriteRune(ansiCEscape(runes[i]))
+					continue
+				}
+				current.WriteRune(runes[i])
+			}
+			if i >= len(runes) {
+				return nil, errors.New("unterminated single quote in command")
+			}
+		case c == '"':
+			inWord = true
+			i++
+			for ; i < len(runes) && runes[i] != '"'; i++ {
+				if runes[i] == '\\' && i+1 < len(runes) && strings.ContainsRune("$`\"\\\n", runes[i+1]) {
+					i++
+					if runes[i] == '\n' {
+						continue
+					}
+				}
+				current.WriteRune(runes[i])
+			}
+			if i >= len(runes) {
+				return nil, errors.New("unterminated double quote in command")
+			}
+		case c == '\\' && i+1 < len(runes):
+			inWord = true
+			i++
+			current.WriteRune(runes[i])
+		default:
+			inWord = true
+			current.WriteRune(c)
+		}
+	}
+	if inWord {
+		words = append(words, current.String())
+	}
+	return words, nil
+}
+
+// ansiCEscape returns the character a backslash escape stands for in a
+// $'...' string.
+func ansiCEscape(c rune) rune {
+	switch c {
+	case 'n':
+		return '\n'
+	case 't':
+		return '\t'
+	case 'r':
+		return '\r'
+	default:
+		return c
+	}
+}
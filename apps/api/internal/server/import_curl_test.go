@@ -0,0 +1,100 @@
+package server
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestParseCurlCommand(t *testing.T) {
+	command := "curl 'https://api.example.com/v1/orders?limit=5' \\\n" +
+		"  -X PUT \\\n" +
+		"  -H 'Accept: application/json' \\\n" +
+		"  -H \"Authorization: Bearer s3cret\" \\\n" +
+		"  -b 'session=abc' \\\n" +
+		"  --data-raw $'{\"note\":\"it\\'s\"}' \\\n" +
+		"  --compressed -sSL"
+
+	draft, err := parseCurlCommand(command)
+	if err != nil {
+		t.Fatalf("expected command to parse: %v", err)
+	}
+	if draft.Method != http.MethodPut || draft.URL != "https://api.example.com/v1/orders?limit=5" || draft.ExpectedType != "json" {
+		t.Fatalf("expected PUT JSON draft, got %+v", draft)
+	}
+	if draft.Body == nil || *draft.Body != `{"note":"it's"}` {
+		t.Fatalf("expected ANSI-C quoted body, got %v", draft.Body)
+	}
+	expectedHeaders := map[string]string{
+		"Accept":       "application/json",
+		"Cookie":       "session=abc",
+		"Content-Type": "application/x-www-form-urlencoded",
+	}
+	if !reflect.DeepEqual(draft.Headers, expectedHeaders) {
+		t.Fatalf("expected headers %v, got %v", expectedHeaders, draft.Headers)
+	}
+	if !reflect.DeepEqual(draft.Auth, map[string]string{"type": "bearer", "token": "s3cret"}) {
+		t.Fatalf("expected bearer auth from the Authorization header, got %v", draft.Auth)
+	}
+}
+
+func TestParseCurlCommandOptions(t *testing.T) {
+	draft, err := parseCurlCommand(`curl -u admin:hunter2 -d a=1 --data-urlencode "q=hello world" example.com/search -G`)
+	if err != nil {
+		t.Fatalf("expected command to parse: %v", err)
+	}
+	if draft.Method != http.MethodGet || draft.URL != "http://example.com/search?a=1&q=hello+world" || draft.Body != nil {
+		t.Fatalf("expected -G to move data into the query, got %+v", draft)
+	}
+	if !reflect.DeepEqual(draft.Auth, map[string]string{"type": "basic", "username": "admin", "password": "hunter2"}) {
+		t.Fatalf("expected basic auth from -u, got %v", draft.Auth)
+	}
+
+	draft, err = parseCurlCommand(`curl --json '{"a":1}' -o out.json https://example.com/hook`)
+	if err != nil {
+		t.Fatalf("expected command to parse: %v", err)
+	}
+	if draft.Method != http.MethodPost || draft.URL != "https://example.com/hook" || draft.Headers["Content-Type"] != "application/json" {
+		t.Fatalf("expected JSON POST draft, got %+v", draft)
+	}
+
+	for _, command := range []string{
+		`wget https://example.com`,
+		`curl -H 'Accept: */*'`,
+		`curl -d @body.json https://example.com`,
+		`curl -F file=@a.png https://example.com`,
+		`curl 'https://example.com`,
+	} {
+		if _, err := parseCurlCommand(command); err == nil {
+			t.Fatalf("expected %q to be rejected", command)
+		}
+	}
+}
+
+func TestHandleImportMonitorCurl(t *testing.T) {
+	mux := http.NewServeMux()
+	server := New(nil)
+	mux.HandleFunc("POST /v1/monitors/import/curl", server.handleImportMonitorCurl)
+
+	rec := httptest.NewRecorder()
+	mux.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/v1/monitors/import/curl", strings.NewReader(`curl https://example.com/prices.json`)))
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected 200, got %d: %s", rec.Code, rec.Body.String())
+	}
+	var draft monitorDraftResponse
+	if err := json.Unmarshal(rec.Body.Bytes(), &draft); err != nil {
+		t.Fatalf("expected draft JSON: %v", err)
+	}
+	if draft.Method != http.MethodGet || draft.URL != "https://example.com/prices.json" || draft.ExpectedType != "json" {
+		t.Fatalf("expected GET JSON draft, got %+v", draft)
+	}
+
+	rec = httptest.NewRecorder()
+	mux.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/v1/monitors/import/curl", strings.NewReader(`curl`)))
+	if rec.Code != http.StatusBadRequest {
+		t.Fatalf("expected 400 without a URL, got %d", rec.Code)
+	}
+}
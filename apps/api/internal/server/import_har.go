@@ -0,0 +1,245 @@
+package server
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"goanna/apps/api/ent/monitor"
+
+	"github.com/robfig/cron/v3"
+)
+
+// maxHARImportBytes is larger than other imports because HAR exports carry
+// the response bodies of every request.
+const maxHARImportBytes = 32 * 1024 * 1024
+
+type harFile struct {
+	Log struct {
+		Entries []harEntry `json:"entries"`
+	} `json:"log"`
+}
+
+type harEntry struct {
+	Request struct {
+		Method   string         `json:"method"`
+		URL      string         `json:"url"`
+		Headers  []harNameValue `json:"headers"`
+		PostData *struct {
+			Text string `json:"text"`
+		} `json:"postData"`
+	} `json:"request"`
+	Response struct {
+		Status  int `json:"status"`
+		Content struct {
+			MimeType string `json:"mimeType"`
+		} `json:"content"`
+	} `json:"response"`
+}
+
+type harNameValue struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+type importHARResponse struct {
+	Requests []harRequestResponse `json:"requests"`
+	Created  []monitorResponse    `json:"created"`
+}
+
+type harRequestResponse struct {
+	Entry          int                  `json:"entry"`
+	ResponseStatus int                  `json:"responseStatus"`
+	MimeType       string               `json:"mimeType,omitempty"`
+	Draft          monitorDraftResponse `json:"draft"`
+}
+
+// harSkippedHeaders are request headers the browser or transport sets for a
+// request, which a monitor should not send as fixed values.
+var harSkippedHeaders = map[string]struct{}{
+	"host":              {},
+	"content-length":    {},
+	"connection":        {},
+	"transfer-encoding": {},
+	"upgrade":           {},
+	"keep-alive":        {},
+}
+
+// handleImportMonitorHAR lists the http(s) requests of a HAR export as
+// monitor drafts that keep their method, headers and body. The entry query
+// parameter picks requests by their index in the export to create as monitors,
+// with the cron and tags of the URL import.
+func (s *Server) handleImportMonitorHAR(w http.ResponseWriter, r *http.Request) {
+	payload, err := io.ReadAll(io.LimitReader(r.Body, maxHARImportBytes+1))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "failed to read request body")
+		return
+	}
+	if len(payload) > maxHARImportBytes {
+		writeError(w, http.StatusRequestEntityTooLarge, "HAR file is too large")
+		return
+	}
+
+	var har harFile
+	if err := json.Unmarshal(payload, &har); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid HAR file")
+		return
+	}
+
+	query := r.URL.Query()
+	picked := make(map[int]struct{}, len(query["entry"]))
+	for _, raw := range query["entry"] {
+		entry, err := strconv.Atoi(strings.TrimSpace(raw))
+		if err != nil || entry < 0 || entry >= len(har.Log.Entries) {
+			writeError(w, http.StatusBadRequest, "entry must be the index of a request in the HAR file")
+			return
+		}
+		picked[entry] = struct{}{}
+	}
+	if len(picked) > maxImportedMonitors {
+		writeError(w, http.StatusBadRequest, "too many entries picked")
+		return
+	}
+	cronExpr := strings.TrimSpace(query.Get("cron"))
+	if cronExpr == "" {
+		cronExpr = defaultImportCron
+	}
+	if _, err := cron.ParseStandard(cronExpr); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid cron expression")
+		return
+	}
+	tags, err := normalizeMonitorTags(query["tag"])
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	requests := make([]harRequestResponse, 0, len(har.Log.Entries))
+	inputs := make([]normalizedMonitorRequest, 0, len(picked))
+	for index, entry := range har.Log.Entries {
+		_, pick := picked[index]
+		if !isImportableURL(entry.Request.URL) {
+			if pick {
+				writeError(w, http.StatusBadRequest, "entry "+strconv.Itoa(index)+" is not an http(s) request")
+				return
+			}
+			continue
+		}
+
+		draft := harEntryDraft(entry)
+		requests = append(requests, harRequestResponse{
+			Entry:          index,
+			ResponseStatus: entry.Response.Status,
+			MimeType:       entry.Response.Content.MimeType,
+			Draft:          draft,
+		})
+		if !pick {
+			continue
+		}
+
+		input, err := normalizeMonitorRequest(createMonitorRequest{
+			Method:       draft.Method,
+			URL:          draft.URL,
+			Body:         draft.Body,
+			Headers:      draft.Headers,
+			Auth:         draft.Auth,
+			ExpectedType: draft.ExpectedType,
+			Cron:         cronExpr,
+			Tags:         tags,
+		})
+		if err == nil {
+			input.auth, err = unmaskMonitorAuth(input.auth, nil)
+		}
+		if err != nil {
+			writeError(w, http.StatusBadRequest, "entry "+strconv.Itoa(index)+": "+err.Error())
+			return
+		}
+		inputs = append(inputs, input)
+	}
+
+	created := make([]monitorResponse, 0, len(inputs))
+	if len(inputs) > 0 {
+		config, err := s.ensureGlobalSystemConfig(r.Context())
+		if err != nil {
+			writeError(w, http.StatusInternalServerError, "failed to load runtime settings")
+			return
+		}
+		cronLocation := runtimeCronLocation(config.Timezone)
+		now := time.Now().UTC()
+
+		channelStates := s.loadNotificationChannelStates(r.Context())
+		tx, err := s.db.Tx(r.Context())
+		if err != nil {
+			writeError(w, http.StatusInternalServerError, "failed to import monitors")
+			return
+		}
+		for _, input := range inputs {
+			row, runtime, err := createMonitorWithRuntime(r.Context(), tx.Client(), input, now, cronLocation)
+			if err != nil {
+				_ = tx.Rollback()
+				writeError(w, http.StatusInternalServerError, "failed to import monitors")
+				return
+			}
+			created = append(created, s.mapMonitor(
+				row,
+				runtime,
+				buildMonitorNotificationIssues(row.NotificationChannels, channelStates),
+			))
+		}
+		if err := tx.Commit(); err != nil {
+			writeError(w, http.StatusInternalServerError, "failed to import monitors")
+			return
+		}
+		s.scheduleChanges.Publish()
+		for i := range created {
+			s.publishMonitorUpdated(int(created[i].ID), monitorCreated, &created[i])
+		}
+	}
+
+	writeJSON(w, http.StatusOK, importHARResponse{
+		Requests: requests,
+		Created:  created,
+	})
+}
+
+// harEntryDraft returns the monitor draft for the request of a HAR entry. A
+// bearer Authorization header becomes monitor auth, and a JSON response makes
+// the draft a JSON monitor.
+func harEntryDraft(entry harEntry) monitorDraftResponse {
+	draft := monitorDraftResponse{
+		Method:       strings.ToUpper(strings.TrimSpace(entry.Request.Method)),
+		URL:          entry.Request.URL,
+		ExpectedType: detectExpectedTypeFromURL(entry.Request.URL),
+	}
+	if draft.Method == "" {
+		draft.Method = http.MethodGet
+	}
+	if entry.Request.PostData != nil && entry.Request.PostData.Text != "" {
+		body := entry.Request.PostData.Text
+		draft.Body = &body
+	}
+	if strings.Contains(strings.ToLower(entry.Response.Content.MimeType), "json") {
+		draft.ExpectedType = monitor.ExpectedTypeJSON.String()
+	}
+
+	headers := make(map[string]string, len(entry.Request.Headers))
+	for _, header := range entry.Request.Headers {
+		name := strings.TrimSpace(header.Name)
+		// HTTP/2 exports list pseudo-headers such as :authority.
+		if name == "" || strings.HasPrefix(name, ":") {
+			continue
+		}
+		if _, skip := harSkippedHeaders[strings.ToLower(name)]; skip {
+			continue
+		}
+		headers[name] = header.Value
+	}
+	draft.Auth = moveBearerAuth(headers)
+	if len(headers) > 0 {
+		draft.Headers = headers
+	}
+	return draft
+}
@@ -0,0 +1,100 @@
+package server
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+
+	"goanna/apps/api/ent/enttest"
+
+	_ "github.com/mattn/go-sqlite3"
+)
+
+const testHARFile = `{"log":{"entries":[
+	{"request":{"method":"GET","url":"https://example.com/","headers":[{"name":":authority","value":"example.com"}]},
+	 "response":{"status":200,"content":{"mimeType":"text/html"}}},
+	{"request":{"method":"GET","url":"data:image/png;base64,AAAA","headers":[]},
+	 "response":{"status":200,"content":{"mimeType":"image/png"}}},
+	{"request":{"method":"POST","url":"https://example.com/graphql",
+	  "headers":[{"name":"Content-Type","value":"application/json"},{"name":"Content-Length","value":"17"},{"name":"authorization","value":"Bearer s3cret"}],
+	  "postData":{"mimeType":"application/json","text":"{\"query\":\"{me}\"}"}},
+	 "response":{"status":200,"content":{"mimeType":"application/json; charset=utf-8","text":"{}"}}}
+]}}`
+
+func TestHandleImportMonitorHARListsRequests(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:monitor-import-har?mode=memory&cache=shared&_fk=1")
+	defer client.Close()
+
+	mux := http.NewServeMux()
+	New(client).RegisterRoutes(mux)
+
+	rec := httptest.NewRecorder()
+	mux.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/v1/monitors/import/har", strings.NewReader(testHARFile)))
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected 200, got %d: %s", rec.Code, rec.Body.String())
+	}
+
+	var response importHARResponse
+	if err := json.Unmarshal(rec.Body.Bytes(), &response); err != nil {
+		t.Fatalf("expected import JSON: %v", err)
+	}
+	if len(response.Requests) != 2 || len(response.Created) != 0 {
+		t.Fatalf("expected two http requests and nothing created, got %+v", response)
+	}
+	if response.Requests[0].Entry != 0 || response.Requests[0].Draft.Headers != nil {
+		t.Fatalf("expected the page request without pseudo-headers, got %+v", response.Requests[0])
+	}
+
+	graphql := response.Requests[1]
+	if graphql.Entry != 2 || graphql.Draft.Method != http.MethodPost || graphql.Draft.ExpectedType != "json" ||
+		graphql.Draft.Body == nil || *graphql.Draft.Body != `{"query":"{me}"}` {
+		t.Fatalf("expected the POST request as a JSON draft, got %+v", graphql)
+	}
+	if !reflect.DeepEqual(graphql.Draft.Headers, map[string]string{"Content-Type": "application/json"}) {
+		t.Fatalf("expected transport headers and auth to be dropped, got %v", graphql.Draft.Headers)
+	}
+	if !reflect.DeepEqual(graphql.Draft.Auth, map[string]string{"type": "bearer", "token": "s3cret"}) {
+		t.Fatalf("expected bearer auth, got %v", graphql.Draft.Auth)
+	}
+}
+
+func TestHandleImportMonitorHARCreatesPickedRequests(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:monitor-import-har-pick?mode=memory&cache=shared&_fk=1")
+	defer client.Close()
+
+	mux := http.NewServeMux()
+	New(client).RegisterRoutes(mux)
+
+	rec := httptest.NewRecorder()
+	mux.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/v1/monitors/import/har?entry=2&cron=*/10+*+*+*+*&tag=api", strings.NewReader(testHARFile)))
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected 200, got %d: %s", rec.Code, rec.Body.String())
+	}
+
+	var response importHARResponse
+	if err := json.Unmarshal(rec.Body.Bytes(), &response); err != nil {
+		t.Fatalf("expected import JSON: %v", err)
+	}
+	if len(response.Created) != 1 {
+		t.Fatalf("expected one created monitor, got %+v", response.Created)
+	}
+	created := response.Created[0]
+	if created.Method != http.MethodPost || created.URL != "https://example.com/graphql" || created.Cron != "*/10 * * * *" ||
+		created.Body == nil || created.Headers["Content-Type"] != "application/json" || !reflect.DeepEqual(created.Tags, []string{"api"}) {
+		t.Fatalf("expected the picked request as a monitor, got %+v", created)
+	}
+
+	for _, target := range []string{"/v1/monitors/import/har?entry=1", "/v1/monitors/import/har?entry=9"} {
+		rec = httptest.NewRecorder()
+		mux.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, target, strings.NewReader(testHARFile)))
+		if rec.Code != http.StatusBadRequest {
+			t.Fatalf("expected 400 for %s, got %d: %s", target, rec.Code, rec.Body.String())
+		}
+	}
+	if count := client.Monitor.Query().CountX(t.Context()); count != 1 {
+		t.Fatalf("expected rejected picks to create nothing, got %d monitors", count)
+	}
+}
@@ -0,0 +1,104 @@
+package server
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+
+	"goanna/apps/api/ent/enttest"
+
+	_ "github.com/mattn/go-sqlite3"
+)
+
+func TestDetectExpectedTypeFromURL(t *testing.T) {
+	cases := map[string]string{
+		"https://example.com/data/prices.json": "json",
+		"https://api.example.com/v1/status":    "json",
+		"https://example.com/api/items":        "json",
+		"https://example.com/robots.txt":       "text",
+		"https://example.com/blog":             "html",
+		"https://example.com/news.rss":         "feed",
+		"https://example.com/sitemap.xml":      "sitemap",
+	}
+
+	for url, expected := range cases {
+		if actual := detectExpectedTypeFromURL(url); actual != expected {
+			t.Fatalf("expected %q for %s, got %q", expected, url, actual)
+		}
+	}
+}
+
+func TestHandleImportMonitorURLs(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:monitor-import?mode=memory&cache=shared&_fk=1")
+	defer client.Close()
+
+	if _, err := client.Monitor.Create().
+		SetURL("https://example.com/existing").
+		SetCron("*/5 * * * *").
+		Save(t.Context()); err != nil {
+		t.Fatalf("expected monitor to save: %v", err)
+	}
+
+	mux := http.NewServeMux()
+	New(client).RegisterRoutes(mux)
+
+	watchlist := strings.Join([]string{
+		"# shops",
+		"https://example.com/pricing",
+		"",
+		"https://api.example.com/v1/stock",
+		"https://example.com/existing",
+		"https://example.com/pricing",
+		"ftp://example.com/file",
+	}, "\n")
+	req := httptest.NewRequest(http.MethodPost, "/v1/monitors/import/urls?tag=Shops&tag=q3", strings.NewReader(watchlist))
+	req.Header.Set("Content-Type", "text/plain")
+	rec := httptest.NewRecorder()
+	mux.ServeHTTP(rec, req)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected 200, got %d: %s", rec.Code, rec.Body.String())
+	}
+
+	var response importMonitorURLsResponse
+	if err := json.Unmarshal(rec.Body.Bytes(), &response); err != nil {
+		t.Fatalf("expected import JSON: %v", err)
+	}
+	if len(response.Created) != 2 {
+		t.Fatalf("expected 2 created monitors, got %#v", response.Created)
+	}
+	if response.Created[0].ExpectedType != "html" || response.Created[1].ExpectedType != "json" {
+		t.Fatalf("expected detected types html/json, got %q/%q", response.Created[0].ExpectedType, response.Created[1].ExpectedType)
+	}
+	if response.Created[0].Cron != defaultImportCron || response.Created[0].NextRunAt == nil {
+		t.Fatalf("expected default cron with scheduled run, got %#v", response.Created[0])
+	}
+	if !reflect.DeepEqual(response.Created[0].Tags, []string{"q3", "shops"}) {
+		t.Fatalf("expected normalized tags, got %#v", response.Created[0].Tags)
+	}
+
+	reasons := make([]string, 0, len(response.Skipped))
+	for _, skipped := range response.Skipped {
+		reasons = append(reasons, skipped.Reason)
+	}
+	if !reflect.DeepEqual(reasons, []string{"already monitored", "already monitored", "not an http(s) URL"}) {
+		t.Fatalf("unexpected skipped lines %#v", response.Skipped)
+	}
+	if response.Skipped[2].Line != 7 {
+		t.Fatalf("expected skipped line number 7, got %d", response.Skipped[2].Line)
+	}
+
+	count, err := client.Monitor.Query().Count(t.Context())
+	if err != nil || count != 3 {
+		t.Fatalf("expected 3 monitors after import, got %d (%v)", count, err)
+	}
+
+	req = httptest.NewRequest(http.MethodPost, "/v1/monitors/import/urls?cron=nope", strings.NewReader("https://example.com/new"))
+	rec = httptest.NewRecorder()
+	mux.ServeHTTP(rec, req)
+	if rec.Code != http.StatusBadRequest {
+		t.Fatalf("expected 400 for invalid cron, got %d", rec.Code)
+	}
+}
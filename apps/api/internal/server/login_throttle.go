@@ -0,0 +1,97 @@
+package server
+
+import (
+	"net"
+	"net/http"
+	"sync"
+	"time"
+)
+
+const (
+	loginFailureWindow         = 15 * time.Minute
+	maxLoginFailuresPerUser    = 10
+	maxLoginFailuresPerAddress = 30
+	// loginThrottlePruneSize is how many tracked keys trigger dropping the
+	// ones whose window has passed.
+	loginThrottlePruneSize = 1024
+)
+
+// loginThrottle counts failed sign-ins per username and per client address
+// and refuses further attempts until the window of the first failure has
+// passed once either reaches its limit. Counts are kept in memory, so each
+// replica throttles on its own.
+type loginThrottle struct {
+	mu       sync.Mutex
+	failures map[string]loginFailures
+}
+
+type loginFailures struct {
+	count int
+	since time.Time
+}
+
+func newLoginThrottle() *loginThrottle {
+	return &loginThrottle{failures: map[string]loginFailures{}}
+}
+
+// retryAfter returns how long sign-ins for username from r stay refused, or
+// zero when they are allowed.
+func (t *loginThrottle) retryAfter(r *http.Request, username string, now time.Time) time.Duration {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	var wait time.Duration
+	for key, limit := range loginThrottleKeys(r, username) {
+		entry, ok := t.failures[key]
+		if !ok || entry.count < limit {
+			continue
+		}
+		if remaining := entry.since.Add(loginFailureWindow).Sub(now); remaining > wait {
+			wait = remaining
+		}
+	}
+	return wait
+}
+
+// fail records a failed sign-in for username from r.
+func (t *loginThrottle) fail(r *http.Request, username string, now time.Time) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if len(t.failures) >= loginThrottlePruneSize {
+		for key, entry := range t.failures {
+			if !now.Before(entry.since.Add(loginFailureWindow)) {
+				delete(t.failures, key)
+			}
+		}
+	}
+	for key := range loginThrottleKeys(r, username) {
+		entry, ok := t.failures[key]
+		if !ok || !now.Before(entry.since.Add(loginFailureWindow)) {
+			entry = loginFailures{since: now}
+		}
+		entry.count++
+		t.failures[key] = entry
+	}
+}
+
+// succeed clears the failures of username. The address keeps its count, so
+// signing in to one account does not reset guessing at others.
+func (t *loginThrottle) succeed(username string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	delete(t.failures, "user:"+username)
+}
+
+// loginThrottleKeys returns the throttle keys of a sign-in with their limits.
+func loginThrottleKeys(r *http.Request, username string) map[string]int {
+	address := r.RemoteAddr
+	if host, _, err := net.SplitHostPort(address); err == nil {
+		address = host
+	}
+	return map[string]int{
+		"user:" + username:   maxLoginFailuresPerUser,
+		"address:" + address: maxLoginFailuresPerAddress,
+	}
+}
@@ -0,0 +1,110 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"time"
+
+	"goanna/apps/api/ent"
+	"goanna/apps/api/internal/worker"
+)
+
+type dryRunMonitorRequest struct {
+	createMonitorRequest
+	// MonitorID fills in masked auth values from a saved monitor when the url
+	// keeps its origin, and is the monitor whose latest stored check the
+	// result is diffed against.
+	MonitorID *int `json:"monitorId"`
+}
+
+// handleDryRunMonitor runs one check of a monitor config through the whole
+// check pipeline, including the expected type, selector and expected response,
+// without saving the monitor or the check. With a monitorId it also reports
+// the diff the check would record against that monitor's latest check.
+func (s *Server) handleDryRunMonitor(w http.ResponseWriter, r *http.Request) {
+	var req dryRunMonitorRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid JSON body")
+		return
+	}
+
+	input, err := normalizeMonitorRequest(req.createMonitorRequest)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	var saved *ent.Monitor
+	if req.MonitorID != nil {
+		saved, err = s.db.Monitor.Get(r.Context(), *req.MonitorID)
+		if err != nil {
+			if ent.IsNotFound(err) {
+				writeError(w, http.StatusBadRequest, "monitorId does not exist")
+				return
+			}
+			writeError(w, http.StatusInternalServerError, "failed to load monitor")
+			return
+		}
+	}
+	input.auth, err = unmaskSavedMonitorAuth(input.auth, saved, input.url, time.Now().UTC())
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	row, err := s.unsavedMonitor(r.Context(), input)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	if req.MonitorID != nil {
+		row.ID = *req.MonitorID
+	}
+	if input.headerProfileID != nil {
+		profile, err := s.db.HeaderProfile.Get(r.Context(), *input.headerProfileID)
+		if err != nil {
+			if ent.IsNotFound(err) {
+				writeError(w, http.StatusBadRequest, "headerProfileId does not exist")
+				return
+			}
+			writeError(w, http.StatusInternalServerError, "failed to load header profile")
+			return
+		}
+		row.Edges.HeaderProfile = profile
+	}
+
+	preview, err := s.triggerWorker.PreviewCheck(r.Context(), row)
+	if err != nil {
+		if errors.Is(err, worker.ErrHeartbeatMonitor) {
+			writeError(w, http.StatusBadRequest, "heartbeat monitors cannot be tested")
+			return
+		}
+		writeError(w, http.StatusInternalServerError, "failed to run check")
+		return
+	}
+
+	writeJSON(w, http.StatusOK, preview)
+}
+
+// unsavedMonitor returns the monitor input describes, as it would be saved,
+// for running checks without saving it. It goes through the create path in a
+// transaction that is rolled back, so defaults and new fields match a saved
+// monitor.
+func (s *Server) unsavedMonitor(ctx context.Context, input normalizedMonitorRequest) (*ent.Monitor, error) {
+	tx, err := s.db.Tx(ctx)
+	if err != nil {
+		return nil, errors.New("failed to prepare monitor")
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	row, _, err := createMonitorWithRuntime(ctx, tx.Client(), input, time.Now().UTC(), time.UTC)
+	if err != nil {
+		return nil, err
+	}
+	row.ID = 0
+	row.SortIndex = 0
+	row.Edges = ent.MonitorEdges{}
+	return row, nil
+}
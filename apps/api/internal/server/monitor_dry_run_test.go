@@ -0,0 +1,140 @@
+package server
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"goanna/apps/api/ent/enttest"
+	"goanna/apps/api/internal/worker"
+
+	_ "github.com/mattn/go-sqlite3"
+)
+
+func TestHandleDryRunMonitorReportsVerdictAndDiff(t *testing.T) {
+	target := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"status":"up"}`))
+	}))
+	defer target.Close()
+
+	client := enttest.Open(t, "sqlite3", "file:monitor-dry-run?mode=memory&cache=shared&_fk=1")
+	defer client.Close()
+
+	row, err := client.Monitor.Create().
+		SetURL(target.URL).
+		SetSelector("status").
+		SetCron("*/5 * * * *").
+		Save(t.Context())
+	if err != nil {
+		t.Fatalf("expected monitor to save: %v", err)
+	}
+	seedMonitorCheck(t, client, row.ID, "string", "down", time.Now().Add(-time.Minute))
+
+	mux := http.NewServeMux()
+	New(client).RegisterRoutes(mux)
+
+	dryRun := func(expectedResponse string) worker.CheckPreview {
+		t.Helper()
+
+		body := fmt.Sprintf(`{"url":%q,"cron":"*/5 * * * *","selector":"status","expectedResponse":%q,"monitorId":%d}`, target.URL, expectedResponse, row.ID)
+		req := httptest.NewRequest(http.MethodPost, "/v1/monitors/dry-run", strings.NewReader(body))
+		rec := httptest.NewRecorder()
+		mux.ServeHTTP(rec, req)
+		if rec.Code != http.StatusOK {
+			t.Fatalf("expected 200, got %d: %s", rec.Code, rec.Body.String())
+		}
+
+		var preview worker.CheckPreview
+		if err := json.Unmarshal(rec.Body.Bytes(), &preview); err != nil {
+			t.Fatalf("expected check JSON: %v", err)
+		}
+		return preview
+	}
+
+	passed := dryRun("up")
+	if !passed.Success || passed.SelectionValue == nil || *passed.SelectionValue != "up" {
+		t.Fatalf("expected passing check selecting up, got %+v", passed)
+	}
+	if passed.Diff == nil || !passed.Diff.Changed {
+		t.Fatalf("expected a change from the stored selection, got %+v", passed.Diff)
+	}
+
+	failed := dryRun("down")
+	if failed.Success || failed.Error == nil {
+		t.Fatalf("expected failing check with an error, got %+v", failed)
+	}
+
+	if count := client.CheckResult.Query().CountX(t.Context()); count != 1 {
+		t.Fatalf("expected dry runs to save no checks, got %d stored", count)
+	}
+}
+
+func TestHandleDryRunMonitorRejectsHeartbeatMonitors(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:monitor-dry-run-heartbeat?mode=memory&cache=shared&_fk=1")
+	defer client.Close()
+
+	mux := http.NewServeMux()
+	New(client).RegisterRoutes(mux)
+
+	req := httptest.NewRequest(http.MethodPost, "/v1/monitors/dry-run", strings.NewReader(`{"fetchMode":"heartbeat","cron":"*/5 * * * *"}`))
+	rec := httptest.NewRecorder()
+	mux.ServeHTTP(rec, req)
+	if rec.Code != http.StatusBadRequest {
+		t.Fatalf("expected 400, got %d: %s", rec.Code, rec.Body.String())
+	}
+}
+
+func TestHandleDryRunMonitorKeepsSavedAuthOnItsHost(t *testing.T) {
+	var savedHostAuth, otherHostAuth string
+	savedHost := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		savedHostAuth = r.Header.Get("Authorization")
+		_, _ = w.Write([]byte("ok"))
+	}))
+	defer savedHost.Close()
+	otherHost := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		otherHostAuth = r.Header.Get("Authorization")
+		_, _ = w.Write([]byte("ok"))
+	}))
+	defer otherHost.Close()
+
+	client := enttest.Open(t, "sqlite3", "file:monitor-dry-run-auth?mode=memory&cache=shared&_fk=1")
+	defer client.Close()
+
+	row, err := client.Monitor.Create().
+		SetURL(savedHost.URL + "/status").
+		SetAuth(map[string]string{"type": "bearer", "token": "saved-token-value"}).
+		SetCron("*/5 * * * *").
+		Save(t.Context())
+	if err != nil {
+		t.Fatalf("expected monitor to save: %v", err)
+	}
+
+	mux := http.NewServeMux()
+	New(client).RegisterRoutes(mux)
+	dryRun := func(url string) *httptest.ResponseRecorder {
+		body := fmt.Sprintf(`{"url":%q,"cron":"*/5 * * * *","expectedType":"text","auth":{"type":"bearer","token":"••••alue"},"monitorId":%d}`, url, row.ID)
+		rec := httptest.NewRecorder()
+		mux.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/v1/monitors/dry-run", strings.NewReader(body)))
+		return rec
+	}
+
+	if rec := dryRun(savedHost.URL + "/other"); rec.Code != http.StatusOK {
+		t.Fatalf("expected 200 on the saved host, got %d: %s", rec.Code, rec.Body.String())
+	}
+	if savedHostAuth != "Bearer saved-token-value" {
+		t.Fatalf("expected the saved token on the saved host, got %q", savedHostAuth)
+	}
+
+	rec := dryRun(otherHost.URL + "/status")
+	if rec.Code != http.StatusBadRequest || !strings.Contains(rec.Body.String(), "auth.token") {
+		t.Fatalf("expected a masked token for another host to be rejected, got %d: %s", rec.Code, rec.Body.String())
+	}
+	if otherHostAuth != "" {
+		t.Fatalf("expected no request with the saved token to another host, got %q", otherHostAuth)
+	}
+}
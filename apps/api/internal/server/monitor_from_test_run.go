@@ -0,0 +1,117 @@
+package server
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"goanna/apps/api/ent"
+	selectorutil "goanna/apps/api/internal/selector"
+)
+
+type monitorFromTestRequest struct {
+	testMonitorRequest
+	Label    *string `json:"label"`
+	Cron     string  `json:"cron"`
+	Selector *string `json:"selector"`
+	// SelectorPayloadToken is the tested response the selector was previewed
+	// against. When set, the selector must match something in it.
+	SelectorPayloadToken *string `json:"selectorPayloadToken"`
+}
+
+// handleCreateMonitorFromTest creates a JSON monitor from the request of a
+// monitor test, its previewed selector, a cron and a label, so a tested
+// request does not have to be entered again. Like the test, it takes a
+// monitorId to fill masked auth values in from that monitor.
+func (s *Server) handleCreateMonitorFromTest(w http.ResponseWriter, r *http.Request) {
+	var req monitorFromTestRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid JSON body")
+		return
+	}
+
+	input, err := normalizeMonitorRequest(createMonitorRequest{
+		Label:           req.Label,
+		Method:          req.Method,
+		URL:             req.URL,
+		Body:            req.Body,
+		Headers:         req.Headers,
+		UserAgent:       req.UserAgent,
+		HeaderProfileID: req.HeaderProfileID,
+		Auth:            req.Auth,
+		Selector:        req.Selector,
+		Cron:            req.Cron,
+	})
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	if !s.requireHeaderProfile(w, r, input.headerProfileID) {
+		return
+	}
+
+	var storedAuth map[string]string
+	if req.MonitorID != nil {
+		saved, err := s.db.Monitor.Get(r.Context(), *req.MonitorID)
+		if err != nil {
+			if ent.IsNotFound(err) {
+				writeError(w, http.StatusBadRequest, "monitorId does not exist")
+				return
+			}
+			writeError(w, http.StatusInternalServerError, "failed to load monitor")
+			return
+		}
+		storedAuth = saved.Auth
+	}
+	input.auth, err = unmaskMonitorAuth(input.auth, storedAuth)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	if token := normalizeOptionalString(req.SelectorPayloadToken); token != nil && input.selector != nil {
+		payload, ok := s.loadSelectorPayload(r.Context(), *token)
+		if !ok {
+			writeError(w, http.StatusBadRequest, "selector payload unavailable, run test again or increase GOANNA_MAX_RESPONSE_BODY_BYTES")
+			return
+		}
+		selection, err := selectorutil.SelectJSON(payload, *input.selector)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, "tested response is not valid JSON")
+			return
+		}
+		if !selection.Exists {
+			writeError(w, http.StatusBadRequest, "selector matches nothing in the tested response")
+			return
+		}
+	}
+
+	config, err := s.ensureGlobalSystemConfig(r.Context())
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "failed to load runtime settings")
+		return
+	}
+
+	created, runtime, err := createMonitorWithRuntime(
+		r.Context(),
+		s.db,
+		input,
+		time.Now().UTC(),
+		runtimeCronLocation(config.Timezone),
+	)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	s.scheduleChanges.Publish()
+
+	channelStates := s.loadNotificationChannelStates(r.Context())
+	mapped := s.mapMonitor(
+		created,
+		runtime,
+		buildMonitorNotificationIssues(created.NotificationChannels, channelStates),
+	)
+	s.publishMonitorUpdated(created.ID, monitorCreated, &mapped)
+
+	writeJSON(w, http.StatusCreated, mapped)
+}
@@ -0,0 +1,61 @@
+package server
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"goanna/apps/api/ent/enttest"
+
+	_ "github.com/mattn/go-sqlite3"
+)
+
+func TestHandleCreateMonitorFromTestCarriesSelector(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:monitor-from-test?mode=memory&cache=shared&_fk=1")
+	defer client.Close()
+
+	server := New(client)
+	mux := http.NewServeMux()
+	server.RegisterRoutes(mux)
+	token := server.storeSelectorPayload(t.Context(), []byte(`{"data":{"price":12}}`), DefaultMaxSelectorPayloadBytes)
+
+	body := `{"method":"POST","url":"https://shop.example.com/prices","body":"{}","headers":{"X-Env":"staging"},` +
+		`"label":"Shop prices","cron":"*/5 * * * *","selector":"data.price","selectorPayloadToken":"` + token + `"}`
+	rec := httptest.NewRecorder()
+	mux.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/v1/monitors/from-test", strings.NewReader(body)))
+	if rec.Code != http.StatusCreated {
+		t.Fatalf("expected 201, got %d: %s", rec.Code, rec.Body.String())
+	}
+
+	var created monitorResponse
+	if err := json.Unmarshal(rec.Body.Bytes(), &created); err != nil {
+		t.Fatalf("expected monitor JSON: %v", err)
+	}
+	if created.Label == nil || *created.Label != "Shop prices" || created.Method != http.MethodPost ||
+		created.Selector == nil || *created.Selector != "data.price" || created.Headers["X-Env"] != "staging" ||
+		created.Cron != "*/5 * * * *" || !created.Enabled {
+		t.Fatalf("expected tested request to be saved as a monitor, got %+v", created)
+	}
+}
+
+func TestHandleCreateMonitorFromTestRejectsUnmatchedSelector(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:monitor-from-test-unmatched?mode=memory&cache=shared&_fk=1")
+	defer client.Close()
+
+	server := New(client)
+	mux := http.NewServeMux()
+	server.RegisterRoutes(mux)
+	token := server.storeSelectorPayload(t.Context(), []byte(`{"data":{"price":12}}`), DefaultMaxSelectorPayloadBytes)
+
+	body := `{"url":"https://shop.example.com/prices","cron":"*/5 * * * *","selector":"data.stock","selectorPayloadToken":"` + token + `"}`
+	rec := httptest.NewRecorder()
+	mux.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/v1/monitors/from-test", strings.NewReader(body)))
+	if rec.Code != http.StatusBadRequest {
+		t.Fatalf("expected 400, got %d: %s", rec.Code, rec.Body.String())
+	}
+	if count := client.Monitor.Query().CountX(t.Context()); count != 0 {
+		t.Fatalf("expected no monitor to be created, got %d", count)
+	}
+}
@@ -0,0 +1,131 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+
+	"goanna/apps/api/ent"
+	"goanna/apps/api/ent/monitor"
+)
+
+type monitorOrderRequest struct {
+	MonitorIDs []int `json:"monitorIds"`
+}
+
+type monitorOrderResponse struct {
+	MonitorIDs []int `json:"monitorIds"`
+}
+
+// handleReorderMonitors stores a user-chosen monitor order. The listed
+// monitors come first, in the order given; monitors left out keep their
+// relative order after them.
+func (s *Server) handleReorderMonitors(w http.ResponseWriter, r *http.Request) {
+	var req monitorOrderRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid JSON body")
+		return
+	}
+	if len(req.MonitorIDs) == 0 {
+		writeError(w, http.StatusBadRequest, "monitorIds must not be empty")
+		return
+	}
+	seen := make(map[int]struct{}, len(req.MonitorIDs))
+	for _, monitorID := range req.MonitorIDs {
+		if monitorID <= 0 {
+			writeError(w, http.StatusBadRequest, "monitorIds must be positive integers")
+			return
+		}
+		if _, ok := seen[monitorID]; ok {
+			writeError(w, http.StatusBadRequest, fmt.Sprintf("monitorIds lists monitor %d more than once", monitorID))
+			return
+		}
+		seen[monitorID] = struct{}{}
+	}
+
+	tx, err := s.db.Tx(r.Context())
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "failed to reorder monitors")
+		return
+	}
+
+	rows, err := tx.Monitor.Query().
+		Select(monitor.FieldSortIndex, monitor.FieldUpdatedAt).
+		Order(ent.Asc(monitor.FieldSortIndex), ent.Asc(monitor.FieldID)).
+		All(r.Context())
+	if err != nil {
+		_ = tx.Rollback()
+		writeError(w, http.StatusInternalServerError, "failed to load monitors")
+		return
+	}
+
+	byID := make(map[int]*ent.Monitor, len(rows))
+	for _, row := range rows {
+		byID[row.ID] = row
+	}
+	missing := make([]string, 0)
+	for _, monitorID := range req.MonitorIDs {
+		if _, ok := byID[monitorID]; !ok {
+			missing = append(missing, fmt.Sprint(monitorID))
+		}
+	}
+	if len(missing) > 0 {
+		_ = tx.Rollback()
+		writeError(w, http.StatusNotFound, "monitors not found: "+strings.Join(missing, ", "))
+		return
+	}
+
+	ordered := slices.Clone(req.MonitorIDs)
+	for _, row := range rows {
+		if _, ok := seen[row.ID]; !ok {
+			ordered = append(ordered, row.ID)
+		}
+	}
+
+	changed := make([]int, 0)
+	for index, monitorID := range ordered {
+		row := byID[monitorID]
+		if row.SortIndex == index {
+			continue
+		}
+		// Reordering is not a configuration change, so updated_at is kept.
+		if err := tx.Monitor.UpdateOneID(monitorID).
+			SetSortIndex(index).
+			SetUpdatedAt(row.UpdatedAt).
+			Exec(r.Context()); err != nil {
+			_ = tx.Rollback()
+			writeError(w, http.StatusInternalServerError, "failed to reorder monitors")
+			return
+		}
+		changed = append(changed, monitorID)
+	}
+	if err := tx.Commit(); err != nil {
+		writeError(w, http.StatusInternalServerError, "failed to reorder monitors")
+		return
+	}
+
+	if len(changed) > 0 {
+		s.publishBulkMonitorUpdates(r.Context(), "reorder", changed)
+	}
+
+	writeJSON(w, http.StatusOK, monitorOrderResponse{MonitorIDs: ordered})
+}
+
+// nextMonitorSortIndex returns the sort index that places a new monitor after
+// every existing one.
+func nextMonitorSortIndex(ctx context.Context, client *ent.Client) (int, error) {
+	last, err := client.Monitor.Query().
+		Select(monitor.FieldSortIndex).
+		Order(ent.Desc(monitor.FieldSortIndex)).
+		First(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return 0, nil
+		}
+		return 0, err
+	}
+	return last.SortIndex + 1, nil
+}
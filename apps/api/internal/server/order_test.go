@@ -0,0 +1,91 @@
+package server
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"testing"
+
+	"goanna/apps/api/ent/enttest"
+
+	_ "github.com/mattn/go-sqlite3"
+)
+
+func TestHandleReorderMonitors(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:monitor-order?mode=memory&cache=shared&_fk=1")
+	defer client.Close()
+
+	mux := http.NewServeMux()
+	New(client).RegisterRoutes(mux)
+
+	create := func(url string) int {
+		rec := httptest.NewRecorder()
+		body := fmt.Sprintf(`{"url":%q,"cron":"*/5 * * * *"}`, url)
+		mux.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/v1/monitors", strings.NewReader(body)))
+		var created monitorTriggerResponse
+		if err := json.Unmarshal(rec.Body.Bytes(), &created); err != nil || rec.Code != http.StatusCreated {
+			t.Fatalf("expected monitor to be created, got %d: %s", rec.Code, rec.Body.String())
+		}
+		return int(created.Monitor.ID)
+	}
+	first := create("https://example.com/a")
+	second := create("https://example.com/b")
+	third := create("https://example.com/c")
+
+	listed := func() []int {
+		rec := httptest.NewRecorder()
+		mux.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/v1/monitors", nil))
+		var monitors []monitorResponse
+		if err := json.Unmarshal(rec.Body.Bytes(), &monitors); err != nil {
+			t.Fatalf("expected monitor list, got %s", rec.Body.String())
+		}
+		ids := make([]int, 0, len(monitors))
+		for _, item := range monitors {
+			ids = append(ids, int(item.ID))
+		}
+		return ids
+	}
+	reorder := func(body string) *httptest.ResponseRecorder {
+		rec := httptest.NewRecorder()
+		mux.ServeHTTP(rec, httptest.NewRequest(http.MethodPut, "/v1/monitors/order", strings.NewReader(body)))
+		return rec
+	}
+
+	if got := listed(); !slices.Equal(got, []int{first, second, third}) {
+		t.Fatalf("expected new monitors in creation order, got %v", got)
+	}
+
+	rec := reorder(fmt.Sprintf(`{"monitorIds":[%d,%d,%d]}`, third, first, second))
+	var response monitorOrderResponse
+	if err := json.Unmarshal(rec.Body.Bytes(), &response); err != nil || rec.Code != http.StatusOK {
+		t.Fatalf("expected 200, got %d: %s", rec.Code, rec.Body.String())
+	}
+	if want := []int{third, first, second}; !slices.Equal(response.MonitorIDs, want) || !slices.Equal(listed(), want) {
+		t.Fatalf("expected order %v, got %v and %v", want, response.MonitorIDs, listed())
+	}
+
+	if rec := reorder(fmt.Sprintf(`{"monitorIds":[%d]}`, second)); rec.Code != http.StatusOK {
+		t.Fatalf("expected 200, got %d: %s", rec.Code, rec.Body.String())
+	}
+	if want := []int{second, third, first}; !slices.Equal(listed(), want) {
+		t.Fatalf("expected unlisted monitors to follow in their previous order %v, got %v", want, listed())
+	}
+
+	fourth := create("https://example.com/d")
+	if got := listed(); got[len(got)-1] != fourth {
+		t.Fatalf("expected a new monitor to be listed last, got %v", got)
+	}
+
+	if rec := reorder(fmt.Sprintf(`{"monitorIds":[%d,%d]}`, first, first)); rec.Code != http.StatusBadRequest {
+		t.Fatalf("expected 400 for duplicate IDs, got %d", rec.Code)
+	}
+	if rec := reorder(fmt.Sprintf(`{"monitorIds":[%d,999]}`, first)); rec.Code != http.StatusNotFound {
+		t.Fatalf("expected 404 for an unknown ID, got %d", rec.Code)
+	}
+	if rec := reorder(`{"monitorIds":[]}`); rec.Code != http.StatusBadRequest {
+		t.Fatalf("expected 400 for an empty list, got %d", rec.Code)
+	}
+}
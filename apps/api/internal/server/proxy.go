@@ -0,0 +1,179 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"goanna/apps/api/ent"
+	"goanna/apps/api/internal/worker"
+)
+
+// maxProxySettingLength bounds the proxy URL and bypass list, which are
+// stored in VARCHAR(255) columns on MySQL.
+const maxProxySettingLength = 255
+
+type testProxySettingsRequest struct {
+	// ProxyURL and ProxyBypass default to the saved settings. The redacted
+	// proxy URL returned by the runtime settings tests the saved one.
+	ProxyURL    *string `json:"proxyUrl"`
+	ProxyBypass *string `json:"proxyBypass"`
+	URL         string  `json:"url"`
+}
+
+type testProxySettingsResponse struct {
+	OK             bool `json:"ok"`
+	Proxied        bool `json:"proxied"`
+	StatusCode     int  `json:"statusCode"`
+	ResponseTimeMs int  `json:"responseTimeMs"`
+}
+
+// handleTestProxySettings fetches a URL through proxy settings before they
+// are saved, reporting whether the request went through the proxy.
+func (s *Server) handleTestProxySettings(w http.ResponseWriter, r *http.Request) {
+	var req testProxySettingsRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid JSON body")
+		return
+	}
+
+	targetURL := strings.TrimSpace(req.URL)
+	parsedTarget, err := url.ParseRequestURI(targetURL)
+	if err != nil || (parsedTarget.Scheme != "http" && parsedTarget.Scheme != "https") {
+		writeError(w, http.StatusBadRequest, "url must be an http or https URL")
+		return
+	}
+
+	config, err := s.ensureGlobalSystemConfig(r.Context())
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "failed to load runtime settings")
+		return
+	}
+	proxy, err := mergeProxySettings(config, req.ProxyURL, req.ProxyBypass)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), testRequestTimeout)
+	defer cancel()
+	outboundReq, err := http.NewRequestWithContext(ctx, http.MethodGet, targetURL, nil)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "failed to build request")
+		return
+	}
+
+	effective := proxy
+	if effective == (worker.ProxySettings{}) {
+		effective = s.startupProxy
+	}
+	proxyURL, err := effective.ProxyFunc(s.networkGuard)(outboundReq)
+	if errors.Is(err, worker.ErrBlockedByNetworkPolicy) {
+		writeError(w, http.StatusForbidden, "target address is blocked by network policy")
+		return
+	}
+	if err != nil {
+		writeError(w, http.StatusBadRequest, fmt.Sprintf("invalid proxy settings: %v", err))
+		return
+	}
+
+	client := s.testClientFor(proxy)
+	started := time.Now()
+	response, err := client.Do(outboundReq)
+	if errors.Is(err, worker.ErrBlockedByNetworkPolicy) {
+		writeError(w, http.StatusForbidden, "target address is blocked by network policy")
+		return
+	}
+	if err != nil {
+		writeError(w, http.StatusBadGateway, fmt.Sprintf("proxy test failed: %v", err))
+		return
+	}
+	defer response.Body.Close()
+	_, _ = io.Copy(io.Discard, io.LimitReader(response.Body, int64(s.maxSelectorPayloadBytes)))
+
+	writeJSON(w, http.StatusOK, testProxySettingsResponse{
+		OK:             true,
+		Proxied:        proxyURL != nil,
+		StatusCode:     response.StatusCode,
+		ResponseTimeMs: int(time.Since(started).Milliseconds()),
+	})
+}
+
+// mergeProxySettings applies the proxy fields of a request to the saved
+// settings. A nil field keeps the saved value, an empty one clears it, and
+// the redacted saved URL keeps the saved URL with its password.
+func mergeProxySettings(config *ent.SystemConfig, rawURL, rawBypass *string) (worker.ProxySettings, error) {
+	proxy := worker.ProxySettingsFromSystem(config)
+
+	if rawURL != nil {
+		proxyURL := strings.TrimSpace(*rawURL)
+		switch {
+		case proxyURL == "":
+			proxy.URL = ""
+		case proxyURL == worker.RedactProxyURL(proxy.URL):
+			// The saved URL echoed back with its password hidden.
+		case len(proxyURL) > maxProxySettingLength:
+			return worker.ProxySettings{}, fmt.Errorf("proxyUrl must be at most %d characters", maxProxySettingLength)
+		default:
+			if _, err := worker.ParseProxyURL(proxyURL); err != nil {
+				return worker.ProxySettings{}, err
+			}
+			proxy.URL = proxyURL
+		}
+	}
+
+	if rawBypass != nil {
+		bypass := strings.TrimSpace(*rawBypass)
+		if len(bypass) > maxProxySettingLength {
+			return worker.ProxySettings{}, fmt.Errorf("proxyBypass must be at most %d characters", maxProxySettingLength)
+		}
+		if strings.ContainsAny(bypass, "\r\n") {
+			return worker.ProxySettings{}, errors.New("proxyBypass must not contain line breaks")
+		}
+		proxy.Bypass = bypass
+	}
+
+	return proxy, nil
+}
+
+// testClientFor returns the client for test requests through the runtime
+// proxy settings. Without them the shared client applies the startup proxy;
+// otherwise each call gets a client without keep-alives so it leaves no idle
+// connections behind.
+func (s *Server) testClientFor(proxy worker.ProxySettings) *http.Client {
+	if proxy == (worker.ProxySettings{}) {
+		return s.testClient
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	if base, ok := s.testClient.Transport.(*http.Transport); ok {
+		transport = base.Clone()
+	}
+	transport.Proxy = proxy.ProxyFunc(s.networkGuard)
+	transport.DisableKeepAlives = true
+
+	client := *s.testClient
+	client.Transport = transport
+	return &client
+}
+
+// proxySettingsResponse returns the saved proxy URL with its password hidden
+// and the bypass list, or nil for unset values.
+func proxySettingsResponse(config *ent.SystemConfig) (*string, *string) {
+	proxy := worker.ProxySettingsFromSystem(config)
+	var proxyURL, bypass *string
+	if proxy.URL != "" {
+		redacted := worker.RedactProxyURL(proxy.URL)
+		proxyURL = &redacted
+	}
+	if proxy.Bypass != "" {
+		bypass = &proxy.Bypass
+	}
+	return proxyURL, bypass
+}
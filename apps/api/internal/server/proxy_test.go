@@ -0,0 +1,88 @@
+package server
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"goanna/apps/api/ent/enttest"
+
+	_ "github.com/mattn/go-sqlite3"
+)
+
+func TestRuntimeSettingsProxy(t *testing.T) {
+	var proxiedHost, proxyAuth string
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		proxiedHost = r.URL.Host
+		proxyAuth = r.Header.Get("Proxy-Authorization")
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer proxy.Close()
+	proxyURL := strings.Replace(proxy.URL, "http://", "http://goanna:s3cret@", 1)
+
+	client := enttest.Open(t, "sqlite3", "file:runtime-settings-proxy?mode=memory&cache=shared&_fk=1")
+	defer client.Close()
+
+	mux := http.NewServeMux()
+	New(client).RegisterRoutes(mux)
+
+	do := func(method, path, body string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(method, path, strings.NewReader(body))
+		rec := httptest.NewRecorder()
+		mux.ServeHTTP(rec, req)
+		return rec
+	}
+
+	rec := do(http.MethodPut, "/v1/settings/runtime", fmt.Sprintf(`{"checksHistoryLimit":200,"timezone":"UTC","proxyUrl":%q,"proxyBypass":"intranet.example"}`, proxyURL))
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected 200, got %d: %s", rec.Code, rec.Body.String())
+	}
+	var settings runtimeSettingsResponse
+	if err := json.Unmarshal(rec.Body.Bytes(), &settings); err != nil {
+		t.Fatalf("expected settings JSON: %v", err)
+	}
+	if settings.ProxyURL == nil || strings.Contains(*settings.ProxyURL, "s3cret") || settings.ProxyBypass == nil {
+		t.Fatalf("expected proxy settings with the password hidden, got %+v", settings)
+	}
+
+	// Saving the redacted URL back keeps the stored password.
+	rec = do(http.MethodPut, "/v1/settings/runtime", fmt.Sprintf(`{"checksHistoryLimit":200,"timezone":"UTC","proxyUrl":%q}`, *settings.ProxyURL))
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected 200, got %d: %s", rec.Code, rec.Body.String())
+	}
+
+	rec = do(http.MethodPost, "/v1/settings/runtime/proxy/test", `{"url":"http://shop.example.test/"}`)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected 200, got %d: %s", rec.Code, rec.Body.String())
+	}
+	var result testProxySettingsResponse
+	if err := json.Unmarshal(rec.Body.Bytes(), &result); err != nil {
+		t.Fatalf("expected test JSON: %v", err)
+	}
+	if !result.OK || !result.Proxied || result.StatusCode != http.StatusNoContent || proxiedHost != "shop.example.test" || proxyAuth == "" {
+		t.Fatalf("expected request through the saved proxy with credentials, got %+v host=%q auth=%q", result, proxiedHost, proxyAuth)
+	}
+
+	rec = do(http.MethodPost, "/v1/settings/runtime/proxy/test", `{"url":"http://shop.example.test/","proxyUrl":"http://127.0.0.1:1"}`)
+	if rec.Code != http.StatusBadGateway {
+		t.Fatalf("expected 502 for an unreachable proxy, got %d: %s", rec.Code, rec.Body.String())
+	}
+
+	for _, body := range []string{
+		`{"checksHistoryLimit":200,"timezone":"UTC","proxyUrl":"ftp://proxy.example"}`,
+		`{"checksHistoryLimit":200,"timezone":"UTC","proxyBypass":"a\nb"}`,
+	} {
+		if rec := do(http.MethodPut, "/v1/settings/runtime", body); rec.Code != http.StatusBadRequest {
+			t.Fatalf("expected 400 for %s, got %d", body, rec.Code)
+		}
+	}
+
+	rec = do(http.MethodPut, "/v1/settings/runtime", `{"checksHistoryLimit":200,"timezone":"UTC","proxyUrl":"","proxyBypass":""}`)
+	settings = runtimeSettingsResponse{}
+	if err := json.Unmarshal(rec.Body.Bytes(), &settings); err != nil || settings.ProxyURL != nil || settings.ProxyBypass != nil {
+		t.Fatalf("expected proxy settings to be cleared, got %+v (%v)", settings, err)
+	}
+}
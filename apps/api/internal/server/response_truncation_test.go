@@ -1,12 +1,16 @@
 package server
 
 import (
+	"fmt"
 	"strings"
 	"testing"
 	"time"
 	"unicode/utf8"
 
 	"goanna/apps/api/ent"
+	"goanna/apps/api/ent/enttest"
+
+	_ "github.com/mattn/go-sqlite3"
 )
 
 func TestTruncateResponseStringLeavesShortValueUntouched(t *testing.T) {
@@ -100,15 +104,18 @@ func TestDecodeTestResponseBodyDecodesSmallJSON(t *testing.T) {
 }
 
 func TestStoreAndLoadSelectorPayload(t *testing.T) {
-	server := New(nil)
+	client := enttest.Open(t, "sqlite3", "file:selector-payload-store?mode=memory&cache=shared&_fk=1")
+	defer client.Close()
+
 	payload := []byte(`{"value":42}`)
 
-	token := server.storeSelectorPayload(payload)
+	token := New(client).storeSelectorPayload(t.Context(), payload, DefaultMaxSelectorPayloadBytes)
 	if token == "" {
 		t.Fatal("expected selector payload token")
 	}
 
-	loaded, ok := server.loadSelectorPayload(token)
+	// Another replica sharing the database serves the same token.
+	loaded, ok := New(client).loadSelectorPayload(t.Context(), token)
 	if !ok {
 		t.Fatal("expected selector payload to be available")
 	}
@@ -119,18 +126,50 @@ func TestStoreAndLoadSelectorPayload(t *testing.T) {
 }
 
 func TestLoadSelectorPayloadExpires(t *testing.T) {
-	server := New(nil)
-	server.selectorPayloads["expired"] = selectorPayloadEntry{
-		payload:   []byte(`{"expired":true}`),
-		expiresAt: time.Now().UTC().Add(-time.Second),
-	}
+	client := enttest.Open(t, "sqlite3", "file:selector-payload-expires?mode=memory&cache=shared&_fk=1")
+	defer client.Close()
+
+	client.SelectorPayload.Create().
+		SetToken("expired").
+		SetPayload([]byte(`{"expired":true}`)).
+		SetExpiresAt(time.Now().UTC().Add(-time.Second)).
+		SaveX(t.Context())
 
-	_, ok := server.loadSelectorPayload("expired")
+	_, ok := New(client).loadSelectorPayload(t.Context(), "expired")
 	if ok {
 		t.Fatal("expected expired payload to be unavailable")
 	}
 }
 
+func TestStoreSelectorPayloadKeepsNewestPayloads(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:selector-payload-cap?mode=memory&cache=shared&_fk=1")
+	defer client.Close()
+
+	server := New(client)
+	if token := server.storeSelectorPayload(t.Context(), []byte(`{"large":true}`), 4); token != "" {
+		t.Fatal("expected payload over the limit to be rejected")
+	}
+
+	tokens := []string{}
+	for index := 0; index < selectorPayloadCacheSize+2; index++ {
+		token := server.storeSelectorPayload(t.Context(), []byte(fmt.Sprintf(`{"index":%d}`, index)), DefaultMaxSelectorPayloadBytes)
+		if token == "" {
+			t.Fatal("expected selector payload token")
+		}
+		tokens = append(tokens, token)
+	}
+
+	if count := client.SelectorPayload.Query().CountX(t.Context()); count != selectorPayloadCacheSize {
+		t.Fatalf("expected %d stored payloads, got %d", selectorPayloadCacheSize, count)
+	}
+	if _, ok := server.loadSelectorPayload(t.Context(), tokens[0]); ok {
+		t.Fatal("expected the oldest payload to be evicted")
+	}
+	if _, ok := server.loadSelectorPayload(t.Context(), tokens[len(tokens)-1]); !ok {
+		t.Fatal("expected the newest payload to be available")
+	}
+}
+
 func TestMapMonitorCheckTruncatesLargeStringFields(t *testing.T) {
 	large := strings.Repeat("y", maxResponseStringBytes+64)
 	row := &ent.CheckResult{
@@ -2,11 +2,16 @@ package server
 
 import (
 	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
 	"testing"
 	"time"
 
 	"goanna/apps/api/ent/enttest"
 	"goanna/apps/api/ent/monitorruntime"
+	"goanna/apps/api/internal/worker"
 
 	_ "github.com/mattn/go-sqlite3"
 )
@@ -35,7 +40,7 @@ func TestNextRunFromCronUsesConfiguredTimezone(t *testing.T) {
 		t.Fatalf("expected timezone to load: %v", err)
 	}
 
-	nextRun, err := nextRunFromCron("0 8-18/2 * * *", now, location)
+	nextRun, err := nextRunFromCron("0 8-18/2 * * *", worker.DSTPolicyNextValid, now, location)
 	if err != nil {
 		t.Fatalf("expected cron to parse: %v", err)
 	}
@@ -127,3 +132,225 @@ func TestRealignEnabledMonitorRuntimesUsesConfiguredTimezone(t *testing.T) {
 		t.Fatalf("expected disabled runtime next run to remain nil, got %s", updatedDisabledRuntime.NextRunAt)
 	}
 }
+
+func TestUpsertRuntimeSettingsCircuitBreaker(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:runtime-settings-circuit-breaker?mode=memory&cache=shared&_fk=1")
+	defer client.Close()
+
+	mux := http.NewServeMux()
+	New(client).RegisterRoutes(mux)
+
+	put := func(body string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPut, "/v1/settings/runtime", strings.NewReader(body))
+		rec := httptest.NewRecorder()
+		mux.ServeHTTP(rec, req)
+		return rec
+	}
+
+	rec := put(`{"checksHistoryLimit":200,"timezone":"UTC","circuitBreakerThreshold":5,"circuitBreakerAction":"suspend","circuitBreakerBackoffMinutes":120}`)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected 200, got %d: %s", rec.Code, rec.Body.String())
+	}
+	var settings runtimeSettingsResponse
+	if err := json.Unmarshal(rec.Body.Bytes(), &settings); err != nil {
+		t.Fatalf("expected settings JSON: %v", err)
+	}
+	if settings.CircuitBreakerThreshold != 5 || settings.CircuitBreakerAction != "suspend" || settings.CircuitBreakerBackoffMinutes != 120 {
+		t.Fatalf("unexpected circuit breaker settings %+v", settings)
+	}
+
+	for _, body := range []string{
+		`{"checksHistoryLimit":200,"timezone":"UTC","circuitBreakerThreshold":-1}`,
+		`{"checksHistoryLimit":200,"timezone":"UTC","circuitBreakerAction":"pause"}`,
+		`{"checksHistoryLimit":200,"timezone":"UTC","circuitBreakerBackoffMinutes":0}`,
+	} {
+		if rec := put(body); rec.Code != http.StatusBadRequest {
+			t.Fatalf("expected 400 for %s, got %d", body, rec.Code)
+		}
+	}
+}
+
+func TestUpsertRuntimeSettingsDefaultHeaders(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:runtime-settings-default-headers?mode=memory&cache=shared&_fk=1")
+	defer client.Close()
+
+	mux := http.NewServeMux()
+	New(client).RegisterRoutes(mux)
+
+	put := func(body string) runtimeSettingsResponse {
+		t.Helper()
+		req := httptest.NewRequest(http.MethodPut, "/v1/settings/runtime", strings.NewReader(body))
+		rec := httptest.NewRecorder()
+		mux.ServeHTTP(rec, req)
+		if rec.Code != http.StatusOK {
+			t.Fatalf("expected 200 for %s, got %d: %s", body, rec.Code, rec.Body.String())
+		}
+		var settings runtimeSettingsResponse
+		if err := json.Unmarshal(rec.Body.Bytes(), &settings); err != nil {
+			t.Fatalf("expected settings JSON: %v", err)
+		}
+		return settings
+	}
+
+	settings := put(`{"checksHistoryLimit":200,"timezone":"UTC","defaultUserAgent":" goanna-bot/1.0 ","defaultHeaders":{" Accept-Language ":"en-AU"}}`)
+	if settings.DefaultUserAgent == nil || *settings.DefaultUserAgent != "goanna-bot/1.0" || settings.DefaultHeaders["Accept-Language"] != "en-AU" {
+		t.Fatalf("unexpected default headers %+v", settings)
+	}
+
+	settings = put(`{"checksHistoryLimit":200,"timezone":"UTC"}`)
+	if settings.DefaultUserAgent == nil || len(settings.DefaultHeaders) != 1 {
+		t.Fatalf("expected omitted defaults to be kept, got %+v", settings)
+	}
+
+	settings = put(`{"checksHistoryLimit":200,"timezone":"UTC","defaultUserAgent":"","defaultHeaders":{}}`)
+	if settings.DefaultUserAgent != nil || len(settings.DefaultHeaders) != 0 {
+		t.Fatalf("expected defaults to be cleared, got %+v", settings)
+	}
+
+	for _, body := range []string{
+		`{"checksHistoryLimit":200,"timezone":"UTC","defaultUserAgent":"a\nb"}`,
+		`{"checksHistoryLimit":200,"timezone":"UTC","defaultHeaders":{"Bad Name":"x"}}`,
+	} {
+		req := httptest.NewRequest(http.MethodPut, "/v1/settings/runtime", strings.NewReader(body))
+		rec := httptest.NewRecorder()
+		mux.ServeHTTP(rec, req)
+		if rec.Code != http.StatusBadRequest {
+			t.Fatalf("expected 400 for %s, got %d", body, rec.Code)
+		}
+	}
+}
+
+func TestUpsertRuntimeSettingsRequestDefaults(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:runtime-settings-request-defaults?mode=memory&cache=shared&_fk=1")
+	defer client.Close()
+
+	mux := http.NewServeMux()
+	New(client).RegisterRoutes(mux)
+
+	put := func(body string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPut, "/v1/settings/runtime", strings.NewReader(body))
+		rec := httptest.NewRecorder()
+		mux.ServeHTTP(rec, req)
+		return rec
+	}
+
+	rec := put(`{"checksHistoryLimit":200,"timezone":"UTC"}`)
+	var settings runtimeSettingsResponse
+	if err := json.Unmarshal(rec.Body.Bytes(), &settings); err != nil {
+		t.Fatalf("expected settings JSON: %v", err)
+	}
+	if settings.RequestTimeoutSeconds != 15 || settings.MaxRetries != 2 || settings.RetryBackoffSeconds != 1 {
+		t.Fatalf("expected built-in request defaults, got %+v", settings)
+	}
+
+	rec = put(`{"checksHistoryLimit":200,"timezone":"UTC","requestTimeoutSeconds":45,"maxRetries":0,"retryBackoffSeconds":10}`)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected 200, got %d: %s", rec.Code, rec.Body.String())
+	}
+	if err := json.Unmarshal(rec.Body.Bytes(), &settings); err != nil {
+		t.Fatalf("expected settings JSON: %v", err)
+	}
+	if settings.RequestTimeoutSeconds != 45 || settings.MaxRetries != 0 || settings.RetryBackoffSeconds != 10 {
+		t.Fatalf("unexpected request defaults %+v", settings)
+	}
+
+	for _, body := range []string{
+		`{"checksHistoryLimit":200,"timezone":"UTC","requestTimeoutSeconds":0}`,
+		`{"checksHistoryLimit":200,"timezone":"UTC","maxRetries":11}`,
+		`{"checksHistoryLimit":200,"timezone":"UTC","retryBackoffSeconds":-1}`,
+	} {
+		if rec := put(body); rec.Code != http.StatusBadRequest {
+			t.Fatalf("expected 400 for %s, got %d", body, rec.Code)
+		}
+	}
+}
+
+func TestUpsertRuntimeSettingsTickInterval(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:runtime-settings-tick-interval?mode=memory&cache=shared&_fk=1")
+	defer client.Close()
+
+	mux := http.NewServeMux()
+	New(client).RegisterRoutes(mux)
+
+	put := func(body string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPut, "/v1/settings/runtime", strings.NewReader(body))
+		rec := httptest.NewRecorder()
+		mux.ServeHTTP(rec, req)
+		return rec
+	}
+
+	rec := put(`{"checksHistoryLimit":200,"timezone":"UTC"}`)
+	var settings runtimeSettingsResponse
+	if err := json.Unmarshal(rec.Body.Bytes(), &settings); err != nil {
+		t.Fatalf("expected settings JSON: %v", err)
+	}
+	if settings.TickIntervalSeconds != 60 || settings.ScheduleLookaheadSeconds != 0 {
+		t.Fatalf("expected built-in tick defaults, got %+v", settings)
+	}
+
+	rec = put(`{"checksHistoryLimit":200,"timezone":"UTC","tickIntervalSeconds":300,"scheduleLookaheadSeconds":15}`)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected 200, got %d: %s", rec.Code, rec.Body.String())
+	}
+	if err := json.Unmarshal(rec.Body.Bytes(), &settings); err != nil {
+		t.Fatalf("expected settings JSON: %v", err)
+	}
+	if settings.TickIntervalSeconds != 300 || settings.ScheduleLookaheadSeconds != 15 {
+		t.Fatalf("unexpected tick settings %+v", settings)
+	}
+
+	for _, body := range []string{
+		`{"checksHistoryLimit":200,"timezone":"UTC","tickIntervalSeconds":5}`,
+		`{"checksHistoryLimit":200,"timezone":"UTC","tickIntervalSeconds":3601}`,
+		`{"checksHistoryLimit":200,"timezone":"UTC","scheduleLookaheadSeconds":61}`,
+	} {
+		if rec := put(body); rec.Code != http.StatusBadRequest {
+			t.Fatalf("expected 400 for %s, got %d", body, rec.Code)
+		}
+	}
+}
+
+func TestUpsertRuntimeSettingsMaxResponseBodyBytes(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:runtime-settings-body-limit?mode=memory&cache=shared&_fk=1")
+	defer client.Close()
+
+	mux := http.NewServeMux()
+	New(client).RegisterRoutes(mux)
+
+	put := func(body string) runtimeSettingsResponse {
+		t.Helper()
+
+		rec := httptest.NewRecorder()
+		mux.ServeHTTP(rec, httptest.NewRequest(http.MethodPut, "/v1/settings/runtime", strings.NewReader(body)))
+		if rec.Code != http.StatusOK {
+			t.Fatalf("expected 200, got %d: %s", rec.Code, rec.Body.String())
+		}
+		var settings runtimeSettingsResponse
+		if err := json.Unmarshal(rec.Body.Bytes(), &settings); err != nil {
+			t.Fatalf("expected settings JSON: %v", err)
+		}
+		return settings
+	}
+
+	settings := put(`{"checksHistoryLimit":200,"timezone":"UTC","maxResponseBodyBytes":1048576}`)
+	if settings.MaxResponseBodyBytes == nil || *settings.MaxResponseBodyBytes != 1048576 {
+		t.Fatalf("expected the body limit to be saved, got %v", settings.MaxResponseBodyBytes)
+	}
+	config := client.SystemConfig.Query().OnlyX(t.Context())
+	if defaults := worker.CheckDefaultsFromSystem(config); defaults.MaxResponseBodyBytes != 1048576 {
+		t.Fatalf("expected checks to use the saved limit, got %d", defaults.MaxResponseBodyBytes)
+	}
+
+	if settings := put(`{"checksHistoryLimit":200,"timezone":"UTC"}`); settings.MaxResponseBodyBytes == nil {
+		t.Fatal("expected an omitted body limit to keep the saved value")
+	}
+	if settings := put(`{"checksHistoryLimit":200,"timezone":"UTC","maxResponseBodyBytes":0}`); settings.MaxResponseBodyBytes != nil {
+		t.Fatalf("expected 0 to clear the body limit, got %d", *settings.MaxResponseBodyBytes)
+	}
+
+	rec := httptest.NewRecorder()
+	mux.ServeHTTP(rec, httptest.NewRequest(http.MethodPut, "/v1/settings/runtime", strings.NewReader(`{"checksHistoryLimit":200,"timezone":"UTC","maxResponseBodyBytes":-1}`)))
+	if rec.Code != http.StatusBadRequest {
+		t.Fatalf("expected 400 for a negative body limit, got %d", rec.Code)
+	}
+}
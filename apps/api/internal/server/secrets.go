@@ -0,0 +1,120 @@
+package server
+
+import (
+	"fmt"
+	"maps"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+
+	"goanna/apps/api/ent"
+	"goanna/apps/api/internal/worker"
+)
+
+// secretMask replaces secrets in API responses. Sending a masked value back
+// keeps the stored secret, so clients can save forms without re-entering it.
+const secretMask = "••••"
+
+// minSecretLengthForHint is the shortest secret whose last four characters
+// are shown after the mask.
+const minSecretLengthForHint = 12
+
+// publicAuthKeys are monitor auth fields that hold no secret.
+var publicAuthKeys = []string{"type", "username", "name"}
+
+// maskSecret hides value for display, keeping the last four characters of
+// long secrets as a hint. ${ENV:NAME} references are not secret and are
+// returned as they are.
+func maskSecret(value string) string {
+	if value == "" || worker.HasEnvReference(value) {
+		return value
+	}
+	if len([]rune(value)) < minSecretLengthForHint {
+		return secretMask
+	}
+	runes := []rune(value)
+	return secretMask + string(runes[len(runes)-4:])
+}
+
+func isMaskedSecret(value string) bool {
+	return strings.HasPrefix(strings.TrimSpace(value), secretMask)
+}
+
+// unmaskSecret returns the stored secret when value is its masked form, and
+// value otherwise.
+func unmaskSecret(value string, stored string) (string, bool) {
+	if !isMaskedSecret(value) {
+		return value, true
+	}
+	if stored == "" || strings.TrimSpace(value) != maskSecret(stored) {
+		return "", false
+	}
+	return stored, true
+}
+
+// maskMonitorAuth returns auth with every secret field masked.
+func maskMonitorAuth(auth map[string]string) map[string]string {
+	if auth == nil {
+		return nil
+	}
+	masked := make(map[string]string, len(auth))
+	for key, value := range auth {
+		if slices.Contains(publicAuthKeys, key) {
+			masked[key] = value
+			continue
+		}
+		masked[key] = maskSecret(value)
+	}
+	return masked
+}
+
+// unmaskMonitorAuth puts the stored secrets back into auth fields sent in
+// their masked form.
+func unmaskMonitorAuth(auth map[string]string, stored map[string]string) (map[string]string, error) {
+	if auth == nil {
+		return nil, nil
+	}
+	unmasked := make(map[string]string, len(auth))
+	for key, value := range auth {
+		secret, ok := unmaskSecret(value, stored[key])
+		if !ok {
+			return nil, fmt.Errorf("auth.%s is masked but does not match the saved value", key)
+		}
+		unmasked[key] = secret
+	}
+	return unmasked, nil
+}
+
+// unmaskSavedMonitorAuth unmasks auth sent for a request to rawURL with the
+// secrets of saved, which may be nil. Saved secrets are only reused while the
+// url keeps the saved monitor's scheme and host, so editing the url cannot
+// send them to another server; masked values must then be entered again.
+func unmaskSavedMonitorAuth(auth map[string]string, saved *ent.Monitor, rawURL string, now time.Time) (map[string]string, error) {
+	if saved == nil {
+		return unmaskMonitorAuth(auth, nil)
+	}
+	if !sameOrigin(saved.URL, rawURL, now) {
+		for _, key := range slices.Sorted(maps.Keys(auth)) {
+			if isMaskedSecret(auth[key]) {
+				return nil, fmt.Errorf("auth.%s must be entered again when the url points to another host", key)
+			}
+		}
+		return unmaskMonitorAuth(auth, nil)
+	}
+	return unmaskMonitorAuth(auth, saved.Auth)
+}
+
+// sameOrigin reports whether two monitor urls, after expanding templates,
+// share a scheme and host.
+func sameOrigin(a string, b string, now time.Time) bool {
+	parsedA, err := url.Parse(worker.ExpandTemplate(strings.TrimSpace(a), now))
+	if err != nil || parsedA.Host == "" {
+		return false
+	}
+	parsedB, err := url.Parse(worker.ExpandTemplate(strings.TrimSpace(b), now))
+	if err != nil {
+		return false
+	}
+	return strings.EqualFold(parsedA.Scheme, parsedB.Scheme) && strings.EqualFold(parsedA.Host, parsedB.Host)
+}
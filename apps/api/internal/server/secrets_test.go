@@ -0,0 +1,118 @@
+package server
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+
+	"goanna/apps/api/ent/enttest"
+
+	_ "github.com/mattn/go-sqlite3"
+)
+
+func TestMaskSecret(t *testing.T) {
+	cases := map[string]string{
+		"":                                    "",
+		"short":                               "••••",
+		"a-much-longer-secret":                "••••cret",
+		"${ENV:GOANNA_SECRET_SHOP_API_TOKEN}": "${ENV:GOANNA_SECRET_SHOP_API_TOKEN}",
+	}
+	for value, want := range cases {
+		if got := maskSecret(value); got != want {
+			t.Fatalf("maskSecret(%q) = %q, want %q", value, got, want)
+		}
+	}
+
+	if secret, ok := unmaskSecret("••••cret", "a-much-longer-secret"); !ok || secret != "a-much-longer-secret" {
+		t.Fatalf("expected the masked value to restore the secret, got %q %t", secret, ok)
+	}
+	if _, ok := unmaskSecret("••••", ""); ok {
+		t.Fatal("expected a masked value without a stored secret to be rejected")
+	}
+	if secret, ok := unmaskSecret("new-secret", "a-much-longer-secret"); !ok || secret != "new-secret" {
+		t.Fatalf("expected a new value to replace the secret, got %q %t", secret, ok)
+	}
+}
+
+func TestMonitorAuthIsWriteOnly(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:monitor-auth-write-only?mode=memory&cache=shared&_fk=1")
+	defer client.Close()
+
+	mux := http.NewServeMux()
+	New(client).RegisterRoutes(mux)
+	send := func(method string, path string, body string) *httptest.ResponseRecorder {
+		rec := httptest.NewRecorder()
+		mux.ServeHTTP(rec, httptest.NewRequest(method, path, strings.NewReader(body)))
+		return rec
+	}
+
+	rec := send(http.MethodPost, "/v1/monitors", `{"url":"https://example.com/api","cron":"0 * * * *","auth":{"type":"basic","username":"ops","password":"correct-horse-battery"}}`)
+	if rec.Code != http.StatusCreated {
+		t.Fatalf("expected 201, got %d: %s", rec.Code, rec.Body.String())
+	}
+	var response monitorTriggerResponse
+	if err := json.Unmarshal(rec.Body.Bytes(), &response); err != nil {
+		t.Fatalf("expected monitor JSON: %v", err)
+	}
+	created := response.Monitor
+	if created.Auth["password"] != "••••tery" || created.Auth["username"] != "ops" {
+		t.Fatalf("expected the password to be masked, got %v", created.Auth)
+	}
+
+	path := "/v1/monitors/" + strconv.FormatInt(created.ID, 10)
+	rec = send(http.MethodPut, path, `{"label":"API","url":"https://example.com/api","cron":"0 * * * *","auth":{"type":"basic","username":"ops","password":"••••tery"}}`)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected 200, got %d: %s", rec.Code, rec.Body.String())
+	}
+	row := client.Monitor.GetX(t.Context(), int(created.ID))
+	if row.Auth["password"] != "correct-horse-battery" || row.Label == nil || *row.Label != "API" {
+		t.Fatalf("expected the stored password to be kept, got %+v", row)
+	}
+
+	rec = send(http.MethodPost, "/v1/monitors", `{"url":"https://example.com/other","cron":"0 * * * *","auth":{"type":"bearer","token":"••••tery"}}`)
+	if rec.Code != http.StatusBadRequest || !strings.Contains(rec.Body.String(), "auth.token") {
+		t.Fatalf("expected a masked secret on a new monitor to be rejected, got %d: %s", rec.Code, rec.Body.String())
+	}
+}
+
+func TestTestMonitorURLKeepsSavedAuthOnItsHost(t *testing.T) {
+	var savedHostAuth, otherHostAuth string
+	savedHost := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		savedHostAuth = r.Header.Get("Authorization")
+	}))
+	defer savedHost.Close()
+	otherHost := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		otherHostAuth = r.Header.Get("Authorization")
+	}))
+	defer otherHost.Close()
+
+	client := enttest.Open(t, "sqlite3", "file:test-monitor-url-auth?mode=memory&cache=shared&_fk=1")
+	defer client.Close()
+
+	row := client.Monitor.Create().
+		SetURL(savedHost.URL + "/status").
+		SetAuth(map[string]string{"type": "bearer", "token": "saved-token-value"}).
+		SetCron("*/5 * * * *").
+		SaveX(t.Context())
+
+	mux := http.NewServeMux()
+	New(client).RegisterRoutes(mux)
+	test := func(url string) *httptest.ResponseRecorder {
+		body := fmt.Sprintf(`{"url":%q,"auth":{"type":"bearer","token":"••••alue"},"monitorId":%d}`, url, row.ID)
+		rec := httptest.NewRecorder()
+		mux.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/v1/monitors/test", strings.NewReader(body)))
+		return rec
+	}
+
+	if rec := test(savedHost.URL + "/other"); rec.Code != http.StatusOK || savedHostAuth != "Bearer saved-token-value" {
+		t.Fatalf("expected the saved token on the saved host, got %d %q: %s", rec.Code, savedHostAuth, rec.Body.String())
+	}
+	rec := test(otherHost.URL + "/status")
+	if rec.Code != http.StatusBadRequest || !strings.Contains(rec.Body.String(), "auth.token") || otherHostAuth != "" {
+		t.Fatalf("expected a masked token for another host to be rejected, got %d %q: %s", rec.Code, otherHostAuth, rec.Body.String())
+	}
+}
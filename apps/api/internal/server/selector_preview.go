@@ -0,0 +1,127 @@
+package server
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"time"
+
+	"goanna/apps/api/ent"
+	"goanna/apps/api/ent/checkresult"
+	"goanna/apps/api/ent/monitor"
+	selectorutil "goanna/apps/api/internal/selector"
+	"goanna/apps/api/internal/worker"
+)
+
+const (
+	storedSelectorSourceBody      = "body"
+	storedSelectorSourceSelection = "selection"
+)
+
+type storedSelectorPreviewRequest struct {
+	Selector *string `json:"selector"`
+}
+
+type storedSelectorPreviewResponse struct {
+	selectorPreviewResponse
+	CheckID   int64     `json:"checkId"`
+	CheckedAt time.Time `json:"checkedAt"`
+	Source    string    `json:"source"`
+}
+
+// handlePreviewStoredSelector evaluates a candidate selector against the
+// latest check of a monitor that stored a body snapshot or a selection, so
+// selectors can be refined without fetching the target again. A stored body
+// is the whole response; a stored selection is the output of the monitor's
+// current selector, so the candidate is applied relative to it.
+func (s *Server) handlePreviewStoredSelector(w http.ResponseWriter, r *http.Request) {
+	monitorID, err := parseMonitorID(r.PathValue("monitorId"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "monitorId must be a positive integer")
+		return
+	}
+
+	var req storedSelectorPreviewRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid JSON body")
+		return
+	}
+	selectorPath := ""
+	if req.Selector != nil {
+		selectorPath = strings.TrimSpace(*req.Selector)
+	}
+
+	row, err := s.db.Monitor.Get(r.Context(), monitorID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			writeError(w, http.StatusNotFound, "monitor not found")
+			return
+		}
+		writeError(w, http.StatusInternalServerError, "failed to query monitor")
+		return
+	}
+	if row.ExpectedType != monitor.ExpectedTypeJSON {
+		writeError(w, http.StatusBadRequest, "selector is only supported for JSON expectedType")
+		return
+	}
+
+	check, err := s.db.CheckResult.Query().
+		Where(
+			checkresult.MonitorIDEQ(monitorID),
+			checkresult.Or(checkresult.BodySnapshotNotNil(), checkresult.SelectionValueNotNil()),
+		).
+		Order(ent.Desc(checkresult.FieldCheckedAt), ent.Desc(checkresult.FieldID)).
+		First(r.Context())
+	if err != nil {
+		if ent.IsNotFound(err) {
+			writeError(w, http.StatusNotFound, "monitor has no stored response or selection")
+			return
+		}
+		writeError(w, http.StatusInternalServerError, "failed to query checks")
+		return
+	}
+
+	source := storedSelectorSourceSelection
+	var payload []byte
+	if worker.HasBodySnapshot(check) {
+		payload, err = worker.DecodeBodySnapshot(check)
+		if err != nil {
+			writeError(w, http.StatusInternalServerError, "failed to decode check body")
+			return
+		}
+		source = storedSelectorSourceBody
+	} else if check.SelectionValue != nil {
+		payload = []byte(*check.SelectionValue)
+	}
+
+	selection, err := selectorutil.SelectJSON(payload, selectorPath)
+	if err != nil {
+		switch {
+		case source == storedSelectorSourceBody && check.BodySnapshotTruncated:
+			writeError(w, http.StatusBadRequest, "stored body was truncated and is not valid JSON")
+		case source == storedSelectorSourceBody:
+			writeError(w, http.StatusBadRequest, "stored body is not valid JSON")
+		default:
+			writeError(w, http.StatusBadRequest, "stored selection is not JSON, enable body snapshots to preview against the full response")
+		}
+		return
+	}
+
+	response := storedSelectorPreviewResponse{
+		selectorPreviewResponse: selectorPreviewResponse{
+			Exists: selection.Exists,
+			Type:   selection.Type,
+		},
+		CheckID:   int64(check.ID),
+		CheckedAt: check.CheckedAt,
+		Source:    source,
+	}
+	if selection.Exists {
+		truncatedRaw := truncateSelectorPreviewString(selection.Raw)
+		truncatedValue := truncateSelectorPreviewString(selection.Value)
+		response.Raw = &truncatedRaw
+		response.Value = &truncatedValue
+	}
+
+	writeJSON(w, http.StatusOK, response)
+}
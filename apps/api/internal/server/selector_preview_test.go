@@ -0,0 +1,148 @@
+package server
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"goanna/apps/api/ent"
+	"goanna/apps/api/ent/enttest"
+	"goanna/apps/api/ent/monitor"
+
+	_ "github.com/mattn/go-sqlite3"
+)
+
+func previewStoredSelector(t *testing.T, client *ent.Client, monitorID int, body string) *httptest.ResponseRecorder {
+	t.Helper()
+
+	mux := http.NewServeMux()
+	New(client).RegisterRoutes(mux)
+
+	req := httptest.NewRequest(http.MethodPost, fmt.Sprintf("/v1/monitors/%d/selector-preview", monitorID), strings.NewReader(body))
+	recorder := httptest.NewRecorder()
+	mux.ServeHTTP(recorder, req)
+	return recorder
+}
+
+func TestHandlePreviewStoredSelectorUsesLatestStoredBody(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:stored-selector-body?mode=memory&cache=shared&_fk=1")
+	defer client.Close()
+
+	row, err := client.Monitor.Create().
+		SetURL("https://example.com/rates").
+		SetCron("*/5 * * * *").
+		SetSelector("rates.eur").
+		Save(t.Context())
+	if err != nil {
+		t.Fatalf("expected monitor to save: %v", err)
+	}
+
+	base := time.Date(2026, time.March, 1, 12, 0, 0, 0, time.UTC)
+	for index, payload := range []string{`{"rates":{"eur":1.1,"usd":1}}`, `{"rates":{"eur":1.2,"usd":1}}`} {
+		_, err := client.CheckResult.Create().
+			SetMonitorID(row.ID).
+			SetStatus("ok").
+			SetBodySnapshot([]byte(payload)).
+			SetBodySnapshotEncoding("identity").
+			SetCheckedAt(base.Add(time.Duration(index) * time.Minute)).
+			Save(t.Context())
+		if err != nil {
+			t.Fatalf("expected check to save: %v", err)
+		}
+	}
+	// A later check without a stored body or selection, such as a failure.
+	if _, err := client.CheckResult.Create().
+		SetMonitorID(row.ID).
+		SetStatus("error").
+		SetCheckedAt(base.Add(time.Hour)).
+		Save(t.Context()); err != nil {
+		t.Fatalf("expected check to save: %v", err)
+	}
+
+	recorder := previewStoredSelector(t, client, row.ID, `{"selector":"rates"}`)
+	if recorder.Code != http.StatusOK {
+		t.Fatalf("expected status 200, got %d: %s", recorder.Code, recorder.Body.String())
+	}
+
+	var response storedSelectorPreviewResponse
+	if err := json.Unmarshal(recorder.Body.Bytes(), &response); err != nil {
+		t.Fatalf("expected JSON response: %v", err)
+	}
+	if response.Source != storedSelectorSourceBody || !response.CheckedAt.Equal(base.Add(time.Minute)) {
+		t.Fatalf("expected the latest stored body to be used, got %+v", response)
+	}
+	if !response.Exists || response.Type != "json" || response.Value == nil || *response.Value != `{"eur":1.2,"usd":1}` {
+		t.Fatalf("expected the rates object to be selected, got %+v", response)
+	}
+}
+
+func TestHandlePreviewStoredSelectorFallsBackToSelection(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:stored-selector-selection?mode=memory&cache=shared&_fk=1")
+	defer client.Close()
+
+	row, err := client.Monitor.Create().
+		SetURL("https://example.com/rates").
+		SetCron("*/5 * * * *").
+		SetSelector("rates").
+		Save(t.Context())
+	if err != nil {
+		t.Fatalf("expected monitor to save: %v", err)
+	}
+
+	base := time.Date(2026, time.March, 1, 12, 0, 0, 0, time.UTC)
+	seedMonitorCheck(t, client, row.ID, "json", `{"eur":1.1,"usd":1}`, base)
+
+	recorder := previewStoredSelector(t, client, row.ID, `{"selector":"usd"}`)
+	if recorder.Code != http.StatusOK {
+		t.Fatalf("expected status 200, got %d: %s", recorder.Code, recorder.Body.String())
+	}
+
+	var response storedSelectorPreviewResponse
+	if err := json.Unmarshal(recorder.Body.Bytes(), &response); err != nil {
+		t.Fatalf("expected JSON response: %v", err)
+	}
+	if response.Source != storedSelectorSourceSelection || response.Type != "number" || response.Value == nil || *response.Value != "1" {
+		t.Fatalf("expected usd to be selected from the stored selection, got %+v", response)
+	}
+
+	seedMonitorCheck(t, client, row.ID, "string", "not json", base.Add(time.Minute))
+	recorder = previewStoredSelector(t, client, row.ID, `{"selector":"usd"}`)
+	if recorder.Code != http.StatusBadRequest {
+		t.Fatalf("expected status 400 for a non-JSON selection, got %d: %s", recorder.Code, recorder.Body.String())
+	}
+}
+
+func TestHandlePreviewStoredSelectorRejectsMissingData(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:stored-selector-missing?mode=memory&cache=shared&_fk=1")
+	defer client.Close()
+
+	jsonMonitor, err := client.Monitor.Create().
+		SetURL("https://example.com/rates").
+		SetCron("*/5 * * * *").
+		Save(t.Context())
+	if err != nil {
+		t.Fatalf("expected monitor to save: %v", err)
+	}
+	htmlMonitor, err := client.Monitor.Create().
+		SetURL("https://example.com/").
+		SetCron("*/5 * * * *").
+		SetExpectedType(monitor.ExpectedTypeHTML).
+		Save(t.Context())
+	if err != nil {
+		t.Fatalf("expected monitor to save: %v", err)
+	}
+
+	if recorder := previewStoredSelector(t, client, jsonMonitor.ID, `{"selector":"rates"}`); recorder.Code != http.StatusNotFound {
+		t.Fatalf("expected status 404 without stored checks, got %d: %s", recorder.Code, recorder.Body.String())
+	}
+	if recorder := previewStoredSelector(t, client, htmlMonitor.ID, `{"selector":"rates"}`); recorder.Code != http.StatusBadRequest {
+		t.Fatalf("expected status 400 for an html monitor, got %d: %s", recorder.Code, recorder.Body.String())
+	}
+	if recorder := previewStoredSelector(t, client, 999, `{}`); recorder.Code != http.StatusNotFound {
+		t.Fatalf("expected status 404 for an unknown monitor, got %d: %s", recorder.Code, recorder.Body.String())
+	}
+}
@@ -8,21 +8,28 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"math"
 	"net/http"
 	"net/url"
 	"sort"
 	"strconv"
 	"strings"
-	"sync"
 	"time"
 
 	"goanna/apps/api/ent"
 	"goanna/apps/api/ent/checkresult"
+	"goanna/apps/api/ent/checkrollup"
 	"goanna/apps/api/ent/monitor"
 	"goanna/apps/api/ent/monitorruntime"
+	"goanna/apps/api/ent/monitorversion"
 	"goanna/apps/api/ent/notificationchannel"
 	"goanna/apps/api/ent/notificationevent"
+	"goanna/apps/api/ent/selectorpayload"
 	"goanna/apps/api/ent/systemconfig"
+	"goanna/apps/api/ent/user"
+	"goanna/apps/api/internal/backup"
+	"goanna/apps/api/internal/migrations"
+	"goanna/apps/api/internal/requestid"
 	selectorutil "goanna/apps/api/internal/selector"
 	"goanna/apps/api/internal/worker"
 
@@ -34,13 +41,29 @@ import (
 const (
 	globalConfigKey                = "global"
 	defaultChecksHistoryKeep       = 200
+	minChecksHistoryLimit          = 10
 	defaultCronTimezone            = "UTC"
 	requiredRuntimeTimezone        = "timezone"
 	maxMonitorChecksLimit          = 500
+	maxMonitorTagLength            = 64
+	maxMonitorKeywords             = 20
+	maxJitterSeconds               = 3600
+	maxCircuitBreakerThreshold     = 1000
+	maxCircuitBreakerBackoff       = 10080
+	maxCatchUpAgeMinutes           = 525600
+	maxRequestTimeoutSeconds       = 300
+	maxCheckRetries                = 10
+	maxRetryBackoffSeconds         = 300
+	minTickIntervalSeconds         = 10
+	maxTickIntervalSeconds         = 3600
+	maxScheduleLookaheadSeconds    = 60
+	maxRedirectHops                = 20
+	maxMonitorResponseBytes        = 256 * 1024 * 1024
 	maxResponseStringBytes         = 16 * 1024
 	maxSelectorPreviewBytes        = 4 * 1024
 	maxTestResponseBodyBytes       = 4 * 1024
 	DefaultMaxSelectorPayloadBytes = 24 * 1024 * 1024
+	DefaultSessionTTL              = 30 * 24 * time.Hour
 	selectorPayloadTTL             = 10 * time.Minute
 	selectorPayloadCacheSize       = 8
 	testRequestTimeout             = 20 * time.Second
@@ -50,20 +73,40 @@ const (
 
 type Config struct {
 	MaxSelectorPayloadBytes int
+	// Worker configures the worker that runs manually triggered checks; its
+	// NetworkGuard also applies to the test URL endpoint.
+	Worker worker.Config
+	// Backups snapshots the database for POST /v1/system/backup; nil when
+	// the database is not SQLite.
+	Backups *backup.Snapshotter
+	// SessionTTL is how long sign-in sessions last, DefaultSessionTTL when
+	// zero.
+	SessionTTL time.Duration
+	// BasePath prefixes every route and generated link, for hosting behind
+	// a reverse proxy at a sub-path such as /goanna. Empty serves from the
+	// root.
+	BasePath string
+	// Migrations tells the health checks which schema migrations are
+	// pending; nil reports them as unknown.
+	Migrations *migrations.Migrator
 }
 
 type Server struct {
 	db                      *ent.Client
 	maxSelectorPayloadBytes int
 	triggerWorker           *worker.Worker
-
-	selectorPayloadsMu sync.Mutex
-	selectorPayloads   map[string]selectorPayloadEntry
-}
-
-type selectorPayloadEntry struct {
-	payload   []byte
-	expiresAt time.Time
+	scheduleChanges         *worker.ScheduleChanges
+	events                  *worker.Events
+	liveness                *worker.Liveness
+	backups                 *backup.Snapshotter
+	testClient              *http.Client
+	networkGuard            *worker.NetworkGuard
+	startupProxy            worker.ProxySettings
+	sessionTTL              time.Duration
+	loginThrottle           *loginThrottle
+	statusPageUptime        *statusPageUptimeCache
+	migrations              *migrations.Migrator
+	basePath                string
 }
 
 func New(db *ent.Client) *Server {
@@ -76,64 +119,233 @@ func NewWithConfig(db *ent.Client, config Config) *Server {
 		maxSelectorPayloadBytes = DefaultMaxSelectorPayloadBytes
 	}
 
+	workerConfig := config.Worker
+	workerConfig.MaxResponseBodyBytes = maxSelectorPayloadBytes
+
+	sessionTTL := config.SessionTTL
+	if sessionTTL <= 0 {
+		sessionTTL = DefaultSessionTTL
+	}
+
+	testClient := http.DefaultClient
+	if config.Worker.NetworkGuard != nil || config.Worker.Proxy != (worker.ProxySettings{}) {
+		transport := http.DefaultTransport.(*http.Transport).Clone()
+		if config.Worker.NetworkGuard != nil {
+			transport.DialContext = worker.NewGuardedDialer(config.Worker.NetworkGuard, nil).DialContext
+		}
+		transport.Proxy = config.Worker.Proxy.ProxyFunc(config.Worker.NetworkGuard)
+		testClient = &http.Client{Transport: transport}
+	}
+
 	return &Server{
 		db:                      db,
 		maxSelectorPayloadBytes: maxSelectorPayloadBytes,
-		triggerWorker: worker.NewWithConfig(db, worker.Config{
-			MaxResponseBodyBytes: maxSelectorPayloadBytes,
-		}),
-		selectorPayloads: map[string]selectorPayloadEntry{},
+		triggerWorker:           worker.NewWithConfig(db, workerConfig),
+		scheduleChanges:         config.Worker.Changes,
+		events:                  config.Worker.Events,
+		liveness:                config.Worker.Liveness,
+		backups:                 config.Backups,
+		testClient:              testClient,
+		networkGuard:            config.Worker.NetworkGuard,
+		startupProxy:            config.Worker.Proxy,
+		sessionTTL:              sessionTTL,
+		loginThrottle:           newLoginThrottle(),
+		statusPageUptime:        &statusPageUptimeCache{},
+		migrations:              config.Migrations,
+		basePath:                NormalizeBasePath(config.BasePath),
+	}
+}
+
+// NormalizeBasePath returns path with a leading and no trailing slash, or ""
+// for the root.
+func NormalizeBasePath(path string) string {
+	trimmed := strings.Trim(strings.TrimSpace(path), "/")
+	if trimmed == "" {
+		return ""
 	}
+	return "/" + trimmed
+}
+
+// routeMux registers routes under a base path.
+type routeMux struct {
+	mux      *http.ServeMux
+	basePath string
 }
 
-func (s *Server) RegisterRoutes(mux *http.ServeMux) {
+// HandleFunc registers handler for a "METHOD /path" pattern with the base
+// path put in front of the path.
+func (m routeMux) HandleFunc(pattern string, handler http.HandlerFunc) {
+	if method, path, ok := strings.Cut(pattern, " "); ok {
+		pattern = method + " " + m.basePath + path
+	} else {
+		pattern = m.basePath + pattern
+	}
+	m.mux.HandleFunc(pattern, handler)
+}
+
+// link returns the URL path of an API route, including the base path.
+func (s *Server) link(path string) string {
+	return s.basePath + path
+}
+
+func (s *Server) RegisterRoutes(serveMux *http.ServeMux) {
+	mux := routeMux{mux: serveMux, basePath: s.basePath}
 	mux.HandleFunc("GET /healthz", s.handleHealth)
-	mux.HandleFunc("GET /v1/monitors", s.handleListMonitors)
-	mux.HandleFunc("POST /v1/monitors", s.handleCreateMonitor)
-	mux.HandleFunc("PUT /v1/monitors/{monitorId}", s.handleUpdateMonitor)
-	mux.HandleFunc("DELETE /v1/monitors/{monitorId}", s.handleDeleteMonitor)
-	mux.HandleFunc("POST /v1/monitors/{monitorId}/trigger", s.handleTriggerMonitor)
-	mux.HandleFunc("POST /v1/monitors/test", s.handleTestMonitorURL)
-	mux.HandleFunc("POST /v1/monitors/selector-preview", s.handlePreviewMonitorSelector)
-	mux.HandleFunc("GET /v1/monitors/{monitorId}/checks", s.handleListMonitorChecks)
-	mux.HandleFunc("GET /v1/settings/notifications/telegram", s.handleGetTelegramSettings)
-	mux.HandleFunc("PUT /v1/settings/notifications/telegram", s.handleUpsertTelegramSettings)
-	mux.HandleFunc("POST /v1/settings/notifications/telegram/test", s.handleTestTelegramSettings)
-	mux.HandleFunc("GET /v1/settings/runtime", s.handleGetRuntimeSettings)
-	mux.HandleFunc("PUT /v1/settings/runtime", s.handleUpsertRuntimeSettings)
+	mux.HandleFunc("GET /readyz", s.handleReadiness)
+	mux.HandleFunc("GET /v1/health/details", s.handleHealthDetails)
+	mux.HandleFunc("GET /v1/monitors", s.authorize(user.RoleViewer, s.handleListMonitors))
+	mux.HandleFunc("POST /v1/monitors", s.authorize(user.RoleAdmin, s.handleCreateMonitor))
+	mux.HandleFunc("PUT /v1/monitors/order", s.authorize(user.RoleAdmin, s.handleReorderMonitors))
+	mux.HandleFunc("PUT /v1/monitors/{monitorId}", s.authorize(user.RoleAdmin, s.handleUpdateMonitor))
+	mux.HandleFunc("DELETE /v1/monitors/{monitorId}", s.authorize(user.RoleAdmin, s.handleArchiveMonitor))
+	mux.HandleFunc("POST /v1/monitors/{monitorId}/restore", s.authorize(user.RoleAdmin, s.handleRestoreMonitor))
+	mux.HandleFunc("DELETE /v1/monitors/{monitorId}/permanent", s.authorize(user.RoleAdmin, s.handleDeleteMonitor))
+	mux.HandleFunc("POST /v1/monitors/{monitorId}/trigger", s.authorize(user.RoleAdmin, s.handleTriggerMonitor))
+	mux.HandleFunc("POST /v1/monitors/{monitorId}/run", s.authorize(user.RoleAdmin, s.handleRunMonitor))
+	mux.HandleFunc("POST /v1/monitors/{monitorId}/acknowledge", s.authorize(user.RoleAdmin, s.handleAcknowledgeMonitor))
+	mux.HandleFunc("POST /v1/monitors/{monitorId}/duplicate", s.authorize(user.RoleAdmin, s.handleDuplicateMonitor))
+	mux.HandleFunc("GET /v1/monitors/{monitorId}/versions", s.authorize(user.RoleAdmin, s.handleListMonitorVersions))
+	mux.HandleFunc("POST /v1/monitors/{monitorId}/versions/{version}/restore", s.authorize(user.RoleAdmin, s.handleRestoreMonitorVersion))
+	mux.HandleFunc("GET /v1/monitors/{monitorId}/cookies", s.authorize(user.RoleAdmin, s.handleGetMonitorCookies))
+	mux.HandleFunc("PUT /v1/monitors/{monitorId}/cookies", s.authorize(user.RoleAdmin, s.handleReplaceMonitorCookies))
+	mux.HandleFunc("DELETE /v1/monitors/{monitorId}/cookies", s.authorize(user.RoleAdmin, s.handleClearMonitorCookies))
+	mux.HandleFunc("POST /v1/monitors/{monitorId}/expect-change", s.authorize(user.RoleAdmin, s.handleExpectMonitorChange))
+	mux.HandleFunc("DELETE /v1/monitors/{monitorId}/expect-change", s.authorize(user.RoleAdmin, s.handleCancelExpectMonitorChange))
+	mux.HandleFunc("GET /v1/monitors/stats", s.authorize(user.RoleViewer, s.handleListMonitorStats))
+	mux.HandleFunc("GET /v1/monitors/{monitorId}/stats", s.authorize(user.RoleViewer, s.handleGetMonitorStats))
+	mux.HandleFunc("GET /v1/monitors/{monitorId}/stats/rollups", s.authorize(user.RoleViewer, s.handleGetMonitorRollups))
+	mux.HandleFunc("GET /v1/monitors/{monitorId}/badge.svg", s.handleMonitorBadge)
+	mux.HandleFunc("GET /v1/monitors/export", s.authorize(user.RoleAdmin, s.handleExportMonitors))
+	mux.HandleFunc("POST /v1/monitors/import", s.authorize(user.RoleAdmin, s.handleImportMonitors))
+	mux.HandleFunc("POST /v1/monitors/import/urls", s.authorize(user.RoleAdmin, s.handleImportMonitorURLs))
+	mux.HandleFunc("POST /v1/monitors/import/curl", s.authorize(user.RoleAdmin, s.handleImportMonitorCurl))
+	mux.HandleFunc("POST /v1/monitors/import/har", s.authorize(user.RoleAdmin, s.handleImportMonitorHAR))
+	mux.HandleFunc("POST /v1/monitors/bulk", s.authorize(user.RoleAdmin, s.handleBulkMonitors))
+	mux.HandleFunc("POST /v1/monitors/test", s.authorize(user.RoleAdmin, s.handleTestMonitorURL))
+	mux.HandleFunc("POST /v1/monitors/dry-run", s.authorize(user.RoleAdmin, s.handleDryRunMonitor))
+	mux.HandleFunc("POST /v1/monitors/from-test", s.authorize(user.RoleAdmin, s.handleCreateMonitorFromTest))
+	mux.HandleFunc("POST /v1/monitors/selector-preview", s.authorize(user.RoleAdmin, s.handlePreviewMonitorSelector))
+	mux.HandleFunc("POST /v1/monitors/{monitorId}/selector-preview", s.authorize(user.RoleAdmin, s.handlePreviewStoredSelector))
+	mux.HandleFunc("POST /v1/diff/preview", s.authorize(user.RoleAdmin, s.handlePreviewDiff))
+	mux.HandleFunc("GET /v1/monitors/{monitorId}/checks", s.authorize(user.RoleViewer, s.handleListMonitorChecks))
+	mux.HandleFunc("GET /v1/monitors/{monitorId}/checks/diff", s.authorize(user.RoleViewer, s.handleDiffMonitorChecks))
+	mux.HandleFunc("DELETE /v1/monitors/{monitorId}/checks", s.authorize(user.RoleAdmin, s.handleDeleteMonitorChecks))
+	mux.HandleFunc("GET /v1/monitors/{monitorId}/checks/{checkId}", s.authorize(user.RoleViewer, s.handleGetMonitorCheck))
+	mux.HandleFunc("DELETE /v1/monitors/{monitorId}/checks/{checkId}", s.authorize(user.RoleAdmin, s.handleDeleteMonitorCheck))
+	mux.HandleFunc("GET /v1/monitors/{monitorId}/checks/{checkId}/body", s.authorize(user.RoleViewer, s.handleGetMonitorCheckBody))
+	mux.HandleFunc("GET /v1/monitors/{monitorId}/checks/{checkId}/neighbors", s.authorize(user.RoleViewer, s.handleMonitorCheckNeighbors))
+	mux.HandleFunc("GET /v1/tags", s.authorize(user.RoleViewer, s.handleListTags))
+	mux.HandleFunc("GET /v1/tags/{tag}", s.authorize(user.RoleViewer, s.handleGetTag))
+	mux.HandleFunc("GET /v1/header-profiles", s.authorize(user.RoleViewer, s.handleListHeaderProfiles))
+	mux.HandleFunc("POST /v1/header-profiles", s.authorize(user.RoleAdmin, s.handleCreateHeaderProfile))
+	mux.HandleFunc("PUT /v1/header-profiles/{profileId}", s.authorize(user.RoleAdmin, s.handleUpdateHeaderProfile))
+	mux.HandleFunc("DELETE /v1/header-profiles/{profileId}", s.authorize(user.RoleAdmin, s.handleDeleteHeaderProfile))
+	mux.HandleFunc("GET /v1/heartbeats/{token}", s.handleHeartbeatPing)
+	mux.HandleFunc("GET /v1/status-page", s.handleGetStatusPage)
+	mux.HandleFunc("POST /v1/heartbeats/{token}", s.handleHeartbeatPing)
+	mux.HandleFunc("GET /v1/settings/notifications/telegram", s.authorize(user.RoleAdmin, s.handleGetTelegramSettings))
+	mux.HandleFunc("PUT /v1/settings/notifications/telegram", s.authorize(user.RoleAdmin, s.handleUpsertTelegramSettings))
+	mux.HandleFunc("POST /v1/settings/notifications/telegram/test", s.authorize(user.RoleAdmin, s.handleTestTelegramSettings))
+	mux.HandleFunc("GET /v1/settings/export", s.authorize(user.RoleAdmin, s.handleExportSettings))
+	mux.HandleFunc("POST /v1/settings/import", s.authorize(user.RoleAdmin, s.handleImportSettings))
+	mux.HandleFunc("GET /v1/settings/runtime", s.authorize(user.RoleViewer, s.handleGetRuntimeSettings))
+	mux.HandleFunc("PUT /v1/settings/runtime", s.authorize(user.RoleAdmin, s.handleUpsertRuntimeSettings))
+	mux.HandleFunc("POST /v1/settings/runtime/proxy/test", s.authorize(user.RoleAdmin, s.handleTestProxySettings))
+	mux.HandleFunc("GET /v1/ws", s.withWebSocketTicket(s.authorize(user.RoleViewer, s.handleWebSocket)))
+	mux.HandleFunc("POST /v1/ws/ticket", s.authorize(user.RoleViewer, s.handleCreateWebSocketTicket))
+	mux.HandleFunc("GET /v1/system", s.authorize(user.RoleViewer, s.handleGetSystemState))
+	mux.HandleFunc("POST /v1/system/pause", s.authorize(user.RoleAdmin, s.handlePauseSystem))
+	mux.HandleFunc("POST /v1/system/resume", s.authorize(user.RoleAdmin, s.handleResumeSystem))
+	mux.HandleFunc("POST /v1/system/backup", s.authorize(user.RoleAdmin, s.handleBackupDatabase))
+	mux.HandleFunc("POST /v1/auth/login", s.handleLogin)
+	mux.HandleFunc("POST /v1/auth/logout", s.handleLogout)
+	mux.HandleFunc("GET /v1/auth/status", s.handleGetAuthStatus)
+	mux.HandleFunc("PUT /v1/auth/password", s.authorize(user.RoleViewer, s.handleChangePassword))
+	mux.HandleFunc("GET /v1/users", s.authorize(user.RoleAdmin, s.handleListUsers))
+	mux.HandleFunc("POST /v1/users", s.authorize(user.RoleAdmin, s.handleCreateUser))
+	mux.HandleFunc("PUT /v1/users/{userId}", s.authorize(user.RoleAdmin, s.handleUpdateUser))
+	mux.HandleFunc("DELETE /v1/users/{userId}", s.authorize(user.RoleAdmin, s.handleDeleteUser))
 }
 
 type healthResponse struct {
 	Status string `json:"status"`
+	Paused bool   `json:"paused"`
 }
 
 type monitorResponse struct {
-	ID                   int64                              `json:"id"`
-	Label                *string                            `json:"label,omitempty"`
-	Method               string                             `json:"method"`
-	URL                  string                             `json:"url"`
-	IconURL              string                             `json:"iconUrl"`
-	Body                 *string                            `json:"body,omitempty"`
-	Headers              kvMap                              `json:"headers"`
-	Auth                 kvMap                              `json:"auth"`
-	NotificationChannels []string                           `json:"notificationChannels"`
-	NotificationIssues   []monitorNotificationIssueResponse `json:"notificationIssues"`
-	Selector             *string                            `json:"selector,omitempty"`
-	ExpectedType         string                             `json:"expectedType"`
-	ExpectedResponse     *string                            `json:"expectedResponse,omitempty"`
-	Cron                 string                             `json:"cron"`
-	Enabled              bool                               `json:"enabled"`
-	Status               string                             `json:"status"`
-	CheckCount           int64                              `json:"checkCount"`
-	NextRunAt            *time.Time                         `json:"nextRunAt,omitempty"`
-	LastCheckAt          *time.Time                         `json:"lastCheckAt,omitempty"`
-	LastSuccessAt        *time.Time                         `json:"lastSuccessAt,omitempty"`
-	LastErrorAt          *time.Time                         `json:"lastErrorAt,omitempty"`
-	LastStatusCode       *int                               `json:"lastStatusCode,omitempty"`
-	LastDurationMs       *int                               `json:"lastDurationMs,omitempty"`
-	LastErrorMessage     *string                            `json:"lastErrorMessage,omitempty"`
-	CreatedAt            time.Time                          `json:"createdAt"`
-	UpdatedAt            time.Time                          `json:"updatedAt"`
+	ID                     int64                              `json:"id"`
+	Label                  *string                            `json:"label,omitempty"`
+	Method                 string                             `json:"method"`
+	URL                    string                             `json:"url"`
+	IconURL                string                             `json:"iconUrl"`
+	Body                   *string                            `json:"body,omitempty"`
+	Headers                kvMap                              `json:"headers"`
+	UserAgent              *string                            `json:"userAgent,omitempty"`
+	HeaderProfileID        *int                               `json:"headerProfileId,omitempty"`
+	Auth                   kvMap                              `json:"auth"`
+	NotificationChannels   []string                           `json:"notificationChannels"`
+	NotificationIssues     []monitorNotificationIssueResponse `json:"notificationIssues"`
+	EscalationChannels     []string                           `json:"escalationChannels"`
+	EscalationAfterMinutes *int                               `json:"escalationAfterMinutes,omitempty"`
+	Tags                   []string                           `json:"tags"`
+	Selector               *string                            `json:"selector,omitempty"`
+	ExpectedStatus         *string                            `json:"expectedStatus,omitempty"`
+	RetryOn                *string                            `json:"retryOn,omitempty"`
+	ExpectedType           string                             `json:"expectedType"`
+	ExpectedResponse       *string                            `json:"expectedResponse,omitempty"`
+	MustContain            []string                           `json:"mustContain"`
+	MustNotContain         []string                           `json:"mustNotContain"`
+	TreatNotFoundAsSuccess bool                               `json:"treatNotFoundAsSuccess"`
+	AcceptEmptyBody        bool                               `json:"acceptEmptyBody"`
+	WatchdogMinutes        *int                               `json:"watchdogMinutes,omitempty"`
+	HeaderAssertions       kvMap                              `json:"headerAssertions"`
+	TrackHeader            *string                            `json:"trackHeader,omitempty"`
+	WatchdogAlertedAt      *time.Time                         `json:"watchdogAlertedAt,omitempty"`
+	NumericTolerance       *float64                           `json:"numericTolerance,omitempty"`
+	HostOverrides          kvMap                              `json:"hostOverrides"`
+	IPFamily               string                             `json:"ipFamily"`
+	RedirectPolicy         string                             `json:"redirectPolicy"`
+	MaxRedirects           *int                               `json:"maxRedirects,omitempty"`
+	MaxResponseBytes       *int                               `json:"maxResponseBytes,omitempty"`
+	ChecksHistoryLimit     *int                               `json:"checksHistoryLimit,omitempty"`
+	CookieJar              bool                               `json:"cookieJar"`
+	TLSCAPEM               *string                            `json:"tlsCaPem,omitempty"`
+	TLSInsecureSkipVerify  bool                               `json:"tlsInsecureSkipVerify"`
+	TLSMinVersion          *string                            `json:"tlsMinVersion,omitempty"`
+	TLSServerName          *string                            `json:"tlsServerName,omitempty"`
+	Cron                   string                             `json:"cron"`
+	Timezone               *string                            `json:"timezone,omitempty"`
+	JitterSeconds          *int                               `json:"jitterSeconds,omitempty"`
+	DSTPolicy              string                             `json:"dstPolicy"`
+	BodySnapshot           string                             `json:"bodySnapshot"`
+	ContentHash            string                             `json:"contentHash"`
+	FetchMode              string                             `json:"fetchMode"`
+	HeartbeatURL           *string                            `json:"heartbeatUrl,omitempty"`
+	LastPingAt             *time.Time                         `json:"lastPingAt,omitempty"`
+	CircuitOpenedAt        *time.Time                         `json:"circuitOpenedAt,omitempty"`
+	Enabled                bool                               `json:"enabled"`
+	StatusPage             bool                               `json:"statusPage"`
+	SortIndex              int                                `json:"sortIndex"`
+	ArchivedAt             *time.Time                         `json:"archivedAt,omitempty"`
+	Status                 string                             `json:"status"`
+	CheckCount             int64                              `json:"checkCount"`
+	NextRunAt              *time.Time                         `json:"nextRunAt,omitempty"`
+	LastCheckAt            *time.Time                         `json:"lastCheckAt,omitempty"`
+	LastSuccessAt          *time.Time                         `json:"lastSuccessAt,omitempty"`
+	LastErrorAt            *time.Time                         `json:"lastErrorAt,omitempty"`
+	LastStatusCode         *int                               `json:"lastStatusCode,omitempty"`
+	LastDurationMs         *int                               `json:"lastDurationMs,omitempty"`
+	LastErrorMessage       *string                            `json:"lastErrorMessage,omitempty"`
+	FailingSince           *time.Time                         `json:"failingSince,omitempty"`
+	EscalatedAt            *time.Time                         `json:"escalatedAt,omitempty"`
+	AcknowledgedAt         *time.Time                         `json:"acknowledgedAt,omitempty"`
+	ChangeFrequency        changeFrequencyResponse            `json:"changeFrequency"`
+	LastChangeAt           *time.Time                         `json:"lastChangeAt,omitempty"`
+	Recent                 *recentChecksResponse              `json:"recent,omitempty"`
+	ExpectChangeUntil      *time.Time                         `json:"expectChangeUntil,omitempty"`
+	StaleReason            *string                            `json:"staleReason,omitempty"`
+	CreatedAt              time.Time                          `json:"createdAt"`
+	UpdatedAt              time.Time                          `json:"updatedAt"`
 }
 
 type monitorNotificationIssueResponse struct {
@@ -148,20 +360,53 @@ type notificationChannelState struct {
 }
 
 type createMonitorRequest struct {
-	Label                *string           `json:"label"`
-	Method               string            `json:"method"`
-	URL                  string            `json:"url"`
-	IconURL              *string           `json:"iconUrl"`
-	Body                 *string           `json:"body"`
-	Headers              map[string]string `json:"headers"`
-	Auth                 map[string]string `json:"auth"`
-	NotificationChannels []string          `json:"notificationChannels"`
-	Selector             *string           `json:"selector"`
-	ExpectedType         string            `json:"expectedType"`
-	ExpectedResponse     *string           `json:"expectedResponse"`
-	Cron                 string            `json:"cron"`
-	Enabled              *bool             `json:"enabled"`
-	TriggerOnCreate      *bool             `json:"triggerOnCreate"`
+	Label                  *string           `json:"label"`
+	Method                 string            `json:"method"`
+	URL                    string            `json:"url"`
+	IconURL                *string           `json:"iconUrl"`
+	Body                   *string           `json:"body"`
+	Headers                map[string]string `json:"headers"`
+	UserAgent              *string           `json:"userAgent"`
+	HeaderProfileID        *int              `json:"headerProfileId"`
+	Auth                   map[string]string `json:"auth"`
+	NotificationChannels   []string          `json:"notificationChannels"`
+	EscalationChannels     []string          `json:"escalationChannels"`
+	EscalationAfterMinutes *int              `json:"escalationAfterMinutes"`
+	Tags                   []string          `json:"tags"`
+	Selector               *string           `json:"selector"`
+	ExpectedStatus         *string           `json:"expectedStatus"`
+	RetryOn                *string           `json:"retryOn"`
+	ExpectedType           string            `json:"expectedType"`
+	ExpectedResponse       *string           `json:"expectedResponse"`
+	MustContain            []string          `json:"mustContain"`
+	MustNotContain         []string          `json:"mustNotContain"`
+	TreatNotFoundAsSuccess *bool             `json:"treatNotFoundAsSuccess"`
+	AcceptEmptyBody        *bool             `json:"acceptEmptyBody"`
+	WatchdogMinutes        *int              `json:"watchdogMinutes"`
+	HeaderAssertions       map[string]string `json:"headerAssertions"`
+	TrackHeader            *string           `json:"trackHeader"`
+	NumericTolerance       *float64          `json:"numericTolerance"`
+	HostOverrides          map[string]string `json:"hostOverrides"`
+	IPFamily               string            `json:"ipFamily"`
+	RedirectPolicy         string            `json:"redirectPolicy"`
+	MaxRedirects           *int              `json:"maxRedirects"`
+	MaxResponseBytes       *int              `json:"maxResponseBytes"`
+	ChecksHistoryLimit     *int              `json:"checksHistoryLimit"`
+	CookieJar              *bool             `json:"cookieJar"`
+	TLSCAPEM               *string           `json:"tlsCaPem"`
+	TLSInsecureSkipVerify  *bool             `json:"tlsInsecureSkipVerify"`
+	TLSMinVersion          *string           `json:"tlsMinVersion"`
+	TLSServerName          *string           `json:"tlsServerName"`
+	Cron                   string            `json:"cron"`
+	Timezone               *string           `json:"timezone"`
+	JitterSeconds          *int              `json:"jitterSeconds"`
+	DSTPolicy              string            `json:"dstPolicy"`
+	BodySnapshot           string            `json:"bodySnapshot"`
+	ContentHash            string            `json:"contentHash"`
+	FetchMode              string            `json:"fetchMode"`
+	Enabled                *bool             `json:"enabled"`
+	StatusPage             *bool             `json:"statusPage"`
+	TriggerOnCreate        *bool             `json:"triggerOnCreate"`
 }
 
 type monitorTriggerResponse struct {
@@ -170,27 +415,66 @@ type monitorTriggerResponse struct {
 }
 
 type normalizedMonitorRequest struct {
-	label                *string
-	method               string
-	url                  string
-	iconURL              string
-	body                 *string
-	headers              map[string]string
-	auth                 map[string]string
-	notificationChannels []string
-	selector             *string
-	expectedType         string
-	expectedResponse     *string
-	cronExpr             string
-	enabled              bool
+	label                  *string
+	method                 string
+	url                    string
+	iconURL                string
+	body                   *string
+	headers                map[string]string
+	userAgent              *string
+	headerProfileID        *int
+	auth                   map[string]string
+	notificationChannels   []string
+	escalationChannels     []string
+	escalationAfterMinutes *int
+	tags                   []string
+	selector               *string
+	expectedStatus         *string
+	retryOn                *string
+	expectedType           string
+	expectedResponse       *string
+	mustContain            []string
+	mustNotContain         []string
+	treatNotFoundAsSuccess bool
+	acceptEmptyBody        bool
+	watchdogMinutes        *int
+	headerAssertions       map[string]string
+	trackHeader            *string
+	numericTolerance       *float64
+	hostOverrides          map[string]string
+	ipFamily               string
+	redirectPolicy         string
+	maxRedirects           *int
+	maxResponseBytes       *int
+	checksHistoryLimit     *int
+	cookieJar              bool
+	tlsCAPEM               *string
+	tlsInsecureSkipVerify  bool
+	tlsMinVersion          *string
+	tlsServerName          *string
+	cronExpr               string
+	timezone               *string
+	jitterSeconds          *int
+	dstPolicy              string
+	bodySnapshot           string
+	contentHash            string
+	fetchMode              string
+	enabled                bool
+	statusPage             bool
 }
 
 type testMonitorRequest struct {
-	Method  string            `json:"method"`
-	URL     string            `json:"url"`
-	Body    *string           `json:"body"`
-	Headers map[string]string `json:"headers"`
-	Auth    map[string]string `json:"auth"`
+	Method          string            `json:"method"`
+	URL             string            `json:"url"`
+	Body            *string           `json:"body"`
+	Headers         map[string]string `json:"headers"`
+	UserAgent       *string           `json:"userAgent"`
+	HeaderProfileID *int              `json:"headerProfileId"`
+	Auth            map[string]string `json:"auth"`
+	// MonitorID fills in masked auth values from a saved monitor, so its
+	// edit form can be tested without re-entering secrets, as long as the url
+	// keeps the saved monitor's scheme and host.
+	MonitorID *int `json:"monitorId"`
 }
 
 type testMonitorResponse struct {
@@ -241,28 +525,91 @@ type testTelegramSettingsResponse struct {
 type runtimeSettingsRequest struct {
 	ChecksHistoryLimit int    `json:"checksHistoryLimit"`
 	Timezone           string `json:"timezone"`
+	StaleAfterDays     *int   `json:"staleAfterDays"`
+	StaleNotifications *bool  `json:"staleNotifications"`
+	// ScheduleJitterSeconds delays each scheduled run by a random 0..n seconds.
+	ScheduleJitterSeconds *int `json:"scheduleJitterSeconds"`
+	// CircuitBreakerThreshold is the number of consecutive errors after which
+	// a monitor is backed off or suspended; 0 disables the circuit breaker.
+	CircuitBreakerThreshold      *int    `json:"circuitBreakerThreshold"`
+	CircuitBreakerAction         *string `json:"circuitBreakerAction"`
+	CircuitBreakerBackoffMinutes *int    `json:"circuitBreakerBackoffMinutes"`
+	// CatchUpPolicy decides how runs missed while the worker was down are
+	// handled at startup; CatchUpMaxAgeMinutes drops older missed runs.
+	CatchUpPolicy        *string `json:"catchUpPolicy"`
+	CatchUpMaxAgeMinutes *int    `json:"catchUpMaxAgeMinutes"`
+	// DefaultUserAgent and DefaultHeaders are sent with every check unless
+	// the monitor or its header profile sets the same header. An empty
+	// string or object clears them.
+	DefaultUserAgent *string           `json:"defaultUserAgent"`
+	DefaultHeaders   map[string]string `json:"defaultHeaders"`
+	// ProxyURL routes checks through an outbound proxy instead of the
+	// HTTP_PROXY environment variables; hosts in ProxyBypass are reached
+	// directly. An empty string clears them.
+	ProxyURL    *string `json:"proxyUrl"`
+	ProxyBypass *string `json:"proxyBypass"`
+	// RequestTimeoutSeconds bounds each check attempt. Failed attempts are
+	// retried up to MaxRetries times, the n-th retry after n times
+	// RetryBackoffSeconds.
+	RequestTimeoutSeconds *int `json:"requestTimeoutSeconds"`
+	MaxRetries            *int `json:"maxRetries"`
+	RetryBackoffSeconds   *int `json:"retryBackoffSeconds"`
+	// TickIntervalSeconds is the time between full scheduling passes. Runs
+	// due within ScheduleLookaheadSeconds of a wake-up start with it.
+	TickIntervalSeconds      *int `json:"tickIntervalSeconds"`
+	ScheduleLookaheadSeconds *int `json:"scheduleLookaheadSeconds"`
+	// MaxResponseBodyBytes overrides GOANNA_MAX_RESPONSE_BODY_BYTES for
+	// checks and monitor tests; 0 goes back to the startup limit.
+	MaxResponseBodyBytes *int `json:"maxResponseBodyBytes"`
 }
 
 type runtimeSettingsResponse struct {
-	ChecksHistoryLimit int        `json:"checksHistoryLimit"`
-	Timezone           *string    `json:"timezone,omitempty"`
-	RequiredSettings   []string   `json:"requiredSettings"`
-	UpdatedAt          *time.Time `json:"updatedAt"`
+	ChecksHistoryLimit           int        `json:"checksHistoryLimit"`
+	Timezone                     *string    `json:"timezone,omitempty"`
+	StaleAfterDays               int        `json:"staleAfterDays"`
+	StaleNotifications           bool       `json:"staleNotifications"`
+	ScheduleJitterSeconds        int        `json:"scheduleJitterSeconds"`
+	CircuitBreakerThreshold      int        `json:"circuitBreakerThreshold"`
+	CircuitBreakerAction         string     `json:"circuitBreakerAction"`
+	CircuitBreakerBackoffMinutes int        `json:"circuitBreakerBackoffMinutes"`
+	CatchUpPolicy                string     `json:"catchUpPolicy"`
+	CatchUpMaxAgeMinutes         int        `json:"catchUpMaxAgeMinutes"`
+	DefaultUserAgent             *string    `json:"defaultUserAgent,omitempty"`
+	DefaultHeaders               kvMap      `json:"defaultHeaders"`
+	ProxyURL                     *string    `json:"proxyUrl,omitempty"`
+	ProxyBypass                  *string    `json:"proxyBypass,omitempty"`
+	RequestTimeoutSeconds        int        `json:"requestTimeoutSeconds"`
+	MaxRetries                   int        `json:"maxRetries"`
+	RetryBackoffSeconds          int        `json:"retryBackoffSeconds"`
+	TickIntervalSeconds          int        `json:"tickIntervalSeconds"`
+	ScheduleLookaheadSeconds     int        `json:"scheduleLookaheadSeconds"`
+	MaxResponseBodyBytes         *int       `json:"maxResponseBodyBytes,omitempty"`
+	RequiredSettings             []string   `json:"requiredSettings"`
+	UpdatedAt                    *time.Time `json:"updatedAt"`
 }
 
 type monitorCheckResponse struct {
-	ID             int64     `json:"id"`
-	Status         string    `json:"status"`
-	StatusCode     *int      `json:"statusCode,omitempty"`
-	ResponseTimeMs *int      `json:"responseTimeMs,omitempty"`
-	ErrorMessage   *string   `json:"errorMessage,omitempty"`
-	SelectionType  *string   `json:"selectionType,omitempty"`
-	SelectionValue *string   `json:"selectionValue,omitempty"`
-	DiffChanged    bool      `json:"diffChanged"`
-	DiffKind       *string   `json:"diffKind,omitempty"`
-	DiffSummary    *string   `json:"diffSummary,omitempty"`
-	DiffDetails    *string   `json:"diffDetails,omitempty"`
-	CheckedAt      time.Time `json:"checkedAt"`
+	ID                 int64     `json:"id"`
+	Status             string    `json:"status"`
+	StatusCode         *int      `json:"statusCode,omitempty"`
+	ResponseTimeMs     *int      `json:"responseTimeMs,omitempty"`
+	ErrorMessage       *string   `json:"errorMessage,omitempty"`
+	SelectionType      *string   `json:"selectionType,omitempty"`
+	SelectionValue     *string   `json:"selectionValue,omitempty"`
+	DiffChanged        bool      `json:"diffChanged"`
+	DiffKind           *string   `json:"diffKind,omitempty"`
+	DiffSummary        *string   `json:"diffSummary,omitempty"`
+	DiffDetails        *string   `json:"diffDetails,omitempty"`
+	BodySize           *int      `json:"bodySize,omitempty"`
+	HasBody            bool      `json:"hasBody"`
+	BodyTruncated      bool      `json:"bodyTruncated"`
+	BodyHash           *string   `json:"bodyHash,omitempty"`
+	TrackedHeaderValue *string   `json:"trackedHeaderValue,omitempty"`
+	RedirectChain      []string  `json:"redirectChain,omitempty"`
+	BodyReadMs         *float64  `json:"bodyReadMs,omitempty"`
+	SelectorMs         *float64  `json:"selectorMs,omitempty"`
+	DiffMs             *float64  `json:"diffMs,omitempty"`
+	CheckedAt          time.Time `json:"checkedAt"`
 }
 
 type kvMap map[string]string
@@ -274,26 +621,89 @@ func (m kvMap) MarshalJSON() ([]byte, error) {
 	return json.Marshal(map[string]string(m))
 }
 
-func (s *Server) handleHealth(w http.ResponseWriter, _ *http.Request) {
-	writeJSON(w, http.StatusOK, healthResponse{Status: "ok"})
+func (s *Server) handleHealth(w http.ResponseWriter, r *http.Request) {
+	response := healthResponse{Status: "ok"}
+	if config, err := s.ensureGlobalSystemConfig(r.Context()); err == nil {
+		response.Paused = config.Paused
+	}
+
+	writeJSON(w, http.StatusOK, response)
 }
 
 func (s *Server) handleListMonitors(w http.ResponseWriter, r *http.Request) {
-	rows, err := s.db.Monitor.Query().WithRuntime().All(r.Context())
+	staleOnly := false
+	if staleValue := strings.TrimSpace(r.URL.Query().Get("stale")); staleValue != "" {
+		parsed, err := strconv.ParseBool(staleValue)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, "stale must be true or false")
+			return
+		}
+		staleOnly = parsed
+	}
+	// Archived monitors are listed only when asked for, and then on their own.
+	archivedOnly := false
+	if archivedValue := strings.TrimSpace(r.URL.Query().Get("archived")); archivedValue != "" {
+		parsed, err := strconv.ParseBool(archivedValue)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, "archived must be true or false")
+			return
+		}
+		archivedOnly = parsed
+	}
+	tags := parseMonitorTagFilter(r)
+
+	query := s.db.Monitor.Query()
+	if archivedOnly {
+		query = query.Where(monitor.ArchivedAtNotNil())
+	} else {
+		query = query.Where(monitor.ArchivedAtIsNil())
+	}
+	rows, err := query.
+		WithRuntime().
+		Order(ent.Asc(monitor.FieldSortIndex), ent.Asc(monitor.FieldID)).
+		All(r.Context())
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "failed to list monitors")
+		return
+	}
+
+	config, err := s.ensureGlobalSystemConfig(r.Context())
 	if err != nil {
 		writeError(w, http.StatusInternalServerError, "failed to list monitors")
 		return
 	}
 
 	channelStates := s.loadNotificationChannelStates(r.Context())
+	staleAfter := worker.StaleAfter(config)
+	now := time.Now().UTC()
+	recent, err := s.loadRecentChecks(r.Context(), now)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "failed to list monitors")
+		return
+	}
 
 	response := make([]monitorResponse, 0, len(rows))
 	for _, row := range rows {
-		response = append(response, mapMonitor(
+		if !hasAllTags(row, tags) {
+			continue
+		}
+
+		staleReason := worker.StaleReason(row, row.Edges.Runtime, staleAfter, now)
+		if staleOnly && staleReason == "" {
+			continue
+		}
+
+		item := s.mapMonitor(
 			row,
 			row.Edges.Runtime,
 			buildMonitorNotificationIssues(row.NotificationChannels, channelStates),
-		))
+		)
+		if staleReason != "" {
+			item.StaleReason = &staleReason
+		}
+		recentChecks := recent[row.ID]
+		item.Recent = &recentChecks
+		response = append(response, item)
 	}
 
 	writeJSON(w, http.StatusOK, response)
@@ -307,24 +717,111 @@ func (s *Server) handleCreateMonitor(w http.ResponseWriter, r *http.Request) {
 	}
 
 	input, err := normalizeMonitorRequest(req)
+	if err == nil {
+		input.auth, err = unmaskMonitorAuth(input.auth, nil)
+	}
 	if err != nil {
 		writeError(w, http.StatusBadRequest, err.Error())
 		return
 	}
+	if !s.requireHeaderProfile(w, r, input.headerProfileID) {
+		return
+	}
+
+	config, err := s.ensureGlobalSystemConfig(r.Context())
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "failed to load runtime settings")
+		return
+	}
+
+	created, runtime, err := createMonitorWithRuntime(
+		r.Context(),
+		s.db,
+		input,
+		time.Now().UTC(),
+		runtimeCronLocation(config.Timezone),
+	)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	s.scheduleChanges.Publish()
+
+	channelStates := s.loadNotificationChannelStates(r.Context())
+	mapped := s.mapMonitor(
+		created,
+		runtime,
+		buildMonitorNotificationIssues(created.NotificationChannels, channelStates),
+	)
+	s.publishMonitorUpdated(created.ID, monitorCreated, &mapped)
+
+	triggerOnCreate := req.TriggerOnCreate != nil && *req.TriggerOnCreate
+	if !triggerOnCreate {
+		writeJSON(w, http.StatusCreated, monitorTriggerResponse{Monitor: mapped})
+		return
+	}
+
+	triggerResult, triggerErr := s.triggerWorker.TriggerMonitorNow(r.Context(), created.ID)
+	if triggerErr != nil {
+		writeError(w, http.StatusInternalServerError, "failed to trigger monitor")
+		return
+	}
+
+	writeJSON(w, http.StatusCreated, s.mapTriggerResponse(triggerResult, channelStates))
+}
+
+// createMonitorWithRuntime saves a normalized monitor together with its
+// runtime row, scheduling the first run when the monitor is enabled.
+func createMonitorWithRuntime(
+	ctx context.Context,
+	client *ent.Client,
+	input normalizedMonitorRequest,
+	now time.Time,
+	cronLocation *time.Location,
+) (*ent.Monitor, *ent.MonitorRuntime, error) {
+	sortIndex, err := nextMonitorSortIndex(ctx, client)
+	if err != nil {
+		return nil, nil, err
+	}
 
-	create := s.db.Monitor.Create().
+	create := client.Monitor.Create().
 		SetMethod(input.method).
 		SetURL(input.url).
 		SetIconURL(input.iconURL).
 		SetCron(input.cronExpr).
+		SetDstPolicy(monitor.DstPolicy(input.dstPolicy)).
+		SetBodySnapshot(monitor.BodySnapshot(input.bodySnapshot)).
+		SetContentHash(monitor.ContentHash(input.contentHash)).
+		SetFetchMode(monitor.FetchMode(input.fetchMode)).
 		SetExpectedType(monitor.ExpectedType(input.expectedType)).
 		SetEnabled(input.enabled).
+		SetStatusPage(input.statusPage).
+		SetSortIndex(sortIndex).
 		SetHeaders(input.headers).
 		SetAuth(input.auth).
-		SetNotificationChannels(input.notificationChannels)
+		SetNotificationChannels(input.notificationChannels).
+		SetEscalationChannels(input.escalationChannels).
+		SetTags(input.tags).
+		SetMustContain(input.mustContain).
+		SetMustNotContain(input.mustNotContain).
+		SetTreatNotFoundAsSuccess(input.treatNotFoundAsSuccess).
+		SetAcceptEmptyBody(input.acceptEmptyBody).
+		SetHeaderAssertions(input.headerAssertions)
 	if input.label != nil {
 		create = create.SetLabel(*input.label)
 	}
+	if input.fetchMode == monitor.FetchModeHeartbeat.String() {
+		token, err := worker.NewHeartbeatToken()
+		if err != nil {
+			return nil, nil, err
+		}
+		create = create.
+			SetHeartbeatToken(token).
+			SetURL(worker.HeartbeatPingPath(token))
+	}
+	if input.escalationAfterMinutes != nil {
+		create = create.SetEscalationAfterMinutes(*input.escalationAfterMinutes)
+	}
 	if input.body != nil {
 		create = create.SetBody(*input.body)
 	}
@@ -334,11 +831,61 @@ func (s *Server) handleCreateMonitor(w http.ResponseWriter, r *http.Request) {
 	if input.expectedResponse != nil {
 		create = create.SetExpectedResponse(*input.expectedResponse)
 	}
+	if input.numericTolerance != nil {
+		create = create.SetNumericTolerance(*input.numericTolerance)
+	}
+	if input.watchdogMinutes != nil {
+		create = create.SetWatchdogMinutes(*input.watchdogMinutes)
+	}
+	if input.expectedStatus != nil {
+		create = create.SetExpectedStatus(*input.expectedStatus)
+	}
+	if input.retryOn != nil {
+		create = create.SetRetryOn(*input.retryOn)
+	}
+	if input.userAgent != nil {
+		create = create.SetUserAgent(*input.userAgent)
+	}
+	if input.headerProfileID != nil {
+		create = create.SetHeaderProfileID(*input.headerProfileID)
+	}
+	create = create.
+		SetHostOverrides(input.hostOverrides).
+		SetIPFamily(monitor.IPFamily(input.ipFamily)).
+		SetRedirectPolicy(monitor.RedirectPolicy(input.redirectPolicy)).
+		SetCookieJar(input.cookieJar)
+	if input.maxRedirects != nil {
+		create = create.SetMaxRedirects(*input.maxRedirects)
+	}
+	if input.maxResponseBytes != nil {
+		create = create.SetMaxResponseBytes(*input.maxResponseBytes)
+	}
+	if input.checksHistoryLimit != nil {
+		create = create.SetChecksHistoryLimit(*input.checksHistoryLimit)
+	}
+	if input.tlsCAPEM != nil {
+		create = create.SetTLSCaPem(*input.tlsCAPEM)
+	}
+	create = create.SetTLSInsecureSkipVerify(input.tlsInsecureSkipVerify)
+	if input.tlsMinVersion != nil {
+		create = create.SetTLSMinVersion(*input.tlsMinVersion)
+	}
+	if input.tlsServerName != nil {
+		create = create.SetTLSServerName(*input.tlsServerName)
+	}
+	if input.timezone != nil {
+		create = create.SetTimezone(*input.timezone)
+	}
+	if input.jitterSeconds != nil {
+		create = create.SetJitterSeconds(*input.jitterSeconds)
+	}
+	if input.trackHeader != nil {
+		create = create.SetTrackHeader(*input.trackHeader)
+	}
 
-	created, err := create.Save(r.Context())
+	created, err := create.Save(ctx)
 	if err != nil {
-		writeError(w, http.StatusInternalServerError, "failed to create monitor")
-		return
+		return nil, nil, errors.New("failed to create monitor")
 	}
 
 	runtimeStatus := monitorruntime.StatusPending
@@ -346,49 +893,26 @@ func (s *Server) handleCreateMonitor(w http.ResponseWriter, r *http.Request) {
 		runtimeStatus = monitorruntime.StatusDisabled
 	}
 
-	config, err := s.ensureGlobalSystemConfig(r.Context())
-	if err != nil {
-		writeError(w, http.StatusInternalServerError, "failed to load runtime settings")
-		return
-	}
-	cronLocation := runtimeCronLocation(config.Timezone)
-
-	runtimeCreate := s.db.MonitorRuntime.Create().
+	runtimeCreate := client.MonitorRuntime.Create().
 		SetMonitor(created).
 		SetStatus(runtimeStatus)
 	if created.Enabled {
-		nextRun, nextErr := nextRunFromCron(created.Cron, time.Now().UTC(), cronLocation)
+		nextRun, nextErr := nextRunFromCron(created.Cron, created.DstPolicy.String(), now, worker.MonitorLocation(created, cronLocation))
 		if nextErr == nil {
 			runtimeCreate = runtimeCreate.SetNextRunAt(nextRun)
 		}
 	}
 
-	runtime, runtimeErr := runtimeCreate.Save(r.Context())
-	if runtimeErr != nil {
-		writeError(w, http.StatusInternalServerError, "failed to initialize monitor runtime")
-		return
-	}
-
-	triggerOnCreate := req.TriggerOnCreate != nil && *req.TriggerOnCreate
-	channelStates := s.loadNotificationChannelStates(r.Context())
-	if !triggerOnCreate {
-		writeJSON(w, http.StatusCreated, monitorTriggerResponse{
-			Monitor: mapMonitor(
-				created,
-				runtime,
-				buildMonitorNotificationIssues(created.NotificationChannels, channelStates),
-			),
-		})
-		return
+	runtime, err := runtimeCreate.Save(ctx)
+	if err != nil {
+		return nil, nil, errors.New("failed to initialize monitor runtime")
 	}
 
-	triggerResult, triggerErr := s.triggerWorker.TriggerMonitorNow(r.Context(), created.ID)
-	if triggerErr != nil {
-		writeError(w, http.StatusInternalServerError, "failed to trigger monitor")
-		return
+	if err := recordMonitorVersion(ctx, client, created); err != nil {
+		return nil, nil, errors.New("failed to record monitor version")
 	}
 
-	writeJSON(w, http.StatusCreated, mapTriggerResponse(triggerResult, channelStates))
+	return created, runtime, nil
 }
 
 func (s *Server) handleUpdateMonitor(w http.ResponseWriter, r *http.Request) {
@@ -409,6 +933,9 @@ func (s *Server) handleUpdateMonitor(w http.ResponseWriter, r *http.Request) {
 		writeError(w, http.StatusBadRequest, err.Error())
 		return
 	}
+	if !s.requireHeaderProfile(w, r, input.headerProfileID) {
+		return
+	}
 
 	existing, err := s.db.Monitor.Query().
 		Where(monitor.IDEQ(monitorID)).
@@ -422,22 +949,109 @@ func (s *Server) handleUpdateMonitor(w http.ResponseWriter, r *http.Request) {
 		writeError(w, http.StatusInternalServerError, "failed to load monitor")
 		return
 	}
+	input.auth, err = unmaskMonitorAuth(input.auth, existing.Auth)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	config, err := s.ensureGlobalSystemConfig(r.Context())
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "failed to load runtime settings")
+		return
+	}
+
+	updated, runtime, err := updateMonitorWithRuntime(
+		r.Context(),
+		s.db,
+		existing,
+		input,
+		time.Now().UTC(),
+		runtimeCronLocation(config.Timezone),
+	)
+	if err != nil {
+		if errors.Is(err, errInvalidMonitorCron) {
+			writeError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		writeError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	s.scheduleChanges.Publish()
 
-	update := s.db.Monitor.UpdateOneID(monitorID).
+	channelStates := s.loadNotificationChannelStates(r.Context())
+	mapped := s.mapMonitor(
+		updated,
+		runtime,
+		buildMonitorNotificationIssues(updated.NotificationChannels, channelStates),
+	)
+	s.publishMonitorUpdated(updated.ID, monitorUpdated, &mapped)
+
+	writeJSON(w, http.StatusOK, mapped)
+}
+
+// errInvalidMonitorCron reports a saved cron expression that has no next run.
+var errInvalidMonitorCron = errors.New("invalid cron expression")
+
+// updateMonitorWithRuntime replaces existing's configuration with input and
+// reschedules its runtime row. existing must be loaded with its runtime.
+func updateMonitorWithRuntime(
+	ctx context.Context,
+	client *ent.Client,
+	existing *ent.Monitor,
+	input normalizedMonitorRequest,
+	now time.Time,
+	cronLocation *time.Location,
+) (*ent.Monitor, *ent.MonitorRuntime, error) {
+	update := client.Monitor.UpdateOneID(existing.ID).
 		SetMethod(input.method).
 		SetURL(input.url).
 		SetIconURL(input.iconURL).
 		SetCron(input.cronExpr).
+		SetDstPolicy(monitor.DstPolicy(input.dstPolicy)).
+		SetBodySnapshot(monitor.BodySnapshot(input.bodySnapshot)).
+		SetContentHash(monitor.ContentHash(input.contentHash)).
+		SetFetchMode(monitor.FetchMode(input.fetchMode)).
 		SetExpectedType(monitor.ExpectedType(input.expectedType)).
 		SetEnabled(input.enabled).
+		SetStatusPage(input.statusPage).
 		SetHeaders(input.headers).
 		SetAuth(input.auth).
-		SetNotificationChannels(input.notificationChannels)
+		SetNotificationChannels(input.notificationChannels).
+		SetEscalationChannels(input.escalationChannels).
+		SetTags(input.tags).
+		SetMustContain(input.mustContain).
+		SetMustNotContain(input.mustNotContain).
+		SetTreatNotFoundAsSuccess(input.treatNotFoundAsSuccess).
+		SetAcceptEmptyBody(input.acceptEmptyBody).
+		SetHeaderAssertions(input.headerAssertions)
 	if input.label != nil {
 		update = update.SetLabel(*input.label)
 	} else {
 		update = update.ClearLabel()
 	}
+	if input.fetchMode == monitor.FetchModeHeartbeat.String() {
+		var token string
+		if existing.HeartbeatToken != nil {
+			token = *existing.HeartbeatToken
+		} else {
+			generated, err := worker.NewHeartbeatToken()
+			if err != nil {
+				return nil, nil, errors.New("failed to update monitor")
+			}
+			token = generated
+		}
+		update = update.
+			SetHeartbeatToken(token).
+			SetURL(worker.HeartbeatPingPath(token))
+	} else {
+		update = update.ClearHeartbeatToken()
+	}
+	if input.escalationAfterMinutes != nil {
+		update = update.SetEscalationAfterMinutes(*input.escalationAfterMinutes)
+	} else {
+		update = update.ClearEscalationAfterMinutes()
+	}
 	if input.body != nil {
 		update = update.SetBody(*input.body)
 	} else {
@@ -453,31 +1067,104 @@ func (s *Server) handleUpdateMonitor(w http.ResponseWriter, r *http.Request) {
 	} else {
 		update = update.ClearExpectedResponse()
 	}
-
-	updated, err := update.Save(r.Context())
-	if err != nil {
-		writeError(w, http.StatusInternalServerError, "failed to update monitor")
-		return
+	if input.numericTolerance != nil {
+		update = update.SetNumericTolerance(*input.numericTolerance)
+	} else {
+		update = update.ClearNumericTolerance()
+	}
+	if input.watchdogMinutes != nil {
+		update = update.SetWatchdogMinutes(*input.watchdogMinutes)
+	} else {
+		update = update.ClearWatchdogMinutes()
+	}
+	if input.expectedStatus != nil {
+		update = update.SetExpectedStatus(*input.expectedStatus)
+	} else {
+		update = update.ClearExpectedStatus()
+	}
+	if input.retryOn != nil {
+		update = update.SetRetryOn(*input.retryOn)
+	} else {
+		update = update.ClearRetryOn()
+	}
+	if input.userAgent != nil {
+		update = update.SetUserAgent(*input.userAgent)
+	} else {
+		update = update.ClearUserAgent()
+	}
+	if input.headerProfileID != nil {
+		update = update.SetHeaderProfileID(*input.headerProfileID)
+	} else {
+		update = update.ClearHeaderProfileID()
+	}
+	update = update.
+		SetHostOverrides(input.hostOverrides).
+		SetIPFamily(monitor.IPFamily(input.ipFamily)).
+		SetRedirectPolicy(monitor.RedirectPolicy(input.redirectPolicy)).
+		SetCookieJar(input.cookieJar)
+	if input.maxRedirects != nil {
+		update = update.SetMaxRedirects(*input.maxRedirects)
+	} else {
+		update = update.ClearMaxRedirects()
+	}
+	if input.maxResponseBytes != nil {
+		update = update.SetMaxResponseBytes(*input.maxResponseBytes)
+	} else {
+		update = update.ClearMaxResponseBytes()
+	}
+	if input.checksHistoryLimit != nil {
+		update = update.SetChecksHistoryLimit(*input.checksHistoryLimit)
+	} else {
+		update = update.ClearChecksHistoryLimit()
+	}
+	if input.tlsCAPEM != nil {
+		update = update.SetTLSCaPem(*input.tlsCAPEM)
+	} else {
+		update = update.ClearTLSCaPem()
+	}
+	update = update.SetTLSInsecureSkipVerify(input.tlsInsecureSkipVerify)
+	if input.tlsMinVersion != nil {
+		update = update.SetTLSMinVersion(*input.tlsMinVersion)
+	} else {
+		update = update.ClearTLSMinVersion()
+	}
+	if input.tlsServerName != nil {
+		update = update.SetTLSServerName(*input.tlsServerName)
+	} else {
+		update = update.ClearTLSServerName()
+	}
+	if input.timezone != nil {
+		update = update.SetTimezone(*input.timezone)
+	} else {
+		update = update.ClearTimezone()
+	}
+	if input.jitterSeconds != nil {
+		update = update.SetJitterSeconds(*input.jitterSeconds)
+	} else {
+		update = update.ClearJitterSeconds()
+	}
+	if input.trackHeader != nil {
+		update = update.SetTrackHeader(*input.trackHeader)
+	} else {
+		update = update.ClearTrackHeader()
 	}
 
-	now := time.Now().UTC()
-	config, err := s.ensureGlobalSystemConfig(r.Context())
+	updated, err := update.Save(ctx)
 	if err != nil {
-		writeError(w, http.StatusInternalServerError, "failed to load runtime settings")
-		return
+		return nil, nil, errors.New("failed to update monitor")
 	}
-	cronLocation := runtimeCronLocation(config.Timezone)
 
-	nextRun, nextErr := nextRunFromCron(updated.Cron, now, cronLocation)
-	if nextErr != nil {
-		writeError(w, http.StatusBadRequest, "invalid cron expression")
-		return
+	nextRun, err := nextRunFromCron(updated.Cron, updated.DstPolicy.String(), now, worker.MonitorLocation(updated, cronLocation))
+	if err != nil {
+		return nil, nil, errInvalidMonitorCron
 	}
 
+	// Archived monitors keep their configuration current but stay unscheduled.
+	scheduled := updated.Enabled && updated.ArchivedAt == nil
 	runtime := existing.Edges.Runtime
 	if runtime == nil {
-		runtimeCreate := s.db.MonitorRuntime.Create().SetMonitor(updated)
-		if updated.Enabled {
+		runtimeCreate := client.MonitorRuntime.Create().SetMonitor(updated)
+		if scheduled {
 			runtimeCreate = runtimeCreate.
 				SetStatus(monitorruntime.StatusPending).
 				SetNextRunAt(nextRun)
@@ -485,38 +1172,47 @@ func (s *Server) handleUpdateMonitor(w http.ResponseWriter, r *http.Request) {
 			runtimeCreate = runtimeCreate.SetStatus(monitorruntime.StatusDisabled)
 		}
 
-		runtime, err = runtimeCreate.Save(r.Context())
+		runtime, err = runtimeCreate.Save(ctx)
 		if err != nil {
-			writeError(w, http.StatusInternalServerError, "failed to update monitor runtime")
-			return
+			return nil, nil, errors.New("failed to update monitor runtime")
 		}
 	} else {
-		runtimeUpdate := s.db.MonitorRuntime.UpdateOneID(runtime.ID)
-		if updated.Enabled {
+		runtimeUpdate := client.MonitorRuntime.UpdateOneID(runtime.ID)
+		if scheduled {
 			runtimeUpdate = runtimeUpdate.
 				SetStatus(monitorruntime.StatusPending).
-				SetNextRunAt(nextRun)
+				SetNextRunAt(nextRun).
+				ClearCircuitOpenedAt()
+			if runtime.CircuitOpenedAt != nil {
+				// Give a monitor the circuit breaker backed off or suspended a
+				// fresh run of attempts once it is edited or re-enabled.
+				runtimeUpdate = runtimeUpdate.SetConsecutiveErrors(0)
+			}
 		} else {
 			runtimeUpdate = runtimeUpdate.
 				SetStatus(monitorruntime.StatusDisabled).
 				ClearNextRunAt()
 		}
 
-		runtime, err = runtimeUpdate.Save(r.Context())
+		if !updated.CookieJar {
+			runtimeUpdate = runtimeUpdate.ClearCookies()
+		}
+
+		runtime, err = runtimeUpdate.Save(ctx)
 		if err != nil {
-			writeError(w, http.StatusInternalServerError, "failed to update monitor runtime")
-			return
+			return nil, nil, errors.New("failed to update monitor runtime")
 		}
 	}
 
-	channelStates := s.loadNotificationChannelStates(r.Context())
-	writeJSON(w, http.StatusOK, mapMonitor(
-		updated,
-		runtime,
-		buildMonitorNotificationIssues(updated.NotificationChannels, channelStates),
-	))
+	if err := recordMonitorVersion(ctx, client, updated); err != nil {
+		return nil, nil, errors.New("failed to record monitor version")
+	}
+
+	return updated, runtime, nil
 }
 
+// handleDeleteMonitor is DELETE /v1/monitors/{monitorId}/permanent. Unlike
+// archiving, it removes the monitor and its history for good.
 func (s *Server) handleDeleteMonitor(w http.ResponseWriter, r *http.Request) {
 	monitorID, err := parseMonitorID(r.PathValue("monitorId"))
 	if err != nil {
@@ -530,67 +1226,164 @@ func (s *Server) handleDeleteMonitor(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	if _, err := tx.CheckResult.Delete().
-		Where(checkresult.HasMonitorWith(monitor.IDEQ(monitorID))).
-		Exec(r.Context()); err != nil {
+	deleted, err := deleteMonitors(r.Context(), tx, monitorID)
+	if err != nil {
 		_ = tx.Rollback()
 		writeError(w, http.StatusInternalServerError, "failed to delete monitor")
 		return
 	}
-
-	if _, err := tx.NotificationEvent.Delete().
-		Where(notificationevent.HasMonitorWith(monitor.IDEQ(monitorID))).
-		Exec(r.Context()); err != nil {
+	if deleted == 0 {
 		_ = tx.Rollback()
-		writeError(w, http.StatusInternalServerError, "failed to delete monitor")
+		writeError(w, http.StatusNotFound, "monitor not found")
 		return
 	}
 
-	if _, err := tx.MonitorRuntime.Delete().
-		Where(monitorruntime.HasMonitorWith(monitor.IDEQ(monitorID))).
-		Exec(r.Context()); err != nil {
-		_ = tx.Rollback()
+	if err := tx.Commit(); err != nil {
 		writeError(w, http.StatusInternalServerError, "failed to delete monitor")
 		return
 	}
+	s.scheduleChanges.Publish()
+	s.publishMonitorUpdated(monitorID, monitorDeleted, nil)
 
-	if err := tx.Monitor.DeleteOneID(monitorID).Exec(r.Context()); err != nil {
-		_ = tx.Rollback()
-		if ent.IsNotFound(err) {
-			writeError(w, http.StatusNotFound, "monitor not found")
-			return
-		}
-		writeError(w, http.StatusInternalServerError, "failed to delete monitor")
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// deleteMonitors removes monitors together with their checks, rollups,
+// notification events, runtime and versions, returning how many monitors were
+// deleted.
+func deleteMonitors(ctx context.Context, tx *ent.Tx, monitorIDs ...int) (int, error) {
+	if _, err := tx.CheckResult.Delete().
+		Where(checkresult.MonitorIDIn(monitorIDs...)).
+		Exec(ctx); err != nil {
+		return 0, err
+	}
+
+	if _, err := tx.NotificationEvent.Delete().
+		Where(notificationevent.HasMonitorWith(monitor.IDIn(monitorIDs...))).
+		Exec(ctx); err != nil {
+		return 0, err
+	}
+
+	if _, err := tx.MonitorRuntime.Delete().
+		Where(monitorruntime.HasMonitorWith(monitor.IDIn(monitorIDs...))).
+		Exec(ctx); err != nil {
+		return 0, err
+	}
+
+	if _, err := tx.MonitorVersion.Delete().
+		Where(monitorversion.MonitorIDIn(monitorIDs...)).
+		Exec(ctx); err != nil {
+		return 0, err
+	}
+
+	if _, err := tx.CheckRollup.Delete().
+		Where(checkrollup.MonitorIDIn(monitorIDs...)).
+		Exec(ctx); err != nil {
+		return 0, err
+	}
+
+	return tx.Monitor.Delete().
+		Where(monitor.IDIn(monitorIDs...)).
+		Exec(ctx)
+}
+
+func (s *Server) handleTriggerMonitor(w http.ResponseWriter, r *http.Request) {
+	triggerResult, ok := s.runMonitorNow(w, r)
+	if !ok {
 		return
 	}
 
-	if err := tx.Commit(); err != nil {
-		writeError(w, http.StatusInternalServerError, "failed to delete monitor")
+	channelStates := s.loadNotificationChannelStates(r.Context())
+	writeJSON(w, http.StatusOK, s.mapTriggerResponse(triggerResult, channelStates))
+}
+
+// handleRunMonitor executes a check immediately like handleTriggerMonitor but
+// responds with just the resulting check.
+func (s *Server) handleRunMonitor(w http.ResponseWriter, r *http.Request) {
+	triggerResult, ok := s.runMonitorNow(w, r)
+	if !ok {
+		return
+	}
+	if triggerResult.Check == nil {
+		writeError(w, http.StatusInternalServerError, "monitor run did not record a check")
 		return
 	}
 
-	w.WriteHeader(http.StatusNoContent)
+	writeJSON(w, http.StatusOK, mapMonitorCheck(triggerResult.Check))
 }
 
-func (s *Server) handleTriggerMonitor(w http.ResponseWriter, r *http.Request) {
+// runMonitorNow runs the monitor named in the path through the worker and
+// writes the error response when it fails.
+func (s *Server) runMonitorNow(w http.ResponseWriter, r *http.Request) (*worker.TriggerMonitorResult, bool) {
 	monitorID, err := parseMonitorID(r.PathValue("monitorId"))
 	if err != nil {
 		writeError(w, http.StatusBadRequest, "monitorId must be a positive integer")
-		return
+		return nil, false
 	}
 
 	triggerResult, err := s.triggerWorker.TriggerMonitorNow(r.Context(), monitorID)
 	if err != nil {
 		if ent.IsNotFound(err) {
 			writeError(w, http.StatusNotFound, "monitor not found")
-			return
+			return nil, false
+		}
+		if errors.Is(err, worker.ErrMonitorInFlight) || errors.Is(err, worker.ErrMonitorArchived) {
+			writeError(w, http.StatusConflict, err.Error())
+			return nil, false
 		}
 		writeError(w, http.StatusInternalServerError, "failed to trigger monitor")
+		return nil, false
+	}
+	s.scheduleChanges.Publish()
+
+	return triggerResult, true
+}
+
+func (s *Server) handleAcknowledgeMonitor(w http.ResponseWriter, r *http.Request) {
+	monitorID, err := parseMonitorID(r.PathValue("monitorId"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "monitorId must be a positive integer")
+		return
+	}
+
+	row, err := s.db.Monitor.Query().
+		Where(monitor.IDEQ(monitorID)).
+		WithRuntime().
+		Only(r.Context())
+	if err != nil {
+		if ent.IsNotFound(err) {
+			writeError(w, http.StatusNotFound, "monitor not found")
+			return
+		}
+		writeError(w, http.StatusInternalServerError, "failed to load monitor")
 		return
 	}
 
+	runtime := row.Edges.Runtime
+	if runtime == nil || runtime.FailingSince == nil {
+		writeError(w, http.StatusConflict, "monitor is not failing")
+		return
+	}
+
+	if runtime.AcknowledgedAt == nil {
+		runtime, err = s.db.MonitorRuntime.UpdateOneID(runtime.ID).
+			SetAcknowledgedAt(time.Now().UTC()).
+			Save(r.Context())
+		if err != nil {
+			writeError(w, http.StatusInternalServerError, "failed to acknowledge monitor")
+			return
+		}
+	}
+
 	channelStates := s.loadNotificationChannelStates(r.Context())
-	writeJSON(w, http.StatusOK, mapTriggerResponse(triggerResult, channelStates))
+	mapped := s.mapMonitor(
+		row,
+		runtime,
+		buildMonitorNotificationIssues(row.NotificationChannels, channelStates),
+	)
+	s.publishMonitorUpdated(row.ID, monitorUpdated, &mapped)
+
+	writeJSON(w, http.StatusOK, mapped)
 }
 
 func (s *Server) handleTestMonitorURL(w http.ResponseWriter, r *http.Request) {
@@ -600,7 +1393,8 @@ func (s *Server) handleTestMonitorURL(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	targetURL := strings.TrimSpace(req.URL)
+	now := time.Now().UTC()
+	targetURL := worker.ExpandTemplate(strings.TrimSpace(req.URL), now)
 	if targetURL == "" {
 		writeError(w, http.StatusBadRequest, "url is required")
 		return
@@ -617,7 +1411,7 @@ func (s *Server) handleTestMonitorURL(w http.ResponseWriter, r *http.Request) {
 
 	var body io.Reader
 	if req.Body != nil && method != http.MethodGet && method != http.MethodHead {
-		body = strings.NewReader(*req.Body)
+		body = strings.NewReader(worker.ExpandTemplate(*req.Body, now))
 	}
 
 	ctx, cancel := context.WithTimeout(r.Context(), testRequestTimeout)
@@ -629,20 +1423,84 @@ func (s *Server) handleTestMonitorURL(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	for key, value := range req.Headers {
-		outboundReq.Header.Set(key, value)
+	userAgent, err := normalizeUserAgent(req.UserAgent)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	if err := worker.ValidateEnvReferences(req.Headers); err != nil {
+		writeError(w, http.StatusBadRequest, "headers: "+err.Error())
+		return
+	}
+	if err := worker.ValidateEnvReferences(req.Auth); err != nil {
+		writeError(w, http.StatusBadRequest, "auth: "+err.Error())
+		return
+	}
+	var profileHeaders map[string]string
+	if req.HeaderProfileID != nil {
+		profile, err := s.db.HeaderProfile.Get(r.Context(), *req.HeaderProfileID)
+		if err != nil {
+			if ent.IsNotFound(err) {
+				writeError(w, http.StatusBadRequest, "headerProfileId does not exist")
+				return
+			}
+			writeError(w, http.StatusInternalServerError, "failed to load header profile")
+			return
+		}
+		profileHeaders = profile.Headers
+	}
+	var saved *ent.Monitor
+	if req.MonitorID != nil {
+		saved, err = s.db.Monitor.Get(r.Context(), *req.MonitorID)
+		if err != nil {
+			if ent.IsNotFound(err) {
+				writeError(w, http.StatusBadRequest, "monitorId does not exist")
+				return
+			}
+			writeError(w, http.StatusInternalServerError, "failed to load monitor")
+			return
+		}
+	}
+	auth, err := unmaskSavedMonitorAuth(req.Auth, saved, req.URL, now)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	config, err := s.ensureGlobalSystemConfig(r.Context())
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "failed to load runtime settings")
+		return
+	}
+	defaults := worker.CheckDefaultsFromSystem(config)
+
+	for key, value := range worker.MergeRequestHeaders(defaults.Headers, profileHeaders, req.Headers, userAgent) {
+		outboundReq.Header.Set(key, worker.ExpandEnvReferences(worker.ExpandTemplate(value, now)))
 	}
-	applyTestAuth(outboundReq, req.Auth)
+	applyTestAuth(outboundReq, worker.ExpandAuthEnvReferences(auth))
 
-	response, err := http.DefaultClient.Do(outboundReq)
+	response, err := s.testClientFor(defaults.Proxy).Do(outboundReq)
+	if errors.Is(err, worker.ErrBlockedByNetworkPolicy) {
+		writeError(w, http.StatusForbidden, "target address is blocked by network policy")
+		return
+	}
 	if err != nil {
 		writeError(w, http.StatusBadGateway, "failed to fetch target URL")
 		return
 	}
 	defer response.Body.Close()
 
-	testResponseBodyLimit := int64(s.maxSelectorPayloadBytes + 1)
-	payload, readErr := io.ReadAll(io.LimitReader(response.Body, testResponseBodyLimit))
+	responseBody, err := worker.DecodeContentEncoding(response.Header.Get("Content-Encoding"), response.Body)
+	if err != nil {
+		writeError(w, http.StatusBadGateway, fmt.Sprintf("failed decoding target response: %v", err))
+		return
+	}
+
+	bodyLimit := s.maxSelectorPayloadBytes
+	if defaults.MaxResponseBodyBytes > 0 {
+		bodyLimit = defaults.MaxResponseBodyBytes
+	}
+	payload, readErr := io.ReadAll(io.LimitReader(responseBody, int64(bodyLimit+1)))
 	if readErr != nil {
 		writeError(w, http.StatusBadGateway, "failed reading target response")
 		return
@@ -656,7 +1514,7 @@ func (s *Server) handleTestMonitorURL(w http.ResponseWriter, r *http.Request) {
 	contentType := response.Header.Get("Content-Type")
 	var selectorPayloadToken *string
 	if isJSONContentType(contentType) {
-		if token := s.storeSelectorPayload(payload); token != "" {
+		if token := s.storeSelectorPayload(r.Context(), payload, bodyLimit); token != "" {
 			selectorPayloadToken = &token
 		}
 	}
@@ -686,7 +1544,7 @@ func (s *Server) handlePreviewMonitorSelector(w http.ResponseWriter, r *http.Req
 	var payload []byte
 	token := normalizeOptionalString(req.Token)
 	if token != nil {
-		cachedPayload, ok := s.loadSelectorPayload(*token)
+		cachedPayload, ok := s.loadSelectorPayload(r.Context(), *token)
 		if !ok {
 			writeError(w, http.StatusBadRequest, "selector payload unavailable, run test again or increase GOANNA_MAX_RESPONSE_BODY_BYTES")
 			return
@@ -752,16 +1610,38 @@ func (s *Server) handleListMonitorChecks(w http.ResponseWriter, r *http.Request)
 		limit = parsedLimit
 	}
 
-	rows, err := s.db.CheckResult.Query().
-		Where(checkresult.HasMonitorWith(monitor.IDEQ(monitorID))).
+	filters, err := parseMonitorChecksFilters(r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	query := s.db.CheckResult.Query().
+		Where(checkresult.MonitorIDEQ(monitorID)).
+		Where(filters...)
+	if cursorValue := strings.TrimSpace(r.URL.Query().Get("cursor")); cursorValue != "" {
+		cursor, err := decodeChecksCursor(cursorValue)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, "cursor is invalid")
+			return
+		}
+		query = query.Where(cursor.predicate())
+	}
+
+	rows, err := query.
 		Order(ent.Desc(checkresult.FieldCheckedAt), ent.Desc(checkresult.FieldID)).
-		Limit(limit).
+		Limit(limit + 1).
 		All(r.Context())
 	if err != nil {
 		writeError(w, http.StatusInternalServerError, "failed to list monitor checks")
 		return
 	}
 
+	if len(rows) > limit {
+		rows = rows[:limit]
+		w.Header().Set(nextCursorHeader, encodeChecksCursor(rows[len(rows)-1]))
+	}
+
 	response := make([]monitorCheckResponse, 0, len(rows))
 	for _, row := range rows {
 		response = append(response, mapMonitorCheck(row))
@@ -790,7 +1670,7 @@ func (s *Server) handleGetTelegramSettings(w http.ResponseWriter, r *http.Reques
 	updatedAt := channel.UpdatedAt
 	writeJSON(w, http.StatusOK, telegramSettingsResponse{
 		Enabled:   channel.Enabled,
-		BotToken:  channel.BotToken,
+		BotToken:  maskSecret(channel.BotToken),
 		ChatID:    channel.ChatID,
 		UpdatedAt: &updatedAt,
 	})
@@ -824,6 +1704,18 @@ func (s *Server) handleUpsertTelegramSettings(w http.ResponseWriter, r *http.Req
 		writeError(w, http.StatusInternalServerError, "failed to load telegram settings")
 		return
 	}
+	if !clearChannel {
+		var storedToken string
+		if existing != nil {
+			storedToken = existing.BotToken
+		}
+		unmasked, ok := unmaskSecret(botToken, storedToken)
+		if !ok {
+			writeError(w, http.StatusBadRequest, "botToken is masked but does not match the saved token")
+			return
+		}
+		botToken = unmasked
+	}
 
 	if clearChannel {
 		if !ent.IsNotFound(err) {
@@ -865,7 +1757,7 @@ func (s *Server) handleUpsertTelegramSettings(w http.ResponseWriter, r *http.Req
 	updatedAt := channel.UpdatedAt
 	writeJSON(w, http.StatusOK, telegramSettingsResponse{
 		Enabled:   channel.Enabled,
-		BotToken:  channel.BotToken,
+		BotToken:  maskSecret(channel.BotToken),
 		ChatID:    channel.ChatID,
 		UpdatedAt: &updatedAt,
 	})
@@ -900,6 +1792,25 @@ func (s *Server) handleTestTelegramSettings(w http.ResponseWriter, r *http.Reque
 		writeError(w, http.StatusBadRequest, "botToken and chatId are required")
 		return
 	}
+	if isMaskedSecret(botToken) {
+		var storedToken string
+		channel, err := s.db.NotificationChannel.Query().
+			Where(notificationchannel.KindEQ("telegram")).
+			Only(r.Context())
+		if err != nil && !ent.IsNotFound(err) {
+			writeError(w, http.StatusInternalServerError, "failed to load telegram settings")
+			return
+		}
+		if channel != nil {
+			storedToken = channel.BotToken
+		}
+		unmasked, ok := unmaskSecret(botToken, storedToken)
+		if !ok {
+			writeError(w, http.StatusBadRequest, "botToken is masked but does not match the saved token")
+			return
+		}
+		botToken = unmasked
+	}
 
 	message := telegramTestMessage
 	if req.Message != nil {
@@ -938,14 +1849,7 @@ func (s *Server) handleGetRuntimeSettings(w http.ResponseWriter, r *http.Request
 		return
 	}
 
-	timezone, timezoneValid := normalizeStoredRuntimeTimezone(config.Timezone)
-	updatedAt := config.UpdatedAt
-	writeJSON(w, http.StatusOK, runtimeSettingsResponse{
-		ChecksHistoryLimit: config.ChecksHistoryLimit,
-		Timezone:           timezone,
-		RequiredSettings:   requiredRuntimeSettings(timezoneValid),
-		UpdatedAt:          &updatedAt,
-	})
+	writeJSON(w, http.StatusOK, newRuntimeSettingsResponse(config))
 }
 
 func (s *Server) handleUpsertRuntimeSettings(w http.ResponseWriter, r *http.Request) {
@@ -955,77 +1859,281 @@ func (s *Server) handleUpsertRuntimeSettings(w http.ResponseWriter, r *http.Requ
 		return
 	}
 
-	if req.ChecksHistoryLimit < 10 {
-		writeError(w, http.StatusBadRequest, "checksHistoryLimit must be at least 10")
-		return
+	update, err := normalizeRuntimeSettingsRequest(req)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	config, err := s.ensureGlobalSystemConfig(r.Context())
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "failed to load runtime settings")
+		return
+	}
+
+	proxy, err := mergeProxySettings(config, req.ProxyURL, req.ProxyBypass)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	tx, err := s.db.Tx(r.Context())
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "failed to save runtime settings")
+		return
+	}
+	updated, err := saveRuntimeSettings(r.Context(), tx.Client(), config, update, proxy)
+	if errors.Is(err, errTimezoneRealign) {
+		_ = tx.Rollback()
+		writeError(w, http.StatusInternalServerError, errTimezoneRealign.Error())
+		return
+	}
+	if err != nil {
+		_ = tx.Rollback()
+		writeError(w, http.StatusInternalServerError, "failed to save runtime settings")
+		return
+	}
+	if err := tx.Commit(); err != nil {
+		writeError(w, http.StatusInternalServerError, "failed to save runtime settings")
+		return
+	}
+	s.scheduleChanges.Publish()
+
+	writeJSON(w, http.StatusOK, newRuntimeSettingsResponse(updated))
+}
+
+// runtimeSettingsUpdate is a validated runtimeSettingsRequest with its
+// normalized values.
+type runtimeSettingsUpdate struct {
+	runtimeSettingsRequest
+	timezone             string
+	circuitBreakerAction *systemconfig.CircuitBreakerAction
+	catchUpPolicy        *systemconfig.CatchUpPolicy
+	defaultUserAgent     *string
+	defaultHeaders       map[string]string
+}
+
+func normalizeRuntimeSettingsRequest(req runtimeSettingsRequest) (runtimeSettingsUpdate, error) {
+	if req.ChecksHistoryLimit < minChecksHistoryLimit {
+		return runtimeSettingsUpdate{}, fmt.Errorf("checksHistoryLimit must be at least %d", minChecksHistoryLimit)
 	}
 	timezone, err := normalizeRuntimeTimezone(req.Timezone)
 	if err != nil {
-		writeError(w, http.StatusBadRequest, err.Error())
-		return
+		return runtimeSettingsUpdate{}, err
 	}
-
-	config, err := s.ensureGlobalSystemConfig(r.Context())
+	if req.StaleAfterDays != nil && *req.StaleAfterDays < 1 {
+		return runtimeSettingsUpdate{}, errors.New("staleAfterDays must be at least 1")
+	}
+	if req.ScheduleJitterSeconds != nil && (*req.ScheduleJitterSeconds < 0 || *req.ScheduleJitterSeconds > maxJitterSeconds) {
+		return runtimeSettingsUpdate{}, fmt.Errorf("scheduleJitterSeconds must be between 0 and %d", maxJitterSeconds)
+	}
+	if req.CircuitBreakerThreshold != nil && (*req.CircuitBreakerThreshold < 0 || *req.CircuitBreakerThreshold > maxCircuitBreakerThreshold) {
+		return runtimeSettingsUpdate{}, fmt.Errorf("circuitBreakerThreshold must be between 0 and %d", maxCircuitBreakerThreshold)
+	}
+	var circuitBreakerAction *systemconfig.CircuitBreakerAction
+	if req.CircuitBreakerAction != nil {
+		action := systemconfig.CircuitBreakerAction(strings.TrimSpace(*req.CircuitBreakerAction))
+		if err := systemconfig.CircuitBreakerActionValidator(action); err != nil {
+			return runtimeSettingsUpdate{}, errors.New("circuitBreakerAction must be backoff or suspend")
+		}
+		circuitBreakerAction = &action
+	}
+	if req.CircuitBreakerBackoffMinutes != nil && (*req.CircuitBreakerBackoffMinutes < 1 || *req.CircuitBreakerBackoffMinutes > maxCircuitBreakerBackoff) {
+		return runtimeSettingsUpdate{}, fmt.Errorf("circuitBreakerBackoffMinutes must be between 1 and %d", maxCircuitBreakerBackoff)
+	}
+	var catchUpPolicy *systemconfig.CatchUpPolicy
+	if req.CatchUpPolicy != nil {
+		policy := systemconfig.CatchUpPolicy(strings.TrimSpace(*req.CatchUpPolicy))
+		if err := systemconfig.CatchUpPolicyValidator(policy); err != nil {
+			return runtimeSettingsUpdate{}, errors.New("catchUpPolicy must be run_all_missed, run_latest_only or skip")
+		}
+		catchUpPolicy = &policy
+	}
+	if req.CatchUpMaxAgeMinutes != nil && (*req.CatchUpMaxAgeMinutes < 0 || *req.CatchUpMaxAgeMinutes > maxCatchUpAgeMinutes) {
+		return runtimeSettingsUpdate{}, fmt.Errorf("catchUpMaxAgeMinutes must be between 0 and %d", maxCatchUpAgeMinutes)
+	}
+	if req.RequestTimeoutSeconds != nil && (*req.RequestTimeoutSeconds < 1 || *req.RequestTimeoutSeconds > maxRequestTimeoutSeconds) {
+		return runtimeSettingsUpdate{}, fmt.Errorf("requestTimeoutSeconds must be between 1 and %d", maxRequestTimeoutSeconds)
+	}
+	if req.MaxRetries != nil && (*req.MaxRetries < 0 || *req.MaxRetries > maxCheckRetries) {
+		return runtimeSettingsUpdate{}, fmt.Errorf("maxRetries must be between 0 and %d", maxCheckRetries)
+	}
+	if req.RetryBackoffSeconds != nil && (*req.RetryBackoffSeconds < 0 || *req.RetryBackoffSeconds > maxRetryBackoffSeconds) {
+		return runtimeSettingsUpdate{}, fmt.Errorf("retryBackoffSeconds must be between 0 and %d", maxRetryBackoffSeconds)
+	}
+	if req.TickIntervalSeconds != nil && (*req.TickIntervalSeconds < minTickIntervalSeconds || *req.TickIntervalSeconds > maxTickIntervalSeconds) {
+		return runtimeSettingsUpdate{}, fmt.Errorf("tickIntervalSeconds must be between %d and %d", minTickIntervalSeconds, maxTickIntervalSeconds)
+	}
+	if req.ScheduleLookaheadSeconds != nil && (*req.ScheduleLookaheadSeconds < 0 || *req.ScheduleLookaheadSeconds > maxScheduleLookaheadSeconds) {
+		return runtimeSettingsUpdate{}, fmt.Errorf("scheduleLookaheadSeconds must be between 0 and %d", maxScheduleLookaheadSeconds)
+	}
+	if req.MaxResponseBodyBytes != nil && (*req.MaxResponseBodyBytes < 0 || *req.MaxResponseBodyBytes > maxMonitorResponseBytes) {
+		return runtimeSettingsUpdate{}, fmt.Errorf("maxResponseBodyBytes must be between 0 and %d", maxMonitorResponseBytes)
+	}
+	defaultUserAgent, err := normalizeUserAgent(req.DefaultUserAgent)
 	if err != nil {
-		writeError(w, http.StatusInternalServerError, "failed to load runtime settings")
-		return
+		return runtimeSettingsUpdate{}, fmt.Errorf("defaultUserAgent: %w", err)
+	}
+	var defaultHeaders map[string]string
+	if req.DefaultHeaders != nil {
+		defaultHeaders, err = normalizeHeaderMap(req.DefaultHeaders)
+		if err != nil {
+			return runtimeSettingsUpdate{}, fmt.Errorf("defaultHeaders: %w", err)
+		}
 	}
 
+	return runtimeSettingsUpdate{
+		runtimeSettingsRequest: req,
+		timezone:               timezone,
+		circuitBreakerAction:   circuitBreakerAction,
+		catchUpPolicy:          catchUpPolicy,
+		defaultUserAgent:       defaultUserAgent,
+		defaultHeaders:         defaultHeaders,
+	}, nil
+}
+
+// errTimezoneRealign reports that saved runtime settings changed the timezone
+// but the enabled monitors could not be rescheduled for it.
+var errTimezoneRealign = errors.New("failed to update monitor schedules for timezone change")
+
+// saveRuntimeSettings applies update and proxy to the global settings and
+// realigns monitor schedules when the timezone changed. Run it in a
+// transaction.
+func saveRuntimeSettings(ctx context.Context, client *ent.Client, config *ent.SystemConfig, update runtimeSettingsUpdate, proxy worker.ProxySettings) (*ent.SystemConfig, error) {
 	previousTimezone, previousTimezoneValid := normalizeStoredRuntimeTimezone(config.Timezone)
 	timezoneChanged := !previousTimezoneValid
 	if previousTimezoneValid {
-		timezoneChanged = *previousTimezone != timezone
+		timezoneChanged = *previousTimezone != update.timezone
 	}
 
-	tx, err := s.db.Tx(r.Context())
-	if err != nil {
-		writeError(w, http.StatusInternalServerError, "failed to save runtime settings")
-		return
+	updateConfig := client.SystemConfig.UpdateOneID(config.ID).
+		SetChecksHistoryLimit(update.ChecksHistoryLimit).
+		SetTimezone(update.timezone)
+	if update.StaleAfterDays != nil {
+		updateConfig = updateConfig.SetStaleAfterDays(*update.StaleAfterDays)
+	}
+	if update.StaleNotifications != nil {
+		updateConfig = updateConfig.SetStaleNotifications(*update.StaleNotifications)
+	}
+	if update.ScheduleJitterSeconds != nil {
+		updateConfig = updateConfig.SetScheduleJitterSeconds(*update.ScheduleJitterSeconds)
+	}
+	if update.CircuitBreakerThreshold != nil {
+		updateConfig = updateConfig.SetCircuitBreakerThreshold(*update.CircuitBreakerThreshold)
+	}
+	if update.circuitBreakerAction != nil {
+		updateConfig = updateConfig.SetCircuitBreakerAction(*update.circuitBreakerAction)
+	}
+	if update.CircuitBreakerBackoffMinutes != nil {
+		updateConfig = updateConfig.SetCircuitBreakerBackoffMinutes(*update.CircuitBreakerBackoffMinutes)
+	}
+	if update.catchUpPolicy != nil {
+		updateConfig = updateConfig.SetCatchUpPolicy(*update.catchUpPolicy)
+	}
+	if update.CatchUpMaxAgeMinutes != nil {
+		updateConfig = updateConfig.SetCatchUpMaxAgeMinutes(*update.CatchUpMaxAgeMinutes)
+	}
+	if update.DefaultUserAgent != nil {
+		if update.defaultUserAgent == nil {
+			updateConfig = updateConfig.ClearDefaultUserAgent()
+		} else {
+			updateConfig = updateConfig.SetDefaultUserAgent(*update.defaultUserAgent)
+		}
+	}
+	if update.RequestTimeoutSeconds != nil {
+		updateConfig = updateConfig.SetRequestTimeoutSeconds(*update.RequestTimeoutSeconds)
+	}
+	if update.MaxRetries != nil {
+		updateConfig = updateConfig.SetMaxRetries(*update.MaxRetries)
+	}
+	if update.RetryBackoffSeconds != nil {
+		updateConfig = updateConfig.SetRetryBackoffSeconds(*update.RetryBackoffSeconds)
+	}
+	if update.TickIntervalSeconds != nil {
+		updateConfig = updateConfig.SetTickIntervalSeconds(*update.TickIntervalSeconds)
+	}
+	if update.ScheduleLookaheadSeconds != nil {
+		updateConfig = updateConfig.SetScheduleLookaheadSeconds(*update.ScheduleLookaheadSeconds)
+	}
+	if update.MaxResponseBodyBytes != nil {
+		if *update.MaxResponseBodyBytes == 0 {
+			updateConfig = updateConfig.ClearMaxResponseBodyBytes()
+		} else {
+			updateConfig = updateConfig.SetMaxResponseBodyBytes(*update.MaxResponseBodyBytes)
+		}
+	}
+	if proxy.URL == "" {
+		updateConfig = updateConfig.ClearProxyURL()
+	} else {
+		updateConfig = updateConfig.SetProxyURL(proxy.URL)
+	}
+	if proxy.Bypass == "" {
+		updateConfig = updateConfig.ClearProxyBypass()
+	} else {
+		updateConfig = updateConfig.SetProxyBypass(proxy.Bypass)
+	}
+	if update.DefaultHeaders != nil {
+		if len(update.defaultHeaders) == 0 {
+			updateConfig = updateConfig.ClearDefaultHeaders()
+		} else {
+			updateConfig = updateConfig.SetDefaultHeaders(update.defaultHeaders)
+		}
 	}
 
-	updated, err := tx.SystemConfig.UpdateOneID(config.ID).
-		SetChecksHistoryLimit(req.ChecksHistoryLimit).
-		SetTimezone(timezone).
-		Save(r.Context())
+	updated, err := updateConfig.Save(ctx)
 	if err != nil {
-		_ = tx.Rollback()
-		writeError(w, http.StatusInternalServerError, "failed to save runtime settings")
-		return
+		return nil, err
 	}
 
 	if timezoneChanged {
-		err = realignEnabledMonitorRuntimes(
-			r.Context(),
-			tx.Client(),
-			time.Now().UTC(),
-			runtimeCronLocation(updated.Timezone),
-		)
+		err = realignEnabledMonitorRuntimes(ctx, client, time.Now().UTC(), runtimeCronLocation(updated.Timezone))
 		if err != nil {
-			_ = tx.Rollback()
-			writeError(w, http.StatusInternalServerError, "failed to update monitor schedules for timezone change")
-			return
+			return nil, fmt.Errorf("%w: %w", errTimezoneRealign, err)
 		}
 	}
+	return updated, nil
+}
 
-	if err := tx.Commit(); err != nil {
-		writeError(w, http.StatusInternalServerError, "failed to save runtime settings")
-		return
+func newRuntimeSettingsResponse(config *ent.SystemConfig) runtimeSettingsResponse {
+	timezone, timezoneValid := normalizeStoredRuntimeTimezone(config.Timezone)
+	proxyURL, proxyBypass := proxySettingsResponse(config)
+	updatedAt := config.UpdatedAt
+	return runtimeSettingsResponse{
+		ChecksHistoryLimit:           config.ChecksHistoryLimit,
+		Timezone:                     timezone,
+		StaleAfterDays:               config.StaleAfterDays,
+		StaleNotifications:           config.StaleNotifications,
+		ScheduleJitterSeconds:        config.ScheduleJitterSeconds,
+		CircuitBreakerThreshold:      config.CircuitBreakerThreshold,
+		CircuitBreakerAction:         config.CircuitBreakerAction.String(),
+		CircuitBreakerBackoffMinutes: config.CircuitBreakerBackoffMinutes,
+		CatchUpPolicy:                config.CatchUpPolicy.String(),
+		CatchUpMaxAgeMinutes:         config.CatchUpMaxAgeMinutes,
+		DefaultUserAgent:             config.DefaultUserAgent,
+		DefaultHeaders:               kvMap(config.DefaultHeaders),
+		ProxyURL:                     proxyURL,
+		ProxyBypass:                  proxyBypass,
+		RequestTimeoutSeconds:        config.RequestTimeoutSeconds,
+		MaxRetries:                   config.MaxRetries,
+		RetryBackoffSeconds:          config.RetryBackoffSeconds,
+		TickIntervalSeconds:          config.TickIntervalSeconds,
+		ScheduleLookaheadSeconds:     config.ScheduleLookaheadSeconds,
+		MaxResponseBodyBytes:         config.MaxResponseBodyBytes,
+		RequiredSettings:             requiredRuntimeSettings(timezoneValid),
+		UpdatedAt:                    &updatedAt,
 	}
-
-	normalizedTimezone, timezoneValid := normalizeStoredRuntimeTimezone(updated.Timezone)
-	updatedAt := updated.UpdatedAt
-	writeJSON(w, http.StatusOK, runtimeSettingsResponse{
-		ChecksHistoryLimit: updated.ChecksHistoryLimit,
-		Timezone:           normalizedTimezone,
-		RequiredSettings:   requiredRuntimeSettings(timezoneValid),
-		UpdatedAt:          &updatedAt,
-	})
 }
 
 func normalizeMonitorRequest(req createMonitorRequest) (normalizedMonitorRequest, error) {
 	url := strings.TrimSpace(req.URL)
 	cronExpr := strings.TrimSpace(req.Cron)
-	if url == "" || cronExpr == "" {
+	heartbeat := strings.TrimSpace(req.FetchMode) == monitor.FetchModeHeartbeat.String()
+	if heartbeat {
+		// Heartbeat monitors are pinged rather than fetched; their URL is the
+		// ping path assigned when the monitor is saved.
+		url = ""
+	}
+	if (url == "" && !heartbeat) || cronExpr == "" {
 		return normalizedMonitorRequest{}, errors.New("url and cron are required")
 	}
 	if _, err := cron.ParseStandard(cronExpr); err != nil {
@@ -1037,12 +2145,36 @@ func normalizeMonitorRequest(req createMonitorRequest) (normalizedMonitorRequest
 		method = "GET"
 	}
 
+	dstPolicy := strings.TrimSpace(req.DSTPolicy)
+	if dstPolicy == "" {
+		dstPolicy = worker.DSTPolicyNextValid
+	}
+	if err := monitor.DstPolicyValidator(monitor.DstPolicy(dstPolicy)); err != nil {
+		return normalizedMonitorRequest{}, errors.New("dstPolicy must be one of: skip, next_valid, run_twice")
+	}
+
+	bodySnapshot := strings.TrimSpace(req.BodySnapshot)
+	if bodySnapshot == "" {
+		bodySnapshot = monitor.DefaultBodySnapshot.String()
+	}
+	if err := monitor.BodySnapshotValidator(monitor.BodySnapshot(bodySnapshot)); err != nil {
+		return normalizedMonitorRequest{}, errors.New("bodySnapshot must be one of: off, raw, gzip")
+	}
+
+	contentHash := strings.TrimSpace(req.ContentHash)
+	if contentHash == "" {
+		contentHash = monitor.DefaultContentHash.String()
+	}
+	if err := monitor.ContentHashValidator(monitor.ContentHash(contentHash)); err != nil {
+		return normalizedMonitorRequest{}, errors.New("contentHash must be one of: off, raw, normalized")
+	}
+
 	expectedType := strings.TrimSpace(req.ExpectedType)
 	if expectedType == "" {
 		expectedType = "json"
 	}
-	if expectedType != "json" && expectedType != "html" && expectedType != "text" {
-		return normalizedMonitorRequest{}, errors.New("expectedType must be one of: json, html, text")
+	if err := monitor.ExpectedTypeValidator(monitor.ExpectedType(expectedType)); err != nil {
+		return normalizedMonitorRequest{}, errors.New("expectedType must be one of: json, html, text, feed, sitemap")
 	}
 
 	enabled := true
@@ -1058,12 +2190,151 @@ func normalizeMonitorRequest(req createMonitorRequest) (normalizedMonitorRequest
 	if auth == nil {
 		auth = map[string]string{}
 	}
+	if err := worker.ValidateEnvReferences(headers); err != nil {
+		return normalizedMonitorRequest{}, fmt.Errorf("headers: %v", err)
+	}
+	if err := worker.ValidateEnvReferences(auth); err != nil {
+		return normalizedMonitorRequest{}, fmt.Errorf("auth: %v", err)
+	}
 
 	notificationChannels, err := normalizeNotificationChannels(req.NotificationChannels)
 	if err != nil {
 		return normalizedMonitorRequest{}, err
 	}
 
+	escalationChannels, escalationAfterMinutes, err := normalizeEscalationPolicy(req.EscalationChannels, req.EscalationAfterMinutes)
+	if err != nil {
+		return normalizedMonitorRequest{}, err
+	}
+
+	numericTolerance, err := normalizeNumericTolerance(req.NumericTolerance)
+	if err != nil {
+		return normalizedMonitorRequest{}, err
+	}
+
+	tags, err := normalizeMonitorTags(req.Tags)
+	if err != nil {
+		return normalizedMonitorRequest{}, err
+	}
+
+	mustContain, err := normalizeKeywords("mustContain", req.MustContain)
+	if err != nil {
+		return normalizedMonitorRequest{}, err
+	}
+	mustNotContain, err := normalizeKeywords("mustNotContain", req.MustNotContain)
+	if err != nil {
+		return normalizedMonitorRequest{}, err
+	}
+	if expectedType == "json" && (len(mustContain) > 0 || len(mustNotContain) > 0) {
+		return normalizedMonitorRequest{}, errors.New("mustContain and mustNotContain are not supported for json expectedType")
+	}
+
+	expectedStatus := normalizeOptionalString(req.ExpectedStatus)
+	if expectedStatus != nil {
+		if err := worker.ValidateExpectedStatus(*expectedStatus); err != nil {
+			return normalizedMonitorRequest{}, fmt.Errorf("expectedStatus: %v", err)
+		}
+	}
+
+	retryOn := normalizeOptionalString(req.RetryOn)
+	if retryOn != nil {
+		if err := worker.ValidateRetryOn(*retryOn); err != nil {
+			return normalizedMonitorRequest{}, fmt.Errorf("retryOn: %v", err)
+		}
+	}
+
+	userAgent, err := normalizeUserAgent(req.UserAgent)
+	if err != nil {
+		return normalizedMonitorRequest{}, err
+	}
+	if req.HeaderProfileID != nil && *req.HeaderProfileID <= 0 {
+		return normalizedMonitorRequest{}, errors.New("headerProfileId must be a positive integer")
+	}
+
+	hostOverrides, err := worker.NormalizeHostOverrides(req.HostOverrides)
+	if err != nil {
+		return normalizedMonitorRequest{}, fmt.Errorf("hostOverrides: %v", err)
+	}
+
+	ipFamily := strings.TrimSpace(req.IPFamily)
+	if ipFamily == "" {
+		ipFamily = monitor.DefaultIPFamily.String()
+	}
+	if err := monitor.IPFamilyValidator(monitor.IPFamily(ipFamily)); err != nil {
+		return normalizedMonitorRequest{}, errors.New("ipFamily must be one of: any, ipv4, ipv6")
+	}
+
+	redirectPolicy := strings.TrimSpace(req.RedirectPolicy)
+	if redirectPolicy == "" {
+		redirectPolicy = monitor.DefaultRedirectPolicy.String()
+	}
+	if err := monitor.RedirectPolicyValidator(monitor.RedirectPolicy(redirectPolicy)); err != nil {
+		return normalizedMonitorRequest{}, errors.New("redirectPolicy must be one of: follow, none, record")
+	}
+	if req.MaxRedirects != nil && (*req.MaxRedirects < 1 || *req.MaxRedirects > maxRedirectHops) {
+		return normalizedMonitorRequest{}, fmt.Errorf("maxRedirects must be between 1 and %d", maxRedirectHops)
+	}
+	if req.MaxResponseBytes != nil && (*req.MaxResponseBytes < 1 || *req.MaxResponseBytes > maxMonitorResponseBytes) {
+		return normalizedMonitorRequest{}, fmt.Errorf("maxResponseBytes must be between 1 and %d", maxMonitorResponseBytes)
+	}
+	if req.ChecksHistoryLimit != nil && *req.ChecksHistoryLimit < minChecksHistoryLimit {
+		return normalizedMonitorRequest{}, fmt.Errorf("checksHistoryLimit must be at least %d", minChecksHistoryLimit)
+	}
+
+	tlsCAPEM := normalizeOptionalString(req.TLSCAPEM)
+	if tlsCAPEM != nil {
+		if err := worker.ValidateCAPEM(*tlsCAPEM); err != nil {
+			return normalizedMonitorRequest{}, fmt.Errorf("tlsCaPem: %v", err)
+		}
+	}
+	tlsMinVersion := normalizeOptionalString(req.TLSMinVersion)
+	if tlsMinVersion != nil {
+		if err := worker.ValidateTLSMinVersion(*tlsMinVersion); err != nil {
+			return normalizedMonitorRequest{}, fmt.Errorf("tlsMinVersion: %v", err)
+		}
+	}
+
+	var timezone *string
+	if rawTimezone := normalizeOptionalString(req.Timezone); rawTimezone != nil {
+		normalizedTimezone, err := normalizeRuntimeTimezone(*rawTimezone)
+		if err != nil {
+			return normalizedMonitorRequest{}, err
+		}
+		timezone = &normalizedTimezone
+	}
+
+	if req.JitterSeconds != nil && (*req.JitterSeconds < 0 || *req.JitterSeconds > maxJitterSeconds) {
+		return normalizedMonitorRequest{}, fmt.Errorf("jitterSeconds must be between 0 and %d", maxJitterSeconds)
+	}
+
+	if req.WatchdogMinutes != nil && *req.WatchdogMinutes <= 0 {
+		return normalizedMonitorRequest{}, errors.New("watchdogMinutes must be a positive integer")
+	}
+
+	headerAssertions, err := normalizeHeaderAssertions(req.HeaderAssertions)
+	if err != nil {
+		return normalizedMonitorRequest{}, err
+	}
+
+	var trackHeader *string
+	if normalizedTrackHeader := normalizeOptionalString(req.TrackHeader); normalizedTrackHeader != nil {
+		canonical := http.CanonicalHeaderKey(*normalizedTrackHeader)
+		trackHeader = &canonical
+	}
+
+	fetchMode := strings.TrimSpace(req.FetchMode)
+	if fetchMode == "" {
+		fetchMode = monitor.DefaultFetchMode.String()
+	}
+	if err := monitor.FetchModeValidator(monitor.FetchMode(fetchMode)); err != nil {
+		return normalizedMonitorRequest{}, errors.New("fetchMode must be one of: http, rendered, heartbeat")
+	}
+	if fetchMode == monitor.FetchModeRendered.String() {
+		if err := validateRenderedMonitor(method, req, expectedType, headerAssertions, trackHeader, expectedStatus); err != nil {
+			return normalizedMonitorRequest{}, err
+		}
+	}
+
 	label := normalizeOptionalString(req.Label)
 	iconURL := monitorDefaultIconURL(url)
 	if normalizedIconURL := normalizeOptionalString(req.IconURL); normalizedIconURL != nil {
@@ -1071,22 +2342,70 @@ func normalizeMonitorRequest(req createMonitorRequest) (normalizedMonitorRequest
 	}
 
 	return normalizedMonitorRequest{
-		label:                label,
-		method:               method,
-		url:                  url,
-		iconURL:              iconURL,
-		body:                 req.Body,
-		headers:              headers,
-		auth:                 auth,
-		notificationChannels: notificationChannels,
-		selector:             req.Selector,
-		expectedType:         expectedType,
-		expectedResponse:     req.ExpectedResponse,
-		cronExpr:             cronExpr,
-		enabled:              enabled,
+		label:                  label,
+		method:                 method,
+		url:                    url,
+		iconURL:                iconURL,
+		body:                   req.Body,
+		headers:                headers,
+		auth:                   auth,
+		notificationChannels:   notificationChannels,
+		escalationChannels:     escalationChannels,
+		escalationAfterMinutes: escalationAfterMinutes,
+		tags:                   tags,
+		selector:               req.Selector,
+		expectedStatus:         expectedStatus,
+		retryOn:                retryOn,
+		expectedType:           expectedType,
+		expectedResponse:       req.ExpectedResponse,
+		mustContain:            mustContain,
+		mustNotContain:         mustNotContain,
+		treatNotFoundAsSuccess: req.TreatNotFoundAsSuccess != nil && *req.TreatNotFoundAsSuccess,
+		acceptEmptyBody:        req.AcceptEmptyBody != nil && *req.AcceptEmptyBody,
+		watchdogMinutes:        req.WatchdogMinutes,
+		headerAssertions:       headerAssertions,
+		trackHeader:            trackHeader,
+		numericTolerance:       numericTolerance,
+		userAgent:              userAgent,
+		headerProfileID:        req.HeaderProfileID,
+		hostOverrides:          hostOverrides,
+		ipFamily:               ipFamily,
+		redirectPolicy:         redirectPolicy,
+		maxRedirects:           req.MaxRedirects,
+		maxResponseBytes:       req.MaxResponseBytes,
+		checksHistoryLimit:     req.ChecksHistoryLimit,
+		cookieJar:              req.CookieJar != nil && *req.CookieJar,
+		tlsCAPEM:               tlsCAPEM,
+		tlsInsecureSkipVerify:  req.TLSInsecureSkipVerify != nil && *req.TLSInsecureSkipVerify,
+		tlsMinVersion:          tlsMinVersion,
+		tlsServerName:          normalizeOptionalString(req.TLSServerName),
+		cronExpr:               cronExpr,
+		timezone:               timezone,
+		jitterSeconds:          req.JitterSeconds,
+		dstPolicy:              dstPolicy,
+		bodySnapshot:           bodySnapshot,
+		contentHash:            contentHash,
+		fetchMode:              fetchMode,
+		enabled:                enabled,
+		statusPage:             req.StatusPage != nil && *req.StatusPage,
 	}, nil
 }
 
+func normalizeNumericTolerance(raw *float64) (*float64, error) {
+	if raw == nil {
+		return nil, nil
+	}
+	if math.IsNaN(*raw) || math.IsInf(*raw, 0) || *raw < 0 {
+		return nil, errors.New("numericTolerance must be a non-negative number")
+	}
+	if *raw == 0 {
+		return nil, nil
+	}
+
+	tolerance := *raw
+	return &tolerance, nil
+}
+
 func parseMonitorID(raw string) (int, error) {
 	monitorIDValue := strings.TrimSpace(raw)
 	monitorID, err := strconv.Atoi(monitorIDValue)
@@ -1127,6 +2446,148 @@ func normalizeNotificationChannels(rawChannels []string) ([]string, error) {
 	return normalized, nil
 }
 
+func normalizeMonitorTags(rawTags []string) ([]string, error) {
+	if len(rawTags) == 0 {
+		return []string{}, nil
+	}
+
+	normalized := make([]string, 0, len(rawTags))
+	seen := make(map[string]struct{}, len(rawTags))
+	for _, rawTag := range rawTags {
+		tag := strings.ToLower(strings.TrimSpace(rawTag))
+		if tag == "" {
+			continue
+		}
+		if len(tag) > maxMonitorTagLength {
+			return nil, fmt.Errorf("tags must be at most %d characters", maxMonitorTagLength)
+		}
+
+		if _, ok := seen[tag]; ok {
+			continue
+		}
+		seen[tag] = struct{}{}
+		normalized = append(normalized, tag)
+	}
+
+	sort.Strings(normalized)
+	return normalized, nil
+}
+
+func normalizeKeywords(field string, rawKeywords []string) ([]string, error) {
+	if len(rawKeywords) == 0 {
+		return []string{}, nil
+	}
+	if len(rawKeywords) > maxMonitorKeywords {
+		return nil, fmt.Errorf("%s must have at most %d entries", field, maxMonitorKeywords)
+	}
+
+	normalized := make([]string, 0, len(rawKeywords))
+	for _, rawKeyword := range rawKeywords {
+		keyword := strings.TrimSpace(rawKeyword)
+		if keyword == "" {
+			continue
+		}
+		if err := worker.ValidateKeyword(keyword); err != nil {
+			return nil, fmt.Errorf("%s: %v", field, err)
+		}
+		normalized = append(normalized, keyword)
+	}
+
+	return normalized, nil
+}
+
+func normalizeHeaderAssertions(rawAssertions map[string]string) (map[string]string, error) {
+	normalized := make(map[string]string, len(rawAssertions))
+	for rawName, rawValue := range rawAssertions {
+		name := strings.TrimSpace(rawName)
+		if name == "" {
+			return nil, errors.New("headerAssertions contains an empty header name")
+		}
+
+		value := strings.TrimSpace(rawValue)
+		if value != "" {
+			if err := worker.ValidateKeyword(value); err != nil {
+				return nil, fmt.Errorf("headerAssertions: %v", err)
+			}
+		}
+		normalized[http.CanonicalHeaderKey(name)] = value
+	}
+
+	return normalized, nil
+}
+
+// validateRenderedMonitor rejects settings the headless browser cannot honour:
+// it only issues plain GET navigations and exposes no status code or headers.
+func validateRenderedMonitor(
+	method string,
+	req createMonitorRequest,
+	expectedType string,
+	headerAssertions map[string]string,
+	trackHeader *string,
+	expectedStatus *string,
+) error {
+	if method != http.MethodGet {
+		return errors.New("rendered fetchMode only supports GET")
+	}
+	if !worker.IsRenderableURL(req.URL) {
+		return errors.New("rendered fetchMode requires an http or https url")
+	}
+	if req.Body != nil && strings.TrimSpace(*req.Body) != "" {
+		return errors.New("rendered fetchMode does not support a request body")
+	}
+	if len(req.Headers) > 0 || len(req.Auth) > 0 || normalizeOptionalString(req.UserAgent) != nil || req.HeaderProfileID != nil {
+		return errors.New("rendered fetchMode does not support custom headers or auth")
+	}
+	if expectedType != "html" && expectedType != "text" {
+		return errors.New("rendered fetchMode requires html or text expectedType")
+	}
+	if len(headerAssertions) > 0 || trackHeader != nil || expectedStatus != nil {
+		return errors.New("rendered fetchMode does not support headerAssertions, trackHeader or expectedStatus")
+	}
+	if normalizeOptionalString(req.TLSCAPEM) != nil ||
+		(req.TLSInsecureSkipVerify != nil && *req.TLSInsecureSkipVerify) ||
+		normalizeOptionalString(req.TLSMinVersion) != nil ||
+		normalizeOptionalString(req.TLSServerName) != nil {
+		return errors.New("rendered fetchMode does not support TLS settings")
+	}
+	if len(req.HostOverrides) > 0 {
+		return errors.New("rendered fetchMode does not support hostOverrides")
+	}
+	if ipFamily := strings.TrimSpace(req.IPFamily); ipFamily != "" && ipFamily != monitor.DefaultIPFamily.String() {
+		return errors.New("rendered fetchMode does not support ipFamily")
+	}
+	if redirectPolicy := strings.TrimSpace(req.RedirectPolicy); (redirectPolicy != "" && redirectPolicy != monitor.DefaultRedirectPolicy.String()) || req.MaxRedirects != nil {
+		return errors.New("rendered fetchMode does not support redirectPolicy or maxRedirects")
+	}
+	if req.CookieJar != nil && *req.CookieJar {
+		return errors.New("rendered fetchMode does not support cookieJar")
+	}
+	return nil
+}
+
+func normalizeEscalationPolicy(rawChannels []string, rawAfterMinutes *int) ([]string, *int, error) {
+	channels, err := normalizeNotificationChannels(rawChannels)
+	if err != nil {
+		return nil, nil, errors.New(strings.Replace(err.Error(), "notificationChannels", "escalationChannels", 1))
+	}
+
+	if rawAfterMinutes == nil {
+		if len(channels) > 0 {
+			return nil, nil, errors.New("escalationAfterMinutes is required when escalationChannels are set")
+		}
+		return channels, nil, nil
+	}
+	if *rawAfterMinutes <= 0 {
+		return nil, nil, errors.New("escalationAfterMinutes must be a positive integer")
+	}
+	if len(channels) == 0 {
+		return nil, nil, errors.New("escalationChannels are required when escalationAfterMinutes is set")
+	}
+
+	afterMinutes := *rawAfterMinutes
+	return channels, &afterMinutes, nil
+}
+
 func normalizeNotificationChannelKind(rawKind string) string {
 	return strings.ToLower(strings.TrimSpace(rawKind))
 }
@@ -1361,7 +2822,7 @@ func runtimeCronLocation(rawTimezone *string) *time.Location {
 
 func realignEnabledMonitorRuntimes(ctx context.Context, db *ent.Client, now time.Time, location *time.Location) error {
 	rows, err := db.Monitor.Query().
-		Where(monitor.EnabledEQ(true)).
+		Where(monitor.EnabledEQ(true), monitor.ArchivedAtIsNil()).
 		WithRuntime().
 		All(ctx)
 	if err != nil {
@@ -1369,7 +2830,7 @@ func realignEnabledMonitorRuntimes(ctx context.Context, db *ent.Client, now time
 	}
 
 	for _, row := range rows {
-		nextRun, err := nextRunFromCron(row.Cron, now, location)
+		nextRun, err := nextRunFromCron(row.Cron, row.DstPolicy.String(), now, worker.MonitorLocation(row, location))
 		if err != nil {
 			return err
 		}
@@ -1400,7 +2861,7 @@ func realignEnabledMonitorRuntimes(ctx context.Context, db *ent.Client, now time
 	return nil
 }
 
-func mapMonitor(
+func (s *Server) mapMonitor(
 	row *ent.Monitor,
 	runtime *ent.MonitorRuntime,
 	notificationIssues []monitorNotificationIssueResponse,
@@ -1414,6 +2875,14 @@ func mapMonitor(
 	var lastStatusCode *int
 	var lastDurationMs *int
 	var lastErrorMessage *string
+	var failingSince *time.Time
+	var escalatedAt *time.Time
+	var acknowledgedAt *time.Time
+	var lastChangeAt *time.Time
+	var expectChangeUntil *time.Time
+	var watchdogAlertedAt *time.Time
+	var lastPingAt *time.Time
+	var circuitOpenedAt *time.Time
 
 	if !row.Enabled {
 		status = "disabled"
@@ -1429,6 +2898,20 @@ func mapMonitor(
 		lastStatusCode = runtime.LastStatusCode
 		lastDurationMs = runtime.LastDurationMs
 		lastErrorMessage = runtime.LastErrorMessage
+		failingSince = runtime.FailingSince
+		escalatedAt = runtime.EscalatedAt
+		acknowledgedAt = runtime.AcknowledgedAt
+		lastChangeAt = runtime.LastChangeAt
+		expectChangeUntil = runtime.ExpectChangeUntil
+		watchdogAlertedAt = runtime.WatchdogAlertedAt
+		lastPingAt = runtime.LastPingAt
+		circuitOpenedAt = runtime.CircuitOpenedAt
+	}
+
+	var heartbeatURL *string
+	if row.HeartbeatToken != nil {
+		pingPath := s.link(worker.HeartbeatPingPath(*row.HeartbeatToken))
+		heartbeatURL = &pingPath
 	}
 
 	notificationChannels := row.NotificationChannels
@@ -1438,38 +2921,99 @@ func mapMonitor(
 	if notificationIssues == nil {
 		notificationIssues = []monitorNotificationIssueResponse{}
 	}
+	escalationChannels := row.EscalationChannels
+	if escalationChannels == nil {
+		escalationChannels = []string{}
+	}
+	tags := row.Tags
+	if tags == nil {
+		tags = []string{}
+	}
+	mustContain := row.MustContain
+	if mustContain == nil {
+		mustContain = []string{}
+	}
+	mustNotContain := row.MustNotContain
+	if mustNotContain == nil {
+		mustNotContain = []string{}
+	}
 
 	return monitorResponse{
-		ID:                   int64(row.ID),
-		Label:                row.Label,
-		Method:               row.Method,
-		URL:                  row.URL,
-		IconURL:              resolveMonitorIconURL(row),
-		Body:                 truncateOptionalResponseString(row.Body),
-		Headers:              kvMap(row.Headers),
-		Auth:                 kvMap(row.Auth),
-		NotificationChannels: notificationChannels,
-		NotificationIssues:   notificationIssues,
-		Selector:             row.Selector,
-		ExpectedType:         string(row.ExpectedType),
-		ExpectedResponse:     truncateOptionalResponseString(row.ExpectedResponse),
-		Cron:                 row.Cron,
-		Enabled:              row.Enabled,
-		Status:               status,
-		CheckCount:           checkCount,
-		NextRunAt:            nextRunAt,
-		LastCheckAt:          lastCheckAt,
-		LastSuccessAt:        lastSuccessAt,
-		LastErrorAt:          lastErrorAt,
-		LastStatusCode:       lastStatusCode,
-		LastDurationMs:       lastDurationMs,
-		LastErrorMessage:     truncateOptionalResponseString(lastErrorMessage),
-		CreatedAt:            row.CreatedAt,
-		UpdatedAt:            row.UpdatedAt,
-	}
-}
-
-func mapTriggerResponse(
+		ID:                     int64(row.ID),
+		Label:                  row.Label,
+		Method:                 row.Method,
+		URL:                    row.URL,
+		IconURL:                resolveMonitorIconURL(row),
+		Body:                   truncateOptionalResponseString(row.Body),
+		Headers:                kvMap(row.Headers),
+		Auth:                   maskMonitorAuth(kvMap(row.Auth)),
+		NotificationChannels:   notificationChannels,
+		NotificationIssues:     notificationIssues,
+		EscalationChannels:     escalationChannels,
+		EscalationAfterMinutes: row.EscalationAfterMinutes,
+		Tags:                   tags,
+		Selector:               row.Selector,
+		ExpectedStatus:         row.ExpectedStatus,
+		RetryOn:                row.RetryOn,
+		ExpectedType:           string(row.ExpectedType),
+		ExpectedResponse:       truncateOptionalResponseString(row.ExpectedResponse),
+		MustContain:            mustContain,
+		MustNotContain:         mustNotContain,
+		TreatNotFoundAsSuccess: row.TreatNotFoundAsSuccess,
+		AcceptEmptyBody:        row.AcceptEmptyBody,
+		WatchdogMinutes:        row.WatchdogMinutes,
+		HeaderAssertions:       kvMap(row.HeaderAssertions),
+		TrackHeader:            row.TrackHeader,
+		WatchdogAlertedAt:      watchdogAlertedAt,
+		NumericTolerance:       row.NumericTolerance,
+		Cron:                   row.Cron,
+		UserAgent:              row.UserAgent,
+		HeaderProfileID:        row.HeaderProfileID,
+		HostOverrides:          kvMap(row.HostOverrides),
+		IPFamily:               row.IPFamily.String(),
+		RedirectPolicy:         row.RedirectPolicy.String(),
+		MaxRedirects:           row.MaxRedirects,
+		MaxResponseBytes:       row.MaxResponseBytes,
+		ChecksHistoryLimit:     row.ChecksHistoryLimit,
+		CookieJar:              row.CookieJar,
+		TLSCAPEM:               row.TLSCaPem,
+		TLSInsecureSkipVerify:  row.TLSInsecureSkipVerify,
+		TLSMinVersion:          row.TLSMinVersion,
+		TLSServerName:          row.TLSServerName,
+		Timezone:               row.Timezone,
+		JitterSeconds:          row.JitterSeconds,
+		DSTPolicy:              row.DstPolicy.String(),
+		BodySnapshot:           row.BodySnapshot.String(),
+		ContentHash:            row.ContentHash.String(),
+		FetchMode:              row.FetchMode.String(),
+		HeartbeatURL:           heartbeatURL,
+		LastPingAt:             lastPingAt,
+		CircuitOpenedAt:        circuitOpenedAt,
+		Enabled:                row.Enabled,
+		StatusPage:             row.StatusPage,
+		SortIndex:              row.SortIndex,
+		ArchivedAt:             row.ArchivedAt,
+		Status:                 status,
+		CheckCount:             checkCount,
+		NextRunAt:              nextRunAt,
+		LastCheckAt:            lastCheckAt,
+		LastSuccessAt:          lastSuccessAt,
+		LastErrorAt:            lastErrorAt,
+		LastStatusCode:         lastStatusCode,
+		LastDurationMs:         lastDurationMs,
+		LastErrorMessage:       truncateOptionalResponseString(lastErrorMessage),
+		FailingSince:           failingSince,
+		EscalatedAt:            escalatedAt,
+		AcknowledgedAt:         acknowledgedAt,
+		ChangeFrequency:        computeChangeFrequency(row, runtime, time.Now().UTC()),
+		LastChangeAt:           lastChangeAt,
+		ExpectChangeUntil:      expectChangeUntil,
+		CreatedAt:              row.CreatedAt,
+		UpdatedAt:              row.UpdatedAt,
+	}
+}
+
+func (s *Server) mapTriggerResponse(
 	result *worker.TriggerMonitorResult,
 	channelStates map[string]notificationChannelState,
 ) monitorTriggerResponse {
@@ -1483,7 +3027,7 @@ func mapTriggerResponse(
 	}
 
 	response := monitorTriggerResponse{
-		Monitor: mapMonitor(
+		Monitor: s.mapMonitor(
 			result.Monitor,
 			runtime,
 			buildMonitorNotificationIssues(result.Monitor.NotificationChannels, channelStates),
@@ -1500,18 +3044,27 @@ func mapTriggerResponse(
 
 func mapMonitorCheck(row *ent.CheckResult) monitorCheckResponse {
 	return monitorCheckResponse{
-		ID:             int64(row.ID),
-		Status:         row.Status,
-		StatusCode:     row.StatusCode,
-		ResponseTimeMs: row.ResponseTimeMs,
-		ErrorMessage:   truncateOptionalResponseString(row.ErrorMessage),
-		SelectionType:  row.SelectionType,
-		SelectionValue: truncateOptionalResponseString(row.SelectionValue),
-		DiffChanged:    row.DiffChanged,
-		DiffKind:       row.DiffKind,
-		DiffSummary:    truncateOptionalResponseString(row.DiffSummary),
-		DiffDetails:    truncateOptionalResponseString(row.DiffDetails),
-		CheckedAt:      row.CheckedAt,
+		ID:                 int64(row.ID),
+		Status:             row.Status,
+		StatusCode:         row.StatusCode,
+		ResponseTimeMs:     row.ResponseTimeMs,
+		ErrorMessage:       truncateOptionalResponseString(row.ErrorMessage),
+		SelectionType:      row.SelectionType,
+		SelectionValue:     truncateOptionalResponseString(row.SelectionValue),
+		DiffChanged:        row.DiffChanged,
+		DiffKind:           row.DiffKind,
+		DiffSummary:        truncateOptionalResponseString(row.DiffSummary),
+		DiffDetails:        truncateOptionalResponseString(row.DiffDetails),
+		BodySize:           row.BodySize,
+		HasBody:            worker.HasBodySnapshot(row),
+		BodyTruncated:      row.BodySnapshotTruncated,
+		BodyHash:           row.BodyHash,
+		TrackedHeaderValue: row.TrackedHeaderValue,
+		RedirectChain:      row.RedirectChain,
+		BodyReadMs:         row.BodyReadMs,
+		SelectorMs:         row.SelectorMs,
+		DiffMs:             row.DiffMs,
+		CheckedAt:          row.CheckedAt,
 	}
 }
 
@@ -1561,21 +3114,8 @@ func truncateResponseStringToBytes(value string, maxBytes int) string {
 	return value[:cutoff] + truncationSuffix
 }
 
-func nextRunFromCron(expr string, now time.Time, location *time.Location) (time.Time, error) {
-	schedule, err := cron.ParseStandard(expr)
-	if err != nil {
-		return time.Time{}, err
-	}
-
-	if location == nil {
-		location = time.UTC
-	}
-
-	if specSchedule, ok := schedule.(*cron.SpecSchedule); ok {
-		specSchedule.Location = location
-	}
-
-	return schedule.Next(now), nil
+func nextRunFromCron(expr string, dstPolicy string, now time.Time, location *time.Location) (time.Time, error) {
+	return worker.NextRunFromCron(expr, now, location, dstPolicy)
 }
 
 func applyTestAuth(req *http.Request, auth map[string]string) {
@@ -1630,78 +3170,70 @@ func isJSONContentType(contentType string) bool {
 	return strings.Contains(strings.ToLower(contentType), "application/json")
 }
 
-func (s *Server) storeSelectorPayload(payload []byte) string {
-	if len(payload) == 0 || len(payload) > s.maxSelectorPayloadBytes {
+// storeSelectorPayload saves payload for selectorPayloadTTL and returns the
+// token to preview selectors against it, or "" when it is empty, over limit
+// or could not be saved. Payloads live in the database so that any replica
+// can serve the preview; only the newest selectorPayloadCacheSize are kept.
+func (s *Server) storeSelectorPayload(ctx context.Context, payload []byte, limit int) string {
+	if len(payload) == 0 || len(payload) > limit {
 		return ""
 	}
 
-	expiresAt := time.Now().UTC().Add(selectorPayloadTTL)
-	payloadCopy := append([]byte(nil), payload...)
-
-	s.selectorPayloadsMu.Lock()
-	defer s.selectorPayloadsMu.Unlock()
-
 	now := time.Now().UTC()
-	for key, entry := range s.selectorPayloads {
-		if !entry.expiresAt.After(now) {
-			delete(s.selectorPayloads, key)
-		}
+	if _, err := s.db.SelectorPayload.Delete().
+		Where(selectorpayload.ExpiresAtLTE(now)).
+		Exec(ctx); err != nil {
+		return ""
 	}
 
-	if len(s.selectorPayloads) >= selectorPayloadCacheSize {
-		oldestKey := ""
-		oldestExpiry := time.Time{}
-		for key, entry := range s.selectorPayloads {
-			if oldestKey == "" || entry.expiresAt.Before(oldestExpiry) {
-				oldestKey = key
-				oldestExpiry = entry.expiresAt
-			}
-		}
-		if oldestKey != "" {
-			delete(s.selectorPayloads, oldestKey)
-		}
+	stale, err := s.db.SelectorPayload.Query().
+		Order(ent.Desc(selectorpayload.FieldExpiresAt), ent.Desc(selectorpayload.FieldID)).
+		Offset(selectorPayloadCacheSize - 1).
+		IDs(ctx)
+	if err != nil {
+		return ""
 	}
-
-	for attempts := 0; attempts < 4; attempts++ {
-		tokenBytes := make([]byte, 16)
-		if _, err := rand.Read(tokenBytes); err != nil {
+	if len(stale) > 0 {
+		if _, err := s.db.SelectorPayload.Delete().
+			Where(selectorpayload.IDIn(stale...)).
+			Exec(ctx); err != nil {
 			return ""
 		}
-		token := hex.EncodeToString(tokenBytes)
-		if _, exists := s.selectorPayloads[token]; exists {
-			continue
-		}
+	}
 
-		s.selectorPayloads[token] = selectorPayloadEntry{
-			payload:   payloadCopy,
-			expiresAt: expiresAt,
-		}
-		return token
+	tokenBytes := make([]byte, 16)
+	if _, err := rand.Read(tokenBytes); err != nil {
+		return ""
 	}
+	token := hex.EncodeToString(tokenBytes)
 
-	return ""
+	if _, err := s.db.SelectorPayload.Create().
+		SetToken(token).
+		SetPayload(payload).
+		SetExpiresAt(now.Add(selectorPayloadTTL)).
+		Save(ctx); err != nil {
+		return ""
+	}
+	return token
 }
 
-func (s *Server) loadSelectorPayload(token string) ([]byte, bool) {
+func (s *Server) loadSelectorPayload(ctx context.Context, token string) ([]byte, bool) {
 	token = strings.TrimSpace(token)
 	if token == "" {
 		return nil, false
 	}
 
-	s.selectorPayloadsMu.Lock()
-	defer s.selectorPayloadsMu.Unlock()
-
-	entry, exists := s.selectorPayloads[token]
-	if !exists {
-		return nil, false
-	}
-
-	if !entry.expiresAt.After(time.Now().UTC()) {
-		delete(s.selectorPayloads, token)
+	row, err := s.db.SelectorPayload.Query().
+		Where(
+			selectorpayload.TokenEQ(token),
+			selectorpayload.ExpiresAtGT(time.Now().UTC()),
+		).
+		Only(ctx)
+	if err != nil {
 		return nil, false
 	}
 
-	return append([]byte(nil), entry.payload...), true
+	return row.Payload, true
 }
 
 func sanitizeTelegramError(err error, botToken string) string {
@@ -1726,6 +3258,12 @@ func writeJSON(w http.ResponseWriter, statusCode int, payload any) {
 	}
 }
 
+// writeError writes an error body, with the request ID when the request
+// passed through requestid.Middleware.
 func writeError(w http.ResponseWriter, statusCode int, message string) {
-	writeJSON(w, statusCode, map[string]string{"error": message})
+	payload := map[string]string{"error": message}
+	if id := w.Header().Get(requestid.Header); id != "" {
+		payload["requestId"] = id
+	}
+	writeJSON(w, statusCode, payload)
 }
@@ -0,0 +1,45 @@
+package server
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"goanna/apps/api/ent/enttest"
+	"goanna/apps/api/internal/requestid"
+
+	_ "github.com/mattn/go-sqlite3"
+)
+
+func TestErrorResponsesIncludeRequestID(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:server-request-id?mode=memory&cache=shared&_fk=1")
+	defer client.Close()
+
+	mux := http.NewServeMux()
+	New(client).RegisterRoutes(mux)
+	handler := requestid.Middleware(mux)
+
+	req := httptest.NewRequest(http.MethodGet, "/v1/monitors/404/badge.svg", nil)
+	req.Header.Set(requestid.Header, "edge-7f3a")
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+
+	var response map[string]string
+	if err := json.Unmarshal(rec.Body.Bytes(), &response); err != nil {
+		t.Fatalf("expected an error body: %v", err)
+	}
+	if rec.Code != http.StatusNotFound || response["error"] != "monitor not found" || response["requestId"] != "edge-7f3a" {
+		t.Fatalf("expected a 404 carrying the request ID, got %d: %v", rec.Code, response)
+	}
+
+	rec = httptest.NewRecorder()
+	mux.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/v1/monitors/404/badge.svg", nil))
+	response = nil
+	if err := json.Unmarshal(rec.Body.Bytes(), &response); err != nil {
+		t.Fatalf("expected an error body: %v", err)
+	}
+	if _, ok := response["requestId"]; ok {
+		t.Fatalf("expected no request ID without the middleware, got %v", response)
+	}
+}
@@ -0,0 +1,335 @@
+package server
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"goanna/apps/api/ent"
+	"goanna/apps/api/ent/notificationchannel"
+	"goanna/apps/api/internal/worker"
+)
+
+const settingsExportVersion = 1
+
+// settingsExportDocument is the file format of a settings export. The
+// runtime section uses the runtime settings request shape; fields it leaves
+// out keep the importing instance's values.
+type settingsExportDocument struct {
+	Version              int                         `json:"version"`
+	ExportedAt           *time.Time                  `json:"exportedAt,omitempty"`
+	IncludesSecrets      bool                        `json:"includesSecrets,omitempty"`
+	Runtime              *runtimeSettingsRequest     `json:"runtime,omitempty"`
+	NotificationChannels []notificationChannelExport `json:"notificationChannels"`
+}
+
+// notificationChannelExport is one notification channel. Without a bot
+// token the importing instance keeps the token it already has.
+type notificationChannelExport struct {
+	Name     string `json:"name"`
+	Kind     string `json:"kind"`
+	BotToken string `json:"botToken,omitempty"`
+	ChatID   string `json:"chatId"`
+	Enabled  *bool  `json:"enabled"`
+}
+
+type importSettingsResponse struct {
+	Runtime              *runtimeSettingsResponse            `json:"runtime,omitempty"`
+	NotificationChannels []importNotificationChannelResponse `json:"notificationChannels"`
+}
+
+type importNotificationChannelResponse struct {
+	Kind   string  `json:"kind"`
+	Status string  `json:"status"`
+	Reason *string `json:"reason,omitempty"`
+}
+
+// handleExportSettings writes the runtime settings and notification channels
+// as JSON or YAML, for bootstrapping another instance. Bot tokens, proxy
+// passwords and credential default headers are left out unless
+// includeSecrets=true.
+func (s *Server) handleExportSettings(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+
+	format, err := parseExportFormat(query)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	includeSecrets, err := parseExportFlag(query, "includeSecrets")
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	config, err := s.ensureGlobalSystemConfig(r.Context())
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "failed to load runtime settings")
+		return
+	}
+
+	channels, err := s.db.NotificationChannel.Query().
+		Order(ent.Asc(notificationchannel.FieldID)).
+		All(r.Context())
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "failed to load notification channels")
+		return
+	}
+
+	exportedAt := time.Now().UTC()
+	document := settingsExportDocument{
+		Version:              settingsExportVersion,
+		ExportedAt:           &exportedAt,
+		IncludesSecrets:      includeSecrets,
+		Runtime:              exportRuntimeSettings(config, includeSecrets),
+		NotificationChannels: make([]notificationChannelExport, 0, len(channels)),
+	}
+	for _, channel := range channels {
+		enabled := channel.Enabled
+		exported := notificationChannelExport{
+			Name:    channel.Name,
+			Kind:    channel.Kind.String(),
+			ChatID:  channel.ChatID,
+			Enabled: &enabled,
+		}
+		if includeSecrets {
+			exported.BotToken = channel.BotToken
+		}
+		document.NotificationChannels = append(document.NotificationChannels, exported)
+	}
+
+	writeExportDocument(w, document, format, "goanna-settings")
+}
+
+// exportRuntimeSettings returns the saved runtime settings as a request, or
+// nil before a timezone is set since such settings could not be imported.
+func exportRuntimeSettings(config *ent.SystemConfig, includeSecrets bool) *runtimeSettingsRequest {
+	timezone, timezoneValid := normalizeStoredRuntimeTimezone(config.Timezone)
+	if !timezoneValid {
+		return nil
+	}
+
+	circuitBreakerAction := config.CircuitBreakerAction.String()
+	catchUpPolicy := config.CatchUpPolicy.String()
+	req := &runtimeSettingsRequest{
+		ChecksHistoryLimit:           config.ChecksHistoryLimit,
+		Timezone:                     *timezone,
+		StaleAfterDays:               &config.StaleAfterDays,
+		StaleNotifications:           &config.StaleNotifications,
+		ScheduleJitterSeconds:        &config.ScheduleJitterSeconds,
+		CircuitBreakerThreshold:      &config.CircuitBreakerThreshold,
+		CircuitBreakerAction:         &circuitBreakerAction,
+		CircuitBreakerBackoffMinutes: &config.CircuitBreakerBackoffMinutes,
+		CatchUpPolicy:                &catchUpPolicy,
+		CatchUpMaxAgeMinutes:         &config.CatchUpMaxAgeMinutes,
+		DefaultUserAgent:             config.DefaultUserAgent,
+		DefaultHeaders:               kvMap(config.DefaultHeaders),
+		RequestTimeoutSeconds:        &config.RequestTimeoutSeconds,
+		MaxRetries:                   &config.MaxRetries,
+		RetryBackoffSeconds:          &config.RetryBackoffSeconds,
+		TickIntervalSeconds:          &config.TickIntervalSeconds,
+		ScheduleLookaheadSeconds:     &config.ScheduleLookaheadSeconds,
+		MaxResponseBodyBytes:         config.MaxResponseBodyBytes,
+	}
+
+	proxy := worker.ProxySettingsFromSystem(config)
+	if proxy.URL != "" && (includeSecrets || !proxyURLHasPassword(proxy.URL)) {
+		req.ProxyURL = &proxy.URL
+	}
+	if proxy.Bypass != "" {
+		req.ProxyBypass = &proxy.Bypass
+	}
+
+	if !includeSecrets && len(req.DefaultHeaders) > 0 {
+		headers := make(map[string]string, len(req.DefaultHeaders))
+		for name, value := range req.DefaultHeaders {
+			if !isSensitiveHeader(name) || worker.HasEnvReference(value) {
+				headers[name] = value
+			}
+		}
+		req.DefaultHeaders = headers
+	}
+
+	return req
+}
+
+func proxyURLHasPassword(raw string) bool {
+	parsed, err := url.Parse(raw)
+	if err != nil || parsed.User == nil {
+		return false
+	}
+	_, ok := parsed.User.Password()
+	return ok
+}
+
+// handleImportSettings applies a settings export. Notification channels are
+// matched by kind and created or updated; a channel without a bot token that
+// does not exist yet is skipped. Everything is validated first and saved in
+// one transaction.
+func (s *Server) handleImportSettings(w http.ResponseWriter, r *http.Request) {
+	payload, err := io.ReadAll(io.LimitReader(r.Body, maxImportBodyBytes+1))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "failed to read request body")
+		return
+	}
+	if len(payload) > maxImportBodyBytes {
+		writeError(w, http.StatusRequestEntityTooLarge, "import is too large")
+		return
+	}
+
+	var document settingsExportDocument
+	if err := decodeExportDocument(payload, &document); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	if document.Version != settingsExportVersion {
+		writeError(w, http.StatusBadRequest, "import version must be "+strconv.Itoa(settingsExportVersion))
+		return
+	}
+
+	var update *runtimeSettingsUpdate
+	if document.Runtime != nil {
+		normalized, err := normalizeRuntimeSettingsRequest(*document.Runtime)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, "runtime: "+err.Error())
+			return
+		}
+		update = &normalized
+	}
+	channels, err := normalizeImportedChannels(document.NotificationChannels)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	config, err := s.ensureGlobalSystemConfig(r.Context())
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "failed to load runtime settings")
+		return
+	}
+	var proxy worker.ProxySettings
+	if update != nil {
+		proxy, err = mergeProxySettings(config, update.ProxyURL, update.ProxyBypass)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, "runtime: "+err.Error())
+			return
+		}
+	}
+
+	existing, err := s.db.NotificationChannel.Query().All(r.Context())
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "failed to load notification channels")
+		return
+	}
+	byKind := make(map[notificationchannel.Kind]*ent.NotificationChannel, len(existing))
+	for _, channel := range existing {
+		byKind[channel.Kind] = channel
+	}
+
+	tx, err := s.db.Tx(r.Context())
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "failed to import settings")
+		return
+	}
+
+	response := importSettingsResponse{
+		NotificationChannels: make([]importNotificationChannelResponse, 0, len(channels)),
+	}
+	if update != nil {
+		updated, err := saveRuntimeSettings(r.Context(), tx.Client(), config, *update, proxy)
+		if err != nil {
+			_ = tx.Rollback()
+			writeError(w, http.StatusInternalServerError, "failed to import runtime settings")
+			return
+		}
+		runtime := newRuntimeSettingsResponse(updated)
+		response.Runtime = &runtime
+	}
+
+	for _, channel := range channels {
+		result := importNotificationChannelResponse{Kind: channel.Kind}
+		kind := notificationchannel.Kind(channel.Kind)
+		match := byKind[kind]
+		switch {
+		case match != nil:
+			updateChannel := tx.NotificationChannel.UpdateOneID(match.ID).
+				SetChatID(channel.ChatID).
+				SetEnabled(*channel.Enabled)
+			if channel.Name != "" {
+				updateChannel = updateChannel.SetName(channel.Name)
+			}
+			if channel.BotToken != "" {
+				updateChannel = updateChannel.SetBotToken(channel.BotToken)
+			}
+			err = updateChannel.Exec(r.Context())
+			result.Status = "updated"
+		case channel.BotToken == "":
+			reason := "botToken is required for a new channel"
+			result.Status = "skipped"
+			result.Reason = &reason
+		default:
+			createChannel := tx.NotificationChannel.Create().
+				SetKind(kind).
+				SetBotToken(channel.BotToken).
+				SetChatID(channel.ChatID).
+				SetEnabled(*channel.Enabled)
+			if channel.Name != "" {
+				createChannel = createChannel.SetName(channel.Name)
+			}
+			err = createChannel.Exec(r.Context())
+			result.Status = "created"
+		}
+		if err != nil {
+			_ = tx.Rollback()
+			writeError(w, http.StatusInternalServerError, "failed to import notification channels")
+			return
+		}
+		response.NotificationChannels = append(response.NotificationChannels, result)
+	}
+
+	if err := tx.Commit(); err != nil {
+		writeError(w, http.StatusInternalServerError, "failed to import settings")
+		return
+	}
+	if update != nil {
+		s.scheduleChanges.Publish()
+	}
+
+	writeJSON(w, http.StatusOK, response)
+}
+
+// normalizeImportedChannels trims and validates the channels of an import.
+// Each kind may appear once, and channels are enabled unless they say
+// otherwise.
+func normalizeImportedChannels(channels []notificationChannelExport) ([]notificationChannelExport, error) {
+	normalized := make([]notificationChannelExport, 0, len(channels))
+	seen := make(map[string]struct{}, len(channels))
+	for i, channel := range channels {
+		channel.Name = strings.TrimSpace(channel.Name)
+		channel.Kind = strings.ToLower(strings.TrimSpace(channel.Kind))
+		channel.BotToken = strings.TrimSpace(channel.BotToken)
+		channel.ChatID = strings.TrimSpace(channel.ChatID)
+
+		if err := notificationchannel.KindValidator(notificationchannel.Kind(channel.Kind)); err != nil {
+			return nil, fmt.Errorf("notificationChannels[%d]: unsupported kind %q", i, channel.Kind)
+		}
+		if _, ok := seen[channel.Kind]; ok {
+			return nil, fmt.Errorf("notificationChannels[%d]: %s appears more than once", i, channel.Kind)
+		}
+		seen[channel.Kind] = struct{}{}
+		if channel.ChatID == "" {
+			return nil, fmt.Errorf("notificationChannels[%d]: chatId is required", i)
+		}
+		if channel.Enabled == nil {
+			enabled := true
+			channel.Enabled = &enabled
+		}
+		normalized = append(normalized, channel)
+	}
+	return normalized, nil
+}
@@ -0,0 +1,75 @@
+package server
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"goanna/apps/api/ent/enttest"
+	"goanna/apps/api/ent/monitorruntime"
+
+	_ "github.com/mattn/go-sqlite3"
+)
+
+func TestHandleListMonitorsFiltersStale(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:monitor-stale?mode=memory&cache=shared&_fk=1")
+	defer client.Close()
+
+	now := time.Now().UTC()
+	longAgo := now.Add(-30 * 24 * time.Hour)
+	for _, changedAt := range []time.Time{longAgo, now.Add(-time.Hour)} {
+		row, err := client.Monitor.Create().
+			SetURL("https://example.com/feed").
+			SetCron("*/5 * * * *").
+			SetCreatedAt(longAgo).
+			Save(t.Context())
+		if err != nil {
+			t.Fatalf("expected monitor to save: %v", err)
+		}
+		if _, err := client.MonitorRuntime.Create().
+			SetMonitor(row).
+			SetStatus(monitorruntime.StatusOk).
+			SetLastSuccessAt(now).
+			SetLastChangeAt(changedAt).
+			Save(t.Context()); err != nil {
+			t.Fatalf("expected runtime to save: %v", err)
+		}
+	}
+
+	mux := http.NewServeMux()
+	New(client).RegisterRoutes(mux)
+
+	req := httptest.NewRequest(http.MethodGet, "/v1/monitors?stale=true", nil)
+	rec := httptest.NewRecorder()
+	mux.ServeHTTP(rec, req)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected 200, got %d: %s", rec.Code, rec.Body.String())
+	}
+
+	var monitors []monitorResponse
+	if err := json.Unmarshal(rec.Body.Bytes(), &monitors); err != nil {
+		t.Fatalf("expected monitors JSON: %v", err)
+	}
+	if len(monitors) != 1 || monitors[0].StaleReason == nil || *monitors[0].StaleReason != "unchanged" {
+		t.Fatalf("expected only the unchanged monitor, got %#v", monitors)
+	}
+
+	req = httptest.NewRequest(http.MethodGet, "/v1/monitors", nil)
+	rec = httptest.NewRecorder()
+	mux.ServeHTTP(rec, req)
+	if err := json.Unmarshal(rec.Body.Bytes(), &monitors); err != nil {
+		t.Fatalf("expected monitors JSON: %v", err)
+	}
+	if len(monitors) != 2 {
+		t.Fatalf("expected all monitors without stale filter, got %d", len(monitors))
+	}
+
+	req = httptest.NewRequest(http.MethodGet, "/v1/monitors?stale=maybe", nil)
+	rec = httptest.NewRecorder()
+	mux.ServeHTTP(rec, req)
+	if rec.Code != http.StatusBadRequest {
+		t.Fatalf("expected 400 for invalid stale filter, got %d", rec.Code)
+	}
+}
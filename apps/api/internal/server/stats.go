@@ -0,0 +1,519 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	"goanna/apps/api/ent"
+	"goanna/apps/api/ent/checkresult"
+	"goanna/apps/api/ent/checkrollup"
+	"goanna/apps/api/ent/monitor"
+	"goanna/apps/api/internal/worker"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+type changeFrequencyResponse struct {
+	WindowDays    int     `json:"windowDays"`
+	Changes       int     `json:"changes"`
+	ChangesPerDay float64 `json:"changesPerDay"`
+}
+
+type dailyChangeCountResponse struct {
+	Date    string `json:"date"`
+	Changes int    `json:"changes"`
+}
+
+type timingSummaryResponse struct {
+	Samples int     `json:"samples"`
+	AvgMs   float64 `json:"avgMs"`
+	MaxMs   float64 `json:"maxMs"`
+}
+
+type checkPerformanceResponse struct {
+	BodyRead timingSummaryResponse `json:"bodyRead"`
+	Selector timingSummaryResponse `json:"selector"`
+	Diff     timingSummaryResponse `json:"diff"`
+}
+
+type latencySummaryResponse struct {
+	Samples int  `json:"samples"`
+	P50Ms   *int `json:"p50Ms,omitempty"`
+	P95Ms   *int `json:"p95Ms,omitempty"`
+	MaxMs   *int `json:"maxMs,omitempty"`
+}
+
+type uptimeStatsResponse struct {
+	Window        string                 `json:"window"`
+	From          time.Time              `json:"from"`
+	Checks        int                    `json:"checks"`
+	Successes     int                    `json:"successes"`
+	Errors        int                    `json:"errors"`
+	UptimePercent *float64               `json:"uptimePercent,omitempty"`
+	Latency       latencySummaryResponse `json:"latency"`
+}
+
+type monitorStatsResponse struct {
+	MonitorID       int64                      `json:"monitorId"`
+	Label           *string                    `json:"label,omitempty"`
+	URL             string                     `json:"url"`
+	CheckCount      int64                      `json:"checkCount"`
+	ChangeFrequency changeFrequencyResponse    `json:"changeFrequency"`
+	Performance     checkPerformanceResponse   `json:"performance"`
+	Daily           []dailyChangeCountResponse `json:"daily"`
+	Uptime          *uptimeStatsResponse       `json:"uptime,omitempty"`
+}
+
+type statsRollupResponse struct {
+	Start         time.Time `json:"start"`
+	Checks        int       `json:"checks"`
+	Successes     int       `json:"successes"`
+	Errors        int       `json:"errors"`
+	Changes       int       `json:"changes"`
+	UptimePercent *float64  `json:"uptimePercent,omitempty"`
+	AvgLatencyMs  *float64  `json:"avgLatencyMs,omitempty"`
+	P95LatencyMs  *int      `json:"p95LatencyMs,omitempty"`
+	MaxLatencyMs  *int      `json:"maxLatencyMs,omitempty"`
+}
+
+type monitorRollupsResponse struct {
+	MonitorID int64                 `json:"monitorId"`
+	Period    string                `json:"period"`
+	From      time.Time             `json:"from"`
+	To        time.Time             `json:"to"`
+	Buckets   []statsRollupResponse `json:"buckets"`
+}
+
+// rollupDefaultRanges is how far back the rollups endpoint reads when from
+// is not given.
+var rollupDefaultRanges = map[checkrollup.Period]time.Duration{
+	checkrollup.PeriodHour: 7 * 24 * time.Hour,
+	checkrollup.PeriodDay:  365 * 24 * time.Hour,
+}
+
+// statsWindows are the uptime windows the single-monitor stats endpoint
+// accepts.
+var statsWindows = map[string]time.Duration{
+	"24h": 24 * time.Hour,
+	"7d":  7 * 24 * time.Hour,
+	"30d": 30 * 24 * time.Hour,
+}
+
+func (s *Server) handleListMonitorStats(w http.ResponseWriter, r *http.Request) {
+	sortBy := strings.TrimSpace(r.URL.Query().Get("sort"))
+	if sortBy == "" {
+		sortBy = "changes"
+	}
+	if sortBy != "changes" && sortBy != "performance" {
+		writeError(w, http.StatusBadRequest, "sort must be one of: changes, performance")
+		return
+	}
+
+	rows, err := s.db.Monitor.Query().
+		Where(monitor.ArchivedAtIsNil()).
+		WithRuntime().
+		All(r.Context())
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "failed to list monitor stats")
+		return
+	}
+
+	now := time.Now().UTC()
+	response := make([]monitorStatsResponse, 0, len(rows))
+	for _, row := range rows {
+		performance, err := s.loadCheckPerformance(r.Context(), row.ID)
+		if err != nil {
+			writeError(w, http.StatusInternalServerError, "failed to list monitor stats")
+			return
+		}
+		response = append(response, mapMonitorStats(row, row.Edges.Runtime, performance, now))
+	}
+	sort.SliceStable(response, func(i, j int) bool {
+		left, right := response[i].ChangeFrequency.ChangesPerDay, response[j].ChangeFrequency.ChangesPerDay
+		if sortBy == "performance" {
+			left, right = response[i].Performance.totalAvgMs(), response[j].Performance.totalAvgMs()
+		}
+		if left != right {
+			return left > right
+		}
+		return response[i].MonitorID < response[j].MonitorID
+	})
+
+	writeJSON(w, http.StatusOK, response)
+}
+
+func (s *Server) handleGetMonitorStats(w http.ResponseWriter, r *http.Request) {
+	monitorID, err := parseMonitorID(r.PathValue("monitorId"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "monitorId must be a positive integer")
+		return
+	}
+
+	window := strings.TrimSpace(r.URL.Query().Get("window"))
+	if window == "" {
+		window = "24h"
+	}
+	if _, ok := statsWindows[window]; !ok {
+		writeError(w, http.StatusBadRequest, "window must be one of: 24h, 7d, 30d")
+		return
+	}
+
+	row, err := s.db.Monitor.Query().
+		Where(monitor.IDEQ(monitorID)).
+		WithRuntime().
+		Only(r.Context())
+	if err != nil {
+		if ent.IsNotFound(err) {
+			writeError(w, http.StatusNotFound, "monitor not found")
+			return
+		}
+		writeError(w, http.StatusInternalServerError, "failed to load monitor")
+		return
+	}
+
+	performance, err := s.loadCheckPerformance(r.Context(), row.ID)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "failed to load monitor stats")
+		return
+	}
+
+	now := time.Now().UTC()
+	uptime, err := s.loadUptimeStats(r.Context(), row.ID, window, now)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "failed to load monitor stats")
+		return
+	}
+
+	response := mapMonitorStats(row, row.Edges.Runtime, performance, now)
+	response.Uptime = &uptime
+	writeJSON(w, http.StatusOK, response)
+}
+
+// handleGetMonitorRollups returns a monitor's hourly or daily rollups, which
+// outlive the raw check history, oldest first.
+func (s *Server) handleGetMonitorRollups(w http.ResponseWriter, r *http.Request) {
+	monitorID, err := parseMonitorID(r.PathValue("monitorId"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "monitorId must be a positive integer")
+		return
+	}
+
+	period := checkrollup.Period(strings.TrimSpace(r.URL.Query().Get("period")))
+	if period == "" {
+		period = checkrollup.PeriodHour
+	}
+	if checkrollup.PeriodValidator(period) != nil {
+		writeError(w, http.StatusBadRequest, "period must be one of: hour, day")
+		return
+	}
+
+	to := time.Now().UTC()
+	from := to.Add(-rollupDefaultRanges[period])
+	for _, bound := range []struct {
+		key    string
+		target *time.Time
+	}{{key: "from", target: &from}, {key: "to", target: &to}} {
+		raw := strings.TrimSpace(r.URL.Query().Get(bound.key))
+		if raw == "" {
+			continue
+		}
+		parsed, err := time.Parse(time.RFC3339, raw)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, bound.key+" must be an RFC3339 timestamp")
+			return
+		}
+		*bound.target = parsed.UTC()
+	}
+
+	exists, err := s.db.Monitor.Query().Where(monitor.IDEQ(monitorID)).Exist(r.Context())
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "failed to load monitor")
+		return
+	}
+	if !exists {
+		writeError(w, http.StatusNotFound, "monitor not found")
+		return
+	}
+
+	rows, err := s.db.CheckRollup.Query().
+		Where(
+			checkrollup.MonitorIDEQ(monitorID),
+			checkrollup.PeriodEQ(period),
+			checkrollup.BucketStartGTE(worker.RollupBucketStart(period, from)),
+			checkrollup.BucketStartLTE(to),
+		).
+		Order(ent.Asc(checkrollup.FieldBucketStart)).
+		All(r.Context())
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "failed to load monitor rollups")
+		return
+	}
+
+	response := monitorRollupsResponse{
+		MonitorID: int64(monitorID),
+		Period:    period.String(),
+		From:      from,
+		To:        to,
+		Buckets:   make([]statsRollupResponse, 0, len(rows)),
+	}
+	for _, row := range rows {
+		response.Buckets = append(response.Buckets, mapStatsRollup(row))
+	}
+	writeJSON(w, http.StatusOK, response)
+}
+
+func mapStatsRollup(row *ent.CheckRollup) statsRollupResponse {
+	response := statsRollupResponse{
+		Start:         row.BucketStart.UTC(),
+		Checks:        row.Checks,
+		Successes:     row.Successes,
+		Errors:        row.Errors,
+		Changes:       row.Changes,
+		UptimePercent: uptimePercent(row.Checks, row.Successes),
+		P95LatencyMs:  worker.LatencyPercentile(row.LatencyHistogram, 0.95, row.LatencyMaxMs),
+		MaxLatencyMs:  row.LatencyMaxMs,
+	}
+	if row.LatencySamples > 0 {
+		avg := math.Round(float64(row.LatencyTotalMs)/float64(row.LatencySamples)*10) / 10
+		response.AvgLatencyMs = &avg
+	}
+	return response
+}
+
+func mapMonitorStats(
+	row *ent.Monitor,
+	runtime *ent.MonitorRuntime,
+	performance checkPerformanceResponse,
+	now time.Time,
+) monitorStatsResponse {
+	response := monitorStatsResponse{
+		MonitorID:       int64(row.ID),
+		Label:           row.Label,
+		URL:             row.URL,
+		ChangeFrequency: computeChangeFrequency(row, runtime, now),
+		Performance:     performance,
+		Daily:           dailyChangeCounts(runtime, now),
+	}
+	if runtime != nil {
+		response.CheckCount = runtime.CheckCount
+	}
+	return response
+}
+
+// computeChangeFrequency averages detected changes over the last
+// worker.ChangeFrequencyWindowDays UTC days, or over the monitor's lifetime
+// when it is younger than that.
+func computeChangeFrequency(row *ent.Monitor, runtime *ent.MonitorRuntime, now time.Time) changeFrequencyResponse {
+	windowDays := worker.ChangeFrequencyWindowDays
+	if !row.CreatedAt.IsZero() {
+		ageDays := int(utcDay(now).Sub(utcDay(row.CreatedAt)).Hours()/24) + 1
+		windowDays = max(1, min(windowDays, ageDays))
+	}
+
+	changes := 0
+	if runtime != nil {
+		cutoff := worker.ChangeDayKey(now.AddDate(0, 0, -(windowDays - 1)))
+		for day, count := range runtime.DailyChangeCounts {
+			if day >= cutoff {
+				changes += count
+			}
+		}
+	}
+
+	return changeFrequencyResponse{
+		WindowDays:    windowDays,
+		Changes:       changes,
+		ChangesPerDay: math.Round(float64(changes)/float64(windowDays)*100) / 100,
+	}
+}
+
+func dailyChangeCounts(runtime *ent.MonitorRuntime, now time.Time) []dailyChangeCountResponse {
+	daily := make([]dailyChangeCountResponse, 0, worker.ChangeFrequencyWindowDays)
+	for offset := worker.ChangeFrequencyWindowDays - 1; offset >= 0; offset-- {
+		day := worker.ChangeDayKey(now.AddDate(0, 0, -offset))
+		changes := 0
+		if runtime != nil {
+			changes = runtime.DailyChangeCounts[day]
+		}
+		daily = append(daily, dailyChangeCountResponse{Date: day, Changes: changes})
+	}
+	return daily
+}
+
+// recentChecksWindow is the window of the check counts in monitor lists.
+const recentChecksWindow = 24 * time.Hour
+
+// recentChecksResponse counts a monitor's checks over recentChecksWindow.
+type recentChecksResponse struct {
+	Checks        int      `json:"checks"`
+	Errors        int      `json:"errors"`
+	UptimePercent *float64 `json:"uptimePercent,omitempty"`
+}
+
+// loadRecentChecks counts the checks of every monitor within
+// recentChecksWindow before now in one grouped query, so listing monitors
+// does not query each monitor's history. Monitors without recent checks are
+// missing from the result.
+func (s *Server) loadRecentChecks(ctx context.Context, now time.Time) (map[int]recentChecksResponse, error) {
+	var rows []struct {
+		MonitorID int `json:"monitor_check_results"`
+		Checks    int `json:"checks"`
+		Successes int `json:"successes"`
+		Errors    int `json:"errors"`
+	}
+	err := s.db.CheckResult.Query().
+		Where(
+			checkresult.CheckedAtGT(now.Add(-recentChecksWindow)),
+			checkresult.CheckedAtLTE(now),
+		).
+		GroupBy(checkresult.FieldMonitorID).
+		Aggregate(
+			ent.As(ent.Count(), "checks"),
+			countChecksWithStatus("ok", "successes"),
+			countChecksWithStatus("error", "errors"),
+		).
+		Scan(ctx, &rows)
+	if err != nil {
+		return nil, err
+	}
+
+	recent := make(map[int]recentChecksResponse, len(rows))
+	for _, row := range rows {
+		recent[row.MonitorID] = recentChecksResponse{
+			Checks:        row.Checks,
+			Errors:        row.Errors,
+			UptimePercent: uptimePercent(row.Checks, row.Successes),
+		}
+	}
+	return recent, nil
+}
+
+// countChecksWithStatus aggregates the number of checks in a group that ended
+// with status.
+func countChecksWithStatus(status string, as string) ent.AggregateFunc {
+	return func(s *sql.Selector) string {
+		return sql.As(fmt.Sprintf("SUM(CASE WHEN %s = '%s' THEN 1 ELSE 0 END)", s.C(checkresult.FieldStatus), status), as)
+	}
+}
+
+// loadCheckPerformance summarizes body read, selector and diff timings over the
+// monitor's retained check history.
+func (s *Server) loadCheckPerformance(ctx context.Context, monitorID int) (checkPerformanceResponse, error) {
+	rows, err := s.db.CheckResult.Query().
+		Where(checkresult.MonitorIDEQ(monitorID)).
+		Select(checkresult.FieldBodyReadMs, checkresult.FieldSelectorMs, checkresult.FieldDiffMs).
+		All(ctx)
+	if err != nil {
+		return checkPerformanceResponse{}, err
+	}
+
+	bodyRead := make([]*float64, 0, len(rows))
+	selector := make([]*float64, 0, len(rows))
+	diff := make([]*float64, 0, len(rows))
+	for _, row := range rows {
+		bodyRead = append(bodyRead, row.BodyReadMs)
+		selector = append(selector, row.SelectorMs)
+		diff = append(diff, row.DiffMs)
+	}
+
+	return checkPerformanceResponse{
+		BodyRead: summarizeTimings(bodyRead),
+		Selector: summarizeTimings(selector),
+		Diff:     summarizeTimings(diff),
+	}, nil
+}
+
+// loadUptimeStats summarizes the checks recorded within window before now.
+// Only retained check history is counted, so windows longer than the history
+// limit cover fewer checks.
+func (s *Server) loadUptimeStats(ctx context.Context, monitorID int, window string, now time.Time) (uptimeStatsResponse, error) {
+	from := now.Add(-statsWindows[window])
+	rows, err := s.db.CheckResult.Query().
+		Where(
+			checkresult.MonitorIDEQ(monitorID),
+			checkresult.CheckedAtGT(from),
+			checkresult.CheckedAtLTE(now),
+		).
+		Select(checkresult.FieldStatus, checkresult.FieldResponseTimeMs).
+		All(ctx)
+	if err != nil {
+		return uptimeStatsResponse{}, err
+	}
+
+	stats := uptimeStatsResponse{Window: window, From: from, Checks: len(rows)}
+	latencies := make([]int, 0, len(rows))
+	for _, row := range rows {
+		switch row.Status {
+		case "ok":
+			stats.Successes++
+		case "error":
+			stats.Errors++
+		}
+		if row.ResponseTimeMs != nil {
+			latencies = append(latencies, *row.ResponseTimeMs)
+		}
+	}
+	stats.UptimePercent = uptimePercent(stats.Checks, stats.Successes)
+	stats.Latency = summarizeLatencies(latencies)
+
+	return stats, nil
+}
+
+// uptimePercent is successes as a percentage of checks, rounded to two
+// decimals, or nil when there were no checks.
+func uptimePercent(checks int, successes int) *float64 {
+	if checks == 0 {
+		return nil
+	}
+	uptime := math.Round(float64(successes)/float64(checks)*10000) / 100
+	return &uptime
+}
+
+// summarizeLatencies reports nearest-rank percentiles of response times.
+func summarizeLatencies(latencies []int) latencySummaryResponse {
+	summary := latencySummaryResponse{Samples: len(latencies)}
+	if len(latencies) == 0 {
+		return summary
+	}
+
+	sort.Ints(latencies)
+	percentile := func(p float64) *int {
+		value := latencies[int(math.Ceil(p*float64(len(latencies))))-1]
+		return &value
+	}
+	summary.P50Ms = percentile(0.5)
+	summary.P95Ms = percentile(0.95)
+	summary.MaxMs = &latencies[len(latencies)-1]
+	return summary
+}
+
+func summarizeTimings(values []*float64) timingSummaryResponse {
+	summary := timingSummaryResponse{}
+	total := 0.0
+	for _, value := range values {
+		if value == nil {
+			continue
+		}
+		summary.Samples++
+		total += *value
+		summary.MaxMs = max(summary.MaxMs, *value)
+	}
+	if summary.Samples > 0 {
+		summary.AvgMs = math.Round(total/float64(summary.Samples)*1000) / 1000
+	}
+	return summary
+}
+
+func (p checkPerformanceResponse) totalAvgMs() float64 {
+	return p.BodyRead.AvgMs + p.Selector.AvgMs + p.Diff.AvgMs
+}
+
+func utcDay(t time.Time) time.Time {
+	year, month, day := t.UTC().Date()
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
@@ -0,0 +1,409 @@
+package server
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"goanna/apps/api/ent"
+	"goanna/apps/api/ent/checkrollup"
+	"goanna/apps/api/ent/enttest"
+	"goanna/apps/api/ent/monitorruntime"
+	"goanna/apps/api/internal/worker"
+
+	_ "github.com/mattn/go-sqlite3"
+)
+
+func TestComputeChangeFrequency(t *testing.T) {
+	now := time.Date(2026, time.March, 31, 12, 0, 0, 0, time.UTC)
+	runtime := &ent.MonitorRuntime{DailyChangeCounts: map[string]int{
+		"2026-03-31": 3,
+		"2026-03-02": 3,
+		"2026-03-01": 10,
+	}}
+
+	frequency := computeChangeFrequency(&ent.Monitor{CreatedAt: now.AddDate(0, -6, 0)}, runtime, now)
+	if frequency.WindowDays != worker.ChangeFrequencyWindowDays || frequency.Changes != 6 || frequency.ChangesPerDay != 0.2 {
+		t.Fatalf("unexpected frequency %#v", frequency)
+	}
+
+	young := computeChangeFrequency(&ent.Monitor{CreatedAt: now.AddDate(0, 0, -1)}, runtime, now)
+	if young.WindowDays != 2 || young.Changes != 3 || young.ChangesPerDay != 1.5 {
+		t.Fatalf("expected window limited to monitor age, got %#v", young)
+	}
+
+	empty := computeChangeFrequency(&ent.Monitor{CreatedAt: now}, nil, now)
+	if empty.WindowDays != 1 || empty.Changes != 0 || empty.ChangesPerDay != 0 {
+		t.Fatalf("expected zero frequency without runtime, got %#v", empty)
+	}
+}
+
+func TestHandleMonitorStats(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:monitor-stats?mode=memory&cache=shared&_fk=1")
+	defer client.Close()
+
+	now := time.Now().UTC()
+	quiet, err := client.Monitor.Create().
+		SetURL("https://example.com/quiet").
+		SetCron("*/5 * * * *").
+		SetCreatedAt(now.AddDate(0, 0, -60)).
+		Save(t.Context())
+	if err != nil {
+		t.Fatalf("expected monitor to save: %v", err)
+	}
+	noisy, err := client.Monitor.Create().
+		SetURL("https://example.com/noisy").
+		SetCron("*/5 * * * *").
+		SetCreatedAt(now.AddDate(0, 0, -60)).
+		Save(t.Context())
+	if err != nil {
+		t.Fatalf("expected monitor to save: %v", err)
+	}
+	if _, err := client.MonitorRuntime.Create().
+		SetMonitor(noisy).
+		SetStatus(monitorruntime.StatusOk).
+		SetDailyChangeCounts(map[string]int{worker.ChangeDayKey(now): 15}).
+		Save(t.Context()); err != nil {
+		t.Fatalf("expected runtime to save: %v", err)
+	}
+
+	mux := http.NewServeMux()
+	New(client).RegisterRoutes(mux)
+
+	req := httptest.NewRequest(http.MethodGet, fmt.Sprintf("/v1/monitors/%d/stats", noisy.ID), nil)
+	rec := httptest.NewRecorder()
+	mux.ServeHTTP(rec, req)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected 200, got %d: %s", rec.Code, rec.Body.String())
+	}
+
+	var stats monitorStatsResponse
+	if err := json.Unmarshal(rec.Body.Bytes(), &stats); err != nil {
+		t.Fatalf("expected stats JSON: %v", err)
+	}
+	if stats.ChangeFrequency.Changes != 15 || stats.ChangeFrequency.ChangesPerDay != 0.5 {
+		t.Fatalf("unexpected change frequency %#v", stats.ChangeFrequency)
+	}
+	if len(stats.Daily) != worker.ChangeFrequencyWindowDays {
+		t.Fatalf("expected %d daily buckets, got %d", worker.ChangeFrequencyWindowDays, len(stats.Daily))
+	}
+	if last := stats.Daily[len(stats.Daily)-1]; last.Date != worker.ChangeDayKey(now) || last.Changes != 15 {
+		t.Fatalf("expected today's bucket last, got %#v", last)
+	}
+
+	req = httptest.NewRequest(http.MethodGet, "/v1/monitors/stats", nil)
+	rec = httptest.NewRecorder()
+	mux.ServeHTTP(rec, req)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected 200, got %d: %s", rec.Code, rec.Body.String())
+	}
+
+	var list []monitorStatsResponse
+	if err := json.Unmarshal(rec.Body.Bytes(), &list); err != nil {
+		t.Fatalf("expected stats list JSON: %v", err)
+	}
+	if len(list) != 2 || list[0].MonitorID != int64(noisy.ID) || list[1].MonitorID != int64(quiet.ID) {
+		t.Fatalf("expected noisiest monitor first, got %#v", list)
+	}
+
+	req = httptest.NewRequest(http.MethodGet, "/v1/monitors/999/stats", nil)
+	rec = httptest.NewRecorder()
+	mux.ServeHTTP(rec, req)
+	if rec.Code != http.StatusNotFound {
+		t.Fatalf("expected 404 for unknown monitor, got %d", rec.Code)
+	}
+}
+
+func TestSummarizeTimings(t *testing.T) {
+	fast, slow := 1.5, 4.5
+	summary := summarizeTimings([]*float64{&fast, nil, &slow})
+	if summary.Samples != 2 || summary.AvgMs != 3 || summary.MaxMs != 4.5 {
+		t.Fatalf("unexpected timing summary %#v", summary)
+	}
+
+	empty := summarizeTimings(nil)
+	if empty.Samples != 0 || empty.AvgMs != 0 || empty.MaxMs != 0 {
+		t.Fatalf("expected empty summary, got %#v", empty)
+	}
+}
+
+func TestHandleListMonitorStatsSortsByPerformance(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:monitor-stats-performance?mode=memory&cache=shared&_fk=1")
+	defer client.Close()
+
+	monitorIDs := make([]int, 0, 2)
+	for _, selectorMs := range []float64{2, 250} {
+		row, err := client.Monitor.Create().
+			SetURL("https://example.com/feed").
+			SetCron("*/5 * * * *").
+			Save(t.Context())
+		if err != nil {
+			t.Fatalf("expected monitor to save: %v", err)
+		}
+		if _, err := client.CheckResult.Create().
+			SetMonitor(row).
+			SetStatus("ok").
+			SetBodyReadMs(1).
+			SetSelectorMs(selectorMs).
+			Save(t.Context()); err != nil {
+			t.Fatalf("expected check to save: %v", err)
+		}
+		monitorIDs = append(monitorIDs, row.ID)
+	}
+
+	mux := http.NewServeMux()
+	New(client).RegisterRoutes(mux)
+
+	req := httptest.NewRequest(http.MethodGet, "/v1/monitors/stats?sort=performance", nil)
+	rec := httptest.NewRecorder()
+	mux.ServeHTTP(rec, req)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected 200, got %d: %s", rec.Code, rec.Body.String())
+	}
+
+	var list []monitorStatsResponse
+	if err := json.Unmarshal(rec.Body.Bytes(), &list); err != nil {
+		t.Fatalf("expected stats list JSON: %v", err)
+	}
+	if len(list) != 2 || list[0].MonitorID != int64(monitorIDs[1]) {
+		t.Fatalf("expected slowest monitor first, got %#v", list)
+	}
+	if list[0].Performance.Selector.MaxMs != 250 || list[0].Performance.Diff.Samples != 0 {
+		t.Fatalf("unexpected performance %#v", list[0].Performance)
+	}
+
+	req = httptest.NewRequest(http.MethodGet, "/v1/monitors/stats?sort=name", nil)
+	rec = httptest.NewRecorder()
+	mux.ServeHTTP(rec, req)
+	if rec.Code != http.StatusBadRequest {
+		t.Fatalf("expected 400 for unsupported sort, got %d", rec.Code)
+	}
+}
+
+func TestHandleMonitorStatsUptimeWindow(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:monitor-stats-uptime?mode=memory&cache=shared&_fk=1")
+	defer client.Close()
+
+	row, err := client.Monitor.Create().
+		SetURL("https://example.com/status").
+		SetCron("*/5 * * * *").
+		Save(t.Context())
+	if err != nil {
+		t.Fatalf("expected monitor to save: %v", err)
+	}
+
+	now := time.Now().UTC()
+	checks := []struct {
+		status         string
+		responseTimeMs int
+		age            time.Duration
+	}{
+		{"ok", 100, time.Hour},
+		{"ok", 200, 2 * time.Hour},
+		{"ok", 300, 3 * time.Hour},
+		{"error", 900, 4 * time.Hour},
+		{"error", 50, 3 * 24 * time.Hour},
+	}
+	for _, check := range checks {
+		if _, err := client.CheckResult.Create().
+			SetMonitor(row).
+			SetStatus(check.status).
+			SetResponseTimeMs(check.responseTimeMs).
+			SetCheckedAt(now.Add(-check.age)).
+			Save(t.Context()); err != nil {
+			t.Fatalf("expected check to save: %v", err)
+		}
+	}
+
+	mux := http.NewServeMux()
+	New(client).RegisterRoutes(mux)
+
+	getStats := func(query string) monitorStatsResponse {
+		t.Helper()
+		req := httptest.NewRequest(http.MethodGet, fmt.Sprintf("/v1/monitors/%d/stats%s", row.ID, query), nil)
+		rec := httptest.NewRecorder()
+		mux.ServeHTTP(rec, req)
+		if rec.Code != http.StatusOK {
+			t.Fatalf("expected 200, got %d: %s", rec.Code, rec.Body.String())
+		}
+		var stats monitorStatsResponse
+		if err := json.Unmarshal(rec.Body.Bytes(), &stats); err != nil {
+			t.Fatalf("expected stats JSON: %v", err)
+		}
+		if stats.Uptime == nil {
+			t.Fatal("expected uptime stats")
+		}
+		return stats
+	}
+
+	day := getStats("").Uptime
+	if day.Window != "24h" || day.Checks != 4 || day.Successes != 3 || day.Errors != 1 {
+		t.Fatalf("unexpected 24h uptime %#v", day)
+	}
+	if day.UptimePercent == nil || *day.UptimePercent != 75 {
+		t.Fatalf("expected 75%% uptime, got %v", day.UptimePercent)
+	}
+	if day.Latency.Samples != 4 || *day.Latency.P50Ms != 200 || *day.Latency.P95Ms != 900 || *day.Latency.MaxMs != 900 {
+		t.Fatalf("unexpected 24h latency %#v", day.Latency)
+	}
+
+	week := getStats("?window=7d").Uptime
+	if week.Checks != 5 || week.Errors != 2 || *week.UptimePercent != 60 {
+		t.Fatalf("unexpected 7d uptime %#v", week)
+	}
+
+	req := httptest.NewRequest(http.MethodGet, fmt.Sprintf("/v1/monitors/%d/stats?window=1y", row.ID), nil)
+	rec := httptest.NewRecorder()
+	mux.ServeHTTP(rec, req)
+	if rec.Code != http.StatusBadRequest {
+		t.Fatalf("expected 400 for unsupported window, got %d", rec.Code)
+	}
+}
+
+func TestSummarizeLatencies(t *testing.T) {
+	latencies := make([]int, 0, 100)
+	for i := 100; i >= 1; i-- {
+		latencies = append(latencies, i)
+	}
+	summary := summarizeLatencies(latencies)
+	if summary.Samples != 100 || *summary.P50Ms != 50 || *summary.P95Ms != 95 || *summary.MaxMs != 100 {
+		t.Fatalf("unexpected latency summary %#v", summary)
+	}
+
+	if empty := summarizeLatencies(nil); empty.Samples != 0 || empty.P50Ms != nil || empty.MaxMs != nil {
+		t.Fatalf("expected empty summary, got %#v", empty)
+	}
+}
+
+func TestHandleMonitorRollups(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:monitor-rollups?mode=memory&cache=shared&_fk=1")
+	defer client.Close()
+
+	row, err := client.Monitor.Create().SetURL("https://example.com").SetCron("*/5 * * * *").Save(t.Context())
+	if err != nil {
+		t.Fatalf("expected monitor to save: %v", err)
+	}
+	today := worker.RollupBucketStart(checkrollup.PeriodDay, time.Now())
+	maxMs := 300
+	for offset, checks := range []int{4, 2} {
+		if _, err := client.CheckRollup.Create().
+			SetMonitorID(row.ID).
+			SetPeriod(checkrollup.PeriodDay).
+			SetBucketStart(today.AddDate(0, 0, -offset)).
+			SetChecks(checks).
+			SetSuccesses(checks - 1).
+			SetErrors(1).
+			SetLatencySamples(2).
+			SetLatencyTotalMs(400).
+			SetLatencyMaxMs(maxMs).
+			SetLatencyHistogram(map[string]int{"100": 1, "312": 1}).
+			Save(t.Context()); err != nil {
+			t.Fatalf("expected rollup to save: %v", err)
+		}
+	}
+
+	mux := http.NewServeMux()
+	New(client).RegisterRoutes(mux)
+
+	rec := httptest.NewRecorder()
+	mux.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, fmt.Sprintf("/v1/monitors/%d/stats/rollups?period=day", row.ID), nil))
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected 200, got %d: %s", rec.Code, rec.Body.String())
+	}
+	var response monitorRollupsResponse
+	if err := json.Unmarshal(rec.Body.Bytes(), &response); err != nil {
+		t.Fatalf("expected rollups JSON: %v", err)
+	}
+	if response.Period != "day" || len(response.Buckets) != 2 || response.Buckets[0].Checks != 2 {
+		t.Fatalf("expected two daily buckets oldest first, got %+v", response)
+	}
+	latest := response.Buckets[1]
+	if latest.UptimePercent == nil || *latest.UptimePercent != 75 || latest.AvgLatencyMs == nil || *latest.AvgLatencyMs != 200 {
+		t.Fatalf("unexpected bucket summary %+v", latest)
+	}
+	if latest.P95LatencyMs == nil || *latest.P95LatencyMs != maxMs {
+		t.Fatalf("expected p95 capped at the max latency, got %v", latest.P95LatencyMs)
+	}
+
+	for path, code := range map[string]int{
+		fmt.Sprintf("/v1/monitors/%d/stats/rollups?period=week", row.ID):    http.StatusBadRequest,
+		fmt.Sprintf("/v1/monitors/%d/stats/rollups?from=yesterday", row.ID): http.StatusBadRequest,
+		"/v1/monitors/999/stats/rollups":                                    http.StatusNotFound,
+	} {
+		rec := httptest.NewRecorder()
+		mux.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, path, nil))
+		if rec.Code != code {
+			t.Fatalf("%s: expected %d, got %d", path, code, rec.Code)
+		}
+	}
+}
+
+func TestHandleListMonitorsIncludesRecentChecks(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:monitor-list-recent?mode=memory&cache=shared&_fk=1")
+	defer client.Close()
+
+	checked, err := client.Monitor.Create().
+		SetURL("https://example.com/status").
+		SetCron("*/5 * * * *").
+		Save(t.Context())
+	if err != nil {
+		t.Fatalf("expected monitor to save: %v", err)
+	}
+	idle, err := client.Monitor.Create().
+		SetURL("https://example.com/idle").
+		SetCron("*/5 * * * *").
+		Save(t.Context())
+	if err != nil {
+		t.Fatalf("expected monitor to save: %v", err)
+	}
+
+	now := time.Now().UTC()
+	for _, check := range []struct {
+		status string
+		age    time.Duration
+	}{
+		{"ok", time.Hour},
+		{"ok", 2 * time.Hour},
+		{"ok", 3 * time.Hour},
+		{"error", 4 * time.Hour},
+		{"error", 3 * 24 * time.Hour},
+	} {
+		if _, err := client.CheckResult.Create().
+			SetMonitor(checked).
+			SetStatus(check.status).
+			SetCheckedAt(now.Add(-check.age)).
+			Save(t.Context()); err != nil {
+			t.Fatalf("expected check to save: %v", err)
+		}
+	}
+
+	mux := http.NewServeMux()
+	New(client).RegisterRoutes(mux)
+
+	req := httptest.NewRequest(http.MethodGet, "/v1/monitors", nil)
+	rec := httptest.NewRecorder()
+	mux.ServeHTTP(rec, req)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected 200, got %d: %s", rec.Code, rec.Body.String())
+	}
+
+	var monitors []monitorResponse
+	if err := json.Unmarshal(rec.Body.Bytes(), &monitors); err != nil {
+		t.Fatalf("expected monitors JSON: %v", err)
+	}
+	recent := map[int64]*recentChecksResponse{}
+	for _, item := range monitors {
+		recent[item.ID] = item.Recent
+	}
+
+	got := recent[int64(checked.ID)]
+	if got == nil || got.Checks != 4 || got.Errors != 1 || got.UptimePercent == nil || *got.UptimePercent != 75 {
+		t.Fatalf("unexpected recent checks %#v", got)
+	}
+	if got := recent[int64(idle.ID)]; got == nil || got.Checks != 0 || got.UptimePercent != nil {
+		t.Fatalf("expected no recent checks for the idle monitor, got %#v", got)
+	}
+}
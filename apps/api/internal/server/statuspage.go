@@ -0,0 +1,197 @@
+package server
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"slices"
+	"strconv"
+	"sync"
+	"time"
+
+	"goanna/apps/api/ent"
+	"goanna/apps/api/ent/checkrollup"
+	"goanna/apps/api/ent/monitor"
+	"goanna/apps/api/internal/worker"
+)
+
+// Overall states reported by the status page.
+const (
+	statusPageOperational = "operational"
+	statusPageDegraded    = "degraded"
+	statusPageDown        = "down"
+)
+
+type statusPageResponse struct {
+	Status      string                      `json:"status"`
+	GeneratedAt time.Time                   `json:"generatedAt"`
+	Monitors    []statusPageMonitorResponse `json:"monitors"`
+}
+
+// statusPageMonitorResponse is the public view of a monitor. It leaves out
+// the URL and configuration, which may carry credentials.
+type statusPageMonitorResponse struct {
+	ID          int64      `json:"id"`
+	Name        string     `json:"name"`
+	Status      string     `json:"status"`
+	LastCheckAt *time.Time `json:"lastCheckAt,omitempty"`
+	Uptime24h   *float64   `json:"uptime24h,omitempty"`
+	Uptime7d    *float64   `json:"uptime7d,omitempty"`
+	Uptime30d   *float64   `json:"uptime30d,omitempty"`
+	BadgeURL    string     `json:"badgeUrl"`
+}
+
+// handleGetStatusPage lists the enabled, unarchived monitors marked statusPage with their
+// current status and recent uptime. It needs no authentication so it can
+// back a public status page.
+func (s *Server) handleGetStatusPage(w http.ResponseWriter, r *http.Request) {
+	rows, err := s.db.Monitor.Query().
+		Where(monitor.StatusPageEQ(true), monitor.EnabledEQ(true), monitor.ArchivedAtIsNil()).
+		WithRuntime().
+		Order(ent.Asc(monitor.FieldLabel), ent.Asc(monitor.FieldID)).
+		All(r.Context())
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "failed to load status page")
+		return
+	}
+
+	now := time.Now().UTC()
+	monitorIDs := make([]int, 0, len(rows))
+	for _, row := range rows {
+		monitorIDs = append(monitorIDs, row.ID)
+	}
+	uptime, err := s.loadStatusPageUptime(r.Context(), monitorIDs, now)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "failed to load status page")
+		return
+	}
+
+	response := statusPageResponse{
+		GeneratedAt: now,
+		Monitors:    make([]statusPageMonitorResponse, 0, len(rows)),
+	}
+	for _, row := range rows {
+		mapped := s.mapMonitor(row, row.Edges.Runtime, nil)
+		entry := statusPageMonitorResponse{
+			ID:          mapped.ID,
+			Name:        statusPageName(row),
+			Status:      mapped.Status,
+			LastCheckAt: mapped.LastCheckAt,
+			Uptime24h:   uptime[row.ID].Uptime24h,
+			Uptime7d:    uptime[row.ID].Uptime7d,
+			Uptime30d:   uptime[row.ID].Uptime30d,
+			BadgeURL:    s.link("/v1/monitors/" + strconv.Itoa(row.ID) + "/badge.svg"),
+		}
+		response.Monitors = append(response.Monitors, entry)
+	}
+	response.Status = statusPageOverall(response.Monitors)
+
+	writeJSON(w, http.StatusOK, response)
+}
+
+// statusPageUptimeTTL is how long status page uptime is reused before the
+// rollups are read again.
+const statusPageUptimeTTL = 30 * time.Second
+
+type statusPageUptime struct {
+	Uptime24h *float64
+	Uptime7d  *float64
+	Uptime30d *float64
+}
+
+// statusPageUptimeCache keeps the uptime of the listed monitors for
+// statusPageUptimeTTL, so requests to the public status page do not each
+// aggregate a month of rollups.
+type statusPageUptimeCache struct {
+	mu         sync.Mutex
+	loadedAt   time.Time
+	monitorIDs []int
+	uptime     map[int]statusPageUptime
+}
+
+// loadStatusPageUptime returns the share of ok checks of each monitor over
+// each stats window, summed from hourly rollups. Windows cover the whole
+// hours they touch.
+func (s *Server) loadStatusPageUptime(ctx context.Context, monitorIDs []int, now time.Time) (map[int]statusPageUptime, error) {
+	cache := s.statusPageUptime
+	cache.mu.Lock()
+	defer cache.mu.Unlock()
+
+	if cache.uptime != nil && now.Sub(cache.loadedAt) < statusPageUptimeTTL && slices.Equal(cache.monitorIDs, monitorIDs) {
+		return cache.uptime, nil
+	}
+
+	uptime := make(map[int]statusPageUptime, len(monitorIDs))
+	for _, window := range []string{"24h", "7d", "30d"} {
+		var rows []struct {
+			MonitorID int `json:"monitor_id"`
+			Checks    int `json:"checks"`
+			Successes int `json:"successes"`
+		}
+		err := s.db.CheckRollup.Query().
+			Where(
+				checkrollup.MonitorIDIn(monitorIDs...),
+				checkrollup.PeriodEQ(checkrollup.PeriodHour),
+				checkrollup.BucketStartGTE(worker.RollupBucketStart(checkrollup.PeriodHour, now.Add(-statsWindows[window]))),
+				checkrollup.BucketStartLTE(now),
+			).
+			GroupBy(checkrollup.FieldMonitorID).
+			Aggregate(
+				ent.As(ent.Sum(checkrollup.FieldChecks), "checks"),
+				ent.As(ent.Sum(checkrollup.FieldSuccesses), "successes"),
+			).
+			Scan(ctx, &rows)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, row := range rows {
+			entry := uptime[row.MonitorID]
+			percent := uptimePercent(row.Checks, row.Successes)
+			switch window {
+			case "24h":
+				entry.Uptime24h = percent
+			case "7d":
+				entry.Uptime7d = percent
+			case "30d":
+				entry.Uptime30d = percent
+			}
+			uptime[row.MonitorID] = entry
+		}
+	}
+
+	cache.loadedAt = now
+	cache.monitorIDs = monitorIDs
+	cache.uptime = uptime
+	return uptime, nil
+}
+
+// statusPageName is the monitor's label, or its host when it has none.
+func statusPageName(row *ent.Monitor) string {
+	if row.Label != nil && *row.Label != "" {
+		return *row.Label
+	}
+	if parsed, err := url.Parse(row.URL); err == nil && parsed.Hostname() != "" {
+		return parsed.Hostname()
+	}
+	return "Monitor " + strconv.Itoa(row.ID)
+}
+
+// statusPageOverall is operational while no listed monitor is failing, down
+// when every one is, and degraded otherwise.
+func statusPageOverall(monitors []statusPageMonitorResponse) string {
+	failing := 0
+	for _, entry := range monitors {
+		if entry.Status == "error" || entry.Status == "retrying" {
+			failing++
+		}
+	}
+	switch {
+	case failing == 0:
+		return statusPageOperational
+	case failing == len(monitors):
+		return statusPageDown
+	default:
+		return statusPageDegraded
+	}
+}
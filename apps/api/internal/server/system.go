@@ -0,0 +1,91 @@
+package server
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"time"
+
+	"goanna/apps/api/ent"
+)
+
+type pauseSystemRequest struct {
+	Notifications *bool `json:"notifications"`
+}
+
+type systemStateResponse struct {
+	Paused              bool       `json:"paused"`
+	NotificationsPaused bool       `json:"notificationsPaused"`
+	PausedAt            *time.Time `json:"pausedAt,omitempty"`
+}
+
+func (s *Server) handleGetSystemState(w http.ResponseWriter, r *http.Request) {
+	config, err := s.ensureGlobalSystemConfig(r.Context())
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "failed to load system state")
+		return
+	}
+
+	writeJSON(w, http.StatusOK, mapSystemState(config))
+}
+
+func (s *Server) handlePauseSystem(w http.ResponseWriter, r *http.Request) {
+	var req pauseSystemRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil && !errors.Is(err, io.EOF) {
+		writeError(w, http.StatusBadRequest, "invalid JSON body")
+		return
+	}
+
+	config, err := s.ensureGlobalSystemConfig(r.Context())
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "failed to load system state")
+		return
+	}
+
+	notificationsPaused := req.Notifications != nil && *req.Notifications
+	update := s.db.SystemConfig.UpdateOneID(config.ID).
+		SetPaused(true).
+		SetNotificationsPaused(notificationsPaused)
+	if !config.Paused || config.PausedAt == nil {
+		update = update.SetPausedAt(time.Now().UTC())
+	}
+
+	updated, err := update.Save(r.Context())
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "failed to pause system")
+		return
+	}
+	s.scheduleChanges.Publish()
+
+	writeJSON(w, http.StatusOK, mapSystemState(updated))
+}
+
+func (s *Server) handleResumeSystem(w http.ResponseWriter, r *http.Request) {
+	config, err := s.ensureGlobalSystemConfig(r.Context())
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "failed to load system state")
+		return
+	}
+
+	updated, err := s.db.SystemConfig.UpdateOneID(config.ID).
+		SetPaused(false).
+		SetNotificationsPaused(false).
+		ClearPausedAt().
+		Save(r.Context())
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "failed to resume system")
+		return
+	}
+	s.scheduleChanges.Publish()
+
+	writeJSON(w, http.StatusOK, mapSystemState(updated))
+}
+
+func mapSystemState(config *ent.SystemConfig) systemStateResponse {
+	return systemStateResponse{
+		Paused:              config.Paused,
+		NotificationsPaused: config.NotificationsPaused,
+		PausedAt:            config.PausedAt,
+	}
+}
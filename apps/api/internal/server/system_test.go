@@ -0,0 +1,130 @@
+package server
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"goanna/apps/api/ent/enttest"
+	"goanna/apps/api/internal/worker"
+
+	_ "github.com/mattn/go-sqlite3"
+)
+
+func TestPauseAndResumeSystemSurfacesInHealth(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:system-pause?mode=memory&cache=shared&_fk=1")
+	defer client.Close()
+
+	mux := http.NewServeMux()
+	New(client).RegisterRoutes(mux)
+
+	pauseReq := httptest.NewRequest(http.MethodPost, "/v1/system/pause", strings.NewReader(`{"notifications":true}`))
+	pauseRecorder := httptest.NewRecorder()
+	mux.ServeHTTP(pauseRecorder, pauseReq)
+
+	if pauseRecorder.Code != http.StatusOK {
+		t.Fatalf("expected status 200, got %d: %s", pauseRecorder.Code, pauseRecorder.Body.String())
+	}
+
+	var paused systemStateResponse
+	if err := json.NewDecoder(pauseRecorder.Body).Decode(&paused); err != nil {
+		t.Fatalf("expected JSON response, got %v", err)
+	}
+	if !paused.Paused || !paused.NotificationsPaused || paused.PausedAt == nil {
+		t.Fatalf("expected paused state with notifications paused, got %#v", paused)
+	}
+
+	health := getHealth(t, mux)
+	if !health.Paused {
+		t.Fatal("expected health to report paused")
+	}
+
+	resumeReq := httptest.NewRequest(http.MethodPost, "/v1/system/resume", nil)
+	resumeRecorder := httptest.NewRecorder()
+	mux.ServeHTTP(resumeRecorder, resumeReq)
+
+	if resumeRecorder.Code != http.StatusOK {
+		t.Fatalf("expected status 200, got %d: %s", resumeRecorder.Code, resumeRecorder.Body.String())
+	}
+
+	var resumed systemStateResponse
+	if err := json.NewDecoder(resumeRecorder.Body).Decode(&resumed); err != nil {
+		t.Fatalf("expected JSON response, got %v", err)
+	}
+	if resumed.Paused || resumed.NotificationsPaused || resumed.PausedAt != nil {
+		t.Fatalf("expected resumed state, got %#v", resumed)
+	}
+
+	health = getHealth(t, mux)
+	if health.Paused {
+		t.Fatal("expected health to report resumed")
+	}
+}
+
+func TestPauseSystemAcceptsEmptyBody(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:system-pause-empty?mode=memory&cache=shared&_fk=1")
+	defer client.Close()
+
+	mux := http.NewServeMux()
+	New(client).RegisterRoutes(mux)
+
+	req := httptest.NewRequest(http.MethodPost, "/v1/system/pause", nil)
+	recorder := httptest.NewRecorder()
+	mux.ServeHTTP(recorder, req)
+
+	if recorder.Code != http.StatusOK {
+		t.Fatalf("expected status 200, got %d: %s", recorder.Code, recorder.Body.String())
+	}
+
+	var state systemStateResponse
+	if err := json.NewDecoder(recorder.Body).Decode(&state); err != nil {
+		t.Fatalf("expected JSON response, got %v", err)
+	}
+	if !state.Paused || state.NotificationsPaused {
+		t.Fatalf("expected scheduling-only pause, got %#v", state)
+	}
+}
+
+func getHealth(t *testing.T, mux *http.ServeMux) healthResponse {
+	t.Helper()
+
+	recorder := httptest.NewRecorder()
+	mux.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/healthz", nil))
+	if recorder.Code != http.StatusOK {
+		t.Fatalf("expected status 200, got %d", recorder.Code)
+	}
+
+	var response healthResponse
+	if err := json.NewDecoder(recorder.Body).Decode(&response); err != nil {
+		t.Fatalf("expected JSON response, got %v", err)
+	}
+	return response
+}
+
+func TestTestMonitorURLEnforcesNetworkGuard(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:system-network-guard?mode=memory&cache=shared&_fk=1")
+	defer client.Close()
+
+	target := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"ok":true}`))
+	}))
+	defer target.Close()
+
+	guard, err := worker.NewNetworkGuard(worker.DefaultBlockedNetworks, nil)
+	if err != nil {
+		t.Fatalf("expected guard to parse: %v", err)
+	}
+
+	mux := http.NewServeMux()
+	NewWithConfig(client, Config{Worker: worker.Config{NetworkGuard: guard}}).RegisterRoutes(mux)
+
+	req := httptest.NewRequest(http.MethodPost, "/v1/monitors/test", strings.NewReader(`{"url":"`+target.URL+`"}`))
+	recorder := httptest.NewRecorder()
+	mux.ServeHTTP(recorder, req)
+
+	if recorder.Code != http.StatusForbidden {
+		t.Fatalf("expected status 403, got %d: %s", recorder.Code, recorder.Body.String())
+	}
+}
@@ -0,0 +1,126 @@
+package server
+
+import (
+	"net/http"
+	"slices"
+	"sort"
+	"strings"
+
+	"goanna/apps/api/ent"
+	"goanna/apps/api/ent/monitor"
+)
+
+type tagSummaryResponse struct {
+	Tag          string         `json:"tag"`
+	MonitorCount int            `json:"monitorCount"`
+	EnabledCount int            `json:"enabledCount"`
+	StatusCounts map[string]int `json:"statusCounts"`
+}
+
+// handleListTags summarizes every tag in use, for dashboards grouping
+// monitors by project or environment.
+func (s *Server) handleListTags(w http.ResponseWriter, r *http.Request) {
+	rows, err := s.db.Monitor.Query().
+		Where(monitor.ArchivedAtIsNil()).
+		WithRuntime().
+		All(r.Context())
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "failed to list tags")
+		return
+	}
+
+	summaries := map[string]*tagSummaryResponse{}
+	for _, row := range rows {
+		for _, tag := range row.Tags {
+			summary, ok := summaries[tag]
+			if !ok {
+				summary = newTagSummary(tag)
+				summaries[tag] = summary
+			}
+			summary.add(row)
+		}
+	}
+
+	response := make([]tagSummaryResponse, 0, len(summaries))
+	for _, summary := range summaries {
+		response = append(response, *summary)
+	}
+	sort.Slice(response, func(i, j int) bool {
+		return response[i].Tag < response[j].Tag
+	})
+
+	writeJSON(w, http.StatusOK, response)
+}
+
+func (s *Server) handleGetTag(w http.ResponseWriter, r *http.Request) {
+	tag := strings.ToLower(strings.TrimSpace(r.PathValue("tag")))
+	if tag == "" {
+		writeError(w, http.StatusBadRequest, "tag is required")
+		return
+	}
+
+	rows, err := s.db.Monitor.Query().
+		Where(monitor.ArchivedAtIsNil()).
+		WithRuntime().
+		All(r.Context())
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "failed to load tag")
+		return
+	}
+
+	summary := newTagSummary(tag)
+	for _, row := range rows {
+		if slices.Contains(row.Tags, tag) {
+			summary.add(row)
+		}
+	}
+	if summary.MonitorCount == 0 {
+		writeError(w, http.StatusNotFound, "tag not found")
+		return
+	}
+
+	writeJSON(w, http.StatusOK, summary)
+}
+
+func newTagSummary(tag string) *tagSummaryResponse {
+	return &tagSummaryResponse{Tag: tag, StatusCounts: map[string]int{}}
+}
+
+func (t *tagSummaryResponse) add(row *ent.Monitor) {
+	t.MonitorCount++
+	if row.Enabled {
+		t.EnabledCount++
+	}
+
+	status := "pending"
+	if row.Edges.Runtime != nil {
+		status = row.Edges.Runtime.Status.String()
+	}
+	t.StatusCounts[status]++
+}
+
+// parseMonitorTagFilter returns the normalized tags a monitor list must
+// match; repeated tag parameters narrow the list to monitors with all of them.
+func parseMonitorTagFilter(r *http.Request) []string {
+	return normalizeTagFilter(r.URL.Query()["tag"])
+}
+
+func normalizeTagFilter(values []string) []string {
+	tags := make([]string, 0)
+	for _, raw := range values {
+		tag := strings.ToLower(strings.TrimSpace(raw))
+		if tag != "" && !slices.Contains(tags, tag) {
+			tags = append(tags, tag)
+		}
+	}
+	return tags
+}
+
+func hasAllTags(row *ent.Monitor, tags []string) bool {
+	for _, tag := range tags {
+		if !slices.Contains(row.Tags, tag) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,76 @@
+package server
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"goanna/apps/api/ent/enttest"
+	"goanna/apps/api/ent/monitorruntime"
+
+	_ "github.com/mattn/go-sqlite3"
+)
+
+func TestTagSummariesAndMonitorTagFilter(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:monitor-tags?mode=memory&cache=shared&_fk=1")
+	defer client.Close()
+
+	seed := func(tags []string, enabled bool, status monitorruntime.Status) {
+		row, err := client.Monitor.Create().
+			SetURL("https://example.com").
+			SetCron("*/5 * * * *").
+			SetTags(tags).
+			SetEnabled(enabled).
+			Save(t.Context())
+		if err != nil {
+			t.Fatalf("expected monitor to save: %v", err)
+		}
+		if _, err := client.MonitorRuntime.Create().SetMonitor(row).SetStatus(status).Save(t.Context()); err != nil {
+			t.Fatalf("expected runtime to save: %v", err)
+		}
+	}
+	seed([]string{"shop", "prod"}, true, monitorruntime.StatusOk)
+	seed([]string{"shop", "staging"}, true, monitorruntime.StatusError)
+	seed([]string{"shop", "prod"}, false, monitorruntime.StatusDisabled)
+	seed(nil, true, monitorruntime.StatusOk)
+
+	mux := http.NewServeMux()
+	New(client).RegisterRoutes(mux)
+
+	get := func(path string) *httptest.ResponseRecorder {
+		rec := httptest.NewRecorder()
+		mux.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, path, nil))
+		return rec
+	}
+
+	var summaries []tagSummaryResponse
+	if err := json.Unmarshal(get("/v1/tags").Body.Bytes(), &summaries); err != nil {
+		t.Fatalf("expected tags JSON: %v", err)
+	}
+	if len(summaries) != 3 || summaries[0].Tag != "prod" || summaries[1].Tag != "shop" || summaries[2].Tag != "staging" {
+		t.Fatalf("expected prod, shop and staging tags, got %+v", summaries)
+	}
+	shop := summaries[1]
+	if shop.MonitorCount != 3 || shop.EnabledCount != 2 ||
+		shop.StatusCounts["ok"] != 1 || shop.StatusCounts["error"] != 1 || shop.StatusCounts["disabled"] != 1 {
+		t.Fatalf("unexpected shop summary %+v", shop)
+	}
+
+	rec := get("/v1/tags/PROD")
+	var prod tagSummaryResponse
+	if err := json.Unmarshal(rec.Body.Bytes(), &prod); err != nil || rec.Code != http.StatusOK || prod.MonitorCount != 2 {
+		t.Fatalf("expected prod summary, got %d %s", rec.Code, rec.Body.String())
+	}
+	if rec := get("/v1/tags/unused"); rec.Code != http.StatusNotFound {
+		t.Fatalf("expected 404 for unused tag, got %d", rec.Code)
+	}
+
+	var monitors []monitorResponse
+	if err := json.Unmarshal(get("/v1/monitors?tag=shop&tag=prod").Body.Bytes(), &monitors); err != nil {
+		t.Fatalf("expected monitors JSON: %v", err)
+	}
+	if len(monitors) != 2 {
+		t.Fatalf("expected two monitors tagged shop and prod, got %d", len(monitors))
+	}
+}
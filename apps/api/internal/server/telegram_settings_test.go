@@ -157,3 +157,38 @@ func TestHandleUpsertTelegramSettingsRejectsClearingWhenEnabled(t *testing.T) {
 		t.Fatalf("expected status 400, got %d", recorder.Code)
 	}
 }
+
+func TestTelegramSettingsMaskBotToken(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:telegram-mask-token?mode=memory&cache=shared&_fk=1")
+	defer client.Close()
+
+	mux := http.NewServeMux()
+	New(client).RegisterRoutes(mux)
+	put := func(body string) *httptest.ResponseRecorder {
+		recorder := httptest.NewRecorder()
+		mux.ServeHTTP(recorder, httptest.NewRequest(http.MethodPut, "/v1/settings/notifications/telegram", strings.NewReader(body)))
+		return recorder
+	}
+
+	recorder := put(`{"botToken":"123456:secret-token","chatId":"chat"}`)
+	var response telegramSettingsResponse
+	if err := json.NewDecoder(recorder.Body).Decode(&response); err != nil {
+		t.Fatalf("expected JSON response, got %v", err)
+	}
+	if response.BotToken != "••••oken" {
+		t.Fatalf("expected a masked token, got %q", response.BotToken)
+	}
+
+	// Saving the form again with the masked token keeps the stored one.
+	if recorder := put(`{"botToken":"••••oken","chatId":"other"}`); recorder.Code != http.StatusOK {
+		t.Fatalf("expected status 200, got %d: %s", recorder.Code, recorder.Body.String())
+	}
+	channel := client.NotificationChannel.Query().OnlyX(t.Context())
+	if channel.BotToken != "123456:secret-token" || channel.ChatID != "other" {
+		t.Fatalf("expected the stored token to be kept, got %+v", channel)
+	}
+
+	if recorder := put(`{"botToken":"••••1234","chatId":"other"}`); recorder.Code != http.StatusBadRequest {
+		t.Fatalf("expected a mismatched mask to be rejected, got %d", recorder.Code)
+	}
+}
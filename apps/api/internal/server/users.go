@@ -0,0 +1,319 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"goanna/apps/api/ent"
+	"goanna/apps/api/ent/session"
+	"goanna/apps/api/ent/user"
+)
+
+const (
+	maxUsernameLength = 64
+	minPasswordLength = 8
+	maxPasswordLength = 256
+)
+
+type userResponse struct {
+	ID        int       `json:"id"`
+	Username  string    `json:"username"`
+	Role      string    `json:"role"`
+	CreatedAt time.Time `json:"createdAt"`
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+type createUserRequest struct {
+	Username string  `json:"username"`
+	Password string  `json:"password"`
+	Role     *string `json:"role"`
+}
+
+type updateUserRequest struct {
+	Password *string `json:"password"`
+	Role     *string `json:"role"`
+}
+
+func (s *Server) handleListUsers(w http.ResponseWriter, r *http.Request) {
+	rows, err := s.db.User.Query().
+		Order(ent.Asc(user.FieldUsername)).
+		All(r.Context())
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "failed to load users")
+		return
+	}
+
+	response := make([]userResponse, 0, len(rows))
+	for _, row := range rows {
+		response = append(response, mapUser(row))
+	}
+
+	writeJSON(w, http.StatusOK, response)
+}
+
+// handleCreateUser adds an account. While no users exist anyone may create
+// the first one, which must be an admin and turns authentication on.
+func (s *Server) handleCreateUser(w http.ResponseWriter, r *http.Request) {
+	var req createUserRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid JSON body")
+		return
+	}
+
+	username := normalizeUsername(req.Username)
+	if err := validateUsername(username); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	if err := validatePassword(req.Password); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	first := currentUser(r.Context()) == nil
+	role := user.RoleViewer
+	if first {
+		role = user.RoleAdmin
+	}
+	if req.Role != nil {
+		parsed, err := parseUserRole(*req.Role)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		role = parsed
+	}
+	if first && role != user.RoleAdmin {
+		writeError(w, http.StatusBadRequest, "the first user must be an admin")
+		return
+	}
+
+	passwordHash, err := hashPassword(req.Password)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "failed to create user")
+		return
+	}
+
+	created, err := s.db.User.Create().
+		SetUsername(username).
+		SetPasswordHash(passwordHash).
+		SetRole(role).
+		Save(r.Context())
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			writeError(w, http.StatusConflict, "username is already taken")
+			return
+		}
+		writeError(w, http.StatusInternalServerError, "failed to create user")
+		return
+	}
+
+	writeJSON(w, http.StatusCreated, mapUser(created))
+}
+
+// handleUpdateUser changes a user's role or password. A new password revokes
+// the user's sessions, and the last admin cannot be demoted.
+func (s *Server) handleUpdateUser(w http.ResponseWriter, r *http.Request) {
+	userID, err := parseUserID(r.PathValue("userId"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	var req updateUserRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid JSON body")
+		return
+	}
+
+	existing, err := s.db.User.Get(r.Context(), userID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			writeError(w, http.StatusNotFound, "user not found")
+			return
+		}
+		writeError(w, http.StatusInternalServerError, "failed to load user")
+		return
+	}
+
+	tx, err := s.db.Tx(r.Context())
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "failed to update user")
+		return
+	}
+
+	update := tx.User.UpdateOneID(userID)
+	if req.Role != nil {
+		role, err := parseUserRole(*req.Role)
+		if err != nil {
+			_ = tx.Rollback()
+			writeError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		if existing.Role == user.RoleAdmin && role != user.RoleAdmin {
+			if last, err := isLastAdmin(r.Context(), tx.Client(), userID); err != nil || last {
+				_ = tx.Rollback()
+				writeLastAdminError(w, err)
+				return
+			}
+		}
+		update = update.SetRole(role)
+	}
+	if req.Password != nil {
+		if err := validatePassword(*req.Password); err != nil {
+			_ = tx.Rollback()
+			writeError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		passwordHash, err := hashPassword(*req.Password)
+		if err != nil {
+			_ = tx.Rollback()
+			writeError(w, http.StatusInternalServerError, "failed to update user")
+			return
+		}
+		update = update.SetPasswordHash(passwordHash)
+		if _, err := tx.Session.Delete().Where(session.UserIDEQ(userID)).Exec(r.Context()); err != nil {
+			_ = tx.Rollback()
+			writeError(w, http.StatusInternalServerError, "failed to update user")
+			return
+		}
+	}
+
+	updated, err := update.Save(r.Context())
+	if err != nil {
+		_ = tx.Rollback()
+		writeError(w, http.StatusInternalServerError, "failed to update user")
+		return
+	}
+	if err := tx.Commit(); err != nil {
+		writeError(w, http.StatusInternalServerError, "failed to update user")
+		return
+	}
+
+	writeJSON(w, http.StatusOK, mapUser(updated))
+}
+
+// handleDeleteUser removes a user and their sessions. The last admin cannot
+// be deleted.
+func (s *Server) handleDeleteUser(w http.ResponseWriter, r *http.Request) {
+	userID, err := parseUserID(r.PathValue("userId"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	tx, err := s.db.Tx(r.Context())
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "failed to delete user")
+		return
+	}
+
+	existing, err := tx.User.Get(r.Context(), userID)
+	if err != nil {
+		_ = tx.Rollback()
+		if ent.IsNotFound(err) {
+			writeError(w, http.StatusNotFound, "user not found")
+			return
+		}
+		writeError(w, http.StatusInternalServerError, "failed to load user")
+		return
+	}
+	if existing.Role == user.RoleAdmin {
+		if last, err := isLastAdmin(r.Context(), tx.Client(), userID); err != nil || last {
+			_ = tx.Rollback()
+			writeLastAdminError(w, err)
+			return
+		}
+	}
+
+	if _, err := tx.Session.Delete().Where(session.UserIDEQ(userID)).Exec(r.Context()); err != nil {
+		_ = tx.Rollback()
+		writeError(w, http.StatusInternalServerError, "failed to delete user")
+		return
+	}
+	if err := tx.User.DeleteOneID(userID).Exec(r.Context()); err != nil {
+		_ = tx.Rollback()
+		writeError(w, http.StatusInternalServerError, "failed to delete user")
+		return
+	}
+	if err := tx.Commit(); err != nil {
+		writeError(w, http.StatusInternalServerError, "failed to delete user")
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func isLastAdmin(ctx context.Context, client *ent.Client, userID int) (bool, error) {
+	others, err := client.User.Query().
+		Where(user.RoleEQ(user.RoleAdmin), user.IDNEQ(userID)).
+		Exist(ctx)
+	if err != nil {
+		return false, err
+	}
+	return !others, nil
+}
+
+func writeLastAdminError(w http.ResponseWriter, err error) {
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "failed to load users")
+		return
+	}
+	writeError(w, http.StatusConflict, "at least one admin must remain")
+}
+
+func normalizeUsername(raw string) string {
+	return strings.ToLower(strings.TrimSpace(raw))
+}
+
+func validateUsername(username string) error {
+	if username == "" || len(username) > maxUsernameLength {
+		return fmt.Errorf("username must be between 1 and %d characters", maxUsernameLength)
+	}
+	for _, char := range username {
+		if (char < 'a' || char > 'z') && (char < '0' || char > '9') && !strings.ContainsRune("._-@", char) {
+			return errors.New("username may only contain letters, digits and . _ - @")
+		}
+	}
+	return nil
+}
+
+func validatePassword(password string) error {
+	if len(password) < minPasswordLength || len(password) > maxPasswordLength {
+		return fmt.Errorf("password must be between %d and %d characters", minPasswordLength, maxPasswordLength)
+	}
+	return nil
+}
+
+func parseUserRole(raw string) (user.Role, error) {
+	role := user.Role(strings.ToLower(strings.TrimSpace(raw)))
+	if err := user.RoleValidator(role); err != nil {
+		return "", errors.New("role must be one of: admin, viewer")
+	}
+	return role, nil
+}
+
+func parseUserID(raw string) (int, error) {
+	userID, err := strconv.Atoi(strings.TrimSpace(raw))
+	if err != nil || userID <= 0 {
+		return 0, errors.New("userId must be a positive integer")
+	}
+
+	return userID, nil
+}
+
+func mapUser(row *ent.User) userResponse {
+	return userResponse{
+		ID:        row.ID,
+		Username:  row.Username,
+		Role:      row.Role.String(),
+		CreatedAt: row.CreatedAt.UTC(),
+		UpdatedAt: row.UpdatedAt.UTC(),
+	}
+}
@@ -0,0 +1,240 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"goanna/apps/api/ent"
+	"goanna/apps/api/ent/headerprofile"
+	"goanna/apps/api/ent/monitor"
+	"goanna/apps/api/ent/monitorversion"
+)
+
+// maxMonitorVersions is how many configuration snapshots are kept per
+// monitor; older ones are pruned as new ones are recorded.
+const maxMonitorVersions = 50
+
+type monitorVersionResponse struct {
+	Version   int                  `json:"version"`
+	CreatedAt time.Time            `json:"createdAt"`
+	CreatedBy *string              `json:"createdBy,omitempty"`
+	Config    createMonitorRequest `json:"config"`
+}
+
+// recordMonitorVersion snapshots row's configuration as its next version,
+// unless it matches the latest snapshot. The signed-in user, if any, is
+// recorded as the author.
+func recordMonitorVersion(ctx context.Context, client *ent.Client, row *ent.Monitor) error {
+	config, err := json.Marshal(exportMonitorRequest(row))
+	if err != nil {
+		return err
+	}
+
+	latest, err := client.MonitorVersion.Query().
+		Where(monitorversion.MonitorIDEQ(row.ID)).
+		Order(ent.Desc(monitorversion.FieldVersion)).
+		First(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		return err
+	}
+
+	next := 1
+	if latest != nil {
+		if bytes.Equal(latest.Config, config) {
+			return nil
+		}
+		next = latest.Version + 1
+	}
+
+	create := client.MonitorVersion.Create().
+		SetMonitorID(row.ID).
+		SetVersion(next).
+		SetConfig(config)
+	if account := currentUser(ctx); account != nil {
+		create = create.SetCreatedBy(account.Username)
+	}
+	if _, err := create.Save(ctx); err != nil {
+		return err
+	}
+
+	_, err = client.MonitorVersion.Delete().
+		Where(
+			monitorversion.MonitorIDEQ(row.ID),
+			monitorversion.VersionLTE(next-maxMonitorVersions),
+		).
+		Exec(ctx)
+	return err
+}
+
+// handleListMonitorVersions returns a monitor's configuration snapshots,
+// newest first.
+func (s *Server) handleListMonitorVersions(w http.ResponseWriter, r *http.Request) {
+	monitorID, err := parseMonitorID(r.PathValue("monitorId"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "monitorId must be a positive integer")
+		return
+	}
+
+	exists, err := s.db.Monitor.Query().Where(monitor.IDEQ(monitorID)).Exist(r.Context())
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "failed to load monitor")
+		return
+	}
+	if !exists {
+		writeError(w, http.StatusNotFound, "monitor not found")
+		return
+	}
+
+	rows, err := s.db.MonitorVersion.Query().
+		Where(monitorversion.MonitorIDEQ(monitorID)).
+		Order(ent.Desc(monitorversion.FieldVersion)).
+		All(r.Context())
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "failed to load monitor versions")
+		return
+	}
+
+	response := make([]monitorVersionResponse, 0, len(rows))
+	for _, row := range rows {
+		mapped, err := mapMonitorVersion(row)
+		if err != nil {
+			writeError(w, http.StatusInternalServerError, "failed to decode monitor version")
+			return
+		}
+		response = append(response, mapped)
+	}
+
+	writeJSON(w, http.StatusOK, response)
+}
+
+// handleRestoreMonitorVersion puts a monitor's configuration back to an
+// earlier snapshot. The restore is itself recorded as a new version, so it
+// can be undone the same way.
+func (s *Server) handleRestoreMonitorVersion(w http.ResponseWriter, r *http.Request) {
+	monitorID, err := parseMonitorID(r.PathValue("monitorId"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "monitorId must be a positive integer")
+		return
+	}
+	version, err := parseMonitorVersion(r.PathValue("version"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	existing, err := s.db.Monitor.Query().
+		Where(monitor.IDEQ(monitorID)).
+		WithRuntime().
+		Only(r.Context())
+	if err != nil {
+		if ent.IsNotFound(err) {
+			writeError(w, http.StatusNotFound, "monitor not found")
+			return
+		}
+		writeError(w, http.StatusInternalServerError, "failed to load monitor")
+		return
+	}
+
+	snapshot, err := s.db.MonitorVersion.Query().
+		Where(
+			monitorversion.MonitorIDEQ(monitorID),
+			monitorversion.VersionEQ(version),
+		).
+		Only(r.Context())
+	if err != nil {
+		if ent.IsNotFound(err) {
+			writeError(w, http.StatusNotFound, "monitor version not found")
+			return
+		}
+		writeError(w, http.StatusInternalServerError, "failed to load monitor version")
+		return
+	}
+
+	var req createMonitorRequest
+	if err := json.Unmarshal(snapshot.Config, &req); err != nil {
+		writeError(w, http.StatusInternalServerError, "failed to decode monitor version")
+		return
+	}
+	input, err := normalizeMonitorRequest(req)
+	if err != nil {
+		writeError(w, http.StatusConflict, "version can no longer be applied: "+err.Error())
+		return
+	}
+	if input.headerProfileID != nil {
+		exists, err := s.db.HeaderProfile.Query().
+			Where(headerprofile.IDEQ(*input.headerProfileID)).
+			Exist(r.Context())
+		if err != nil {
+			writeError(w, http.StatusInternalServerError, "failed to load header profile")
+			return
+		}
+		if !exists {
+			writeError(w, http.StatusConflict, "version can no longer be applied: its header profile was deleted")
+			return
+		}
+	}
+
+	config, err := s.ensureGlobalSystemConfig(r.Context())
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "failed to load runtime settings")
+		return
+	}
+
+	updated, runtime, err := updateMonitorWithRuntime(
+		r.Context(),
+		s.db,
+		existing,
+		input,
+		time.Now().UTC(),
+		runtimeCronLocation(config.Timezone),
+	)
+	if err != nil {
+		if errors.Is(err, errInvalidMonitorCron) {
+			writeError(w, http.StatusConflict, "version can no longer be applied: "+err.Error())
+			return
+		}
+		writeError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	s.scheduleChanges.Publish()
+
+	channelStates := s.loadNotificationChannelStates(r.Context())
+	mapped := s.mapMonitor(
+		updated,
+		runtime,
+		buildMonitorNotificationIssues(updated.NotificationChannels, channelStates),
+	)
+	s.publishMonitorUpdated(updated.ID, monitorUpdated, &mapped)
+
+	writeJSON(w, http.StatusOK, mapped)
+}
+
+func parseMonitorVersion(raw string) (int, error) {
+	version, err := strconv.Atoi(strings.TrimSpace(raw))
+	if err != nil || version <= 0 {
+		return 0, errors.New("version must be a positive integer")
+	}
+
+	return version, nil
+}
+
+func mapMonitorVersion(row *ent.MonitorVersion) (monitorVersionResponse, error) {
+	var config createMonitorRequest
+	if err := json.Unmarshal(row.Config, &config); err != nil {
+		return monitorVersionResponse{}, err
+	}
+	config.Auth = maskMonitorAuth(config.Auth)
+
+	return monitorVersionResponse{
+		Version:   row.Version,
+		CreatedAt: row.CreatedAt.UTC(),
+		CreatedBy: row.CreatedBy,
+		Config:    config,
+	}, nil
+}
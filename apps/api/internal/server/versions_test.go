@@ -0,0 +1,131 @@
+package server
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"goanna/apps/api/ent/enttest"
+	"goanna/apps/api/ent/monitorversion"
+
+	_ "github.com/mattn/go-sqlite3"
+)
+
+func TestMonitorVersionsRecordAndRestore(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:monitor-versions?mode=memory&cache=shared&_fk=1")
+	defer client.Close()
+
+	mux := http.NewServeMux()
+	New(client).RegisterRoutes(mux)
+
+	do := func(method string, path string, body string) *httptest.ResponseRecorder {
+		t.Helper()
+		rec := httptest.NewRecorder()
+		mux.ServeHTTP(rec, httptest.NewRequest(method, path, strings.NewReader(body)))
+		return rec
+	}
+
+	rec := do(http.MethodPost, "/v1/monitors", `{"url":"https://example.com/price","cron":"*/5 * * * *","selector":"price"}`)
+	if rec.Code != http.StatusCreated {
+		t.Fatalf("expected 201, got %d: %s", rec.Code, rec.Body.String())
+	}
+	var createResponse monitorTriggerResponse
+	if err := json.Unmarshal(rec.Body.Bytes(), &createResponse); err != nil {
+		t.Fatalf("expected monitor JSON: %v", err)
+	}
+	created := createResponse.Monitor
+
+	updatePath := fmt.Sprintf("/v1/monitors/%d", created.ID)
+	rec = do(http.MethodPut, updatePath, `{"url":"https://example.com/price","cron":"0 * * * *","selector":".oops"}`)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected 200, got %d: %s", rec.Code, rec.Body.String())
+	}
+	// Saving the same configuration again does not add a version.
+	rec = do(http.MethodPut, updatePath, `{"url":"https://example.com/price","cron":"0 * * * *","selector":".oops"}`)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected 200, got %d: %s", rec.Code, rec.Body.String())
+	}
+
+	rec = do(http.MethodGet, fmt.Sprintf("/v1/monitors/%d/versions", created.ID), "")
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected 200, got %d: %s", rec.Code, rec.Body.String())
+	}
+	var versions []monitorVersionResponse
+	if err := json.Unmarshal(rec.Body.Bytes(), &versions); err != nil {
+		t.Fatalf("expected versions JSON: %v", err)
+	}
+	if len(versions) != 2 || versions[0].Version != 2 || versions[1].Version != 1 {
+		t.Fatalf("expected versions 2 and 1, got %#v", versions)
+	}
+	if versions[1].Config.Cron != "*/5 * * * *" || versions[1].Config.Selector == nil || *versions[1].Config.Selector != "price" {
+		t.Fatalf("unexpected first version config %#v", versions[1].Config)
+	}
+
+	rec = do(http.MethodPost, fmt.Sprintf("/v1/monitors/%d/versions/1/restore", created.ID), "")
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected 200, got %d: %s", rec.Code, rec.Body.String())
+	}
+	var restored monitorResponse
+	if err := json.Unmarshal(rec.Body.Bytes(), &restored); err != nil {
+		t.Fatalf("expected monitor JSON: %v", err)
+	}
+	if restored.Cron != "*/5 * * * *" || restored.Selector == nil || *restored.Selector != "price" {
+		t.Fatalf("expected first configuration restored, got %+v", restored)
+	}
+
+	count, err := client.MonitorVersion.Query().Where(monitorversion.MonitorIDEQ(int(created.ID))).Count(t.Context())
+	if err != nil || count != 3 {
+		t.Fatalf("expected the restore to be recorded as version 3, got %d (%v)", count, err)
+	}
+
+	rec = do(http.MethodPost, fmt.Sprintf("/v1/monitors/%d/versions/9/restore", created.ID), "")
+	if rec.Code != http.StatusNotFound {
+		t.Fatalf("expected 404 for unknown version, got %d", rec.Code)
+	}
+
+	rec = do(http.MethodDelete, updatePath+"/permanent", "")
+	if rec.Code != http.StatusNoContent {
+		t.Fatalf("expected 204, got %d: %s", rec.Code, rec.Body.String())
+	}
+	if count, err := client.MonitorVersion.Query().Count(t.Context()); err != nil || count != 0 {
+		t.Fatalf("expected versions deleted with the monitor, got %d (%v)", count, err)
+	}
+}
+
+func TestRecordMonitorVersionPrunesOldVersions(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:monitor-versions-prune?mode=memory&cache=shared&_fk=1")
+	defer client.Close()
+
+	row, err := client.Monitor.Create().
+		SetURL("https://example.com/").
+		SetCron("*/5 * * * *").
+		Save(t.Context())
+	if err != nil {
+		t.Fatalf("expected monitor to save: %v", err)
+	}
+
+	for i := 0; i < maxMonitorVersions+5; i++ {
+		row, err = client.Monitor.UpdateOne(row).SetJitterSeconds(i).Save(t.Context())
+		if err != nil {
+			t.Fatalf("expected monitor to update: %v", err)
+		}
+		if err := recordMonitorVersion(t.Context(), client, row); err != nil {
+			t.Fatalf("expected version to record: %v", err)
+		}
+	}
+
+	oldest, err := client.MonitorVersion.Query().
+		Where(monitorversion.MonitorIDEQ(row.ID)).
+		Order(monitorversion.ByVersion()).
+		First(t.Context())
+	if err != nil {
+		t.Fatalf("expected versions: %v", err)
+	}
+	count, err := client.MonitorVersion.Query().Count(t.Context())
+	if err != nil || count != maxMonitorVersions || oldest.Version != 6 {
+		t.Fatalf("expected the latest %d versions kept, got %d from version %d (%v)", maxMonitorVersions, count, oldest.Version, err)
+	}
+}
@@ -0,0 +1,114 @@
+package server
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"goanna/apps/api/ent"
+	"goanna/apps/api/ent/session"
+)
+
+// webSocketTicketTTL is how long a ticket can be used to open a WebSocket.
+// The stream itself stays open for as long as the session does.
+const webSocketTicketTTL = 30 * time.Second
+
+type webSocketTicketKey struct{}
+
+type webSocketTicketResponse struct {
+	Ticket    string    `json:"ticket"`
+	ExpiresAt time.Time `json:"expiresAt"`
+}
+
+// handleCreateWebSocketTicket issues a short-lived ticket for the caller's
+// session, so browsers, which cannot set headers on WebSockets, do not have
+// to put their session token in the URL.
+func (s *Server) handleCreateWebSocketTicket(w http.ResponseWriter, r *http.Request) {
+	_, row, err := s.sessionUser(r)
+	if err != nil {
+		writeError(w, http.StatusConflict, "tickets are only needed once sign-in is required")
+		return
+	}
+
+	expiresAt := time.Now().UTC().Add(webSocketTicketTTL).Truncate(time.Second)
+	writeJSON(w, http.StatusCreated, webSocketTicketResponse{
+		Ticket:    webSocketTicket(row, expiresAt),
+		ExpiresAt: expiresAt,
+	})
+}
+
+// withWebSocketTicket signs in requests carrying a valid ticket query
+// parameter as the session the ticket was issued for.
+func (s *Server) withWebSocketTicket(next http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ticket := strings.TrimSpace(r.URL.Query().Get("ticket"))
+		if ticket != "" && r.Header.Get("Authorization") == "" {
+			if row, err := s.webSocketTicketSession(r.Context(), ticket, time.Now().UTC()); err == nil {
+				r = r.WithContext(context.WithValue(r.Context(), webSocketTicketKey{}, row))
+			}
+		}
+		next(w, r)
+	}
+}
+
+// webSocketTicket signs the session ID and expiry with the session's token
+// hash, so tickets need no storage of their own and stop working when the
+// session is revoked.
+func webSocketTicket(row *ent.Session, expiresAt time.Time) string {
+	payload := fmt.Sprintf("%d.%d", row.ID, expiresAt.Unix())
+	return payload + "." + webSocketTicketSignature(row.TokenHash, payload)
+}
+
+func webSocketTicketSignature(tokenHash string, payload string) string {
+	mac := hmac.New(sha256.New, []byte(tokenHash))
+	mac.Write([]byte("websocket-ticket:" + payload))
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+// webSocketTicketSession returns the unexpired session a ticket was issued
+// for, with its user.
+func (s *Server) webSocketTicketSession(ctx context.Context, ticket string, now time.Time) (*ent.Session, error) {
+	parts := strings.Split(ticket, ".")
+	if len(parts) != 3 {
+		return nil, errors.New("malformed ticket")
+	}
+	sessionID, err := strconv.Atoi(parts[0])
+	if err != nil {
+		return nil, errors.New("malformed ticket")
+	}
+	expiresAt, err := strconv.ParseInt(parts[1], 10, 64)
+	if err != nil {
+		return nil, errors.New("malformed ticket")
+	}
+	if !now.Before(time.Unix(expiresAt, 0)) {
+		return nil, errors.New("ticket expired")
+	}
+
+	row, err := s.db.Session.Query().
+		Where(session.IDEQ(sessionID), session.ExpiresAtGT(now)).
+		WithUser().
+		Only(ctx)
+	if err != nil {
+		return nil, err
+	}
+	want := webSocketTicketSignature(row.TokenHash, parts[0]+"."+parts[1])
+	if !hmac.Equal([]byte(want), []byte(parts[2])) {
+		return nil, errors.New("invalid ticket")
+	}
+	return row, nil
+}
+
+// sessionActive reports whether a session still exists and has not expired.
+func (s *Server) sessionActive(ctx context.Context, sessionID int) bool {
+	active, err := s.db.Session.Query().
+		Where(session.IDEQ(sessionID), session.ExpiresAtGT(time.Now().UTC())).
+		Exist(ctx)
+	return err == nil && active
+}
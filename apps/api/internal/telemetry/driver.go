@@ -0,0 +1,92 @@
+package telemetry
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"entgo.io/ent/dialect"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	semconv "go.opentelemetry.io/otel/semconv/v1.39.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Driver wraps an ent driver so that every SQL statement ent runs, in or out
+// of a transaction, gets a client span carrying the statement text. Argument
+// values are left out, as they can hold secrets.
+func Driver(drv dialect.Driver) dialect.Driver {
+	return &tracedDriver{Driver: drv}
+}
+
+type tracedDriver struct {
+	dialect.Driver
+}
+
+func (d *tracedDriver) Exec(ctx context.Context, query string, args, v any) error {
+	ctx, span := startStatement(ctx, d.Dialect(), "db.exec", query)
+	return endStatement(span, d.Driver.Exec(ctx, query, args, v))
+}
+
+func (d *tracedDriver) Query(ctx context.Context, query string, args, v any) error {
+	ctx, span := startStatement(ctx, d.Dialect(), "db.query", query)
+	return endStatement(span, d.Driver.Query(ctx, query, args, v))
+}
+
+func (d *tracedDriver) Tx(ctx context.Context) (dialect.Tx, error) {
+	tx, err := d.Driver.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &tracedTx{Tx: tx, dialect: d.Dialect()}, nil
+}
+
+// BeginTx backs ent.Client.BeginTx.
+func (d *tracedDriver) BeginTx(ctx context.Context, opts *sql.TxOptions) (dialect.Tx, error) {
+	drv, ok := d.Driver.(interface {
+		BeginTx(context.Context, *sql.TxOptions) (dialect.Tx, error)
+	})
+	if !ok {
+		return nil, fmt.Errorf("driver %T does not support BeginTx", d.Driver)
+	}
+	tx, err := drv.BeginTx(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	return &tracedTx{Tx: tx, dialect: d.Dialect()}, nil
+}
+
+type tracedTx struct {
+	dialect.Tx
+	dialect string
+}
+
+func (t *tracedTx) Exec(ctx context.Context, query string, args, v any) error {
+	ctx, span := startStatement(ctx, t.dialect, "db.exec", query)
+	return endStatement(span, t.Tx.Exec(ctx, query, args, v))
+}
+
+func (t *tracedTx) Query(ctx context.Context, query string, args, v any) error {
+	ctx, span := startStatement(ctx, t.dialect, "db.query", query)
+	return endStatement(span, t.Tx.Query(ctx, query, args, v))
+}
+
+func startStatement(ctx context.Context, name string, operation string, query string) (context.Context, trace.Span) {
+	system := semconv.DBSystemNameSQLite
+	if name == dialect.MySQL {
+		system = semconv.DBSystemNameMySQL
+	}
+	return otel.Tracer(ScopeName).Start(ctx, operation,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(system, semconv.DBQueryText(query)),
+	)
+}
+
+func endStatement(span trace.Span, err error) error {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+	return err
+}
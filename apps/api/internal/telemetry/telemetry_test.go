@@ -0,0 +1,91 @@
+package telemetry
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"goanna/apps/api/ent"
+
+	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
+	_ "github.com/mattn/go-sqlite3"
+	"go.opentelemetry.io/otel"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	semconv "go.opentelemetry.io/otel/semconv/v1.39.0"
+)
+
+func recordSpans(t *testing.T) *tracetest.SpanRecorder {
+	t.Helper()
+	recorder := tracetest.NewSpanRecorder()
+	previous := otel.GetTracerProvider()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+	t.Cleanup(func() { otel.SetTracerProvider(previous) })
+	return recorder
+}
+
+func TestDriverTracesStatements(t *testing.T) {
+	recorder := recordSpans(t)
+
+	drv, err := entsql.Open(dialect.SQLite, "file:telemetry-driver?mode=memory&cache=shared&_fk=1")
+	if err != nil {
+		t.Fatalf("open database: %v", err)
+	}
+	client := ent.NewClient(ent.Driver(Driver(drv)))
+	defer client.Close()
+	if err := client.Schema.Create(t.Context()); err != nil {
+		t.Fatalf("create schema: %v", err)
+	}
+
+	tx, err := client.Tx(t.Context())
+	if err != nil {
+		t.Fatalf("begin transaction: %v", err)
+	}
+	if _, err := tx.Monitor.Create().SetURL("https://example.com").SetCron("*/5 * * * *").Save(t.Context()); err != nil {
+		t.Fatalf("create monitor: %v", err)
+	}
+	if err := tx.Commit(); err != nil {
+		t.Fatalf("commit: %v", err)
+	}
+	if _, err := client.Monitor.Query().Count(t.Context()); err != nil {
+		t.Fatalf("count monitors: %v", err)
+	}
+
+	names := map[string]bool{}
+	for _, span := range recorder.Ended() {
+		names[span.Name()] = true
+		system := false
+		for _, attr := range span.Attributes() {
+			if attr == semconv.DBSystemNameSQLite {
+				system = true
+			}
+		}
+		if !system {
+			t.Fatalf("expected %s to carry the database system, got %v", span.Name(), span.Attributes())
+		}
+	}
+	if !names["db.exec"] || !names["db.query"] {
+		t.Fatalf("expected exec and query spans, got %v", names)
+	}
+}
+
+func TestHandlerNamesSpansAfterRoutes(t *testing.T) {
+	recorder := recordSpans(t)
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /v1/monitors/{monitorId}", func(w http.ResponseWriter, r *http.Request) {})
+	handler := Handler(mux)
+
+	handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/v1/monitors/4", nil))
+	handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/missing", nil))
+
+	spans := recorder.Ended()
+	if len(spans) != 2 || spans[0].Name() != "GET /v1/monitors/{monitorId}" || spans[1].Name() != "GET http.request" {
+		names := []string{}
+		for _, span := range spans {
+			names = append(names, span.Name())
+		}
+		t.Fatalf("expected spans named after the route, got %v", names)
+	}
+}
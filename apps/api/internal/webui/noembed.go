@@ -0,0 +1,9 @@
+//go:build !embedui
+
+package webui
+
+import "io/fs"
+
+func files() fs.FS {
+	return nil
+}
@@ -0,0 +1,17 @@
+// Package webui holds the web UI build embedded into the API binary.
+//
+// The UI is only embedded when building with the embedui tag, after copying
+// the static web build into dist:
+//
+//	GOANNA_WEB_SPA=true bun run build:web
+//	cp -r ../web/.output/public internal/webui/dist
+//	go build -tags embedui ./cmd/server
+package webui
+
+import "io/fs"
+
+// Files returns the embedded UI with index.html at its root, or nil when the
+// binary was built without it.
+func Files() fs.FS {
+	return files()
+}
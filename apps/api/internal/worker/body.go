@@ -0,0 +1,232 @@
+package worker
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"fmt"
+	"io"
+	"strings"
+
+	"goanna/apps/api/ent"
+	"goanna/apps/api/ent/checkresult"
+	"goanna/apps/api/ent/monitor"
+)
+
+const (
+	MaxBodySnapshotBytes = 1024 * 1024
+
+	bodySnapshotEncodingIdentity = "identity"
+	bodySnapshotEncodingGzip     = "gzip"
+	maxBodyDiffSampleLines       = 10
+	maxBodyDiffSampleLineBytes   = 200
+)
+
+type bodySnapshot struct {
+	Data      []byte
+	Size      int
+	Truncated bool
+}
+
+func captureBodySnapshot(row *ent.Monitor, payload []byte) *bodySnapshot {
+	if row == nil || row.BodySnapshot == monitor.BodySnapshotOff || row.BodySnapshot == "" {
+		return nil
+	}
+
+	data := payload
+	truncated := false
+	if len(data) > MaxBodySnapshotBytes {
+		data = data[:MaxBodySnapshotBytes]
+		truncated = true
+	}
+
+	return &bodySnapshot{
+		Data:      append([]byte(nil), data...),
+		Size:      len(payload),
+		Truncated: truncated,
+	}
+}
+
+func encodeBodySnapshot(data []byte, mode monitor.BodySnapshot) ([]byte, string, error) {
+	if mode != monitor.BodySnapshotGzip {
+		return data, bodySnapshotEncodingIdentity, nil
+	}
+
+	var buffer bytes.Buffer
+	writer := gzip.NewWriter(&buffer)
+	if _, err := writer.Write(data); err != nil {
+		return nil, "", err
+	}
+	if err := writer.Close(); err != nil {
+		return nil, "", err
+	}
+
+	return buffer.Bytes(), bodySnapshotEncodingGzip, nil
+}
+
+// HasBodySnapshot reports whether a check stored a response body snapshot.
+// Ent scans a NULL blob into a non-nil pointer, so both levels are checked.
+func HasBodySnapshot(row *ent.CheckResult) bool {
+	return row != nil && row.BodySnapshot != nil && *row.BodySnapshot != nil
+}
+
+// DecodeBodySnapshot returns the stored response body of a check, or nil when
+// the check has no body snapshot.
+func DecodeBodySnapshot(row *ent.CheckResult) ([]byte, error) {
+	if !HasBodySnapshot(row) {
+		return nil, nil
+	}
+
+	encoding := bodySnapshotEncodingIdentity
+	if row.BodySnapshotEncoding != nil {
+		encoding = *row.BodySnapshotEncoding
+	}
+
+	switch encoding {
+	case bodySnapshotEncodingIdentity:
+		return *row.BodySnapshot, nil
+	case bodySnapshotEncodingGzip:
+		reader, err := gzip.NewReader(bytes.NewReader(*row.BodySnapshot))
+		if err != nil {
+			return nil, err
+		}
+		defer reader.Close()
+
+		return io.ReadAll(io.LimitReader(reader, MaxBodySnapshotBytes+1))
+	default:
+		return nil, fmt.Errorf("unsupported body snapshot encoding %q", encoding)
+	}
+}
+
+func bodySnapshotFromCheck(row *ent.CheckResult) *bodySnapshot {
+	data, err := DecodeBodySnapshot(row)
+	if err != nil || data == nil {
+		return nil
+	}
+
+	size := len(data)
+	if row.BodySize != nil {
+		size = *row.BodySize
+	}
+
+	return &bodySnapshot{
+		Data:      data,
+		Size:      size,
+		Truncated: row.BodySnapshotTruncated,
+	}
+}
+
+func (w *Worker) loadPreviousBodySnapshot(ctx context.Context, monitorID int) (*bodySnapshot, error) {
+	row, err := w.db.CheckResult.Query().
+		Where(
+			checkresult.MonitorIDEQ(monitorID),
+			checkresult.BodySnapshotNotNil(),
+		).
+		Order(ent.Desc(checkresult.FieldCheckedAt), ent.Desc(checkresult.FieldID)).
+		First(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return bodySnapshotFromCheck(row), nil
+}
+
+func buildBodyDiff(previous *bodySnapshot, current *bodySnapshot) *selectionDiff {
+	if current == nil {
+		return nil
+	}
+
+	if previous == nil {
+		return &selectionDiff{
+			Kind:    "initial",
+			Changed: false,
+			Summary: "initial body captured",
+			Details: map[string]any{
+				"type":    "body",
+				"newSize": current.Size,
+			},
+		}
+	}
+
+	changed := !bytes.Equal(previous.Data, current.Data)
+	details := map[string]any{
+		"oldSize": previous.Size,
+		"newSize": current.Size,
+	}
+	if previous.Truncated || current.Truncated {
+		details["truncated"] = true
+	}
+
+	if !changed {
+		return &selectionDiff{
+			Kind:    "body",
+			Changed: false,
+			Summary: "body unchanged",
+			Details: details,
+		}
+	}
+
+	previousCounts, previousOrder := lineCountMap(previous.Data)
+	currentCounts, currentOrder := lineCountMap(current.Data)
+	added := mapCountDiff(currentCounts, previousCounts)
+	removed := mapCountDiff(previousCounts, currentCounts)
+	addedCount := totalMapCount(added)
+	removedCount := totalMapCount(removed)
+
+	details["addedLines"] = addedCount
+	details["removedLines"] = removedCount
+	if addedCount > 0 {
+		details["added"] = sampleBodyLines(currentOrder, added)
+	}
+	if removedCount > 0 {
+		details["removed"] = sampleBodyLines(previousOrder, removed)
+	}
+
+	summary := fmt.Sprintf("body changed (+%d -%d lines)", addedCount, removedCount)
+	if addedCount == 0 && removedCount == 0 {
+		summary = "body lines reordered"
+	}
+
+	return &selectionDiff{
+		Kind:    "body",
+		Changed: true,
+		Summary: summary,
+		Details: details,
+	}
+}
+
+func lineCountMap(data []byte) (map[string]int, []string) {
+	lines := strings.Split(strings.ReplaceAll(string(data), "\r\n", "\n"), "\n")
+	counts := make(map[string]int, len(lines))
+	for _, line := range lines {
+		counts[line]++
+	}
+	return counts, lines
+}
+
+func sampleBodyLines(ordered []string, wanted map[string]int) []string {
+	remaining := make(map[string]int, len(wanted))
+	for line, count := range wanted {
+		remaining[line] = count
+	}
+
+	sample := make([]string, 0, maxBodyDiffSampleLines)
+	for _, line := range ordered {
+		if len(sample) == maxBodyDiffSampleLines {
+			break
+		}
+		if remaining[line] == 0 {
+			continue
+		}
+		remaining[line]--
+
+		if len(line) > maxBodyDiffSampleLineBytes {
+			line = line[:maxBodyDiffSampleLineBytes] + "..."
+		}
+		sample = append(sample, line)
+	}
+	return sample
+}
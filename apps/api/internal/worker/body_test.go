@@ -0,0 +1,84 @@
+package worker
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+
+	"goanna/apps/api/ent"
+	"goanna/apps/api/ent/monitor"
+)
+
+func TestBuildBodyDiffReportsChangedLines(t *testing.T) {
+	previous := &bodySnapshot{Data: []byte("<h1>Price</h1>\n<p>10</p>\n<footer/>"), Size: 34}
+	current := &bodySnapshot{Data: []byte("<h1>Price</h1>\n<p>12</p>\n<footer/>"), Size: 34}
+
+	diff := buildBodyDiff(previous, current)
+	if diff == nil {
+		t.Fatal("expected diff result")
+	}
+	if diff.Kind != "body" || !diff.Changed {
+		t.Fatalf("expected changed body diff, got kind=%q changed=%t", diff.Kind, diff.Changed)
+	}
+	if diff.Summary != "body changed (+1 -1 lines)" {
+		t.Fatalf("unexpected summary %q", diff.Summary)
+	}
+	if !reflect.DeepEqual(diff.Details["added"], []string{"<p>12</p>"}) {
+		t.Fatalf("expected added line sample, got %#v", diff.Details["added"])
+	}
+	if !reflect.DeepEqual(diff.Details["removed"], []string{"<p>10</p>"}) {
+		t.Fatalf("expected removed line sample, got %#v", diff.Details["removed"])
+	}
+}
+
+func TestBuildBodyDiffUnchangedAndInitial(t *testing.T) {
+	current := &bodySnapshot{Data: []byte("same"), Size: 4}
+
+	initial := buildBodyDiff(nil, current)
+	if initial == nil || initial.Kind != "initial" || initial.Changed {
+		t.Fatalf("expected unchanged initial diff, got %#v", initial)
+	}
+
+	unchanged := buildBodyDiff(&bodySnapshot{Data: []byte("same"), Size: 4}, current)
+	if unchanged == nil || unchanged.Kind != "body" || unchanged.Changed {
+		t.Fatalf("expected unchanged body diff, got %#v", unchanged)
+	}
+}
+
+func TestCaptureBodySnapshotRespectsModeAndCap(t *testing.T) {
+	if snapshot := captureBodySnapshot(&ent.Monitor{BodySnapshot: monitor.BodySnapshotOff}, []byte("body")); snapshot != nil {
+		t.Fatalf("expected no snapshot when mode is off, got %#v", snapshot)
+	}
+
+	payload := bytes.Repeat([]byte("a"), MaxBodySnapshotBytes+10)
+	snapshot := captureBodySnapshot(&ent.Monitor{BodySnapshot: monitor.BodySnapshotRaw}, payload)
+	if snapshot == nil {
+		t.Fatal("expected snapshot when mode is raw")
+	}
+	if len(snapshot.Data) != MaxBodySnapshotBytes || !snapshot.Truncated {
+		t.Fatalf("expected truncated snapshot of %d bytes, got %d truncated=%t", MaxBodySnapshotBytes, len(snapshot.Data), snapshot.Truncated)
+	}
+	if snapshot.Size != len(payload) {
+		t.Fatalf("expected original size %d, got %d", len(payload), snapshot.Size)
+	}
+}
+
+func TestBodySnapshotGzipRoundTrip(t *testing.T) {
+	body := []byte(`{"status":"online","items":[1,2,3]}`)
+
+	encoded, encoding, err := encodeBodySnapshot(body, monitor.BodySnapshotGzip)
+	if err != nil {
+		t.Fatalf("expected body to encode: %v", err)
+	}
+	if encoding != bodySnapshotEncodingGzip {
+		t.Fatalf("expected gzip encoding, got %q", encoding)
+	}
+
+	decoded, err := DecodeBodySnapshot(&ent.CheckResult{BodySnapshot: &encoded, BodySnapshotEncoding: &encoding})
+	if err != nil {
+		t.Fatalf("expected body to decode: %v", err)
+	}
+	if !bytes.Equal(decoded, body) {
+		t.Fatalf("expected decoded body %q, got %q", body, decoded)
+	}
+}
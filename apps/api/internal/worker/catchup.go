@@ -0,0 +1,77 @@
+package worker
+
+import (
+	"context"
+	"time"
+
+	"goanna/apps/api/ent"
+	"goanna/apps/api/ent/systemconfig"
+)
+
+// catchUpPolicy decides what happens to runs missed while the worker was down:
+//   - run_latest_only: each overdue monitor runs once, then resumes its schedule.
+//   - run_all_missed: every missed run is replayed, one per tick, until the
+//     monitor has caught up with its schedule.
+//   - skip: overdue monitors wait for their next scheduled run.
+//
+// With a max age, runs scheduled longer than that before startup are not
+// caught up.
+type catchUpPolicy struct {
+	policy systemconfig.CatchUpPolicy
+	maxAge time.Duration
+}
+
+func catchUpPolicyFromSystem(config *ent.SystemConfig) catchUpPolicy {
+	if config == nil {
+		return catchUpPolicy{policy: systemconfig.DefaultCatchUpPolicy}
+	}
+
+	return catchUpPolicy{
+		policy: config.CatchUpPolicy,
+		maxAge: time.Duration(config.CatchUpMaxAgeMinutes) * time.Minute,
+	}
+}
+
+// plan reports whether a run scheduled for nextRunAt and found overdue at
+// startup should run, and the time its following run is scheduled from.
+func (p catchUpPolicy) plan(nextRunAt time.Time, now time.Time) (bool, time.Time) {
+	if p.policy == systemconfig.CatchUpPolicySkip {
+		return false, now
+	}
+
+	if p.maxAge > 0 {
+		oldest := now.Add(-p.maxAge)
+		if nextRunAt.Before(oldest) {
+			if p.policy == systemconfig.CatchUpPolicyRunAllMissed {
+				return true, oldest
+			}
+			return false, now
+		}
+	}
+
+	if p.policy == systemconfig.CatchUpPolicyRunAllMissed {
+		return true, nextRunAt
+	}
+	return true, now
+}
+
+// replayingMissedRuns reports whether runtime is still working through runs
+// missed before the worker started under the run_all_missed policy.
+func (w *Worker) replayingMissedRuns(runtime *ent.MonitorRuntime, schedule scheduleConfig) bool {
+	if schedule.catchUp.policy != systemconfig.CatchUpPolicyRunAllMissed || w.startedAt.IsZero() {
+		return false
+	}
+	return shouldTriggerStartupCatchUp(runtime.NextRunAt, w.startedAt)
+}
+
+func (w *Worker) skipMissedRun(ctx context.Context, row *ent.Monitor, runtime *ent.MonitorRuntime, now time.Time, schedule scheduleConfig) (time.Time, error) {
+	nextRun, err := nextRunForMonitor(row, now, schedule)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	_, err = w.db.MonitorRuntime.UpdateOneID(runtime.ID).
+		SetNextRunAt(nextRun).
+		Save(ctx)
+	return nextRun, err
+}
@@ -0,0 +1,88 @@
+package worker
+
+import (
+	"testing"
+	"time"
+
+	"goanna/apps/api/ent/enttest"
+	"goanna/apps/api/ent/monitorruntime"
+	"goanna/apps/api/ent/systemconfig"
+
+	_ "github.com/mattn/go-sqlite3"
+)
+
+func TestCatchUpPolicyPlan(t *testing.T) {
+	now := time.Date(2026, time.March, 1, 12, 0, 0, 0, time.UTC)
+	missed := now.Add(-3 * time.Hour)
+
+	tests := []struct {
+		name     string
+		policy   catchUpPolicy
+		wantRun  bool
+		wantFrom time.Time
+	}{
+		{"latest only", catchUpPolicy{policy: systemconfig.CatchUpPolicyRunLatestOnly}, true, now},
+		{"all missed", catchUpPolicy{policy: systemconfig.CatchUpPolicyRunAllMissed}, true, missed},
+		{"skip", catchUpPolicy{policy: systemconfig.CatchUpPolicySkip}, false, now},
+		{"latest only past max age", catchUpPolicy{policy: systemconfig.CatchUpPolicyRunLatestOnly, maxAge: time.Hour}, false, now},
+		{"all missed past max age", catchUpPolicy{policy: systemconfig.CatchUpPolicyRunAllMissed, maxAge: time.Hour}, true, now.Add(-time.Hour)},
+		{"all missed within max age", catchUpPolicy{policy: systemconfig.CatchUpPolicyRunAllMissed, maxAge: 4 * time.Hour}, true, missed},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			run, from := tt.policy.plan(missed, now)
+			if run != tt.wantRun || !from.Equal(tt.wantFrom) {
+				t.Fatalf("expected run=%v from=%s, got run=%v from=%s", tt.wantRun, tt.wantFrom, run, from)
+			}
+		})
+	}
+}
+
+func TestStartupTickSkipsMissedRuns(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:worker-catch-up-skip?mode=memory&cache=shared&_fk=1")
+	defer client.Close()
+
+	row, err := client.Monitor.Create().
+		SetURL("http://127.0.0.1:0/unreachable").
+		SetCron("*/5 * * * *").
+		Save(t.Context())
+	if err != nil {
+		t.Fatalf("expected monitor to save: %v", err)
+	}
+
+	dueAt := time.Now().UTC().Add(-time.Hour)
+	if _, err := client.MonitorRuntime.Create().
+		SetMonitor(row).
+		SetStatus(monitorruntime.StatusOk).
+		SetNextRunAt(dueAt).
+		Save(t.Context()); err != nil {
+		t.Fatalf("expected runtime to save: %v", err)
+	}
+
+	if _, err := client.SystemConfig.Create().
+		SetKey(globalConfigKey).
+		SetCatchUpPolicy(systemconfig.CatchUpPolicySkip).
+		Save(t.Context()); err != nil {
+		t.Fatalf("expected system config to save: %v", err)
+	}
+
+	startupAt := time.Now().UTC()
+	New(client).tick(t.Context(), &startupAt)
+
+	checks, err := client.CheckResult.Query().Count(t.Context())
+	if err != nil {
+		t.Fatalf("expected check count: %v", err)
+	}
+	if checks != 0 {
+		t.Fatalf("expected missed run to be skipped, got %d checks", checks)
+	}
+
+	runtime, err := client.MonitorRuntime.Query().Only(t.Context())
+	if err != nil {
+		t.Fatalf("expected runtime to load: %v", err)
+	}
+	if runtime.NextRunAt == nil || !runtime.NextRunAt.After(startupAt) {
+		t.Fatalf("expected next run after startup, got %v", runtime.NextRunAt)
+	}
+}
@@ -0,0 +1,123 @@
+package worker
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"goanna/apps/api/ent"
+	"goanna/apps/api/ent/notificationevent"
+	"goanna/apps/api/ent/systemconfig"
+)
+
+// circuitBreaker backs off or suspends monitors after Threshold consecutive
+// errors so dead endpoints stop being retried on their normal schedule. A
+// zero threshold disables it.
+type circuitBreaker struct {
+	threshold int
+	action    systemconfig.CircuitBreakerAction
+	backoff   time.Duration
+}
+
+func circuitBreakerFromSystem(config *ent.SystemConfig) circuitBreaker {
+	if config == nil {
+		return circuitBreaker{}
+	}
+
+	return circuitBreaker{
+		threshold: config.CircuitBreakerThreshold,
+		action:    config.CircuitBreakerAction,
+		backoff:   time.Duration(config.CircuitBreakerBackoffMinutes) * time.Minute,
+	}
+}
+
+// open reports whether the breaker is open after result: the check failed and
+// brought the monitor to at least threshold consecutive errors. Heartbeat
+// monitors are never backed off, since their checks cost nothing.
+func (b circuitBreaker) open(row *ent.Monitor, runtime *ent.MonitorRuntime, result executionResult) bool {
+	if b.threshold <= 0 || result.success || runtime == nil || isHeartbeatMonitor(row) {
+		return false
+	}
+	return runtime.ConsecutiveErrors+1 >= int64(b.threshold)
+}
+
+// backoffRun delays nextRun so an open breaker checks at most once per
+// backoff interval.
+func (b circuitBreaker) backoffRun(nextRun time.Time, now time.Time) time.Time {
+	earliest := now.Add(b.backoff)
+	if nextRun.Before(earliest) {
+		return earliest
+	}
+	return nextRun
+}
+
+func (w *Worker) notifyCircuitBreaker(ctx context.Context, row *ent.Monitor, runtime *ent.MonitorRuntime, result executionResult, breaker circuitBreaker) error {
+	paused, err := w.notificationsPaused(ctx)
+	if err != nil {
+		return err
+	}
+	if paused {
+		return nil
+	}
+
+	channels, err := w.enabledChannelsForMonitor(ctx, row)
+	if err != nil {
+		return err
+	}
+
+	message := formatCircuitBreakerMessage(row, runtime, result, breaker)
+	summary := fmt.Sprintf("auto-suspended after %d consecutive errors", runtime.ConsecutiveErrors)
+	if breaker.action == systemconfig.CircuitBreakerActionBackoff {
+		summary = fmt.Sprintf("backed off after %d consecutive errors", runtime.ConsecutiveErrors)
+	}
+	var notifyErr error
+	for _, channel := range channels {
+		status := "sent"
+		eventMessage := summary
+
+		if err := w.sendMonitorDiffToChannel(ctx, channel, message); err != nil {
+			status = "error"
+			eventMessage = err.Error()
+			notifyErr = err
+		}
+
+		if err := w.saveNotificationEvent(ctx, withRunID(w.db.NotificationEvent.Create().
+			SetMonitorID(row.ID).
+			SetChannelID(channel.ID).
+			SetKind(notificationevent.KindCircuitBreaker).
+			SetStatus(status).
+			SetMessage(eventMessage).
+			SetSentAt(result.checkedAt), result.runID)); err != nil {
+			notifyErr = err
+		}
+	}
+
+	return notifyErr
+}
+
+func formatCircuitBreakerMessage(row *ent.Monitor, runtime *ent.MonitorRuntime, result executionResult, breaker circuitBreaker) string {
+	monitorLine := fmt.Sprintf("Monitor: %d", row.ID)
+	if monitorLabel := monitorNotificationLabel(row); monitorLabel != "" {
+		monitorLine = fmt.Sprintf("Monitor: %s (#%d)", monitorLabel, row.ID)
+	}
+
+	title := "Goanna monitor auto-suspended"
+	next := "Checks are paused until the monitor is re-enabled"
+	if breaker.action == systemconfig.CircuitBreakerActionBackoff {
+		title = "Goanna monitor backed off"
+		next = fmt.Sprintf("Checking at most every %d minutes until it recovers", int(breaker.backoff/time.Minute))
+	}
+
+	lines := []string{
+		title,
+		monitorLine,
+		fmt.Sprintf("URL: %s", row.URL),
+		fmt.Sprintf("Failed %d checks in a row", runtime.ConsecutiveErrors),
+		next,
+		fmt.Sprintf("Error: %s", monitorFailureSummary(result)),
+		fmt.Sprintf("CheckedAt (UTC): %s", result.checkedAt.UTC().Format(time.RFC3339)),
+	}
+
+	return strings.Join(lines, "\n")
+}
@@ -0,0 +1,73 @@
+package worker
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"goanna/apps/api/ent"
+	"goanna/apps/api/ent/monitor"
+	"goanna/apps/api/ent/systemconfig"
+)
+
+func TestCircuitBreakerOpensAtThreshold(t *testing.T) {
+	breaker := circuitBreakerFromSystem(&ent.SystemConfig{
+		CircuitBreakerThreshold:      3,
+		CircuitBreakerAction:         systemconfig.CircuitBreakerActionBackoff,
+		CircuitBreakerBackoffMinutes: 30,
+	})
+	row := &ent.Monitor{FetchMode: monitor.FetchModeHTTP}
+	failed := executionResult{success: false}
+
+	if breaker.open(row, &ent.MonitorRuntime{ConsecutiveErrors: 1}, failed) {
+		t.Fatal("expected breaker to stay closed below the threshold")
+	}
+	if !breaker.open(row, &ent.MonitorRuntime{ConsecutiveErrors: 2}, failed) {
+		t.Fatal("expected breaker to open on the threshold failure")
+	}
+	if !breaker.open(row, &ent.MonitorRuntime{ConsecutiveErrors: 7}, failed) {
+		t.Fatal("expected breaker to stay open while failing")
+	}
+	if breaker.open(row, &ent.MonitorRuntime{ConsecutiveErrors: 7}, executionResult{success: true}) {
+		t.Fatal("expected a success to close the breaker")
+	}
+	if breaker.open(&ent.Monitor{FetchMode: monitor.FetchModeHeartbeat}, &ent.MonitorRuntime{ConsecutiveErrors: 7}, failed) {
+		t.Fatal("expected heartbeat monitors to be exempt")
+	}
+	if circuitBreakerFromSystem(nil).open(row, &ent.MonitorRuntime{ConsecutiveErrors: 100}, failed) {
+		t.Fatal("expected breaker to be disabled without a threshold")
+	}
+}
+
+func TestCircuitBreakerBackoffRun(t *testing.T) {
+	breaker := circuitBreaker{threshold: 3, action: systemconfig.CircuitBreakerActionBackoff, backoff: time.Hour}
+	now := time.Date(2026, time.March, 1, 12, 0, 0, 0, time.UTC)
+
+	if got := breaker.backoffRun(now.Add(5*time.Minute), now); !got.Equal(now.Add(time.Hour)) {
+		t.Fatalf("expected run delayed to %s, got %s", now.Add(time.Hour), got)
+	}
+	later := now.Add(3 * time.Hour)
+	if got := breaker.backoffRun(later, now); !got.Equal(later) {
+		t.Fatalf("expected later cron run %s to be kept, got %s", later, got)
+	}
+}
+
+func TestFormatCircuitBreakerMessage(t *testing.T) {
+	label := "API"
+	row := &ent.Monitor{ID: 4, Label: &label, URL: "https://example.com/health"}
+	runtime := &ent.MonitorRuntime{ConsecutiveErrors: 5}
+	errMsg := "connection refused"
+	result := executionResult{errorMessage: &errMsg, checkedAt: time.Date(2026, time.March, 1, 12, 0, 0, 0, time.UTC)}
+
+	suspended := formatCircuitBreakerMessage(row, runtime, result, circuitBreaker{action: systemconfig.CircuitBreakerActionSuspend})
+	for _, want := range []string{"Goanna monitor auto-suspended", "Monitor: API (#4)", "Failed 5 checks in a row", "re-enabled", "Error: connection refused"} {
+		if !strings.Contains(suspended, want) {
+			t.Fatalf("expected message to contain %q, got %q", want, suspended)
+		}
+	}
+
+	backedOff := formatCircuitBreakerMessage(row, runtime, result, circuitBreaker{action: systemconfig.CircuitBreakerActionBackoff, backoff: 30 * time.Minute})
+	if !strings.Contains(backedOff, "Goanna monitor backed off") || !strings.Contains(backedOff, "every 30 minutes") {
+		t.Fatalf("unexpected backoff message %q", backedOff)
+	}
+}
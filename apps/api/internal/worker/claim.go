@@ -0,0 +1,76 @@
+package worker
+
+import (
+	"context"
+	"crypto/rand"
+	"fmt"
+	"os"
+	"time"
+
+	"goanna/apps/api/ent"
+	"goanna/apps/api/ent/monitorruntime"
+)
+
+const (
+	// minClaimLease is the shortest time a claimed run stays reserved.
+	minClaimLease = 5 * time.Minute
+	// claimLeaseMargin covers saving a check's result after its last attempt.
+	claimLeaseMargin = time.Minute
+)
+
+// claimLease returns how long a claimed run stays reserved under defaults:
+// every attempt running into its timeout plus every retry backoff, so a lease
+// only expires when the replica that claimed it stopped before saving the
+// result.
+func (w *Worker) claimLease(defaults CheckDefaults) time.Duration {
+	attempt := defaults.RequestTimeout
+	if attempt <= 0 {
+		attempt = requestTimeout
+	}
+	if renderer, ok := w.renderer.(*chromiumRenderer); ok && renderer.timeout > attempt {
+		attempt = renderer.timeout
+	}
+
+	retries := max(defaults.MaxRetries, 0)
+	// The n-th retry waits n times RetryBackoff.
+	backoff := defaults.RetryBackoff * time.Duration(retries*(retries+1)/2)
+	return max(attempt*time.Duration(retries+1)+backoff+claimLeaseMargin, minClaimLease)
+}
+
+func defaultInstanceID() string {
+	host, err := os.Hostname()
+	if err != nil || host == "" {
+		host = "goanna"
+	}
+
+	var suffix [4]byte
+	_, _ = rand.Read(suffix[:])
+	return fmt.Sprintf("%s-%d-%x", host, os.Getpid(), suffix)
+}
+
+// claimRun atomically reserves the run scheduled at runtime.NextRunAt for
+// this worker until now plus lease. It fails when another replica holds an unexpired claim or has
+// already run the monitor and moved its next run on.
+func (w *Worker) claimRun(ctx context.Context, runtime *ent.MonitorRuntime, now time.Time, lease time.Duration) (bool, error) {
+	if runtime.NextRunAt == nil {
+		return false, nil
+	}
+
+	claimed, err := w.db.MonitorRuntime.Update().
+		Where(
+			monitorruntime.IDEQ(runtime.ID),
+			monitorruntime.NextRunAtEQ(*runtime.NextRunAt),
+			monitorruntime.Or(
+				monitorruntime.ClaimedUntilIsNil(),
+				monitorruntime.ClaimedUntilLTE(now),
+				monitorruntime.ClaimedByEQ(w.instanceID),
+			),
+		).
+		SetClaimedBy(w.instanceID).
+		SetClaimedUntil(now.Add(lease)).
+		Save(ctx)
+	if err != nil {
+		return false, err
+	}
+	return claimed == 1, nil
+}
@@ -0,0 +1,80 @@
+package worker
+
+import (
+	"testing"
+	"time"
+
+	"goanna/apps/api/ent/enttest"
+	"goanna/apps/api/ent/monitorruntime"
+
+	_ "github.com/mattn/go-sqlite3"
+)
+
+func TestClaimRunIsExclusiveAcrossReplicas(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:worker-claim?mode=memory&cache=shared&_fk=1")
+	defer client.Close()
+
+	row, err := client.Monitor.Create().
+		SetURL("https://example.com/health").
+		SetCron("*/5 * * * *").
+		Save(t.Context())
+	if err != nil {
+		t.Fatalf("expected monitor to save: %v", err)
+	}
+	if _, err := client.MonitorRuntime.Create().
+		SetMonitor(row).
+		SetStatus(monitorruntime.StatusOk).
+		SetNextRunAt(time.Now().UTC().Add(-time.Minute)).
+		Save(t.Context()); err != nil {
+		t.Fatalf("expected runtime to save: %v", err)
+	}
+
+	first := NewWithConfig(client, Config{InstanceID: "replica-a"})
+	second := NewWithConfig(client, Config{InstanceID: "replica-b"})
+
+	runtime, err := client.MonitorRuntime.Query().Only(t.Context())
+	if err != nil {
+		t.Fatalf("expected runtime to load: %v", err)
+	}
+
+	now := time.Now().UTC()
+	claimed, err := first.claimRun(t.Context(), runtime, now, minClaimLease)
+	if err != nil || !claimed {
+		t.Fatalf("expected first replica to claim the run, got %v (%v)", claimed, err)
+	}
+	claimed, err = second.claimRun(t.Context(), runtime, now, minClaimLease)
+	if err != nil || claimed {
+		t.Fatalf("expected second replica to be refused, got %v (%v)", claimed, err)
+	}
+
+	claimed, err = second.claimRun(t.Context(), runtime, now.Add(minClaimLease+time.Second), minClaimLease)
+	if err != nil || !claimed {
+		t.Fatalf("expected expired claim to be taken over, got %v (%v)", claimed, err)
+	}
+
+	if _, err := client.MonitorRuntime.UpdateOneID(runtime.ID).
+		SetNextRunAt(now.Add(5 * time.Minute)).
+		ClearClaimedBy().
+		ClearClaimedUntil().
+		Save(t.Context()); err != nil {
+		t.Fatalf("expected runtime to update: %v", err)
+	}
+	claimed, err = first.claimRun(t.Context(), runtime, now, minClaimLease)
+	if err != nil || claimed {
+		t.Fatalf("expected a run already moved on not to be claimed, got %v (%v)", claimed, err)
+	}
+}
+
+func TestClaimLeaseOutlastsRetries(t *testing.T) {
+	w := New(nil)
+
+	if lease := w.claimLease(CheckDefaults{RequestTimeout: 15 * time.Second, MaxRetries: 2, RetryBackoff: time.Second}); lease != minClaimLease {
+		t.Fatalf("expected short checks to keep the minimum lease, got %s", lease)
+	}
+
+	defaults := CheckDefaults{RequestTimeout: 300 * time.Second, MaxRetries: 10, RetryBackoff: 300 * time.Second}
+	expected := 11*300*time.Second + 55*300*time.Second + claimLeaseMargin
+	if lease := w.claimLease(defaults); lease != expected {
+		t.Fatalf("expected lease %s to cover every attempt and backoff, got %s", expected, lease)
+	}
+}
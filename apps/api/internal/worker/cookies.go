@@ -0,0 +1,144 @@
+package worker
+
+import (
+	"net/http"
+	"net/http/cookiejar"
+	"net/url"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"goanna/apps/api/ent"
+	"goanna/apps/api/ent/schema"
+)
+
+// cookieJar wraps a standard jar and records every cookie a target sets, since
+// cookiejar.Jar cannot export its contents for the next check.
+type cookieJar struct {
+	jar    *cookiejar.Jar
+	mu     sync.Mutex
+	now    func() time.Time
+	stored map[string]schema.StoredCookie
+}
+
+// newCookieJar returns a jar holding the cookies kept from previous checks.
+func newCookieJar(stored []schema.StoredCookie, now func() time.Time) *cookieJar {
+	jar, _ := cookiejar.New(nil)
+	cookies := &cookieJar{
+		jar:    jar,
+		now:    now,
+		stored: make(map[string]schema.StoredCookie, len(stored)),
+	}
+
+	current := now()
+	for _, cookie := range stored {
+		if cookie.Expires != nil && !cookie.Expires.After(current) {
+			continue
+		}
+		cookies.stored[storedCookieKey(cookie)] = cookie
+
+		httpCookie := &http.Cookie{
+			Name:     cookie.Name,
+			Value:    cookie.Value,
+			Domain:   cookie.Domain,
+			Path:     cookie.Path,
+			Secure:   cookie.Secure,
+			HttpOnly: cookie.HTTPOnly,
+		}
+		if cookie.Expires != nil {
+			httpCookie.Expires = *cookie.Expires
+		}
+		jar.SetCookies(&url.URL{Scheme: "https", Host: cookie.Domain, Path: cookie.Path}, []*http.Cookie{httpCookie})
+	}
+
+	return cookies
+}
+
+func (j *cookieJar) SetCookies(u *url.URL, cookies []*http.Cookie) {
+	j.jar.SetCookies(u, cookies)
+
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	current := j.now()
+	for _, cookie := range cookies {
+		stored := schema.StoredCookie{
+			Name:     cookie.Name,
+			Value:    cookie.Value,
+			Domain:   strings.TrimPrefix(strings.ToLower(cookie.Domain), "."),
+			Path:     cookie.Path,
+			Secure:   cookie.Secure,
+			HTTPOnly: cookie.HttpOnly,
+		}
+		if stored.Domain == "" {
+			stored.Domain = strings.ToLower(u.Hostname())
+		}
+		if stored.Path == "" || !strings.HasPrefix(stored.Path, "/") {
+			stored.Path = "/"
+		}
+
+		switch {
+		case cookie.MaxAge < 0:
+			delete(j.stored, storedCookieKey(stored))
+			continue
+		case cookie.MaxAge > 0:
+			expires := current.Add(time.Duration(cookie.MaxAge) * time.Second).UTC()
+			stored.Expires = &expires
+		case !cookie.Expires.IsZero():
+			expires := cookie.Expires.UTC()
+			stored.Expires = &expires
+		}
+		if stored.Expires != nil && !stored.Expires.After(current) {
+			delete(j.stored, storedCookieKey(stored))
+			continue
+		}
+		j.stored[storedCookieKey(stored)] = stored
+	}
+}
+
+func (j *cookieJar) Cookies(u *url.URL) []*http.Cookie {
+	return j.jar.Cookies(u)
+}
+
+// snapshot returns the unexpired cookies to keep for the next check, ordered
+// by domain, path and name.
+func (j *cookieJar) snapshot() []schema.StoredCookie {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	current := j.now()
+	cookies := make([]schema.StoredCookie, 0, len(j.stored))
+	for _, cookie := range j.stored {
+		if cookie.Expires != nil && !cookie.Expires.After(current) {
+			continue
+		}
+		cookies = append(cookies, cookie)
+	}
+	sort.Slice(cookies, func(i, k int) bool {
+		return storedCookieKey(cookies[i]) < storedCookieKey(cookies[k])
+	})
+	return cookies
+}
+
+func storedCookieKey(cookie schema.StoredCookie) string {
+	return cookie.Domain + "\x00" + cookie.Path + "\x00" + cookie.Name
+}
+
+// withCookieJar returns a copy of client sending and recording the monitor's
+// cookies, or client itself when the monitor has no cookie jar.
+func withCookieJar(client *http.Client, row *ent.Monitor) (*http.Client, *cookieJar) {
+	if row == nil || !row.CookieJar {
+		return client, nil
+	}
+
+	var stored []schema.StoredCookie
+	if row.Edges.Runtime != nil {
+		stored = row.Edges.Runtime.Cookies
+	}
+	jar := newCookieJar(stored, func() time.Time { return time.Now().UTC() })
+
+	withJar := *client
+	withJar.Jar = jar
+	return &withJar, jar
+}
@@ -0,0 +1,78 @@
+package worker
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"goanna/apps/api/ent"
+	"goanna/apps/api/ent/monitor"
+	"goanna/apps/api/ent/schema"
+)
+
+func TestExecuteOnceKeepsCookiesAcrossChecks(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/login":
+			http.SetCookie(w, &http.Cookie{Name: "session", Value: "abc", Path: "/"})
+			http.Redirect(w, r, "/home", http.StatusFound)
+		default:
+			session, err := r.Cookie("session")
+			if err != nil || session.Value != "abc" {
+				http.Error(w, "no session", http.StatusUnauthorized)
+				return
+			}
+			if seeded, err := r.Cookie("seeded"); err != nil || seeded.Value != "1" {
+				http.Error(w, "no seeded cookie", http.StatusUnauthorized)
+				return
+			}
+			_, _ = w.Write([]byte("ok"))
+		}
+	}))
+	defer server.Close()
+
+	w := &Worker{client: server.Client(), maxResponseBodyBytes: DefaultMaxResponseBodyBytes}
+	row := &ent.Monitor{
+		Method:       http.MethodGet,
+		URL:          server.URL + "/login",
+		ExpectedType: monitor.ExpectedTypeText,
+		CookieJar:    true,
+		Edges: ent.MonitorEdges{Runtime: &ent.MonitorRuntime{Cookies: []schema.StoredCookie{
+			{Name: "seeded", Value: "1", Domain: "127.0.0.1", Path: "/"},
+		}}},
+	}
+
+	result := w.executeOnce(t.Context(), row, CheckDefaults{})
+	if !result.success {
+		t.Fatalf("expected session cookie to follow the redirect, got error=%v", result.errorMessage)
+	}
+	if len(result.cookies) != 2 || result.cookies[0].Name != "seeded" || result.cookies[1].Name != "session" {
+		t.Fatalf("expected seeded and session cookies to be kept, got %+v", result.cookies)
+	}
+
+	row.URL = server.URL + "/home"
+	row.Edges.Runtime.Cookies = result.cookies
+	if result := w.executeOnce(t.Context(), row, CheckDefaults{}); !result.success {
+		t.Fatalf("expected stored cookies to be sent on the next check, got error=%v", result.errorMessage)
+	}
+
+	row.CookieJar = false
+	if result := w.executeOnce(t.Context(), row, CheckDefaults{}); result.success || result.cookies != nil {
+		t.Fatalf("expected no cookies without a cookie jar, got success=%t cookies=%+v", result.success, result.cookies)
+	}
+}
+
+func TestCookieJarForgetsDeletedCookies(t *testing.T) {
+	jar := newCookieJar([]schema.StoredCookie{
+		{Name: "session", Value: "abc", Domain: "example.com", Path: "/"},
+	}, func() time.Time { return time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC) })
+
+	target, _ := url.Parse("https://example.com/logout")
+	jar.SetCookies(target, []*http.Cookie{{Name: "session", Path: "/", MaxAge: -1}})
+
+	if cookies := jar.snapshot(); len(cookies) != 0 {
+		t.Fatalf("expected deleted cookie to be dropped, got %+v", cookies)
+	}
+}
@@ -0,0 +1,65 @@
+package worker
+
+import (
+	"net/http"
+	"strings"
+	"time"
+
+	"goanna/apps/api/ent"
+)
+
+// CheckDefaults are the runtime settings every check starts from before a
+// monitor's own configuration is applied.
+type CheckDefaults struct {
+	// Headers are sent with every check unless the monitor's header profile
+	// or own headers set the same name. The default User-Agent is included.
+	Headers map[string]string
+	// Proxy routes checks through an outbound proxy.
+	Proxy ProxySettings
+	// RequestTimeout bounds each attempt, including reading the body; zero
+	// keeps the client's own timeout.
+	RequestTimeout time.Duration
+	// MaxRetries failed attempts are repeated, the n-th after n times
+	// RetryBackoff.
+	MaxRetries   int
+	RetryBackoff time.Duration
+	// MaxResponseBodyBytes caps response bodies of monitors without their
+	// own maxResponseBytes; zero keeps the limit the worker started with.
+	MaxResponseBodyBytes int
+}
+
+// CheckDefaultsFromSystem reads the check defaults from the global runtime
+// settings.
+func CheckDefaultsFromSystem(config *ent.SystemConfig) CheckDefaults {
+	defaults := CheckDefaults{
+		Proxy:          ProxySettingsFromSystem(config),
+		RequestTimeout: requestTimeout,
+		MaxRetries:     maxRetries,
+		RetryBackoff:   retryBackoff,
+	}
+	if config == nil {
+		return defaults
+	}
+
+	defaults.RequestTimeout = time.Duration(config.RequestTimeoutSeconds) * time.Second
+	defaults.MaxRetries = config.MaxRetries
+	defaults.RetryBackoff = time.Duration(config.RetryBackoffSeconds) * time.Second
+	if config.MaxResponseBodyBytes != nil {
+		defaults.MaxResponseBodyBytes = *config.MaxResponseBodyBytes
+	}
+
+	headers := make(http.Header, len(config.DefaultHeaders)+1)
+	for key, value := range config.DefaultHeaders {
+		headers.Set(key, value)
+	}
+	if config.DefaultUserAgent != nil && strings.TrimSpace(*config.DefaultUserAgent) != "" {
+		headers.Set("User-Agent", strings.TrimSpace(*config.DefaultUserAgent))
+	}
+	if len(headers) > 0 {
+		defaults.Headers = make(map[string]string, len(headers))
+		for key := range headers {
+			defaults.Headers[key] = headers.Get(key)
+		}
+	}
+	return defaults
+}
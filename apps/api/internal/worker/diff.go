@@ -9,6 +9,9 @@ import (
 	"strconv"
 	"strings"
 	"time"
+
+	"goanna/apps/api/ent"
+	selectorutil "goanna/apps/api/internal/selector"
 )
 
 type selectionSnapshot struct {
@@ -25,7 +28,93 @@ type selectionDiff struct {
 	Details map[string]any
 }
 
+type diffOptions struct {
+	// numericTolerance treats numeric deltas at or below it as unchanged; zero keeps exact comparison.
+	numericTolerance float64
+}
+
+type CheckDiff struct {
+	Kind    string         `json:"kind"`
+	Changed bool           `json:"changed"`
+	Summary string         `json:"summary"`
+	Details map[string]any `json:"details,omitempty"`
+}
+
+// DiffCheckResults compares the stored body snapshots of two checks when both
+// have one, then their content hashes, and otherwise their stored selections
+// using the monitor's diff options. It returns nil when the checks have nothing
+// comparable.
+func DiffCheckResults(row *ent.Monitor, from *ent.CheckResult, to *ent.CheckResult) *CheckDiff {
+	var diff *selectionDiff
+	previousBody := bodySnapshotFromCheck(from)
+	currentBody := bodySnapshotFromCheck(to)
+	if previousBody != nil && currentBody != nil {
+		diff = buildBodyDiff(previousBody, currentBody)
+	} else if from != nil && to != nil && from.BodyHash != nil && to.BodyHash != nil {
+		diff = buildContentHashDiff(from.BodyHash, to.BodyHash)
+	} else {
+		previous := selectionSnapshotFromCheck(from)
+		current := selectionSnapshotFromCheck(to)
+		if previous != nil && current != nil {
+			diff = buildMonitorSelectionDiff(row, previous, current)
+		}
+	}
+	if from != nil && to != nil && from.TrackedHeaderValue != nil && to.TrackedHeaderValue != nil {
+		name := "tracked"
+		if row != nil && row.TrackHeader != nil {
+			name = *row.TrackHeader
+		}
+		diff = mergeHeaderDiff(diff, buildHeaderDiff(name, from.TrackedHeaderValue, to.TrackedHeaderValue))
+	}
+	if diff == nil {
+		return nil
+	}
+
+	return &CheckDiff{
+		Kind:    diff.Kind,
+		Changed: diff.Changed,
+		Summary: diff.Summary,
+		Details: diff.Details,
+	}
+}
+
+// DiffSelections reports how a change from previous to current would be
+// described for a JSON monitor with default diff options. It returns nil when
+// current does not exist, as no check would be diffed then.
+func DiffSelections(previous selectorutil.Selection, current selectorutil.Selection) *CheckDiff {
+	diff := buildSelectionDiff(
+		&selectionSnapshot{Exists: previous.Exists, Type: previous.Type, Raw: previous.Raw, Value: previous.Value},
+		&selectionSnapshot{Exists: current.Exists, Type: current.Type, Raw: current.Raw, Value: current.Value},
+	)
+	if diff == nil {
+		return nil
+	}
+
+	return &CheckDiff{
+		Kind:    diff.Kind,
+		Changed: diff.Changed,
+		Summary: diff.Summary,
+		Details: diff.Details,
+	}
+}
+
+func selectionSnapshotFromCheck(row *ent.CheckResult) *selectionSnapshot {
+	if row == nil || row.SelectionType == nil || row.SelectionValue == nil {
+		return nil
+	}
+
+	return &selectionSnapshot{
+		Exists: true,
+		Type:   *row.SelectionType,
+		Value:  *row.SelectionValue,
+	}
+}
+
 func buildSelectionDiff(previous *selectionSnapshot, current *selectionSnapshot) *selectionDiff {
+	return buildSelectionDiffWithOptions(previous, current, diffOptions{})
+}
+
+func buildSelectionDiffWithOptions(previous *selectionSnapshot, current *selectionSnapshot, options diffOptions) *selectionDiff {
 	if current == nil || !current.Exists {
 		return nil
 	}
@@ -60,7 +149,7 @@ func buildSelectionDiff(previous *selectionSnapshot, current *selectionSnapshot)
 
 	switch currentKind {
 	case "number":
-		return buildNumberDiff(previous, current)
+		return buildNumberDiff(previous, current, options)
 	case "boolean":
 		return buildBooleanDiff(previous, current)
 	case "null":
@@ -83,7 +172,7 @@ func buildSelectionDiff(previous *selectionSnapshot, current *selectionSnapshot)
 	case "array":
 		return buildArrayDiff(previous, current)
 	case "object":
-		return buildObjectDiff(previous, current)
+		return buildObjectDiff(previous, current, options)
 	default:
 		return buildTextDiff(previous, current)
 	}
@@ -138,7 +227,7 @@ func buildTextDiff(previous *selectionSnapshot, current *selectionSnapshot) *sel
 	}
 }
 
-func buildNumberDiff(previous *selectionSnapshot, current *selectionSnapshot) *selectionDiff {
+func buildNumberDiff(previous *selectionSnapshot, current *selectionSnapshot, options diffOptions) *selectionDiff {
 	previousNumber, previousErr := strconv.ParseFloat(strings.TrimSpace(previous.Value), 64)
 	currentNumber, currentErr := strconv.ParseFloat(strings.TrimSpace(current.Value), 64)
 	if previousErr != nil || currentErr != nil {
@@ -150,7 +239,7 @@ func buildNumberDiff(previous *selectionSnapshot, current *selectionSnapshot) *s
 		decimalPlacesFromNumericString(current.Value),
 	)
 	delta := roundToDecimalPlaces(currentNumber-previousNumber, precision)
-	changed := delta != 0
+	changed := !withinNumericTolerance(delta, options.numericTolerance)
 	percent := math.NaN()
 	if previousNumber != 0 {
 		percent = (delta / previousNumber) * 100
@@ -169,6 +258,9 @@ func buildNumberDiff(previous *selectionSnapshot, current *selectionSnapshot) *s
 	if !math.IsNaN(percent) {
 		details["percent"] = percent
 	}
+	if !changed && delta != 0 {
+		details["tolerance"] = options.numericTolerance
+	}
 
 	return &selectionDiff{
 		Kind:    "number",
@@ -375,7 +467,16 @@ func buildKeyedObjectArrayDiff(previousArray []any, currentArray []any) *selecti
 	}
 }
 
-func buildObjectDiff(previous *selectionSnapshot, current *selectionSnapshot) *selectionDiff {
+func diffOptionsForMonitor(row *ent.Monitor) diffOptions {
+	options := diffOptions{}
+	if row != nil && row.NumericTolerance != nil && *row.NumericTolerance > 0 {
+		options.numericTolerance = *row.NumericTolerance
+	}
+
+	return options
+}
+
+func buildObjectDiff(previous *selectionSnapshot, current *selectionSnapshot, options diffOptions) *selectionDiff {
 	var previousValue any
 	if err := json.Unmarshal([]byte(previous.Value), &previousValue); err != nil {
 		return buildTextDiff(previous, current)
@@ -395,7 +496,7 @@ func buildObjectDiff(previous *selectionSnapshot, current *selectionSnapshot) *s
 	removed := make([]string, 0)
 	changedPaths := make([]string, 0)
 	changes := map[string]map[string]any{}
-	collectObjectDiff("", previousObject, currentObject, &added, &removed, &changedPaths, changes)
+	collectObjectDiff("", previousObject, currentObject, options, &added, &removed, &changedPaths, changes)
 	sort.Strings(added)
 	sort.Strings(removed)
 	sort.Strings(changedPaths)
@@ -428,7 +529,7 @@ func buildObjectDiff(previous *selectionSnapshot, current *selectionSnapshot) *s
 	}
 }
 
-func collectObjectDiff(prefix string, previous map[string]any, current map[string]any, added *[]string, removed *[]string, changed *[]string, changes map[string]map[string]any) {
+func collectObjectDiff(prefix string, previous map[string]any, current map[string]any, options diffOptions, added *[]string, removed *[]string, changed *[]string, changes map[string]map[string]any) {
 	keysMap := make(map[string]struct{}, len(previous)+len(current))
 	for key := range previous {
 		keysMap[key] = struct{}{}
@@ -460,11 +561,10 @@ func collectObjectDiff(prefix string, previous map[string]any, current map[strin
 			previousObject, previousIsObject := previousValue.(map[string]any)
 			currentObject, currentIsObject := currentValue.(map[string]any)
 			if previousIsObject && currentIsObject {
-				collectObjectDiff(path, previousObject, currentObject, added, removed, changed, changes)
+				collectObjectDiff(path, previousObject, currentObject, options, added, removed, changed, changes)
 				continue
 			}
 			if !reflect.DeepEqual(previousValue, currentValue) {
-				*changed = append(*changed, path)
 				changeEntry := map[string]any{
 					"old": previousValue,
 					"new": currentValue,
@@ -477,9 +577,14 @@ func collectObjectDiff(prefix string, previous map[string]any, current map[strin
 						decimalPlacesFromValue(previousValue),
 						decimalPlacesFromValue(currentValue),
 					)
-					changeEntry["delta"] = roundToDecimalPlaces(currentNumber-previousNumber, precision)
+					delta := roundToDecimalPlaces(currentNumber-previousNumber, precision)
+					if options.numericTolerance > 0 && withinNumericTolerance(delta, options.numericTolerance) {
+						continue
+					}
+					changeEntry["delta"] = delta
 				}
 
+				*changed = append(*changed, path)
 				changes[path] = changeEntry
 			}
 		}
@@ -641,6 +746,14 @@ func stableJSON(value any) string {
 	return string(encoded)
 }
 
+func withinNumericTolerance(delta float64, tolerance float64) bool {
+	if tolerance <= 0 {
+		return delta == 0
+	}
+
+	return math.Abs(delta) <= tolerance
+}
+
 func formatFloat(value float64) string {
 	if value > 0 {
 		return "+" + strconv.FormatFloat(value, 'f', -1, 64)
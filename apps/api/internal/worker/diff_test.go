@@ -232,3 +232,58 @@ func TestBuildSelectionDiffNumberDeltaRounded(t *testing.T) {
 		t.Fatalf("expected rounded delta 2.6, got %v", deltaValue)
 	}
 }
+
+func TestBuildSelectionDiffNumberWithinToleranceUnchanged(t *testing.T) {
+	previous := &selectionSnapshot{Exists: true, Type: "number", Value: "0.6543210"}
+	current := &selectionSnapshot{Exists: true, Type: "number", Value: "0.6543211"}
+
+	diff := buildSelectionDiffWithOptions(previous, current, diffOptions{numericTolerance: 0.000001})
+	if diff == nil {
+		t.Fatal("expected diff result")
+	}
+	if diff.Changed {
+		t.Fatal("expected jitter within tolerance to be unchanged")
+	}
+	if diff.Summary != "number unchanged" {
+		t.Fatalf("expected unchanged summary, got %q", diff.Summary)
+	}
+
+	exact := buildSelectionDiff(previous, current)
+	if exact == nil || !exact.Changed {
+		t.Fatal("expected exact comparison to report a change")
+	}
+}
+
+func TestBuildSelectionDiffNumberBeyondToleranceChanged(t *testing.T) {
+	previous := &selectionSnapshot{Exists: true, Type: "number", Value: "1.50"}
+	current := &selectionSnapshot{Exists: true, Type: "number", Value: "1.53"}
+
+	diff := buildSelectionDiffWithOptions(previous, current, diffOptions{numericTolerance: 0.01})
+	if diff == nil {
+		t.Fatal("expected diff result")
+	}
+	if !diff.Changed {
+		t.Fatal("expected delta beyond tolerance to be changed")
+	}
+}
+
+func TestBuildSelectionDiffObjectIgnoresDeltasWithinTolerance(t *testing.T) {
+	previous := &selectionSnapshot{Exists: true, Type: "json", Raw: `{"rate":1.1000001,"bid":5}`, Value: `{"bid":5,"rate":1.1000001}`}
+	current := &selectionSnapshot{Exists: true, Type: "json", Raw: `{"rate":1.1000002,"bid":6}`, Value: `{"bid":6,"rate":1.1000002}`}
+
+	diff := buildSelectionDiffWithOptions(previous, current, diffOptions{numericTolerance: 0.0001})
+	if diff == nil {
+		t.Fatal("expected diff result")
+	}
+	if !diff.Changed {
+		t.Fatal("expected bid change to be reported")
+	}
+
+	changed, ok := diff.Details["changed"].([]string)
+	if !ok {
+		t.Fatalf("expected changed paths, got %#v", diff.Details["changed"])
+	}
+	if len(changed) != 1 || changed[0] != "bid" {
+		t.Fatalf("expected only bid to change, got %#v", changed)
+	}
+}
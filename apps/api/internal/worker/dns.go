@@ -0,0 +1,80 @@
+package worker
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"strings"
+	"time"
+)
+
+const dnsDialTimeout = 5 * time.Second
+
+// NormalizeDNSServer returns a DNS server address as host:port, defaulting the
+// port to 53.
+func NormalizeDNSServer(raw string) (string, error) {
+	server := strings.TrimSpace(raw)
+	if server == "" {
+		return "", errors.New("dns server is empty")
+	}
+	if _, _, err := net.SplitHostPort(server); err != nil {
+		server = net.JoinHostPort(strings.Trim(server, "[]"), "53")
+	}
+	host, port, err := net.SplitHostPort(server)
+	if err != nil || host == "" || port == "" {
+		return "", fmt.Errorf("invalid dns server %q", raw)
+	}
+	return server, nil
+}
+
+// newResolver returns a resolver that sends every lookup to server instead of
+// the system configuration, or nil to use the system resolver.
+func newResolver(server string) *net.Resolver {
+	if server == "" {
+		return nil
+	}
+
+	dialer := &net.Dialer{Timeout: dnsDialTimeout}
+	return &net.Resolver{
+		PreferGo: true,
+		Dial: func(ctx context.Context, network, _ string) (net.Conn, error) {
+			return dialer.DialContext(ctx, network, server)
+		},
+	}
+}
+
+// NormalizeHostOverrides validates a monitor's host to IP overrides and
+// lowercases the host names.
+func NormalizeHostOverrides(raw map[string]string) (map[string]string, error) {
+	normalized := make(map[string]string, len(raw))
+	for rawHost, rawIP := range raw {
+		host := strings.ToLower(strings.TrimSpace(rawHost))
+		if host == "" || strings.ContainsAny(host, ":/ ") {
+			return nil, fmt.Errorf("invalid host %q", rawHost)
+		}
+		ip := strings.TrimSpace(rawIP)
+		if net.ParseIP(ip) == nil {
+			return nil, fmt.Errorf("invalid IP address %q for host %q", rawIP, host)
+		}
+		normalized[host] = ip
+	}
+	return normalized, nil
+}
+
+type dialContextFunc func(ctx context.Context, network, addr string) (net.Conn, error)
+
+// dialWithHostOverrides connects to the overridden IP for matching hosts,
+// like curl --resolve. The request URL, Host header and TLS server name keep
+// the original host name.
+func dialWithHostOverrides(dial dialContextFunc, overrides map[string]string) dialContextFunc {
+	return func(ctx context.Context, network, addr string) (net.Conn, error) {
+		host, port, err := net.SplitHostPort(addr)
+		if err == nil {
+			if ip, ok := overrides[strings.ToLower(host)]; ok {
+				addr = net.JoinHostPort(ip, port)
+			}
+		}
+		return dial(ctx, network, addr)
+	}
+}
@@ -0,0 +1,75 @@
+package worker
+
+import (
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"goanna/apps/api/ent"
+	"goanna/apps/api/ent/monitor"
+)
+
+func TestExecuteOnceAppliesHostOverrides(t *testing.T) {
+	var gotHost string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotHost = r.Host
+		_, _ = w.Write([]byte("origin"))
+	}))
+	defer server.Close()
+
+	serverURL, err := url.Parse(server.URL)
+	if err != nil {
+		t.Fatalf("expected server URL to parse: %v", err)
+	}
+	_, port, _ := net.SplitHostPort(serverURL.Host)
+
+	w := &Worker{client: &http.Client{}, maxResponseBodyBytes: DefaultMaxResponseBodyBytes}
+	row := &ent.Monitor{
+		Method:        http.MethodGet,
+		URL:           "http://origin.goanna.invalid:" + port + "/",
+		ExpectedType:  monitor.ExpectedTypeText,
+		HostOverrides: map[string]string{"origin.goanna.invalid": "127.0.0.1"},
+	}
+
+	result := w.executeOnce(t.Context(), row, CheckDefaults{})
+	if !result.success {
+		t.Fatalf("expected host override to reach the test server, got error=%v", result.errorMessage)
+	}
+	if gotHost != "origin.goanna.invalid:"+port {
+		t.Fatalf("expected original Host header, got %q", gotHost)
+	}
+}
+
+func TestNormalizeHostOverridesAndDNSServer(t *testing.T) {
+	overrides, err := NormalizeHostOverrides(map[string]string{" Example.COM ": " 203.0.113.7 "})
+	if err != nil {
+		t.Fatalf("expected overrides to normalize: %v", err)
+	}
+	if overrides["example.com"] != "203.0.113.7" {
+		t.Fatalf("expected lowercased host override, got %#v", overrides)
+	}
+	if _, err := NormalizeHostOverrides(map[string]string{"example.com": "not-an-ip"}); err == nil {
+		t.Fatal("expected invalid IP to fail")
+	}
+	if _, err := NormalizeHostOverrides(map[string]string{"example.com:443": "203.0.113.7"}); err == nil {
+		t.Fatal("expected host with port to fail")
+	}
+
+	cases := map[string]string{
+		"1.1.1.1":         "1.1.1.1:53",
+		"9.9.9.9:5353":    "9.9.9.9:5353",
+		"2606:4700::1111": "[2606:4700::1111]:53",
+		"dns.internal":    "dns.internal:53",
+	}
+	for raw, expected := range cases {
+		server, err := NormalizeDNSServer(raw)
+		if err != nil || server != expected {
+			t.Fatalf("expected %q to normalize to %q, got %q err=%v", raw, expected, server, err)
+		}
+	}
+	if _, err := NormalizeDNSServer(" "); err == nil {
+		t.Fatal("expected empty DNS server to fail")
+	}
+}
@@ -0,0 +1,56 @@
+package worker
+
+import (
+	"bufio"
+	"compress/flate"
+	"compress/gzip"
+	"compress/zlib"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// DecodeContentEncoding wraps body in decoders for a Content-Encoding header
+// value. net/http only decompresses gzip when it set Accept-Encoding itself, so
+// monitors sending their own Accept-Encoding header receive encoded bodies.
+// Encodings are undone in reverse order of application; brotli is reported as
+// unsupported rather than passed on as binary data.
+func DecodeContentEncoding(contentEncoding string, body io.Reader) (io.Reader, error) {
+	encodings := strings.Split(contentEncoding, ",")
+	for i := len(encodings) - 1; i >= 0; i-- {
+		encoding := strings.ToLower(strings.TrimSpace(encodings[i]))
+		switch encoding {
+		case "", "identity":
+		case "gzip", "x-gzip":
+			reader, err := gzip.NewReader(body)
+			if err != nil {
+				return nil, fmt.Errorf("failed decoding gzip response body: %w", err)
+			}
+			body = reader
+		case "deflate":
+			reader, err := newDeflateReader(body)
+			if err != nil {
+				return nil, fmt.Errorf("failed decoding deflate response body: %w", err)
+			}
+			body = reader
+		default:
+			return nil, fmt.Errorf("unsupported content encoding %q", encoding)
+		}
+	}
+	return body, nil
+}
+
+// newDeflateReader accepts both zlib-wrapped deflate, which HTTP specifies,
+// and the raw deflate streams some servers send instead.
+func newDeflateReader(body io.Reader) (io.Reader, error) {
+	buffered := bufio.NewReader(body)
+	header, err := buffered.Peek(2)
+	if err != nil && err != io.EOF {
+		return nil, err
+	}
+
+	if len(header) == 2 && header[0]&0x0f == 8 && (uint16(header[0])<<8|uint16(header[1]))%31 == 0 {
+		return zlib.NewReader(buffered)
+	}
+	return flate.NewReader(buffered), nil
+}
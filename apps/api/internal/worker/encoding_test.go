@@ -0,0 +1,91 @@
+package worker
+
+import (
+	"bytes"
+	"compress/flate"
+	"compress/gzip"
+	"compress/zlib"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"goanna/apps/api/ent"
+	"goanna/apps/api/ent/monitor"
+)
+
+func TestDecodeContentEncoding(t *testing.T) {
+	body := []byte(`{"status":"online"}`)
+
+	var gzipped bytes.Buffer
+	gzipWriter := gzip.NewWriter(&gzipped)
+	_, _ = gzipWriter.Write(body)
+	_ = gzipWriter.Close()
+
+	var zlibbed bytes.Buffer
+	zlibWriter := zlib.NewWriter(&zlibbed)
+	_, _ = zlibWriter.Write(body)
+	_ = zlibWriter.Close()
+
+	var deflated bytes.Buffer
+	flateWriter, _ := flate.NewWriter(&deflated, flate.DefaultCompression)
+	_, _ = flateWriter.Write(body)
+	_ = flateWriter.Close()
+
+	cases := map[string]struct {
+		encoding string
+		payload  []byte
+	}{
+		"identity":    {encoding: "", payload: body},
+		"gzip":        {encoding: "gzip", payload: gzipped.Bytes()},
+		"zlibDeflate": {encoding: "deflate", payload: zlibbed.Bytes()},
+		"rawDeflate":  {encoding: "Deflate", payload: deflated.Bytes()},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			reader, err := DecodeContentEncoding(tc.encoding, bytes.NewReader(tc.payload))
+			if err != nil {
+				t.Fatalf("expected body to decode: %v", err)
+			}
+			decoded, err := io.ReadAll(reader)
+			if err != nil {
+				t.Fatalf("expected body to read: %v", err)
+			}
+			if !bytes.Equal(decoded, body) {
+				t.Fatalf("expected %q, got %q", body, decoded)
+			}
+		})
+	}
+
+	if _, err := DecodeContentEncoding("br", bytes.NewReader(body)); err == nil {
+		t.Fatal("expected brotli to be reported as unsupported")
+	}
+}
+
+func TestExecuteOnceDecodesCompressedBody(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("Content-Encoding", "gzip")
+		writer := gzip.NewWriter(w)
+		_, _ = writer.Write([]byte(`{"status":"online"}`))
+		_ = writer.Close()
+	}))
+	defer server.Close()
+
+	w := &Worker{client: server.Client(), maxResponseBodyBytes: DefaultMaxResponseBodyBytes}
+	selector := "status"
+	expected := "online"
+	row := &ent.Monitor{
+		Method:           http.MethodGet,
+		URL:              server.URL,
+		Headers:          map[string]string{"Accept-Encoding": "gzip"},
+		ExpectedType:     monitor.ExpectedTypeJSON,
+		Selector:         &selector,
+		ExpectedResponse: &expected,
+	}
+
+	result := w.executeOnce(t.Context(), row, CheckDefaults{})
+	if !result.success {
+		t.Fatalf("expected compressed JSON to be decoded, got error=%v", result.errorMessage)
+	}
+}
@@ -0,0 +1,67 @@
+package worker
+
+import (
+	"fmt"
+	"maps"
+	"os"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+// EnvReferencePrefix is the prefix of the environment variables a header or
+// auth value may reference, so monitors cannot read the server's own
+// configuration such as GOANNA_DSN or cloud credentials.
+const EnvReferencePrefix = "GOANNA_SECRET_"
+
+var envReferencePattern = regexp.MustCompile(`\$\{ENV:([A-Za-z_][A-Za-z0-9_]*)\}`)
+
+// ExpandEnvReferences replaces ${ENV:NAME} references in a header or auth
+// value with the NAME environment variable, so secrets can stay out of the
+// database and exports. Unset variables expand to an empty string; names
+// without EnvReferencePrefix are never resolved and are left as they are.
+func ExpandEnvReferences(raw string) string {
+	if !strings.Contains(raw, "${ENV:") {
+		return raw
+	}
+
+	return envReferencePattern.ReplaceAllStringFunc(raw, func(reference string) string {
+		name := envReferencePattern.FindStringSubmatch(reference)[1]
+		if !strings.HasPrefix(name, EnvReferencePrefix) {
+			return reference
+		}
+		return os.Getenv(name)
+	})
+}
+
+// HasEnvReference reports whether value contains an ${ENV:NAME} reference.
+func HasEnvReference(value string) bool {
+	return envReferencePattern.MatchString(value)
+}
+
+// ValidateEnvReferences rejects values that reference an environment variable
+// without EnvReferencePrefix.
+func ValidateEnvReferences(values map[string]string) error {
+	for _, key := range slices.Sorted(maps.Keys(values)) {
+		for _, match := range envReferencePattern.FindAllStringSubmatch(values[key], -1) {
+			if !strings.HasPrefix(match[1], EnvReferencePrefix) {
+				return fmt.Errorf("%s: ${ENV:%s} is not allowed, referenced variables must start with %s", key, match[1], EnvReferencePrefix)
+			}
+		}
+	}
+	return nil
+}
+
+// ExpandAuthEnvReferences returns a copy of auth with environment references
+// expanded in every value.
+func ExpandAuthEnvReferences(auth map[string]string) map[string]string {
+	if len(auth) == 0 {
+		return auth
+	}
+
+	expanded := maps.Clone(auth)
+	for key, value := range expanded {
+		expanded[key] = ExpandEnvReferences(value)
+	}
+	return expanded
+}
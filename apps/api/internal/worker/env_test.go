@@ -0,0 +1,52 @@
+package worker
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestExpandEnvReferences(t *testing.T) {
+	t.Setenv("GOANNA_SECRET_TEST_API_KEY", "s3cret")
+	t.Setenv("GOANNA_TEST_DSN", "file:goanna.db")
+
+	cases := map[string]string{
+		"${ENV:GOANNA_SECRET_TEST_API_KEY}":        "s3cret",
+		"Bearer ${ENV:GOANNA_SECRET_TEST_API_KEY}": "Bearer s3cret",
+		"${ENV:GOANNA_SECRET_TEST_UNSET_KEY}":      "",
+		"${ENV:GOANNA_TEST_DSN}":                   "${ENV:GOANNA_TEST_DSN}",
+		"${ENV:not valid}":                         "${ENV:not valid}",
+		"$GOANNA_SECRET_TEST_API_KEY":              "$GOANNA_SECRET_TEST_API_KEY",
+		"plain":                                    "plain",
+	}
+	for raw, expected := range cases {
+		if actual := ExpandEnvReferences(raw); actual != expected {
+			t.Fatalf("expected %q to expand to %q, got %q", raw, expected, actual)
+		}
+	}
+}
+
+func TestApplyAuthExpandsEnvReferences(t *testing.T) {
+	t.Setenv("GOANNA_SECRET_TEST_TOKEN", "abc123")
+
+	auth := map[string]string{"type": "bearer", "token": "${ENV:GOANNA_SECRET_TEST_TOKEN}"}
+	req, _ := http.NewRequest(http.MethodGet, "https://example.com", nil)
+	applyAuth(req, ExpandAuthEnvReferences(auth))
+
+	if got := req.Header.Get("Authorization"); got != "Bearer abc123" {
+		t.Fatalf("expected resolved bearer token, got %q", got)
+	}
+	if auth["token"] != "${ENV:GOANNA_SECRET_TEST_TOKEN}" {
+		t.Fatalf("expected stored auth to keep the reference, got %q", auth["token"])
+	}
+}
+
+func TestValidateEnvReferences(t *testing.T) {
+	if err := ValidateEnvReferences(map[string]string{"token": "${ENV:GOANNA_SECRET_SHOP}", "type": "bearer"}); err != nil {
+		t.Fatalf("expected prefixed reference to be allowed: %v", err)
+	}
+	err := ValidateEnvReferences(map[string]string{"X-Debug": "${ENV:GOANNA_SECRET_SHOP}:${ENV:GOANNA_DSN}"})
+	if err == nil || !strings.Contains(err.Error(), "GOANNA_DSN") {
+		t.Fatalf("expected reference without the prefix to be rejected, got %v", err)
+	}
+}
@@ -0,0 +1,167 @@
+package worker
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"goanna/apps/api/ent"
+	"goanna/apps/api/ent/monitor"
+	"goanna/apps/api/ent/notificationevent"
+)
+
+// escalationPolicyActive reports whether the monitor alerts on failures: the
+// first failure goes to its notification channels and, if it keeps failing
+// unacknowledged for EscalationAfterMinutes, to its escalation channels.
+func escalationPolicyActive(row *ent.Monitor) bool {
+	return row != nil &&
+		row.EscalationAfterMinutes != nil &&
+		*row.EscalationAfterMinutes > 0 &&
+		len(row.EscalationChannels) > 0
+}
+
+func shouldEscalate(row *ent.Monitor, runtime *ent.MonitorRuntime, now time.Time) bool {
+	if !escalationPolicyActive(row) || runtime == nil || runtime.FailingSince == nil {
+		return false
+	}
+	if runtime.EscalatedAt != nil || runtime.AcknowledgedAt != nil {
+		return false
+	}
+
+	after := time.Duration(*row.EscalationAfterMinutes) * time.Minute
+	return !now.Before(runtime.FailingSince.Add(after))
+}
+
+func (w *Worker) handleFailureEscalation(ctx context.Context, row *ent.Monitor, runtime *ent.MonitorRuntime, result executionResult) error {
+	if result.success || !escalationPolicyActive(row) || runtime == nil || runtime.FailingSince == nil {
+		return nil
+	}
+
+	paused, err := w.notificationsPaused(ctx)
+	if err != nil {
+		return err
+	}
+	if paused {
+		return nil
+	}
+
+	if runtime.ConsecutiveErrors == 1 {
+		_, err := w.sendFailureNotification(ctx, row, runtime, result, row.NotificationChannels, notificationevent.KindFailure, 0, nil)
+		return err
+	}
+
+	if !shouldEscalate(row, runtime, result.checkedAt) {
+		return nil
+	}
+
+	parent, err := w.db.NotificationEvent.Query().
+		Where(
+			notificationevent.HasMonitorWith(monitor.IDEQ(row.ID)),
+			notificationevent.KindEQ(notificationevent.KindFailure),
+			notificationevent.SentAtGTE(*runtime.FailingSince),
+		).
+		Order(ent.Desc(notificationevent.FieldSentAt), ent.Desc(notificationevent.FieldID)).
+		First(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		return err
+	}
+
+	sent, notifyErr := w.sendFailureNotification(ctx, row, runtime, result, row.EscalationChannels, notificationevent.KindEscalation, 1, parent)
+	if sent == 0 {
+		return notifyErr
+	}
+
+	if _, err := w.db.MonitorRuntime.UpdateOneID(runtime.ID).
+		SetEscalatedAt(result.checkedAt).
+		Save(ctx); err != nil {
+		return err
+	}
+
+	return notifyErr
+}
+
+func (w *Worker) sendFailureNotification(
+	ctx context.Context,
+	row *ent.Monitor,
+	runtime *ent.MonitorRuntime,
+	result executionResult,
+	kinds []string,
+	kind notificationevent.Kind,
+	level int,
+	parent *ent.NotificationEvent,
+) (int, error) {
+	channels, err := w.enabledChannelsForKinds(ctx, kinds)
+	if err != nil {
+		return 0, err
+	}
+
+	message := formatMonitorFailureMessage(row, runtime, result, level)
+	summary := monitorFailureSummary(result)
+	sent := 0
+	var notifyErr error
+	for _, channel := range channels {
+		status := "sent"
+		eventMessage := summary
+
+		if err := w.sendMonitorDiffToChannel(ctx, channel, message); err != nil {
+			status = "error"
+			eventMessage = err.Error()
+			notifyErr = err
+		} else {
+			sent++
+		}
+
+		eventCreate := w.db.NotificationEvent.Create().
+			SetMonitorID(row.ID).
+			SetChannelID(channel.ID).
+			SetKind(kind).
+			SetEscalationLevel(level).
+			SetStatus(status).
+			SetMessage(eventMessage).
+			SetSentAt(result.checkedAt)
+		if parent != nil {
+			eventCreate = eventCreate.SetEscalatedFromID(parent.ID)
+		}
+		if err := w.saveNotificationEvent(ctx, withRunID(eventCreate, result.runID)); err != nil {
+			notifyErr = err
+		}
+	}
+
+	return sent, notifyErr
+}
+
+func monitorFailureSummary(result executionResult) string {
+	if result.errorMessage != nil && strings.TrimSpace(*result.errorMessage) != "" {
+		return *result.errorMessage
+	}
+	if result.statusCode != nil {
+		return fmt.Sprintf("unexpected status code: %d", *result.statusCode)
+	}
+	return "check failed"
+}
+
+func formatMonitorFailureMessage(row *ent.Monitor, runtime *ent.MonitorRuntime, result executionResult, level int) string {
+	monitorLine := fmt.Sprintf("Monitor: %d", row.ID)
+	if monitorLabel := monitorNotificationLabel(row); monitorLabel != "" {
+		monitorLine = fmt.Sprintf("Monitor: %s (#%d)", monitorLabel, row.ID)
+	}
+
+	title := "Goanna monitor failing"
+	if level > 0 {
+		title = "Goanna monitor failing (escalated)"
+	}
+
+	lines := []string{
+		title,
+		monitorLine,
+		fmt.Sprintf("URL: %s", row.URL),
+		fmt.Sprintf("CheckedAt (UTC): %s", result.checkedAt.UTC().Format(time.RFC3339)),
+	}
+	if runtime != nil && runtime.FailingSince != nil {
+		lines = append(lines, fmt.Sprintf("FailingSince (UTC): %s", runtime.FailingSince.UTC().Format(time.RFC3339)))
+	}
+	lines = append(lines, fmt.Sprintf("Error: %s", monitorFailureSummary(result)))
+
+	return strings.Join(lines, "\n")
+}
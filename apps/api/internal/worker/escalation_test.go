@@ -0,0 +1,67 @@
+package worker
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"goanna/apps/api/ent"
+)
+
+func TestShouldEscalateAfterConfiguredMinutes(t *testing.T) {
+	afterMinutes := 15
+	row := &ent.Monitor{
+		EscalationChannels:     []string{"telegram"},
+		EscalationAfterMinutes: &afterMinutes,
+	}
+	failingSince := time.Date(2026, time.March, 1, 12, 0, 0, 0, time.UTC)
+	runtime := &ent.MonitorRuntime{FailingSince: &failingSince}
+
+	if shouldEscalate(row, runtime, failingSince.Add(14*time.Minute)) {
+		t.Fatal("expected no escalation before the threshold")
+	}
+	if !shouldEscalate(row, runtime, failingSince.Add(15*time.Minute)) {
+		t.Fatal("expected escalation at the threshold")
+	}
+
+	acknowledgedAt := failingSince.Add(5 * time.Minute)
+	acknowledged := &ent.MonitorRuntime{FailingSince: &failingSince, AcknowledgedAt: &acknowledgedAt}
+	if shouldEscalate(row, acknowledged, failingSince.Add(time.Hour)) {
+		t.Fatal("expected acknowledged failure not to escalate")
+	}
+
+	escalatedAt := failingSince.Add(15 * time.Minute)
+	escalated := &ent.MonitorRuntime{FailingSince: &failingSince, EscalatedAt: &escalatedAt}
+	if shouldEscalate(row, escalated, failingSince.Add(time.Hour)) {
+		t.Fatal("expected failure to escalate only once")
+	}
+}
+
+func TestShouldEscalateRequiresPolicy(t *testing.T) {
+	failingSince := time.Date(2026, time.March, 1, 12, 0, 0, 0, time.UTC)
+	runtime := &ent.MonitorRuntime{FailingSince: &failingSince}
+
+	if shouldEscalate(&ent.Monitor{}, runtime, failingSince.Add(time.Hour)) {
+		t.Fatal("expected monitor without policy not to escalate")
+	}
+}
+
+func TestFormatMonitorFailureMessage(t *testing.T) {
+	label := "Prices"
+	row := &ent.Monitor{ID: 7, Label: &label, URL: "https://example.com"}
+	failingSince := time.Date(2026, time.March, 1, 12, 0, 0, 0, time.UTC)
+	errMsg := "unexpected status code: 503"
+	result := executionResult{checkedAt: failingSince.Add(20 * time.Minute), errorMessage: &errMsg}
+
+	message := formatMonitorFailureMessage(row, &ent.MonitorRuntime{FailingSince: &failingSince}, result, 1)
+	for _, want := range []string{
+		"Goanna monitor failing (escalated)",
+		"Monitor: Prices (#7)",
+		"FailingSince (UTC): 2026-03-01T12:00:00Z",
+		"Error: unexpected status code: 503",
+	} {
+		if !strings.Contains(message, want) {
+			t.Fatalf("expected message to contain %q, got %q", want, message)
+		}
+	}
+}
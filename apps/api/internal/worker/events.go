@@ -0,0 +1,152 @@
+package worker
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"goanna/apps/api/ent"
+
+	"go.opentelemetry.io/otel/metric"
+)
+
+// Event types broadcast to live dashboard clients.
+const (
+	EventCheckCompleted   = "check.completed"
+	EventMonitorUpdated   = "monitor.updated"
+	EventNotificationSent = "notification.sent"
+)
+
+// Event is a structured update about one monitor.
+type Event struct {
+	Type      string    `json:"type"`
+	MonitorID int       `json:"monitorId"`
+	At        time.Time `json:"at"`
+	Data      any       `json:"data,omitempty"`
+}
+
+// CheckCompletedData describes a recorded check and the monitor status it
+// left behind.
+type CheckCompletedData struct {
+	CheckID        int     `json:"checkId"`
+	Status         string  `json:"status"`
+	MonitorStatus  string  `json:"monitorStatus"`
+	StatusCode     *int    `json:"statusCode,omitempty"`
+	ResponseTimeMs *int    `json:"responseTimeMs,omitempty"`
+	ErrorMessage   *string `json:"errorMessage,omitempty"`
+	DiffChanged    bool    `json:"diffChanged"`
+}
+
+// NotificationSentData describes a recorded notification attempt.
+type NotificationSentData struct {
+	NotificationID int     `json:"notificationId"`
+	ChannelID      int     `json:"channelId"`
+	Kind           string  `json:"kind"`
+	Status         string  `json:"status"`
+	Message        *string `json:"message,omitempty"`
+	RunID          *string `json:"runId,omitempty"`
+}
+
+// Events fans worker and API events out to live subscribers. Slow
+// subscribers miss events rather than holding up checks. Publishing on a nil
+// *Events is a no-op.
+type Events struct {
+	mu          sync.Mutex
+	subscribers map[chan Event]struct{}
+}
+
+func NewEvents() *Events {
+	return &Events{subscribers: map[chan Event]struct{}{}}
+}
+
+func (e *Events) Publish(event Event) {
+	if e == nil {
+		return
+	}
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	for subscriber := range e.subscribers {
+		select {
+		case subscriber <- event:
+		default:
+		}
+	}
+}
+
+// Subscribe returns a channel receiving published events and a function that
+// stops the subscription. The channel of a nil *Events never receives.
+func (e *Events) Subscribe(buffer int) (<-chan Event, func()) {
+	subscriber := make(chan Event, buffer)
+	if e == nil {
+		return subscriber, func() {}
+	}
+
+	e.mu.Lock()
+	e.subscribers[subscriber] = struct{}{}
+	e.mu.Unlock()
+
+	return subscriber, func() {
+		e.mu.Lock()
+		delete(e.subscribers, subscriber)
+		e.mu.Unlock()
+	}
+}
+
+func (w *Worker) publishCheckCompleted(monitorID int, check *ent.CheckResult, runtime *ent.MonitorRuntime) {
+	w.events.Publish(Event{
+		Type:      EventCheckCompleted,
+		MonitorID: monitorID,
+		At:        check.CheckedAt.UTC(),
+		Data: CheckCompletedData{
+			CheckID:        check.ID,
+			Status:         check.Status,
+			MonitorStatus:  runtime.Status.String(),
+			StatusCode:     check.StatusCode,
+			ResponseTimeMs: check.ResponseTimeMs,
+			ErrorMessage:   check.ErrorMessage,
+			DiffChanged:    check.DiffChanged,
+		},
+	})
+}
+
+// withRunID tags a notification event with the check run that sent it.
+func withRunID(create *ent.NotificationEventCreate, runID string) *ent.NotificationEventCreate {
+	if runID == "" {
+		return create
+	}
+	return create.SetRunID(runID)
+}
+
+// saveNotificationEvent records a notification attempt and publishes it.
+func (w *Worker) saveNotificationEvent(ctx context.Context, create *ent.NotificationEventCreate) error {
+	event, err := create.Save(ctx)
+	if err != nil {
+		return err
+	}
+	vars.Add(varNotifications, 1)
+	if event.Status == "error" {
+		vars.Add(varNotificationErrors, 1)
+	}
+	notificationCounter.Add(ctx, 1, metric.WithAttributes(
+		attrNotification.String(event.Kind.String()),
+		attrDeliveryState.String(event.Status),
+	))
+
+	monitorID, _ := create.Mutation().MonitorID()
+	channelID, _ := create.Mutation().ChannelID()
+	w.events.Publish(Event{
+		Type:      EventNotificationSent,
+		MonitorID: monitorID,
+		At:        event.SentAt.UTC(),
+		Data: NotificationSentData{
+			NotificationID: event.ID,
+			ChannelID:      channelID,
+			Kind:           event.Kind.String(),
+			Status:         event.Status,
+			Message:        event.Message,
+			RunID:          event.RunID,
+		},
+	})
+	return nil
+}
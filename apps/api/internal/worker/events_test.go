@@ -0,0 +1,123 @@
+package worker
+
+import (
+	"bytes"
+	"expvar"
+	"log/slog"
+	"strings"
+	"testing"
+
+	"goanna/apps/api/ent"
+	"goanna/apps/api/ent/enttest"
+	"goanna/apps/api/ent/notificationevent"
+	"goanna/apps/api/internal/requestid"
+
+	_ "github.com/mattn/go-sqlite3"
+)
+
+func TestEventsPublishToSubscribers(t *testing.T) {
+	var disabled *Events
+	disabled.Publish(Event{Type: EventMonitorUpdated})
+	if _, stop := disabled.Subscribe(1); stop == nil {
+		t.Fatal("expected a nil Events to return a stop function")
+	}
+
+	events := NewEvents()
+	received, stop := events.Subscribe(1)
+
+	events.Publish(Event{Type: EventCheckCompleted, MonitorID: 1})
+	events.Publish(Event{Type: EventCheckCompleted, MonitorID: 2})
+	if event := <-received; event.MonitorID != 1 {
+		t.Fatalf("expected the first event, got %+v", event)
+	}
+	select {
+	case event := <-received:
+		t.Fatalf("expected events beyond the buffer to be dropped, got %+v", event)
+	default:
+	}
+
+	stop()
+	events.Publish(Event{Type: EventCheckCompleted, MonitorID: 3})
+	select {
+	case event := <-received:
+		t.Fatalf("expected no events after stopping, got %+v", event)
+	default:
+	}
+}
+
+func TestSaveNotificationEventTagsRunID(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:worker-notification-run-id?mode=memory&cache=shared&_fk=1")
+	defer client.Close()
+
+	row, err := client.Monitor.Create().
+		SetURL("https://example.com/health").
+		SetCron("*/5 * * * *").
+		Save(t.Context())
+	if err != nil {
+		t.Fatalf("expected monitor to save: %v", err)
+	}
+	channel, err := client.NotificationChannel.Create().
+		SetName("ops").
+		SetBotToken("token").
+		SetChatID("42").
+		Save(t.Context())
+	if err != nil {
+		t.Fatalf("expected channel to save: %v", err)
+	}
+
+	events := NewEvents()
+	received, stop := events.Subscribe(2)
+	defer stop()
+	w := NewWithConfig(client, Config{Events: events})
+	sent := varValue(varNotifications)
+
+	for _, runID := range []string{"3f2a9c1d0b7e4a55", ""} {
+		create := client.NotificationEvent.Create().
+			SetMonitorID(row.ID).
+			SetChannelID(channel.ID).
+			SetStatus("sent")
+		if err := w.saveNotificationEvent(t.Context(), withRunID(create, runID)); err != nil {
+			t.Fatalf("expected event to save: %v", err)
+		}
+	}
+
+	first := (<-received).Data.(NotificationSentData)
+	if first.RunID == nil || *first.RunID != "3f2a9c1d0b7e4a55" {
+		t.Fatalf("expected the run ID to be published, got %+v", first)
+	}
+	if second := (<-received).Data.(NotificationSentData); second.RunID != nil {
+		t.Fatalf("expected no run ID outside a check run, got %q", *second.RunID)
+	}
+
+	saved, err := client.NotificationEvent.Query().Order(ent.Asc(notificationevent.FieldID)).All(t.Context())
+	if err != nil || len(saved) != 2 || saved[0].RunID == nil || *saved[0].RunID != "3f2a9c1d0b7e4a55" || saved[1].RunID != nil {
+		t.Fatalf("expected the run ID to be saved on the first event only, got %+v (%v)", saved, err)
+	}
+	if got := varValue(varNotifications) - sent; got != 2 {
+		t.Fatalf("expected the notifications counter to count both events, got %d", got)
+	}
+}
+
+func varValue(name string) int64 {
+	if value, ok := vars.Get(name).(*expvar.Int); ok {
+		return value.Value()
+	}
+	return 0
+}
+
+func TestRunLoggerTagsRequestID(t *testing.T) {
+	var out bytes.Buffer
+	w := NewWithConfig(nil, Config{Logger: slog.New(slog.NewTextHandler(&out, nil))})
+
+	ctx := requestid.NewContext(t.Context(), "req-1")
+	w.runLogger(ctx, 4, "run-1").Info("check finished")
+	if got := out.String(); !strings.Contains(got, "monitor_id=4 run_id=run-1 request_id=req-1") {
+		t.Fatalf("expected the run and request IDs, got %q", got)
+	}
+
+	out.Reset()
+	w.runLogger(t.Context(), 4, "run-2").Info("check finished")
+	if got := out.String(); strings.Contains(got, "request_id") {
+		t.Fatalf("expected no request ID for scheduled runs, got %q", got)
+	}
+}
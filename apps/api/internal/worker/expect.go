@@ -0,0 +1,28 @@
+package worker
+
+import (
+	"time"
+
+	"goanna/apps/api/ent"
+)
+
+// isExpectedChange reports whether diff is the change pre-authorized through
+// the monitor's expect-change window. Such a change is recorded and becomes
+// the new baseline as usual, but is not alerted.
+func isExpectedChange(runtime *ent.MonitorRuntime, diff *selectionDiff, checkedAt time.Time) bool {
+	if runtime == nil || runtime.ExpectChangeUntil == nil || diff == nil || !diff.Changed {
+		return false
+	}
+	return !checkedAt.After(*runtime.ExpectChangeUntil)
+}
+
+func expectChangeWindowExpired(runtime *ent.MonitorRuntime, checkedAt time.Time) bool {
+	return runtime != nil && runtime.ExpectChangeUntil != nil && checkedAt.After(*runtime.ExpectChangeUntil)
+}
+
+func markDiffExpected(diff *selectionDiff) {
+	if diff.Details == nil {
+		diff.Details = map[string]any{}
+	}
+	diff.Details["expected"] = true
+}
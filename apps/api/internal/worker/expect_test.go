@@ -0,0 +1,39 @@
+package worker
+
+import (
+	"testing"
+	"time"
+
+	"goanna/apps/api/ent"
+)
+
+func TestIsExpectedChangeWithinWindow(t *testing.T) {
+	until := time.Date(2026, time.March, 1, 18, 0, 0, 0, time.UTC)
+	runtime := &ent.MonitorRuntime{ExpectChangeUntil: &until}
+	changed := &selectionDiff{Kind: "value", Changed: true}
+
+	if !isExpectedChange(runtime, changed, until.Add(-time.Hour)) {
+		t.Fatal("expected change inside the window to be expected")
+	}
+	if isExpectedChange(runtime, changed, until.Add(time.Minute)) {
+		t.Fatal("expected change after the window not to be expected")
+	}
+	if isExpectedChange(runtime, &selectionDiff{Kind: "value"}, until.Add(-time.Hour)) {
+		t.Fatal("expected unchanged diff not to consume the window")
+	}
+	if isExpectedChange(&ent.MonitorRuntime{}, changed, until) {
+		t.Fatal("expected change without window not to be expected")
+	}
+
+	if expectChangeWindowExpired(runtime, until) || !expectChangeWindowExpired(runtime, until.Add(time.Second)) {
+		t.Fatal("expected window to expire only after until")
+	}
+}
+
+func TestMarkDiffExpected(t *testing.T) {
+	diff := &selectionDiff{Kind: "value", Changed: true}
+	markDiffExpected(diff)
+	if diff.Details["expected"] != true {
+		t.Fatalf("expected diff details to flag expected change, got %#v", diff.Details)
+	}
+}
@@ -0,0 +1,314 @@
+package worker
+
+import (
+	"bytes"
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"goanna/apps/api/ent"
+	"goanna/apps/api/ent/monitor"
+	selectorutil "goanna/apps/api/internal/selector"
+)
+
+const maxFeedNotificationItems = 10
+
+// feedItem is the normalized form of an RSS item or Atom entry. ID is the
+// GUID/Atom id, falling back to the link and then the title.
+type feedItem struct {
+	ID        string `json:"id"`
+	Title     string `json:"title,omitempty"`
+	Link      string `json:"link,omitempty"`
+	Published string `json:"published,omitempty"`
+}
+
+type rssDocument struct {
+	Channel struct {
+		Items []rssItem `xml:"item"`
+	} `xml:"channel"`
+	// RSS 1.0 (RDF) places items next to the channel instead of inside it.
+	Items []rssItem `xml:"item"`
+}
+
+type rssItem struct {
+	GUID    string `xml:"guid"`
+	About   string `xml:"about,attr"`
+	Title   string `xml:"title"`
+	Link    string `xml:"link"`
+	PubDate string `xml:"pubDate"`
+	Date    string `xml:"date"`
+}
+
+type atomDocument struct {
+	Entries []atomEntry `xml:"entry"`
+}
+
+type atomEntry struct {
+	ID        string     `xml:"id"`
+	Title     string     `xml:"title"`
+	Links     []atomLink `xml:"link"`
+	Published string     `xml:"published"`
+	Updated   string     `xml:"updated"`
+}
+
+type atomLink struct {
+	Href string `xml:"href,attr"`
+	Rel  string `xml:"rel,attr"`
+}
+
+func isFeedMonitor(row *ent.Monitor) bool {
+	return row != nil && row.ExpectedType == monitor.ExpectedTypeFeed
+}
+
+func parseFeed(payload []byte) ([]feedItem, error) {
+	root, err := feedRootElement(payload)
+	if err != nil {
+		return nil, err
+	}
+
+	var items []feedItem
+	switch strings.ToLower(root) {
+	case "rss", "rdf":
+		var document rssDocument
+		if err := xml.Unmarshal(payload, &document); err != nil {
+			return nil, err
+		}
+		for _, item := range append(document.Channel.Items, document.Items...) {
+			items = append(items, feedItem{
+				ID:        firstNonEmpty(item.GUID, item.About, item.Link, item.Title),
+				Title:     strings.TrimSpace(item.Title),
+				Link:      strings.TrimSpace(item.Link),
+				Published: firstNonEmpty(item.PubDate, item.Date),
+			})
+		}
+	case "feed":
+		var document atomDocument
+		if err := xml.Unmarshal(payload, &document); err != nil {
+			return nil, err
+		}
+		for _, entry := range document.Entries {
+			link := atomEntryLink(entry.Links)
+			items = append(items, feedItem{
+				ID:        firstNonEmpty(entry.ID, link, entry.Title),
+				Title:     strings.TrimSpace(entry.Title),
+				Link:      link,
+				Published: firstNonEmpty(entry.Published, entry.Updated),
+			})
+		}
+	default:
+		return nil, fmt.Errorf("unsupported feed root <%s>", root)
+	}
+
+	return dedupeFeedItems(items), nil
+}
+
+func feedRootElement(payload []byte) (string, error) {
+	decoder := xml.NewDecoder(bytes.NewReader(payload))
+	for {
+		token, err := decoder.Token()
+		if err != nil {
+			return "", errors.New("response is not valid XML")
+		}
+		if start, ok := token.(xml.StartElement); ok {
+			return start.Name.Local, nil
+		}
+	}
+}
+
+func atomEntryLink(links []atomLink) string {
+	for _, link := range links {
+		if link.Rel == "" || link.Rel == "alternate" {
+			return strings.TrimSpace(link.Href)
+		}
+	}
+	if len(links) > 0 {
+		return strings.TrimSpace(links[0].Href)
+	}
+	return ""
+}
+
+func firstNonEmpty(values ...string) string {
+	for _, value := range values {
+		if trimmed := strings.TrimSpace(value); trimmed != "" {
+			return trimmed
+		}
+	}
+	return ""
+}
+
+// dedupeFeedItems drops items without an identity and keeps the first of any
+// repeated IDs so items can be keyed reliably.
+func dedupeFeedItems(items []feedItem) []feedItem {
+	seen := make(map[string]struct{}, len(items))
+	deduped := make([]feedItem, 0, len(items))
+	for _, item := range items {
+		if item.ID == "" {
+			continue
+		}
+		if _, ok := seen[item.ID]; ok {
+			continue
+		}
+		seen[item.ID] = struct{}{}
+		deduped = append(deduped, item)
+	}
+	return deduped
+}
+
+// evaluateFeed parses an RSS or Atom payload into a JSON array selection of
+// normalized items.
+func evaluateFeed(payload []byte) (bool, string, *selectorutil.Selection) {
+	items, err := parseFeed(payload)
+	if err != nil {
+		return false, fmt.Sprintf("invalid feed: %v", err), nil
+	}
+
+	encoded, err := json.Marshal(items)
+	if err != nil {
+		return false, fmt.Sprintf("invalid feed: %v", err), nil
+	}
+
+	return true, "", &selectorutil.Selection{
+		Exists: true,
+		Type:   "json",
+		Raw:    string(encoded),
+		Value:  string(encoded),
+	}
+}
+
+func decodeFeedItems(snapshot *selectionSnapshot) ([]feedItem, bool) {
+	if snapshot == nil || !snapshot.Exists {
+		return nil, false
+	}
+
+	var items []feedItem
+	if err := json.Unmarshal([]byte(snapshot.Value), &items); err != nil {
+		return nil, false
+	}
+	return items, true
+}
+
+// buildFeedDiff keys feed items by ID and only reports a change when new
+// items appear; items rolling off the end of a feed are not a change.
+func buildFeedDiff(previous *selectionSnapshot, current *selectionSnapshot) *selectionDiff {
+	currentItems, ok := decodeFeedItems(current)
+	if !ok {
+		return nil
+	}
+
+	previousItems, ok := decodeFeedItems(previous)
+	if !ok {
+		return &selectionDiff{
+			Kind:    "initial",
+			Changed: false,
+			Summary: fmt.Sprintf("initial feed captured (%d items)", len(currentItems)),
+			Details: map[string]any{
+				"type":     "feed",
+				"newCount": len(currentItems),
+			},
+		}
+	}
+
+	previousByID := make(map[string]feedItem, len(previousItems))
+	for _, item := range previousItems {
+		previousByID[item.ID] = item
+	}
+	currentIDs := make(map[string]struct{}, len(currentItems))
+
+	added := make([]string, 0)
+	updated := make([]string, 0)
+	newItems := make([]feedItem, 0)
+	for _, item := range currentItems {
+		currentIDs[item.ID] = struct{}{}
+		old, ok := previousByID[item.ID]
+		if !ok {
+			added = append(added, item.ID)
+			newItems = append(newItems, item)
+			continue
+		}
+		if old != item {
+			updated = append(updated, item.ID)
+		}
+	}
+
+	removed := make([]string, 0)
+	for _, item := range previousItems {
+		if _, ok := currentIDs[item.ID]; !ok {
+			removed = append(removed, item.ID)
+		}
+	}
+	sort.Strings(updated)
+	sort.Strings(removed)
+
+	changed := len(newItems) > 0
+	summary := "no new feed items"
+	switch {
+	case len(newItems) == 1:
+		summary = fmt.Sprintf("new feed item: %s", feedItemLabel(newItems[0]))
+	case len(newItems) > 1:
+		summary = fmt.Sprintf("%d new feed items", len(newItems))
+	}
+
+	return &selectionDiff{
+		Kind:    "arrayObject",
+		Changed: changed,
+		Summary: summary,
+		Details: map[string]any{
+			"keyField": "id",
+			"added":    added,
+			"removed":  removed,
+			"updated":  updated,
+			"newItems": newItems,
+		},
+	}
+}
+
+func feedItemLabel(item feedItem) string {
+	if item.Title != "" {
+		return item.Title
+	}
+	if item.Link != "" {
+		return item.Link
+	}
+	return item.ID
+}
+
+func formatFeedItemsNotificationDetail(details map[string]any) string {
+	items, ok := details["newItems"].([]feedItem)
+	if !ok || len(items) == 0 {
+		return ""
+	}
+
+	lines := make([]string, 0, len(items)+1)
+	for index, item := range items {
+		if index == maxFeedNotificationItems {
+			lines = append(lines, fmt.Sprintf("...and %d more", len(items)-index))
+			break
+		}
+
+		line := fmt.Sprintf("New: %s", truncateNotificationValue(feedItemLabel(item)))
+		if item.Link != "" && item.Link != feedItemLabel(item) {
+			line = fmt.Sprintf("%s\n  %s", line, item.Link)
+		}
+		lines = append(lines, line)
+	}
+	return strings.Join(lines, "\n")
+}
+
+// buildMonitorSelectionDiff diffs two selections using the monitor's feed,
+// sitemap or selector diff rules.
+func buildMonitorSelectionDiff(row *ent.Monitor, previous *selectionSnapshot, current *selectionSnapshot) *selectionDiff {
+	if isFeedMonitor(row) {
+		if diff := buildFeedDiff(previous, current); diff != nil {
+			return diff
+		}
+	}
+	if isSitemapMonitor(row) {
+		if diff := buildSitemapDiff(previous, current); diff != nil {
+			return diff
+		}
+	}
+	return buildSelectionDiffWithOptions(previous, current, diffOptionsForMonitor(row))
+}
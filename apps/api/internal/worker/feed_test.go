@@ -0,0 +1,89 @@
+package worker
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+const testRSSFeed = `<?xml version="1.0"?>
+<rss version="2.0"><channel><title>News</title>
+<item><guid>post-2</guid><title>Second</title><link>https://example.com/2</link></item>
+<item><title>First</title><link>https://example.com/1</link></item>
+<item><guid>post-2</guid><title>Duplicate</title></item>
+</channel></rss>`
+
+const testAtomFeed = `<?xml version="1.0" encoding="utf-8"?>
+<feed xmlns="http://www.w3.org/2005/Atom"><title>Releases</title>
+<entry><id>tag:example.com,2026:v2</id><title>v2</title>
+<link rel="self" href="https://example.com/self"/><link href="https://example.com/v2"/>
+<updated>2026-10-01T00:00:00Z</updated></entry>
+</feed>`
+
+func TestParseFeedRSSAndAtom(t *testing.T) {
+	items, err := parseFeed([]byte(testRSSFeed))
+	if err != nil {
+		t.Fatalf("expected RSS to parse: %v", err)
+	}
+	expected := []feedItem{
+		{ID: "post-2", Title: "Second", Link: "https://example.com/2"},
+		{ID: "https://example.com/1", Title: "First", Link: "https://example.com/1"},
+	}
+	if !reflect.DeepEqual(items, expected) {
+		t.Fatalf("expected RSS items %#v, got %#v", expected, items)
+	}
+
+	items, err = parseFeed([]byte(testAtomFeed))
+	if err != nil {
+		t.Fatalf("expected Atom to parse: %v", err)
+	}
+	expected = []feedItem{{ID: "tag:example.com,2026:v2", Title: "v2", Link: "https://example.com/v2", Published: "2026-10-01T00:00:00Z"}}
+	if !reflect.DeepEqual(items, expected) {
+		t.Fatalf("expected Atom items %#v, got %#v", expected, items)
+	}
+
+	if _, err := parseFeed([]byte(`<html><body>nope</body></html>`)); err == nil {
+		t.Fatal("expected html document to be rejected")
+	}
+}
+
+func TestBuildFeedDiffReportsNewItems(t *testing.T) {
+	ok, errMsg, previous := evaluateFeed([]byte(testRSSFeed))
+	if !ok {
+		t.Fatalf("expected feed to evaluate: %s", errMsg)
+	}
+	updatedFeed := strings.Replace(testRSSFeed, "<item><guid>post-2</guid>", "<item><guid>post-3</guid><title>Third</title><link>https://example.com/3</link></item><item><guid>post-2</guid>", 1)
+	ok, errMsg, current := evaluateFeed([]byte(updatedFeed))
+	if !ok {
+		t.Fatalf("expected updated feed to evaluate: %s", errMsg)
+	}
+
+	previousSnapshot := &selectionSnapshot{Exists: true, Type: previous.Type, Value: previous.Value}
+	currentSnapshot := &selectionSnapshot{Exists: true, Type: current.Type, Value: current.Value}
+
+	diff := buildFeedDiff(previousSnapshot, currentSnapshot)
+	if diff == nil || diff.Kind != "arrayObject" || !diff.Changed {
+		t.Fatalf("expected changed arrayObject diff, got %#v", diff)
+	}
+	if diff.Summary != "new feed item: Third" {
+		t.Fatalf("unexpected summary %q", diff.Summary)
+	}
+	if !reflect.DeepEqual(diff.Details["added"], []string{"post-3"}) {
+		t.Fatalf("expected added post-3, got %#v", diff.Details["added"])
+	}
+
+	detail := formatNotificationDetail(diff)
+	if detail != "New: Third\n  https://example.com/3" {
+		t.Fatalf("unexpected notification detail %q", detail)
+	}
+
+	unchanged := buildFeedDiff(currentSnapshot, previousSnapshot)
+	if unchanged == nil || unchanged.Changed {
+		t.Fatalf("expected removed items not to count as a change, got %#v", unchanged)
+	}
+
+	initial := buildFeedDiff(nil, currentSnapshot)
+	if initial == nil || initial.Kind != "initial" || initial.Changed {
+		t.Fatalf("expected unchanged initial diff, got %#v", initial)
+	}
+}
@@ -0,0 +1,34 @@
+package worker
+
+import (
+	"time"
+)
+
+const (
+	// ChangeFrequencyWindowDays is how many UTC days of change counts each
+	// monitor runtime keeps for frequency metrics.
+	ChangeFrequencyWindowDays = 30
+
+	changeDayLayout = "2006-01-02"
+)
+
+// ChangeDayKey returns the daily_change_counts key for the UTC day of t.
+func ChangeDayKey(t time.Time) string {
+	return t.UTC().Format(changeDayLayout)
+}
+
+// recordDailyChange returns a copy of counts with one change added for the day
+// of checkedAt and days outside the frequency window dropped.
+func recordDailyChange(counts map[string]int, checkedAt time.Time) map[string]int {
+	cutoff := ChangeDayKey(checkedAt.AddDate(0, 0, -(ChangeFrequencyWindowDays - 1)))
+
+	updated := make(map[string]int, len(counts)+1)
+	for day, count := range counts {
+		if day >= cutoff {
+			updated[day] = count
+		}
+	}
+	updated[ChangeDayKey(checkedAt)]++
+
+	return updated
+}
@@ -0,0 +1,34 @@
+package worker
+
+import (
+	"testing"
+	"time"
+)
+
+func TestRecordDailyChangeCountsAndPrunesWindow(t *testing.T) {
+	checkedAt := time.Date(2026, time.March, 31, 23, 30, 0, 0, time.UTC)
+	counts := map[string]int{
+		"2026-03-31": 2,
+		"2026-03-02": 4,
+		"2026-03-01": 7,
+	}
+
+	updated := recordDailyChange(counts, checkedAt)
+	if updated["2026-03-31"] != 3 {
+		t.Fatalf("expected today's count to increment to 3, got %d", updated["2026-03-31"])
+	}
+	if updated["2026-03-02"] != 4 {
+		t.Fatalf("expected oldest in-window day to be kept, got %d", updated["2026-03-02"])
+	}
+	if _, ok := updated["2026-03-01"]; ok {
+		t.Fatal("expected day outside the window to be pruned")
+	}
+	if counts["2026-03-31"] != 2 {
+		t.Fatal("expected input counts to be left untouched")
+	}
+
+	initial := recordDailyChange(nil, checkedAt)
+	if len(initial) != 1 || initial["2026-03-31"] != 1 {
+		t.Fatalf("expected single change for empty counts, got %#v", initial)
+	}
+}
@@ -0,0 +1,152 @@
+package worker
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/netip"
+	"strings"
+	"syscall"
+	"time"
+)
+
+// DefaultBlockedNetworks are the private, loopback and link-local ranges
+// blocked when SSRF protection is enabled without an explicit blocklist.
+var DefaultBlockedNetworks = []string{
+	"0.0.0.0/8",
+	"10.0.0.0/8",
+	"100.64.0.0/10",
+	"127.0.0.0/8",
+	"169.254.0.0/16",
+	"172.16.0.0/12",
+	"192.168.0.0/16",
+	"::/128",
+	"::1/128",
+	"fc00::/7",
+	"fe80::/10",
+}
+
+// ErrBlockedByNetworkPolicy is wrapped by errors for connections a
+// NetworkGuard refuses.
+var ErrBlockedByNetworkPolicy = errors.New("blocked by network policy")
+
+// NetworkGuard rejects connections to blocked networks unless the address is
+// also in an allowed network. It is enforced when a connection is dialled, so
+// it covers redirects and DNS answers rather than only the URL as written.
+type NetworkGuard struct {
+	blocked []netip.Prefix
+	allowed []netip.Prefix
+}
+
+// NewNetworkGuard parses CIDR blocklists and allowlists.
+func NewNetworkGuard(blocked []string, allowed []string) (*NetworkGuard, error) {
+	blockedPrefixes, err := parsePrefixes(blocked)
+	if err != nil {
+		return nil, err
+	}
+	allowedPrefixes, err := parsePrefixes(allowed)
+	if err != nil {
+		return nil, err
+	}
+	return &NetworkGuard{blocked: blockedPrefixes, allowed: allowedPrefixes}, nil
+}
+
+func parsePrefixes(raw []string) ([]netip.Prefix, error) {
+	prefixes := make([]netip.Prefix, 0, len(raw))
+	for _, value := range raw {
+		value = strings.TrimSpace(value)
+		if value == "" {
+			continue
+		}
+		if !strings.Contains(value, "/") {
+			addr, err := netip.ParseAddr(value)
+			if err != nil {
+				return nil, fmt.Errorf("invalid network %q", value)
+			}
+			prefixes = append(prefixes, netip.PrefixFrom(addr, addr.BitLen()))
+			continue
+		}
+		prefix, err := netip.ParsePrefix(value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid network %q", value)
+		}
+		prefixes = append(prefixes, prefix.Masked())
+	}
+	return prefixes, nil
+}
+
+// CheckAddr returns an error when connecting to addr is not permitted.
+func (g *NetworkGuard) CheckAddr(addr netip.Addr) error {
+	if g == nil {
+		return nil
+	}
+
+	// Prefixes never contain zoned addresses, so fe80::1%eth0 would slip
+	// past the link-local block with its zone.
+	addr = addr.Unmap().WithZone("")
+	for _, prefix := range g.allowed {
+		if prefix.Contains(addr) {
+			return nil
+		}
+	}
+	for _, prefix := range g.blocked {
+		if prefix.Contains(addr) {
+			return fmt.Errorf("connection to %s %w (%s)", addr, ErrBlockedByNetworkPolicy, prefix)
+		}
+	}
+	return nil
+}
+
+// Control is a net.Dialer Control hook that checks the address about to be
+// connected to.
+func (g *NetworkGuard) Control(_ string, address string, _ syscall.RawConn) error {
+	if g == nil {
+		return nil
+	}
+
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return err
+	}
+	addr, err := netip.ParseAddr(host)
+	if err != nil {
+		return err
+	}
+	return g.CheckAddr(addr)
+}
+
+// CheckHost resolves host and checks every address it maps to, for fetches
+// that do not go through a guarded dialer such as proxied checks.
+func (g *NetworkGuard) CheckHost(ctx context.Context, host string) error {
+	if g == nil {
+		return nil
+	}
+
+	if addr, err := netip.ParseAddr(host); err == nil {
+		return g.CheckAddr(addr)
+	}
+	addrs, err := net.DefaultResolver.LookupNetIP(ctx, "ip", host)
+	if err != nil {
+		return err
+	}
+	for _, addr := range addrs {
+		if err := g.CheckAddr(addr); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// NewGuardedDialer returns the dialer checks connect with.
+func NewGuardedDialer(guard *NetworkGuard, resolver *net.Resolver) *net.Dialer {
+	dialer := &net.Dialer{
+		Timeout:   30 * time.Second,
+		KeepAlive: 30 * time.Second,
+		Resolver:  resolver,
+	}
+	if guard != nil {
+		dialer.Control = guard.Control
+	}
+	return dialer
+}
@@ -0,0 +1,68 @@
+package worker
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	"strings"
+	"testing"
+
+	"goanna/apps/api/ent"
+	"goanna/apps/api/ent/monitor"
+)
+
+func TestNetworkGuardCheckAddr(t *testing.T) {
+	guard, err := NewNetworkGuard(DefaultBlockedNetworks, []string{"10.1.2.3"})
+	if err != nil {
+		t.Fatalf("expected guard to parse: %v", err)
+	}
+
+	cases := map[string]bool{
+		"10.0.0.5":         false,
+		"10.1.2.3":         true,
+		"172.20.1.1":       false,
+		"192.168.1.10":     false,
+		"169.254.169.254":  false,
+		"127.0.0.1":        false,
+		"::1":              false,
+		"::":               false,
+		"fe80::1%eth0":     false,
+		"::ffff:127.0.0.1": false,
+		"fd00::1":          false,
+		"93.184.216.34":    true,
+		"2606:4700::1111":  true,
+	}
+	for raw, allowed := range cases {
+		err := guard.CheckAddr(netip.MustParseAddr(raw))
+		if allowed && err != nil {
+			t.Fatalf("expected %s to be allowed, got %v", raw, err)
+		}
+		if !allowed && err == nil {
+			t.Fatalf("expected %s to be blocked", raw)
+		}
+	}
+
+	if _, err := NewNetworkGuard([]string{"10.0.0.0/33"}, nil); err == nil {
+		t.Fatal("expected invalid CIDR to fail")
+	}
+}
+
+func TestExecuteOnceEnforcesNetworkGuard(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte("internal"))
+	}))
+	defer server.Close()
+
+	row := &ent.Monitor{Method: http.MethodGet, URL: server.URL, ExpectedType: monitor.ExpectedTypeText}
+
+	blocking, _ := NewNetworkGuard(DefaultBlockedNetworks, nil)
+	result := NewWithConfig(nil, Config{NetworkGuard: blocking}).executeOnce(t.Context(), row, CheckDefaults{})
+	if result.success || result.errorMessage == nil || !strings.Contains(*result.errorMessage, "blocked by network policy") {
+		t.Fatalf("expected loopback check to be blocked, got success=%t error=%v", result.success, result.errorMessage)
+	}
+
+	allowing, _ := NewNetworkGuard(DefaultBlockedNetworks, []string{"127.0.0.1/32"})
+	if result := NewWithConfig(nil, Config{NetworkGuard: allowing}).executeOnce(t.Context(), row, CheckDefaults{}); !result.success {
+		t.Fatalf("expected allowlisted loopback check to succeed, got error=%v", result.errorMessage)
+	}
+}
@@ -0,0 +1,89 @@
+package worker
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+
+	"goanna/apps/api/ent"
+	"goanna/apps/api/ent/checkresult"
+	"goanna/apps/api/ent/monitor"
+)
+
+func computeContentHash(row *ent.Monitor, payload []byte) *string {
+	if row == nil || row.ContentHash == monitor.ContentHashOff || row.ContentHash == "" {
+		return nil
+	}
+
+	if row.ContentHash == monitor.ContentHashNormalized {
+		payload = normalizeHashPayload(payload)
+	}
+
+	sum := sha256.Sum256(payload)
+	encoded := hex.EncodeToString(sum[:])
+	return &encoded
+}
+
+// normalizeHashPayload unifies line endings and drops trailing whitespace on
+// each line and around the body, so formatting-only changes keep the same hash.
+func normalizeHashPayload(payload []byte) []byte {
+	lines := bytes.Split(bytes.ReplaceAll(payload, []byte("\r\n"), []byte("\n")), []byte("\n"))
+	for index, line := range lines {
+		lines[index] = bytes.TrimRight(line, " \t\r")
+	}
+
+	return bytes.TrimSpace(bytes.Join(lines, []byte("\n")))
+}
+
+func (w *Worker) loadPreviousContentHash(ctx context.Context, monitorID int) (*string, error) {
+	row, err := w.db.CheckResult.Query().
+		Where(
+			checkresult.MonitorIDEQ(monitorID),
+			checkresult.BodyHashNotNil(),
+		).
+		Order(ent.Desc(checkresult.FieldCheckedAt), ent.Desc(checkresult.FieldID)).
+		First(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return row.BodyHash, nil
+}
+
+func buildContentHashDiff(previous *string, current *string) *selectionDiff {
+	if current == nil {
+		return nil
+	}
+
+	if previous == nil {
+		return &selectionDiff{
+			Kind:    "initial",
+			Changed: false,
+			Summary: "initial content hash captured",
+			Details: map[string]any{
+				"type":    "hash",
+				"current": *current,
+			},
+		}
+	}
+
+	changed := *previous != *current
+	summary := "content hash unchanged"
+	if changed {
+		summary = "content hash changed"
+	}
+
+	return &selectionDiff{
+		Kind:    "hash",
+		Changed: changed,
+		Summary: summary,
+		Details: map[string]any{
+			"old": *previous,
+			"new": *current,
+		},
+	}
+}
@@ -0,0 +1,60 @@
+package worker
+
+import (
+	"testing"
+
+	"goanna/apps/api/ent"
+	"goanna/apps/api/ent/monitor"
+)
+
+func TestComputeContentHashRespectsMode(t *testing.T) {
+	payload := []byte("hello")
+
+	if hash := computeContentHash(&ent.Monitor{ContentHash: monitor.ContentHashOff}, payload); hash != nil {
+		t.Fatalf("expected no hash when mode is off, got %q", *hash)
+	}
+
+	hash := computeContentHash(&ent.Monitor{ContentHash: monitor.ContentHashRaw}, payload)
+	want := "2cf24dba5fb0a30e26e83b2ac5b9e29e1b161e5c1fa7425e73043362938b9824"
+	if hash == nil || *hash != want {
+		t.Fatalf("expected sha256 %q, got %v", want, hash)
+	}
+}
+
+func TestComputeContentHashNormalizedIgnoresFormatting(t *testing.T) {
+	row := &ent.Monitor{ContentHash: monitor.ContentHashNormalized}
+
+	first := computeContentHash(row, []byte("line one  \r\nline two\r\n\r\n"))
+	second := computeContentHash(row, []byte("line one\nline two"))
+	if first == nil || second == nil || *first != *second {
+		t.Fatalf("expected normalized hashes to match, got %v and %v", first, second)
+	}
+
+	raw := &ent.Monitor{ContentHash: monitor.ContentHashRaw}
+	if *computeContentHash(raw, []byte("a \n")) == *computeContentHash(raw, []byte("a\n")) {
+		t.Fatal("expected raw hashes to differ on whitespace")
+	}
+}
+
+func TestBuildContentHashDiff(t *testing.T) {
+	previous := "aaa"
+	current := "bbb"
+
+	initial := buildContentHashDiff(nil, &current)
+	if initial == nil || initial.Kind != "initial" || initial.Changed {
+		t.Fatalf("expected unchanged initial diff, got %#v", initial)
+	}
+
+	diff := buildContentHashDiff(&previous, &current)
+	if diff == nil || diff.Kind != "hash" || !diff.Changed {
+		t.Fatalf("expected changed hash diff, got %#v", diff)
+	}
+	if diff.Details["old"] != previous || diff.Details["new"] != current {
+		t.Fatalf("expected old/new hashes in details, got %#v", diff.Details)
+	}
+
+	unchanged := buildContentHashDiff(&current, &current)
+	if unchanged == nil || unchanged.Changed {
+		t.Fatalf("expected unchanged hash diff, got %#v", unchanged)
+	}
+}
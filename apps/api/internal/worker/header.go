@@ -0,0 +1,137 @@
+package worker
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+
+	"goanna/apps/api/ent"
+	"goanna/apps/api/ent/checkresult"
+)
+
+// evaluateHeaderAssertions checks response headers against the monitor's
+// headerAssertions. An empty expected value only requires the header to be
+// present; other values use the mustContain syntax (substring or /regex/).
+func evaluateHeaderAssertions(header http.Header, assertions map[string]string) string {
+	names := make([]string, 0, len(assertions))
+	for name := range assertions {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		values, ok := header[http.CanonicalHeaderKey(name)]
+		if !ok {
+			return fmt.Sprintf("header %q missing", name)
+		}
+
+		expected := assertions[name]
+		if expected == "" {
+			continue
+		}
+
+		matcher, err := parseKeyword(expected)
+		if err != nil {
+			return err.Error()
+		}
+		actual := strings.Join(values, ", ")
+		if !matcher.matches([]byte(actual)) {
+			return fmt.Sprintf("header %q value %q does not match %q", name, actual, expected)
+		}
+	}
+
+	return ""
+}
+
+// trackedHeaderValue returns the value of the monitor's tracked header, or nil
+// when no header is tracked. A missing header is tracked as an empty value.
+func trackedHeaderValue(row *ent.Monitor, header http.Header) *string {
+	if row == nil || row.TrackHeader == nil || strings.TrimSpace(*row.TrackHeader) == "" {
+		return nil
+	}
+
+	value := strings.Join(header.Values(*row.TrackHeader), ", ")
+	return &value
+}
+
+func (w *Worker) loadPreviousTrackedHeader(ctx context.Context, monitorID int) (*string, error) {
+	row, err := w.db.CheckResult.Query().
+		Where(
+			checkresult.MonitorIDEQ(monitorID),
+			checkresult.TrackedHeaderValueNotNil(),
+		).
+		Order(ent.Desc(checkresult.FieldCheckedAt), ent.Desc(checkresult.FieldID)).
+		First(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return row.TrackedHeaderValue, nil
+}
+
+func buildHeaderDiff(name string, previous *string, current *string) *selectionDiff {
+	if current == nil {
+		return nil
+	}
+
+	if previous == nil {
+		return &selectionDiff{
+			Kind:    "initial",
+			Changed: false,
+			Summary: fmt.Sprintf("initial %s header captured", name),
+			Details: map[string]any{
+				"type":   "header",
+				"header": name,
+				"new":    *current,
+			},
+		}
+	}
+
+	details := map[string]any{
+		"header": name,
+		"old":    *previous,
+		"new":    *current,
+	}
+	if *previous == *current {
+		return &selectionDiff{
+			Kind:    "header",
+			Changed: false,
+			Summary: fmt.Sprintf("%s header unchanged", name),
+			Details: details,
+		}
+	}
+
+	return &selectionDiff{
+		Kind:    "header",
+		Changed: true,
+		Summary: fmt.Sprintf("%s header changed: %q -> %q", name, *previous, *current),
+		Details: details,
+	}
+}
+
+// mergeHeaderDiff folds a tracked header diff into the content diff. A header
+// change alone becomes the check's diff; when both changed, the header change
+// is reported alongside the content change.
+func mergeHeaderDiff(content *selectionDiff, header *selectionDiff) *selectionDiff {
+	if header == nil {
+		return content
+	}
+	if content == nil || (header.Changed && !content.Changed) {
+		return header
+	}
+	if !header.Changed {
+		return content
+	}
+
+	if content.Details == nil {
+		content.Details = map[string]any{}
+	}
+	content.Details["header"] = header.Details
+	content.Summary = content.Summary + "; " + header.Summary
+	return content
+}
@@ -0,0 +1,54 @@
+package worker
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestEvaluateHeaderAssertions(t *testing.T) {
+	header := http.Header{}
+	header.Set("Content-Type", "application/json; charset=utf-8")
+	header.Set("X-Version", "2.4.1")
+
+	if msg := evaluateHeaderAssertions(header, map[string]string{
+		"content-type": "application/json",
+		"X-Version":    `/^2\.\d+/`,
+	}); msg != "" {
+		t.Fatalf("expected header assertions to pass, got %q", msg)
+	}
+	if msg := evaluateHeaderAssertions(header, map[string]string{"Cache-Control": ""}); msg != `header "Cache-Control" missing` {
+		t.Fatalf("unexpected missing header message %q", msg)
+	}
+	if msg := evaluateHeaderAssertions(header, map[string]string{"X-Version": "3."}); !strings.Contains(msg, "does not match") {
+		t.Fatalf("expected mismatch message, got %q", msg)
+	}
+}
+
+func TestBuildHeaderDiffAndMerge(t *testing.T) {
+	previous, current := "2.4.1", "2.5.0"
+
+	if initial := buildHeaderDiff("X-Version", nil, &current); initial == nil || initial.Kind != "initial" || initial.Changed {
+		t.Fatalf("expected unchanged initial header diff, got %#v", initial)
+	}
+
+	header := buildHeaderDiff("X-Version", &previous, &current)
+	if header == nil || header.Kind != "header" || !header.Changed {
+		t.Fatalf("expected changed header diff, got %#v", header)
+	}
+
+	unchangedContent := &selectionDiff{Kind: "value", Changed: false, Summary: "value unchanged"}
+	if merged := mergeHeaderDiff(unchangedContent, header); merged != header {
+		t.Fatalf("expected header change to replace unchanged content diff, got %#v", merged)
+	}
+
+	changedContent := &selectionDiff{Kind: "value", Changed: true, Summary: "value changed"}
+	merged := mergeHeaderDiff(changedContent, header)
+	if merged.Kind != "value" || !strings.Contains(merged.Summary, "X-Version header changed") || merged.Details["header"] == nil {
+		t.Fatalf("expected header change folded into content diff, got %#v", merged)
+	}
+
+	if merged := mergeHeaderDiff(nil, buildHeaderDiff("X-Version", &previous, &previous)); merged == nil || merged.Changed {
+		t.Fatalf("expected unchanged header diff without content diff, got %#v", merged)
+	}
+}
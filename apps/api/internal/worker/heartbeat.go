@@ -0,0 +1,83 @@
+package worker
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"time"
+
+	"goanna/apps/api/ent"
+	"goanna/apps/api/ent/monitor"
+	"goanna/apps/api/ent/notificationevent"
+)
+
+const heartbeatTokenBytes = 16
+
+// HeartbeatPingPath is the API path external jobs call to report a heartbeat.
+func HeartbeatPingPath(token string) string {
+	return "/v1/heartbeats/" + token
+}
+
+// NewHeartbeatToken returns a random token identifying a heartbeat monitor's
+// ping URL.
+func NewHeartbeatToken() (string, error) {
+	buffer := make([]byte, heartbeatTokenBytes)
+	if _, err := rand.Read(buffer); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(buffer), nil
+}
+
+func isHeartbeatMonitor(row *ent.Monitor) bool {
+	return row != nil && row.FetchMode == monitor.FetchModeHeartbeat
+}
+
+// evaluateHeartbeat checks that a ping arrived since the previous scheduled
+// evaluation. The first evaluation passes without a ping so a job scheduled
+// on the same cron gets one full window to report in.
+func evaluateHeartbeat(runtime *ent.MonitorRuntime, now time.Time) executionResult {
+	result := executionResult{checkedAt: now, status: "ok", success: true}
+	if runtime == nil {
+		return result
+	}
+
+	windowStart := runtime.LastCheckAt
+	if windowStart == nil {
+		return result
+	}
+	if runtime.LastPingAt != nil && runtime.LastPingAt.After(*windowStart) {
+		return result
+	}
+
+	msg := fmt.Sprintf("no heartbeat ping since %s", windowStart.UTC().Format(time.RFC3339))
+	if runtime.LastPingAt != nil {
+		msg = fmt.Sprintf("%s (last ping %s)", msg, runtime.LastPingAt.UTC().Format(time.RFC3339))
+	}
+	result.status = "error"
+	result.success = false
+	result.errorMessage = &msg
+	return result
+}
+
+// notifyMissedHeartbeat alerts the monitor's channels when a heartbeat first
+// goes missing. Monitors with an escalation policy are already alerted by it.
+func (w *Worker) notifyMissedHeartbeat(ctx context.Context, row *ent.Monitor, runtime *ent.MonitorRuntime, result executionResult) error {
+	if !isHeartbeatMonitor(row) || result.success || escalationPolicyActive(row) {
+		return nil
+	}
+	if runtime == nil || runtime.ConsecutiveErrors != 1 {
+		return nil
+	}
+
+	paused, err := w.notificationsPaused(ctx)
+	if err != nil {
+		return err
+	}
+	if paused {
+		return nil
+	}
+
+	_, err = w.sendFailureNotification(ctx, row, runtime, result, row.NotificationChannels, notificationevent.KindFailure, 0, nil)
+	return err
+}
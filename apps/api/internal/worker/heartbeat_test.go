@@ -0,0 +1,31 @@
+package worker
+
+import (
+	"testing"
+	"time"
+
+	"goanna/apps/api/ent"
+)
+
+func TestEvaluateHeartbeat(t *testing.T) {
+	now := time.Date(2026, time.October, 15, 3, 0, 0, 0, time.UTC)
+	previousCheck := now.Add(-24 * time.Hour)
+	pingInWindow := now.Add(-23 * time.Hour)
+	pingBeforeWindow := now.Add(-25 * time.Hour)
+
+	if result := evaluateHeartbeat(&ent.MonitorRuntime{}, now); !result.success {
+		t.Fatalf("expected first window to pass without a ping, got %v", result.errorMessage)
+	}
+	if result := evaluateHeartbeat(&ent.MonitorRuntime{LastCheckAt: &previousCheck, LastPingAt: &pingInWindow}, now); !result.success {
+		t.Fatalf("expected ping in window to pass, got %v", result.errorMessage)
+	}
+
+	result := evaluateHeartbeat(&ent.MonitorRuntime{LastCheckAt: &previousCheck, LastPingAt: &pingBeforeWindow}, now)
+	if result.success || result.errorMessage == nil {
+		t.Fatal("expected missing ping to fail")
+	}
+	expected := "no heartbeat ping since 2026-10-14T03:00:00Z (last ping 2026-10-14T02:00:00Z)"
+	if *result.errorMessage != expected {
+		t.Fatalf("expected %q, got %q", expected, *result.errorMessage)
+	}
+}
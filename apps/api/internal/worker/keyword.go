@@ -0,0 +1,74 @@
+package worker
+
+import (
+	"bytes"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// keywordMatcher matches a mustContain/mustNotContain entry. Entries wrapped
+// in slashes ("/out of stock/i") are regular expressions; anything else is a
+// case-sensitive substring.
+type keywordMatcher struct {
+	raw     string
+	literal string
+	pattern *regexp.Regexp
+}
+
+// ValidateKeyword reports whether a mustContain/mustNotContain entry is usable.
+func ValidateKeyword(raw string) error {
+	_, err := parseKeyword(raw)
+	return err
+}
+
+func parseKeyword(raw string) (keywordMatcher, error) {
+	if len(raw) >= 2 && strings.HasPrefix(raw, "/") {
+		closing := strings.LastIndex(raw, "/")
+		flags := raw[closing+1:]
+		if closing > 0 && (flags == "" || flags == "i") {
+			expression := raw[1:closing]
+			if flags == "i" {
+				expression = "(?i)" + expression
+			}
+			pattern, err := regexp.Compile(expression)
+			if err != nil {
+				return keywordMatcher{}, fmt.Errorf("invalid keyword pattern %q: %v", raw, err)
+			}
+			return keywordMatcher{raw: raw, pattern: pattern}, nil
+		}
+	}
+
+	return keywordMatcher{raw: raw, literal: raw}, nil
+}
+
+func (m keywordMatcher) matches(payload []byte) bool {
+	if m.pattern != nil {
+		return m.pattern.Match(payload)
+	}
+	return bytes.Contains(payload, []byte(m.literal))
+}
+
+func evaluateKeywordAssertions(payload []byte, mustContain []string, mustNotContain []string) string {
+	for _, raw := range mustContain {
+		matcher, err := parseKeyword(raw)
+		if err != nil {
+			return err.Error()
+		}
+		if !matcher.matches(payload) {
+			return fmt.Sprintf("keyword %q not found", raw)
+		}
+	}
+
+	for _, raw := range mustNotContain {
+		matcher, err := parseKeyword(raw)
+		if err != nil {
+			return err.Error()
+		}
+		if matcher.matches(payload) {
+			return fmt.Sprintf("forbidden keyword %q found", raw)
+		}
+	}
+
+	return ""
+}
@@ -0,0 +1,33 @@
+package worker
+
+import (
+	"testing"
+)
+
+func TestEvaluateKeywordAssertions(t *testing.T) {
+	payload := []byte("<p>Widget</p><span>In stock</span>")
+
+	if msg := evaluateKeywordAssertions(payload, []string{"In stock", "/widget/i"}, []string{"Error"}); msg != "" {
+		t.Fatalf("expected assertions to pass, got %q", msg)
+	}
+	if msg := evaluateKeywordAssertions(payload, []string{"Out of stock"}, nil); msg != `keyword "Out of stock" not found` {
+		t.Fatalf("unexpected missing keyword message %q", msg)
+	}
+	if msg := evaluateKeywordAssertions(payload, nil, []string{"/in\\s+stock/"}); msg != "" {
+		t.Fatalf("expected case-sensitive regex not to match, got %q", msg)
+	}
+	if msg := evaluateKeywordAssertions(payload, nil, []string{"/In\\s+stock/"}); msg == "" {
+		t.Fatal("expected forbidden regex to fail the check")
+	}
+}
+
+func TestValidateKeyword(t *testing.T) {
+	if err := ValidateKeyword("/[unclosed/"); err == nil {
+		t.Fatal("expected invalid regex to fail validation")
+	}
+	for _, keyword := range []string{"plain text", "/a+b/", "/sold out/i", "/usr/bin"} {
+		if err := ValidateKeyword(keyword); err != nil {
+			t.Fatalf("expected %q to validate: %v", keyword, err)
+		}
+	}
+}
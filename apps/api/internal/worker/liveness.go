@@ -0,0 +1,70 @@
+package worker
+
+import (
+	"sync"
+	"time"
+)
+
+// livenessStaleTicks is how many tick intervals a scheduler may go without a
+// pass before it is considered stuck.
+const livenessStaleTicks = 3
+
+// Liveness records when the scheduler last ran a full pass, so the API can
+// report a stopped or stuck worker. Recording on a nil *Liveness is a no-op.
+type Liveness struct {
+	mu           sync.Mutex
+	lastTick     time.Time
+	tickInterval time.Duration
+}
+
+func NewLiveness() *Liveness {
+	return &Liveness{}
+}
+
+// RecordTick marks the start of a scheduling pass.
+func (l *Liveness) RecordTick(at time.Time) {
+	if l == nil {
+		return
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.lastTick = at
+}
+
+// RecordTickInterval records the configured time between full passes.
+func (l *Liveness) RecordTickInterval(interval time.Duration) {
+	if l == nil {
+		return
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.tickInterval = interval
+}
+
+// StaleAfter is how long the scheduler may go without a pass before it is
+// considered stuck: a few tick intervals.
+func (l *Liveness) StaleAfter() time.Duration {
+	interval := defaultTickInterval
+	if l != nil {
+		l.mu.Lock()
+		if l.tickInterval > 0 {
+			interval = l.tickInterval
+		}
+		l.mu.Unlock()
+	}
+	return livenessStaleTicks * interval
+}
+
+// LastTick returns when the scheduler last started a pass, and false if it
+// has not run yet.
+func (l *Liveness) LastTick() (time.Time, bool) {
+	if l == nil {
+		return time.Time{}, false
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.lastTick, !l.lastTick.IsZero()
+}
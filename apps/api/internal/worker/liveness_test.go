@@ -0,0 +1,35 @@
+package worker
+
+import (
+	"testing"
+	"time"
+
+	"goanna/apps/api/ent/enttest"
+
+	_ "github.com/mattn/go-sqlite3"
+)
+
+func TestTickRecordsLiveness(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:worker-liveness?mode=memory&cache=shared&_fk=1")
+	defer client.Close()
+
+	liveness := NewLiveness()
+	if _, ok := liveness.LastTick(); ok {
+		t.Fatal("expected no tick before the scheduler runs")
+	}
+
+	before := time.Now().UTC()
+	w := NewWithConfig(client, Config{Liveness: liveness})
+	w.tick(t.Context(), nil)
+
+	lastTick, ok := liveness.LastTick()
+	if !ok || lastTick.Before(before) {
+		t.Fatalf("expected tick at or after %s, got %s (%t)", before, lastTick, ok)
+	}
+
+	var missing *Liveness
+	missing.RecordTick(time.Now())
+	if _, ok := missing.LastTick(); ok {
+		t.Fatal("expected nil liveness to report no tick")
+	}
+}
@@ -17,11 +17,19 @@ import (
 
 const telegramSendTimeout = 10 * time.Second
 
-func (w *Worker) notifyMonitorDiff(ctx context.Context, row *ent.Monitor, diff *selectionDiff, checkedAt time.Time) error {
+func (w *Worker) notifyMonitorDiff(ctx context.Context, row *ent.Monitor, diff *selectionDiff, checkedAt time.Time, runID string) error {
 	if diff == nil || !diff.Changed {
 		return nil
 	}
 
+	paused, err := w.notificationsPaused(ctx)
+	if err != nil {
+		return err
+	}
+	if paused {
+		return nil
+	}
+
 	channels, err := w.enabledChannelsForMonitor(ctx, row)
 	if err != nil {
 		return err
@@ -48,7 +56,7 @@ func (w *Worker) notifyMonitorDiff(ctx context.Context, row *ent.Monitor, diff *
 			SetStatus(status).
 			SetMessage(eventMessage).
 			SetSentAt(checkedAt)
-		if _, err := eventCreate.Save(ctx); err != nil {
+		if err := w.saveNotificationEvent(ctx, withRunID(eventCreate, runID)); err != nil {
 			notifyErr = err
 		}
 	}
@@ -56,7 +64,20 @@ func (w *Worker) notifyMonitorDiff(ctx context.Context, row *ent.Monitor, diff *
 	return notifyErr
 }
 
+func (w *Worker) notificationsPaused(ctx context.Context) (bool, error) {
+	config, err := w.ensureSystemConfig(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	return config.NotificationsPaused, nil
+}
+
 func (w *Worker) enabledChannelsForMonitor(ctx context.Context, row *ent.Monitor) ([]*ent.NotificationChannel, error) {
+	return w.enabledChannelsForKinds(ctx, row.NotificationChannels)
+}
+
+func (w *Worker) enabledChannelsForKinds(ctx context.Context, kinds []string) ([]*ent.NotificationChannel, error) {
 	channels, err := w.db.NotificationChannel.Query().
 		Where(notificationchannel.EnabledEQ(true)).
 		All(ctx)
@@ -64,12 +85,12 @@ func (w *Worker) enabledChannelsForMonitor(ctx context.Context, row *ent.Monitor
 		return nil, err
 	}
 
-	if len(row.NotificationChannels) == 0 {
+	if len(kinds) == 0 {
 		return []*ent.NotificationChannel{}, nil
 	}
 
-	allowedKinds := make(map[string]struct{}, len(row.NotificationChannels))
-	for _, rawKind := range row.NotificationChannels {
+	allowedKinds := make(map[string]struct{}, len(kinds))
+	for _, rawKind := range kinds {
 		kind := strings.ToLower(strings.TrimSpace(rawKind))
 		if kind == "" {
 			continue
@@ -88,7 +109,10 @@ func (w *Worker) enabledChannelsForMonitor(ctx context.Context, row *ent.Monitor
 	return filtered, nil
 }
 
-func (w *Worker) sendMonitorDiffToChannel(ctx context.Context, channel *ent.NotificationChannel, message string) error {
+func (w *Worker) sendMonitorDiffToChannel(ctx context.Context, channel *ent.NotificationChannel, message string) (err error) {
+	ctx, span := startNotificationSpan(ctx, channel)
+	defer func() { endSpan(span, err) }()
+
 	switch channel.Kind {
 	case notificationchannel.KindTelegram:
 		return w.sendTelegramMessage(ctx, channel.BotToken, channel.ChatID, message)
@@ -164,6 +188,9 @@ func formatNotificationDetail(diff *selectionDiff) string {
 		}
 		return formatNotificationJSONDetails(diff.Details)
 	case "arrayObject":
+		if detail := formatFeedItemsNotificationDetail(diff.Details); detail != "" {
+			return detail
+		}
 		if detail := formatArrayObjectNotificationDetail(diff.Details); detail != "" {
 			return detail
 		}
@@ -177,6 +204,8 @@ func formatNotificationDetail(diff *selectionDiff) string {
 		return formatNotificationJSONDetails(diff.Details)
 	case "object":
 		return formatNotificationJSONDetails(diff.Details)
+	case "sitemap":
+		return formatSitemapNotificationDetail(diff.Details)
 	default:
 		return ""
 	}
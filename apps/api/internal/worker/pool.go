@@ -0,0 +1,43 @@
+package worker
+
+import (
+	"errors"
+	"sync"
+)
+
+const DefaultMaxConcurrentChecks = 4
+
+// ErrMonitorInFlight is returned when a monitor is triggered while one of its
+// checks is already running.
+var ErrMonitorInFlight = errors.New("monitor check already in progress")
+
+// ErrMonitorArchived is returned when an archived monitor is triggered.
+var ErrMonitorArchived = errors.New("monitor is archived")
+
+// inFlightMonitors guards against overlapping checks of the same monitor. It is
+// shared by every Worker in the process, so manual triggers served by the API
+// and scheduled runs of the background worker never race.
+var inFlightMonitors = &monitorGuard{running: map[int]struct{}{}}
+
+type monitorGuard struct {
+	mu      sync.Mutex
+	running map[int]struct{}
+}
+
+func (g *monitorGuard) tryAcquire(monitorID int) bool {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	if _, ok := g.running[monitorID]; ok {
+		return false
+	}
+	g.running[monitorID] = struct{}{}
+	return true
+}
+
+func (g *monitorGuard) release(monitorID int) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	delete(g.running, monitorID)
+}
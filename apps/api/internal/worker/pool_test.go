@@ -0,0 +1,82 @@
+package worker
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"goanna/apps/api/ent/enttest"
+	"goanna/apps/api/ent/monitorruntime"
+)
+
+func TestMonitorGuardRejectsOverlappingChecks(t *testing.T) {
+	guard := &monitorGuard{running: map[int]struct{}{}}
+	if !guard.tryAcquire(1) {
+		t.Fatal("expected first acquire to succeed")
+	}
+	if guard.tryAcquire(1) {
+		t.Fatal("expected overlapping acquire to fail")
+	}
+	if !guard.tryAcquire(2) {
+		t.Fatal("expected other monitor to be independent")
+	}
+	guard.release(1)
+	if !guard.tryAcquire(1) {
+		t.Fatal("expected acquire after release to succeed")
+	}
+}
+
+func TestTickRunsDueMonitorsConcurrently(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:worker-pool?mode=memory&cache=shared&_fk=1")
+	defer client.Close()
+
+	var active, peak atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		current := active.Add(1)
+		defer active.Add(-1)
+		for {
+			previous := peak.Load()
+			if current <= previous || peak.CompareAndSwap(previous, current) {
+				break
+			}
+		}
+		time.Sleep(100 * time.Millisecond)
+		w.Write([]byte(`{"ok":true}`))
+	}))
+	defer server.Close()
+
+	dueAt := time.Now().UTC().Add(-time.Minute)
+	for range 3 {
+		row, err := client.Monitor.Create().
+			SetURL(server.URL).
+			SetCron("*/5 * * * *").
+			Save(t.Context())
+		if err != nil {
+			t.Fatalf("expected monitor to save: %v", err)
+		}
+		if _, err := client.MonitorRuntime.Create().
+			SetMonitor(row).
+			SetStatus(monitorruntime.StatusPending).
+			SetNextRunAt(dueAt).
+			Save(t.Context()); err != nil {
+			t.Fatalf("expected runtime to save: %v", err)
+		}
+	}
+
+	w := NewWithConfig(client, Config{MaxConcurrentChecks: 2})
+	w.tick(t.Context(), nil)
+	w.checks.Wait()
+
+	checks, err := client.CheckResult.Query().Count(t.Context())
+	if err != nil {
+		t.Fatalf("expected check count: %v", err)
+	}
+	if checks != 3 {
+		t.Fatalf("expected 3 checks, got %d", checks)
+	}
+	if got := peak.Load(); got != 2 {
+		t.Fatalf("expected 2 concurrent checks, got %d", got)
+	}
+}
@@ -0,0 +1,94 @@
+package worker
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"goanna/apps/api/ent"
+	"goanna/apps/api/ent/monitor"
+)
+
+// ErrHeartbeatMonitor is returned when previewing a heartbeat monitor, which
+// is pinged rather than fetched.
+var ErrHeartbeatMonitor = errors.New("heartbeat monitors are pinged, not fetched")
+
+// CheckPreview is the outcome of a check that was not saved.
+type CheckPreview struct {
+	Status         string     `json:"status"`
+	Success        bool       `json:"success"`
+	StatusCode     *int       `json:"statusCode,omitempty"`
+	DurationMs     *int       `json:"durationMs,omitempty"`
+	Error          *string    `json:"error,omitempty"`
+	SelectionType  *string    `json:"selectionType,omitempty"`
+	SelectionValue *string    `json:"selectionValue,omitempty"`
+	ContentHash    *string    `json:"contentHash,omitempty"`
+	Header         *string    `json:"header,omitempty"`
+	Redirects      []string   `json:"redirects,omitempty"`
+	Diff           *CheckDiff `json:"diff,omitempty"`
+	CheckedAt      time.Time  `json:"checkedAt"`
+}
+
+// PreviewMonitorCheck runs one check of a saved monitor with the current
+// runtime settings and returns its outcome. Retries are skipped and nothing
+// is saved, so the monitor's runtime, history and alerts are unaffected.
+func (w *Worker) PreviewMonitorCheck(ctx context.Context, monitorID int) (*CheckPreview, error) {
+	row, err := w.db.Monitor.Query().
+		Where(monitor.IDEQ(monitorID)).
+		WithHeaderProfile().
+		Only(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return w.PreviewCheck(ctx, row)
+}
+
+// PreviewCheck runs one check of row, which need not be saved, with the
+// current runtime settings and returns its outcome. When row.ID names a saved
+// monitor, the outcome includes the diff against that monitor's latest stored
+// check. Retries are skipped and nothing is saved.
+func (w *Worker) PreviewCheck(ctx context.Context, row *ent.Monitor) (*CheckPreview, error) {
+	if isHeartbeatMonitor(row) {
+		return nil, ErrHeartbeatMonitor
+	}
+
+	config, err := w.ensureSystemConfig(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result := w.executeOnce(ctx, row, CheckDefaultsFromSystem(config))
+	if row.ID != 0 {
+		if err := w.diffWithPrevious(ctx, row, &result); err != nil {
+			return nil, err
+		}
+	}
+	return newCheckPreview(result), nil
+}
+
+func newCheckPreview(result executionResult) *CheckPreview {
+	preview := &CheckPreview{
+		Status:      result.status,
+		Success:     result.success,
+		StatusCode:  result.statusCode,
+		DurationMs:  result.durationMs,
+		Error:       result.errorMessage,
+		ContentHash: result.contentHash,
+		Header:      result.header,
+		Redirects:   result.redirects,
+		CheckedAt:   result.checkedAt,
+	}
+	if result.diff != nil {
+		preview.Diff = &CheckDiff{
+			Kind:    result.diff.Kind,
+			Changed: result.diff.Changed,
+			Summary: result.diff.Summary,
+			Details: result.diff.Details,
+		}
+	}
+	if result.selection != nil && result.selection.Exists {
+		preview.SelectionType = &result.selection.Type
+		preview.SelectionValue = &result.selection.Value
+	}
+	return preview
+}
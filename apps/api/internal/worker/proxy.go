@@ -0,0 +1,128 @@
+package worker
+
+import (
+	"errors"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+
+	"goanna/apps/api/ent"
+
+	"golang.org/x/net/http/httpproxy"
+)
+
+// ProxySettings route checks through an outbound proxy. Without a URL the
+// HTTP_PROXY, HTTPS_PROXY and NO_PROXY environment variables apply.
+type ProxySettings struct {
+	// URL is an http, https or socks5 proxy, optionally with credentials.
+	URL string
+	// Bypass lists hosts, domains and CIDRs reached directly, in NO_PROXY
+	// syntax.
+	Bypass string
+}
+
+// ProxySettingsFromSystem reads the proxy settings from the global runtime
+// settings.
+func ProxySettingsFromSystem(config *ent.SystemConfig) ProxySettings {
+	var proxy ProxySettings
+	if config == nil {
+		return proxy
+	}
+	if config.ProxyURL != nil {
+		proxy.URL = strings.TrimSpace(*config.ProxyURL)
+	}
+	if config.ProxyBypass != nil {
+		proxy.Bypass = strings.TrimSpace(*config.ProxyBypass)
+	}
+	return proxy
+}
+
+// ParseProxyURL validates a proxy URL.
+func ParseProxyURL(raw string) (*url.URL, error) {
+	parsed, err := url.Parse(strings.TrimSpace(raw))
+	if err != nil {
+		return nil, errors.New("proxy URL is invalid")
+	}
+	switch parsed.Scheme {
+	case "http", "https", "socks5":
+	default:
+		return nil, errors.New("proxy URL must use http, https or socks5")
+	}
+	if parsed.Hostname() == "" {
+		return nil, errors.New("proxy URL must include a host")
+	}
+	return parsed, nil
+}
+
+// RedactProxyURL hides the password of a proxy URL for display.
+func RedactProxyURL(raw string) string {
+	parsed, err := url.Parse(raw)
+	if err != nil {
+		return raw
+	}
+	return parsed.Redacted()
+}
+
+// ProxyFunc returns the http.Transport Proxy function for the settings. With
+// a guard, proxied requests are refused when the target host resolves to a
+// blocked address, since the guarded dialer only sees the proxy's address.
+func (p ProxySettings) ProxyFunc(guard *NetworkGuard) func(*http.Request) (*url.URL, error) {
+	proxy := http.ProxyFromEnvironment
+	if p.URL != "" {
+		config := httpproxy.Config{HTTPProxy: p.URL, HTTPSProxy: p.URL, NoProxy: p.Bypass}
+		proxyForURL := config.ProxyFunc()
+		proxy = func(req *http.Request) (*url.URL, error) {
+			return proxyForURL(req.URL)
+		}
+	}
+	if guard == nil {
+		return proxy
+	}
+
+	return func(req *http.Request) (*url.URL, error) {
+		proxyURL, err := proxy(req)
+		if err != nil || proxyURL == nil {
+			return proxyURL, err
+		}
+		if err := guard.CheckHost(req.Context(), req.URL.Hostname()); err != nil {
+			return nil, err
+		}
+		return proxyURL, nil
+	}
+}
+
+// proxyClients caches the client for the proxy settings checks last used, so
+// checks through a configured proxy still reuse connections. It is replaced
+// when the settings change.
+type proxyClients struct {
+	mu       sync.Mutex
+	settings ProxySettings
+	client   *http.Client
+}
+
+// clientForProxy returns the shared check client routed by proxy. Without
+// runtime proxy settings checks use the client built at startup, which
+// applies the startup proxy.
+func (w *Worker) clientForProxy(proxy ProxySettings) *http.Client {
+	if proxy == (ProxySettings{}) {
+		return w.client
+	}
+
+	w.proxies.mu.Lock()
+	defer w.proxies.mu.Unlock()
+	if w.proxies.client != nil && w.proxies.settings == proxy {
+		return w.proxies.client
+	}
+	if w.proxies.client != nil {
+		w.proxies.client.CloseIdleConnections()
+	}
+
+	transport := cloneTransport(w.client)
+	transport.Proxy = proxy.ProxyFunc(w.guard)
+	client := *w.client
+	client.Transport = transport
+	w.proxies.settings = proxy
+	w.proxies.client = &client
+	return &client
+}
@@ -0,0 +1,75 @@
+package worker
+
+import (
+	"context"
+	"time"
+
+	"goanna/apps/api/ent"
+	"goanna/apps/api/ent/checkresult"
+)
+
+// historyPruneInterval is how often check history is cut back to the history
+// limits, so a monitor keeps up to one interval of checks beyond its limit.
+const historyPruneInterval = 10 * time.Minute
+
+// pruneHistory trims the check history of monitors to their history limits
+// when historyPruneInterval has passed since the last prune. It runs from the
+// scheduling pass rather than after each check, keeping deletes off the path
+// of running checks.
+func (w *Worker) pruneHistory(ctx context.Context, config *ent.SystemConfig, monitors []*ent.Monitor, now time.Time) error {
+	if !w.prunedAt.IsZero() && now.Before(w.prunedAt.Add(historyPruneInterval)) {
+		return nil
+	}
+	w.prunedAt = now
+
+	for _, row := range monitors {
+		if err := w.pruneCheckHistory(ctx, row.ID, checksHistoryLimit(row, config)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// checksHistoryLimit returns how many checks to keep for row: its own
+// checksHistoryLimit, otherwise the global runtime setting.
+func checksHistoryLimit(row *ent.Monitor, config *ent.SystemConfig) int {
+	if row != nil && row.ChecksHistoryLimit != nil {
+		return *row.ChecksHistoryLimit
+	}
+	return config.ChecksHistoryLimit
+}
+
+// pruneCheckHistory deletes the checks of a monitor older than its keep
+// newest in one statement, after looking up the oldest check to keep.
+func (w *Worker) pruneCheckHistory(ctx context.Context, monitorID int, keep int) error {
+	if keep <= 0 {
+		return nil
+	}
+
+	oldestKept, err := w.db.CheckResult.Query().
+		Where(checkresult.MonitorIDEQ(monitorID)).
+		Order(ent.Desc(checkresult.FieldCheckedAt), ent.Desc(checkresult.FieldID)).
+		Offset(keep-1).
+		Select(checkresult.FieldID, checkresult.FieldCheckedAt).
+		First(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+
+	_, err = w.db.CheckResult.Delete().
+		Where(
+			checkresult.MonitorIDEQ(monitorID),
+			checkresult.Or(
+				checkresult.CheckedAtLT(oldestKept.CheckedAt),
+				checkresult.And(
+					checkresult.CheckedAtEQ(oldestKept.CheckedAt),
+					checkresult.IDLT(oldestKept.ID),
+				),
+			),
+		).
+		Exec(ctx)
+	return err
+}
@@ -0,0 +1,58 @@
+package worker
+
+import (
+	"fmt"
+	"net/http"
+
+	"goanna/apps/api/ent"
+	"goanna/apps/api/ent/monitor"
+)
+
+// DefaultMaxRedirects matches net/http's own redirect limit.
+const DefaultMaxRedirects = 10
+
+func maxRedirectsForMonitor(row *ent.Monitor) int {
+	if row != nil && row.MaxRedirects != nil && *row.MaxRedirects > 0 {
+		return *row.MaxRedirects
+	}
+	return DefaultMaxRedirects
+}
+
+// clientForRedirects applies the monitor's redirect policy to client:
+//   - none returns the first response as is, so its status and Location
+//     header can be asserted on;
+//   - follow follows up to the monitor's max hops, unless the monitor accepts
+//     a 3xx status, in which case the redirect response itself is evaluated;
+//   - record follows like follow and appends each hop to chain as
+//     "<status> <from> -> <to>".
+func clientForRedirects(client *http.Client, row *ent.Monitor, ranges []statusRange, chain *[]string) *http.Client {
+	policy := monitor.DefaultRedirectPolicy
+	if row != nil && row.RedirectPolicy != "" {
+		policy = row.RedirectPolicy
+	}
+
+	redirecting := *client
+	if policy == monitor.RedirectPolicyNone || (policy == monitor.RedirectPolicyFollow && acceptsRedirect(ranges)) {
+		redirecting.CheckRedirect = func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		}
+		return &redirecting
+	}
+
+	maxRedirects := maxRedirectsForMonitor(row)
+	record := policy == monitor.RedirectPolicyRecord && chain != nil
+	redirecting.CheckRedirect = func(req *http.Request, via []*http.Request) error {
+		if len(via) > maxRedirects {
+			return fmt.Errorf("stopped after %d redirects", maxRedirects)
+		}
+		if record {
+			statusCode := 0
+			if req.Response != nil {
+				statusCode = req.Response.StatusCode
+			}
+			*chain = append(*chain, fmt.Sprintf("%d %s -> %s", statusCode, via[len(via)-1].URL, req.URL))
+		}
+		return nil
+	}
+	return &redirecting
+}
@@ -0,0 +1,63 @@
+package worker
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"goanna/apps/api/ent"
+	"goanna/apps/api/ent/monitor"
+)
+
+func TestExecuteOnceAppliesRedirectPolicy(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/a":
+			http.Redirect(w, r, "/b", http.StatusMovedPermanently)
+		case "/b":
+			http.Redirect(w, r, "/c", http.StatusFound)
+		default:
+			_, _ = w.Write([]byte("done"))
+		}
+	}))
+	defer server.Close()
+
+	w := &Worker{client: server.Client(), maxResponseBodyBytes: DefaultMaxResponseBodyBytes}
+	newMonitor := func(policy monitor.RedirectPolicy) *ent.Monitor {
+		return &ent.Monitor{Method: http.MethodGet, URL: server.URL + "/a", ExpectedType: monitor.ExpectedTypeText, RedirectPolicy: policy}
+	}
+
+	follow := newMonitor(monitor.RedirectPolicyFollow)
+	if result := w.executeOnce(t.Context(), follow, CheckDefaults{}); !result.success || len(result.redirects) != 0 {
+		t.Fatalf("expected followed redirects without a chain, got success=%t redirects=%v", result.success, result.redirects)
+	}
+
+	oneHop := 1
+	follow.MaxRedirects = &oneHop
+	result := w.executeOnce(t.Context(), follow, CheckDefaults{})
+	if result.success || result.errorMessage == nil || !strings.Contains(*result.errorMessage, "stopped after 1 redirects") {
+		t.Fatalf("expected max redirects error, got success=%t error=%v", result.success, result.errorMessage)
+	}
+
+	none := newMonitor(monitor.RedirectPolicyNone)
+	expectMoved := "301"
+	none.ExpectedStatus = &expectMoved
+	result = w.executeOnce(t.Context(), none, CheckDefaults{})
+	if !result.success || result.statusCode == nil || *result.statusCode != http.StatusMovedPermanently {
+		t.Fatalf("expected first redirect response, got success=%t status=%v", result.success, result.statusCode)
+	}
+
+	record := newMonitor(monitor.RedirectPolicyRecord)
+	result = w.executeOnce(t.Context(), record, CheckDefaults{})
+	if !result.success {
+		t.Fatalf("expected recorded redirects to succeed, got error=%v", result.errorMessage)
+	}
+	expected := []string{
+		"301 " + server.URL + "/a -> " + server.URL + "/b",
+		"302 " + server.URL + "/b -> " + server.URL + "/c",
+	}
+	if strings.Join(result.redirects, "\n") != strings.Join(expected, "\n") {
+		t.Fatalf("expected redirect chain %v, got %v", expected, result.redirects)
+	}
+}
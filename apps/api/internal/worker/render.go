@@ -0,0 +1,185 @@
+package worker
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os/exec"
+	"strings"
+	"time"
+
+	"goanna/apps/api/ent"
+	"goanna/apps/api/ent/monitor"
+)
+
+const (
+	DefaultBrowserPath          = "chromium"
+	DefaultRenderTimeout        = 30 * time.Second
+	DefaultMaxConcurrentRenders = 2
+
+	renderSettleBudget   = 5 * time.Second
+	maxRenderStderrBytes = 4 * 1024
+)
+
+var errRenderingDisabled = errors.New("rendered fetch mode is disabled (set GOANNA_RENDERING_ENABLED=true)")
+
+// pageRenderer loads a page in a browser and returns its DOM after scripts ran.
+type pageRenderer interface {
+	Render(ctx context.Context, url string, maxBytes int) ([]byte, error)
+}
+
+// chromiumRenderer shells out to headless Chromium's --dump-dom. Renders are
+// bounded by a timeout and a concurrency limit since each one is a browser
+// process. With a guard, the browser's requests go through a guardedProxy.
+type chromiumRenderer struct {
+	path    string
+	timeout time.Duration
+	slots   chan struct{}
+	guard   *NetworkGuard
+}
+
+func newChromiumRenderer(path string, timeout time.Duration, maxConcurrent int, guard *NetworkGuard) *chromiumRenderer {
+	if strings.TrimSpace(path) == "" {
+		path = DefaultBrowserPath
+	}
+	if timeout <= 0 {
+		timeout = DefaultRenderTimeout
+	}
+	if maxConcurrent <= 0 {
+		maxConcurrent = DefaultMaxConcurrentRenders
+	}
+
+	return &chromiumRenderer{
+		path:    path,
+		timeout: timeout,
+		slots:   make(chan struct{}, maxConcurrent),
+		guard:   guard,
+	}
+}
+
+// IsRenderableURL reports whether raw is an http or https URL. Chromium would
+// otherwise open file:// and other local schemes.
+func IsRenderableURL(raw string) bool {
+	raw = strings.ToLower(strings.TrimSpace(raw))
+	return strings.HasPrefix(raw, "http://") || strings.HasPrefix(raw, "https://")
+}
+
+func (r *chromiumRenderer) Render(ctx context.Context, url string, maxBytes int) ([]byte, error) {
+	if !IsRenderableURL(url) {
+		return nil, errors.New("render requires an http or https url")
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	select {
+	case r.slots <- struct{}{}:
+		defer func() { <-r.slots }()
+	case <-ctx.Done():
+		return nil, fmt.Errorf("waiting for render slot: %w", ctx.Err())
+	}
+
+	settle := renderSettleBudget
+	if settle > r.timeout/2 {
+		settle = r.timeout / 2
+	}
+
+	args := []string{
+		"--headless=new",
+		"--no-sandbox",
+		"--disable-gpu",
+		"--disable-extensions",
+		"--disable-dev-shm-usage",
+		"--mute-audio",
+		"--hide-scrollbars",
+		"--blink-settings=imagesEnabled=false",
+		fmt.Sprintf("--virtual-time-budget=%d", settle.Milliseconds()),
+		"--dump-dom",
+	}
+	if r.guard != nil {
+		proxy, err := startGuardedProxy(r.guard)
+		if err != nil {
+			return nil, fmt.Errorf("starting render proxy: %w", err)
+		}
+		defer proxy.Close()
+		// <-loopback> stops Chromium from connecting to loopback hosts
+		// directly instead of through the proxy.
+		args = append(args, "--proxy-server="+proxy.URL(), "--proxy-bypass-list=<-loopback>")
+	}
+	// "--" ends the options, so the url can never be read as a flag.
+	cmd := exec.CommandContext(ctx, r.path, append(args, "--", url)...)
+	stdout := &limitedBuffer{limit: maxBytes + 1}
+	stderr := &limitedBuffer{limit: maxRenderStderrBytes}
+	cmd.Stdout = stdout
+	cmd.Stderr = stderr
+
+	if err := cmd.Run(); err != nil {
+		if ctx.Err() != nil {
+			return nil, fmt.Errorf("render timed out after %s", r.timeout)
+		}
+		if detail := strings.TrimSpace(stderr.buffer.String()); detail != "" {
+			return nil, fmt.Errorf("render failed: %v: %s", err, detail)
+		}
+		return nil, fmt.Errorf("render failed: %w", err)
+	}
+
+	return stdout.buffer.Bytes(), nil
+}
+
+// limitedBuffer keeps the first limit bytes written and discards the rest, so
+// a runaway page cannot grow the worker's memory.
+type limitedBuffer struct {
+	buffer bytes.Buffer
+	limit  int
+}
+
+func (b *limitedBuffer) Write(p []byte) (int, error) {
+	if remaining := b.limit - b.buffer.Len(); remaining > 0 {
+		if len(p) > remaining {
+			b.buffer.Write(p[:remaining])
+		} else {
+			b.buffer.Write(p)
+		}
+	}
+	return len(p), nil
+}
+
+func isRenderedMonitor(row *ent.Monitor) bool {
+	return row != nil && row.FetchMode == monitor.FetchModeRendered
+}
+
+// renderResponse renders the monitor's page and wraps the DOM in a synthetic
+// 200 response so the rest of the check pipeline stays unchanged.
+func (w *Worker) renderResponse(ctx context.Context, row *ent.Monitor, defaults CheckDefaults) (*http.Response, error) {
+	if w.renderer == nil {
+		return nil, errRenderingDisabled
+	}
+
+	targetURL := ExpandTemplate(row.URL, time.Now())
+	if w.guard != nil {
+		parsed, err := url.Parse(targetURL)
+		if err != nil {
+			return nil, err
+		}
+		if err := w.guard.CheckHost(ctx, parsed.Hostname()); err != nil {
+			return nil, err
+		}
+	}
+
+	dom, err := w.renderer.Render(ctx, targetURL, w.responseBodyLimit(row, defaults))
+	if err != nil {
+		return nil, err
+	}
+
+	header := http.Header{}
+	header.Set("Content-Type", "text/html; charset=utf-8")
+	return &http.Response{
+		StatusCode: http.StatusOK,
+		Header:     header,
+		Body:       io.NopCloser(bytes.NewReader(dom)),
+	}, nil
+}
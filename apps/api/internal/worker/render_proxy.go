@@ -0,0 +1,134 @@
+package worker
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// guardedProxy is a forward proxy on loopback that a rendered check's browser
+// sends every request through, so redirects, subresources and script fetches
+// are resolved and dialled under the NetworkGuard rather than by the browser.
+type guardedProxy struct {
+	listener  net.Listener
+	server    *http.Server
+	dialer    *net.Dialer
+	transport *http.Transport
+}
+
+// startGuardedProxy starts a guarded proxy on a free loopback port.
+func startGuardedProxy(guard *NetworkGuard) (*guardedProxy, error) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return nil, err
+	}
+
+	dialer := NewGuardedDialer(guard, nil)
+	proxy := &guardedProxy{
+		listener: listener,
+		dialer:   dialer,
+		transport: &http.Transport{
+			DialContext:           dialer.DialContext,
+			TLSHandshakeTimeout:   10 * time.Second,
+			ResponseHeaderTimeout: 30 * time.Second,
+		},
+	}
+	proxy.server = &http.Server{Handler: proxy, ReadHeaderTimeout: 10 * time.Second}
+	go func() { _ = proxy.server.Serve(listener) }()
+	return proxy, nil
+}
+
+// URL is the address to pass to the browser's --proxy-server.
+func (p *guardedProxy) URL() string {
+	return "http://" + p.listener.Addr().String()
+}
+
+// Close stops the proxy and drops connections still open through it.
+func (p *guardedProxy) Close() error {
+	p.transport.CloseIdleConnections()
+	return p.server.Close()
+}
+
+func (p *guardedProxy) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method == http.MethodConnect {
+		p.tunnel(w, r)
+		return
+	}
+	if r.URL.Scheme != "http" || r.URL.Host == "" {
+		http.Error(w, "absolute http URL required", http.StatusBadRequest)
+		return
+	}
+
+	outbound := r.Clone(r.Context())
+	outbound.RequestURI = ""
+	outbound.Header.Del("Proxy-Connection")
+	outbound.Header.Del("Proxy-Authorization")
+	response, err := p.transport.RoundTrip(outbound)
+	if err != nil {
+		writeProxyError(w, err)
+		return
+	}
+	defer response.Body.Close()
+
+	for key, values := range response.Header {
+		for _, value := range values {
+			w.Header().Add(key, value)
+		}
+	}
+	w.WriteHeader(response.StatusCode)
+	_, _ = io.Copy(w, response.Body)
+}
+
+// tunnel serves a CONNECT request, used for https and websockets, by piping
+// the browser's connection to a guarded connection to the target.
+func (p *guardedProxy) tunnel(w http.ResponseWriter, r *http.Request) {
+	target, err := p.dialer.DialContext(r.Context(), "tcp", r.Host)
+	if err != nil {
+		writeProxyError(w, err)
+		return
+	}
+
+	hijacker, ok := w.(http.Hijacker)
+	if !ok {
+		target.Close()
+		http.Error(w, "tunnelling not supported", http.StatusInternalServerError)
+		return
+	}
+	client, buffered, err := hijacker.Hijack()
+	if err != nil {
+		target.Close()
+		return
+	}
+	if _, err := client.Write([]byte("HTTP/1.1 200 Connection established\r\n\r\n")); err != nil {
+		client.Close()
+		target.Close()
+		return
+	}
+
+	var once sync.Once
+	closeBoth := func() {
+		client.Close()
+		target.Close()
+	}
+	go func() {
+		_, _ = io.Copy(target, buffered)
+		once.Do(closeBoth)
+	}()
+	_, _ = io.Copy(client, target)
+	once.Do(closeBoth)
+}
+
+func writeProxyError(w http.ResponseWriter, err error) {
+	if errors.Is(err, ErrBlockedByNetworkPolicy) {
+		http.Error(w, err.Error(), http.StatusForbidden)
+		return
+	}
+	if errors.Is(err, context.Canceled) {
+		return
+	}
+	http.Error(w, err.Error(), http.StatusBadGateway)
+}
@@ -0,0 +1,91 @@
+package worker
+
+import (
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync/atomic"
+	"testing"
+)
+
+func TestGuardedProxyRejectsRedirectToBlockedAddress(t *testing.T) {
+	var internalHits atomic.Int32
+	internal := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		internalHits.Add(1)
+		_, _ = w.Write([]byte("metadata"))
+	}))
+	listener, err := net.Listen("tcp", "127.0.0.2:0")
+	if err != nil {
+		t.Skipf("second loopback address unavailable: %v", err)
+	}
+	internal.Listener = listener
+	internal.Start()
+	defer internal.Close()
+
+	public := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/page" {
+			_, _ = w.Write([]byte("public page"))
+			return
+		}
+		http.Redirect(w, r, internal.URL, http.StatusFound)
+	}))
+	defer public.Close()
+
+	// 127.0.0.1 stands in for a public address, 127.0.0.2 for a blocked one.
+	guard, err := NewNetworkGuard([]string{"127.0.0.0/8"}, []string{"127.0.0.1"})
+	if err != nil {
+		t.Fatalf("expected guard to parse: %v", err)
+	}
+	proxy, err := startGuardedProxy(guard)
+	if err != nil {
+		t.Fatalf("expected proxy to start: %v", err)
+	}
+	defer proxy.Close()
+
+	proxyURL, _ := url.Parse(proxy.URL())
+	client := &http.Client{Transport: &http.Transport{Proxy: http.ProxyURL(proxyURL)}}
+
+	response, err := client.Get(public.URL + "/page")
+	if err != nil {
+		t.Fatalf("expected allowed page to load through the proxy: %v", err)
+	}
+	body, _ := io.ReadAll(response.Body)
+	response.Body.Close()
+	if response.StatusCode != http.StatusOK || string(body) != "public page" {
+		t.Fatalf("expected allowed page, got %d %q", response.StatusCode, body)
+	}
+
+	response, err = client.Get(public.URL + "/redirect")
+	if err != nil {
+		t.Fatalf("expected proxy to answer the redirected request: %v", err)
+	}
+	body, _ = io.ReadAll(response.Body)
+	response.Body.Close()
+	if response.StatusCode != http.StatusForbidden || !strings.Contains(string(body), "blocked by network policy") {
+		t.Fatalf("expected redirect to a blocked address to be refused, got %d %q", response.StatusCode, body)
+	}
+
+	internalTLS := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		internalHits.Add(1)
+	}))
+	tlsListener, err := net.Listen("tcp", "127.0.0.2:0")
+	if err != nil {
+		t.Fatalf("expected second listener: %v", err)
+	}
+	internalTLS.Listener = tlsListener
+	internalTLS.StartTLS()
+	defer internalTLS.Close()
+
+	tlsClient := internalTLS.Client()
+	tlsClient.Transport.(*http.Transport).Proxy = http.ProxyURL(proxyURL)
+	if _, err := tlsClient.Get(internalTLS.URL); err == nil {
+		t.Fatal("expected a tunnel to a blocked address to be refused")
+	}
+
+	if hits := internalHits.Load(); hits != 0 {
+		t.Fatalf("expected blocked servers never to be reached, got %d requests", hits)
+	}
+}
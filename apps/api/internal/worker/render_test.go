@@ -0,0 +1,104 @@
+package worker
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"goanna/apps/api/ent"
+	"goanna/apps/api/ent/monitor"
+)
+
+type stubRenderer struct {
+	dom []byte
+	err error
+	url string
+}
+
+func (r *stubRenderer) Render(_ context.Context, url string, _ int) ([]byte, error) {
+	r.url = url
+	return r.dom, r.err
+}
+
+func TestExecuteOnceUsesRendererForRenderedMonitors(t *testing.T) {
+	renderer := &stubRenderer{dom: []byte(`<html><body><span id="price">$12</span></body></html>`)}
+	w := &Worker{renderer: renderer, maxResponseBodyBytes: DefaultMaxResponseBodyBytes}
+	row := &ent.Monitor{
+		Method:       http.MethodGet,
+		URL:          "https://example.com/app",
+		ExpectedType: monitor.ExpectedTypeHTML,
+		MustContain:  []string{"$12"},
+		BodySnapshot: monitor.BodySnapshotRaw,
+		FetchMode:    monitor.FetchModeRendered,
+	}
+
+	result := w.executeOnce(t.Context(), row, CheckDefaults{})
+	if !result.success {
+		t.Fatalf("expected rendered check to succeed, got error=%v", result.errorMessage)
+	}
+	if renderer.url != row.URL {
+		t.Fatalf("expected renderer to load %q, got %q", row.URL, renderer.url)
+	}
+	if result.body == nil || !bytes.Equal(result.body.Data, renderer.dom) {
+		t.Fatalf("expected rendered DOM to be captured, got %#v", result.body)
+	}
+}
+
+func TestExecuteOnceRenderedFailures(t *testing.T) {
+	row := &ent.Monitor{
+		Method:       http.MethodGet,
+		URL:          "https://example.com/app",
+		ExpectedType: monitor.ExpectedTypeHTML,
+		FetchMode:    monitor.FetchModeRendered,
+	}
+
+	disabled := &Worker{maxResponseBodyBytes: DefaultMaxResponseBodyBytes}
+	result := disabled.executeOnce(t.Context(), row, CheckDefaults{})
+	if result.success || result.errorMessage == nil || *result.errorMessage != errRenderingDisabled.Error() {
+		t.Fatalf("expected rendering disabled error, got %v", result.errorMessage)
+	}
+
+	failing := &Worker{renderer: &stubRenderer{err: errors.New("render timed out after 30s")}, maxResponseBodyBytes: DefaultMaxResponseBodyBytes}
+	result = failing.executeOnce(t.Context(), row, CheckDefaults{})
+	if result.success || result.errorMessage == nil || *result.errorMessage != "render timed out after 30s" {
+		t.Fatalf("expected render error, got %v", result.errorMessage)
+	}
+}
+
+func TestChromiumRendererPassesURLAfterOptions(t *testing.T) {
+	browser := filepath.Join(t.TempDir(), "chromium")
+	if err := os.WriteFile(browser, []byte("#!/bin/sh\nprintf '%s\\n' \"$@\"\n"), 0o755); err != nil {
+		t.Fatalf("expected fake browser to be written: %v", err)
+	}
+	renderer := newChromiumRenderer(browser, 0, 0, nil)
+
+	out, err := renderer.Render(t.Context(), "https://example.com/app", 4096)
+	if err != nil {
+		t.Fatalf("expected render to succeed: %v", err)
+	}
+	args := strings.Split(strings.TrimSpace(string(out)), "\n")
+	if len(args) < 2 || args[len(args)-2] != "--" || args[len(args)-1] != "https://example.com/app" {
+		t.Fatalf("expected url after --, got %q", args)
+	}
+
+	for _, url := range []string{"file:///etc/passwd", "--renderer-cmd-prefix=touch /tmp/pwned"} {
+		if _, err := renderer.Render(t.Context(), url, 4096); err == nil {
+			t.Fatalf("expected %q to be refused", url)
+		}
+	}
+}
+
+func TestLimitedBufferDiscardsOverflow(t *testing.T) {
+	buffer := &limitedBuffer{limit: 4}
+	if n, err := buffer.Write([]byte("abcdef")); n != 6 || err != nil {
+		t.Fatalf("expected full write to be acknowledged, got n=%d err=%v", n, err)
+	}
+	if got := buffer.buffer.String(); got != "abcd" {
+		t.Fatalf("expected buffer to keep first 4 bytes, got %q", got)
+	}
+}
@@ -0,0 +1,43 @@
+package worker
+
+import (
+	"net/http"
+
+	"goanna/apps/api/ent"
+)
+
+// MergeRequestHeaders combines the global default headers, a header profile
+// and a monitor's own headers and User-Agent. Profile headers override
+// defaults of the same name, monitor headers override both, and a User-Agent
+// set on the monitor overrides everything.
+func MergeRequestHeaders(defaults, profile, headers map[string]string, userAgent *string) map[string]string {
+	merged := make(http.Header, len(defaults)+len(profile)+len(headers)+1)
+	for key, value := range defaults {
+		merged.Set(key, value)
+	}
+	for key, value := range profile {
+		merged.Set(key, value)
+	}
+	for key, value := range headers {
+		merged.Set(key, value)
+	}
+	if userAgent != nil && *userAgent != "" {
+		merged.Set("User-Agent", *userAgent)
+	}
+
+	result := make(map[string]string, len(merged))
+	for key := range merged {
+		result[key] = merged.Get(key)
+	}
+	return result
+}
+
+// monitorRequestHeaders returns the headers a check of row sends. The header
+// profile edge must be loaded for its headers to apply.
+func monitorRequestHeaders(row *ent.Monitor, defaults CheckDefaults) map[string]string {
+	var profile map[string]string
+	if row.Edges.HeaderProfile != nil {
+		profile = row.Edges.HeaderProfile.Headers
+	}
+	return MergeRequestHeaders(defaults.Headers, profile, row.Headers, row.UserAgent)
+}
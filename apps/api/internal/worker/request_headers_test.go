@@ -0,0 +1,70 @@
+package worker
+
+import (
+	"maps"
+	"testing"
+
+	"goanna/apps/api/ent"
+)
+
+func TestMergeRequestHeaders(t *testing.T) {
+	profile := map[string]string{
+		"User-Agent":      "Mozilla/5.0",
+		"Accept-Language": "en-US",
+		"accept":          "text/html",
+	}
+	headers := map[string]string{"Accept": "application/json"}
+
+	got := MergeRequestHeaders(nil, profile, headers, nil)
+	want := map[string]string{
+		"User-Agent":      "Mozilla/5.0",
+		"Accept-Language": "en-US",
+		"Accept":          "application/json",
+	}
+	if !maps.Equal(got, want) {
+		t.Fatalf("expected %v, got %v", want, got)
+	}
+
+	userAgent := "goanna-probe/1.0"
+	got = MergeRequestHeaders(nil, profile, map[string]string{"user-agent": "custom"}, &userAgent)
+	if got["User-Agent"] != userAgent {
+		t.Fatalf("expected monitor User-Agent to win, got %v", got)
+	}
+}
+
+func TestMergeRequestHeadersDefaults(t *testing.T) {
+	defaultUserAgent := " goanna/2.0 "
+	defaults := CheckDefaultsFromSystem(&ent.SystemConfig{
+		DefaultUserAgent: &defaultUserAgent,
+		DefaultHeaders: map[string]string{
+			"accept-language": "de-DE",
+			"X-Team":          "ops",
+			"user-agent":      "overridden by defaultUserAgent",
+		},
+	})
+	if !maps.Equal(defaults.Headers, map[string]string{
+		"User-Agent":      "goanna/2.0",
+		"Accept-Language": "de-DE",
+		"X-Team":          "ops",
+	}) {
+		t.Fatalf("unexpected default headers %v", defaults.Headers)
+	}
+
+	got := MergeRequestHeaders(defaults.Headers, map[string]string{"Accept-Language": "en-US"}, map[string]string{"x-team": "web"}, nil)
+	want := map[string]string{
+		"User-Agent":      "goanna/2.0",
+		"Accept-Language": "en-US",
+		"X-Team":          "web",
+	}
+	if !maps.Equal(got, want) {
+		t.Fatalf("expected %v, got %v", want, got)
+	}
+
+	userAgent := "custom"
+	if got := MergeRequestHeaders(defaults.Headers, nil, nil, &userAgent); got["User-Agent"] != userAgent {
+		t.Fatalf("expected monitor User-Agent to override the default, got %v", got)
+	}
+	if defaults := CheckDefaultsFromSystem(&ent.SystemConfig{}); defaults.Headers != nil {
+		t.Fatalf("expected no default headers, got %v", defaults.Headers)
+	}
+}
@@ -0,0 +1,83 @@
+package worker
+
+import (
+	"fmt"
+	"strings"
+
+	"goanna/apps/api/ent"
+)
+
+// retryPolicy decides which failed attempts are worth repeating. The zero
+// value retries nothing; retryPolicyFor returns one retrying every failure
+// for monitors without a retryOn setting.
+type retryPolicy struct {
+	all        bool
+	network    bool
+	assertions bool
+	statuses   []statusRange
+}
+
+// ValidateRetryOn reports whether raw is a usable retryOn setting: a
+// comma-separated list of "network", "assertion", status codes ("429"),
+// classes ("5xx") or ranges ("500-504").
+func ValidateRetryOn(raw string) error {
+	_, err := parseRetryOn(raw)
+	return err
+}
+
+func parseRetryOn(raw string) (retryPolicy, error) {
+	var policy retryPolicy
+	empty := true
+	for _, part := range strings.Split(raw, ",") {
+		token := strings.ToLower(strings.TrimSpace(part))
+		switch token {
+		case "":
+			continue
+		case "network":
+			policy.network = true
+		case "assertion":
+			policy.assertions = true
+		default:
+			parsed, err := parseStatusToken(token)
+			if err != nil {
+				return retryPolicy{}, fmt.Errorf("%v (use network, assertion or status codes)", err)
+			}
+			policy.statuses = append(policy.statuses, parsed)
+		}
+		empty = false
+	}
+
+	if empty {
+		return retryPolicy{}, fmt.Errorf("retryOn %q has no conditions", raw)
+	}
+	return policy, nil
+}
+
+// retryPolicyFor returns the monitor's retry conditions. Monitors without
+// retryOn, or with an invalid stored setting, retry any failure.
+func retryPolicyFor(row *ent.Monitor) retryPolicy {
+	if row == nil || row.RetryOn == nil || strings.TrimSpace(*row.RetryOn) == "" {
+		return retryPolicy{all: true}
+	}
+
+	policy, err := parseRetryOn(*row.RetryOn)
+	if err != nil {
+		return retryPolicy{all: true}
+	}
+	return policy
+}
+
+// retryable classifies a failed attempt as a network error (no response), an
+// unexpected status code, or an assertion failure on an accepted response.
+func (p retryPolicy) retryable(row *ent.Monitor, result executionResult) bool {
+	if p.all {
+		return true
+	}
+	if result.statusCode == nil {
+		return p.network
+	}
+	if !statusAllowed(expectedStatusRanges(row), *result.statusCode) {
+		return len(p.statuses) > 0 && statusAllowed(p.statuses, *result.statusCode)
+	}
+	return p.assertions
+}
@@ -0,0 +1,148 @@
+package worker
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"goanna/apps/api/ent"
+	"goanna/apps/api/ent/enttest"
+	"goanna/apps/api/ent/monitor"
+	"goanna/apps/api/ent/monitorruntime"
+
+	_ "github.com/mattn/go-sqlite3"
+)
+
+func TestRetryPolicyClassifiesFailures(t *testing.T) {
+	status := func(code int) executionResult { return executionResult{statusCode: &code} }
+	row := &ent.Monitor{}
+
+	if err := ValidateRetryOn("network, 5xx ,429"); err != nil {
+		t.Fatalf("expected valid retryOn, got %v", err)
+	}
+	for _, raw := range []string{"", " , ", "timeouts", "6xx"} {
+		if err := ValidateRetryOn(raw); err == nil {
+			t.Fatalf("expected %q to be rejected", raw)
+		}
+	}
+
+	if policy := retryPolicyFor(row); !policy.retryable(row, status(200)) {
+		t.Fatal("expected monitors without retryOn to retry any failure")
+	}
+
+	retryOn := "network,5xx,429"
+	row.RetryOn = &retryOn
+	policy := retryPolicyFor(row)
+	cases := []struct {
+		name   string
+		result executionResult
+		want   bool
+	}{
+		{name: "network", result: executionResult{}, want: true},
+		{name: "503", result: status(503), want: true},
+		{name: "429", result: status(429), want: true},
+		{name: "404", result: status(404), want: false},
+		{name: "assertion", result: status(200), want: false},
+	}
+	for _, tc := range cases {
+		if got := policy.retryable(row, tc.result); got != tc.want {
+			t.Fatalf("%s: expected retryable=%t, got %t", tc.name, tc.want, got)
+		}
+	}
+}
+
+func TestExecuteWithRetrySkipsAssertionFailures(t *testing.T) {
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		requests.Add(1)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"status":"ok"}`))
+	}))
+	defer server.Close()
+
+	client := enttest.Open(t, "sqlite3", "file:worker-retry?mode=memory&cache=shared&_fk=1")
+	defer client.Close()
+
+	retryOn := "network,5xx"
+	row, err := client.Monitor.Create().
+		SetURL(server.URL).
+		SetCron("*/5 * * * *").
+		SetSelector("missing").
+		SetRetryOn(retryOn).
+		SetExpectedType(monitor.ExpectedTypeJSON).
+		Save(t.Context())
+	if err != nil {
+		t.Fatalf("expected monitor to save: %v", err)
+	}
+	runtime, err := client.MonitorRuntime.Create().
+		SetMonitor(row).
+		SetStatus(monitorruntime.StatusOk).
+		Save(t.Context())
+	if err != nil {
+		t.Fatalf("expected runtime to save: %v", err)
+	}
+
+	w := NewWithConfig(client, Config{})
+	w.client = server.Client()
+	result, retriesUsed := w.executeWithRetry(t.Context(), row, runtime, CheckDefaults{})
+	if result.success || retriesUsed != 0 || requests.Load() != 1 {
+		t.Fatalf("expected one attempt without retries, got success=%t retries=%d requests=%d", result.success, retriesUsed, requests.Load())
+	}
+}
+
+func TestExecuteWithRetryUsesRuntimeDefaults(t *testing.T) {
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		requests.Add(1)
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	client := enttest.Open(t, "sqlite3", "file:worker-retry-defaults?mode=memory&cache=shared&_fk=1")
+	defer client.Close()
+
+	row, err := client.Monitor.Create().
+		SetURL(server.URL).
+		SetCron("*/5 * * * *").
+		SetExpectedType(monitor.ExpectedTypeText).
+		Save(t.Context())
+	if err != nil {
+		t.Fatalf("expected monitor to save: %v", err)
+	}
+	runtime, err := client.MonitorRuntime.Create().
+		SetMonitor(row).
+		SetStatus(monitorruntime.StatusOk).
+		Save(t.Context())
+	if err != nil {
+		t.Fatalf("expected runtime to save: %v", err)
+	}
+	config, err := client.SystemConfig.Create().
+		SetMaxRetries(4).
+		SetRetryBackoffSeconds(0).
+		SetRequestTimeoutSeconds(30).
+		Save(t.Context())
+	if err != nil {
+		t.Fatalf("expected system config to save: %v", err)
+	}
+
+	defaults := CheckDefaultsFromSystem(config)
+	if defaults.RequestTimeout != 30*time.Second || defaults.MaxRetries != 4 || defaults.RetryBackoff != 0 {
+		t.Fatalf("unexpected check defaults %+v", defaults)
+	}
+	if fallback := CheckDefaultsFromSystem(nil); fallback.RequestTimeout != requestTimeout || fallback.MaxRetries != maxRetries {
+		t.Fatalf("expected built-in defaults without a config, got %+v", fallback)
+	}
+
+	w := NewWithConfig(client, Config{})
+	result, retriesUsed := w.executeWithRetry(t.Context(), row, runtime, defaults)
+	if result.success || retriesUsed != 4 || requests.Load() != 5 {
+		t.Fatalf("expected five attempts, got success=%t retries=%d requests=%d", result.success, retriesUsed, requests.Load())
+	}
+
+	checkClient, err := w.clientForMonitor(row, defaults)
+	if err != nil || checkClient.Timeout != 30*time.Second || w.client.Timeout != requestTimeout {
+		t.Fatalf("expected a 30s client without changing the shared one, got %v (%v)", checkClient.Timeout, err)
+	}
+}
@@ -0,0 +1,165 @@
+package worker
+
+import (
+	"context"
+	"maps"
+	"math"
+	"slices"
+	"strconv"
+	"time"
+
+	"goanna/apps/api/ent"
+	"goanna/apps/api/ent/checkrollup"
+)
+
+// HourlyRollupRetention is how long hourly rollups are kept. Daily rollups
+// are kept for the monitor's lifetime.
+const HourlyRollupRetention = 90 * 24 * time.Hour
+
+// latencyBucketBounds are the upper bounds in milliseconds of the rollup
+// latency histogram, each about 25% above the previous one, so percentile
+// estimates are off by at most that much.
+var latencyBucketBounds = func() []int {
+	bounds := []int{1}
+	for bounds[len(bounds)-1] < 10*60*1000 {
+		last := bounds[len(bounds)-1]
+		bounds = append(bounds, max(last+1, int(math.Ceil(float64(last)*1.25))))
+	}
+	return bounds
+}()
+
+// RollupBucketStart returns the start of the hour or UTC day holding t.
+func RollupBucketStart(period checkrollup.Period, t time.Time) time.Time {
+	t = t.UTC()
+	if period == checkrollup.PeriodDay {
+		year, month, day := t.Date()
+		return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+	}
+	return t.Truncate(time.Hour)
+}
+
+// LatencyPercentile estimates the p-th percentile (0 to 1) of a rollup's
+// response times as the upper bound of the histogram bucket holding that
+// rank, capped at the slowest response. It returns nil for an empty
+// histogram.
+func LatencyPercentile(histogram map[string]int, p float64, maxMs *int) *int {
+	total := 0
+	bounds := make([]int, 0, len(histogram))
+	for key, count := range histogram {
+		bound, err := strconv.Atoi(key)
+		if err != nil || count <= 0 {
+			continue
+		}
+		total += count
+		bounds = append(bounds, bound)
+	}
+	if total == 0 {
+		return nil
+	}
+	slices.Sort(bounds)
+
+	rank := int(math.Ceil(p * float64(total)))
+	seen := 0
+	for _, bound := range bounds {
+		seen += histogram[strconv.Itoa(bound)]
+		if seen >= rank {
+			if maxMs != nil && *maxMs < bound {
+				bound = *maxMs
+			}
+			return &bound
+		}
+	}
+	return nil
+}
+
+func latencyBucket(ms int) string {
+	index, _ := slices.BinarySearch(latencyBucketBounds, ms)
+	if index == len(latencyBucketBounds) {
+		index--
+	}
+	return strconv.Itoa(latencyBucketBounds[index])
+}
+
+// recordRollups adds a check to its monitor's hourly and daily rollups and
+// drops hourly rollups past HourlyRollupRetention.
+func (w *Worker) recordRollups(ctx context.Context, monitorID int, result executionResult) error {
+	for _, period := range []checkrollup.Period{checkrollup.PeriodHour, checkrollup.PeriodDay} {
+		if err := w.addToRollup(ctx, monitorID, period, result); err != nil {
+			return err
+		}
+	}
+
+	_, err := w.db.CheckRollup.Delete().
+		Where(
+			checkrollup.MonitorIDEQ(monitorID),
+			checkrollup.PeriodEQ(checkrollup.PeriodHour),
+			checkrollup.BucketStartLT(result.checkedAt.Add(-HourlyRollupRetention)),
+		).
+		Exec(ctx)
+	return err
+}
+
+func (w *Worker) addToRollup(ctx context.Context, monitorID int, period checkrollup.Period, result executionResult) error {
+	rollup, err := w.loadRollup(ctx, monitorID, period, RollupBucketStart(period, result.checkedAt))
+	if err != nil {
+		return err
+	}
+
+	update := w.db.CheckRollup.UpdateOneID(rollup.ID).AddChecks(1)
+	if result.success {
+		update = update.AddSuccesses(1)
+	} else {
+		update = update.AddErrors(1)
+	}
+	if result.diff != nil && result.diff.Changed {
+		update = update.AddChanges(1)
+	}
+	if result.durationMs != nil {
+		durationMs := *result.durationMs
+		histogram := maps.Clone(rollup.LatencyHistogram)
+		if histogram == nil {
+			histogram = map[string]int{}
+		}
+		histogram[latencyBucket(durationMs)]++
+
+		update = update.
+			AddLatencySamples(1).
+			AddLatencyTotalMs(int64(durationMs)).
+			SetLatencyHistogram(histogram)
+		if rollup.LatencyMaxMs == nil || *rollup.LatencyMaxMs < durationMs {
+			update = update.SetLatencyMaxMs(durationMs)
+		}
+	}
+
+	return update.Exec(ctx)
+}
+
+// loadRollup returns the rollup for the bucket, creating it when this is the
+// bucket's first check.
+func (w *Worker) loadRollup(ctx context.Context, monitorID int, period checkrollup.Period, bucketStart time.Time) (*ent.CheckRollup, error) {
+	query := func() (*ent.CheckRollup, error) {
+		return w.db.CheckRollup.Query().
+			Where(
+				checkrollup.MonitorIDEQ(monitorID),
+				checkrollup.PeriodEQ(period),
+				checkrollup.BucketStartEQ(bucketStart),
+			).
+			Only(ctx)
+	}
+
+	rollup, err := query()
+	if !ent.IsNotFound(err) {
+		return rollup, err
+	}
+
+	rollup, err = w.db.CheckRollup.Create().
+		SetMonitorID(monitorID).
+		SetPeriod(period).
+		SetBucketStart(bucketStart).
+		Save(ctx)
+	if ent.IsConstraintError(err) {
+		// A concurrent check of the same monitor created it first.
+		return query()
+	}
+	return rollup, err
+}
@@ -0,0 +1,89 @@
+package worker
+
+import (
+	"testing"
+	"time"
+
+	"goanna/apps/api/ent/checkrollup"
+	"goanna/apps/api/ent/enttest"
+
+	_ "github.com/mattn/go-sqlite3"
+)
+
+func TestLatencyPercentile(t *testing.T) {
+	histogram := map[string]int{}
+	for ms := 1; ms <= 100; ms++ {
+		histogram[latencyBucket(ms*10)]++
+	}
+
+	p95 := LatencyPercentile(histogram, 0.95, nil)
+	if p95 == nil || *p95 < 950 || float64(*p95) > 950*1.25 {
+		t.Fatalf("expected p95 near 950ms, got %v", p95)
+	}
+	maxMs := 900
+	if capped := LatencyPercentile(histogram, 0.95, &maxMs); capped == nil || *capped != 900 {
+		t.Fatalf("expected p95 capped at the slowest response, got %v", capped)
+	}
+	if empty := LatencyPercentile(nil, 0.95, nil); empty != nil {
+		t.Fatalf("expected no percentile without samples, got %d", *empty)
+	}
+	if bucket := latencyBucket(24 * 60 * 60 * 1000); bucket != latencyBucket(10*60*1000) {
+		t.Fatalf("expected slow responses in the last bucket, got %s", bucket)
+	}
+}
+
+func TestRecordRollups(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:worker-rollups?mode=memory&cache=shared&_fk=1")
+	defer client.Close()
+
+	row, err := client.Monitor.Create().SetURL("https://example.com").SetCron("* * * * *").Save(t.Context())
+	if err != nil {
+		t.Fatalf("expected monitor to save: %v", err)
+	}
+	w := New(client)
+
+	checkedAt := time.Date(2026, time.March, 1, 10, 15, 0, 0, time.UTC)
+	stale, err := client.CheckRollup.Create().
+		SetMonitorID(row.ID).
+		SetPeriod(checkrollup.PeriodHour).
+		SetBucketStart(checkedAt.Add(-HourlyRollupRetention - time.Hour)).
+		Save(t.Context())
+	if err != nil {
+		t.Fatalf("expected rollup to save: %v", err)
+	}
+
+	fast, slow := 120, 480
+	results := []executionResult{
+		{checkedAt: checkedAt, success: true, durationMs: &fast},
+		{checkedAt: checkedAt.Add(30 * time.Minute), success: false, durationMs: &slow, diff: &selectionDiff{Changed: true}},
+		{checkedAt: checkedAt.Add(time.Hour), success: true},
+	}
+	for _, result := range results {
+		if err := w.recordRollups(t.Context(), row.ID, result); err != nil {
+			t.Fatalf("expected rollups to record: %v", err)
+		}
+	}
+
+	hourly, err := client.CheckRollup.Query().
+		Where(checkrollup.PeriodEQ(checkrollup.PeriodHour)).
+		Order(checkrollup.ByBucketStart()).
+		All(t.Context())
+	if err != nil || len(hourly) != 2 {
+		t.Fatalf("expected two hourly rollups and the stale one pruned, got %d (%v)", len(hourly), err)
+	}
+	if hourly[0].ID == stale.ID {
+		t.Fatal("expected the stale hourly rollup to be pruned")
+	}
+	first := hourly[0]
+	if first.Checks != 2 || first.Successes != 1 || first.Errors != 1 || first.Changes != 1 {
+		t.Fatalf("unexpected hourly counts %+v", first)
+	}
+	if first.LatencySamples != 2 || first.LatencyTotalMs != 600 || first.LatencyMaxMs == nil || *first.LatencyMaxMs != 480 {
+		t.Fatalf("unexpected hourly latency %+v", first)
+	}
+
+	daily, err := client.CheckRollup.Query().Where(checkrollup.PeriodEQ(checkrollup.PeriodDay)).Only(t.Context())
+	if err != nil || daily.Checks != 3 || !daily.BucketStart.Equal(time.Date(2026, time.March, 1, 0, 0, 0, 0, time.UTC)) {
+		t.Fatalf("expected one daily rollup with every check, got %+v (%v)", daily, err)
+	}
+}
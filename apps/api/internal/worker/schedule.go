@@ -0,0 +1,215 @@
+package worker
+
+import (
+	"errors"
+	"math/rand/v2"
+	"strings"
+	"time"
+
+	"goanna/apps/api/ent"
+
+	"github.com/robfig/cron/v3"
+)
+
+const (
+	DSTPolicySkip      = "skip"
+	DSTPolicyNextValid = "next_valid"
+	DSTPolicyRunTwice  = "run_twice"
+
+	dstOverlapLookback  = 2 * time.Hour
+	maxCronCandidates   = 4096
+	dstOffsetProbeRange = 3 * time.Hour
+)
+
+// NextRunFromCron evaluates expr against local wall-clock time in location and
+// resolves DST gaps and overlaps according to policy:
+//   - skip: runs scheduled inside a skipped hour are dropped, repeated hours run once.
+//   - next_valid: runs inside a skipped hour move to the first valid instant after it, repeated hours run once.
+//   - run_twice: like next_valid, but runs inside a repeated hour fire on both occurrences.
+//
+// A repeated hour runs once only for crons matching a single time in it. Crons
+// matching several times there keep their cadence through both occurrences,
+// so frequent monitors do not pause for the hour.
+func NextRunFromCron(expr string, now time.Time, location *time.Location, policy string) (time.Time, error) {
+	schedule, err := cron.ParseStandard(expr)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	if location == nil {
+		location = time.UTC
+	}
+
+	if specSchedule, ok := schedule.(*cron.SpecSchedule); ok {
+		specSchedule.Location = time.UTC
+	}
+
+	// Wall times repeat inside an overlap, so candidates start before now
+	// and the earliest instant after now wins rather than the first found.
+	var next time.Time
+	cursor := wallClock(now, location).Add(-dstOverlapLookback)
+	for attempt := 0; attempt < maxCronCandidates; attempt++ {
+		cursor = schedule.Next(cursor)
+		if cursor.IsZero() {
+			break
+		}
+		// Offsets change by less than the lookback, so later wall times
+		// cannot map to an earlier instant.
+		if !next.IsZero() && cursor.After(wallClock(next, location).Add(dstOverlapLookback)) {
+			break
+		}
+
+		instants := instantsForWallClock(cursor, location, policy)
+		if len(instants) == 2 && policy != DSTPolicyRunTwice && !cronRepeatsInOverlap(schedule, cursor, instants[1], location) {
+			instants = instants[:1]
+		}
+		for _, instant := range instants {
+			if instant.After(now) && (next.IsZero() || instant.Before(next)) {
+				next = instant
+			}
+		}
+	}
+
+	if next.IsZero() {
+		return time.Time{}, errors.New("cron expression has no upcoming run")
+	}
+	return next, nil
+}
+
+// cronRepeatsInOverlap reports whether schedule matches a wall time other than
+// wall inside the repeated hour that second, the later occurrence of wall,
+// falls in.
+func cronRepeatsInOverlap(schedule cron.Schedule, wall time.Time, second time.Time, location *time.Location) bool {
+	transition, _ := second.ZoneBounds()
+	_, laterOffset := second.Zone()
+	_, earlierOffset := transition.Add(-time.Second).Zone()
+	overlapStart := wallClock(transition, location)
+	overlapEnd := overlapStart.Add(time.Duration(earlierOffset-laterOffset) * time.Second)
+
+	for match := schedule.Next(overlapStart.Add(-time.Second)); !match.IsZero() && match.Before(overlapEnd); match = schedule.Next(match) {
+		if !match.Equal(wall) {
+			return true
+		}
+	}
+	return false
+}
+
+// scheduleConfig holds the global settings that shape monitor schedules and
+// the checks they run.
+type scheduleConfig struct {
+	location *time.Location
+	jitter   time.Duration
+	breaker  circuitBreaker
+	catchUp  catchUpPolicy
+	checks   CheckDefaults
+	// tickInterval is the time between full scheduling passes; runs due
+	// within lookahead of a wake-up are dispatched with it.
+	tickInterval time.Duration
+	lookahead    time.Duration
+}
+
+func scheduleConfigFromSystem(config *ent.SystemConfig) scheduleConfig {
+	schedule := scheduleConfig{location: time.UTC, tickInterval: defaultTickInterval}
+	if config == nil {
+		return schedule
+	}
+
+	schedule.location = cronLocationFromConfig(config.Timezone)
+	schedule.jitter = time.Duration(config.ScheduleJitterSeconds) * time.Second
+	schedule.breaker = circuitBreakerFromSystem(config)
+	schedule.catchUp = catchUpPolicyFromSystem(config)
+	schedule.checks = CheckDefaultsFromSystem(config)
+	schedule.tickInterval = time.Duration(config.TickIntervalSeconds) * time.Second
+	schedule.lookahead = time.Duration(config.ScheduleLookaheadSeconds) * time.Second
+	return schedule
+}
+
+// MonitorLocation returns the location a monitor's cron is evaluated in: its
+// own timezone when set and valid, otherwise fallback.
+func MonitorLocation(row *ent.Monitor, fallback *time.Location) *time.Location {
+	if row != nil && row.Timezone != nil && strings.TrimSpace(*row.Timezone) != "" {
+		if location, err := time.LoadLocation(strings.TrimSpace(*row.Timezone)); err == nil {
+			return location
+		}
+	}
+	return fallback
+}
+
+// nextRunForMonitor returns the monitor's next cron run delayed by a random
+// jitter, so monitors sharing a cron expression do not all fire in the same
+// tick. A monitor's own jitterSeconds overrides the global setting.
+func nextRunForMonitor(row *ent.Monitor, now time.Time, schedule scheduleConfig) (time.Time, error) {
+	nextRun, err := NextRunFromCron(row.Cron, now, MonitorLocation(row, schedule.location), row.DstPolicy.String())
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	return nextRun.Add(scheduleJitter(monitorJitter(row, schedule))), nil
+}
+
+func monitorJitter(row *ent.Monitor, schedule scheduleConfig) time.Duration {
+	if row != nil && row.JitterSeconds != nil {
+		return time.Duration(*row.JitterSeconds) * time.Second
+	}
+	return schedule.jitter
+}
+
+func scheduleJitter(maxJitter time.Duration) time.Duration {
+	if maxJitter <= 0 {
+		return 0
+	}
+	return rand.N(maxJitter)
+}
+
+// wallClock returns the local date and time of t in location re-expressed in
+// UTC, so cron fields can be matched without DST shifts.
+func wallClock(t time.Time, location *time.Location) time.Time {
+	local := t.In(location)
+	return time.Date(
+		local.Year(), local.Month(), local.Day(),
+		local.Hour(), local.Minute(), local.Second(), local.Nanosecond(),
+		time.UTC,
+	)
+}
+
+// instantsForWallClock returns the instants wall occurs at in location, both
+// in order inside an overlap. A wall time inside a gap maps to the end of the
+// gap, or to nothing under the skip policy.
+func instantsForWallClock(wall time.Time, location *time.Location, policy string) []time.Time {
+	guess := time.Date(
+		wall.Year(), wall.Month(), wall.Day(),
+		wall.Hour(), wall.Minute(), wall.Second(), wall.Nanosecond(),
+		location,
+	)
+
+	instants := make([]time.Time, 0, 2)
+	seen := map[int]struct{}{}
+	for _, probe := range []time.Time{guess.Add(-dstOffsetProbeRange), guess, guess.Add(dstOffsetProbeRange)} {
+		_, offset := probe.Zone()
+		if _, ok := seen[offset]; ok {
+			continue
+		}
+		seen[offset] = struct{}{}
+
+		instant := wall.Add(-time.Duration(offset) * time.Second).In(location)
+		if wallClock(instant, location).Equal(wall) {
+			instants = append(instants, instant)
+		}
+	}
+
+	if len(instants) == 0 {
+		if policy == DSTPolicySkip {
+			return nil
+		}
+
+		_, offsetBefore := guess.Add(-dstOffsetProbeRange).Zone()
+		afterGap := wall.Add(-time.Duration(offsetBefore) * time.Second).In(location)
+		gapEnd, _ := afterGap.ZoneBounds()
+		return []time.Time{gapEnd}
+	}
+
+	if len(instants) == 2 && instants[1].Before(instants[0]) {
+		instants[0], instants[1] = instants[1], instants[0]
+	}
+	return instants
+}
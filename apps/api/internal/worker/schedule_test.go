@@ -3,6 +3,12 @@ package worker
 import (
 	"testing"
 	"time"
+
+	"goanna/apps/api/ent"
+	"goanna/apps/api/ent/enttest"
+	"goanna/apps/api/ent/monitorruntime"
+
+	_ "github.com/mattn/go-sqlite3"
 )
 
 func TestNextRunFromCronUsesConfiguredTimezone(t *testing.T) {
@@ -12,7 +18,7 @@ func TestNextRunFromCronUsesConfiguredTimezone(t *testing.T) {
 		t.Fatalf("expected timezone to load: %v", err)
 	}
 
-	nextRun, err := nextRunFromCron("0 8-18/2 * * *", now, location)
+	nextRun, err := NextRunFromCron("0 8-18/2 * * *", now, location, DSTPolicyNextValid)
 	if err != nil {
 		t.Fatalf("expected cron to parse: %v", err)
 	}
@@ -26,7 +32,7 @@ func TestNextRunFromCronUsesConfiguredTimezone(t *testing.T) {
 func TestNextRunFromCronDefaultsToUTCWhenTimezoneMissing(t *testing.T) {
 	now := time.Date(2026, time.February, 25, 7, 59, 0, 0, time.UTC)
 
-	nextRun, err := nextRunFromCron("0 8-18/2 * * *", now, nil)
+	nextRun, err := NextRunFromCron("0 8-18/2 * * *", now, nil, DSTPolicyNextValid)
 	if err != nil {
 		t.Fatalf("expected cron to parse: %v", err)
 	}
@@ -37,6 +43,159 @@ func TestNextRunFromCronDefaultsToUTCWhenTimezoneMissing(t *testing.T) {
 	}
 }
 
+func TestNextRunFromCronSpringForwardGap(t *testing.T) {
+	location, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Fatalf("expected timezone to load: %v", err)
+	}
+
+	// 2026-03-08 01:00 EST; local clocks jump from 02:00 to 03:00.
+	now := time.Date(2026, time.March, 8, 6, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		policy string
+		want   time.Time
+	}{
+		{policy: DSTPolicySkip, want: time.Date(2026, time.March, 9, 6, 30, 0, 0, time.UTC)},
+		{policy: DSTPolicyNextValid, want: time.Date(2026, time.March, 8, 7, 0, 0, 0, time.UTC)},
+		{policy: DSTPolicyRunTwice, want: time.Date(2026, time.March, 8, 7, 0, 0, 0, time.UTC)},
+	}
+
+	for _, tt := range tests {
+		nextRun, err := NextRunFromCron("30 2 * * *", now, location, tt.policy)
+		if err != nil {
+			t.Fatalf("%s: expected cron to parse: %v", tt.policy, err)
+		}
+		if !nextRun.Equal(tt.want) {
+			t.Fatalf("%s: expected next run %s, got %s", tt.policy, tt.want, nextRun)
+		}
+	}
+}
+
+func TestNextRunFromCronFallBackOverlap(t *testing.T) {
+	location, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Fatalf("expected timezone to load: %v", err)
+	}
+
+	// 2026-11-01 00:00 EDT; local 01:00-02:00 occurs twice.
+	now := time.Date(2026, time.November, 1, 4, 0, 0, 0, time.UTC)
+	firstOccurrence := time.Date(2026, time.November, 1, 5, 30, 0, 0, time.UTC)
+	secondOccurrence := time.Date(2026, time.November, 1, 6, 30, 0, 0, time.UTC)
+	nextDay := time.Date(2026, time.November, 2, 6, 30, 0, 0, time.UTC)
+
+	tests := []struct {
+		policy    string
+		wantFirst time.Time
+		wantAfter time.Time
+	}{
+		{policy: DSTPolicySkip, wantFirst: firstOccurrence, wantAfter: nextDay},
+		{policy: DSTPolicyNextValid, wantFirst: firstOccurrence, wantAfter: nextDay},
+		{policy: DSTPolicyRunTwice, wantFirst: firstOccurrence, wantAfter: secondOccurrence},
+	}
+
+	for _, tt := range tests {
+		nextRun, err := NextRunFromCron("30 1 * * *", now, location, tt.policy)
+		if err != nil {
+			t.Fatalf("%s: expected cron to parse: %v", tt.policy, err)
+		}
+		if !nextRun.Equal(tt.wantFirst) {
+			t.Fatalf("%s: expected first run %s, got %s", tt.policy, tt.wantFirst, nextRun)
+		}
+
+		nextRun, err = NextRunFromCron("30 1 * * *", nextRun, location, tt.policy)
+		if err != nil {
+			t.Fatalf("%s: expected cron to parse: %v", tt.policy, err)
+		}
+		if !nextRun.Equal(tt.wantAfter) {
+			t.Fatalf("%s: expected following run %s, got %s", tt.policy, tt.wantAfter, nextRun)
+		}
+	}
+
+	// Crons matching several times in the repeated hour keep their cadence:
+	// 05:00-06:00 UTC is 01:00-02:00 EDT, 06:00-07:00 UTC repeats it as EST.
+	cadenceTests := []struct {
+		name   string
+		expr   string
+		policy string
+		now    time.Time
+		want   time.Time
+	}{
+		{name: "first pass", expr: "*/5 * * * *", policy: DSTPolicyNextValid,
+			now: time.Date(2026, time.November, 1, 5, 10, 0, 0, time.UTC), want: time.Date(2026, time.November, 1, 5, 15, 0, 0, time.UTC)},
+		{name: "into second pass", expr: "*/5 * * * *", policy: DSTPolicyNextValid,
+			now: time.Date(2026, time.November, 1, 5, 55, 0, 0, time.UTC), want: time.Date(2026, time.November, 1, 6, 0, 0, 0, time.UTC)},
+		{name: "within second pass", expr: "*/5 * * * *", policy: DSTPolicySkip,
+			now: time.Date(2026, time.November, 1, 6, 10, 0, 0, time.UTC), want: time.Date(2026, time.November, 1, 6, 15, 0, 0, time.UTC)},
+		{name: "hourly runs once", expr: "0 * * * *", policy: DSTPolicyNextValid,
+			now: time.Date(2026, time.November, 1, 5, 0, 0, 0, time.UTC), want: time.Date(2026, time.November, 1, 7, 0, 0, 0, time.UTC)},
+		{name: "hourly runs twice", expr: "0 * * * *", policy: DSTPolicyRunTwice,
+			now: time.Date(2026, time.November, 1, 5, 0, 0, 0, time.UTC), want: time.Date(2026, time.November, 1, 6, 0, 0, 0, time.UTC)},
+	}
+
+	for _, tt := range cadenceTests {
+		nextRun, err := NextRunFromCron(tt.expr, tt.now, location, tt.policy)
+		if err != nil {
+			t.Fatalf("%s: expected cron to parse: %v", tt.name, err)
+		}
+		if !nextRun.Equal(tt.want) {
+			t.Fatalf("%s: expected next run %s, got %s", tt.name, tt.want, nextRun)
+		}
+	}
+}
+
+func TestNextRunForMonitorAppliesJitter(t *testing.T) {
+	now := time.Date(2026, time.October, 15, 9, 2, 0, 0, time.UTC)
+	cronRun := time.Date(2026, time.October, 15, 9, 5, 0, 0, time.UTC)
+	row := &ent.Monitor{Cron: "*/5 * * * *"}
+	schedule := scheduleConfig{location: time.UTC, jitter: 30 * time.Second}
+
+	for range 50 {
+		nextRun, err := nextRunForMonitor(row, now, schedule)
+		if err != nil {
+			t.Fatalf("expected next run: %v", err)
+		}
+		if nextRun.Before(cronRun) || !nextRun.Before(cronRun.Add(30*time.Second)) {
+			t.Fatalf("expected jittered run within 30s of %s, got %s", cronRun, nextRun)
+		}
+	}
+
+	disabled := 0
+	row.JitterSeconds = &disabled
+	nextRun, err := nextRunForMonitor(row, now, schedule)
+	if err != nil {
+		t.Fatalf("expected next run: %v", err)
+	}
+	if !nextRun.Equal(cronRun) {
+		t.Fatalf("expected monitor override to disable jitter, got %s", nextRun)
+	}
+}
+
+func TestNextRunForMonitorUsesMonitorTimezone(t *testing.T) {
+	now := time.Date(2026, time.October, 15, 6, 0, 0, 0, time.UTC)
+	timezone := "America/New_York"
+	row := &ent.Monitor{Cron: "0 9 * * *", Timezone: &timezone}
+
+	nextRun, err := nextRunForMonitor(row, now, scheduleConfig{location: time.UTC})
+	if err != nil {
+		t.Fatalf("expected next run: %v", err)
+	}
+	expected := time.Date(2026, time.October, 15, 13, 0, 0, 0, time.UTC)
+	if !nextRun.Equal(expected) {
+		t.Fatalf("expected monitor timezone run %s, got %s", expected, nextRun)
+	}
+
+	row.Timezone = nil
+	nextRun, err = nextRunForMonitor(row, now, scheduleConfig{location: time.UTC})
+	if err != nil {
+		t.Fatalf("expected next run: %v", err)
+	}
+	expected = time.Date(2026, time.October, 15, 9, 0, 0, 0, time.UTC)
+	if !nextRun.Equal(expected) {
+		t.Fatalf("expected global timezone run %s, got %s", expected, nextRun)
+	}
+}
+
 func TestShouldTriggerStartupCatchUp(t *testing.T) {
 	startupAt := time.Date(2026, time.February, 25, 12, 0, 0, 0, time.UTC)
 
@@ -59,3 +218,50 @@ func TestShouldTriggerStartupCatchUp(t *testing.T) {
 		t.Fatal("expected future next run not to trigger startup catch-up")
 	}
 }
+
+func TestTickHoldsScheduledRunsWhilePaused(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:worker-paused?mode=memory&cache=shared&_fk=1")
+	defer client.Close()
+
+	row, err := client.Monitor.Create().
+		SetURL("http://127.0.0.1:0/unreachable").
+		SetCron("*/5 * * * *").
+		Save(t.Context())
+	if err != nil {
+		t.Fatalf("expected monitor to save: %v", err)
+	}
+
+	dueAt := time.Now().UTC().Add(-time.Hour).Truncate(time.Second)
+	if _, err := client.MonitorRuntime.Create().
+		SetMonitor(row).
+		SetStatus(monitorruntime.StatusPending).
+		SetNextRunAt(dueAt).
+		Save(t.Context()); err != nil {
+		t.Fatalf("expected runtime to save: %v", err)
+	}
+
+	if _, err := client.SystemConfig.Create().
+		SetKey(globalConfigKey).
+		SetPaused(true).
+		Save(t.Context()); err != nil {
+		t.Fatalf("expected system config to save: %v", err)
+	}
+
+	New(client).tick(t.Context(), nil)
+
+	checks, err := client.CheckResult.Query().Count(t.Context())
+	if err != nil {
+		t.Fatalf("expected check count: %v", err)
+	}
+	if checks != 0 {
+		t.Fatalf("expected no checks while paused, got %d", checks)
+	}
+
+	runtime, err := client.MonitorRuntime.Query().Only(t.Context())
+	if err != nil {
+		t.Fatalf("expected runtime to load: %v", err)
+	}
+	if runtime.NextRunAt == nil || !runtime.NextRunAt.Equal(dueAt) {
+		t.Fatalf("expected nextRunAt %s to be preserved, got %v", dueAt, runtime.NextRunAt)
+	}
+}
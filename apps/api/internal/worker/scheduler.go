@@ -0,0 +1,266 @@
+package worker
+
+import (
+	"container/heap"
+	"context"
+	"sync"
+	"time"
+
+	"goanna/apps/api/ent/monitor"
+)
+
+const (
+	// defaultTickInterval bounds how long the scheduler trusts its run queue
+	// unless the runtime settings set tickIntervalSeconds. Full passes pick up
+	// changes made by other replicas or outside the API, and run watchdog and
+	// stale housekeeping.
+	defaultTickInterval = time.Minute
+	// busyRetryInterval delays a due run that could not start because it is
+	// still in flight or another replica holds its claim.
+	busyRetryInterval = 5 * time.Second
+)
+
+// ScheduleChanges is a small pub/sub the API uses to tell schedulers that
+// monitors or scheduling settings changed, so they rebuild their run queue
+// instead of polling the database. Publishing on a nil *ScheduleChanges is a
+// no-op.
+type ScheduleChanges struct {
+	mu          sync.Mutex
+	subscribers []chan struct{}
+}
+
+func NewScheduleChanges() *ScheduleChanges {
+	return &ScheduleChanges{}
+}
+
+// Publish wakes every subscriber. Publishes made while a subscriber is busy
+// coalesce into a single wake-up.
+func (c *ScheduleChanges) Publish() {
+	if c == nil {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for _, subscriber := range c.subscribers {
+		select {
+		case subscriber <- struct{}{}:
+		default:
+		}
+	}
+}
+
+// subscribe returns a channel that receives after each Publish. The channel
+// of a nil *ScheduleChanges never receives.
+func (c *ScheduleChanges) subscribe() <-chan struct{} {
+	subscriber := make(chan struct{}, 1)
+	if c == nil {
+		return subscriber
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.subscribers = append(c.subscribers, subscriber)
+	return subscriber
+}
+
+type queuedRun struct {
+	monitorID int
+	at        time.Time
+	index     int
+}
+
+// runQueue is a min-heap of the next run time of each monitor. It is only
+// used from the scheduler goroutine.
+type runQueue struct {
+	items     []*queuedRun
+	byMonitor map[int]*queuedRun
+}
+
+func newRunQueue() *runQueue {
+	return &runQueue{byMonitor: map[int]*queuedRun{}}
+}
+
+func (q *runQueue) Len() int { return len(q.items) }
+
+func (q *runQueue) Less(i, k int) bool { return q.items[i].at.Before(q.items[k].at) }
+
+func (q *runQueue) Swap(i, k int) {
+	q.items[i], q.items[k] = q.items[k], q.items[i]
+	q.items[i].index = i
+	q.items[k].index = k
+}
+
+func (q *runQueue) Push(value any) {
+	item := value.(*queuedRun)
+	item.index = len(q.items)
+	q.items = append(q.items, item)
+}
+
+func (q *runQueue) Pop() any {
+	last := len(q.items) - 1
+	item := q.items[last]
+	q.items[last] = nil
+	q.items = q.items[:last]
+	return item
+}
+
+// schedule queues the monitor's next run at at, replacing any queued run.
+func (q *runQueue) schedule(monitorID int, at time.Time) {
+	if item, ok := q.byMonitor[monitorID]; ok {
+		item.at = at
+		heap.Fix(q, item.index)
+		return
+	}
+
+	item := &queuedRun{monitorID: monitorID, at: at}
+	heap.Push(q, item)
+	q.byMonitor[monitorID] = item
+}
+
+func (q *runQueue) remove(monitorID int) {
+	item, ok := q.byMonitor[monitorID]
+	if !ok {
+		return
+	}
+	heap.Remove(q, item.index)
+	delete(q.byMonitor, monitorID)
+}
+
+func (q *runQueue) reset() {
+	q.items = nil
+	q.byMonitor = map[int]*queuedRun{}
+}
+
+func (q *runQueue) next() (time.Time, bool) {
+	if len(q.items) == 0 {
+		return time.Time{}, false
+	}
+	return q.items[0].at, true
+}
+
+// popDue removes and returns the monitors whose queued run is at or before
+// now.
+func (q *runQueue) popDue(now time.Time) []int {
+	var due []int
+	for len(q.items) > 0 && !q.items[0].at.After(now) {
+		item := heap.Pop(q).(*queuedRun)
+		delete(q.byMonitor, item.monitorID)
+		due = append(due, item.monitorID)
+	}
+	return due
+}
+
+// Start runs the scheduler until ctx is cancelled. After a full pass it
+// sleeps until the earliest queued run, a published change, a finished check
+// or the next resync, whichever comes first. Runs due within the lookahead of
+// a wake-up are dispatched with it, so close runs share one wake-up.
+func (w *Worker) Start(ctx context.Context) {
+	changes := w.changes.subscribe()
+
+	startupAt := time.Now().UTC()
+	w.startedAt = startupAt
+	w.tick(ctx, &startupAt)
+	resyncAt := time.Now().Add(w.tickInterval)
+
+	timer := time.NewTimer(w.untilNextWake(resyncAt))
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			w.checks.Wait()
+			return
+		case <-changes:
+			w.tick(ctx, nil)
+			resyncAt = time.Now().Add(w.tickInterval)
+		case <-w.completions:
+			w.syncMonitors(ctx, w.takeCompleted())
+		case <-timer.C:
+			if time.Now().Before(resyncAt) {
+				w.syncMonitors(ctx, w.queue.popDue(time.Now().UTC().Add(w.lookahead)))
+			} else {
+				w.tick(ctx, nil)
+				resyncAt = time.Now().Add(w.tickInterval)
+			}
+		}
+		timer.Reset(w.untilNextWake(resyncAt))
+	}
+}
+
+func (w *Worker) untilNextWake(resyncAt time.Time) time.Duration {
+	wake := resyncAt
+	if next, ok := w.queue.next(); ok && next.Before(wake) {
+		wake = next
+	}
+	return max(time.Until(wake), 0)
+}
+
+// syncMonitors reloads the given monitors, dispatching those that are due and
+// queueing the others at their next run.
+func (w *Worker) syncMonitors(ctx context.Context, monitorIDs []int) {
+	if len(monitorIDs) == 0 {
+		return
+	}
+
+	config, err := w.ensureSystemConfig(ctx)
+	if err != nil {
+		w.logger.Error("failed ensuring system config", "error", err)
+		return
+	}
+	if config.Paused {
+		return
+	}
+	schedule := scheduleConfigFromSystem(config)
+	w.applyTickSettings(schedule)
+
+	monitors, err := w.db.Monitor.Query().
+		Where(monitor.IDIn(monitorIDs...), monitor.ArchivedAtIsNil()).
+		WithRuntime().
+		WithHeaderProfile().
+		All(ctx)
+	if err != nil {
+		w.logger.Error("failed loading monitors", "error", err)
+		return
+	}
+
+	now := time.Now().UTC()
+	for _, row := range monitors {
+		if !w.scheduleMonitor(ctx, row, now, schedule, nil) {
+			return
+		}
+	}
+}
+
+// applyTickSettings adopts the tick interval and lookahead of the latest
+// runtime settings. Only the scheduler goroutine reads them.
+func (w *Worker) applyTickSettings(schedule scheduleConfig) {
+	w.tickInterval = schedule.tickInterval
+	w.lookahead = schedule.lookahead
+	w.liveness.RecordTickInterval(schedule.tickInterval)
+}
+
+// markCompleted records that a dispatched check finished, so the scheduler
+// queues the monitor's next run. It never blocks.
+func (w *Worker) markCompleted(monitorID int) {
+	w.completedMu.Lock()
+	w.completed[monitorID] = struct{}{}
+	w.completedMu.Unlock()
+
+	select {
+	case w.completions <- struct{}{}:
+	default:
+	}
+}
+
+func (w *Worker) takeCompleted() []int {
+	w.completedMu.Lock()
+	defer w.completedMu.Unlock()
+
+	monitorIDs := make([]int, 0, len(w.completed))
+	for monitorID := range w.completed {
+		monitorIDs = append(monitorIDs, monitorID)
+	}
+	clear(w.completed)
+	return monitorIDs
+}
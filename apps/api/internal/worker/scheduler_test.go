@@ -0,0 +1,227 @@
+package worker
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"goanna/apps/api/ent/enttest"
+	"goanna/apps/api/ent/monitor"
+	"goanna/apps/api/ent/monitorruntime"
+
+	_ "github.com/mattn/go-sqlite3"
+)
+
+func TestRunQueueOrdersAndReschedules(t *testing.T) {
+	base := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	queue := newRunQueue()
+	queue.schedule(1, base.Add(3*time.Minute))
+	queue.schedule(2, base.Add(time.Minute))
+	queue.schedule(3, base.Add(2*time.Minute))
+	queue.schedule(1, base)
+	queue.remove(3)
+
+	if next, ok := queue.next(); !ok || !next.Equal(base) {
+		t.Fatalf("expected rescheduled run first, got %s (%t)", next, ok)
+	}
+
+	due := queue.popDue(base.Add(time.Minute))
+	if len(due) != 2 || due[0] != 1 || due[1] != 2 {
+		t.Fatalf("expected monitors 1 and 2 to be due, got %v", due)
+	}
+	if _, ok := queue.next(); ok {
+		t.Fatal("expected removed monitor not to stay queued")
+	}
+}
+
+func TestScheduleChangesCoalescePublishes(t *testing.T) {
+	var unset *ScheduleChanges
+	unset.Publish()
+
+	changes := NewScheduleChanges()
+	first := changes.subscribe()
+	second := changes.subscribe()
+	changes.Publish()
+	changes.Publish()
+
+	for _, subscriber := range []<-chan struct{}{first, second} {
+		select {
+		case <-subscriber:
+		default:
+			t.Fatal("expected subscriber to be woken")
+		}
+		select {
+		case <-subscriber:
+			t.Fatal("expected publishes to coalesce")
+		default:
+		}
+	}
+}
+
+func TestTickQueuesNextRuns(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:worker-queue?mode=memory&cache=shared&_fk=1")
+	defer client.Close()
+
+	row, err := client.Monitor.Create().
+		SetURL("https://example.com/health").
+		SetCron("*/5 * * * *").
+		Save(t.Context())
+	if err != nil {
+		t.Fatalf("expected monitor to save: %v", err)
+	}
+	nextRun := time.Now().UTC().Add(time.Hour).Truncate(time.Second)
+	if _, err := client.MonitorRuntime.Create().
+		SetMonitor(row).
+		SetStatus(monitorruntime.StatusOk).
+		SetNextRunAt(nextRun).
+		Save(t.Context()); err != nil {
+		t.Fatalf("expected runtime to save: %v", err)
+	}
+
+	w := New(client)
+	w.tick(t.Context(), nil)
+
+	if next, ok := w.queue.next(); !ok || !next.Equal(nextRun) {
+		t.Fatalf("expected queued run at %s, got %s (%t)", nextRun, next, ok)
+	}
+}
+
+func TestStartRunsMonitorAfterPublishedChange(t *testing.T) {
+	target := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(`{"status":"ok"}`))
+	}))
+	defer target.Close()
+
+	client := enttest.Open(t, "sqlite3", "file:worker-start?mode=memory&cache=shared&_fk=1")
+	defer client.Close()
+
+	row, err := client.Monitor.Create().
+		SetURL(target.URL).
+		SetCron("0 0 1 1 *").
+		Save(t.Context())
+	if err != nil {
+		t.Fatalf("expected monitor to save: %v", err)
+	}
+	runtime, err := client.MonitorRuntime.Create().
+		SetMonitor(row).
+		SetStatus(monitorruntime.StatusOk).
+		SetNextRunAt(time.Now().UTC().Add(24 * time.Hour)).
+		Save(t.Context())
+	if err != nil {
+		t.Fatalf("expected runtime to save: %v", err)
+	}
+
+	changes := NewScheduleChanges()
+	ctx, cancel := context.WithCancel(t.Context())
+	stopped := make(chan struct{})
+	go func() {
+		NewWithConfig(client, Config{Changes: changes}).Start(ctx)
+		close(stopped)
+	}()
+	defer func() {
+		cancel()
+		<-stopped
+	}()
+
+	waitFor := func(condition func() bool) bool {
+		deadline := time.Now().Add(2 * time.Second)
+		for time.Now().Before(deadline) {
+			if condition() {
+				return true
+			}
+			time.Sleep(10 * time.Millisecond)
+		}
+		return false
+	}
+
+	// Let the startup pass finish before the schedule changes under it.
+	subscribed := func() bool {
+		changes.mu.Lock()
+		defer changes.mu.Unlock()
+		return len(changes.subscribers) == 1
+	}
+	if !waitFor(subscribed) {
+		t.Fatal("expected scheduler to subscribe to changes")
+	}
+	time.Sleep(50 * time.Millisecond)
+
+	if _, err := client.MonitorRuntime.UpdateOneID(runtime.ID).
+		SetNextRunAt(time.Now().UTC().Add(-time.Second)).
+		Save(t.Context()); err != nil {
+		t.Fatalf("expected runtime to update: %v", err)
+	}
+	changes.Publish()
+
+	ran := waitFor(func() bool {
+		count, err := client.CheckResult.Query().Count(t.Context())
+		return err == nil && count == 1
+	})
+	if !ran {
+		t.Fatal("expected published change to run the now-due monitor")
+	}
+}
+
+func TestTickAppliesLookaheadAndTickInterval(t *testing.T) {
+	target := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(`{"status":"ok"}`))
+	}))
+	defer target.Close()
+
+	client := enttest.Open(t, "sqlite3", "file:worker-lookahead?mode=memory&cache=shared&_fk=1")
+	defer client.Close()
+
+	if _, err := client.SystemConfig.Create().
+		SetKey(globalConfigKey).
+		SetChecksHistoryLimit(defaultChecksHistoryLimit).
+		SetTickIntervalSeconds(120).
+		SetScheduleLookaheadSeconds(30).
+		Save(t.Context()); err != nil {
+		t.Fatalf("expected system config to save: %v", err)
+	}
+	soon, err := client.Monitor.Create().
+		SetURL(target.URL).
+		SetCron("0 0 1 1 *").
+		Save(t.Context())
+	if err != nil {
+		t.Fatalf("expected monitor to save: %v", err)
+	}
+	later, err := client.Monitor.Create().
+		SetURL(target.URL).
+		SetCron("0 0 1 1 *").
+		Save(t.Context())
+	if err != nil {
+		t.Fatalf("expected monitor to save: %v", err)
+	}
+	soonRun := time.Now().UTC().Add(20 * time.Second).Truncate(time.Second)
+	laterRun := time.Now().UTC().Add(time.Hour).Truncate(time.Second)
+	for monitorID, nextRun := range map[int]time.Time{soon.ID: soonRun, later.ID: laterRun} {
+		if _, err := client.MonitorRuntime.Create().
+			SetMonitorID(monitorID).
+			SetStatus(monitorruntime.StatusOk).
+			SetNextRunAt(nextRun).
+			Save(t.Context()); err != nil {
+			t.Fatalf("expected runtime to save: %v", err)
+		}
+	}
+
+	liveness := NewLiveness()
+	w := NewWithConfig(client, Config{Liveness: liveness})
+	w.tick(t.Context(), nil)
+	w.checks.Wait()
+
+	if w.tickInterval != 2*time.Minute || w.lookahead != 30*time.Second || liveness.StaleAfter() != 6*time.Minute {
+		t.Fatalf("expected tick settings from the runtime settings, got %s/%s/%s", w.tickInterval, w.lookahead, liveness.StaleAfter())
+	}
+	if next, ok := w.queue.next(); !ok || !next.Equal(laterRun) {
+		t.Fatalf("expected only the later run to stay queued, got %s (%t)", next, ok)
+	}
+	runtime, err := client.MonitorRuntime.Query().Where(monitorruntime.HasMonitorWith(monitor.IDEQ(soon.ID))).Only(t.Context())
+	if err != nil {
+		t.Fatalf("expected runtime: %v", err)
+	}
+	if runtime.LastCheckAt == nil || runtime.NextRunAt == nil || !runtime.NextRunAt.After(soonRun) {
+		t.Fatalf("expected the run within the lookahead to start early and move on, got %+v", runtime)
+	}
+}
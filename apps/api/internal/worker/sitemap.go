@@ -0,0 +1,298 @@
+package worker
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	"goanna/apps/api/ent"
+	"goanna/apps/api/ent/monitor"
+	selectorutil "goanna/apps/api/internal/selector"
+)
+
+const (
+	maxSitemapChildren           = 50
+	maxSitemapURLs               = 50000
+	maxSitemapDiffSampleURLs     = 50
+	maxSitemapNotificationSample = 10
+)
+
+type sitemapDocument struct {
+	XMLName  xml.Name
+	URLs     []sitemapLocation `xml:"url"`
+	Sitemaps []sitemapLocation `xml:"sitemap"`
+}
+
+type sitemapLocation struct {
+	Loc     string `xml:"loc"`
+	LastMod string `xml:"lastmod"`
+}
+
+func isSitemapMonitor(row *ent.Monitor) bool {
+	return row != nil && row.ExpectedType == monitor.ExpectedTypeSitemap
+}
+
+// parseSitemap returns the URL entries of a urlset, keyed by loc with their
+// lastmod, and the child sitemap locations of a sitemap index.
+func parseSitemap(payload []byte) (map[string]string, []string, error) {
+	payload, err := gunzipSitemap(payload)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var document sitemapDocument
+	if err := xml.Unmarshal(payload, &document); err != nil {
+		return nil, nil, fmt.Errorf("response is not valid XML: %v", err)
+	}
+
+	switch document.XMLName.Local {
+	case "urlset":
+		entries := make(map[string]string, len(document.URLs))
+		for _, location := range document.URLs {
+			loc := strings.TrimSpace(location.Loc)
+			if loc == "" {
+				continue
+			}
+			entries[loc] = strings.TrimSpace(location.LastMod)
+		}
+		return entries, nil, nil
+	case "sitemapindex":
+		children := make([]string, 0, len(document.Sitemaps))
+		for _, location := range document.Sitemaps {
+			if loc := strings.TrimSpace(location.Loc); loc != "" {
+				children = append(children, loc)
+			}
+		}
+		return nil, children, nil
+	default:
+		return nil, nil, fmt.Errorf("unsupported sitemap root <%s>", document.XMLName.Local)
+	}
+}
+
+func gunzipSitemap(payload []byte) ([]byte, error) {
+	if len(payload) < 2 || payload[0] != 0x1f || payload[1] != 0x8b {
+		return payload, nil
+	}
+
+	reader, err := gzip.NewReader(bytes.NewReader(payload))
+	if err != nil {
+		return nil, err
+	}
+	defer reader.Close()
+
+	return io.ReadAll(io.LimitReader(reader, DefaultMaxResponseBodyBytes+1))
+}
+
+// evaluateSitemap collects the URL set of a sitemap, following one level of
+// sitemap index, into a JSON object selection of loc to lastmod.
+func (w *Worker) evaluateSitemap(ctx context.Context, row *ent.Monitor, payload []byte, defaults CheckDefaults) (bool, string, *selectorutil.Selection) {
+	entries, children, err := parseSitemap(payload)
+	if err != nil {
+		return false, fmt.Sprintf("invalid sitemap: %v", err), nil
+	}
+
+	if children != nil {
+		if len(children) > maxSitemapChildren {
+			return false, fmt.Sprintf("sitemap index lists %d sitemaps (limit %d)", len(children), maxSitemapChildren), nil
+		}
+
+		entries = map[string]string{}
+		for _, child := range children {
+			childEntries, err := w.fetchChildSitemap(ctx, row, child, defaults)
+			if err != nil {
+				return false, fmt.Sprintf("sitemap %s: %v", child, err), nil
+			}
+			for loc, lastMod := range childEntries {
+				entries[loc] = lastMod
+			}
+			if len(entries) > maxSitemapURLs {
+				break
+			}
+		}
+	}
+
+	if len(entries) > maxSitemapURLs {
+		return false, fmt.Sprintf("sitemap exceeds %d URLs", maxSitemapURLs), nil
+	}
+
+	encoded, err := json.Marshal(entries)
+	if err != nil {
+		return false, fmt.Sprintf("invalid sitemap: %v", err), nil
+	}
+
+	return true, "", &selectorutil.Selection{
+		Exists: true,
+		Type:   "json",
+		Raw:    string(encoded),
+		Value:  string(encoded),
+	}
+}
+
+func (w *Worker) fetchChildSitemap(ctx context.Context, row *ent.Monitor, url string, defaults CheckDefaults) (map[string]string, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	for key, value := range monitorRequestHeaders(row, defaults) {
+		req.Header.Set(key, ExpandEnvReferences(ExpandTemplate(value, now)))
+	}
+	applyAuth(req, ExpandAuthEnvReferences(row.Auth))
+
+	client, err := w.clientForMonitor(row, defaults)
+	if err != nil {
+		return nil, err
+	}
+	response, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode < 200 || response.StatusCode >= 300 {
+		return nil, fmt.Errorf("unexpected status code: %d", response.StatusCode)
+	}
+
+	responseBody, err := DecodeContentEncoding(response.Header.Get("Content-Encoding"), response.Body)
+	if err != nil {
+		return nil, err
+	}
+	bodyLimit := w.responseBodyLimit(row, defaults)
+	payload, err := io.ReadAll(io.LimitReader(responseBody, int64(bodyLimit+1)))
+	if err != nil {
+		return nil, err
+	}
+	if len(payload) > bodyLimit {
+		return nil, fmt.Errorf("response body exceeds %d bytes limit", bodyLimit)
+	}
+
+	entries, children, err := parseSitemap(payload)
+	if err != nil {
+		return nil, err
+	}
+	if children != nil {
+		return nil, fmt.Errorf("nested sitemap indexes are not supported")
+	}
+	return entries, nil
+}
+
+func decodeSitemapEntries(snapshot *selectionSnapshot) (map[string]string, bool) {
+	if snapshot == nil || !snapshot.Exists {
+		return nil, false
+	}
+
+	var entries map[string]string
+	if err := json.Unmarshal([]byte(snapshot.Value), &entries); err != nil {
+		return nil, false
+	}
+	return entries, true
+}
+
+// buildSitemapDiff reports URLs added to or removed from a sitemap and URLs
+// whose lastmod changed. Detail lists are sampled; the counts are exact.
+func buildSitemapDiff(previous *selectionSnapshot, current *selectionSnapshot) *selectionDiff {
+	currentEntries, ok := decodeSitemapEntries(current)
+	if !ok {
+		return nil
+	}
+
+	previousEntries, ok := decodeSitemapEntries(previous)
+	if !ok {
+		return &selectionDiff{
+			Kind:    "initial",
+			Changed: false,
+			Summary: fmt.Sprintf("initial sitemap captured (%d URLs)", len(currentEntries)),
+			Details: map[string]any{
+				"type":     "sitemap",
+				"newCount": len(currentEntries),
+			},
+		}
+	}
+
+	added := make([]string, 0)
+	modified := make([]string, 0)
+	for loc, lastMod := range currentEntries {
+		previousLastMod, ok := previousEntries[loc]
+		if !ok {
+			added = append(added, loc)
+			continue
+		}
+		if previousLastMod != lastMod {
+			modified = append(modified, loc)
+		}
+	}
+	removed := make([]string, 0)
+	for loc := range previousEntries {
+		if _, ok := currentEntries[loc]; !ok {
+			removed = append(removed, loc)
+		}
+	}
+
+	changed := len(added) > 0 || len(removed) > 0 || len(modified) > 0
+	summary := "sitemap unchanged"
+	if changed {
+		summary = fmt.Sprintf("sitemap changed (+%d -%d ~%d URLs)", len(added), len(removed), len(modified))
+	}
+
+	return &selectionDiff{
+		Kind:    "sitemap",
+		Changed: changed,
+		Summary: summary,
+		Details: map[string]any{
+			"oldCount":      len(previousEntries),
+			"newCount":      len(currentEntries),
+			"addedCount":    len(added),
+			"removedCount":  len(removed),
+			"modifiedCount": len(modified),
+			"added":         sampleSitemapURLs(added),
+			"removed":       sampleSitemapURLs(removed),
+			"modified":      sampleSitemapURLs(modified),
+		},
+	}
+}
+
+func sampleSitemapURLs(urls []string) []string {
+	sort.Strings(urls)
+	if len(urls) > maxSitemapDiffSampleURLs {
+		return urls[:maxSitemapDiffSampleURLs]
+	}
+	return urls
+}
+
+func formatSitemapNotificationDetail(details map[string]any) string {
+	lines := make([]string, 0, 3)
+	for _, field := range []struct {
+		label string
+		key   string
+	}{
+		{label: "Added", key: "added"},
+		{label: "Removed", key: "removed"},
+		{label: "Modified", key: "modified"},
+	} {
+		urls, _ := details[field.key].([]string)
+		count, _ := details[field.key+"Count"].(int)
+		if count == 0 || len(urls) == 0 {
+			continue
+		}
+
+		shown := urls
+		if len(shown) > maxSitemapNotificationSample {
+			shown = shown[:maxSitemapNotificationSample]
+		}
+		line := fmt.Sprintf("%s (%d): %s", field.label, count, strings.Join(shown, ", "))
+		if count > len(shown) {
+			line = fmt.Sprintf("%s (+%d more)", line, count-len(shown))
+		}
+		lines = append(lines, line)
+	}
+
+	return strings.Join(lines, "\n")
+}
@@ -0,0 +1,74 @@
+package worker
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"goanna/apps/api/ent"
+	"goanna/apps/api/ent/monitor"
+)
+
+func TestEvaluateSitemapFollowsIndex(t *testing.T) {
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	mux.HandleFunc("/sitemap.xml", func(w http.ResponseWriter, _ *http.Request) {
+		fmt.Fprintf(w, `<sitemapindex xmlns="http://www.sitemaps.org/schemas/sitemap/0.9">
+<sitemap><loc>%[1]s/pages.xml</loc></sitemap><sitemap><loc>%[1]s/posts.xml</loc></sitemap>
+</sitemapindex>`, server.URL)
+	})
+	mux.HandleFunc("/pages.xml", func(w http.ResponseWriter, _ *http.Request) {
+		fmt.Fprint(w, `<urlset xmlns="http://www.sitemaps.org/schemas/sitemap/0.9">
+<url><loc>https://example.com/</loc><lastmod>2026-10-01</lastmod></url></urlset>`)
+	})
+	mux.HandleFunc("/posts.xml", func(w http.ResponseWriter, _ *http.Request) {
+		fmt.Fprint(w, `<urlset xmlns="http://www.sitemaps.org/schemas/sitemap/0.9">
+<url><loc>https://example.com/post</loc></url></urlset>`)
+	})
+
+	row := &ent.Monitor{Method: http.MethodGet, URL: server.URL + "/sitemap.xml", ExpectedType: monitor.ExpectedTypeSitemap}
+	w := &Worker{client: server.Client(), maxResponseBodyBytes: DefaultMaxResponseBodyBytes}
+
+	result := w.executeOnce(t.Context(), row, CheckDefaults{})
+	if !result.success {
+		t.Fatalf("expected sitemap check to succeed, got error=%v", result.errorMessage)
+	}
+	entries, ok := decodeSitemapEntries(result.selection)
+	if !ok {
+		t.Fatalf("expected sitemap selection, got %#v", result.selection)
+	}
+	expected := map[string]string{"https://example.com/": "2026-10-01", "https://example.com/post": ""}
+	if !reflect.DeepEqual(entries, expected) {
+		t.Fatalf("expected entries %#v, got %#v", expected, entries)
+	}
+}
+
+func TestBuildSitemapDiff(t *testing.T) {
+	previous := &selectionSnapshot{Exists: true, Type: "json", Value: `{"https://example.com/a":"2026-10-01","https://example.com/b":""}`}
+	current := &selectionSnapshot{Exists: true, Type: "json", Value: `{"https://example.com/a":"2026-10-02","https://example.com/c":""}`}
+
+	diff := buildSitemapDiff(previous, current)
+	if diff == nil || diff.Kind != "sitemap" || !diff.Changed {
+		t.Fatalf("expected changed sitemap diff, got %#v", diff)
+	}
+	if diff.Summary != "sitemap changed (+1 -1 ~1 URLs)" {
+		t.Fatalf("unexpected summary %q", diff.Summary)
+	}
+
+	detail := formatNotificationDetail(diff)
+	expectedDetail := "Added (1): https://example.com/c\nRemoved (1): https://example.com/b\nModified (1): https://example.com/a"
+	if detail != expectedDetail {
+		t.Fatalf("unexpected notification detail %q", detail)
+	}
+
+	if unchanged := buildSitemapDiff(current, current); unchanged == nil || unchanged.Changed {
+		t.Fatalf("expected unchanged sitemap diff, got %#v", unchanged)
+	}
+	if initial := buildSitemapDiff(nil, current); initial == nil || initial.Kind != "initial" {
+		t.Fatalf("expected initial sitemap diff, got %#v", initial)
+	}
+}
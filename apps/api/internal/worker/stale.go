@@ -0,0 +1,171 @@
+package worker
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"goanna/apps/api/ent"
+	"goanna/apps/api/ent/monitorruntime"
+	"goanna/apps/api/ent/notificationchannel"
+	"goanna/apps/api/ent/notificationevent"
+)
+
+const (
+	StaleReasonUnchanged     = "unchanged"
+	StaleReasonRepeatedError = "repeated_error"
+
+	staleHousekeepingInterval = 24 * time.Hour
+	maxStaleMessageMonitors   = 20
+)
+
+// StaleReason reports why an enabled monitor looks stale: its selection has
+// not changed for staleAfter (possible dead feed), or it has returned the same
+// error for staleAfter. It returns "" for monitors that are not stale.
+func StaleReason(row *ent.Monitor, runtime *ent.MonitorRuntime, staleAfter time.Duration, now time.Time) string {
+	if row == nil || !row.Enabled || runtime == nil || staleAfter <= 0 {
+		return ""
+	}
+
+	switch runtime.Status {
+	case monitorruntime.StatusOk:
+		if runtime.LastSuccessAt == nil {
+			return ""
+		}
+		unchangedSince := row.CreatedAt
+		if runtime.LastChangeAt != nil {
+			unchangedSince = *runtime.LastChangeAt
+		}
+		if !now.Before(unchangedSince.Add(staleAfter)) {
+			return StaleReasonUnchanged
+		}
+	case monitorruntime.StatusError:
+		if runtime.ErrorRepeatingSince != nil && !now.Before(runtime.ErrorRepeatingSince.Add(staleAfter)) {
+			return StaleReasonRepeatedError
+		}
+	}
+
+	return ""
+}
+
+// StaleAfter returns the configured stale period.
+func StaleAfter(config *ent.SystemConfig) time.Duration {
+	days := defaultStaleAfterDays
+	if config != nil && config.StaleAfterDays > 0 {
+		days = config.StaleAfterDays
+	}
+	return time.Duration(days) * 24 * time.Hour
+}
+
+type staleMonitor struct {
+	monitor *ent.Monitor
+	reason  string
+}
+
+func (w *Worker) runStaleHousekeeping(ctx context.Context, config *ent.SystemConfig, monitors []*ent.Monitor, now time.Time) error {
+	if !config.StaleNotifications || config.NotificationsPaused {
+		return nil
+	}
+	if config.StaleNotifiedAt != nil && now.Before(config.StaleNotifiedAt.Add(staleHousekeepingInterval)) {
+		return nil
+	}
+
+	staleAfter := StaleAfter(config)
+	stale := make([]staleMonitor, 0)
+	for _, row := range monitors {
+		if reason := StaleReason(row, row.Edges.Runtime, staleAfter, now); reason != "" {
+			stale = append(stale, staleMonitor{monitor: row, reason: reason})
+		}
+	}
+
+	var notifyErr error
+	if len(stale) > 0 {
+		notifyErr = w.notifyStaleMonitors(ctx, stale, config.StaleAfterDays, now)
+	}
+
+	if _, err := w.db.SystemConfig.UpdateOneID(config.ID).
+		SetStaleNotifiedAt(now).
+		Save(ctx); err != nil {
+		return err
+	}
+
+	return notifyErr
+}
+
+func (w *Worker) notifyStaleMonitors(ctx context.Context, stale []staleMonitor, staleAfterDays int, now time.Time) error {
+	channels, err := w.db.NotificationChannel.Query().
+		Where(notificationchannel.EnabledEQ(true)).
+		All(ctx)
+	if err != nil {
+		return err
+	}
+
+	message := formatStaleMonitorsMessage(stale, staleAfterDays)
+	var notifyErr error
+	for _, channel := range channels {
+		status := "sent"
+		var sendErr error
+		if err := w.sendMonitorDiffToChannel(ctx, channel, message); err != nil {
+			status = "error"
+			sendErr = err
+			notifyErr = err
+		}
+
+		for _, item := range stale {
+			eventMessage := staleReasonSummary(item.reason, staleAfterDays)
+			if sendErr != nil {
+				eventMessage = sendErr.Error()
+			}
+			if err := w.saveNotificationEvent(ctx, w.db.NotificationEvent.Create().
+				SetMonitorID(item.monitor.ID).
+				SetChannelID(channel.ID).
+				SetKind(notificationevent.KindStale).
+				SetStatus(status).
+				SetMessage(eventMessage).
+				SetSentAt(now)); err != nil {
+				w.logger.Error("failed recording stale notification", "monitor_id", item.monitor.ID, "error", err)
+			}
+		}
+	}
+
+	return notifyErr
+}
+
+func staleReasonSummary(reason string, staleAfterDays int) string {
+	switch reason {
+	case StaleReasonRepeatedError:
+		return fmt.Sprintf("same error for %d+ days", staleAfterDays)
+	default:
+		return fmt.Sprintf("no changes for %d+ days", staleAfterDays)
+	}
+}
+
+func formatStaleMonitorsMessage(stale []staleMonitor, staleAfterDays int) string {
+	lines := []string{
+		"Goanna stale monitors",
+		fmt.Sprintf("%d monitor(s) may need attention:", len(stale)),
+	}
+
+	for index, item := range stale {
+		if index == maxStaleMessageMonitors {
+			lines = append(lines, fmt.Sprintf("...and %d more", len(stale)-maxStaleMessageMonitors))
+			break
+		}
+
+		name := fmt.Sprintf("#%d", item.monitor.ID)
+		if label := monitorNotificationLabel(item.monitor); label != "" {
+			name = fmt.Sprintf("%s (#%d)", label, item.monitor.ID)
+		}
+		lines = append(lines, fmt.Sprintf("- %s: %s - %s", name, staleReasonSummary(item.reason, staleAfterDays), item.monitor.URL))
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+func sameErrorMessage(previous *string, current *string) bool {
+	if previous == nil || current == nil {
+		return previous == nil && current == nil
+	}
+	return *previous == *current
+}
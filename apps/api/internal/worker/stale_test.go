@@ -0,0 +1,67 @@
+package worker
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"goanna/apps/api/ent"
+	"goanna/apps/api/ent/monitorruntime"
+)
+
+func TestStaleReason(t *testing.T) {
+	now := time.Date(2026, time.March, 31, 12, 0, 0, 0, time.UTC)
+	staleAfter := 14 * 24 * time.Hour
+	longAgo := now.Add(-20 * 24 * time.Hour)
+	recently := now.Add(-time.Hour)
+	row := &ent.Monitor{Enabled: true, CreatedAt: longAgo}
+
+	unchanged := &ent.MonitorRuntime{Status: monitorruntime.StatusOk, LastSuccessAt: &recently, LastChangeAt: &longAgo}
+	if reason := StaleReason(row, unchanged, staleAfter, now); reason != StaleReasonUnchanged {
+		t.Fatalf("expected unchanged stale reason, got %q", reason)
+	}
+
+	changed := &ent.MonitorRuntime{Status: monitorruntime.StatusOk, LastSuccessAt: &recently, LastChangeAt: &recently}
+	if reason := StaleReason(row, changed, staleAfter, now); reason != "" {
+		t.Fatalf("expected recently changed monitor not to be stale, got %q", reason)
+	}
+
+	repeatedError := &ent.MonitorRuntime{Status: monitorruntime.StatusError, ErrorRepeatingSince: &longAgo}
+	if reason := StaleReason(row, repeatedError, staleAfter, now); reason != StaleReasonRepeatedError {
+		t.Fatalf("expected repeated error stale reason, got %q", reason)
+	}
+
+	disabled := &ent.Monitor{Enabled: false, CreatedAt: longAgo}
+	if reason := StaleReason(disabled, unchanged, staleAfter, now); reason != "" {
+		t.Fatalf("expected disabled monitor not to be stale, got %q", reason)
+	}
+}
+
+func TestSameErrorMessage(t *testing.T) {
+	timeout := "timeout"
+	refused := "connection refused"
+	if !sameErrorMessage(&timeout, &timeout) || !sameErrorMessage(nil, nil) {
+		t.Fatal("expected identical messages to match")
+	}
+	if sameErrorMessage(&timeout, &refused) || sameErrorMessage(nil, &timeout) {
+		t.Fatal("expected different messages not to match")
+	}
+}
+
+func TestFormatStaleMonitorsMessage(t *testing.T) {
+	label := "Feed"
+	message := formatStaleMonitorsMessage([]staleMonitor{
+		{monitor: &ent.Monitor{ID: 3, Label: &label, URL: "https://example.com/feed"}, reason: StaleReasonUnchanged},
+		{monitor: &ent.Monitor{ID: 4, URL: "https://example.com/api"}, reason: StaleReasonRepeatedError},
+	}, 14)
+
+	for _, expected := range []string{
+		"2 monitor(s) may need attention:",
+		"- Feed (#3): no changes for 14+ days - https://example.com/feed",
+		"- #4: same error for 14+ days - https://example.com/api",
+	} {
+		if !strings.Contains(message, expected) {
+			t.Fatalf("expected message to contain %q, got %q", expected, message)
+		}
+	}
+}
@@ -0,0 +1,113 @@
+package worker
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"goanna/apps/api/ent"
+)
+
+type statusRange struct {
+	min int
+	max int
+}
+
+var defaultExpectedStatus = []statusRange{{min: 200, max: 299}}
+
+// ValidateExpectedStatus reports whether raw is a usable expectedStatus
+// setting: comma-separated codes ("204"), classes ("3xx") or ranges
+// ("200-299").
+func ValidateExpectedStatus(raw string) error {
+	_, err := parseExpectedStatus(raw)
+	return err
+}
+
+func parseExpectedStatus(raw string) ([]statusRange, error) {
+	ranges := make([]statusRange, 0)
+	for _, part := range strings.Split(raw, ",") {
+		token := strings.ToLower(strings.TrimSpace(part))
+		if token == "" {
+			continue
+		}
+
+		parsed, err := parseStatusToken(token)
+		if err != nil {
+			return nil, err
+		}
+		ranges = append(ranges, parsed)
+	}
+
+	if len(ranges) == 0 {
+		return nil, fmt.Errorf("expectedStatus %q has no status codes", raw)
+	}
+	return ranges, nil
+}
+
+func parseStatusToken(token string) (statusRange, error) {
+	if len(token) == 3 && strings.HasSuffix(token, "xx") {
+		class, err := strconv.Atoi(token[:1])
+		if err != nil || class < 1 || class > 5 {
+			return statusRange{}, fmt.Errorf("invalid status class %q", token)
+		}
+		return statusRange{min: class * 100, max: class*100 + 99}, nil
+	}
+
+	if low, high, ok := strings.Cut(token, "-"); ok {
+		minCode, minErr := parseStatusCode(strings.TrimSpace(low))
+		maxCode, maxErr := parseStatusCode(strings.TrimSpace(high))
+		if minErr != nil || maxErr != nil || minCode > maxCode {
+			return statusRange{}, fmt.Errorf("invalid status range %q", token)
+		}
+		return statusRange{min: minCode, max: maxCode}, nil
+	}
+
+	code, err := parseStatusCode(token)
+	if err != nil {
+		return statusRange{}, err
+	}
+	return statusRange{min: code, max: code}, nil
+}
+
+func parseStatusCode(token string) (int, error) {
+	code, err := strconv.Atoi(token)
+	if err != nil || code < 100 || code > 599 {
+		return 0, fmt.Errorf("invalid status code %q", token)
+	}
+	return code, nil
+}
+
+// expectedStatusRanges returns the monitor's accepted status codes, defaulting
+// to 2xx. Invalid stored settings also fall back to 2xx.
+func expectedStatusRanges(row *ent.Monitor) []statusRange {
+	if row == nil || row.ExpectedStatus == nil || strings.TrimSpace(*row.ExpectedStatus) == "" {
+		return defaultExpectedStatus
+	}
+
+	ranges, err := parseExpectedStatus(*row.ExpectedStatus)
+	if err != nil {
+		return defaultExpectedStatus
+	}
+	return ranges
+}
+
+func acceptsRedirect(ranges []statusRange) bool {
+	for _, allowed := range ranges {
+		if allowed.min <= 399 && allowed.max >= 300 {
+			return true
+		}
+	}
+	return false
+}
+
+func statusAllowed(ranges []statusRange, statusCode int) bool {
+	if len(ranges) == 0 {
+		ranges = defaultExpectedStatus
+	}
+	for _, allowed := range ranges {
+		if statusCode >= allowed.min && statusCode <= allowed.max {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,78 @@
+package worker
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"goanna/apps/api/ent"
+	"goanna/apps/api/ent/monitor"
+)
+
+func TestParseExpectedStatus(t *testing.T) {
+	ranges, err := parseExpectedStatus(" 204, 3XX ,400-404")
+	if err != nil {
+		t.Fatalf("expected status list to parse: %v", err)
+	}
+
+	for _, code := range []int{204, 301, 399, 400, 404} {
+		if !statusAllowed(ranges, code) {
+			t.Fatalf("expected %d to be allowed", code)
+		}
+	}
+	for _, code := range []int{200, 405, 500} {
+		if statusAllowed(ranges, code) {
+			t.Fatalf("expected %d to be rejected", code)
+		}
+	}
+
+	for _, raw := range []string{"", "ok", "6xx", "99", "404-400", "200,abc"} {
+		if err := ValidateExpectedStatus(raw); err == nil {
+			t.Fatalf("expected %q to be rejected", raw)
+		}
+	}
+}
+
+func TestExpectedStatusRangesDefaultsTo2xx(t *testing.T) {
+	invalid := "nope"
+	for _, row := range []*ent.Monitor{{}, {ExpectedStatus: &invalid}} {
+		ranges := expectedStatusRanges(row)
+		if !statusAllowed(ranges, 200) || statusAllowed(ranges, 301) {
+			t.Fatalf("expected default 2xx ranges, got %#v", ranges)
+		}
+	}
+}
+
+func TestExecuteOnceHonoursExpectedStatus(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/old" {
+			http.Redirect(w, r, "/new", http.StatusMovedPermanently)
+			return
+		}
+		http.NotFound(w, r)
+	}))
+	defer server.Close()
+
+	w := &Worker{client: server.Client(), maxResponseBodyBytes: DefaultMaxResponseBodyBytes}
+
+	expectRedirect := "301"
+	redirect := &ent.Monitor{Method: http.MethodGet, URL: server.URL + "/old", ExpectedType: monitor.ExpectedTypeText, ExpectedStatus: &expectRedirect}
+	result := w.executeOnce(t.Context(), redirect, CheckDefaults{})
+	if !result.success {
+		t.Fatalf("expected redirect to succeed, got status=%q error=%v", result.status, result.errorMessage)
+	}
+	if result.statusCode == nil || *result.statusCode != http.StatusMovedPermanently {
+		t.Fatalf("expected redirect status to be recorded, got %v", result.statusCode)
+	}
+
+	expectNotFound := "404"
+	missing := &ent.Monitor{Method: http.MethodGet, URL: server.URL + "/gone", ExpectedType: monitor.ExpectedTypeText, ExpectedStatus: &expectNotFound}
+	if result := w.executeOnce(t.Context(), missing, CheckDefaults{}); !result.success {
+		t.Fatalf("expected 404 to succeed, got status=%q error=%v", result.status, result.errorMessage)
+	}
+
+	missing.ExpectedStatus = nil
+	if result := w.executeOnce(t.Context(), missing, CheckDefaults{}); result.success {
+		t.Fatal("expected 404 to fail without expectedStatus")
+	}
+}
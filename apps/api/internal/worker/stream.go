@@ -0,0 +1,67 @@
+package worker
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"goanna/apps/api/ent"
+	"goanna/apps/api/ent/monitor"
+	selectorutil "goanna/apps/api/internal/selector"
+)
+
+// canStreamSelection reports whether a monitor's JSON selector can be
+// evaluated while the response is read, so large bodies are never buffered.
+// Anything needing the whole body (snapshots, hashes, empty-body handling)
+// keeps the buffered path.
+func canStreamSelection(row *ent.Monitor) bool {
+	return row.ExpectedType == monitor.ExpectedTypeJSON &&
+		!isRenderedMonitor(row) &&
+		row.Selector != nil &&
+		selectorutil.StreamableJSON(*row.Selector) &&
+		(row.BodySnapshot == "" || row.BodySnapshot == monitor.BodySnapshotOff) &&
+		(row.ContentHash == "" || row.ContentHash == monitor.ContentHashOff) &&
+		!row.AcceptEmptyBody &&
+		!row.TreatNotFoundAsSuccess
+}
+
+func (w *Worker) evaluateStreamedJSON(row *ent.Monitor, response *http.Response, body io.Reader, defaults CheckDefaults) (bool, string, *selectorutil.Selection) {
+	if !statusAllowed(expectedStatusRanges(row), response.StatusCode) {
+		return false, fmt.Sprintf("unexpected status code: %d", response.StatusCode), nil
+	}
+
+	bodyLimit := w.responseBodyLimit(row, defaults)
+	reader := &countingReader{reader: io.LimitReader(body, int64(bodyLimit+1))}
+	selectorPath := strings.TrimSpace(*row.Selector)
+	selection, err := selectorutil.SelectJSONStream(reader, selectorPath)
+	if reader.count > int64(bodyLimit) {
+		return false, responseBodyLimitMessage(row, defaults, bodyLimit), nil
+	}
+	if err != nil {
+		return false, "response is not valid JSON", nil
+	}
+
+	expected := ""
+	if row.ExpectedResponse != nil {
+		expected = strings.TrimSpace(*row.ExpectedResponse)
+	}
+	ok, errMsg, selected := assertJSONSelection(selection, selectorPath, expected)
+	if ok {
+		if headerErr := evaluateHeaderAssertions(response.Header, row.HeaderAssertions); headerErr != "" {
+			return false, headerErr, selected
+		}
+	}
+	return ok, errMsg, selected
+}
+
+type countingReader struct {
+	reader io.Reader
+	count  int64
+}
+
+func (r *countingReader) Read(p []byte) (int, error) {
+	n, err := r.reader.Read(p)
+	r.count += int64(n)
+	return n, err
+}
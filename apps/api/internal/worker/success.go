@@ -0,0 +1,25 @@
+package worker
+
+import (
+	"bytes"
+	"net/http"
+
+	"goanna/apps/api/ent"
+)
+
+// isExpectedEmptyState reports whether a response is the monitor's configured
+// "nothing new" state: a 404 when TreatNotFoundAsSuccess is set, or an empty
+// 2xx body when AcceptEmptyBody is set. Such responses succeed without
+// running selector, expectedResponse or keyword assertions.
+func isExpectedEmptyState(row *ent.Monitor, statusCode int, payload []byte) bool {
+	if row == nil {
+		return false
+	}
+	if row.TreatNotFoundAsSuccess && statusCode == http.StatusNotFound {
+		return true
+	}
+	if row.AcceptEmptyBody && statusCode >= 200 && statusCode < 300 && len(bytes.TrimSpace(payload)) == 0 {
+		return true
+	}
+	return false
+}
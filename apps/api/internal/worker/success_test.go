@@ -0,0 +1,66 @@
+package worker
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"goanna/apps/api/ent"
+	"goanna/apps/api/ent/monitor"
+)
+
+func TestExecuteOnceTreatsNotFoundAsSuccessWhenConfigured(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		http.NotFound(w, nil)
+	}))
+	defer server.Close()
+
+	row := &ent.Monitor{Method: http.MethodGet, URL: server.URL, ExpectedType: monitor.ExpectedTypeJSON}
+	w := &Worker{client: server.Client(), maxResponseBodyBytes: DefaultMaxResponseBodyBytes}
+
+	if result := w.executeOnce(t.Context(), row, CheckDefaults{}); result.success {
+		t.Fatal("expected 404 to fail by default")
+	}
+
+	row.TreatNotFoundAsSuccess = true
+	result := w.executeOnce(t.Context(), row, CheckDefaults{})
+	if !result.success || result.status != "ok" {
+		t.Fatalf("expected 404 to succeed, got status=%q error=%v", result.status, result.errorMessage)
+	}
+	if result.statusCode == nil || *result.statusCode != http.StatusNotFound {
+		t.Fatalf("expected status code 404 to be recorded, got %v", result.statusCode)
+	}
+}
+
+func TestExecuteOnceAcceptsEmptyBodyWhenConfigured(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	row := &ent.Monitor{Method: http.MethodGet, URL: server.URL, ExpectedType: monitor.ExpectedTypeJSON}
+	w := &Worker{client: server.Client(), maxResponseBodyBytes: DefaultMaxResponseBodyBytes}
+
+	if result := w.executeOnce(t.Context(), row, CheckDefaults{}); result.success {
+		t.Fatal("expected empty JSON body to fail by default")
+	}
+
+	row.AcceptEmptyBody = true
+	if result := w.executeOnce(t.Context(), row, CheckDefaults{}); !result.success {
+		t.Fatalf("expected empty body to succeed, got error %v", result.errorMessage)
+	}
+}
+
+func TestIsExpectedEmptyStateIgnoresOtherResponses(t *testing.T) {
+	row := &ent.Monitor{TreatNotFoundAsSuccess: true, AcceptEmptyBody: true}
+
+	if isExpectedEmptyState(row, http.StatusOK, []byte(`{"items":[]}`)) {
+		t.Fatal("expected non-empty body not to match")
+	}
+	if isExpectedEmptyState(row, http.StatusInternalServerError, nil) {
+		t.Fatal("expected empty 500 not to match")
+	}
+	if !isExpectedEmptyState(row, http.StatusNoContent, []byte(" \n")) {
+		t.Fatal("expected whitespace-only 204 to match")
+	}
+}
@@ -0,0 +1,91 @@
+package worker
+
+import (
+	"context"
+
+	"goanna/apps/api/ent"
+	"goanna/apps/api/internal/telemetry"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/metric"
+	semconv "go.opentelemetry.io/otel/semconv/v1.39.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// OpenTelemetry instruments. They report nowhere until telemetry.Setup
+// installs the global providers.
+var (
+	tracer = otel.Tracer(telemetry.ScopeName)
+	meter  = otel.Meter(telemetry.ScopeName)
+
+	checkCounter, _ = meter.Int64Counter("goanna.checks",
+		metric.WithDescription("Checks run, by outcome status"))
+	checkDuration, _ = meter.Float64Histogram("goanna.check.duration",
+		metric.WithDescription("Response time of checks"),
+		metric.WithUnit("ms"))
+	notificationCounter, _ = meter.Int64Counter("goanna.notifications",
+		metric.WithDescription("Notifications sent, by kind and delivery status"))
+)
+
+// Span and metric attributes.
+const (
+	attrMonitorID     = attribute.Key("goanna.monitor.id")
+	attrRunID         = attribute.Key("goanna.run.id")
+	attrCheckStatus   = attribute.Key("goanna.check.status")
+	attrChannelID     = attribute.Key("goanna.channel.id")
+	attrChannelKind   = attribute.Key("goanna.channel.kind")
+	attrNotification  = attribute.Key("goanna.notification.kind")
+	attrDeliveryState = attribute.Key("goanna.notification.status")
+)
+
+// startCheckSpan starts the span of a check run, parent of its fetch
+// attempts, queries and notifications.
+func startCheckSpan(ctx context.Context, monitorID int, runID string) (context.Context, trace.Span) {
+	return tracer.Start(ctx, "monitor.check", trace.WithAttributes(attrMonitorID.Int(monitorID), attrRunID.String(runID)))
+}
+
+// startFetchSpan starts the span of one attempt at fetching a monitor.
+func startFetchSpan(ctx context.Context, monitorID int) (context.Context, trace.Span) {
+	return tracer.Start(ctx, "monitor.fetch", trace.WithAttributes(attrMonitorID.Int(monitorID)))
+}
+
+func endFetchSpan(span trace.Span, result executionResult) {
+	if result.statusCode != nil {
+		span.SetAttributes(semconv.HTTPResponseStatusCode(*result.statusCode))
+	}
+	span.SetAttributes(attrCheckStatus.String(result.status))
+	span.End()
+}
+
+// startNotificationSpan starts the span of sending a notification to channel.
+func startNotificationSpan(ctx context.Context, channel *ent.NotificationChannel) (context.Context, trace.Span) {
+	return tracer.Start(ctx, "notification.send", trace.WithAttributes(
+		attrChannelID.Int(channel.ID),
+		attrChannelKind.String(channel.Kind.String()),
+	))
+}
+
+// recordCheck adds a finished check to the check metrics and its span.
+func recordCheck(ctx context.Context, span trace.Span, result executionResult) {
+	status := attrCheckStatus.String(result.status)
+	span.SetAttributes(status)
+	if !result.success && result.errorMessage != nil {
+		span.SetStatus(codes.Error, *result.errorMessage)
+	}
+
+	checkCounter.Add(ctx, 1, metric.WithAttributes(status))
+	if result.durationMs != nil {
+		checkDuration.Record(ctx, float64(*result.durationMs), metric.WithAttributes(status))
+	}
+}
+
+// endSpan ends span, marking it failed when err is set.
+func endSpan(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
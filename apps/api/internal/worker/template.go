@@ -0,0 +1,70 @@
+package worker
+
+import (
+	"crypto/rand"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var templatePlaceholderPattern = regexp.MustCompile(`\{\{\s*([a-z_]+)\s*(?:([+-])\s*(\d+))?\s*\}\}`)
+
+// ExpandTemplate replaces runtime placeholders in a monitor's URL, header
+// values or body:
+//   - {{now}}: the current time as RFC 3339 in UTC
+//   - {{today}}, {{today+N}}, {{today-N}}: a UTC date (YYYY-MM-DD) N days away
+//   - {{unix_ms}}: Unix time in milliseconds
+//   - {{uuid}}: a random version 4 UUID
+//
+// Unknown placeholders are left untouched so bodies using their own {{...}}
+// syntax keep working.
+func ExpandTemplate(raw string, now time.Time) string {
+	if !strings.Contains(raw, "{{") {
+		return raw
+	}
+
+	now = now.UTC()
+	return templatePlaceholderPattern.ReplaceAllStringFunc(raw, func(placeholder string) string {
+		match := templatePlaceholderPattern.FindStringSubmatch(placeholder)
+		name, sign, rawOffset := match[1], match[2], match[3]
+
+		if name == "today" {
+			days := 0
+			if rawOffset != "" {
+				offset, err := strconv.Atoi(rawOffset)
+				if err != nil {
+					return placeholder
+				}
+				days = offset
+				if sign == "-" {
+					days = -offset
+				}
+			}
+			return now.AddDate(0, 0, days).Format(time.DateOnly)
+		}
+		if sign != "" {
+			return placeholder
+		}
+
+		switch name {
+		case "now":
+			return now.Format(time.RFC3339)
+		case "unix_ms":
+			return strconv.FormatInt(now.UnixMilli(), 10)
+		case "uuid":
+			return newUUID()
+		default:
+			return placeholder
+		}
+	})
+}
+
+func newUUID() string {
+	var b [16]byte
+	_, _ = rand.Read(b[:])
+	b[6] = b[6]&0x0f | 0x40
+	b[8] = b[8]&0x3f | 0x80
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:16])
+}
@@ -0,0 +1,34 @@
+package worker
+
+import (
+	"regexp"
+	"testing"
+	"time"
+)
+
+func TestExpandTemplate(t *testing.T) {
+	now := time.Date(2026, time.March, 1, 8, 30, 0, 0, time.FixedZone("CET", 3600))
+
+	cases := map[string]string{
+		"https://api.example.com/rates?date={{today}}": "https://api.example.com/rates?date=2026-03-01",
+		"from={{ today-1 }}&to={{today+30}}":           "from=2026-02-28&to=2026-03-31",
+		`{"at":"{{now}}","ts":{{unix_ms}}}`:            `{"at":"2026-03-01T07:30:00Z","ts":1772350200000}`,
+		"query { user(id: {{id}}) }":                   "query { user(id: {{id}}) }",
+		"{{now+1}}":                                    "{{now+1}}",
+		"no placeholders":                              "no placeholders",
+	}
+	for raw, expected := range cases {
+		if actual := ExpandTemplate(raw, now); actual != expected {
+			t.Fatalf("expected %q to expand to %q, got %q", raw, expected, actual)
+		}
+	}
+
+	uuidPattern := regexp.MustCompile(`^[0-9a-f]{8}-[0-9a-f]{4}-4[0-9a-f]{3}-[89ab][0-9a-f]{3}-[0-9a-f]{12}$`)
+	first := ExpandTemplate("{{uuid}}", now)
+	if !uuidPattern.MatchString(first) {
+		t.Fatalf("expected a version 4 UUID, got %q", first)
+	}
+	if second := ExpandTemplate("{{uuid}}", now); second == first {
+		t.Fatal("expected a fresh UUID per expansion")
+	}
+}
@@ -0,0 +1,18 @@
+package worker
+
+import (
+	"time"
+)
+
+// checkTimings records how long the CPU-heavy phases of a check took, in
+// milliseconds. Phases that did not run stay nil.
+type checkTimings struct {
+	bodyRead *float64
+	selector *float64
+	diff     *float64
+}
+
+func elapsedMs(started time.Time) *float64 {
+	ms := float64(time.Since(started).Microseconds()) / 1000
+	return &ms
+}
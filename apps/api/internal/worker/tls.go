@@ -0,0 +1,75 @@
+package worker
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"strings"
+
+	"goanna/apps/api/ent"
+)
+
+var tlsMinVersions = map[string]uint16{
+	"1.0": tls.VersionTLS10,
+	"1.1": tls.VersionTLS11,
+	"1.2": tls.VersionTLS12,
+	"1.3": tls.VersionTLS13,
+}
+
+// ValidateTLSMinVersion checks a monitor's minimum TLS version setting.
+func ValidateTLSMinVersion(raw string) error {
+	if _, ok := tlsMinVersions[strings.TrimSpace(raw)]; !ok {
+		return errors.New("must be one of: 1.0, 1.1, 1.2, 1.3")
+	}
+	return nil
+}
+
+// ValidateCAPEM checks that a monitor's CA bundle holds at least one PEM
+// encoded certificate.
+func ValidateCAPEM(raw string) error {
+	if !x509.NewCertPool().AppendCertsFromPEM([]byte(raw)) {
+		return errors.New("no valid PEM certificates found")
+	}
+	return nil
+}
+
+func hasTLSSettings(row *ent.Monitor) bool {
+	return row != nil &&
+		(row.TLSInsecureSkipVerify || row.TLSCaPem != nil || row.TLSMinVersion != nil || row.TLSServerName != nil)
+}
+
+// tlsConfigForMonitor returns the TLS client config for a monitor, or nil when
+// it uses the defaults. A custom CA bundle is added to the system roots so
+// public endpoints keep verifying.
+func tlsConfigForMonitor(row *ent.Monitor) (*tls.Config, error) {
+	if !hasTLSSettings(row) {
+		return nil, nil
+	}
+
+	config := &tls.Config{
+		InsecureSkipVerify: row.TLSInsecureSkipVerify,
+	}
+	if row.TLSCaPem != nil {
+		roots, err := x509.SystemCertPool()
+		if err != nil || roots == nil {
+			roots = x509.NewCertPool()
+		}
+		if !roots.AppendCertsFromPEM([]byte(*row.TLSCaPem)) {
+			return nil, errors.New("tls CA bundle has no valid PEM certificates")
+		}
+		config.RootCAs = roots
+	}
+	if row.TLSMinVersion != nil {
+		version, ok := tlsMinVersions[strings.TrimSpace(*row.TLSMinVersion)]
+		if !ok {
+			return nil, fmt.Errorf("unsupported tls min version %q", *row.TLSMinVersion)
+		}
+		config.MinVersion = version
+	}
+	if row.TLSServerName != nil {
+		config.ServerName = strings.TrimSpace(*row.TLSServerName)
+	}
+
+	return config, nil
+}
@@ -0,0 +1,75 @@
+package worker
+
+import (
+	"crypto/tls"
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"goanna/apps/api/ent"
+	"goanna/apps/api/ent/monitor"
+)
+
+func TestExecuteOnceAppliesMonitorTLSSettings(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte("ok"))
+	}))
+	defer server.Close()
+
+	w := &Worker{client: &http.Client{}, maxResponseBodyBytes: DefaultMaxResponseBodyBytes}
+	row := &ent.Monitor{Method: http.MethodGet, URL: server.URL, ExpectedType: monitor.ExpectedTypeText}
+
+	if result := w.executeOnce(t.Context(), row, CheckDefaults{}); result.success {
+		t.Fatal("expected untrusted certificate to fail verification")
+	}
+
+	caPEM := string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw}))
+	row.TLSCaPem = &caPEM
+	if result := w.executeOnce(t.Context(), row, CheckDefaults{}); !result.success {
+		t.Fatalf("expected custom CA to verify, got error=%v", result.errorMessage)
+	}
+
+	// The httptest certificate is issued for example.com, so an SNI override
+	// is verified against that name instead of the dialled IP.
+	serverName := "example.com"
+	row.TLSServerName = &serverName
+	if result := w.executeOnce(t.Context(), row, CheckDefaults{}); !result.success {
+		t.Fatalf("expected server name override to verify, got error=%v", result.errorMessage)
+	}
+
+	mismatch := "other.test"
+	row.TLSServerName = &mismatch
+	if result := w.executeOnce(t.Context(), row, CheckDefaults{}); result.success {
+		t.Fatal("expected mismatched server name to fail verification")
+	}
+
+	row.TLSCaPem = nil
+	row.TLSServerName = nil
+	row.TLSInsecureSkipVerify = true
+	if result := w.executeOnce(t.Context(), row, CheckDefaults{}); !result.success {
+		t.Fatalf("expected insecureSkipVerify to succeed, got error=%v", result.errorMessage)
+	}
+}
+
+func TestTLSConfigForMonitor(t *testing.T) {
+	if config, err := tlsConfigForMonitor(&ent.Monitor{}); err != nil || config != nil {
+		t.Fatalf("expected no TLS config without settings, got %#v err=%v", config, err)
+	}
+
+	minVersion := "1.3"
+	config, err := tlsConfigForMonitor(&ent.Monitor{TLSMinVersion: &minVersion})
+	if err != nil {
+		t.Fatalf("expected TLS config: %v", err)
+	}
+	if config.MinVersion != tls.VersionTLS13 {
+		t.Fatalf("expected TLS 1.3 minimum, got %#x", config.MinVersion)
+	}
+
+	if err := ValidateTLSMinVersion("1.4"); err == nil {
+		t.Fatal("expected unknown TLS version to fail")
+	}
+	if err := ValidateCAPEM("not a certificate"); err == nil {
+		t.Fatal("expected invalid CA bundle to fail")
+	}
+}
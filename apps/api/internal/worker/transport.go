@@ -0,0 +1,121 @@
+package worker
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"time"
+
+	"goanna/apps/api/ent"
+	"goanna/apps/api/ent/monitor"
+)
+
+const (
+	// DefaultMaxIdleConnsPerHost keeps enough idle connections for the default
+	// number of concurrent checks to reuse them against a single host.
+	DefaultMaxIdleConnsPerHost = DefaultMaxConcurrentChecks
+	DefaultIdleConnTimeout     = 90 * time.Second
+)
+
+// newTransport builds the HTTP transport shared by all checks from the
+// worker's connection reuse settings.
+func newTransport(config Config) *http.Transport {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+
+	transport.MaxIdleConnsPerHost = config.MaxIdleConnsPerHost
+	if transport.MaxIdleConnsPerHost <= 0 {
+		transport.MaxIdleConnsPerHost = DefaultMaxIdleConnsPerHost
+	}
+	transport.IdleConnTimeout = config.IdleConnTimeout
+	if transport.IdleConnTimeout <= 0 {
+		transport.IdleConnTimeout = DefaultIdleConnTimeout
+	}
+	transport.DisableKeepAlives = config.DisableKeepAlives
+	// The cloned transport has a custom dialer, so without ForceAttemptHTTP2
+	// it only speaks HTTP/1.1.
+	transport.ForceAttemptHTTP2 = config.ForceHTTP2
+	transport.Proxy = config.Proxy.ProxyFunc(config.NetworkGuard)
+	if resolver := newResolver(config.DNSServer); resolver != nil || config.NetworkGuard != nil {
+		transport.DialContext = NewGuardedDialer(config.NetworkGuard, resolver).DialContext
+	}
+
+	return transport
+}
+
+// clientForMonitor returns the HTTP client used to fetch a monitor with the
+// proxy and timeout of defaults. Monitors with TLS settings, host overrides
+// or an IP family get their own transport; keep-alives are disabled so these
+// one-off transports do not hold idle connections between checks.
+func (w *Worker) clientForMonitor(row *ent.Monitor, defaults CheckDefaults) (*http.Client, error) {
+	tlsConfig, err := tlsConfigForMonitor(row)
+	if err != nil {
+		return nil, err
+	}
+	network := ipFamilyNetwork(row)
+	if tlsConfig == nil && network == "" && (row == nil || len(row.HostOverrides) == 0) {
+		return withRequestTimeout(w.clientForProxy(defaults.Proxy), defaults.RequestTimeout), nil
+	}
+
+	base := w.clientForProxy(defaults.Proxy)
+	transport := cloneTransport(base)
+	transport.DisableKeepAlives = true
+	if tlsConfig != nil {
+		transport.TLSClientConfig = tlsConfig
+	}
+	if len(row.HostOverrides) > 0 {
+		dial := transport.DialContext
+		if dial == nil {
+			dial = (&net.Dialer{}).DialContext
+		}
+		transport.DialContext = dialWithHostOverrides(dial, row.HostOverrides)
+	}
+	if network != "" {
+		dial := transport.DialContext
+		if dial == nil {
+			dial = (&net.Dialer{}).DialContext
+		}
+		transport.DialContext = func(ctx context.Context, _, addr string) (net.Conn, error) {
+			return dial(ctx, network, addr)
+		}
+	}
+
+	custom := *base
+	custom.Transport = transport
+	return withRequestTimeout(&custom, defaults.RequestTimeout), nil
+}
+
+// withRequestTimeout returns client with its timeout set to timeout, sharing
+// its transport. A zero timeout keeps client unchanged.
+func withRequestTimeout(client *http.Client, timeout time.Duration) *http.Client {
+	if timeout <= 0 || client.Timeout == timeout {
+		return client
+	}
+	custom := *client
+	custom.Timeout = timeout
+	return &custom
+}
+
+// ipFamilyNetwork returns the dial network forcing a monitor's IP family, or
+// "" to let the dialer pick either family.
+func ipFamilyNetwork(row *ent.Monitor) string {
+	if row == nil {
+		return ""
+	}
+	switch row.IPFamily {
+	case monitor.IPFamilyIpv4:
+		return "tcp4"
+	case monitor.IPFamilyIpv6:
+		return "tcp6"
+	default:
+		return ""
+	}
+}
+
+// cloneTransport returns a copy of client's transport, for monitors that need
+// their own TLS settings.
+func cloneTransport(client *http.Client) *http.Transport {
+	if transport, ok := client.Transport.(*http.Transport); ok {
+		return transport.Clone()
+	}
+	return http.DefaultTransport.(*http.Transport).Clone()
+}
@@ -0,0 +1,57 @@
+package worker
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"goanna/apps/api/ent"
+	"goanna/apps/api/ent/monitor"
+)
+
+func TestNewWithConfigTunesSharedTransport(t *testing.T) {
+	defaults, ok := NewWithConfig(nil, Config{}).client.Transport.(*http.Transport)
+	if !ok {
+		t.Fatal("expected worker client to use an http.Transport")
+	}
+	if defaults.MaxIdleConnsPerHost != DefaultMaxIdleConnsPerHost || defaults.IdleConnTimeout != DefaultIdleConnTimeout {
+		t.Fatalf("expected default idle settings, got %d/%s", defaults.MaxIdleConnsPerHost, defaults.IdleConnTimeout)
+	}
+
+	w := NewWithConfig(nil, Config{
+		MaxIdleConnsPerHost: 16,
+		IdleConnTimeout:     30 * time.Second,
+		DisableKeepAlives:   true,
+		ForceHTTP2:          true,
+	})
+	transport := w.client.Transport.(*http.Transport)
+	if transport.MaxIdleConnsPerHost != 16 || transport.IdleConnTimeout != 30*time.Second {
+		t.Fatalf("expected configured idle settings, got %d/%s", transport.MaxIdleConnsPerHost, transport.IdleConnTimeout)
+	}
+	if !transport.DisableKeepAlives || !transport.ForceAttemptHTTP2 {
+		t.Fatalf("expected keep-alives disabled and HTTP/2 forced, got %t/%t", transport.DisableKeepAlives, transport.ForceAttemptHTTP2)
+	}
+
+	if base := cloneTransport(w.client); base == transport || base.MaxIdleConnsPerHost != 16 {
+		t.Fatal("expected per-monitor transports to copy the shared settings")
+	}
+}
+
+func TestExecuteOnceForcesIPFamily(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte("ok"))
+	}))
+	defer server.Close()
+
+	w := &Worker{client: &http.Client{}, maxResponseBodyBytes: DefaultMaxResponseBodyBytes}
+	row := &ent.Monitor{Method: http.MethodGet, URL: server.URL, ExpectedType: monitor.ExpectedTypeText, IPFamily: monitor.IPFamilyIpv4}
+	if result := w.executeOnce(t.Context(), row, CheckDefaults{}); !result.success {
+		t.Fatalf("expected IPv4 dial to reach the IPv4 test server, got error=%v", result.errorMessage)
+	}
+
+	row.IPFamily = monitor.IPFamilyIpv6
+	if result := w.executeOnce(t.Context(), row, CheckDefaults{}); result.success {
+		t.Fatal("expected IPv6-only dial to fail against an IPv4 address")
+	}
+}
@@ -0,0 +1,18 @@
+package worker
+
+import "expvar"
+
+// vars holds the worker's counters, served with the rest of expvar at
+// /debug/vars on the admin listener. Every Worker in the process adds to
+// them.
+var vars = expvar.NewMap("worker")
+
+const (
+	varChecksRunning        = "checks_running"
+	varChecks               = "checks"
+	varCheckErrors          = "check_errors"
+	varResponseBodyBytes    = "response_body_bytes"
+	varResponseBodyOverflow = "response_body_limit_exceeded"
+	varNotifications        = "notifications"
+	varNotificationErrors   = "notification_errors"
+)
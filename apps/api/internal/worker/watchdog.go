@@ -0,0 +1,129 @@
+package worker
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"goanna/apps/api/ent"
+	"goanna/apps/api/ent/monitor"
+	"goanna/apps/api/ent/notificationevent"
+)
+
+// watchdogDue reports whether a monitor with a watchdog has gone
+// WatchdogMinutes without a detected change and has not been alerted for it
+// yet. Monitors that never changed are measured from their first captured
+// value.
+func watchdogDue(row *ent.Monitor, runtime *ent.MonitorRuntime, now time.Time) bool {
+	if row == nil || !row.Enabled || row.WatchdogMinutes == nil || *row.WatchdogMinutes <= 0 {
+		return false
+	}
+	if runtime == nil || runtime.WatchdogAlertedAt != nil {
+		return false
+	}
+
+	since := runtime.LastChangeAt
+	if since == nil {
+		return false
+	}
+
+	window := time.Duration(*row.WatchdogMinutes) * time.Minute
+	return !now.Before(since.Add(window))
+}
+
+// runWatchdogs alerts on monitors whose selection stopped changing. It runs on
+// every tick, independent of whether the monitor was checked in that tick.
+func (w *Worker) runWatchdogs(ctx context.Context, now time.Time) error {
+	rows, err := w.db.Monitor.Query().
+		Where(
+			monitor.EnabledEQ(true),
+			monitor.ArchivedAtIsNil(),
+			monitor.WatchdogMinutesNotNil(),
+		).
+		WithRuntime().
+		All(ctx)
+	if err != nil {
+		return err
+	}
+
+	paused, err := w.notificationsPaused(ctx)
+	if err != nil {
+		return err
+	}
+	if paused {
+		return nil
+	}
+
+	for _, row := range rows {
+		runtime := row.Edges.Runtime
+		if !watchdogDue(row, runtime, now) {
+			continue
+		}
+
+		if err := w.notifyWatchdog(ctx, row, runtime, now); err != nil {
+			w.logger.Error("failed notifying watchdog", "monitor_id", row.ID, "error", err)
+		}
+
+		if _, err := w.db.MonitorRuntime.UpdateOneID(runtime.ID).
+			SetWatchdogAlertedAt(now).
+			Save(ctx); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (w *Worker) notifyWatchdog(ctx context.Context, row *ent.Monitor, runtime *ent.MonitorRuntime, now time.Time) error {
+	channels, err := w.enabledChannelsForMonitor(ctx, row)
+	if err != nil {
+		return err
+	}
+
+	message := formatWatchdogMessage(row, runtime, now)
+	summary := fmt.Sprintf("no change for %d minutes", *row.WatchdogMinutes)
+	var notifyErr error
+	for _, channel := range channels {
+		status := "sent"
+		eventMessage := summary
+
+		if err := w.sendMonitorDiffToChannel(ctx, channel, message); err != nil {
+			status = "error"
+			eventMessage = err.Error()
+			notifyErr = err
+		}
+
+		if err := w.saveNotificationEvent(ctx, w.db.NotificationEvent.Create().
+			SetMonitorID(row.ID).
+			SetChannelID(channel.ID).
+			SetKind(notificationevent.KindWatchdog).
+			SetStatus(status).
+			SetMessage(eventMessage).
+			SetSentAt(now)); err != nil {
+			notifyErr = err
+		}
+	}
+
+	return notifyErr
+}
+
+func formatWatchdogMessage(row *ent.Monitor, runtime *ent.MonitorRuntime, now time.Time) string {
+	monitorLine := fmt.Sprintf("Monitor: %d", row.ID)
+	if monitorLabel := monitorNotificationLabel(row); monitorLabel != "" {
+		monitorLine = fmt.Sprintf("Monitor: %s (#%d)", monitorLabel, row.ID)
+	}
+
+	lines := []string{
+		"Goanna watchdog: value stopped changing",
+		monitorLine,
+		fmt.Sprintf("URL: %s", row.URL),
+		fmt.Sprintf("Expected a change every %d minutes", *row.WatchdogMinutes),
+	}
+	if runtime != nil && runtime.LastChangeAt != nil {
+		lines = append(lines, fmt.Sprintf("LastChangeAt (UTC): %s", runtime.LastChangeAt.UTC().Format(time.RFC3339)))
+	}
+	lines = append(lines, fmt.Sprintf("CheckedAt (UTC): %s", now.UTC().Format(time.RFC3339)))
+
+	return strings.Join(lines, "\n")
+}
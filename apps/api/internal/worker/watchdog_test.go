@@ -0,0 +1,97 @@
+package worker
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"goanna/apps/api/ent"
+	"goanna/apps/api/ent/enttest"
+	"goanna/apps/api/ent/monitorruntime"
+
+	_ "github.com/mattn/go-sqlite3"
+)
+
+func TestWatchdogDue(t *testing.T) {
+	minutes := 60
+	row := &ent.Monitor{Enabled: true, WatchdogMinutes: &minutes}
+	lastChange := time.Date(2026, time.March, 1, 12, 0, 0, 0, time.UTC)
+	runtime := &ent.MonitorRuntime{LastChangeAt: &lastChange}
+
+	if watchdogDue(row, runtime, lastChange.Add(59*time.Minute)) {
+		t.Fatal("expected watchdog not to fire before the window")
+	}
+	if !watchdogDue(row, runtime, lastChange.Add(time.Hour)) {
+		t.Fatal("expected watchdog to fire once the window elapsed")
+	}
+
+	alertedAt := lastChange.Add(time.Hour)
+	alerted := &ent.MonitorRuntime{LastChangeAt: &lastChange, WatchdogAlertedAt: &alertedAt}
+	if watchdogDue(row, alerted, lastChange.Add(3*time.Hour)) {
+		t.Fatal("expected watchdog to alert once per stalled period")
+	}
+
+	if watchdogDue(row, &ent.MonitorRuntime{}, lastChange.Add(time.Hour)) {
+		t.Fatal("expected watchdog to wait for a first captured value")
+	}
+	if watchdogDue(&ent.Monitor{Enabled: true}, runtime, lastChange.Add(time.Hour)) {
+		t.Fatal("expected monitor without watchdog not to fire")
+	}
+}
+
+func TestRunWatchdogsMarksStalledMonitors(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:worker-watchdog?mode=memory&cache=shared&_fk=1")
+	defer client.Close()
+
+	now := time.Now().UTC()
+	lastChange := now.Add(-2 * time.Hour)
+	row, err := client.Monitor.Create().
+		SetURL("https://example.com/feed").
+		SetCron("*/5 * * * *").
+		SetWatchdogMinutes(60).
+		Save(t.Context())
+	if err != nil {
+		t.Fatalf("expected monitor to save: %v", err)
+	}
+	if _, err := client.MonitorRuntime.Create().
+		SetMonitor(row).
+		SetStatus(monitorruntime.StatusOk).
+		SetLastChangeAt(lastChange).
+		Save(t.Context()); err != nil {
+		t.Fatalf("expected runtime to save: %v", err)
+	}
+
+	if err := New(client).runWatchdogs(t.Context(), now); err != nil {
+		t.Fatalf("expected watchdogs to run: %v", err)
+	}
+
+	runtime, err := client.MonitorRuntime.Query().Only(t.Context())
+	if err != nil {
+		t.Fatalf("expected runtime to load: %v", err)
+	}
+	if runtime.WatchdogAlertedAt == nil || !runtime.WatchdogAlertedAt.Equal(now) {
+		t.Fatalf("expected watchdogAlertedAt %s, got %v", now, runtime.WatchdogAlertedAt)
+	}
+}
+
+func TestFormatWatchdogMessage(t *testing.T) {
+	minutes := 60
+	label := "Feed"
+	lastChange := time.Date(2026, time.March, 1, 12, 0, 0, 0, time.UTC)
+	message := formatWatchdogMessage(
+		&ent.Monitor{ID: 9, Label: &label, URL: "https://example.com/feed", WatchdogMinutes: &minutes},
+		&ent.MonitorRuntime{LastChangeAt: &lastChange},
+		lastChange.Add(2*time.Hour),
+	)
+
+	for _, want := range []string{
+		"Goanna watchdog: value stopped changing",
+		"Monitor: Feed (#9)",
+		"Expected a change every 60 minutes",
+		"LastChangeAt (UTC): 2026-03-01T12:00:00Z",
+	} {
+		if !strings.Contains(message, want) {
+			t.Fatalf("expected message to contain %q, got %q", want, message)
+		}
+	}
+}
@@ -6,39 +6,107 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
-	"log"
+	"log/slog"
 	"net/http"
+	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"goanna/apps/api/ent"
 	"goanna/apps/api/ent/checkresult"
 	"goanna/apps/api/ent/monitor"
 	"goanna/apps/api/ent/monitorruntime"
+	"goanna/apps/api/ent/schema"
 	"goanna/apps/api/ent/systemconfig"
+	"goanna/apps/api/internal/errorreport"
+	"goanna/apps/api/internal/requestid"
 	selectorutil "goanna/apps/api/internal/selector"
-
-	"github.com/robfig/cron/v3"
 )
 
 const (
 	globalConfigKey             = "global"
 	defaultChecksHistoryLimit   = 200
+	defaultStaleAfterDays       = 14
 	defaultCronTimezone         = "UTC"
-	workerTickInterval          = 5 * time.Second
 	requestTimeout              = 15 * time.Second
 	maxRetries                  = 2
+	retryBackoff                = time.Second
 	DefaultMaxResponseBodyBytes = 24 * 1024 * 1024
 )
 
 type Config struct {
 	MaxResponseBodyBytes int
+	// MaxConcurrentChecks bounds how many scheduled checks run at once.
+	MaxConcurrentChecks int
+
+	// MaxIdleConnsPerHost, IdleConnTimeout, DisableKeepAlives and ForceHTTP2
+	// tune the HTTP transport shared by all checks. Without ForceHTTP2 checks
+	// use HTTP/1.1.
+	MaxIdleConnsPerHost int
+	IdleConnTimeout     time.Duration
+	DisableKeepAlives   bool
+	ForceHTTP2          bool
+	// DNSServer sends check lookups to this host:port instead of the system
+	// resolver when set.
+	DNSServer string
+	// NetworkGuard blocks checks from connecting to restricted networks when
+	// set.
+	NetworkGuard *NetworkGuard
+	// Proxy routes checks through an outbound proxy until one is set in the
+	// runtime settings. Without a URL the HTTP_PROXY environment variables
+	// apply.
+	Proxy ProxySettings
+	// InstanceID identifies this worker when claiming due runs, so replicas
+	// sharing a database run each check once. Defaults to host name and pid.
+	InstanceID string
+	// Changes wakes the scheduler to rebuild its run queue when the API
+	// changes monitors or scheduling settings.
+	Changes *ScheduleChanges
+	// Events receives check.completed and notification.sent events for live
+	// dashboard clients when set.
+	Events *Events
+	// Liveness records each scheduling pass for health checks when set.
+	Liveness *Liveness
+	// Logger receives the worker's logs, slog.Default() when nil.
+	Logger *slog.Logger
+
+	// RenderingEnabled allows monitors to use the rendered fetch mode, which
+	// loads pages in headless Chromium before evaluating them.
+	RenderingEnabled     bool
+	BrowserPath          string
+	RenderTimeout        time.Duration
+	MaxConcurrentRenders int
 }
 
 type Worker struct {
 	db                   *ent.Client
+	logger               *slog.Logger
 	client               *http.Client
+	renderer             pageRenderer
+	guard                *NetworkGuard
+	instanceID           string
 	maxResponseBodyBytes int
+	checkSlots           chan struct{}
+	checks               sync.WaitGroup
+	// startedAt is when Start began; runs scheduled before it were missed
+	// while the worker was down.
+	startedAt time.Time
+	// prunedAt is when the latest pass pruned check history.
+	prunedAt time.Time
+
+	changes  *ScheduleChanges
+	events   *Events
+	liveness *Liveness
+	queue    *runQueue
+	// tickInterval and lookahead come from the runtime settings read by the
+	// latest pass.
+	tickInterval time.Duration
+	lookahead    time.Duration
+	proxies      proxyClients
+	completedMu  sync.Mutex
+	completed    map[int]struct{}
+	completions  chan struct{}
 }
 
 type executionResult struct {
@@ -47,7 +115,14 @@ type executionResult struct {
 	durationMs   *int
 	errorMessage *string
 	selection    *selectionSnapshot
+	body         *bodySnapshot
+	contentHash  *string
+	header       *string
+	runID        string
+	redirects    []string
+	cookies      []schema.StoredCookie
 	diff         *selectionDiff
+	timings      checkTimings
 	checkedAt    time.Time
 	success      bool
 }
@@ -68,29 +143,45 @@ func NewWithConfig(db *ent.Client, config Config) *Worker {
 		maxResponseBodyBytes = DefaultMaxResponseBodyBytes
 	}
 
-	return &Worker{
-		db: db,
-		client: &http.Client{
-			Timeout: requestTimeout,
-		},
-		maxResponseBodyBytes: maxResponseBodyBytes,
+	maxConcurrentChecks := config.MaxConcurrentChecks
+	if maxConcurrentChecks <= 0 {
+		maxConcurrentChecks = DefaultMaxConcurrentChecks
 	}
-}
 
-func (w *Worker) Start(ctx context.Context) {
-	ticker := time.NewTicker(workerTickInterval)
-	defer ticker.Stop()
+	instanceID := strings.TrimSpace(config.InstanceID)
+	if instanceID == "" {
+		instanceID = defaultInstanceID()
+	}
 
-	startupAt := time.Now().UTC()
-	w.tick(ctx, &startupAt)
+	logger := config.Logger
+	if logger == nil {
+		logger = slog.Default()
+	}
 
-	for {
-		select {
-		case <-ctx.Done():
-			return
-		case <-ticker.C:
-			w.tick(ctx, nil)
-		}
+	var renderer pageRenderer
+	if config.RenderingEnabled {
+		renderer = newChromiumRenderer(config.BrowserPath, config.RenderTimeout, config.MaxConcurrentRenders, config.NetworkGuard)
+	}
+
+	return &Worker{
+		db:     db,
+		logger: logger,
+		client: &http.Client{
+			Timeout:   requestTimeout,
+			Transport: newTransport(config),
+		},
+		renderer:             renderer,
+		guard:                config.NetworkGuard,
+		instanceID:           instanceID,
+		maxResponseBodyBytes: maxResponseBodyBytes,
+		checkSlots:           make(chan struct{}, maxConcurrentChecks),
+		changes:              config.Changes,
+		events:               config.Events,
+		liveness:             config.Liveness,
+		queue:                newRunQueue(),
+		tickInterval:         defaultTickInterval,
+		completed:            map[int]struct{}{},
+		completions:          make(chan struct{}, 1),
 	}
 }
 
@@ -98,25 +189,34 @@ func (w *Worker) TriggerMonitorNow(ctx context.Context, monitorID int) (*Trigger
 	row, err := w.db.Monitor.Query().
 		Where(monitor.IDEQ(monitorID)).
 		WithRuntime().
+		WithHeaderProfile().
 		Only(ctx)
 	if err != nil {
 		return nil, err
 	}
+	if row.ArchivedAt != nil {
+		return nil, ErrMonitorArchived
+	}
+
+	if !inFlightMonitors.tryAcquire(monitorID) {
+		return nil, ErrMonitorInFlight
+	}
+	defer inFlightMonitors.release(monitorID)
 
 	config, err := w.ensureSystemConfig(ctx)
 	if err != nil {
 		return nil, err
 	}
-	cronLocation := cronLocationFromConfig(config.Timezone)
+	schedule := scheduleConfigFromSystem(config)
 
 	now := time.Now().UTC()
-	runtimeRow, err := w.ensureRuntime(ctx, row, now, cronLocation)
+	runtimeRow, err := w.ensureRuntime(ctx, row, now, schedule)
 	if err != nil {
 		return nil, err
 	}
 
 	disableAfterRun := !row.Enabled
-	if err := w.runMonitor(ctx, row, runtimeRow, now, cronLocation, disableAfterRun); err != nil {
+	if err := w.runMonitor(ctx, row, runtimeRow, now, schedule, disableAfterRun, requestid.New()); err != nil {
 		return nil, err
 	}
 
@@ -129,7 +229,7 @@ func (w *Worker) TriggerMonitorNow(ctx context.Context, monitorID int) (*Trigger
 	}
 
 	latestCheck, err := w.db.CheckResult.Query().
-		Where(checkresult.HasMonitorWith(monitor.IDEQ(monitorID))).
+		Where(checkresult.MonitorIDEQ(monitorID)).
 		Order(ent.Desc(checkresult.FieldCheckedAt), ent.Desc(checkresult.FieldID)).
 		First(ctx)
 	if err != nil {
@@ -146,52 +246,107 @@ func (w *Worker) TriggerMonitorNow(ctx context.Context, monitorID int) (*Trigger
 	}, nil
 }
 
+// tick is a full scheduling pass: it loads every monitor that is not
+// archived, dispatches those due, rebuilds the run queue and runs housekeeping.
 func (w *Worker) tick(ctx context.Context, startupCutoff *time.Time) {
+	w.liveness.RecordTick(time.Now().UTC())
+	w.queue.reset()
+
 	config, err := w.ensureSystemConfig(ctx)
 	if err != nil {
-		log.Printf("worker: failed ensuring system config: %v", err)
+		w.logger.Error("failed ensuring system config", "error", err)
+		return
+	}
+	schedule := scheduleConfigFromSystem(config)
+	w.applyTickSettings(schedule)
+	if config.Paused {
 		return
 	}
-	cronLocation := cronLocationFromConfig(config.Timezone)
 
-	monitors, err := w.db.Monitor.Query().WithRuntime().All(ctx)
+	monitors, err := w.db.Monitor.Query().
+		Where(monitor.ArchivedAtIsNil()).
+		WithRuntime().
+		WithHeaderProfile().
+		All(ctx)
 	if err != nil {
-		log.Printf("worker: failed loading monitors: %v", err)
+		w.logger.Error("failed loading monitors", "error", err)
 		return
 	}
 
 	now := time.Now().UTC()
 	for _, row := range monitors {
-		runtime, err := w.ensureRuntime(ctx, row, now, cronLocation)
-		if err != nil {
-			log.Printf("worker: failed ensuring runtime monitor=%d: %v", row.ID, err)
-			continue
+		if !w.scheduleMonitor(ctx, row, now, schedule, startupCutoff) {
+			break
 		}
+	}
 
-		if runtime.NextRunAt == nil || now.Before(*runtime.NextRunAt) {
-			continue
-		}
+	if err := w.runWatchdogs(ctx, now); err != nil {
+		w.logger.Error("failed evaluating watchdogs", "error", err)
+	}
 
-		manualDisabledRun := !row.Enabled
-		if manualDisabledRun && runtime.Status != monitorruntime.StatusPending {
-			continue
-		}
+	if err := w.runStaleHousekeeping(ctx, config, monitors, now); err != nil {
+		w.logger.Error("failed stale monitor housekeeping", "error", err)
+	}
 
-		if !manualDisabledRun && !row.Enabled {
-			continue
-		}
+	if err := w.pruneHistory(ctx, config, monitors, now); err != nil {
+		w.logger.Error("failed pruning check history", "error", err)
+	}
+}
 
-		if startupCutoff != nil && shouldTriggerStartupCatchUp(runtime.NextRunAt, *startupCutoff) {
-			log.Printf("worker: startup catch-up trigger monitor=%d scheduled_for=%s", row.ID, runtime.NextRunAt.UTC().Format(time.RFC3339))
-		}
+// scheduleMonitor dispatches row when its run is due and otherwise queues its
+// next run. It returns false only when ctx is cancelled while waiting for a
+// check slot.
+func (w *Worker) scheduleMonitor(ctx context.Context, row *ent.Monitor, now time.Time, schedule scheduleConfig, startupCutoff *time.Time) bool {
+	w.queue.remove(row.ID)
+
+	runtime, err := w.ensureRuntime(ctx, row, now, schedule)
+	if err != nil {
+		w.logger.Error("failed ensuring monitor runtime", "monitor_id", row.ID, "error", err)
+		return true
+	}
+
+	if runtime.NextRunAt == nil {
+		return true
+	}
+
+	manualDisabledRun := !row.Enabled
+	if manualDisabledRun && runtime.Status != monitorruntime.StatusPending {
+		return true
+	}
 
-		if err := w.runMonitor(ctx, row, runtime, now, cronLocation, manualDisabledRun); err != nil {
-			log.Printf("worker: failed running monitor=%d: %v", row.ID, err)
+	if now.Add(schedule.lookahead).Before(*runtime.NextRunAt) {
+		w.queue.schedule(row.ID, *runtime.NextRunAt)
+		return true
+	}
+
+	// A run dispatched early within the lookahead counts as the scheduled
+	// one, so its next run follows the scheduled time rather than now.
+	scheduleFrom := now
+	if now.Before(*runtime.NextRunAt) {
+		scheduleFrom = *runtime.NextRunAt
+	}
+	if !manualDisabledRun && startupCutoff != nil && shouldTriggerStartupCatchUp(runtime.NextRunAt, *startupCutoff) {
+		run, from := schedule.catchUp.plan(*runtime.NextRunAt, now)
+		if !run {
+			w.logger.Info("startup catch-up skip", "monitor_id", row.ID, "scheduled_for", runtime.NextRunAt.UTC())
+			nextRun, err := w.skipMissedRun(ctx, row, runtime, now, schedule)
+			if err != nil {
+				w.logger.Error("failed rescheduling monitor", "monitor_id", row.ID, "error", err)
+				return true
+			}
+			w.queue.schedule(row.ID, nextRun)
+			return true
 		}
+		w.logger.Info("startup catch-up trigger", "monitor_id", row.ID, "scheduled_for", runtime.NextRunAt.UTC())
+		scheduleFrom = from
+	} else if !manualDisabledRun && w.replayingMissedRuns(runtime, schedule) {
+		scheduleFrom = *runtime.NextRunAt
 	}
+
+	return w.dispatchMonitor(ctx, row, runtime, scheduleFrom, schedule, manualDisabledRun)
 }
 
-func (w *Worker) ensureRuntime(ctx context.Context, row *ent.Monitor, now time.Time, cronLocation *time.Location) (*ent.MonitorRuntime, error) {
+func (w *Worker) ensureRuntime(ctx context.Context, row *ent.Monitor, now time.Time, schedule scheduleConfig) (*ent.MonitorRuntime, error) {
 	runtime := row.Edges.Runtime
 	if runtime == nil {
 		status := monitorruntime.StatusPending
@@ -201,7 +356,7 @@ func (w *Worker) ensureRuntime(ctx context.Context, row *ent.Monitor, now time.T
 		if !row.Enabled {
 			create = create.SetStatus(monitorruntime.StatusDisabled)
 		} else {
-			nextRun, err := nextRunFromCron(row.Cron, now, cronLocation)
+			nextRun, err := nextRunForMonitor(row, now, schedule)
 			if err == nil {
 				create = create.SetNextRunAt(nextRun)
 			}
@@ -236,7 +391,7 @@ func (w *Worker) ensureRuntime(ctx context.Context, row *ent.Monitor, now time.T
 		update := w.db.MonitorRuntime.UpdateOneID(runtime.ID).
 			SetStatus(monitorruntime.StatusPending)
 		if runtime.NextRunAt == nil {
-			nextRun, err := nextRunFromCron(row.Cron, now, cronLocation)
+			nextRun, err := nextRunForMonitor(row, now, schedule)
 			if err == nil {
 				update = update.SetNextRunAt(nextRun)
 			}
@@ -249,7 +404,7 @@ func (w *Worker) ensureRuntime(ctx context.Context, row *ent.Monitor, now time.T
 	}
 
 	if runtime.NextRunAt == nil {
-		nextRun, err := nextRunFromCron(row.Cron, now, cronLocation)
+		nextRun, err := nextRunForMonitor(row, now, schedule)
 		if err != nil {
 			msg := err.Error()
 			updated, updateErr := w.db.MonitorRuntime.UpdateOneID(runtime.ID).
@@ -274,48 +429,194 @@ func (w *Worker) ensureRuntime(ctx context.Context, row *ent.Monitor, now time.T
 	return runtime, nil
 }
 
-func (w *Worker) runMonitor(ctx context.Context, row *ent.Monitor, runtime *ent.MonitorRuntime, now time.Time, cronLocation *time.Location, disableAfterRun bool) error {
-	result, retriesUsed := w.executeWithRetry(ctx, row, runtime)
+// dispatchMonitor runs a due monitor on the check pool, retrying it later
+// while a previous check of the same monitor is still in flight or another
+// replica claimed the run. It waits for a free slot and returns false only
+// when ctx is cancelled first.
+func (w *Worker) dispatchMonitor(ctx context.Context, row *ent.Monitor, runtime *ent.MonitorRuntime, now time.Time, schedule scheduleConfig, disableAfterRun bool) bool {
+	if !inFlightMonitors.tryAcquire(row.ID) {
+		w.queue.schedule(row.ID, time.Now().UTC().Add(busyRetryInterval))
+		return true
+	}
 
-	if result.selection != nil && result.selection.Exists {
+	select {
+	case w.checkSlots <- struct{}{}:
+	case <-ctx.Done():
+		inFlightMonitors.release(row.ID)
+		return false
+	}
+
+	claimed, err := w.claimRun(ctx, runtime, time.Now().UTC(), w.claimLease(schedule.checks))
+	if err != nil || !claimed {
+		if err != nil {
+			w.logger.Error("failed claiming monitor", "monitor_id", row.ID, "error", err)
+		}
+		<-w.checkSlots
+		inFlightMonitors.release(row.ID)
+		w.queue.schedule(row.ID, time.Now().UTC().Add(busyRetryInterval))
+		return true
+	}
+
+	// A started check runs to completion after ctx is done, so shutdown
+	// waits for its result to be saved instead of abandoning the run.
+	checkCtx := context.WithoutCancel(ctx)
+	runID := requestid.New()
+	w.checks.Add(1)
+	go func() {
+		defer w.checks.Done()
+		defer func() { <-w.checkSlots }()
+		defer w.markCompleted(row.ID)
+		defer inFlightMonitors.release(row.ID)
+		defer errorreport.Recover(map[string]string{"monitor_id": strconv.Itoa(row.ID), "run_id": runID})
+
+		if err := w.runMonitor(checkCtx, row, runtime, now, schedule, disableAfterRun, runID); err != nil {
+			w.runLogger(checkCtx, row.ID, runID).Error("failed running monitor", "error", err)
+		}
+	}()
+	return true
+}
+
+// runLogger returns the worker's logger tagged with a check run's monitor,
+// its run ID and, for runs triggered through the API, the request ID.
+func (w *Worker) runLogger(ctx context.Context, monitorID int, runID string) *slog.Logger {
+	logger := w.logger.With("monitor_id", monitorID, "run_id", runID)
+	if id := requestid.FromContext(ctx); id != "" {
+		logger = logger.With("request_id", id)
+	}
+	return logger
+}
+
+// diffWithPrevious sets result.diff to the change from the latest stored
+// check of row: its body snapshot, content hash or selection, whichever the
+// result carries, plus the tracked header.
+func (w *Worker) diffWithPrevious(ctx context.Context, row *ent.Monitor, result *executionResult) error {
+	if result.body != nil {
+		previousBody, err := w.loadPreviousBodySnapshot(ctx, row.ID)
+		if err != nil {
+			return err
+		}
+		diffStarted := time.Now()
+		result.diff = buildBodyDiff(previousBody, result.body)
+		result.timings.diff = elapsedMs(diffStarted)
+	} else if result.contentHash != nil {
+		previousHash, err := w.loadPreviousContentHash(ctx, row.ID)
+		if err != nil {
+			return err
+		}
+		diffStarted := time.Now()
+		result.diff = buildContentHashDiff(previousHash, result.contentHash)
+		result.timings.diff = elapsedMs(diffStarted)
+	} else if result.selection != nil && result.selection.Exists {
 		previousSelection, err := w.loadPreviousSelection(ctx, row.ID)
 		if err != nil {
 			return err
 		}
-		result.diff = buildSelectionDiff(previousSelection, result.selection)
+		diffStarted := time.Now()
+		result.diff = buildMonitorSelectionDiff(row, previousSelection, result.selection)
+		result.timings.diff = elapsedMs(diffStarted)
+	}
+
+	if result.header != nil {
+		previousHeader, err := w.loadPreviousTrackedHeader(ctx, row.ID)
+		if err != nil {
+			return err
+		}
+		result.diff = mergeHeaderDiff(result.diff, buildHeaderDiff(*row.TrackHeader, previousHeader, result.header))
+	}
+	return nil
+}
+
+// runMonitor runs one check of row and saves its outcome. runID tags the
+// run's log lines and notification events.
+func (w *Worker) runMonitor(ctx context.Context, row *ent.Monitor, runtime *ent.MonitorRuntime, now time.Time, schedule scheduleConfig, disableAfterRun bool, runID string) (err error) {
+	ctx, span := startCheckSpan(ctx, row.ID, runID)
+	defer func() { endSpan(span, err) }()
+	logger := w.runLogger(ctx, row.ID, runID)
+	vars.Add(varChecksRunning, 1)
+	defer vars.Add(varChecksRunning, -1)
+
+	result, retriesUsed := w.executeWithRetry(ctx, row, runtime, schedule.checks)
+	result.runID = runID
+	recordCheck(ctx, span, result)
+	vars.Add(varChecks, 1)
+	if !result.success {
+		vars.Add(varCheckErrors, 1)
+	}
+	logger.Debug("check finished", "status", result.status, "retries", retriesUsed)
+
+	if err := w.diffWithPrevious(ctx, row, &result); err != nil {
+		return err
+	}
+
+	changeExpected := isExpectedChange(runtime, result.diff, result.checkedAt)
+	if changeExpected {
+		markDiffExpected(result.diff)
 	}
 
-	if err := w.insertCheckResult(ctx, row.ID, result); err != nil {
+	check, err := w.insertCheckResult(ctx, row, result)
+	if err != nil {
 		return err
 	}
+	if err := w.recordRollups(ctx, row.ID, result); err != nil {
+		logger.Error("failed recording rollups", "error", err)
+	}
 
 	update := w.db.MonitorRuntime.UpdateOneID(runtime.ID).
 		AddCheckCount(1).
 		AddRetryCount(int64(retriesUsed)).
-		SetLastCheckAt(result.checkedAt)
+		SetLastCheckAt(result.checkedAt).
+		ClearClaimedBy().
+		ClearClaimedUntil()
+
+	circuitOpen := !disableAfterRun && schedule.breaker.open(row, runtime, result)
+	circuitTripped := circuitOpen && runtime.CircuitOpenedAt == nil
+	suspend := circuitOpen && schedule.breaker.action == systemconfig.CircuitBreakerActionSuspend
 
-	if disableAfterRun {
+	if disableAfterRun || suspend {
 		update = update.
 			SetStatus(monitorruntime.StatusDisabled).
 			ClearNextRunAt()
 	} else {
-		nextRun, err := nextRunFromCron(row.Cron, now, cronLocation)
+		nextRun, err := nextRunForMonitor(row, now, schedule)
 		if err != nil {
 			nextRun = now.Add(time.Minute)
 		}
+		if circuitOpen {
+			nextRun = schedule.breaker.backoffRun(nextRun, result.checkedAt)
+		}
 
 		update = update.
 			SetStatus(monitorruntime.Status(result.status)).
 			SetNextRunAt(nextRun)
 	}
 
+	if result.diff != nil && result.diff.Changed {
+		update = update.
+			SetDailyChangeCounts(recordDailyChange(runtime.DailyChangeCounts, result.checkedAt)).
+			SetLastChangeAt(result.checkedAt).
+			ClearWatchdogAlertedAt()
+	} else if result.diff != nil && runtime.LastChangeAt == nil {
+		update = update.SetLastChangeAt(result.checkedAt)
+	}
+	if changeExpected || expectChangeWindowExpired(runtime, result.checkedAt) {
+		update = update.ClearExpectChangeUntil()
+	}
+	if result.cookies != nil {
+		update = update.SetCookies(result.cookies)
+	}
+
 	if result.success {
 		update = update.
 			AddSuccessCount(1).
 			SetConsecutiveErrors(0).
 			AddConsecutiveSuccesses(1).
 			SetLastSuccessAt(result.checkedAt).
-			ClearLastErrorMessage()
+			ClearLastErrorMessage().
+			ClearFailingSince().
+			ClearEscalatedAt().
+			ClearAcknowledgedAt().
+			ClearErrorRepeatingSince().
+			ClearCircuitOpenedAt()
 	} else {
 		update = update.
 			AddErrorCount(1).
@@ -325,6 +626,15 @@ func (w *Worker) runMonitor(ctx context.Context, row *ent.Monitor, runtime *ent.
 		if result.errorMessage != nil {
 			update = update.SetLastErrorMessage(*result.errorMessage)
 		}
+		if runtime.FailingSince == nil {
+			update = update.SetFailingSince(result.checkedAt)
+		}
+		if runtime.ErrorRepeatingSince == nil || !sameErrorMessage(runtime.LastErrorMessage, result.errorMessage) {
+			update = update.SetErrorRepeatingSince(result.checkedAt)
+		}
+		if circuitTripped {
+			update = update.SetCircuitOpenedAt(result.checkedAt)
+		}
 	}
 
 	if result.statusCode != nil {
@@ -339,35 +649,55 @@ func (w *Worker) runMonitor(ctx context.Context, row *ent.Monitor, runtime *ent.
 		update = update.ClearLastDurationMs()
 	}
 
-	if _, err := update.Save(ctx); err != nil {
+	updatedRuntime, err := update.Save(ctx)
+	if err != nil {
 		return err
 	}
+	w.publishCheckCompleted(row.ID, check, updatedRuntime)
 
-	if result.diff != nil && result.diff.Changed {
-		if err := w.notifyMonitorDiff(ctx, row, result.diff, result.checkedAt); err != nil {
-			log.Printf("worker: failed notifying monitor=%d: %v", row.ID, err)
+	if suspend {
+		if _, err := w.db.Monitor.UpdateOneID(row.ID).SetEnabled(false).Save(ctx); err != nil {
+			return err
+		}
+	}
+	if circuitTripped {
+		if err := w.notifyCircuitBreaker(ctx, row, updatedRuntime, result, schedule.breaker); err != nil {
+			logger.Error("failed notifying circuit breaker", "error", err)
 		}
 	}
 
-	limit, err := w.getChecksHistoryLimit(ctx)
-	if err != nil {
-		return err
+	if err := w.handleFailureEscalation(ctx, row, updatedRuntime, result); err != nil {
+		logger.Error("failed escalating monitor", "error", err)
+	}
+	if err := w.notifyMissedHeartbeat(ctx, row, updatedRuntime, result); err != nil {
+		logger.Error("failed notifying missed heartbeat", "error", err)
 	}
 
-	return w.pruneCheckHistory(ctx, row.ID, limit)
+	if result.diff != nil && result.diff.Changed && !changeExpected {
+		if err := w.notifyMonitorDiff(ctx, row, result.diff, result.checkedAt, runID); err != nil {
+			logger.Error("failed notifying monitor", "error", err)
+		}
+	}
+
+	return nil
 }
 
-func (w *Worker) executeWithRetry(ctx context.Context, row *ent.Monitor, runtime *ent.MonitorRuntime) (executionResult, int) {
+func (w *Worker) executeWithRetry(ctx context.Context, row *ent.Monitor, runtime *ent.MonitorRuntime, defaults CheckDefaults) (executionResult, int) {
+	if isHeartbeatMonitor(row) {
+		return evaluateHeartbeat(runtime, time.Now().UTC()), 0
+	}
+
 	var result executionResult
 	retriesUsed := 0
+	policy := retryPolicyFor(row)
 
-	for attempt := 0; attempt <= maxRetries; attempt++ {
-		result = w.executeOnce(ctx, row)
+	for attempt := 0; attempt <= defaults.MaxRetries; attempt++ {
+		result = w.executeOnce(ctx, row, defaults)
 		if result.success {
 			return result, retriesUsed
 		}
 
-		if attempt == maxRetries {
+		if attempt == defaults.MaxRetries || !policy.retryable(row, result) {
 			return result, retriesUsed
 		}
 
@@ -376,7 +706,7 @@ func (w *Worker) executeWithRetry(ctx context.Context, row *ent.Monitor, runtime
 			SetStatus(monitorruntime.StatusRetrying).
 			Save(ctx)
 
-		backoff := time.Duration(retriesUsed) * time.Second
+		backoff := time.Duration(retriesUsed) * defaults.RetryBackoff
 		select {
 		case <-ctx.Done():
 			cancelled := "worker stopped"
@@ -391,28 +721,45 @@ func (w *Worker) executeWithRetry(ctx context.Context, row *ent.Monitor, runtime
 	return result, retriesUsed
 }
 
-func (w *Worker) executeOnce(ctx context.Context, row *ent.Monitor) executionResult {
+func (w *Worker) executeOnce(ctx context.Context, row *ent.Monitor, defaults CheckDefaults) executionResult {
 	started := time.Now().UTC()
 	result := executionResult{checkedAt: started, status: "error", success: false}
 
+	ctx, span := startFetchSpan(ctx, row.ID)
+	defer func() { endFetchSpan(span, result) }()
+
 	var body io.Reader
 	if row.Body != nil {
-		body = strings.NewReader(*row.Body)
+		body = strings.NewReader(ExpandTemplate(*row.Body, started))
 	}
 
-	req, err := http.NewRequestWithContext(ctx, row.Method, row.URL, body)
+	req, err := http.NewRequestWithContext(ctx, row.Method, ExpandTemplate(row.URL, started), body)
 	if err != nil {
 		msg := err.Error()
 		result.errorMessage = &msg
 		return result
 	}
 
-	for key, value := range row.Headers {
-		req.Header.Set(key, value)
+	for key, value := range monitorRequestHeaders(row, defaults) {
+		req.Header.Set(key, ExpandEnvReferences(ExpandTemplate(value, started)))
 	}
-	applyAuth(req, row.Auth)
+	applyAuth(req, ExpandAuthEnvReferences(row.Auth))
 
-	response, err := w.client.Do(req)
+	var response *http.Response
+	if isRenderedMonitor(row) {
+		response, err = w.renderResponse(ctx, row, defaults)
+	} else {
+		var client *http.Client
+		client, err = w.clientForMonitor(row, defaults)
+		if err == nil {
+			var jar *cookieJar
+			client, jar = withCookieJar(client, row)
+			response, err = clientForRedirects(client, row, expectedStatusRanges(row), &result.redirects).Do(req)
+			if jar != nil {
+				result.cookies = jar.snapshot()
+			}
+		}
+	}
 	if err != nil {
 		msg := err.Error()
 		result.errorMessage = &msg
@@ -427,23 +774,72 @@ func (w *Worker) executeOnce(ctx context.Context, row *ent.Monitor) executionRes
 	statusCode := response.StatusCode
 	result.statusCode = &statusCode
 
-	responseReadLimit := int64(w.maxResponseBodyBytes + 1)
-	payload, readErr := io.ReadAll(io.LimitReader(response.Body, responseReadLimit))
+	responseBody, err := DecodeContentEncoding(response.Header.Get("Content-Encoding"), response.Body)
+	if err != nil {
+		msg := err.Error()
+		result.errorMessage = &msg
+		return result
+	}
+
+	if canStreamSelection(row) {
+		result.header = trackedHeaderValue(row, response.Header)
+		selectorStarted := time.Now()
+		ok, errMsg, selection := w.evaluateStreamedJSON(row, response, responseBody, defaults)
+		result.timings.selector = elapsedMs(selectorStarted)
+		result.applyEvaluation(ok, errMsg, selection)
+		return result
+	}
+
+	bodyLimit := w.responseBodyLimit(row, defaults)
+	readStarted := time.Now()
+	payload, readErr := io.ReadAll(io.LimitReader(responseBody, int64(bodyLimit+1)))
+	result.timings.bodyRead = elapsedMs(readStarted)
+	vars.Add(varResponseBodyBytes, int64(len(payload)))
 	if readErr != nil {
 		msg := readErr.Error()
 		result.errorMessage = &msg
 		return result
 	}
-	if len(payload) > w.maxResponseBodyBytes {
-		msg := fmt.Sprintf(
-			"response body exceeds %d bytes limit (increase GOANNA_MAX_RESPONSE_BODY_BYTES)",
-			w.maxResponseBodyBytes,
-		)
+	if len(payload) > bodyLimit {
+		vars.Add(varResponseBodyOverflow, 1)
+		msg := responseBodyLimitMessage(row, defaults, bodyLimit)
 		result.errorMessage = &msg
 		return result
 	}
+	result.body = captureBodySnapshot(row, payload)
+	result.contentHash = computeContentHash(row, payload)
+	result.header = trackedHeaderValue(row, response.Header)
+
+	if isExpectedEmptyState(row, response.StatusCode, payload) {
+		result.status = "ok"
+		result.success = true
+		return result
+	}
+
+	selectorStarted := time.Now()
+	ok, errMsg, selection := evaluateResponse(response.StatusCode, expectedStatusRanges(row), payload, row.ExpectedType.String(), row.Selector, row.ExpectedResponse)
+	if ok && isSitemapMonitor(row) {
+		ok, errMsg, selection = w.evaluateSitemap(ctx, row, payload, defaults)
+	}
+	if ok {
+		if keywordErr := evaluateKeywordAssertions(payload, row.MustContain, row.MustNotContain); keywordErr != "" {
+			ok = false
+			errMsg = keywordErr
+		}
+	}
+	if ok {
+		if headerErr := evaluateHeaderAssertions(response.Header, row.HeaderAssertions); headerErr != "" {
+			ok = false
+			errMsg = headerErr
+		}
+	}
+	result.timings.selector = elapsedMs(selectorStarted)
+	result.applyEvaluation(ok, errMsg, selection)
+	return result
+}
 
-	ok, errMsg, selection := evaluateResponse(response.StatusCode, payload, row.ExpectedType.String(), row.Selector, row.ExpectedResponse)
+// applyEvaluation records the outcome of evaluating a response body.
+func (result *executionResult) applyEvaluation(ok bool, errMsg string, selection *selectorutil.Selection) {
 	if selection != nil {
 		result.selection = &selectionSnapshot{
 			Exists: selection.Exists,
@@ -457,13 +853,52 @@ func (w *Worker) executeOnce(ctx context.Context, row *ent.Monitor) executionRes
 		if errMsg != "" {
 			result.errorMessage = &errMsg
 		}
-		return result
+		return
 	}
 
 	result.status = "ok"
 	result.success = true
 	result.errorMessage = nil
-	return result
+}
+
+func responseBodyLimitMessage(row *ent.Monitor, defaults CheckDefaults, limit int) string {
+	if row != nil && row.MaxResponseBytes != nil {
+		return fmt.Sprintf("response body exceeds %d bytes limit (increase the monitor's maxResponseBytes)", limit)
+	}
+	if defaults.MaxResponseBodyBytes > 0 {
+		return fmt.Sprintf("response body exceeds %d bytes limit (increase the runtime maxResponseBodyBytes setting)", limit)
+	}
+	return fmt.Sprintf("response body exceeds %d bytes limit (increase GOANNA_MAX_RESPONSE_BODY_BYTES)", limit)
+}
+
+// responseBodyLimit returns the monitor's own body size cap, falling back to
+// the runtime setting and then the worker-wide GOANNA_MAX_RESPONSE_BODY_BYTES
+// limit.
+func (w *Worker) responseBodyLimit(row *ent.Monitor, defaults CheckDefaults) int {
+	if row != nil && row.MaxResponseBytes != nil && *row.MaxResponseBytes > 0 {
+		return *row.MaxResponseBytes
+	}
+	if defaults.MaxResponseBodyBytes > 0 {
+		return defaults.MaxResponseBodyBytes
+	}
+	return w.maxResponseBodyBytes
+}
+
+// assertJSONSelection checks that a selected JSON value exists and matches the
+// monitor's expected response, when one is set.
+func assertJSONSelection(selection selectorutil.Selection, selectorPath string, expected string) (bool, string, *selectorutil.Selection) {
+	if selectorPath != "" && !selection.Exists {
+		return false, fmt.Sprintf("selector %q not found", selectorPath), &selection
+	}
+
+	if expected == "" {
+		return true, "", &selection
+	}
+
+	if selection.Value != expected {
+		return false, "JSON assertion failed", &selection
+	}
+	return true, "", &selection
 }
 
 func applyAuth(req *http.Request, auth map[string]string) {
@@ -490,8 +925,8 @@ func applyAuth(req *http.Request, auth map[string]string) {
 	}
 }
 
-func evaluateResponse(statusCode int, payload []byte, expectedType string, selector *string, expected *string) (bool, string, *selectorutil.Selection) {
-	if statusCode < 200 || statusCode >= 300 {
+func evaluateResponse(statusCode int, expectedStatus []statusRange, payload []byte, expectedType string, selector *string, expected *string) (bool, string, *selectorutil.Selection) {
+	if !statusAllowed(expectedStatus, statusCode) {
 		return false, fmt.Sprintf("unexpected status code: %d", statusCode), nil
 	}
 
@@ -512,20 +947,7 @@ func evaluateResponse(statusCode int, payload []byte, expectedType string, selec
 			return false, "response is not valid JSON", nil
 		}
 
-		selectionCopy := selection
-		if selectorPath != "" && !selection.Exists {
-			return false, fmt.Sprintf("selector %q not found", selectorPath), &selectionCopy
-		}
-
-		if trimmedExpected == "" {
-			return true, "", &selectionCopy
-		}
-
-		actual := selection.Value
-		if actual != trimmedExpected {
-			return false, "JSON assertion failed", &selectionCopy
-		}
-		return true, "", &selectionCopy
+		return assertJSONSelection(selection, selectorPath, trimmedExpected)
 
 	case "html", "text":
 		if selector != nil && strings.TrimSpace(*selector) != "" {
@@ -540,17 +962,31 @@ func evaluateResponse(statusCode int, payload []byte, expectedType string, selec
 		if actual != trimmedExpected {
 			return false, "text assertion failed", nil
 		}
+		return true, "", nil
+	case "feed":
+		if selector != nil && strings.TrimSpace(*selector) != "" {
+			return false, "selector is only supported for JSON expectedType", nil
+		}
+
+		return evaluateFeed(payload)
+	case "sitemap":
+		// The URL set is collected by Worker.evaluateSitemap, which may need to
+		// fetch the child sitemaps of an index.
+		if selector != nil && strings.TrimSpace(*selector) != "" {
+			return false, "selector is only supported for JSON expectedType", nil
+		}
+
 		return true, "", nil
 	default:
 		return false, "unsupported expectedType", nil
 	}
 }
 
-func (w *Worker) insertCheckResult(ctx context.Context, monitorID int, result executionResult) error {
+func (w *Worker) insertCheckResult(ctx context.Context, row *ent.Monitor, result executionResult) (*ent.CheckResult, error) {
 	create := w.db.CheckResult.Create().
 		SetStatus(result.status).
 		SetCheckedAt(result.checkedAt).
-		SetMonitorID(monitorID)
+		SetMonitorID(row.ID)
 
 	if result.statusCode != nil {
 		create = create.SetStatusCode(*result.statusCode)
@@ -566,6 +1002,35 @@ func (w *Worker) insertCheckResult(ctx context.Context, monitorID int, result ex
 			SetSelectionType(result.selection.Type).
 			SetSelectionValue(result.selection.Value)
 	}
+	if result.body != nil {
+		encoded, encoding, err := encodeBodySnapshot(result.body.Data, row.BodySnapshot)
+		if err != nil {
+			return nil, err
+		}
+		create = create.
+			SetBodySnapshot(encoded).
+			SetBodySnapshotEncoding(encoding).
+			SetBodySize(result.body.Size).
+			SetBodySnapshotTruncated(result.body.Truncated)
+	}
+	if result.contentHash != nil {
+		create = create.SetBodyHash(*result.contentHash)
+	}
+	if result.header != nil {
+		create = create.SetTrackedHeaderValue(*result.header)
+	}
+	if len(result.redirects) > 0 {
+		create = create.SetRedirectChain(result.redirects)
+	}
+	if result.timings.bodyRead != nil {
+		create = create.SetBodyReadMs(*result.timings.bodyRead)
+	}
+	if result.timings.selector != nil {
+		create = create.SetSelectorMs(*result.timings.selector)
+	}
+	if result.timings.diff != nil {
+		create = create.SetDiffMs(*result.timings.diff)
+	}
 	if result.diff != nil {
 		create = create.
 			SetDiffChanged(result.diff.Changed).
@@ -580,14 +1045,13 @@ func (w *Worker) insertCheckResult(ctx context.Context, monitorID int, result ex
 		}
 	}
 
-	_, err := create.Save(ctx)
-	return err
+	return create.Save(ctx)
 }
 
 func (w *Worker) loadPreviousSelection(ctx context.Context, monitorID int) (*selectionSnapshot, error) {
 	row, err := w.db.CheckResult.Query().
 		Where(
-			checkresult.HasMonitorWith(monitor.IDEQ(monitorID)),
+			checkresult.MonitorIDEQ(monitorID),
 			checkresult.SelectionTypeNotNil(),
 			checkresult.SelectionValueNotNil(),
 		).
@@ -600,49 +1064,7 @@ func (w *Worker) loadPreviousSelection(ctx context.Context, monitorID int) (*sel
 		return nil, err
 	}
 
-	if row.SelectionType == nil || row.SelectionValue == nil {
-		return nil, nil
-	}
-
-	return &selectionSnapshot{
-		Exists: true,
-		Type:   *row.SelectionType,
-		Value:  *row.SelectionValue,
-	}, nil
-}
-
-func (w *Worker) pruneCheckHistory(ctx context.Context, monitorID int, keep int) error {
-	if keep <= 0 {
-		return nil
-	}
-
-	stale, err := w.db.CheckResult.Query().
-		Where(checkresult.HasMonitorWith(monitor.IDEQ(monitorID))).
-		Order(ent.Desc(checkresult.FieldCheckedAt), ent.Desc(checkresult.FieldID)).
-		Offset(keep).
-		All(ctx)
-	if err != nil {
-		return err
-	}
-	if len(stale) == 0 {
-		return nil
-	}
-
-	ids := make([]int, 0, len(stale))
-	for _, row := range stale {
-		ids = append(ids, row.ID)
-	}
-
-	_, err = w.db.CheckResult.Delete().Where(checkresult.IDIn(ids...)).Exec(ctx)
-	return err
-}
-
-func (w *Worker) getChecksHistoryLimit(ctx context.Context) (int, error) {
-	config, err := w.ensureSystemConfig(ctx)
-	if err != nil {
-		return 0, err
-	}
-	return config.ChecksHistoryLimit, nil
+	return selectionSnapshotFromCheck(row), nil
 }
 
 func (w *Worker) ensureSystemConfig(ctx context.Context) (*ent.SystemConfig, error) {
@@ -673,30 +1095,13 @@ func cronLocationFromConfig(rawTimezone *string) *time.Location {
 
 	location, err := time.LoadLocation(timezone)
 	if err != nil {
-		log.Printf("worker: invalid timezone %q in runtime settings, defaulting to UTC", timezone)
+		slog.Warn("invalid timezone in runtime settings, defaulting to UTC", "timezone", timezone)
 		return time.UTC
 	}
 
 	return location
 }
 
-func nextRunFromCron(expr string, now time.Time, location *time.Location) (time.Time, error) {
-	schedule, err := cron.ParseStandard(expr)
-	if err != nil {
-		return time.Time{}, err
-	}
-
-	if location == nil {
-		location = time.UTC
-	}
-
-	if specSchedule, ok := schedule.(*cron.SpecSchedule); ok {
-		specSchedule.Location = location
-	}
-
-	return schedule.Next(now), nil
-}
-
 func shouldTriggerStartupCatchUp(nextRunAt *time.Time, startupAt time.Time) bool {
 	if nextRunAt == nil {
 		return false
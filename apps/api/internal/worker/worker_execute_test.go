@@ -7,8 +7,11 @@ import (
 	"strconv"
 	"strings"
 	"testing"
+	"time"
 
 	"goanna/apps/api/ent"
+	"goanna/apps/api/ent/checkresult"
+	"goanna/apps/api/ent/enttest"
 	"goanna/apps/api/ent/monitor"
 )
 
@@ -37,7 +40,7 @@ func TestExecuteOnceHandlesLargeJSONResponses(t *testing.T) {
 
 	w := &Worker{client: server.Client()}
 	w.maxResponseBodyBytes = DefaultMaxResponseBodyBytes
-	result := w.executeOnce(t.Context(), row)
+	result := w.executeOnce(t.Context(), row, CheckDefaults{})
 
 	if !result.success {
 		if result.errorMessage != nil {
@@ -51,6 +54,9 @@ func TestExecuteOnceHandlesLargeJSONResponses(t *testing.T) {
 	if !strings.Contains(result.selection.Value, "PAIR0AUD") {
 		t.Fatalf("expected selector output to include first pair key, got %q", result.selection.Value)
 	}
+	if result.timings.bodyRead == nil || result.timings.selector == nil {
+		t.Fatalf("expected body read and selector timings, got %#v", result.timings)
+	}
 }
 
 func TestExecuteOnceRejectsOversizedResponses(t *testing.T) {
@@ -70,7 +76,7 @@ func TestExecuteOnceRejectsOversizedResponses(t *testing.T) {
 
 	w := &Worker{client: server.Client()}
 	w.maxResponseBodyBytes = DefaultMaxResponseBodyBytes
-	result := w.executeOnce(t.Context(), row)
+	result := w.executeOnce(t.Context(), row, CheckDefaults{})
 
 	if result.success {
 		t.Fatal("expected oversized response to fail")
@@ -103,3 +109,129 @@ func buildPairResponseJSON(count int) []byte {
 
 	return []byte(builder.String())
 }
+
+func TestExecuteOnceHonorsMonitorResponseLimit(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"result":"` + strings.Repeat("a", 64) + `"}`))
+	}))
+	defer server.Close()
+
+	w := &Worker{client: server.Client(), maxResponseBodyBytes: 32}
+	row := &ent.Monitor{
+		Method:       http.MethodGet,
+		URL:          server.URL,
+		ExpectedType: monitor.ExpectedTypeJSON,
+	}
+	if result := w.executeOnce(t.Context(), row, CheckDefaults{}); result.success {
+		t.Fatal("expected response over the worker limit to fail")
+	}
+	if result := w.executeOnce(t.Context(), row, CheckDefaults{MaxResponseBodyBytes: 1024}); !result.success {
+		t.Fatalf("expected the runtime limit to allow the response, got error=%v", result.errorMessage)
+	}
+	result := w.executeOnce(t.Context(), row, CheckDefaults{MaxResponseBodyBytes: 24})
+	if want := "response body exceeds 24 bytes limit (increase the runtime maxResponseBodyBytes setting)"; result.errorMessage == nil || *result.errorMessage != want {
+		t.Fatalf("expected error %q, got %v", want, result.errorMessage)
+	}
+
+	raised := 1024
+	row.MaxResponseBytes = &raised
+	if result := w.executeOnce(t.Context(), row, CheckDefaults{}); !result.success {
+		t.Fatalf("expected monitor limit to allow the response, got error=%v", result.errorMessage)
+	}
+
+	lowered := 16
+	row.MaxResponseBytes = &lowered
+	result = w.executeOnce(t.Context(), row, CheckDefaults{MaxResponseBodyBytes: 1024})
+	want := "response body exceeds 16 bytes limit (increase the monitor's maxResponseBytes)"
+	if result.success || result.errorMessage == nil || *result.errorMessage != want {
+		t.Fatalf("expected error %q, got success=%t error=%v", want, result.success, result.errorMessage)
+	}
+}
+
+func TestPruneHistoryUsesMonitorLimit(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:worker-history-limit?mode=memory&cache=shared&_fk=1")
+	defer client.Close()
+
+	config, err := client.SystemConfig.Create().
+		SetKey(globalConfigKey).
+		SetChecksHistoryLimit(12).
+		Save(t.Context())
+	if err != nil {
+		t.Fatalf("expected system config to save: %v", err)
+	}
+	limited, err := client.Monitor.Create().
+		SetURL("https://example.com/limited").
+		SetCron("*/5 * * * *").
+		SetChecksHistoryLimit(10).
+		Save(t.Context())
+	if err != nil {
+		t.Fatalf("expected monitor to save: %v", err)
+	}
+	global, err := client.Monitor.Create().
+		SetURL("https://example.com/global").
+		SetCron("*/5 * * * *").
+		Save(t.Context())
+	if err != nil {
+		t.Fatalf("expected monitor to save: %v", err)
+	}
+	monitors := []*ent.Monitor{limited, global}
+
+	base := time.Date(2026, time.March, 1, 12, 0, 0, 0, time.UTC)
+	seed := func(from int, count int) {
+		t.Helper()
+		for _, row := range monitors {
+			for index := from; index < from+count; index++ {
+				if _, err := client.CheckResult.Create().
+					SetMonitor(row).
+					SetStatus("ok").
+					SetCheckedAt(base.Add(time.Duration(index) * time.Minute)).
+					Save(t.Context()); err != nil {
+					t.Fatalf("expected check to save: %v", err)
+				}
+			}
+		}
+	}
+	assertKept := func(want map[*ent.Monitor]int) {
+		t.Helper()
+		for row, count := range want {
+			kept, err := client.CheckResult.Query().Where(checkresult.MonitorIDEQ(row.ID)).Count(t.Context())
+			if err != nil {
+				t.Fatalf("expected check count: %v", err)
+			}
+			if kept != count {
+				t.Fatalf("monitor %s: expected %d checks kept, got %d", row.URL, count, kept)
+			}
+		}
+	}
+
+	w := New(client)
+	now := time.Now().UTC()
+	seed(0, 15)
+	if err := w.pruneHistory(t.Context(), config, monitors, now); err != nil {
+		t.Fatalf("expected history to prune: %v", err)
+	}
+	assertKept(map[*ent.Monitor]int{limited: 10, global: 12})
+
+	oldest, err := client.CheckResult.Query().
+		Where(checkresult.MonitorIDEQ(limited.ID)).
+		Order(ent.Asc(checkresult.FieldCheckedAt)).
+		First(t.Context())
+	if err != nil {
+		t.Fatalf("expected oldest check: %v", err)
+	}
+	if !oldest.CheckedAt.Equal(base.Add(5 * time.Minute)) {
+		t.Fatalf("expected the newest checks to be kept, oldest is %s", oldest.CheckedAt)
+	}
+
+	seed(15, 3)
+	if err := w.pruneHistory(t.Context(), config, monitors, now.Add(time.Minute)); err != nil {
+		t.Fatalf("expected history prune to be skipped: %v", err)
+	}
+	assertKept(map[*ent.Monitor]int{limited: 13, global: 15})
+
+	if err := w.pruneHistory(t.Context(), config, monitors, now.Add(historyPruneInterval)); err != nil {
+		t.Fatalf("expected history to prune: %v", err)
+	}
+	assertKept(map[*ent.Monitor]int{limited: 10, global: 12})
+}
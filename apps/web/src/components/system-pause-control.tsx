@@ -0,0 +1,78 @@
+import {
+  getSystemStateOptions,
+  getSystemStateQueryKey,
+  pauseSystemMutation,
+  resumeSystemMutation,
+} from '@goanna/api-client'
+import { useMutation, useQuery, useQueryClient } from '@tanstack/react-query'
+import { Pause, Play } from 'lucide-react'
+import { sileo } from 'sileo'
+
+import { Badge } from '@/components/ui/badge'
+import { Button } from '@/components/ui/button'
+import { getApiErrorMessage } from '@/lib/api'
+
+export function SystemPauseControl() {
+  const queryClient = useQueryClient()
+  const systemStateQuery = useQuery(getSystemStateOptions())
+  const pauseSystem = useMutation(pauseSystemMutation())
+  const resumeSystem = useMutation(resumeSystemMutation())
+
+  const systemState = systemStateQuery.data
+  const saving = pauseSystem.isPending || resumeSystem.isPending
+
+  if (!systemState) {
+    return null
+  }
+
+  async function onToggle() {
+    try {
+      const state = systemState?.paused
+        ? await resumeSystem.mutateAsync({})
+        : await pauseSystem.mutateAsync({ body: {} })
+
+      queryClient.setQueryData(getSystemStateQueryKey(), state)
+    } catch (error) {
+      sileo.error({
+        title: getApiErrorMessage(
+          error,
+          systemState?.paused
+            ? 'Could not resume scheduling.'
+            : 'Could not pause scheduling.',
+        ),
+      })
+    }
+  }
+
+  return (
+    <div className="flex items-center gap-2">
+      {systemState.paused ? (
+        <Badge
+          variant="destructive"
+          title={
+            systemState.pausedAt
+              ? `Paused since ${new Date(systemState.pausedAt).toLocaleString()}`
+              : undefined
+          }
+        >
+          {systemState.notificationsPaused
+            ? 'Scheduling and notifications paused'
+            : 'Scheduling paused'}
+        </Badge>
+      ) : null}
+      <Button
+        variant="outline"
+        size="sm"
+        disabled={saving}
+        onClick={() => void onToggle()}
+      >
+        {systemState.paused ? (
+          <Play className="h-3.5 w-3.5" />
+        ) : (
+          <Pause className="h-3.5 w-3.5" />
+        )}
+        {systemState.paused ? 'Resume' : 'Pause'}
+      </Button>
+    </div>
+  )
+}
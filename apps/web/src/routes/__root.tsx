@@ -15,6 +15,7 @@ import { BellRing, Radar } from 'lucide-react'
 import appCss from '../styles.css?url'
 
 import { RuntimeSettingsRequiredDialog } from '@/components/runtime-settings-required-dialog'
+import { SystemPauseControl } from '@/components/system-pause-control'
 import '@/lib/api'
 
 export const Route = createRootRoute({
@@ -62,6 +63,7 @@ function RootDocument({ children }: { children: React.ReactNode }) {
                   Goanna
                 </div>
                 <nav className="flex items-center gap-2 text-sm">
+                  <SystemPauseControl />
                   <Link
                     to="/"
                     className="rounded-md px-3 py-2 text-muted-foreground transition hover:bg-muted hover:text-foreground"
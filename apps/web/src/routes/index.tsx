@@ -1,6 +1,6 @@
 import {
+  archiveMonitorMutation,
   createMonitorMutation,
-  deleteMonitorMutation,
   listMonitorChecksOptions,
   listMonitorsOptions,
   listMonitorsQueryKey,
@@ -34,7 +34,7 @@ function MonitorsPage() {
   const monitorsQuery = useQuery(listMonitorsOptions())
   const createMonitorRequest = useMutation(createMonitorMutation())
   const triggerMonitorRequest = useMutation(triggerMonitorMutation())
-  const deleteMonitorRequest = useMutation(deleteMonitorMutation())
+  const deleteMonitorRequest = useMutation(archiveMonitorMutation())
   const updateMonitorRequest = useMutation(updateMonitorMutation())
 
   const [error, setError] = useState('')
@@ -19,7 +19,13 @@ const config = defineConfig({
       projects: ['./tsconfig.json'],
     }),
     tailwindcss(),
-    tanstackStart(),
+    // GOANNA_WEB_SPA=true prerenders a static index.html shell so the build
+    // can be embedded into and served by the API binary.
+    tanstackStart(
+      process.env.GOANNA_WEB_SPA === 'true'
+        ? { spa: { enabled: true, prerender: { outputPath: '/index.html' } } }
+        : {},
+    ),
     viteReact(),
   ],
 })
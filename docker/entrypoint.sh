@@ -23,7 +23,19 @@ shutdown() {
 
 trap shutdown INT TERM
 
-/app/bin/goanna-api -addr "$GOANNA_API_ADDR" -dsn "$GOANNA_API_DSN" &
+# The API reads GOANNA_* variables and GOANNA_CONFIG itself. The older
+# GOANNA_API_* names still work and fill in the new ones when those are unset.
+if [ -n "${GOANNA_API_ADDR:-}" ]; then
+  export GOANNA_ADDR="${GOANNA_ADDR:-$GOANNA_API_ADDR}"
+fi
+if [ -n "${GOANNA_API_DB_DRIVER:-}" ]; then
+  export GOANNA_DB_DRIVER="${GOANNA_DB_DRIVER:-$GOANNA_API_DB_DRIVER}"
+fi
+if [ -n "${GOANNA_API_DSN:-}" ]; then
+  export GOANNA_DSN="${GOANNA_DSN:-$GOANNA_API_DSN}"
+fi
+
+/app/bin/goanna-api &
 api_pid=$!
 
 HOST='127.0.0.1' \
@@ -55,6 +55,50 @@ const server = http.createServer((incomingRequest, outgoingResponse) => {
   incomingRequest.pipe(upstreamRequest)
 })
 
+// WebSocket upgrades (such as /v1/ws) bypass the request handler, so they are
+// forwarded here and the two sockets are spliced together once the upstream
+// accepts.
+server.on('upgrade', (incomingRequest, clientSocket, clientHead) => {
+  const requestPath = incomingRequest.url ?? '/'
+  const destination = pickDestination(requestPath)
+  const transport = destination.protocol === 'https:' ? https : http
+
+  const upstreamRequest = transport.request({
+    protocol: destination.protocol,
+    hostname: destination.hostname,
+    port: destination.port,
+    method: incomingRequest.method,
+    path: requestPath,
+    headers: {
+      ...incomingRequest.headers,
+      host: destination.host,
+    },
+  })
+
+  upstreamRequest.on('upgrade', (upstreamResponse, upstreamSocket, upstreamHead) => {
+    clientSocket.write(formatResponseHead(upstreamResponse))
+    if (upstreamHead.length > 0) {
+      clientSocket.write(upstreamHead)
+    }
+    if (clientHead.length > 0) {
+      upstreamSocket.write(clientHead)
+    }
+
+    upstreamSocket.on('error', () => clientSocket.destroy())
+    clientSocket.on('error', () => upstreamSocket.destroy())
+    upstreamSocket.pipe(clientSocket)
+    clientSocket.pipe(upstreamSocket)
+  })
+
+  upstreamRequest.on('response', (upstreamResponse) => {
+    clientSocket.write(formatResponseHead(upstreamResponse))
+    upstreamResponse.pipe(clientSocket)
+  })
+
+  upstreamRequest.on('error', () => clientSocket.destroy())
+  upstreamRequest.end()
+})
+
 server.listen(listenPort, listenHost, () => {
   process.stdout.write(
     `[gateway] listening on http://${listenHost}:${listenPort}; web=${webTarget.origin} api=${apiTarget.origin}\n`,
@@ -64,9 +108,20 @@ server.listen(listenPort, listenHost, () => {
 process.on('SIGINT', () => server.close(() => process.exit(0)))
 process.on('SIGTERM', () => server.close(() => process.exit(0)))
 
+function formatResponseHead(response) {
+  const lines = [
+    `HTTP/1.1 ${response.statusCode ?? 502} ${response.statusMessage ?? ''}`,
+  ]
+  for (let index = 0; index < response.rawHeaders.length; index += 2) {
+    lines.push(`${response.rawHeaders[index]}: ${response.rawHeaders[index + 1]}`)
+  }
+  return `${lines.join('\r\n')}\r\n\r\n`
+}
+
 function pickDestination(path) {
   if (
     path === '/healthz' ||
+    path === '/readyz' ||
     path === '/v1' ||
     path.startsWith('/v1/') ||
     path.startsWith('/v1?')
@@ -1,10 +1,10 @@
 // This file is auto-generated by @hey-api/openapi-ts
 
-import { type DefaultError, queryOptions, type UseMutationOptions } from '@tanstack/react-query';
+import { type DefaultError, type InfiniteData, infiniteQueryOptions, queryOptions, type UseMutationOptions } from '@tanstack/react-query';
 
 import { client } from '../client.gen';
-import { createMonitor, deleteMonitor, getHealth, getRuntimeSettings, getTelegramSettings, listMonitorChecks, listMonitors, type Options, previewMonitorSelector, testMonitorUrl, testTelegramSettings, triggerMonitor, updateMonitor, upsertRuntimeSettings, upsertTelegramSettings } from '../sdk.gen';
-import type { CreateMonitorData, CreateMonitorResponse, DeleteMonitorData, DeleteMonitorResponse, GetHealthData, GetHealthResponse, GetRuntimeSettingsData, GetRuntimeSettingsResponse, GetTelegramSettingsData, GetTelegramSettingsResponse, ListMonitorChecksData, ListMonitorChecksResponse, ListMonitorsData, ListMonitorsResponse, PreviewMonitorSelectorData, PreviewMonitorSelectorResponse, TestMonitorUrlData, TestMonitorUrlResponse, TestTelegramSettingsData, TestTelegramSettingsResponse2, TriggerMonitorData, TriggerMonitorResponse, UpdateMonitorData, UpdateMonitorResponse, UpsertRuntimeSettingsData, UpsertRuntimeSettingsResponse, UpsertTelegramSettingsData, UpsertTelegramSettingsResponse } from '../types.gen';
+import { acknowledgeMonitor, archiveMonitor, backupDatabase, bulkMonitors, cancelExpectMonitorChange, changePassword, clearMonitorCookies, createHeaderProfile, createMonitor, createMonitorFromTest, createUser, createWebSocketTicket, deleteHeaderProfile, deleteMonitor, deleteMonitorCheck, deleteMonitorChecks, deleteUser, diffMonitorChecks, dryRunMonitor, duplicateMonitor, expectMonitorChange, exportMonitors, exportSettings, getAuthStatus, getHealth, getHealthDetails, getMonitorBadge, getMonitorCheck, getMonitorCheckBody, getMonitorCheckNeighbors, getMonitorCookies, getMonitorRollups, getMonitorStats, getReadiness, getRuntimeSettings, getStatusPage, getSystemState, getTag, getTelegramSettings, importMonitorCurl, importMonitorHar, importMonitors, importMonitorUrls, importSettings, listHeaderProfiles, listMonitorChecks, listMonitors, listMonitorStats, listMonitorVersions, listTags, listUsers, login, logout, type Options, pauseSystem, pingHeartbeat, postHeartbeat, previewDiff, previewMonitorSelector, previewStoredMonitorSelector, reorderMonitors, replaceMonitorCookies, restoreMonitor, restoreMonitorVersion, resumeSystem, runMonitor, streamEvents, testMonitorUrl, testProxySettings, testTelegramSettings, triggerMonitor, updateHeaderProfile, updateMonitor, updateUser, upsertRuntimeSettings, upsertTelegramSettings } from '../sdk.gen';
+import type { AcknowledgeMonitorData, AcknowledgeMonitorResponse, ArchiveMonitorData, ArchiveMonitorResponse, BackupDatabaseData, BackupDatabaseResponse, BulkMonitorsData, BulkMonitorsResponse, CancelExpectMonitorChangeData, CancelExpectMonitorChangeResponse, ChangePasswordData, ChangePasswordResponse, ClearMonitorCookiesData, ClearMonitorCookiesResponse, CreateHeaderProfileData, CreateHeaderProfileResponse, CreateMonitorData, CreateMonitorFromTestData, CreateMonitorFromTestResponse, CreateMonitorResponse, CreateUserData, CreateUserResponse, CreateWebSocketTicketData, CreateWebSocketTicketResponse, DeleteHeaderProfileData, DeleteHeaderProfileResponse, DeleteMonitorCheckData, DeleteMonitorCheckResponse, DeleteMonitorChecksData, DeleteMonitorChecksResponse2, DeleteMonitorData, DeleteMonitorResponse, DeleteUserData, DeleteUserResponse, DiffMonitorChecksData, DiffMonitorChecksResponse, DryRunMonitorData, DryRunMonitorResponse2, DuplicateMonitorData, DuplicateMonitorResponse, ExpectMonitorChangeData, ExpectMonitorChangeResponse, ExportMonitorsData, ExportMonitorsResponse, ExportSettingsData, ExportSettingsResponse, GetAuthStatusData, GetAuthStatusResponse, GetHealthData, GetHealthDetailsData, GetHealthDetailsError, GetHealthDetailsResponse, GetHealthResponse, GetMonitorBadgeData, GetMonitorBadgeResponse, GetMonitorCheckBodyData, GetMonitorCheckBodyResponse, GetMonitorCheckData, GetMonitorCheckNeighborsData, GetMonitorCheckNeighborsResponse, GetMonitorCheckResponse, GetMonitorCookiesData, GetMonitorCookiesResponse, GetMonitorRollupsData, GetMonitorRollupsResponse, GetMonitorStatsData, GetMonitorStatsResponse, GetReadinessData, GetReadinessError, GetReadinessResponse, GetRuntimeSettingsData, GetRuntimeSettingsResponse, GetStatusPageData, GetStatusPageResponse, GetSystemStateData, GetSystemStateResponse, GetTagData, GetTagResponse, GetTelegramSettingsData, GetTelegramSettingsResponse, ImportMonitorCurlData, ImportMonitorCurlResponse, ImportMonitorHarData, ImportMonitorHarResponse2, ImportMonitorsData, ImportMonitorsResponse2, ImportMonitorUrlsData, ImportMonitorUrlsResponse2, ImportSettingsData, ImportSettingsResponse2, ListHeaderProfilesData, ListHeaderProfilesResponse, ListMonitorChecksData, ListMonitorChecksResponse, ListMonitorsData, ListMonitorsResponse, ListMonitorStatsData, ListMonitorStatsResponse, ListMonitorVersionsData, ListMonitorVersionsResponse, ListTagsData, ListTagsResponse, ListUsersData, ListUsersResponse, LoginData, LoginResponse2, LogoutData, LogoutResponse, PauseSystemData, PauseSystemResponse, PingHeartbeatData, PingHeartbeatResponse, PostHeartbeatData, PostHeartbeatResponse, PreviewDiffData, PreviewDiffResponse, PreviewMonitorSelectorData, PreviewMonitorSelectorResponse, PreviewStoredMonitorSelectorData, PreviewStoredMonitorSelectorResponse, ReorderMonitorsData, ReorderMonitorsResponse, ReplaceMonitorCookiesData, ReplaceMonitorCookiesResponse, RestoreMonitorData, RestoreMonitorResponse, RestoreMonitorVersionData, RestoreMonitorVersionResponse, ResumeSystemData, ResumeSystemResponse, RunMonitorData, RunMonitorResponse, StreamEventsData, TestMonitorUrlData, TestMonitorUrlResponse, TestProxySettingsData, TestProxySettingsResponse2, TestTelegramSettingsData, TestTelegramSettingsResponse2, TriggerMonitorData, TriggerMonitorResponse, UpdateHeaderProfileData, UpdateHeaderProfileResponse, UpdateMonitorData, UpdateMonitorResponse, UpdateUserData, UpdateUserResponse, UpsertRuntimeSettingsData, UpsertRuntimeSettingsResponse, UpsertTelegramSettingsData, UpsertTelegramSettingsResponse } from '../types.gen';
 
 export type QueryKey<TOptions extends Options> = [
     Pick<TOptions, 'baseUrl' | 'body' | 'headers' | 'path' | 'query'> & {
@@ -57,10 +57,52 @@ export const getHealthOptions = (options?: Options<GetHealthData>) => queryOptio
     queryKey: getHealthQueryKey(options)
 });
 
+export const getReadinessQueryKey = (options?: Options<GetReadinessData>) => createQueryKey('getReadiness', options);
+
+/**
+ * Readiness check
+ *
+ * Answers 200 once the database schema is migrated and the worker has completed its first scheduling pass. Use /healthz for liveness.
+ */
+export const getReadinessOptions = (options?: Options<GetReadinessData>) => queryOptions<GetReadinessResponse, GetReadinessError, GetReadinessResponse, ReturnType<typeof getReadinessQueryKey>>({
+    queryFn: async ({ queryKey, signal }) => {
+        const { data } = await getReadiness({
+            ...options,
+            ...queryKey[0],
+            signal,
+            throwOnError: true
+        });
+        return data;
+    },
+    queryKey: getReadinessQueryKey(options)
+});
+
+export const getHealthDetailsQueryKey = (options?: Options<GetHealthDetailsData>) => createQueryKey('getHealthDetails', options);
+
+/**
+ * Check the database, worker and schema
+ *
+ * The worker is unhealthy when its scheduler has not run a pass for three minutes. Migrations are unhealthy when migrations of this build have not been applied. Component messages only include the underlying errors while the API is open or for signed-in callers.
+ */
+export const getHealthDetailsOptions = (options?: Options<GetHealthDetailsData>) => queryOptions<GetHealthDetailsResponse, GetHealthDetailsError, GetHealthDetailsResponse, ReturnType<typeof getHealthDetailsQueryKey>>({
+    queryFn: async ({ queryKey, signal }) => {
+        const { data } = await getHealthDetails({
+            ...options,
+            ...queryKey[0],
+            signal,
+            throwOnError: true
+        });
+        return data;
+    },
+    queryKey: getHealthDetailsQueryKey(options)
+});
+
 export const listMonitorsQueryKey = (options?: Options<ListMonitorsData>) => createQueryKey('listMonitors', options);
 
 /**
  * List configured monitors
+ *
+ * Monitors are listed in the order set with PUT /v1/monitors/order; new monitors are added last.
  */
 export const listMonitorsOptions = (options?: Options<ListMonitorsData>) => queryOptions<ListMonitorsResponse, DefaultError, ListMonitorsResponse, ReturnType<typeof listMonitorsQueryKey>>({
     queryFn: async ({ queryKey, signal }) => {
@@ -93,12 +135,14 @@ export const createMonitorMutation = (options?: Partial<Options<CreateMonitorDat
 };
 
 /**
- * Delete monitor
+ * Archive monitor
+ *
+ * Hides the monitor from listings and stops scheduling it while keeping its history. Use /permanent to delete it for good.
  */
-export const deleteMonitorMutation = (options?: Partial<Options<DeleteMonitorData>>): UseMutationOptions<DeleteMonitorResponse, DefaultError, Options<DeleteMonitorData>> => {
-    const mutationOptions: UseMutationOptions<DeleteMonitorResponse, DefaultError, Options<DeleteMonitorData>> = {
+export const archiveMonitorMutation = (options?: Partial<Options<ArchiveMonitorData>>): UseMutationOptions<ArchiveMonitorResponse, DefaultError, Options<ArchiveMonitorData>> => {
+    const mutationOptions: UseMutationOptions<ArchiveMonitorResponse, DefaultError, Options<ArchiveMonitorData>> = {
         mutationFn: async (fnOptions) => {
-            const { data } = await deleteMonitor({
+            const { data } = await archiveMonitor({
                 ...options,
                 ...fnOptions,
                 throwOnError: true
@@ -126,6 +170,44 @@ export const updateMonitorMutation = (options?: Partial<Options<UpdateMonitorDat
     return mutationOptions;
 };
 
+/**
+ * Restore an archived monitor
+ *
+ * Enabled monitors are scheduled again from their cron expression.
+ */
+export const restoreMonitorMutation = (options?: Partial<Options<RestoreMonitorData>>): UseMutationOptions<RestoreMonitorResponse, DefaultError, Options<RestoreMonitorData>> => {
+    const mutationOptions: UseMutationOptions<RestoreMonitorResponse, DefaultError, Options<RestoreMonitorData>> = {
+        mutationFn: async (fnOptions) => {
+            const { data } = await restoreMonitor({
+                ...options,
+                ...fnOptions,
+                throwOnError: true
+            });
+            return data;
+        }
+    };
+    return mutationOptions;
+};
+
+/**
+ * Permanently delete monitor
+ *
+ * Removes the monitor with its checks, notification events and runtime. This cannot be undone.
+ */
+export const deleteMonitorMutation = (options?: Partial<Options<DeleteMonitorData>>): UseMutationOptions<DeleteMonitorResponse, DefaultError, Options<DeleteMonitorData>> => {
+    const mutationOptions: UseMutationOptions<DeleteMonitorResponse, DefaultError, Options<DeleteMonitorData>> = {
+        mutationFn: async (fnOptions) => {
+            const { data } = await deleteMonitor({
+                ...options,
+                ...fnOptions,
+                throwOnError: true
+            });
+            return data;
+        }
+    };
+    return mutationOptions;
+};
+
 /**
  * Trigger monitor to run immediately
  */
@@ -144,12 +226,12 @@ export const triggerMonitorMutation = (options?: Partial<Options<TriggerMonitorD
 };
 
 /**
- * Test monitor URL via backend
+ * Run a monitor check immediately and return its result
  */
-export const testMonitorUrlMutation = (options?: Partial<Options<TestMonitorUrlData>>): UseMutationOptions<TestMonitorUrlResponse, DefaultError, Options<TestMonitorUrlData>> => {
-    const mutationOptions: UseMutationOptions<TestMonitorUrlResponse, DefaultError, Options<TestMonitorUrlData>> = {
+export const runMonitorMutation = (options?: Partial<Options<RunMonitorData>>): UseMutationOptions<RunMonitorResponse, DefaultError, Options<RunMonitorData>> => {
+    const mutationOptions: UseMutationOptions<RunMonitorResponse, DefaultError, Options<RunMonitorData>> = {
         mutationFn: async (fnOptions) => {
-            const { data } = await testMonitorUrl({
+            const { data } = await runMonitor({
                 ...options,
                 ...fnOptions,
                 throwOnError: true
@@ -160,13 +242,31 @@ export const testMonitorUrlMutation = (options?: Partial<Options<TestMonitorUrlD
     return mutationOptions;
 };
 
+export const getMonitorCookiesQueryKey = (options: Options<GetMonitorCookiesData>) => createQueryKey('getMonitorCookies', options);
+
 /**
- * Preview a gjson selector against JSON
+ * List the cookies a monitor with a cookie jar sends
  */
-export const previewMonitorSelectorMutation = (options?: Partial<Options<PreviewMonitorSelectorData>>): UseMutationOptions<PreviewMonitorSelectorResponse, DefaultError, Options<PreviewMonitorSelectorData>> => {
-    const mutationOptions: UseMutationOptions<PreviewMonitorSelectorResponse, DefaultError, Options<PreviewMonitorSelectorData>> = {
+export const getMonitorCookiesOptions = (options: Options<GetMonitorCookiesData>) => queryOptions<GetMonitorCookiesResponse, DefaultError, GetMonitorCookiesResponse, ReturnType<typeof getMonitorCookiesQueryKey>>({
+    queryFn: async ({ queryKey, signal }) => {
+        const { data } = await getMonitorCookies({
+            ...options,
+            ...queryKey[0],
+            signal,
+            throwOnError: true
+        });
+        return data;
+    },
+    queryKey: getMonitorCookiesQueryKey(options)
+});
+
+/**
+ * Seed a monitor's cookie jar, replacing its stored cookies
+ */
+export const replaceMonitorCookiesMutation = (options?: Partial<Options<ReplaceMonitorCookiesData>>): UseMutationOptions<ReplaceMonitorCookiesResponse, DefaultError, Options<ReplaceMonitorCookiesData>> => {
+    const mutationOptions: UseMutationOptions<ReplaceMonitorCookiesResponse, DefaultError, Options<ReplaceMonitorCookiesData>> = {
         mutationFn: async (fnOptions) => {
-            const { data } = await previewMonitorSelector({
+            const { data } = await replaceMonitorCookies({
                 ...options,
                 ...fnOptions,
                 throwOnError: true
@@ -177,14 +277,69 @@ export const previewMonitorSelectorMutation = (options?: Partial<Options<Preview
     return mutationOptions;
 };
 
-export const getTelegramSettingsQueryKey = (options?: Options<GetTelegramSettingsData>) => createQueryKey('getTelegramSettings', options);
+/**
+ * Clear a monitor's stored cookies
+ */
+export const clearMonitorCookiesMutation = (options?: Partial<Options<ClearMonitorCookiesData>>): UseMutationOptions<ClearMonitorCookiesResponse, DefaultError, Options<ClearMonitorCookiesData>> => {
+    const mutationOptions: UseMutationOptions<ClearMonitorCookiesResponse, DefaultError, Options<ClearMonitorCookiesData>> = {
+        mutationFn: async (fnOptions) => {
+            const { data } = await clearMonitorCookies({
+                ...options,
+                ...fnOptions,
+                throwOnError: true
+            });
+            return data;
+        }
+    };
+    return mutationOptions;
+};
 
 /**
- * Get Telegram notification channel settings
+ * Acknowledge a failing monitor to stop escalation
  */
-export const getTelegramSettingsOptions = (options?: Options<GetTelegramSettingsData>) => queryOptions<GetTelegramSettingsResponse, DefaultError, GetTelegramSettingsResponse, ReturnType<typeof getTelegramSettingsQueryKey>>({
+export const acknowledgeMonitorMutation = (options?: Partial<Options<AcknowledgeMonitorData>>): UseMutationOptions<AcknowledgeMonitorResponse, DefaultError, Options<AcknowledgeMonitorData>> => {
+    const mutationOptions: UseMutationOptions<AcknowledgeMonitorResponse, DefaultError, Options<AcknowledgeMonitorData>> = {
+        mutationFn: async (fnOptions) => {
+            const { data } = await acknowledgeMonitor({
+                ...options,
+                ...fnOptions,
+                throwOnError: true
+            });
+            return data;
+        }
+    };
+    return mutationOptions;
+};
+
+/**
+ * Copy a monitor's configuration into a new disabled monitor
+ *
+ * The copy's label gets a " (copy)" suffix. Checks and runtime state are not copied, and heartbeat monitors get a new ping URL.
+ */
+export const duplicateMonitorMutation = (options?: Partial<Options<DuplicateMonitorData>>): UseMutationOptions<DuplicateMonitorResponse, DefaultError, Options<DuplicateMonitorData>> => {
+    const mutationOptions: UseMutationOptions<DuplicateMonitorResponse, DefaultError, Options<DuplicateMonitorData>> = {
+        mutationFn: async (fnOptions) => {
+            const { data } = await duplicateMonitor({
+                ...options,
+                ...fnOptions,
+                throwOnError: true
+            });
+            return data;
+        }
+    };
+    return mutationOptions;
+};
+
+export const listMonitorVersionsQueryKey = (options: Options<ListMonitorVersionsData>) => createQueryKey('listMonitorVersions', options);
+
+/**
+ * List a monitor's configuration history, newest first
+ *
+ * A version is recorded whenever the monitor is created or its configuration changes. The latest 50 are kept.
+ */
+export const listMonitorVersionsOptions = (options: Options<ListMonitorVersionsData>) => queryOptions<ListMonitorVersionsResponse, DefaultError, ListMonitorVersionsResponse, ReturnType<typeof listMonitorVersionsQueryKey>>({
     queryFn: async ({ queryKey, signal }) => {
-        const { data } = await getTelegramSettings({
+        const { data } = await listMonitorVersions({
             ...options,
             ...queryKey[0],
             signal,
@@ -192,16 +347,18 @@ export const getTelegramSettingsOptions = (options?: Options<GetTelegramSettings
         });
         return data;
     },
-    queryKey: getTelegramSettingsQueryKey(options)
+    queryKey: listMonitorVersionsQueryKey(options)
 });
 
 /**
- * Create or update Telegram notification channel settings
+ * Restore a monitor's configuration from an earlier version
+ *
+ * The restored configuration is recorded as a new version.
  */
-export const upsertTelegramSettingsMutation = (options?: Partial<Options<UpsertTelegramSettingsData>>): UseMutationOptions<UpsertTelegramSettingsResponse, DefaultError, Options<UpsertTelegramSettingsData>> => {
-    const mutationOptions: UseMutationOptions<UpsertTelegramSettingsResponse, DefaultError, Options<UpsertTelegramSettingsData>> = {
+export const restoreMonitorVersionMutation = (options?: Partial<Options<RestoreMonitorVersionData>>): UseMutationOptions<RestoreMonitorVersionResponse, DefaultError, Options<RestoreMonitorVersionData>> => {
+    const mutationOptions: UseMutationOptions<RestoreMonitorVersionResponse, DefaultError, Options<RestoreMonitorVersionData>> = {
         mutationFn: async (fnOptions) => {
-            const { data } = await upsertTelegramSettings({
+            const { data } = await restoreMonitorVersion({
                 ...options,
                 ...fnOptions,
                 throwOnError: true
@@ -213,12 +370,12 @@ export const upsertTelegramSettingsMutation = (options?: Partial<Options<UpsertT
 };
 
 /**
- * Send a test Telegram notification
+ * Pre-authorize the next change so it is recorded as the new baseline without alerting
  */
-export const testTelegramSettingsMutation = (options?: Partial<Options<TestTelegramSettingsData>>): UseMutationOptions<TestTelegramSettingsResponse2, DefaultError, Options<TestTelegramSettingsData>> => {
-    const mutationOptions: UseMutationOptions<TestTelegramSettingsResponse2, DefaultError, Options<TestTelegramSettingsData>> = {
+export const expectMonitorChangeMutation = (options?: Partial<Options<ExpectMonitorChangeData>>): UseMutationOptions<ExpectMonitorChangeResponse, DefaultError, Options<ExpectMonitorChangeData>> => {
+    const mutationOptions: UseMutationOptions<ExpectMonitorChangeResponse, DefaultError, Options<ExpectMonitorChangeData>> = {
         mutationFn: async (fnOptions) => {
-            const { data } = await testTelegramSettings({
+            const { data } = await expectMonitorChange({
                 ...options,
                 ...fnOptions,
                 throwOnError: true
@@ -229,14 +386,31 @@ export const testTelegramSettingsMutation = (options?: Partial<Options<TestTeleg
     return mutationOptions;
 };
 
-export const getRuntimeSettingsQueryKey = (options?: Options<GetRuntimeSettingsData>) => createQueryKey('getRuntimeSettings', options);
+/**
+ * Cancel a pending expected change window
+ */
+export const cancelExpectMonitorChangeMutation = (options?: Partial<Options<CancelExpectMonitorChangeData>>): UseMutationOptions<CancelExpectMonitorChangeResponse, DefaultError, Options<CancelExpectMonitorChangeData>> => {
+    const mutationOptions: UseMutationOptions<CancelExpectMonitorChangeResponse, DefaultError, Options<CancelExpectMonitorChangeData>> = {
+        mutationFn: async (fnOptions) => {
+            const { data } = await cancelExpectMonitorChange({
+                ...options,
+                ...fnOptions,
+                throwOnError: true
+            });
+            return data;
+        }
+    };
+    return mutationOptions;
+};
+
+export const listMonitorStatsQueryKey = (options?: Options<ListMonitorStatsData>) => createQueryKey('listMonitorStats', options);
 
 /**
- * Get global runtime settings
+ * List change frequency and performance stats for all monitors
  */
-export const getRuntimeSettingsOptions = (options?: Options<GetRuntimeSettingsData>) => queryOptions<GetRuntimeSettingsResponse, DefaultError, GetRuntimeSettingsResponse, ReturnType<typeof getRuntimeSettingsQueryKey>>({
+export const listMonitorStatsOptions = (options?: Options<ListMonitorStatsData>) => queryOptions<ListMonitorStatsResponse, DefaultError, ListMonitorStatsResponse, ReturnType<typeof listMonitorStatsQueryKey>>({
     queryFn: async ({ queryKey, signal }) => {
-        const { data } = await getRuntimeSettings({
+        const { data } = await listMonitorStats({
             ...options,
             ...queryKey[0],
             signal,
@@ -244,16 +418,76 @@ export const getRuntimeSettingsOptions = (options?: Options<GetRuntimeSettingsDa
         });
         return data;
     },
-    queryKey: getRuntimeSettingsQueryKey(options)
+    queryKey: listMonitorStatsQueryKey(options)
 });
 
+export const getMonitorStatsQueryKey = (options: Options<GetMonitorStatsData>) => createQueryKey('getMonitorStats', options);
+
 /**
- * Update global runtime settings
+ * Get change frequency, uptime and latency stats for a monitor
  */
-export const upsertRuntimeSettingsMutation = (options?: Partial<Options<UpsertRuntimeSettingsData>>): UseMutationOptions<UpsertRuntimeSettingsResponse, DefaultError, Options<UpsertRuntimeSettingsData>> => {
-    const mutationOptions: UseMutationOptions<UpsertRuntimeSettingsResponse, DefaultError, Options<UpsertRuntimeSettingsData>> = {
+export const getMonitorStatsOptions = (options: Options<GetMonitorStatsData>) => queryOptions<GetMonitorStatsResponse, DefaultError, GetMonitorStatsResponse, ReturnType<typeof getMonitorStatsQueryKey>>({
+    queryFn: async ({ queryKey, signal }) => {
+        const { data } = await getMonitorStats({
+            ...options,
+            ...queryKey[0],
+            signal,
+            throwOnError: true
+        });
+        return data;
+    },
+    queryKey: getMonitorStatsQueryKey(options)
+});
+
+export const getMonitorRollupsQueryKey = (options: Options<GetMonitorRollupsData>) => createQueryKey('getMonitorRollups', options);
+
+/**
+ * Get hourly or daily check rollups for a monitor
+ *
+ * Rollups are recorded by the worker for every check and outlive the raw check history. Hourly rollups are kept for 90 days, daily rollups for the monitor's lifetime.
+ */
+export const getMonitorRollupsOptions = (options: Options<GetMonitorRollupsData>) => queryOptions<GetMonitorRollupsResponse, DefaultError, GetMonitorRollupsResponse, ReturnType<typeof getMonitorRollupsQueryKey>>({
+    queryFn: async ({ queryKey, signal }) => {
+        const { data } = await getMonitorRollups({
+            ...options,
+            ...queryKey[0],
+            signal,
+            throwOnError: true
+        });
+        return data;
+    },
+    queryKey: getMonitorRollupsQueryKey(options)
+});
+
+export const getMonitorBadgeQueryKey = (options: Options<GetMonitorBadgeData>) => createQueryKey('getMonitorBadge', options);
+
+/**
+ * Render a shields-style SVG badge for a monitor
+ *
+ * Public for enabled, unarchived monitors with statusPage enabled, so badges can be embedded in READMEs and wikis; other monitors, and value badges of any monitor, need authentication.
+ */
+export const getMonitorBadgeOptions = (options: Options<GetMonitorBadgeData>) => queryOptions<GetMonitorBadgeResponse, DefaultError, GetMonitorBadgeResponse, ReturnType<typeof getMonitorBadgeQueryKey>>({
+    queryFn: async ({ queryKey, signal }) => {
+        const { data } = await getMonitorBadge({
+            ...options,
+            ...queryKey[0],
+            signal,
+            throwOnError: true
+        });
+        return data;
+    },
+    queryKey: getMonitorBadgeQueryKey(options)
+});
+
+/**
+ * Enable, disable, delete or trigger several monitors
+ *
+ * Enable, disable and delete are applied in one transaction. Trigger runs each check in turn and reports every outcome.
+ */
+export const bulkMonitorsMutation = (options?: Partial<Options<BulkMonitorsData>>): UseMutationOptions<BulkMonitorsResponse, DefaultError, Options<BulkMonitorsData>> => {
+    const mutationOptions: UseMutationOptions<BulkMonitorsResponse, DefaultError, Options<BulkMonitorsData>> = {
         mutationFn: async (fnOptions) => {
-            const { data } = await upsertRuntimeSettings({
+            const { data } = await bulkMonitors({
                 ...options,
                 ...fnOptions,
                 throwOnError: true
@@ -264,14 +498,35 @@ export const upsertRuntimeSettingsMutation = (options?: Partial<Options<UpsertRu
     return mutationOptions;
 };
 
-export const listMonitorChecksQueryKey = (options: Options<ListMonitorChecksData>) => createQueryKey('listMonitorChecks', options);
+/**
+ * Set the order monitors are listed in
+ *
+ * The listed monitors come first, in the order given. Monitors left out keep their relative order after them.
+ */
+export const reorderMonitorsMutation = (options?: Partial<Options<ReorderMonitorsData>>): UseMutationOptions<ReorderMonitorsResponse, DefaultError, Options<ReorderMonitorsData>> => {
+    const mutationOptions: UseMutationOptions<ReorderMonitorsResponse, DefaultError, Options<ReorderMonitorsData>> = {
+        mutationFn: async (fnOptions) => {
+            const { data } = await reorderMonitors({
+                ...options,
+                ...fnOptions,
+                throwOnError: true
+            });
+            return data;
+        }
+    };
+    return mutationOptions;
+};
+
+export const exportMonitorsQueryKey = (options?: Options<ExportMonitorsData>) => createQueryKey('exportMonitors', options);
 
 /**
- * List recent checks for a monitor
+ * Export monitor configurations for version control or migration
+ *
+ * Check history and runtime state are not exported. headerProfileId refers to header profiles on the exporting instance.
  */
-export const listMonitorChecksOptions = (options: Options<ListMonitorChecksData>) => queryOptions<ListMonitorChecksResponse, DefaultError, ListMonitorChecksResponse, ReturnType<typeof listMonitorChecksQueryKey>>({
+export const exportMonitorsOptions = (options?: Options<ExportMonitorsData>) => queryOptions<ExportMonitorsResponse, DefaultError, ExportMonitorsResponse, ReturnType<typeof exportMonitorsQueryKey>>({
     queryFn: async ({ queryKey, signal }) => {
-        const { data } = await listMonitorChecks({
+        const { data } = await exportMonitors({
             ...options,
             ...queryKey[0],
             signal,
@@ -279,5 +534,931 @@ export const listMonitorChecksOptions = (options: Options<ListMonitorChecksData>
         });
         return data;
     },
-    queryKey: listMonitorChecksQueryKey(options)
+    queryKey: exportMonitorsQueryKey(options)
 });
+
+/**
+ * Create or update monitors from an export document
+ *
+ * An imported monitor conflicts with an existing monitor that has the same label, or the same URL when unlabelled. Every entry is validated before anything is saved.
+ */
+export const importMonitorsMutation = (options?: Partial<Options<ImportMonitorsData>>): UseMutationOptions<ImportMonitorsResponse2, DefaultError, Options<ImportMonitorsData>> => {
+    const mutationOptions: UseMutationOptions<ImportMonitorsResponse2, DefaultError, Options<ImportMonitorsData>> = {
+        mutationFn: async (fnOptions) => {
+            const { data } = await importMonitors({
+                ...options,
+                ...fnOptions,
+                throwOnError: true
+            });
+            return data;
+        }
+    };
+    return mutationOptions;
+};
+
+/**
+ * Create basic monitors from a newline-separated URL list
+ */
+export const importMonitorUrlsMutation = (options?: Partial<Options<ImportMonitorUrlsData>>): UseMutationOptions<ImportMonitorUrlsResponse2, DefaultError, Options<ImportMonitorUrlsData>> => {
+    const mutationOptions: UseMutationOptions<ImportMonitorUrlsResponse2, DefaultError, Options<ImportMonitorUrlsData>> = {
+        mutationFn: async (fnOptions) => {
+            const { data } = await importMonitorUrls({
+                ...options,
+                ...fnOptions,
+                throwOnError: true
+            });
+            return data;
+        }
+    };
+    return mutationOptions;
+};
+
+/**
+ * Read a curl command into a monitor draft
+ *
+ * Reads the method, URL, -H headers, -d body and -u auth of a pasted curl command. An "Authorization: Bearer" header becomes bearer auth. Nothing is saved; add a cron to the draft and send it to POST /v1/monitors.
+ */
+export const importMonitorCurlMutation = (options?: Partial<Options<ImportMonitorCurlData>>): UseMutationOptions<ImportMonitorCurlResponse, DefaultError, Options<ImportMonitorCurlData>> => {
+    const mutationOptions: UseMutationOptions<ImportMonitorCurlResponse, DefaultError, Options<ImportMonitorCurlData>> = {
+        mutationFn: async (fnOptions) => {
+            const { data } = await importMonitorCurl({
+                ...options,
+                ...fnOptions,
+                throwOnError: true
+            });
+            return data;
+        }
+    };
+    return mutationOptions;
+};
+
+/**
+ * List the requests of a HAR export and create monitors from picked ones
+ *
+ * Lists every http(s) request of the export as a monitor draft that keeps its method, headers and body; transport headers and HTTP/2 pseudo-headers are dropped and a bearer Authorization header becomes auth. Requests picked with entry are created as monitors.
+ */
+export const importMonitorHarMutation = (options?: Partial<Options<ImportMonitorHarData>>): UseMutationOptions<ImportMonitorHarResponse2, DefaultError, Options<ImportMonitorHarData>> => {
+    const mutationOptions: UseMutationOptions<ImportMonitorHarResponse2, DefaultError, Options<ImportMonitorHarData>> = {
+        mutationFn: async (fnOptions) => {
+            const { data } = await importMonitorHar({
+                ...options,
+                ...fnOptions,
+                throwOnError: true
+            });
+            return data;
+        }
+    };
+    return mutationOptions;
+};
+
+/**
+ * Test monitor URL via backend
+ */
+export const testMonitorUrlMutation = (options?: Partial<Options<TestMonitorUrlData>>): UseMutationOptions<TestMonitorUrlResponse, DefaultError, Options<TestMonitorUrlData>> => {
+    const mutationOptions: UseMutationOptions<TestMonitorUrlResponse, DefaultError, Options<TestMonitorUrlData>> = {
+        mutationFn: async (fnOptions) => {
+            const { data } = await testMonitorUrl({
+                ...options,
+                ...fnOptions,
+                throwOnError: true
+            });
+            return data;
+        }
+    };
+    return mutationOptions;
+};
+
+/**
+ * Run one check of a monitor config without saving it
+ *
+ * Runs the full check pipeline, including expectedType, selector and expectedResponse, with the current runtime settings. With monitorId, the result also carries the diff the check would record against that monitor's latest stored check. Retries are skipped and nothing is saved.
+ */
+export const dryRunMonitorMutation = (options?: Partial<Options<DryRunMonitorData>>): UseMutationOptions<DryRunMonitorResponse2, DefaultError, Options<DryRunMonitorData>> => {
+    const mutationOptions: UseMutationOptions<DryRunMonitorResponse2, DefaultError, Options<DryRunMonitorData>> = {
+        mutationFn: async (fnOptions) => {
+            const { data } = await dryRunMonitor({
+                ...options,
+                ...fnOptions,
+                throwOnError: true
+            });
+            return data;
+        }
+    };
+    return mutationOptions;
+};
+
+/**
+ * Create a JSON monitor from a tested request
+ *
+ * Saves the request of a monitor test with a cron, label and selector. With selectorPayloadToken from the test, the selector must match something in the tested response.
+ */
+export const createMonitorFromTestMutation = (options?: Partial<Options<CreateMonitorFromTestData>>): UseMutationOptions<CreateMonitorFromTestResponse, DefaultError, Options<CreateMonitorFromTestData>> => {
+    const mutationOptions: UseMutationOptions<CreateMonitorFromTestResponse, DefaultError, Options<CreateMonitorFromTestData>> = {
+        mutationFn: async (fnOptions) => {
+            const { data } = await createMonitorFromTest({
+                ...options,
+                ...fnOptions,
+                throwOnError: true
+            });
+            return data;
+        }
+    };
+    return mutationOptions;
+};
+
+/**
+ * Preview a gjson selector against JSON
+ */
+export const previewMonitorSelectorMutation = (options?: Partial<Options<PreviewMonitorSelectorData>>): UseMutationOptions<PreviewMonitorSelectorResponse, DefaultError, Options<PreviewMonitorSelectorData>> => {
+    const mutationOptions: UseMutationOptions<PreviewMonitorSelectorResponse, DefaultError, Options<PreviewMonitorSelectorData>> = {
+        mutationFn: async (fnOptions) => {
+            const { data } = await previewMonitorSelector({
+                ...options,
+                ...fnOptions,
+                throwOnError: true
+            });
+            return data;
+        }
+    };
+    return mutationOptions;
+};
+
+/**
+ * Preview the diff a JSON monitor would report between two payloads
+ *
+ * Applies the selector to both payloads and diffs the selections with default diff options, as a JSON monitor would when its response changes from previous to current.
+ */
+export const previewDiffMutation = (options?: Partial<Options<PreviewDiffData>>): UseMutationOptions<PreviewDiffResponse, DefaultError, Options<PreviewDiffData>> => {
+    const mutationOptions: UseMutationOptions<PreviewDiffResponse, DefaultError, Options<PreviewDiffData>> = {
+        mutationFn: async (fnOptions) => {
+            const { data } = await previewDiff({
+                ...options,
+                ...fnOptions,
+                throwOnError: true
+            });
+            return data;
+        }
+    };
+    return mutationOptions;
+};
+
+export const pingHeartbeatQueryKey = (options: Options<PingHeartbeatData>) => createQueryKey('pingHeartbeat', options);
+
+/**
+ * Record a heartbeat ping from an external job
+ */
+export const pingHeartbeatOptions = (options: Options<PingHeartbeatData>) => queryOptions<PingHeartbeatResponse, DefaultError, PingHeartbeatResponse, ReturnType<typeof pingHeartbeatQueryKey>>({
+    queryFn: async ({ queryKey, signal }) => {
+        const { data } = await pingHeartbeat({
+            ...options,
+            ...queryKey[0],
+            signal,
+            throwOnError: true
+        });
+        return data;
+    },
+    queryKey: pingHeartbeatQueryKey(options)
+});
+
+/**
+ * Record a heartbeat ping from an external job
+ */
+export const postHeartbeatMutation = (options?: Partial<Options<PostHeartbeatData>>): UseMutationOptions<PostHeartbeatResponse, DefaultError, Options<PostHeartbeatData>> => {
+    const mutationOptions: UseMutationOptions<PostHeartbeatResponse, DefaultError, Options<PostHeartbeatData>> = {
+        mutationFn: async (fnOptions) => {
+            const { data } = await postHeartbeat({
+                ...options,
+                ...fnOptions,
+                throwOnError: true
+            });
+            return data;
+        }
+    };
+    return mutationOptions;
+};
+
+export const getStatusPageQueryKey = (options?: Options<GetStatusPageData>) => createQueryKey('getStatusPage', options);
+
+/**
+ * Get the public status page
+ *
+ * Lists enabled monitors marked statusPage with their current status and recent uptime. Monitor URLs and configuration are not included.
+ */
+export const getStatusPageOptions = (options?: Options<GetStatusPageData>) => queryOptions<GetStatusPageResponse, DefaultError, GetStatusPageResponse, ReturnType<typeof getStatusPageQueryKey>>({
+    queryFn: async ({ queryKey, signal }) => {
+        const { data } = await getStatusPage({
+            ...options,
+            ...queryKey[0],
+            signal,
+            throwOnError: true
+        });
+        return data;
+    },
+    queryKey: getStatusPageQueryKey(options)
+});
+
+export const listTagsQueryKey = (options?: Options<ListTagsData>) => createQueryKey('listTags', options);
+
+/**
+ * Summarize monitors by tag
+ */
+export const listTagsOptions = (options?: Options<ListTagsData>) => queryOptions<ListTagsResponse, DefaultError, ListTagsResponse, ReturnType<typeof listTagsQueryKey>>({
+    queryFn: async ({ queryKey, signal }) => {
+        const { data } = await listTags({
+            ...options,
+            ...queryKey[0],
+            signal,
+            throwOnError: true
+        });
+        return data;
+    },
+    queryKey: listTagsQueryKey(options)
+});
+
+export const getTagQueryKey = (options: Options<GetTagData>) => createQueryKey('getTag', options);
+
+/**
+ * Summarize the monitors with a tag
+ */
+export const getTagOptions = (options: Options<GetTagData>) => queryOptions<GetTagResponse, DefaultError, GetTagResponse, ReturnType<typeof getTagQueryKey>>({
+    queryFn: async ({ queryKey, signal }) => {
+        const { data } = await getTag({
+            ...options,
+            ...queryKey[0],
+            signal,
+            throwOnError: true
+        });
+        return data;
+    },
+    queryKey: getTagQueryKey(options)
+});
+
+export const listHeaderProfilesQueryKey = (options?: Options<ListHeaderProfilesData>) => createQueryKey('listHeaderProfiles', options);
+
+/**
+ * List header profiles
+ */
+export const listHeaderProfilesOptions = (options?: Options<ListHeaderProfilesData>) => queryOptions<ListHeaderProfilesResponse, DefaultError, ListHeaderProfilesResponse, ReturnType<typeof listHeaderProfilesQueryKey>>({
+    queryFn: async ({ queryKey, signal }) => {
+        const { data } = await listHeaderProfiles({
+            ...options,
+            ...queryKey[0],
+            signal,
+            throwOnError: true
+        });
+        return data;
+    },
+    queryKey: listHeaderProfilesQueryKey(options)
+});
+
+/**
+ * Create a named set of headers monitors can reference
+ */
+export const createHeaderProfileMutation = (options?: Partial<Options<CreateHeaderProfileData>>): UseMutationOptions<CreateHeaderProfileResponse, DefaultError, Options<CreateHeaderProfileData>> => {
+    const mutationOptions: UseMutationOptions<CreateHeaderProfileResponse, DefaultError, Options<CreateHeaderProfileData>> = {
+        mutationFn: async (fnOptions) => {
+            const { data } = await createHeaderProfile({
+                ...options,
+                ...fnOptions,
+                throwOnError: true
+            });
+            return data;
+        }
+    };
+    return mutationOptions;
+};
+
+/**
+ * Update a header profile
+ */
+export const updateHeaderProfileMutation = (options?: Partial<Options<UpdateHeaderProfileData>>): UseMutationOptions<UpdateHeaderProfileResponse, DefaultError, Options<UpdateHeaderProfileData>> => {
+    const mutationOptions: UseMutationOptions<UpdateHeaderProfileResponse, DefaultError, Options<UpdateHeaderProfileData>> = {
+        mutationFn: async (fnOptions) => {
+            const { data } = await updateHeaderProfile({
+                ...options,
+                ...fnOptions,
+                throwOnError: true
+            });
+            return data;
+        }
+    };
+    return mutationOptions;
+};
+
+/**
+ * Delete a header profile that no monitor references
+ */
+export const deleteHeaderProfileMutation = (options?: Partial<Options<DeleteHeaderProfileData>>): UseMutationOptions<DeleteHeaderProfileResponse, DefaultError, Options<DeleteHeaderProfileData>> => {
+    const mutationOptions: UseMutationOptions<DeleteHeaderProfileResponse, DefaultError, Options<DeleteHeaderProfileData>> = {
+        mutationFn: async (fnOptions) => {
+            const { data } = await deleteHeaderProfile({
+                ...options,
+                ...fnOptions,
+                throwOnError: true
+            });
+            return data;
+        }
+    };
+    return mutationOptions;
+};
+
+export const getTelegramSettingsQueryKey = (options?: Options<GetTelegramSettingsData>) => createQueryKey('getTelegramSettings', options);
+
+/**
+ * Get Telegram notification channel settings
+ */
+export const getTelegramSettingsOptions = (options?: Options<GetTelegramSettingsData>) => queryOptions<GetTelegramSettingsResponse, DefaultError, GetTelegramSettingsResponse, ReturnType<typeof getTelegramSettingsQueryKey>>({
+    queryFn: async ({ queryKey, signal }) => {
+        const { data } = await getTelegramSettings({
+            ...options,
+            ...queryKey[0],
+            signal,
+            throwOnError: true
+        });
+        return data;
+    },
+    queryKey: getTelegramSettingsQueryKey(options)
+});
+
+/**
+ * Create or update Telegram notification channel settings
+ */
+export const upsertTelegramSettingsMutation = (options?: Partial<Options<UpsertTelegramSettingsData>>): UseMutationOptions<UpsertTelegramSettingsResponse, DefaultError, Options<UpsertTelegramSettingsData>> => {
+    const mutationOptions: UseMutationOptions<UpsertTelegramSettingsResponse, DefaultError, Options<UpsertTelegramSettingsData>> = {
+        mutationFn: async (fnOptions) => {
+            const { data } = await upsertTelegramSettings({
+                ...options,
+                ...fnOptions,
+                throwOnError: true
+            });
+            return data;
+        }
+    };
+    return mutationOptions;
+};
+
+/**
+ * Send a test Telegram notification
+ */
+export const testTelegramSettingsMutation = (options?: Partial<Options<TestTelegramSettingsData>>): UseMutationOptions<TestTelegramSettingsResponse2, DefaultError, Options<TestTelegramSettingsData>> => {
+    const mutationOptions: UseMutationOptions<TestTelegramSettingsResponse2, DefaultError, Options<TestTelegramSettingsData>> = {
+        mutationFn: async (fnOptions) => {
+            const { data } = await testTelegramSettings({
+                ...options,
+                ...fnOptions,
+                throwOnError: true
+            });
+            return data;
+        }
+    };
+    return mutationOptions;
+};
+
+export const exportSettingsQueryKey = (options?: Options<ExportSettingsData>) => createQueryKey('exportSettings', options);
+
+/**
+ * Export runtime settings and notification channels to bootstrap another instance
+ *
+ * The runtime section is left out until a timezone is set.
+ */
+export const exportSettingsOptions = (options?: Options<ExportSettingsData>) => queryOptions<ExportSettingsResponse, DefaultError, ExportSettingsResponse, ReturnType<typeof exportSettingsQueryKey>>({
+    queryFn: async ({ queryKey, signal }) => {
+        const { data } = await exportSettings({
+            ...options,
+            ...queryKey[0],
+            signal,
+            throwOnError: true
+        });
+        return data;
+    },
+    queryKey: exportSettingsQueryKey(options)
+});
+
+/**
+ * Apply a settings export document
+ *
+ * Runtime fields missing from the document keep their current values. Notification channels are matched by kind; a channel without a bot token keeps its current token, and is skipped when it does not exist yet. Everything is validated before anything is saved.
+ */
+export const importSettingsMutation = (options?: Partial<Options<ImportSettingsData>>): UseMutationOptions<ImportSettingsResponse2, DefaultError, Options<ImportSettingsData>> => {
+    const mutationOptions: UseMutationOptions<ImportSettingsResponse2, DefaultError, Options<ImportSettingsData>> = {
+        mutationFn: async (fnOptions) => {
+            const { data } = await importSettings({
+                ...options,
+                ...fnOptions,
+                throwOnError: true
+            });
+            return data;
+        }
+    };
+    return mutationOptions;
+};
+
+export const getRuntimeSettingsQueryKey = (options?: Options<GetRuntimeSettingsData>) => createQueryKey('getRuntimeSettings', options);
+
+/**
+ * Get global runtime settings
+ */
+export const getRuntimeSettingsOptions = (options?: Options<GetRuntimeSettingsData>) => queryOptions<GetRuntimeSettingsResponse, DefaultError, GetRuntimeSettingsResponse, ReturnType<typeof getRuntimeSettingsQueryKey>>({
+    queryFn: async ({ queryKey, signal }) => {
+        const { data } = await getRuntimeSettings({
+            ...options,
+            ...queryKey[0],
+            signal,
+            throwOnError: true
+        });
+        return data;
+    },
+    queryKey: getRuntimeSettingsQueryKey(options)
+});
+
+/**
+ * Update global runtime settings
+ */
+export const upsertRuntimeSettingsMutation = (options?: Partial<Options<UpsertRuntimeSettingsData>>): UseMutationOptions<UpsertRuntimeSettingsResponse, DefaultError, Options<UpsertRuntimeSettingsData>> => {
+    const mutationOptions: UseMutationOptions<UpsertRuntimeSettingsResponse, DefaultError, Options<UpsertRuntimeSettingsData>> = {
+        mutationFn: async (fnOptions) => {
+            const { data } = await upsertRuntimeSettings({
+                ...options,
+                ...fnOptions,
+                throwOnError: true
+            });
+            return data;
+        }
+    };
+    return mutationOptions;
+};
+
+/**
+ * Fetch a URL through proxy settings before saving them
+ */
+export const testProxySettingsMutation = (options?: Partial<Options<TestProxySettingsData>>): UseMutationOptions<TestProxySettingsResponse2, DefaultError, Options<TestProxySettingsData>> => {
+    const mutationOptions: UseMutationOptions<TestProxySettingsResponse2, DefaultError, Options<TestProxySettingsData>> = {
+        mutationFn: async (fnOptions) => {
+            const { data } = await testProxySettings({
+                ...options,
+                ...fnOptions,
+                throwOnError: true
+            });
+            return data;
+        }
+    };
+    return mutationOptions;
+};
+
+export const getSystemStateQueryKey = (options?: Options<GetSystemStateData>) => createQueryKey('getSystemState', options);
+
+/**
+ * Get the global pause state
+ */
+export const getSystemStateOptions = (options?: Options<GetSystemStateData>) => queryOptions<GetSystemStateResponse, DefaultError, GetSystemStateResponse, ReturnType<typeof getSystemStateQueryKey>>({
+    queryFn: async ({ queryKey, signal }) => {
+        const { data } = await getSystemState({
+            ...options,
+            ...queryKey[0],
+            signal,
+            throwOnError: true
+        });
+        return data;
+    },
+    queryKey: getSystemStateQueryKey(options)
+});
+
+/**
+ * Stop scheduling monitor runs globally
+ *
+ * Scheduled runs are held while paused; each monitor keeps its nextRunAt and overdue monitors run once scheduling resumes.
+ */
+export const pauseSystemMutation = (options?: Partial<Options<PauseSystemData>>): UseMutationOptions<PauseSystemResponse, DefaultError, Options<PauseSystemData>> => {
+    const mutationOptions: UseMutationOptions<PauseSystemResponse, DefaultError, Options<PauseSystemData>> = {
+        mutationFn: async (fnOptions) => {
+            const { data } = await pauseSystem({
+                ...options,
+                ...fnOptions,
+                throwOnError: true
+            });
+            return data;
+        }
+    };
+    return mutationOptions;
+};
+
+/**
+ * Resume scheduling and notifications
+ */
+export const resumeSystemMutation = (options?: Partial<Options<ResumeSystemData>>): UseMutationOptions<ResumeSystemResponse, DefaultError, Options<ResumeSystemData>> => {
+    const mutationOptions: UseMutationOptions<ResumeSystemResponse, DefaultError, Options<ResumeSystemData>> = {
+        mutationFn: async (fnOptions) => {
+            const { data } = await resumeSystem({
+                ...options,
+                ...fnOptions,
+                throwOnError: true
+            });
+            return data;
+        }
+    };
+    return mutationOptions;
+};
+
+/**
+ * Download a consistent snapshot of the SQLite database
+ *
+ * The snapshot is taken with VACUUM INTO while the server keeps running. Start the server with -restore-from to restore it.
+ */
+export const backupDatabaseMutation = (options?: Partial<Options<BackupDatabaseData>>): UseMutationOptions<BackupDatabaseResponse, DefaultError, Options<BackupDatabaseData>> => {
+    const mutationOptions: UseMutationOptions<BackupDatabaseResponse, DefaultError, Options<BackupDatabaseData>> = {
+        mutationFn: async (fnOptions) => {
+            const { data } = await backupDatabase({
+                ...options,
+                ...fnOptions,
+                throwOnError: true
+            });
+            return data;
+        }
+    };
+    return mutationOptions;
+};
+
+export const listMonitorChecksQueryKey = (options: Options<ListMonitorChecksData>) => createQueryKey('listMonitorChecks', options);
+
+/**
+ * List recent checks for a monitor
+ */
+export const listMonitorChecksOptions = (options: Options<ListMonitorChecksData>) => queryOptions<ListMonitorChecksResponse, DefaultError, ListMonitorChecksResponse, ReturnType<typeof listMonitorChecksQueryKey>>({
+    queryFn: async ({ queryKey, signal }) => {
+        const { data } = await listMonitorChecks({
+            ...options,
+            ...queryKey[0],
+            signal,
+            throwOnError: true
+        });
+        return data;
+    },
+    queryKey: listMonitorChecksQueryKey(options)
+});
+
+const createInfiniteParams = <K extends Pick<QueryKey<Options>[0], 'body' | 'headers' | 'path' | 'query'>>(queryKey: QueryKey<Options>, page: K) => {
+    const params = { ...queryKey[0] };
+    if (page.body) {
+        params.body = {
+            ...queryKey[0].body as any,
+            ...page.body as any
+        };
+    }
+    if (page.headers) {
+        params.headers = {
+            ...queryKey[0].headers,
+            ...page.headers
+        };
+    }
+    if (page.path) {
+        params.path = {
+            ...queryKey[0].path as any,
+            ...page.path as any
+        };
+    }
+    if (page.query) {
+        params.query = {
+            ...queryKey[0].query as any,
+            ...page.query as any
+        };
+    }
+    return params as unknown as typeof page;
+};
+
+export const listMonitorChecksInfiniteQueryKey = (options: Options<ListMonitorChecksData>): QueryKey<Options<ListMonitorChecksData>> => createQueryKey('listMonitorChecks', options, true);
+
+/**
+ * List recent checks for a monitor
+ */
+export const listMonitorChecksInfiniteOptions = (options: Options<ListMonitorChecksData>) => infiniteQueryOptions<ListMonitorChecksResponse, DefaultError, InfiniteData<ListMonitorChecksResponse>, QueryKey<Options<ListMonitorChecksData>>, string | Pick<QueryKey<Options<ListMonitorChecksData>>[0], 'body' | 'headers' | 'path' | 'query'>>(
+// @ts-ignore
+{
+    queryFn: async ({ pageParam, queryKey, signal }) => {
+        // @ts-ignore
+        const page: Pick<QueryKey<Options<ListMonitorChecksData>>[0], 'body' | 'headers' | 'path' | 'query'> = typeof pageParam === 'object' ? pageParam : {
+            query: {
+                cursor: pageParam
+            }
+        };
+        const params = createInfiniteParams(queryKey, page);
+        const { data } = await listMonitorChecks({
+            ...options,
+            ...params,
+            signal,
+            throwOnError: true
+        });
+        return data;
+    },
+    queryKey: listMonitorChecksInfiniteQueryKey(options)
+});
+
+/**
+ * Delete a monitor's checks, optionally within a time range
+ *
+ * Without from or to every stored check is deleted. Lifetime counters such as checkCount are kept.
+ */
+export const deleteMonitorChecksMutation = (options?: Partial<Options<DeleteMonitorChecksData>>): UseMutationOptions<DeleteMonitorChecksResponse2, DefaultError, Options<DeleteMonitorChecksData>> => {
+    const mutationOptions: UseMutationOptions<DeleteMonitorChecksResponse2, DefaultError, Options<DeleteMonitorChecksData>> = {
+        mutationFn: async (fnOptions) => {
+            const { data } = await deleteMonitorChecks({
+                ...options,
+                ...fnOptions,
+                throwOnError: true
+            });
+            return data;
+        }
+    };
+    return mutationOptions;
+};
+
+export const diffMonitorChecksQueryKey = (options: Options<DiffMonitorChecksData>) => createQueryKey('diffMonitorChecks', options);
+
+/**
+ * Compare the stored selections or bodies of two checks
+ */
+export const diffMonitorChecksOptions = (options: Options<DiffMonitorChecksData>) => queryOptions<DiffMonitorChecksResponse, DefaultError, DiffMonitorChecksResponse, ReturnType<typeof diffMonitorChecksQueryKey>>({
+    queryFn: async ({ queryKey, signal }) => {
+        const { data } = await diffMonitorChecks({
+            ...options,
+            ...queryKey[0],
+            signal,
+            throwOnError: true
+        });
+        return data;
+    },
+    queryKey: diffMonitorChecksQueryKey(options)
+});
+
+/**
+ * Preview a gjson selector against the latest stored check
+ *
+ * Evaluates the selector against the body snapshot of the most recent check that stored a body or selection. Without a body snapshot the stored selection is used, so the selector applies to the output of the monitor's current selector.
+ */
+export const previewStoredMonitorSelectorMutation = (options?: Partial<Options<PreviewStoredMonitorSelectorData>>): UseMutationOptions<PreviewStoredMonitorSelectorResponse, DefaultError, Options<PreviewStoredMonitorSelectorData>> => {
+    const mutationOptions: UseMutationOptions<PreviewStoredMonitorSelectorResponse, DefaultError, Options<PreviewStoredMonitorSelectorData>> = {
+        mutationFn: async (fnOptions) => {
+            const { data } = await previewStoredMonitorSelector({
+                ...options,
+                ...fnOptions,
+                throwOnError: true
+            });
+            return data;
+        }
+    };
+    return mutationOptions;
+};
+
+export const getMonitorCheckQueryKey = (options: Options<GetMonitorCheckData>) => createQueryKey('getMonitorCheck', options);
+
+/**
+ * Get one check with its stored values untruncated
+ *
+ * Check lists cut errorMessage, selectionValue, diffSummary and diffDetails to 16 KiB; this returns them in full.
+ */
+export const getMonitorCheckOptions = (options: Options<GetMonitorCheckData>) => queryOptions<GetMonitorCheckResponse, DefaultError, GetMonitorCheckResponse, ReturnType<typeof getMonitorCheckQueryKey>>({
+    queryFn: async ({ queryKey, signal }) => {
+        const { data } = await getMonitorCheck({
+            ...options,
+            ...queryKey[0],
+            signal,
+            throwOnError: true
+        });
+        return data;
+    },
+    queryKey: getMonitorCheckQueryKey(options)
+});
+
+/**
+ * Delete one check
+ */
+export const deleteMonitorCheckMutation = (options?: Partial<Options<DeleteMonitorCheckData>>): UseMutationOptions<DeleteMonitorCheckResponse, DefaultError, Options<DeleteMonitorCheckData>> => {
+    const mutationOptions: UseMutationOptions<DeleteMonitorCheckResponse, DefaultError, Options<DeleteMonitorCheckData>> = {
+        mutationFn: async (fnOptions) => {
+            const { data } = await deleteMonitorCheck({
+                ...options,
+                ...fnOptions,
+                throwOnError: true
+            });
+            return data;
+        }
+    };
+    return mutationOptions;
+};
+
+export const getMonitorCheckBodyQueryKey = (options: Options<GetMonitorCheckBodyData>) => createQueryKey('getMonitorCheckBody', options);
+
+/**
+ * Get the stored response body of a check
+ */
+export const getMonitorCheckBodyOptions = (options: Options<GetMonitorCheckBodyData>) => queryOptions<GetMonitorCheckBodyResponse, DefaultError, GetMonitorCheckBodyResponse, ReturnType<typeof getMonitorCheckBodyQueryKey>>({
+    queryFn: async ({ queryKey, signal }) => {
+        const { data } = await getMonitorCheckBody({
+            ...options,
+            ...queryKey[0],
+            signal,
+            throwOnError: true
+        });
+        return data;
+    },
+    queryKey: getMonitorCheckBodyQueryKey(options)
+});
+
+export const getMonitorCheckNeighborsQueryKey = (options: Options<GetMonitorCheckNeighborsData>) => createQueryKey('getMonitorCheckNeighbors', options);
+
+/**
+ * Get the nearest earlier and later checks with changes
+ */
+export const getMonitorCheckNeighborsOptions = (options: Options<GetMonitorCheckNeighborsData>) => queryOptions<GetMonitorCheckNeighborsResponse, DefaultError, GetMonitorCheckNeighborsResponse, ReturnType<typeof getMonitorCheckNeighborsQueryKey>>({
+    queryFn: async ({ queryKey, signal }) => {
+        const { data } = await getMonitorCheckNeighbors({
+            ...options,
+            ...queryKey[0],
+            signal,
+            throwOnError: true
+        });
+        return data;
+    },
+    queryKey: getMonitorCheckNeighborsQueryKey(options)
+});
+
+export const streamEventsQueryKey = (options?: Options<StreamEventsData>) => createQueryKey('streamEvents', options);
+
+/**
+ * WebSocket stream of live monitor events
+ *
+ * Upgrades to a WebSocket that sends LiveEvent JSON messages of type check.completed, monitor.updated and notification.sent.
+ * The connection starts subscribed to the monitors named by monitorId, or to every monitor; send {"type":"subscribe","monitorIds":[...]} to change that, with an empty list meaning every monitor.
+ * Each subscription change is acknowledged with {"type":"subscribed","monitorIds":[...]}. Browsers, which cannot set headers on WebSockets, pass a ticket from POST /v1/ws/ticket as the ticket query parameter instead of the bearer token.
+ * The stream closes when the session it was opened with expires or is revoked.
+ */
+export const streamEventsOptions = (options?: Options<StreamEventsData>) => queryOptions<unknown, DefaultError, unknown, ReturnType<typeof streamEventsQueryKey>>({
+    queryFn: async ({ queryKey, signal }) => {
+        const { data } = await streamEvents({
+            ...options,
+            ...queryKey[0],
+            signal,
+            throwOnError: true
+        });
+        return data;
+    },
+    queryKey: streamEventsQueryKey(options)
+});
+
+/**
+ * Issue a 30-second ticket for opening the event WebSocket
+ */
+export const createWebSocketTicketMutation = (options?: Partial<Options<CreateWebSocketTicketData>>): UseMutationOptions<CreateWebSocketTicketResponse, DefaultError, Options<CreateWebSocketTicketData>> => {
+    const mutationOptions: UseMutationOptions<CreateWebSocketTicketResponse, DefaultError, Options<CreateWebSocketTicketData>> = {
+        mutationFn: async (fnOptions) => {
+            const { data } = await createWebSocketTicket({
+                ...options,
+                ...fnOptions,
+                throwOnError: true
+            });
+            return data;
+        }
+    };
+    return mutationOptions;
+};
+
+/**
+ * Exchange a username and password for a bearer token
+ */
+export const loginMutation = (options?: Partial<Options<LoginData>>): UseMutationOptions<LoginResponse2, DefaultError, Options<LoginData>> => {
+    const mutationOptions: UseMutationOptions<LoginResponse2, DefaultError, Options<LoginData>> = {
+        mutationFn: async (fnOptions) => {
+            const { data } = await login({
+                ...options,
+                ...fnOptions,
+                throwOnError: true
+            });
+            return data;
+        }
+    };
+    return mutationOptions;
+};
+
+/**
+ * Revoke the bearer token the request is made with
+ */
+export const logoutMutation = (options?: Partial<Options<LogoutData>>): UseMutationOptions<LogoutResponse, DefaultError, Options<LogoutData>> => {
+    const mutationOptions: UseMutationOptions<LogoutResponse, DefaultError, Options<LogoutData>> = {
+        mutationFn: async (fnOptions) => {
+            const { data } = await logout({
+                ...options,
+                ...fnOptions,
+                throwOnError: true
+            });
+            return data;
+        }
+    };
+    return mutationOptions;
+};
+
+export const getAuthStatusQueryKey = (options?: Options<GetAuthStatusData>) => createQueryKey('getAuthStatus', options);
+
+/**
+ * Report whether sign-in is required and who the token belongs to
+ */
+export const getAuthStatusOptions = (options?: Options<GetAuthStatusData>) => queryOptions<GetAuthStatusResponse, DefaultError, GetAuthStatusResponse, ReturnType<typeof getAuthStatusQueryKey>>({
+    queryFn: async ({ queryKey, signal }) => {
+        const { data } = await getAuthStatus({
+            ...options,
+            ...queryKey[0],
+            signal,
+            throwOnError: true
+        });
+        return data;
+    },
+    queryKey: getAuthStatusQueryKey(options)
+});
+
+/**
+ * Change the signed-in user's password, revoking their other sessions
+ */
+export const changePasswordMutation = (options?: Partial<Options<ChangePasswordData>>): UseMutationOptions<ChangePasswordResponse, DefaultError, Options<ChangePasswordData>> => {
+    const mutationOptions: UseMutationOptions<ChangePasswordResponse, DefaultError, Options<ChangePasswordData>> = {
+        mutationFn: async (fnOptions) => {
+            const { data } = await changePassword({
+                ...options,
+                ...fnOptions,
+                throwOnError: true
+            });
+            return data;
+        }
+    };
+    return mutationOptions;
+};
+
+export const listUsersQueryKey = (options?: Options<ListUsersData>) => createQueryKey('listUsers', options);
+
+/**
+ * List users
+ */
+export const listUsersOptions = (options?: Options<ListUsersData>) => queryOptions<ListUsersResponse, DefaultError, ListUsersResponse, ReturnType<typeof listUsersQueryKey>>({
+    queryFn: async ({ queryKey, signal }) => {
+        const { data } = await listUsers({
+            ...options,
+            ...queryKey[0],
+            signal,
+            throwOnError: true
+        });
+        return data;
+    },
+    queryKey: listUsersQueryKey(options)
+});
+
+/**
+ * Create a user
+ *
+ * While no users exist anyone may create the first user, which must be an admin. Creating it turns authentication on.
+ */
+export const createUserMutation = (options?: Partial<Options<CreateUserData>>): UseMutationOptions<CreateUserResponse, DefaultError, Options<CreateUserData>> => {
+    const mutationOptions: UseMutationOptions<CreateUserResponse, DefaultError, Options<CreateUserData>> = {
+        mutationFn: async (fnOptions) => {
+            const { data } = await createUser({
+                ...options,
+                ...fnOptions,
+                throwOnError: true
+            });
+            return data;
+        }
+    };
+    return mutationOptions;
+};
+
+/**
+ * Change a user's role or password
+ *
+ * A new password revokes the user's sessions.
+ */
+export const updateUserMutation = (options?: Partial<Options<UpdateUserData>>): UseMutationOptions<UpdateUserResponse, DefaultError, Options<UpdateUserData>> => {
+    const mutationOptions: UseMutationOptions<UpdateUserResponse, DefaultError, Options<UpdateUserData>> = {
+        mutationFn: async (fnOptions) => {
+            const { data } = await updateUser({
+                ...options,
+                ...fnOptions,
+                throwOnError: true
+            });
+            return data;
+        }
+    };
+    return mutationOptions;
+};
+
+/**
+ * Delete a user and their sessions
+ */
+export const deleteUserMutation = (options?: Partial<Options<DeleteUserData>>): UseMutationOptions<DeleteUserResponse, DefaultError, Options<DeleteUserData>> => {
+    const mutationOptions: UseMutationOptions<DeleteUserResponse, DefaultError, Options<DeleteUserData>> = {
+        mutationFn: async (fnOptions) => {
+            const { data } = await deleteUser({
+                ...options,
+                ...fnOptions,
+                throwOnError: true
+            });
+            return data;
+        }
+    };
+    return mutationOptions;
+};
@@ -1,4 +1,4 @@
 // This file is auto-generated by @hey-api/openapi-ts
 
-export { createMonitor, deleteMonitor, getHealth, getRuntimeSettings, getTelegramSettings, listMonitorChecks, listMonitors, type Options, previewMonitorSelector, testMonitorUrl, testTelegramSettings, triggerMonitor, updateMonitor, upsertRuntimeSettings, upsertTelegramSettings } from './sdk.gen';
-export type { ClientOptions, CreateMonitorData, CreateMonitorErrors, CreateMonitorRequest, CreateMonitorResponse, CreateMonitorResponses, DeleteMonitorData, DeleteMonitorErrors, DeleteMonitorResponse, DeleteMonitorResponses, GetHealthData, GetHealthResponse, GetHealthResponses, GetRuntimeSettingsData, GetRuntimeSettingsResponse, GetRuntimeSettingsResponses, GetTelegramSettingsData, GetTelegramSettingsResponse, GetTelegramSettingsResponses, HealthResponse, ListMonitorChecksData, ListMonitorChecksErrors, ListMonitorChecksResponse, ListMonitorChecksResponses, ListMonitorsData, ListMonitorsResponse, ListMonitorsResponses, Monitor, MonitorCheck, MonitorNotificationIssue, MonitorTriggerResult, PreviewMonitorSelectorData, PreviewMonitorSelectorErrors, PreviewMonitorSelectorResponse, PreviewMonitorSelectorResponses, RuntimeSettings, SelectorPreviewRequest, SelectorPreviewResponse, TelegramSettings, TestMonitorRequest, TestMonitorResponse, TestMonitorUrlData, TestMonitorUrlErrors, TestMonitorUrlResponse, TestMonitorUrlResponses, TestTelegramSettingsData, TestTelegramSettingsErrors, TestTelegramSettingsRequest, TestTelegramSettingsResponse, TestTelegramSettingsResponse2, TestTelegramSettingsResponses, TriggerMonitorData, TriggerMonitorErrors, TriggerMonitorResponse, TriggerMonitorResponses, UpdateMonitorData, UpdateMonitorErrors, UpdateMonitorResponse, UpdateMonitorResponses, UpsertRuntimeSettingsData, UpsertRuntimeSettingsErrors, UpsertRuntimeSettingsRequest, UpsertRuntimeSettingsResponse, UpsertRuntimeSettingsResponses, UpsertTelegramSettingsData, UpsertTelegramSettingsErrors, UpsertTelegramSettingsRequest, UpsertTelegramSettingsResponse, UpsertTelegramSettingsResponses } from './types.gen';
+export { acknowledgeMonitor, archiveMonitor, backupDatabase, bulkMonitors, cancelExpectMonitorChange, changePassword, clearMonitorCookies, createHeaderProfile, createMonitor, createMonitorFromTest, createUser, createWebSocketTicket, deleteHeaderProfile, deleteMonitor, deleteMonitorCheck, deleteMonitorChecks, deleteUser, diffMonitorChecks, dryRunMonitor, duplicateMonitor, expectMonitorChange, exportMonitors, exportSettings, getAuthStatus, getHealth, getHealthDetails, getMonitorBadge, getMonitorCheck, getMonitorCheckBody, getMonitorCheckNeighbors, getMonitorCookies, getMonitorRollups, getMonitorStats, getReadiness, getRuntimeSettings, getStatusPage, getSystemState, getTag, getTelegramSettings, importMonitorCurl, importMonitorHar, importMonitors, importMonitorUrls, importSettings, listHeaderProfiles, listMonitorChecks, listMonitors, listMonitorStats, listMonitorVersions, listTags, listUsers, login, logout, type Options, pauseSystem, pingHeartbeat, postHeartbeat, previewDiff, previewMonitorSelector, previewStoredMonitorSelector, reorderMonitors, replaceMonitorCookies, restoreMonitor, restoreMonitorVersion, resumeSystem, runMonitor, streamEvents, testMonitorUrl, testProxySettings, testTelegramSettings, triggerMonitor, updateHeaderProfile, updateMonitor, updateUser, upsertRuntimeSettings, upsertTelegramSettings } from './sdk.gen';
+export type { AcknowledgeMonitorData, AcknowledgeMonitorErrors, AcknowledgeMonitorResponse, AcknowledgeMonitorResponses, ArchiveMonitorData, ArchiveMonitorErrors, ArchiveMonitorResponse, ArchiveMonitorResponses, AuthStatus, BackupDatabaseData, BackupDatabaseErrors, BackupDatabaseResponse, BackupDatabaseResponses, BulkMonitorRequest, BulkMonitorResponse, BulkMonitorsData, BulkMonitorsErrors, BulkMonitorsResponse, BulkMonitorsResponses, CancelExpectMonitorChangeData, CancelExpectMonitorChangeErrors, CancelExpectMonitorChangeResponse, CancelExpectMonitorChangeResponses, ChangeFrequency, ChangePasswordData, ChangePasswordErrors, ChangePasswordRequest, ChangePasswordResponse, ChangePasswordResponses, CheckPerformance, ClearMonitorCookiesData, ClearMonitorCookiesErrors, ClearMonitorCookiesResponse, ClearMonitorCookiesResponses, ClientOptions, CreateHeaderProfileData, CreateHeaderProfileErrors, CreateHeaderProfileResponse, CreateHeaderProfileResponses, CreateMonitorData, CreateMonitorErrors, CreateMonitorFromTestData, CreateMonitorFromTestErrors, CreateMonitorFromTestResponse, CreateMonitorFromTestResponses, CreateMonitorRequest, CreateMonitorResponse, CreateMonitorResponses, CreateUserData, CreateUserErrors, CreateUserRequest, CreateUserResponse, CreateUserResponses, CreateWebSocketTicketData, CreateWebSocketTicketErrors, CreateWebSocketTicketResponse, CreateWebSocketTicketResponses, DailyChangeCount, DeleteHeaderProfileData, DeleteHeaderProfileErrors, DeleteHeaderProfileResponse, DeleteHeaderProfileResponses, DeleteMonitorCheckData, DeleteMonitorCheckErrors, DeleteMonitorCheckResponse, DeleteMonitorCheckResponses, DeleteMonitorChecksData, DeleteMonitorChecksErrors, DeleteMonitorChecksResponse, DeleteMonitorChecksResponse2, DeleteMonitorChecksResponses, DeleteMonitorData, DeleteMonitorErrors, DeleteMonitorResponse, DeleteMonitorResponses, DeleteUserData, DeleteUserErrors, DeleteUserResponse, DeleteUserResponses, DiffMonitorChecksData, DiffMonitorChecksErrors, DiffMonitorChecksResponse, DiffMonitorChecksResponses, DiffPreviewRequest, DiffPreviewResponse, DryRunMonitorData, DryRunMonitorErrors, DryRunMonitorRequest, DryRunMonitorResponse, DryRunMonitorResponse2, DryRunMonitorResponses, DuplicateMonitorData, DuplicateMonitorErrors, DuplicateMonitorResponse, DuplicateMonitorResponses, ExpectMonitorChangeData, ExpectMonitorChangeErrors, ExpectMonitorChangeResponse, ExpectMonitorChangeResponses, ExportMonitorsData, ExportMonitorsErrors, ExportMonitorsResponse, ExportMonitorsResponses, ExportSettingsData, ExportSettingsErrors, ExportSettingsResponse, ExportSettingsResponses, GetAuthStatusData, GetAuthStatusResponse, GetAuthStatusResponses, GetHealthData, GetHealthDetailsData, GetHealthDetailsError, GetHealthDetailsErrors, GetHealthDetailsResponse, GetHealthDetailsResponses, GetHealthResponse, GetHealthResponses, GetMonitorBadgeData, GetMonitorBadgeErrors, GetMonitorBadgeResponse, GetMonitorBadgeResponses, GetMonitorCheckBodyData, GetMonitorCheckBodyErrors, GetMonitorCheckBodyResponse, GetMonitorCheckBodyResponses, GetMonitorCheckData, GetMonitorCheckErrors, GetMonitorCheckNeighborsData, GetMonitorCheckNeighborsErrors, GetMonitorCheckNeighborsResponse, GetMonitorCheckNeighborsResponses, GetMonitorCheckResponse, GetMonitorCheckResponses, GetMonitorCookiesData, GetMonitorCookiesErrors, GetMonitorCookiesResponse, GetMonitorCookiesResponses, GetMonitorRollupsData, GetMonitorRollupsErrors, GetMonitorRollupsResponse, GetMonitorRollupsResponses, GetMonitorStatsData, GetMonitorStatsErrors, GetMonitorStatsResponse, GetMonitorStatsResponses, GetReadinessData, GetReadinessError, GetReadinessErrors, GetReadinessResponse, GetReadinessResponses, GetRuntimeSettingsData, GetRuntimeSettingsResponse, GetRuntimeSettingsResponses, GetStatusPageData, GetStatusPageResponse, GetStatusPageResponses, GetSystemStateData, GetSystemStateResponse, GetSystemStateResponses, GetTagData, GetTagErrors, GetTagResponse, GetTagResponses, GetTelegramSettingsData, GetTelegramSettingsResponse, GetTelegramSettingsResponses, HarRequest, HeaderProfile, HeaderProfileRequest, HealthComponent, HealthDetails, HealthResponse, HeartbeatPingResponse, ImportMonitorCurlData, ImportMonitorCurlErrors, ImportMonitorCurlResponse, ImportMonitorCurlResponses, ImportMonitorHarData, ImportMonitorHarErrors, ImportMonitorHarResponse, ImportMonitorHarResponse2, ImportMonitorHarResponses, ImportMonitorsData, ImportMonitorsErrors, ImportMonitorsResponse, ImportMonitorsResponse2, ImportMonitorsResponses, ImportMonitorUrlsData, ImportMonitorUrlsErrors, ImportMonitorUrlsResponse, ImportMonitorUrlsResponse2, ImportMonitorUrlsResponses, ImportNotificationChannelResult, ImportSettingsData, ImportSettingsErrors, ImportSettingsResponse, ImportSettingsResponse2, ImportSettingsResponses, ImportSkippedMonitor, ImportSkippedUrl, LatencySummary, ListHeaderProfilesData, ListHeaderProfilesResponse, ListHeaderProfilesResponses, ListMonitorChecksData, ListMonitorChecksErrors, ListMonitorChecksResponse, ListMonitorChecksResponses, ListMonitorsData, ListMonitorsResponse, ListMonitorsResponses, ListMonitorStatsData, ListMonitorStatsResponse, ListMonitorStatsResponses, ListMonitorVersionsData, ListMonitorVersionsErrors, ListMonitorVersionsResponse, ListMonitorVersionsResponses, ListTagsData, ListTagsResponse, ListTagsResponses, ListUsersData, ListUsersErrors, ListUsersResponse, ListUsersResponses, LiveEvent, LoginData, LoginErrors, LoginRequest, LoginResponse, LoginResponse2, LoginResponses, LogoutData, LogoutResponse, LogoutResponses, Monitor, MonitorCheck, MonitorCheckBody, MonitorCheckDetail, MonitorCheckDiff, MonitorCheckNeighbors, MonitorCookie, MonitorCookies, MonitorDraft, MonitorExport, MonitorFromTestRequest, MonitorNotificationIssue, MonitorOrder, MonitorRecentChecks, MonitorRollups, MonitorStats, MonitorTriggerResult, MonitorVersion, NotificationChannelExport, PauseSystemData, PauseSystemErrors, PauseSystemRequest, PauseSystemResponse, PauseSystemResponses, PingHeartbeatData, PingHeartbeatErrors, PingHeartbeatResponse, PingHeartbeatResponses, PostHeartbeatData, PostHeartbeatErrors, PostHeartbeatResponse, PostHeartbeatResponses, PreviewDiffData, PreviewDiffErrors, PreviewDiffResponse, PreviewDiffResponses, PreviewMonitorSelectorData, PreviewMonitorSelectorErrors, PreviewMonitorSelectorResponse, PreviewMonitorSelectorResponses, PreviewStoredMonitorSelectorData, PreviewStoredMonitorSelectorErrors, PreviewStoredMonitorSelectorResponse, PreviewStoredMonitorSelectorResponses, Readiness, ReorderMonitorsData, ReorderMonitorsErrors, ReorderMonitorsResponse, ReorderMonitorsResponses, ReplaceMonitorCookiesData, ReplaceMonitorCookiesErrors, ReplaceMonitorCookiesResponse, ReplaceMonitorCookiesResponses, RestoreMonitorData, RestoreMonitorErrors, RestoreMonitorResponse, RestoreMonitorResponses, RestoreMonitorVersionData, RestoreMonitorVersionErrors, RestoreMonitorVersionResponse, RestoreMonitorVersionResponses, ResumeSystemData, ResumeSystemResponse, ResumeSystemResponses, RunMonitorData, RunMonitorErrors, RunMonitorResponse, RunMonitorResponses, RuntimeSettings, SelectorPreviewRequest, SelectorPreviewResponse, SettingsExport, StatsRollup, StatusPage, StatusPageMonitor, StoredSelectorPreviewRequest, StoredSelectorPreviewResponse, StreamEventsData, StreamEventsErrors, SystemState, TagSummary, TelegramSettings, TestMonitorRequest, TestMonitorResponse, TestMonitorUrlData, TestMonitorUrlErrors, TestMonitorUrlResponse, TestMonitorUrlResponses, TestProxySettingsData, TestProxySettingsErrors, TestProxySettingsRequest, TestProxySettingsResponse, TestProxySettingsResponse2, TestProxySettingsResponses, TestTelegramSettingsData, TestTelegramSettingsErrors, TestTelegramSettingsRequest, TestTelegramSettingsResponse, TestTelegramSettingsResponse2, TestTelegramSettingsResponses, TimingSummary, TriggerMonitorData, TriggerMonitorErrors, TriggerMonitorResponse, TriggerMonitorResponses, UpdateHeaderProfileData, UpdateHeaderProfileErrors, UpdateHeaderProfileResponse, UpdateHeaderProfileResponses, UpdateMonitorData, UpdateMonitorErrors, UpdateMonitorResponse, UpdateMonitorResponses, UpdateUserData, UpdateUserErrors, UpdateUserRequest, UpdateUserResponse, UpdateUserResponses, UpsertRuntimeSettingsData, UpsertRuntimeSettingsErrors, UpsertRuntimeSettingsRequest, UpsertRuntimeSettingsResponse, UpsertRuntimeSettingsResponses, UpsertTelegramSettingsData, UpsertTelegramSettingsErrors, UpsertTelegramSettingsRequest, UpsertTelegramSettingsResponse, UpsertTelegramSettingsResponses, UptimeStats, User, WebSocketTicket } from './types.gen';
@@ -2,7 +2,7 @@
 
 import type { Client, Options as Options2, TDataShape } from './client';
 import { client } from './client.gen';
-import type { CreateMonitorData, CreateMonitorErrors, CreateMonitorResponses, DeleteMonitorData, DeleteMonitorErrors, DeleteMonitorResponses, GetHealthData, GetHealthResponses, GetRuntimeSettingsData, GetRuntimeSettingsResponses, GetTelegramSettingsData, GetTelegramSettingsResponses, ListMonitorChecksData, ListMonitorChecksErrors, ListMonitorChecksResponses, ListMonitorsData, ListMonitorsResponses, PreviewMonitorSelectorData, PreviewMonitorSelectorErrors, PreviewMonitorSelectorResponses, TestMonitorUrlData, TestMonitorUrlErrors, TestMonitorUrlResponses, TestTelegramSettingsData, TestTelegramSettingsErrors, TestTelegramSettingsResponses, TriggerMonitorData, TriggerMonitorErrors, TriggerMonitorResponses, UpdateMonitorData, UpdateMonitorErrors, UpdateMonitorResponses, UpsertRuntimeSettingsData, UpsertRuntimeSettingsErrors, UpsertRuntimeSettingsResponses, UpsertTelegramSettingsData, UpsertTelegramSettingsErrors, UpsertTelegramSettingsResponses } from './types.gen';
+import type { AcknowledgeMonitorData, AcknowledgeMonitorErrors, AcknowledgeMonitorResponses, ArchiveMonitorData, ArchiveMonitorErrors, ArchiveMonitorResponses, BackupDatabaseData, BackupDatabaseErrors, BackupDatabaseResponses, BulkMonitorsData, BulkMonitorsErrors, BulkMonitorsResponses, CancelExpectMonitorChangeData, CancelExpectMonitorChangeErrors, CancelExpectMonitorChangeResponses, ChangePasswordData, ChangePasswordErrors, ChangePasswordResponses, ClearMonitorCookiesData, ClearMonitorCookiesErrors, ClearMonitorCookiesResponses, CreateHeaderProfileData, CreateHeaderProfileErrors, CreateHeaderProfileResponses, CreateMonitorData, CreateMonitorErrors, CreateMonitorFromTestData, CreateMonitorFromTestErrors, CreateMonitorFromTestResponses, CreateMonitorResponses, CreateUserData, CreateUserErrors, CreateUserResponses, CreateWebSocketTicketData, CreateWebSocketTicketErrors, CreateWebSocketTicketResponses, DeleteHeaderProfileData, DeleteHeaderProfileErrors, DeleteHeaderProfileResponses, DeleteMonitorCheckData, DeleteMonitorCheckErrors, DeleteMonitorCheckResponses, DeleteMonitorChecksData, DeleteMonitorChecksErrors, DeleteMonitorChecksResponses, DeleteMonitorData, DeleteMonitorErrors, DeleteMonitorResponses, DeleteUserData, DeleteUserErrors, DeleteUserResponses, DiffMonitorChecksData, DiffMonitorChecksErrors, DiffMonitorChecksResponses, DryRunMonitorData, DryRunMonitorErrors, DryRunMonitorResponses, DuplicateMonitorData, DuplicateMonitorErrors, DuplicateMonitorResponses, ExpectMonitorChangeData, ExpectMonitorChangeErrors, ExpectMonitorChangeResponses, ExportMonitorsData, ExportMonitorsErrors, ExportMonitorsResponses, ExportSettingsData, ExportSettingsErrors, ExportSettingsResponses, GetAuthStatusData, GetAuthStatusResponses, GetHealthData, GetHealthDetailsData, GetHealthDetailsErrors, GetHealthDetailsResponses, GetHealthResponses, GetMonitorBadgeData, GetMonitorBadgeErrors, GetMonitorBadgeResponses, GetMonitorCheckBodyData, GetMonitorCheckBodyErrors, GetMonitorCheckBodyResponses, GetMonitorCheckData, GetMonitorCheckErrors, GetMonitorCheckNeighborsData, GetMonitorCheckNeighborsErrors, GetMonitorCheckNeighborsResponses, GetMonitorCheckResponses, GetMonitorCookiesData, GetMonitorCookiesErrors, GetMonitorCookiesResponses, GetMonitorRollupsData, GetMonitorRollupsErrors, GetMonitorRollupsResponses, GetMonitorStatsData, GetMonitorStatsErrors, GetMonitorStatsResponses, GetReadinessData, GetReadinessErrors, GetReadinessResponses, GetRuntimeSettingsData, GetRuntimeSettingsResponses, GetStatusPageData, GetStatusPageResponses, GetSystemStateData, GetSystemStateResponses, GetTagData, GetTagErrors, GetTagResponses, GetTelegramSettingsData, GetTelegramSettingsResponses, ImportMonitorCurlData, ImportMonitorCurlErrors, ImportMonitorCurlResponses, ImportMonitorHarData, ImportMonitorHarErrors, ImportMonitorHarResponses, ImportMonitorsData, ImportMonitorsErrors, ImportMonitorsResponses, ImportMonitorUrlsData, ImportMonitorUrlsErrors, ImportMonitorUrlsResponses, ImportSettingsData, ImportSettingsErrors, ImportSettingsResponses, ListHeaderProfilesData, ListHeaderProfilesResponses, ListMonitorChecksData, ListMonitorChecksErrors, ListMonitorChecksResponses, ListMonitorsData, ListMonitorsResponses, ListMonitorStatsData, ListMonitorStatsResponses, ListMonitorVersionsData, ListMonitorVersionsErrors, ListMonitorVersionsResponses, ListTagsData, ListTagsResponses, ListUsersData, ListUsersErrors, ListUsersResponses, LoginData, LoginErrors, LoginResponses, LogoutData, LogoutResponses, PauseSystemData, PauseSystemErrors, PauseSystemResponses, PingHeartbeatData, PingHeartbeatErrors, PingHeartbeatResponses, PostHeartbeatData, PostHeartbeatErrors, PostHeartbeatResponses, PreviewDiffData, PreviewDiffErrors, PreviewDiffResponses, PreviewMonitorSelectorData, PreviewMonitorSelectorErrors, PreviewMonitorSelectorResponses, PreviewStoredMonitorSelectorData, PreviewStoredMonitorSelectorErrors, PreviewStoredMonitorSelectorResponses, ReorderMonitorsData, ReorderMonitorsErrors, ReorderMonitorsResponses, ReplaceMonitorCookiesData, ReplaceMonitorCookiesErrors, ReplaceMonitorCookiesResponses, RestoreMonitorData, RestoreMonitorErrors, RestoreMonitorResponses, RestoreMonitorVersionData, RestoreMonitorVersionErrors, RestoreMonitorVersionResponses, ResumeSystemData, ResumeSystemResponses, RunMonitorData, RunMonitorErrors, RunMonitorResponses, StreamEventsData, StreamEventsErrors, TestMonitorUrlData, TestMonitorUrlErrors, TestMonitorUrlResponses, TestProxySettingsData, TestProxySettingsErrors, TestProxySettingsResponses, TestTelegramSettingsData, TestTelegramSettingsErrors, TestTelegramSettingsResponses, TriggerMonitorData, TriggerMonitorErrors, TriggerMonitorResponses, UpdateHeaderProfileData, UpdateHeaderProfileErrors, UpdateHeaderProfileResponses, UpdateMonitorData, UpdateMonitorErrors, UpdateMonitorResponses, UpdateUserData, UpdateUserErrors, UpdateUserResponses, UpsertRuntimeSettingsData, UpsertRuntimeSettingsErrors, UpsertRuntimeSettingsResponses, UpsertTelegramSettingsData, UpsertTelegramSettingsErrors, UpsertTelegramSettingsResponses } from './types.gen';
 
 export type Options<TData extends TDataShape = TDataShape, ThrowOnError extends boolean = boolean> = Options2<TData, ThrowOnError> & {
     /**
@@ -23,15 +23,36 @@ export type Options<TData extends TDataShape = TDataShape, ThrowOnError extends
  */
 export const getHealth = <ThrowOnError extends boolean = false>(options?: Options<GetHealthData, ThrowOnError>) => (options?.client ?? client).get<GetHealthResponses, unknown, ThrowOnError>({ url: '/healthz', ...options });
 
+/**
+ * Readiness check
+ *
+ * Answers 200 once the database schema is migrated and the worker has completed its first scheduling pass. Use /healthz for liveness.
+ */
+export const getReadiness = <ThrowOnError extends boolean = false>(options?: Options<GetReadinessData, ThrowOnError>) => (options?.client ?? client).get<GetReadinessResponses, GetReadinessErrors, ThrowOnError>({ url: '/readyz', ...options });
+
+/**
+ * Check the database, worker and schema
+ *
+ * The worker is unhealthy when its scheduler has not run a pass for three minutes. Migrations are unhealthy when migrations of this build have not been applied. Component messages only include the underlying errors while the API is open or for signed-in callers.
+ */
+export const getHealthDetails = <ThrowOnError extends boolean = false>(options?: Options<GetHealthDetailsData, ThrowOnError>) => (options?.client ?? client).get<GetHealthDetailsResponses, GetHealthDetailsErrors, ThrowOnError>({ url: '/v1/health/details', ...options });
+
 /**
  * List configured monitors
+ *
+ * Monitors are listed in the order set with PUT /v1/monitors/order; new monitors are added last.
  */
-export const listMonitors = <ThrowOnError extends boolean = false>(options?: Options<ListMonitorsData, ThrowOnError>) => (options?.client ?? client).get<ListMonitorsResponses, unknown, ThrowOnError>({ url: '/v1/monitors', ...options });
+export const listMonitors = <ThrowOnError extends boolean = false>(options?: Options<ListMonitorsData, ThrowOnError>) => (options?.client ?? client).get<ListMonitorsResponses, unknown, ThrowOnError>({
+    security: [{ scheme: 'bearer', type: 'http' }],
+    url: '/v1/monitors',
+    ...options
+});
 
 /**
  * Create monitor
  */
 export const createMonitor = <ThrowOnError extends boolean = false>(options: Options<CreateMonitorData, ThrowOnError>) => (options.client ?? client).post<CreateMonitorResponses, CreateMonitorErrors, ThrowOnError>({
+    security: [{ scheme: 'bearer', type: 'http' }],
     url: '/v1/monitors',
     ...options,
     headers: {
@@ -41,14 +62,21 @@ export const createMonitor = <ThrowOnError extends boolean = false>(options: Opt
 });
 
 /**
- * Delete monitor
+ * Archive monitor
+ *
+ * Hides the monitor from listings and stops scheduling it while keeping its history. Use /permanent to delete it for good.
  */
-export const deleteMonitor = <ThrowOnError extends boolean = false>(options: Options<DeleteMonitorData, ThrowOnError>) => (options.client ?? client).delete<DeleteMonitorResponses, DeleteMonitorErrors, ThrowOnError>({ url: '/v1/monitors/{monitorId}', ...options });
+export const archiveMonitor = <ThrowOnError extends boolean = false>(options: Options<ArchiveMonitorData, ThrowOnError>) => (options.client ?? client).delete<ArchiveMonitorResponses, ArchiveMonitorErrors, ThrowOnError>({
+    security: [{ scheme: 'bearer', type: 'http' }],
+    url: '/v1/monitors/{monitorId}',
+    ...options
+});
 
 /**
  * Update monitor
  */
 export const updateMonitor = <ThrowOnError extends boolean = false>(options: Options<UpdateMonitorData, ThrowOnError>) => (options.client ?? client).put<UpdateMonitorResponses, UpdateMonitorErrors, ThrowOnError>({
+    security: [{ scheme: 'bearer', type: 'http' }],
     url: '/v1/monitors/{monitorId}',
     ...options,
     headers: {
@@ -57,15 +85,283 @@ export const updateMonitor = <ThrowOnError extends boolean = false>(options: Opt
     }
 });
 
+/**
+ * Restore an archived monitor
+ *
+ * Enabled monitors are scheduled again from their cron expression.
+ */
+export const restoreMonitor = <ThrowOnError extends boolean = false>(options: Options<RestoreMonitorData, ThrowOnError>) => (options.client ?? client).post<RestoreMonitorResponses, RestoreMonitorErrors, ThrowOnError>({
+    security: [{ scheme: 'bearer', type: 'http' }],
+    url: '/v1/monitors/{monitorId}/restore',
+    ...options
+});
+
+/**
+ * Permanently delete monitor
+ *
+ * Removes the monitor with its checks, notification events and runtime. This cannot be undone.
+ */
+export const deleteMonitor = <ThrowOnError extends boolean = false>(options: Options<DeleteMonitorData, ThrowOnError>) => (options.client ?? client).delete<DeleteMonitorResponses, DeleteMonitorErrors, ThrowOnError>({
+    security: [{ scheme: 'bearer', type: 'http' }],
+    url: '/v1/monitors/{monitorId}/permanent',
+    ...options
+});
+
 /**
  * Trigger monitor to run immediately
  */
-export const triggerMonitor = <ThrowOnError extends boolean = false>(options: Options<TriggerMonitorData, ThrowOnError>) => (options.client ?? client).post<TriggerMonitorResponses, TriggerMonitorErrors, ThrowOnError>({ url: '/v1/monitors/{monitorId}/trigger', ...options });
+export const triggerMonitor = <ThrowOnError extends boolean = false>(options: Options<TriggerMonitorData, ThrowOnError>) => (options.client ?? client).post<TriggerMonitorResponses, TriggerMonitorErrors, ThrowOnError>({
+    security: [{ scheme: 'bearer', type: 'http' }],
+    url: '/v1/monitors/{monitorId}/trigger',
+    ...options
+});
+
+/**
+ * Run a monitor check immediately and return its result
+ */
+export const runMonitor = <ThrowOnError extends boolean = false>(options: Options<RunMonitorData, ThrowOnError>) => (options.client ?? client).post<RunMonitorResponses, RunMonitorErrors, ThrowOnError>({
+    security: [{ scheme: 'bearer', type: 'http' }],
+    url: '/v1/monitors/{monitorId}/run',
+    ...options
+});
+
+/**
+ * List the cookies a monitor with a cookie jar sends
+ */
+export const getMonitorCookies = <ThrowOnError extends boolean = false>(options: Options<GetMonitorCookiesData, ThrowOnError>) => (options.client ?? client).get<GetMonitorCookiesResponses, GetMonitorCookiesErrors, ThrowOnError>({
+    security: [{ scheme: 'bearer', type: 'http' }],
+    url: '/v1/monitors/{monitorId}/cookies',
+    ...options
+});
+
+/**
+ * Seed a monitor's cookie jar, replacing its stored cookies
+ */
+export const replaceMonitorCookies = <ThrowOnError extends boolean = false>(options: Options<ReplaceMonitorCookiesData, ThrowOnError>) => (options.client ?? client).put<ReplaceMonitorCookiesResponses, ReplaceMonitorCookiesErrors, ThrowOnError>({
+    security: [{ scheme: 'bearer', type: 'http' }],
+    url: '/v1/monitors/{monitorId}/cookies',
+    ...options,
+    headers: {
+        'Content-Type': 'application/json',
+        ...options.headers
+    }
+});
+
+/**
+ * Clear a monitor's stored cookies
+ */
+export const clearMonitorCookies = <ThrowOnError extends boolean = false>(options: Options<ClearMonitorCookiesData, ThrowOnError>) => (options.client ?? client).delete<ClearMonitorCookiesResponses, ClearMonitorCookiesErrors, ThrowOnError>({
+    security: [{ scheme: 'bearer', type: 'http' }],
+    url: '/v1/monitors/{monitorId}/cookies',
+    ...options
+});
+
+/**
+ * Acknowledge a failing monitor to stop escalation
+ */
+export const acknowledgeMonitor = <ThrowOnError extends boolean = false>(options: Options<AcknowledgeMonitorData, ThrowOnError>) => (options.client ?? client).post<AcknowledgeMonitorResponses, AcknowledgeMonitorErrors, ThrowOnError>({
+    security: [{ scheme: 'bearer', type: 'http' }],
+    url: '/v1/monitors/{monitorId}/acknowledge',
+    ...options
+});
+
+/**
+ * Copy a monitor's configuration into a new disabled monitor
+ *
+ * The copy's label gets a " (copy)" suffix. Checks and runtime state are not copied, and heartbeat monitors get a new ping URL.
+ */
+export const duplicateMonitor = <ThrowOnError extends boolean = false>(options: Options<DuplicateMonitorData, ThrowOnError>) => (options.client ?? client).post<DuplicateMonitorResponses, DuplicateMonitorErrors, ThrowOnError>({
+    security: [{ scheme: 'bearer', type: 'http' }],
+    url: '/v1/monitors/{monitorId}/duplicate',
+    ...options
+});
+
+/**
+ * List a monitor's configuration history, newest first
+ *
+ * A version is recorded whenever the monitor is created or its configuration changes. The latest 50 are kept.
+ */
+export const listMonitorVersions = <ThrowOnError extends boolean = false>(options: Options<ListMonitorVersionsData, ThrowOnError>) => (options.client ?? client).get<ListMonitorVersionsResponses, ListMonitorVersionsErrors, ThrowOnError>({
+    security: [{ scheme: 'bearer', type: 'http' }],
+    url: '/v1/monitors/{monitorId}/versions',
+    ...options
+});
+
+/**
+ * Restore a monitor's configuration from an earlier version
+ *
+ * The restored configuration is recorded as a new version.
+ */
+export const restoreMonitorVersion = <ThrowOnError extends boolean = false>(options: Options<RestoreMonitorVersionData, ThrowOnError>) => (options.client ?? client).post<RestoreMonitorVersionResponses, RestoreMonitorVersionErrors, ThrowOnError>({
+    security: [{ scheme: 'bearer', type: 'http' }],
+    url: '/v1/monitors/{monitorId}/versions/{version}/restore',
+    ...options
+});
+
+/**
+ * Pre-authorize the next change so it is recorded as the new baseline without alerting
+ */
+export const expectMonitorChange = <ThrowOnError extends boolean = false>(options: Options<ExpectMonitorChangeData, ThrowOnError>) => (options.client ?? client).post<ExpectMonitorChangeResponses, ExpectMonitorChangeErrors, ThrowOnError>({
+    security: [{ scheme: 'bearer', type: 'http' }],
+    url: '/v1/monitors/{monitorId}/expect-change',
+    ...options
+});
+
+/**
+ * Cancel a pending expected change window
+ */
+export const cancelExpectMonitorChange = <ThrowOnError extends boolean = false>(options: Options<CancelExpectMonitorChangeData, ThrowOnError>) => (options.client ?? client).delete<CancelExpectMonitorChangeResponses, CancelExpectMonitorChangeErrors, ThrowOnError>({
+    security: [{ scheme: 'bearer', type: 'http' }],
+    url: '/v1/monitors/{monitorId}/expect-change',
+    ...options
+});
+
+/**
+ * List change frequency and performance stats for all monitors
+ */
+export const listMonitorStats = <ThrowOnError extends boolean = false>(options?: Options<ListMonitorStatsData, ThrowOnError>) => (options?.client ?? client).get<ListMonitorStatsResponses, unknown, ThrowOnError>({
+    security: [{ scheme: 'bearer', type: 'http' }],
+    url: '/v1/monitors/stats',
+    ...options
+});
+
+/**
+ * Get change frequency, uptime and latency stats for a monitor
+ */
+export const getMonitorStats = <ThrowOnError extends boolean = false>(options: Options<GetMonitorStatsData, ThrowOnError>) => (options.client ?? client).get<GetMonitorStatsResponses, GetMonitorStatsErrors, ThrowOnError>({
+    security: [{ scheme: 'bearer', type: 'http' }],
+    url: '/v1/monitors/{monitorId}/stats',
+    ...options
+});
+
+/**
+ * Get hourly or daily check rollups for a monitor
+ *
+ * Rollups are recorded by the worker for every check and outlive the raw check history. Hourly rollups are kept for 90 days, daily rollups for the monitor's lifetime.
+ */
+export const getMonitorRollups = <ThrowOnError extends boolean = false>(options: Options<GetMonitorRollupsData, ThrowOnError>) => (options.client ?? client).get<GetMonitorRollupsResponses, GetMonitorRollupsErrors, ThrowOnError>({
+    security: [{ scheme: 'bearer', type: 'http' }],
+    url: '/v1/monitors/{monitorId}/stats/rollups',
+    ...options
+});
+
+/**
+ * Render a shields-style SVG badge for a monitor
+ *
+ * Public for enabled, unarchived monitors with statusPage enabled, so badges can be embedded in READMEs and wikis; other monitors, and value badges of any monitor, need authentication.
+ */
+export const getMonitorBadge = <ThrowOnError extends boolean = false>(options: Options<GetMonitorBadgeData, ThrowOnError>) => (options.client ?? client).get<GetMonitorBadgeResponses, GetMonitorBadgeErrors, ThrowOnError>({
+    security: [{ scheme: 'bearer', type: 'http' }],
+    url: '/v1/monitors/{monitorId}/badge.svg',
+    ...options
+});
+
+/**
+ * Enable, disable, delete or trigger several monitors
+ *
+ * Enable, disable and delete are applied in one transaction. Trigger runs each check in turn and reports every outcome.
+ */
+export const bulkMonitors = <ThrowOnError extends boolean = false>(options: Options<BulkMonitorsData, ThrowOnError>) => (options.client ?? client).post<BulkMonitorsResponses, BulkMonitorsErrors, ThrowOnError>({
+    security: [{ scheme: 'bearer', type: 'http' }],
+    url: '/v1/monitors/bulk',
+    ...options,
+    headers: {
+        'Content-Type': 'application/json',
+        ...options.headers
+    }
+});
+
+/**
+ * Set the order monitors are listed in
+ *
+ * The listed monitors come first, in the order given. Monitors left out keep their relative order after them.
+ */
+export const reorderMonitors = <ThrowOnError extends boolean = false>(options: Options<ReorderMonitorsData, ThrowOnError>) => (options.client ?? client).put<ReorderMonitorsResponses, ReorderMonitorsErrors, ThrowOnError>({
+    security: [{ scheme: 'bearer', type: 'http' }],
+    url: '/v1/monitors/order',
+    ...options,
+    headers: {
+        'Content-Type': 'application/json',
+        ...options.headers
+    }
+});
+
+/**
+ * Export monitor configurations for version control or migration
+ *
+ * Check history and runtime state are not exported. headerProfileId refers to header profiles on the exporting instance.
+ */
+export const exportMonitors = <ThrowOnError extends boolean = false>(options?: Options<ExportMonitorsData, ThrowOnError>) => (options?.client ?? client).get<ExportMonitorsResponses, ExportMonitorsErrors, ThrowOnError>({
+    security: [{ scheme: 'bearer', type: 'http' }],
+    url: '/v1/monitors/export',
+    ...options
+});
+
+/**
+ * Create or update monitors from an export document
+ *
+ * An imported monitor conflicts with an existing monitor that has the same label, or the same URL when unlabelled. Every entry is validated before anything is saved.
+ */
+export const importMonitors = <ThrowOnError extends boolean = false>(options: Options<ImportMonitorsData, ThrowOnError>) => (options.client ?? client).post<ImportMonitorsResponses, ImportMonitorsErrors, ThrowOnError>({
+    security: [{ scheme: 'bearer', type: 'http' }],
+    url: '/v1/monitors/import',
+    ...options,
+    headers: {
+        'Content-Type': 'application/json',
+        ...options.headers
+    }
+});
+
+/**
+ * Create basic monitors from a newline-separated URL list
+ */
+export const importMonitorUrls = <ThrowOnError extends boolean = false>(options: Options<ImportMonitorUrlsData, ThrowOnError>) => (options.client ?? client).post<ImportMonitorUrlsResponses, ImportMonitorUrlsErrors, ThrowOnError>({
+    bodySerializer: null,
+    security: [{ scheme: 'bearer', type: 'http' }],
+    url: '/v1/monitors/import/urls',
+    ...options,
+    headers: {
+        'Content-Type': 'text/plain',
+        ...options.headers
+    }
+});
+
+/**
+ * Read a curl command into a monitor draft
+ *
+ * Reads the method, URL, -H headers, -d body and -u auth of a pasted curl command. An "Authorization: Bearer" header becomes bearer auth. Nothing is saved; add a cron to the draft and send it to POST /v1/monitors.
+ */
+export const importMonitorCurl = <ThrowOnError extends boolean = false>(options: Options<ImportMonitorCurlData, ThrowOnError>) => (options.client ?? client).post<ImportMonitorCurlResponses, ImportMonitorCurlErrors, ThrowOnError>({
+    bodySerializer: null,
+    security: [{ scheme: 'bearer', type: 'http' }],
+    url: '/v1/monitors/import/curl',
+    ...options,
+    headers: {
+        'Content-Type': 'text/plain',
+        ...options.headers
+    }
+});
+
+/**
+ * List the requests of a HAR export and create monitors from picked ones
+ *
+ * Lists every http(s) request of the export as a monitor draft that keeps its method, headers and body; transport headers and HTTP/2 pseudo-headers are dropped and a bearer Authorization header becomes auth. Requests picked with entry are created as monitors.
+ */
+export const importMonitorHar = <ThrowOnError extends boolean = false>(options: Options<ImportMonitorHarData, ThrowOnError>) => (options.client ?? client).post<ImportMonitorHarResponses, ImportMonitorHarErrors, ThrowOnError>({
+    security: [{ scheme: 'bearer', type: 'http' }],
+    url: '/v1/monitors/import/har',
+    ...options,
+    headers: {
+        'Content-Type': 'application/json',
+        ...options.headers
+    }
+});
 
 /**
  * Test monitor URL via backend
  */
 export const testMonitorUrl = <ThrowOnError extends boolean = false>(options: Options<TestMonitorUrlData, ThrowOnError>) => (options.client ?? client).post<TestMonitorUrlResponses, TestMonitorUrlErrors, ThrowOnError>({
+    security: [{ scheme: 'bearer', type: 'http' }],
     url: '/v1/monitors/test',
     ...options,
     headers: {
@@ -74,10 +370,41 @@ export const testMonitorUrl = <ThrowOnError extends boolean = false>(options: Op
     }
 });
 
+/**
+ * Run one check of a monitor config without saving it
+ *
+ * Runs the full check pipeline, including expectedType, selector and expectedResponse, with the current runtime settings. With monitorId, the result also carries the diff the check would record against that monitor's latest stored check. Retries are skipped and nothing is saved.
+ */
+export const dryRunMonitor = <ThrowOnError extends boolean = false>(options: Options<DryRunMonitorData, ThrowOnError>) => (options.client ?? client).post<DryRunMonitorResponses, DryRunMonitorErrors, ThrowOnError>({
+    security: [{ scheme: 'bearer', type: 'http' }],
+    url: '/v1/monitors/dry-run',
+    ...options,
+    headers: {
+        'Content-Type': 'application/json',
+        ...options.headers
+    }
+});
+
+/**
+ * Create a JSON monitor from a tested request
+ *
+ * Saves the request of a monitor test with a cron, label and selector. With selectorPayloadToken from the test, the selector must match something in the tested response.
+ */
+export const createMonitorFromTest = <ThrowOnError extends boolean = false>(options: Options<CreateMonitorFromTestData, ThrowOnError>) => (options.client ?? client).post<CreateMonitorFromTestResponses, CreateMonitorFromTestErrors, ThrowOnError>({
+    security: [{ scheme: 'bearer', type: 'http' }],
+    url: '/v1/monitors/from-test',
+    ...options,
+    headers: {
+        'Content-Type': 'application/json',
+        ...options.headers
+    }
+});
+
 /**
  * Preview a gjson selector against JSON
  */
 export const previewMonitorSelector = <ThrowOnError extends boolean = false>(options: Options<PreviewMonitorSelectorData, ThrowOnError>) => (options.client ?? client).post<PreviewMonitorSelectorResponses, PreviewMonitorSelectorErrors, ThrowOnError>({
+    security: [{ scheme: 'bearer', type: 'http' }],
     url: '/v1/monitors/selector-preview',
     ...options,
     headers: {
@@ -86,15 +413,114 @@ export const previewMonitorSelector = <ThrowOnError extends boolean = false>(opt
     }
 });
 
+/**
+ * Preview the diff a JSON monitor would report between two payloads
+ *
+ * Applies the selector to both payloads and diffs the selections with default diff options, as a JSON monitor would when its response changes from previous to current.
+ */
+export const previewDiff = <ThrowOnError extends boolean = false>(options: Options<PreviewDiffData, ThrowOnError>) => (options.client ?? client).post<PreviewDiffResponses, PreviewDiffErrors, ThrowOnError>({
+    security: [{ scheme: 'bearer', type: 'http' }],
+    url: '/v1/diff/preview',
+    ...options,
+    headers: {
+        'Content-Type': 'application/json',
+        ...options.headers
+    }
+});
+
+/**
+ * Record a heartbeat ping from an external job
+ */
+export const pingHeartbeat = <ThrowOnError extends boolean = false>(options: Options<PingHeartbeatData, ThrowOnError>) => (options.client ?? client).get<PingHeartbeatResponses, PingHeartbeatErrors, ThrowOnError>({ url: '/v1/heartbeats/{token}', ...options });
+
+/**
+ * Record a heartbeat ping from an external job
+ */
+export const postHeartbeat = <ThrowOnError extends boolean = false>(options: Options<PostHeartbeatData, ThrowOnError>) => (options.client ?? client).post<PostHeartbeatResponses, PostHeartbeatErrors, ThrowOnError>({ url: '/v1/heartbeats/{token}', ...options });
+
+/**
+ * Get the public status page
+ *
+ * Lists enabled monitors marked statusPage with their current status and recent uptime. Monitor URLs and configuration are not included.
+ */
+export const getStatusPage = <ThrowOnError extends boolean = false>(options?: Options<GetStatusPageData, ThrowOnError>) => (options?.client ?? client).get<GetStatusPageResponses, unknown, ThrowOnError>({ url: '/v1/status-page', ...options });
+
+/**
+ * Summarize monitors by tag
+ */
+export const listTags = <ThrowOnError extends boolean = false>(options?: Options<ListTagsData, ThrowOnError>) => (options?.client ?? client).get<ListTagsResponses, unknown, ThrowOnError>({
+    security: [{ scheme: 'bearer', type: 'http' }],
+    url: '/v1/tags',
+    ...options
+});
+
+/**
+ * Summarize the monitors with a tag
+ */
+export const getTag = <ThrowOnError extends boolean = false>(options: Options<GetTagData, ThrowOnError>) => (options.client ?? client).get<GetTagResponses, GetTagErrors, ThrowOnError>({
+    security: [{ scheme: 'bearer', type: 'http' }],
+    url: '/v1/tags/{tag}',
+    ...options
+});
+
+/**
+ * List header profiles
+ */
+export const listHeaderProfiles = <ThrowOnError extends boolean = false>(options?: Options<ListHeaderProfilesData, ThrowOnError>) => (options?.client ?? client).get<ListHeaderProfilesResponses, unknown, ThrowOnError>({
+    security: [{ scheme: 'bearer', type: 'http' }],
+    url: '/v1/header-profiles',
+    ...options
+});
+
+/**
+ * Create a named set of headers monitors can reference
+ */
+export const createHeaderProfile = <ThrowOnError extends boolean = false>(options: Options<CreateHeaderProfileData, ThrowOnError>) => (options.client ?? client).post<CreateHeaderProfileResponses, CreateHeaderProfileErrors, ThrowOnError>({
+    security: [{ scheme: 'bearer', type: 'http' }],
+    url: '/v1/header-profiles',
+    ...options,
+    headers: {
+        'Content-Type': 'application/json',
+        ...options.headers
+    }
+});
+
+/**
+ * Update a header profile
+ */
+export const updateHeaderProfile = <ThrowOnError extends boolean = false>(options: Options<UpdateHeaderProfileData, ThrowOnError>) => (options.client ?? client).put<UpdateHeaderProfileResponses, UpdateHeaderProfileErrors, ThrowOnError>({
+    security: [{ scheme: 'bearer', type: 'http' }],
+    url: '/v1/header-profiles/{profileId}',
+    ...options,
+    headers: {
+        'Content-Type': 'application/json',
+        ...options.headers
+    }
+});
+
+/**
+ * Delete a header profile that no monitor references
+ */
+export const deleteHeaderProfile = <ThrowOnError extends boolean = false>(options: Options<DeleteHeaderProfileData, ThrowOnError>) => (options.client ?? client).delete<DeleteHeaderProfileResponses, DeleteHeaderProfileErrors, ThrowOnError>({
+    security: [{ scheme: 'bearer', type: 'http' }],
+    url: '/v1/header-profiles/{profileId}',
+    ...options
+});
+
 /**
  * Get Telegram notification channel settings
  */
-export const getTelegramSettings = <ThrowOnError extends boolean = false>(options?: Options<GetTelegramSettingsData, ThrowOnError>) => (options?.client ?? client).get<GetTelegramSettingsResponses, unknown, ThrowOnError>({ url: '/v1/settings/notifications/telegram', ...options });
+export const getTelegramSettings = <ThrowOnError extends boolean = false>(options?: Options<GetTelegramSettingsData, ThrowOnError>) => (options?.client ?? client).get<GetTelegramSettingsResponses, unknown, ThrowOnError>({
+    security: [{ scheme: 'bearer', type: 'http' }],
+    url: '/v1/settings/notifications/telegram',
+    ...options
+});
 
 /**
  * Create or update Telegram notification channel settings
  */
 export const upsertTelegramSettings = <ThrowOnError extends boolean = false>(options: Options<UpsertTelegramSettingsData, ThrowOnError>) => (options.client ?? client).put<UpsertTelegramSettingsResponses, UpsertTelegramSettingsErrors, ThrowOnError>({
+    security: [{ scheme: 'bearer', type: 'http' }],
     url: '/v1/settings/notifications/telegram',
     ...options,
     headers: {
@@ -107,6 +533,7 @@ export const upsertTelegramSettings = <ThrowOnError extends boolean = false>(opt
  * Send a test Telegram notification
  */
 export const testTelegramSettings = <ThrowOnError extends boolean = false>(options: Options<TestTelegramSettingsData, ThrowOnError>) => (options.client ?? client).post<TestTelegramSettingsResponses, TestTelegramSettingsErrors, ThrowOnError>({
+    security: [{ scheme: 'bearer', type: 'http' }],
     url: '/v1/settings/notifications/telegram/test',
     ...options,
     headers: {
@@ -115,15 +542,46 @@ export const testTelegramSettings = <ThrowOnError extends boolean = false>(optio
     }
 });
 
+/**
+ * Export runtime settings and notification channels to bootstrap another instance
+ *
+ * The runtime section is left out until a timezone is set.
+ */
+export const exportSettings = <ThrowOnError extends boolean = false>(options?: Options<ExportSettingsData, ThrowOnError>) => (options?.client ?? client).get<ExportSettingsResponses, ExportSettingsErrors, ThrowOnError>({
+    security: [{ scheme: 'bearer', type: 'http' }],
+    url: '/v1/settings/export',
+    ...options
+});
+
+/**
+ * Apply a settings export document
+ *
+ * Runtime fields missing from the document keep their current values. Notification channels are matched by kind; a channel without a bot token keeps its current token, and is skipped when it does not exist yet. Everything is validated before anything is saved.
+ */
+export const importSettings = <ThrowOnError extends boolean = false>(options: Options<ImportSettingsData, ThrowOnError>) => (options.client ?? client).post<ImportSettingsResponses, ImportSettingsErrors, ThrowOnError>({
+    security: [{ scheme: 'bearer', type: 'http' }],
+    url: '/v1/settings/import',
+    ...options,
+    headers: {
+        'Content-Type': 'application/json',
+        ...options.headers
+    }
+});
+
 /**
  * Get global runtime settings
  */
-export const getRuntimeSettings = <ThrowOnError extends boolean = false>(options?: Options<GetRuntimeSettingsData, ThrowOnError>) => (options?.client ?? client).get<GetRuntimeSettingsResponses, unknown, ThrowOnError>({ url: '/v1/settings/runtime', ...options });
+export const getRuntimeSettings = <ThrowOnError extends boolean = false>(options?: Options<GetRuntimeSettingsData, ThrowOnError>) => (options?.client ?? client).get<GetRuntimeSettingsResponses, unknown, ThrowOnError>({
+    security: [{ scheme: 'bearer', type: 'http' }],
+    url: '/v1/settings/runtime',
+    ...options
+});
 
 /**
  * Update global runtime settings
  */
 export const upsertRuntimeSettings = <ThrowOnError extends boolean = false>(options: Options<UpsertRuntimeSettingsData, ThrowOnError>) => (options.client ?? client).put<UpsertRuntimeSettingsResponses, UpsertRuntimeSettingsErrors, ThrowOnError>({
+    security: [{ scheme: 'bearer', type: 'http' }],
     url: '/v1/settings/runtime',
     ...options,
     headers: {
@@ -132,7 +590,251 @@ export const upsertRuntimeSettings = <ThrowOnError extends boolean = false>(opti
     }
 });
 
+/**
+ * Fetch a URL through proxy settings before saving them
+ */
+export const testProxySettings = <ThrowOnError extends boolean = false>(options: Options<TestProxySettingsData, ThrowOnError>) => (options.client ?? client).post<TestProxySettingsResponses, TestProxySettingsErrors, ThrowOnError>({
+    security: [{ scheme: 'bearer', type: 'http' }],
+    url: '/v1/settings/runtime/proxy/test',
+    ...options,
+    headers: {
+        'Content-Type': 'application/json',
+        ...options.headers
+    }
+});
+
+/**
+ * Get the global pause state
+ */
+export const getSystemState = <ThrowOnError extends boolean = false>(options?: Options<GetSystemStateData, ThrowOnError>) => (options?.client ?? client).get<GetSystemStateResponses, unknown, ThrowOnError>({
+    security: [{ scheme: 'bearer', type: 'http' }],
+    url: '/v1/system',
+    ...options
+});
+
+/**
+ * Stop scheduling monitor runs globally
+ *
+ * Scheduled runs are held while paused; each monitor keeps its nextRunAt and overdue monitors run once scheduling resumes.
+ */
+export const pauseSystem = <ThrowOnError extends boolean = false>(options?: Options<PauseSystemData, ThrowOnError>) => (options?.client ?? client).post<PauseSystemResponses, PauseSystemErrors, ThrowOnError>({
+    security: [{ scheme: 'bearer', type: 'http' }],
+    url: '/v1/system/pause',
+    ...options,
+    headers: {
+        'Content-Type': 'application/json',
+        ...options?.headers
+    }
+});
+
+/**
+ * Resume scheduling and notifications
+ */
+export const resumeSystem = <ThrowOnError extends boolean = false>(options?: Options<ResumeSystemData, ThrowOnError>) => (options?.client ?? client).post<ResumeSystemResponses, unknown, ThrowOnError>({
+    security: [{ scheme: 'bearer', type: 'http' }],
+    url: '/v1/system/resume',
+    ...options
+});
+
+/**
+ * Download a consistent snapshot of the SQLite database
+ *
+ * The snapshot is taken with VACUUM INTO while the server keeps running. Start the server with -restore-from to restore it.
+ */
+export const backupDatabase = <ThrowOnError extends boolean = false>(options?: Options<BackupDatabaseData, ThrowOnError>) => (options?.client ?? client).post<BackupDatabaseResponses, BackupDatabaseErrors, ThrowOnError>({
+    security: [{ scheme: 'bearer', type: 'http' }],
+    url: '/v1/system/backup',
+    ...options
+});
+
 /**
  * List recent checks for a monitor
  */
-export const listMonitorChecks = <ThrowOnError extends boolean = false>(options: Options<ListMonitorChecksData, ThrowOnError>) => (options.client ?? client).get<ListMonitorChecksResponses, ListMonitorChecksErrors, ThrowOnError>({ url: '/v1/monitors/{monitorId}/checks', ...options });
+export const listMonitorChecks = <ThrowOnError extends boolean = false>(options: Options<ListMonitorChecksData, ThrowOnError>) => (options.client ?? client).get<ListMonitorChecksResponses, ListMonitorChecksErrors, ThrowOnError>({
+    security: [{ scheme: 'bearer', type: 'http' }],
+    url: '/v1/monitors/{monitorId}/checks',
+    ...options
+});
+
+/**
+ * Delete a monitor's checks, optionally within a time range
+ *
+ * Without from or to every stored check is deleted. Lifetime counters such as checkCount are kept.
+ */
+export const deleteMonitorChecks = <ThrowOnError extends boolean = false>(options: Options<DeleteMonitorChecksData, ThrowOnError>) => (options.client ?? client).delete<DeleteMonitorChecksResponses, DeleteMonitorChecksErrors, ThrowOnError>({
+    security: [{ scheme: 'bearer', type: 'http' }],
+    url: '/v1/monitors/{monitorId}/checks',
+    ...options
+});
+
+/**
+ * Compare the stored selections or bodies of two checks
+ */
+export const diffMonitorChecks = <ThrowOnError extends boolean = false>(options: Options<DiffMonitorChecksData, ThrowOnError>) => (options.client ?? client).get<DiffMonitorChecksResponses, DiffMonitorChecksErrors, ThrowOnError>({
+    security: [{ scheme: 'bearer', type: 'http' }],
+    url: '/v1/monitors/{monitorId}/checks/diff',
+    ...options
+});
+
+/**
+ * Preview a gjson selector against the latest stored check
+ *
+ * Evaluates the selector against the body snapshot of the most recent check that stored a body or selection. Without a body snapshot the stored selection is used, so the selector applies to the output of the monitor's current selector.
+ */
+export const previewStoredMonitorSelector = <ThrowOnError extends boolean = false>(options: Options<PreviewStoredMonitorSelectorData, ThrowOnError>) => (options.client ?? client).post<PreviewStoredMonitorSelectorResponses, PreviewStoredMonitorSelectorErrors, ThrowOnError>({
+    security: [{ scheme: 'bearer', type: 'http' }],
+    url: '/v1/monitors/{monitorId}/selector-preview',
+    ...options,
+    headers: {
+        'Content-Type': 'application/json',
+        ...options.headers
+    }
+});
+
+/**
+ * Get one check with its stored values untruncated
+ *
+ * Check lists cut errorMessage, selectionValue, diffSummary and diffDetails to 16 KiB; this returns them in full.
+ */
+export const getMonitorCheck = <ThrowOnError extends boolean = false>(options: Options<GetMonitorCheckData, ThrowOnError>) => (options.client ?? client).get<GetMonitorCheckResponses, GetMonitorCheckErrors, ThrowOnError>({
+    security: [{ scheme: 'bearer', type: 'http' }],
+    url: '/v1/monitors/{monitorId}/checks/{checkId}',
+    ...options
+});
+
+/**
+ * Delete one check
+ */
+export const deleteMonitorCheck = <ThrowOnError extends boolean = false>(options: Options<DeleteMonitorCheckData, ThrowOnError>) => (options.client ?? client).delete<DeleteMonitorCheckResponses, DeleteMonitorCheckErrors, ThrowOnError>({
+    security: [{ scheme: 'bearer', type: 'http' }],
+    url: '/v1/monitors/{monitorId}/checks/{checkId}',
+    ...options
+});
+
+/**
+ * Get the stored response body of a check
+ */
+export const getMonitorCheckBody = <ThrowOnError extends boolean = false>(options: Options<GetMonitorCheckBodyData, ThrowOnError>) => (options.client ?? client).get<GetMonitorCheckBodyResponses, GetMonitorCheckBodyErrors, ThrowOnError>({
+    security: [{ scheme: 'bearer', type: 'http' }],
+    url: '/v1/monitors/{monitorId}/checks/{checkId}/body',
+    ...options
+});
+
+/**
+ * Get the nearest earlier and later checks with changes
+ */
+export const getMonitorCheckNeighbors = <ThrowOnError extends boolean = false>(options: Options<GetMonitorCheckNeighborsData, ThrowOnError>) => (options.client ?? client).get<GetMonitorCheckNeighborsResponses, GetMonitorCheckNeighborsErrors, ThrowOnError>({
+    security: [{ scheme: 'bearer', type: 'http' }],
+    url: '/v1/monitors/{monitorId}/checks/{checkId}/neighbors',
+    ...options
+});
+
+/**
+ * WebSocket stream of live monitor events
+ *
+ * Upgrades to a WebSocket that sends LiveEvent JSON messages of type check.completed, monitor.updated and notification.sent.
+ * The connection starts subscribed to the monitors named by monitorId, or to every monitor; send {"type":"subscribe","monitorIds":[...]} to change that, with an empty list meaning every monitor.
+ * Each subscription change is acknowledged with {"type":"subscribed","monitorIds":[...]}. Browsers, which cannot set headers on WebSockets, pass a ticket from POST /v1/ws/ticket as the ticket query parameter instead of the bearer token.
+ * The stream closes when the session it was opened with expires or is revoked.
+ */
+export const streamEvents = <ThrowOnError extends boolean = false>(options?: Options<StreamEventsData, ThrowOnError>) => (options?.client ?? client).get<unknown, StreamEventsErrors, ThrowOnError>({
+    security: [{ scheme: 'bearer', type: 'http' }],
+    url: '/v1/ws',
+    ...options
+});
+
+/**
+ * Issue a 30-second ticket for opening the event WebSocket
+ */
+export const createWebSocketTicket = <ThrowOnError extends boolean = false>(options?: Options<CreateWebSocketTicketData, ThrowOnError>) => (options?.client ?? client).post<CreateWebSocketTicketResponses, CreateWebSocketTicketErrors, ThrowOnError>({
+    security: [{ scheme: 'bearer', type: 'http' }],
+    url: '/v1/ws/ticket',
+    ...options
+});
+
+/**
+ * Exchange a username and password for a bearer token
+ */
+export const login = <ThrowOnError extends boolean = false>(options: Options<LoginData, ThrowOnError>) => (options.client ?? client).post<LoginResponses, LoginErrors, ThrowOnError>({
+    url: '/v1/auth/login',
+    ...options,
+    headers: {
+        'Content-Type': 'application/json',
+        ...options.headers
+    }
+});
+
+/**
+ * Revoke the bearer token the request is made with
+ */
+export const logout = <ThrowOnError extends boolean = false>(options?: Options<LogoutData, ThrowOnError>) => (options?.client ?? client).post<LogoutResponses, unknown, ThrowOnError>({
+    security: [{ scheme: 'bearer', type: 'http' }],
+    url: '/v1/auth/logout',
+    ...options
+});
+
+/**
+ * Report whether sign-in is required and who the token belongs to
+ */
+export const getAuthStatus = <ThrowOnError extends boolean = false>(options?: Options<GetAuthStatusData, ThrowOnError>) => (options?.client ?? client).get<GetAuthStatusResponses, unknown, ThrowOnError>({ url: '/v1/auth/status', ...options });
+
+/**
+ * Change the signed-in user's password, revoking their other sessions
+ */
+export const changePassword = <ThrowOnError extends boolean = false>(options: Options<ChangePasswordData, ThrowOnError>) => (options.client ?? client).put<ChangePasswordResponses, ChangePasswordErrors, ThrowOnError>({
+    security: [{ scheme: 'bearer', type: 'http' }],
+    url: '/v1/auth/password',
+    ...options,
+    headers: {
+        'Content-Type': 'application/json',
+        ...options.headers
+    }
+});
+
+/**
+ * List users
+ */
+export const listUsers = <ThrowOnError extends boolean = false>(options?: Options<ListUsersData, ThrowOnError>) => (options?.client ?? client).get<ListUsersResponses, ListUsersErrors, ThrowOnError>({
+    security: [{ scheme: 'bearer', type: 'http' }],
+    url: '/v1/users',
+    ...options
+});
+
+/**
+ * Create a user
+ *
+ * While no users exist anyone may create the first user, which must be an admin. Creating it turns authentication on.
+ */
+export const createUser = <ThrowOnError extends boolean = false>(options: Options<CreateUserData, ThrowOnError>) => (options.client ?? client).post<CreateUserResponses, CreateUserErrors, ThrowOnError>({
+    security: [{ scheme: 'bearer', type: 'http' }],
+    url: '/v1/users',
+    ...options,
+    headers: {
+        'Content-Type': 'application/json',
+        ...options.headers
+    }
+});
+
+/**
+ * Change a user's role or password
+ *
+ * A new password revokes the user's sessions.
+ */
+export const updateUser = <ThrowOnError extends boolean = false>(options: Options<UpdateUserData, ThrowOnError>) => (options.client ?? client).put<UpdateUserResponses, UpdateUserErrors, ThrowOnError>({
+    security: [{ scheme: 'bearer', type: 'http' }],
+    url: '/v1/users/{userId}',
+    ...options,
+    headers: {
+        'Content-Type': 'application/json',
+        ...options.headers
+    }
+});
+
+/**
+ * Delete a user and their sessions
+ */
+export const deleteUser = <ThrowOnError extends boolean = false>(options: Options<DeleteUserData, ThrowOnError>) => (options.client ?? client).delete<DeleteUserResponses, DeleteUserErrors, ThrowOnError>({
+    security: [{ scheme: 'bearer', type: 'http' }],
+    url: '/v1/users/{userId}',
+    ...options
+});